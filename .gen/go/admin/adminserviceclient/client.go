@@ -47,18 +47,42 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	BatchTerminate(
+		ctx context.Context,
+		Request *admin.BatchTerminateRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.BatchTerminateResponse, error)
+
+	CancelPendingActivities(
+		ctx context.Context,
+		Request *shared.CancelPendingActivitiesRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	CloseShard(
 		ctx context.Context,
 		Request *shared.CloseShardRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	DecodeTaskToken(
+		ctx context.Context,
+		Request *admin.DecodeTaskTokenRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.DecodeTaskTokenResponse, error)
+
 	DeleteWorkflow(
 		ctx context.Context,
 		Request *admin.AdminDeleteWorkflowRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.AdminDeleteWorkflowResponse, error)
 
+	DescribeBatchTerminate(
+		ctx context.Context,
+		Request *admin.DescribeBatchTerminateRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.DescribeBatchTerminateResponse, error)
+
 	DescribeCluster(
 		ctx context.Context,
 		opts ...yarpc.CallOption,
@@ -88,6 +112,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*admin.DescribeWorkflowExecutionResponse, error)
 
+	ExpireActivityHeartbeat(
+		ctx context.Context,
+		Request *shared.ExpireActivityHeartbeatRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	GetCrossClusterTasks(
 		ctx context.Context,
 		Request *shared.GetCrossClusterTasksRequest,
@@ -178,6 +208,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RedriveTimedOutActivities(
+		ctx context.Context,
+		Request *shared.RedriveTimedOutActivitiesRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RefreshWorkflowTasks(
 		ctx context.Context,
 		Request *shared.RefreshWorkflowTasksRequest,
@@ -190,6 +226,18 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RemoveTaskListTask(
+		ctx context.Context,
+		Request *admin.RemoveTaskListTaskRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.RemoveTaskListTaskResponse, error)
+
+	ReplayActivityCompletions(
+		ctx context.Context,
+		Request *shared.ReplayActivityCompletionsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ReplayActivityCompletionsResponse, error)
+
 	ResendReplicationTasks(
 		ctx context.Context,
 		Request *admin.ResendReplicationTasksRequest,
@@ -296,6 +344,62 @@ func (c client) AddSearchAttribute(
 	return
 }
 
+func (c client) BatchTerminate(
+	ctx context.Context,
+	_Request *admin.BatchTerminateRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.BatchTerminateResponse, err error) {
+
+	var result admin.AdminService_BatchTerminate_Result
+	args := admin.AdminService_BatchTerminate_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_BatchTerminate_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) CancelPendingActivities(
+	ctx context.Context,
+	_Request *shared.CancelPendingActivitiesRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_CancelPendingActivities_Result
+	args := admin.AdminService_CancelPendingActivities_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_CancelPendingActivities_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) CloseShard(
 	ctx context.Context,
 	_Request *shared.CloseShardRequest,
@@ -324,6 +428,34 @@ func (c client) CloseShard(
 	return
 }
 
+func (c client) DecodeTaskToken(
+	ctx context.Context,
+	_Request *admin.DecodeTaskTokenRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DecodeTaskTokenResponse, err error) {
+
+	var result admin.AdminService_DecodeTaskToken_Result
+	args := admin.AdminService_DecodeTaskToken_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_DecodeTaskToken_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DeleteWorkflow(
 	ctx context.Context,
 	_Request *admin.AdminDeleteWorkflowRequest,
@@ -352,6 +484,34 @@ func (c client) DeleteWorkflow(
 	return
 }
 
+func (c client) DescribeBatchTerminate(
+	ctx context.Context,
+	_Request *admin.DescribeBatchTerminateRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeBatchTerminateResponse, err error) {
+
+	var result admin.AdminService_DescribeBatchTerminate_Result
+	args := admin.AdminService_DescribeBatchTerminate_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_DescribeBatchTerminate_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeCluster(
 	ctx context.Context,
 	opts ...yarpc.CallOption,
@@ -491,6 +651,34 @@ func (c client) DescribeWorkflowExecution(
 	return
 }
 
+func (c client) ExpireActivityHeartbeat(
+	ctx context.Context,
+	_Request *shared.ExpireActivityHeartbeatRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_ExpireActivityHeartbeat_Result
+	args := admin.AdminService_ExpireActivityHeartbeat_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_ExpireActivityHeartbeat_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetCrossClusterTasks(
 	ctx context.Context,
 	_Request *shared.GetCrossClusterTasksRequest,
@@ -911,6 +1099,34 @@ func (c client) ReapplyEvents(
 	return
 }
 
+func (c client) RedriveTimedOutActivities(
+	ctx context.Context,
+	_Request *shared.RedriveTimedOutActivitiesRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_RedriveTimedOutActivities_Result
+	args := admin.AdminService_RedriveTimedOutActivities_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_RedriveTimedOutActivities_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RefreshWorkflowTasks(
 	ctx context.Context,
 	_Request *shared.RefreshWorkflowTasksRequest,
@@ -967,6 +1183,62 @@ func (c client) RemoveTask(
 	return
 }
 
+func (c client) RemoveTaskListTask(
+	ctx context.Context,
+	_Request *admin.RemoveTaskListTaskRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.RemoveTaskListTaskResponse, err error) {
+
+	var result admin.AdminService_RemoveTaskListTask_Result
+	args := admin.AdminService_RemoveTaskListTask_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_RemoveTaskListTask_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ReplayActivityCompletions(
+	ctx context.Context,
+	_Request *shared.ReplayActivityCompletionsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ReplayActivityCompletionsResponse, err error) {
+
+	var result admin.AdminService_ReplayActivityCompletions_Result
+	args := admin.AdminService_ReplayActivityCompletions_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_ReplayActivityCompletions_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ResendReplicationTasks(
 	ctx context.Context,
 	_Request *admin.ResendReplicationTasksRequest,
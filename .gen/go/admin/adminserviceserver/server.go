@@ -46,16 +46,36 @@ type Interface interface {
 		Request *admin.AddSearchAttributeRequest,
 	) error
 
+	BatchTerminate(
+		ctx context.Context,
+		Request *admin.BatchTerminateRequest,
+	) (*admin.BatchTerminateResponse, error)
+
+	CancelPendingActivities(
+		ctx context.Context,
+		Request *shared.CancelPendingActivitiesRequest,
+	) error
+
 	CloseShard(
 		ctx context.Context,
 		Request *shared.CloseShardRequest,
 	) error
 
+	DecodeTaskToken(
+		ctx context.Context,
+		Request *admin.DecodeTaskTokenRequest,
+	) (*admin.DecodeTaskTokenResponse, error)
+
 	DeleteWorkflow(
 		ctx context.Context,
 		Request *admin.AdminDeleteWorkflowRequest,
 	) (*admin.AdminDeleteWorkflowResponse, error)
 
+	DescribeBatchTerminate(
+		ctx context.Context,
+		Request *admin.DescribeBatchTerminateRequest,
+	) (*admin.DescribeBatchTerminateResponse, error)
+
 	DescribeCluster(
 		ctx context.Context,
 	) (*admin.DescribeClusterResponse, error)
@@ -80,6 +100,11 @@ type Interface interface {
 		Request *admin.DescribeWorkflowExecutionRequest,
 	) (*admin.DescribeWorkflowExecutionResponse, error)
 
+	ExpireActivityHeartbeat(
+		ctx context.Context,
+		Request *shared.ExpireActivityHeartbeatRequest,
+	) error
+
 	GetCrossClusterTasks(
 		ctx context.Context,
 		Request *shared.GetCrossClusterTasksRequest,
@@ -155,6 +180,11 @@ type Interface interface {
 		ReapplyEventsRequest *shared.ReapplyEventsRequest,
 	) error
 
+	RedriveTimedOutActivities(
+		ctx context.Context,
+		Request *shared.RedriveTimedOutActivitiesRequest,
+	) error
+
 	RefreshWorkflowTasks(
 		ctx context.Context,
 		Request *shared.RefreshWorkflowTasksRequest,
@@ -165,6 +195,16 @@ type Interface interface {
 		Request *shared.RemoveTaskRequest,
 	) error
 
+	RemoveTaskListTask(
+		ctx context.Context,
+		Request *admin.RemoveTaskListTaskRequest,
+	) (*admin.RemoveTaskListTaskResponse, error)
+
+	ReplayActivityCompletions(
+		ctx context.Context,
+		Request *shared.ReplayActivityCompletionsRequest,
+	) (*shared.ReplayActivityCompletionsResponse, error)
+
 	ResendReplicationTasks(
 		ctx context.Context,
 		Request *admin.ResendReplicationTasksRequest,
@@ -229,6 +269,30 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "BatchTerminate",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.BatchTerminate),
+					NoWire: batchterminate_NoWireHandler{impl},
+				},
+				Signature:    "BatchTerminate(Request *admin.BatchTerminateRequest) (*admin.BatchTerminateResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "CancelPendingActivities",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.CancelPendingActivities),
+					NoWire: cancelpendingactivities_NoWireHandler{impl},
+				},
+				Signature:    "CancelPendingActivities(Request *shared.CancelPendingActivitiesRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "CloseShard",
 				HandlerSpec: thrift.HandlerSpec{
@@ -241,6 +305,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DecodeTaskToken",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.DecodeTaskToken),
+					NoWire: decodetasktoken_NoWireHandler{impl},
+				},
+				Signature:    "DecodeTaskToken(Request *admin.DecodeTaskTokenRequest) (*admin.DecodeTaskTokenResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DeleteWorkflow",
 				HandlerSpec: thrift.HandlerSpec{
@@ -253,6 +329,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeBatchTerminate",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.DescribeBatchTerminate),
+					NoWire: describebatchterminate_NoWireHandler{impl},
+				},
+				Signature:    "DescribeBatchTerminate(Request *admin.DescribeBatchTerminateRequest) (*admin.DescribeBatchTerminateResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeCluster",
 				HandlerSpec: thrift.HandlerSpec{
@@ -313,6 +401,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ExpireActivityHeartbeat",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ExpireActivityHeartbeat),
+					NoWire: expireactivityheartbeat_NoWireHandler{impl},
+				},
+				Signature:    "ExpireActivityHeartbeat(Request *shared.ExpireActivityHeartbeatRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetCrossClusterTasks",
 				HandlerSpec: thrift.HandlerSpec{
@@ -493,6 +593,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RedriveTimedOutActivities",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RedriveTimedOutActivities),
+					NoWire: redrivetimedoutactivities_NoWireHandler{impl},
+				},
+				Signature:    "RedriveTimedOutActivities(Request *shared.RedriveTimedOutActivitiesRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RefreshWorkflowTasks",
 				HandlerSpec: thrift.HandlerSpec{
@@ -517,6 +629,30 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RemoveTaskListTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RemoveTaskListTask),
+					NoWire: removetasklisttask_NoWireHandler{impl},
+				},
+				Signature:    "RemoveTaskListTask(Request *admin.RemoveTaskListTaskRequest) (*admin.RemoveTaskListTaskResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ReplayActivityCompletions",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ReplayActivityCompletions),
+					NoWire: replayactivitycompletions_NoWireHandler{impl},
+				},
+				Signature:    "ReplayActivityCompletions(Request *shared.ReplayActivityCompletionsRequest) (*shared.ReplayActivityCompletionsResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ResendReplicationTasks",
 				HandlerSpec: thrift.HandlerSpec{
@@ -615,7 +751,7 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 33)
+	procedures := make([]transport.Procedure, 0, 41)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -656,6 +792,66 @@ func (h handler) AddSearchAttribute(ctx context.Context, body wire.Value) (thrif
 	return response, err
 }
 
+func (h handler) BatchTerminate(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_BatchTerminate_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'BatchTerminate': %w", err)
+	}
+
+	success, appErr := h.impl.BatchTerminate(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_BatchTerminate_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) CancelPendingActivities(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_CancelPendingActivities_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'CancelPendingActivities': %w", err)
+	}
+
+	appErr := h.impl.CancelPendingActivities(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_CancelPendingActivities_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_CloseShard_Args
 	if err := args.FromWire(body); err != nil {
@@ -686,6 +882,36 @@ func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
+func (h handler) DecodeTaskToken(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DecodeTaskToken_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'DecodeTaskToken': %w", err)
+	}
+
+	success, appErr := h.impl.DecodeTaskToken(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DecodeTaskToken_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DeleteWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DeleteWorkflow_Args
 	if err := args.FromWire(body); err != nil {
@@ -716,6 +942,36 @@ func (h handler) DeleteWorkflow(ctx context.Context, body wire.Value) (thrift.Re
 	return response, err
 }
 
+func (h handler) DescribeBatchTerminate(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeBatchTerminate_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'DescribeBatchTerminate': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeBatchTerminate(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeBatchTerminate_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DescribeCluster(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeCluster_Args
 	if err := args.FromWire(body); err != nil {
@@ -866,6 +1122,36 @@ func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) ExpireActivityHeartbeat(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ExpireActivityHeartbeat_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ExpireActivityHeartbeat': %w", err)
+	}
+
+	appErr := h.impl.ExpireActivityHeartbeat(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ExpireActivityHeartbeat_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) GetCrossClusterTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_GetCrossClusterTasks_Args
 	if err := args.FromWire(body); err != nil {
@@ -1316,17 +1602,17 @@ func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
-func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_RefreshWorkflowTasks_Args
+func (h handler) RedriveTimedOutActivities(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RedriveTimedOutActivities_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'RefreshWorkflowTasks': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'RedriveTimedOutActivities': %w", err)
 	}
 
-	appErr := h.impl.RefreshWorkflowTasks(ctx, args.Request)
+	appErr := h.impl.RedriveTimedOutActivities(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_RefreshWorkflowTasks_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_RedriveTimedOutActivities_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1346,17 +1632,17 @@ func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
-func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_RemoveTask_Args
+func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RefreshWorkflowTasks_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'RemoveTask': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'RefreshWorkflowTasks': %w", err)
 	}
 
-	appErr := h.impl.RemoveTask(ctx, args.Request)
+	appErr := h.impl.RefreshWorkflowTasks(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_RemoveTask_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_RefreshWorkflowTasks_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1376,17 +1662,17 @@ func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
-func (h handler) ResendReplicationTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_ResendReplicationTasks_Args
+func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RemoveTask_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'ResendReplicationTasks': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'RemoveTask': %w", err)
 	}
 
-	appErr := h.impl.ResendReplicationTasks(ctx, args.Request)
+	appErr := h.impl.RemoveTask(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_ResendReplicationTasks_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_RemoveTask_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1406,17 +1692,17 @@ func (h handler) ResendReplicationTasks(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_ResetQueue_Args
+func (h handler) RemoveTaskListTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RemoveTaskListTask_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'ResetQueue': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'RemoveTaskListTask': %w", err)
 	}
 
-	appErr := h.impl.ResetQueue(ctx, args.Request)
+	success, appErr := h.impl.RemoveTaskListTask(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_ResetQueue_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_RemoveTaskListTask_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1436,17 +1722,17 @@ func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
-func (h handler) RespondCrossClusterTasksCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_RespondCrossClusterTasksCompleted_Args
+func (h handler) ReplayActivityCompletions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReplayActivityCompletions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'RespondCrossClusterTasksCompleted': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'ReplayActivityCompletions': %w", err)
 	}
 
-	success, appErr := h.impl.RespondCrossClusterTasksCompleted(ctx, args.Request)
+	success, appErr := h.impl.ReplayActivityCompletions(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_RespondCrossClusterTasksCompleted_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_ReplayActivityCompletions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1466,17 +1752,17 @@ func (h handler) RespondCrossClusterTasksCompleted(ctx context.Context, body wir
 	return response, err
 }
 
-func (h handler) RestoreDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_RestoreDynamicConfig_Args
+func (h handler) ResendReplicationTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResendReplicationTasks_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'RestoreDynamicConfig': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'ResendReplicationTasks': %w", err)
 	}
 
-	appErr := h.impl.RestoreDynamicConfig(ctx, args.Request)
+	appErr := h.impl.ResendReplicationTasks(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_RestoreDynamicConfig_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_ResendReplicationTasks_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1496,9 +1782,99 @@ func (h handler) RestoreDynamicConfig(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
-func (h handler) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_UpdateDomainAsyncWorkflowConfiguraton_Args
-	if err := args.FromWire(body); err != nil {
+func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResetQueue_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ResetQueue': %w", err)
+	}
+
+	appErr := h.impl.ResetQueue(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ResetQueue_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondCrossClusterTasksCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RespondCrossClusterTasksCompleted_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RespondCrossClusterTasksCompleted': %w", err)
+	}
+
+	success, appErr := h.impl.RespondCrossClusterTasksCompleted(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RespondCrossClusterTasksCompleted_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RestoreDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RestoreDynamicConfig_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RestoreDynamicConfig': %w", err)
+	}
+
+	appErr := h.impl.RestoreDynamicConfig(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RestoreDynamicConfig_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UpdateDomainAsyncWorkflowConfiguraton_Args
+	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
 			"could not decode Thrift request for service 'AdminService' procedure 'UpdateDomainAsyncWorkflowConfiguraton': %w", err)
 	}
@@ -1653,6 +2029,80 @@ func (h addsearchattribute_NoWireHandler) HandleNoWire(ctx context.Context, nwc
 
 }
 
+type batchterminate_NoWireHandler struct{ impl Interface }
+
+func (h batchterminate_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_BatchTerminate_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'BatchTerminate': %w", err)
+	}
+
+	success, appErr := h.impl.BatchTerminate(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_BatchTerminate_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type cancelpendingactivities_NoWireHandler struct{ impl Interface }
+
+func (h cancelpendingactivities_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_CancelPendingActivities_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'CancelPendingActivities': %w", err)
+	}
+
+	appErr := h.impl.CancelPendingActivities(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_CancelPendingActivities_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type closeshard_NoWireHandler struct{ impl Interface }
 
 func (h closeshard_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -1690,6 +2140,43 @@ func (h closeshard_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.
 
 }
 
+type decodetasktoken_NoWireHandler struct{ impl Interface }
+
+func (h decodetasktoken_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DecodeTaskToken_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DecodeTaskToken': %w", err)
+	}
+
+	success, appErr := h.impl.DecodeTaskToken(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DecodeTaskToken_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type deleteworkflow_NoWireHandler struct{ impl Interface }
 
 func (h deleteworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -1727,6 +2214,43 @@ func (h deleteworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thr
 
 }
 
+type describebatchterminate_NoWireHandler struct{ impl Interface }
+
+func (h describebatchterminate_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DescribeBatchTerminate_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeBatchTerminate': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeBatchTerminate(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeBatchTerminate_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type describecluster_NoWireHandler struct{ impl Interface }
 
 func (h describecluster_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -1912,6 +2436,43 @@ func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Contex
 
 }
 
+type expireactivityheartbeat_NoWireHandler struct{ impl Interface }
+
+func (h expireactivityheartbeat_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_ExpireActivityHeartbeat_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ExpireActivityHeartbeat': %w", err)
+	}
+
+	appErr := h.impl.ExpireActivityHeartbeat(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ExpireActivityHeartbeat_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type getcrossclustertasks_NoWireHandler struct{ impl Interface }
 
 func (h getcrossclustertasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2467,6 +3028,43 @@ func (h reapplyevents_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thri
 
 }
 
+type redrivetimedoutactivities_NoWireHandler struct{ impl Interface }
+
+func (h redrivetimedoutactivities_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_RedriveTimedOutActivities_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'RedriveTimedOutActivities': %w", err)
+	}
+
+	appErr := h.impl.RedriveTimedOutActivities(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RedriveTimedOutActivities_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type refreshworkflowtasks_NoWireHandler struct{ impl Interface }
 
 func (h refreshworkflowtasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2541,6 +3139,80 @@ func (h removetask_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.
 
 }
 
+type removetasklisttask_NoWireHandler struct{ impl Interface }
+
+func (h removetasklisttask_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_RemoveTaskListTask_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'RemoveTaskListTask': %w", err)
+	}
+
+	success, appErr := h.impl.RemoveTaskListTask(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RemoveTaskListTask_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type replayactivitycompletions_NoWireHandler struct{ impl Interface }
+
+func (h replayactivitycompletions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_ReplayActivityCompletions_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ReplayActivityCompletions': %w", err)
+	}
+
+	success, appErr := h.impl.ReplayActivityCompletions(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ReplayActivityCompletions_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type resendreplicationtasks_NoWireHandler struct{ impl Interface }
 
 func (h resendreplicationtasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
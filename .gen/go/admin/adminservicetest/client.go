@@ -99,6 +99,70 @@ func (mr *_MockClientRecorder) AddSearchAttribute(
 	return mr.mock.ctrl.RecordCall(mr.mock, "AddSearchAttribute", args...)
 }
 
+// BatchTerminate responds to a BatchTerminate call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().BatchTerminate(gomock.Any(), ...).Return(...)
+//	... := client.BatchTerminate(...)
+func (m *MockClient) BatchTerminate(
+	ctx context.Context,
+	_Request *admin.BatchTerminateRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.BatchTerminateResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "BatchTerminate", args...)
+	success, _ = ret[i].(*admin.BatchTerminateResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) BatchTerminate(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "BatchTerminate", args...)
+}
+
+// CancelPendingActivities responds to a CancelPendingActivities call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().CancelPendingActivities(gomock.Any(), ...).Return(...)
+//	... := client.CancelPendingActivities(...)
+func (m *MockClient) CancelPendingActivities(
+	ctx context.Context,
+	_Request *shared.CancelPendingActivitiesRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "CancelPendingActivities", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) CancelPendingActivities(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "CancelPendingActivities", args...)
+}
+
 // CloseShard responds to a CloseShard call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -130,6 +194,39 @@ func (mr *_MockClientRecorder) CloseShard(
 	return mr.mock.ctrl.RecordCall(mr.mock, "CloseShard", args...)
 }
 
+// DecodeTaskToken responds to a DecodeTaskToken call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DecodeTaskToken(gomock.Any(), ...).Return(...)
+//	... := client.DecodeTaskToken(...)
+func (m *MockClient) DecodeTaskToken(
+	ctx context.Context,
+	_Request *admin.DecodeTaskTokenRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DecodeTaskTokenResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DecodeTaskToken", args...)
+	success, _ = ret[i].(*admin.DecodeTaskTokenResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DecodeTaskToken(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DecodeTaskToken", args...)
+}
+
 // DeleteWorkflow responds to a DeleteWorkflow call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -163,6 +260,39 @@ func (mr *_MockClientRecorder) DeleteWorkflow(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DeleteWorkflow", args...)
 }
 
+// DescribeBatchTerminate responds to a DescribeBatchTerminate call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DescribeBatchTerminate(gomock.Any(), ...).Return(...)
+//	... := client.DescribeBatchTerminate(...)
+func (m *MockClient) DescribeBatchTerminate(
+	ctx context.Context,
+	_Request *admin.DescribeBatchTerminateRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeBatchTerminateResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeBatchTerminate", args...)
+	success, _ = ret[i].(*admin.DescribeBatchTerminateResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeBatchTerminate(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeBatchTerminate", args...)
+}
+
 // DescribeCluster responds to a DescribeCluster call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -326,6 +456,37 @@ func (mr *_MockClientRecorder) DescribeWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeWorkflowExecution", args...)
 }
 
+// ExpireActivityHeartbeat responds to a ExpireActivityHeartbeat call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ExpireActivityHeartbeat(gomock.Any(), ...).Return(...)
+//	... := client.ExpireActivityHeartbeat(...)
+func (m *MockClient) ExpireActivityHeartbeat(
+	ctx context.Context,
+	_Request *shared.ExpireActivityHeartbeatRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ExpireActivityHeartbeat", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ExpireActivityHeartbeat(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ExpireActivityHeartbeat", args...)
+}
+
 // GetCrossClusterTasks responds to a GetCrossClusterTasks call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -817,6 +978,37 @@ func (mr *_MockClientRecorder) ReapplyEvents(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ReapplyEvents", args...)
 }
 
+// RedriveTimedOutActivities responds to a RedriveTimedOutActivities call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RedriveTimedOutActivities(gomock.Any(), ...).Return(...)
+//	... := client.RedriveTimedOutActivities(...)
+func (m *MockClient) RedriveTimedOutActivities(
+	ctx context.Context,
+	_Request *shared.RedriveTimedOutActivitiesRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RedriveTimedOutActivities", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RedriveTimedOutActivities(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RedriveTimedOutActivities", args...)
+}
+
 // RefreshWorkflowTasks responds to a RefreshWorkflowTasks call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -879,6 +1071,72 @@ func (mr *_MockClientRecorder) RemoveTask(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RemoveTask", args...)
 }
 
+// RemoveTaskListTask responds to a RemoveTaskListTask call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RemoveTaskListTask(gomock.Any(), ...).Return(...)
+//	... := client.RemoveTaskListTask(...)
+func (m *MockClient) RemoveTaskListTask(
+	ctx context.Context,
+	_Request *admin.RemoveTaskListTaskRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.RemoveTaskListTaskResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RemoveTaskListTask", args...)
+	success, _ = ret[i].(*admin.RemoveTaskListTaskResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RemoveTaskListTask(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RemoveTaskListTask", args...)
+}
+
+// ReplayActivityCompletions responds to a ReplayActivityCompletions call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ReplayActivityCompletions(gomock.Any(), ...).Return(...)
+//	... := client.ReplayActivityCompletions(...)
+func (m *MockClient) ReplayActivityCompletions(
+	ctx context.Context,
+	_Request *shared.ReplayActivityCompletionsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ReplayActivityCompletionsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReplayActivityCompletions", args...)
+	success, _ = ret[i].(*shared.ReplayActivityCompletionsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReplayActivityCompletions(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReplayActivityCompletions", args...)
+}
+
 // ResendReplicationTasks responds to a ResendReplicationTasks call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
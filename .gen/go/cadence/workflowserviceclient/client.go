@@ -40,6 +40,12 @@ import (
 
 // Interface is a client for the WorkflowService service.
 type Interface interface {
+	BatchDescribeWorkflowExecutions(
+		ctx context.Context,
+		DescribeRequest *shared.BatchDescribeWorkflowExecutionsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.BatchDescribeWorkflowExecutionsResponse, error)
+
 	CountWorkflowExecutions(
 		ctx context.Context,
 		CountRequest *shared.CountWorkflowExecutionsRequest,
@@ -76,6 +82,48 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DiagnoseWorkflowExecutionResponse, error)
 
+	GetActivityAttemptTimings(
+		ctx context.Context,
+		Request *shared.GetActivityAttemptTimingsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetActivityAttemptTimingsResponse, error)
+
+	GetActivityEffectiveConfig(
+		ctx context.Context,
+		Request *shared.GetActivityEffectiveConfigRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetActivityEffectiveConfigResponse, error)
+
+	GetActivityNextRetryTime(
+		ctx context.Context,
+		Request *shared.GetActivityNextRetryTimeRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetActivityNextRetryTimeResponse, error)
+
+	GetActivityRescheduleReasons(
+		ctx context.Context,
+		Request *shared.GetActivityRescheduleReasonsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetActivityRescheduleReasonsResponse, error)
+
+	GetActivityRetryStats(
+		ctx context.Context,
+		Request *shared.GetActivityRetryStatsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetActivityRetryStatsResponse, error)
+
+	GetActivityTokenValidationFailures(
+		ctx context.Context,
+		Request *shared.GetActivityTokenValidationFailuresRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetActivityTokenValidationFailuresResponse, error)
+
+	GetActivityWorkerHistory(
+		ctx context.Context,
+		Request *shared.GetActivityWorkerHistoryRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetActivityWorkerHistoryResponse, error)
+
 	GetClusterInfo(
 		ctx context.Context,
 		opts ...yarpc.CallOption,
@@ -98,6 +146,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.GetWorkflowExecutionHistoryResponse, error)
 
+	ListActivityTypes(
+		ctx context.Context,
+		Request *shared.ListActivityTypesRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListActivityTypesResponse, error)
+
 	ListArchivedWorkflowExecutions(
 		ctx context.Context,
 		ListRequest *shared.ListArchivedWorkflowExecutionsRequest,
@@ -122,6 +176,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.ListOpenWorkflowExecutionsResponse, error)
 
+	ListPendingActivityScheduleAttributes(
+		ctx context.Context,
+		Request *shared.ListPendingActivityScheduleAttributesRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListPendingActivityScheduleAttributesResponse, error)
+
 	ListTaskListPartitions(
 		ctx context.Context,
 		Request *shared.ListTaskListPartitionsRequest,
@@ -176,12 +236,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RenewActivityLease(
+		ctx context.Context,
+		Request *shared.RenewActivityLeaseRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.RenewActivityLeaseResponse, error)
+
 	RequestCancelWorkflowExecution(
 		ctx context.Context,
 		CancelRequest *shared.RequestCancelWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	ResetActivityAttempts(
+		ctx context.Context,
+		Request *shared.ResetActivityAttemptsRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ResetStickyTaskList(
 		ctx context.Context,
 		ResetRequest *shared.ResetStickyTaskListRequest,
@@ -230,6 +302,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RespondActivityTaskNack(
+		ctx context.Context,
+		Request *shared.RespondActivityTaskNackRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RespondDecisionTaskCompleted(
 		ctx context.Context,
 		CompleteRequest *shared.RespondDecisionTaskCompletedRequest,
@@ -260,6 +338,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.ListWorkflowExecutionsResponse, error)
 
+	SignalActivity(
+		ctx context.Context,
+		SignalRequest *shared.SignalActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	SignalWithStartWorkflowExecution(
 		ctx context.Context,
 		SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest,
@@ -301,6 +385,12 @@ type Interface interface {
 		UpdateRequest *shared.UpdateDomainRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.UpdateDomainResponse, error)
+
+	WaitForActivityCompletion(
+		ctx context.Context,
+		Request *shared.WaitForActivityCompletionRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.WaitForActivityCompletionResponse, error)
 }
 
 // New builds a new client for the WorkflowService service.
@@ -332,6 +422,34 @@ type client struct {
 	nwc thrift.NoWireClient
 }
 
+func (c client) BatchDescribeWorkflowExecutions(
+	ctx context.Context,
+	_DescribeRequest *shared.BatchDescribeWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.BatchDescribeWorkflowExecutionsResponse, err error) {
+
+	var result cadence.WorkflowService_BatchDescribeWorkflowExecutions_Result
+	args := cadence.WorkflowService_BatchDescribeWorkflowExecutions_Helper.Args(_DescribeRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_BatchDescribeWorkflowExecutions_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) CountWorkflowExecutions(
 	ctx context.Context,
 	_CountRequest *shared.CountWorkflowExecutionsRequest,
@@ -500,6 +618,202 @@ func (c client) DiagnoseWorkflowExecution(
 	return
 }
 
+func (c client) GetActivityAttemptTimings(
+	ctx context.Context,
+	_Request *shared.GetActivityAttemptTimingsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityAttemptTimingsResponse, err error) {
+
+	var result cadence.WorkflowService_GetActivityAttemptTimings_Result
+	args := cadence.WorkflowService_GetActivityAttemptTimings_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_GetActivityAttemptTimings_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetActivityEffectiveConfig(
+	ctx context.Context,
+	_Request *shared.GetActivityEffectiveConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityEffectiveConfigResponse, err error) {
+
+	var result cadence.WorkflowService_GetActivityEffectiveConfig_Result
+	args := cadence.WorkflowService_GetActivityEffectiveConfig_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_GetActivityEffectiveConfig_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetActivityNextRetryTime(
+	ctx context.Context,
+	_Request *shared.GetActivityNextRetryTimeRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityNextRetryTimeResponse, err error) {
+
+	var result cadence.WorkflowService_GetActivityNextRetryTime_Result
+	args := cadence.WorkflowService_GetActivityNextRetryTime_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_GetActivityNextRetryTime_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetActivityRescheduleReasons(
+	ctx context.Context,
+	_Request *shared.GetActivityRescheduleReasonsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityRescheduleReasonsResponse, err error) {
+
+	var result cadence.WorkflowService_GetActivityRescheduleReasons_Result
+	args := cadence.WorkflowService_GetActivityRescheduleReasons_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_GetActivityRescheduleReasons_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetActivityRetryStats(
+	ctx context.Context,
+	_Request *shared.GetActivityRetryStatsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityRetryStatsResponse, err error) {
+
+	var result cadence.WorkflowService_GetActivityRetryStats_Result
+	args := cadence.WorkflowService_GetActivityRetryStats_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_GetActivityRetryStats_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetActivityTokenValidationFailures(
+	ctx context.Context,
+	_Request *shared.GetActivityTokenValidationFailuresRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityTokenValidationFailuresResponse, err error) {
+
+	var result cadence.WorkflowService_GetActivityTokenValidationFailures_Result
+	args := cadence.WorkflowService_GetActivityTokenValidationFailures_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_GetActivityTokenValidationFailures_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetActivityWorkerHistory(
+	ctx context.Context,
+	_Request *shared.GetActivityWorkerHistoryRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityWorkerHistoryResponse, err error) {
+
+	var result cadence.WorkflowService_GetActivityWorkerHistory_Result
+	args := cadence.WorkflowService_GetActivityWorkerHistory_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_GetActivityWorkerHistory_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetClusterInfo(
 	ctx context.Context,
 	opts ...yarpc.CallOption,
@@ -610,6 +924,34 @@ func (c client) GetWorkflowExecutionHistory(
 	return
 }
 
+func (c client) ListActivityTypes(
+	ctx context.Context,
+	_Request *shared.ListActivityTypesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListActivityTypesResponse, err error) {
+
+	var result cadence.WorkflowService_ListActivityTypes_Result
+	args := cadence.WorkflowService_ListActivityTypes_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_ListActivityTypes_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ListArchivedWorkflowExecutions(
 	ctx context.Context,
 	_ListRequest *shared.ListArchivedWorkflowExecutionsRequest,
@@ -722,6 +1064,34 @@ func (c client) ListOpenWorkflowExecutions(
 	return
 }
 
+func (c client) ListPendingActivityScheduleAttributes(
+	ctx context.Context,
+	_Request *shared.ListPendingActivityScheduleAttributesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListPendingActivityScheduleAttributesResponse, err error) {
+
+	var result cadence.WorkflowService_ListPendingActivityScheduleAttributes_Result
+	args := cadence.WorkflowService_ListPendingActivityScheduleAttributes_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_ListPendingActivityScheduleAttributes_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ListTaskListPartitions(
 	ctx context.Context,
 	_Request *shared.ListTaskListPartitionsRequest,
@@ -974,6 +1344,34 @@ func (c client) RegisterDomain(
 	return
 }
 
+func (c client) RenewActivityLease(
+	ctx context.Context,
+	_Request *shared.RenewActivityLeaseRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.RenewActivityLeaseResponse, err error) {
+
+	var result cadence.WorkflowService_RenewActivityLease_Result
+	args := cadence.WorkflowService_RenewActivityLease_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_RenewActivityLease_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RequestCancelWorkflowExecution(
 	ctx context.Context,
 	_CancelRequest *shared.RequestCancelWorkflowExecutionRequest,
@@ -1002,6 +1400,34 @@ func (c client) RequestCancelWorkflowExecution(
 	return
 }
 
+func (c client) ResetActivityAttempts(
+	ctx context.Context,
+	_Request *shared.ResetActivityAttemptsRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result cadence.WorkflowService_ResetActivityAttempts_Result
+	args := cadence.WorkflowService_ResetActivityAttempts_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = cadence.WorkflowService_ResetActivityAttempts_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ResetStickyTaskList(
 	ctx context.Context,
 	_ResetRequest *shared.ResetStickyTaskListRequest,
@@ -1226,6 +1652,34 @@ func (c client) RespondActivityTaskFailedByID(
 	return
 }
 
+func (c client) RespondActivityTaskNack(
+	ctx context.Context,
+	_Request *shared.RespondActivityTaskNackRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result cadence.WorkflowService_RespondActivityTaskNack_Result
+	args := cadence.WorkflowService_RespondActivityTaskNack_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = cadence.WorkflowService_RespondActivityTaskNack_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RespondDecisionTaskCompleted(
 	ctx context.Context,
 	_CompleteRequest *shared.RespondDecisionTaskCompletedRequest,
@@ -1366,6 +1820,34 @@ func (c client) ScanWorkflowExecutions(
 	return
 }
 
+func (c client) SignalActivity(
+	ctx context.Context,
+	_SignalRequest *shared.SignalActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result cadence.WorkflowService_SignalActivity_Result
+	args := cadence.WorkflowService_SignalActivity_Helper.Args(_SignalRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = cadence.WorkflowService_SignalActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	_SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest,
@@ -1561,3 +2043,31 @@ func (c client) UpdateDomain(
 	success, err = cadence.WorkflowService_UpdateDomain_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) WaitForActivityCompletion(
+	ctx context.Context,
+	_Request *shared.WaitForActivityCompletionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.WaitForActivityCompletionResponse, err error) {
+
+	var result cadence.WorkflowService_WaitForActivityCompletion_Result
+	args := cadence.WorkflowService_WaitForActivityCompletion_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_WaitForActivityCompletion_Helper.UnwrapResponse(&result)
+	return
+}
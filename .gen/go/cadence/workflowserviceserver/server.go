@@ -40,6 +40,11 @@ import (
 
 // Interface is the server-side interface for the WorkflowService service.
 type Interface interface {
+	BatchDescribeWorkflowExecutions(
+		ctx context.Context,
+		DescribeRequest *shared.BatchDescribeWorkflowExecutionsRequest,
+	) (*shared.BatchDescribeWorkflowExecutionsResponse, error)
+
 	CountWorkflowExecutions(
 		ctx context.Context,
 		CountRequest *shared.CountWorkflowExecutionsRequest,
@@ -70,6 +75,41 @@ type Interface interface {
 		DiagnoseRequest *shared.DiagnoseWorkflowExecutionRequest,
 	) (*shared.DiagnoseWorkflowExecutionResponse, error)
 
+	GetActivityAttemptTimings(
+		ctx context.Context,
+		Request *shared.GetActivityAttemptTimingsRequest,
+	) (*shared.GetActivityAttemptTimingsResponse, error)
+
+	GetActivityEffectiveConfig(
+		ctx context.Context,
+		Request *shared.GetActivityEffectiveConfigRequest,
+	) (*shared.GetActivityEffectiveConfigResponse, error)
+
+	GetActivityNextRetryTime(
+		ctx context.Context,
+		Request *shared.GetActivityNextRetryTimeRequest,
+	) (*shared.GetActivityNextRetryTimeResponse, error)
+
+	GetActivityRescheduleReasons(
+		ctx context.Context,
+		Request *shared.GetActivityRescheduleReasonsRequest,
+	) (*shared.GetActivityRescheduleReasonsResponse, error)
+
+	GetActivityRetryStats(
+		ctx context.Context,
+		Request *shared.GetActivityRetryStatsRequest,
+	) (*shared.GetActivityRetryStatsResponse, error)
+
+	GetActivityTokenValidationFailures(
+		ctx context.Context,
+		Request *shared.GetActivityTokenValidationFailuresRequest,
+	) (*shared.GetActivityTokenValidationFailuresResponse, error)
+
+	GetActivityWorkerHistory(
+		ctx context.Context,
+		Request *shared.GetActivityWorkerHistoryRequest,
+	) (*shared.GetActivityWorkerHistoryResponse, error)
+
 	GetClusterInfo(
 		ctx context.Context,
 	) (*shared.ClusterInfo, error)
@@ -88,6 +128,11 @@ type Interface interface {
 		GetRequest *shared.GetWorkflowExecutionHistoryRequest,
 	) (*shared.GetWorkflowExecutionHistoryResponse, error)
 
+	ListActivityTypes(
+		ctx context.Context,
+		Request *shared.ListActivityTypesRequest,
+	) (*shared.ListActivityTypesResponse, error)
+
 	ListArchivedWorkflowExecutions(
 		ctx context.Context,
 		ListRequest *shared.ListArchivedWorkflowExecutionsRequest,
@@ -108,6 +153,11 @@ type Interface interface {
 		ListRequest *shared.ListOpenWorkflowExecutionsRequest,
 	) (*shared.ListOpenWorkflowExecutionsResponse, error)
 
+	ListPendingActivityScheduleAttributes(
+		ctx context.Context,
+		Request *shared.ListPendingActivityScheduleAttributesRequest,
+	) (*shared.ListPendingActivityScheduleAttributesResponse, error)
+
 	ListTaskListPartitions(
 		ctx context.Context,
 		Request *shared.ListTaskListPartitionsRequest,
@@ -153,11 +203,21 @@ type Interface interface {
 		RegisterRequest *shared.RegisterDomainRequest,
 	) error
 
+	RenewActivityLease(
+		ctx context.Context,
+		Request *shared.RenewActivityLeaseRequest,
+	) (*shared.RenewActivityLeaseResponse, error)
+
 	RequestCancelWorkflowExecution(
 		ctx context.Context,
 		CancelRequest *shared.RequestCancelWorkflowExecutionRequest,
 	) error
 
+	ResetActivityAttempts(
+		ctx context.Context,
+		Request *shared.ResetActivityAttemptsRequest,
+	) error
+
 	ResetStickyTaskList(
 		ctx context.Context,
 		ResetRequest *shared.ResetStickyTaskListRequest,
@@ -198,6 +258,11 @@ type Interface interface {
 		FailRequest *shared.RespondActivityTaskFailedByIDRequest,
 	) error
 
+	RespondActivityTaskNack(
+		ctx context.Context,
+		Request *shared.RespondActivityTaskNackRequest,
+	) error
+
 	RespondDecisionTaskCompleted(
 		ctx context.Context,
 		CompleteRequest *shared.RespondDecisionTaskCompletedRequest,
@@ -223,6 +288,11 @@ type Interface interface {
 		ListRequest *shared.ListWorkflowExecutionsRequest,
 	) (*shared.ListWorkflowExecutionsResponse, error)
 
+	SignalActivity(
+		ctx context.Context,
+		SignalRequest *shared.SignalActivityRequest,
+	) error
+
 	SignalWithStartWorkflowExecution(
 		ctx context.Context,
 		SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest,
@@ -257,6 +327,11 @@ type Interface interface {
 		ctx context.Context,
 		UpdateRequest *shared.UpdateDomainRequest,
 	) (*shared.UpdateDomainResponse, error)
+
+	WaitForActivityCompletion(
+		ctx context.Context,
+		Request *shared.WaitForActivityCompletionRequest,
+	) (*shared.WaitForActivityCompletionResponse, error)
 }
 
 // New prepares an implementation of the WorkflowService service for
@@ -270,6 +345,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		Name: "WorkflowService",
 		Methods: []thrift.Method{
 
+			thrift.Method{
+				Name: "BatchDescribeWorkflowExecutions",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.BatchDescribeWorkflowExecutions),
+					NoWire: batchdescribeworkflowexecutions_NoWireHandler{impl},
+				},
+				Signature:    "BatchDescribeWorkflowExecutions(DescribeRequest *shared.BatchDescribeWorkflowExecutionsRequest) (*shared.BatchDescribeWorkflowExecutionsResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "CountWorkflowExecutions",
 				HandlerSpec: thrift.HandlerSpec{
@@ -342,6 +429,90 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetActivityAttemptTimings",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetActivityAttemptTimings),
+					NoWire: getactivityattempttimings_NoWireHandler{impl},
+				},
+				Signature:    "GetActivityAttemptTimings(Request *shared.GetActivityAttemptTimingsRequest) (*shared.GetActivityAttemptTimingsResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetActivityEffectiveConfig",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetActivityEffectiveConfig),
+					NoWire: getactivityeffectiveconfig_NoWireHandler{impl},
+				},
+				Signature:    "GetActivityEffectiveConfig(Request *shared.GetActivityEffectiveConfigRequest) (*shared.GetActivityEffectiveConfigResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetActivityNextRetryTime",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetActivityNextRetryTime),
+					NoWire: getactivitynextretrytime_NoWireHandler{impl},
+				},
+				Signature:    "GetActivityNextRetryTime(Request *shared.GetActivityNextRetryTimeRequest) (*shared.GetActivityNextRetryTimeResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetActivityRescheduleReasons",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetActivityRescheduleReasons),
+					NoWire: getactivityreschedulereasons_NoWireHandler{impl},
+				},
+				Signature:    "GetActivityRescheduleReasons(Request *shared.GetActivityRescheduleReasonsRequest) (*shared.GetActivityRescheduleReasonsResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetActivityRetryStats",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetActivityRetryStats),
+					NoWire: getactivityretrystats_NoWireHandler{impl},
+				},
+				Signature:    "GetActivityRetryStats(Request *shared.GetActivityRetryStatsRequest) (*shared.GetActivityRetryStatsResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetActivityTokenValidationFailures",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetActivityTokenValidationFailures),
+					NoWire: getactivitytokenvalidationfailures_NoWireHandler{impl},
+				},
+				Signature:    "GetActivityTokenValidationFailures(Request *shared.GetActivityTokenValidationFailuresRequest) (*shared.GetActivityTokenValidationFailuresResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetActivityWorkerHistory",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetActivityWorkerHistory),
+					NoWire: getactivityworkerhistory_NoWireHandler{impl},
+				},
+				Signature:    "GetActivityWorkerHistory(Request *shared.GetActivityWorkerHistoryRequest) (*shared.GetActivityWorkerHistoryResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetClusterInfo",
 				HandlerSpec: thrift.HandlerSpec{
@@ -390,6 +561,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListActivityTypes",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ListActivityTypes),
+					NoWire: listactivitytypes_NoWireHandler{impl},
+				},
+				Signature:    "ListActivityTypes(Request *shared.ListActivityTypesRequest) (*shared.ListActivityTypesResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ListArchivedWorkflowExecutions",
 				HandlerSpec: thrift.HandlerSpec{
@@ -438,6 +621,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListPendingActivityScheduleAttributes",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ListPendingActivityScheduleAttributes),
+					NoWire: listpendingactivityscheduleattributes_NoWireHandler{impl},
+				},
+				Signature:    "ListPendingActivityScheduleAttributes(Request *shared.ListPendingActivityScheduleAttributesRequest) (*shared.ListPendingActivityScheduleAttributesResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ListTaskListPartitions",
 				HandlerSpec: thrift.HandlerSpec{
@@ -546,6 +741,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RenewActivityLease",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RenewActivityLease),
+					NoWire: renewactivitylease_NoWireHandler{impl},
+				},
+				Signature:    "RenewActivityLease(Request *shared.RenewActivityLeaseRequest) (*shared.RenewActivityLeaseResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RequestCancelWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -558,6 +765,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ResetActivityAttempts",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ResetActivityAttempts),
+					NoWire: resetactivityattempts_NoWireHandler{impl},
+				},
+				Signature:    "ResetActivityAttempts(Request *shared.ResetActivityAttemptsRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ResetStickyTaskList",
 				HandlerSpec: thrift.HandlerSpec{
@@ -654,6 +873,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RespondActivityTaskNack",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RespondActivityTaskNack),
+					NoWire: respondactivitytasknack_NoWireHandler{impl},
+				},
+				Signature:    "RespondActivityTaskNack(Request *shared.RespondActivityTaskNackRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RespondDecisionTaskCompleted",
 				HandlerSpec: thrift.HandlerSpec{
@@ -714,6 +945,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "SignalActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.SignalActivity),
+					NoWire: signalactivity_NoWireHandler{impl},
+				},
+				Signature:    "SignalActivity(SignalRequest *shared.SignalActivityRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "SignalWithStartWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -797,10 +1040,22 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "UpdateDomain(UpdateRequest *shared.UpdateDomainRequest) (*shared.UpdateDomainResponse)",
 				ThriftModule: cadence.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "WaitForActivityCompletion",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.WaitForActivityCompletion),
+					NoWire: waitforactivitycompletion_NoWireHandler{impl},
+				},
+				Signature:    "WaitForActivityCompletion(Request *shared.WaitForActivityCompletionRequest) (*shared.WaitForActivityCompletionResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 44)
+	procedures := make([]transport.Procedure, 0, 59)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -811,6 +1066,36 @@ type yarpcErrorNamer interface{ YARPCErrorName() string }
 
 type yarpcErrorCoder interface{ YARPCErrorCode() *yarpcerrors.Code }
 
+func (h handler) BatchDescribeWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_BatchDescribeWorkflowExecutions_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'BatchDescribeWorkflowExecutions': %w", err)
+	}
+
+	success, appErr := h.impl.BatchDescribeWorkflowExecutions(ctx, args.DescribeRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_BatchDescribeWorkflowExecutions_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) CountWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_CountWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
@@ -991,17 +1276,17 @@ func (h handler) DiagnoseWorkflowExecution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) GetClusterInfo(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_GetClusterInfo_Args
+func (h handler) GetActivityAttemptTimings(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetActivityAttemptTimings_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'GetClusterInfo': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetActivityAttemptTimings': %w", err)
 	}
 
-	success, appErr := h.impl.GetClusterInfo(ctx)
+	success, appErr := h.impl.GetActivityAttemptTimings(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_GetClusterInfo_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetActivityAttemptTimings_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1021,17 +1306,17 @@ func (h handler) GetClusterInfo(ctx context.Context, body wire.Value) (thrift.Re
 	return response, err
 }
 
-func (h handler) GetSearchAttributes(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_GetSearchAttributes_Args
+func (h handler) GetActivityEffectiveConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetActivityEffectiveConfig_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'GetSearchAttributes': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetActivityEffectiveConfig': %w", err)
 	}
 
-	success, appErr := h.impl.GetSearchAttributes(ctx)
+	success, appErr := h.impl.GetActivityEffectiveConfig(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_GetSearchAttributes_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetActivityEffectiveConfig_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1051,17 +1336,17 @@ func (h handler) GetSearchAttributes(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
-func (h handler) GetTaskListsByDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_GetTaskListsByDomain_Args
+func (h handler) GetActivityNextRetryTime(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetActivityNextRetryTime_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'GetTaskListsByDomain': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetActivityNextRetryTime': %w", err)
 	}
 
-	success, appErr := h.impl.GetTaskListsByDomain(ctx, args.Request)
+	success, appErr := h.impl.GetActivityNextRetryTime(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_GetTaskListsByDomain_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetActivityNextRetryTime_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1081,17 +1366,17 @@ func (h handler) GetTaskListsByDomain(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
-func (h handler) GetWorkflowExecutionHistory(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_GetWorkflowExecutionHistory_Args
+func (h handler) GetActivityRescheduleReasons(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetActivityRescheduleReasons_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'GetWorkflowExecutionHistory': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetActivityRescheduleReasons': %w", err)
 	}
 
-	success, appErr := h.impl.GetWorkflowExecutionHistory(ctx, args.GetRequest)
+	success, appErr := h.impl.GetActivityRescheduleReasons(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_GetWorkflowExecutionHistory_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetActivityRescheduleReasons_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1111,17 +1396,17 @@ func (h handler) GetWorkflowExecutionHistory(ctx context.Context, body wire.Valu
 	return response, err
 }
 
-func (h handler) ListArchivedWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListArchivedWorkflowExecutions_Args
+func (h handler) GetActivityRetryStats(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetActivityRetryStats_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListArchivedWorkflowExecutions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetActivityRetryStats': %w", err)
 	}
 
-	success, appErr := h.impl.ListArchivedWorkflowExecutions(ctx, args.ListRequest)
+	success, appErr := h.impl.GetActivityRetryStats(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListArchivedWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetActivityRetryStats_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1141,17 +1426,17 @@ func (h handler) ListArchivedWorkflowExecutions(ctx context.Context, body wire.V
 	return response, err
 }
 
-func (h handler) ListClosedWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListClosedWorkflowExecutions_Args
+func (h handler) GetActivityTokenValidationFailures(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetActivityTokenValidationFailures_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListClosedWorkflowExecutions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetActivityTokenValidationFailures': %w", err)
 	}
 
-	success, appErr := h.impl.ListClosedWorkflowExecutions(ctx, args.ListRequest)
+	success, appErr := h.impl.GetActivityTokenValidationFailures(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListClosedWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetActivityTokenValidationFailures_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1171,17 +1456,17 @@ func (h handler) ListClosedWorkflowExecutions(ctx context.Context, body wire.Val
 	return response, err
 }
 
-func (h handler) ListDomains(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListDomains_Args
+func (h handler) GetActivityWorkerHistory(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetActivityWorkerHistory_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListDomains': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetActivityWorkerHistory': %w", err)
 	}
 
-	success, appErr := h.impl.ListDomains(ctx, args.ListRequest)
+	success, appErr := h.impl.GetActivityWorkerHistory(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListDomains_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetActivityWorkerHistory_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1201,17 +1486,17 @@ func (h handler) ListDomains(ctx context.Context, body wire.Value) (thrift.Respo
 	return response, err
 }
 
-func (h handler) ListOpenWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListOpenWorkflowExecutions_Args
+func (h handler) GetClusterInfo(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetClusterInfo_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListOpenWorkflowExecutions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetClusterInfo': %w", err)
 	}
 
-	success, appErr := h.impl.ListOpenWorkflowExecutions(ctx, args.ListRequest)
+	success, appErr := h.impl.GetClusterInfo(ctx)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListOpenWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetClusterInfo_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1231,17 +1516,17 @@ func (h handler) ListOpenWorkflowExecutions(ctx context.Context, body wire.Value
 	return response, err
 }
 
-func (h handler) ListTaskListPartitions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListTaskListPartitions_Args
+func (h handler) GetSearchAttributes(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetSearchAttributes_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListTaskListPartitions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetSearchAttributes': %w", err)
 	}
 
-	success, appErr := h.impl.ListTaskListPartitions(ctx, args.Request)
+	success, appErr := h.impl.GetSearchAttributes(ctx)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListTaskListPartitions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetSearchAttributes_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1261,17 +1546,17 @@ func (h handler) ListTaskListPartitions(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) ListWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListWorkflowExecutions_Args
+func (h handler) GetTaskListsByDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetTaskListsByDomain_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListWorkflowExecutions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetTaskListsByDomain': %w", err)
 	}
 
-	success, appErr := h.impl.ListWorkflowExecutions(ctx, args.ListRequest)
+	success, appErr := h.impl.GetTaskListsByDomain(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetTaskListsByDomain_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1291,17 +1576,17 @@ func (h handler) ListWorkflowExecutions(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) PollForActivityTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_PollForActivityTask_Args
+func (h handler) GetWorkflowExecutionHistory(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetWorkflowExecutionHistory_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'PollForActivityTask': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetWorkflowExecutionHistory': %w", err)
 	}
 
-	success, appErr := h.impl.PollForActivityTask(ctx, args.PollRequest)
+	success, appErr := h.impl.GetWorkflowExecutionHistory(ctx, args.GetRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_PollForActivityTask_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetWorkflowExecutionHistory_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1321,17 +1606,17 @@ func (h handler) PollForActivityTask(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
-func (h handler) PollForDecisionTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_PollForDecisionTask_Args
+func (h handler) ListActivityTypes(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListActivityTypes_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'PollForDecisionTask': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListActivityTypes': %w", err)
 	}
 
-	success, appErr := h.impl.PollForDecisionTask(ctx, args.PollRequest)
+	success, appErr := h.impl.ListActivityTypes(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_PollForDecisionTask_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListActivityTypes_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1351,17 +1636,17 @@ func (h handler) PollForDecisionTask(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
-func (h handler) QueryWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_QueryWorkflow_Args
+func (h handler) ListArchivedWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListArchivedWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'QueryWorkflow': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListArchivedWorkflowExecutions': %w", err)
 	}
 
-	success, appErr := h.impl.QueryWorkflow(ctx, args.QueryRequest)
+	success, appErr := h.impl.ListArchivedWorkflowExecutions(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_QueryWorkflow_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListArchivedWorkflowExecutions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1381,17 +1666,17 @@ func (h handler) QueryWorkflow(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
-func (h handler) RecordActivityTaskHeartbeat(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RecordActivityTaskHeartbeat_Args
+func (h handler) ListClosedWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListClosedWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RecordActivityTaskHeartbeat': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListClosedWorkflowExecutions': %w", err)
 	}
 
-	success, appErr := h.impl.RecordActivityTaskHeartbeat(ctx, args.HeartbeatRequest)
+	success, appErr := h.impl.ListClosedWorkflowExecutions(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RecordActivityTaskHeartbeat_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListClosedWorkflowExecutions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1411,17 +1696,17 @@ func (h handler) RecordActivityTaskHeartbeat(ctx context.Context, body wire.Valu
 	return response, err
 }
 
-func (h handler) RecordActivityTaskHeartbeatByID(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RecordActivityTaskHeartbeatByID_Args
+func (h handler) ListDomains(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListDomains_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RecordActivityTaskHeartbeatByID': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListDomains': %w", err)
 	}
 
-	success, appErr := h.impl.RecordActivityTaskHeartbeatByID(ctx, args.HeartbeatRequest)
+	success, appErr := h.impl.ListDomains(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RecordActivityTaskHeartbeatByID_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListDomains_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1441,17 +1726,17 @@ func (h handler) RecordActivityTaskHeartbeatByID(ctx context.Context, body wire.
 	return response, err
 }
 
-func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RefreshWorkflowTasks_Args
+func (h handler) ListOpenWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListOpenWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RefreshWorkflowTasks': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListOpenWorkflowExecutions': %w", err)
 	}
 
-	appErr := h.impl.RefreshWorkflowTasks(ctx, args.Request)
+	success, appErr := h.impl.ListOpenWorkflowExecutions(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RefreshWorkflowTasks_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_ListOpenWorkflowExecutions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1471,17 +1756,17 @@ func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
-func (h handler) RegisterDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RegisterDomain_Args
+func (h handler) ListPendingActivityScheduleAttributes(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListPendingActivityScheduleAttributes_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RegisterDomain': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListPendingActivityScheduleAttributes': %w", err)
 	}
 
-	appErr := h.impl.RegisterDomain(ctx, args.RegisterRequest)
+	success, appErr := h.impl.ListPendingActivityScheduleAttributes(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RegisterDomain_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_ListPendingActivityScheduleAttributes_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1501,17 +1786,17 @@ func (h handler) RegisterDomain(ctx context.Context, body wire.Value) (thrift.Re
 	return response, err
 }
 
-func (h handler) RequestCancelWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RequestCancelWorkflowExecution_Args
+func (h handler) ListTaskListPartitions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListTaskListPartitions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RequestCancelWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListTaskListPartitions': %w", err)
 	}
 
-	appErr := h.impl.RequestCancelWorkflowExecution(ctx, args.CancelRequest)
+	success, appErr := h.impl.ListTaskListPartitions(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RequestCancelWorkflowExecution_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_ListTaskListPartitions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1531,17 +1816,17 @@ func (h handler) RequestCancelWorkflowExecution(ctx context.Context, body wire.V
 	return response, err
 }
 
-func (h handler) ResetStickyTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ResetStickyTaskList_Args
+func (h handler) ListWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ResetStickyTaskList': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListWorkflowExecutions': %w", err)
 	}
 
-	success, appErr := h.impl.ResetStickyTaskList(ctx, args.ResetRequest)
+	success, appErr := h.impl.ListWorkflowExecutions(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ResetStickyTaskList_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListWorkflowExecutions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1561,17 +1846,17 @@ func (h handler) ResetStickyTaskList(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
-func (h handler) ResetWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ResetWorkflowExecution_Args
+func (h handler) PollForActivityTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_PollForActivityTask_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ResetWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'PollForActivityTask': %w", err)
 	}
 
-	success, appErr := h.impl.ResetWorkflowExecution(ctx, args.ResetRequest)
+	success, appErr := h.impl.PollForActivityTask(ctx, args.PollRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ResetWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_PollForActivityTask_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1591,17 +1876,17 @@ func (h handler) ResetWorkflowExecution(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) RespondActivityTaskCanceled(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RespondActivityTaskCanceled_Args
+func (h handler) PollForDecisionTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_PollForDecisionTask_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskCanceled': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'PollForDecisionTask': %w", err)
 	}
 
-	appErr := h.impl.RespondActivityTaskCanceled(ctx, args.CanceledRequest)
+	success, appErr := h.impl.PollForDecisionTask(ctx, args.PollRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RespondActivityTaskCanceled_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_PollForDecisionTask_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1621,17 +1906,17 @@ func (h handler) RespondActivityTaskCanceled(ctx context.Context, body wire.Valu
 	return response, err
 }
 
-func (h handler) RespondActivityTaskCanceledByID(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RespondActivityTaskCanceledByID_Args
+func (h handler) QueryWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_QueryWorkflow_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskCanceledByID': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'QueryWorkflow': %w", err)
 	}
 
-	appErr := h.impl.RespondActivityTaskCanceledByID(ctx, args.CanceledRequest)
+	success, appErr := h.impl.QueryWorkflow(ctx, args.QueryRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RespondActivityTaskCanceledByID_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_QueryWorkflow_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1651,17 +1936,17 @@ func (h handler) RespondActivityTaskCanceledByID(ctx context.Context, body wire.
 	return response, err
 }
 
-func (h handler) RespondActivityTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RespondActivityTaskCompleted_Args
+func (h handler) RecordActivityTaskHeartbeat(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RecordActivityTaskHeartbeat_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskCompleted': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RecordActivityTaskHeartbeat': %w", err)
 	}
 
-	appErr := h.impl.RespondActivityTaskCompleted(ctx, args.CompleteRequest)
+	success, appErr := h.impl.RecordActivityTaskHeartbeat(ctx, args.HeartbeatRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RespondActivityTaskCompleted_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_RecordActivityTaskHeartbeat_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1681,17 +1966,17 @@ func (h handler) RespondActivityTaskCompleted(ctx context.Context, body wire.Val
 	return response, err
 }
 
-func (h handler) RespondActivityTaskCompletedByID(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RespondActivityTaskCompletedByID_Args
+func (h handler) RecordActivityTaskHeartbeatByID(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RecordActivityTaskHeartbeatByID_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskCompletedByID': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RecordActivityTaskHeartbeatByID': %w", err)
 	}
 
-	appErr := h.impl.RespondActivityTaskCompletedByID(ctx, args.CompleteRequest)
+	success, appErr := h.impl.RecordActivityTaskHeartbeatByID(ctx, args.HeartbeatRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RespondActivityTaskCompletedByID_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_RecordActivityTaskHeartbeatByID_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1711,17 +1996,17 @@ func (h handler) RespondActivityTaskCompletedByID(ctx context.Context, body wire
 	return response, err
 }
 
-func (h handler) RespondActivityTaskFailed(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RespondActivityTaskFailed_Args
+func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RefreshWorkflowTasks_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskFailed': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RefreshWorkflowTasks': %w", err)
 	}
 
-	appErr := h.impl.RespondActivityTaskFailed(ctx, args.FailRequest)
+	appErr := h.impl.RefreshWorkflowTasks(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RespondActivityTaskFailed_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_RefreshWorkflowTasks_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1741,17 +2026,17 @@ func (h handler) RespondActivityTaskFailed(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) RespondActivityTaskFailedByID(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RespondActivityTaskFailedByID_Args
+func (h handler) RegisterDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RegisterDomain_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskFailedByID': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RegisterDomain': %w", err)
 	}
 
-	appErr := h.impl.RespondActivityTaskFailedByID(ctx, args.FailRequest)
+	appErr := h.impl.RegisterDomain(ctx, args.RegisterRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RespondActivityTaskFailedByID_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_RegisterDomain_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1771,17 +2056,17 @@ func (h handler) RespondActivityTaskFailedByID(ctx context.Context, body wire.Va
 	return response, err
 }
 
-func (h handler) RespondDecisionTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RespondDecisionTaskCompleted_Args
+func (h handler) RenewActivityLease(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RenewActivityLease_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondDecisionTaskCompleted': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RenewActivityLease': %w", err)
 	}
 
-	success, appErr := h.impl.RespondDecisionTaskCompleted(ctx, args.CompleteRequest)
+	success, appErr := h.impl.RenewActivityLease(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RespondDecisionTaskCompleted_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_RenewActivityLease_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1801,17 +2086,17 @@ func (h handler) RespondDecisionTaskCompleted(ctx context.Context, body wire.Val
 	return response, err
 }
 
-func (h handler) RespondDecisionTaskFailed(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RespondDecisionTaskFailed_Args
+func (h handler) RequestCancelWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RequestCancelWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondDecisionTaskFailed': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RequestCancelWorkflowExecution': %w", err)
 	}
 
-	appErr := h.impl.RespondDecisionTaskFailed(ctx, args.FailedRequest)
+	appErr := h.impl.RequestCancelWorkflowExecution(ctx, args.CancelRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RespondDecisionTaskFailed_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_RequestCancelWorkflowExecution_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1831,17 +2116,17 @@ func (h handler) RespondDecisionTaskFailed(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) RespondQueryTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RespondQueryTaskCompleted_Args
+func (h handler) ResetActivityAttempts(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ResetActivityAttempts_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondQueryTaskCompleted': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ResetActivityAttempts': %w", err)
 	}
 
-	appErr := h.impl.RespondQueryTaskCompleted(ctx, args.CompleteRequest)
+	appErr := h.impl.ResetActivityAttempts(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RespondQueryTaskCompleted_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_ResetActivityAttempts_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1861,17 +2146,17 @@ func (h handler) RespondQueryTaskCompleted(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) RestartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RestartWorkflowExecution_Args
+func (h handler) ResetStickyTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ResetStickyTaskList_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RestartWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ResetStickyTaskList': %w", err)
 	}
 
-	success, appErr := h.impl.RestartWorkflowExecution(ctx, args.RestartRequest)
+	success, appErr := h.impl.ResetStickyTaskList(ctx, args.ResetRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RestartWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ResetStickyTaskList_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1891,17 +2176,17 @@ func (h handler) RestartWorkflowExecution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) ScanWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ScanWorkflowExecutions_Args
+func (h handler) ResetWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ResetWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ScanWorkflowExecutions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ResetWorkflowExecution': %w", err)
 	}
 
-	success, appErr := h.impl.ScanWorkflowExecutions(ctx, args.ListRequest)
+	success, appErr := h.impl.ResetWorkflowExecution(ctx, args.ResetRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ScanWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ResetWorkflowExecution_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1921,17 +2206,17 @@ func (h handler) ScanWorkflowExecutions(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) SignalWithStartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_SignalWithStartWorkflowExecution_Args
+func (h handler) RespondActivityTaskCanceled(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondActivityTaskCanceled_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'SignalWithStartWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskCanceled': %w", err)
 	}
 
-	success, appErr := h.impl.SignalWithStartWorkflowExecution(ctx, args.SignalWithStartRequest)
+	appErr := h.impl.RespondActivityTaskCanceled(ctx, args.CanceledRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_SignalWithStartWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_RespondActivityTaskCanceled_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1951,17 +2236,17 @@ func (h handler) SignalWithStartWorkflowExecution(ctx context.Context, body wire
 	return response, err
 }
 
-func (h handler) SignalWithStartWorkflowExecutionAsync(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_SignalWithStartWorkflowExecutionAsync_Args
+func (h handler) RespondActivityTaskCanceledByID(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondActivityTaskCanceledByID_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'SignalWithStartWorkflowExecutionAsync': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskCanceledByID': %w", err)
 	}
 
-	success, appErr := h.impl.SignalWithStartWorkflowExecutionAsync(ctx, args.SignalWithStartRequest)
+	appErr := h.impl.RespondActivityTaskCanceledByID(ctx, args.CanceledRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_SignalWithStartWorkflowExecutionAsync_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_RespondActivityTaskCanceledByID_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1981,17 +2266,17 @@ func (h handler) SignalWithStartWorkflowExecutionAsync(ctx context.Context, body
 	return response, err
 }
 
-func (h handler) SignalWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_SignalWorkflowExecution_Args
+func (h handler) RespondActivityTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondActivityTaskCompleted_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'SignalWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskCompleted': %w", err)
 	}
 
-	appErr := h.impl.SignalWorkflowExecution(ctx, args.SignalRequest)
+	appErr := h.impl.RespondActivityTaskCompleted(ctx, args.CompleteRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_SignalWorkflowExecution_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_RespondActivityTaskCompleted_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -2011,17 +2296,17 @@ func (h handler) SignalWorkflowExecution(ctx context.Context, body wire.Value) (
 	return response, err
 }
 
-func (h handler) StartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_StartWorkflowExecution_Args
+func (h handler) RespondActivityTaskCompletedByID(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondActivityTaskCompletedByID_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'StartWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskCompletedByID': %w", err)
 	}
 
-	success, appErr := h.impl.StartWorkflowExecution(ctx, args.StartRequest)
+	appErr := h.impl.RespondActivityTaskCompletedByID(ctx, args.CompleteRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_StartWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_RespondActivityTaskCompletedByID_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -2041,17 +2326,17 @@ func (h handler) StartWorkflowExecution(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) StartWorkflowExecutionAsync(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_StartWorkflowExecutionAsync_Args
+func (h handler) RespondActivityTaskFailed(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondActivityTaskFailed_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'StartWorkflowExecutionAsync': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskFailed': %w", err)
 	}
 
-	success, appErr := h.impl.StartWorkflowExecutionAsync(ctx, args.StartRequest)
+	appErr := h.impl.RespondActivityTaskFailed(ctx, args.FailRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_StartWorkflowExecutionAsync_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_RespondActivityTaskFailed_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -2071,17 +2356,17 @@ func (h handler) StartWorkflowExecutionAsync(ctx context.Context, body wire.Valu
 	return response, err
 }
 
-func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_TerminateWorkflowExecution_Args
+func (h handler) RespondActivityTaskFailedByID(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondActivityTaskFailedByID_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'TerminateWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskFailedByID': %w", err)
 	}
 
-	appErr := h.impl.TerminateWorkflowExecution(ctx, args.TerminateRequest)
+	appErr := h.impl.RespondActivityTaskFailedByID(ctx, args.FailRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_TerminateWorkflowExecution_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_RespondActivityTaskFailedByID_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -2101,17 +2386,17 @@ func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value
 	return response, err
 }
 
-func (h handler) UpdateDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_UpdateDomain_Args
+func (h handler) RespondActivityTaskNack(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondActivityTaskNack_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'UpdateDomain': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskNack': %w", err)
 	}
 
-	success, appErr := h.impl.UpdateDomain(ctx, args.UpdateRequest)
+	appErr := h.impl.RespondActivityTaskNack(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_UpdateDomain_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_RespondActivityTaskNack_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -2127,15 +2412,731 @@ func (h handler) UpdateDomain(ctx context.Context, body wire.Value) (thrift.Resp
 			response.ApplicationErrorDetails = appErr.Error()
 		}
 	}
-
+
+	return response, err
+}
+
+func (h handler) RespondDecisionTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondDecisionTaskCompleted_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondDecisionTaskCompleted': %w", err)
+	}
+
+	success, appErr := h.impl.RespondDecisionTaskCompleted(ctx, args.CompleteRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_RespondDecisionTaskCompleted_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondDecisionTaskFailed(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondDecisionTaskFailed_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondDecisionTaskFailed': %w", err)
+	}
+
+	appErr := h.impl.RespondDecisionTaskFailed(ctx, args.FailedRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_RespondDecisionTaskFailed_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondQueryTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondQueryTaskCompleted_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondQueryTaskCompleted': %w", err)
+	}
+
+	appErr := h.impl.RespondQueryTaskCompleted(ctx, args.CompleteRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_RespondQueryTaskCompleted_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RestartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RestartWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RestartWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.RestartWorkflowExecution(ctx, args.RestartRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_RestartWorkflowExecution_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ScanWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ScanWorkflowExecutions_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ScanWorkflowExecutions': %w", err)
+	}
+
+	success, appErr := h.impl.ScanWorkflowExecutions(ctx, args.ListRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ScanWorkflowExecutions_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) SignalActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_SignalActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'SignalActivity': %w", err)
+	}
+
+	appErr := h.impl.SignalActivity(ctx, args.SignalRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_SignalActivity_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) SignalWithStartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_SignalWithStartWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'SignalWithStartWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.SignalWithStartWorkflowExecution(ctx, args.SignalWithStartRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_SignalWithStartWorkflowExecution_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) SignalWithStartWorkflowExecutionAsync(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_SignalWithStartWorkflowExecutionAsync_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'SignalWithStartWorkflowExecutionAsync': %w", err)
+	}
+
+	success, appErr := h.impl.SignalWithStartWorkflowExecutionAsync(ctx, args.SignalWithStartRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_SignalWithStartWorkflowExecutionAsync_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) SignalWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_SignalWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'SignalWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.SignalWorkflowExecution(ctx, args.SignalRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_SignalWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) StartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_StartWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'StartWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.StartWorkflowExecution(ctx, args.StartRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_StartWorkflowExecution_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) StartWorkflowExecutionAsync(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_StartWorkflowExecutionAsync_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'StartWorkflowExecutionAsync': %w", err)
+	}
+
+	success, appErr := h.impl.StartWorkflowExecutionAsync(ctx, args.StartRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_StartWorkflowExecutionAsync_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_TerminateWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'TerminateWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.TerminateWorkflowExecution(ctx, args.TerminateRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_TerminateWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UpdateDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UpdateDomain_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'UpdateDomain': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateDomain(ctx, args.UpdateRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UpdateDomain_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) WaitForActivityCompletion(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_WaitForActivityCompletion_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'WaitForActivityCompletion': %w", err)
+	}
+
+	success, appErr := h.impl.WaitForActivityCompletion(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_WaitForActivityCompletion_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+type batchdescribeworkflowexecutions_NoWireHandler struct{ impl Interface }
+
+func (h batchdescribeworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_BatchDescribeWorkflowExecutions_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'BatchDescribeWorkflowExecutions': %w", err)
+	}
+
+	success, appErr := h.impl.BatchDescribeWorkflowExecutions(ctx, args.DescribeRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_BatchDescribeWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type countworkflowexecutions_NoWireHandler struct{ impl Interface }
+
+func (h countworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_CountWorkflowExecutions_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'CountWorkflowExecutions': %w", err)
+	}
+
+	success, appErr := h.impl.CountWorkflowExecutions(ctx, args.CountRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_CountWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type deprecatedomain_NoWireHandler struct{ impl Interface }
+
+func (h deprecatedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_DeprecateDomain_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DeprecateDomain': %w", err)
+	}
+
+	appErr := h.impl.DeprecateDomain(ctx, args.DeprecateRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_DeprecateDomain_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describedomain_NoWireHandler struct{ impl Interface }
+
+func (h describedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_DescribeDomain_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DescribeDomain': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeDomain(ctx, args.DescribeRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_DescribeDomain_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describetasklist_NoWireHandler struct{ impl Interface }
+
+func (h describetasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_DescribeTaskList_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DescribeTaskList': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeTaskList(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_DescribeTaskList_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describeworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_DescribeWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DescribeWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeWorkflowExecution(ctx, args.DescribeRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_DescribeWorkflowExecution_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type diagnoseworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h diagnoseworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_DiagnoseWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DiagnoseWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.DiagnoseWorkflowExecution(ctx, args.DiagnoseRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_DiagnoseWorkflowExecution_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type getactivityattempttimings_NoWireHandler struct{ impl Interface }
+
+func (h getactivityattempttimings_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_GetActivityAttemptTimings_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'GetActivityAttemptTimings': %w", err)
+	}
+
+	success, appErr := h.impl.GetActivityAttemptTimings(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_GetActivityAttemptTimings_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
 	return response, err
+
 }
 
-type countworkflowexecutions_NoWireHandler struct{ impl Interface }
+type getactivityeffectiveconfig_NoWireHandler struct{ impl Interface }
 
-func (h countworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getactivityeffectiveconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_CountWorkflowExecutions_Args
+		args cadence.WorkflowService_GetActivityEffectiveConfig_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2143,13 +3144,13 @@ func (h countworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context,
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'CountWorkflowExecutions': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'GetActivityEffectiveConfig': %w", err)
 	}
 
-	success, appErr := h.impl.CountWorkflowExecutions(ctx, args.CountRequest)
+	success, appErr := h.impl.GetActivityEffectiveConfig(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_CountWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetActivityEffectiveConfig_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2168,11 +3169,11 @@ func (h countworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context,
 
 }
 
-type deprecatedomain_NoWireHandler struct{ impl Interface }
+type getactivitynextretrytime_NoWireHandler struct{ impl Interface }
 
-func (h deprecatedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getactivitynextretrytime_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_DeprecateDomain_Args
+		args cadence.WorkflowService_GetActivityNextRetryTime_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2180,13 +3181,13 @@ func (h deprecatedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *th
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DeprecateDomain': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'GetActivityNextRetryTime': %w", err)
 	}
 
-	appErr := h.impl.DeprecateDomain(ctx, args.DeprecateRequest)
+	success, appErr := h.impl.GetActivityNextRetryTime(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_DeprecateDomain_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_GetActivityNextRetryTime_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2205,11 +3206,11 @@ func (h deprecatedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *th
 
 }
 
-type describedomain_NoWireHandler struct{ impl Interface }
+type getactivityreschedulereasons_NoWireHandler struct{ impl Interface }
 
-func (h describedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getactivityreschedulereasons_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_DescribeDomain_Args
+		args cadence.WorkflowService_GetActivityRescheduleReasons_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2217,13 +3218,13 @@ func (h describedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thr
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DescribeDomain': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'GetActivityRescheduleReasons': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeDomain(ctx, args.DescribeRequest)
+	success, appErr := h.impl.GetActivityRescheduleReasons(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_DescribeDomain_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetActivityRescheduleReasons_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2242,11 +3243,11 @@ func (h describedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thr
 
 }
 
-type describetasklist_NoWireHandler struct{ impl Interface }
+type getactivityretrystats_NoWireHandler struct{ impl Interface }
 
-func (h describetasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getactivityretrystats_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_DescribeTaskList_Args
+		args cadence.WorkflowService_GetActivityRetryStats_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2254,13 +3255,13 @@ func (h describetasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DescribeTaskList': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'GetActivityRetryStats': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeTaskList(ctx, args.Request)
+	success, appErr := h.impl.GetActivityRetryStats(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_DescribeTaskList_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetActivityRetryStats_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2279,11 +3280,11 @@ func (h describetasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 
 }
 
-type describeworkflowexecution_NoWireHandler struct{ impl Interface }
+type getactivitytokenvalidationfailures_NoWireHandler struct{ impl Interface }
 
-func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getactivitytokenvalidationfailures_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_DescribeWorkflowExecution_Args
+		args cadence.WorkflowService_GetActivityTokenValidationFailures_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2291,13 +3292,13 @@ func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Contex
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DescribeWorkflowExecution': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'GetActivityTokenValidationFailures': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeWorkflowExecution(ctx, args.DescribeRequest)
+	success, appErr := h.impl.GetActivityTokenValidationFailures(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_DescribeWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetActivityTokenValidationFailures_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2316,11 +3317,11 @@ func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Contex
 
 }
 
-type diagnoseworkflowexecution_NoWireHandler struct{ impl Interface }
+type getactivityworkerhistory_NoWireHandler struct{ impl Interface }
 
-func (h diagnoseworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getactivityworkerhistory_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_DiagnoseWorkflowExecution_Args
+		args cadence.WorkflowService_GetActivityWorkerHistory_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2328,13 +3329,13 @@ func (h diagnoseworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Contex
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DiagnoseWorkflowExecution': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'GetActivityWorkerHistory': %w", err)
 	}
 
-	success, appErr := h.impl.DiagnoseWorkflowExecution(ctx, args.DiagnoseRequest)
+	success, appErr := h.impl.GetActivityWorkerHistory(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_DiagnoseWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetActivityWorkerHistory_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2501,6 +3502,43 @@ func (h getworkflowexecutionhistory_NoWireHandler) HandleNoWire(ctx context.Cont
 
 }
 
+type listactivitytypes_NoWireHandler struct{ impl Interface }
+
+func (h listactivitytypes_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_ListActivityTypes_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListActivityTypes': %w", err)
+	}
+
+	success, appErr := h.impl.ListActivityTypes(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ListActivityTypes_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type listarchivedworkflowexecutions_NoWireHandler struct{ impl Interface }
 
 func (h listarchivedworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2649,6 +3687,43 @@ func (h listopenworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Conte
 
 }
 
+type listpendingactivityscheduleattributes_NoWireHandler struct{ impl Interface }
+
+func (h listpendingactivityscheduleattributes_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_ListPendingActivityScheduleAttributes_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListPendingActivityScheduleAttributes': %w", err)
+	}
+
+	success, appErr := h.impl.ListPendingActivityScheduleAttributes(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ListPendingActivityScheduleAttributes_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type listtasklistpartitions_NoWireHandler struct{ impl Interface }
 
 func (h listtasklistpartitions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2982,6 +4057,43 @@ func (h registerdomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thr
 
 }
 
+type renewactivitylease_NoWireHandler struct{ impl Interface }
+
+func (h renewactivitylease_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_RenewActivityLease_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'RenewActivityLease': %w", err)
+	}
+
+	success, appErr := h.impl.RenewActivityLease(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_RenewActivityLease_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type requestcancelworkflowexecution_NoWireHandler struct{ impl Interface }
 
 func (h requestcancelworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3019,6 +4131,43 @@ func (h requestcancelworkflowexecution_NoWireHandler) HandleNoWire(ctx context.C
 
 }
 
+type resetactivityattempts_NoWireHandler struct{ impl Interface }
+
+func (h resetactivityattempts_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_ResetActivityAttempts_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ResetActivityAttempts': %w", err)
+	}
+
+	appErr := h.impl.ResetActivityAttempts(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ResetActivityAttempts_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type resetstickytasklist_NoWireHandler struct{ impl Interface }
 
 func (h resetstickytasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3315,6 +4464,43 @@ func (h respondactivitytaskfailedbyid_NoWireHandler) HandleNoWire(ctx context.Co
 
 }
 
+type respondactivitytasknack_NoWireHandler struct{ impl Interface }
+
+func (h respondactivitytasknack_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_RespondActivityTaskNack_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskNack': %w", err)
+	}
+
+	appErr := h.impl.RespondActivityTaskNack(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_RespondActivityTaskNack_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type responddecisiontaskcompleted_NoWireHandler struct{ impl Interface }
 
 func (h responddecisiontaskcompleted_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3500,6 +4686,43 @@ func (h scanworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context,
 
 }
 
+type signalactivity_NoWireHandler struct{ impl Interface }
+
+func (h signalactivity_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_SignalActivity_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'SignalActivity': %w", err)
+	}
+
+	appErr := h.impl.SignalActivity(ctx, args.SignalRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_SignalActivity_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type signalwithstartworkflowexecution_NoWireHandler struct{ impl Interface }
 
 func (h signalwithstartworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3758,3 +4981,40 @@ func (h updatedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrif
 	return response, err
 
 }
+
+type waitforactivitycompletion_NoWireHandler struct{ impl Interface }
+
+func (h waitforactivitycompletion_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_WaitForActivityCompletion_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'WaitForActivityCompletion': %w", err)
+	}
+
+	success, appErr := h.impl.WaitForActivityCompletion(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_WaitForActivityCompletion_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
@@ -66,6 +66,39 @@ func (m *MockClient) EXPECT() *_MockClientRecorder {
 	return m.recorder
 }
 
+// BatchDescribeWorkflowExecutions responds to a BatchDescribeWorkflowExecutions call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().BatchDescribeWorkflowExecutions(gomock.Any(), ...).Return(...)
+//	... := client.BatchDescribeWorkflowExecutions(...)
+func (m *MockClient) BatchDescribeWorkflowExecutions(
+	ctx context.Context,
+	_DescribeRequest *shared.BatchDescribeWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.BatchDescribeWorkflowExecutionsResponse, err error) {
+
+	args := []interface{}{ctx, _DescribeRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "BatchDescribeWorkflowExecutions", args...)
+	success, _ = ret[i].(*shared.BatchDescribeWorkflowExecutionsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) BatchDescribeWorkflowExecutions(
+	ctx interface{},
+	_DescribeRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _DescribeRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "BatchDescribeWorkflowExecutions", args...)
+}
+
 // CountWorkflowExecutions responds to a CountWorkflowExecutions call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -262,6 +295,237 @@ func (mr *_MockClientRecorder) DiagnoseWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DiagnoseWorkflowExecution", args...)
 }
 
+// GetActivityAttemptTimings responds to a GetActivityAttemptTimings call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetActivityAttemptTimings(gomock.Any(), ...).Return(...)
+//	... := client.GetActivityAttemptTimings(...)
+func (m *MockClient) GetActivityAttemptTimings(
+	ctx context.Context,
+	_Request *shared.GetActivityAttemptTimingsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityAttemptTimingsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetActivityAttemptTimings", args...)
+	success, _ = ret[i].(*shared.GetActivityAttemptTimingsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetActivityAttemptTimings(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetActivityAttemptTimings", args...)
+}
+
+// GetActivityEffectiveConfig responds to a GetActivityEffectiveConfig call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetActivityEffectiveConfig(gomock.Any(), ...).Return(...)
+//	... := client.GetActivityEffectiveConfig(...)
+func (m *MockClient) GetActivityEffectiveConfig(
+	ctx context.Context,
+	_Request *shared.GetActivityEffectiveConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityEffectiveConfigResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetActivityEffectiveConfig", args...)
+	success, _ = ret[i].(*shared.GetActivityEffectiveConfigResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetActivityEffectiveConfig(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetActivityEffectiveConfig", args...)
+}
+
+// GetActivityNextRetryTime responds to a GetActivityNextRetryTime call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetActivityNextRetryTime(gomock.Any(), ...).Return(...)
+//	... := client.GetActivityNextRetryTime(...)
+func (m *MockClient) GetActivityNextRetryTime(
+	ctx context.Context,
+	_Request *shared.GetActivityNextRetryTimeRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityNextRetryTimeResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetActivityNextRetryTime", args...)
+	success, _ = ret[i].(*shared.GetActivityNextRetryTimeResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetActivityNextRetryTime(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetActivityNextRetryTime", args...)
+}
+
+// GetActivityRescheduleReasons responds to a GetActivityRescheduleReasons call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetActivityRescheduleReasons(gomock.Any(), ...).Return(...)
+//	... := client.GetActivityRescheduleReasons(...)
+func (m *MockClient) GetActivityRescheduleReasons(
+	ctx context.Context,
+	_Request *shared.GetActivityRescheduleReasonsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityRescheduleReasonsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetActivityRescheduleReasons", args...)
+	success, _ = ret[i].(*shared.GetActivityRescheduleReasonsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetActivityRescheduleReasons(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetActivityRescheduleReasons", args...)
+}
+
+// GetActivityRetryStats responds to a GetActivityRetryStats call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetActivityRetryStats(gomock.Any(), ...).Return(...)
+//	... := client.GetActivityRetryStats(...)
+func (m *MockClient) GetActivityRetryStats(
+	ctx context.Context,
+	_Request *shared.GetActivityRetryStatsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityRetryStatsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetActivityRetryStats", args...)
+	success, _ = ret[i].(*shared.GetActivityRetryStatsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetActivityRetryStats(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetActivityRetryStats", args...)
+}
+
+// GetActivityTokenValidationFailures responds to a GetActivityTokenValidationFailures call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetActivityTokenValidationFailures(gomock.Any(), ...).Return(...)
+//	... := client.GetActivityTokenValidationFailures(...)
+func (m *MockClient) GetActivityTokenValidationFailures(
+	ctx context.Context,
+	_Request *shared.GetActivityTokenValidationFailuresRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityTokenValidationFailuresResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetActivityTokenValidationFailures", args...)
+	success, _ = ret[i].(*shared.GetActivityTokenValidationFailuresResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetActivityTokenValidationFailures(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetActivityTokenValidationFailures", args...)
+}
+
+// GetActivityWorkerHistory responds to a GetActivityWorkerHistory call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetActivityWorkerHistory(gomock.Any(), ...).Return(...)
+//	... := client.GetActivityWorkerHistory(...)
+func (m *MockClient) GetActivityWorkerHistory(
+	ctx context.Context,
+	_Request *shared.GetActivityWorkerHistoryRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityWorkerHistoryResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetActivityWorkerHistory", args...)
+	success, _ = ret[i].(*shared.GetActivityWorkerHistoryResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetActivityWorkerHistory(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetActivityWorkerHistory", args...)
+}
+
 // GetClusterInfo responds to a GetClusterInfo call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -390,6 +654,39 @@ func (mr *_MockClientRecorder) GetWorkflowExecutionHistory(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetWorkflowExecutionHistory", args...)
 }
 
+// ListActivityTypes responds to a ListActivityTypes call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ListActivityTypes(gomock.Any(), ...).Return(...)
+//	... := client.ListActivityTypes(...)
+func (m *MockClient) ListActivityTypes(
+	ctx context.Context,
+	_Request *shared.ListActivityTypesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListActivityTypesResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListActivityTypes", args...)
+	success, _ = ret[i].(*shared.ListActivityTypesResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListActivityTypes(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListActivityTypes", args...)
+}
+
 // ListArchivedWorkflowExecutions responds to a ListArchivedWorkflowExecutions call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -522,6 +819,39 @@ func (mr *_MockClientRecorder) ListOpenWorkflowExecutions(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ListOpenWorkflowExecutions", args...)
 }
 
+// ListPendingActivityScheduleAttributes responds to a ListPendingActivityScheduleAttributes call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ListPendingActivityScheduleAttributes(gomock.Any(), ...).Return(...)
+//	... := client.ListPendingActivityScheduleAttributes(...)
+func (m *MockClient) ListPendingActivityScheduleAttributes(
+	ctx context.Context,
+	_Request *shared.ListPendingActivityScheduleAttributesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListPendingActivityScheduleAttributesResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListPendingActivityScheduleAttributes", args...)
+	success, _ = ret[i].(*shared.ListPendingActivityScheduleAttributesResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListPendingActivityScheduleAttributes(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListPendingActivityScheduleAttributes", args...)
+}
+
 // ListTaskListPartitions responds to a ListTaskListPartitions call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -815,6 +1145,39 @@ func (mr *_MockClientRecorder) RegisterDomain(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RegisterDomain", args...)
 }
 
+// RenewActivityLease responds to a RenewActivityLease call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RenewActivityLease(gomock.Any(), ...).Return(...)
+//	... := client.RenewActivityLease(...)
+func (m *MockClient) RenewActivityLease(
+	ctx context.Context,
+	_Request *shared.RenewActivityLeaseRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.RenewActivityLeaseResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RenewActivityLease", args...)
+	success, _ = ret[i].(*shared.RenewActivityLeaseResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RenewActivityLease(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RenewActivityLease", args...)
+}
+
 // RequestCancelWorkflowExecution responds to a RequestCancelWorkflowExecution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -846,6 +1209,37 @@ func (mr *_MockClientRecorder) RequestCancelWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RequestCancelWorkflowExecution", args...)
 }
 
+// ResetActivityAttempts responds to a ResetActivityAttempts call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ResetActivityAttempts(gomock.Any(), ...).Return(...)
+//	... := client.ResetActivityAttempts(...)
+func (m *MockClient) ResetActivityAttempts(
+	ctx context.Context,
+	_Request *shared.ResetActivityAttemptsRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResetActivityAttempts", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResetActivityAttempts(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResetActivityAttempts", args...)
+}
+
 // ResetStickyTaskList responds to a ResetStickyTaskList call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1098,6 +1492,37 @@ func (mr *_MockClientRecorder) RespondActivityTaskFailedByID(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RespondActivityTaskFailedByID", args...)
 }
 
+// RespondActivityTaskNack responds to a RespondActivityTaskNack call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RespondActivityTaskNack(gomock.Any(), ...).Return(...)
+//	... := client.RespondActivityTaskNack(...)
+func (m *MockClient) RespondActivityTaskNack(
+	ctx context.Context,
+	_Request *shared.RespondActivityTaskNackRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RespondActivityTaskNack", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RespondActivityTaskNack(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RespondActivityTaskNack", args...)
+}
+
 // RespondDecisionTaskCompleted responds to a RespondDecisionTaskCompleted call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1259,6 +1684,37 @@ func (mr *_MockClientRecorder) ScanWorkflowExecutions(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ScanWorkflowExecutions", args...)
 }
 
+// SignalActivity responds to a SignalActivity call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().SignalActivity(gomock.Any(), ...).Return(...)
+//	... := client.SignalActivity(...)
+func (m *MockClient) SignalActivity(
+	ctx context.Context,
+	_SignalRequest *shared.SignalActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _SignalRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "SignalActivity", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) SignalActivity(
+	ctx interface{},
+	_SignalRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _SignalRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "SignalActivity", args...)
+}
+
 // SignalWithStartWorkflowExecution responds to a SignalWithStartWorkflowExecution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1485,3 +1941,36 @@ func (mr *_MockClientRecorder) UpdateDomain(
 	args := append([]interface{}{ctx, _UpdateRequest}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateDomain", args...)
 }
+
+// WaitForActivityCompletion responds to a WaitForActivityCompletion call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().WaitForActivityCompletion(gomock.Any(), ...).Return(...)
+//	... := client.WaitForActivityCompletion(...)
+func (m *MockClient) WaitForActivityCompletion(
+	ctx context.Context,
+	_Request *shared.WaitForActivityCompletionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.WaitForActivityCompletionResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "WaitForActivityCompletion", args...)
+	success, _ = ret[i].(*shared.WaitForActivityCompletionResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) WaitForActivityCompletion(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "WaitForActivityCompletion", args...)
+}
@@ -41,6 +41,18 @@ import (
 
 // Interface is a client for the HistoryService service.
 type Interface interface {
+	BatchDescribeWorkflowExecutions(
+		ctx context.Context,
+		DescribeRequest *history.BatchDescribeWorkflowExecutionsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.BatchDescribeWorkflowExecutionsResponse, error)
+
+	CancelPendingActivities(
+		ctx context.Context,
+		Request *history.CancelPendingActivitiesRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	CloseShard(
 		ctx context.Context,
 		Request *shared.CloseShardRequest,
@@ -71,6 +83,54 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeWorkflowExecutionResponse, error)
 
+	ExpireActivityHeartbeat(
+		ctx context.Context,
+		Request *history.ExpireActivityHeartbeatRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	GetActivityAttemptTimings(
+		ctx context.Context,
+		Request *history.GetActivityAttemptTimingsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetActivityAttemptTimingsResponse, error)
+
+	GetActivityEffectiveConfig(
+		ctx context.Context,
+		Request *history.GetActivityEffectiveConfigRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetActivityEffectiveConfigResponse, error)
+
+	GetActivityNextRetryTime(
+		ctx context.Context,
+		Request *history.GetActivityNextRetryTimeRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetActivityNextRetryTimeResponse, error)
+
+	GetActivityRescheduleReasons(
+		ctx context.Context,
+		Request *history.GetActivityRescheduleReasonsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetActivityRescheduleReasonsResponse, error)
+
+	GetActivityRetryStats(
+		ctx context.Context,
+		Request *history.GetActivityRetryStatsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetActivityRetryStatsResponse, error)
+
+	GetActivityTokenValidationFailures(
+		ctx context.Context,
+		Request *history.GetActivityTokenValidationFailuresRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetActivityTokenValidationFailuresResponse, error)
+
+	GetActivityWorkerHistory(
+		ctx context.Context,
+		Request *history.GetActivityWorkerHistoryRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetActivityWorkerHistoryResponse, error)
+
 	GetCrossClusterTasks(
 		ctx context.Context,
 		Request *shared.GetCrossClusterTasksRequest,
@@ -173,6 +233,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.RecordDecisionTaskStartedResponse, error)
 
+	RedriveTimedOutActivities(
+		ctx context.Context,
+		Request *history.RedriveTimedOutActivitiesRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RefreshWorkflowTasks(
 		ctx context.Context,
 		Request *history.RefreshWorkflowTasksRequest,
@@ -191,6 +257,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ReplayActivityCompletions(
+		ctx context.Context,
+		Request *history.ReplayActivityCompletionsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ReplayActivityCompletionsResponse, error)
+
 	ReplicateEventsV2(
 		ctx context.Context,
 		ReplicateV2Request *history.ReplicateEventsV2Request,
@@ -203,6 +275,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ResetActivityAttempts(
+		ctx context.Context,
+		Request *history.ResetActivityAttemptsRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ResetQueue(
 		ctx context.Context,
 		Request *shared.ResetQueueRequest,
@@ -239,6 +317,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RespondActivityTaskNack(
+		ctx context.Context,
+		Request *history.RespondActivityTaskNackRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RespondCrossClusterTasksCompleted(
 		ctx context.Context,
 		Request *shared.RespondCrossClusterTasksCompletedRequest,
@@ -263,6 +347,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	SignalActivity(
+		ctx context.Context,
+		SignalRequest *history.SignalActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	SignalWithStartWorkflowExecution(
 		ctx context.Context,
 		SignalWithStartRequest *history.SignalWithStartWorkflowExecutionRequest,
@@ -329,6 +419,62 @@ type client struct {
 	nwc thrift.NoWireClient
 }
 
+func (c client) BatchDescribeWorkflowExecutions(
+	ctx context.Context,
+	_DescribeRequest *history.BatchDescribeWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.BatchDescribeWorkflowExecutionsResponse, err error) {
+
+	var result history.HistoryService_BatchDescribeWorkflowExecutions_Result
+	args := history.HistoryService_BatchDescribeWorkflowExecutions_Helper.Args(_DescribeRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_BatchDescribeWorkflowExecutions_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) CancelPendingActivities(
+	ctx context.Context,
+	_Request *history.CancelPendingActivitiesRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_CancelPendingActivities_Result
+	args := history.HistoryService_CancelPendingActivities_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_CancelPendingActivities_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) CloseShard(
 	ctx context.Context,
 	_Request *shared.CloseShardRequest,
@@ -469,6 +615,230 @@ func (c client) DescribeWorkflowExecution(
 	return
 }
 
+func (c client) ExpireActivityHeartbeat(
+	ctx context.Context,
+	_Request *history.ExpireActivityHeartbeatRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_ExpireActivityHeartbeat_Result
+	args := history.HistoryService_ExpireActivityHeartbeat_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_ExpireActivityHeartbeat_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetActivityAttemptTimings(
+	ctx context.Context,
+	_Request *history.GetActivityAttemptTimingsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityAttemptTimingsResponse, err error) {
+
+	var result history.HistoryService_GetActivityAttemptTimings_Result
+	args := history.HistoryService_GetActivityAttemptTimings_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_GetActivityAttemptTimings_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetActivityEffectiveConfig(
+	ctx context.Context,
+	_Request *history.GetActivityEffectiveConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityEffectiveConfigResponse, err error) {
+
+	var result history.HistoryService_GetActivityEffectiveConfig_Result
+	args := history.HistoryService_GetActivityEffectiveConfig_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_GetActivityEffectiveConfig_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetActivityNextRetryTime(
+	ctx context.Context,
+	_Request *history.GetActivityNextRetryTimeRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityNextRetryTimeResponse, err error) {
+
+	var result history.HistoryService_GetActivityNextRetryTime_Result
+	args := history.HistoryService_GetActivityNextRetryTime_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_GetActivityNextRetryTime_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetActivityRescheduleReasons(
+	ctx context.Context,
+	_Request *history.GetActivityRescheduleReasonsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityRescheduleReasonsResponse, err error) {
+
+	var result history.HistoryService_GetActivityRescheduleReasons_Result
+	args := history.HistoryService_GetActivityRescheduleReasons_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_GetActivityRescheduleReasons_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetActivityRetryStats(
+	ctx context.Context,
+	_Request *history.GetActivityRetryStatsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityRetryStatsResponse, err error) {
+
+	var result history.HistoryService_GetActivityRetryStats_Result
+	args := history.HistoryService_GetActivityRetryStats_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_GetActivityRetryStats_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetActivityTokenValidationFailures(
+	ctx context.Context,
+	_Request *history.GetActivityTokenValidationFailuresRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityTokenValidationFailuresResponse, err error) {
+
+	var result history.HistoryService_GetActivityTokenValidationFailures_Result
+	args := history.HistoryService_GetActivityTokenValidationFailures_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_GetActivityTokenValidationFailures_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetActivityWorkerHistory(
+	ctx context.Context,
+	_Request *history.GetActivityWorkerHistoryRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityWorkerHistoryResponse, err error) {
+
+	var result history.HistoryService_GetActivityWorkerHistory_Result
+	args := history.HistoryService_GetActivityWorkerHistory_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_GetActivityWorkerHistory_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetCrossClusterTasks(
 	ctx context.Context,
 	_Request *shared.GetCrossClusterTasksRequest,
@@ -945,6 +1315,34 @@ func (c client) RecordDecisionTaskStarted(
 	return
 }
 
+func (c client) RedriveTimedOutActivities(
+	ctx context.Context,
+	_Request *history.RedriveTimedOutActivitiesRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_RedriveTimedOutActivities_Result
+	args := history.HistoryService_RedriveTimedOutActivities_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_RedriveTimedOutActivities_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RefreshWorkflowTasks(
 	ctx context.Context,
 	_Request *history.RefreshWorkflowTasksRequest,
@@ -1029,6 +1427,34 @@ func (c client) RemoveTask(
 	return
 }
 
+func (c client) ReplayActivityCompletions(
+	ctx context.Context,
+	_Request *history.ReplayActivityCompletionsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ReplayActivityCompletionsResponse, err error) {
+
+	var result history.HistoryService_ReplayActivityCompletions_Result
+	args := history.HistoryService_ReplayActivityCompletions_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_ReplayActivityCompletions_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ReplicateEventsV2(
 	ctx context.Context,
 	_ReplicateV2Request *history.ReplicateEventsV2Request,
@@ -1085,6 +1511,34 @@ func (c client) RequestCancelWorkflowExecution(
 	return
 }
 
+func (c client) ResetActivityAttempts(
+	ctx context.Context,
+	_Request *history.ResetActivityAttemptsRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_ResetActivityAttempts_Result
+	args := history.HistoryService_ResetActivityAttempts_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_ResetActivityAttempts_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ResetQueue(
 	ctx context.Context,
 	_Request *shared.ResetQueueRequest,
@@ -1253,6 +1707,34 @@ func (c client) RespondActivityTaskFailed(
 	return
 }
 
+func (c client) RespondActivityTaskNack(
+	ctx context.Context,
+	_Request *history.RespondActivityTaskNackRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_RespondActivityTaskNack_Result
+	args := history.HistoryService_RespondActivityTaskNack_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_RespondActivityTaskNack_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RespondCrossClusterTasksCompleted(
 	ctx context.Context,
 	_Request *shared.RespondCrossClusterTasksCompletedRequest,
@@ -1365,6 +1847,34 @@ func (c client) ScheduleDecisionTask(
 	return
 }
 
+func (c client) SignalActivity(
+	ctx context.Context,
+	_SignalRequest *history.SignalActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_SignalActivity_Result
+	args := history.HistoryService_SignalActivity_Helper.Args(_SignalRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_SignalActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	_SignalWithStartRequest *history.SignalWithStartWorkflowExecutionRequest,
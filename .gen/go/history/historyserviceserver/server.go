@@ -41,6 +41,16 @@ import (
 
 // Interface is the server-side interface for the HistoryService service.
 type Interface interface {
+	BatchDescribeWorkflowExecutions(
+		ctx context.Context,
+		DescribeRequest *history.BatchDescribeWorkflowExecutionsRequest,
+	) (*shared.BatchDescribeWorkflowExecutionsResponse, error)
+
+	CancelPendingActivities(
+		ctx context.Context,
+		Request *history.CancelPendingActivitiesRequest,
+	) error
+
 	CloseShard(
 		ctx context.Context,
 		Request *shared.CloseShardRequest,
@@ -66,6 +76,46 @@ type Interface interface {
 		DescribeRequest *history.DescribeWorkflowExecutionRequest,
 	) (*shared.DescribeWorkflowExecutionResponse, error)
 
+	ExpireActivityHeartbeat(
+		ctx context.Context,
+		Request *history.ExpireActivityHeartbeatRequest,
+	) error
+
+	GetActivityAttemptTimings(
+		ctx context.Context,
+		Request *history.GetActivityAttemptTimingsRequest,
+	) (*shared.GetActivityAttemptTimingsResponse, error)
+
+	GetActivityEffectiveConfig(
+		ctx context.Context,
+		Request *history.GetActivityEffectiveConfigRequest,
+	) (*shared.GetActivityEffectiveConfigResponse, error)
+
+	GetActivityNextRetryTime(
+		ctx context.Context,
+		Request *history.GetActivityNextRetryTimeRequest,
+	) (*shared.GetActivityNextRetryTimeResponse, error)
+
+	GetActivityRescheduleReasons(
+		ctx context.Context,
+		Request *history.GetActivityRescheduleReasonsRequest,
+	) (*shared.GetActivityRescheduleReasonsResponse, error)
+
+	GetActivityRetryStats(
+		ctx context.Context,
+		Request *history.GetActivityRetryStatsRequest,
+	) (*shared.GetActivityRetryStatsResponse, error)
+
+	GetActivityTokenValidationFailures(
+		ctx context.Context,
+		Request *history.GetActivityTokenValidationFailuresRequest,
+	) (*shared.GetActivityTokenValidationFailuresResponse, error)
+
+	GetActivityWorkerHistory(
+		ctx context.Context,
+		Request *history.GetActivityWorkerHistoryRequest,
+	) (*shared.GetActivityWorkerHistoryResponse, error)
+
 	GetCrossClusterTasks(
 		ctx context.Context,
 		Request *shared.GetCrossClusterTasksRequest,
@@ -151,6 +201,11 @@ type Interface interface {
 		AddRequest *history.RecordDecisionTaskStartedRequest,
 	) (*history.RecordDecisionTaskStartedResponse, error)
 
+	RedriveTimedOutActivities(
+		ctx context.Context,
+		Request *history.RedriveTimedOutActivitiesRequest,
+	) error
+
 	RefreshWorkflowTasks(
 		ctx context.Context,
 		Request *history.RefreshWorkflowTasksRequest,
@@ -166,6 +221,11 @@ type Interface interface {
 		Request *shared.RemoveTaskRequest,
 	) error
 
+	ReplayActivityCompletions(
+		ctx context.Context,
+		Request *history.ReplayActivityCompletionsRequest,
+	) (*shared.ReplayActivityCompletionsResponse, error)
+
 	ReplicateEventsV2(
 		ctx context.Context,
 		ReplicateV2Request *history.ReplicateEventsV2Request,
@@ -176,6 +236,11 @@ type Interface interface {
 		CancelRequest *history.RequestCancelWorkflowExecutionRequest,
 	) error
 
+	ResetActivityAttempts(
+		ctx context.Context,
+		Request *history.ResetActivityAttemptsRequest,
+	) error
+
 	ResetQueue(
 		ctx context.Context,
 		Request *shared.ResetQueueRequest,
@@ -206,6 +271,11 @@ type Interface interface {
 		FailRequest *history.RespondActivityTaskFailedRequest,
 	) error
 
+	RespondActivityTaskNack(
+		ctx context.Context,
+		Request *history.RespondActivityTaskNackRequest,
+	) error
+
 	RespondCrossClusterTasksCompleted(
 		ctx context.Context,
 		Request *shared.RespondCrossClusterTasksCompletedRequest,
@@ -226,6 +296,11 @@ type Interface interface {
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
 	) error
 
+	SignalActivity(
+		ctx context.Context,
+		SignalRequest *history.SignalActivityRequest,
+	) error
+
 	SignalWithStartWorkflowExecution(
 		ctx context.Context,
 		SignalWithStartRequest *history.SignalWithStartWorkflowExecutionRequest,
@@ -268,6 +343,30 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		Name: "HistoryService",
 		Methods: []thrift.Method{
 
+			thrift.Method{
+				Name: "BatchDescribeWorkflowExecutions",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.BatchDescribeWorkflowExecutions),
+					NoWire: batchdescribeworkflowexecutions_NoWireHandler{impl},
+				},
+				Signature:    "BatchDescribeWorkflowExecutions(DescribeRequest *history.BatchDescribeWorkflowExecutionsRequest) (*shared.BatchDescribeWorkflowExecutionsResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "CancelPendingActivities",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.CancelPendingActivities),
+					NoWire: cancelpendingactivities_NoWireHandler{impl},
+				},
+				Signature:    "CancelPendingActivities(Request *history.CancelPendingActivitiesRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "CloseShard",
 				HandlerSpec: thrift.HandlerSpec{
@@ -328,6 +427,102 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ExpireActivityHeartbeat",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ExpireActivityHeartbeat),
+					NoWire: expireactivityheartbeat_NoWireHandler{impl},
+				},
+				Signature:    "ExpireActivityHeartbeat(Request *history.ExpireActivityHeartbeatRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetActivityAttemptTimings",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetActivityAttemptTimings),
+					NoWire: getactivityattempttimings_NoWireHandler{impl},
+				},
+				Signature:    "GetActivityAttemptTimings(Request *history.GetActivityAttemptTimingsRequest) (*shared.GetActivityAttemptTimingsResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetActivityEffectiveConfig",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetActivityEffectiveConfig),
+					NoWire: getactivityeffectiveconfig_NoWireHandler{impl},
+				},
+				Signature:    "GetActivityEffectiveConfig(Request *history.GetActivityEffectiveConfigRequest) (*shared.GetActivityEffectiveConfigResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetActivityNextRetryTime",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetActivityNextRetryTime),
+					NoWire: getactivitynextretrytime_NoWireHandler{impl},
+				},
+				Signature:    "GetActivityNextRetryTime(Request *history.GetActivityNextRetryTimeRequest) (*shared.GetActivityNextRetryTimeResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetActivityRescheduleReasons",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetActivityRescheduleReasons),
+					NoWire: getactivityreschedulereasons_NoWireHandler{impl},
+				},
+				Signature:    "GetActivityRescheduleReasons(Request *history.GetActivityRescheduleReasonsRequest) (*shared.GetActivityRescheduleReasonsResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetActivityRetryStats",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetActivityRetryStats),
+					NoWire: getactivityretrystats_NoWireHandler{impl},
+				},
+				Signature:    "GetActivityRetryStats(Request *history.GetActivityRetryStatsRequest) (*shared.GetActivityRetryStatsResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetActivityTokenValidationFailures",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetActivityTokenValidationFailures),
+					NoWire: getactivitytokenvalidationfailures_NoWireHandler{impl},
+				},
+				Signature:    "GetActivityTokenValidationFailures(Request *history.GetActivityTokenValidationFailuresRequest) (*shared.GetActivityTokenValidationFailuresResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetActivityWorkerHistory",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetActivityWorkerHistory),
+					NoWire: getactivityworkerhistory_NoWireHandler{impl},
+				},
+				Signature:    "GetActivityWorkerHistory(Request *history.GetActivityWorkerHistoryRequest) (*shared.GetActivityWorkerHistoryResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetCrossClusterTasks",
 				HandlerSpec: thrift.HandlerSpec{
@@ -532,6 +727,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RedriveTimedOutActivities",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RedriveTimedOutActivities),
+					NoWire: redrivetimedoutactivities_NoWireHandler{impl},
+				},
+				Signature:    "RedriveTimedOutActivities(Request *history.RedriveTimedOutActivitiesRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RefreshWorkflowTasks",
 				HandlerSpec: thrift.HandlerSpec{
@@ -568,6 +775,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ReplayActivityCompletions",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ReplayActivityCompletions),
+					NoWire: replayactivitycompletions_NoWireHandler{impl},
+				},
+				Signature:    "ReplayActivityCompletions(Request *history.ReplayActivityCompletionsRequest) (*shared.ReplayActivityCompletionsResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ReplicateEventsV2",
 				HandlerSpec: thrift.HandlerSpec{
@@ -592,6 +811,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ResetActivityAttempts",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ResetActivityAttempts),
+					NoWire: resetactivityattempts_NoWireHandler{impl},
+				},
+				Signature:    "ResetActivityAttempts(Request *history.ResetActivityAttemptsRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ResetQueue",
 				HandlerSpec: thrift.HandlerSpec{
@@ -664,6 +895,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RespondActivityTaskNack",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RespondActivityTaskNack),
+					NoWire: respondactivitytasknack_NoWireHandler{impl},
+				},
+				Signature:    "RespondActivityTaskNack(Request *history.RespondActivityTaskNackRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RespondCrossClusterTasksCompleted",
 				HandlerSpec: thrift.HandlerSpec{
@@ -712,6 +955,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "SignalActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.SignalActivity),
+					NoWire: signalactivity_NoWireHandler{impl},
+				},
+				Signature:    "SignalActivity(SignalRequest *history.SignalActivityRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "SignalWithStartWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -786,7 +1041,7 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 43)
+	procedures := make([]transport.Procedure, 0, 58)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -797,17 +1052,17 @@ type yarpcErrorNamer interface{ YARPCErrorName() string }
 
 type yarpcErrorCoder interface{ YARPCErrorCode() *yarpcerrors.Code }
 
-func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_CloseShard_Args
+func (h handler) BatchDescribeWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_BatchDescribeWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'CloseShard': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'BatchDescribeWorkflowExecutions': %w", err)
 	}
 
-	appErr := h.impl.CloseShard(ctx, args.Request)
+	success, appErr := h.impl.BatchDescribeWorkflowExecutions(ctx, args.DescribeRequest)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_CloseShard_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_BatchDescribeWorkflowExecutions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -827,17 +1082,17 @@ func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
-func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_DescribeHistoryHost_Args
+func (h handler) CancelPendingActivities(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_CancelPendingActivities_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'DescribeHistoryHost': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'CancelPendingActivities': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeHistoryHost(ctx, args.Request)
+	appErr := h.impl.CancelPendingActivities(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_DescribeHistoryHost_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_CancelPendingActivities_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -857,17 +1112,17 @@ func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
-func (h handler) DescribeMutableState(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_DescribeMutableState_Args
+func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_CloseShard_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'DescribeMutableState': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'CloseShard': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeMutableState(ctx, args.Request)
+	appErr := h.impl.CloseShard(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_DescribeMutableState_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_CloseShard_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -887,17 +1142,17 @@ func (h handler) DescribeMutableState(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
-func (h handler) DescribeQueue(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_DescribeQueue_Args
+func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_DescribeHistoryHost_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'DescribeQueue': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'DescribeHistoryHost': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeQueue(ctx, args.Request)
+	success, appErr := h.impl.DescribeHistoryHost(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_DescribeQueue_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_DescribeHistoryHost_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -917,17 +1172,17 @@ func (h handler) DescribeQueue(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
-func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_DescribeWorkflowExecution_Args
+func (h handler) DescribeMutableState(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_DescribeMutableState_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'DescribeWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'DescribeMutableState': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeWorkflowExecution(ctx, args.DescribeRequest)
+	success, appErr := h.impl.DescribeMutableState(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_DescribeWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_DescribeMutableState_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -947,17 +1202,17 @@ func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) GetCrossClusterTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_GetCrossClusterTasks_Args
+func (h handler) DescribeQueue(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_DescribeQueue_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'GetCrossClusterTasks': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'DescribeQueue': %w", err)
 	}
 
-	success, appErr := h.impl.GetCrossClusterTasks(ctx, args.Request)
+	success, appErr := h.impl.DescribeQueue(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_GetCrossClusterTasks_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_DescribeQueue_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -977,17 +1232,17 @@ func (h handler) GetCrossClusterTasks(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
-func (h handler) GetDLQReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_GetDLQReplicationMessages_Args
+func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_DescribeWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'GetDLQReplicationMessages': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'DescribeWorkflowExecution': %w", err)
 	}
 
-	success, appErr := h.impl.GetDLQReplicationMessages(ctx, args.Request)
+	success, appErr := h.impl.DescribeWorkflowExecution(ctx, args.DescribeRequest)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_GetDLQReplicationMessages_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_DescribeWorkflowExecution_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1007,17 +1262,17 @@ func (h handler) GetDLQReplicationMessages(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) GetFailoverInfo(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_GetFailoverInfo_Args
+func (h handler) ExpireActivityHeartbeat(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ExpireActivityHeartbeat_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'GetFailoverInfo': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'ExpireActivityHeartbeat': %w", err)
 	}
 
-	success, appErr := h.impl.GetFailoverInfo(ctx, args.Request)
+	appErr := h.impl.ExpireActivityHeartbeat(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_GetFailoverInfo_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_ExpireActivityHeartbeat_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1037,17 +1292,17 @@ func (h handler) GetFailoverInfo(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
-func (h handler) GetMutableState(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_GetMutableState_Args
+func (h handler) GetActivityAttemptTimings(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_GetActivityAttemptTimings_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'GetMutableState': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'GetActivityAttemptTimings': %w", err)
 	}
 
-	success, appErr := h.impl.GetMutableState(ctx, args.GetRequest)
+	success, appErr := h.impl.GetActivityAttemptTimings(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_GetMutableState_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_GetActivityAttemptTimings_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1067,17 +1322,17 @@ func (h handler) GetMutableState(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
-func (h handler) GetReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_GetReplicationMessages_Args
+func (h handler) GetActivityEffectiveConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_GetActivityEffectiveConfig_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'GetReplicationMessages': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'GetActivityEffectiveConfig': %w", err)
 	}
 
-	success, appErr := h.impl.GetReplicationMessages(ctx, args.Request)
+	success, appErr := h.impl.GetActivityEffectiveConfig(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_GetReplicationMessages_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_GetActivityEffectiveConfig_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1097,17 +1352,17 @@ func (h handler) GetReplicationMessages(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_MergeDLQMessages_Args
+func (h handler) GetActivityNextRetryTime(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_GetActivityNextRetryTime_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'MergeDLQMessages': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'GetActivityNextRetryTime': %w", err)
 	}
 
-	success, appErr := h.impl.MergeDLQMessages(ctx, args.Request)
+	success, appErr := h.impl.GetActivityNextRetryTime(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_MergeDLQMessages_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_GetActivityNextRetryTime_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1127,17 +1382,17 @@ func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
-func (h handler) NotifyFailoverMarkers(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_NotifyFailoverMarkers_Args
+func (h handler) GetActivityRescheduleReasons(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_GetActivityRescheduleReasons_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'NotifyFailoverMarkers': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'GetActivityRescheduleReasons': %w", err)
 	}
 
-	appErr := h.impl.NotifyFailoverMarkers(ctx, args.Request)
+	success, appErr := h.impl.GetActivityRescheduleReasons(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_NotifyFailoverMarkers_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_GetActivityRescheduleReasons_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1157,17 +1412,17 @@ func (h handler) NotifyFailoverMarkers(ctx context.Context, body wire.Value) (th
 	return response, err
 }
 
-func (h handler) PollMutableState(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_PollMutableState_Args
+func (h handler) GetActivityRetryStats(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_GetActivityRetryStats_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'PollMutableState': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'GetActivityRetryStats': %w", err)
 	}
 
-	success, appErr := h.impl.PollMutableState(ctx, args.PollRequest)
+	success, appErr := h.impl.GetActivityRetryStats(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_PollMutableState_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_GetActivityRetryStats_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1187,17 +1442,17 @@ func (h handler) PollMutableState(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
-func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_PurgeDLQMessages_Args
+func (h handler) GetActivityTokenValidationFailures(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_GetActivityTokenValidationFailures_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'PurgeDLQMessages': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'GetActivityTokenValidationFailures': %w", err)
 	}
 
-	appErr := h.impl.PurgeDLQMessages(ctx, args.Request)
+	success, appErr := h.impl.GetActivityTokenValidationFailures(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_PurgeDLQMessages_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_GetActivityTokenValidationFailures_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1217,17 +1472,17 @@ func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
-func (h handler) QueryWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_QueryWorkflow_Args
+func (h handler) GetActivityWorkerHistory(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_GetActivityWorkerHistory_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'QueryWorkflow': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'GetActivityWorkerHistory': %w", err)
 	}
 
-	success, appErr := h.impl.QueryWorkflow(ctx, args.QueryRequest)
+	success, appErr := h.impl.GetActivityWorkerHistory(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_QueryWorkflow_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_GetActivityWorkerHistory_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1247,17 +1502,17 @@ func (h handler) QueryWorkflow(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
-func (h handler) RatelimitUpdate(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RatelimitUpdate_Args
+func (h handler) GetCrossClusterTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_GetCrossClusterTasks_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RatelimitUpdate': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'GetCrossClusterTasks': %w", err)
 	}
 
-	success, appErr := h.impl.RatelimitUpdate(ctx, args.Request)
+	success, appErr := h.impl.GetCrossClusterTasks(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_RatelimitUpdate_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_GetCrossClusterTasks_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1277,17 +1532,17 @@ func (h handler) RatelimitUpdate(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
-func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_ReadDLQMessages_Args
+func (h handler) GetDLQReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_GetDLQReplicationMessages_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'ReadDLQMessages': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'GetDLQReplicationMessages': %w", err)
 	}
 
-	success, appErr := h.impl.ReadDLQMessages(ctx, args.Request)
+	success, appErr := h.impl.GetDLQReplicationMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_ReadDLQMessages_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_GetDLQReplicationMessages_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1307,17 +1562,17 @@ func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
-func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_ReapplyEvents_Args
+func (h handler) GetFailoverInfo(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_GetFailoverInfo_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'ReapplyEvents': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'GetFailoverInfo': %w", err)
 	}
 
-	appErr := h.impl.ReapplyEvents(ctx, args.ReapplyEventsRequest)
+	success, appErr := h.impl.GetFailoverInfo(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_ReapplyEvents_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_GetFailoverInfo_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1337,17 +1592,17 @@ func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
-func (h handler) RecordActivityTaskHeartbeat(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RecordActivityTaskHeartbeat_Args
+func (h handler) GetMutableState(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_GetMutableState_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RecordActivityTaskHeartbeat': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'GetMutableState': %w", err)
 	}
 
-	success, appErr := h.impl.RecordActivityTaskHeartbeat(ctx, args.HeartbeatRequest)
+	success, appErr := h.impl.GetMutableState(ctx, args.GetRequest)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_RecordActivityTaskHeartbeat_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_GetMutableState_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1367,17 +1622,17 @@ func (h handler) RecordActivityTaskHeartbeat(ctx context.Context, body wire.Valu
 	return response, err
 }
 
-func (h handler) RecordActivityTaskStarted(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RecordActivityTaskStarted_Args
+func (h handler) GetReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_GetReplicationMessages_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RecordActivityTaskStarted': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'GetReplicationMessages': %w", err)
 	}
 
-	success, appErr := h.impl.RecordActivityTaskStarted(ctx, args.AddRequest)
+	success, appErr := h.impl.GetReplicationMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_RecordActivityTaskStarted_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_GetReplicationMessages_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1397,17 +1652,17 @@ func (h handler) RecordActivityTaskStarted(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) RecordChildExecutionCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RecordChildExecutionCompleted_Args
+func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_MergeDLQMessages_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RecordChildExecutionCompleted': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'MergeDLQMessages': %w", err)
 	}
 
-	appErr := h.impl.RecordChildExecutionCompleted(ctx, args.CompletionRequest)
+	success, appErr := h.impl.MergeDLQMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_RecordChildExecutionCompleted_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_MergeDLQMessages_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1427,17 +1682,17 @@ func (h handler) RecordChildExecutionCompleted(ctx context.Context, body wire.Va
 	return response, err
 }
 
-func (h handler) RecordDecisionTaskStarted(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RecordDecisionTaskStarted_Args
+func (h handler) NotifyFailoverMarkers(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_NotifyFailoverMarkers_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RecordDecisionTaskStarted': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'NotifyFailoverMarkers': %w", err)
 	}
 
-	success, appErr := h.impl.RecordDecisionTaskStarted(ctx, args.AddRequest)
+	appErr := h.impl.NotifyFailoverMarkers(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_RecordDecisionTaskStarted_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_NotifyFailoverMarkers_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1457,17 +1712,17 @@ func (h handler) RecordDecisionTaskStarted(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RefreshWorkflowTasks_Args
+func (h handler) PollMutableState(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_PollMutableState_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RefreshWorkflowTasks': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'PollMutableState': %w", err)
 	}
 
-	appErr := h.impl.RefreshWorkflowTasks(ctx, args.Request)
+	success, appErr := h.impl.PollMutableState(ctx, args.PollRequest)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_RefreshWorkflowTasks_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_PollMutableState_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1487,17 +1742,17 @@ func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
-func (h handler) RemoveSignalMutableState(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RemoveSignalMutableState_Args
+func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_PurgeDLQMessages_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RemoveSignalMutableState': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'PurgeDLQMessages': %w", err)
 	}
 
-	appErr := h.impl.RemoveSignalMutableState(ctx, args.RemoveRequest)
+	appErr := h.impl.PurgeDLQMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_RemoveSignalMutableState_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_PurgeDLQMessages_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1517,17 +1772,17 @@ func (h handler) RemoveSignalMutableState(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RemoveTask_Args
+func (h handler) QueryWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_QueryWorkflow_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RemoveTask': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'QueryWorkflow': %w", err)
 	}
 
-	appErr := h.impl.RemoveTask(ctx, args.Request)
+	success, appErr := h.impl.QueryWorkflow(ctx, args.QueryRequest)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_RemoveTask_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_QueryWorkflow_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1547,17 +1802,17 @@ func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
-func (h handler) ReplicateEventsV2(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_ReplicateEventsV2_Args
+func (h handler) RatelimitUpdate(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RatelimitUpdate_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'ReplicateEventsV2': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'RatelimitUpdate': %w", err)
 	}
 
-	appErr := h.impl.ReplicateEventsV2(ctx, args.ReplicateV2Request)
+	success, appErr := h.impl.RatelimitUpdate(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_ReplicateEventsV2_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_RatelimitUpdate_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1577,17 +1832,17 @@ func (h handler) ReplicateEventsV2(ctx context.Context, body wire.Value) (thrift
 	return response, err
 }
 
-func (h handler) RequestCancelWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RequestCancelWorkflowExecution_Args
+func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ReadDLQMessages_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RequestCancelWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'ReadDLQMessages': %w", err)
 	}
 
-	appErr := h.impl.RequestCancelWorkflowExecution(ctx, args.CancelRequest)
+	success, appErr := h.impl.ReadDLQMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_RequestCancelWorkflowExecution_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_ReadDLQMessages_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1607,17 +1862,17 @@ func (h handler) RequestCancelWorkflowExecution(ctx context.Context, body wire.V
 	return response, err
 }
 
-func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_ResetQueue_Args
+func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ReapplyEvents_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'ResetQueue': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'ReapplyEvents': %w", err)
 	}
 
-	appErr := h.impl.ResetQueue(ctx, args.Request)
+	appErr := h.impl.ReapplyEvents(ctx, args.ReapplyEventsRequest)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_ResetQueue_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_ReapplyEvents_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1637,17 +1892,17 @@ func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
-func (h handler) ResetStickyTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_ResetStickyTaskList_Args
+func (h handler) RecordActivityTaskHeartbeat(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RecordActivityTaskHeartbeat_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'ResetStickyTaskList': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'RecordActivityTaskHeartbeat': %w", err)
 	}
 
-	success, appErr := h.impl.ResetStickyTaskList(ctx, args.ResetRequest)
+	success, appErr := h.impl.RecordActivityTaskHeartbeat(ctx, args.HeartbeatRequest)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_ResetStickyTaskList_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_RecordActivityTaskHeartbeat_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1667,17 +1922,17 @@ func (h handler) ResetStickyTaskList(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
-func (h handler) ResetWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_ResetWorkflowExecution_Args
+func (h handler) RecordActivityTaskStarted(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RecordActivityTaskStarted_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'ResetWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'RecordActivityTaskStarted': %w", err)
 	}
 
-	success, appErr := h.impl.ResetWorkflowExecution(ctx, args.ResetRequest)
+	success, appErr := h.impl.RecordActivityTaskStarted(ctx, args.AddRequest)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_ResetWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_RecordActivityTaskStarted_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1697,17 +1952,17 @@ func (h handler) ResetWorkflowExecution(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) RespondActivityTaskCanceled(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RespondActivityTaskCanceled_Args
+func (h handler) RecordChildExecutionCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RecordChildExecutionCompleted_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RespondActivityTaskCanceled': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'RecordChildExecutionCompleted': %w", err)
 	}
 
-	appErr := h.impl.RespondActivityTaskCanceled(ctx, args.CanceledRequest)
+	appErr := h.impl.RecordChildExecutionCompleted(ctx, args.CompletionRequest)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_RespondActivityTaskCanceled_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_RecordChildExecutionCompleted_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1727,17 +1982,17 @@ func (h handler) RespondActivityTaskCanceled(ctx context.Context, body wire.Valu
 	return response, err
 }
 
-func (h handler) RespondActivityTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RespondActivityTaskCompleted_Args
+func (h handler) RecordDecisionTaskStarted(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RecordDecisionTaskStarted_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RespondActivityTaskCompleted': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'RecordDecisionTaskStarted': %w", err)
 	}
 
-	appErr := h.impl.RespondActivityTaskCompleted(ctx, args.CompleteRequest)
+	success, appErr := h.impl.RecordDecisionTaskStarted(ctx, args.AddRequest)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_RespondActivityTaskCompleted_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_RecordDecisionTaskStarted_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1757,17 +2012,17 @@ func (h handler) RespondActivityTaskCompleted(ctx context.Context, body wire.Val
 	return response, err
 }
 
-func (h handler) RespondActivityTaskFailed(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RespondActivityTaskFailed_Args
+func (h handler) RedriveTimedOutActivities(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RedriveTimedOutActivities_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RespondActivityTaskFailed': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'RedriveTimedOutActivities': %w", err)
 	}
 
-	appErr := h.impl.RespondActivityTaskFailed(ctx, args.FailRequest)
+	appErr := h.impl.RedriveTimedOutActivities(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_RespondActivityTaskFailed_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_RedriveTimedOutActivities_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1787,17 +2042,17 @@ func (h handler) RespondActivityTaskFailed(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) RespondCrossClusterTasksCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RespondCrossClusterTasksCompleted_Args
+func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RefreshWorkflowTasks_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RespondCrossClusterTasksCompleted': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'RefreshWorkflowTasks': %w", err)
 	}
 
-	success, appErr := h.impl.RespondCrossClusterTasksCompleted(ctx, args.Request)
+	appErr := h.impl.RefreshWorkflowTasks(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_RespondCrossClusterTasksCompleted_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_RefreshWorkflowTasks_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1817,17 +2072,17 @@ func (h handler) RespondCrossClusterTasksCompleted(ctx context.Context, body wir
 	return response, err
 }
 
-func (h handler) RespondDecisionTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RespondDecisionTaskCompleted_Args
+func (h handler) RemoveSignalMutableState(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RemoveSignalMutableState_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RespondDecisionTaskCompleted': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'RemoveSignalMutableState': %w", err)
 	}
 
-	success, appErr := h.impl.RespondDecisionTaskCompleted(ctx, args.CompleteRequest)
+	appErr := h.impl.RemoveSignalMutableState(ctx, args.RemoveRequest)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_RespondDecisionTaskCompleted_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_RemoveSignalMutableState_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1847,17 +2102,17 @@ func (h handler) RespondDecisionTaskCompleted(ctx context.Context, body wire.Val
 	return response, err
 }
 
-func (h handler) RespondDecisionTaskFailed(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RespondDecisionTaskFailed_Args
+func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RemoveTask_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RespondDecisionTaskFailed': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'RemoveTask': %w", err)
 	}
 
-	appErr := h.impl.RespondDecisionTaskFailed(ctx, args.FailedRequest)
+	appErr := h.impl.RemoveTask(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_RespondDecisionTaskFailed_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_RemoveTask_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1877,17 +2132,17 @@ func (h handler) RespondDecisionTaskFailed(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) ScheduleDecisionTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_ScheduleDecisionTask_Args
+func (h handler) ReplayActivityCompletions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ReplayActivityCompletions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'ScheduleDecisionTask': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'ReplayActivityCompletions': %w", err)
 	}
 
-	appErr := h.impl.ScheduleDecisionTask(ctx, args.ScheduleRequest)
+	success, appErr := h.impl.ReplayActivityCompletions(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_ScheduleDecisionTask_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_ReplayActivityCompletions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1907,17 +2162,17 @@ func (h handler) ScheduleDecisionTask(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
-func (h handler) SignalWithStartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_SignalWithStartWorkflowExecution_Args
+func (h handler) ReplicateEventsV2(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ReplicateEventsV2_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'SignalWithStartWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'ReplicateEventsV2': %w", err)
 	}
 
-	success, appErr := h.impl.SignalWithStartWorkflowExecution(ctx, args.SignalWithStartRequest)
+	appErr := h.impl.ReplicateEventsV2(ctx, args.ReplicateV2Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_SignalWithStartWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_ReplicateEventsV2_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1937,17 +2192,17 @@ func (h handler) SignalWithStartWorkflowExecution(ctx context.Context, body wire
 	return response, err
 }
 
-func (h handler) SignalWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_SignalWorkflowExecution_Args
+func (h handler) RequestCancelWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RequestCancelWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'SignalWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'RequestCancelWorkflowExecution': %w", err)
 	}
 
-	appErr := h.impl.SignalWorkflowExecution(ctx, args.SignalRequest)
+	appErr := h.impl.RequestCancelWorkflowExecution(ctx, args.CancelRequest)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_SignalWorkflowExecution_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_RequestCancelWorkflowExecution_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1967,19 +2222,818 @@ func (h handler) SignalWorkflowExecution(ctx context.Context, body wire.Value) (
 	return response, err
 }
 
-func (h handler) StartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_StartWorkflowExecution_Args
+func (h handler) ResetActivityAttempts(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ResetActivityAttempts_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'StartWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'ResetActivityAttempts': %w", err)
 	}
 
-	success, appErr := h.impl.StartWorkflowExecution(ctx, args.StartRequest)
+	appErr := h.impl.ResetActivityAttempts(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_ResetActivityAttempts_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ResetQueue_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'ResetQueue': %w", err)
+	}
+
+	appErr := h.impl.ResetQueue(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_ResetQueue_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ResetStickyTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ResetStickyTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'ResetStickyTaskList': %w", err)
+	}
+
+	success, appErr := h.impl.ResetStickyTaskList(ctx, args.ResetRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_ResetStickyTaskList_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ResetWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ResetWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'ResetWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.ResetWorkflowExecution(ctx, args.ResetRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_ResetWorkflowExecution_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondActivityTaskCanceled(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RespondActivityTaskCanceled_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'RespondActivityTaskCanceled': %w", err)
+	}
+
+	appErr := h.impl.RespondActivityTaskCanceled(ctx, args.CanceledRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RespondActivityTaskCanceled_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondActivityTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RespondActivityTaskCompleted_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'RespondActivityTaskCompleted': %w", err)
+	}
+
+	appErr := h.impl.RespondActivityTaskCompleted(ctx, args.CompleteRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RespondActivityTaskCompleted_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondActivityTaskFailed(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RespondActivityTaskFailed_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'RespondActivityTaskFailed': %w", err)
+	}
+
+	appErr := h.impl.RespondActivityTaskFailed(ctx, args.FailRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RespondActivityTaskFailed_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondActivityTaskNack(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RespondActivityTaskNack_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'RespondActivityTaskNack': %w", err)
+	}
+
+	appErr := h.impl.RespondActivityTaskNack(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RespondActivityTaskNack_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondCrossClusterTasksCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RespondCrossClusterTasksCompleted_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'RespondCrossClusterTasksCompleted': %w", err)
+	}
+
+	success, appErr := h.impl.RespondCrossClusterTasksCompleted(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RespondCrossClusterTasksCompleted_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondDecisionTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RespondDecisionTaskCompleted_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'RespondDecisionTaskCompleted': %w", err)
+	}
+
+	success, appErr := h.impl.RespondDecisionTaskCompleted(ctx, args.CompleteRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RespondDecisionTaskCompleted_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondDecisionTaskFailed(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RespondDecisionTaskFailed_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'RespondDecisionTaskFailed': %w", err)
+	}
+
+	appErr := h.impl.RespondDecisionTaskFailed(ctx, args.FailedRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RespondDecisionTaskFailed_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ScheduleDecisionTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ScheduleDecisionTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'ScheduleDecisionTask': %w", err)
+	}
+
+	appErr := h.impl.ScheduleDecisionTask(ctx, args.ScheduleRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_ScheduleDecisionTask_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) SignalActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_SignalActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'SignalActivity': %w", err)
+	}
+
+	appErr := h.impl.SignalActivity(ctx, args.SignalRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_SignalActivity_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) SignalWithStartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_SignalWithStartWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'SignalWithStartWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.SignalWithStartWorkflowExecution(ctx, args.SignalWithStartRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_SignalWithStartWorkflowExecution_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) SignalWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_SignalWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'SignalWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.SignalWorkflowExecution(ctx, args.SignalRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_SignalWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) StartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_StartWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'StartWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.StartWorkflowExecution(ctx, args.StartRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_StartWorkflowExecution_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) SyncActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_SyncActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'SyncActivity': %w", err)
+	}
+
+	appErr := h.impl.SyncActivity(ctx, args.SyncActivityRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_SyncActivity_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) SyncShardStatus(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_SyncShardStatus_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'SyncShardStatus': %w", err)
+	}
+
+	appErr := h.impl.SyncShardStatus(ctx, args.SyncShardStatusRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_SyncShardStatus_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_TerminateWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'TerminateWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.TerminateWorkflowExecution(ctx, args.TerminateRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_TerminateWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+type batchdescribeworkflowexecutions_NoWireHandler struct{ impl Interface }
+
+func (h batchdescribeworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_BatchDescribeWorkflowExecutions_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'BatchDescribeWorkflowExecutions': %w", err)
+	}
+
+	success, appErr := h.impl.BatchDescribeWorkflowExecutions(ctx, args.DescribeRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_BatchDescribeWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type cancelpendingactivities_NoWireHandler struct{ impl Interface }
+
+func (h cancelpendingactivities_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_CancelPendingActivities_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'CancelPendingActivities': %w", err)
+	}
+
+	appErr := h.impl.CancelPendingActivities(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_CancelPendingActivities_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type closeshard_NoWireHandler struct{ impl Interface }
+
+func (h closeshard_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_CloseShard_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'CloseShard': %w", err)
+	}
+
+	appErr := h.impl.CloseShard(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_CloseShard_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describehistoryhost_NoWireHandler struct{ impl Interface }
+
+func (h describehistoryhost_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_DescribeHistoryHost_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'DescribeHistoryHost': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeHistoryHost(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_DescribeHistoryHost_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describemutablestate_NoWireHandler struct{ impl Interface }
+
+func (h describemutablestate_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_DescribeMutableState_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'DescribeMutableState': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeMutableState(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_DescribeMutableState_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describequeue_NoWireHandler struct{ impl Interface }
+
+func (h describequeue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_DescribeQueue_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'DescribeQueue': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeQueue(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_DescribeQueue_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describeworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_DescribeWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'DescribeWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeWorkflowExecution(ctx, args.DescribeRequest)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_StartWorkflowExecution_Helper.WrapResponse(success, appErr)
-
-	var response thrift.Response
+	result, err := history.HistoryService_DescribeWorkflowExecution_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
 		response.Body = result
@@ -1993,23 +3047,30 @@ func (h handler) StartWorkflowExecution(ctx context.Context, body wire.Value) (t
 			response.ApplicationErrorDetails = appErr.Error()
 		}
 	}
-
 	return response, err
+
 }
 
-func (h handler) SyncActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_SyncActivity_Args
-	if err := args.FromWire(body); err != nil {
-		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'SyncActivity': %w", err)
+type expireactivityheartbeat_NoWireHandler struct{ impl Interface }
+
+func (h expireactivityheartbeat_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_ExpireActivityHeartbeat_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'ExpireActivityHeartbeat': %w", err)
 	}
 
-	appErr := h.impl.SyncActivity(ctx, args.SyncActivityRequest)
+	appErr := h.impl.ExpireActivityHeartbeat(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_SyncActivity_Helper.WrapResponse(appErr)
-
-	var response thrift.Response
+	result, err := history.HistoryService_ExpireActivityHeartbeat_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
 		response.Body = result
@@ -2023,23 +3084,30 @@ func (h handler) SyncActivity(ctx context.Context, body wire.Value) (thrift.Resp
 			response.ApplicationErrorDetails = appErr.Error()
 		}
 	}
-
 	return response, err
+
 }
 
-func (h handler) SyncShardStatus(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_SyncShardStatus_Args
-	if err := args.FromWire(body); err != nil {
-		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'SyncShardStatus': %w", err)
+type getactivityattempttimings_NoWireHandler struct{ impl Interface }
+
+func (h getactivityattempttimings_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_GetActivityAttemptTimings_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'GetActivityAttemptTimings': %w", err)
 	}
 
-	appErr := h.impl.SyncShardStatus(ctx, args.SyncShardStatusRequest)
+	success, appErr := h.impl.GetActivityAttemptTimings(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_SyncShardStatus_Helper.WrapResponse(appErr)
-
-	var response thrift.Response
+	result, err := history.HistoryService_GetActivityAttemptTimings_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
 		response.Body = result
@@ -2053,23 +3121,30 @@ func (h handler) SyncShardStatus(ctx context.Context, body wire.Value) (thrift.R
 			response.ApplicationErrorDetails = appErr.Error()
 		}
 	}
-
 	return response, err
+
 }
 
-func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_TerminateWorkflowExecution_Args
-	if err := args.FromWire(body); err != nil {
-		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'TerminateWorkflowExecution': %w", err)
+type getactivityeffectiveconfig_NoWireHandler struct{ impl Interface }
+
+func (h getactivityeffectiveconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_GetActivityEffectiveConfig_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'GetActivityEffectiveConfig': %w", err)
 	}
 
-	appErr := h.impl.TerminateWorkflowExecution(ctx, args.TerminateRequest)
+	success, appErr := h.impl.GetActivityEffectiveConfig(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_TerminateWorkflowExecution_Helper.WrapResponse(appErr)
-
-	var response thrift.Response
+	result, err := history.HistoryService_GetActivityEffectiveConfig_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
 		response.Body = result
@@ -2083,15 +3158,15 @@ func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value
 			response.ApplicationErrorDetails = appErr.Error()
 		}
 	}
-
 	return response, err
+
 }
 
-type closeshard_NoWireHandler struct{ impl Interface }
+type getactivitynextretrytime_NoWireHandler struct{ impl Interface }
 
-func (h closeshard_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getactivitynextretrytime_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args history.HistoryService_CloseShard_Args
+		args history.HistoryService_GetActivityNextRetryTime_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2099,13 +3174,13 @@ func (h closeshard_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'CloseShard': %w", err)
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'GetActivityNextRetryTime': %w", err)
 	}
 
-	appErr := h.impl.CloseShard(ctx, args.Request)
+	success, appErr := h.impl.GetActivityNextRetryTime(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_CloseShard_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_GetActivityNextRetryTime_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2124,11 +3199,11 @@ func (h closeshard_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.
 
 }
 
-type describehistoryhost_NoWireHandler struct{ impl Interface }
+type getactivityreschedulereasons_NoWireHandler struct{ impl Interface }
 
-func (h describehistoryhost_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getactivityreschedulereasons_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args history.HistoryService_DescribeHistoryHost_Args
+		args history.HistoryService_GetActivityRescheduleReasons_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2136,13 +3211,13 @@ func (h describehistoryhost_NoWireHandler) HandleNoWire(ctx context.Context, nwc
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'DescribeHistoryHost': %w", err)
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'GetActivityRescheduleReasons': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeHistoryHost(ctx, args.Request)
+	success, appErr := h.impl.GetActivityRescheduleReasons(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_DescribeHistoryHost_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_GetActivityRescheduleReasons_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2161,11 +3236,11 @@ func (h describehistoryhost_NoWireHandler) HandleNoWire(ctx context.Context, nwc
 
 }
 
-type describemutablestate_NoWireHandler struct{ impl Interface }
+type getactivityretrystats_NoWireHandler struct{ impl Interface }
 
-func (h describemutablestate_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getactivityretrystats_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args history.HistoryService_DescribeMutableState_Args
+		args history.HistoryService_GetActivityRetryStats_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2173,13 +3248,13 @@ func (h describemutablestate_NoWireHandler) HandleNoWire(ctx context.Context, nw
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'DescribeMutableState': %w", err)
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'GetActivityRetryStats': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeMutableState(ctx, args.Request)
+	success, appErr := h.impl.GetActivityRetryStats(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_DescribeMutableState_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_GetActivityRetryStats_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2198,11 +3273,11 @@ func (h describemutablestate_NoWireHandler) HandleNoWire(ctx context.Context, nw
 
 }
 
-type describequeue_NoWireHandler struct{ impl Interface }
+type getactivitytokenvalidationfailures_NoWireHandler struct{ impl Interface }
 
-func (h describequeue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getactivitytokenvalidationfailures_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args history.HistoryService_DescribeQueue_Args
+		args history.HistoryService_GetActivityTokenValidationFailures_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2210,13 +3285,13 @@ func (h describequeue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thri
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'DescribeQueue': %w", err)
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'GetActivityTokenValidationFailures': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeQueue(ctx, args.Request)
+	success, appErr := h.impl.GetActivityTokenValidationFailures(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_DescribeQueue_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_GetActivityTokenValidationFailures_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2235,11 +3310,11 @@ func (h describequeue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thri
 
 }
 
-type describeworkflowexecution_NoWireHandler struct{ impl Interface }
+type getactivityworkerhistory_NoWireHandler struct{ impl Interface }
 
-func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getactivityworkerhistory_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args history.HistoryService_DescribeWorkflowExecution_Args
+		args history.HistoryService_GetActivityWorkerHistory_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2247,13 +3322,13 @@ func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Contex
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'DescribeWorkflowExecution': %w", err)
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'GetActivityWorkerHistory': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeWorkflowExecution(ctx, args.DescribeRequest)
+	success, appErr := h.impl.GetActivityWorkerHistory(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_DescribeWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_GetActivityWorkerHistory_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2901,6 +3976,43 @@ func (h recorddecisiontaskstarted_NoWireHandler) HandleNoWire(ctx context.Contex
 
 }
 
+type redrivetimedoutactivities_NoWireHandler struct{ impl Interface }
+
+func (h redrivetimedoutactivities_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_RedriveTimedOutActivities_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'RedriveTimedOutActivities': %w", err)
+	}
+
+	appErr := h.impl.RedriveTimedOutActivities(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RedriveTimedOutActivities_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type refreshworkflowtasks_NoWireHandler struct{ impl Interface }
 
 func (h refreshworkflowtasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3012,6 +4124,43 @@ func (h removetask_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.
 
 }
 
+type replayactivitycompletions_NoWireHandler struct{ impl Interface }
+
+func (h replayactivitycompletions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_ReplayActivityCompletions_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'ReplayActivityCompletions': %w", err)
+	}
+
+	success, appErr := h.impl.ReplayActivityCompletions(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_ReplayActivityCompletions_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type replicateeventsv2_NoWireHandler struct{ impl Interface }
 
 func (h replicateeventsv2_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3086,6 +4235,43 @@ func (h requestcancelworkflowexecution_NoWireHandler) HandleNoWire(ctx context.C
 
 }
 
+type resetactivityattempts_NoWireHandler struct{ impl Interface }
+
+func (h resetactivityattempts_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_ResetActivityAttempts_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'ResetActivityAttempts': %w", err)
+	}
+
+	appErr := h.impl.ResetActivityAttempts(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_ResetActivityAttempts_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type resetqueue_NoWireHandler struct{ impl Interface }
 
 func (h resetqueue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3308,6 +4494,43 @@ func (h respondactivitytaskfailed_NoWireHandler) HandleNoWire(ctx context.Contex
 
 }
 
+type respondactivitytasknack_NoWireHandler struct{ impl Interface }
+
+func (h respondactivitytasknack_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_RespondActivityTaskNack_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'RespondActivityTaskNack': %w", err)
+	}
+
+	appErr := h.impl.RespondActivityTaskNack(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RespondActivityTaskNack_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type respondcrossclustertaskscompleted_NoWireHandler struct{ impl Interface }
 
 func (h respondcrossclustertaskscompleted_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3456,6 +4679,43 @@ func (h scheduledecisiontask_NoWireHandler) HandleNoWire(ctx context.Context, nw
 
 }
 
+type signalactivity_NoWireHandler struct{ impl Interface }
+
+func (h signalactivity_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_SignalActivity_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'SignalActivity': %w", err)
+	}
+
+	appErr := h.impl.SignalActivity(ctx, args.SignalRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_SignalActivity_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type signalwithstartworkflowexecution_NoWireHandler struct{ impl Interface }
 
 func (h signalwithstartworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -68,6 +68,70 @@ func (m *MockClient) EXPECT() *_MockClientRecorder {
 	return m.recorder
 }
 
+// BatchDescribeWorkflowExecutions responds to a BatchDescribeWorkflowExecutions call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().BatchDescribeWorkflowExecutions(gomock.Any(), ...).Return(...)
+//	... := client.BatchDescribeWorkflowExecutions(...)
+func (m *MockClient) BatchDescribeWorkflowExecutions(
+	ctx context.Context,
+	_DescribeRequest *history.BatchDescribeWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.BatchDescribeWorkflowExecutionsResponse, err error) {
+
+	args := []interface{}{ctx, _DescribeRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "BatchDescribeWorkflowExecutions", args...)
+	success, _ = ret[i].(*shared.BatchDescribeWorkflowExecutionsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) BatchDescribeWorkflowExecutions(
+	ctx interface{},
+	_DescribeRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _DescribeRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "BatchDescribeWorkflowExecutions", args...)
+}
+
+// CancelPendingActivities responds to a CancelPendingActivities call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().CancelPendingActivities(gomock.Any(), ...).Return(...)
+//	... := client.CancelPendingActivities(...)
+func (m *MockClient) CancelPendingActivities(
+	ctx context.Context,
+	_Request *history.CancelPendingActivitiesRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "CancelPendingActivities", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) CancelPendingActivities(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "CancelPendingActivities", args...)
+}
+
 // CloseShard responds to a CloseShard call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -231,6 +295,268 @@ func (mr *_MockClientRecorder) DescribeWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeWorkflowExecution", args...)
 }
 
+// ExpireActivityHeartbeat responds to a ExpireActivityHeartbeat call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ExpireActivityHeartbeat(gomock.Any(), ...).Return(...)
+//	... := client.ExpireActivityHeartbeat(...)
+func (m *MockClient) ExpireActivityHeartbeat(
+	ctx context.Context,
+	_Request *history.ExpireActivityHeartbeatRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ExpireActivityHeartbeat", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ExpireActivityHeartbeat(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ExpireActivityHeartbeat", args...)
+}
+
+// GetActivityAttemptTimings responds to a GetActivityAttemptTimings call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetActivityAttemptTimings(gomock.Any(), ...).Return(...)
+//	... := client.GetActivityAttemptTimings(...)
+func (m *MockClient) GetActivityAttemptTimings(
+	ctx context.Context,
+	_Request *history.GetActivityAttemptTimingsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityAttemptTimingsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetActivityAttemptTimings", args...)
+	success, _ = ret[i].(*shared.GetActivityAttemptTimingsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetActivityAttemptTimings(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetActivityAttemptTimings", args...)
+}
+
+// GetActivityEffectiveConfig responds to a GetActivityEffectiveConfig call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetActivityEffectiveConfig(gomock.Any(), ...).Return(...)
+//	... := client.GetActivityEffectiveConfig(...)
+func (m *MockClient) GetActivityEffectiveConfig(
+	ctx context.Context,
+	_Request *history.GetActivityEffectiveConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityEffectiveConfigResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetActivityEffectiveConfig", args...)
+	success, _ = ret[i].(*shared.GetActivityEffectiveConfigResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetActivityEffectiveConfig(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetActivityEffectiveConfig", args...)
+}
+
+// GetActivityNextRetryTime responds to a GetActivityNextRetryTime call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetActivityNextRetryTime(gomock.Any(), ...).Return(...)
+//	... := client.GetActivityNextRetryTime(...)
+func (m *MockClient) GetActivityNextRetryTime(
+	ctx context.Context,
+	_Request *history.GetActivityNextRetryTimeRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityNextRetryTimeResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetActivityNextRetryTime", args...)
+	success, _ = ret[i].(*shared.GetActivityNextRetryTimeResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetActivityNextRetryTime(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetActivityNextRetryTime", args...)
+}
+
+// GetActivityRescheduleReasons responds to a GetActivityRescheduleReasons call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetActivityRescheduleReasons(gomock.Any(), ...).Return(...)
+//	... := client.GetActivityRescheduleReasons(...)
+func (m *MockClient) GetActivityRescheduleReasons(
+	ctx context.Context,
+	_Request *history.GetActivityRescheduleReasonsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityRescheduleReasonsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetActivityRescheduleReasons", args...)
+	success, _ = ret[i].(*shared.GetActivityRescheduleReasonsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetActivityRescheduleReasons(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetActivityRescheduleReasons", args...)
+}
+
+// GetActivityRetryStats responds to a GetActivityRetryStats call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetActivityRetryStats(gomock.Any(), ...).Return(...)
+//	... := client.GetActivityRetryStats(...)
+func (m *MockClient) GetActivityRetryStats(
+	ctx context.Context,
+	_Request *history.GetActivityRetryStatsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityRetryStatsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetActivityRetryStats", args...)
+	success, _ = ret[i].(*shared.GetActivityRetryStatsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetActivityRetryStats(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetActivityRetryStats", args...)
+}
+
+// GetActivityTokenValidationFailures responds to a GetActivityTokenValidationFailures call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetActivityTokenValidationFailures(gomock.Any(), ...).Return(...)
+//	... := client.GetActivityTokenValidationFailures(...)
+func (m *MockClient) GetActivityTokenValidationFailures(
+	ctx context.Context,
+	_Request *history.GetActivityTokenValidationFailuresRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityTokenValidationFailuresResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetActivityTokenValidationFailures", args...)
+	success, _ = ret[i].(*shared.GetActivityTokenValidationFailuresResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetActivityTokenValidationFailures(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetActivityTokenValidationFailures", args...)
+}
+
+// GetActivityWorkerHistory responds to a GetActivityWorkerHistory call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetActivityWorkerHistory(gomock.Any(), ...).Return(...)
+//	... := client.GetActivityWorkerHistory(...)
+func (m *MockClient) GetActivityWorkerHistory(
+	ctx context.Context,
+	_Request *history.GetActivityWorkerHistoryRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetActivityWorkerHistoryResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetActivityWorkerHistory", args...)
+	success, _ = ret[i].(*shared.GetActivityWorkerHistoryResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetActivityWorkerHistory(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetActivityWorkerHistory", args...)
+}
+
 // GetCrossClusterTasks responds to a GetCrossClusterTasks call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -784,6 +1110,37 @@ func (mr *_MockClientRecorder) RecordDecisionTaskStarted(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RecordDecisionTaskStarted", args...)
 }
 
+// RedriveTimedOutActivities responds to a RedriveTimedOutActivities call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RedriveTimedOutActivities(gomock.Any(), ...).Return(...)
+//	... := client.RedriveTimedOutActivities(...)
+func (m *MockClient) RedriveTimedOutActivities(
+	ctx context.Context,
+	_Request *history.RedriveTimedOutActivitiesRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RedriveTimedOutActivities", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RedriveTimedOutActivities(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RedriveTimedOutActivities", args...)
+}
+
 // RefreshWorkflowTasks responds to a RefreshWorkflowTasks call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -877,6 +1234,39 @@ func (mr *_MockClientRecorder) RemoveTask(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RemoveTask", args...)
 }
 
+// ReplayActivityCompletions responds to a ReplayActivityCompletions call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ReplayActivityCompletions(gomock.Any(), ...).Return(...)
+//	... := client.ReplayActivityCompletions(...)
+func (m *MockClient) ReplayActivityCompletions(
+	ctx context.Context,
+	_Request *history.ReplayActivityCompletionsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ReplayActivityCompletionsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReplayActivityCompletions", args...)
+	success, _ = ret[i].(*shared.ReplayActivityCompletionsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReplayActivityCompletions(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReplayActivityCompletions", args...)
+}
+
 // ReplicateEventsV2 responds to a ReplicateEventsV2 call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -939,6 +1329,37 @@ func (mr *_MockClientRecorder) RequestCancelWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RequestCancelWorkflowExecution", args...)
 }
 
+// ResetActivityAttempts responds to a ResetActivityAttempts call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ResetActivityAttempts(gomock.Any(), ...).Return(...)
+//	... := client.ResetActivityAttempts(...)
+func (m *MockClient) ResetActivityAttempts(
+	ctx context.Context,
+	_Request *history.ResetActivityAttemptsRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResetActivityAttempts", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResetActivityAttempts(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResetActivityAttempts", args...)
+}
+
 // ResetQueue responds to a ResetQueue call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1129,6 +1550,37 @@ func (mr *_MockClientRecorder) RespondActivityTaskFailed(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RespondActivityTaskFailed", args...)
 }
 
+// RespondActivityTaskNack responds to a RespondActivityTaskNack call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RespondActivityTaskNack(gomock.Any(), ...).Return(...)
+//	... := client.RespondActivityTaskNack(...)
+func (m *MockClient) RespondActivityTaskNack(
+	ctx context.Context,
+	_Request *history.RespondActivityTaskNackRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RespondActivityTaskNack", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RespondActivityTaskNack(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RespondActivityTaskNack", args...)
+}
+
 // RespondCrossClusterTasksCompleted responds to a RespondCrossClusterTasksCompleted call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1257,6 +1709,37 @@ func (mr *_MockClientRecorder) ScheduleDecisionTask(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ScheduleDecisionTask", args...)
 }
 
+// SignalActivity responds to a SignalActivity call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().SignalActivity(gomock.Any(), ...).Return(...)
+//	... := client.SignalActivity(...)
+func (m *MockClient) SignalActivity(
+	ctx context.Context,
+	_SignalRequest *history.SignalActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _SignalRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "SignalActivity", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) SignalActivity(
+	ctx interface{},
+	_SignalRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _SignalRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "SignalActivity", args...)
+}
+
 // SignalWithStartWorkflowExecution responds to a SignalWithStartWorkflowExecution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -745,6 +745,7 @@ type AddActivityTaskRequest struct {
 	ForwardedFrom                 *string                   `json:"forwardedFrom,omitempty"`
 	ActivityTaskDispatchInfo      *ActivityTaskDispatchInfo `json:"activityTaskDispatchInfo,omitempty"`
 	PartitionConfig               map[string]string         `json:"partitionConfig,omitempty"`
+	Priority                      *int32                    `json:"priority,omitempty"`
 }
 
 type _Map_String_String_MapItemList map[string]string
@@ -799,7 +800,7 @@ func (_Map_String_String_MapItemList) Close() {}
 //	}
 func (v *AddActivityTaskRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [10]wire.Field
+		fields [11]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -885,6 +886,14 @@ func (v *AddActivityTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 90, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -1054,6 +1063,16 @@ func (v *AddActivityTaskRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 100:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -1213,6 +1232,18 @@ func (v *AddActivityTaskRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Priority != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Priority)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -1370,6 +1401,14 @@ func (v *AddActivityTaskRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 100 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Priority = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -1399,7 +1438,7 @@ func (v *AddActivityTaskRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [10]string
+	var fields [11]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
@@ -1441,6 +1480,10 @@ func (v *AddActivityTaskRequest) String() string {
 		fields[i] = fmt.Sprintf("PartitionConfig: %v", v.PartitionConfig)
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
 
 	return fmt.Sprintf("AddActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -1522,6 +1565,9 @@ func (v *AddActivityTaskRequest) Equals(rhs *AddActivityTaskRequest) bool {
 	if !((v.PartitionConfig == nil && rhs.PartitionConfig == nil) || (v.PartitionConfig != nil && rhs.PartitionConfig != nil && _Map_String_String_Equals(v.PartitionConfig, rhs.PartitionConfig))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
 
 	return true
 }
@@ -1573,6 +1619,9 @@ func (v *AddActivityTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (er
 	if v.PartitionConfig != nil {
 		err = multierr.Append(err, enc.AddObject("partitionConfig", (_Map_String_String_Zapper)(v.PartitionConfig)))
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
 	return err
 }
 
@@ -1726,6 +1775,21 @@ func (v *AddActivityTaskRequest) IsSetPartitionConfig() bool {
 	return v != nil && v.PartitionConfig != nil
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *AddActivityTaskRequest) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *AddActivityTaskRequest) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
 type AddDecisionTaskRequest struct {
 	DomainUUID                    *string                   `json:"domainUUID,omitempty"`
 	Execution                     *shared.WorkflowExecution `json:"execution,omitempty"`
@@ -6208,14 +6272,13 @@ func (v *QueryWorkflowRequest) IsSetForwardedFrom() bool {
 	return v != nil && v.ForwardedFrom != nil
 }
 
-type RespondQueryTaskCompletedRequest struct {
-	DomainUUID       *string                                  `json:"domainUUID,omitempty"`
-	TaskList         *shared.TaskList                         `json:"taskList,omitempty"`
-	TaskID           *string                                  `json:"taskID,omitempty"`
-	CompletedRequest *shared.RespondQueryTaskCompletedRequest `json:"completedRequest,omitempty"`
+type RecordBackpressureHintRequest struct {
+	DomainUUID *string          `json:"domainUUID,omitempty"`
+	TaskList   *shared.TaskList `json:"taskList,omitempty"`
+	Hint       *float64         `json:"hint,omitempty"`
 }
 
-// ToWire translates a RespondQueryTaskCompletedRequest struct into a Thrift-level intermediate
+// ToWire translates a RecordBackpressureHintRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6230,9 +6293,9 @@ type RespondQueryTaskCompletedRequest struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *RespondQueryTaskCompletedRequest) ToWire() (wire.Value, error) {
+func (v *RecordBackpressureHintRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -6254,37 +6317,23 @@ func (v *RespondQueryTaskCompletedRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.TaskID != nil {
-		w, err = wire.NewValueString(*(v.TaskID)), error(nil)
+	if v.Hint != nil {
+		w, err = wire.NewValueDouble(*(v.Hint)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.CompletedRequest != nil {
-		w, err = v.CompletedRequest.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondQueryTaskCompletedRequest_Read(w wire.Value) (*shared.RespondQueryTaskCompletedRequest, error) {
-	var v shared.RespondQueryTaskCompletedRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a RespondQueryTaskCompletedRequest struct from its Thrift-level
+// FromWire deserializes a RecordBackpressureHintRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondQueryTaskCompletedRequest struct
+// An error is returned if we were unable to build a RecordBackpressureHintRequest struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6292,12 +6341,12 @@ func _RespondQueryTaskCompletedRequest_Read(w wire.Value) (*shared.RespondQueryT
 //	  return nil, err
 //	}
 //
-//	var v RespondQueryTaskCompletedRequest
+//	var v RecordBackpressureHintRequest
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
+func (v *RecordBackpressureHintRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -6321,18 +6370,10 @@ func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.TaskID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.CompletedRequest, err = _RespondQueryTaskCompletedRequest_Read(field.Value)
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.Hint = &x
 				if err != nil {
 					return err
 				}
@@ -6344,11 +6385,11 @@ func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a RespondQueryTaskCompletedRequest struct directly into bytes, without going
+// Encode serializes a RecordBackpressureHintRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a RespondQueryTaskCompletedRequest struct could not be encoded.
-func (v *RespondQueryTaskCompletedRequest) Encode(sw stream.Writer) error {
+// An error is returned if a RecordBackpressureHintRequest struct could not be encoded.
+func (v *RecordBackpressureHintRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -6377,23 +6418,11 @@ func (v *RespondQueryTaskCompletedRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.TaskID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.TaskID)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.CompletedRequest != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
+	if v.Hint != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TDouble}); err != nil {
 			return err
 		}
-		if err := v.CompletedRequest.Encode(sw); err != nil {
+		if err := sw.WriteDouble(*(v.Hint)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -6404,18 +6433,12 @@ func (v *RespondQueryTaskCompletedRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _RespondQueryTaskCompletedRequest_Decode(sr stream.Reader) (*shared.RespondQueryTaskCompletedRequest, error) {
-	var v shared.RespondQueryTaskCompletedRequest
-	err := v.Decode(sr)
-	return &v, err
-}
-
-// Decode deserializes a RespondQueryTaskCompletedRequest struct directly from its Thrift-level
+// Decode deserializes a RecordBackpressureHintRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RespondQueryTaskCompletedRequest struct could not be generated from the wire
+// An error is returned if a RecordBackpressureHintRequest struct could not be generated from the wire
 // representation.
-func (v *RespondQueryTaskCompletedRequest) Decode(sr stream.Reader) error {
+func (v *RecordBackpressureHintRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -6442,16 +6465,10 @@ func (v *RespondQueryTaskCompletedRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.TaskID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 40 && fh.Type == wire.TStruct:
-			v.CompletedRequest, err = _RespondQueryTaskCompletedRequest_Decode(sr)
+		case fh.ID == 30 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.Hint = &x
 			if err != nil {
 				return err
 			}
@@ -6478,14 +6495,14 @@ func (v *RespondQueryTaskCompletedRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondQueryTaskCompletedRequest
+// String returns a readable string representation of a RecordBackpressureHintRequest
 // struct.
-func (v *RespondQueryTaskCompletedRequest) String() string {
+func (v *RecordBackpressureHintRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [3]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
@@ -6495,23 +6512,29 @@ func (v *RespondQueryTaskCompletedRequest) String() string {
 		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
 		i++
 	}
-	if v.TaskID != nil {
-		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
-		i++
-	}
-	if v.CompletedRequest != nil {
-		fields[i] = fmt.Sprintf("CompletedRequest: %v", v.CompletedRequest)
+	if v.Hint != nil {
+		fields[i] = fmt.Sprintf("Hint: %v", *(v.Hint))
 		i++
 	}
 
-	return fmt.Sprintf("RespondQueryTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RecordBackpressureHintRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondQueryTaskCompletedRequest match the
-// provided RespondQueryTaskCompletedRequest.
+func _Double_EqualsPtr(lhs, rhs *float64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this RecordBackpressureHintRequest match the
+// provided RecordBackpressureHintRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompletedRequest) bool {
+func (v *RecordBackpressureHintRequest) Equals(rhs *RecordBackpressureHintRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -6523,10 +6546,7 @@ func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompleted
 	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.TaskID, rhs.TaskID) {
-		return false
-	}
-	if !((v.CompletedRequest == nil && rhs.CompletedRequest == nil) || (v.CompletedRequest != nil && rhs.CompletedRequest != nil && v.CompletedRequest.Equals(rhs.CompletedRequest))) {
+	if !_Double_EqualsPtr(v.Hint, rhs.Hint) {
 		return false
 	}
 
@@ -6534,8 +6554,8 @@ func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompleted
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondQueryTaskCompletedRequest.
-func (v *RespondQueryTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RecordBackpressureHintRequest.
+func (v *RecordBackpressureHintRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -6545,18 +6565,15 @@ func (v *RespondQueryTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEn
 	if v.TaskList != nil {
 		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
 	}
-	if v.TaskID != nil {
-		enc.AddString("taskID", *v.TaskID)
-	}
-	if v.CompletedRequest != nil {
-		err = multierr.Append(err, enc.AddObject("completedRequest", v.CompletedRequest))
+	if v.Hint != nil {
+		enc.AddFloat64("hint", *v.Hint)
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetDomainUUID() (o string) {
+func (v *RecordBackpressureHintRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -6565,13 +6582,13 @@ func (v *RespondQueryTaskCompletedRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetDomainUUID() bool {
+func (v *RecordBackpressureHintRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
 // GetTaskList returns the value of TaskList if it is set or its
 // zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetTaskList() (o *shared.TaskList) {
+func (v *RecordBackpressureHintRequest) GetTaskList() (o *shared.TaskList) {
 	if v != nil && v.TaskList != nil {
 		return v.TaskList
 	}
@@ -6580,261 +6597,1794 @@ func (v *RespondQueryTaskCompletedRequest) GetTaskList() (o *shared.TaskList) {
 }
 
 // IsSetTaskList returns true if TaskList is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetTaskList() bool {
+func (v *RecordBackpressureHintRequest) IsSetTaskList() bool {
 	return v != nil && v.TaskList != nil
 }
 
-// GetTaskID returns the value of TaskID if it is set or its
+// GetHint returns the value of Hint if it is set or its
 // zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetTaskID() (o string) {
-	if v != nil && v.TaskID != nil {
-		return *v.TaskID
+func (v *RecordBackpressureHintRequest) GetHint() (o float64) {
+	if v != nil && v.Hint != nil {
+		return *v.Hint
 	}
 
 	return
 }
 
-// IsSetTaskID returns true if TaskID is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetTaskID() bool {
-	return v != nil && v.TaskID != nil
-}
-
-// GetCompletedRequest returns the value of CompletedRequest if it is set or its
-// zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetCompletedRequest() (o *shared.RespondQueryTaskCompletedRequest) {
-	if v != nil && v.CompletedRequest != nil {
-		return v.CompletedRequest
-	}
-
-	return
+// IsSetHint returns true if Hint is not nil.
+func (v *RecordBackpressureHintRequest) IsSetHint() bool {
+	return v != nil && v.Hint != nil
 }
 
-// IsSetCompletedRequest returns true if CompletedRequest is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetCompletedRequest() bool {
-	return v != nil && v.CompletedRequest != nil
+type RespondQueryTaskCompletedRequest struct {
+	DomainUUID       *string                                  `json:"domainUUID,omitempty"`
+	TaskList         *shared.TaskList                         `json:"taskList,omitempty"`
+	TaskID           *string                                  `json:"taskID,omitempty"`
+	CompletedRequest *shared.RespondQueryTaskCompletedRequest `json:"completedRequest,omitempty"`
 }
 
-type TaskSource int32
-
-const (
-	TaskSourceHistory   TaskSource = 0
-	TaskSourceDbBacklog TaskSource = 1
-)
+// ToWire translates a RespondQueryTaskCompletedRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *RespondQueryTaskCompletedRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
 
-// TaskSource_Values returns all recognized values of TaskSource.
-func TaskSource_Values() []TaskSource {
-	return []TaskSource{
-		TaskSourceHistory,
-		TaskSourceDbBacklog,
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
 	}
-}
-
-// UnmarshalText tries to decode TaskSource from a byte slice
-// containing its name.
-//
-//	var v TaskSource
-//	err := v.UnmarshalText([]byte("HISTORY"))
-func (v *TaskSource) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "HISTORY":
-		*v = TaskSourceHistory
-		return nil
-	case "DB_BACKLOG":
-		*v = TaskSourceDbBacklog
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
 		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "TaskSource", err)
+			return w, err
 		}
-		*v = TaskSource(val)
-		return nil
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
-}
-
-// MarshalText encodes TaskSource to text.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements the TextMarshaler interface.
-func (v TaskSource) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return []byte("HISTORY"), nil
-	case 1:
-		return []byte("DB_BACKLOG"), nil
+	if v.TaskID != nil {
+		w, err = wire.NewValueString(*(v.TaskID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
 	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of TaskSource.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v TaskSource) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 0:
-		enc.AddString("name", "HISTORY")
-	case 1:
-		enc.AddString("name", "DB_BACKLOG")
+	if v.CompletedRequest != nil {
+		w, err = v.CompletedRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
 	}
-	return nil
-}
 
-// Ptr returns a pointer to this enum value.
-func (v TaskSource) Ptr() *TaskSource {
-	return &v
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// Encode encodes TaskSource directly to bytes.
-//
-//	sWriter := BinaryStreamer.Writer(writer)
-//
-//	var v TaskSource
-//	return v.Encode(sWriter)
-func (v TaskSource) Encode(sw stream.Writer) error {
-	return sw.WriteInt32(int32(v))
+func _RespondQueryTaskCompletedRequest_Read(w wire.Value) (*shared.RespondQueryTaskCompletedRequest, error) {
+	var v shared.RespondQueryTaskCompletedRequest
+	err := v.FromWire(w)
+	return &v, err
 }
 
-// ToWire translates TaskSource into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
+// FromWire deserializes a RespondQueryTaskCompletedRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
 //
-// Enums are represented as 32-bit integers over the wire.
-func (v TaskSource) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
-}
-
-// FromWire deserializes TaskSource from its Thrift-level
-// representation.
+// An error is returned if we were unable to build a RespondQueryTaskCompletedRequest struct
+// from the provided intermediate representation.
 //
-//	x, err := binaryProtocol.Decode(reader, wire.TI32)
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
 //	if err != nil {
-//	  return TaskSource(0), err
+//	  return nil, err
 //	}
 //
-//	var v TaskSource
+//	var v RespondQueryTaskCompletedRequest
 //	if err := v.FromWire(x); err != nil {
-//	  return TaskSource(0), err
+//	  return nil, err
 //	}
-//	return v, nil
-func (v *TaskSource) FromWire(w wire.Value) error {
-	*v = (TaskSource)(w.GetI32())
+//	return &v, nil
+func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.CompletedRequest, err = _RespondQueryTaskCompletedRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
 	return nil
 }
 
-// Decode reads off the encoded TaskSource directly off of the wire.
-//
-//	sReader := BinaryStreamer.Reader(reader)
+// Encode serializes a RespondQueryTaskCompletedRequest struct directly into bytes, without going
+// through an intermediary type.
 //
-//	var v TaskSource
-//	if err := v.Decode(sReader); err != nil {
-//	  return TaskSource(0), err
-//	}
-//	return v, nil
-func (v *TaskSource) Decode(sr stream.Reader) error {
-	i, err := sr.ReadInt32()
-	if err != nil {
+// An error is returned if a RespondQueryTaskCompletedRequest struct could not be encoded.
+func (v *RespondQueryTaskCompletedRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
-	*v = (TaskSource)(i)
-	return nil
-}
 
-// String returns a readable string representation of TaskSource.
-func (v TaskSource) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "HISTORY"
-	case 1:
-		return "DB_BACKLOG"
+	if v.DomainUUID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.DomainUUID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
-	return fmt.Sprintf("TaskSource(%d)", w)
+
+	if v.TaskList != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.TaskList.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TaskID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.TaskID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.CompletedRequest != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.CompletedRequest.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
 }
 
-// Equals returns true if this TaskSource value matches the provided
-// value.
-func (v TaskSource) Equals(rhs TaskSource) bool {
-	return v == rhs
+func _RespondQueryTaskCompletedRequest_Decode(sr stream.Reader) (*shared.RespondQueryTaskCompletedRequest, error) {
+	var v shared.RespondQueryTaskCompletedRequest
+	err := v.Decode(sr)
+	return &v, err
 }
 
-// MarshalJSON serializes TaskSource into JSON.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
+// Decode deserializes a RespondQueryTaskCompletedRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
 //
-// This implements json.Marshaler.
-func (v TaskSource) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"HISTORY\""), nil
-	case 1:
-		return ([]byte)("\"DB_BACKLOG\""), nil
+// An error is returned if a RespondQueryTaskCompletedRequest struct could not be generated from the wire
+// representation.
+func (v *RespondQueryTaskCompletedRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.DomainUUID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.TaskList, err = _TaskList_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.TaskID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TStruct:
+			v.CompletedRequest, err = _RespondQueryTaskCompletedRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RespondQueryTaskCompletedRequest
+// struct.
+func (v *RespondQueryTaskCompletedRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.TaskID != nil {
+		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
+		i++
+	}
+	if v.CompletedRequest != nil {
+		fields[i] = fmt.Sprintf("CompletedRequest: %v", v.CompletedRequest)
+		i++
+	}
+
+	return fmt.Sprintf("RespondQueryTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RespondQueryTaskCompletedRequest match the
+// provided RespondQueryTaskCompletedRequest.
+//
+// This function performs a deep comparison.
+func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompletedRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.TaskID, rhs.TaskID) {
+		return false
+	}
+	if !((v.CompletedRequest == nil && rhs.CompletedRequest == nil) || (v.CompletedRequest != nil && rhs.CompletedRequest != nil && v.CompletedRequest.Equals(rhs.CompletedRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RespondQueryTaskCompletedRequest.
+func (v *RespondQueryTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.TaskID != nil {
+		enc.AddString("taskID", *v.TaskID)
+	}
+	if v.CompletedRequest != nil {
+		err = multierr.Append(err, enc.AddObject("completedRequest", v.CompletedRequest))
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *RespondQueryTaskCompletedRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *RespondQueryTaskCompletedRequest) GetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetTaskID returns the value of TaskID if it is set or its
+// zero value if it is unset.
+func (v *RespondQueryTaskCompletedRequest) GetTaskID() (o string) {
+	if v != nil && v.TaskID != nil {
+		return *v.TaskID
+	}
+
+	return
+}
+
+// IsSetTaskID returns true if TaskID is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetTaskID() bool {
+	return v != nil && v.TaskID != nil
+}
+
+// GetCompletedRequest returns the value of CompletedRequest if it is set or its
+// zero value if it is unset.
+func (v *RespondQueryTaskCompletedRequest) GetCompletedRequest() (o *shared.RespondQueryTaskCompletedRequest) {
+	if v != nil && v.CompletedRequest != nil {
+		return v.CompletedRequest
+	}
+
+	return
+}
+
+// IsSetCompletedRequest returns true if CompletedRequest is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetCompletedRequest() bool {
+	return v != nil && v.CompletedRequest != nil
+}
+
+type TaskSource int32
+
+const (
+	TaskSourceHistory   TaskSource = 0
+	TaskSourceDbBacklog TaskSource = 1
+)
+
+// TaskSource_Values returns all recognized values of TaskSource.
+func TaskSource_Values() []TaskSource {
+	return []TaskSource{
+		TaskSourceHistory,
+		TaskSourceDbBacklog,
+	}
+}
+
+// UnmarshalText tries to decode TaskSource from a byte slice
+// containing its name.
+//
+//	var v TaskSource
+//	err := v.UnmarshalText([]byte("HISTORY"))
+func (v *TaskSource) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "HISTORY":
+		*v = TaskSourceHistory
+		return nil
+	case "DB_BACKLOG":
+		*v = TaskSourceDbBacklog
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "TaskSource", err)
+		}
+		*v = TaskSource(val)
+		return nil
+	}
+}
+
+// MarshalText encodes TaskSource to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v TaskSource) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("HISTORY"), nil
+	case 1:
+		return []byte("DB_BACKLOG"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TaskSource.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v TaskSource) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "HISTORY")
+	case 1:
+		enc.AddString("name", "DB_BACKLOG")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v TaskSource) Ptr() *TaskSource {
+	return &v
+}
+
+// Encode encodes TaskSource directly to bytes.
+//
+//	sWriter := BinaryStreamer.Writer(writer)
+//
+//	var v TaskSource
+//	return v.Encode(sWriter)
+func (v TaskSource) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates TaskSource into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v TaskSource) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes TaskSource from its Thrift-level
+// representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TI32)
+//	if err != nil {
+//	  return TaskSource(0), err
+//	}
+//
+//	var v TaskSource
+//	if err := v.FromWire(x); err != nil {
+//	  return TaskSource(0), err
+//	}
+//	return v, nil
+func (v *TaskSource) FromWire(w wire.Value) error {
+	*v = (TaskSource)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded TaskSource directly off of the wire.
+//
+//	sReader := BinaryStreamer.Reader(reader)
+//
+//	var v TaskSource
+//	if err := v.Decode(sReader); err != nil {
+//	  return TaskSource(0), err
+//	}
+//	return v, nil
+func (v *TaskSource) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (TaskSource)(i)
+	return nil
+}
+
+// String returns a readable string representation of TaskSource.
+func (v TaskSource) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "HISTORY"
+	case 1:
+		return "DB_BACKLOG"
+	}
+	return fmt.Sprintf("TaskSource(%d)", w)
+}
+
+// Equals returns true if this TaskSource value matches the provided
+// value.
+func (v TaskSource) Equals(rhs TaskSource) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes TaskSource into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v TaskSource) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"HISTORY\""), nil
+	case 1:
+		return ([]byte)("\"DB_BACKLOG\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode TaskSource from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *TaskSource) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "TaskSource")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "TaskSource")
+		}
+		*v = (TaskSource)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "TaskSource")
+	}
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "matching",
+	Package:  "github.com/uber/cadence/.gen/go/matching",
+	FilePath: "matching.thrift",
+	SHA1:     "ba84f65f34b1a3e38a2bbf640b6eff5b77c58c53",
+	Includes: []*thriftreflect.ThriftModule{
+		shared.ThriftModule,
+	},
+	Raw: rawIDL,
+}
+
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\ninclude \"shared.thrift\"\n\nnamespace java com.uber.cadence.matching\n\n// TaskSource is the source from which a task was produced\nenum TaskSource {\n    HISTORY,    // Task produced by history service\n    DB_BACKLOG // Task produced from matching db backlog\n}\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domainUUID\n  15: optional string pollerID\n  20: optional shared.PollForDecisionTaskRequest pollRequest\n  30: optional string forwardedFrom\n  40: optional string isolationGroup\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional shared.WorkflowExecution workflowExecution\n  30: optional shared.WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = \"Long\") attempt\n  60: optional i64 (js.type = \"Long\") nextEventId\n  65: optional i64 (js.type = \"Long\") backlogCountHint\n  70: optional bool stickyExecutionEnabled\n  80: optional shared.WorkflowQuery query\n  90: optional shared.TransientDecisionInfo decisionInfo\n  100: optional shared.TaskList WorkflowExecutionTaskList\n  110: optional i32 eventStoreVersion\n  120: optional binary branchToken\n  130: optional i64 (js.type = \"Long\") scheduledTimestamp\n  140: optional i64 (js.type = \"Long\") startedTimestamp\n  150: optional map<string, shared.WorkflowQuery> queries\n  160: optional i64 (js.type = \"Long\") totalHistoryBytes\n  170: optional shared.AutoConfigHint autoConfigHint\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domainUUID\n  15: optional string pollerID\n  20: optional shared.PollForActivityTaskRequest pollRequest\n  30: optional string forwardedFrom\n  40: optional string isolationGroup\n}\n\nstruct AddDecisionTaskRequest {\n  10: optional string domainUUID\n  20: optional shared.WorkflowExecution execution\n  30: optional shared.TaskList taskList\n  40: optional i64 (js.type = \"Long\") scheduleId\n  50: optional i32 scheduleToStartTimeoutSeconds\n  59: optional TaskSource source\n  60: optional string forwardedFrom\n  70: optional map<string, string> partitionConfig\n}\n\nstruct AddActivityTaskRequest {\n  10: optional string domainUUID\n  20: optional shared.WorkflowExecution execution\n  30: optional string sourceDomainUUID\n  40: optional shared.TaskList taskList\n  50: optional i64 (js.type = \"Long\") scheduleId\n  60: optional i32 scheduleToStartTimeoutSeconds\n  69: optional TaskSource source\n  70: optional string forwardedFrom\n  80: optional ActivityTaskDispatchInfo activityTaskDispatchInfo\n  90: optional map<string, string> partitionConfig\n  100: optional i32 priority\n}\n\nstruct ActivityTaskDispatchInfo {\n   10: optional shared.HistoryEvent scheduledEvent\n   20: optional i64 (js.type = \"Long\") startedTimestamp\n   30: optional i64 (js.type = \"Long\") attempt\n   40: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n   50: optional i64 (js.type = \"Long\") scheduledTimestamp\n   60: optional binary heartbeatDetails\n   70: optional shared.WorkflowType workflowType\n   80: optional string workflowDomain\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional shared.QueryWorkflowRequest queryRequest\n  40: optional string forwardedFrom\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional string taskID\n  40: optional shared.RespondQueryTaskCompletedRequest completedRequest\n}\n\nstruct CancelOutstandingPollRequest {\n  10: optional string domainUUID\n  20: optional i32 taskListType\n  30: optional shared.TaskList taskList\n  40: optional string pollerID\n}\n\nstruct RecordBackpressureHintRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional double hint\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domainUUID\n  20: optional shared.DescribeTaskListRequest descRequest\n}\n\nstruct ListTaskListPartitionsRequest {\n  10: optional string domain\n  20: optional shared.TaskList taskList\n}\n\n/**\n* MatchingService API is exposed to provide support for polling from long running applications.\n* Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each\n* DecisionTask, application is expected to process the history of events for that session and respond back with next\n* decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back\n* with completion or failure.\n**/\nservice MatchingService {\n  /**\n  * PollForDecisionTask is called by frontend to process DecisionTask from a specific taskList.  A\n  * DecisionTask is dispatched to callers for active workflow executions, with pending decisions.\n  **/\n  PollForDecisionTaskResponse PollForDecisionTask(1: PollForDecisionTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * PollForActivityTask is called by frontend to process ActivityTask from a specific taskList.  ActivityTask\n  * is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.\n  **/\n  shared.PollForActivityTaskResponse PollForActivityTask(1: PollForActivityTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * AddDecisionTask is called by the history service when a decision task is scheduled, so that it can be dispatched\n  * by the MatchingEngine.\n  **/\n  void AddDecisionTask(1: AddDecisionTaskRequest addRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.RemoteSyncMatchedError remoteSyncMatchedError,\n      7: shared.StickyWorkerUnavailableError stickyWorkerUnavailableError,\n      8: shared.TaskListNotOwnedByHostError taskListNotOwnedByHostError,\n    )\n\n  /**\n  * AddActivityTask is called by the history service when a decision task is scheduled, so that it can be dispatched\n  * by the MatchingEngine.\n  **/\n  void AddActivityTask(1: AddActivityTaskRequest addRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.RemoteSyncMatchedError remoteSyncMatchedError,\n      7: shared.TaskListNotOwnedByHostError taskListNotOwnedByHostError,\n    )\n\n  /**\n  * QueryWorkflow is called by frontend to query a workflow.\n  **/\n  shared.QueryWorkflowResponse QueryWorkflow(1: QueryWorkflowRequest queryRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.QueryFailedError queryFailedError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.StickyWorkerUnavailableError stickyWorkerUnavailableError,\n      8: shared.TaskListNotOwnedByHostError taskListNotOwnedByHostError,\n    )\n\n  /**\n  * RespondQueryTaskCompleted is called by frontend to respond query completed.\n  **/\n  void RespondQueryTaskCompleted(1: RespondQueryTaskCompletedRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n    * CancelOutstandingPoll is called by frontend to unblock long polls on matching for zombie pollers.\n    * Our rpc stack does not support context propagation, so when a client connection goes away frontend sees\n    * cancellation of context for that handler, but any corresponding calls (long-poll) to matching service does not\n    * see the cancellation propagated so it can unblock corresponding long-polls on its end.  This results is tasks\n    * being dispatched to zombie pollers in this situation.  This API is added so everytime frontend makes a long-poll\n    * api call to matching it passes in a pollerID and then calls this API when it detects client connection is closed\n    * to unblock long polls for this poller and prevent tasks being sent to these zombie pollers.\n    **/\n  void CancelOutstandingPoll(1: CancelOutstandingPollRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.TaskListNotOwnedByHostError taskListNotOwnedByHostError,\n    )\n\n  /**\n  * RecordBackpressureHint is called by frontend to forward the backpressure hint an activity worker reported\n  * with the result of a task, so matching can lower the dispatch rate of the task list the task came from.\n  **/\n  void RecordBackpressureHint(1: RecordBackpressureHintRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.TaskListNotOwnedByHostError taskListNotOwnedByHostError,\n    )\n\n  /**\n  * DescribeTaskList returns information about the target tasklist, right now this API returns the\n  * pollers which polled this tasklist in last few minutes.\n  **/\n  shared.DescribeTaskListResponse DescribeTaskList(1: DescribeTaskListRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n        5: shared.TaskListNotOwnedByHostError taskListNotOwnedByHostError,\n      )\n\n  /**\n  * GetTaskListsByDomain returns the list of all the task lists for a domainName.\n  **/\n  shared.GetTaskListsByDomainResponse GetTaskListsByDomain(1: shared.GetTaskListsByDomainRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n      )\n\n  /**\n  * ListTaskListPartitions returns a map of partitionKey and hostAddress for a taskList\n  **/\n  shared.ListTaskListPartitionsResponse ListTaskListPartitions(1: ListTaskListPartitionsRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        4: shared.ServiceBusyError serviceBusyError,\n    )\n}\n"
+
+// MatchingService_AddActivityTask_Args represents the arguments for the MatchingService.AddActivityTask function.
+//
+// The arguments for AddActivityTask are sent and received over the wire as this struct.
+type MatchingService_AddActivityTask_Args struct {
+	AddRequest *AddActivityTaskRequest `json:"addRequest,omitempty"`
+}
+
+// ToWire translates a MatchingService_AddActivityTask_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *MatchingService_AddActivityTask_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.AddRequest != nil {
+		w, err = v.AddRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _AddActivityTaskRequest_Read(w wire.Value) (*AddActivityTaskRequest, error) {
+	var v AddActivityTaskRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_AddActivityTask_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MatchingService_AddActivityTask_Args struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v MatchingService_AddActivityTask_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *MatchingService_AddActivityTask_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.AddRequest, err = _AddActivityTaskRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a MatchingService_AddActivityTask_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a MatchingService_AddActivityTask_Args struct could not be encoded.
+func (v *MatchingService_AddActivityTask_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.AddRequest != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.AddRequest.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _AddActivityTaskRequest_Decode(sr stream.Reader) (*AddActivityTaskRequest, error) {
+	var v AddActivityTaskRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a MatchingService_AddActivityTask_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a MatchingService_AddActivityTask_Args struct could not be generated from the wire
+// representation.
+func (v *MatchingService_AddActivityTask_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.AddRequest, err = _AddActivityTaskRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MatchingService_AddActivityTask_Args
+// struct.
+func (v *MatchingService_AddActivityTask_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.AddRequest != nil {
+		fields[i] = fmt.Sprintf("AddRequest: %v", v.AddRequest)
+		i++
+	}
+
+	return fmt.Sprintf("MatchingService_AddActivityTask_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MatchingService_AddActivityTask_Args match the
+// provided MatchingService_AddActivityTask_Args.
+//
+// This function performs a deep comparison.
+func (v *MatchingService_AddActivityTask_Args) Equals(rhs *MatchingService_AddActivityTask_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.AddRequest == nil && rhs.AddRequest == nil) || (v.AddRequest != nil && rhs.AddRequest != nil && v.AddRequest.Equals(rhs.AddRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MatchingService_AddActivityTask_Args.
+func (v *MatchingService_AddActivityTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.AddRequest != nil {
+		err = multierr.Append(err, enc.AddObject("addRequest", v.AddRequest))
+	}
+	return err
+}
+
+// GetAddRequest returns the value of AddRequest if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Args) GetAddRequest() (o *AddActivityTaskRequest) {
+	if v != nil && v.AddRequest != nil {
+		return v.AddRequest
+	}
+
+	return
+}
+
+// IsSetAddRequest returns true if AddRequest is not nil.
+func (v *MatchingService_AddActivityTask_Args) IsSetAddRequest() bool {
+	return v != nil && v.AddRequest != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "AddActivityTask" for this struct.
+func (v *MatchingService_AddActivityTask_Args) MethodName() string {
+	return "AddActivityTask"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *MatchingService_AddActivityTask_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// MatchingService_AddActivityTask_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.AddActivityTask
+// function.
+var MatchingService_AddActivityTask_Helper = struct {
+	// Args accepts the parameters of AddActivityTask in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		addRequest *AddActivityTaskRequest,
+	) *MatchingService_AddActivityTask_Args
+
+	// IsException returns true if the given error can be thrown
+	// by AddActivityTask.
+	//
+	// An error can be thrown by AddActivityTask only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for AddActivityTask
+	// given the error returned by it. The provided error may
+	// be nil if AddActivityTask did not fail.
+	//
+	// This allows mapping errors returned by AddActivityTask into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// AddActivityTask
+	//
+	//   err := AddActivityTask(args)
+	//   result, err := MatchingService_AddActivityTask_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from AddActivityTask: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*MatchingService_AddActivityTask_Result, error)
+
+	// UnwrapResponse takes the result struct for AddActivityTask
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if AddActivityTask threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := MatchingService_AddActivityTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_AddActivityTask_Result) error
+}{}
+
+func init() {
+	MatchingService_AddActivityTask_Helper.Args = func(
+		addRequest *AddActivityTaskRequest,
+	) *MatchingService_AddActivityTask_Args {
+		return &MatchingService_AddActivityTask_Args{
+			AddRequest: addRequest,
+		}
+	}
+
+	MatchingService_AddActivityTask_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.LimitExceededError:
+			return true
+		case *shared.DomainNotActiveError:
+			return true
+		case *shared.RemoteSyncMatchedError:
+			return true
+		case *shared.TaskListNotOwnedByHostError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	MatchingService_AddActivityTask_Helper.WrapResponse = func(err error) (*MatchingService_AddActivityTask_Result, error) {
+		if err == nil {
+			return &MatchingService_AddActivityTask_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.BadRequestError")
+			}
+			return &MatchingService_AddActivityTask_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.InternalServiceError")
+			}
+			return &MatchingService_AddActivityTask_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.ServiceBusyError")
+			}
+			return &MatchingService_AddActivityTask_Result{ServiceBusyError: e}, nil
+		case *shared.LimitExceededError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.LimitExceededError")
+			}
+			return &MatchingService_AddActivityTask_Result{LimitExceededError: e}, nil
+		case *shared.DomainNotActiveError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.DomainNotActiveError")
+			}
+			return &MatchingService_AddActivityTask_Result{DomainNotActiveError: e}, nil
+		case *shared.RemoteSyncMatchedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.RemoteSyncMatchedError")
+			}
+			return &MatchingService_AddActivityTask_Result{RemoteSyncMatchedError: e}, nil
+		case *shared.TaskListNotOwnedByHostError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.TaskListNotOwnedByHostError")
+			}
+			return &MatchingService_AddActivityTask_Result{TaskListNotOwnedByHostError: e}, nil
+		}
+
+		return nil, err
+	}
+	MatchingService_AddActivityTask_Helper.UnwrapResponse = func(result *MatchingService_AddActivityTask_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.LimitExceededError != nil {
+			err = result.LimitExceededError
+			return
+		}
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
+			return
+		}
+		if result.RemoteSyncMatchedError != nil {
+			err = result.RemoteSyncMatchedError
+			return
+		}
+		if result.TaskListNotOwnedByHostError != nil {
+			err = result.TaskListNotOwnedByHostError
+			return
+		}
+		return
+	}
+
+}
+
+// MatchingService_AddActivityTask_Result represents the result of a MatchingService.AddActivityTask function call.
+//
+// The result of a AddActivityTask execution is sent and received over the wire as this struct.
+type MatchingService_AddActivityTask_Result struct {
+	BadRequestError             *shared.BadRequestError             `json:"badRequestError,omitempty"`
+	InternalServiceError        *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
+	ServiceBusyError            *shared.ServiceBusyError            `json:"serviceBusyError,omitempty"`
+	LimitExceededError          *shared.LimitExceededError          `json:"limitExceededError,omitempty"`
+	DomainNotActiveError        *shared.DomainNotActiveError        `json:"domainNotActiveError,omitempty"`
+	RemoteSyncMatchedError      *shared.RemoteSyncMatchedError      `json:"remoteSyncMatchedError,omitempty"`
+	TaskListNotOwnedByHostError *shared.TaskListNotOwnedByHostError `json:"taskListNotOwnedByHostError,omitempty"`
+}
+
+// ToWire translates a MatchingService_AddActivityTask_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *MatchingService_AddActivityTask_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.LimitExceededError != nil {
+		w, err = v.LimitExceededError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.RemoteSyncMatchedError != nil {
+		w, err = v.RemoteSyncMatchedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.TaskListNotOwnedByHostError != nil {
+		w, err = v.TaskListNotOwnedByHostError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
+	var v shared.BadRequestError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
+	var v shared.InternalServiceError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
+	var v shared.ServiceBusyError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _LimitExceededError_Read(w wire.Value) (*shared.LimitExceededError, error) {
+	var v shared.LimitExceededError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _DomainNotActiveError_Read(w wire.Value) (*shared.DomainNotActiveError, error) {
+	var v shared.DomainNotActiveError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _RemoteSyncMatchedError_Read(w wire.Value) (*shared.RemoteSyncMatchedError, error) {
+	var v shared.RemoteSyncMatchedError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _TaskListNotOwnedByHostError_Read(w wire.Value) (*shared.TaskListNotOwnedByHostError, error) {
+	var v shared.TaskListNotOwnedByHostError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_AddActivityTask_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MatchingService_AddActivityTask_Result struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v MatchingService_AddActivityTask_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *MatchingService_AddActivityTask_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.RemoteSyncMatchedError, err = _RemoteSyncMatchedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskListNotOwnedByHostError, err = _TaskListNotOwnedByHostError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.RemoteSyncMatchedError != nil {
+		count++
+	}
+	if v.TaskListNotOwnedByHostError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a MatchingService_AddActivityTask_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a MatchingService_AddActivityTask_Result struct could not be encoded.
+func (v *MatchingService_AddActivityTask_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.BadRequestError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.InternalServiceError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.InternalServiceError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LimitExceededError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.LimitExceededError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DomainNotActiveError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.DomainNotActiveError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RemoteSyncMatchedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.RemoteSyncMatchedError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TaskListNotOwnedByHostError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.TaskListNotOwnedByHostError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.RemoteSyncMatchedError != nil {
+		count++
+	}
+	if v.TaskListNotOwnedByHostError != nil {
+		count++
+	}
+
+	if count > 1 {
+		return fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _BadRequestError_Decode(sr stream.Reader) (*shared.BadRequestError, error) {
+	var v shared.BadRequestError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _InternalServiceError_Decode(sr stream.Reader) (*shared.InternalServiceError, error) {
+	var v shared.InternalServiceError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _ServiceBusyError_Decode(sr stream.Reader) (*shared.ServiceBusyError, error) {
+	var v shared.ServiceBusyError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _LimitExceededError_Decode(sr stream.Reader) (*shared.LimitExceededError, error) {
+	var v shared.LimitExceededError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _DomainNotActiveError_Decode(sr stream.Reader) (*shared.DomainNotActiveError, error) {
+	var v shared.DomainNotActiveError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _RemoteSyncMatchedError_Decode(sr stream.Reader) (*shared.RemoteSyncMatchedError, error) {
+	var v shared.RemoteSyncMatchedError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _TaskListNotOwnedByHostError_Decode(sr stream.Reader) (*shared.TaskListNotOwnedByHostError, error) {
+	var v shared.TaskListNotOwnedByHostError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a MatchingService_AddActivityTask_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a MatchingService_AddActivityTask_Result struct could not be generated from the wire
+// representation.
+func (v *MatchingService_AddActivityTask_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.DomainNotActiveError, err = _DomainNotActiveError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TStruct:
+			v.RemoteSyncMatchedError, err = _RemoteSyncMatchedError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TStruct:
+			v.TaskListNotOwnedByHostError, err = _TaskListNotOwnedByHostError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.RemoteSyncMatchedError != nil {
+		count++
+	}
+	if v.TaskListNotOwnedByHostError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MatchingService_AddActivityTask_Result
+// struct.
+func (v *MatchingService_AddActivityTask_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.LimitExceededError != nil {
+		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+		i++
+	}
+	if v.RemoteSyncMatchedError != nil {
+		fields[i] = fmt.Sprintf("RemoteSyncMatchedError: %v", v.RemoteSyncMatchedError)
+		i++
+	}
+	if v.TaskListNotOwnedByHostError != nil {
+		fields[i] = fmt.Sprintf("TaskListNotOwnedByHostError: %v", v.TaskListNotOwnedByHostError)
+		i++
+	}
+
+	return fmt.Sprintf("MatchingService_AddActivityTask_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MatchingService_AddActivityTask_Result match the
+// provided MatchingService_AddActivityTask_Result.
+//
+// This function performs a deep comparison.
+func (v *MatchingService_AddActivityTask_Result) Equals(rhs *MatchingService_AddActivityTask_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+		return false
+	}
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+		return false
+	}
+	if !((v.RemoteSyncMatchedError == nil && rhs.RemoteSyncMatchedError == nil) || (v.RemoteSyncMatchedError != nil && rhs.RemoteSyncMatchedError != nil && v.RemoteSyncMatchedError.Equals(rhs.RemoteSyncMatchedError))) {
+		return false
+	}
+	if !((v.TaskListNotOwnedByHostError == nil && rhs.TaskListNotOwnedByHostError == nil) || (v.TaskListNotOwnedByHostError != nil && rhs.TaskListNotOwnedByHostError != nil && v.TaskListNotOwnedByHostError.Equals(rhs.TaskListNotOwnedByHostError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MatchingService_AddActivityTask_Result.
+func (v *MatchingService_AddActivityTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.LimitExceededError != nil {
+		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
+	}
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+	}
+	if v.RemoteSyncMatchedError != nil {
+		err = multierr.Append(err, enc.AddObject("remoteSyncMatchedError", v.RemoteSyncMatchedError))
+	}
+	if v.TaskListNotOwnedByHostError != nil {
+		err = multierr.Append(err, enc.AddObject("taskListNotOwnedByHostError", v.TaskListNotOwnedByHostError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetLimitExceededError returns the value of LimitExceededError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+	if v != nil && v.LimitExceededError != nil {
+		return v.LimitExceededError
+	}
+
+	return
+}
+
+// IsSetLimitExceededError returns true if LimitExceededError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetLimitExceededError() bool {
+	return v != nil && v.LimitExceededError != nil
+}
+
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
+	}
+
+	return
+}
+
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
+}
+
+// GetRemoteSyncMatchedError returns the value of RemoteSyncMatchedError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetRemoteSyncMatchedError() (o *shared.RemoteSyncMatchedError) {
+	if v != nil && v.RemoteSyncMatchedError != nil {
+		return v.RemoteSyncMatchedError
+	}
+
+	return
+}
+
+// IsSetRemoteSyncMatchedError returns true if RemoteSyncMatchedError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetRemoteSyncMatchedError() bool {
+	return v != nil && v.RemoteSyncMatchedError != nil
 }
 
-// UnmarshalJSON attempts to decode TaskSource from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *TaskSource) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
+// GetTaskListNotOwnedByHostError returns the value of TaskListNotOwnedByHostError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetTaskListNotOwnedByHostError() (o *shared.TaskListNotOwnedByHostError) {
+	if v != nil && v.TaskListNotOwnedByHostError != nil {
+		return v.TaskListNotOwnedByHostError
 	}
 
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "TaskSource")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "TaskSource")
-		}
-		*v = (TaskSource)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "TaskSource")
-	}
+	return
 }
 
-// ThriftModule represents the IDL file used to generate this package.
-var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "matching",
-	Package:  "github.com/uber/cadence/.gen/go/matching",
-	FilePath: "matching.thrift",
-	SHA1:     "e7db050ae64980b33e3f8ed3f6a3bba3d63174b3",
-	Includes: []*thriftreflect.ThriftModule{
-		shared.ThriftModule,
-	},
-	Raw: rawIDL,
+// IsSetTaskListNotOwnedByHostError returns true if TaskListNotOwnedByHostError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetTaskListNotOwnedByHostError() bool {
+	return v != nil && v.TaskListNotOwnedByHostError != nil
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\ninclude \"shared.thrift\"\n\nnamespace java com.uber.cadence.matching\n\n// TaskSource is the source from which a task was produced\nenum TaskSource {\n    HISTORY,    // Task produced by history service\n    DB_BACKLOG // Task produced from matching db backlog\n}\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domainUUID\n  15: optional string pollerID\n  20: optional shared.PollForDecisionTaskRequest pollRequest\n  30: optional string forwardedFrom\n  40: optional string isolationGroup\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional shared.WorkflowExecution workflowExecution\n  30: optional shared.WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = \"Long\") attempt\n  60: optional i64 (js.type = \"Long\") nextEventId\n  65: optional i64 (js.type = \"Long\") backlogCountHint\n  70: optional bool stickyExecutionEnabled\n  80: optional shared.WorkflowQuery query\n  90: optional shared.TransientDecisionInfo decisionInfo\n  100: optional shared.TaskList WorkflowExecutionTaskList\n  110: optional i32 eventStoreVersion\n  120: optional binary branchToken\n  130: optional i64 (js.type = \"Long\") scheduledTimestamp\n  140: optional i64 (js.type = \"Long\") startedTimestamp\n  150: optional map<string, shared.WorkflowQuery> queries\n  160: optional i64 (js.type = \"Long\") totalHistoryBytes\n  170: optional shared.AutoConfigHint autoConfigHint\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domainUUID\n  15: optional string pollerID\n  20: optional shared.PollForActivityTaskRequest pollRequest\n  30: optional string forwardedFrom\n  40: optional string isolationGroup\n}\n\nstruct AddDecisionTaskRequest {\n  10: optional string domainUUID\n  20: optional shared.WorkflowExecution execution\n  30: optional shared.TaskList taskList\n  40: optional i64 (js.type = \"Long\") scheduleId\n  50: optional i32 scheduleToStartTimeoutSeconds\n  59: optional TaskSource source\n  60: optional string forwardedFrom\n  70: optional map<string, string> partitionConfig\n}\n\nstruct AddActivityTaskRequest {\n  10: optional string domainUUID\n  20: optional shared.WorkflowExecution execution\n  30: optional string sourceDomainUUID\n  40: optional shared.TaskList taskList\n  50: optional i64 (js.type = \"Long\") scheduleId\n  60: optional i32 scheduleToStartTimeoutSeconds\n  69: optional TaskSource source\n  70: optional string forwardedFrom\n  80: optional ActivityTaskDispatchInfo activityTaskDispatchInfo\n  90: optional map<string, string> partitionConfig\n}\n\nstruct ActivityTaskDispatchInfo {\n   10: optional shared.HistoryEvent scheduledEvent\n   20: optional i64 (js.type = \"Long\") startedTimestamp\n   30: optional i64 (js.type = \"Long\") attempt\n   40: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n   50: optional i64 (js.type = \"Long\") scheduledTimestamp\n   60: optional binary heartbeatDetails\n   70: optional shared.WorkflowType workflowType\n   80: optional string workflowDomain\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional shared.QueryWorkflowRequest queryRequest\n  40: optional string forwardedFrom\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional string taskID\n  40: optional shared.RespondQueryTaskCompletedRequest completedRequest\n}\n\nstruct CancelOutstandingPollRequest {\n  10: optional string domainUUID\n  20: optional i32 taskListType\n  30: optional shared.TaskList taskList\n  40: optional string pollerID\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domainUUID\n  20: optional shared.DescribeTaskListRequest descRequest\n}\n\nstruct ListTaskListPartitionsRequest {\n  10: optional string domain\n  20: optional shared.TaskList taskList\n}\n\n/**\n* MatchingService API is exposed to provide support for polling from long running applications.\n* Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each\n* DecisionTask, application is expected to process the history of events for that session and respond back with next\n* decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back\n* with completion or failure.\n**/\nservice MatchingService {\n  /**\n  * PollForDecisionTask is called by frontend to process DecisionTask from a specific taskList.  A\n  * DecisionTask is dispatched to callers for active workflow executions, with pending decisions.\n  **/\n  PollForDecisionTaskResponse PollForDecisionTask(1: PollForDecisionTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * PollForActivityTask is called by frontend to process ActivityTask from a specific taskList.  ActivityTask\n  * is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.\n  **/\n  shared.PollForActivityTaskResponse PollForActivityTask(1: PollForActivityTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * AddDecisionTask is called by the history service when a decision task is scheduled, so that it can be dispatched\n  * by the MatchingEngine.\n  **/\n  void AddDecisionTask(1: AddDecisionTaskRequest addRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.RemoteSyncMatchedError remoteSyncMatchedError,\n      7: shared.StickyWorkerUnavailableError stickyWorkerUnavailableError,\n      8: shared.TaskListNotOwnedByHostError taskListNotOwnedByHostError,\n    )\n\n  /**\n  * AddActivityTask is called by the history service when a decision task is scheduled, so that it can be dispatched\n  * by the MatchingEngine.\n  **/\n  void AddActivityTask(1: AddActivityTaskRequest addRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.RemoteSyncMatchedError remoteSyncMatchedError,\n      7: shared.TaskListNotOwnedByHostError taskListNotOwnedByHostError,\n    )\n\n  /**\n  * QueryWorkflow is called by frontend to query a workflow.\n  **/\n  shared.QueryWorkflowResponse QueryWorkflow(1: QueryWorkflowRequest queryRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.QueryFailedError queryFailedError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n      7: shared.StickyWorkerUnavailableError stickyWorkerUnavailableError,\n      8: shared.TaskListNotOwnedByHostError taskListNotOwnedByHostError,\n    )\n\n  /**\n  * RespondQueryTaskCompleted is called by frontend to respond query completed.\n  **/\n  void RespondQueryTaskCompleted(1: RespondQueryTaskCompletedRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n    * CancelOutstandingPoll is called by frontend to unblock long polls on matching for zombie pollers.\n    * Our rpc stack does not support context propagation, so when a client connection goes away frontend sees\n    * cancellation of context for that handler, but any corresponding calls (long-poll) to matching service does not\n    * see the cancellation propagated so it can unblock corresponding long-polls on its end.  This results is tasks\n    * being dispatched to zombie pollers in this situation.  This API is added so everytime frontend makes a long-poll\n    * api call to matching it passes in a pollerID and then calls this API when it detects client connection is closed\n    * to unblock long polls for this poller and prevent tasks being sent to these zombie pollers.\n    **/\n  void CancelOutstandingPoll(1: CancelOutstandingPollRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.TaskListNotOwnedByHostError taskListNotOwnedByHostError,\n    )\n\n  /**\n  * DescribeTaskList returns information about the target tasklist, right now this API returns the\n  * pollers which polled this tasklist in last few minutes.\n  **/\n  shared.DescribeTaskListResponse DescribeTaskList(1: DescribeTaskListRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n        5: shared.TaskListNotOwnedByHostError taskListNotOwnedByHostError,\n      )\n\n  /**\n  * GetTaskListsByDomain returns the list of all the task lists for a domainName.\n  **/\n  shared.GetTaskListsByDomainResponse GetTaskListsByDomain(1: shared.GetTaskListsByDomainRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n      )\n\n  /**\n  * ListTaskListPartitions returns a map of partitionKey and hostAddress for a taskList\n  **/\n  shared.ListTaskListPartitionsResponse ListTaskListPartitions(1: ListTaskListPartitionsRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        4: shared.ServiceBusyError serviceBusyError,\n    )\n}\n"
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "AddActivityTask" for this struct.
+func (v *MatchingService_AddActivityTask_Result) MethodName() string {
+	return "AddActivityTask"
+}
 
-// MatchingService_AddActivityTask_Args represents the arguments for the MatchingService.AddActivityTask function.
+// EnvelopeType returns the kind of value inside this struct.
 //
-// The arguments for AddActivityTask are sent and received over the wire as this struct.
-type MatchingService_AddActivityTask_Args struct {
-	AddRequest *AddActivityTaskRequest `json:"addRequest,omitempty"`
+// This will always be Reply for this struct.
+func (v *MatchingService_AddActivityTask_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
 }
 
-// ToWire translates a MatchingService_AddActivityTask_Args struct into a Thrift-level intermediate
+// MatchingService_AddDecisionTask_Args represents the arguments for the MatchingService.AddDecisionTask function.
+//
+// The arguments for AddDecisionTask are sent and received over the wire as this struct.
+type MatchingService_AddDecisionTask_Args struct {
+	AddRequest *AddDecisionTaskRequest `json:"addRequest,omitempty"`
+}
+
+// ToWire translates a MatchingService_AddDecisionTask_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6849,7 +8399,7 @@ type MatchingService_AddActivityTask_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *MatchingService_AddActivityTask_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_AddDecisionTask_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -6869,17 +8419,17 @@ func (v *MatchingService_AddActivityTask_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _AddActivityTaskRequest_Read(w wire.Value) (*AddActivityTaskRequest, error) {
-	var v AddActivityTaskRequest
+func _AddDecisionTaskRequest_Read(w wire.Value) (*AddDecisionTaskRequest, error) {
+	var v AddDecisionTaskRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_AddActivityTask_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_AddDecisionTask_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_AddActivityTask_Args struct
+// An error is returned if we were unable to build a MatchingService_AddDecisionTask_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6887,19 +8437,19 @@ func _AddActivityTaskRequest_Read(w wire.Value) (*AddActivityTaskRequest, error)
 //	  return nil, err
 //	}
 //
-//	var v MatchingService_AddActivityTask_Args
+//	var v MatchingService_AddDecisionTask_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *MatchingService_AddActivityTask_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_AddDecisionTask_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.AddRequest, err = _AddActivityTaskRequest_Read(field.Value)
+				v.AddRequest, err = _AddDecisionTaskRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -6911,11 +8461,11 @@ func (v *MatchingService_AddActivityTask_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a MatchingService_AddActivityTask_Args struct directly into bytes, without going
+// Encode serializes a MatchingService_AddDecisionTask_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_AddActivityTask_Args struct could not be encoded.
-func (v *MatchingService_AddActivityTask_Args) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_AddDecisionTask_Args struct could not be encoded.
+func (v *MatchingService_AddDecisionTask_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -6935,18 +8485,18 @@ func (v *MatchingService_AddActivityTask_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _AddActivityTaskRequest_Decode(sr stream.Reader) (*AddActivityTaskRequest, error) {
-	var v AddActivityTaskRequest
+func _AddDecisionTaskRequest_Decode(sr stream.Reader) (*AddDecisionTaskRequest, error) {
+	var v AddDecisionTaskRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_AddActivityTask_Args struct directly from its Thrift-level
+// Decode deserializes a MatchingService_AddDecisionTask_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_AddActivityTask_Args struct could not be generated from the wire
+// An error is returned if a MatchingService_AddDecisionTask_Args struct could not be generated from the wire
 // representation.
-func (v *MatchingService_AddActivityTask_Args) Decode(sr stream.Reader) error {
+func (v *MatchingService_AddDecisionTask_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -6960,7 +8510,7 @@ func (v *MatchingService_AddActivityTask_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.AddRequest, err = _AddActivityTaskRequest_Decode(sr)
+			v.AddRequest, err = _AddDecisionTaskRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -6987,9 +8537,9 @@ func (v *MatchingService_AddActivityTask_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_AddActivityTask_Args
+// String returns a readable string representation of a MatchingService_AddDecisionTask_Args
 // struct.
-func (v *MatchingService_AddActivityTask_Args) String() string {
+func (v *MatchingService_AddDecisionTask_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -7001,14 +8551,14 @@ func (v *MatchingService_AddActivityTask_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_AddActivityTask_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_AddDecisionTask_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_AddActivityTask_Args match the
-// provided MatchingService_AddActivityTask_Args.
+// Equals returns true if all the fields of this MatchingService_AddDecisionTask_Args match the
+// provided MatchingService_AddDecisionTask_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_AddActivityTask_Args) Equals(rhs *MatchingService_AddActivityTask_Args) bool {
+func (v *MatchingService_AddDecisionTask_Args) Equals(rhs *MatchingService_AddDecisionTask_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -7022,8 +8572,8 @@ func (v *MatchingService_AddActivityTask_Args) Equals(rhs *MatchingService_AddAc
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_AddActivityTask_Args.
-func (v *MatchingService_AddActivityTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_AddDecisionTask_Args.
+func (v *MatchingService_AddDecisionTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -7035,7 +8585,7 @@ func (v *MatchingService_AddActivityTask_Args) MarshalLogObject(enc zapcore.Obje
 
 // GetAddRequest returns the value of AddRequest if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Args) GetAddRequest() (o *AddActivityTaskRequest) {
+func (v *MatchingService_AddDecisionTask_Args) GetAddRequest() (o *AddDecisionTaskRequest) {
 	if v != nil && v.AddRequest != nil {
 		return v.AddRequest
 	}
@@ -7044,81 +8594,81 @@ func (v *MatchingService_AddActivityTask_Args) GetAddRequest() (o *AddActivityTa
 }
 
 // IsSetAddRequest returns true if AddRequest is not nil.
-func (v *MatchingService_AddActivityTask_Args) IsSetAddRequest() bool {
+func (v *MatchingService_AddDecisionTask_Args) IsSetAddRequest() bool {
 	return v != nil && v.AddRequest != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "AddActivityTask" for this struct.
-func (v *MatchingService_AddActivityTask_Args) MethodName() string {
-	return "AddActivityTask"
+// This will always be "AddDecisionTask" for this struct.
+func (v *MatchingService_AddDecisionTask_Args) MethodName() string {
+	return "AddDecisionTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_AddActivityTask_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_AddDecisionTask_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_AddActivityTask_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.AddActivityTask
+// MatchingService_AddDecisionTask_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.AddDecisionTask
 // function.
-var MatchingService_AddActivityTask_Helper = struct {
-	// Args accepts the parameters of AddActivityTask in-order and returns
+var MatchingService_AddDecisionTask_Helper = struct {
+	// Args accepts the parameters of AddDecisionTask in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		addRequest *AddActivityTaskRequest,
-	) *MatchingService_AddActivityTask_Args
+		addRequest *AddDecisionTaskRequest,
+	) *MatchingService_AddDecisionTask_Args
 
 	// IsException returns true if the given error can be thrown
-	// by AddActivityTask.
+	// by AddDecisionTask.
 	//
-	// An error can be thrown by AddActivityTask only if the
+	// An error can be thrown by AddDecisionTask only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for AddActivityTask
+	// WrapResponse returns the result struct for AddDecisionTask
 	// given the error returned by it. The provided error may
-	// be nil if AddActivityTask did not fail.
+	// be nil if AddDecisionTask did not fail.
 	//
-	// This allows mapping errors returned by AddActivityTask into a
+	// This allows mapping errors returned by AddDecisionTask into a
 	// serializable result struct. WrapResponse returns a
 	// non-nil error if the provided error cannot be thrown by
-	// AddActivityTask
+	// AddDecisionTask
 	//
-	//   err := AddActivityTask(args)
-	//   result, err := MatchingService_AddActivityTask_Helper.WrapResponse(err)
+	//   err := AddDecisionTask(args)
+	//   result, err := MatchingService_AddDecisionTask_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from AddActivityTask: %v", err)
+	//     return fmt.Errorf("unexpected error from AddDecisionTask: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*MatchingService_AddActivityTask_Result, error)
+	WrapResponse func(error) (*MatchingService_AddDecisionTask_Result, error)
 
-	// UnwrapResponse takes the result struct for AddActivityTask
+	// UnwrapResponse takes the result struct for AddDecisionTask
 	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if AddActivityTask threw an
+	// The error is non-nil only if AddDecisionTask threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := MatchingService_AddActivityTask_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_AddActivityTask_Result) error
+	//   err := MatchingService_AddDecisionTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_AddDecisionTask_Result) error
 }{}
 
 func init() {
-	MatchingService_AddActivityTask_Helper.Args = func(
-		addRequest *AddActivityTaskRequest,
-	) *MatchingService_AddActivityTask_Args {
-		return &MatchingService_AddActivityTask_Args{
+	MatchingService_AddDecisionTask_Helper.Args = func(
+		addRequest *AddDecisionTaskRequest,
+	) *MatchingService_AddDecisionTask_Args {
+		return &MatchingService_AddDecisionTask_Args{
 			AddRequest: addRequest,
 		}
 	}
 
-	MatchingService_AddActivityTask_Helper.IsException = func(err error) bool {
+	MatchingService_AddDecisionTask_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -7132,6 +8682,8 @@ func init() {
 			return true
 		case *shared.RemoteSyncMatchedError:
 			return true
+		case *shared.StickyWorkerUnavailableError:
+			return true
 		case *shared.TaskListNotOwnedByHostError:
 			return true
 		default:
@@ -7139,52 +8691,57 @@ func init() {
 		}
 	}
 
-	MatchingService_AddActivityTask_Helper.WrapResponse = func(err error) (*MatchingService_AddActivityTask_Result, error) {
+	MatchingService_AddDecisionTask_Helper.WrapResponse = func(err error) (*MatchingService_AddDecisionTask_Result, error) {
 		if err == nil {
-			return &MatchingService_AddActivityTask_Result{}, nil
+			return &MatchingService_AddDecisionTask_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.BadRequestError")
 			}
-			return &MatchingService_AddActivityTask_Result{BadRequestError: e}, nil
+			return &MatchingService_AddDecisionTask_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.InternalServiceError")
 			}
-			return &MatchingService_AddActivityTask_Result{InternalServiceError: e}, nil
+			return &MatchingService_AddDecisionTask_Result{InternalServiceError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.ServiceBusyError")
 			}
-			return &MatchingService_AddActivityTask_Result{ServiceBusyError: e}, nil
+			return &MatchingService_AddDecisionTask_Result{ServiceBusyError: e}, nil
 		case *shared.LimitExceededError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.LimitExceededError")
 			}
-			return &MatchingService_AddActivityTask_Result{LimitExceededError: e}, nil
+			return &MatchingService_AddDecisionTask_Result{LimitExceededError: e}, nil
 		case *shared.DomainNotActiveError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.DomainNotActiveError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.DomainNotActiveError")
 			}
-			return &MatchingService_AddActivityTask_Result{DomainNotActiveError: e}, nil
+			return &MatchingService_AddDecisionTask_Result{DomainNotActiveError: e}, nil
 		case *shared.RemoteSyncMatchedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.RemoteSyncMatchedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.RemoteSyncMatchedError")
 			}
-			return &MatchingService_AddActivityTask_Result{RemoteSyncMatchedError: e}, nil
+			return &MatchingService_AddDecisionTask_Result{RemoteSyncMatchedError: e}, nil
+		case *shared.StickyWorkerUnavailableError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.StickyWorkerUnavailableError")
+			}
+			return &MatchingService_AddDecisionTask_Result{StickyWorkerUnavailableError: e}, nil
 		case *shared.TaskListNotOwnedByHostError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.TaskListNotOwnedByHostError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.TaskListNotOwnedByHostError")
 			}
-			return &MatchingService_AddActivityTask_Result{TaskListNotOwnedByHostError: e}, nil
+			return &MatchingService_AddDecisionTask_Result{TaskListNotOwnedByHostError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_AddActivityTask_Helper.UnwrapResponse = func(result *MatchingService_AddActivityTask_Result) (err error) {
+	MatchingService_AddDecisionTask_Helper.UnwrapResponse = func(result *MatchingService_AddDecisionTask_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -7209,6 +8766,10 @@ func init() {
 			err = result.RemoteSyncMatchedError
 			return
 		}
+		if result.StickyWorkerUnavailableError != nil {
+			err = result.StickyWorkerUnavailableError
+			return
+		}
 		if result.TaskListNotOwnedByHostError != nil {
 			err = result.TaskListNotOwnedByHostError
 			return
@@ -7218,20 +8779,21 @@ func init() {
 
 }
 
-// MatchingService_AddActivityTask_Result represents the result of a MatchingService.AddActivityTask function call.
+// MatchingService_AddDecisionTask_Result represents the result of a MatchingService.AddDecisionTask function call.
 //
-// The result of a AddActivityTask execution is sent and received over the wire as this struct.
-type MatchingService_AddActivityTask_Result struct {
-	BadRequestError             *shared.BadRequestError             `json:"badRequestError,omitempty"`
-	InternalServiceError        *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
-	ServiceBusyError            *shared.ServiceBusyError            `json:"serviceBusyError,omitempty"`
-	LimitExceededError          *shared.LimitExceededError          `json:"limitExceededError,omitempty"`
-	DomainNotActiveError        *shared.DomainNotActiveError        `json:"domainNotActiveError,omitempty"`
-	RemoteSyncMatchedError      *shared.RemoteSyncMatchedError      `json:"remoteSyncMatchedError,omitempty"`
-	TaskListNotOwnedByHostError *shared.TaskListNotOwnedByHostError `json:"taskListNotOwnedByHostError,omitempty"`
+// The result of a AddDecisionTask execution is sent and received over the wire as this struct.
+type MatchingService_AddDecisionTask_Result struct {
+	BadRequestError              *shared.BadRequestError              `json:"badRequestError,omitempty"`
+	InternalServiceError         *shared.InternalServiceError         `json:"internalServiceError,omitempty"`
+	ServiceBusyError             *shared.ServiceBusyError             `json:"serviceBusyError,omitempty"`
+	LimitExceededError           *shared.LimitExceededError           `json:"limitExceededError,omitempty"`
+	DomainNotActiveError         *shared.DomainNotActiveError         `json:"domainNotActiveError,omitempty"`
+	RemoteSyncMatchedError       *shared.RemoteSyncMatchedError       `json:"remoteSyncMatchedError,omitempty"`
+	StickyWorkerUnavailableError *shared.StickyWorkerUnavailableError `json:"stickyWorkerUnavailableError,omitempty"`
+	TaskListNotOwnedByHostError  *shared.TaskListNotOwnedByHostError  `json:"taskListNotOwnedByHostError,omitempty"`
 }
 
-// ToWire translates a MatchingService_AddActivityTask_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_AddDecisionTask_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7246,9 +8808,9 @@ type MatchingService_AddActivityTask_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *MatchingService_AddActivityTask_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_AddDecisionTask_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -7302,69 +8864,41 @@ func (v *MatchingService_AddActivityTask_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 6, Value: w}
 		i++
 	}
+	if v.StickyWorkerUnavailableError != nil {
+		w, err = v.StickyWorkerUnavailableError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
 	if v.TaskListNotOwnedByHostError != nil {
 		w, err = v.TaskListNotOwnedByHostError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 7, Value: w}
+		fields[i] = wire.Field{ID: 8, Value: w}
 		i++
 	}
 
 	if i > 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
-	var v shared.BadRequestError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
-	var v shared.InternalServiceError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
-	var v shared.ServiceBusyError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _LimitExceededError_Read(w wire.Value) (*shared.LimitExceededError, error) {
-	var v shared.LimitExceededError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _DomainNotActiveError_Read(w wire.Value) (*shared.DomainNotActiveError, error) {
-	var v shared.DomainNotActiveError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _RemoteSyncMatchedError_Read(w wire.Value) (*shared.RemoteSyncMatchedError, error) {
-	var v shared.RemoteSyncMatchedError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _TaskListNotOwnedByHostError_Read(w wire.Value) (*shared.TaskListNotOwnedByHostError, error) {
-	var v shared.TaskListNotOwnedByHostError
+func _StickyWorkerUnavailableError_Read(w wire.Value) (*shared.StickyWorkerUnavailableError, error) {
+	var v shared.StickyWorkerUnavailableError
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_AddActivityTask_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_AddDecisionTask_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_AddActivityTask_Result struct
+// An error is returned if we were unable to build a MatchingService_AddDecisionTask_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7372,12 +8906,12 @@ func _TaskListNotOwnedByHostError_Read(w wire.Value) (*shared.TaskListNotOwnedBy
 //	  return nil, err
 //	}
 //
-//	var v MatchingService_AddActivityTask_Result
+//	var v MatchingService_AddDecisionTask_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *MatchingService_AddActivityTask_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_AddDecisionTask_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -7431,6 +8965,14 @@ func (v *MatchingService_AddActivityTask_Result) FromWire(w wire.Value) error {
 
 			}
 		case 7:
+			if field.Value.Type() == wire.TStruct {
+				v.StickyWorkerUnavailableError, err = _StickyWorkerUnavailableError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
 			if field.Value.Type() == wire.TStruct {
 				v.TaskListNotOwnedByHostError, err = _TaskListNotOwnedByHostError_Read(field.Value)
 				if err != nil {
@@ -7460,21 +9002,24 @@ func (v *MatchingService_AddActivityTask_Result) FromWire(w wire.Value) error {
 	if v.RemoteSyncMatchedError != nil {
 		count++
 	}
+	if v.StickyWorkerUnavailableError != nil {
+		count++
+	}
 	if v.TaskListNotOwnedByHostError != nil {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a MatchingService_AddActivityTask_Result struct directly into bytes, without going
+// Encode serializes a MatchingService_AddDecisionTask_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_AddActivityTask_Result struct could not be encoded.
-func (v *MatchingService_AddActivityTask_Result) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_AddDecisionTask_Result struct could not be encoded.
+func (v *MatchingService_AddDecisionTask_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -7551,10 +9096,22 @@ func (v *MatchingService_AddActivityTask_Result) Encode(sw stream.Writer) error
 		}
 	}
 
-	if v.TaskListNotOwnedByHostError != nil {
+	if v.StickyWorkerUnavailableError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TStruct}); err != nil {
 			return err
 		}
+		if err := v.StickyWorkerUnavailableError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TaskListNotOwnedByHostError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TStruct}); err != nil {
+			return err
+		}
 		if err := v.TaskListNotOwnedByHostError.Encode(sw); err != nil {
 			return err
 		}
@@ -7582,65 +9139,32 @@ func (v *MatchingService_AddActivityTask_Result) Encode(sw stream.Writer) error
 	if v.RemoteSyncMatchedError != nil {
 		count++
 	}
+	if v.StickyWorkerUnavailableError != nil {
+		count++
+	}
 	if v.TaskListNotOwnedByHostError != nil {
 		count++
 	}
 
 	if count > 1 {
-		return fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _BadRequestError_Decode(sr stream.Reader) (*shared.BadRequestError, error) {
-	var v shared.BadRequestError
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _InternalServiceError_Decode(sr stream.Reader) (*shared.InternalServiceError, error) {
-	var v shared.InternalServiceError
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _ServiceBusyError_Decode(sr stream.Reader) (*shared.ServiceBusyError, error) {
-	var v shared.ServiceBusyError
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _LimitExceededError_Decode(sr stream.Reader) (*shared.LimitExceededError, error) {
-	var v shared.LimitExceededError
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _DomainNotActiveError_Decode(sr stream.Reader) (*shared.DomainNotActiveError, error) {
-	var v shared.DomainNotActiveError
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _RemoteSyncMatchedError_Decode(sr stream.Reader) (*shared.RemoteSyncMatchedError, error) {
-	var v shared.RemoteSyncMatchedError
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _TaskListNotOwnedByHostError_Decode(sr stream.Reader) (*shared.TaskListNotOwnedByHostError, error) {
-	var v shared.TaskListNotOwnedByHostError
+func _StickyWorkerUnavailableError_Decode(sr stream.Reader) (*shared.StickyWorkerUnavailableError, error) {
+	var v shared.StickyWorkerUnavailableError
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_AddActivityTask_Result struct directly from its Thrift-level
+// Decode deserializes a MatchingService_AddDecisionTask_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_AddActivityTask_Result struct could not be generated from the wire
+// An error is returned if a MatchingService_AddDecisionTask_Result struct could not be generated from the wire
 // representation.
-func (v *MatchingService_AddActivityTask_Result) Decode(sr stream.Reader) error {
+func (v *MatchingService_AddDecisionTask_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -7690,6 +9214,12 @@ func (v *MatchingService_AddActivityTask_Result) Decode(sr stream.Reader) error
 			}
 
 		case fh.ID == 7 && fh.Type == wire.TStruct:
+			v.StickyWorkerUnavailableError, err = _StickyWorkerUnavailableError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TStruct:
 			v.TaskListNotOwnedByHostError, err = _TaskListNotOwnedByHostError_Decode(sr)
 			if err != nil {
 				return err
@@ -7733,24 +9263,27 @@ func (v *MatchingService_AddActivityTask_Result) Decode(sr stream.Reader) error
 	if v.RemoteSyncMatchedError != nil {
 		count++
 	}
+	if v.StickyWorkerUnavailableError != nil {
+		count++
+	}
 	if v.TaskListNotOwnedByHostError != nil {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_AddActivityTask_Result
+// String returns a readable string representation of a MatchingService_AddDecisionTask_Result
 // struct.
-func (v *MatchingService_AddActivityTask_Result) String() string {
+func (v *MatchingService_AddDecisionTask_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [8]string
 	i := 0
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
@@ -7776,19 +9309,23 @@ func (v *MatchingService_AddActivityTask_Result) String() string {
 		fields[i] = fmt.Sprintf("RemoteSyncMatchedError: %v", v.RemoteSyncMatchedError)
 		i++
 	}
+	if v.StickyWorkerUnavailableError != nil {
+		fields[i] = fmt.Sprintf("StickyWorkerUnavailableError: %v", v.StickyWorkerUnavailableError)
+		i++
+	}
 	if v.TaskListNotOwnedByHostError != nil {
 		fields[i] = fmt.Sprintf("TaskListNotOwnedByHostError: %v", v.TaskListNotOwnedByHostError)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_AddActivityTask_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_AddDecisionTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_AddActivityTask_Result match the
-// provided MatchingService_AddActivityTask_Result.
+// Equals returns true if all the fields of this MatchingService_AddDecisionTask_Result match the
+// provided MatchingService_AddDecisionTask_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_AddActivityTask_Result) Equals(rhs *MatchingService_AddActivityTask_Result) bool {
+func (v *MatchingService_AddDecisionTask_Result) Equals(rhs *MatchingService_AddDecisionTask_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -7812,6 +9349,9 @@ func (v *MatchingService_AddActivityTask_Result) Equals(rhs *MatchingService_Add
 	if !((v.RemoteSyncMatchedError == nil && rhs.RemoteSyncMatchedError == nil) || (v.RemoteSyncMatchedError != nil && rhs.RemoteSyncMatchedError != nil && v.RemoteSyncMatchedError.Equals(rhs.RemoteSyncMatchedError))) {
 		return false
 	}
+	if !((v.StickyWorkerUnavailableError == nil && rhs.StickyWorkerUnavailableError == nil) || (v.StickyWorkerUnavailableError != nil && rhs.StickyWorkerUnavailableError != nil && v.StickyWorkerUnavailableError.Equals(rhs.StickyWorkerUnavailableError))) {
+		return false
+	}
 	if !((v.TaskListNotOwnedByHostError == nil && rhs.TaskListNotOwnedByHostError == nil) || (v.TaskListNotOwnedByHostError != nil && rhs.TaskListNotOwnedByHostError != nil && v.TaskListNotOwnedByHostError.Equals(rhs.TaskListNotOwnedByHostError))) {
 		return false
 	}
@@ -7820,8 +9360,8 @@ func (v *MatchingService_AddActivityTask_Result) Equals(rhs *MatchingService_Add
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_AddActivityTask_Result.
-func (v *MatchingService_AddActivityTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_AddDecisionTask_Result.
+func (v *MatchingService_AddDecisionTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -7843,6 +9383,9 @@ func (v *MatchingService_AddActivityTask_Result) MarshalLogObject(enc zapcore.Ob
 	if v.RemoteSyncMatchedError != nil {
 		err = multierr.Append(err, enc.AddObject("remoteSyncMatchedError", v.RemoteSyncMatchedError))
 	}
+	if v.StickyWorkerUnavailableError != nil {
+		err = multierr.Append(err, enc.AddObject("stickyWorkerUnavailableError", v.StickyWorkerUnavailableError))
+	}
 	if v.TaskListNotOwnedByHostError != nil {
 		err = multierr.Append(err, enc.AddObject("taskListNotOwnedByHostError", v.TaskListNotOwnedByHostError))
 	}
@@ -7851,7 +9394,7 @@ func (v *MatchingService_AddActivityTask_Result) MarshalLogObject(enc zapcore.Ob
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_AddDecisionTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -7860,13 +9403,13 @@ func (v *MatchingService_AddActivityTask_Result) GetBadRequestError() (o *shared
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_AddDecisionTask_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_AddDecisionTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -7875,13 +9418,13 @@ func (v *MatchingService_AddActivityTask_Result) GetInternalServiceError() (o *s
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_AddDecisionTask_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_AddDecisionTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -7890,13 +9433,13 @@ func (v *MatchingService_AddActivityTask_Result) GetServiceBusyError() (o *share
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_AddDecisionTask_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // GetLimitExceededError returns the value of LimitExceededError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+func (v *MatchingService_AddDecisionTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
 	if v != nil && v.LimitExceededError != nil {
 		return v.LimitExceededError
 	}
@@ -7905,13 +9448,13 @@ func (v *MatchingService_AddActivityTask_Result) GetLimitExceededError() (o *sha
 }
 
 // IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetLimitExceededError() bool {
+func (v *MatchingService_AddDecisionTask_Result) IsSetLimitExceededError() bool {
 	return v != nil && v.LimitExceededError != nil
 }
 
 // GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+func (v *MatchingService_AddDecisionTask_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
 	if v != nil && v.DomainNotActiveError != nil {
 		return v.DomainNotActiveError
 	}
@@ -7920,13 +9463,13 @@ func (v *MatchingService_AddActivityTask_Result) GetDomainNotActiveError() (o *s
 }
 
 // IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetDomainNotActiveError() bool {
+func (v *MatchingService_AddDecisionTask_Result) IsSetDomainNotActiveError() bool {
 	return v != nil && v.DomainNotActiveError != nil
 }
 
 // GetRemoteSyncMatchedError returns the value of RemoteSyncMatchedError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetRemoteSyncMatchedError() (o *shared.RemoteSyncMatchedError) {
+func (v *MatchingService_AddDecisionTask_Result) GetRemoteSyncMatchedError() (o *shared.RemoteSyncMatchedError) {
 	if v != nil && v.RemoteSyncMatchedError != nil {
 		return v.RemoteSyncMatchedError
 	}
@@ -7935,13 +9478,28 @@ func (v *MatchingService_AddActivityTask_Result) GetRemoteSyncMatchedError() (o
 }
 
 // IsSetRemoteSyncMatchedError returns true if RemoteSyncMatchedError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetRemoteSyncMatchedError() bool {
+func (v *MatchingService_AddDecisionTask_Result) IsSetRemoteSyncMatchedError() bool {
 	return v != nil && v.RemoteSyncMatchedError != nil
 }
 
+// GetStickyWorkerUnavailableError returns the value of StickyWorkerUnavailableError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddDecisionTask_Result) GetStickyWorkerUnavailableError() (o *shared.StickyWorkerUnavailableError) {
+	if v != nil && v.StickyWorkerUnavailableError != nil {
+		return v.StickyWorkerUnavailableError
+	}
+
+	return
+}
+
+// IsSetStickyWorkerUnavailableError returns true if StickyWorkerUnavailableError is not nil.
+func (v *MatchingService_AddDecisionTask_Result) IsSetStickyWorkerUnavailableError() bool {
+	return v != nil && v.StickyWorkerUnavailableError != nil
+}
+
 // GetTaskListNotOwnedByHostError returns the value of TaskListNotOwnedByHostError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetTaskListNotOwnedByHostError() (o *shared.TaskListNotOwnedByHostError) {
+func (v *MatchingService_AddDecisionTask_Result) GetTaskListNotOwnedByHostError() (o *shared.TaskListNotOwnedByHostError) {
 	if v != nil && v.TaskListNotOwnedByHostError != nil {
 		return v.TaskListNotOwnedByHostError
 	}
@@ -7950,33 +9508,33 @@ func (v *MatchingService_AddActivityTask_Result) GetTaskListNotOwnedByHostError(
 }
 
 // IsSetTaskListNotOwnedByHostError returns true if TaskListNotOwnedByHostError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetTaskListNotOwnedByHostError() bool {
+func (v *MatchingService_AddDecisionTask_Result) IsSetTaskListNotOwnedByHostError() bool {
 	return v != nil && v.TaskListNotOwnedByHostError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "AddActivityTask" for this struct.
-func (v *MatchingService_AddActivityTask_Result) MethodName() string {
-	return "AddActivityTask"
+// This will always be "AddDecisionTask" for this struct.
+func (v *MatchingService_AddDecisionTask_Result) MethodName() string {
+	return "AddDecisionTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_AddActivityTask_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_AddDecisionTask_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_AddDecisionTask_Args represents the arguments for the MatchingService.AddDecisionTask function.
+// MatchingService_CancelOutstandingPoll_Args represents the arguments for the MatchingService.CancelOutstandingPoll function.
 //
-// The arguments for AddDecisionTask are sent and received over the wire as this struct.
-type MatchingService_AddDecisionTask_Args struct {
-	AddRequest *AddDecisionTaskRequest `json:"addRequest,omitempty"`
+// The arguments for CancelOutstandingPoll are sent and received over the wire as this struct.
+type MatchingService_CancelOutstandingPoll_Args struct {
+	Request *CancelOutstandingPollRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_AddDecisionTask_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_CancelOutstandingPoll_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7991,7 +9549,7 @@ type MatchingService_AddDecisionTask_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *MatchingService_AddDecisionTask_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_CancelOutstandingPoll_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -7999,8 +9557,8 @@ func (v *MatchingService_AddDecisionTask_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.AddRequest != nil {
-		w, err = v.AddRequest.ToWire()
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -8011,17 +9569,17 @@ func (v *MatchingService_AddDecisionTask_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _AddDecisionTaskRequest_Read(w wire.Value) (*AddDecisionTaskRequest, error) {
-	var v AddDecisionTaskRequest
+func _CancelOutstandingPollRequest_Read(w wire.Value) (*CancelOutstandingPollRequest, error) {
+	var v CancelOutstandingPollRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_AddDecisionTask_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_CancelOutstandingPoll_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_AddDecisionTask_Args struct
+// An error is returned if we were unable to build a MatchingService_CancelOutstandingPoll_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8029,19 +9587,19 @@ func _AddDecisionTaskRequest_Read(w wire.Value) (*AddDecisionTaskRequest, error)
 //	  return nil, err
 //	}
 //
-//	var v MatchingService_AddDecisionTask_Args
+//	var v MatchingService_CancelOutstandingPoll_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *MatchingService_AddDecisionTask_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_CancelOutstandingPoll_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.AddRequest, err = _AddDecisionTaskRequest_Read(field.Value)
+				v.Request, err = _CancelOutstandingPollRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -8053,20 +9611,20 @@ func (v *MatchingService_AddDecisionTask_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a MatchingService_AddDecisionTask_Args struct directly into bytes, without going
+// Encode serializes a MatchingService_CancelOutstandingPoll_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_AddDecisionTask_Args struct could not be encoded.
-func (v *MatchingService_AddDecisionTask_Args) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_CancelOutstandingPoll_Args struct could not be encoded.
+func (v *MatchingService_CancelOutstandingPoll_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.AddRequest != nil {
+	if v.Request != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.AddRequest.Encode(sw); err != nil {
+		if err := v.Request.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -8077,18 +9635,18 @@ func (v *MatchingService_AddDecisionTask_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _AddDecisionTaskRequest_Decode(sr stream.Reader) (*AddDecisionTaskRequest, error) {
-	var v AddDecisionTaskRequest
+func _CancelOutstandingPollRequest_Decode(sr stream.Reader) (*CancelOutstandingPollRequest, error) {
+	var v CancelOutstandingPollRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_AddDecisionTask_Args struct directly from its Thrift-level
+// Decode deserializes a MatchingService_CancelOutstandingPoll_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_AddDecisionTask_Args struct could not be generated from the wire
+// An error is returned if a MatchingService_CancelOutstandingPoll_Args struct could not be generated from the wire
 // representation.
-func (v *MatchingService_AddDecisionTask_Args) Decode(sr stream.Reader) error {
+func (v *MatchingService_CancelOutstandingPoll_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -8102,7 +9660,7 @@ func (v *MatchingService_AddDecisionTask_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.AddRequest, err = _AddDecisionTaskRequest_Decode(sr)
+			v.Request, err = _CancelOutstandingPollRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -8129,34 +9687,34 @@ func (v *MatchingService_AddDecisionTask_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_AddDecisionTask_Args
+// String returns a readable string representation of a MatchingService_CancelOutstandingPoll_Args
 // struct.
-func (v *MatchingService_AddDecisionTask_Args) String() string {
+func (v *MatchingService_CancelOutstandingPoll_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.AddRequest != nil {
-		fields[i] = fmt.Sprintf("AddRequest: %v", v.AddRequest)
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_AddDecisionTask_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_CancelOutstandingPoll_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_AddDecisionTask_Args match the
-// provided MatchingService_AddDecisionTask_Args.
+// Equals returns true if all the fields of this MatchingService_CancelOutstandingPoll_Args match the
+// provided MatchingService_CancelOutstandingPoll_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_AddDecisionTask_Args) Equals(rhs *MatchingService_AddDecisionTask_Args) bool {
+func (v *MatchingService_CancelOutstandingPoll_Args) Equals(rhs *MatchingService_CancelOutstandingPoll_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.AddRequest == nil && rhs.AddRequest == nil) || (v.AddRequest != nil && rhs.AddRequest != nil && v.AddRequest.Equals(rhs.AddRequest))) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -8164,103 +9722,103 @@ func (v *MatchingService_AddDecisionTask_Args) Equals(rhs *MatchingService_AddDe
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_AddDecisionTask_Args.
-func (v *MatchingService_AddDecisionTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_CancelOutstandingPoll_Args.
+func (v *MatchingService_CancelOutstandingPoll_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.AddRequest != nil {
-		err = multierr.Append(err, enc.AddObject("addRequest", v.AddRequest))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetAddRequest returns the value of AddRequest if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Args) GetAddRequest() (o *AddDecisionTaskRequest) {
-	if v != nil && v.AddRequest != nil {
-		return v.AddRequest
+func (v *MatchingService_CancelOutstandingPoll_Args) GetRequest() (o *CancelOutstandingPollRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetAddRequest returns true if AddRequest is not nil.
-func (v *MatchingService_AddDecisionTask_Args) IsSetAddRequest() bool {
-	return v != nil && v.AddRequest != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *MatchingService_CancelOutstandingPoll_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "AddDecisionTask" for this struct.
-func (v *MatchingService_AddDecisionTask_Args) MethodName() string {
-	return "AddDecisionTask"
+// This will always be "CancelOutstandingPoll" for this struct.
+func (v *MatchingService_CancelOutstandingPoll_Args) MethodName() string {
+	return "CancelOutstandingPoll"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_AddDecisionTask_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_CancelOutstandingPoll_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_AddDecisionTask_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.AddDecisionTask
+// MatchingService_CancelOutstandingPoll_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.CancelOutstandingPoll
 // function.
-var MatchingService_AddDecisionTask_Helper = struct {
-	// Args accepts the parameters of AddDecisionTask in-order and returns
+var MatchingService_CancelOutstandingPoll_Helper = struct {
+	// Args accepts the parameters of CancelOutstandingPoll in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		addRequest *AddDecisionTaskRequest,
-	) *MatchingService_AddDecisionTask_Args
+		request *CancelOutstandingPollRequest,
+	) *MatchingService_CancelOutstandingPoll_Args
 
 	// IsException returns true if the given error can be thrown
-	// by AddDecisionTask.
+	// by CancelOutstandingPoll.
 	//
-	// An error can be thrown by AddDecisionTask only if the
+	// An error can be thrown by CancelOutstandingPoll only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for AddDecisionTask
+	// WrapResponse returns the result struct for CancelOutstandingPoll
 	// given the error returned by it. The provided error may
-	// be nil if AddDecisionTask did not fail.
+	// be nil if CancelOutstandingPoll did not fail.
 	//
-	// This allows mapping errors returned by AddDecisionTask into a
+	// This allows mapping errors returned by CancelOutstandingPoll into a
 	// serializable result struct. WrapResponse returns a
 	// non-nil error if the provided error cannot be thrown by
-	// AddDecisionTask
+	// CancelOutstandingPoll
 	//
-	//   err := AddDecisionTask(args)
-	//   result, err := MatchingService_AddDecisionTask_Helper.WrapResponse(err)
+	//   err := CancelOutstandingPoll(args)
+	//   result, err := MatchingService_CancelOutstandingPoll_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from AddDecisionTask: %v", err)
+	//     return fmt.Errorf("unexpected error from CancelOutstandingPoll: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*MatchingService_AddDecisionTask_Result, error)
+	WrapResponse func(error) (*MatchingService_CancelOutstandingPoll_Result, error)
 
-	// UnwrapResponse takes the result struct for AddDecisionTask
+	// UnwrapResponse takes the result struct for CancelOutstandingPoll
 	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if AddDecisionTask threw an
+	// The error is non-nil only if CancelOutstandingPoll threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := MatchingService_AddDecisionTask_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_AddDecisionTask_Result) error
+	//   err := MatchingService_CancelOutstandingPoll_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_CancelOutstandingPoll_Result) error
 }{}
 
 func init() {
-	MatchingService_AddDecisionTask_Helper.Args = func(
-		addRequest *AddDecisionTaskRequest,
-	) *MatchingService_AddDecisionTask_Args {
-		return &MatchingService_AddDecisionTask_Args{
-			AddRequest: addRequest,
+	MatchingService_CancelOutstandingPoll_Helper.Args = func(
+		request *CancelOutstandingPollRequest,
+	) *MatchingService_CancelOutstandingPoll_Args {
+		return &MatchingService_CancelOutstandingPoll_Args{
+			Request: request,
 		}
 	}
 
-	MatchingService_AddDecisionTask_Helper.IsException = func(err error) bool {
+	MatchingService_CancelOutstandingPoll_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -8268,14 +9826,6 @@ func init() {
 			return true
 		case *shared.ServiceBusyError:
 			return true
-		case *shared.LimitExceededError:
-			return true
-		case *shared.DomainNotActiveError:
-			return true
-		case *shared.RemoteSyncMatchedError:
-			return true
-		case *shared.StickyWorkerUnavailableError:
-			return true
 		case *shared.TaskListNotOwnedByHostError:
 			return true
 		default:
@@ -8283,57 +9833,37 @@ func init() {
 		}
 	}
 
-	MatchingService_AddDecisionTask_Helper.WrapResponse = func(err error) (*MatchingService_AddDecisionTask_Result, error) {
+	MatchingService_CancelOutstandingPoll_Helper.WrapResponse = func(err error) (*MatchingService_CancelOutstandingPoll_Result, error) {
 		if err == nil {
-			return &MatchingService_AddDecisionTask_Result{}, nil
+			return &MatchingService_CancelOutstandingPoll_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.BadRequestError")
 			}
-			return &MatchingService_AddDecisionTask_Result{BadRequestError: e}, nil
+			return &MatchingService_CancelOutstandingPoll_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.InternalServiceError")
 			}
-			return &MatchingService_AddDecisionTask_Result{InternalServiceError: e}, nil
+			return &MatchingService_CancelOutstandingPoll_Result{InternalServiceError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.ServiceBusyError")
-			}
-			return &MatchingService_AddDecisionTask_Result{ServiceBusyError: e}, nil
-		case *shared.LimitExceededError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.LimitExceededError")
-			}
-			return &MatchingService_AddDecisionTask_Result{LimitExceededError: e}, nil
-		case *shared.DomainNotActiveError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.DomainNotActiveError")
-			}
-			return &MatchingService_AddDecisionTask_Result{DomainNotActiveError: e}, nil
-		case *shared.RemoteSyncMatchedError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.RemoteSyncMatchedError")
-			}
-			return &MatchingService_AddDecisionTask_Result{RemoteSyncMatchedError: e}, nil
-		case *shared.StickyWorkerUnavailableError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.StickyWorkerUnavailableError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.ServiceBusyError")
 			}
-			return &MatchingService_AddDecisionTask_Result{StickyWorkerUnavailableError: e}, nil
+			return &MatchingService_CancelOutstandingPoll_Result{ServiceBusyError: e}, nil
 		case *shared.TaskListNotOwnedByHostError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.TaskListNotOwnedByHostError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.TaskListNotOwnedByHostError")
 			}
-			return &MatchingService_AddDecisionTask_Result{TaskListNotOwnedByHostError: e}, nil
+			return &MatchingService_CancelOutstandingPoll_Result{TaskListNotOwnedByHostError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_AddDecisionTask_Helper.UnwrapResponse = func(result *MatchingService_AddDecisionTask_Result) (err error) {
+	MatchingService_CancelOutstandingPoll_Helper.UnwrapResponse = func(result *MatchingService_CancelOutstandingPoll_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -8346,22 +9876,6 @@ func init() {
 			err = result.ServiceBusyError
 			return
 		}
-		if result.LimitExceededError != nil {
-			err = result.LimitExceededError
-			return
-		}
-		if result.DomainNotActiveError != nil {
-			err = result.DomainNotActiveError
-			return
-		}
-		if result.RemoteSyncMatchedError != nil {
-			err = result.RemoteSyncMatchedError
-			return
-		}
-		if result.StickyWorkerUnavailableError != nil {
-			err = result.StickyWorkerUnavailableError
-			return
-		}
 		if result.TaskListNotOwnedByHostError != nil {
 			err = result.TaskListNotOwnedByHostError
 			return
@@ -8371,21 +9885,17 @@ func init() {
 
 }
 
-// MatchingService_AddDecisionTask_Result represents the result of a MatchingService.AddDecisionTask function call.
+// MatchingService_CancelOutstandingPoll_Result represents the result of a MatchingService.CancelOutstandingPoll function call.
 //
-// The result of a AddDecisionTask execution is sent and received over the wire as this struct.
-type MatchingService_AddDecisionTask_Result struct {
-	BadRequestError              *shared.BadRequestError              `json:"badRequestError,omitempty"`
-	InternalServiceError         *shared.InternalServiceError         `json:"internalServiceError,omitempty"`
-	ServiceBusyError             *shared.ServiceBusyError             `json:"serviceBusyError,omitempty"`
-	LimitExceededError           *shared.LimitExceededError           `json:"limitExceededError,omitempty"`
-	DomainNotActiveError         *shared.DomainNotActiveError         `json:"domainNotActiveError,omitempty"`
-	RemoteSyncMatchedError       *shared.RemoteSyncMatchedError       `json:"remoteSyncMatchedError,omitempty"`
-	StickyWorkerUnavailableError *shared.StickyWorkerUnavailableError `json:"stickyWorkerUnavailableError,omitempty"`
-	TaskListNotOwnedByHostError  *shared.TaskListNotOwnedByHostError  `json:"taskListNotOwnedByHostError,omitempty"`
+// The result of a CancelOutstandingPoll execution is sent and received over the wire as this struct.
+type MatchingService_CancelOutstandingPoll_Result struct {
+	BadRequestError             *shared.BadRequestError             `json:"badRequestError,omitempty"`
+	InternalServiceError        *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
+	ServiceBusyError            *shared.ServiceBusyError            `json:"serviceBusyError,omitempty"`
+	TaskListNotOwnedByHostError *shared.TaskListNotOwnedByHostError `json:"taskListNotOwnedByHostError,omitempty"`
 }
 
-// ToWire translates a MatchingService_AddDecisionTask_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_CancelOutstandingPoll_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -8400,9 +9910,9 @@ type MatchingService_AddDecisionTask_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *MatchingService_AddDecisionTask_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_CancelOutstandingPoll_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [8]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -8413,55 +9923,23 @@ func (v *MatchingService_AddDecisionTask_Result) ToWire() (wire.Value, error) {
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 1, Value: w}
-		i++
-	}
-	if v.InternalServiceError != nil {
-		w, err = v.InternalServiceError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 2, Value: w}
-		i++
-	}
-	if v.ServiceBusyError != nil {
-		w, err = v.ServiceBusyError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
-	}
-	if v.LimitExceededError != nil {
-		w, err = v.LimitExceededError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
-	if v.DomainNotActiveError != nil {
-		w, err = v.DomainNotActiveError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
+		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.RemoteSyncMatchedError != nil {
-		w, err = v.RemoteSyncMatchedError.ToWire()
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 6, Value: w}
+		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.StickyWorkerUnavailableError != nil {
-		w, err = v.StickyWorkerUnavailableError.ToWire()
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 7, Value: w}
+		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
 	if v.TaskListNotOwnedByHostError != nil {
@@ -8469,28 +9947,22 @@ func (v *MatchingService_AddDecisionTask_Result) ToWire() (wire.Value, error) {
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 8, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 
 	if i > 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _StickyWorkerUnavailableError_Read(w wire.Value) (*shared.StickyWorkerUnavailableError, error) {
-	var v shared.StickyWorkerUnavailableError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a MatchingService_AddDecisionTask_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_CancelOutstandingPoll_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_AddDecisionTask_Result struct
+// An error is returned if we were unable to build a MatchingService_CancelOutstandingPoll_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8498,12 +9970,12 @@ func _StickyWorkerUnavailableError_Read(w wire.Value) (*shared.StickyWorkerUnava
 //	  return nil, err
 //	}
 //
-//	var v MatchingService_AddDecisionTask_Result
+//	var v MatchingService_CancelOutstandingPoll_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *MatchingService_AddDecisionTask_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_CancelOutstandingPoll_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -8533,38 +10005,6 @@ func (v *MatchingService_AddDecisionTask_Result) FromWire(w wire.Value) error {
 
 			}
 		case 4:
-			if field.Value.Type() == wire.TStruct {
-				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 5:
-			if field.Value.Type() == wire.TStruct {
-				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 6:
-			if field.Value.Type() == wire.TStruct {
-				v.RemoteSyncMatchedError, err = _RemoteSyncMatchedError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 7:
-			if field.Value.Type() == wire.TStruct {
-				v.StickyWorkerUnavailableError, err = _StickyWorkerUnavailableError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 8:
 			if field.Value.Type() == wire.TStruct {
 				v.TaskListNotOwnedByHostError, err = _TaskListNotOwnedByHostError_Read(field.Value)
 				if err != nil {
@@ -8585,33 +10025,21 @@ func (v *MatchingService_AddDecisionTask_Result) FromWire(w wire.Value) error {
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
-		count++
-	}
-	if v.DomainNotActiveError != nil {
-		count++
-	}
-	if v.RemoteSyncMatchedError != nil {
-		count++
-	}
-	if v.StickyWorkerUnavailableError != nil {
-		count++
-	}
 	if v.TaskListNotOwnedByHostError != nil {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a MatchingService_AddDecisionTask_Result struct directly into bytes, without going
+// Encode serializes a MatchingService_CancelOutstandingPoll_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_AddDecisionTask_Result struct could not be encoded.
-func (v *MatchingService_AddDecisionTask_Result) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_CancelOutstandingPoll_Result struct could not be encoded.
+func (v *MatchingService_CancelOutstandingPoll_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -8652,56 +10080,8 @@ func (v *MatchingService_AddDecisionTask_Result) Encode(sw stream.Writer) error
 		}
 	}
 
-	if v.LimitExceededError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.LimitExceededError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.DomainNotActiveError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.DomainNotActiveError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.RemoteSyncMatchedError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.RemoteSyncMatchedError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.StickyWorkerUnavailableError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.StickyWorkerUnavailableError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
 	if v.TaskListNotOwnedByHostError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.TaskListNotOwnedByHostError.Encode(sw); err != nil {
@@ -8722,41 +10102,23 @@ func (v *MatchingService_AddDecisionTask_Result) Encode(sw stream.Writer) error
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
-		count++
-	}
-	if v.DomainNotActiveError != nil {
-		count++
-	}
-	if v.RemoteSyncMatchedError != nil {
-		count++
-	}
-	if v.StickyWorkerUnavailableError != nil {
-		count++
-	}
 	if v.TaskListNotOwnedByHostError != nil {
 		count++
 	}
 
 	if count > 1 {
-		return fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _StickyWorkerUnavailableError_Decode(sr stream.Reader) (*shared.StickyWorkerUnavailableError, error) {
-	var v shared.StickyWorkerUnavailableError
-	err := v.Decode(sr)
-	return &v, err
-}
-
-// Decode deserializes a MatchingService_AddDecisionTask_Result struct directly from its Thrift-level
+// Decode deserializes a MatchingService_CancelOutstandingPoll_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_AddDecisionTask_Result struct could not be generated from the wire
+// An error is returned if a MatchingService_CancelOutstandingPoll_Result struct could not be generated from the wire
 // representation.
-func (v *MatchingService_AddDecisionTask_Result) Decode(sr stream.Reader) error {
+func (v *MatchingService_CancelOutstandingPoll_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -8788,30 +10150,6 @@ func (v *MatchingService_AddDecisionTask_Result) Decode(sr stream.Reader) error
 			}
 
 		case fh.ID == 4 && fh.Type == wire.TStruct:
-			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 5 && fh.Type == wire.TStruct:
-			v.DomainNotActiveError, err = _DomainNotActiveError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 6 && fh.Type == wire.TStruct:
-			v.RemoteSyncMatchedError, err = _RemoteSyncMatchedError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 7 && fh.Type == wire.TStruct:
-			v.StickyWorkerUnavailableError, err = _StickyWorkerUnavailableError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 8 && fh.Type == wire.TStruct:
 			v.TaskListNotOwnedByHostError, err = _TaskListNotOwnedByHostError_Decode(sr)
 			if err != nil {
 				return err
@@ -8846,36 +10184,24 @@ func (v *MatchingService_AddDecisionTask_Result) Decode(sr stream.Reader) error
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
-		count++
-	}
-	if v.DomainNotActiveError != nil {
-		count++
-	}
-	if v.RemoteSyncMatchedError != nil {
-		count++
-	}
-	if v.StickyWorkerUnavailableError != nil {
-		count++
-	}
 	if v.TaskListNotOwnedByHostError != nil {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_AddDecisionTask_Result
+// String returns a readable string representation of a MatchingService_CancelOutstandingPoll_Result
 // struct.
-func (v *MatchingService_AddDecisionTask_Result) String() string {
+func (v *MatchingService_CancelOutstandingPoll_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [8]string
+	var fields [4]string
 	i := 0
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
@@ -8889,35 +10215,19 @@ func (v *MatchingService_AddDecisionTask_Result) String() string {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
-	if v.LimitExceededError != nil {
-		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
-		i++
-	}
-	if v.DomainNotActiveError != nil {
-		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
-		i++
-	}
-	if v.RemoteSyncMatchedError != nil {
-		fields[i] = fmt.Sprintf("RemoteSyncMatchedError: %v", v.RemoteSyncMatchedError)
-		i++
-	}
-	if v.StickyWorkerUnavailableError != nil {
-		fields[i] = fmt.Sprintf("StickyWorkerUnavailableError: %v", v.StickyWorkerUnavailableError)
-		i++
-	}
 	if v.TaskListNotOwnedByHostError != nil {
 		fields[i] = fmt.Sprintf("TaskListNotOwnedByHostError: %v", v.TaskListNotOwnedByHostError)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_AddDecisionTask_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_CancelOutstandingPoll_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_AddDecisionTask_Result match the
-// provided MatchingService_AddDecisionTask_Result.
+// Equals returns true if all the fields of this MatchingService_CancelOutstandingPoll_Result match the
+// provided MatchingService_CancelOutstandingPoll_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_AddDecisionTask_Result) Equals(rhs *MatchingService_AddDecisionTask_Result) bool {
+func (v *MatchingService_CancelOutstandingPoll_Result) Equals(rhs *MatchingService_CancelOutstandingPoll_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -8932,18 +10242,6 @@ func (v *MatchingService_AddDecisionTask_Result) Equals(rhs *MatchingService_Add
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
-	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
-		return false
-	}
-	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
-		return false
-	}
-	if !((v.RemoteSyncMatchedError == nil && rhs.RemoteSyncMatchedError == nil) || (v.RemoteSyncMatchedError != nil && rhs.RemoteSyncMatchedError != nil && v.RemoteSyncMatchedError.Equals(rhs.RemoteSyncMatchedError))) {
-		return false
-	}
-	if !((v.StickyWorkerUnavailableError == nil && rhs.StickyWorkerUnavailableError == nil) || (v.StickyWorkerUnavailableError != nil && rhs.StickyWorkerUnavailableError != nil && v.StickyWorkerUnavailableError.Equals(rhs.StickyWorkerUnavailableError))) {
-		return false
-	}
 	if !((v.TaskListNotOwnedByHostError == nil && rhs.TaskListNotOwnedByHostError == nil) || (v.TaskListNotOwnedByHostError != nil && rhs.TaskListNotOwnedByHostError != nil && v.TaskListNotOwnedByHostError.Equals(rhs.TaskListNotOwnedByHostError))) {
 		return false
 	}
@@ -8952,31 +10250,19 @@ func (v *MatchingService_AddDecisionTask_Result) Equals(rhs *MatchingService_Add
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_AddDecisionTask_Result.
-func (v *MatchingService_AddDecisionTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_CancelOutstandingPoll_Result.
+func (v *MatchingService_CancelOutstandingPoll_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.BadRequestError != nil {
-		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
-	}
-	if v.InternalServiceError != nil {
-		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
-	}
-	if v.ServiceBusyError != nil {
-		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
-	}
-	if v.LimitExceededError != nil {
-		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
-	}
-	if v.DomainNotActiveError != nil {
-		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.RemoteSyncMatchedError != nil {
-		err = multierr.Append(err, enc.AddObject("remoteSyncMatchedError", v.RemoteSyncMatchedError))
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.StickyWorkerUnavailableError != nil {
-		err = multierr.Append(err, enc.AddObject("stickyWorkerUnavailableError", v.StickyWorkerUnavailableError))
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
 	if v.TaskListNotOwnedByHostError != nil {
 		err = multierr.Append(err, enc.AddObject("taskListNotOwnedByHostError", v.TaskListNotOwnedByHostError))
@@ -8986,7 +10272,7 @@ func (v *MatchingService_AddDecisionTask_Result) MarshalLogObject(enc zapcore.Ob
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_CancelOutstandingPoll_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -8995,13 +10281,13 @@ func (v *MatchingService_AddDecisionTask_Result) GetBadRequestError() (o *shared
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_CancelOutstandingPoll_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_CancelOutstandingPoll_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -9010,13 +10296,13 @@ func (v *MatchingService_AddDecisionTask_Result) GetInternalServiceError() (o *s
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_CancelOutstandingPoll_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_CancelOutstandingPoll_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -9025,73 +10311,13 @@ func (v *MatchingService_AddDecisionTask_Result) GetServiceBusyError() (o *share
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_CancelOutstandingPoll_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
-// GetLimitExceededError returns the value of LimitExceededError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
-	if v != nil && v.LimitExceededError != nil {
-		return v.LimitExceededError
-	}
-
-	return
-}
-
-// IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetLimitExceededError() bool {
-	return v != nil && v.LimitExceededError != nil
-}
-
-// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
-	if v != nil && v.DomainNotActiveError != nil {
-		return v.DomainNotActiveError
-	}
-
-	return
-}
-
-// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetDomainNotActiveError() bool {
-	return v != nil && v.DomainNotActiveError != nil
-}
-
-// GetRemoteSyncMatchedError returns the value of RemoteSyncMatchedError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetRemoteSyncMatchedError() (o *shared.RemoteSyncMatchedError) {
-	if v != nil && v.RemoteSyncMatchedError != nil {
-		return v.RemoteSyncMatchedError
-	}
-
-	return
-}
-
-// IsSetRemoteSyncMatchedError returns true if RemoteSyncMatchedError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetRemoteSyncMatchedError() bool {
-	return v != nil && v.RemoteSyncMatchedError != nil
-}
-
-// GetStickyWorkerUnavailableError returns the value of StickyWorkerUnavailableError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetStickyWorkerUnavailableError() (o *shared.StickyWorkerUnavailableError) {
-	if v != nil && v.StickyWorkerUnavailableError != nil {
-		return v.StickyWorkerUnavailableError
-	}
-
-	return
-}
-
-// IsSetStickyWorkerUnavailableError returns true if StickyWorkerUnavailableError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetStickyWorkerUnavailableError() bool {
-	return v != nil && v.StickyWorkerUnavailableError != nil
-}
-
 // GetTaskListNotOwnedByHostError returns the value of TaskListNotOwnedByHostError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetTaskListNotOwnedByHostError() (o *shared.TaskListNotOwnedByHostError) {
+func (v *MatchingService_CancelOutstandingPoll_Result) GetTaskListNotOwnedByHostError() (o *shared.TaskListNotOwnedByHostError) {
 	if v != nil && v.TaskListNotOwnedByHostError != nil {
 		return v.TaskListNotOwnedByHostError
 	}
@@ -9100,33 +10326,33 @@ func (v *MatchingService_AddDecisionTask_Result) GetTaskListNotOwnedByHostError(
 }
 
 // IsSetTaskListNotOwnedByHostError returns true if TaskListNotOwnedByHostError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetTaskListNotOwnedByHostError() bool {
+func (v *MatchingService_CancelOutstandingPoll_Result) IsSetTaskListNotOwnedByHostError() bool {
 	return v != nil && v.TaskListNotOwnedByHostError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "AddDecisionTask" for this struct.
-func (v *MatchingService_AddDecisionTask_Result) MethodName() string {
-	return "AddDecisionTask"
+// This will always be "CancelOutstandingPoll" for this struct.
+func (v *MatchingService_CancelOutstandingPoll_Result) MethodName() string {
+	return "CancelOutstandingPoll"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_AddDecisionTask_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_CancelOutstandingPoll_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_CancelOutstandingPoll_Args represents the arguments for the MatchingService.CancelOutstandingPoll function.
+// MatchingService_DescribeTaskList_Args represents the arguments for the MatchingService.DescribeTaskList function.
 //
-// The arguments for CancelOutstandingPoll are sent and received over the wire as this struct.
-type MatchingService_CancelOutstandingPoll_Args struct {
-	Request *CancelOutstandingPollRequest `json:"request,omitempty"`
+// The arguments for DescribeTaskList are sent and received over the wire as this struct.
+type MatchingService_DescribeTaskList_Args struct {
+	Request *DescribeTaskListRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_CancelOutstandingPoll_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_DescribeTaskList_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -9141,7 +10367,7 @@ type MatchingService_CancelOutstandingPoll_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *MatchingService_CancelOutstandingPoll_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_DescribeTaskList_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -9161,17 +10387,17 @@ func (v *MatchingService_CancelOutstandingPoll_Args) ToWire() (wire.Value, error
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _CancelOutstandingPollRequest_Read(w wire.Value) (*CancelOutstandingPollRequest, error) {
-	var v CancelOutstandingPollRequest
+func _DescribeTaskListRequest_1_Read(w wire.Value) (*DescribeTaskListRequest, error) {
+	var v DescribeTaskListRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_CancelOutstandingPoll_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_DescribeTaskList_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_CancelOutstandingPoll_Args struct
+// An error is returned if we were unable to build a MatchingService_DescribeTaskList_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -9179,19 +10405,19 @@ func _CancelOutstandingPollRequest_Read(w wire.Value) (*CancelOutstandingPollReq
 //	  return nil, err
 //	}
 //
-//	var v MatchingService_CancelOutstandingPoll_Args
+//	var v MatchingService_DescribeTaskList_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *MatchingService_CancelOutstandingPoll_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_DescribeTaskList_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _CancelOutstandingPollRequest_Read(field.Value)
+				v.Request, err = _DescribeTaskListRequest_1_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -9203,11 +10429,11 @@ func (v *MatchingService_CancelOutstandingPoll_Args) FromWire(w wire.Value) erro
 	return nil
 }
 
-// Encode serializes a MatchingService_CancelOutstandingPoll_Args struct directly into bytes, without going
+// Encode serializes a MatchingService_DescribeTaskList_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_CancelOutstandingPoll_Args struct could not be encoded.
-func (v *MatchingService_CancelOutstandingPoll_Args) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_DescribeTaskList_Args struct could not be encoded.
+func (v *MatchingService_DescribeTaskList_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -9227,18 +10453,18 @@ func (v *MatchingService_CancelOutstandingPoll_Args) Encode(sw stream.Writer) er
 	return sw.WriteStructEnd()
 }
 
-func _CancelOutstandingPollRequest_Decode(sr stream.Reader) (*CancelOutstandingPollRequest, error) {
-	var v CancelOutstandingPollRequest
+func _DescribeTaskListRequest_1_Decode(sr stream.Reader) (*DescribeTaskListRequest, error) {
+	var v DescribeTaskListRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_CancelOutstandingPoll_Args struct directly from its Thrift-level
+// Decode deserializes a MatchingService_DescribeTaskList_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_CancelOutstandingPoll_Args struct could not be generated from the wire
+// An error is returned if a MatchingService_DescribeTaskList_Args struct could not be generated from the wire
 // representation.
-func (v *MatchingService_CancelOutstandingPoll_Args) Decode(sr stream.Reader) error {
+func (v *MatchingService_DescribeTaskList_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -9252,7 +10478,7 @@ func (v *MatchingService_CancelOutstandingPoll_Args) Decode(sr stream.Reader) er
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _CancelOutstandingPollRequest_Decode(sr)
+			v.Request, err = _DescribeTaskListRequest_1_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -9279,9 +10505,9 @@ func (v *MatchingService_CancelOutstandingPoll_Args) Decode(sr stream.Reader) er
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_CancelOutstandingPoll_Args
+// String returns a readable string representation of a MatchingService_DescribeTaskList_Args
 // struct.
-func (v *MatchingService_CancelOutstandingPoll_Args) String() string {
+func (v *MatchingService_DescribeTaskList_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -9293,14 +10519,14 @@ func (v *MatchingService_CancelOutstandingPoll_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_CancelOutstandingPoll_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_DescribeTaskList_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_CancelOutstandingPoll_Args match the
-// provided MatchingService_CancelOutstandingPoll_Args.
+// Equals returns true if all the fields of this MatchingService_DescribeTaskList_Args match the
+// provided MatchingService_DescribeTaskList_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_CancelOutstandingPoll_Args) Equals(rhs *MatchingService_CancelOutstandingPoll_Args) bool {
+func (v *MatchingService_DescribeTaskList_Args) Equals(rhs *MatchingService_DescribeTaskList_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -9314,8 +10540,8 @@ func (v *MatchingService_CancelOutstandingPoll_Args) Equals(rhs *MatchingService
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_CancelOutstandingPoll_Args.
-func (v *MatchingService_CancelOutstandingPoll_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_DescribeTaskList_Args.
+func (v *MatchingService_DescribeTaskList_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -9327,7 +10553,7 @@ func (v *MatchingService_CancelOutstandingPoll_Args) MarshalLogObject(enc zapcor
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_CancelOutstandingPoll_Args) GetRequest() (o *CancelOutstandingPollRequest) {
+func (v *MatchingService_DescribeTaskList_Args) GetRequest() (o *DescribeTaskListRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -9336,86 +10562,87 @@ func (v *MatchingService_CancelOutstandingPoll_Args) GetRequest() (o *CancelOuts
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *MatchingService_CancelOutstandingPoll_Args) IsSetRequest() bool {
+func (v *MatchingService_DescribeTaskList_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "CancelOutstandingPoll" for this struct.
-func (v *MatchingService_CancelOutstandingPoll_Args) MethodName() string {
-	return "CancelOutstandingPoll"
+// This will always be "DescribeTaskList" for this struct.
+func (v *MatchingService_DescribeTaskList_Args) MethodName() string {
+	return "DescribeTaskList"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_CancelOutstandingPoll_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_DescribeTaskList_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_CancelOutstandingPoll_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.CancelOutstandingPoll
+// MatchingService_DescribeTaskList_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.DescribeTaskList
 // function.
-var MatchingService_CancelOutstandingPoll_Helper = struct {
-	// Args accepts the parameters of CancelOutstandingPoll in-order and returns
+var MatchingService_DescribeTaskList_Helper = struct {
+	// Args accepts the parameters of DescribeTaskList in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *CancelOutstandingPollRequest,
-	) *MatchingService_CancelOutstandingPoll_Args
+		request *DescribeTaskListRequest,
+	) *MatchingService_DescribeTaskList_Args
 
 	// IsException returns true if the given error can be thrown
-	// by CancelOutstandingPoll.
+	// by DescribeTaskList.
 	//
-	// An error can be thrown by CancelOutstandingPoll only if the
+	// An error can be thrown by DescribeTaskList only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for CancelOutstandingPoll
-	// given the error returned by it. The provided error may
-	// be nil if CancelOutstandingPoll did not fail.
+	// WrapResponse returns the result struct for DescribeTaskList
+	// given its return value and error.
 	//
-	// This allows mapping errors returned by CancelOutstandingPoll into a
-	// serializable result struct. WrapResponse returns a
-	// non-nil error if the provided error cannot be thrown by
-	// CancelOutstandingPoll
+	// This allows mapping values and errors returned by
+	// DescribeTaskList into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeTaskList
 	//
-	//   err := CancelOutstandingPoll(args)
-	//   result, err := MatchingService_CancelOutstandingPoll_Helper.WrapResponse(err)
+	//   value, err := DescribeTaskList(args)
+	//   result, err := MatchingService_DescribeTaskList_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from CancelOutstandingPoll: %v", err)
+	//     return fmt.Errorf("unexpected error from DescribeTaskList: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*MatchingService_CancelOutstandingPoll_Result, error)
+	WrapResponse func(*shared.DescribeTaskListResponse, error) (*MatchingService_DescribeTaskList_Result, error)
 
-	// UnwrapResponse takes the result struct for CancelOutstandingPoll
-	// and returns the erorr returned by it (if any).
+	// UnwrapResponse takes the result struct for DescribeTaskList
+	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if CancelOutstandingPoll threw an
+	// The error is non-nil only if DescribeTaskList threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := MatchingService_CancelOutstandingPoll_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_CancelOutstandingPoll_Result) error
+	//   value, err := MatchingService_DescribeTaskList_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_DescribeTaskList_Result) (*shared.DescribeTaskListResponse, error)
 }{}
 
 func init() {
-	MatchingService_CancelOutstandingPoll_Helper.Args = func(
-		request *CancelOutstandingPollRequest,
-	) *MatchingService_CancelOutstandingPoll_Args {
-		return &MatchingService_CancelOutstandingPoll_Args{
+	MatchingService_DescribeTaskList_Helper.Args = func(
+		request *DescribeTaskListRequest,
+	) *MatchingService_DescribeTaskList_Args {
+		return &MatchingService_DescribeTaskList_Args{
 			Request: request,
 		}
 	}
 
-	MatchingService_CancelOutstandingPoll_Helper.IsException = func(err error) bool {
+	MatchingService_DescribeTaskList_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.EntityNotExistsError:
+			return true
 		case *shared.ServiceBusyError:
 			return true
 		case *shared.TaskListNotOwnedByHostError:
@@ -9425,37 +10652,42 @@ func init() {
 		}
 	}
 
-	MatchingService_CancelOutstandingPoll_Helper.WrapResponse = func(err error) (*MatchingService_CancelOutstandingPoll_Result, error) {
+	MatchingService_DescribeTaskList_Helper.WrapResponse = func(success *shared.DescribeTaskListResponse, err error) (*MatchingService_DescribeTaskList_Result, error) {
 		if err == nil {
-			return &MatchingService_CancelOutstandingPoll_Result{}, nil
+			return &MatchingService_DescribeTaskList_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.BadRequestError")
 			}
-			return &MatchingService_CancelOutstandingPoll_Result{BadRequestError: e}, nil
+			return &MatchingService_DescribeTaskList_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.InternalServiceError")
 			}
-			return &MatchingService_CancelOutstandingPoll_Result{InternalServiceError: e}, nil
+			return &MatchingService_DescribeTaskList_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.EntityNotExistError")
+			}
+			return &MatchingService_DescribeTaskList_Result{EntityNotExistError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.ServiceBusyError")
 			}
-			return &MatchingService_CancelOutstandingPoll_Result{ServiceBusyError: e}, nil
+			return &MatchingService_DescribeTaskList_Result{ServiceBusyError: e}, nil
 		case *shared.TaskListNotOwnedByHostError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.TaskListNotOwnedByHostError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.TaskListNotOwnedByHostError")
 			}
-			return &MatchingService_CancelOutstandingPoll_Result{TaskListNotOwnedByHostError: e}, nil
+			return &MatchingService_DescribeTaskList_Result{TaskListNotOwnedByHostError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_CancelOutstandingPoll_Helper.UnwrapResponse = func(result *MatchingService_CancelOutstandingPoll_Result) (err error) {
+	MatchingService_DescribeTaskList_Helper.UnwrapResponse = func(result *MatchingService_DescribeTaskList_Result) (success *shared.DescribeTaskListResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -9464,6 +10696,10 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
@@ -9472,22 +10708,34 @@ func init() {
 			err = result.TaskListNotOwnedByHostError
 			return
 		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// MatchingService_CancelOutstandingPoll_Result represents the result of a MatchingService.CancelOutstandingPoll function call.
+// MatchingService_DescribeTaskList_Result represents the result of a MatchingService.DescribeTaskList function call.
 //
-// The result of a CancelOutstandingPoll execution is sent and received over the wire as this struct.
-type MatchingService_CancelOutstandingPoll_Result struct {
+// The result of a DescribeTaskList execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type MatchingService_DescribeTaskList_Result struct {
+	// Value returned by DescribeTaskList after a successful execution.
+	Success                     *shared.DescribeTaskListResponse    `json:"success,omitempty"`
 	BadRequestError             *shared.BadRequestError             `json:"badRequestError,omitempty"`
 	InternalServiceError        *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
+	EntityNotExistError         *shared.EntityNotExistsError        `json:"entityNotExistError,omitempty"`
 	ServiceBusyError            *shared.ServiceBusyError            `json:"serviceBusyError,omitempty"`
 	TaskListNotOwnedByHostError *shared.TaskListNotOwnedByHostError `json:"taskListNotOwnedByHostError,omitempty"`
 }
 
-// ToWire translates a MatchingService_CancelOutstandingPoll_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_DescribeTaskList_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -9502,14 +10750,22 @@ type MatchingService_CancelOutstandingPoll_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *MatchingService_CancelOutstandingPoll_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_DescribeTaskList_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -9526,12 +10782,20 @@ func (v *MatchingService_CancelOutstandingPoll_Result) ToWire() (wire.Value, err
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
 	if v.ServiceBusyError != nil {
 		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 	if v.TaskListNotOwnedByHostError != nil {
@@ -9539,22 +10803,34 @@ func (v *MatchingService_CancelOutstandingPoll_Result) ToWire() (wire.Value, err
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 4, Value: w}
+		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
 
-	if i > 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", i)
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a MatchingService_CancelOutstandingPoll_Result struct from its Thrift-level
+func _DescribeTaskListResponse_Read(w wire.Value) (*shared.DescribeTaskListResponse, error) {
+	var v shared.DescribeTaskListResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
+	var v shared.EntityNotExistsError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_DescribeTaskList_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_CancelOutstandingPoll_Result struct
+// An error is returned if we were unable to build a MatchingService_DescribeTaskList_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -9562,16 +10838,24 @@ func (v *MatchingService_CancelOutstandingPoll_Result) ToWire() (wire.Value, err
 //	  return nil, err
 //	}
 //
-//	var v MatchingService_CancelOutstandingPoll_Result
+//	var v MatchingService_DescribeTaskList_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *MatchingService_CancelOutstandingPoll_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_DescribeTaskList_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeTaskListResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -9590,13 +10874,21 @@ func (v *MatchingService_CancelOutstandingPoll_Result) FromWire(w wire.Value) er
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
 			if field.Value.Type() == wire.TStruct {
 				v.TaskListNotOwnedByHostError, err = _TaskListNotOwnedByHostError_Read(field.Value)
 				if err != nil {
@@ -9608,34 +10900,52 @@ func (v *MatchingService_CancelOutstandingPoll_Result) FromWire(w wire.Value) er
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
 	if v.TaskListNotOwnedByHostError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a MatchingService_CancelOutstandingPoll_Result struct directly into bytes, without going
+// Encode serializes a MatchingService_DescribeTaskList_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_CancelOutstandingPoll_Result struct could not be encoded.
-func (v *MatchingService_CancelOutstandingPoll_Result) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_DescribeTaskList_Result struct could not be encoded.
+func (v *MatchingService_DescribeTaskList_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	if v.BadRequestError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
@@ -9660,10 +10970,22 @@ func (v *MatchingService_CancelOutstandingPoll_Result) Encode(sw stream.Writer)
 		}
 	}
 
-	if v.ServiceBusyError != nil {
+	if v.EntityNotExistError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
 		if err := v.ServiceBusyError.Encode(sw); err != nil {
 			return err
 		}
@@ -9673,7 +10995,7 @@ func (v *MatchingService_CancelOutstandingPoll_Result) Encode(sw stream.Writer)
 	}
 
 	if v.TaskListNotOwnedByHostError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.TaskListNotOwnedByHostError.Encode(sw); err != nil {
@@ -9685,12 +11007,18 @@ func (v *MatchingService_CancelOutstandingPoll_Result) Encode(sw stream.Writer)
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
@@ -9698,19 +11026,31 @@ func (v *MatchingService_CancelOutstandingPoll_Result) Encode(sw stream.Writer)
 		count++
 	}
 
-	if count > 1 {
-		return fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a MatchingService_CancelOutstandingPoll_Result struct directly from its Thrift-level
+func _DescribeTaskListResponse_Decode(sr stream.Reader) (*shared.DescribeTaskListResponse, error) {
+	var v shared.DescribeTaskListResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _EntityNotExistsError_Decode(sr stream.Reader) (*shared.EntityNotExistsError, error) {
+	var v shared.EntityNotExistsError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a MatchingService_DescribeTaskList_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_CancelOutstandingPoll_Result struct could not be generated from the wire
+// An error is returned if a MatchingService_DescribeTaskList_Result struct could not be generated from the wire
 // representation.
-func (v *MatchingService_CancelOutstandingPoll_Result) Decode(sr stream.Reader) error {
+func (v *MatchingService_DescribeTaskList_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -9723,6 +11063,12 @@ func (v *MatchingService_CancelOutstandingPoll_Result) Decode(sr stream.Reader)
 
 	for ok {
 		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _DescribeTaskListResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		case fh.ID == 1 && fh.Type == wire.TStruct:
 			v.BadRequestError, err = _BadRequestError_Decode(sr)
 			if err != nil {
@@ -9736,12 +11082,18 @@ func (v *MatchingService_CancelOutstandingPoll_Result) Decode(sr stream.Reader)
 			}
 
 		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TStruct:
 			v.TaskListNotOwnedByHostError, err = _TaskListNotOwnedByHostError_Decode(sr)
 			if err != nil {
 				return err
@@ -9767,34 +11119,44 @@ func (v *MatchingService_CancelOutstandingPoll_Result) Decode(sr stream.Reader)
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
 	if v.TaskListNotOwnedByHostError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_CancelOutstandingPoll_Result
+// String returns a readable string representation of a MatchingService_DescribeTaskList_Result
 // struct.
-func (v *MatchingService_CancelOutstandingPoll_Result) String() string {
+func (v *MatchingService_DescribeTaskList_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [6]string
 	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -9803,6 +11165,10 @@ func (v *MatchingService_CancelOutstandingPoll_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
@@ -9812,25 +11178,31 @@ func (v *MatchingService_CancelOutstandingPoll_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_CancelOutstandingPoll_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_DescribeTaskList_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_CancelOutstandingPoll_Result match the
-// provided MatchingService_CancelOutstandingPoll_Result.
+// Equals returns true if all the fields of this MatchingService_DescribeTaskList_Result match the
+// provided MatchingService_DescribeTaskList_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_CancelOutstandingPoll_Result) Equals(rhs *MatchingService_CancelOutstandingPoll_Result) bool {
+func (v *MatchingService_DescribeTaskList_Result) Equals(rhs *MatchingService_DescribeTaskList_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
@@ -9842,17 +11214,23 @@ func (v *MatchingService_CancelOutstandingPoll_Result) Equals(rhs *MatchingServi
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_CancelOutstandingPoll_Result.
-func (v *MatchingService_CancelOutstandingPoll_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_DescribeTaskList_Result.
+func (v *MatchingService_DescribeTaskList_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
@@ -9862,9 +11240,24 @@ func (v *MatchingService_CancelOutstandingPoll_Result) MarshalLogObject(enc zapc
 	return err
 }
 
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_DescribeTaskList_Result) GetSuccess() (o *shared.DescribeTaskListResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *MatchingService_DescribeTaskList_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_CancelOutstandingPoll_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_DescribeTaskList_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -9873,13 +11266,13 @@ func (v *MatchingService_CancelOutstandingPoll_Result) GetBadRequestError() (o *
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_CancelOutstandingPoll_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_DescribeTaskList_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_CancelOutstandingPoll_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_DescribeTaskList_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -9888,13 +11281,28 @@ func (v *MatchingService_CancelOutstandingPoll_Result) GetInternalServiceError()
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_CancelOutstandingPoll_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_DescribeTaskList_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_DescribeTaskList_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *MatchingService_DescribeTaskList_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_CancelOutstandingPoll_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_DescribeTaskList_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -9903,13 +11311,13 @@ func (v *MatchingService_CancelOutstandingPoll_Result) GetServiceBusyError() (o
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_CancelOutstandingPoll_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_DescribeTaskList_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // GetTaskListNotOwnedByHostError returns the value of TaskListNotOwnedByHostError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_CancelOutstandingPoll_Result) GetTaskListNotOwnedByHostError() (o *shared.TaskListNotOwnedByHostError) {
+func (v *MatchingService_DescribeTaskList_Result) GetTaskListNotOwnedByHostError() (o *shared.TaskListNotOwnedByHostError) {
 	if v != nil && v.TaskListNotOwnedByHostError != nil {
 		return v.TaskListNotOwnedByHostError
 	}
@@ -9918,33 +11326,33 @@ func (v *MatchingService_CancelOutstandingPoll_Result) GetTaskListNotOwnedByHost
 }
 
 // IsSetTaskListNotOwnedByHostError returns true if TaskListNotOwnedByHostError is not nil.
-func (v *MatchingService_CancelOutstandingPoll_Result) IsSetTaskListNotOwnedByHostError() bool {
+func (v *MatchingService_DescribeTaskList_Result) IsSetTaskListNotOwnedByHostError() bool {
 	return v != nil && v.TaskListNotOwnedByHostError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "CancelOutstandingPoll" for this struct.
-func (v *MatchingService_CancelOutstandingPoll_Result) MethodName() string {
-	return "CancelOutstandingPoll"
+// This will always be "DescribeTaskList" for this struct.
+func (v *MatchingService_DescribeTaskList_Result) MethodName() string {
+	return "DescribeTaskList"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_CancelOutstandingPoll_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_DescribeTaskList_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_DescribeTaskList_Args represents the arguments for the MatchingService.DescribeTaskList function.
+// MatchingService_GetTaskListsByDomain_Args represents the arguments for the MatchingService.GetTaskListsByDomain function.
 //
-// The arguments for DescribeTaskList are sent and received over the wire as this struct.
-type MatchingService_DescribeTaskList_Args struct {
-	Request *DescribeTaskListRequest `json:"request,omitempty"`
+// The arguments for GetTaskListsByDomain are sent and received over the wire as this struct.
+type MatchingService_GetTaskListsByDomain_Args struct {
+	Request *shared.GetTaskListsByDomainRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_DescribeTaskList_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_GetTaskListsByDomain_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -9959,7 +11367,7 @@ type MatchingService_DescribeTaskList_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *MatchingService_DescribeTaskList_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_GetTaskListsByDomain_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -9979,17 +11387,17 @@ func (v *MatchingService_DescribeTaskList_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeTaskListRequest_1_Read(w wire.Value) (*DescribeTaskListRequest, error) {
-	var v DescribeTaskListRequest
+func _GetTaskListsByDomainRequest_Read(w wire.Value) (*shared.GetTaskListsByDomainRequest, error) {
+	var v shared.GetTaskListsByDomainRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_DescribeTaskList_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_GetTaskListsByDomain_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_DescribeTaskList_Args struct
+// An error is returned if we were unable to build a MatchingService_GetTaskListsByDomain_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -9997,19 +11405,19 @@ func _DescribeTaskListRequest_1_Read(w wire.Value) (*DescribeTaskListRequest, er
 //	  return nil, err
 //	}
 //
-//	var v MatchingService_DescribeTaskList_Args
+//	var v MatchingService_GetTaskListsByDomain_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *MatchingService_DescribeTaskList_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_GetTaskListsByDomain_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _DescribeTaskListRequest_1_Read(field.Value)
+				v.Request, err = _GetTaskListsByDomainRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -10021,11 +11429,11 @@ func (v *MatchingService_DescribeTaskList_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a MatchingService_DescribeTaskList_Args struct directly into bytes, without going
+// Encode serializes a MatchingService_GetTaskListsByDomain_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_DescribeTaskList_Args struct could not be encoded.
-func (v *MatchingService_DescribeTaskList_Args) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_GetTaskListsByDomain_Args struct could not be encoded.
+func (v *MatchingService_GetTaskListsByDomain_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -10045,18 +11453,18 @@ func (v *MatchingService_DescribeTaskList_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _DescribeTaskListRequest_1_Decode(sr stream.Reader) (*DescribeTaskListRequest, error) {
-	var v DescribeTaskListRequest
+func _GetTaskListsByDomainRequest_Decode(sr stream.Reader) (*shared.GetTaskListsByDomainRequest, error) {
+	var v shared.GetTaskListsByDomainRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_DescribeTaskList_Args struct directly from its Thrift-level
+// Decode deserializes a MatchingService_GetTaskListsByDomain_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_DescribeTaskList_Args struct could not be generated from the wire
+// An error is returned if a MatchingService_GetTaskListsByDomain_Args struct could not be generated from the wire
 // representation.
-func (v *MatchingService_DescribeTaskList_Args) Decode(sr stream.Reader) error {
+func (v *MatchingService_GetTaskListsByDomain_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -10070,7 +11478,7 @@ func (v *MatchingService_DescribeTaskList_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _DescribeTaskListRequest_1_Decode(sr)
+			v.Request, err = _GetTaskListsByDomainRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -10097,9 +11505,9 @@ func (v *MatchingService_DescribeTaskList_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_DescribeTaskList_Args
+// String returns a readable string representation of a MatchingService_GetTaskListsByDomain_Args
 // struct.
-func (v *MatchingService_DescribeTaskList_Args) String() string {
+func (v *MatchingService_GetTaskListsByDomain_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -10111,14 +11519,14 @@ func (v *MatchingService_DescribeTaskList_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_DescribeTaskList_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_GetTaskListsByDomain_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_DescribeTaskList_Args match the
-// provided MatchingService_DescribeTaskList_Args.
+// Equals returns true if all the fields of this MatchingService_GetTaskListsByDomain_Args match the
+// provided MatchingService_GetTaskListsByDomain_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_DescribeTaskList_Args) Equals(rhs *MatchingService_DescribeTaskList_Args) bool {
+func (v *MatchingService_GetTaskListsByDomain_Args) Equals(rhs *MatchingService_GetTaskListsByDomain_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -10132,8 +11540,8 @@ func (v *MatchingService_DescribeTaskList_Args) Equals(rhs *MatchingService_Desc
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_DescribeTaskList_Args.
-func (v *MatchingService_DescribeTaskList_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_GetTaskListsByDomain_Args.
+func (v *MatchingService_GetTaskListsByDomain_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -10145,7 +11553,7 @@ func (v *MatchingService_DescribeTaskList_Args) MarshalLogObject(enc zapcore.Obj
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Args) GetRequest() (o *DescribeTaskListRequest) {
+func (v *MatchingService_GetTaskListsByDomain_Args) GetRequest() (o *shared.GetTaskListsByDomainRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -10154,80 +11562,80 @@ func (v *MatchingService_DescribeTaskList_Args) GetRequest() (o *DescribeTaskLis
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *MatchingService_DescribeTaskList_Args) IsSetRequest() bool {
+func (v *MatchingService_GetTaskListsByDomain_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "DescribeTaskList" for this struct.
-func (v *MatchingService_DescribeTaskList_Args) MethodName() string {
-	return "DescribeTaskList"
+// This will always be "GetTaskListsByDomain" for this struct.
+func (v *MatchingService_GetTaskListsByDomain_Args) MethodName() string {
+	return "GetTaskListsByDomain"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_DescribeTaskList_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_GetTaskListsByDomain_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_DescribeTaskList_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.DescribeTaskList
+// MatchingService_GetTaskListsByDomain_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.GetTaskListsByDomain
 // function.
-var MatchingService_DescribeTaskList_Helper = struct {
-	// Args accepts the parameters of DescribeTaskList in-order and returns
+var MatchingService_GetTaskListsByDomain_Helper = struct {
+	// Args accepts the parameters of GetTaskListsByDomain in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *DescribeTaskListRequest,
-	) *MatchingService_DescribeTaskList_Args
+		request *shared.GetTaskListsByDomainRequest,
+	) *MatchingService_GetTaskListsByDomain_Args
 
 	// IsException returns true if the given error can be thrown
-	// by DescribeTaskList.
+	// by GetTaskListsByDomain.
 	//
-	// An error can be thrown by DescribeTaskList only if the
+	// An error can be thrown by GetTaskListsByDomain only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for DescribeTaskList
+	// WrapResponse returns the result struct for GetTaskListsByDomain
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// DescribeTaskList into a serializable result struct.
+	// GetTaskListsByDomain into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by DescribeTaskList
+	// error cannot be thrown by GetTaskListsByDomain
 	//
-	//   value, err := DescribeTaskList(args)
-	//   result, err := MatchingService_DescribeTaskList_Helper.WrapResponse(value, err)
+	//   value, err := GetTaskListsByDomain(args)
+	//   result, err := MatchingService_GetTaskListsByDomain_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from DescribeTaskList: %v", err)
+	//     return fmt.Errorf("unexpected error from GetTaskListsByDomain: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.DescribeTaskListResponse, error) (*MatchingService_DescribeTaskList_Result, error)
+	WrapResponse func(*shared.GetTaskListsByDomainResponse, error) (*MatchingService_GetTaskListsByDomain_Result, error)
 
-	// UnwrapResponse takes the result struct for DescribeTaskList
+	// UnwrapResponse takes the result struct for GetTaskListsByDomain
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if DescribeTaskList threw an
+	// The error is non-nil only if GetTaskListsByDomain threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := MatchingService_DescribeTaskList_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_DescribeTaskList_Result) (*shared.DescribeTaskListResponse, error)
+	//   value, err := MatchingService_GetTaskListsByDomain_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_GetTaskListsByDomain_Result) (*shared.GetTaskListsByDomainResponse, error)
 }{}
 
 func init() {
-	MatchingService_DescribeTaskList_Helper.Args = func(
-		request *DescribeTaskListRequest,
-	) *MatchingService_DescribeTaskList_Args {
-		return &MatchingService_DescribeTaskList_Args{
+	MatchingService_GetTaskListsByDomain_Helper.Args = func(
+		request *shared.GetTaskListsByDomainRequest,
+	) *MatchingService_GetTaskListsByDomain_Args {
+		return &MatchingService_GetTaskListsByDomain_Args{
 			Request: request,
 		}
 	}
 
-	MatchingService_DescribeTaskList_Helper.IsException = func(err error) bool {
+	MatchingService_GetTaskListsByDomain_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -10237,49 +11645,42 @@ func init() {
 			return true
 		case *shared.ServiceBusyError:
 			return true
-		case *shared.TaskListNotOwnedByHostError:
-			return true
 		default:
 			return false
 		}
 	}
 
-	MatchingService_DescribeTaskList_Helper.WrapResponse = func(success *shared.DescribeTaskListResponse, err error) (*MatchingService_DescribeTaskList_Result, error) {
+	MatchingService_GetTaskListsByDomain_Helper.WrapResponse = func(success *shared.GetTaskListsByDomainResponse, err error) (*MatchingService_GetTaskListsByDomain_Result, error) {
 		if err == nil {
-			return &MatchingService_DescribeTaskList_Result{Success: success}, nil
+			return &MatchingService_GetTaskListsByDomain_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_GetTaskListsByDomain_Result.BadRequestError")
 			}
-			return &MatchingService_DescribeTaskList_Result{BadRequestError: e}, nil
+			return &MatchingService_GetTaskListsByDomain_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_GetTaskListsByDomain_Result.InternalServiceError")
 			}
-			return &MatchingService_DescribeTaskList_Result{InternalServiceError: e}, nil
+			return &MatchingService_GetTaskListsByDomain_Result{InternalServiceError: e}, nil
 		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_GetTaskListsByDomain_Result.EntityNotExistError")
 			}
-			return &MatchingService_DescribeTaskList_Result{EntityNotExistError: e}, nil
+			return &MatchingService_GetTaskListsByDomain_Result{EntityNotExistError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.ServiceBusyError")
-			}
-			return &MatchingService_DescribeTaskList_Result{ServiceBusyError: e}, nil
-		case *shared.TaskListNotOwnedByHostError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.TaskListNotOwnedByHostError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_GetTaskListsByDomain_Result.ServiceBusyError")
 			}
-			return &MatchingService_DescribeTaskList_Result{TaskListNotOwnedByHostError: e}, nil
+			return &MatchingService_GetTaskListsByDomain_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_DescribeTaskList_Helper.UnwrapResponse = func(result *MatchingService_DescribeTaskList_Result) (success *shared.DescribeTaskListResponse, err error) {
+	MatchingService_GetTaskListsByDomain_Helper.UnwrapResponse = func(result *MatchingService_GetTaskListsByDomain_Result) (success *shared.GetTaskListsByDomainResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -10296,10 +11697,6 @@ func init() {
 			err = result.ServiceBusyError
 			return
 		}
-		if result.TaskListNotOwnedByHostError != nil {
-			err = result.TaskListNotOwnedByHostError
-			return
-		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -10312,22 +11709,21 @@ func init() {
 
 }
 
-// MatchingService_DescribeTaskList_Result represents the result of a MatchingService.DescribeTaskList function call.
+// MatchingService_GetTaskListsByDomain_Result represents the result of a MatchingService.GetTaskListsByDomain function call.
 //
-// The result of a DescribeTaskList execution is sent and received over the wire as this struct.
+// The result of a GetTaskListsByDomain execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type MatchingService_DescribeTaskList_Result struct {
-	// Value returned by DescribeTaskList after a successful execution.
-	Success                     *shared.DescribeTaskListResponse    `json:"success,omitempty"`
-	BadRequestError             *shared.BadRequestError             `json:"badRequestError,omitempty"`
-	InternalServiceError        *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
-	EntityNotExistError         *shared.EntityNotExistsError        `json:"entityNotExistError,omitempty"`
-	ServiceBusyError            *shared.ServiceBusyError            `json:"serviceBusyError,omitempty"`
-	TaskListNotOwnedByHostError *shared.TaskListNotOwnedByHostError `json:"taskListNotOwnedByHostError,omitempty"`
+type MatchingService_GetTaskListsByDomain_Result struct {
+	// Value returned by GetTaskListsByDomain after a successful execution.
+	Success              *shared.GetTaskListsByDomainResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError              `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError         `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError         `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError             `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_DescribeTaskList_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_GetTaskListsByDomain_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -10342,9 +11738,9 @@ type MatchingService_DescribeTaskList_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *MatchingService_DescribeTaskList_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_GetTaskListsByDomain_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -10390,39 +11786,25 @@ func (v *MatchingService_DescribeTaskList_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
-	if v.TaskListNotOwnedByHostError != nil {
-		w, err = v.TaskListNotOwnedByHostError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_GetTaskListsByDomain_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeTaskListResponse_Read(w wire.Value) (*shared.DescribeTaskListResponse, error) {
-	var v shared.DescribeTaskListResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
-	var v shared.EntityNotExistsError
+func _GetTaskListsByDomainResponse_Read(w wire.Value) (*shared.GetTaskListsByDomainResponse, error) {
+	var v shared.GetTaskListsByDomainResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_DescribeTaskList_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_GetTaskListsByDomain_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_DescribeTaskList_Result struct
+// An error is returned if we were unable to build a MatchingService_GetTaskListsByDomain_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -10430,19 +11812,19 @@ func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, err
 //	  return nil, err
 //	}
 //
-//	var v MatchingService_DescribeTaskList_Result
+//	var v MatchingService_GetTaskListsByDomain_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *MatchingService_DescribeTaskList_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_GetTaskListsByDomain_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _DescribeTaskListResponse_Read(field.Value)
+				v.Success, err = _GetTaskListsByDomainResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -10479,14 +11861,6 @@ func (v *MatchingService_DescribeTaskList_Result) FromWire(w wire.Value) error {
 					return err
 				}
 
-			}
-		case 5:
-			if field.Value.Type() == wire.TStruct {
-				v.TaskListNotOwnedByHostError, err = _TaskListNotOwnedByHostError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
 			}
 		}
 	}
@@ -10507,21 +11881,18 @@ func (v *MatchingService_DescribeTaskList_Result) FromWire(w wire.Value) error {
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.TaskListNotOwnedByHostError != nil {
-		count++
-	}
 	if count != 1 {
-		return fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_GetTaskListsByDomain_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a MatchingService_DescribeTaskList_Result struct directly into bytes, without going
+// Encode serializes a MatchingService_GetTaskListsByDomain_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_DescribeTaskList_Result struct could not be encoded.
-func (v *MatchingService_DescribeTaskList_Result) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_GetTaskListsByDomain_Result struct could not be encoded.
+func (v *MatchingService_GetTaskListsByDomain_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -10574,23 +11945,11 @@ func (v *MatchingService_DescribeTaskList_Result) Encode(sw stream.Writer) error
 		}
 	}
 
-	if v.ServiceBusyError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.ServiceBusyError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.TaskListNotOwnedByHostError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.TaskListNotOwnedByHostError.Encode(sw); err != nil {
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -10614,35 +11973,26 @@ func (v *MatchingService_DescribeTaskList_Result) Encode(sw stream.Writer) error
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.TaskListNotOwnedByHostError != nil {
-		count++
-	}
 
 	if count != 1 {
-		return fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_GetTaskListsByDomain_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _DescribeTaskListResponse_Decode(sr stream.Reader) (*shared.DescribeTaskListResponse, error) {
-	var v shared.DescribeTaskListResponse
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _EntityNotExistsError_Decode(sr stream.Reader) (*shared.EntityNotExistsError, error) {
-	var v shared.EntityNotExistsError
+func _GetTaskListsByDomainResponse_Decode(sr stream.Reader) (*shared.GetTaskListsByDomainResponse, error) {
+	var v shared.GetTaskListsByDomainResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_DescribeTaskList_Result struct directly from its Thrift-level
+// Decode deserializes a MatchingService_GetTaskListsByDomain_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_DescribeTaskList_Result struct could not be generated from the wire
+// An error is returned if a MatchingService_GetTaskListsByDomain_Result struct could not be generated from the wire
 // representation.
-func (v *MatchingService_DescribeTaskList_Result) Decode(sr stream.Reader) error {
+func (v *MatchingService_GetTaskListsByDomain_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -10656,7 +12006,7 @@ func (v *MatchingService_DescribeTaskList_Result) Decode(sr stream.Reader) error
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _DescribeTaskListResponse_Decode(sr)
+			v.Success, err = _GetTaskListsByDomainResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -10685,12 +12035,6 @@ func (v *MatchingService_DescribeTaskList_Result) Decode(sr stream.Reader) error
 				return err
 			}
 
-		case fh.ID == 5 && fh.Type == wire.TStruct:
-			v.TaskListNotOwnedByHostError, err = _TaskListNotOwnedByHostError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -10726,24 +12070,21 @@ func (v *MatchingService_DescribeTaskList_Result) Decode(sr stream.Reader) error
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.TaskListNotOwnedByHostError != nil {
-		count++
-	}
 	if count != 1 {
-		return fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_GetTaskListsByDomain_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_DescribeTaskList_Result
+// String returns a readable string representation of a MatchingService_GetTaskListsByDomain_Result
 // struct.
-func (v *MatchingService_DescribeTaskList_Result) String() string {
+func (v *MatchingService_GetTaskListsByDomain_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [5]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -10765,19 +12106,15 @@ func (v *MatchingService_DescribeTaskList_Result) String() string {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
-	if v.TaskListNotOwnedByHostError != nil {
-		fields[i] = fmt.Sprintf("TaskListNotOwnedByHostError: %v", v.TaskListNotOwnedByHostError)
-		i++
-	}
 
-	return fmt.Sprintf("MatchingService_DescribeTaskList_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_GetTaskListsByDomain_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_DescribeTaskList_Result match the
-// provided MatchingService_DescribeTaskList_Result.
+// Equals returns true if all the fields of this MatchingService_GetTaskListsByDomain_Result match the
+// provided MatchingService_GetTaskListsByDomain_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_DescribeTaskList_Result) Equals(rhs *MatchingService_DescribeTaskList_Result) bool {
+func (v *MatchingService_GetTaskListsByDomain_Result) Equals(rhs *MatchingService_GetTaskListsByDomain_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -10798,16 +12135,13 @@ func (v *MatchingService_DescribeTaskList_Result) Equals(rhs *MatchingService_De
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
-	if !((v.TaskListNotOwnedByHostError == nil && rhs.TaskListNotOwnedByHostError == nil) || (v.TaskListNotOwnedByHostError != nil && rhs.TaskListNotOwnedByHostError != nil && v.TaskListNotOwnedByHostError.Equals(rhs.TaskListNotOwnedByHostError))) {
-		return false
-	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_DescribeTaskList_Result.
-func (v *MatchingService_DescribeTaskList_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_GetTaskListsByDomain_Result.
+func (v *MatchingService_GetTaskListsByDomain_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -10826,15 +12160,12 @@ func (v *MatchingService_DescribeTaskList_Result) MarshalLogObject(enc zapcore.O
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
-	if v.TaskListNotOwnedByHostError != nil {
-		err = multierr.Append(err, enc.AddObject("taskListNotOwnedByHostError", v.TaskListNotOwnedByHostError))
-	}
 	return err
 }
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetSuccess() (o *shared.DescribeTaskListResponse) {
+func (v *MatchingService_GetTaskListsByDomain_Result) GetSuccess() (o *shared.GetTaskListsByDomainResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -10843,13 +12174,13 @@ func (v *MatchingService_DescribeTaskList_Result) GetSuccess() (o *shared.Descri
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetSuccess() bool {
+func (v *MatchingService_GetTaskListsByDomain_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_GetTaskListsByDomain_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -10858,13 +12189,13 @@ func (v *MatchingService_DescribeTaskList_Result) GetBadRequestError() (o *share
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_GetTaskListsByDomain_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_GetTaskListsByDomain_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -10873,13 +12204,13 @@ func (v *MatchingService_DescribeTaskList_Result) GetInternalServiceError() (o *
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_GetTaskListsByDomain_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+func (v *MatchingService_GetTaskListsByDomain_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
 	if v != nil && v.EntityNotExistError != nil {
 		return v.EntityNotExistError
 	}
@@ -10888,13 +12219,13 @@ func (v *MatchingService_DescribeTaskList_Result) GetEntityNotExistError() (o *s
 }
 
 // IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetEntityNotExistError() bool {
+func (v *MatchingService_GetTaskListsByDomain_Result) IsSetEntityNotExistError() bool {
 	return v != nil && v.EntityNotExistError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_GetTaskListsByDomain_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -10903,48 +12234,33 @@ func (v *MatchingService_DescribeTaskList_Result) GetServiceBusyError() (o *shar
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_GetTaskListsByDomain_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
-// GetTaskListNotOwnedByHostError returns the value of TaskListNotOwnedByHostError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetTaskListNotOwnedByHostError() (o *shared.TaskListNotOwnedByHostError) {
-	if v != nil && v.TaskListNotOwnedByHostError != nil {
-		return v.TaskListNotOwnedByHostError
-	}
-
-	return
-}
-
-// IsSetTaskListNotOwnedByHostError returns true if TaskListNotOwnedByHostError is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetTaskListNotOwnedByHostError() bool {
-	return v != nil && v.TaskListNotOwnedByHostError != nil
-}
-
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "DescribeTaskList" for this struct.
-func (v *MatchingService_DescribeTaskList_Result) MethodName() string {
-	return "DescribeTaskList"
+// This will always be "GetTaskListsByDomain" for this struct.
+func (v *MatchingService_GetTaskListsByDomain_Result) MethodName() string {
+	return "GetTaskListsByDomain"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_DescribeTaskList_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_GetTaskListsByDomain_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_GetTaskListsByDomain_Args represents the arguments for the MatchingService.GetTaskListsByDomain function.
+// MatchingService_ListTaskListPartitions_Args represents the arguments for the MatchingService.ListTaskListPartitions function.
 //
-// The arguments for GetTaskListsByDomain are sent and received over the wire as this struct.
-type MatchingService_GetTaskListsByDomain_Args struct {
-	Request *shared.GetTaskListsByDomainRequest `json:"request,omitempty"`
+// The arguments for ListTaskListPartitions are sent and received over the wire as this struct.
+type MatchingService_ListTaskListPartitions_Args struct {
+	Request *ListTaskListPartitionsRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_GetTaskListsByDomain_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_ListTaskListPartitions_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -10959,7 +12275,7 @@ type MatchingService_GetTaskListsByDomain_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *MatchingService_GetTaskListsByDomain_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_ListTaskListPartitions_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -10979,17 +12295,17 @@ func (v *MatchingService_GetTaskListsByDomain_Args) ToWire() (wire.Value, error)
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetTaskListsByDomainRequest_Read(w wire.Value) (*shared.GetTaskListsByDomainRequest, error) {
-	var v shared.GetTaskListsByDomainRequest
+func _ListTaskListPartitionsRequest_Read(w wire.Value) (*ListTaskListPartitionsRequest, error) {
+	var v ListTaskListPartitionsRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_GetTaskListsByDomain_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_ListTaskListPartitions_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_GetTaskListsByDomain_Args struct
+// An error is returned if we were unable to build a MatchingService_ListTaskListPartitions_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -10997,19 +12313,19 @@ func _GetTaskListsByDomainRequest_Read(w wire.Value) (*shared.GetTaskListsByDoma
 //	  return nil, err
 //	}
 //
-//	var v MatchingService_GetTaskListsByDomain_Args
+//	var v MatchingService_ListTaskListPartitions_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *MatchingService_GetTaskListsByDomain_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_ListTaskListPartitions_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _GetTaskListsByDomainRequest_Read(field.Value)
+				v.Request, err = _ListTaskListPartitionsRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -11021,11 +12337,11 @@ func (v *MatchingService_GetTaskListsByDomain_Args) FromWire(w wire.Value) error
 	return nil
 }
 
-// Encode serializes a MatchingService_GetTaskListsByDomain_Args struct directly into bytes, without going
+// Encode serializes a MatchingService_ListTaskListPartitions_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_GetTaskListsByDomain_Args struct could not be encoded.
-func (v *MatchingService_GetTaskListsByDomain_Args) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_ListTaskListPartitions_Args struct could not be encoded.
+func (v *MatchingService_ListTaskListPartitions_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -11045,18 +12361,18 @@ func (v *MatchingService_GetTaskListsByDomain_Args) Encode(sw stream.Writer) err
 	return sw.WriteStructEnd()
 }
 
-func _GetTaskListsByDomainRequest_Decode(sr stream.Reader) (*shared.GetTaskListsByDomainRequest, error) {
-	var v shared.GetTaskListsByDomainRequest
+func _ListTaskListPartitionsRequest_Decode(sr stream.Reader) (*ListTaskListPartitionsRequest, error) {
+	var v ListTaskListPartitionsRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_GetTaskListsByDomain_Args struct directly from its Thrift-level
+// Decode deserializes a MatchingService_ListTaskListPartitions_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_GetTaskListsByDomain_Args struct could not be generated from the wire
+// An error is returned if a MatchingService_ListTaskListPartitions_Args struct could not be generated from the wire
 // representation.
-func (v *MatchingService_GetTaskListsByDomain_Args) Decode(sr stream.Reader) error {
+func (v *MatchingService_ListTaskListPartitions_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -11070,7 +12386,7 @@ func (v *MatchingService_GetTaskListsByDomain_Args) Decode(sr stream.Reader) err
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _GetTaskListsByDomainRequest_Decode(sr)
+			v.Request, err = _ListTaskListPartitionsRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -11097,9 +12413,9 @@ func (v *MatchingService_GetTaskListsByDomain_Args) Decode(sr stream.Reader) err
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_GetTaskListsByDomain_Args
+// String returns a readable string representation of a MatchingService_ListTaskListPartitions_Args
 // struct.
-func (v *MatchingService_GetTaskListsByDomain_Args) String() string {
+func (v *MatchingService_ListTaskListPartitions_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -11111,14 +12427,14 @@ func (v *MatchingService_GetTaskListsByDomain_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_GetTaskListsByDomain_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_ListTaskListPartitions_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_GetTaskListsByDomain_Args match the
-// provided MatchingService_GetTaskListsByDomain_Args.
+// Equals returns true if all the fields of this MatchingService_ListTaskListPartitions_Args match the
+// provided MatchingService_ListTaskListPartitions_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_GetTaskListsByDomain_Args) Equals(rhs *MatchingService_GetTaskListsByDomain_Args) bool {
+func (v *MatchingService_ListTaskListPartitions_Args) Equals(rhs *MatchingService_ListTaskListPartitions_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -11132,8 +12448,8 @@ func (v *MatchingService_GetTaskListsByDomain_Args) Equals(rhs *MatchingService_
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_GetTaskListsByDomain_Args.
-func (v *MatchingService_GetTaskListsByDomain_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_ListTaskListPartitions_Args.
+func (v *MatchingService_ListTaskListPartitions_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -11145,7 +12461,7 @@ func (v *MatchingService_GetTaskListsByDomain_Args) MarshalLogObject(enc zapcore
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_GetTaskListsByDomain_Args) GetRequest() (o *shared.GetTaskListsByDomainRequest) {
+func (v *MatchingService_ListTaskListPartitions_Args) GetRequest() (o *ListTaskListPartitionsRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -11154,87 +12470,85 @@ func (v *MatchingService_GetTaskListsByDomain_Args) GetRequest() (o *shared.GetT
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *MatchingService_GetTaskListsByDomain_Args) IsSetRequest() bool {
+func (v *MatchingService_ListTaskListPartitions_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "GetTaskListsByDomain" for this struct.
-func (v *MatchingService_GetTaskListsByDomain_Args) MethodName() string {
-	return "GetTaskListsByDomain"
+// This will always be "ListTaskListPartitions" for this struct.
+func (v *MatchingService_ListTaskListPartitions_Args) MethodName() string {
+	return "ListTaskListPartitions"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_GetTaskListsByDomain_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_ListTaskListPartitions_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_GetTaskListsByDomain_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.GetTaskListsByDomain
+// MatchingService_ListTaskListPartitions_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.ListTaskListPartitions
 // function.
-var MatchingService_GetTaskListsByDomain_Helper = struct {
-	// Args accepts the parameters of GetTaskListsByDomain in-order and returns
+var MatchingService_ListTaskListPartitions_Helper = struct {
+	// Args accepts the parameters of ListTaskListPartitions in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.GetTaskListsByDomainRequest,
-	) *MatchingService_GetTaskListsByDomain_Args
+		request *ListTaskListPartitionsRequest,
+	) *MatchingService_ListTaskListPartitions_Args
 
 	// IsException returns true if the given error can be thrown
-	// by GetTaskListsByDomain.
+	// by ListTaskListPartitions.
 	//
-	// An error can be thrown by GetTaskListsByDomain only if the
+	// An error can be thrown by ListTaskListPartitions only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for GetTaskListsByDomain
+	// WrapResponse returns the result struct for ListTaskListPartitions
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// GetTaskListsByDomain into a serializable result struct.
+	// ListTaskListPartitions into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by GetTaskListsByDomain
+	// error cannot be thrown by ListTaskListPartitions
 	//
-	//   value, err := GetTaskListsByDomain(args)
-	//   result, err := MatchingService_GetTaskListsByDomain_Helper.WrapResponse(value, err)
+	//   value, err := ListTaskListPartitions(args)
+	//   result, err := MatchingService_ListTaskListPartitions_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from GetTaskListsByDomain: %v", err)
+	//     return fmt.Errorf("unexpected error from ListTaskListPartitions: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.GetTaskListsByDomainResponse, error) (*MatchingService_GetTaskListsByDomain_Result, error)
+	WrapResponse func(*shared.ListTaskListPartitionsResponse, error) (*MatchingService_ListTaskListPartitions_Result, error)
 
-	// UnwrapResponse takes the result struct for GetTaskListsByDomain
+	// UnwrapResponse takes the result struct for ListTaskListPartitions
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if GetTaskListsByDomain threw an
+	// The error is non-nil only if ListTaskListPartitions threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := MatchingService_GetTaskListsByDomain_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_GetTaskListsByDomain_Result) (*shared.GetTaskListsByDomainResponse, error)
+	//   value, err := MatchingService_ListTaskListPartitions_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_ListTaskListPartitions_Result) (*shared.ListTaskListPartitionsResponse, error)
 }{}
 
 func init() {
-	MatchingService_GetTaskListsByDomain_Helper.Args = func(
-		request *shared.GetTaskListsByDomainRequest,
-	) *MatchingService_GetTaskListsByDomain_Args {
-		return &MatchingService_GetTaskListsByDomain_Args{
+	MatchingService_ListTaskListPartitions_Helper.Args = func(
+		request *ListTaskListPartitionsRequest,
+	) *MatchingService_ListTaskListPartitions_Args {
+		return &MatchingService_ListTaskListPartitions_Args{
 			Request: request,
 		}
 	}
 
-	MatchingService_GetTaskListsByDomain_Helper.IsException = func(err error) bool {
+	MatchingService_ListTaskListPartitions_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.EntityNotExistsError:
-			return true
 		case *shared.ServiceBusyError:
 			return true
 		default:
@@ -11242,37 +12556,32 @@ func init() {
 		}
 	}
 
-	MatchingService_GetTaskListsByDomain_Helper.WrapResponse = func(success *shared.GetTaskListsByDomainResponse, err error) (*MatchingService_GetTaskListsByDomain_Result, error) {
+	MatchingService_ListTaskListPartitions_Helper.WrapResponse = func(success *shared.ListTaskListPartitionsResponse, err error) (*MatchingService_ListTaskListPartitions_Result, error) {
 		if err == nil {
-			return &MatchingService_GetTaskListsByDomain_Result{Success: success}, nil
+			return &MatchingService_ListTaskListPartitions_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_GetTaskListsByDomain_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_ListTaskListPartitions_Result.BadRequestError")
 			}
-			return &MatchingService_GetTaskListsByDomain_Result{BadRequestError: e}, nil
+			return &MatchingService_ListTaskListPartitions_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_GetTaskListsByDomain_Result.InternalServiceError")
-			}
-			return &MatchingService_GetTaskListsByDomain_Result{InternalServiceError: e}, nil
-		case *shared.EntityNotExistsError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_GetTaskListsByDomain_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_ListTaskListPartitions_Result.InternalServiceError")
 			}
-			return &MatchingService_GetTaskListsByDomain_Result{EntityNotExistError: e}, nil
+			return &MatchingService_ListTaskListPartitions_Result{InternalServiceError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_GetTaskListsByDomain_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_ListTaskListPartitions_Result.ServiceBusyError")
 			}
-			return &MatchingService_GetTaskListsByDomain_Result{ServiceBusyError: e}, nil
+			return &MatchingService_ListTaskListPartitions_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_GetTaskListsByDomain_Helper.UnwrapResponse = func(result *MatchingService_GetTaskListsByDomain_Result) (success *shared.GetTaskListsByDomainResponse, err error) {
+	MatchingService_ListTaskListPartitions_Helper.UnwrapResponse = func(result *MatchingService_ListTaskListPartitions_Result) (success *shared.ListTaskListPartitionsResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -11281,10 +12590,6 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
-			return
-		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
@@ -11301,21 +12606,20 @@ func init() {
 
 }
 
-// MatchingService_GetTaskListsByDomain_Result represents the result of a MatchingService.GetTaskListsByDomain function call.
+// MatchingService_ListTaskListPartitions_Result represents the result of a MatchingService.ListTaskListPartitions function call.
 //
-// The result of a GetTaskListsByDomain execution is sent and received over the wire as this struct.
+// The result of a ListTaskListPartitions execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type MatchingService_GetTaskListsByDomain_Result struct {
-	// Value returned by GetTaskListsByDomain after a successful execution.
-	Success              *shared.GetTaskListsByDomainResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError              `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError         `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError         `json:"entityNotExistError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError             `json:"serviceBusyError,omitempty"`
+type MatchingService_ListTaskListPartitions_Result struct {
+	// Value returned by ListTaskListPartitions after a successful execution.
+	Success              *shared.ListTaskListPartitionsResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError                `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError           `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError               `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_GetTaskListsByDomain_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_ListTaskListPartitions_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -11330,9 +12634,9 @@ type MatchingService_GetTaskListsByDomain_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *MatchingService_GetTaskListsByDomain_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_ListTaskListPartitions_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -11362,14 +12666,6 @@ func (v *MatchingService_GetTaskListsByDomain_Result) ToWire() (wire.Value, erro
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
-	}
 	if v.ServiceBusyError != nil {
 		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
@@ -11380,23 +12676,23 @@ func (v *MatchingService_GetTaskListsByDomain_Result) ToWire() (wire.Value, erro
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_GetTaskListsByDomain_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_ListTaskListPartitions_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetTaskListsByDomainResponse_Read(w wire.Value) (*shared.GetTaskListsByDomainResponse, error) {
-	var v shared.GetTaskListsByDomainResponse
+func _ListTaskListPartitionsResponse_Read(w wire.Value) (*shared.ListTaskListPartitionsResponse, error) {
+	var v shared.ListTaskListPartitionsResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_GetTaskListsByDomain_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_ListTaskListPartitions_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_GetTaskListsByDomain_Result struct
+// An error is returned if we were unable to build a MatchingService_ListTaskListPartitions_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -11404,19 +12700,19 @@ func _GetTaskListsByDomainResponse_Read(w wire.Value) (*shared.GetTaskListsByDom
 //	  return nil, err
 //	}
 //
-//	var v MatchingService_GetTaskListsByDomain_Result
+//	var v MatchingService_ListTaskListPartitions_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *MatchingService_GetTaskListsByDomain_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_ListTaskListPartitions_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _GetTaskListsByDomainResponse_Read(field.Value)
+				v.Success, err = _ListTaskListPartitionsResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -11437,14 +12733,6 @@ func (v *MatchingService_GetTaskListsByDomain_Result) FromWire(w wire.Value) err
 					return err
 				}
 
-			}
-		case 3:
-			if field.Value.Type() == wire.TStruct {
-				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
 			}
 		case 4:
 			if field.Value.Type() == wire.TStruct {
@@ -11467,24 +12755,21 @@ func (v *MatchingService_GetTaskListsByDomain_Result) FromWire(w wire.Value) err
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
-		count++
-	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("MatchingService_GetTaskListsByDomain_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_ListTaskListPartitions_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a MatchingService_GetTaskListsByDomain_Result struct directly into bytes, without going
+// Encode serializes a MatchingService_ListTaskListPartitions_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_GetTaskListsByDomain_Result struct could not be encoded.
-func (v *MatchingService_GetTaskListsByDomain_Result) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_ListTaskListPartitions_Result struct could not be encoded.
+func (v *MatchingService_ListTaskListPartitions_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -11525,18 +12810,6 @@ func (v *MatchingService_GetTaskListsByDomain_Result) Encode(sw stream.Writer) e
 		}
 	}
 
-	if v.EntityNotExistError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.EntityNotExistError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
 	if v.ServiceBusyError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
 			return err
@@ -11559,32 +12832,29 @@ func (v *MatchingService_GetTaskListsByDomain_Result) Encode(sw stream.Writer) e
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
-		count++
-	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
 
 	if count != 1 {
-		return fmt.Errorf("MatchingService_GetTaskListsByDomain_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_ListTaskListPartitions_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _GetTaskListsByDomainResponse_Decode(sr stream.Reader) (*shared.GetTaskListsByDomainResponse, error) {
-	var v shared.GetTaskListsByDomainResponse
+func _ListTaskListPartitionsResponse_Decode(sr stream.Reader) (*shared.ListTaskListPartitionsResponse, error) {
+	var v shared.ListTaskListPartitionsResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_GetTaskListsByDomain_Result struct directly from its Thrift-level
+// Decode deserializes a MatchingService_ListTaskListPartitions_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_GetTaskListsByDomain_Result struct could not be generated from the wire
+// An error is returned if a MatchingService_ListTaskListPartitions_Result struct could not be generated from the wire
 // representation.
-func (v *MatchingService_GetTaskListsByDomain_Result) Decode(sr stream.Reader) error {
+func (v *MatchingService_ListTaskListPartitions_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -11598,7 +12868,7 @@ func (v *MatchingService_GetTaskListsByDomain_Result) Decode(sr stream.Reader) e
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _GetTaskListsByDomainResponse_Decode(sr)
+			v.Success, err = _ListTaskListPartitionsResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -11615,12 +12885,6 @@ func (v *MatchingService_GetTaskListsByDomain_Result) Decode(sr stream.Reader) e
 				return err
 			}
 
-		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
 		case fh.ID == 4 && fh.Type == wire.TStruct:
 			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
@@ -11656,27 +12920,24 @@ func (v *MatchingService_GetTaskListsByDomain_Result) Decode(sr stream.Reader) e
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
-		count++
-	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("MatchingService_GetTaskListsByDomain_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_ListTaskListPartitions_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_GetTaskListsByDomain_Result
+// String returns a readable string representation of a MatchingService_ListTaskListPartitions_Result
 // struct.
-func (v *MatchingService_GetTaskListsByDomain_Result) String() string {
+func (v *MatchingService_ListTaskListPartitions_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [4]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -11690,23 +12951,19 @@ func (v *MatchingService_GetTaskListsByDomain_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
-		i++
-	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_GetTaskListsByDomain_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_ListTaskListPartitions_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_GetTaskListsByDomain_Result match the
-// provided MatchingService_GetTaskListsByDomain_Result.
+// Equals returns true if all the fields of this MatchingService_ListTaskListPartitions_Result match the
+// provided MatchingService_ListTaskListPartitions_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_GetTaskListsByDomain_Result) Equals(rhs *MatchingService_GetTaskListsByDomain_Result) bool {
+func (v *MatchingService_ListTaskListPartitions_Result) Equals(rhs *MatchingService_ListTaskListPartitions_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -11721,9 +12978,6 @@ func (v *MatchingService_GetTaskListsByDomain_Result) Equals(rhs *MatchingServic
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
-		return false
-	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
@@ -11732,8 +12986,8 @@ func (v *MatchingService_GetTaskListsByDomain_Result) Equals(rhs *MatchingServic
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_GetTaskListsByDomain_Result.
-func (v *MatchingService_GetTaskListsByDomain_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_ListTaskListPartitions_Result.
+func (v *MatchingService_ListTaskListPartitions_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -11746,9 +13000,6 @@ func (v *MatchingService_GetTaskListsByDomain_Result) MarshalLogObject(enc zapco
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.EntityNotExistError != nil {
-		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
-	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
@@ -11757,7 +13008,7 @@ func (v *MatchingService_GetTaskListsByDomain_Result) MarshalLogObject(enc zapco
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_GetTaskListsByDomain_Result) GetSuccess() (o *shared.GetTaskListsByDomainResponse) {
+func (v *MatchingService_ListTaskListPartitions_Result) GetSuccess() (o *shared.ListTaskListPartitionsResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -11766,13 +13017,13 @@ func (v *MatchingService_GetTaskListsByDomain_Result) GetSuccess() (o *shared.Ge
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *MatchingService_GetTaskListsByDomain_Result) IsSetSuccess() bool {
+func (v *MatchingService_ListTaskListPartitions_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_GetTaskListsByDomain_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_ListTaskListPartitions_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -11781,13 +13032,13 @@ func (v *MatchingService_GetTaskListsByDomain_Result) GetBadRequestError() (o *s
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_GetTaskListsByDomain_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_ListTaskListPartitions_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_GetTaskListsByDomain_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_ListTaskListPartitions_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -11796,28 +13047,13 @@ func (v *MatchingService_GetTaskListsByDomain_Result) GetInternalServiceError()
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_GetTaskListsByDomain_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_ListTaskListPartitions_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_GetTaskListsByDomain_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
-	if v != nil && v.EntityNotExistError != nil {
-		return v.EntityNotExistError
-	}
-
-	return
-}
-
-// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *MatchingService_GetTaskListsByDomain_Result) IsSetEntityNotExistError() bool {
-	return v != nil && v.EntityNotExistError != nil
-}
-
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_GetTaskListsByDomain_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_ListTaskListPartitions_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -11826,33 +13062,33 @@ func (v *MatchingService_GetTaskListsByDomain_Result) GetServiceBusyError() (o *
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_GetTaskListsByDomain_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_ListTaskListPartitions_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "GetTaskListsByDomain" for this struct.
-func (v *MatchingService_GetTaskListsByDomain_Result) MethodName() string {
-	return "GetTaskListsByDomain"
+// This will always be "ListTaskListPartitions" for this struct.
+func (v *MatchingService_ListTaskListPartitions_Result) MethodName() string {
+	return "ListTaskListPartitions"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_GetTaskListsByDomain_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_ListTaskListPartitions_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_ListTaskListPartitions_Args represents the arguments for the MatchingService.ListTaskListPartitions function.
+// MatchingService_PollForActivityTask_Args represents the arguments for the MatchingService.PollForActivityTask function.
 //
-// The arguments for ListTaskListPartitions are sent and received over the wire as this struct.
-type MatchingService_ListTaskListPartitions_Args struct {
-	Request *ListTaskListPartitionsRequest `json:"request,omitempty"`
+// The arguments for PollForActivityTask are sent and received over the wire as this struct.
+type MatchingService_PollForActivityTask_Args struct {
+	PollRequest *PollForActivityTaskRequest `json:"pollRequest,omitempty"`
 }
 
-// ToWire translates a MatchingService_ListTaskListPartitions_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_PollForActivityTask_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -11867,7 +13103,7 @@ type MatchingService_ListTaskListPartitions_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *MatchingService_ListTaskListPartitions_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_PollForActivityTask_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -11875,8 +13111,8 @@ func (v *MatchingService_ListTaskListPartitions_Args) ToWire() (wire.Value, erro
 		err    error
 	)
 
-	if v.Request != nil {
-		w, err = v.Request.ToWire()
+	if v.PollRequest != nil {
+		w, err = v.PollRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -11887,17 +13123,17 @@ func (v *MatchingService_ListTaskListPartitions_Args) ToWire() (wire.Value, erro
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ListTaskListPartitionsRequest_Read(w wire.Value) (*ListTaskListPartitionsRequest, error) {
-	var v ListTaskListPartitionsRequest
+func _PollForActivityTaskRequest_1_Read(w wire.Value) (*PollForActivityTaskRequest, error) {
+	var v PollForActivityTaskRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_ListTaskListPartitions_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_PollForActivityTask_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_ListTaskListPartitions_Args struct
+// An error is returned if we were unable to build a MatchingService_PollForActivityTask_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -11905,19 +13141,19 @@ func _ListTaskListPartitionsRequest_Read(w wire.Value) (*ListTaskListPartitionsR
 //	  return nil, err
 //	}
 //
-//	var v MatchingService_ListTaskListPartitions_Args
+//	var v MatchingService_PollForActivityTask_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *MatchingService_ListTaskListPartitions_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_PollForActivityTask_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _ListTaskListPartitionsRequest_Read(field.Value)
+				v.PollRequest, err = _PollForActivityTaskRequest_1_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -11929,20 +13165,20 @@ func (v *MatchingService_ListTaskListPartitions_Args) FromWire(w wire.Value) err
 	return nil
 }
 
-// Encode serializes a MatchingService_ListTaskListPartitions_Args struct directly into bytes, without going
+// Encode serializes a MatchingService_PollForActivityTask_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_ListTaskListPartitions_Args struct could not be encoded.
-func (v *MatchingService_ListTaskListPartitions_Args) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_PollForActivityTask_Args struct could not be encoded.
+func (v *MatchingService_PollForActivityTask_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Request != nil {
+	if v.PollRequest != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.Request.Encode(sw); err != nil {
+		if err := v.PollRequest.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -11953,18 +13189,18 @@ func (v *MatchingService_ListTaskListPartitions_Args) Encode(sw stream.Writer) e
 	return sw.WriteStructEnd()
 }
 
-func _ListTaskListPartitionsRequest_Decode(sr stream.Reader) (*ListTaskListPartitionsRequest, error) {
-	var v ListTaskListPartitionsRequest
+func _PollForActivityTaskRequest_1_Decode(sr stream.Reader) (*PollForActivityTaskRequest, error) {
+	var v PollForActivityTaskRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_ListTaskListPartitions_Args struct directly from its Thrift-level
+// Decode deserializes a MatchingService_PollForActivityTask_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_ListTaskListPartitions_Args struct could not be generated from the wire
+// An error is returned if a MatchingService_PollForActivityTask_Args struct could not be generated from the wire
 // representation.
-func (v *MatchingService_ListTaskListPartitions_Args) Decode(sr stream.Reader) error {
+func (v *MatchingService_PollForActivityTask_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -11978,7 +13214,7 @@ func (v *MatchingService_ListTaskListPartitions_Args) Decode(sr stream.Reader) e
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _ListTaskListPartitionsRequest_Decode(sr)
+			v.PollRequest, err = _PollForActivityTaskRequest_1_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -12005,34 +13241,34 @@ func (v *MatchingService_ListTaskListPartitions_Args) Decode(sr stream.Reader) e
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_ListTaskListPartitions_Args
+// String returns a readable string representation of a MatchingService_PollForActivityTask_Args
 // struct.
-func (v *MatchingService_ListTaskListPartitions_Args) String() string {
+func (v *MatchingService_PollForActivityTask_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.Request != nil {
-		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+	if v.PollRequest != nil {
+		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_ListTaskListPartitions_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_PollForActivityTask_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_ListTaskListPartitions_Args match the
-// provided MatchingService_ListTaskListPartitions_Args.
+// Equals returns true if all the fields of this MatchingService_PollForActivityTask_Args match the
+// provided MatchingService_PollForActivityTask_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_ListTaskListPartitions_Args) Equals(rhs *MatchingService_ListTaskListPartitions_Args) bool {
+func (v *MatchingService_PollForActivityTask_Args) Equals(rhs *MatchingService_PollForActivityTask_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
 		return false
 	}
 
@@ -12040,107 +13276,109 @@ func (v *MatchingService_ListTaskListPartitions_Args) Equals(rhs *MatchingServic
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_ListTaskListPartitions_Args.
-func (v *MatchingService_ListTaskListPartitions_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_PollForActivityTask_Args.
+func (v *MatchingService_PollForActivityTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Request != nil {
-		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	if v.PollRequest != nil {
+		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
 	}
 	return err
 }
 
-// GetRequest returns the value of Request if it is set or its
+// GetPollRequest returns the value of PollRequest if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_ListTaskListPartitions_Args) GetRequest() (o *ListTaskListPartitionsRequest) {
-	if v != nil && v.Request != nil {
-		return v.Request
+func (v *MatchingService_PollForActivityTask_Args) GetPollRequest() (o *PollForActivityTaskRequest) {
+	if v != nil && v.PollRequest != nil {
+		return v.PollRequest
 	}
 
 	return
 }
 
-// IsSetRequest returns true if Request is not nil.
-func (v *MatchingService_ListTaskListPartitions_Args) IsSetRequest() bool {
-	return v != nil && v.Request != nil
+// IsSetPollRequest returns true if PollRequest is not nil.
+func (v *MatchingService_PollForActivityTask_Args) IsSetPollRequest() bool {
+	return v != nil && v.PollRequest != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "ListTaskListPartitions" for this struct.
-func (v *MatchingService_ListTaskListPartitions_Args) MethodName() string {
-	return "ListTaskListPartitions"
+// This will always be "PollForActivityTask" for this struct.
+func (v *MatchingService_PollForActivityTask_Args) MethodName() string {
+	return "PollForActivityTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_ListTaskListPartitions_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_PollForActivityTask_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_ListTaskListPartitions_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.ListTaskListPartitions
+// MatchingService_PollForActivityTask_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.PollForActivityTask
 // function.
-var MatchingService_ListTaskListPartitions_Helper = struct {
-	// Args accepts the parameters of ListTaskListPartitions in-order and returns
+var MatchingService_PollForActivityTask_Helper = struct {
+	// Args accepts the parameters of PollForActivityTask in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *ListTaskListPartitionsRequest,
-	) *MatchingService_ListTaskListPartitions_Args
+		pollRequest *PollForActivityTaskRequest,
+	) *MatchingService_PollForActivityTask_Args
 
 	// IsException returns true if the given error can be thrown
-	// by ListTaskListPartitions.
+	// by PollForActivityTask.
 	//
-	// An error can be thrown by ListTaskListPartitions only if the
+	// An error can be thrown by PollForActivityTask only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for ListTaskListPartitions
+	// WrapResponse returns the result struct for PollForActivityTask
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// ListTaskListPartitions into a serializable result struct.
+	// PollForActivityTask into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by ListTaskListPartitions
+	// error cannot be thrown by PollForActivityTask
 	//
-	//   value, err := ListTaskListPartitions(args)
-	//   result, err := MatchingService_ListTaskListPartitions_Helper.WrapResponse(value, err)
+	//   value, err := PollForActivityTask(args)
+	//   result, err := MatchingService_PollForActivityTask_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from ListTaskListPartitions: %v", err)
+	//     return fmt.Errorf("unexpected error from PollForActivityTask: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.ListTaskListPartitionsResponse, error) (*MatchingService_ListTaskListPartitions_Result, error)
+	WrapResponse func(*shared.PollForActivityTaskResponse, error) (*MatchingService_PollForActivityTask_Result, error)
 
-	// UnwrapResponse takes the result struct for ListTaskListPartitions
+	// UnwrapResponse takes the result struct for PollForActivityTask
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if ListTaskListPartitions threw an
+	// The error is non-nil only if PollForActivityTask threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := MatchingService_ListTaskListPartitions_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_ListTaskListPartitions_Result) (*shared.ListTaskListPartitionsResponse, error)
+	//   value, err := MatchingService_PollForActivityTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_PollForActivityTask_Result) (*shared.PollForActivityTaskResponse, error)
 }{}
 
 func init() {
-	MatchingService_ListTaskListPartitions_Helper.Args = func(
-		request *ListTaskListPartitionsRequest,
-	) *MatchingService_ListTaskListPartitions_Args {
-		return &MatchingService_ListTaskListPartitions_Args{
-			Request: request,
+	MatchingService_PollForActivityTask_Helper.Args = func(
+		pollRequest *PollForActivityTaskRequest,
+	) *MatchingService_PollForActivityTask_Args {
+		return &MatchingService_PollForActivityTask_Args{
+			PollRequest: pollRequest,
 		}
 	}
 
-	MatchingService_ListTaskListPartitions_Helper.IsException = func(err error) bool {
+	MatchingService_PollForActivityTask_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.LimitExceededError:
+			return true
 		case *shared.ServiceBusyError:
 			return true
 		default:
@@ -12148,32 +13386,37 @@ func init() {
 		}
 	}
 
-	MatchingService_ListTaskListPartitions_Helper.WrapResponse = func(success *shared.ListTaskListPartitionsResponse, err error) (*MatchingService_ListTaskListPartitions_Result, error) {
+	MatchingService_PollForActivityTask_Helper.WrapResponse = func(success *shared.PollForActivityTaskResponse, err error) (*MatchingService_PollForActivityTask_Result, error) {
 		if err == nil {
-			return &MatchingService_ListTaskListPartitions_Result{Success: success}, nil
+			return &MatchingService_PollForActivityTask_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_ListTaskListPartitions_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.BadRequestError")
 			}
-			return &MatchingService_ListTaskListPartitions_Result{BadRequestError: e}, nil
+			return &MatchingService_PollForActivityTask_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_ListTaskListPartitions_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.InternalServiceError")
 			}
-			return &MatchingService_ListTaskListPartitions_Result{InternalServiceError: e}, nil
+			return &MatchingService_PollForActivityTask_Result{InternalServiceError: e}, nil
+		case *shared.LimitExceededError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.LimitExceededError")
+			}
+			return &MatchingService_PollForActivityTask_Result{LimitExceededError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_ListTaskListPartitions_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.ServiceBusyError")
 			}
-			return &MatchingService_ListTaskListPartitions_Result{ServiceBusyError: e}, nil
+			return &MatchingService_PollForActivityTask_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_ListTaskListPartitions_Helper.UnwrapResponse = func(result *MatchingService_ListTaskListPartitions_Result) (success *shared.ListTaskListPartitionsResponse, err error) {
+	MatchingService_PollForActivityTask_Helper.UnwrapResponse = func(result *MatchingService_PollForActivityTask_Result) (success *shared.PollForActivityTaskResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -12182,6 +13425,10 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
+		if result.LimitExceededError != nil {
+			err = result.LimitExceededError
+			return
+		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
@@ -12198,20 +13445,21 @@ func init() {
 
 }
 
-// MatchingService_ListTaskListPartitions_Result represents the result of a MatchingService.ListTaskListPartitions function call.
+// MatchingService_PollForActivityTask_Result represents the result of a MatchingService.PollForActivityTask function call.
 //
-// The result of a ListTaskListPartitions execution is sent and received over the wire as this struct.
+// The result of a PollForActivityTask execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type MatchingService_ListTaskListPartitions_Result struct {
-	// Value returned by ListTaskListPartitions after a successful execution.
-	Success              *shared.ListTaskListPartitionsResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError                `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError           `json:"internalServiceError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError               `json:"serviceBusyError,omitempty"`
+type MatchingService_PollForActivityTask_Result struct {
+	// Value returned by PollForActivityTask after a successful execution.
+	Success              *shared.PollForActivityTaskResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
+	LimitExceededError   *shared.LimitExceededError          `json:"limitExceededError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError            `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_ListTaskListPartitions_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_PollForActivityTask_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -12226,9 +13474,9 @@ type MatchingService_ListTaskListPartitions_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *MatchingService_ListTaskListPartitions_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_PollForActivityTask_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -12258,6 +13506,14 @@ func (v *MatchingService_ListTaskListPartitions_Result) ToWire() (wire.Value, er
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.LimitExceededError != nil {
+		w, err = v.LimitExceededError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
 	if v.ServiceBusyError != nil {
 		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
@@ -12268,23 +13524,23 @@ func (v *MatchingService_ListTaskListPartitions_Result) ToWire() (wire.Value, er
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_ListTaskListPartitions_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_PollForActivityTask_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ListTaskListPartitionsResponse_Read(w wire.Value) (*shared.ListTaskListPartitionsResponse, error) {
-	var v shared.ListTaskListPartitionsResponse
+func _PollForActivityTaskResponse_Read(w wire.Value) (*shared.PollForActivityTaskResponse, error) {
+	var v shared.PollForActivityTaskResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_ListTaskListPartitions_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_PollForActivityTask_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_ListTaskListPartitions_Result struct
+// An error is returned if we were unable to build a MatchingService_PollForActivityTask_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -12292,19 +13548,19 @@ func _ListTaskListPartitionsResponse_Read(w wire.Value) (*shared.ListTaskListPar
 //	  return nil, err
 //	}
 //
-//	var v MatchingService_ListTaskListPartitions_Result
+//	var v MatchingService_PollForActivityTask_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *MatchingService_ListTaskListPartitions_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_PollForActivityTask_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _ListTaskListPartitionsResponse_Read(field.Value)
+				v.Success, err = _PollForActivityTaskResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -12325,6 +13581,14 @@ func (v *MatchingService_ListTaskListPartitions_Result) FromWire(w wire.Value) e
 					return err
 				}
 
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		case 4:
 			if field.Value.Type() == wire.TStruct {
@@ -12347,21 +13611,24 @@ func (v *MatchingService_ListTaskListPartitions_Result) FromWire(w wire.Value) e
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.LimitExceededError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("MatchingService_ListTaskListPartitions_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_PollForActivityTask_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a MatchingService_ListTaskListPartitions_Result struct directly into bytes, without going
+// Encode serializes a MatchingService_PollForActivityTask_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_ListTaskListPartitions_Result struct could not be encoded.
-func (v *MatchingService_ListTaskListPartitions_Result) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_PollForActivityTask_Result struct could not be encoded.
+func (v *MatchingService_PollForActivityTask_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -12402,6 +13669,18 @@ func (v *MatchingService_ListTaskListPartitions_Result) Encode(sw stream.Writer)
 		}
 	}
 
+	if v.LimitExceededError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.LimitExceededError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	if v.ServiceBusyError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
 			return err
@@ -12424,29 +13703,32 @@ func (v *MatchingService_ListTaskListPartitions_Result) Encode(sw stream.Writer)
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.LimitExceededError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
 
 	if count != 1 {
-		return fmt.Errorf("MatchingService_ListTaskListPartitions_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_PollForActivityTask_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _ListTaskListPartitionsResponse_Decode(sr stream.Reader) (*shared.ListTaskListPartitionsResponse, error) {
-	var v shared.ListTaskListPartitionsResponse
+func _PollForActivityTaskResponse_Decode(sr stream.Reader) (*shared.PollForActivityTaskResponse, error) {
+	var v shared.PollForActivityTaskResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_ListTaskListPartitions_Result struct directly from its Thrift-level
+// Decode deserializes a MatchingService_PollForActivityTask_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_ListTaskListPartitions_Result struct could not be generated from the wire
+// An error is returned if a MatchingService_PollForActivityTask_Result struct could not be generated from the wire
 // representation.
-func (v *MatchingService_ListTaskListPartitions_Result) Decode(sr stream.Reader) error {
+func (v *MatchingService_PollForActivityTask_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -12460,7 +13742,7 @@ func (v *MatchingService_ListTaskListPartitions_Result) Decode(sr stream.Reader)
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _ListTaskListPartitionsResponse_Decode(sr)
+			v.Success, err = _PollForActivityTaskResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -12477,6 +13759,12 @@ func (v *MatchingService_ListTaskListPartitions_Result) Decode(sr stream.Reader)
 				return err
 			}
 
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		case fh.ID == 4 && fh.Type == wire.TStruct:
 			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
@@ -12512,24 +13800,27 @@ func (v *MatchingService_ListTaskListPartitions_Result) Decode(sr stream.Reader)
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.LimitExceededError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("MatchingService_ListTaskListPartitions_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_PollForActivityTask_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_ListTaskListPartitions_Result
+// String returns a readable string representation of a MatchingService_PollForActivityTask_Result
 // struct.
-func (v *MatchingService_ListTaskListPartitions_Result) String() string {
+func (v *MatchingService_PollForActivityTask_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -12543,19 +13834,23 @@ func (v *MatchingService_ListTaskListPartitions_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.LimitExceededError != nil {
+		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+		i++
+	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_ListTaskListPartitions_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_PollForActivityTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_ListTaskListPartitions_Result match the
-// provided MatchingService_ListTaskListPartitions_Result.
+// Equals returns true if all the fields of this MatchingService_PollForActivityTask_Result match the
+// provided MatchingService_PollForActivityTask_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_ListTaskListPartitions_Result) Equals(rhs *MatchingService_ListTaskListPartitions_Result) bool {
+func (v *MatchingService_PollForActivityTask_Result) Equals(rhs *MatchingService_PollForActivityTask_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -12570,6 +13865,9 @@ func (v *MatchingService_ListTaskListPartitions_Result) Equals(rhs *MatchingServ
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
+	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+		return false
+	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
@@ -12578,8 +13876,8 @@ func (v *MatchingService_ListTaskListPartitions_Result) Equals(rhs *MatchingServ
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_ListTaskListPartitions_Result.
-func (v *MatchingService_ListTaskListPartitions_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_PollForActivityTask_Result.
+func (v *MatchingService_PollForActivityTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -12592,6 +13890,9 @@ func (v *MatchingService_ListTaskListPartitions_Result) MarshalLogObject(enc zap
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.LimitExceededError != nil {
+		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
+	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
@@ -12600,7 +13901,7 @@ func (v *MatchingService_ListTaskListPartitions_Result) MarshalLogObject(enc zap
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_ListTaskListPartitions_Result) GetSuccess() (o *shared.ListTaskListPartitionsResponse) {
+func (v *MatchingService_PollForActivityTask_Result) GetSuccess() (o *shared.PollForActivityTaskResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -12609,13 +13910,13 @@ func (v *MatchingService_ListTaskListPartitions_Result) GetSuccess() (o *shared.
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *MatchingService_ListTaskListPartitions_Result) IsSetSuccess() bool {
+func (v *MatchingService_PollForActivityTask_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_ListTaskListPartitions_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_PollForActivityTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -12624,13 +13925,13 @@ func (v *MatchingService_ListTaskListPartitions_Result) GetBadRequestError() (o
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_ListTaskListPartitions_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_PollForActivityTask_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_ListTaskListPartitions_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_PollForActivityTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -12638,14 +13939,29 @@ func (v *MatchingService_ListTaskListPartitions_Result) GetInternalServiceError(
 	return
 }
 
-// IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_ListTaskListPartitions_Result) IsSetInternalServiceError() bool {
-	return v != nil && v.InternalServiceError != nil
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *MatchingService_PollForActivityTask_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetLimitExceededError returns the value of LimitExceededError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_PollForActivityTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+	if v != nil && v.LimitExceededError != nil {
+		return v.LimitExceededError
+	}
+
+	return
+}
+
+// IsSetLimitExceededError returns true if LimitExceededError is not nil.
+func (v *MatchingService_PollForActivityTask_Result) IsSetLimitExceededError() bool {
+	return v != nil && v.LimitExceededError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_ListTaskListPartitions_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_PollForActivityTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -12654,33 +13970,33 @@ func (v *MatchingService_ListTaskListPartitions_Result) GetServiceBusyError() (o
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_ListTaskListPartitions_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_PollForActivityTask_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "ListTaskListPartitions" for this struct.
-func (v *MatchingService_ListTaskListPartitions_Result) MethodName() string {
-	return "ListTaskListPartitions"
+// This will always be "PollForActivityTask" for this struct.
+func (v *MatchingService_PollForActivityTask_Result) MethodName() string {
+	return "PollForActivityTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_ListTaskListPartitions_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_PollForActivityTask_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_PollForActivityTask_Args represents the arguments for the MatchingService.PollForActivityTask function.
+// MatchingService_PollForDecisionTask_Args represents the arguments for the MatchingService.PollForDecisionTask function.
 //
-// The arguments for PollForActivityTask are sent and received over the wire as this struct.
-type MatchingService_PollForActivityTask_Args struct {
-	PollRequest *PollForActivityTaskRequest `json:"pollRequest,omitempty"`
+// The arguments for PollForDecisionTask are sent and received over the wire as this struct.
+type MatchingService_PollForDecisionTask_Args struct {
+	PollRequest *PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
 }
 
-// ToWire translates a MatchingService_PollForActivityTask_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_PollForDecisionTask_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -12695,7 +14011,7 @@ type MatchingService_PollForActivityTask_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *MatchingService_PollForActivityTask_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_PollForDecisionTask_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -12715,17 +14031,17 @@ func (v *MatchingService_PollForActivityTask_Args) ToWire() (wire.Value, error)
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForActivityTaskRequest_1_Read(w wire.Value) (*PollForActivityTaskRequest, error) {
-	var v PollForActivityTaskRequest
+func _PollForDecisionTaskRequest_1_Read(w wire.Value) (*PollForDecisionTaskRequest, error) {
+	var v PollForDecisionTaskRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_PollForActivityTask_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_PollForDecisionTask_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_PollForActivityTask_Args struct
+// An error is returned if we were unable to build a MatchingService_PollForDecisionTask_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -12733,19 +14049,19 @@ func _PollForActivityTaskRequest_1_Read(w wire.Value) (*PollForActivityTaskReque
 //	  return nil, err
 //	}
 //
-//	var v MatchingService_PollForActivityTask_Args
+//	var v MatchingService_PollForDecisionTask_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *MatchingService_PollForActivityTask_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_PollForDecisionTask_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.PollRequest, err = _PollForActivityTaskRequest_1_Read(field.Value)
+				v.PollRequest, err = _PollForDecisionTaskRequest_1_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -12757,11 +14073,11 @@ func (v *MatchingService_PollForActivityTask_Args) FromWire(w wire.Value) error
 	return nil
 }
 
-// Encode serializes a MatchingService_PollForActivityTask_Args struct directly into bytes, without going
+// Encode serializes a MatchingService_PollForDecisionTask_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_PollForActivityTask_Args struct could not be encoded.
-func (v *MatchingService_PollForActivityTask_Args) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_PollForDecisionTask_Args struct could not be encoded.
+func (v *MatchingService_PollForDecisionTask_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -12781,18 +14097,18 @@ func (v *MatchingService_PollForActivityTask_Args) Encode(sw stream.Writer) erro
 	return sw.WriteStructEnd()
 }
 
-func _PollForActivityTaskRequest_1_Decode(sr stream.Reader) (*PollForActivityTaskRequest, error) {
-	var v PollForActivityTaskRequest
+func _PollForDecisionTaskRequest_1_Decode(sr stream.Reader) (*PollForDecisionTaskRequest, error) {
+	var v PollForDecisionTaskRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_PollForActivityTask_Args struct directly from its Thrift-level
+// Decode deserializes a MatchingService_PollForDecisionTask_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_PollForActivityTask_Args struct could not be generated from the wire
+// An error is returned if a MatchingService_PollForDecisionTask_Args struct could not be generated from the wire
 // representation.
-func (v *MatchingService_PollForActivityTask_Args) Decode(sr stream.Reader) error {
+func (v *MatchingService_PollForDecisionTask_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -12806,7 +14122,7 @@ func (v *MatchingService_PollForActivityTask_Args) Decode(sr stream.Reader) erro
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.PollRequest, err = _PollForActivityTaskRequest_1_Decode(sr)
+			v.PollRequest, err = _PollForDecisionTaskRequest_1_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -12833,9 +14149,9 @@ func (v *MatchingService_PollForActivityTask_Args) Decode(sr stream.Reader) erro
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_PollForActivityTask_Args
+// String returns a readable string representation of a MatchingService_PollForDecisionTask_Args
 // struct.
-func (v *MatchingService_PollForActivityTask_Args) String() string {
+func (v *MatchingService_PollForDecisionTask_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -12847,14 +14163,14 @@ func (v *MatchingService_PollForActivityTask_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_PollForActivityTask_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_PollForDecisionTask_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_PollForActivityTask_Args match the
-// provided MatchingService_PollForActivityTask_Args.
+// Equals returns true if all the fields of this MatchingService_PollForDecisionTask_Args match the
+// provided MatchingService_PollForDecisionTask_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_PollForActivityTask_Args) Equals(rhs *MatchingService_PollForActivityTask_Args) bool {
+func (v *MatchingService_PollForDecisionTask_Args) Equals(rhs *MatchingService_PollForDecisionTask_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -12868,8 +14184,8 @@ func (v *MatchingService_PollForActivityTask_Args) Equals(rhs *MatchingService_P
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_PollForActivityTask_Args.
-func (v *MatchingService_PollForActivityTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_PollForDecisionTask_Args.
+func (v *MatchingService_PollForDecisionTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -12881,7 +14197,7 @@ func (v *MatchingService_PollForActivityTask_Args) MarshalLogObject(enc zapcore.
 
 // GetPollRequest returns the value of PollRequest if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForActivityTask_Args) GetPollRequest() (o *PollForActivityTaskRequest) {
+func (v *MatchingService_PollForDecisionTask_Args) GetPollRequest() (o *PollForDecisionTaskRequest) {
 	if v != nil && v.PollRequest != nil {
 		return v.PollRequest
 	}
@@ -12890,80 +14206,80 @@ func (v *MatchingService_PollForActivityTask_Args) GetPollRequest() (o *PollForA
 }
 
 // IsSetPollRequest returns true if PollRequest is not nil.
-func (v *MatchingService_PollForActivityTask_Args) IsSetPollRequest() bool {
+func (v *MatchingService_PollForDecisionTask_Args) IsSetPollRequest() bool {
 	return v != nil && v.PollRequest != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "PollForActivityTask" for this struct.
-func (v *MatchingService_PollForActivityTask_Args) MethodName() string {
-	return "PollForActivityTask"
+// This will always be "PollForDecisionTask" for this struct.
+func (v *MatchingService_PollForDecisionTask_Args) MethodName() string {
+	return "PollForDecisionTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_PollForActivityTask_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_PollForDecisionTask_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_PollForActivityTask_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.PollForActivityTask
+// MatchingService_PollForDecisionTask_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.PollForDecisionTask
 // function.
-var MatchingService_PollForActivityTask_Helper = struct {
-	// Args accepts the parameters of PollForActivityTask in-order and returns
+var MatchingService_PollForDecisionTask_Helper = struct {
+	// Args accepts the parameters of PollForDecisionTask in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		pollRequest *PollForActivityTaskRequest,
-	) *MatchingService_PollForActivityTask_Args
+		pollRequest *PollForDecisionTaskRequest,
+	) *MatchingService_PollForDecisionTask_Args
 
 	// IsException returns true if the given error can be thrown
-	// by PollForActivityTask.
+	// by PollForDecisionTask.
 	//
-	// An error can be thrown by PollForActivityTask only if the
+	// An error can be thrown by PollForDecisionTask only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for PollForActivityTask
+	// WrapResponse returns the result struct for PollForDecisionTask
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// PollForActivityTask into a serializable result struct.
+	// PollForDecisionTask into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by PollForActivityTask
+	// error cannot be thrown by PollForDecisionTask
 	//
-	//   value, err := PollForActivityTask(args)
-	//   result, err := MatchingService_PollForActivityTask_Helper.WrapResponse(value, err)
+	//   value, err := PollForDecisionTask(args)
+	//   result, err := MatchingService_PollForDecisionTask_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from PollForActivityTask: %v", err)
+	//     return fmt.Errorf("unexpected error from PollForDecisionTask: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.PollForActivityTaskResponse, error) (*MatchingService_PollForActivityTask_Result, error)
+	WrapResponse func(*PollForDecisionTaskResponse, error) (*MatchingService_PollForDecisionTask_Result, error)
 
-	// UnwrapResponse takes the result struct for PollForActivityTask
+	// UnwrapResponse takes the result struct for PollForDecisionTask
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if PollForActivityTask threw an
+	// The error is non-nil only if PollForDecisionTask threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := MatchingService_PollForActivityTask_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_PollForActivityTask_Result) (*shared.PollForActivityTaskResponse, error)
+	//   value, err := MatchingService_PollForDecisionTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_PollForDecisionTask_Result) (*PollForDecisionTaskResponse, error)
 }{}
 
 func init() {
-	MatchingService_PollForActivityTask_Helper.Args = func(
-		pollRequest *PollForActivityTaskRequest,
-	) *MatchingService_PollForActivityTask_Args {
-		return &MatchingService_PollForActivityTask_Args{
+	MatchingService_PollForDecisionTask_Helper.Args = func(
+		pollRequest *PollForDecisionTaskRequest,
+	) *MatchingService_PollForDecisionTask_Args {
+		return &MatchingService_PollForDecisionTask_Args{
 			PollRequest: pollRequest,
 		}
 	}
 
-	MatchingService_PollForActivityTask_Helper.IsException = func(err error) bool {
+	MatchingService_PollForDecisionTask_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -12978,37 +14294,37 @@ func init() {
 		}
 	}
 
-	MatchingService_PollForActivityTask_Helper.WrapResponse = func(success *shared.PollForActivityTaskResponse, err error) (*MatchingService_PollForActivityTask_Result, error) {
+	MatchingService_PollForDecisionTask_Helper.WrapResponse = func(success *PollForDecisionTaskResponse, err error) (*MatchingService_PollForDecisionTask_Result, error) {
 		if err == nil {
-			return &MatchingService_PollForActivityTask_Result{Success: success}, nil
+			return &MatchingService_PollForDecisionTask_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.BadRequestError")
 			}
-			return &MatchingService_PollForActivityTask_Result{BadRequestError: e}, nil
+			return &MatchingService_PollForDecisionTask_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.InternalServiceError")
 			}
-			return &MatchingService_PollForActivityTask_Result{InternalServiceError: e}, nil
+			return &MatchingService_PollForDecisionTask_Result{InternalServiceError: e}, nil
 		case *shared.LimitExceededError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.LimitExceededError")
 			}
-			return &MatchingService_PollForActivityTask_Result{LimitExceededError: e}, nil
+			return &MatchingService_PollForDecisionTask_Result{LimitExceededError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.ServiceBusyError")
 			}
-			return &MatchingService_PollForActivityTask_Result{ServiceBusyError: e}, nil
+			return &MatchingService_PollForDecisionTask_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_PollForActivityTask_Helper.UnwrapResponse = func(result *MatchingService_PollForActivityTask_Result) (success *shared.PollForActivityTaskResponse, err error) {
+	MatchingService_PollForDecisionTask_Helper.UnwrapResponse = func(result *MatchingService_PollForDecisionTask_Result) (success *PollForDecisionTaskResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -13037,21 +14353,21 @@ func init() {
 
 }
 
-// MatchingService_PollForActivityTask_Result represents the result of a MatchingService.PollForActivityTask function call.
+// MatchingService_PollForDecisionTask_Result represents the result of a MatchingService.PollForDecisionTask function call.
 //
-// The result of a PollForActivityTask execution is sent and received over the wire as this struct.
+// The result of a PollForDecisionTask execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type MatchingService_PollForActivityTask_Result struct {
-	// Value returned by PollForActivityTask after a successful execution.
-	Success              *shared.PollForActivityTaskResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
-	LimitExceededError   *shared.LimitExceededError          `json:"limitExceededError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError            `json:"serviceBusyError,omitempty"`
+type MatchingService_PollForDecisionTask_Result struct {
+	// Value returned by PollForDecisionTask after a successful execution.
+	Success              *PollForDecisionTaskResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	LimitExceededError   *shared.LimitExceededError   `json:"limitExceededError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_PollForActivityTask_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_PollForDecisionTask_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -13066,7 +14382,7 @@ type MatchingService_PollForActivityTask_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *MatchingService_PollForActivityTask_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_PollForDecisionTask_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [5]wire.Field
 		i      int = 0
@@ -13116,23 +14432,23 @@ func (v *MatchingService_PollForActivityTask_Result) ToWire() (wire.Value, error
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_PollForActivityTask_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_PollForDecisionTask_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForActivityTaskResponse_Read(w wire.Value) (*shared.PollForActivityTaskResponse, error) {
-	var v shared.PollForActivityTaskResponse
+func _PollForDecisionTaskResponse_Read(w wire.Value) (*PollForDecisionTaskResponse, error) {
+	var v PollForDecisionTaskResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_PollForActivityTask_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_PollForDecisionTask_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_PollForActivityTask_Result struct
+// An error is returned if we were unable to build a MatchingService_PollForDecisionTask_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -13140,19 +14456,19 @@ func _PollForActivityTaskResponse_Read(w wire.Value) (*shared.PollForActivityTas
 //	  return nil, err
 //	}
 //
-//	var v MatchingService_PollForActivityTask_Result
+//	var v MatchingService_PollForDecisionTask_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *MatchingService_PollForActivityTask_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_PollForDecisionTask_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _PollForActivityTaskResponse_Read(field.Value)
+				v.Success, err = _PollForDecisionTaskResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -13210,17 +14526,17 @@ func (v *MatchingService_PollForActivityTask_Result) FromWire(w wire.Value) erro
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("MatchingService_PollForActivityTask_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_PollForDecisionTask_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a MatchingService_PollForActivityTask_Result struct directly into bytes, without going
+// Encode serializes a MatchingService_PollForDecisionTask_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_PollForActivityTask_Result struct could not be encoded.
-func (v *MatchingService_PollForActivityTask_Result) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_PollForDecisionTask_Result struct could not be encoded.
+func (v *MatchingService_PollForDecisionTask_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -13303,24 +14619,24 @@ func (v *MatchingService_PollForActivityTask_Result) Encode(sw stream.Writer) er
 	}
 
 	if count != 1 {
-		return fmt.Errorf("MatchingService_PollForActivityTask_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_PollForDecisionTask_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _PollForActivityTaskResponse_Decode(sr stream.Reader) (*shared.PollForActivityTaskResponse, error) {
-	var v shared.PollForActivityTaskResponse
+func _PollForDecisionTaskResponse_Decode(sr stream.Reader) (*PollForDecisionTaskResponse, error) {
+	var v PollForDecisionTaskResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_PollForActivityTask_Result struct directly from its Thrift-level
+// Decode deserializes a MatchingService_PollForDecisionTask_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_PollForActivityTask_Result struct could not be generated from the wire
+// An error is returned if a MatchingService_PollForDecisionTask_Result struct could not be generated from the wire
 // representation.
-func (v *MatchingService_PollForActivityTask_Result) Decode(sr stream.Reader) error {
+func (v *MatchingService_PollForDecisionTask_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -13334,7 +14650,7 @@ func (v *MatchingService_PollForActivityTask_Result) Decode(sr stream.Reader) er
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _PollForActivityTaskResponse_Decode(sr)
+			v.Success, err = _PollForDecisionTaskResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -13399,15 +14715,15 @@ func (v *MatchingService_PollForActivityTask_Result) Decode(sr stream.Reader) er
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("MatchingService_PollForActivityTask_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_PollForDecisionTask_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_PollForActivityTask_Result
+// String returns a readable string representation of a MatchingService_PollForDecisionTask_Result
 // struct.
-func (v *MatchingService_PollForActivityTask_Result) String() string {
+func (v *MatchingService_PollForDecisionTask_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -13435,14 +14751,14 @@ func (v *MatchingService_PollForActivityTask_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_PollForActivityTask_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_PollForDecisionTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_PollForActivityTask_Result match the
-// provided MatchingService_PollForActivityTask_Result.
+// Equals returns true if all the fields of this MatchingService_PollForDecisionTask_Result match the
+// provided MatchingService_PollForDecisionTask_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_PollForActivityTask_Result) Equals(rhs *MatchingService_PollForActivityTask_Result) bool {
+func (v *MatchingService_PollForDecisionTask_Result) Equals(rhs *MatchingService_PollForDecisionTask_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -13468,8 +14784,8 @@ func (v *MatchingService_PollForActivityTask_Result) Equals(rhs *MatchingService
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_PollForActivityTask_Result.
-func (v *MatchingService_PollForActivityTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_PollForDecisionTask_Result.
+func (v *MatchingService_PollForDecisionTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -13493,7 +14809,7 @@ func (v *MatchingService_PollForActivityTask_Result) MarshalLogObject(enc zapcor
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForActivityTask_Result) GetSuccess() (o *shared.PollForActivityTaskResponse) {
+func (v *MatchingService_PollForDecisionTask_Result) GetSuccess() (o *PollForDecisionTaskResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -13502,13 +14818,13 @@ func (v *MatchingService_PollForActivityTask_Result) GetSuccess() (o *shared.Pol
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *MatchingService_PollForActivityTask_Result) IsSetSuccess() bool {
+func (v *MatchingService_PollForDecisionTask_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForActivityTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_PollForDecisionTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -13517,13 +14833,13 @@ func (v *MatchingService_PollForActivityTask_Result) GetBadRequestError() (o *sh
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_PollForActivityTask_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_PollForDecisionTask_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForActivityTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_PollForDecisionTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -13532,13 +14848,13 @@ func (v *MatchingService_PollForActivityTask_Result) GetInternalServiceError() (
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_PollForActivityTask_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_PollForDecisionTask_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetLimitExceededError returns the value of LimitExceededError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForActivityTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+func (v *MatchingService_PollForDecisionTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
 	if v != nil && v.LimitExceededError != nil {
 		return v.LimitExceededError
 	}
@@ -13547,13 +14863,13 @@ func (v *MatchingService_PollForActivityTask_Result) GetLimitExceededError() (o
 }
 
 // IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_PollForActivityTask_Result) IsSetLimitExceededError() bool {
+func (v *MatchingService_PollForDecisionTask_Result) IsSetLimitExceededError() bool {
 	return v != nil && v.LimitExceededError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForActivityTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_PollForDecisionTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -13562,33 +14878,33 @@ func (v *MatchingService_PollForActivityTask_Result) GetServiceBusyError() (o *s
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_PollForActivityTask_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_PollForDecisionTask_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "PollForActivityTask" for this struct.
-func (v *MatchingService_PollForActivityTask_Result) MethodName() string {
-	return "PollForActivityTask"
+// This will always be "PollForDecisionTask" for this struct.
+func (v *MatchingService_PollForDecisionTask_Result) MethodName() string {
+	return "PollForDecisionTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_PollForActivityTask_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_PollForDecisionTask_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_PollForDecisionTask_Args represents the arguments for the MatchingService.PollForDecisionTask function.
+// MatchingService_QueryWorkflow_Args represents the arguments for the MatchingService.QueryWorkflow function.
 //
-// The arguments for PollForDecisionTask are sent and received over the wire as this struct.
-type MatchingService_PollForDecisionTask_Args struct {
-	PollRequest *PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
+// The arguments for QueryWorkflow are sent and received over the wire as this struct.
+type MatchingService_QueryWorkflow_Args struct {
+	QueryRequest *QueryWorkflowRequest `json:"queryRequest,omitempty"`
 }
 
-// ToWire translates a MatchingService_PollForDecisionTask_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_QueryWorkflow_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -13603,7 +14919,7 @@ type MatchingService_PollForDecisionTask_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *MatchingService_PollForDecisionTask_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_QueryWorkflow_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -13611,8 +14927,8 @@ func (v *MatchingService_PollForDecisionTask_Args) ToWire() (wire.Value, error)
 		err    error
 	)
 
-	if v.PollRequest != nil {
-		w, err = v.PollRequest.ToWire()
+	if v.QueryRequest != nil {
+		w, err = v.QueryRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -13623,17 +14939,17 @@ func (v *MatchingService_PollForDecisionTask_Args) ToWire() (wire.Value, error)
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForDecisionTaskRequest_1_Read(w wire.Value) (*PollForDecisionTaskRequest, error) {
-	var v PollForDecisionTaskRequest
+func _QueryWorkflowRequest_1_Read(w wire.Value) (*QueryWorkflowRequest, error) {
+	var v QueryWorkflowRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_PollForDecisionTask_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_QueryWorkflow_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_PollForDecisionTask_Args struct
+// An error is returned if we were unable to build a MatchingService_QueryWorkflow_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -13641,19 +14957,19 @@ func _PollForDecisionTaskRequest_1_Read(w wire.Value) (*PollForDecisionTaskReque
 //	  return nil, err
 //	}
 //
-//	var v MatchingService_PollForDecisionTask_Args
+//	var v MatchingService_QueryWorkflow_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *MatchingService_PollForDecisionTask_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_QueryWorkflow_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.PollRequest, err = _PollForDecisionTaskRequest_1_Read(field.Value)
+				v.QueryRequest, err = _QueryWorkflowRequest_1_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -13665,20 +14981,20 @@ func (v *MatchingService_PollForDecisionTask_Args) FromWire(w wire.Value) error
 	return nil
 }
 
-// Encode serializes a MatchingService_PollForDecisionTask_Args struct directly into bytes, without going
+// Encode serializes a MatchingService_QueryWorkflow_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_PollForDecisionTask_Args struct could not be encoded.
-func (v *MatchingService_PollForDecisionTask_Args) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_QueryWorkflow_Args struct could not be encoded.
+func (v *MatchingService_QueryWorkflow_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.PollRequest != nil {
+	if v.QueryRequest != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.PollRequest.Encode(sw); err != nil {
+		if err := v.QueryRequest.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -13689,18 +15005,18 @@ func (v *MatchingService_PollForDecisionTask_Args) Encode(sw stream.Writer) erro
 	return sw.WriteStructEnd()
 }
 
-func _PollForDecisionTaskRequest_1_Decode(sr stream.Reader) (*PollForDecisionTaskRequest, error) {
-	var v PollForDecisionTaskRequest
+func _QueryWorkflowRequest_1_Decode(sr stream.Reader) (*QueryWorkflowRequest, error) {
+	var v QueryWorkflowRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_PollForDecisionTask_Args struct directly from its Thrift-level
+// Decode deserializes a MatchingService_QueryWorkflow_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_PollForDecisionTask_Args struct could not be generated from the wire
+// An error is returned if a MatchingService_QueryWorkflow_Args struct could not be generated from the wire
 // representation.
-func (v *MatchingService_PollForDecisionTask_Args) Decode(sr stream.Reader) error {
+func (v *MatchingService_QueryWorkflow_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -13714,7 +15030,7 @@ func (v *MatchingService_PollForDecisionTask_Args) Decode(sr stream.Reader) erro
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.PollRequest, err = _PollForDecisionTaskRequest_1_Decode(sr)
+			v.QueryRequest, err = _QueryWorkflowRequest_1_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -13741,34 +15057,34 @@ func (v *MatchingService_PollForDecisionTask_Args) Decode(sr stream.Reader) erro
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_PollForDecisionTask_Args
+// String returns a readable string representation of a MatchingService_QueryWorkflow_Args
 // struct.
-func (v *MatchingService_PollForDecisionTask_Args) String() string {
+func (v *MatchingService_QueryWorkflow_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.PollRequest != nil {
-		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
+	if v.QueryRequest != nil {
+		fields[i] = fmt.Sprintf("QueryRequest: %v", v.QueryRequest)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_PollForDecisionTask_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_QueryWorkflow_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_PollForDecisionTask_Args match the
-// provided MatchingService_PollForDecisionTask_Args.
+// Equals returns true if all the fields of this MatchingService_QueryWorkflow_Args match the
+// provided MatchingService_QueryWorkflow_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_PollForDecisionTask_Args) Equals(rhs *MatchingService_PollForDecisionTask_Args) bool {
+func (v *MatchingService_QueryWorkflow_Args) Equals(rhs *MatchingService_QueryWorkflow_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
+	if !((v.QueryRequest == nil && rhs.QueryRequest == nil) || (v.QueryRequest != nil && rhs.QueryRequest != nil && v.QueryRequest.Equals(rhs.QueryRequest))) {
 		return false
 	}
 
@@ -13776,147 +15092,175 @@ func (v *MatchingService_PollForDecisionTask_Args) Equals(rhs *MatchingService_P
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_PollForDecisionTask_Args.
-func (v *MatchingService_PollForDecisionTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_QueryWorkflow_Args.
+func (v *MatchingService_QueryWorkflow_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.PollRequest != nil {
-		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
+	if v.QueryRequest != nil {
+		err = multierr.Append(err, enc.AddObject("queryRequest", v.QueryRequest))
 	}
 	return err
 }
 
-// GetPollRequest returns the value of PollRequest if it is set or its
+// GetQueryRequest returns the value of QueryRequest if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForDecisionTask_Args) GetPollRequest() (o *PollForDecisionTaskRequest) {
-	if v != nil && v.PollRequest != nil {
-		return v.PollRequest
+func (v *MatchingService_QueryWorkflow_Args) GetQueryRequest() (o *QueryWorkflowRequest) {
+	if v != nil && v.QueryRequest != nil {
+		return v.QueryRequest
 	}
 
 	return
 }
 
-// IsSetPollRequest returns true if PollRequest is not nil.
-func (v *MatchingService_PollForDecisionTask_Args) IsSetPollRequest() bool {
-	return v != nil && v.PollRequest != nil
+// IsSetQueryRequest returns true if QueryRequest is not nil.
+func (v *MatchingService_QueryWorkflow_Args) IsSetQueryRequest() bool {
+	return v != nil && v.QueryRequest != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "PollForDecisionTask" for this struct.
-func (v *MatchingService_PollForDecisionTask_Args) MethodName() string {
-	return "PollForDecisionTask"
+// This will always be "QueryWorkflow" for this struct.
+func (v *MatchingService_QueryWorkflow_Args) MethodName() string {
+	return "QueryWorkflow"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_PollForDecisionTask_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_QueryWorkflow_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_PollForDecisionTask_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.PollForDecisionTask
+// MatchingService_QueryWorkflow_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.QueryWorkflow
 // function.
-var MatchingService_PollForDecisionTask_Helper = struct {
-	// Args accepts the parameters of PollForDecisionTask in-order and returns
+var MatchingService_QueryWorkflow_Helper = struct {
+	// Args accepts the parameters of QueryWorkflow in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		pollRequest *PollForDecisionTaskRequest,
-	) *MatchingService_PollForDecisionTask_Args
+		queryRequest *QueryWorkflowRequest,
+	) *MatchingService_QueryWorkflow_Args
 
 	// IsException returns true if the given error can be thrown
-	// by PollForDecisionTask.
+	// by QueryWorkflow.
 	//
-	// An error can be thrown by PollForDecisionTask only if the
+	// An error can be thrown by QueryWorkflow only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for PollForDecisionTask
+	// WrapResponse returns the result struct for QueryWorkflow
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// PollForDecisionTask into a serializable result struct.
+	// QueryWorkflow into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by PollForDecisionTask
+	// error cannot be thrown by QueryWorkflow
 	//
-	//   value, err := PollForDecisionTask(args)
-	//   result, err := MatchingService_PollForDecisionTask_Helper.WrapResponse(value, err)
+	//   value, err := QueryWorkflow(args)
+	//   result, err := MatchingService_QueryWorkflow_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from PollForDecisionTask: %v", err)
+	//     return fmt.Errorf("unexpected error from QueryWorkflow: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*PollForDecisionTaskResponse, error) (*MatchingService_PollForDecisionTask_Result, error)
+	WrapResponse func(*shared.QueryWorkflowResponse, error) (*MatchingService_QueryWorkflow_Result, error)
 
-	// UnwrapResponse takes the result struct for PollForDecisionTask
+	// UnwrapResponse takes the result struct for QueryWorkflow
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if PollForDecisionTask threw an
+	// The error is non-nil only if QueryWorkflow threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := MatchingService_PollForDecisionTask_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_PollForDecisionTask_Result) (*PollForDecisionTaskResponse, error)
+	//   value, err := MatchingService_QueryWorkflow_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_QueryWorkflow_Result) (*shared.QueryWorkflowResponse, error)
 }{}
 
 func init() {
-	MatchingService_PollForDecisionTask_Helper.Args = func(
-		pollRequest *PollForDecisionTaskRequest,
-	) *MatchingService_PollForDecisionTask_Args {
-		return &MatchingService_PollForDecisionTask_Args{
-			PollRequest: pollRequest,
+	MatchingService_QueryWorkflow_Helper.Args = func(
+		queryRequest *QueryWorkflowRequest,
+	) *MatchingService_QueryWorkflow_Args {
+		return &MatchingService_QueryWorkflow_Args{
+			QueryRequest: queryRequest,
 		}
 	}
 
-	MatchingService_PollForDecisionTask_Helper.IsException = func(err error) bool {
+	MatchingService_QueryWorkflow_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.QueryFailedError:
+			return true
 		case *shared.LimitExceededError:
 			return true
 		case *shared.ServiceBusyError:
 			return true
+		case *shared.StickyWorkerUnavailableError:
+			return true
+		case *shared.TaskListNotOwnedByHostError:
+			return true
 		default:
 			return false
 		}
 	}
 
-	MatchingService_PollForDecisionTask_Helper.WrapResponse = func(success *PollForDecisionTaskResponse, err error) (*MatchingService_PollForDecisionTask_Result, error) {
+	MatchingService_QueryWorkflow_Helper.WrapResponse = func(success *shared.QueryWorkflowResponse, err error) (*MatchingService_QueryWorkflow_Result, error) {
 		if err == nil {
-			return &MatchingService_PollForDecisionTask_Result{Success: success}, nil
+			return &MatchingService_QueryWorkflow_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.BadRequestError")
 			}
-			return &MatchingService_PollForDecisionTask_Result{BadRequestError: e}, nil
+			return &MatchingService_QueryWorkflow_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.InternalServiceError")
 			}
-			return &MatchingService_PollForDecisionTask_Result{InternalServiceError: e}, nil
+			return &MatchingService_QueryWorkflow_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.EntityNotExistError")
+			}
+			return &MatchingService_QueryWorkflow_Result{EntityNotExistError: e}, nil
+		case *shared.QueryFailedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.QueryFailedError")
+			}
+			return &MatchingService_QueryWorkflow_Result{QueryFailedError: e}, nil
 		case *shared.LimitExceededError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.LimitExceededError")
 			}
-			return &MatchingService_PollForDecisionTask_Result{LimitExceededError: e}, nil
+			return &MatchingService_QueryWorkflow_Result{LimitExceededError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.ServiceBusyError")
 			}
-			return &MatchingService_PollForDecisionTask_Result{ServiceBusyError: e}, nil
+			return &MatchingService_QueryWorkflow_Result{ServiceBusyError: e}, nil
+		case *shared.StickyWorkerUnavailableError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.StickyWorkerUnavailableError")
+			}
+			return &MatchingService_QueryWorkflow_Result{StickyWorkerUnavailableError: e}, nil
+		case *shared.TaskListNotOwnedByHostError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.TaskListNotOwnedByHostError")
+			}
+			return &MatchingService_QueryWorkflow_Result{TaskListNotOwnedByHostError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_PollForDecisionTask_Helper.UnwrapResponse = func(result *MatchingService_PollForDecisionTask_Result) (success *PollForDecisionTaskResponse, err error) {
+	MatchingService_QueryWorkflow_Helper.UnwrapResponse = func(result *MatchingService_QueryWorkflow_Result) (success *shared.QueryWorkflowResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -13925,6 +15269,14 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.QueryFailedError != nil {
+			err = result.QueryFailedError
+			return
+		}
 		if result.LimitExceededError != nil {
 			err = result.LimitExceededError
 			return
@@ -13933,6 +15285,14 @@ func init() {
 			err = result.ServiceBusyError
 			return
 		}
+		if result.StickyWorkerUnavailableError != nil {
+			err = result.StickyWorkerUnavailableError
+			return
+		}
+		if result.TaskListNotOwnedByHostError != nil {
+			err = result.TaskListNotOwnedByHostError
+			return
+		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -13945,21 +15305,25 @@ func init() {
 
 }
 
-// MatchingService_PollForDecisionTask_Result represents the result of a MatchingService.PollForDecisionTask function call.
+// MatchingService_QueryWorkflow_Result represents the result of a MatchingService.QueryWorkflow function call.
 //
-// The result of a PollForDecisionTask execution is sent and received over the wire as this struct.
+// The result of a QueryWorkflow execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type MatchingService_PollForDecisionTask_Result struct {
-	// Value returned by PollForDecisionTask after a successful execution.
-	Success              *PollForDecisionTaskResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	LimitExceededError   *shared.LimitExceededError   `json:"limitExceededError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+type MatchingService_QueryWorkflow_Result struct {
+	// Value returned by QueryWorkflow after a successful execution.
+	Success                      *shared.QueryWorkflowResponse        `json:"success,omitempty"`
+	BadRequestError              *shared.BadRequestError              `json:"badRequestError,omitempty"`
+	InternalServiceError         *shared.InternalServiceError         `json:"internalServiceError,omitempty"`
+	EntityNotExistError          *shared.EntityNotExistsError         `json:"entityNotExistError,omitempty"`
+	QueryFailedError             *shared.QueryFailedError             `json:"queryFailedError,omitempty"`
+	LimitExceededError           *shared.LimitExceededError           `json:"limitExceededError,omitempty"`
+	ServiceBusyError             *shared.ServiceBusyError             `json:"serviceBusyError,omitempty"`
+	StickyWorkerUnavailableError *shared.StickyWorkerUnavailableError `json:"stickyWorkerUnavailableError,omitempty"`
+	TaskListNotOwnedByHostError  *shared.TaskListNotOwnedByHostError  `json:"taskListNotOwnedByHostError,omitempty"`
 }
 
-// ToWire translates a MatchingService_PollForDecisionTask_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_QueryWorkflow_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -13974,9 +15338,9 @@ type MatchingService_PollForDecisionTask_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *MatchingService_PollForDecisionTask_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_QueryWorkflow_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [9]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -14006,12 +15370,28 @@ func (v *MatchingService_PollForDecisionTask_Result) ToWire() (wire.Value, error
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.QueryFailedError != nil {
+		w, err = v.QueryFailedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
 	if v.LimitExceededError != nil {
 		w, err = v.LimitExceededError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
 	if v.ServiceBusyError != nil {
@@ -14019,28 +15399,50 @@ func (v *MatchingService_PollForDecisionTask_Result) ToWire() (wire.Value, error
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 4, Value: w}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.StickyWorkerUnavailableError != nil {
+		w, err = v.StickyWorkerUnavailableError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.TaskListNotOwnedByHostError != nil {
+		w, err = v.TaskListNotOwnedByHostError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
 		i++
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_PollForDecisionTask_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_QueryWorkflow_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForDecisionTaskResponse_Read(w wire.Value) (*PollForDecisionTaskResponse, error) {
-	var v PollForDecisionTaskResponse
+func _QueryWorkflowResponse_Read(w wire.Value) (*shared.QueryWorkflowResponse, error) {
+	var v shared.QueryWorkflowResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_PollForDecisionTask_Result struct from its Thrift-level
+func _QueryFailedError_Read(w wire.Value) (*shared.QueryFailedError, error) {
+	var v shared.QueryFailedError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_QueryWorkflow_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_PollForDecisionTask_Result struct
+// An error is returned if we were unable to build a MatchingService_QueryWorkflow_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -14048,19 +15450,19 @@ func _PollForDecisionTaskResponse_Read(w wire.Value) (*PollForDecisionTaskRespon
 //	  return nil, err
 //	}
 //
-//	var v MatchingService_PollForDecisionTask_Result
+//	var v MatchingService_QueryWorkflow_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *MatchingService_PollForDecisionTask_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_QueryWorkflow_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _PollForDecisionTaskResponse_Read(field.Value)
+				v.Success, err = _QueryWorkflowResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -14084,19 +15486,51 @@ func (v *MatchingService_PollForDecisionTask_Result) FromWire(w wire.Value) erro
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.QueryFailedError, err = _QueryFailedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
+			}
+		case 7:
+			if field.Value.Type() == wire.TStruct {
+				v.StickyWorkerUnavailableError, err = _StickyWorkerUnavailableError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskListNotOwnedByHostError, err = _TaskListNotOwnedByHostError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -14111,24 +15545,36 @@ func (v *MatchingService_PollForDecisionTask_Result) FromWire(w wire.Value) erro
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.QueryFailedError != nil {
+		count++
+	}
 	if v.LimitExceededError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
+	if v.StickyWorkerUnavailableError != nil {
+		count++
+	}
+	if v.TaskListNotOwnedByHostError != nil {
+		count++
+	}
 	if count != 1 {
-		return fmt.Errorf("MatchingService_PollForDecisionTask_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_QueryWorkflow_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a MatchingService_PollForDecisionTask_Result struct directly into bytes, without going
+// Encode serializes a MatchingService_QueryWorkflow_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_PollForDecisionTask_Result struct could not be encoded.
-func (v *MatchingService_PollForDecisionTask_Result) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_QueryWorkflow_Result struct could not be encoded.
+func (v *MatchingService_QueryWorkflow_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -14169,10 +15615,34 @@ func (v *MatchingService_PollForDecisionTask_Result) Encode(sw stream.Writer) er
 		}
 	}
 
-	if v.LimitExceededError != nil {
+	if v.EntityNotExistError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.QueryFailedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.QueryFailedError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LimitExceededError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
 		if err := v.LimitExceededError.Encode(sw); err != nil {
 			return err
 		}
@@ -14182,7 +15652,7 @@ func (v *MatchingService_PollForDecisionTask_Result) Encode(sw stream.Writer) er
 	}
 
 	if v.ServiceBusyError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.ServiceBusyError.Encode(sw); err != nil {
@@ -14193,6 +15663,30 @@ func (v *MatchingService_PollForDecisionTask_Result) Encode(sw stream.Writer) er
 		}
 	}
 
+	if v.StickyWorkerUnavailableError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.StickyWorkerUnavailableError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TaskListNotOwnedByHostError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.TaskListNotOwnedByHostError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	count := 0
 	if v.Success != nil {
 		count++
@@ -14203,32 +15697,50 @@ func (v *MatchingService_PollForDecisionTask_Result) Encode(sw stream.Writer) er
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.QueryFailedError != nil {
+		count++
+	}
 	if v.LimitExceededError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
+	if v.StickyWorkerUnavailableError != nil {
+		count++
+	}
+	if v.TaskListNotOwnedByHostError != nil {
+		count++
+	}
 
 	if count != 1 {
-		return fmt.Errorf("MatchingService_PollForDecisionTask_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_QueryWorkflow_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _PollForDecisionTaskResponse_Decode(sr stream.Reader) (*PollForDecisionTaskResponse, error) {
-	var v PollForDecisionTaskResponse
+func _QueryWorkflowResponse_Decode(sr stream.Reader) (*shared.QueryWorkflowResponse, error) {
+	var v shared.QueryWorkflowResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_PollForDecisionTask_Result struct directly from its Thrift-level
+func _QueryFailedError_Decode(sr stream.Reader) (*shared.QueryFailedError, error) {
+	var v shared.QueryFailedError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a MatchingService_QueryWorkflow_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_PollForDecisionTask_Result struct could not be generated from the wire
+// An error is returned if a MatchingService_QueryWorkflow_Result struct could not be generated from the wire
 // representation.
-func (v *MatchingService_PollForDecisionTask_Result) Decode(sr stream.Reader) error {
+func (v *MatchingService_QueryWorkflow_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -14242,7 +15754,7 @@ func (v *MatchingService_PollForDecisionTask_Result) Decode(sr stream.Reader) er
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _PollForDecisionTaskResponse_Decode(sr)
+			v.Success, err = _QueryWorkflowResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -14260,17 +15772,41 @@ func (v *MatchingService_PollForDecisionTask_Result) Decode(sr stream.Reader) er
 			}
 
 		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.QueryFailedError, err = _QueryFailedError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TStruct:
 			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
+		case fh.ID == 7 && fh.Type == wire.TStruct:
+			v.StickyWorkerUnavailableError, err = _StickyWorkerUnavailableError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TStruct:
+			v.TaskListNotOwnedByHostError, err = _TaskListNotOwnedByHostError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -14300,27 +15836,39 @@ func (v *MatchingService_PollForDecisionTask_Result) Decode(sr stream.Reader) er
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.QueryFailedError != nil {
+		count++
+	}
 	if v.LimitExceededError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
+	if v.StickyWorkerUnavailableError != nil {
+		count++
+	}
+	if v.TaskListNotOwnedByHostError != nil {
+		count++
+	}
 	if count != 1 {
-		return fmt.Errorf("MatchingService_PollForDecisionTask_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_QueryWorkflow_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_PollForDecisionTask_Result
+// String returns a readable string representation of a MatchingService_QueryWorkflow_Result
 // struct.
-func (v *MatchingService_PollForDecisionTask_Result) String() string {
+func (v *MatchingService_QueryWorkflow_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [9]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -14334,23 +15882,39 @@ func (v *MatchingService_PollForDecisionTask_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.QueryFailedError != nil {
+		fields[i] = fmt.Sprintf("QueryFailedError: %v", v.QueryFailedError)
+		i++
+	}
 	if v.LimitExceededError != nil {
 		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.StickyWorkerUnavailableError != nil {
+		fields[i] = fmt.Sprintf("StickyWorkerUnavailableError: %v", v.StickyWorkerUnavailableError)
+		i++
+	}
+	if v.TaskListNotOwnedByHostError != nil {
+		fields[i] = fmt.Sprintf("TaskListNotOwnedByHostError: %v", v.TaskListNotOwnedByHostError)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_PollForDecisionTask_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_QueryWorkflow_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_PollForDecisionTask_Result match the
-// provided MatchingService_PollForDecisionTask_Result.
+// Equals returns true if all the fields of this MatchingService_QueryWorkflow_Result match the
+// provided MatchingService_QueryWorkflow_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_PollForDecisionTask_Result) Equals(rhs *MatchingService_PollForDecisionTask_Result) bool {
+func (v *MatchingService_QueryWorkflow_Result) Equals(rhs *MatchingService_QueryWorkflow_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -14365,19 +15929,31 @@ func (v *MatchingService_PollForDecisionTask_Result) Equals(rhs *MatchingService
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.QueryFailedError == nil && rhs.QueryFailedError == nil) || (v.QueryFailedError != nil && rhs.QueryFailedError != nil && v.QueryFailedError.Equals(rhs.QueryFailedError))) {
+		return false
+	}
 	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
 		return false
 	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
+	if !((v.StickyWorkerUnavailableError == nil && rhs.StickyWorkerUnavailableError == nil) || (v.StickyWorkerUnavailableError != nil && rhs.StickyWorkerUnavailableError != nil && v.StickyWorkerUnavailableError.Equals(rhs.StickyWorkerUnavailableError))) {
+		return false
+	}
+	if !((v.TaskListNotOwnedByHostError == nil && rhs.TaskListNotOwnedByHostError == nil) || (v.TaskListNotOwnedByHostError != nil && rhs.TaskListNotOwnedByHostError != nil && v.TaskListNotOwnedByHostError.Equals(rhs.TaskListNotOwnedByHostError))) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_PollForDecisionTask_Result.
-func (v *MatchingService_PollForDecisionTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_QueryWorkflow_Result.
+func (v *MatchingService_QueryWorkflow_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -14390,18 +15966,30 @@ func (v *MatchingService_PollForDecisionTask_Result) MarshalLogObject(enc zapcor
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.QueryFailedError != nil {
+		err = multierr.Append(err, enc.AddObject("queryFailedError", v.QueryFailedError))
+	}
 	if v.LimitExceededError != nil {
 		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
 	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
+	if v.StickyWorkerUnavailableError != nil {
+		err = multierr.Append(err, enc.AddObject("stickyWorkerUnavailableError", v.StickyWorkerUnavailableError))
+	}
+	if v.TaskListNotOwnedByHostError != nil {
+		err = multierr.Append(err, enc.AddObject("taskListNotOwnedByHostError", v.TaskListNotOwnedByHostError))
+	}
 	return err
 }
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForDecisionTask_Result) GetSuccess() (o *PollForDecisionTaskResponse) {
+func (v *MatchingService_QueryWorkflow_Result) GetSuccess() (o *shared.QueryWorkflowResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -14410,13 +15998,13 @@ func (v *MatchingService_PollForDecisionTask_Result) GetSuccess() (o *PollForDec
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *MatchingService_PollForDecisionTask_Result) IsSetSuccess() bool {
+func (v *MatchingService_QueryWorkflow_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForDecisionTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_QueryWorkflow_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -14425,13 +16013,13 @@ func (v *MatchingService_PollForDecisionTask_Result) GetBadRequestError() (o *sh
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_PollForDecisionTask_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_QueryWorkflow_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForDecisionTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_QueryWorkflow_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -14440,13 +16028,43 @@ func (v *MatchingService_PollForDecisionTask_Result) GetInternalServiceError() (
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_PollForDecisionTask_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_QueryWorkflow_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_QueryWorkflow_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *MatchingService_QueryWorkflow_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetQueryFailedError returns the value of QueryFailedError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_QueryWorkflow_Result) GetQueryFailedError() (o *shared.QueryFailedError) {
+	if v != nil && v.QueryFailedError != nil {
+		return v.QueryFailedError
+	}
+
+	return
+}
+
+// IsSetQueryFailedError returns true if QueryFailedError is not nil.
+func (v *MatchingService_QueryWorkflow_Result) IsSetQueryFailedError() bool {
+	return v != nil && v.QueryFailedError != nil
+}
+
 // GetLimitExceededError returns the value of LimitExceededError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForDecisionTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+func (v *MatchingService_QueryWorkflow_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
 	if v != nil && v.LimitExceededError != nil {
 		return v.LimitExceededError
 	}
@@ -14455,13 +16073,13 @@ func (v *MatchingService_PollForDecisionTask_Result) GetLimitExceededError() (o
 }
 
 // IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_PollForDecisionTask_Result) IsSetLimitExceededError() bool {
+func (v *MatchingService_QueryWorkflow_Result) IsSetLimitExceededError() bool {
 	return v != nil && v.LimitExceededError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForDecisionTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_QueryWorkflow_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -14470,33 +16088,63 @@ func (v *MatchingService_PollForDecisionTask_Result) GetServiceBusyError() (o *s
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_PollForDecisionTask_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_QueryWorkflow_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
+// GetStickyWorkerUnavailableError returns the value of StickyWorkerUnavailableError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_QueryWorkflow_Result) GetStickyWorkerUnavailableError() (o *shared.StickyWorkerUnavailableError) {
+	if v != nil && v.StickyWorkerUnavailableError != nil {
+		return v.StickyWorkerUnavailableError
+	}
+
+	return
+}
+
+// IsSetStickyWorkerUnavailableError returns true if StickyWorkerUnavailableError is not nil.
+func (v *MatchingService_QueryWorkflow_Result) IsSetStickyWorkerUnavailableError() bool {
+	return v != nil && v.StickyWorkerUnavailableError != nil
+}
+
+// GetTaskListNotOwnedByHostError returns the value of TaskListNotOwnedByHostError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_QueryWorkflow_Result) GetTaskListNotOwnedByHostError() (o *shared.TaskListNotOwnedByHostError) {
+	if v != nil && v.TaskListNotOwnedByHostError != nil {
+		return v.TaskListNotOwnedByHostError
+	}
+
+	return
+}
+
+// IsSetTaskListNotOwnedByHostError returns true if TaskListNotOwnedByHostError is not nil.
+func (v *MatchingService_QueryWorkflow_Result) IsSetTaskListNotOwnedByHostError() bool {
+	return v != nil && v.TaskListNotOwnedByHostError != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "PollForDecisionTask" for this struct.
-func (v *MatchingService_PollForDecisionTask_Result) MethodName() string {
-	return "PollForDecisionTask"
+// This will always be "QueryWorkflow" for this struct.
+func (v *MatchingService_QueryWorkflow_Result) MethodName() string {
+	return "QueryWorkflow"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_PollForDecisionTask_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_QueryWorkflow_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_QueryWorkflow_Args represents the arguments for the MatchingService.QueryWorkflow function.
+// MatchingService_RecordBackpressureHint_Args represents the arguments for the MatchingService.RecordBackpressureHint function.
 //
-// The arguments for QueryWorkflow are sent and received over the wire as this struct.
-type MatchingService_QueryWorkflow_Args struct {
-	QueryRequest *QueryWorkflowRequest `json:"queryRequest,omitempty"`
+// The arguments for RecordBackpressureHint are sent and received over the wire as this struct.
+type MatchingService_RecordBackpressureHint_Args struct {
+	Request *RecordBackpressureHintRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_QueryWorkflow_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_RecordBackpressureHint_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -14511,7 +16159,7 @@ type MatchingService_QueryWorkflow_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *MatchingService_QueryWorkflow_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_RecordBackpressureHint_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -14519,8 +16167,8 @@ func (v *MatchingService_QueryWorkflow_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.QueryRequest != nil {
-		w, err = v.QueryRequest.ToWire()
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -14531,17 +16179,17 @@ func (v *MatchingService_QueryWorkflow_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _QueryWorkflowRequest_1_Read(w wire.Value) (*QueryWorkflowRequest, error) {
-	var v QueryWorkflowRequest
+func _RecordBackpressureHintRequest_Read(w wire.Value) (*RecordBackpressureHintRequest, error) {
+	var v RecordBackpressureHintRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_QueryWorkflow_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_RecordBackpressureHint_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_QueryWorkflow_Args struct
+// An error is returned if we were unable to build a MatchingService_RecordBackpressureHint_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -14549,19 +16197,19 @@ func _QueryWorkflowRequest_1_Read(w wire.Value) (*QueryWorkflowRequest, error) {
 //	  return nil, err
 //	}
 //
-//	var v MatchingService_QueryWorkflow_Args
+//	var v MatchingService_RecordBackpressureHint_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *MatchingService_QueryWorkflow_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_RecordBackpressureHint_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.QueryRequest, err = _QueryWorkflowRequest_1_Read(field.Value)
+				v.Request, err = _RecordBackpressureHintRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -14573,20 +16221,20 @@ func (v *MatchingService_QueryWorkflow_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a MatchingService_QueryWorkflow_Args struct directly into bytes, without going
+// Encode serializes a MatchingService_RecordBackpressureHint_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_QueryWorkflow_Args struct could not be encoded.
-func (v *MatchingService_QueryWorkflow_Args) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_RecordBackpressureHint_Args struct could not be encoded.
+func (v *MatchingService_RecordBackpressureHint_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.QueryRequest != nil {
+	if v.Request != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.QueryRequest.Encode(sw); err != nil {
+		if err := v.Request.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -14597,18 +16245,18 @@ func (v *MatchingService_QueryWorkflow_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _QueryWorkflowRequest_1_Decode(sr stream.Reader) (*QueryWorkflowRequest, error) {
-	var v QueryWorkflowRequest
+func _RecordBackpressureHintRequest_Decode(sr stream.Reader) (*RecordBackpressureHintRequest, error) {
+	var v RecordBackpressureHintRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_QueryWorkflow_Args struct directly from its Thrift-level
+// Decode deserializes a MatchingService_RecordBackpressureHint_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_QueryWorkflow_Args struct could not be generated from the wire
+// An error is returned if a MatchingService_RecordBackpressureHint_Args struct could not be generated from the wire
 // representation.
-func (v *MatchingService_QueryWorkflow_Args) Decode(sr stream.Reader) error {
+func (v *MatchingService_RecordBackpressureHint_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -14622,7 +16270,7 @@ func (v *MatchingService_QueryWorkflow_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.QueryRequest, err = _QueryWorkflowRequest_1_Decode(sr)
+			v.Request, err = _RecordBackpressureHintRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -14649,34 +16297,34 @@ func (v *MatchingService_QueryWorkflow_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_QueryWorkflow_Args
+// String returns a readable string representation of a MatchingService_RecordBackpressureHint_Args
 // struct.
-func (v *MatchingService_QueryWorkflow_Args) String() string {
+func (v *MatchingService_RecordBackpressureHint_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.QueryRequest != nil {
-		fields[i] = fmt.Sprintf("QueryRequest: %v", v.QueryRequest)
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_QueryWorkflow_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_RecordBackpressureHint_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_QueryWorkflow_Args match the
-// provided MatchingService_QueryWorkflow_Args.
+// Equals returns true if all the fields of this MatchingService_RecordBackpressureHint_Args match the
+// provided MatchingService_RecordBackpressureHint_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_QueryWorkflow_Args) Equals(rhs *MatchingService_QueryWorkflow_Args) bool {
+func (v *MatchingService_RecordBackpressureHint_Args) Equals(rhs *MatchingService_RecordBackpressureHint_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.QueryRequest == nil && rhs.QueryRequest == nil) || (v.QueryRequest != nil && rhs.QueryRequest != nil && v.QueryRequest.Equals(rhs.QueryRequest))) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -14684,117 +16332,110 @@ func (v *MatchingService_QueryWorkflow_Args) Equals(rhs *MatchingService_QueryWo
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_QueryWorkflow_Args.
-func (v *MatchingService_QueryWorkflow_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_RecordBackpressureHint_Args.
+func (v *MatchingService_RecordBackpressureHint_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.QueryRequest != nil {
-		err = multierr.Append(err, enc.AddObject("queryRequest", v.QueryRequest))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetQueryRequest returns the value of QueryRequest if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Args) GetQueryRequest() (o *QueryWorkflowRequest) {
-	if v != nil && v.QueryRequest != nil {
-		return v.QueryRequest
+func (v *MatchingService_RecordBackpressureHint_Args) GetRequest() (o *RecordBackpressureHintRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetQueryRequest returns true if QueryRequest is not nil.
-func (v *MatchingService_QueryWorkflow_Args) IsSetQueryRequest() bool {
-	return v != nil && v.QueryRequest != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *MatchingService_RecordBackpressureHint_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "QueryWorkflow" for this struct.
-func (v *MatchingService_QueryWorkflow_Args) MethodName() string {
-	return "QueryWorkflow"
+// This will always be "RecordBackpressureHint" for this struct.
+func (v *MatchingService_RecordBackpressureHint_Args) MethodName() string {
+	return "RecordBackpressureHint"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_QueryWorkflow_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_RecordBackpressureHint_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_QueryWorkflow_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.QueryWorkflow
+// MatchingService_RecordBackpressureHint_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.RecordBackpressureHint
 // function.
-var MatchingService_QueryWorkflow_Helper = struct {
-	// Args accepts the parameters of QueryWorkflow in-order and returns
+var MatchingService_RecordBackpressureHint_Helper = struct {
+	// Args accepts the parameters of RecordBackpressureHint in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		queryRequest *QueryWorkflowRequest,
-	) *MatchingService_QueryWorkflow_Args
+		request *RecordBackpressureHintRequest,
+	) *MatchingService_RecordBackpressureHint_Args
 
 	// IsException returns true if the given error can be thrown
-	// by QueryWorkflow.
+	// by RecordBackpressureHint.
 	//
-	// An error can be thrown by QueryWorkflow only if the
+	// An error can be thrown by RecordBackpressureHint only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for QueryWorkflow
-	// given its return value and error.
+	// WrapResponse returns the result struct for RecordBackpressureHint
+	// given the error returned by it. The provided error may
+	// be nil if RecordBackpressureHint did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// QueryWorkflow into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by QueryWorkflow
+	// This allows mapping errors returned by RecordBackpressureHint into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// RecordBackpressureHint
 	//
-	//   value, err := QueryWorkflow(args)
-	//   result, err := MatchingService_QueryWorkflow_Helper.WrapResponse(value, err)
+	//   err := RecordBackpressureHint(args)
+	//   result, err := MatchingService_RecordBackpressureHint_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from QueryWorkflow: %v", err)
+	//     return fmt.Errorf("unexpected error from RecordBackpressureHint: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.QueryWorkflowResponse, error) (*MatchingService_QueryWorkflow_Result, error)
+	WrapResponse func(error) (*MatchingService_RecordBackpressureHint_Result, error)
 
-	// UnwrapResponse takes the result struct for QueryWorkflow
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for RecordBackpressureHint
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if QueryWorkflow threw an
+	// The error is non-nil only if RecordBackpressureHint threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := MatchingService_QueryWorkflow_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_QueryWorkflow_Result) (*shared.QueryWorkflowResponse, error)
+	//   err := MatchingService_RecordBackpressureHint_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_RecordBackpressureHint_Result) error
 }{}
 
 func init() {
-	MatchingService_QueryWorkflow_Helper.Args = func(
-		queryRequest *QueryWorkflowRequest,
-	) *MatchingService_QueryWorkflow_Args {
-		return &MatchingService_QueryWorkflow_Args{
-			QueryRequest: queryRequest,
+	MatchingService_RecordBackpressureHint_Helper.Args = func(
+		request *RecordBackpressureHintRequest,
+	) *MatchingService_RecordBackpressureHint_Args {
+		return &MatchingService_RecordBackpressureHint_Args{
+			Request: request,
 		}
 	}
 
-	MatchingService_QueryWorkflow_Helper.IsException = func(err error) bool {
+	MatchingService_RecordBackpressureHint_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.EntityNotExistsError:
-			return true
-		case *shared.QueryFailedError:
-			return true
-		case *shared.LimitExceededError:
-			return true
 		case *shared.ServiceBusyError:
 			return true
-		case *shared.StickyWorkerUnavailableError:
-			return true
 		case *shared.TaskListNotOwnedByHostError:
 			return true
 		default:
@@ -14802,57 +16443,37 @@ func init() {
 		}
 	}
 
-	MatchingService_QueryWorkflow_Helper.WrapResponse = func(success *shared.QueryWorkflowResponse, err error) (*MatchingService_QueryWorkflow_Result, error) {
+	MatchingService_RecordBackpressureHint_Helper.WrapResponse = func(err error) (*MatchingService_RecordBackpressureHint_Result, error) {
 		if err == nil {
-			return &MatchingService_QueryWorkflow_Result{Success: success}, nil
+			return &MatchingService_RecordBackpressureHint_Result{}, nil
 		}
 
-		switch e := err.(type) {
-		case *shared.BadRequestError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.BadRequestError")
-			}
-			return &MatchingService_QueryWorkflow_Result{BadRequestError: e}, nil
-		case *shared.InternalServiceError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.InternalServiceError")
-			}
-			return &MatchingService_QueryWorkflow_Result{InternalServiceError: e}, nil
-		case *shared.EntityNotExistsError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.EntityNotExistError")
-			}
-			return &MatchingService_QueryWorkflow_Result{EntityNotExistError: e}, nil
-		case *shared.QueryFailedError:
+		switch e := err.(type) {
+		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.QueryFailedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RecordBackpressureHint_Result.BadRequestError")
 			}
-			return &MatchingService_QueryWorkflow_Result{QueryFailedError: e}, nil
-		case *shared.LimitExceededError:
+			return &MatchingService_RecordBackpressureHint_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RecordBackpressureHint_Result.InternalServiceError")
 			}
-			return &MatchingService_QueryWorkflow_Result{LimitExceededError: e}, nil
+			return &MatchingService_RecordBackpressureHint_Result{InternalServiceError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.ServiceBusyError")
-			}
-			return &MatchingService_QueryWorkflow_Result{ServiceBusyError: e}, nil
-		case *shared.StickyWorkerUnavailableError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.StickyWorkerUnavailableError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RecordBackpressureHint_Result.ServiceBusyError")
 			}
-			return &MatchingService_QueryWorkflow_Result{StickyWorkerUnavailableError: e}, nil
+			return &MatchingService_RecordBackpressureHint_Result{ServiceBusyError: e}, nil
 		case *shared.TaskListNotOwnedByHostError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.TaskListNotOwnedByHostError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RecordBackpressureHint_Result.TaskListNotOwnedByHostError")
 			}
-			return &MatchingService_QueryWorkflow_Result{TaskListNotOwnedByHostError: e}, nil
+			return &MatchingService_RecordBackpressureHint_Result{TaskListNotOwnedByHostError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_QueryWorkflow_Helper.UnwrapResponse = func(result *MatchingService_QueryWorkflow_Result) (success *shared.QueryWorkflowResponse, err error) {
+	MatchingService_RecordBackpressureHint_Helper.UnwrapResponse = func(result *MatchingService_RecordBackpressureHint_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -14861,61 +16482,30 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
-			return
-		}
-		if result.QueryFailedError != nil {
-			err = result.QueryFailedError
-			return
-		}
-		if result.LimitExceededError != nil {
-			err = result.LimitExceededError
-			return
-		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
 		}
-		if result.StickyWorkerUnavailableError != nil {
-			err = result.StickyWorkerUnavailableError
-			return
-		}
 		if result.TaskListNotOwnedByHostError != nil {
 			err = result.TaskListNotOwnedByHostError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
-			return
-		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// MatchingService_QueryWorkflow_Result represents the result of a MatchingService.QueryWorkflow function call.
-//
-// The result of a QueryWorkflow execution is sent and received over the wire as this struct.
+// MatchingService_RecordBackpressureHint_Result represents the result of a MatchingService.RecordBackpressureHint function call.
 //
-// Success is set only if the function did not throw an exception.
-type MatchingService_QueryWorkflow_Result struct {
-	// Value returned by QueryWorkflow after a successful execution.
-	Success                      *shared.QueryWorkflowResponse        `json:"success,omitempty"`
-	BadRequestError              *shared.BadRequestError              `json:"badRequestError,omitempty"`
-	InternalServiceError         *shared.InternalServiceError         `json:"internalServiceError,omitempty"`
-	EntityNotExistError          *shared.EntityNotExistsError         `json:"entityNotExistError,omitempty"`
-	QueryFailedError             *shared.QueryFailedError             `json:"queryFailedError,omitempty"`
-	LimitExceededError           *shared.LimitExceededError           `json:"limitExceededError,omitempty"`
-	ServiceBusyError             *shared.ServiceBusyError             `json:"serviceBusyError,omitempty"`
-	StickyWorkerUnavailableError *shared.StickyWorkerUnavailableError `json:"stickyWorkerUnavailableError,omitempty"`
-	TaskListNotOwnedByHostError  *shared.TaskListNotOwnedByHostError  `json:"taskListNotOwnedByHostError,omitempty"`
+// The result of a RecordBackpressureHint execution is sent and received over the wire as this struct.
+type MatchingService_RecordBackpressureHint_Result struct {
+	BadRequestError             *shared.BadRequestError             `json:"badRequestError,omitempty"`
+	InternalServiceError        *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
+	ServiceBusyError            *shared.ServiceBusyError            `json:"serviceBusyError,omitempty"`
+	TaskListNotOwnedByHostError *shared.TaskListNotOwnedByHostError `json:"taskListNotOwnedByHostError,omitempty"`
 }
 
-// ToWire translates a MatchingService_QueryWorkflow_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_RecordBackpressureHint_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -14930,22 +16520,14 @@ type MatchingService_QueryWorkflow_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *MatchingService_QueryWorkflow_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_RecordBackpressureHint_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [9]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -14962,44 +16544,12 @@ func (v *MatchingService_QueryWorkflow_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
-	}
-	if v.QueryFailedError != nil {
-		w, err = v.QueryFailedError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
-	if v.LimitExceededError != nil {
-		w, err = v.LimitExceededError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
 	if v.ServiceBusyError != nil {
 		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 6, Value: w}
-		i++
-	}
-	if v.StickyWorkerUnavailableError != nil {
-		w, err = v.StickyWorkerUnavailableError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 7, Value: w}
+		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
 	if v.TaskListNotOwnedByHostError != nil {
@@ -15007,34 +16557,22 @@ func (v *MatchingService_QueryWorkflow_Result) ToWire() (wire.Value, error) {
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 8, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_QueryWorkflow_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_RecordBackpressureHint_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _QueryWorkflowResponse_Read(w wire.Value) (*shared.QueryWorkflowResponse, error) {
-	var v shared.QueryWorkflowResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _QueryFailedError_Read(w wire.Value) (*shared.QueryFailedError, error) {
-	var v shared.QueryFailedError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a MatchingService_QueryWorkflow_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_RecordBackpressureHint_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_QueryWorkflow_Result struct
+// An error is returned if we were unable to build a MatchingService_RecordBackpressureHint_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -15042,24 +16580,16 @@ func _QueryFailedError_Read(w wire.Value) (*shared.QueryFailedError, error) {
 //	  return nil, err
 //	}
 //
-//	var v MatchingService_QueryWorkflow_Result
+//	var v MatchingService_RecordBackpressureHint_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *MatchingService_QueryWorkflow_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_RecordBackpressureHint_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
-			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _QueryWorkflowResponse_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -15077,30 +16607,6 @@ func (v *MatchingService_QueryWorkflow_Result) FromWire(w wire.Value) error {
 
 			}
 		case 3:
-			if field.Value.Type() == wire.TStruct {
-				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 4:
-			if field.Value.Type() == wire.TStruct {
-				v.QueryFailedError, err = _QueryFailedError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 5:
-			if field.Value.Type() == wire.TStruct {
-				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 6:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
@@ -15108,15 +16614,7 @@ func (v *MatchingService_QueryWorkflow_Result) FromWire(w wire.Value) error {
 				}
 
 			}
-		case 7:
-			if field.Value.Type() == wire.TStruct {
-				v.StickyWorkerUnavailableError, err = _StickyWorkerUnavailableError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 8:
+		case 4:
 			if field.Value.Type() == wire.TStruct {
 				v.TaskListNotOwnedByHostError, err = _TaskListNotOwnedByHostError_Read(field.Value)
 				if err != nil {
@@ -15128,114 +16626,39 @@ func (v *MatchingService_QueryWorkflow_Result) FromWire(w wire.Value) error {
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
-		count++
-	}
-	if v.QueryFailedError != nil {
-		count++
-	}
-	if v.LimitExceededError != nil {
-		count++
-	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.StickyWorkerUnavailableError != nil {
-		count++
-	}
 	if v.TaskListNotOwnedByHostError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("MatchingService_QueryWorkflow_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("MatchingService_RecordBackpressureHint_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
-}
-
-// Encode serializes a MatchingService_QueryWorkflow_Result struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a MatchingService_QueryWorkflow_Result struct could not be encoded.
-func (v *MatchingService_QueryWorkflow_Result) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
-		return err
-	}
-
-	if v.Success != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.Success.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.BadRequestError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.BadRequestError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.InternalServiceError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.InternalServiceError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.EntityNotExistError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.EntityNotExistError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.QueryFailedError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.QueryFailedError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+}
+
+// Encode serializes a MatchingService_RecordBackpressureHint_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a MatchingService_RecordBackpressureHint_Result struct could not be encoded.
+func (v *MatchingService_RecordBackpressureHint_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
 	}
 
-	if v.LimitExceededError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.LimitExceededError.Encode(sw); err != nil {
+		if err := v.BadRequestError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -15243,11 +16666,11 @@ func (v *MatchingService_QueryWorkflow_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ServiceBusyError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
+	if v.InternalServiceError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.ServiceBusyError.Encode(sw); err != nil {
+		if err := v.InternalServiceError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -15255,11 +16678,11 @@ func (v *MatchingService_QueryWorkflow_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.StickyWorkerUnavailableError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TStruct}); err != nil {
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.StickyWorkerUnavailableError.Encode(sw); err != nil {
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -15268,7 +16691,7 @@ func (v *MatchingService_QueryWorkflow_Result) Encode(sw stream.Writer) error {
 	}
 
 	if v.TaskListNotOwnedByHostError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.TaskListNotOwnedByHostError.Encode(sw); err != nil {
@@ -15280,59 +16703,32 @@ func (v *MatchingService_QueryWorkflow_Result) Encode(sw stream.Writer) error {
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
-		count++
-	}
-	if v.QueryFailedError != nil {
-		count++
-	}
-	if v.LimitExceededError != nil {
-		count++
-	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.StickyWorkerUnavailableError != nil {
-		count++
-	}
 	if v.TaskListNotOwnedByHostError != nil {
 		count++
 	}
 
-	if count != 1 {
-		return fmt.Errorf("MatchingService_QueryWorkflow_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("MatchingService_RecordBackpressureHint_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _QueryWorkflowResponse_Decode(sr stream.Reader) (*shared.QueryWorkflowResponse, error) {
-	var v shared.QueryWorkflowResponse
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _QueryFailedError_Decode(sr stream.Reader) (*shared.QueryFailedError, error) {
-	var v shared.QueryFailedError
-	err := v.Decode(sr)
-	return &v, err
-}
-
-// Decode deserializes a MatchingService_QueryWorkflow_Result struct directly from its Thrift-level
+// Decode deserializes a MatchingService_RecordBackpressureHint_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_QueryWorkflow_Result struct could not be generated from the wire
+// An error is returned if a MatchingService_RecordBackpressureHint_Result struct could not be generated from the wire
 // representation.
-func (v *MatchingService_QueryWorkflow_Result) Decode(sr stream.Reader) error {
+func (v *MatchingService_RecordBackpressureHint_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -15345,12 +16741,6 @@ func (v *MatchingService_QueryWorkflow_Result) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _QueryWorkflowResponse_Decode(sr)
-			if err != nil {
-				return err
-			}
-
 		case fh.ID == 1 && fh.Type == wire.TStruct:
 			v.BadRequestError, err = _BadRequestError_Decode(sr)
 			if err != nil {
@@ -15364,36 +16754,12 @@ func (v *MatchingService_QueryWorkflow_Result) Decode(sr stream.Reader) error {
 			}
 
 		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 4 && fh.Type == wire.TStruct:
-			v.QueryFailedError, err = _QueryFailedError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 5 && fh.Type == wire.TStruct:
-			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 6 && fh.Type == wire.TStruct:
 			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 7 && fh.Type == wire.TStruct:
-			v.StickyWorkerUnavailableError, err = _StickyWorkerUnavailableError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 8 && fh.Type == wire.TStruct:
+		case fh.ID == 4 && fh.Type == wire.TStruct:
 			v.TaskListNotOwnedByHostError, err = _TaskListNotOwnedByHostError_Decode(sr)
 			if err != nil {
 				return err
@@ -15419,53 +16785,34 @@ func (v *MatchingService_QueryWorkflow_Result) Decode(sr stream.Reader) error {
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
-		count++
-	}
-	if v.QueryFailedError != nil {
-		count++
-	}
-	if v.LimitExceededError != nil {
-		count++
-	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.StickyWorkerUnavailableError != nil {
-		count++
-	}
 	if v.TaskListNotOwnedByHostError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("MatchingService_QueryWorkflow_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("MatchingService_RecordBackpressureHint_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_QueryWorkflow_Result
+// String returns a readable string representation of a MatchingService_RecordBackpressureHint_Result
 // struct.
-func (v *MatchingService_QueryWorkflow_Result) String() string {
+func (v *MatchingService_RecordBackpressureHint_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [9]string
+	var fields [4]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -15474,68 +16821,37 @@ func (v *MatchingService_QueryWorkflow_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
-		i++
-	}
-	if v.QueryFailedError != nil {
-		fields[i] = fmt.Sprintf("QueryFailedError: %v", v.QueryFailedError)
-		i++
-	}
-	if v.LimitExceededError != nil {
-		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
-		i++
-	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
-	if v.StickyWorkerUnavailableError != nil {
-		fields[i] = fmt.Sprintf("StickyWorkerUnavailableError: %v", v.StickyWorkerUnavailableError)
-		i++
-	}
 	if v.TaskListNotOwnedByHostError != nil {
 		fields[i] = fmt.Sprintf("TaskListNotOwnedByHostError: %v", v.TaskListNotOwnedByHostError)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_QueryWorkflow_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_RecordBackpressureHint_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_QueryWorkflow_Result match the
-// provided MatchingService_QueryWorkflow_Result.
+// Equals returns true if all the fields of this MatchingService_RecordBackpressureHint_Result match the
+// provided MatchingService_RecordBackpressureHint_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_QueryWorkflow_Result) Equals(rhs *MatchingService_QueryWorkflow_Result) bool {
+func (v *MatchingService_RecordBackpressureHint_Result) Equals(rhs *MatchingService_RecordBackpressureHint_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
-		return false
-	}
-	if !((v.QueryFailedError == nil && rhs.QueryFailedError == nil) || (v.QueryFailedError != nil && rhs.QueryFailedError != nil && v.QueryFailedError.Equals(rhs.QueryFailedError))) {
-		return false
-	}
-	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
-		return false
-	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
-	if !((v.StickyWorkerUnavailableError == nil && rhs.StickyWorkerUnavailableError == nil) || (v.StickyWorkerUnavailableError != nil && rhs.StickyWorkerUnavailableError != nil && v.StickyWorkerUnavailableError.Equals(rhs.StickyWorkerUnavailableError))) {
-		return false
-	}
 	if !((v.TaskListNotOwnedByHostError == nil && rhs.TaskListNotOwnedByHostError == nil) || (v.TaskListNotOwnedByHostError != nil && rhs.TaskListNotOwnedByHostError != nil && v.TaskListNotOwnedByHostError.Equals(rhs.TaskListNotOwnedByHostError))) {
 		return false
 	}
@@ -15544,59 +16860,29 @@ func (v *MatchingService_QueryWorkflow_Result) Equals(rhs *MatchingService_Query
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_QueryWorkflow_Result.
-func (v *MatchingService_QueryWorkflow_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_RecordBackpressureHint_Result.
+func (v *MatchingService_RecordBackpressureHint_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.EntityNotExistError != nil {
-		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
-	}
-	if v.QueryFailedError != nil {
-		err = multierr.Append(err, enc.AddObject("queryFailedError", v.QueryFailedError))
-	}
-	if v.LimitExceededError != nil {
-		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
-	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
-	if v.StickyWorkerUnavailableError != nil {
-		err = multierr.Append(err, enc.AddObject("stickyWorkerUnavailableError", v.StickyWorkerUnavailableError))
-	}
 	if v.TaskListNotOwnedByHostError != nil {
 		err = multierr.Append(err, enc.AddObject("taskListNotOwnedByHostError", v.TaskListNotOwnedByHostError))
 	}
 	return err
 }
 
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetSuccess() (o *shared.QueryWorkflowResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
-	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
-}
-
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_RecordBackpressureHint_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -15605,13 +16891,13 @@ func (v *MatchingService_QueryWorkflow_Result) GetBadRequestError() (o *shared.B
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_RecordBackpressureHint_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_RecordBackpressureHint_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -15620,58 +16906,13 @@ func (v *MatchingService_QueryWorkflow_Result) GetInternalServiceError() (o *sha
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_RecordBackpressureHint_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
-	if v != nil && v.EntityNotExistError != nil {
-		return v.EntityNotExistError
-	}
-
-	return
-}
-
-// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetEntityNotExistError() bool {
-	return v != nil && v.EntityNotExistError != nil
-}
-
-// GetQueryFailedError returns the value of QueryFailedError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetQueryFailedError() (o *shared.QueryFailedError) {
-	if v != nil && v.QueryFailedError != nil {
-		return v.QueryFailedError
-	}
-
-	return
-}
-
-// IsSetQueryFailedError returns true if QueryFailedError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetQueryFailedError() bool {
-	return v != nil && v.QueryFailedError != nil
-}
-
-// GetLimitExceededError returns the value of LimitExceededError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
-	if v != nil && v.LimitExceededError != nil {
-		return v.LimitExceededError
-	}
-
-	return
-}
-
-// IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetLimitExceededError() bool {
-	return v != nil && v.LimitExceededError != nil
-}
-
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_RecordBackpressureHint_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -15680,28 +16921,13 @@ func (v *MatchingService_QueryWorkflow_Result) GetServiceBusyError() (o *shared.
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_RecordBackpressureHint_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
-// GetStickyWorkerUnavailableError returns the value of StickyWorkerUnavailableError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetStickyWorkerUnavailableError() (o *shared.StickyWorkerUnavailableError) {
-	if v != nil && v.StickyWorkerUnavailableError != nil {
-		return v.StickyWorkerUnavailableError
-	}
-
-	return
-}
-
-// IsSetStickyWorkerUnavailableError returns true if StickyWorkerUnavailableError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetStickyWorkerUnavailableError() bool {
-	return v != nil && v.StickyWorkerUnavailableError != nil
-}
-
 // GetTaskListNotOwnedByHostError returns the value of TaskListNotOwnedByHostError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetTaskListNotOwnedByHostError() (o *shared.TaskListNotOwnedByHostError) {
+func (v *MatchingService_RecordBackpressureHint_Result) GetTaskListNotOwnedByHostError() (o *shared.TaskListNotOwnedByHostError) {
 	if v != nil && v.TaskListNotOwnedByHostError != nil {
 		return v.TaskListNotOwnedByHostError
 	}
@@ -15710,22 +16936,22 @@ func (v *MatchingService_QueryWorkflow_Result) GetTaskListNotOwnedByHostError()
 }
 
 // IsSetTaskListNotOwnedByHostError returns true if TaskListNotOwnedByHostError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetTaskListNotOwnedByHostError() bool {
+func (v *MatchingService_RecordBackpressureHint_Result) IsSetTaskListNotOwnedByHostError() bool {
 	return v != nil && v.TaskListNotOwnedByHostError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "QueryWorkflow" for this struct.
-func (v *MatchingService_QueryWorkflow_Result) MethodName() string {
-	return "QueryWorkflow"
+// This will always be "RecordBackpressureHint" for this struct.
+func (v *MatchingService_RecordBackpressureHint_Result) MethodName() string {
+	return "RecordBackpressureHint"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_QueryWorkflow_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_RecordBackpressureHint_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
@@ -94,6 +94,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.QueryWorkflowResponse, error)
 
+	RecordBackpressureHint(
+		ctx context.Context,
+		Request *matching.RecordBackpressureHintRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RespondQueryTaskCompleted(
 		ctx context.Context,
 		Request *matching.RespondQueryTaskCompletedRequest,
@@ -382,6 +388,34 @@ func (c client) QueryWorkflow(
 	return
 }
 
+func (c client) RecordBackpressureHint(
+	ctx context.Context,
+	_Request *matching.RecordBackpressureHintRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result matching.MatchingService_RecordBackpressureHint_Result
+	args := matching.MatchingService_RecordBackpressureHint_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = matching.MatchingService_RecordBackpressureHint_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RespondQueryTaskCompleted(
 	ctx context.Context,
 	_Request *matching.RespondQueryTaskCompletedRequest,
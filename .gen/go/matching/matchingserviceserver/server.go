@@ -85,6 +85,11 @@ type Interface interface {
 		QueryRequest *matching.QueryWorkflowRequest,
 	) (*shared.QueryWorkflowResponse, error)
 
+	RecordBackpressureHint(
+		ctx context.Context,
+		Request *matching.RecordBackpressureHintRequest,
+	) error
+
 	RespondQueryTaskCompleted(
 		ctx context.Context,
 		Request *matching.RespondQueryTaskCompletedRequest,
@@ -210,6 +215,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: matching.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RecordBackpressureHint",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RecordBackpressureHint),
+					NoWire: recordbackpressurehint_NoWireHandler{impl},
+				},
+				Signature:    "RecordBackpressureHint(Request *matching.RecordBackpressureHintRequest)",
+				ThriftModule: matching.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RespondQueryTaskCompleted",
 				HandlerSpec: thrift.HandlerSpec{
@@ -224,7 +241,7 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 10)
+	procedures := make([]transport.Procedure, 0, 11)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -505,6 +522,36 @@ func (h handler) QueryWorkflow(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
+func (h handler) RecordBackpressureHint(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_RecordBackpressureHint_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'MatchingService' procedure 'RecordBackpressureHint': %w", err)
+	}
+
+	appErr := h.impl.RecordBackpressureHint(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_RecordBackpressureHint_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) RespondQueryTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args matching.MatchingService_RespondQueryTaskCompleted_Args
 	if err := args.FromWire(body); err != nil {
@@ -868,6 +915,43 @@ func (h queryworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thri
 
 }
 
+type recordbackpressurehint_NoWireHandler struct{ impl Interface }
+
+func (h recordbackpressurehint_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args matching.MatchingService_RecordBackpressureHint_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'MatchingService' procedure 'RecordBackpressureHint': %w", err)
+	}
+
+	appErr := h.impl.RecordBackpressureHint(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_RecordBackpressureHint_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type respondquerytaskcompleted_NoWireHandler struct{ impl Interface }
 
 func (h respondquerytaskcompleted_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -358,6 +358,37 @@ func (mr *_MockClientRecorder) QueryWorkflow(
 	return mr.mock.ctrl.RecordCall(mr.mock, "QueryWorkflow", args...)
 }
 
+// RecordBackpressureHint responds to a RecordBackpressureHint call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RecordBackpressureHint(gomock.Any(), ...).Return(...)
+//	... := client.RecordBackpressureHint(...)
+func (m *MockClient) RecordBackpressureHint(
+	ctx context.Context,
+	_Request *matching.RecordBackpressureHintRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RecordBackpressureHint", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RecordBackpressureHint(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RecordBackpressureHint", args...)
+}
+
 // RespondQueryTaskCompleted responds to a RespondQueryTaskCompleted call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -44,37 +44,80 @@ import (
 )
 
 type ActivityInfo struct {
-	Version                       *int64   `json:"version,omitempty"`
-	ScheduledEventBatchID         *int64   `json:"scheduledEventBatchID,omitempty"`
-	ScheduledEvent                []byte   `json:"scheduledEvent,omitempty"`
-	ScheduledEventEncoding        *string  `json:"scheduledEventEncoding,omitempty"`
-	ScheduledTimeNanos            *int64   `json:"scheduledTimeNanos,omitempty"`
-	StartedID                     *int64   `json:"startedID,omitempty"`
-	StartedEvent                  []byte   `json:"startedEvent,omitempty"`
-	StartedEventEncoding          *string  `json:"startedEventEncoding,omitempty"`
-	StartedTimeNanos              *int64   `json:"startedTimeNanos,omitempty"`
-	ActivityID                    *string  `json:"activityID,omitempty"`
-	RequestID                     *string  `json:"requestID,omitempty"`
-	ScheduleToStartTimeoutSeconds *int32   `json:"scheduleToStartTimeoutSeconds,omitempty"`
-	ScheduleToCloseTimeoutSeconds *int32   `json:"scheduleToCloseTimeoutSeconds,omitempty"`
-	StartToCloseTimeoutSeconds    *int32   `json:"startToCloseTimeoutSeconds,omitempty"`
-	HeartbeatTimeoutSeconds       *int32   `json:"heartbeatTimeoutSeconds,omitempty"`
-	CancelRequested               *bool    `json:"cancelRequested,omitempty"`
-	CancelRequestID               *int64   `json:"cancelRequestID,omitempty"`
-	TimerTaskStatus               *int32   `json:"timerTaskStatus,omitempty"`
-	Attempt                       *int32   `json:"attempt,omitempty"`
-	TaskList                      *string  `json:"taskList,omitempty"`
-	StartedIdentity               *string  `json:"startedIdentity,omitempty"`
-	HasRetryPolicy                *bool    `json:"hasRetryPolicy,omitempty"`
-	RetryInitialIntervalSeconds   *int32   `json:"retryInitialIntervalSeconds,omitempty"`
-	RetryMaximumIntervalSeconds   *int32   `json:"retryMaximumIntervalSeconds,omitempty"`
-	RetryMaximumAttempts          *int32   `json:"retryMaximumAttempts,omitempty"`
-	RetryExpirationTimeNanos      *int64   `json:"retryExpirationTimeNanos,omitempty"`
-	RetryBackoffCoefficient       *float64 `json:"retryBackoffCoefficient,omitempty"`
-	RetryNonRetryableErrors       []string `json:"retryNonRetryableErrors,omitempty"`
-	RetryLastFailureReason        *string  `json:"retryLastFailureReason,omitempty"`
-	RetryLastWorkerIdentity       *string  `json:"retryLastWorkerIdentity,omitempty"`
-	RetryLastFailureDetails       []byte   `json:"retryLastFailureDetails,omitempty"`
+	Version                                *int64            `json:"version,omitempty"`
+	ScheduledEventBatchID                  *int64            `json:"scheduledEventBatchID,omitempty"`
+	ScheduledEvent                         []byte            `json:"scheduledEvent,omitempty"`
+	ScheduledEventEncoding                 *string           `json:"scheduledEventEncoding,omitempty"`
+	ScheduledTimeNanos                     *int64            `json:"scheduledTimeNanos,omitempty"`
+	StartedID                              *int64            `json:"startedID,omitempty"`
+	StartedEvent                           []byte            `json:"startedEvent,omitempty"`
+	StartedEventEncoding                   *string           `json:"startedEventEncoding,omitempty"`
+	StartedTimeNanos                       *int64            `json:"startedTimeNanos,omitempty"`
+	ActivityID                             *string           `json:"activityID,omitempty"`
+	RequestID                              *string           `json:"requestID,omitempty"`
+	ScheduleToStartTimeoutSeconds          *int32            `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	ScheduleToCloseTimeoutSeconds          *int32            `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+	StartToCloseTimeoutSeconds             *int32            `json:"startToCloseTimeoutSeconds,omitempty"`
+	HeartbeatTimeoutSeconds                *int32            `json:"heartbeatTimeoutSeconds,omitempty"`
+	CancelRequested                        *bool             `json:"cancelRequested,omitempty"`
+	CancelRequestID                        *int64            `json:"cancelRequestID,omitempty"`
+	TimerTaskStatus                        *int32            `json:"timerTaskStatus,omitempty"`
+	Attempt                                *int32            `json:"attempt,omitempty"`
+	TaskList                               *string           `json:"taskList,omitempty"`
+	StartedIdentity                        *string           `json:"startedIdentity,omitempty"`
+	HasRetryPolicy                         *bool             `json:"hasRetryPolicy,omitempty"`
+	RetryInitialIntervalSeconds            *int32            `json:"retryInitialIntervalSeconds,omitempty"`
+	RetryMaximumIntervalSeconds            *int32            `json:"retryMaximumIntervalSeconds,omitempty"`
+	RetryMaximumAttempts                   *int32            `json:"retryMaximumAttempts,omitempty"`
+	RetryExpirationTimeNanos               *int64            `json:"retryExpirationTimeNanos,omitempty"`
+	RetryBackoffCoefficient                *float64          `json:"retryBackoffCoefficient,omitempty"`
+	RetryNonRetryableErrors                []string          `json:"retryNonRetryableErrors,omitempty"`
+	RetryLastFailureReason                 *string           `json:"retryLastFailureReason,omitempty"`
+	RetryLastWorkerIdentity                *string           `json:"retryLastWorkerIdentity,omitempty"`
+	RetryLastFailureDetails                []byte            `json:"retryLastFailureDetails,omitempty"`
+	RoutingKey                             *string           `json:"routingKey,omitempty"`
+	VisibilityTimeoutSeconds               *int32            `json:"visibilityTimeoutSeconds,omitempty"`
+	PrefetchLeased                         *bool             `json:"prefetchLeased,omitempty"`
+	FallbackTaskList                       *string           `json:"fallbackTaskList,omitempty"`
+	ScheduleToStartTimeouts                *int32            `json:"scheduleToStartTimeouts,omitempty"`
+	EncryptionKeyID                        *string           `json:"encryptionKeyID,omitempty"`
+	NextActivity                           []byte            `json:"nextActivity,omitempty"`
+	NextActivityEncoding                   *string           `json:"nextActivityEncoding,omitempty"`
+	AtMostOnce                             *bool             `json:"atMostOnce,omitempty"`
+	DependsOnActivityID                    *string           `json:"dependsOnActivityID,omitempty"`
+	OnDependencyFailure                    *int32            `json:"onDependencyFailure,omitempty"`
+	TaskListEscalation                     []string          `json:"taskListEscalation,omitempty"`
+	FirstAttemptStartToCloseTimeoutSeconds *int32            `json:"firstAttemptStartToCloseTimeoutSeconds,omitempty"`
+	MaxHeartbeatGapNanos                   *int64            `json:"maxHeartbeatGapNanos,omitempty"`
+	GrantedStartToCloseTimeoutSeconds      *int32            `json:"grantedStartToCloseTimeoutSeconds,omitempty"`
+	MaxTotalExecutionSeconds               *int32            `json:"maxTotalExecutionSeconds,omitempty"`
+	TotalExecutionTimeNanos                *int64            `json:"totalExecutionTimeNanos,omitempty"`
+	HeartbeatSequence                      *int64            `json:"heartbeatSequence,omitempty"`
+	MinimumIntervalSeconds                 *int32            `json:"minimumIntervalSeconds,omitempty"`
+	CancelRequestedTimeNanos               *int64            `json:"cancelRequestedTimeNanos,omitempty"`
+	CancelAckTimeoutSeconds                *int32            `json:"cancelAckTimeoutSeconds,omitempty"`
+	CancelForceTimeoutSeconds              *int32            `json:"cancelForceTimeoutSeconds,omitempty"`
+	CancelAckTimeoutExceededTimeNanos      *int64            `json:"cancelAckTimeoutExceededTimeNanos,omitempty"`
+	MaintenancePausedTimeNanos             *int64            `json:"maintenancePausedTimeNanos,omitempty"`
+	MaintenanceTimeoutsDeferred            *int32            `json:"maintenanceTimeoutsDeferred,omitempty"`
+	NackCount                              *int32            `json:"nackCount,omitempty"`
+	LastNackReason                         *string           `json:"lastNackReason,omitempty"`
+	CancellationCheckpointGraceSeconds     *int32            `json:"cancellationCheckpointGraceSeconds,omitempty"`
+	CancelDeliveredTimeNanos               *int64            `json:"cancelDeliveredTimeNanos,omitempty"`
+	AlertOnFailure                         *string           `json:"alertOnFailure,omitempty"`
+	StealTimeoutSeconds                    *int32            `json:"stealTimeoutSeconds,omitempty"`
+	Idempotent                             *bool             `json:"idempotent,omitempty"`
+	ProgressPercent                        *int32            `json:"progressPercent,omitempty"`
+	StalledHeartbeats                      *int32            `json:"stalledHeartbeats,omitempty"`
+	HeartbeatExpiredTimeNanos              *int64            `json:"heartbeatExpiredTimeNanos,omitempty"`
+	PendingSignals                         []byte            `json:"pendingSignals,omitempty"`
+	PendingSignalsEncoding                 *string           `json:"pendingSignalsEncoding,omitempty"`
+	SearchAttributes                       map[string][]byte `json:"searchAttributes,omitempty"`
+	RescheduleReasons                      []byte            `json:"rescheduleReasons,omitempty"`
+	RescheduleReasonsEncoding              *string           `json:"rescheduleReasonsEncoding,omitempty"`
+	ResultSearchAttributes                 []byte            `json:"resultSearchAttributes,omitempty"`
+	ResultSearchAttributesEncoding         *string           `json:"resultSearchAttributesEncoding,omitempty"`
+	Priority                               *int32            `json:"priority,omitempty"`
 }
 
 type _List_String_ValueList []string
@@ -103,6 +146,44 @@ func (_List_String_ValueList) ValueType() wire.Type {
 
 func (_List_String_ValueList) Close() {}
 
+type _Map_String_Binary_MapItemList map[string][]byte
+
+func (m _Map_String_Binary_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string][]byte', key [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueBinary(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Binary_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Binary_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Binary_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Binary_MapItemList) Close() {}
+
 // ToWire translates a ActivityInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -120,7 +201,7 @@ func (_List_String_ValueList) Close() {}
 //	}
 func (v *ActivityInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [31]wire.Field
+		fields [74]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -374,1955 +455,4762 @@ func (v *ActivityInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _List_String_Read(l wire.ValueList) ([]string, error) {
-	if l.ValueType() != wire.TBinary {
-		return nil, nil
+	if v.RoutingKey != nil {
+		w, err = wire.NewValueString(*(v.RoutingKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 72, Value: w}
+		i++
 	}
-
-	o := make([]string, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetString(), error(nil)
+	if v.VisibilityTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.VisibilityTimeoutSeconds)), error(nil)
 		if err != nil {
-			return err
+			return w, err
 		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-// FromWire deserializes a ActivityInfo struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a ActivityInfo struct
-// from the provided intermediate representation.
-//
-//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//	if err != nil {
-//	  return nil, err
-//	}
-//
-//	var v ActivityInfo
-//	if err := v.FromWire(x); err != nil {
-//	  return nil, err
-//	}
-//	return &v, nil
-func (v *ActivityInfo) FromWire(w wire.Value) error {
-	var err error
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.Version = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 12:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledEventBatchID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 14:
-			if field.Value.Type() == wire.TBinary {
-				v.ScheduledEvent, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 16:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ScheduledEventEncoding = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 18:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledTimeNanos = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 22:
-			if field.Value.Type() == wire.TBinary {
-				v.StartedEvent, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 24:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.StartedEventEncoding = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 26:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedTimeNanos = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 28:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ActivityID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RequestID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 32:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.ScheduleToStartTimeoutSeconds = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 34:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.ScheduleToCloseTimeoutSeconds = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 36:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.StartToCloseTimeoutSeconds = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 38:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.HeartbeatTimeoutSeconds = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.CancelRequested = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 42:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.CancelRequestID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 44:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.TimerTaskStatus = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 46:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.Attempt = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 48:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.TaskList = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.StartedIdentity = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 52:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.HasRetryPolicy = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 54:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.RetryInitialIntervalSeconds = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 56:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.RetryMaximumIntervalSeconds = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 58:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.RetryMaximumAttempts = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.RetryExpirationTimeNanos = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 62:
-			if field.Value.Type() == wire.TDouble {
-				var x float64
-				x, err = field.Value.GetDouble(), error(nil)
-				v.RetryBackoffCoefficient = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 64:
-			if field.Value.Type() == wire.TList {
-				v.RetryNonRetryableErrors, err = _List_String_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 66:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RetryLastFailureReason = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 68:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RetryLastWorkerIdentity = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TBinary {
-				v.RetryLastFailureDetails, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		}
-	}
-
-	return nil
-}
-
-func _List_String_Encode(val []string, sw stream.Writer) error {
-
-	lh := stream.ListHeader{
-		Type:   wire.TBinary,
-		Length: len(val),
-	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
+		fields[i] = wire.Field{ID: 73, Value: w}
+		i++
 	}
-
-	for _, v := range val {
-		if err := sw.WriteString(v); err != nil {
-			return err
+	if v.PrefetchLeased != nil {
+		w, err = wire.NewValueBool(*(v.PrefetchLeased)), error(nil)
+		if err != nil {
+			return w, err
 		}
+		fields[i] = wire.Field{ID: 74, Value: w}
+		i++
 	}
-	return sw.WriteListEnd()
-}
-
-// Encode serializes a ActivityInfo struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a ActivityInfo struct could not be encoded.
-func (v *ActivityInfo) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
-		return err
+	if v.FallbackTaskList != nil {
+		w, err = wire.NewValueString(*(v.FallbackTaskList)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 75, Value: w}
+		i++
 	}
-
-	if v.Version != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
-			return err
+	if v.ScheduleToStartTimeouts != nil {
+		w, err = wire.NewValueI32(*(v.ScheduleToStartTimeouts)), error(nil)
+		if err != nil {
+			return w, err
 		}
-		if err := sw.WriteInt64(*(v.Version)); err != nil {
-			return err
+		fields[i] = wire.Field{ID: 76, Value: w}
+		i++
+	}
+	if v.EncryptionKeyID != nil {
+		w, err = wire.NewValueString(*(v.EncryptionKeyID)), error(nil)
+		if err != nil {
+			return w, err
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
+		fields[i] = wire.Field{ID: 77, Value: w}
+		i++
+	}
+	if v.NextActivity != nil {
+		w, err = wire.NewValueBinary(v.NextActivity), error(nil)
+		if err != nil {
+			return w, err
 		}
+		fields[i] = wire.Field{ID: 78, Value: w}
+		i++
 	}
-
-	if v.ScheduledEventBatchID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 12, Type: wire.TI64}); err != nil {
-			return err
+	if v.NextActivityEncoding != nil {
+		w, err = wire.NewValueString(*(v.NextActivityEncoding)), error(nil)
+		if err != nil {
+			return w, err
 		}
-		if err := sw.WriteInt64(*(v.ScheduledEventBatchID)); err != nil {
-			return err
+		fields[i] = wire.Field{ID: 79, Value: w}
+		i++
+	}
+	if v.AtMostOnce != nil {
+		w, err = wire.NewValueBool(*(v.AtMostOnce)), error(nil)
+		if err != nil {
+			return w, err
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.DependsOnActivityID != nil {
+		w, err = wire.NewValueString(*(v.DependsOnActivityID)), error(nil)
+		if err != nil {
+			return w, err
 		}
+		fields[i] = wire.Field{ID: 81, Value: w}
+		i++
 	}
-
-	if v.ScheduledEvent != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 14, Type: wire.TBinary}); err != nil {
-			return err
+	if v.OnDependencyFailure != nil {
+		w, err = wire.NewValueI32(*(v.OnDependencyFailure)), error(nil)
+		if err != nil {
+			return w, err
 		}
-		if err := sw.WriteBinary(v.ScheduledEvent); err != nil {
-			return err
+		fields[i] = wire.Field{ID: 82, Value: w}
+		i++
+	}
+	if v.TaskListEscalation != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.TaskListEscalation)), error(nil)
+		if err != nil {
+			return w, err
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
+		fields[i] = wire.Field{ID: 83, Value: w}
+		i++
+	}
+	if v.FirstAttemptStartToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.FirstAttemptStartToCloseTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
 		}
+		fields[i] = wire.Field{ID: 84, Value: w}
+		i++
 	}
-
-	if v.ScheduledEventEncoding != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 16, Type: wire.TBinary}); err != nil {
-			return err
+	if v.MaxHeartbeatGapNanos != nil {
+		w, err = wire.NewValueI64(*(v.MaxHeartbeatGapNanos)), error(nil)
+		if err != nil {
+			return w, err
 		}
-		if err := sw.WriteString(*(v.ScheduledEventEncoding)); err != nil {
-			return err
+		fields[i] = wire.Field{ID: 85, Value: w}
+		i++
+	}
+	if v.GrantedStartToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.GrantedStartToCloseTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
+		fields[i] = wire.Field{ID: 86, Value: w}
+		i++
+	}
+	if v.MaxTotalExecutionSeconds != nil {
+		w, err = wire.NewValueI32(*(v.MaxTotalExecutionSeconds)), error(nil)
+		if err != nil {
+			return w, err
 		}
+		fields[i] = wire.Field{ID: 87, Value: w}
+		i++
 	}
-
-	if v.ScheduledTimeNanos != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 18, Type: wire.TI64}); err != nil {
-			return err
+	if v.TotalExecutionTimeNanos != nil {
+		w, err = wire.NewValueI64(*(v.TotalExecutionTimeNanos)), error(nil)
+		if err != nil {
+			return w, err
 		}
-		if err := sw.WriteInt64(*(v.ScheduledTimeNanos)); err != nil {
+		fields[i] = wire.Field{ID: 88, Value: w}
+		i++
+	}
+	if v.HeartbeatSequence != nil {
+		w, err = wire.NewValueI64(*(v.HeartbeatSequence)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 89, Value: w}
+		i++
+	}
+	if v.MinimumIntervalSeconds != nil {
+		w, err = wire.NewValueI32(*(v.MinimumIntervalSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.CancelRequestedTimeNanos != nil {
+		w, err = wire.NewValueI64(*(v.CancelRequestedTimeNanos)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 91, Value: w}
+		i++
+	}
+	if v.CancelAckTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.CancelAckTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 92, Value: w}
+		i++
+	}
+	if v.CancelForceTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.CancelForceTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 93, Value: w}
+		i++
+	}
+	if v.CancelAckTimeoutExceededTimeNanos != nil {
+		w, err = wire.NewValueI64(*(v.CancelAckTimeoutExceededTimeNanos)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 94, Value: w}
+		i++
+	}
+	if v.MaintenancePausedTimeNanos != nil {
+		w, err = wire.NewValueI64(*(v.MaintenancePausedTimeNanos)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 95, Value: w}
+		i++
+	}
+	if v.MaintenanceTimeoutsDeferred != nil {
+		w, err = wire.NewValueI32(*(v.MaintenanceTimeoutsDeferred)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 96, Value: w}
+		i++
+	}
+	if v.NackCount != nil {
+		w, err = wire.NewValueI32(*(v.NackCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 97, Value: w}
+		i++
+	}
+	if v.LastNackReason != nil {
+		w, err = wire.NewValueString(*(v.LastNackReason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 98, Value: w}
+		i++
+	}
+	if v.CancellationCheckpointGraceSeconds != nil {
+		w, err = wire.NewValueI32(*(v.CancellationCheckpointGraceSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 99, Value: w}
+		i++
+	}
+	if v.CancelDeliveredTimeNanos != nil {
+		w, err = wire.NewValueI64(*(v.CancelDeliveredTimeNanos)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.AlertOnFailure != nil {
+		w, err = wire.NewValueString(*(v.AlertOnFailure)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 101, Value: w}
+		i++
+	}
+	if v.StealTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.StealTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 102, Value: w}
+		i++
+	}
+	if v.Idempotent != nil {
+		w, err = wire.NewValueBool(*(v.Idempotent)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 103, Value: w}
+		i++
+	}
+	if v.ProgressPercent != nil {
+		w, err = wire.NewValueI32(*(v.ProgressPercent)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 104, Value: w}
+		i++
+	}
+	if v.StalledHeartbeats != nil {
+		w, err = wire.NewValueI32(*(v.StalledHeartbeats)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 105, Value: w}
+		i++
+	}
+	if v.HeartbeatExpiredTimeNanos != nil {
+		w, err = wire.NewValueI64(*(v.HeartbeatExpiredTimeNanos)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 106, Value: w}
+		i++
+	}
+	if v.PendingSignals != nil {
+		w, err = wire.NewValueBinary(v.PendingSignals), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 107, Value: w}
+		i++
+	}
+	if v.PendingSignalsEncoding != nil {
+		w, err = wire.NewValueString(*(v.PendingSignalsEncoding)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 108, Value: w}
+		i++
+	}
+	if v.SearchAttributes != nil {
+		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.SearchAttributes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 109, Value: w}
+		i++
+	}
+	if v.RescheduleReasons != nil {
+		w, err = wire.NewValueBinary(v.RescheduleReasons), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.RescheduleReasonsEncoding != nil {
+		w, err = wire.NewValueString(*(v.RescheduleReasonsEncoding)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 111, Value: w}
+		i++
+	}
+	if v.ResultSearchAttributes != nil {
+		w, err = wire.NewValueBinary(v.ResultSearchAttributes), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 112, Value: w}
+		i++
+	}
+	if v.ResultSearchAttributesEncoding != nil {
+		w, err = wire.NewValueString(*(v.ResultSearchAttributesEncoding)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 113, Value: w}
+		i++
+	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 114, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
 			return err
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string][]byte, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetBinary(), error(nil)
+		if err != nil {
 			return err
 		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a ActivityInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ActivityInfo struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v ActivityInfo
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ActivityInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 12:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledEventBatchID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 14:
+			if field.Value.Type() == wire.TBinary {
+				v.ScheduledEvent, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 16:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ScheduledEventEncoding = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 18:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledTimeNanos = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 22:
+			if field.Value.Type() == wire.TBinary {
+				v.StartedEvent, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 24:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.StartedEventEncoding = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 26:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedTimeNanos = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 28:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActivityID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 32:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ScheduleToStartTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 34:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ScheduleToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 36:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.StartToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 38:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.HeartbeatTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.CancelRequested = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 42:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.CancelRequestID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 44:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.TimerTaskStatus = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 46:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 48:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TaskList = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.StartedIdentity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 52:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.HasRetryPolicy = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 54:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.RetryInitialIntervalSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 56:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.RetryMaximumIntervalSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 58:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.RetryMaximumAttempts = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.RetryExpirationTimeNanos = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 62:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.RetryBackoffCoefficient = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 64:
+			if field.Value.Type() == wire.TList {
+				v.RetryNonRetryableErrors, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 66:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RetryLastFailureReason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 68:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RetryLastWorkerIdentity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				v.RetryLastFailureDetails, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 72:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RoutingKey = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 73:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.VisibilityTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 74:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.PrefetchLeased = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 75:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FallbackTaskList = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 76:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ScheduleToStartTimeouts = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 77:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.EncryptionKeyID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 78:
+			if field.Value.Type() == wire.TBinary {
+				v.NextActivity, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 79:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.NextActivityEncoding = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.AtMostOnce = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 81:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DependsOnActivityID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 82:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.OnDependencyFailure = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 83:
+			if field.Value.Type() == wire.TList {
+				v.TaskListEscalation, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 84:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.FirstAttemptStartToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 85:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.MaxHeartbeatGapNanos = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 86:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.GrantedStartToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 87:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaxTotalExecutionSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 88:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TotalExecutionTimeNanos = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 89:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.HeartbeatSequence = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MinimumIntervalSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 91:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.CancelRequestedTimeNanos = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 92:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.CancelAckTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 93:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.CancelForceTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 94:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.CancelAckTimeoutExceededTimeNanos = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 95:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.MaintenancePausedTimeNanos = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 96:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaintenanceTimeoutsDeferred = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 97:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NackCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 98:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.LastNackReason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 99:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.CancellationCheckpointGraceSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.CancelDeliveredTimeNanos = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 101:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.AlertOnFailure = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 102:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.StealTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 103:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Idempotent = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 104:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ProgressPercent = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 105:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.StalledHeartbeats = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 106:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.HeartbeatExpiredTimeNanos = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 107:
+			if field.Value.Type() == wire.TBinary {
+				v.PendingSignals, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 108:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.PendingSignalsEncoding = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 109:
+			if field.Value.Type() == wire.TMap {
+				v.SearchAttributes, err = _Map_String_Binary_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TBinary {
+				v.RescheduleReasons, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 111:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RescheduleReasonsEncoding = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 112:
+			if field.Value.Type() == wire.TBinary {
+				v.ResultSearchAttributes, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 113:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ResultSearchAttributesEncoding = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 114:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_String_Encode(val []string, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		if err := sw.WriteString(v); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+func _Map_String_Binary_Encode(val map[string][]byte, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TBinary,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string][]byte', key [%v]: value is nil", k)
+		}
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a ActivityInfo struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ActivityInfo struct could not be encoded.
+func (v *ActivityInfo) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Version != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Version)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ScheduledEventBatchID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 12, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ScheduledEventBatchID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ScheduledEvent != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 14, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.ScheduledEvent); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ScheduledEventEncoding != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 16, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ScheduledEventEncoding)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ScheduledTimeNanos != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 18, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ScheduledTimeNanos)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StartedID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.StartedID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StartedEvent != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 22, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.StartedEvent); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StartedEventEncoding != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 24, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.StartedEventEncoding)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StartedTimeNanos != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 26, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.StartedTimeNanos)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ActivityID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 28, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ActivityID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RequestID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.RequestID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ScheduleToStartTimeoutSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 32, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.ScheduleToStartTimeoutSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ScheduleToCloseTimeoutSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 34, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.ScheduleToCloseTimeoutSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StartToCloseTimeoutSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 36, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.StartToCloseTimeoutSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.HeartbeatTimeoutSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 38, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.HeartbeatTimeoutSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.CancelRequested != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.CancelRequested)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.CancelRequestID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 42, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.CancelRequestID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TimerTaskStatus != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 44, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.TimerTaskStatus)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Attempt != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 46, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Attempt)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TaskList != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 48, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.TaskList)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StartedIdentity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.StartedIdentity)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.HasRetryPolicy != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 52, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.HasRetryPolicy)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RetryInitialIntervalSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 54, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.RetryInitialIntervalSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RetryMaximumIntervalSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 56, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.RetryMaximumIntervalSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RetryMaximumAttempts != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 58, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.RetryMaximumAttempts)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RetryExpirationTimeNanos != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.RetryExpirationTimeNanos)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RetryBackoffCoefficient != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 62, Type: wire.TDouble}); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(*(v.RetryBackoffCoefficient)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RetryNonRetryableErrors != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 64, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.RetryNonRetryableErrors, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RetryLastFailureReason != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 66, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.RetryLastFailureReason)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RetryLastWorkerIdentity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 68, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.RetryLastWorkerIdentity)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RetryLastFailureDetails != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.RetryLastFailureDetails); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RoutingKey != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 72, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.RoutingKey)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.VisibilityTimeoutSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 73, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.VisibilityTimeoutSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PrefetchLeased != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 74, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.PrefetchLeased)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.FallbackTaskList != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 75, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.FallbackTaskList)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ScheduleToStartTimeouts != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 76, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.ScheduleToStartTimeouts)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.EncryptionKeyID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 77, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.EncryptionKeyID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NextActivity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 78, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.NextActivity); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NextActivityEncoding != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 79, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.NextActivityEncoding)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.AtMostOnce != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.AtMostOnce)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DependsOnActivityID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 81, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.DependsOnActivityID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.OnDependencyFailure != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 82, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.OnDependencyFailure)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TaskListEscalation != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 83, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.TaskListEscalation, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.FirstAttemptStartToCloseTimeoutSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 84, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.FirstAttemptStartToCloseTimeoutSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.MaxHeartbeatGapNanos != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 85, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.MaxHeartbeatGapNanos)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.GrantedStartToCloseTimeoutSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 86, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.GrantedStartToCloseTimeoutSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.MaxTotalExecutionSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 87, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.MaxTotalExecutionSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TotalExecutionTimeNanos != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 88, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.TotalExecutionTimeNanos)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.HeartbeatSequence != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 89, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.HeartbeatSequence)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.MinimumIntervalSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.MinimumIntervalSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.CancelRequestedTimeNanos != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 91, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.CancelRequestedTimeNanos)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.CancelAckTimeoutSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 92, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.CancelAckTimeoutSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.CancelForceTimeoutSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 93, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.CancelForceTimeoutSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.CancelAckTimeoutExceededTimeNanos != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 94, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.CancelAckTimeoutExceededTimeNanos)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.MaintenancePausedTimeNanos != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 95, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.MaintenancePausedTimeNanos)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.MaintenanceTimeoutsDeferred != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 96, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.MaintenanceTimeoutsDeferred)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NackCount != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 97, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.NackCount)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastNackReason != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 98, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.LastNackReason)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.CancellationCheckpointGraceSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 99, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.CancellationCheckpointGraceSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.CancelDeliveredTimeNanos != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.CancelDeliveredTimeNanos)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.AlertOnFailure != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 101, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.AlertOnFailure)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StealTimeoutSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 102, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.StealTimeoutSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Idempotent != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 103, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Idempotent)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ProgressPercent != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 104, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.ProgressPercent)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StalledHeartbeats != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 105, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.StalledHeartbeats)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.HeartbeatExpiredTimeNanos != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 106, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.HeartbeatExpiredTimeNanos)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PendingSignals != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 107, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.PendingSignals); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PendingSignalsEncoding != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 108, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.PendingSignalsEncoding)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.SearchAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 109, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_Binary_Encode(v.SearchAttributes, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RescheduleReasons != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 110, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.RescheduleReasons); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RescheduleReasonsEncoding != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 111, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.RescheduleReasonsEncoding)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ResultSearchAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 112, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.ResultSearchAttributes); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ResultSearchAttributesEncoding != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 113, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ResultSearchAttributesEncoding)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Priority != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 114, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Priority)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]string, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_Binary_Decode(sr stream.Reader) (map[string][]byte, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string][]byte, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a ActivityInfo struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ActivityInfo struct could not be generated from the wire
+// representation.
+func (v *ActivityInfo) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Version = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 12 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ScheduledEventBatchID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 14 && fh.Type == wire.TBinary:
+			v.ScheduledEvent, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 16 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ScheduledEventEncoding = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 18 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ScheduledTimeNanos = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.StartedID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 22 && fh.Type == wire.TBinary:
+			v.StartedEvent, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 24 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.StartedEventEncoding = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 26 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.StartedTimeNanos = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 28 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ActivityID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RequestID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 32 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ScheduleToStartTimeoutSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 34 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ScheduleToCloseTimeoutSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 36 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.StartToCloseTimeoutSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 38 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.HeartbeatTimeoutSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.CancelRequested = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 42 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.CancelRequestID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 44 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.TimerTaskStatus = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 46 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Attempt = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 48 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.TaskList = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.StartedIdentity = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 52 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.HasRetryPolicy = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 54 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.RetryInitialIntervalSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 56 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.RetryMaximumIntervalSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 58 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.RetryMaximumAttempts = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.RetryExpirationTimeNanos = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 62 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.RetryBackoffCoefficient = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 64 && fh.Type == wire.TList:
+			v.RetryNonRetryableErrors, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 66 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RetryLastFailureReason = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 68 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RetryLastWorkerIdentity = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TBinary:
+			v.RetryLastFailureDetails, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 72 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RoutingKey = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 73 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.VisibilityTimeoutSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 74 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.PrefetchLeased = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 75 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.FallbackTaskList = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 76 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ScheduleToStartTimeouts = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 77 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.EncryptionKeyID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 78 && fh.Type == wire.TBinary:
+			v.NextActivity, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 79 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.NextActivityEncoding = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 80 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.AtMostOnce = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 81 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.DependsOnActivityID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 82 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.OnDependencyFailure = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 83 && fh.Type == wire.TList:
+			v.TaskListEscalation, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 84 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.FirstAttemptStartToCloseTimeoutSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 85 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.MaxHeartbeatGapNanos = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 86 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.GrantedStartToCloseTimeoutSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 87 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.MaxTotalExecutionSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 88 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.TotalExecutionTimeNanos = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 89 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.HeartbeatSequence = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 90 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.MinimumIntervalSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 91 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.CancelRequestedTimeNanos = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 92 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.CancelAckTimeoutSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 93 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.CancelForceTimeoutSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 94 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.CancelAckTimeoutExceededTimeNanos = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 95 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.MaintenancePausedTimeNanos = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 96 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.MaintenanceTimeoutsDeferred = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 97 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.NackCount = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 98 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.LastNackReason = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 99 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.CancellationCheckpointGraceSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 100 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.CancelDeliveredTimeNanos = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 101 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.AlertOnFailure = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 102 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.StealTimeoutSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 103 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Idempotent = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 104 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ProgressPercent = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 105 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.StalledHeartbeats = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 106 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.HeartbeatExpiredTimeNanos = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 107 && fh.Type == wire.TBinary:
+			v.PendingSignals, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 108 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.PendingSignalsEncoding = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 109 && fh.Type == wire.TMap:
+			v.SearchAttributes, err = _Map_String_Binary_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 110 && fh.Type == wire.TBinary:
+			v.RescheduleReasons, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 111 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RescheduleReasonsEncoding = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 112 && fh.Type == wire.TBinary:
+			v.ResultSearchAttributes, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 113 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ResultSearchAttributesEncoding = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 114 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Priority = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ActivityInfo
+// struct.
+func (v *ActivityInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [74]string
+	i := 0
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+	if v.ScheduledEventBatchID != nil {
+		fields[i] = fmt.Sprintf("ScheduledEventBatchID: %v", *(v.ScheduledEventBatchID))
+		i++
+	}
+	if v.ScheduledEvent != nil {
+		fields[i] = fmt.Sprintf("ScheduledEvent: %v", v.ScheduledEvent)
+		i++
+	}
+	if v.ScheduledEventEncoding != nil {
+		fields[i] = fmt.Sprintf("ScheduledEventEncoding: %v", *(v.ScheduledEventEncoding))
+		i++
+	}
+	if v.ScheduledTimeNanos != nil {
+		fields[i] = fmt.Sprintf("ScheduledTimeNanos: %v", *(v.ScheduledTimeNanos))
+		i++
+	}
+	if v.StartedID != nil {
+		fields[i] = fmt.Sprintf("StartedID: %v", *(v.StartedID))
+		i++
+	}
+	if v.StartedEvent != nil {
+		fields[i] = fmt.Sprintf("StartedEvent: %v", v.StartedEvent)
+		i++
+	}
+	if v.StartedEventEncoding != nil {
+		fields[i] = fmt.Sprintf("StartedEventEncoding: %v", *(v.StartedEventEncoding))
+		i++
+	}
+	if v.StartedTimeNanos != nil {
+		fields[i] = fmt.Sprintf("StartedTimeNanos: %v", *(v.StartedTimeNanos))
+		i++
+	}
+	if v.ActivityID != nil {
+		fields[i] = fmt.Sprintf("ActivityID: %v", *(v.ActivityID))
+		i++
+	}
+	if v.RequestID != nil {
+		fields[i] = fmt.Sprintf("RequestID: %v", *(v.RequestID))
+		i++
+	}
+	if v.ScheduleToStartTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("ScheduleToStartTimeoutSeconds: %v", *(v.ScheduleToStartTimeoutSeconds))
+		i++
+	}
+	if v.ScheduleToCloseTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("ScheduleToCloseTimeoutSeconds: %v", *(v.ScheduleToCloseTimeoutSeconds))
+		i++
+	}
+	if v.StartToCloseTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("StartToCloseTimeoutSeconds: %v", *(v.StartToCloseTimeoutSeconds))
+		i++
+	}
+	if v.HeartbeatTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("HeartbeatTimeoutSeconds: %v", *(v.HeartbeatTimeoutSeconds))
+		i++
+	}
+	if v.CancelRequested != nil {
+		fields[i] = fmt.Sprintf("CancelRequested: %v", *(v.CancelRequested))
+		i++
+	}
+	if v.CancelRequestID != nil {
+		fields[i] = fmt.Sprintf("CancelRequestID: %v", *(v.CancelRequestID))
+		i++
+	}
+	if v.TimerTaskStatus != nil {
+		fields[i] = fmt.Sprintf("TimerTaskStatus: %v", *(v.TimerTaskStatus))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", *(v.TaskList))
+		i++
+	}
+	if v.StartedIdentity != nil {
+		fields[i] = fmt.Sprintf("StartedIdentity: %v", *(v.StartedIdentity))
+		i++
+	}
+	if v.HasRetryPolicy != nil {
+		fields[i] = fmt.Sprintf("HasRetryPolicy: %v", *(v.HasRetryPolicy))
+		i++
+	}
+	if v.RetryInitialIntervalSeconds != nil {
+		fields[i] = fmt.Sprintf("RetryInitialIntervalSeconds: %v", *(v.RetryInitialIntervalSeconds))
+		i++
+	}
+	if v.RetryMaximumIntervalSeconds != nil {
+		fields[i] = fmt.Sprintf("RetryMaximumIntervalSeconds: %v", *(v.RetryMaximumIntervalSeconds))
+		i++
+	}
+	if v.RetryMaximumAttempts != nil {
+		fields[i] = fmt.Sprintf("RetryMaximumAttempts: %v", *(v.RetryMaximumAttempts))
+		i++
+	}
+	if v.RetryExpirationTimeNanos != nil {
+		fields[i] = fmt.Sprintf("RetryExpirationTimeNanos: %v", *(v.RetryExpirationTimeNanos))
+		i++
+	}
+	if v.RetryBackoffCoefficient != nil {
+		fields[i] = fmt.Sprintf("RetryBackoffCoefficient: %v", *(v.RetryBackoffCoefficient))
+		i++
+	}
+	if v.RetryNonRetryableErrors != nil {
+		fields[i] = fmt.Sprintf("RetryNonRetryableErrors: %v", v.RetryNonRetryableErrors)
+		i++
+	}
+	if v.RetryLastFailureReason != nil {
+		fields[i] = fmt.Sprintf("RetryLastFailureReason: %v", *(v.RetryLastFailureReason))
+		i++
+	}
+	if v.RetryLastWorkerIdentity != nil {
+		fields[i] = fmt.Sprintf("RetryLastWorkerIdentity: %v", *(v.RetryLastWorkerIdentity))
+		i++
+	}
+	if v.RetryLastFailureDetails != nil {
+		fields[i] = fmt.Sprintf("RetryLastFailureDetails: %v", v.RetryLastFailureDetails)
+		i++
+	}
+	if v.RoutingKey != nil {
+		fields[i] = fmt.Sprintf("RoutingKey: %v", *(v.RoutingKey))
+		i++
+	}
+	if v.VisibilityTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("VisibilityTimeoutSeconds: %v", *(v.VisibilityTimeoutSeconds))
+		i++
+	}
+	if v.PrefetchLeased != nil {
+		fields[i] = fmt.Sprintf("PrefetchLeased: %v", *(v.PrefetchLeased))
+		i++
+	}
+	if v.FallbackTaskList != nil {
+		fields[i] = fmt.Sprintf("FallbackTaskList: %v", *(v.FallbackTaskList))
+		i++
+	}
+	if v.ScheduleToStartTimeouts != nil {
+		fields[i] = fmt.Sprintf("ScheduleToStartTimeouts: %v", *(v.ScheduleToStartTimeouts))
+		i++
+	}
+	if v.EncryptionKeyID != nil {
+		fields[i] = fmt.Sprintf("EncryptionKeyID: %v", *(v.EncryptionKeyID))
+		i++
+	}
+	if v.NextActivity != nil {
+		fields[i] = fmt.Sprintf("NextActivity: %v", v.NextActivity)
+		i++
+	}
+	if v.NextActivityEncoding != nil {
+		fields[i] = fmt.Sprintf("NextActivityEncoding: %v", *(v.NextActivityEncoding))
+		i++
+	}
+	if v.AtMostOnce != nil {
+		fields[i] = fmt.Sprintf("AtMostOnce: %v", *(v.AtMostOnce))
+		i++
+	}
+	if v.DependsOnActivityID != nil {
+		fields[i] = fmt.Sprintf("DependsOnActivityID: %v", *(v.DependsOnActivityID))
+		i++
+	}
+	if v.OnDependencyFailure != nil {
+		fields[i] = fmt.Sprintf("OnDependencyFailure: %v", *(v.OnDependencyFailure))
+		i++
+	}
+	if v.TaskListEscalation != nil {
+		fields[i] = fmt.Sprintf("TaskListEscalation: %v", v.TaskListEscalation)
+		i++
+	}
+	if v.FirstAttemptStartToCloseTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("FirstAttemptStartToCloseTimeoutSeconds: %v", *(v.FirstAttemptStartToCloseTimeoutSeconds))
+		i++
+	}
+	if v.MaxHeartbeatGapNanos != nil {
+		fields[i] = fmt.Sprintf("MaxHeartbeatGapNanos: %v", *(v.MaxHeartbeatGapNanos))
+		i++
+	}
+	if v.GrantedStartToCloseTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("GrantedStartToCloseTimeoutSeconds: %v", *(v.GrantedStartToCloseTimeoutSeconds))
+		i++
+	}
+	if v.MaxTotalExecutionSeconds != nil {
+		fields[i] = fmt.Sprintf("MaxTotalExecutionSeconds: %v", *(v.MaxTotalExecutionSeconds))
+		i++
+	}
+	if v.TotalExecutionTimeNanos != nil {
+		fields[i] = fmt.Sprintf("TotalExecutionTimeNanos: %v", *(v.TotalExecutionTimeNanos))
+		i++
+	}
+	if v.HeartbeatSequence != nil {
+		fields[i] = fmt.Sprintf("HeartbeatSequence: %v", *(v.HeartbeatSequence))
+		i++
+	}
+	if v.MinimumIntervalSeconds != nil {
+		fields[i] = fmt.Sprintf("MinimumIntervalSeconds: %v", *(v.MinimumIntervalSeconds))
+		i++
+	}
+	if v.CancelRequestedTimeNanos != nil {
+		fields[i] = fmt.Sprintf("CancelRequestedTimeNanos: %v", *(v.CancelRequestedTimeNanos))
+		i++
+	}
+	if v.CancelAckTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("CancelAckTimeoutSeconds: %v", *(v.CancelAckTimeoutSeconds))
+		i++
+	}
+	if v.CancelForceTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("CancelForceTimeoutSeconds: %v", *(v.CancelForceTimeoutSeconds))
+		i++
+	}
+	if v.CancelAckTimeoutExceededTimeNanos != nil {
+		fields[i] = fmt.Sprintf("CancelAckTimeoutExceededTimeNanos: %v", *(v.CancelAckTimeoutExceededTimeNanos))
+		i++
+	}
+	if v.MaintenancePausedTimeNanos != nil {
+		fields[i] = fmt.Sprintf("MaintenancePausedTimeNanos: %v", *(v.MaintenancePausedTimeNanos))
+		i++
+	}
+	if v.MaintenanceTimeoutsDeferred != nil {
+		fields[i] = fmt.Sprintf("MaintenanceTimeoutsDeferred: %v", *(v.MaintenanceTimeoutsDeferred))
+		i++
+	}
+	if v.NackCount != nil {
+		fields[i] = fmt.Sprintf("NackCount: %v", *(v.NackCount))
+		i++
+	}
+	if v.LastNackReason != nil {
+		fields[i] = fmt.Sprintf("LastNackReason: %v", *(v.LastNackReason))
+		i++
+	}
+	if v.CancellationCheckpointGraceSeconds != nil {
+		fields[i] = fmt.Sprintf("CancellationCheckpointGraceSeconds: %v", *(v.CancellationCheckpointGraceSeconds))
+		i++
+	}
+	if v.CancelDeliveredTimeNanos != nil {
+		fields[i] = fmt.Sprintf("CancelDeliveredTimeNanos: %v", *(v.CancelDeliveredTimeNanos))
+		i++
+	}
+	if v.AlertOnFailure != nil {
+		fields[i] = fmt.Sprintf("AlertOnFailure: %v", *(v.AlertOnFailure))
+		i++
+	}
+	if v.StealTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("StealTimeoutSeconds: %v", *(v.StealTimeoutSeconds))
+		i++
+	}
+	if v.Idempotent != nil {
+		fields[i] = fmt.Sprintf("Idempotent: %v", *(v.Idempotent))
+		i++
+	}
+	if v.ProgressPercent != nil {
+		fields[i] = fmt.Sprintf("ProgressPercent: %v", *(v.ProgressPercent))
+		i++
+	}
+	if v.StalledHeartbeats != nil {
+		fields[i] = fmt.Sprintf("StalledHeartbeats: %v", *(v.StalledHeartbeats))
+		i++
+	}
+	if v.HeartbeatExpiredTimeNanos != nil {
+		fields[i] = fmt.Sprintf("HeartbeatExpiredTimeNanos: %v", *(v.HeartbeatExpiredTimeNanos))
+		i++
+	}
+	if v.PendingSignals != nil {
+		fields[i] = fmt.Sprintf("PendingSignals: %v", v.PendingSignals)
+		i++
+	}
+	if v.PendingSignalsEncoding != nil {
+		fields[i] = fmt.Sprintf("PendingSignalsEncoding: %v", *(v.PendingSignalsEncoding))
+		i++
+	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
+	if v.RescheduleReasons != nil {
+		fields[i] = fmt.Sprintf("RescheduleReasons: %v", v.RescheduleReasons)
+		i++
+	}
+	if v.RescheduleReasonsEncoding != nil {
+		fields[i] = fmt.Sprintf("RescheduleReasonsEncoding: %v", *(v.RescheduleReasonsEncoding))
+		i++
+	}
+	if v.ResultSearchAttributes != nil {
+		fields[i] = fmt.Sprintf("ResultSearchAttributes: %v", v.ResultSearchAttributes)
+		i++
+	}
+	if v.ResultSearchAttributesEncoding != nil {
+		fields[i] = fmt.Sprintf("ResultSearchAttributesEncoding: %v", *(v.ResultSearchAttributesEncoding))
+		i++
+	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+
+	return fmt.Sprintf("ActivityInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Double_EqualsPtr(lhs, rhs *float64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_Binary_Equals(lhs, rhs map[string][]byte) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !bytes.Equal(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this ActivityInfo match the
+// provided ActivityInfo.
+//
+// This function performs a deep comparison.
+func (v *ActivityInfo) Equals(rhs *ActivityInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduledEventBatchID, rhs.ScheduledEventBatchID) {
+		return false
+	}
+	if !((v.ScheduledEvent == nil && rhs.ScheduledEvent == nil) || (v.ScheduledEvent != nil && rhs.ScheduledEvent != nil && bytes.Equal(v.ScheduledEvent, rhs.ScheduledEvent))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ScheduledEventEncoding, rhs.ScheduledEventEncoding) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduledTimeNanos, rhs.ScheduledTimeNanos) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedID, rhs.StartedID) {
+		return false
+	}
+	if !((v.StartedEvent == nil && rhs.StartedEvent == nil) || (v.StartedEvent != nil && rhs.StartedEvent != nil && bytes.Equal(v.StartedEvent, rhs.StartedEvent))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.StartedEventEncoding, rhs.StartedEventEncoding) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedTimeNanos, rhs.StartedTimeNanos) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ActivityID, rhs.ActivityID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RequestID, rhs.RequestID) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ScheduleToStartTimeoutSeconds, rhs.ScheduleToStartTimeoutSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ScheduleToCloseTimeoutSeconds, rhs.ScheduleToCloseTimeoutSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.StartToCloseTimeoutSeconds, rhs.StartToCloseTimeoutSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.HeartbeatTimeoutSeconds, rhs.HeartbeatTimeoutSeconds) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.CancelRequested, rhs.CancelRequested) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.CancelRequestID, rhs.CancelRequestID) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.TimerTaskStatus, rhs.TimerTaskStatus) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_String_EqualsPtr(v.TaskList, rhs.TaskList) {
+		return false
+	}
+	if !_String_EqualsPtr(v.StartedIdentity, rhs.StartedIdentity) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.HasRetryPolicy, rhs.HasRetryPolicy) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.RetryInitialIntervalSeconds, rhs.RetryInitialIntervalSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.RetryMaximumIntervalSeconds, rhs.RetryMaximumIntervalSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.RetryMaximumAttempts, rhs.RetryMaximumAttempts) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.RetryExpirationTimeNanos, rhs.RetryExpirationTimeNanos) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.RetryBackoffCoefficient, rhs.RetryBackoffCoefficient) {
+		return false
+	}
+	if !((v.RetryNonRetryableErrors == nil && rhs.RetryNonRetryableErrors == nil) || (v.RetryNonRetryableErrors != nil && rhs.RetryNonRetryableErrors != nil && _List_String_Equals(v.RetryNonRetryableErrors, rhs.RetryNonRetryableErrors))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RetryLastFailureReason, rhs.RetryLastFailureReason) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RetryLastWorkerIdentity, rhs.RetryLastWorkerIdentity) {
+		return false
+	}
+	if !((v.RetryLastFailureDetails == nil && rhs.RetryLastFailureDetails == nil) || (v.RetryLastFailureDetails != nil && rhs.RetryLastFailureDetails != nil && bytes.Equal(v.RetryLastFailureDetails, rhs.RetryLastFailureDetails))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RoutingKey, rhs.RoutingKey) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.VisibilityTimeoutSeconds, rhs.VisibilityTimeoutSeconds) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.PrefetchLeased, rhs.PrefetchLeased) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FallbackTaskList, rhs.FallbackTaskList) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ScheduleToStartTimeouts, rhs.ScheduleToStartTimeouts) {
+		return false
+	}
+	if !_String_EqualsPtr(v.EncryptionKeyID, rhs.EncryptionKeyID) {
+		return false
+	}
+	if !((v.NextActivity == nil && rhs.NextActivity == nil) || (v.NextActivity != nil && rhs.NextActivity != nil && bytes.Equal(v.NextActivity, rhs.NextActivity))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.NextActivityEncoding, rhs.NextActivityEncoding) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.AtMostOnce, rhs.AtMostOnce) {
+		return false
+	}
+	if !_String_EqualsPtr(v.DependsOnActivityID, rhs.DependsOnActivityID) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.OnDependencyFailure, rhs.OnDependencyFailure) {
+		return false
+	}
+	if !((v.TaskListEscalation == nil && rhs.TaskListEscalation == nil) || (v.TaskListEscalation != nil && rhs.TaskListEscalation != nil && _List_String_Equals(v.TaskListEscalation, rhs.TaskListEscalation))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.FirstAttemptStartToCloseTimeoutSeconds, rhs.FirstAttemptStartToCloseTimeoutSeconds) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.MaxHeartbeatGapNanos, rhs.MaxHeartbeatGapNanos) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.GrantedStartToCloseTimeoutSeconds, rhs.GrantedStartToCloseTimeoutSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaxTotalExecutionSeconds, rhs.MaxTotalExecutionSeconds) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TotalExecutionTimeNanos, rhs.TotalExecutionTimeNanos) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.HeartbeatSequence, rhs.HeartbeatSequence) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MinimumIntervalSeconds, rhs.MinimumIntervalSeconds) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.CancelRequestedTimeNanos, rhs.CancelRequestedTimeNanos) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.CancelAckTimeoutSeconds, rhs.CancelAckTimeoutSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.CancelForceTimeoutSeconds, rhs.CancelForceTimeoutSeconds) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.CancelAckTimeoutExceededTimeNanos, rhs.CancelAckTimeoutExceededTimeNanos) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.MaintenancePausedTimeNanos, rhs.MaintenancePausedTimeNanos) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaintenanceTimeoutsDeferred, rhs.MaintenanceTimeoutsDeferred) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.NackCount, rhs.NackCount) {
+		return false
+	}
+	if !_String_EqualsPtr(v.LastNackReason, rhs.LastNackReason) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.CancellationCheckpointGraceSeconds, rhs.CancellationCheckpointGraceSeconds) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.CancelDeliveredTimeNanos, rhs.CancelDeliveredTimeNanos) {
+		return false
+	}
+	if !_String_EqualsPtr(v.AlertOnFailure, rhs.AlertOnFailure) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.StealTimeoutSeconds, rhs.StealTimeoutSeconds) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Idempotent, rhs.Idempotent) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ProgressPercent, rhs.ProgressPercent) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.StalledHeartbeats, rhs.StalledHeartbeats) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.HeartbeatExpiredTimeNanos, rhs.HeartbeatExpiredTimeNanos) {
+		return false
+	}
+	if !((v.PendingSignals == nil && rhs.PendingSignals == nil) || (v.PendingSignals != nil && rhs.PendingSignals != nil && bytes.Equal(v.PendingSignals, rhs.PendingSignals))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.PendingSignalsEncoding, rhs.PendingSignalsEncoding) {
+		return false
+	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && _Map_String_Binary_Equals(v.SearchAttributes, rhs.SearchAttributes))) {
+		return false
+	}
+	if !((v.RescheduleReasons == nil && rhs.RescheduleReasons == nil) || (v.RescheduleReasons != nil && rhs.RescheduleReasons != nil && bytes.Equal(v.RescheduleReasons, rhs.RescheduleReasons))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RescheduleReasonsEncoding, rhs.RescheduleReasonsEncoding) {
+		return false
+	}
+	if !((v.ResultSearchAttributes == nil && rhs.ResultSearchAttributes == nil) || (v.ResultSearchAttributes != nil && rhs.ResultSearchAttributes != nil && bytes.Equal(v.ResultSearchAttributes, rhs.ResultSearchAttributes))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ResultSearchAttributesEncoding, rhs.ResultSearchAttributesEncoding) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
+
+	return true
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+type _Map_String_Binary_Zapper map[string][]byte
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Binary_Zapper.
+func (m _Map_String_Binary_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddString((string)(k), base64.StdEncoding.EncodeToString(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ActivityInfo.
+func (v *ActivityInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	if v.ScheduledEventBatchID != nil {
+		enc.AddInt64("scheduledEventBatchID", *v.ScheduledEventBatchID)
+	}
+	if v.ScheduledEvent != nil {
+		enc.AddString("scheduledEvent", base64.StdEncoding.EncodeToString(v.ScheduledEvent))
+	}
+	if v.ScheduledEventEncoding != nil {
+		enc.AddString("scheduledEventEncoding", *v.ScheduledEventEncoding)
+	}
+	if v.ScheduledTimeNanos != nil {
+		enc.AddInt64("scheduledTimeNanos", *v.ScheduledTimeNanos)
 	}
-
 	if v.StartedID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.StartedID)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+		enc.AddInt64("startedID", *v.StartedID)
 	}
-
 	if v.StartedEvent != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 22, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteBinary(v.StartedEvent); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+		enc.AddString("startedEvent", base64.StdEncoding.EncodeToString(v.StartedEvent))
 	}
-
 	if v.StartedEventEncoding != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 24, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.StartedEventEncoding)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+		enc.AddString("startedEventEncoding", *v.StartedEventEncoding)
 	}
-
 	if v.StartedTimeNanos != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 26, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.StartedTimeNanos)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+		enc.AddInt64("startedTimeNanos", *v.StartedTimeNanos)
 	}
-
 	if v.ActivityID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 28, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.ActivityID)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+		enc.AddString("activityID", *v.ActivityID)
 	}
-
 	if v.RequestID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.RequestID)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+		enc.AddString("requestID", *v.RequestID)
 	}
-
 	if v.ScheduleToStartTimeoutSeconds != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 32, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.ScheduleToStartTimeoutSeconds)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+		enc.AddInt32("scheduleToStartTimeoutSeconds", *v.ScheduleToStartTimeoutSeconds)
 	}
-
 	if v.ScheduleToCloseTimeoutSeconds != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 34, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.ScheduleToCloseTimeoutSeconds)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+		enc.AddInt32("scheduleToCloseTimeoutSeconds", *v.ScheduleToCloseTimeoutSeconds)
 	}
-
 	if v.StartToCloseTimeoutSeconds != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 36, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.StartToCloseTimeoutSeconds)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+		enc.AddInt32("startToCloseTimeoutSeconds", *v.StartToCloseTimeoutSeconds)
 	}
-
 	if v.HeartbeatTimeoutSeconds != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 38, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.HeartbeatTimeoutSeconds)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+		enc.AddInt32("heartbeatTimeoutSeconds", *v.HeartbeatTimeoutSeconds)
 	}
-
 	if v.CancelRequested != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBool}); err != nil {
-			return err
-		}
-		if err := sw.WriteBool(*(v.CancelRequested)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+		enc.AddBool("cancelRequested", *v.CancelRequested)
 	}
-
 	if v.CancelRequestID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 42, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.CancelRequestID)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+		enc.AddInt64("cancelRequestID", *v.CancelRequestID)
 	}
-
 	if v.TimerTaskStatus != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 44, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.TimerTaskStatus)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+		enc.AddInt32("timerTaskStatus", *v.TimerTaskStatus)
 	}
-
 	if v.Attempt != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 46, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.Attempt)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+		enc.AddInt32("attempt", *v.Attempt)
 	}
-
 	if v.TaskList != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 48, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.TaskList)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+		enc.AddString("taskList", *v.TaskList)
 	}
-
 	if v.StartedIdentity != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.StartedIdentity)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+		enc.AddString("startedIdentity", *v.StartedIdentity)
 	}
-
 	if v.HasRetryPolicy != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 52, Type: wire.TBool}); err != nil {
-			return err
-		}
-		if err := sw.WriteBool(*(v.HasRetryPolicy)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+		enc.AddBool("hasRetryPolicy", *v.HasRetryPolicy)
+	}
+	if v.RetryInitialIntervalSeconds != nil {
+		enc.AddInt32("retryInitialIntervalSeconds", *v.RetryInitialIntervalSeconds)
+	}
+	if v.RetryMaximumIntervalSeconds != nil {
+		enc.AddInt32("retryMaximumIntervalSeconds", *v.RetryMaximumIntervalSeconds)
+	}
+	if v.RetryMaximumAttempts != nil {
+		enc.AddInt32("retryMaximumAttempts", *v.RetryMaximumAttempts)
+	}
+	if v.RetryExpirationTimeNanos != nil {
+		enc.AddInt64("retryExpirationTimeNanos", *v.RetryExpirationTimeNanos)
+	}
+	if v.RetryBackoffCoefficient != nil {
+		enc.AddFloat64("retryBackoffCoefficient", *v.RetryBackoffCoefficient)
+	}
+	if v.RetryNonRetryableErrors != nil {
+		err = multierr.Append(err, enc.AddArray("retryNonRetryableErrors", (_List_String_Zapper)(v.RetryNonRetryableErrors)))
+	}
+	if v.RetryLastFailureReason != nil {
+		enc.AddString("retryLastFailureReason", *v.RetryLastFailureReason)
+	}
+	if v.RetryLastWorkerIdentity != nil {
+		enc.AddString("retryLastWorkerIdentity", *v.RetryLastWorkerIdentity)
+	}
+	if v.RetryLastFailureDetails != nil {
+		enc.AddString("retryLastFailureDetails", base64.StdEncoding.EncodeToString(v.RetryLastFailureDetails))
+	}
+	if v.RoutingKey != nil {
+		enc.AddString("routingKey", *v.RoutingKey)
+	}
+	if v.VisibilityTimeoutSeconds != nil {
+		enc.AddInt32("visibilityTimeoutSeconds", *v.VisibilityTimeoutSeconds)
+	}
+	if v.PrefetchLeased != nil {
+		enc.AddBool("prefetchLeased", *v.PrefetchLeased)
+	}
+	if v.FallbackTaskList != nil {
+		enc.AddString("fallbackTaskList", *v.FallbackTaskList)
+	}
+	if v.ScheduleToStartTimeouts != nil {
+		enc.AddInt32("scheduleToStartTimeouts", *v.ScheduleToStartTimeouts)
+	}
+	if v.EncryptionKeyID != nil {
+		enc.AddString("encryptionKeyID", *v.EncryptionKeyID)
+	}
+	if v.NextActivity != nil {
+		enc.AddString("nextActivity", base64.StdEncoding.EncodeToString(v.NextActivity))
+	}
+	if v.NextActivityEncoding != nil {
+		enc.AddString("nextActivityEncoding", *v.NextActivityEncoding)
+	}
+	if v.AtMostOnce != nil {
+		enc.AddBool("atMostOnce", *v.AtMostOnce)
+	}
+	if v.DependsOnActivityID != nil {
+		enc.AddString("dependsOnActivityID", *v.DependsOnActivityID)
+	}
+	if v.OnDependencyFailure != nil {
+		enc.AddInt32("onDependencyFailure", *v.OnDependencyFailure)
+	}
+	if v.TaskListEscalation != nil {
+		err = multierr.Append(err, enc.AddArray("taskListEscalation", (_List_String_Zapper)(v.TaskListEscalation)))
+	}
+	if v.FirstAttemptStartToCloseTimeoutSeconds != nil {
+		enc.AddInt32("firstAttemptStartToCloseTimeoutSeconds", *v.FirstAttemptStartToCloseTimeoutSeconds)
+	}
+	if v.MaxHeartbeatGapNanos != nil {
+		enc.AddInt64("maxHeartbeatGapNanos", *v.MaxHeartbeatGapNanos)
+	}
+	if v.GrantedStartToCloseTimeoutSeconds != nil {
+		enc.AddInt32("grantedStartToCloseTimeoutSeconds", *v.GrantedStartToCloseTimeoutSeconds)
+	}
+	if v.MaxTotalExecutionSeconds != nil {
+		enc.AddInt32("maxTotalExecutionSeconds", *v.MaxTotalExecutionSeconds)
+	}
+	if v.TotalExecutionTimeNanos != nil {
+		enc.AddInt64("totalExecutionTimeNanos", *v.TotalExecutionTimeNanos)
+	}
+	if v.HeartbeatSequence != nil {
+		enc.AddInt64("heartbeatSequence", *v.HeartbeatSequence)
+	}
+	if v.MinimumIntervalSeconds != nil {
+		enc.AddInt32("minimumIntervalSeconds", *v.MinimumIntervalSeconds)
+	}
+	if v.CancelRequestedTimeNanos != nil {
+		enc.AddInt64("cancelRequestedTimeNanos", *v.CancelRequestedTimeNanos)
+	}
+	if v.CancelAckTimeoutSeconds != nil {
+		enc.AddInt32("cancelAckTimeoutSeconds", *v.CancelAckTimeoutSeconds)
+	}
+	if v.CancelForceTimeoutSeconds != nil {
+		enc.AddInt32("cancelForceTimeoutSeconds", *v.CancelForceTimeoutSeconds)
+	}
+	if v.CancelAckTimeoutExceededTimeNanos != nil {
+		enc.AddInt64("cancelAckTimeoutExceededTimeNanos", *v.CancelAckTimeoutExceededTimeNanos)
+	}
+	if v.MaintenancePausedTimeNanos != nil {
+		enc.AddInt64("maintenancePausedTimeNanos", *v.MaintenancePausedTimeNanos)
+	}
+	if v.MaintenanceTimeoutsDeferred != nil {
+		enc.AddInt32("maintenanceTimeoutsDeferred", *v.MaintenanceTimeoutsDeferred)
+	}
+	if v.NackCount != nil {
+		enc.AddInt32("nackCount", *v.NackCount)
+	}
+	if v.LastNackReason != nil {
+		enc.AddString("lastNackReason", *v.LastNackReason)
+	}
+	if v.CancellationCheckpointGraceSeconds != nil {
+		enc.AddInt32("cancellationCheckpointGraceSeconds", *v.CancellationCheckpointGraceSeconds)
+	}
+	if v.CancelDeliveredTimeNanos != nil {
+		enc.AddInt64("cancelDeliveredTimeNanos", *v.CancelDeliveredTimeNanos)
+	}
+	if v.AlertOnFailure != nil {
+		enc.AddString("alertOnFailure", *v.AlertOnFailure)
+	}
+	if v.StealTimeoutSeconds != nil {
+		enc.AddInt32("stealTimeoutSeconds", *v.StealTimeoutSeconds)
+	}
+	if v.Idempotent != nil {
+		enc.AddBool("idempotent", *v.Idempotent)
+	}
+	if v.ProgressPercent != nil {
+		enc.AddInt32("progressPercent", *v.ProgressPercent)
+	}
+	if v.StalledHeartbeats != nil {
+		enc.AddInt32("stalledHeartbeats", *v.StalledHeartbeats)
 	}
-
-	if v.RetryInitialIntervalSeconds != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 54, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.RetryInitialIntervalSeconds)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if v.HeartbeatExpiredTimeNanos != nil {
+		enc.AddInt64("heartbeatExpiredTimeNanos", *v.HeartbeatExpiredTimeNanos)
 	}
-
-	if v.RetryMaximumIntervalSeconds != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 56, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.RetryMaximumIntervalSeconds)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if v.PendingSignals != nil {
+		enc.AddString("pendingSignals", base64.StdEncoding.EncodeToString(v.PendingSignals))
 	}
-
-	if v.RetryMaximumAttempts != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 58, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.RetryMaximumAttempts)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if v.PendingSignalsEncoding != nil {
+		enc.AddString("pendingSignalsEncoding", *v.PendingSignalsEncoding)
 	}
-
-	if v.RetryExpirationTimeNanos != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.RetryExpirationTimeNanos)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if v.SearchAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("searchAttributes", (_Map_String_Binary_Zapper)(v.SearchAttributes)))
 	}
-
-	if v.RetryBackoffCoefficient != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 62, Type: wire.TDouble}); err != nil {
-			return err
-		}
-		if err := sw.WriteDouble(*(v.RetryBackoffCoefficient)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if v.RescheduleReasons != nil {
+		enc.AddString("rescheduleReasons", base64.StdEncoding.EncodeToString(v.RescheduleReasons))
 	}
-
-	if v.RetryNonRetryableErrors != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 64, Type: wire.TList}); err != nil {
-			return err
-		}
-		if err := _List_String_Encode(v.RetryNonRetryableErrors, sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if v.RescheduleReasonsEncoding != nil {
+		enc.AddString("rescheduleReasonsEncoding", *v.RescheduleReasonsEncoding)
 	}
-
-	if v.RetryLastFailureReason != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 66, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.RetryLastFailureReason)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if v.ResultSearchAttributes != nil {
+		enc.AddString("resultSearchAttributes", base64.StdEncoding.EncodeToString(v.ResultSearchAttributes))
 	}
-
-	if v.RetryLastWorkerIdentity != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 68, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.RetryLastWorkerIdentity)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if v.ResultSearchAttributesEncoding != nil {
+		enc.AddString("resultSearchAttributesEncoding", *v.ResultSearchAttributesEncoding)
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
+	return err
+}
 
-	if v.RetryLastFailureDetails != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteBinary(v.RetryLastFailureDetails); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
 	}
 
-	return sw.WriteStructEnd()
+	return
 }
 
-func _List_String_Decode(sr stream.Reader) ([]string, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
-	}
+// IsSetVersion returns true if Version is not nil.
+func (v *ActivityInfo) IsSetVersion() bool {
+	return v != nil && v.Version != nil
+}
 
-	if lh.Type != wire.TBinary {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadListEnd()
+// GetScheduledEventBatchID returns the value of ScheduledEventBatchID if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetScheduledEventBatchID() (o int64) {
+	if v != nil && v.ScheduledEventBatchID != nil {
+		return *v.ScheduledEventBatchID
 	}
 
-	o := make([]string, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := sr.ReadString()
-		if err != nil {
-			return nil, err
-		}
-		o = append(o, v)
-	}
+	return
+}
 
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
+// IsSetScheduledEventBatchID returns true if ScheduledEventBatchID is not nil.
+func (v *ActivityInfo) IsSetScheduledEventBatchID() bool {
+	return v != nil && v.ScheduledEventBatchID != nil
+}
+
+// GetScheduledEvent returns the value of ScheduledEvent if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetScheduledEvent() (o []byte) {
+	if v != nil && v.ScheduledEvent != nil {
+		return v.ScheduledEvent
 	}
-	return o, err
+
+	return
 }
 
-// Decode deserializes a ActivityInfo struct directly from its Thrift-level
-// representation, without going through an intemediary type.
-//
-// An error is returned if a ActivityInfo struct could not be generated from the wire
-// representation.
-func (v *ActivityInfo) Decode(sr stream.Reader) error {
+// IsSetScheduledEvent returns true if ScheduledEvent is not nil.
+func (v *ActivityInfo) IsSetScheduledEvent() bool {
+	return v != nil && v.ScheduledEvent != nil
+}
 
-	if err := sr.ReadStructBegin(); err != nil {
-		return err
+// GetScheduledEventEncoding returns the value of ScheduledEventEncoding if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetScheduledEventEncoding() (o string) {
+	if v != nil && v.ScheduledEventEncoding != nil {
+		return *v.ScheduledEventEncoding
 	}
 
-	fh, ok, err := sr.ReadFieldBegin()
-	if err != nil {
-		return err
+	return
+}
+
+// IsSetScheduledEventEncoding returns true if ScheduledEventEncoding is not nil.
+func (v *ActivityInfo) IsSetScheduledEventEncoding() bool {
+	return v != nil && v.ScheduledEventEncoding != nil
+}
+
+// GetScheduledTimeNanos returns the value of ScheduledTimeNanos if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetScheduledTimeNanos() (o int64) {
+	if v != nil && v.ScheduledTimeNanos != nil {
+		return *v.ScheduledTimeNanos
 	}
 
-	for ok {
-		switch {
-		case fh.ID == 10 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.Version = &x
-			if err != nil {
-				return err
-			}
+	return
+}
 
-		case fh.ID == 12 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.ScheduledEventBatchID = &x
-			if err != nil {
-				return err
-			}
+// IsSetScheduledTimeNanos returns true if ScheduledTimeNanos is not nil.
+func (v *ActivityInfo) IsSetScheduledTimeNanos() bool {
+	return v != nil && v.ScheduledTimeNanos != nil
+}
 
-		case fh.ID == 14 && fh.Type == wire.TBinary:
-			v.ScheduledEvent, err = sr.ReadBinary()
-			if err != nil {
-				return err
-			}
+// GetStartedID returns the value of StartedID if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetStartedID() (o int64) {
+	if v != nil && v.StartedID != nil {
+		return *v.StartedID
+	}
 
-		case fh.ID == 16 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.ScheduledEventEncoding = &x
-			if err != nil {
-				return err
-			}
+	return
+}
 
-		case fh.ID == 18 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.ScheduledTimeNanos = &x
-			if err != nil {
-				return err
-			}
+// IsSetStartedID returns true if StartedID is not nil.
+func (v *ActivityInfo) IsSetStartedID() bool {
+	return v != nil && v.StartedID != nil
+}
 
-		case fh.ID == 20 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.StartedID = &x
-			if err != nil {
-				return err
-			}
+// GetStartedEvent returns the value of StartedEvent if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetStartedEvent() (o []byte) {
+	if v != nil && v.StartedEvent != nil {
+		return v.StartedEvent
+	}
+
+	return
+}
 
-		case fh.ID == 22 && fh.Type == wire.TBinary:
-			v.StartedEvent, err = sr.ReadBinary()
-			if err != nil {
-				return err
-			}
+// IsSetStartedEvent returns true if StartedEvent is not nil.
+func (v *ActivityInfo) IsSetStartedEvent() bool {
+	return v != nil && v.StartedEvent != nil
+}
 
-		case fh.ID == 24 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.StartedEventEncoding = &x
-			if err != nil {
-				return err
-			}
+// GetStartedEventEncoding returns the value of StartedEventEncoding if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetStartedEventEncoding() (o string) {
+	if v != nil && v.StartedEventEncoding != nil {
+		return *v.StartedEventEncoding
+	}
 
-		case fh.ID == 26 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.StartedTimeNanos = &x
-			if err != nil {
-				return err
-			}
+	return
+}
 
-		case fh.ID == 28 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.ActivityID = &x
-			if err != nil {
-				return err
-			}
+// IsSetStartedEventEncoding returns true if StartedEventEncoding is not nil.
+func (v *ActivityInfo) IsSetStartedEventEncoding() bool {
+	return v != nil && v.StartedEventEncoding != nil
+}
 
-		case fh.ID == 30 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.RequestID = &x
-			if err != nil {
-				return err
-			}
+// GetStartedTimeNanos returns the value of StartedTimeNanos if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetStartedTimeNanos() (o int64) {
+	if v != nil && v.StartedTimeNanos != nil {
+		return *v.StartedTimeNanos
+	}
 
-		case fh.ID == 32 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.ScheduleToStartTimeoutSeconds = &x
-			if err != nil {
-				return err
-			}
+	return
+}
 
-		case fh.ID == 34 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.ScheduleToCloseTimeoutSeconds = &x
-			if err != nil {
-				return err
-			}
+// IsSetStartedTimeNanos returns true if StartedTimeNanos is not nil.
+func (v *ActivityInfo) IsSetStartedTimeNanos() bool {
+	return v != nil && v.StartedTimeNanos != nil
+}
 
-		case fh.ID == 36 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.StartToCloseTimeoutSeconds = &x
-			if err != nil {
-				return err
-			}
+// GetActivityID returns the value of ActivityID if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetActivityID() (o string) {
+	if v != nil && v.ActivityID != nil {
+		return *v.ActivityID
+	}
 
-		case fh.ID == 38 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.HeartbeatTimeoutSeconds = &x
-			if err != nil {
-				return err
-			}
+	return
+}
 
-		case fh.ID == 40 && fh.Type == wire.TBool:
-			var x bool
-			x, err = sr.ReadBool()
-			v.CancelRequested = &x
-			if err != nil {
-				return err
-			}
+// IsSetActivityID returns true if ActivityID is not nil.
+func (v *ActivityInfo) IsSetActivityID() bool {
+	return v != nil && v.ActivityID != nil
+}
 
-		case fh.ID == 42 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.CancelRequestID = &x
-			if err != nil {
-				return err
-			}
+// GetRequestID returns the value of RequestID if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetRequestID() (o string) {
+	if v != nil && v.RequestID != nil {
+		return *v.RequestID
+	}
 
-		case fh.ID == 44 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.TimerTaskStatus = &x
-			if err != nil {
-				return err
-			}
+	return
+}
 
-		case fh.ID == 46 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.Attempt = &x
-			if err != nil {
-				return err
-			}
+// IsSetRequestID returns true if RequestID is not nil.
+func (v *ActivityInfo) IsSetRequestID() bool {
+	return v != nil && v.RequestID != nil
+}
 
-		case fh.ID == 48 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.TaskList = &x
-			if err != nil {
-				return err
-			}
+// GetScheduleToStartTimeoutSeconds returns the value of ScheduleToStartTimeoutSeconds if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetScheduleToStartTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToStartTimeoutSeconds != nil {
+		return *v.ScheduleToStartTimeoutSeconds
+	}
 
-		case fh.ID == 50 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.StartedIdentity = &x
-			if err != nil {
-				return err
-			}
+	return
+}
 
-		case fh.ID == 52 && fh.Type == wire.TBool:
-			var x bool
-			x, err = sr.ReadBool()
-			v.HasRetryPolicy = &x
-			if err != nil {
-				return err
-			}
+// IsSetScheduleToStartTimeoutSeconds returns true if ScheduleToStartTimeoutSeconds is not nil.
+func (v *ActivityInfo) IsSetScheduleToStartTimeoutSeconds() bool {
+	return v != nil && v.ScheduleToStartTimeoutSeconds != nil
+}
 
-		case fh.ID == 54 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.RetryInitialIntervalSeconds = &x
-			if err != nil {
-				return err
-			}
+// GetScheduleToCloseTimeoutSeconds returns the value of ScheduleToCloseTimeoutSeconds if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetScheduleToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToCloseTimeoutSeconds != nil {
+		return *v.ScheduleToCloseTimeoutSeconds
+	}
 
-		case fh.ID == 56 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.RetryMaximumIntervalSeconds = &x
-			if err != nil {
-				return err
-			}
+	return
+}
 
-		case fh.ID == 58 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.RetryMaximumAttempts = &x
-			if err != nil {
-				return err
-			}
+// IsSetScheduleToCloseTimeoutSeconds returns true if ScheduleToCloseTimeoutSeconds is not nil.
+func (v *ActivityInfo) IsSetScheduleToCloseTimeoutSeconds() bool {
+	return v != nil && v.ScheduleToCloseTimeoutSeconds != nil
+}
 
-		case fh.ID == 60 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.RetryExpirationTimeNanos = &x
-			if err != nil {
-				return err
-			}
+// GetStartToCloseTimeoutSeconds returns the value of StartToCloseTimeoutSeconds if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.StartToCloseTimeoutSeconds != nil {
+		return *v.StartToCloseTimeoutSeconds
+	}
 
-		case fh.ID == 62 && fh.Type == wire.TDouble:
-			var x float64
-			x, err = sr.ReadDouble()
-			v.RetryBackoffCoefficient = &x
-			if err != nil {
-				return err
-			}
+	return
+}
 
-		case fh.ID == 64 && fh.Type == wire.TList:
-			v.RetryNonRetryableErrors, err = _List_String_Decode(sr)
-			if err != nil {
-				return err
-			}
+// IsSetStartToCloseTimeoutSeconds returns true if StartToCloseTimeoutSeconds is not nil.
+func (v *ActivityInfo) IsSetStartToCloseTimeoutSeconds() bool {
+	return v != nil && v.StartToCloseTimeoutSeconds != nil
+}
 
-		case fh.ID == 66 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.RetryLastFailureReason = &x
-			if err != nil {
-				return err
-			}
+// GetHeartbeatTimeoutSeconds returns the value of HeartbeatTimeoutSeconds if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetHeartbeatTimeoutSeconds() (o int32) {
+	if v != nil && v.HeartbeatTimeoutSeconds != nil {
+		return *v.HeartbeatTimeoutSeconds
+	}
+
+	return
+}
 
-		case fh.ID == 68 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.RetryLastWorkerIdentity = &x
-			if err != nil {
-				return err
-			}
+// IsSetHeartbeatTimeoutSeconds returns true if HeartbeatTimeoutSeconds is not nil.
+func (v *ActivityInfo) IsSetHeartbeatTimeoutSeconds() bool {
+	return v != nil && v.HeartbeatTimeoutSeconds != nil
+}
 
-		case fh.ID == 70 && fh.Type == wire.TBinary:
-			v.RetryLastFailureDetails, err = sr.ReadBinary()
-			if err != nil {
-				return err
-			}
+// GetCancelRequested returns the value of CancelRequested if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetCancelRequested() (o bool) {
+	if v != nil && v.CancelRequested != nil {
+		return *v.CancelRequested
+	}
 
-		default:
-			if err := sr.Skip(fh.Type); err != nil {
-				return err
-			}
-		}
+	return
+}
 
-		if err := sr.ReadFieldEnd(); err != nil {
-			return err
-		}
+// IsSetCancelRequested returns true if CancelRequested is not nil.
+func (v *ActivityInfo) IsSetCancelRequested() bool {
+	return v != nil && v.CancelRequested != nil
+}
 
-		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
-			return err
-		}
+// GetCancelRequestID returns the value of CancelRequestID if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetCancelRequestID() (o int64) {
+	if v != nil && v.CancelRequestID != nil {
+		return *v.CancelRequestID
 	}
 
-	if err := sr.ReadStructEnd(); err != nil {
-		return err
-	}
+	return
+}
 
-	return nil
+// IsSetCancelRequestID returns true if CancelRequestID is not nil.
+func (v *ActivityInfo) IsSetCancelRequestID() bool {
+	return v != nil && v.CancelRequestID != nil
 }
 
-// String returns a readable string representation of a ActivityInfo
-// struct.
-func (v *ActivityInfo) String() string {
-	if v == nil {
-		return "<nil>"
+// GetTimerTaskStatus returns the value of TimerTaskStatus if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetTimerTaskStatus() (o int32) {
+	if v != nil && v.TimerTaskStatus != nil {
+		return *v.TimerTaskStatus
 	}
 
-	var fields [31]string
-	i := 0
-	if v.Version != nil {
-		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
-		i++
-	}
-	if v.ScheduledEventBatchID != nil {
-		fields[i] = fmt.Sprintf("ScheduledEventBatchID: %v", *(v.ScheduledEventBatchID))
-		i++
-	}
-	if v.ScheduledEvent != nil {
-		fields[i] = fmt.Sprintf("ScheduledEvent: %v", v.ScheduledEvent)
-		i++
-	}
-	if v.ScheduledEventEncoding != nil {
-		fields[i] = fmt.Sprintf("ScheduledEventEncoding: %v", *(v.ScheduledEventEncoding))
-		i++
-	}
-	if v.ScheduledTimeNanos != nil {
-		fields[i] = fmt.Sprintf("ScheduledTimeNanos: %v", *(v.ScheduledTimeNanos))
-		i++
-	}
-	if v.StartedID != nil {
-		fields[i] = fmt.Sprintf("StartedID: %v", *(v.StartedID))
-		i++
-	}
-	if v.StartedEvent != nil {
-		fields[i] = fmt.Sprintf("StartedEvent: %v", v.StartedEvent)
-		i++
-	}
-	if v.StartedEventEncoding != nil {
-		fields[i] = fmt.Sprintf("StartedEventEncoding: %v", *(v.StartedEventEncoding))
-		i++
-	}
-	if v.StartedTimeNanos != nil {
-		fields[i] = fmt.Sprintf("StartedTimeNanos: %v", *(v.StartedTimeNanos))
-		i++
-	}
-	if v.ActivityID != nil {
-		fields[i] = fmt.Sprintf("ActivityID: %v", *(v.ActivityID))
-		i++
-	}
-	if v.RequestID != nil {
-		fields[i] = fmt.Sprintf("RequestID: %v", *(v.RequestID))
-		i++
-	}
-	if v.ScheduleToStartTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("ScheduleToStartTimeoutSeconds: %v", *(v.ScheduleToStartTimeoutSeconds))
-		i++
-	}
-	if v.ScheduleToCloseTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("ScheduleToCloseTimeoutSeconds: %v", *(v.ScheduleToCloseTimeoutSeconds))
-		i++
-	}
-	if v.StartToCloseTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("StartToCloseTimeoutSeconds: %v", *(v.StartToCloseTimeoutSeconds))
-		i++
-	}
-	if v.HeartbeatTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("HeartbeatTimeoutSeconds: %v", *(v.HeartbeatTimeoutSeconds))
-		i++
-	}
-	if v.CancelRequested != nil {
-		fields[i] = fmt.Sprintf("CancelRequested: %v", *(v.CancelRequested))
-		i++
-	}
-	if v.CancelRequestID != nil {
-		fields[i] = fmt.Sprintf("CancelRequestID: %v", *(v.CancelRequestID))
-		i++
-	}
-	if v.TimerTaskStatus != nil {
-		fields[i] = fmt.Sprintf("TimerTaskStatus: %v", *(v.TimerTaskStatus))
-		i++
-	}
-	if v.Attempt != nil {
-		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
-		i++
-	}
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", *(v.TaskList))
-		i++
-	}
-	if v.StartedIdentity != nil {
-		fields[i] = fmt.Sprintf("StartedIdentity: %v", *(v.StartedIdentity))
-		i++
-	}
-	if v.HasRetryPolicy != nil {
-		fields[i] = fmt.Sprintf("HasRetryPolicy: %v", *(v.HasRetryPolicy))
-		i++
-	}
-	if v.RetryInitialIntervalSeconds != nil {
-		fields[i] = fmt.Sprintf("RetryInitialIntervalSeconds: %v", *(v.RetryInitialIntervalSeconds))
-		i++
-	}
-	if v.RetryMaximumIntervalSeconds != nil {
-		fields[i] = fmt.Sprintf("RetryMaximumIntervalSeconds: %v", *(v.RetryMaximumIntervalSeconds))
-		i++
-	}
-	if v.RetryMaximumAttempts != nil {
-		fields[i] = fmt.Sprintf("RetryMaximumAttempts: %v", *(v.RetryMaximumAttempts))
-		i++
-	}
-	if v.RetryExpirationTimeNanos != nil {
-		fields[i] = fmt.Sprintf("RetryExpirationTimeNanos: %v", *(v.RetryExpirationTimeNanos))
-		i++
-	}
-	if v.RetryBackoffCoefficient != nil {
-		fields[i] = fmt.Sprintf("RetryBackoffCoefficient: %v", *(v.RetryBackoffCoefficient))
-		i++
-	}
-	if v.RetryNonRetryableErrors != nil {
-		fields[i] = fmt.Sprintf("RetryNonRetryableErrors: %v", v.RetryNonRetryableErrors)
-		i++
-	}
-	if v.RetryLastFailureReason != nil {
-		fields[i] = fmt.Sprintf("RetryLastFailureReason: %v", *(v.RetryLastFailureReason))
-		i++
+	return
+}
+
+// IsSetTimerTaskStatus returns true if TimerTaskStatus is not nil.
+func (v *ActivityInfo) IsSetTimerTaskStatus() bool {
+	return v != nil && v.TimerTaskStatus != nil
+}
+
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetAttempt() (o int32) {
+	if v != nil && v.Attempt != nil {
+		return *v.Attempt
 	}
-	if v.RetryLastWorkerIdentity != nil {
-		fields[i] = fmt.Sprintf("RetryLastWorkerIdentity: %v", *(v.RetryLastWorkerIdentity))
-		i++
+
+	return
+}
+
+// IsSetAttempt returns true if Attempt is not nil.
+func (v *ActivityInfo) IsSetAttempt() bool {
+	return v != nil && v.Attempt != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetTaskList() (o string) {
+	if v != nil && v.TaskList != nil {
+		return *v.TaskList
 	}
-	if v.RetryLastFailureDetails != nil {
-		fields[i] = fmt.Sprintf("RetryLastFailureDetails: %v", v.RetryLastFailureDetails)
-		i++
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *ActivityInfo) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetStartedIdentity returns the value of StartedIdentity if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetStartedIdentity() (o string) {
+	if v != nil && v.StartedIdentity != nil {
+		return *v.StartedIdentity
 	}
 
-	return fmt.Sprintf("ActivityInfo{%v}", strings.Join(fields[:i], ", "))
+	return
 }
 
-func _I64_EqualsPtr(lhs, rhs *int64) bool {
-	if lhs != nil && rhs != nil {
+// IsSetStartedIdentity returns true if StartedIdentity is not nil.
+func (v *ActivityInfo) IsSetStartedIdentity() bool {
+	return v != nil && v.StartedIdentity != nil
+}
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
+// GetHasRetryPolicy returns the value of HasRetryPolicy if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetHasRetryPolicy() (o bool) {
+	if v != nil && v.HasRetryPolicy != nil {
+		return *v.HasRetryPolicy
 	}
-	return lhs == nil && rhs == nil
+
+	return
 }
 
-func _String_EqualsPtr(lhs, rhs *string) bool {
-	if lhs != nil && rhs != nil {
+// IsSetHasRetryPolicy returns true if HasRetryPolicy is not nil.
+func (v *ActivityInfo) IsSetHasRetryPolicy() bool {
+	return v != nil && v.HasRetryPolicy != nil
+}
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
+// GetRetryInitialIntervalSeconds returns the value of RetryInitialIntervalSeconds if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetRetryInitialIntervalSeconds() (o int32) {
+	if v != nil && v.RetryInitialIntervalSeconds != nil {
+		return *v.RetryInitialIntervalSeconds
 	}
-	return lhs == nil && rhs == nil
+
+	return
 }
 
-func _I32_EqualsPtr(lhs, rhs *int32) bool {
-	if lhs != nil && rhs != nil {
+// IsSetRetryInitialIntervalSeconds returns true if RetryInitialIntervalSeconds is not nil.
+func (v *ActivityInfo) IsSetRetryInitialIntervalSeconds() bool {
+	return v != nil && v.RetryInitialIntervalSeconds != nil
+}
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
+// GetRetryMaximumIntervalSeconds returns the value of RetryMaximumIntervalSeconds if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetRetryMaximumIntervalSeconds() (o int32) {
+	if v != nil && v.RetryMaximumIntervalSeconds != nil {
+		return *v.RetryMaximumIntervalSeconds
 	}
-	return lhs == nil && rhs == nil
+
+	return
 }
 
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
+// IsSetRetryMaximumIntervalSeconds returns true if RetryMaximumIntervalSeconds is not nil.
+func (v *ActivityInfo) IsSetRetryMaximumIntervalSeconds() bool {
+	return v != nil && v.RetryMaximumIntervalSeconds != nil
+}
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
+// GetRetryMaximumAttempts returns the value of RetryMaximumAttempts if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetRetryMaximumAttempts() (o int32) {
+	if v != nil && v.RetryMaximumAttempts != nil {
+		return *v.RetryMaximumAttempts
 	}
-	return lhs == nil && rhs == nil
-}
 
-func _Double_EqualsPtr(lhs, rhs *float64) bool {
-	if lhs != nil && rhs != nil {
+	return
+}
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
+// IsSetRetryMaximumAttempts returns true if RetryMaximumAttempts is not nil.
+func (v *ActivityInfo) IsSetRetryMaximumAttempts() bool {
+	return v != nil && v.RetryMaximumAttempts != nil
 }
 
-func _List_String_Equals(lhs, rhs []string) bool {
-	if len(lhs) != len(rhs) {
-		return false
+// GetRetryExpirationTimeNanos returns the value of RetryExpirationTimeNanos if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetRetryExpirationTimeNanos() (o int64) {
+	if v != nil && v.RetryExpirationTimeNanos != nil {
+		return *v.RetryExpirationTimeNanos
 	}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !(lv == rv) {
-			return false
-		}
-	}
+	return
+}
 
-	return true
+// IsSetRetryExpirationTimeNanos returns true if RetryExpirationTimeNanos is not nil.
+func (v *ActivityInfo) IsSetRetryExpirationTimeNanos() bool {
+	return v != nil && v.RetryExpirationTimeNanos != nil
 }
 
-// Equals returns true if all the fields of this ActivityInfo match the
-// provided ActivityInfo.
-//
-// This function performs a deep comparison.
-func (v *ActivityInfo) Equals(rhs *ActivityInfo) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !_I64_EqualsPtr(v.Version, rhs.Version) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ScheduledEventBatchID, rhs.ScheduledEventBatchID) {
-		return false
-	}
-	if !((v.ScheduledEvent == nil && rhs.ScheduledEvent == nil) || (v.ScheduledEvent != nil && rhs.ScheduledEvent != nil && bytes.Equal(v.ScheduledEvent, rhs.ScheduledEvent))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.ScheduledEventEncoding, rhs.ScheduledEventEncoding) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ScheduledTimeNanos, rhs.ScheduledTimeNanos) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartedID, rhs.StartedID) {
-		return false
-	}
-	if !((v.StartedEvent == nil && rhs.StartedEvent == nil) || (v.StartedEvent != nil && rhs.StartedEvent != nil && bytes.Equal(v.StartedEvent, rhs.StartedEvent))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.StartedEventEncoding, rhs.StartedEventEncoding) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartedTimeNanos, rhs.StartedTimeNanos) {
-		return false
-	}
-	if !_String_EqualsPtr(v.ActivityID, rhs.ActivityID) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RequestID, rhs.RequestID) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.ScheduleToStartTimeoutSeconds, rhs.ScheduleToStartTimeoutSeconds) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.ScheduleToCloseTimeoutSeconds, rhs.ScheduleToCloseTimeoutSeconds) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.StartToCloseTimeoutSeconds, rhs.StartToCloseTimeoutSeconds) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.HeartbeatTimeoutSeconds, rhs.HeartbeatTimeoutSeconds) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.CancelRequested, rhs.CancelRequested) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.CancelRequestID, rhs.CancelRequestID) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.TimerTaskStatus, rhs.TimerTaskStatus) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
-		return false
-	}
-	if !_String_EqualsPtr(v.TaskList, rhs.TaskList) {
-		return false
-	}
-	if !_String_EqualsPtr(v.StartedIdentity, rhs.StartedIdentity) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.HasRetryPolicy, rhs.HasRetryPolicy) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.RetryInitialIntervalSeconds, rhs.RetryInitialIntervalSeconds) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.RetryMaximumIntervalSeconds, rhs.RetryMaximumIntervalSeconds) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.RetryMaximumAttempts, rhs.RetryMaximumAttempts) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.RetryExpirationTimeNanos, rhs.RetryExpirationTimeNanos) {
-		return false
-	}
-	if !_Double_EqualsPtr(v.RetryBackoffCoefficient, rhs.RetryBackoffCoefficient) {
-		return false
-	}
-	if !((v.RetryNonRetryableErrors == nil && rhs.RetryNonRetryableErrors == nil) || (v.RetryNonRetryableErrors != nil && rhs.RetryNonRetryableErrors != nil && _List_String_Equals(v.RetryNonRetryableErrors, rhs.RetryNonRetryableErrors))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RetryLastFailureReason, rhs.RetryLastFailureReason) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RetryLastWorkerIdentity, rhs.RetryLastWorkerIdentity) {
-		return false
-	}
-	if !((v.RetryLastFailureDetails == nil && rhs.RetryLastFailureDetails == nil) || (v.RetryLastFailureDetails != nil && rhs.RetryLastFailureDetails != nil && bytes.Equal(v.RetryLastFailureDetails, rhs.RetryLastFailureDetails))) {
-		return false
+// GetRetryBackoffCoefficient returns the value of RetryBackoffCoefficient if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetRetryBackoffCoefficient() (o float64) {
+	if v != nil && v.RetryBackoffCoefficient != nil {
+		return *v.RetryBackoffCoefficient
 	}
 
-	return true
+	return
 }
 
-type _List_String_Zapper []string
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_String_Zapper.
-func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		enc.AppendString(v)
-	}
-	return err
+// IsSetRetryBackoffCoefficient returns true if RetryBackoffCoefficient is not nil.
+func (v *ActivityInfo) IsSetRetryBackoffCoefficient() bool {
+	return v != nil && v.RetryBackoffCoefficient != nil
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ActivityInfo.
-func (v *ActivityInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	if v.Version != nil {
-		enc.AddInt64("version", *v.Version)
-	}
-	if v.ScheduledEventBatchID != nil {
-		enc.AddInt64("scheduledEventBatchID", *v.ScheduledEventBatchID)
-	}
-	if v.ScheduledEvent != nil {
-		enc.AddString("scheduledEvent", base64.StdEncoding.EncodeToString(v.ScheduledEvent))
-	}
-	if v.ScheduledEventEncoding != nil {
-		enc.AddString("scheduledEventEncoding", *v.ScheduledEventEncoding)
-	}
-	if v.ScheduledTimeNanos != nil {
-		enc.AddInt64("scheduledTimeNanos", *v.ScheduledTimeNanos)
-	}
-	if v.StartedID != nil {
-		enc.AddInt64("startedID", *v.StartedID)
-	}
-	if v.StartedEvent != nil {
-		enc.AddString("startedEvent", base64.StdEncoding.EncodeToString(v.StartedEvent))
-	}
-	if v.StartedEventEncoding != nil {
-		enc.AddString("startedEventEncoding", *v.StartedEventEncoding)
-	}
-	if v.StartedTimeNanos != nil {
-		enc.AddInt64("startedTimeNanos", *v.StartedTimeNanos)
-	}
-	if v.ActivityID != nil {
-		enc.AddString("activityID", *v.ActivityID)
-	}
-	if v.RequestID != nil {
-		enc.AddString("requestID", *v.RequestID)
-	}
-	if v.ScheduleToStartTimeoutSeconds != nil {
-		enc.AddInt32("scheduleToStartTimeoutSeconds", *v.ScheduleToStartTimeoutSeconds)
-	}
-	if v.ScheduleToCloseTimeoutSeconds != nil {
-		enc.AddInt32("scheduleToCloseTimeoutSeconds", *v.ScheduleToCloseTimeoutSeconds)
-	}
-	if v.StartToCloseTimeoutSeconds != nil {
-		enc.AddInt32("startToCloseTimeoutSeconds", *v.StartToCloseTimeoutSeconds)
-	}
-	if v.HeartbeatTimeoutSeconds != nil {
-		enc.AddInt32("heartbeatTimeoutSeconds", *v.HeartbeatTimeoutSeconds)
-	}
-	if v.CancelRequested != nil {
-		enc.AddBool("cancelRequested", *v.CancelRequested)
-	}
-	if v.CancelRequestID != nil {
-		enc.AddInt64("cancelRequestID", *v.CancelRequestID)
+// GetRetryNonRetryableErrors returns the value of RetryNonRetryableErrors if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetRetryNonRetryableErrors() (o []string) {
+	if v != nil && v.RetryNonRetryableErrors != nil {
+		return v.RetryNonRetryableErrors
 	}
-	if v.TimerTaskStatus != nil {
-		enc.AddInt32("timerTaskStatus", *v.TimerTaskStatus)
+
+	return
+}
+
+// IsSetRetryNonRetryableErrors returns true if RetryNonRetryableErrors is not nil.
+func (v *ActivityInfo) IsSetRetryNonRetryableErrors() bool {
+	return v != nil && v.RetryNonRetryableErrors != nil
+}
+
+// GetRetryLastFailureReason returns the value of RetryLastFailureReason if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetRetryLastFailureReason() (o string) {
+	if v != nil && v.RetryLastFailureReason != nil {
+		return *v.RetryLastFailureReason
 	}
-	if v.Attempt != nil {
-		enc.AddInt32("attempt", *v.Attempt)
+
+	return
+}
+
+// IsSetRetryLastFailureReason returns true if RetryLastFailureReason is not nil.
+func (v *ActivityInfo) IsSetRetryLastFailureReason() bool {
+	return v != nil && v.RetryLastFailureReason != nil
+}
+
+// GetRetryLastWorkerIdentity returns the value of RetryLastWorkerIdentity if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetRetryLastWorkerIdentity() (o string) {
+	if v != nil && v.RetryLastWorkerIdentity != nil {
+		return *v.RetryLastWorkerIdentity
 	}
-	if v.TaskList != nil {
-		enc.AddString("taskList", *v.TaskList)
+
+	return
+}
+
+// IsSetRetryLastWorkerIdentity returns true if RetryLastWorkerIdentity is not nil.
+func (v *ActivityInfo) IsSetRetryLastWorkerIdentity() bool {
+	return v != nil && v.RetryLastWorkerIdentity != nil
+}
+
+// GetRetryLastFailureDetails returns the value of RetryLastFailureDetails if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetRetryLastFailureDetails() (o []byte) {
+	if v != nil && v.RetryLastFailureDetails != nil {
+		return v.RetryLastFailureDetails
 	}
-	if v.StartedIdentity != nil {
-		enc.AddString("startedIdentity", *v.StartedIdentity)
+
+	return
+}
+
+// IsSetRetryLastFailureDetails returns true if RetryLastFailureDetails is not nil.
+func (v *ActivityInfo) IsSetRetryLastFailureDetails() bool {
+	return v != nil && v.RetryLastFailureDetails != nil
+}
+
+// GetRoutingKey returns the value of RoutingKey if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetRoutingKey() (o string) {
+	if v != nil && v.RoutingKey != nil {
+		return *v.RoutingKey
 	}
-	if v.HasRetryPolicy != nil {
-		enc.AddBool("hasRetryPolicy", *v.HasRetryPolicy)
+
+	return
+}
+
+// IsSetRoutingKey returns true if RoutingKey is not nil.
+func (v *ActivityInfo) IsSetRoutingKey() bool {
+	return v != nil && v.RoutingKey != nil
+}
+
+// GetVisibilityTimeoutSeconds returns the value of VisibilityTimeoutSeconds if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetVisibilityTimeoutSeconds() (o int32) {
+	if v != nil && v.VisibilityTimeoutSeconds != nil {
+		return *v.VisibilityTimeoutSeconds
 	}
-	if v.RetryInitialIntervalSeconds != nil {
-		enc.AddInt32("retryInitialIntervalSeconds", *v.RetryInitialIntervalSeconds)
+
+	return
+}
+
+// IsSetVisibilityTimeoutSeconds returns true if VisibilityTimeoutSeconds is not nil.
+func (v *ActivityInfo) IsSetVisibilityTimeoutSeconds() bool {
+	return v != nil && v.VisibilityTimeoutSeconds != nil
+}
+
+// GetPrefetchLeased returns the value of PrefetchLeased if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetPrefetchLeased() (o bool) {
+	if v != nil && v.PrefetchLeased != nil {
+		return *v.PrefetchLeased
 	}
-	if v.RetryMaximumIntervalSeconds != nil {
-		enc.AddInt32("retryMaximumIntervalSeconds", *v.RetryMaximumIntervalSeconds)
+
+	return
+}
+
+// IsSetPrefetchLeased returns true if PrefetchLeased is not nil.
+func (v *ActivityInfo) IsSetPrefetchLeased() bool {
+	return v != nil && v.PrefetchLeased != nil
+}
+
+// GetFallbackTaskList returns the value of FallbackTaskList if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetFallbackTaskList() (o string) {
+	if v != nil && v.FallbackTaskList != nil {
+		return *v.FallbackTaskList
 	}
-	if v.RetryMaximumAttempts != nil {
-		enc.AddInt32("retryMaximumAttempts", *v.RetryMaximumAttempts)
+
+	return
+}
+
+// IsSetFallbackTaskList returns true if FallbackTaskList is not nil.
+func (v *ActivityInfo) IsSetFallbackTaskList() bool {
+	return v != nil && v.FallbackTaskList != nil
+}
+
+// GetScheduleToStartTimeouts returns the value of ScheduleToStartTimeouts if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetScheduleToStartTimeouts() (o int32) {
+	if v != nil && v.ScheduleToStartTimeouts != nil {
+		return *v.ScheduleToStartTimeouts
 	}
-	if v.RetryExpirationTimeNanos != nil {
-		enc.AddInt64("retryExpirationTimeNanos", *v.RetryExpirationTimeNanos)
+
+	return
+}
+
+// IsSetScheduleToStartTimeouts returns true if ScheduleToStartTimeouts is not nil.
+func (v *ActivityInfo) IsSetScheduleToStartTimeouts() bool {
+	return v != nil && v.ScheduleToStartTimeouts != nil
+}
+
+// GetEncryptionKeyID returns the value of EncryptionKeyID if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetEncryptionKeyID() (o string) {
+	if v != nil && v.EncryptionKeyID != nil {
+		return *v.EncryptionKeyID
 	}
-	if v.RetryBackoffCoefficient != nil {
-		enc.AddFloat64("retryBackoffCoefficient", *v.RetryBackoffCoefficient)
+
+	return
+}
+
+// IsSetEncryptionKeyID returns true if EncryptionKeyID is not nil.
+func (v *ActivityInfo) IsSetEncryptionKeyID() bool {
+	return v != nil && v.EncryptionKeyID != nil
+}
+
+// GetNextActivity returns the value of NextActivity if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetNextActivity() (o []byte) {
+	if v != nil && v.NextActivity != nil {
+		return v.NextActivity
 	}
-	if v.RetryNonRetryableErrors != nil {
-		err = multierr.Append(err, enc.AddArray("retryNonRetryableErrors", (_List_String_Zapper)(v.RetryNonRetryableErrors)))
+
+	return
+}
+
+// IsSetNextActivity returns true if NextActivity is not nil.
+func (v *ActivityInfo) IsSetNextActivity() bool {
+	return v != nil && v.NextActivity != nil
+}
+
+// GetNextActivityEncoding returns the value of NextActivityEncoding if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetNextActivityEncoding() (o string) {
+	if v != nil && v.NextActivityEncoding != nil {
+		return *v.NextActivityEncoding
 	}
-	if v.RetryLastFailureReason != nil {
-		enc.AddString("retryLastFailureReason", *v.RetryLastFailureReason)
+
+	return
+}
+
+// IsSetNextActivityEncoding returns true if NextActivityEncoding is not nil.
+func (v *ActivityInfo) IsSetNextActivityEncoding() bool {
+	return v != nil && v.NextActivityEncoding != nil
+}
+
+// GetAtMostOnce returns the value of AtMostOnce if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetAtMostOnce() (o bool) {
+	if v != nil && v.AtMostOnce != nil {
+		return *v.AtMostOnce
 	}
-	if v.RetryLastWorkerIdentity != nil {
-		enc.AddString("retryLastWorkerIdentity", *v.RetryLastWorkerIdentity)
+
+	return
+}
+
+// IsSetAtMostOnce returns true if AtMostOnce is not nil.
+func (v *ActivityInfo) IsSetAtMostOnce() bool {
+	return v != nil && v.AtMostOnce != nil
+}
+
+// GetDependsOnActivityID returns the value of DependsOnActivityID if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetDependsOnActivityID() (o string) {
+	if v != nil && v.DependsOnActivityID != nil {
+		return *v.DependsOnActivityID
 	}
-	if v.RetryLastFailureDetails != nil {
-		enc.AddString("retryLastFailureDetails", base64.StdEncoding.EncodeToString(v.RetryLastFailureDetails))
+
+	return
+}
+
+// IsSetDependsOnActivityID returns true if DependsOnActivityID is not nil.
+func (v *ActivityInfo) IsSetDependsOnActivityID() bool {
+	return v != nil && v.DependsOnActivityID != nil
+}
+
+// GetOnDependencyFailure returns the value of OnDependencyFailure if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetOnDependencyFailure() (o int32) {
+	if v != nil && v.OnDependencyFailure != nil {
+		return *v.OnDependencyFailure
 	}
-	return err
+
+	return
 }
 
-// GetVersion returns the value of Version if it is set or its
+// IsSetOnDependencyFailure returns true if OnDependencyFailure is not nil.
+func (v *ActivityInfo) IsSetOnDependencyFailure() bool {
+	return v != nil && v.OnDependencyFailure != nil
+}
+
+// GetTaskListEscalation returns the value of TaskListEscalation if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetVersion() (o int64) {
-	if v != nil && v.Version != nil {
-		return *v.Version
+func (v *ActivityInfo) GetTaskListEscalation() (o []string) {
+	if v != nil && v.TaskListEscalation != nil {
+		return v.TaskListEscalation
 	}
 
 	return
 }
 
-// IsSetVersion returns true if Version is not nil.
-func (v *ActivityInfo) IsSetVersion() bool {
-	return v != nil && v.Version != nil
+// IsSetTaskListEscalation returns true if TaskListEscalation is not nil.
+func (v *ActivityInfo) IsSetTaskListEscalation() bool {
+	return v != nil && v.TaskListEscalation != nil
 }
 
-// GetScheduledEventBatchID returns the value of ScheduledEventBatchID if it is set or its
+// GetFirstAttemptStartToCloseTimeoutSeconds returns the value of FirstAttemptStartToCloseTimeoutSeconds if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetScheduledEventBatchID() (o int64) {
-	if v != nil && v.ScheduledEventBatchID != nil {
-		return *v.ScheduledEventBatchID
+func (v *ActivityInfo) GetFirstAttemptStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.FirstAttemptStartToCloseTimeoutSeconds != nil {
+		return *v.FirstAttemptStartToCloseTimeoutSeconds
 	}
 
 	return
 }
 
-// IsSetScheduledEventBatchID returns true if ScheduledEventBatchID is not nil.
-func (v *ActivityInfo) IsSetScheduledEventBatchID() bool {
-	return v != nil && v.ScheduledEventBatchID != nil
+// IsSetFirstAttemptStartToCloseTimeoutSeconds returns true if FirstAttemptStartToCloseTimeoutSeconds is not nil.
+func (v *ActivityInfo) IsSetFirstAttemptStartToCloseTimeoutSeconds() bool {
+	return v != nil && v.FirstAttemptStartToCloseTimeoutSeconds != nil
 }
 
-// GetScheduledEvent returns the value of ScheduledEvent if it is set or its
+// GetMaxHeartbeatGapNanos returns the value of MaxHeartbeatGapNanos if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetScheduledEvent() (o []byte) {
-	if v != nil && v.ScheduledEvent != nil {
-		return v.ScheduledEvent
+func (v *ActivityInfo) GetMaxHeartbeatGapNanos() (o int64) {
+	if v != nil && v.MaxHeartbeatGapNanos != nil {
+		return *v.MaxHeartbeatGapNanos
 	}
 
 	return
 }
 
-// IsSetScheduledEvent returns true if ScheduledEvent is not nil.
-func (v *ActivityInfo) IsSetScheduledEvent() bool {
-	return v != nil && v.ScheduledEvent != nil
+// IsSetMaxHeartbeatGapNanos returns true if MaxHeartbeatGapNanos is not nil.
+func (v *ActivityInfo) IsSetMaxHeartbeatGapNanos() bool {
+	return v != nil && v.MaxHeartbeatGapNanos != nil
 }
 
-// GetScheduledEventEncoding returns the value of ScheduledEventEncoding if it is set or its
+// GetGrantedStartToCloseTimeoutSeconds returns the value of GrantedStartToCloseTimeoutSeconds if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetScheduledEventEncoding() (o string) {
-	if v != nil && v.ScheduledEventEncoding != nil {
-		return *v.ScheduledEventEncoding
+func (v *ActivityInfo) GetGrantedStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.GrantedStartToCloseTimeoutSeconds != nil {
+		return *v.GrantedStartToCloseTimeoutSeconds
 	}
 
 	return
 }
 
-// IsSetScheduledEventEncoding returns true if ScheduledEventEncoding is not nil.
-func (v *ActivityInfo) IsSetScheduledEventEncoding() bool {
-	return v != nil && v.ScheduledEventEncoding != nil
+// IsSetGrantedStartToCloseTimeoutSeconds returns true if GrantedStartToCloseTimeoutSeconds is not nil.
+func (v *ActivityInfo) IsSetGrantedStartToCloseTimeoutSeconds() bool {
+	return v != nil && v.GrantedStartToCloseTimeoutSeconds != nil
 }
 
-// GetScheduledTimeNanos returns the value of ScheduledTimeNanos if it is set or its
+// GetMaxTotalExecutionSeconds returns the value of MaxTotalExecutionSeconds if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetScheduledTimeNanos() (o int64) {
-	if v != nil && v.ScheduledTimeNanos != nil {
-		return *v.ScheduledTimeNanos
+func (v *ActivityInfo) GetMaxTotalExecutionSeconds() (o int32) {
+	if v != nil && v.MaxTotalExecutionSeconds != nil {
+		return *v.MaxTotalExecutionSeconds
 	}
 
 	return
 }
 
-// IsSetScheduledTimeNanos returns true if ScheduledTimeNanos is not nil.
-func (v *ActivityInfo) IsSetScheduledTimeNanos() bool {
-	return v != nil && v.ScheduledTimeNanos != nil
+// IsSetMaxTotalExecutionSeconds returns true if MaxTotalExecutionSeconds is not nil.
+func (v *ActivityInfo) IsSetMaxTotalExecutionSeconds() bool {
+	return v != nil && v.MaxTotalExecutionSeconds != nil
 }
 
-// GetStartedID returns the value of StartedID if it is set or its
+// GetTotalExecutionTimeNanos returns the value of TotalExecutionTimeNanos if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetStartedID() (o int64) {
-	if v != nil && v.StartedID != nil {
-		return *v.StartedID
+func (v *ActivityInfo) GetTotalExecutionTimeNanos() (o int64) {
+	if v != nil && v.TotalExecutionTimeNanos != nil {
+		return *v.TotalExecutionTimeNanos
 	}
 
 	return
 }
 
-// IsSetStartedID returns true if StartedID is not nil.
-func (v *ActivityInfo) IsSetStartedID() bool {
-	return v != nil && v.StartedID != nil
+// IsSetTotalExecutionTimeNanos returns true if TotalExecutionTimeNanos is not nil.
+func (v *ActivityInfo) IsSetTotalExecutionTimeNanos() bool {
+	return v != nil && v.TotalExecutionTimeNanos != nil
 }
 
-// GetStartedEvent returns the value of StartedEvent if it is set or its
+// GetHeartbeatSequence returns the value of HeartbeatSequence if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetStartedEvent() (o []byte) {
-	if v != nil && v.StartedEvent != nil {
-		return v.StartedEvent
+func (v *ActivityInfo) GetHeartbeatSequence() (o int64) {
+	if v != nil && v.HeartbeatSequence != nil {
+		return *v.HeartbeatSequence
 	}
 
 	return
 }
 
-// IsSetStartedEvent returns true if StartedEvent is not nil.
-func (v *ActivityInfo) IsSetStartedEvent() bool {
-	return v != nil && v.StartedEvent != nil
+// IsSetHeartbeatSequence returns true if HeartbeatSequence is not nil.
+func (v *ActivityInfo) IsSetHeartbeatSequence() bool {
+	return v != nil && v.HeartbeatSequence != nil
 }
 
-// GetStartedEventEncoding returns the value of StartedEventEncoding if it is set or its
+// GetMinimumIntervalSeconds returns the value of MinimumIntervalSeconds if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetStartedEventEncoding() (o string) {
-	if v != nil && v.StartedEventEncoding != nil {
-		return *v.StartedEventEncoding
+func (v *ActivityInfo) GetMinimumIntervalSeconds() (o int32) {
+	if v != nil && v.MinimumIntervalSeconds != nil {
+		return *v.MinimumIntervalSeconds
 	}
 
 	return
 }
 
-// IsSetStartedEventEncoding returns true if StartedEventEncoding is not nil.
-func (v *ActivityInfo) IsSetStartedEventEncoding() bool {
-	return v != nil && v.StartedEventEncoding != nil
+// IsSetMinimumIntervalSeconds returns true if MinimumIntervalSeconds is not nil.
+func (v *ActivityInfo) IsSetMinimumIntervalSeconds() bool {
+	return v != nil && v.MinimumIntervalSeconds != nil
 }
 
-// GetStartedTimeNanos returns the value of StartedTimeNanos if it is set or its
+// GetCancelRequestedTimeNanos returns the value of CancelRequestedTimeNanos if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetStartedTimeNanos() (o int64) {
-	if v != nil && v.StartedTimeNanos != nil {
-		return *v.StartedTimeNanos
+func (v *ActivityInfo) GetCancelRequestedTimeNanos() (o int64) {
+	if v != nil && v.CancelRequestedTimeNanos != nil {
+		return *v.CancelRequestedTimeNanos
 	}
 
 	return
 }
 
-// IsSetStartedTimeNanos returns true if StartedTimeNanos is not nil.
-func (v *ActivityInfo) IsSetStartedTimeNanos() bool {
-	return v != nil && v.StartedTimeNanos != nil
+// IsSetCancelRequestedTimeNanos returns true if CancelRequestedTimeNanos is not nil.
+func (v *ActivityInfo) IsSetCancelRequestedTimeNanos() bool {
+	return v != nil && v.CancelRequestedTimeNanos != nil
 }
 
-// GetActivityID returns the value of ActivityID if it is set or its
+// GetCancelAckTimeoutSeconds returns the value of CancelAckTimeoutSeconds if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetActivityID() (o string) {
-	if v != nil && v.ActivityID != nil {
-		return *v.ActivityID
+func (v *ActivityInfo) GetCancelAckTimeoutSeconds() (o int32) {
+	if v != nil && v.CancelAckTimeoutSeconds != nil {
+		return *v.CancelAckTimeoutSeconds
 	}
 
 	return
 }
 
-// IsSetActivityID returns true if ActivityID is not nil.
-func (v *ActivityInfo) IsSetActivityID() bool {
-	return v != nil && v.ActivityID != nil
+// IsSetCancelAckTimeoutSeconds returns true if CancelAckTimeoutSeconds is not nil.
+func (v *ActivityInfo) IsSetCancelAckTimeoutSeconds() bool {
+	return v != nil && v.CancelAckTimeoutSeconds != nil
 }
 
-// GetRequestID returns the value of RequestID if it is set or its
+// GetCancelForceTimeoutSeconds returns the value of CancelForceTimeoutSeconds if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetRequestID() (o string) {
-	if v != nil && v.RequestID != nil {
-		return *v.RequestID
+func (v *ActivityInfo) GetCancelForceTimeoutSeconds() (o int32) {
+	if v != nil && v.CancelForceTimeoutSeconds != nil {
+		return *v.CancelForceTimeoutSeconds
 	}
 
 	return
 }
 
-// IsSetRequestID returns true if RequestID is not nil.
-func (v *ActivityInfo) IsSetRequestID() bool {
-	return v != nil && v.RequestID != nil
+// IsSetCancelForceTimeoutSeconds returns true if CancelForceTimeoutSeconds is not nil.
+func (v *ActivityInfo) IsSetCancelForceTimeoutSeconds() bool {
+	return v != nil && v.CancelForceTimeoutSeconds != nil
 }
 
-// GetScheduleToStartTimeoutSeconds returns the value of ScheduleToStartTimeoutSeconds if it is set or its
+// GetCancelAckTimeoutExceededTimeNanos returns the value of CancelAckTimeoutExceededTimeNanos if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetScheduleToStartTimeoutSeconds() (o int32) {
-	if v != nil && v.ScheduleToStartTimeoutSeconds != nil {
-		return *v.ScheduleToStartTimeoutSeconds
+func (v *ActivityInfo) GetCancelAckTimeoutExceededTimeNanos() (o int64) {
+	if v != nil && v.CancelAckTimeoutExceededTimeNanos != nil {
+		return *v.CancelAckTimeoutExceededTimeNanos
 	}
 
 	return
 }
 
-// IsSetScheduleToStartTimeoutSeconds returns true if ScheduleToStartTimeoutSeconds is not nil.
-func (v *ActivityInfo) IsSetScheduleToStartTimeoutSeconds() bool {
-	return v != nil && v.ScheduleToStartTimeoutSeconds != nil
+// IsSetCancelAckTimeoutExceededTimeNanos returns true if CancelAckTimeoutExceededTimeNanos is not nil.
+func (v *ActivityInfo) IsSetCancelAckTimeoutExceededTimeNanos() bool {
+	return v != nil && v.CancelAckTimeoutExceededTimeNanos != nil
 }
 
-// GetScheduleToCloseTimeoutSeconds returns the value of ScheduleToCloseTimeoutSeconds if it is set or its
+// GetMaintenancePausedTimeNanos returns the value of MaintenancePausedTimeNanos if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetScheduleToCloseTimeoutSeconds() (o int32) {
-	if v != nil && v.ScheduleToCloseTimeoutSeconds != nil {
-		return *v.ScheduleToCloseTimeoutSeconds
+func (v *ActivityInfo) GetMaintenancePausedTimeNanos() (o int64) {
+	if v != nil && v.MaintenancePausedTimeNanos != nil {
+		return *v.MaintenancePausedTimeNanos
 	}
 
 	return
 }
 
-// IsSetScheduleToCloseTimeoutSeconds returns true if ScheduleToCloseTimeoutSeconds is not nil.
-func (v *ActivityInfo) IsSetScheduleToCloseTimeoutSeconds() bool {
-	return v != nil && v.ScheduleToCloseTimeoutSeconds != nil
+// IsSetMaintenancePausedTimeNanos returns true if MaintenancePausedTimeNanos is not nil.
+func (v *ActivityInfo) IsSetMaintenancePausedTimeNanos() bool {
+	return v != nil && v.MaintenancePausedTimeNanos != nil
 }
 
-// GetStartToCloseTimeoutSeconds returns the value of StartToCloseTimeoutSeconds if it is set or its
+// GetMaintenanceTimeoutsDeferred returns the value of MaintenanceTimeoutsDeferred if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetStartToCloseTimeoutSeconds() (o int32) {
-	if v != nil && v.StartToCloseTimeoutSeconds != nil {
-		return *v.StartToCloseTimeoutSeconds
+func (v *ActivityInfo) GetMaintenanceTimeoutsDeferred() (o int32) {
+	if v != nil && v.MaintenanceTimeoutsDeferred != nil {
+		return *v.MaintenanceTimeoutsDeferred
 	}
 
 	return
 }
 
-// IsSetStartToCloseTimeoutSeconds returns true if StartToCloseTimeoutSeconds is not nil.
-func (v *ActivityInfo) IsSetStartToCloseTimeoutSeconds() bool {
-	return v != nil && v.StartToCloseTimeoutSeconds != nil
+// IsSetMaintenanceTimeoutsDeferred returns true if MaintenanceTimeoutsDeferred is not nil.
+func (v *ActivityInfo) IsSetMaintenanceTimeoutsDeferred() bool {
+	return v != nil && v.MaintenanceTimeoutsDeferred != nil
+}
+
+// GetNackCount returns the value of NackCount if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetNackCount() (o int32) {
+	if v != nil && v.NackCount != nil {
+		return *v.NackCount
+	}
+
+	return
 }
 
-// GetHeartbeatTimeoutSeconds returns the value of HeartbeatTimeoutSeconds if it is set or its
+// IsSetNackCount returns true if NackCount is not nil.
+func (v *ActivityInfo) IsSetNackCount() bool {
+	return v != nil && v.NackCount != nil
+}
+
+// GetLastNackReason returns the value of LastNackReason if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetHeartbeatTimeoutSeconds() (o int32) {
-	if v != nil && v.HeartbeatTimeoutSeconds != nil {
-		return *v.HeartbeatTimeoutSeconds
+func (v *ActivityInfo) GetLastNackReason() (o string) {
+	if v != nil && v.LastNackReason != nil {
+		return *v.LastNackReason
 	}
 
 	return
 }
 
-// IsSetHeartbeatTimeoutSeconds returns true if HeartbeatTimeoutSeconds is not nil.
-func (v *ActivityInfo) IsSetHeartbeatTimeoutSeconds() bool {
-	return v != nil && v.HeartbeatTimeoutSeconds != nil
+// IsSetLastNackReason returns true if LastNackReason is not nil.
+func (v *ActivityInfo) IsSetLastNackReason() bool {
+	return v != nil && v.LastNackReason != nil
 }
 
-// GetCancelRequested returns the value of CancelRequested if it is set or its
+// GetCancellationCheckpointGraceSeconds returns the value of CancellationCheckpointGraceSeconds if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetCancelRequested() (o bool) {
-	if v != nil && v.CancelRequested != nil {
-		return *v.CancelRequested
+func (v *ActivityInfo) GetCancellationCheckpointGraceSeconds() (o int32) {
+	if v != nil && v.CancellationCheckpointGraceSeconds != nil {
+		return *v.CancellationCheckpointGraceSeconds
 	}
 
 	return
 }
 
-// IsSetCancelRequested returns true if CancelRequested is not nil.
-func (v *ActivityInfo) IsSetCancelRequested() bool {
-	return v != nil && v.CancelRequested != nil
+// IsSetCancellationCheckpointGraceSeconds returns true if CancellationCheckpointGraceSeconds is not nil.
+func (v *ActivityInfo) IsSetCancellationCheckpointGraceSeconds() bool {
+	return v != nil && v.CancellationCheckpointGraceSeconds != nil
 }
 
-// GetCancelRequestID returns the value of CancelRequestID if it is set or its
+// GetCancelDeliveredTimeNanos returns the value of CancelDeliveredTimeNanos if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetCancelRequestID() (o int64) {
-	if v != nil && v.CancelRequestID != nil {
-		return *v.CancelRequestID
+func (v *ActivityInfo) GetCancelDeliveredTimeNanos() (o int64) {
+	if v != nil && v.CancelDeliveredTimeNanos != nil {
+		return *v.CancelDeliveredTimeNanos
 	}
 
 	return
 }
 
-// IsSetCancelRequestID returns true if CancelRequestID is not nil.
-func (v *ActivityInfo) IsSetCancelRequestID() bool {
-	return v != nil && v.CancelRequestID != nil
+// IsSetCancelDeliveredTimeNanos returns true if CancelDeliveredTimeNanos is not nil.
+func (v *ActivityInfo) IsSetCancelDeliveredTimeNanos() bool {
+	return v != nil && v.CancelDeliveredTimeNanos != nil
 }
 
-// GetTimerTaskStatus returns the value of TimerTaskStatus if it is set or its
+// GetAlertOnFailure returns the value of AlertOnFailure if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetTimerTaskStatus() (o int32) {
-	if v != nil && v.TimerTaskStatus != nil {
-		return *v.TimerTaskStatus
+func (v *ActivityInfo) GetAlertOnFailure() (o string) {
+	if v != nil && v.AlertOnFailure != nil {
+		return *v.AlertOnFailure
 	}
 
 	return
 }
 
-// IsSetTimerTaskStatus returns true if TimerTaskStatus is not nil.
-func (v *ActivityInfo) IsSetTimerTaskStatus() bool {
-	return v != nil && v.TimerTaskStatus != nil
+// IsSetAlertOnFailure returns true if AlertOnFailure is not nil.
+func (v *ActivityInfo) IsSetAlertOnFailure() bool {
+	return v != nil && v.AlertOnFailure != nil
 }
 
-// GetAttempt returns the value of Attempt if it is set or its
+// GetStealTimeoutSeconds returns the value of StealTimeoutSeconds if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetAttempt() (o int32) {
-	if v != nil && v.Attempt != nil {
-		return *v.Attempt
+func (v *ActivityInfo) GetStealTimeoutSeconds() (o int32) {
+	if v != nil && v.StealTimeoutSeconds != nil {
+		return *v.StealTimeoutSeconds
 	}
 
 	return
 }
 
-// IsSetAttempt returns true if Attempt is not nil.
-func (v *ActivityInfo) IsSetAttempt() bool {
-	return v != nil && v.Attempt != nil
+// IsSetStealTimeoutSeconds returns true if StealTimeoutSeconds is not nil.
+func (v *ActivityInfo) IsSetStealTimeoutSeconds() bool {
+	return v != nil && v.StealTimeoutSeconds != nil
 }
 
-// GetTaskList returns the value of TaskList if it is set or its
+// GetIdempotent returns the value of Idempotent if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetTaskList() (o string) {
-	if v != nil && v.TaskList != nil {
-		return *v.TaskList
+func (v *ActivityInfo) GetIdempotent() (o bool) {
+	if v != nil && v.Idempotent != nil {
+		return *v.Idempotent
 	}
 
 	return
 }
 
-// IsSetTaskList returns true if TaskList is not nil.
-func (v *ActivityInfo) IsSetTaskList() bool {
-	return v != nil && v.TaskList != nil
+// IsSetIdempotent returns true if Idempotent is not nil.
+func (v *ActivityInfo) IsSetIdempotent() bool {
+	return v != nil && v.Idempotent != nil
 }
 
-// GetStartedIdentity returns the value of StartedIdentity if it is set or its
+// GetProgressPercent returns the value of ProgressPercent if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetStartedIdentity() (o string) {
-	if v != nil && v.StartedIdentity != nil {
-		return *v.StartedIdentity
+func (v *ActivityInfo) GetProgressPercent() (o int32) {
+	if v != nil && v.ProgressPercent != nil {
+		return *v.ProgressPercent
 	}
 
 	return
 }
 
-// IsSetStartedIdentity returns true if StartedIdentity is not nil.
-func (v *ActivityInfo) IsSetStartedIdentity() bool {
-	return v != nil && v.StartedIdentity != nil
+// IsSetProgressPercent returns true if ProgressPercent is not nil.
+func (v *ActivityInfo) IsSetProgressPercent() bool {
+	return v != nil && v.ProgressPercent != nil
 }
 
-// GetHasRetryPolicy returns the value of HasRetryPolicy if it is set or its
+// GetStalledHeartbeats returns the value of StalledHeartbeats if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetHasRetryPolicy() (o bool) {
-	if v != nil && v.HasRetryPolicy != nil {
-		return *v.HasRetryPolicy
+func (v *ActivityInfo) GetStalledHeartbeats() (o int32) {
+	if v != nil && v.StalledHeartbeats != nil {
+		return *v.StalledHeartbeats
 	}
 
 	return
 }
 
-// IsSetHasRetryPolicy returns true if HasRetryPolicy is not nil.
-func (v *ActivityInfo) IsSetHasRetryPolicy() bool {
-	return v != nil && v.HasRetryPolicy != nil
+// IsSetStalledHeartbeats returns true if StalledHeartbeats is not nil.
+func (v *ActivityInfo) IsSetStalledHeartbeats() bool {
+	return v != nil && v.StalledHeartbeats != nil
 }
 
-// GetRetryInitialIntervalSeconds returns the value of RetryInitialIntervalSeconds if it is set or its
+// GetHeartbeatExpiredTimeNanos returns the value of HeartbeatExpiredTimeNanos if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetRetryInitialIntervalSeconds() (o int32) {
-	if v != nil && v.RetryInitialIntervalSeconds != nil {
-		return *v.RetryInitialIntervalSeconds
+func (v *ActivityInfo) GetHeartbeatExpiredTimeNanos() (o int64) {
+	if v != nil && v.HeartbeatExpiredTimeNanos != nil {
+		return *v.HeartbeatExpiredTimeNanos
 	}
 
 	return
 }
 
-// IsSetRetryInitialIntervalSeconds returns true if RetryInitialIntervalSeconds is not nil.
-func (v *ActivityInfo) IsSetRetryInitialIntervalSeconds() bool {
-	return v != nil && v.RetryInitialIntervalSeconds != nil
+// IsSetHeartbeatExpiredTimeNanos returns true if HeartbeatExpiredTimeNanos is not nil.
+func (v *ActivityInfo) IsSetHeartbeatExpiredTimeNanos() bool {
+	return v != nil && v.HeartbeatExpiredTimeNanos != nil
 }
 
-// GetRetryMaximumIntervalSeconds returns the value of RetryMaximumIntervalSeconds if it is set or its
+// GetPendingSignals returns the value of PendingSignals if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetRetryMaximumIntervalSeconds() (o int32) {
-	if v != nil && v.RetryMaximumIntervalSeconds != nil {
-		return *v.RetryMaximumIntervalSeconds
+func (v *ActivityInfo) GetPendingSignals() (o []byte) {
+	if v != nil && v.PendingSignals != nil {
+		return v.PendingSignals
 	}
 
 	return
 }
 
-// IsSetRetryMaximumIntervalSeconds returns true if RetryMaximumIntervalSeconds is not nil.
-func (v *ActivityInfo) IsSetRetryMaximumIntervalSeconds() bool {
-	return v != nil && v.RetryMaximumIntervalSeconds != nil
+// IsSetPendingSignals returns true if PendingSignals is not nil.
+func (v *ActivityInfo) IsSetPendingSignals() bool {
+	return v != nil && v.PendingSignals != nil
 }
 
-// GetRetryMaximumAttempts returns the value of RetryMaximumAttempts if it is set or its
+// GetPendingSignalsEncoding returns the value of PendingSignalsEncoding if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetRetryMaximumAttempts() (o int32) {
-	if v != nil && v.RetryMaximumAttempts != nil {
-		return *v.RetryMaximumAttempts
+func (v *ActivityInfo) GetPendingSignalsEncoding() (o string) {
+	if v != nil && v.PendingSignalsEncoding != nil {
+		return *v.PendingSignalsEncoding
 	}
 
 	return
 }
 
-// IsSetRetryMaximumAttempts returns true if RetryMaximumAttempts is not nil.
-func (v *ActivityInfo) IsSetRetryMaximumAttempts() bool {
-	return v != nil && v.RetryMaximumAttempts != nil
+// IsSetPendingSignalsEncoding returns true if PendingSignalsEncoding is not nil.
+func (v *ActivityInfo) IsSetPendingSignalsEncoding() bool {
+	return v != nil && v.PendingSignalsEncoding != nil
 }
 
-// GetRetryExpirationTimeNanos returns the value of RetryExpirationTimeNanos if it is set or its
+// GetSearchAttributes returns the value of SearchAttributes if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetRetryExpirationTimeNanos() (o int64) {
-	if v != nil && v.RetryExpirationTimeNanos != nil {
-		return *v.RetryExpirationTimeNanos
+func (v *ActivityInfo) GetSearchAttributes() (o map[string][]byte) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
 	}
 
 	return
 }
 
-// IsSetRetryExpirationTimeNanos returns true if RetryExpirationTimeNanos is not nil.
-func (v *ActivityInfo) IsSetRetryExpirationTimeNanos() bool {
-	return v != nil && v.RetryExpirationTimeNanos != nil
+// IsSetSearchAttributes returns true if SearchAttributes is not nil.
+func (v *ActivityInfo) IsSetSearchAttributes() bool {
+	return v != nil && v.SearchAttributes != nil
 }
 
-// GetRetryBackoffCoefficient returns the value of RetryBackoffCoefficient if it is set or its
+// GetRescheduleReasons returns the value of RescheduleReasons if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetRetryBackoffCoefficient() (o float64) {
-	if v != nil && v.RetryBackoffCoefficient != nil {
-		return *v.RetryBackoffCoefficient
+func (v *ActivityInfo) GetRescheduleReasons() (o []byte) {
+	if v != nil && v.RescheduleReasons != nil {
+		return v.RescheduleReasons
 	}
 
 	return
 }
 
-// IsSetRetryBackoffCoefficient returns true if RetryBackoffCoefficient is not nil.
-func (v *ActivityInfo) IsSetRetryBackoffCoefficient() bool {
-	return v != nil && v.RetryBackoffCoefficient != nil
+// IsSetRescheduleReasons returns true if RescheduleReasons is not nil.
+func (v *ActivityInfo) IsSetRescheduleReasons() bool {
+	return v != nil && v.RescheduleReasons != nil
 }
 
-// GetRetryNonRetryableErrors returns the value of RetryNonRetryableErrors if it is set or its
+// GetRescheduleReasonsEncoding returns the value of RescheduleReasonsEncoding if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetRetryNonRetryableErrors() (o []string) {
-	if v != nil && v.RetryNonRetryableErrors != nil {
-		return v.RetryNonRetryableErrors
+func (v *ActivityInfo) GetRescheduleReasonsEncoding() (o string) {
+	if v != nil && v.RescheduleReasonsEncoding != nil {
+		return *v.RescheduleReasonsEncoding
 	}
 
 	return
 }
 
-// IsSetRetryNonRetryableErrors returns true if RetryNonRetryableErrors is not nil.
-func (v *ActivityInfo) IsSetRetryNonRetryableErrors() bool {
-	return v != nil && v.RetryNonRetryableErrors != nil
+// IsSetRescheduleReasonsEncoding returns true if RescheduleReasonsEncoding is not nil.
+func (v *ActivityInfo) IsSetRescheduleReasonsEncoding() bool {
+	return v != nil && v.RescheduleReasonsEncoding != nil
 }
 
-// GetRetryLastFailureReason returns the value of RetryLastFailureReason if it is set or its
+// GetResultSearchAttributes returns the value of ResultSearchAttributes if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetRetryLastFailureReason() (o string) {
-	if v != nil && v.RetryLastFailureReason != nil {
-		return *v.RetryLastFailureReason
+func (v *ActivityInfo) GetResultSearchAttributes() (o []byte) {
+	if v != nil && v.ResultSearchAttributes != nil {
+		return v.ResultSearchAttributes
 	}
 
 	return
 }
 
-// IsSetRetryLastFailureReason returns true if RetryLastFailureReason is not nil.
-func (v *ActivityInfo) IsSetRetryLastFailureReason() bool {
-	return v != nil && v.RetryLastFailureReason != nil
+// IsSetResultSearchAttributes returns true if ResultSearchAttributes is not nil.
+func (v *ActivityInfo) IsSetResultSearchAttributes() bool {
+	return v != nil && v.ResultSearchAttributes != nil
 }
 
-// GetRetryLastWorkerIdentity returns the value of RetryLastWorkerIdentity if it is set or its
+// GetResultSearchAttributesEncoding returns the value of ResultSearchAttributesEncoding if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetRetryLastWorkerIdentity() (o string) {
-	if v != nil && v.RetryLastWorkerIdentity != nil {
-		return *v.RetryLastWorkerIdentity
+func (v *ActivityInfo) GetResultSearchAttributesEncoding() (o string) {
+	if v != nil && v.ResultSearchAttributesEncoding != nil {
+		return *v.ResultSearchAttributesEncoding
 	}
 
 	return
 }
 
-// IsSetRetryLastWorkerIdentity returns true if RetryLastWorkerIdentity is not nil.
-func (v *ActivityInfo) IsSetRetryLastWorkerIdentity() bool {
-	return v != nil && v.RetryLastWorkerIdentity != nil
+// IsSetResultSearchAttributesEncoding returns true if ResultSearchAttributesEncoding is not nil.
+func (v *ActivityInfo) IsSetResultSearchAttributesEncoding() bool {
+	return v != nil && v.ResultSearchAttributesEncoding != nil
 }
 
-// GetRetryLastFailureDetails returns the value of RetryLastFailureDetails if it is set or its
+// GetPriority returns the value of Priority if it is set or its
 // zero value if it is unset.
-func (v *ActivityInfo) GetRetryLastFailureDetails() (o []byte) {
-	if v != nil && v.RetryLastFailureDetails != nil {
-		return v.RetryLastFailureDetails
+func (v *ActivityInfo) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
 	}
 
 	return
 }
 
-// IsSetRetryLastFailureDetails returns true if RetryLastFailureDetails is not nil.
-func (v *ActivityInfo) IsSetRetryLastFailureDetails() bool {
-	return v != nil && v.RetryLastFailureDetails != nil
+// IsSetPriority returns true if Priority is not nil.
+func (v *ActivityInfo) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
 }
 
 type AsyncRequestMessage struct {
@@ -13956,46 +16844,14 @@ type WorkflowExecutionInfo struct {
 	PartitionConfig                         map[string]string `json:"partitionConfig,omitempty"`
 	Checksum                                []byte            `json:"checksum,omitempty"`
 	ChecksumEncoding                        *string           `json:"checksumEncoding,omitempty"`
+	MinimumIntervalSeconds                  *int32            `json:"minimumIntervalSeconds,omitempty"`
+	Counters                                map[string]int64  `json:"counters,omitempty"`
+	NotifyOnActivityBatchComplete           *bool             `json:"notifyOnActivityBatchComplete,omitempty"`
+	ActivityBatchCompleted                  *bool             `json:"activityBatchCompleted,omitempty"`
+	ActivityBatchNotifiedStartedID          *int64            `json:"activityBatchNotifiedStartedID,omitempty"`
+	Priority                                *int32            `json:"priority,omitempty"`
 }
 
-type _Map_String_Binary_MapItemList map[string][]byte
-
-func (m _Map_String_Binary_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid map 'map[string][]byte', key [%v]: value is nil", k)
-		}
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
-
-		vw, err := wire.NewValueBinary(v), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (m _Map_String_Binary_MapItemList) Size() int {
-	return len(m)
-}
-
-func (_Map_String_Binary_MapItemList) KeyType() wire.Type {
-	return wire.TBinary
-}
-
-func (_Map_String_Binary_MapItemList) ValueType() wire.Type {
-	return wire.TBinary
-}
-
-func (_Map_String_Binary_MapItemList) Close() {}
-
 // ToWire translates a WorkflowExecutionInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -14013,7 +16869,7 @@ func (_Map_String_Binary_MapItemList) Close() {}
 //	}
 func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [62]wire.Field
+		fields [68]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -14515,36 +17371,56 @@ func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 132, Value: w}
 		i++
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
-	if m.KeyType() != wire.TBinary {
-		return nil, nil
+	if v.MinimumIntervalSeconds != nil {
+		w, err = wire.NewValueI32(*(v.MinimumIntervalSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 134, Value: w}
+		i++
 	}
-
-	if m.ValueType() != wire.TBinary {
-		return nil, nil
+	if v.Counters != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.Counters)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 136, Value: w}
+		i++
 	}
-
-	o := make(map[string][]byte, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
+	if v.NotifyOnActivityBatchComplete != nil {
+		w, err = wire.NewValueBool(*(v.NotifyOnActivityBatchComplete)), error(nil)
 		if err != nil {
-			return err
+			return w, err
 		}
-
-		v, err := x.Value.GetBinary(), error(nil)
+		fields[i] = wire.Field{ID: 137, Value: w}
+		i++
+	}
+	if v.ActivityBatchCompleted != nil {
+		w, err = wire.NewValueBool(*(v.ActivityBatchCompleted)), error(nil)
 		if err != nil {
-			return err
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 138, Value: w}
+		i++
+	}
+	if v.ActivityBatchNotifiedStartedID != nil {
+		w, err = wire.NewValueI64(*(v.ActivityBatchNotifiedStartedID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 139, Value: w}
+		i++
+	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
 		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
 
-		o[k] = v
-		return nil
-	})
-	m.Close()
-	return o, err
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
 // FromWire deserializes a WorkflowExecutionInfo struct from its Thrift-level
@@ -15145,19 +18021,77 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 				}
 
 			}
-		case 130:
-			if field.Value.Type() == wire.TBinary {
-				v.Checksum, err = field.Value.GetBinary(), error(nil)
+		case 130:
+			if field.Value.Type() == wire.TBinary {
+				v.Checksum, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 132:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ChecksumEncoding = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 134:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MinimumIntervalSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 136:
+			if field.Value.Type() == wire.TMap {
+				v.Counters, err = _Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 137:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.NotifyOnActivityBatchComplete = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 138:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ActivityBatchCompleted = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 139:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ActivityBatchNotifiedStartedID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 132:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ChecksumEncoding = &x
+		case 140:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
 				if err != nil {
 					return err
 				}
@@ -15169,32 +18103,6 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-func _Map_String_Binary_Encode(val map[string][]byte, sw stream.Writer) error {
-
-	mh := stream.MapHeader{
-		KeyType:   wire.TBinary,
-		ValueType: wire.TBinary,
-		Length:    len(val),
-	}
-	if err := sw.WriteMapBegin(mh); err != nil {
-		return err
-	}
-
-	for k, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid map 'map[string][]byte', key [%v]: value is nil", k)
-		}
-		if err := sw.WriteString(k); err != nil {
-			return err
-		}
-		if err := sw.WriteBinary(v); err != nil {
-			return err
-		}
-	}
-
-	return sw.WriteMapEnd()
-}
-
 // Encode serializes a WorkflowExecutionInfo struct directly into bytes, without going
 // through an intermediary type.
 //
@@ -15948,47 +18856,79 @@ func (v *WorkflowExecutionInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	return sw.WriteStructEnd()
-}
-
-func _Map_String_Binary_Decode(sr stream.Reader) (map[string][]byte, error) {
-	mh, err := sr.ReadMapBegin()
-	if err != nil {
-		return nil, err
+	if v.MinimumIntervalSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 134, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.MinimumIntervalSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
-		for i := 0; i < mh.Length; i++ {
-			if err := sr.Skip(mh.KeyType); err != nil {
-				return nil, err
-			}
-
-			if err := sr.Skip(mh.ValueType); err != nil {
-				return nil, err
-			}
+	if v.Counters != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 136, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_I64_Encode(v.Counters, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
 		}
-		return nil, sr.ReadMapEnd()
 	}
 
-	o := make(map[string][]byte, mh.Length)
-	for i := 0; i < mh.Length; i++ {
-		k, err := sr.ReadString()
-		if err != nil {
-			return nil, err
+	if v.NotifyOnActivityBatchComplete != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 137, Type: wire.TBool}); err != nil {
+			return err
 		}
+		if err := sw.WriteBool(*(v.NotifyOnActivityBatchComplete)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
 
-		v, err := sr.ReadBinary()
-		if err != nil {
-			return nil, err
+	if v.ActivityBatchCompleted != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 138, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.ActivityBatchCompleted)); err != nil {
+			return err
 		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
 
-		o[k] = v
+	if v.ActivityBatchNotifiedStartedID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 139, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ActivityBatchNotifiedStartedID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	if err = sr.ReadMapEnd(); err != nil {
-		return nil, err
+	if v.Priority != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 140, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Priority)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
-	return o, err
+
+	return sw.WriteStructEnd()
 }
 
 // Decode deserializes a WorkflowExecutionInfo struct directly from its Thrift-level
@@ -16479,6 +19419,52 @@ func (v *WorkflowExecutionInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 134 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.MinimumIntervalSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 136 && fh.Type == wire.TMap:
+			v.Counters, err = _Map_String_I64_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 137 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.NotifyOnActivityBatchComplete = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 138 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.ActivityBatchCompleted = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 139 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ActivityBatchNotifiedStartedID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 140 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Priority = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -16508,7 +19494,7 @@ func (v *WorkflowExecutionInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [62]string
+	var fields [68]string
 	i := 0
 	if v.ParentDomainID != nil {
 		fields[i] = fmt.Sprintf("ParentDomainID: %v", v.ParentDomainID)
@@ -16758,25 +19744,32 @@ func (v *WorkflowExecutionInfo) String() string {
 		fields[i] = fmt.Sprintf("ChecksumEncoding: %v", *(v.ChecksumEncoding))
 		i++
 	}
-
-	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _Map_String_Binary_Equals(lhs, rhs map[string][]byte) bool {
-	if len(lhs) != len(rhs) {
-		return false
+	if v.MinimumIntervalSeconds != nil {
+		fields[i] = fmt.Sprintf("MinimumIntervalSeconds: %v", *(v.MinimumIntervalSeconds))
+		i++
 	}
-
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
-		if !bytes.Equal(lv, rv) {
-			return false
-		}
+	if v.Counters != nil {
+		fields[i] = fmt.Sprintf("Counters: %v", v.Counters)
+		i++
 	}
-	return true
+	if v.NotifyOnActivityBatchComplete != nil {
+		fields[i] = fmt.Sprintf("NotifyOnActivityBatchComplete: %v", *(v.NotifyOnActivityBatchComplete))
+		i++
+	}
+	if v.ActivityBatchCompleted != nil {
+		fields[i] = fmt.Sprintf("ActivityBatchCompleted: %v", *(v.ActivityBatchCompleted))
+		i++
+	}
+	if v.ActivityBatchNotifiedStartedID != nil {
+		fields[i] = fmt.Sprintf("ActivityBatchNotifiedStartedID: %v", *(v.ActivityBatchNotifiedStartedID))
+		i++
+	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
 // Equals returns true if all the fields of this WorkflowExecutionInfo match the
@@ -16975,21 +19968,28 @@ func (v *WorkflowExecutionInfo) Equals(rhs *WorkflowExecutionInfo) bool {
 	if !_String_EqualsPtr(v.ChecksumEncoding, rhs.ChecksumEncoding) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.MinimumIntervalSeconds, rhs.MinimumIntervalSeconds) {
+		return false
+	}
+	if !((v.Counters == nil && rhs.Counters == nil) || (v.Counters != nil && rhs.Counters != nil && _Map_String_I64_Equals(v.Counters, rhs.Counters))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.NotifyOnActivityBatchComplete, rhs.NotifyOnActivityBatchComplete) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ActivityBatchCompleted, rhs.ActivityBatchCompleted) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ActivityBatchNotifiedStartedID, rhs.ActivityBatchNotifiedStartedID) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
 
 	return true
 }
 
-type _Map_String_Binary_Zapper map[string][]byte
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of _Map_String_Binary_Zapper.
-func (m _Map_String_Binary_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	for k, v := range m {
-		enc.AddString((string)(k), base64.StdEncoding.EncodeToString(v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of WorkflowExecutionInfo.
 func (v *WorkflowExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -17182,6 +20182,24 @@ func (v *WorkflowExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	if v.ChecksumEncoding != nil {
 		enc.AddString("checksumEncoding", *v.ChecksumEncoding)
 	}
+	if v.MinimumIntervalSeconds != nil {
+		enc.AddInt32("minimumIntervalSeconds", *v.MinimumIntervalSeconds)
+	}
+	if v.Counters != nil {
+		err = multierr.Append(err, enc.AddObject("counters", (_Map_String_I64_Zapper)(v.Counters)))
+	}
+	if v.NotifyOnActivityBatchComplete != nil {
+		enc.AddBool("notifyOnActivityBatchComplete", *v.NotifyOnActivityBatchComplete)
+	}
+	if v.ActivityBatchCompleted != nil {
+		enc.AddBool("activityBatchCompleted", *v.ActivityBatchCompleted)
+	}
+	if v.ActivityBatchNotifiedStartedID != nil {
+		enc.AddInt64("activityBatchNotifiedStartedID", *v.ActivityBatchNotifiedStartedID)
+	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
 	return err
 }
 
@@ -18115,16 +21133,106 @@ func (v *WorkflowExecutionInfo) IsSetChecksumEncoding() bool {
 	return v != nil && v.ChecksumEncoding != nil
 }
 
+// GetMinimumIntervalSeconds returns the value of MinimumIntervalSeconds if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetMinimumIntervalSeconds() (o int32) {
+	if v != nil && v.MinimumIntervalSeconds != nil {
+		return *v.MinimumIntervalSeconds
+	}
+
+	return
+}
+
+// IsSetMinimumIntervalSeconds returns true if MinimumIntervalSeconds is not nil.
+func (v *WorkflowExecutionInfo) IsSetMinimumIntervalSeconds() bool {
+	return v != nil && v.MinimumIntervalSeconds != nil
+}
+
+// GetCounters returns the value of Counters if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetCounters() (o map[string]int64) {
+	if v != nil && v.Counters != nil {
+		return v.Counters
+	}
+
+	return
+}
+
+// IsSetCounters returns true if Counters is not nil.
+func (v *WorkflowExecutionInfo) IsSetCounters() bool {
+	return v != nil && v.Counters != nil
+}
+
+// GetNotifyOnActivityBatchComplete returns the value of NotifyOnActivityBatchComplete if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetNotifyOnActivityBatchComplete() (o bool) {
+	if v != nil && v.NotifyOnActivityBatchComplete != nil {
+		return *v.NotifyOnActivityBatchComplete
+	}
+
+	return
+}
+
+// IsSetNotifyOnActivityBatchComplete returns true if NotifyOnActivityBatchComplete is not nil.
+func (v *WorkflowExecutionInfo) IsSetNotifyOnActivityBatchComplete() bool {
+	return v != nil && v.NotifyOnActivityBatchComplete != nil
+}
+
+// GetActivityBatchCompleted returns the value of ActivityBatchCompleted if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetActivityBatchCompleted() (o bool) {
+	if v != nil && v.ActivityBatchCompleted != nil {
+		return *v.ActivityBatchCompleted
+	}
+
+	return
+}
+
+// IsSetActivityBatchCompleted returns true if ActivityBatchCompleted is not nil.
+func (v *WorkflowExecutionInfo) IsSetActivityBatchCompleted() bool {
+	return v != nil && v.ActivityBatchCompleted != nil
+}
+
+// GetActivityBatchNotifiedStartedID returns the value of ActivityBatchNotifiedStartedID if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetActivityBatchNotifiedStartedID() (o int64) {
+	if v != nil && v.ActivityBatchNotifiedStartedID != nil {
+		return *v.ActivityBatchNotifiedStartedID
+	}
+
+	return
+}
+
+// IsSetActivityBatchNotifiedStartedID returns true if ActivityBatchNotifiedStartedID is not nil.
+func (v *WorkflowExecutionInfo) IsSetActivityBatchNotifiedStartedID() bool {
+	return v != nil && v.ActivityBatchNotifiedStartedID != nil
+}
+
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *WorkflowExecutionInfo) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "sqlblobs",
 	Package:  "github.com/uber/cadence/.gen/go/sqlblobs",
 	FilePath: "sqlblobs.thrift",
-	SHA1:     "07563bc49d96c73d414ed5ce6fd4a74202911035",
+	SHA1:     "4ce3d833d851e7aabdfe760d984180f64e665a2e",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n  42: optional binary pendingFailoverMarkers\n  44: optional string pendingFailoverMarkersEncoding\n  46: optional map<string, i64> replicationDlqAckLevel\n  50: optional binary transferProcessingQueueStates\n  51: optional string transferProcessingQueueStatesEncoding\n  55: optional binary timerProcessingQueueStates\n  56: optional string timerProcessingQueueStatesEncoding\n  60: optional binary crossClusterProcessingQueueStates\n  61: optional string crossClusterProcessingQueueStatesEncoding\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n  50: optional i64 (js.type = \"Long\") failoverEndTime\n  52: optional i64 (js.type = \"Long\") previousFailoverVersion\n  54: optional i64 (js.type = \"Long\") lastUpdatedTime\n  56: optional binary isolationGroupsConfiguration\n  58: optional string isolationGroupsConfigurationEncoding\n  60: optional binary asyncWorkflowConfiguration\n  62: optional string asyncWorkflowConfigurationEncoding\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional binary versionHistories\n  124: optional string versionHistoriesEncoding\n  126: optional binary firstExecutionRunID\n  128: optional map<string, string> partitionConfig\n  130: optional binary checksum\n  132: optional string checksumEncoding\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  29: optional string domainID\n  30: optional string domainName // deprecated\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  // TaskID is a misleading variable, it actually serves\n  // the purpose of indicating whether a timer task is\n  // generated for this timer info\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n  17: optional map<string, string> partitionConfig\n}\n\nstruct TaskListPartition {\n    10: optional list<string> isolationGroups\n}\n\nstruct TaskListPartitionConfig {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i32 numReadPartitions\n  14: optional i32 numWritePartitions\n  16: optional map<i32, TaskListPartition> readPartitions\n  18: optional map<i32, TaskListPartition> writePartitions\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n  18: optional TaskListPartitionConfig adaptivePartitionConfig\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n  34: optional set<binary> targetDomainIDs\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  34: optional binary newRunBranchToken\n  38: optional i64 (js.type = \"Long\") creationTime\n}\n\nenum AsyncRequestType {\n  StartWorkflowExecutionAsyncRequest\n  SignalWithStartWorkflowExecutionAsyncRequest\n}\n\nstruct AsyncRequestMessage {\n  10: optional string partitionKey\n  12: optional AsyncRequestType type\n  14: optional shared.Header header\n  16: optional string encoding\n  18: optional binary payload\n}\n"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n  42: optional binary pendingFailoverMarkers\n  44: optional string pendingFailoverMarkersEncoding\n  46: optional map<string, i64> replicationDlqAckLevel\n  50: optional binary transferProcessingQueueStates\n  51: optional string transferProcessingQueueStatesEncoding\n  55: optional binary timerProcessingQueueStates\n  56: optional string timerProcessingQueueStatesEncoding\n  60: optional binary crossClusterProcessingQueueStates\n  61: optional string crossClusterProcessingQueueStatesEncoding\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n  50: optional i64 (js.type = \"Long\") failoverEndTime\n  52: optional i64 (js.type = \"Long\") previousFailoverVersion\n  54: optional i64 (js.type = \"Long\") lastUpdatedTime\n  56: optional binary isolationGroupsConfiguration\n  58: optional string isolationGroupsConfigurationEncoding\n  60: optional binary asyncWorkflowConfiguration\n  62: optional string asyncWorkflowConfigurationEncoding\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional binary versionHistories\n  124: optional string versionHistoriesEncoding\n  126: optional binary firstExecutionRunID\n  128: optional map<string, string> partitionConfig\n  130: optional binary checksum\n  132: optional string checksumEncoding\n  134: optional i32 minimumIntervalSeconds\n  136: optional map<string, i64> counters\n  137: optional bool notifyOnActivityBatchComplete\n  138: optional bool activityBatchCompleted\n  139: optional i64 activityBatchNotifiedStartedID\n  140: optional i32 priority\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n  72: optional string routingKey\n  73: optional i32 visibilityTimeoutSeconds\n  74: optional bool prefetchLeased\n  75: optional string fallbackTaskList\n  76: optional i32 scheduleToStartTimeouts\n  77: optional string encryptionKeyID\n  78: optional binary nextActivity\n  79: optional string nextActivityEncoding\n  80: optional bool atMostOnce\n  81: optional string dependsOnActivityID\n  82: optional i32 onDependencyFailure\n  83: optional list<string> taskListEscalation\n  84: optional i32 firstAttemptStartToCloseTimeoutSeconds\n  85: optional i64 (js.type = \"Long\") maxHeartbeatGapNanos\n  86: optional i32 grantedStartToCloseTimeoutSeconds\n  87: optional i32 maxTotalExecutionSeconds\n  88: optional i64 (js.type = \"Long\") totalExecutionTimeNanos\n  89: optional i64 (js.type = \"Long\") heartbeatSequence\n  90: optional i32 minimumIntervalSeconds\n  91: optional i64 (js.type = \"Long\") cancelRequestedTimeNanos\n  92: optional i32 cancelAckTimeoutSeconds\n  93: optional i32 cancelForceTimeoutSeconds\n  94: optional i64 (js.type = \"Long\") cancelAckTimeoutExceededTimeNanos\n  95: optional i64 (js.type = \"Long\") maintenancePausedTimeNanos\n  96: optional i32 maintenanceTimeoutsDeferred\n  97: optional i32 nackCount\n  98: optional string lastNackReason\n  99: optional i32 cancellationCheckpointGraceSeconds\n  100: optional i64 (js.type = \"Long\") cancelDeliveredTimeNanos\n  101: optional string alertOnFailure\n  102: optional i32 stealTimeoutSeconds\n  103: optional bool idempotent\n  104: optional i32 progressPercent\n  105: optional i32 stalledHeartbeats\n  106: optional i64 (js.type = \"Long\") heartbeatExpiredTimeNanos\n  107: optional binary pendingSignals\n  108: optional string pendingSignalsEncoding\n  109: optional map<string, binary> searchAttributes\n  110: optional binary rescheduleReasons\n  111: optional string rescheduleReasonsEncoding\n  112: optional binary resultSearchAttributes\n  113: optional string resultSearchAttributesEncoding\n  114: optional i32 priority\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  29: optional string domainID\n  30: optional string domainName // deprecated\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  // TaskID is a misleading variable, it actually serves\n  // the purpose of indicating whether a timer task is\n  // generated for this timer info\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n  17: optional map<string, string> partitionConfig\n}\n\nstruct TaskListPartition {\n    10: optional list<string> isolationGroups\n}\n\nstruct TaskListPartitionConfig {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i32 numReadPartitions\n  14: optional i32 numWritePartitions\n  16: optional map<i32, TaskListPartition> readPartitions\n  18: optional map<i32, TaskListPartition> writePartitions\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n  18: optional TaskListPartitionConfig adaptivePartitionConfig\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n  34: optional set<binary> targetDomainIDs\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  34: optional binary newRunBranchToken\n  38: optional i64 (js.type = \"Long\") creationTime\n}\n\nenum AsyncRequestType {\n  StartWorkflowExecutionAsyncRequest\n  SignalWithStartWorkflowExecutionAsyncRequest\n}\n\nstruct AsyncRequestMessage {\n  10: optional string partitionKey\n  12: optional AsyncRequestType type\n  14: optional shared.Header header\n  16: optional string encoding\n  18: optional binary payload\n}\n"
@@ -520,14 +520,16 @@ func (m *PollForDecisionTaskResponse) GetAutoConfigHint() *v1.AutoConfigHint {
 }
 
 type PollForActivityTaskRequest struct {
-	Request              *v1.PollForActivityTaskRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	DomainId             string                         `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	PollerId             string                         `protobuf:"bytes,3,opt,name=poller_id,json=pollerId,proto3" json:"poller_id,omitempty"`
-	ForwardedFrom        string                         `protobuf:"bytes,4,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
-	IsolationGroup       string                         `protobuf:"bytes,5,opt,name=isolation_group,json=isolationGroup,proto3" json:"isolation_group,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+	Request        *v1.PollForActivityTaskRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId       string                         `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	PollerId       string                         `protobuf:"bytes,3,opt,name=poller_id,json=pollerId,proto3" json:"poller_id,omitempty"`
+	ForwardedFrom  string                         `protobuf:"bytes,4,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
+	IsolationGroup string                         `protobuf:"bytes,5,opt,name=isolation_group,json=isolationGroup,proto3" json:"isolation_group,omitempty"`
+	// Number of additional activity tasks leased to the poller together with the polled one.
+	PrefetchCount        int32    `protobuf:"varint,6,opt,name=prefetch_count,json=prefetchCount,proto3" json:"prefetch_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PollForActivityTaskRequest) Reset()         { *m = PollForActivityTaskRequest{} }
@@ -598,6 +600,13 @@ func (m *PollForActivityTaskRequest) GetIsolationGroup() string {
 	return ""
 }
 
+func (m *PollForActivityTaskRequest) GetPrefetchCount() int32 {
+	if m != nil {
+		return m.PrefetchCount
+	}
+	return 0
+}
+
 type PollForActivityTaskResponse struct {
 	TaskToken                  []byte                   `protobuf:"bytes,1,opt,name=task_token,json=taskToken,proto3" json:"task_token,omitempty"`
 	WorkflowExecution          *v1.WorkflowExecution    `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -618,9 +627,11 @@ type PollForActivityTaskResponse struct {
 	LoadBalancerHints          *LoadBalancerHints       `protobuf:"bytes,17,opt,name=load_balancer_hints,json=loadBalancerHints,proto3" json:"load_balancer_hints,omitempty"`
 	PartitionConfig            *TaskListPartitionConfig `protobuf:"bytes,19,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
 	AutoConfigHint             *v1.AutoConfigHint       `protobuf:"bytes,20,opt,name=auto_config_hint,json=autoConfigHint,proto3" json:"auto_config_hint,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{}                 `json:"-"`
-	XXX_unrecognized           []byte                   `json:"-"`
-	XXX_sizecache              int32                    `json:"-"`
+	// Tasks leased to the poller ahead of execution, see prefetch_count of the request.
+	PrefetchedTasks      []*PollForActivityTaskResponse `protobuf:"bytes,21,rep,name=prefetched_tasks,json=prefetchedTasks,proto3" json:"prefetched_tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
 func (m *PollForActivityTaskResponse) Reset()         { *m = PollForActivityTaskResponse{} }
@@ -789,6 +800,13 @@ func (m *PollForActivityTaskResponse) GetAutoConfigHint() *v1.AutoConfigHint {
 	return nil
 }
 
+func (m *PollForActivityTaskResponse) GetPrefetchedTasks() []*PollForActivityTaskResponse {
+	if m != nil {
+		return m.PrefetchedTasks
+	}
+	return nil
+}
+
 type AddDecisionTaskRequest struct {
 	DomainId               string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	WorkflowExecution      *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -950,6 +968,7 @@ type AddActivityTaskRequest struct {
 	ForwardedFrom            string                    `protobuf:"bytes,8,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
 	ActivityTaskDispatchInfo *ActivityTaskDispatchInfo `protobuf:"bytes,9,opt,name=activityTaskDispatchInfo,proto3" json:"activityTaskDispatchInfo,omitempty"`
 	PartitionConfig          map[string]string         `protobuf:"bytes,10,rep,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Priority                 *types.Int32Value         `protobuf:"bytes,11,opt,name=priority,proto3" json:"priority,omitempty"`
 	XXX_NoUnkeyedLiteral     struct{}                  `json:"-"`
 	XXX_unrecognized         []byte                    `json:"-"`
 	XXX_sizecache            int32                     `json:"-"`
@@ -1058,6 +1077,13 @@ func (m *AddActivityTaskRequest) GetPartitionConfig() map[string]string {
 	return nil
 }
 
+func (m *AddActivityTaskRequest) GetPriority() *types.Int32Value {
+	if m != nil {
+		return m.Priority
+	}
+	return nil
+}
+
 type ActivityTaskDispatchInfo struct {
 	ScheduledEvent             *v1.HistoryEvent `protobuf:"bytes,1,opt,name=scheduled_event,json=scheduledEvent,proto3" json:"scheduled_event,omitempty"`
 	StartedTime                *types.Timestamp `protobuf:"bytes,2,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
@@ -1154,10 +1180,12 @@ func (m *ActivityTaskDispatchInfo) GetWorkflowDomain() string {
 }
 
 type AddActivityTaskResponse struct {
-	PartitionConfig      *TaskListPartitionConfig `protobuf:"bytes,1,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	PartitionConfig *TaskListPartitionConfig `protobuf:"bytes,1,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
+	// Visibility timeout matching applies when the task is sync matched, not set if disabled.
+	VisibilityTimeout    *types.Duration `protobuf:"bytes,2,opt,name=visibility_timeout,json=visibilityTimeout,proto3" json:"visibility_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *AddActivityTaskResponse) Reset()         { *m = AddActivityTaskResponse{} }
@@ -1200,6 +1228,13 @@ func (m *AddActivityTaskResponse) GetPartitionConfig() *TaskListPartitionConfig
 	return nil
 }
 
+func (m *AddActivityTaskResponse) GetVisibilityTimeout() *types.Duration {
+	if m != nil {
+		return m.VisibilityTimeout
+	}
+	return nil
+}
+
 type QueryWorkflowRequest struct {
 	Request              *v1.QueryWorkflowRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                   `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -1546,6 +1581,108 @@ func (m *CancelOutstandingPollResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CancelOutstandingPollResponse proto.InternalMessageInfo
 
+type RecordBackpressureHintRequest struct {
+	DomainId             string       `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	TaskList             *v1.TaskList `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	Hint                 float64      `protobuf:"fixed64,3,opt,name=hint,proto3" json:"hint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RecordBackpressureHintRequest) Reset()         { *m = RecordBackpressureHintRequest{} }
+func (m *RecordBackpressureHintRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordBackpressureHintRequest) ProtoMessage()    {}
+func (*RecordBackpressureHintRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{18}
+}
+func (m *RecordBackpressureHintRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordBackpressureHintRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordBackpressureHintRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordBackpressureHintRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordBackpressureHintRequest.Merge(m, src)
+}
+func (m *RecordBackpressureHintRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordBackpressureHintRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordBackpressureHintRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordBackpressureHintRequest proto.InternalMessageInfo
+
+func (m *RecordBackpressureHintRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *RecordBackpressureHintRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *RecordBackpressureHintRequest) GetHint() float64 {
+	if m != nil {
+		return m.Hint
+	}
+	return 0
+}
+
+type RecordBackpressureHintResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecordBackpressureHintResponse) Reset()         { *m = RecordBackpressureHintResponse{} }
+func (m *RecordBackpressureHintResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordBackpressureHintResponse) ProtoMessage()    {}
+func (*RecordBackpressureHintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{19}
+}
+func (m *RecordBackpressureHintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordBackpressureHintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordBackpressureHintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordBackpressureHintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordBackpressureHintResponse.Merge(m, src)
+}
+func (m *RecordBackpressureHintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordBackpressureHintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordBackpressureHintResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordBackpressureHintResponse proto.InternalMessageInfo
+
 type DescribeTaskListRequest struct {
 	Request              *v1.DescribeTaskListRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                      `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -1558,7 +1695,7 @@ func (m *DescribeTaskListRequest) Reset()         { *m = DescribeTaskListRequest
 func (m *DescribeTaskListRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeTaskListRequest) ProtoMessage()    {}
 func (*DescribeTaskListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{18}
+	return fileDescriptor_826e827d3aabf7fc, []int{20}
 }
 func (m *DescribeTaskListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1614,7 +1751,7 @@ func (m *DescribeTaskListResponse) Reset()         { *m = DescribeTaskListRespon
 func (m *DescribeTaskListResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeTaskListResponse) ProtoMessage()    {}
 func (*DescribeTaskListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{19}
+	return fileDescriptor_826e827d3aabf7fc, []int{21}
 }
 func (m *DescribeTaskListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1676,7 +1813,7 @@ func (m *ListTaskListPartitionsRequest) Reset()         { *m = ListTaskListParti
 func (m *ListTaskListPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListTaskListPartitionsRequest) ProtoMessage()    {}
 func (*ListTaskListPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{20}
+	return fileDescriptor_826e827d3aabf7fc, []int{22}
 }
 func (m *ListTaskListPartitionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1731,7 +1868,7 @@ func (m *ListTaskListPartitionsResponse) Reset()         { *m = ListTaskListPart
 func (m *ListTaskListPartitionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListTaskListPartitionsResponse) ProtoMessage()    {}
 func (*ListTaskListPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{21}
+	return fileDescriptor_826e827d3aabf7fc, []int{23}
 }
 func (m *ListTaskListPartitionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1785,7 +1922,7 @@ func (m *GetTaskListsByDomainRequest) Reset()         { *m = GetTaskListsByDomai
 func (m *GetTaskListsByDomainRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTaskListsByDomainRequest) ProtoMessage()    {}
 func (*GetTaskListsByDomainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{22}
+	return fileDescriptor_826e827d3aabf7fc, []int{24}
 }
 func (m *GetTaskListsByDomainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1833,7 +1970,7 @@ func (m *GetTaskListsByDomainResponse) Reset()         { *m = GetTaskListsByDoma
 func (m *GetTaskListsByDomainResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTaskListsByDomainResponse) ProtoMessage()    {}
 func (*GetTaskListsByDomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{23}
+	return fileDescriptor_826e827d3aabf7fc, []int{25}
 }
 func (m *GetTaskListsByDomainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1890,7 +2027,7 @@ func (m *UpdateTaskListPartitionConfigRequest) Reset()         { *m = UpdateTask
 func (m *UpdateTaskListPartitionConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateTaskListPartitionConfigRequest) ProtoMessage()    {}
 func (*UpdateTaskListPartitionConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{24}
+	return fileDescriptor_826e827d3aabf7fc, []int{26}
 }
 func (m *UpdateTaskListPartitionConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1957,7 +2094,7 @@ func (m *UpdateTaskListPartitionConfigResponse) Reset()         { *m = UpdateTas
 func (m *UpdateTaskListPartitionConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateTaskListPartitionConfigResponse) ProtoMessage()    {}
 func (*UpdateTaskListPartitionConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{25}
+	return fileDescriptor_826e827d3aabf7fc, []int{27}
 }
 func (m *UpdateTaskListPartitionConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2000,7 +2137,7 @@ func (m *RefreshTaskListPartitionConfigRequest) Reset()         { *m = RefreshTa
 func (m *RefreshTaskListPartitionConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*RefreshTaskListPartitionConfigRequest) ProtoMessage()    {}
 func (*RefreshTaskListPartitionConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{26}
+	return fileDescriptor_826e827d3aabf7fc, []int{28}
 }
 func (m *RefreshTaskListPartitionConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2069,7 +2206,7 @@ func (m *RefreshTaskListPartitionConfigResponse) Reset() {
 func (m *RefreshTaskListPartitionConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*RefreshTaskListPartitionConfigResponse) ProtoMessage()    {}
 func (*RefreshTaskListPartitionConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{27}
+	return fileDescriptor_826e827d3aabf7fc, []int{29}
 }
 func (m *RefreshTaskListPartitionConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2098,6 +2235,148 @@ func (m *RefreshTaskListPartitionConfigResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RefreshTaskListPartitionConfigResponse proto.InternalMessageInfo
 
+type RemoveTaskListTaskRequest struct {
+	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	TaskList             *v1.TaskList          `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TaskId               int64                 `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,4,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	ScheduleId           int64                 `protobuf:"varint,5,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Operator             string                `protobuf:"bytes,6,opt,name=operator,proto3" json:"operator,omitempty"`
+	Reason               string                `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RemoveTaskListTaskRequest) Reset()         { *m = RemoveTaskListTaskRequest{} }
+func (m *RemoveTaskListTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveTaskListTaskRequest) ProtoMessage()    {}
+func (*RemoveTaskListTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{30}
+}
+func (m *RemoveTaskListTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveTaskListTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveTaskListTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveTaskListTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveTaskListTaskRequest.Merge(m, src)
+}
+func (m *RemoveTaskListTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveTaskListTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveTaskListTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveTaskListTaskRequest proto.InternalMessageInfo
+
+func (m *RemoveTaskListTaskRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *RemoveTaskListTaskRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *RemoveTaskListTaskRequest) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *RemoveTaskListTaskRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *RemoveTaskListTaskRequest) GetScheduleId() int64 {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return 0
+}
+
+func (m *RemoveTaskListTaskRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *RemoveTaskListTaskRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type RemoveTaskListTaskResponse struct {
+	TaskId               int64    `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveTaskListTaskResponse) Reset()         { *m = RemoveTaskListTaskResponse{} }
+func (m *RemoveTaskListTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveTaskListTaskResponse) ProtoMessage()    {}
+func (*RemoveTaskListTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{31}
+}
+func (m *RemoveTaskListTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveTaskListTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveTaskListTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveTaskListTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveTaskListTaskResponse.Merge(m, src)
+}
+func (m *RemoveTaskListTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveTaskListTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveTaskListTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveTaskListTaskResponse proto.InternalMessageInfo
+
+func (m *RemoveTaskListTaskResponse) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TaskListPartition)(nil), "uber.cadence.matching.v1.TaskListPartition")
 	proto.RegisterType((*TaskListPartitionConfig)(nil), "uber.cadence.matching.v1.TaskListPartitionConfig")
@@ -2122,6 +2401,8 @@ func init() {
 	proto.RegisterType((*RespondQueryTaskCompletedResponse)(nil), "uber.cadence.matching.v1.RespondQueryTaskCompletedResponse")
 	proto.RegisterType((*CancelOutstandingPollRequest)(nil), "uber.cadence.matching.v1.CancelOutstandingPollRequest")
 	proto.RegisterType((*CancelOutstandingPollResponse)(nil), "uber.cadence.matching.v1.CancelOutstandingPollResponse")
+	proto.RegisterType((*RecordBackpressureHintRequest)(nil), "uber.cadence.matching.v1.RecordBackpressureHintRequest")
+	proto.RegisterType((*RecordBackpressureHintResponse)(nil), "uber.cadence.matching.v1.RecordBackpressureHintResponse")
 	proto.RegisterType((*DescribeTaskListRequest)(nil), "uber.cadence.matching.v1.DescribeTaskListRequest")
 	proto.RegisterType((*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.DescribeTaskListResponse")
 	proto.RegisterType((*ListTaskListPartitionsRequest)(nil), "uber.cadence.matching.v1.ListTaskListPartitionsRequest")
@@ -2134,6 +2415,8 @@ func init() {
 	proto.RegisterType((*UpdateTaskListPartitionConfigResponse)(nil), "uber.cadence.matching.v1.UpdateTaskListPartitionConfigResponse")
 	proto.RegisterType((*RefreshTaskListPartitionConfigRequest)(nil), "uber.cadence.matching.v1.RefreshTaskListPartitionConfigRequest")
 	proto.RegisterType((*RefreshTaskListPartitionConfigResponse)(nil), "uber.cadence.matching.v1.RefreshTaskListPartitionConfigResponse")
+	proto.RegisterType((*RemoveTaskListTaskRequest)(nil), "uber.cadence.matching.v1.RemoveTaskListTaskRequest")
+	proto.RegisterType((*RemoveTaskListTaskResponse)(nil), "uber.cadence.matching.v1.RemoveTaskListTaskResponse")
 }
 
 func init() {
@@ -2141,165 +2424,181 @@ func init() {
 }
 
 var fileDescriptor_826e827d3aabf7fc = []byte{
-	// 2519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0x57, 0xdb, 0x1e, 0x7f, 0xbc, 0xb1, 0xc7, 0x76, 0xd9, 0xeb, 0x74, 0x26, 0xb1, 0xe3, 0x4c,
-	0x36, 0x59, 0x2f, 0x2c, 0xe3, 0xb5, 0x77, 0x13, 0xb2, 0x59, 0xb1, 0xc1, 0x1f, 0x71, 0x32, 0x68,
-	0x43, 0xb2, 0x1d, 0x6f, 0x22, 0xc1, 0x2a, 0x4d, 0x79, 0xba, 0xec, 0x69, 0x3c, 0xd3, 0xdd, 0xe9,
-	0xaa, 0xb6, 0xd7, 0x7b, 0xe0, 0x80, 0x00, 0x21, 0x71, 0x85, 0x3b, 0xb0, 0xfc, 0x1d, 0x9c, 0x39,
-	0x72, 0x44, 0x5a, 0x21, 0x41, 0x24, 0xfe, 0x00, 0x90, 0x38, 0x22, 0xa1, 0xfa, 0xe8, 0x99, 0xee,
-	0x99, 0xea, 0xf9, 0xb0, 0x9d, 0x2c, 0x07, 0x6e, 0xee, 0xaa, 0xf7, 0x5d, 0xef, 0xbd, 0xdf, 0xab,
-	0x1a, 0xc3, 0x8d, 0x68, 0x8f, 0x84, 0xab, 0x55, 0xec, 0x10, 0xaf, 0x4a, 0x56, 0x1b, 0x98, 0x55,
-	0x6b, 0xae, 0x77, 0xb0, 0x7a, 0xb4, 0xb6, 0x4a, 0x49, 0x78, 0xe4, 0x56, 0x49, 0x39, 0x08, 0x7d,
-	0xe6, 0x23, 0x93, 0xd3, 0x95, 0x15, 0x5d, 0x39, 0xa6, 0x2b, 0x1f, 0xad, 0x15, 0x97, 0x0e, 0x7c,
-	0xff, 0xa0, 0x4e, 0x56, 0x05, 0xdd, 0x5e, 0xb4, 0xbf, 0xea, 0x44, 0x21, 0x66, 0xae, 0xef, 0x49,
-	0xce, 0xe2, 0x95, 0xf6, 0x7d, 0xe6, 0x36, 0x08, 0x65, 0xb8, 0x11, 0x28, 0x82, 0x0e, 0x01, 0xc7,
-	0x21, 0x0e, 0x02, 0x12, 0x52, 0xb5, 0xbf, 0x9c, 0x32, 0x11, 0x07, 0x2e, 0xb7, 0xae, 0xea, 0x37,
-	0x1a, 0x2d, 0x15, 0x3a, 0x8a, 0x17, 0x11, 0x09, 0x4f, 0x14, 0x41, 0x49, 0x47, 0xc0, 0x30, 0x3d,
-	0xac, 0xbb, 0x94, 0x29, 0x9a, 0x15, 0x1d, 0x8d, 0x0a, 0x82, 0x7d, 0xec, 0x87, 0x87, 0x24, 0x54,
-	0x94, 0xdf, 0xe8, 0x45, 0xb9, 0x5f, 0xf7, 0x8f, 0x15, 0xed, 0x55, 0x1d, 0x6d, 0xcd, 0xa5, 0xcc,
-	0x6f, 0x1a, 0xf7, 0x66, 0x8a, 0x84, 0xd6, 0x70, 0x48, 0x9c, 0x4e, 0xaa, 0xeb, 0x19, 0x54, 0x69,
-	0x2f, 0x4a, 0x1f, 0xc1, 0xec, 0x2e, 0xa6, 0x87, 0x1f, 0xbb, 0x94, 0x3d, 0xc6, 0x21, 0x73, 0xf9,
-	0x41, 0xa0, 0xb7, 0x61, 0xc6, 0xa5, 0x7e, 0x5d, 0x9c, 0x8a, 0x7d, 0x10, 0xfa, 0x51, 0x40, 0x4d,
-	0x63, 0x79, 0x78, 0x65, 0xc2, 0x9a, 0x6e, 0xae, 0xdf, 0x17, 0xcb, 0xa5, 0xbf, 0x8f, 0xc0, 0x85,
-	0x0e, 0x01, 0x5b, 0xbe, 0xb7, 0xef, 0x1e, 0x20, 0x13, 0xc6, 0x8e, 0x48, 0x48, 0x5d, 0xdf, 0x33,
-	0x8d, 0x65, 0x63, 0x65, 0xd8, 0x8a, 0x3f, 0xd1, 0x3a, 0xcc, 0x79, 0x51, 0xc3, 0x0e, 0x09, 0x76,
-	0xec, 0x20, 0xe6, 0xa2, 0xe6, 0xd0, 0xb2, 0xb1, 0x92, 0xdb, 0x1c, 0x32, 0x0d, 0x6b, 0xd6, 0x8b,
-	0x1a, 0x16, 0xc1, 0x4e, 0x53, 0x24, 0x45, 0xef, 0xc3, 0x3c, 0xe7, 0x39, 0x0e, 0x5d, 0x46, 0x92,
-	0x4c, 0xc3, 0x4d, 0x26, 0xe4, 0x45, 0x8d, 0x67, 0x7c, 0x3b, 0xc1, 0xe5, 0xc1, 0x74, 0xbb, 0x96,
-	0x91, 0xe5, 0xe1, 0x95, 0xfc, 0xfa, 0xbd, 0x72, 0x56, 0x86, 0x96, 0x33, 0xfc, 0x29, 0xa7, 0x0d,
-	0xba, 0xe7, 0xb1, 0xf0, 0xc4, 0x2a, 0x84, 0x69, 0x2b, 0x5f, 0xc0, 0x4c, 0x87, 0x85, 0x39, 0xa1,
-	0x70, 0x67, 0x70, 0x85, 0x6d, 0xce, 0x48, 0x8d, 0xd3, 0xc7, 0xe9, 0xd5, 0xa2, 0x07, 0x73, 0x1a,
-	0xcb, 0xd0, 0x0c, 0x0c, 0x1f, 0x92, 0x13, 0x11, 0xf9, 0x9c, 0xc5, 0xff, 0x44, 0x1b, 0x90, 0x3b,
-	0xc2, 0xf5, 0x88, 0x88, 0x38, 0xe7, 0xd7, 0xbf, 0x39, 0x80, 0x41, 0x96, 0xe4, 0xbc, 0x33, 0x74,
-	0xdb, 0x28, 0xfa, 0x30, 0xaf, 0x33, 0xec, 0x95, 0x29, 0x2c, 0xfd, 0x08, 0x66, 0x3f, 0xf6, 0xb1,
-	0xb3, 0x89, 0xeb, 0xd8, 0xab, 0x92, 0xf0, 0x81, 0xeb, 0x31, 0x8a, 0xae, 0xc1, 0xd4, 0x1e, 0xae,
-	0x1e, 0xd6, 0xfd, 0x03, 0xbb, 0xea, 0x47, 0x1e, 0x53, 0x29, 0x36, 0xa9, 0x16, 0xb7, 0xf8, 0x1a,
-	0xba, 0x01, 0xd3, 0x21, 0xe6, 0x87, 0x41, 0x42, 0x9b, 0x92, 0xaa, 0xef, 0x39, 0xc2, 0x14, 0xc3,
-	0x9a, 0xe2, 0xcb, 0x8f, 0x49, 0xf8, 0x44, 0x2c, 0x96, 0xfe, 0x69, 0x40, 0xf1, 0xb1, 0x5f, 0xaf,
-	0xef, 0xf8, 0xe1, 0x36, 0xa9, 0xba, 0x3c, 0x47, 0xb9, 0x45, 0x16, 0x79, 0x11, 0x11, 0xca, 0x50,
-	0x05, 0xc6, 0x42, 0xf9, 0xa7, 0xd0, 0x92, 0x5f, 0x5f, 0x4d, 0x7b, 0x82, 0x03, 0x97, 0x3b, 0x91,
-	0x2d, 0xc1, 0x8a, 0xf9, 0xd1, 0x25, 0x98, 0x70, 0xfc, 0x06, 0x76, 0x3d, 0xdb, 0x95, 0xb6, 0x4c,
-	0x58, 0xe3, 0x72, 0xa1, 0xe2, 0xf0, 0xcd, 0xc0, 0xaf, 0xd7, 0x49, 0xc8, 0x37, 0x87, 0xe5, 0xa6,
-	0x5c, 0xa8, 0x38, 0xe8, 0x3a, 0x14, 0xf6, 0xfd, 0xf0, 0x18, 0x87, 0x0e, 0x71, 0xec, 0xfd, 0xd0,
-	0x6f, 0x98, 0x23, 0x82, 0x62, 0xaa, 0xb9, 0xba, 0x13, 0xfa, 0x0d, 0xf4, 0x16, 0x4c, 0xb7, 0xd5,
-	0xae, 0x99, 0x13, 0x74, 0x85, 0x74, 0xe9, 0x96, 0xfe, 0x98, 0x87, 0x4b, 0x5a, 0x8b, 0x69, 0xe0,
-	0x7b, 0x94, 0xa0, 0x45, 0x00, 0xde, 0x2b, 0x6c, 0xe6, 0x1f, 0x12, 0x59, 0xc0, 0x93, 0xd6, 0x04,
-	0x5f, 0xd9, 0xe5, 0x0b, 0xe8, 0x53, 0x40, 0x71, 0xeb, 0xb2, 0xc9, 0xe7, 0xa4, 0x1a, 0x71, 0xc9,
-	0xea, 0xa0, 0x6f, 0x68, 0xc3, 0xf3, 0x4c, 0x91, 0xdf, 0x8b, 0xa9, 0xad, 0xd9, 0xe3, 0xf6, 0x25,
-	0xb4, 0x03, 0x53, 0x4d, 0xb1, 0xec, 0x24, 0x20, 0x22, 0x0c, 0xf9, 0xf5, 0xab, 0x5d, 0x25, 0xee,
-	0x9e, 0x04, 0xc4, 0x9a, 0x3c, 0x4e, 0x7c, 0xa1, 0xa7, 0x70, 0x31, 0x08, 0xc9, 0x91, 0xeb, 0x47,
-	0xd4, 0xa6, 0x0c, 0x87, 0x8c, 0x38, 0x36, 0x39, 0x22, 0x1e, 0xe3, 0xa1, 0x1d, 0x11, 0x32, 0x2f,
-	0x95, 0x25, 0x90, 0x94, 0x63, 0x20, 0x29, 0x57, 0x3c, 0x76, 0xeb, 0xfd, 0xa7, 0x3c, 0xef, 0xac,
-	0x85, 0x98, 0xfb, 0x89, 0x64, 0xbe, 0xc7, 0x79, 0x2b, 0x0e, 0x5a, 0x81, 0x99, 0x0e, 0x71, 0x39,
-	0x91, 0x79, 0x05, 0x9a, 0xa6, 0x34, 0x61, 0x0c, 0x33, 0x46, 0x1a, 0x01, 0x33, 0x47, 0x45, 0x49,
-	0xc4, 0x9f, 0xa8, 0x04, 0x53, 0x1e, 0xf9, 0x9c, 0xb5, 0x04, 0x8c, 0x09, 0x01, 0x79, 0xbe, 0x18,
-	0x73, 0xbf, 0x03, 0x28, 0x95, 0xde, 0x76, 0xcd, 0xf5, 0x98, 0x39, 0x2e, 0x08, 0x67, 0x92, 0x39,
-	0xce, 0xab, 0x01, 0xdd, 0x06, 0x93, 0x32, 0xb7, 0x7a, 0x78, 0xd2, 0x3a, 0x0a, 0x9b, 0x78, 0x78,
-	0xaf, 0x4e, 0x1c, 0x73, 0x62, 0xd9, 0x58, 0x19, 0xb7, 0x16, 0xe4, 0x7e, 0x33, 0xd0, 0xf7, 0xe4,
-	0x2e, 0xba, 0x0d, 0x39, 0x01, 0x7c, 0x26, 0x88, 0x98, 0x94, 0xba, 0xc6, 0xf9, 0x13, 0x4e, 0x69,
-	0x49, 0x06, 0x64, 0xc1, 0x94, 0xa3, 0xf2, 0xc6, 0x76, 0xbd, 0x7d, 0xdf, 0xcc, 0x0b, 0x09, 0xdf,
-	0x4a, 0x4b, 0x90, 0xc0, 0x23, 0x4a, 0x3c, 0xc4, 0x1e, 0x75, 0x89, 0xc7, 0xe2, 0x6c, 0xab, 0x78,
-	0xfb, 0xbe, 0x35, 0xe9, 0x24, 0xbe, 0xd0, 0x73, 0xb8, 0xdc, 0x99, 0x54, 0xb6, 0x48, 0x43, 0x8e,
-	0x59, 0xe6, 0xa4, 0x50, 0xb1, 0xa8, 0x35, 0x32, 0x6e, 0x21, 0xd6, 0xc5, 0x8e, 0xac, 0x8a, 0xb7,
-	0x50, 0x19, 0xe6, 0x64, 0xd0, 0x39, 0x52, 0x12, 0x3b, 0x46, 0xa7, 0x29, 0x71, 0x3e, 0xb3, 0x62,
-	0xeb, 0x09, 0xdf, 0x79, 0xaa, 0x70, 0xea, 0x2a, 0x4c, 0xee, 0x85, 0xd8, 0xab, 0xd6, 0x54, 0x15,
-	0x14, 0x44, 0x15, 0xe4, 0xe5, 0x9a, 0xac, 0x83, 0x0d, 0x28, 0xd0, 0x6a, 0x8d, 0x38, 0x51, 0x9d,
-	0x38, 0x36, 0x1f, 0x55, 0xcc, 0x69, 0x61, 0x64, 0xb1, 0x23, 0xbb, 0x76, 0xe3, 0x39, 0xc6, 0x9a,
-	0x6a, 0x72, 0xf0, 0x35, 0xf4, 0x1d, 0x98, 0x8c, 0x73, 0x4a, 0x08, 0x98, 0xe9, 0x29, 0x20, 0xaf,
-	0xe8, 0x05, 0xfb, 0x67, 0x30, 0xc6, 0x4f, 0xc4, 0x25, 0xd4, 0x9c, 0x15, 0x48, 0xb3, 0x99, 0xdd,
-	0x67, 0xbb, 0x14, 0x7c, 0xf9, 0x13, 0x29, 0x44, 0xa2, 0x4c, 0x2c, 0x92, 0x87, 0x8c, 0xf9, 0x0c,
-	0xd7, 0x6d, 0x35, 0x5e, 0xd8, 0x7b, 0x27, 0x8c, 0x50, 0x13, 0x89, 0x4c, 0x9c, 0x15, 0x5b, 0x0f,
-	0xe4, 0xce, 0x26, 0xdf, 0x40, 0x9f, 0xc1, 0x4c, 0x13, 0xfa, 0xec, 0xaa, 0xc0, 0x31, 0x73, 0x4e,
-	0x38, 0xb4, 0x36, 0x30, 0x00, 0x5a, 0xd3, 0x41, 0xdb, 0x48, 0xf1, 0x43, 0x98, 0xab, 0xfb, 0xd8,
-	0xb1, 0xf7, 0x14, 0x16, 0x88, 0xb2, 0xa0, 0xe6, 0x7c, 0x2f, 0x7c, 0xe9, 0xc0, 0x0f, 0x6b, 0xb6,
-	0xde, 0x01, 0x29, 0x0f, 0x61, 0x06, 0x47, 0xcc, 0x57, 0x56, 0xcb, 0x8a, 0x7b, 0x43, 0x48, 0xbe,
-	0xa6, 0xcd, 0xb8, 0x8d, 0x88, 0xf9, 0xd2, 0x2e, 0xce, 0x6f, 0x15, 0x70, 0xea, 0xbb, 0xf8, 0x1c,
-	0x26, 0x93, 0x21, 0x4d, 0xe2, 0xe3, 0x84, 0xc4, 0xc7, 0xdb, 0x69, 0x7c, 0xec, 0xab, 0xf8, 0x5a,
-	0xb0, 0x98, 0x00, 0xad, 0x8d, 0x2a, 0x73, 0x8f, 0x5c, 0x76, 0x72, 0x7a, 0xd0, 0xd2, 0x48, 0xf8,
-	0x5f, 0x04, 0xad, 0xdf, 0x40, 0x13, 0xb4, 0xd2, 0x16, 0x7f, 0xad, 0xa0, 0x75, 0x05, 0xf2, 0x58,
-	0x59, 0xd3, 0x0a, 0x02, 0xc4, 0x4b, 0x15, 0x87, 0xa3, 0x5a, 0x93, 0x40, 0xa0, 0xda, 0x48, 0x17,
-	0x54, 0x6b, 0x3a, 0x26, 0x50, 0x0d, 0x27, 0xbe, 0xd0, 0x3a, 0xe4, 0x5c, 0x2f, 0x88, 0x98, 0x88,
-	0x4e, 0x7e, 0xfd, 0xb2, 0xfe, 0x44, 0xf1, 0x09, 0xcf, 0x6d, 0x4b, 0x92, 0x6a, 0x1a, 0xd4, 0xe8,
-	0x59, 0x1b, 0xd4, 0xd8, 0x60, 0x0d, 0x6a, 0x17, 0x2e, 0xc6, 0xf2, 0x6c, 0x5e, 0x5e, 0x75, 0x9f,
-	0x12, 0x21, 0xc8, 0x8f, 0x24, 0xa4, 0xe5, 0xd7, 0x2f, 0x76, 0xc8, 0xda, 0x56, 0xb7, 0x42, 0x6b,
-	0x21, 0xe6, 0xdd, 0xf5, 0xb7, 0x38, 0xe7, 0xae, 0x64, 0x44, 0xdf, 0x87, 0x05, 0xa1, 0xa4, 0x53,
-	0xe4, 0x44, 0x2f, 0x91, 0x73, 0x82, 0xb1, 0x4d, 0xde, 0x0e, 0xcc, 0xd6, 0x08, 0x0e, 0xd9, 0x1e,
-	0xc1, 0xac, 0x29, 0x0a, 0x7a, 0x89, 0x9a, 0x69, 0xf2, 0xc4, 0x72, 0x12, 0xb8, 0x9f, 0x4f, 0xe3,
-	0xfe, 0x73, 0x58, 0x4a, 0x9f, 0x84, 0xed, 0xef, 0xdb, 0xac, 0xe6, 0x52, 0x3b, 0x66, 0x98, 0xec,
-	0x19, 0xd8, 0x62, 0xea, 0x64, 0x1e, 0xed, 0xef, 0xd6, 0x5c, 0xba, 0xa1, 0xe4, 0x57, 0x92, 0x1e,
-	0x38, 0x84, 0x61, 0xb7, 0x4e, 0x05, 0xb6, 0xf5, 0xca, 0x94, 0x96, 0x13, 0xdb, 0x92, 0xab, 0x73,
-	0x0c, 0x2b, 0x9c, 0x6e, 0x0c, 0x7b, 0x0b, 0xa6, 0x9b, 0x72, 0x64, 0xc7, 0x10, 0xf0, 0x38, 0x61,
-	0x15, 0xe2, 0xe5, 0x6d, 0xb1, 0x8a, 0xde, 0x83, 0xd1, 0x1a, 0xc1, 0x0e, 0x09, 0x15, 0xfa, 0x5d,
-	0xd2, 0x6a, 0x7a, 0x20, 0x48, 0x2c, 0x45, 0x9a, 0x85, 0x06, 0xb3, 0xe7, 0x82, 0x06, 0xaf, 0x16,
-	0xc8, 0x74, 0x58, 0x33, 0x7f, 0x6a, 0xac, 0x29, 0xfd, 0x65, 0x04, 0x16, 0x36, 0x1c, 0x47, 0x77,
-	0x79, 0x49, 0x35, 0x6f, 0xa3, 0xad, 0x79, 0xbf, 0xa2, 0x86, 0x78, 0x07, 0x26, 0x5a, 0x43, 0xdb,
-	0x70, 0x3f, 0x43, 0xdb, 0x38, 0x8b, 0x67, 0xb4, 0x2b, 0x90, 0x6f, 0x76, 0x0b, 0x35, 0xab, 0x0f,
-	0x5b, 0x10, 0x2f, 0x55, 0x9c, 0xf6, 0x76, 0xa2, 0x9a, 0x80, 0x2a, 0xd8, 0xdc, 0x00, 0xed, 0x44,
-	0x8c, 0xf6, 0x71, 0xd9, 0xde, 0x81, 0x51, 0xea, 0x47, 0x61, 0x55, 0xb6, 0xc7, 0x42, 0x3b, 0x18,
-	0x27, 0xe6, 0x58, 0x4c, 0x0f, 0x9f, 0x08, 0x4a, 0x4b, 0x71, 0x68, 0x50, 0x6e, 0x4c, 0x87, 0x72,
-	0x81, 0x26, 0xa3, 0xc6, 0x7b, 0x3d, 0x46, 0xe8, 0x4f, 0xb5, 0xdc, 0x96, 0x60, 0xea, 0x69, 0xa0,
-	0x2d, 0xcb, 0x8a, 0x9b, 0x30, 0xaf, 0x23, 0xd4, 0x8c, 0x22, 0xf3, 0xc9, 0x51, 0x64, 0x22, 0x39,
-	0x66, 0x1c, 0xc3, 0x85, 0x0e, 0x1b, 0x14, 0xda, 0xea, 0x4a, 0xc4, 0x38, 0xaf, 0x12, 0x29, 0xfd,
-	0x2b, 0x27, 0x72, 0x5a, 0x37, 0xdb, 0x7c, 0x1d, 0x39, 0xcd, 0x6f, 0x7e, 0xe2, 0xb8, 0xed, 0x96,
-	0x6a, 0x89, 0xf4, 0x05, 0xb9, 0xbe, 0x1d, 0x1b, 0x90, 0xca, 0xfe, 0x91, 0x33, 0x65, 0x7f, 0x6e,
-	0xb0, 0xec, 0x1f, 0x3d, 0x7b, 0xf6, 0x8f, 0x9d, 0x43, 0xf6, 0x8f, 0xeb, 0xb2, 0xdf, 0x03, 0x13,
-	0x27, 0x8e, 0x72, 0xdb, 0xa5, 0x01, 0xcf, 0x0a, 0x7e, 0xef, 0x53, 0x88, 0xbd, 0xde, 0xa5, 0x0a,
-	0x32, 0x38, 0xad, 0x4c, 0x99, 0xda, 0x6a, 0x83, 0x3e, 0xaa, 0x4d, 0x93, 0x6f, 0xaf, 0xb1, 0xda,
-	0xbe, 0x1a, 0x06, 0x33, 0xcb, 0x59, 0xf4, 0x3d, 0x98, 0x6e, 0x0d, 0x10, 0xe2, 0xb6, 0xaa, 0xca,
-	0x4d, 0x8f, 0xcb, 0xea, 0x5e, 0x26, 0x9e, 0x14, 0xac, 0xd6, 0x10, 0x28, 0xbe, 0x3b, 0x66, 0xba,
-	0xa1, 0xc1, 0x66, 0xba, 0xc4, 0x94, 0x33, 0x3c, 0xe8, 0x94, 0x33, 0x72, 0xfe, 0x53, 0x4e, 0xee,
-	0x7c, 0xa6, 0x9c, 0xd1, 0x73, 0x9b, 0x72, 0xc6, 0x74, 0x53, 0x8e, 0xea, 0xa5, 0xda, 0x9b, 0xcb,
-	0xab, 0xed, 0xa5, 0x5f, 0x19, 0x30, 0x2f, 0x2e, 0x90, 0xb1, 0x17, 0x71, 0x27, 0xdd, 0x6a, 0xbf,
-	0x25, 0xbe, 0xad, 0x75, 0x5e, 0xc7, 0xdb, 0xe7, 0xfd, 0xf0, 0x2c, 0xb3, 0x40, 0x7f, 0xd7, 0xc7,
-	0xd2, 0x97, 0x06, 0xbc, 0xd1, 0x66, 0xa1, 0x8a, 0xea, 0x5d, 0x98, 0x14, 0xaf, 0x55, 0x76, 0x48,
-	0x68, 0x54, 0x8f, 0x7d, 0xec, 0x9e, 0x27, 0x79, 0xc1, 0x61, 0x09, 0x06, 0x54, 0x81, 0x42, 0x2c,
-	0xe0, 0xc7, 0xa4, 0xca, 0x88, 0xd3, 0xf5, 0xae, 0x2e, 0xef, 0xe8, 0x8a, 0xd2, 0x9a, 0x7a, 0x91,
-	0xfc, 0x2c, 0xfd, 0xc3, 0x80, 0x65, 0x69, 0x98, 0x23, 0xe8, 0xb8, 0xbf, 0x5b, 0x7e, 0x23, 0xa8,
-	0x13, 0x4e, 0xac, 0x42, 0xf9, 0xa8, 0xfd, 0x3c, 0x6e, 0x6a, 0x15, 0xf5, 0x92, 0xf3, 0x1a, 0xce,
-	0xe6, 0x02, 0x8c, 0x09, 0x5e, 0x35, 0xa3, 0x4d, 0x58, 0xa3, 0xfc, 0xb3, 0xe2, 0x94, 0xae, 0xc1,
-	0xd5, 0x2e, 0xe6, 0xc9, 0x83, 0x29, 0xfd, 0xd5, 0x80, 0xcb, 0x5b, 0x7c, 0xda, 0xae, 0x3f, 0x8a,
-	0x18, 0x65, 0xd8, 0x73, 0x5c, 0xef, 0x80, 0xdf, 0xec, 0xfb, 0x82, 0xf8, 0xd4, 0x9b, 0xc3, 0x50,
-	0xdb, 0x9b, 0xc3, 0x7d, 0x28, 0x34, 0x9d, 0x6a, 0xbd, 0x21, 0x17, 0x32, 0xca, 0x3a, 0xf6, 0x4c,
-	0x96, 0x35, 0x4b, 0x7c, 0x9d, 0x05, 0xc7, 0x4b, 0x57, 0x60, 0x31, 0xc3, 0x3d, 0x15, 0x80, 0x9f,
-	0xc0, 0x85, 0x6d, 0x42, 0xab, 0xa1, 0xbb, 0x47, 0x9a, 0xec, 0xca, 0xf5, 0x9d, 0xf6, 0x1c, 0x78,
-	0x47, 0xab, 0x35, 0x83, 0xbd, 0xbf, 0xa3, 0x2f, 0xfd, 0xc7, 0x00, 0xb3, 0x53, 0x82, 0x2a, 0x9b,
-	0x0f, 0x60, 0x4c, 0x86, 0x53, 0xfe, 0xee, 0x97, 0x5f, 0xbf, 0x92, 0xf9, 0x76, 0x44, 0x42, 0x81,
-	0xc3, 0x31, 0x3d, 0xbf, 0xd8, 0xb4, 0xa2, 0x4f, 0x19, 0x66, 0x11, 0x55, 0x25, 0x73, 0xad, 0x6b,
-	0xec, 0x9e, 0x08, 0x52, 0xab, 0xc0, 0x52, 0xdf, 0xe8, 0x99, 0xa6, 0x2d, 0x0e, 0x77, 0x09, 0x4a,
-	0xdf, 0x1d, 0x91, 0xc2, 0xa2, 0x38, 0xe8, 0x76, 0x7a, 0x1a, 0x9f, 0xc2, 0x02, 0x8c, 0xaa, 0x5e,
-	0x2e, 0xb3, 0x4f, 0x7d, 0xa5, 0xb3, 0x62, 0x68, 0xb0, 0xac, 0xf8, 0xc5, 0x10, 0x2c, 0x65, 0x69,
-	0x55, 0xa1, 0x7f, 0x01, 0x8b, 0xad, 0xa7, 0xa2, 0x66, 0x20, 0x13, 0xbf, 0x26, 0xca, 0x03, 0x29,
-	0xf7, 0xe7, 0xfd, 0x43, 0xc2, 0xb0, 0x83, 0x19, 0xb6, 0x8a, 0xc9, 0x39, 0x29, 0xad, 0x9a, 0xab,
-	0x6c, 0xbe, 0xe4, 0x6b, 0x55, 0x0e, 0x9d, 0x4e, 0xa5, 0x93, 0xb8, 0x33, 0xa4, 0x55, 0x96, 0x6e,
-	0xc2, 0xa5, 0xfb, 0xa4, 0x19, 0x06, 0xba, 0x79, 0x22, 0x01, 0xb2, 0x47, 0xec, 0x4b, 0x7f, 0x18,
-	0x81, 0xcb, 0x7a, 0x3e, 0x15, 0xbd, 0x9f, 0x19, 0xb0, 0xa0, 0xf1, 0xa5, 0x81, 0x03, 0x15, 0xb7,
-	0x47, 0xd9, 0x60, 0xda, 0x4d, 0x70, 0x79, 0xbb, 0xcd, 0x97, 0x87, 0x38, 0x90, 0x53, 0xe0, 0x9c,
-	0xd3, 0xb9, 0x23, 0xcc, 0xd0, 0x9c, 0x22, 0x37, 0x63, 0xe8, 0x4c, 0x66, 0x6c, 0xb4, 0x9d, 0x62,
-	0xcb, 0x0c, 0xdc, 0xb9, 0x53, 0xfc, 0x82, 0x97, 0xb8, 0xde, 0x6e, 0xcd, 0x50, 0xfa, 0x20, 0xfd,
-	0x1a, 0xdd, 0x65, 0x1a, 0xcf, 0xea, 0x1b, 0xc9, 0x5f, 0x89, 0xbf, 0x48, 0xcf, 0xb1, 0xaf, 0x53,
-	0x77, 0xe9, 0x77, 0x43, 0xf0, 0xe6, 0xa7, 0x81, 0x83, 0x19, 0xc9, 0x6a, 0x07, 0xfd, 0x80, 0xcc,
-	0x19, 0x0a, 0xfd, 0xfc, 0x30, 0x48, 0xd7, 0xff, 0x46, 0xce, 0xa3, 0xff, 0xbd, 0x05, 0xd7, 0x7b,
-	0x84, 0x48, 0x01, 0xd5, 0xef, 0x87, 0xe0, 0xba, 0x45, 0xf6, 0x43, 0x42, 0x6b, 0xff, 0x8f, 0x66,
-	0x56, 0x34, 0x57, 0xe0, 0x46, 0xaf, 0x18, 0xc9, 0x70, 0xae, 0xff, 0x7b, 0x12, 0xf2, 0x0f, 0x55,
-	0x3e, 0x6f, 0x3c, 0xae, 0xa0, 0x9f, 0x1a, 0x30, 0xa7, 0xf9, 0x55, 0x0e, 0xbd, 0x3f, 0xe0, 0x8f,
-	0x78, 0xe2, 0x08, 0x8a, 0x37, 0x4f, 0xf5, 0xd3, 0x5f, 0xd2, 0x88, 0x64, 0xd1, 0xf6, 0x61, 0x84,
-	0xe6, 0xb6, 0xdc, 0x87, 0x11, 0xda, 0x1b, 0xd0, 0x11, 0x4c, 0xb7, 0x3d, 0x34, 0xa1, 0x77, 0x07,
-	0x7d, 0x17, 0x2b, 0xae, 0x0d, 0xc0, 0x91, 0xd2, 0x9b, 0xf2, 0xfb, 0xdd, 0x41, 0x5f, 0x08, 0x7a,
-	0xe8, 0xd5, 0xfa, 0x1b, 0xc0, 0x54, 0xea, 0xd2, 0x82, 0xca, 0xd9, 0x32, 0x74, 0xf7, 0xaf, 0xe2,
-	0x6a, 0xdf, 0xf4, 0x4a, 0xe3, 0xaf, 0x0d, 0xb8, 0x98, 0x39, 0x9a, 0xa3, 0x3b, 0xd9, 0xe2, 0x7a,
-	0x5d, 0x37, 0x8a, 0x1f, 0x9e, 0x8a, 0x57, 0x99, 0xf5, 0x4b, 0x03, 0xde, 0xd0, 0x0e, 0xcb, 0xe8,
-	0x56, 0xb6, 0xd8, 0x6e, 0x97, 0x87, 0xe2, 0xb7, 0x07, 0xe6, 0x53, 0xa6, 0x9c, 0xc0, 0x4c, 0x3b,
-	0xc0, 0xa0, 0xb5, 0x41, 0xc0, 0x48, 0xea, 0x3f, 0x05, 0x7e, 0xa1, 0x5f, 0x19, 0xb0, 0xa0, 0x9f,
-	0x0d, 0x51, 0x17, 0x77, 0xba, 0xce, 0xb0, 0xc5, 0xdb, 0x83, 0x33, 0x2a, 0x6b, 0x7e, 0x6e, 0xc0,
-	0xbc, 0x6e, 0x12, 0x41, 0x37, 0x07, 0x9d, 0x5c, 0xa4, 0x25, 0xb7, 0x4e, 0x37, 0xf0, 0xa0, 0xdf,
-	0x1a, 0xb0, 0xd8, 0x15, 0xa7, 0xd0, 0x47, 0xd9, 0x92, 0xfb, 0x99, 0x01, 0x8a, 0x77, 0x4f, 0xcd,
-	0xaf, 0x4c, 0xfc, 0xd2, 0x80, 0xa5, 0xee, 0xcd, 0x1f, 0xdd, 0xed, 0x56, 0x1e, 0x7d, 0x40, 0x6b,
-	0xf1, 0xbb, 0xa7, 0x17, 0x20, 0xad, 0xdc, 0xbc, 0xff, 0xa7, 0x97, 0x4b, 0xc6, 0x9f, 0x5f, 0x2e,
-	0x19, 0x7f, 0x7b, 0xb9, 0x64, 0xfc, 0xe0, 0x83, 0x03, 0x97, 0xd5, 0xa2, 0xbd, 0x72, 0xd5, 0x6f,
-	0xac, 0xa6, 0xfe, 0x51, 0xb4, 0x7c, 0x40, 0x3c, 0xf9, 0x9f, 0xb5, 0xc9, 0x7f, 0xee, 0xfd, 0x30,
-	0xfe, 0xfb, 0x68, 0x6d, 0x6f, 0x54, 0xec, 0xbe, 0xf7, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x61,
-	0xff, 0x4c, 0xcb, 0x0a, 0x2c, 0x00, 0x00,
+	// 2774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4b, 0x6f, 0x1c, 0xc7,
+	0xf1, 0xc7, 0xec, 0xf2, 0x59, 0x4b, 0x2e, 0xc9, 0x26, 0x45, 0x8d, 0x56, 0x22, 0x45, 0x8d, 0x2d,
+	0x99, 0xfe, 0xff, 0x9d, 0xa5, 0xb9, 0x92, 0x6c, 0x59, 0x46, 0xec, 0x90, 0xa2, 0x28, 0x31, 0xb0,
+	0x22, 0x79, 0x44, 0xdb, 0x40, 0x62, 0x78, 0xdc, 0xdc, 0x69, 0x72, 0x27, 0xdc, 0x9d, 0x19, 0x4d,
+	0xf7, 0x92, 0xa6, 0x0f, 0x09, 0x10, 0x38, 0x41, 0x80, 0xe4, 0x98, 0x43, 0x8e, 0x49, 0x9c, 0x5b,
+	0x3e, 0x41, 0x2e, 0x01, 0x72, 0xcb, 0x31, 0xc7, 0x00, 0x46, 0x80, 0xc4, 0x40, 0x3e, 0x40, 0x4e,
+	0x39, 0x05, 0x08, 0xfa, 0x31, 0xbb, 0x33, 0xbb, 0x3d, 0xfb, 0x20, 0x29, 0x3b, 0x87, 0xdc, 0xb6,
+	0xab, 0xab, 0xaa, 0xab, 0xab, 0xab, 0xab, 0x7e, 0xd5, 0x43, 0xc2, 0x8d, 0xe6, 0x1e, 0x89, 0xd6,
+	0xaa, 0xd8, 0x25, 0x7e, 0x95, 0xac, 0x35, 0x30, 0xab, 0xd6, 0x3c, 0xff, 0x60, 0xed, 0x68, 0x7d,
+	0x8d, 0x92, 0xe8, 0xc8, 0xab, 0x92, 0x72, 0x18, 0x05, 0x2c, 0x40, 0x26, 0xe7, 0x2b, 0x2b, 0xbe,
+	0x72, 0xcc, 0x57, 0x3e, 0x5a, 0x2f, 0x2d, 0x1f, 0x04, 0xc1, 0x41, 0x9d, 0xac, 0x09, 0xbe, 0xbd,
+	0xe6, 0xfe, 0x9a, 0xdb, 0x8c, 0x30, 0xf3, 0x02, 0x5f, 0x4a, 0x96, 0xae, 0x76, 0xce, 0x33, 0xaf,
+	0x41, 0x28, 0xc3, 0x8d, 0x50, 0x31, 0x74, 0x29, 0x38, 0x8e, 0x70, 0x18, 0x92, 0x88, 0xaa, 0xf9,
+	0x95, 0x94, 0x89, 0x38, 0xf4, 0xb8, 0x75, 0xd5, 0xa0, 0xd1, 0x68, 0x2f, 0xa1, 0xe3, 0x78, 0xd6,
+	0x24, 0xd1, 0x89, 0x62, 0xb0, 0x74, 0x0c, 0x0c, 0xd3, 0xc3, 0xba, 0x47, 0x99, 0xe2, 0x59, 0xd5,
+	0xf1, 0x28, 0x27, 0x38, 0xc7, 0x41, 0x74, 0x48, 0x22, 0xc5, 0xf9, 0x7f, 0xfd, 0x38, 0xf7, 0xeb,
+	0xc1, 0xb1, 0xe2, 0xbd, 0xa6, 0xe3, 0xad, 0x79, 0x94, 0x05, 0x2d, 0xe3, 0x5e, 0x4c, 0xb1, 0xd0,
+	0x1a, 0x8e, 0x88, 0xdb, 0xcd, 0x75, 0x3d, 0x83, 0x2b, 0xbd, 0x0b, 0xeb, 0x2d, 0x98, 0xdb, 0xc5,
+	0xf4, 0xf0, 0x1d, 0x8f, 0xb2, 0x27, 0x38, 0x62, 0x1e, 0x3f, 0x08, 0xf4, 0x32, 0xcc, 0x7a, 0x34,
+	0xa8, 0x8b, 0x53, 0x71, 0x0e, 0xa2, 0xa0, 0x19, 0x52, 0xd3, 0x58, 0xc9, 0xaf, 0x4e, 0xda, 0x33,
+	0x2d, 0xfa, 0x03, 0x41, 0xb6, 0xfe, 0x3e, 0x02, 0x17, 0xbb, 0x14, 0xdc, 0x0b, 0xfc, 0x7d, 0xef,
+	0x00, 0x99, 0x30, 0x7e, 0x44, 0x22, 0xea, 0x05, 0xbe, 0x69, 0xac, 0x18, 0xab, 0x79, 0x3b, 0x1e,
+	0xa2, 0x0a, 0xcc, 0xfb, 0xcd, 0x86, 0x13, 0x11, 0xec, 0x3a, 0x61, 0x2c, 0x45, 0xcd, 0xdc, 0x8a,
+	0xb1, 0x3a, 0xba, 0x99, 0x33, 0x0d, 0x7b, 0xce, 0x6f, 0x36, 0x6c, 0x82, 0xdd, 0x96, 0x4a, 0x8a,
+	0x6e, 0xc1, 0x02, 0x97, 0x39, 0x8e, 0x3c, 0x46, 0x92, 0x42, 0xf9, 0x96, 0x10, 0xf2, 0x9b, 0x8d,
+	0x0f, 0xf8, 0x74, 0x42, 0xca, 0x87, 0x99, 0xce, 0x55, 0x46, 0x56, 0xf2, 0xab, 0x85, 0xca, 0xfd,
+	0x72, 0x56, 0x84, 0x96, 0x33, 0xf6, 0x53, 0x4e, 0x1b, 0x74, 0xdf, 0x67, 0xd1, 0x89, 0x5d, 0x8c,
+	0xd2, 0x56, 0x3e, 0x83, 0xd9, 0x2e, 0x0b, 0x47, 0xc5, 0x82, 0xdb, 0xc3, 0x2f, 0xd8, 0xb1, 0x19,
+	0xb9, 0xe2, 0xcc, 0x71, 0x9a, 0x5a, 0xf2, 0x61, 0x5e, 0x63, 0x19, 0x9a, 0x85, 0xfc, 0x21, 0x39,
+	0x11, 0x9e, 0x1f, 0xb5, 0xf9, 0x4f, 0xb4, 0x01, 0xa3, 0x47, 0xb8, 0xde, 0x24, 0xc2, 0xcf, 0x85,
+	0xca, 0xff, 0x0f, 0x61, 0x90, 0x2d, 0x25, 0xef, 0xe6, 0xee, 0x18, 0xa5, 0x00, 0x16, 0x74, 0x86,
+	0x3d, 0xb7, 0x05, 0xad, 0x8f, 0x61, 0xee, 0x9d, 0x00, 0xbb, 0x9b, 0xb8, 0x8e, 0xfd, 0x2a, 0x89,
+	0x1e, 0x7a, 0x3e, 0xa3, 0xe8, 0x05, 0x98, 0xde, 0xc3, 0xd5, 0xc3, 0x7a, 0x70, 0xe0, 0x54, 0x83,
+	0xa6, 0xcf, 0x54, 0x88, 0x4d, 0x29, 0xe2, 0x3d, 0x4e, 0x43, 0x37, 0x60, 0x26, 0xc2, 0xfc, 0x30,
+	0x48, 0xe4, 0x50, 0x52, 0x0d, 0x7c, 0x57, 0x98, 0x62, 0xd8, 0xd3, 0x9c, 0xfc, 0x84, 0x44, 0x4f,
+	0x05, 0xd1, 0xfa, 0xa7, 0x01, 0xa5, 0x27, 0x41, 0xbd, 0xbe, 0x1d, 0x44, 0x5b, 0xa4, 0xea, 0xf1,
+	0x18, 0xe5, 0x16, 0xd9, 0xe4, 0x59, 0x93, 0x50, 0x86, 0x76, 0x60, 0x3c, 0x92, 0x3f, 0xc5, 0x2a,
+	0x85, 0xca, 0x5a, 0x7a, 0x27, 0x38, 0xf4, 0xf8, 0x26, 0xb2, 0x35, 0xd8, 0xb1, 0x3c, 0xba, 0x0c,
+	0x93, 0x6e, 0xd0, 0xc0, 0x9e, 0xef, 0x78, 0xd2, 0x96, 0x49, 0x7b, 0x42, 0x12, 0x76, 0x5c, 0x3e,
+	0x19, 0x06, 0xf5, 0x3a, 0x89, 0xf8, 0x64, 0x5e, 0x4e, 0x4a, 0xc2, 0x8e, 0x8b, 0xae, 0x43, 0x71,
+	0x3f, 0x88, 0x8e, 0x71, 0xe4, 0x12, 0xd7, 0xd9, 0x8f, 0x82, 0x86, 0x39, 0x22, 0x38, 0xa6, 0x5b,
+	0xd4, 0xed, 0x28, 0x68, 0xa0, 0x97, 0x60, 0xa6, 0xe3, 0xee, 0x9a, 0xa3, 0x82, 0xaf, 0x98, 0xbe,
+	0xba, 0xd6, 0x1f, 0x0a, 0x70, 0x59, 0x6b, 0x31, 0x0d, 0x03, 0x9f, 0x12, 0xb4, 0x04, 0xc0, 0x73,
+	0x85, 0xc3, 0x82, 0x43, 0x22, 0x2f, 0xf0, 0x94, 0x3d, 0xc9, 0x29, 0xbb, 0x9c, 0x80, 0xde, 0x03,
+	0x14, 0xa7, 0x2e, 0x87, 0x7c, 0x42, 0xaa, 0x4d, 0xae, 0x59, 0x1d, 0xf4, 0x0d, 0xad, 0x7b, 0x3e,
+	0x50, 0xec, 0xf7, 0x63, 0x6e, 0x7b, 0xee, 0xb8, 0x93, 0x84, 0xb6, 0x61, 0xba, 0xa5, 0x96, 0x9d,
+	0x84, 0x44, 0xb8, 0xa1, 0x50, 0xb9, 0xd6, 0x53, 0xe3, 0xee, 0x49, 0x48, 0xec, 0xa9, 0xe3, 0xc4,
+	0x08, 0xbd, 0x0f, 0x97, 0xc2, 0x88, 0x1c, 0x79, 0x41, 0x93, 0x3a, 0x94, 0xe1, 0x88, 0x11, 0xd7,
+	0x21, 0x47, 0xc4, 0x67, 0xdc, 0xb5, 0x23, 0x42, 0xe7, 0xe5, 0xb2, 0x2c, 0x24, 0xe5, 0xb8, 0x90,
+	0x94, 0x77, 0x7c, 0xf6, 0xda, 0xad, 0xf7, 0x79, 0xdc, 0xd9, 0x8b, 0xb1, 0xf4, 0x53, 0x29, 0x7c,
+	0x9f, 0xcb, 0xee, 0xb8, 0x68, 0x15, 0x66, 0xbb, 0xd4, 0x8d, 0x8a, 0xc8, 0x2b, 0xd2, 0x34, 0xa7,
+	0x09, 0xe3, 0x98, 0x31, 0xd2, 0x08, 0x99, 0x39, 0x26, 0xae, 0x44, 0x3c, 0x44, 0x16, 0x4c, 0xfb,
+	0xe4, 0x13, 0xd6, 0x56, 0x30, 0x2e, 0x14, 0x14, 0x38, 0x31, 0x96, 0x7e, 0x05, 0x50, 0x2a, 0xbc,
+	0x9d, 0x9a, 0xe7, 0x33, 0x73, 0x42, 0x30, 0xce, 0x26, 0x63, 0x9c, 0xdf, 0x06, 0x74, 0x07, 0x4c,
+	0xca, 0xbc, 0xea, 0xe1, 0x49, 0xfb, 0x28, 0x1c, 0xe2, 0xe3, 0xbd, 0x3a, 0x71, 0xcd, 0xc9, 0x15,
+	0x63, 0x75, 0xc2, 0x5e, 0x94, 0xf3, 0x2d, 0x47, 0xdf, 0x97, 0xb3, 0xe8, 0x0e, 0x8c, 0x8a, 0xc2,
+	0x67, 0x82, 0xf0, 0x89, 0xd5, 0xd3, 0xcf, 0xef, 0x72, 0x4e, 0x5b, 0x0a, 0x20, 0x1b, 0xa6, 0x5d,
+	0x15, 0x37, 0x8e, 0xe7, 0xef, 0x07, 0x66, 0x41, 0x68, 0xf8, 0x46, 0x5a, 0x83, 0x2c, 0x3c, 0xe2,
+	0x8a, 0x47, 0xd8, 0xa7, 0x1e, 0xf1, 0x59, 0x1c, 0x6d, 0x3b, 0xfe, 0x7e, 0x60, 0x4f, 0xb9, 0x89,
+	0x11, 0xfa, 0x08, 0xae, 0x74, 0x07, 0x95, 0x23, 0xc2, 0x90, 0xd7, 0x2c, 0x73, 0x4a, 0x2c, 0xb1,
+	0xa4, 0x35, 0x32, 0x4e, 0x21, 0xf6, 0xa5, 0xae, 0xa8, 0x8a, 0xa7, 0x50, 0x19, 0xe6, 0xa5, 0xd3,
+	0x79, 0xa5, 0x24, 0x4e, 0x5c, 0x9d, 0xa6, 0xc5, 0xf9, 0xcc, 0x89, 0xa9, 0xa7, 0x7c, 0xe6, 0x7d,
+	0x39, 0x81, 0xae, 0xc1, 0xd4, 0x5e, 0x84, 0xfd, 0x6a, 0x4d, 0xdd, 0x82, 0xa2, 0xb8, 0x05, 0x05,
+	0x49, 0x93, 0xf7, 0x60, 0x03, 0x8a, 0xb4, 0x5a, 0x23, 0x6e, 0xb3, 0x4e, 0x5c, 0x87, 0x43, 0x15,
+	0x73, 0x46, 0x18, 0x59, 0xea, 0x8a, 0xae, 0xdd, 0x18, 0xc7, 0xd8, 0xd3, 0x2d, 0x09, 0x4e, 0x43,
+	0xdf, 0x84, 0xa9, 0x38, 0xa6, 0x84, 0x82, 0xd9, 0xbe, 0x0a, 0x0a, 0x8a, 0x5f, 0x88, 0x7f, 0x08,
+	0xe3, 0xfc, 0x44, 0x3c, 0x42, 0xcd, 0x39, 0x51, 0x69, 0x36, 0xb3, 0xf3, 0x6c, 0x8f, 0x0b, 0x5f,
+	0x7e, 0x57, 0x2a, 0x91, 0x55, 0x26, 0x56, 0xc9, 0x5d, 0xc6, 0x02, 0x86, 0xeb, 0x8e, 0x82, 0x17,
+	0xce, 0xde, 0x09, 0x23, 0xd4, 0x44, 0x22, 0x12, 0xe7, 0xc4, 0xd4, 0x43, 0x39, 0xb3, 0xc9, 0x27,
+	0xd0, 0x87, 0x30, 0xdb, 0x2a, 0x7d, 0x4e, 0x55, 0xd4, 0x31, 0x73, 0x5e, 0x6c, 0x68, 0x7d, 0xe8,
+	0x02, 0x68, 0xcf, 0x84, 0x69, 0x02, 0xfa, 0x1e, 0xcc, 0xd7, 0x03, 0xec, 0x3a, 0x7b, 0xaa, 0x16,
+	0x88, 0x6b, 0x41, 0xcd, 0x85, 0x7e, 0xf5, 0xa5, 0xab, 0x7e, 0xd8, 0x73, 0xf5, 0x4e, 0x12, 0x7a,
+	0x04, 0xb3, 0xb8, 0xc9, 0x02, 0x65, 0xb5, 0xbc, 0x71, 0x17, 0x84, 0xe6, 0x17, 0xb4, 0x11, 0xb7,
+	0xd1, 0x64, 0x81, 0xb4, 0x8b, 0xcb, 0xdb, 0x45, 0x9c, 0x1a, 0x97, 0x3e, 0x82, 0xa9, 0xa4, 0x4b,
+	0x93, 0xf5, 0x71, 0x52, 0xd6, 0xc7, 0x3b, 0xe9, 0xfa, 0x38, 0xd0, 0xe5, 0x6b, 0x97, 0xc5, 0x5f,
+	0xe6, 0x5a, 0x45, 0x6b, 0xa3, 0xca, 0xbc, 0x23, 0x8f, 0x9d, 0x9c, 0xbe, 0x68, 0x69, 0x34, 0xfc,
+	0x17, 0x16, 0x2d, 0xae, 0x2f, 0x8c, 0xc8, 0x3e, 0x61, 0xd5, 0x9a, 0x2a, 0xfb, 0x32, 0xb7, 0x4e,
+	0xc7, 0x54, 0x91, 0x13, 0xad, 0x7f, 0x01, 0x5c, 0xd6, 0x6e, 0xec, 0x6b, 0xad, 0x6d, 0x57, 0xa1,
+	0x80, 0x95, 0x35, 0x6d, 0x5f, 0x41, 0x4c, 0xda, 0x71, 0x79, 0xf1, 0x6b, 0x31, 0x88, 0xe2, 0x37,
+	0xd2, 0xa3, 0xf8, 0xb5, 0x36, 0x26, 0x8a, 0x1f, 0x4e, 0x8c, 0x50, 0x05, 0x46, 0x3d, 0x3f, 0x6c,
+	0x32, 0xe1, 0xc4, 0x42, 0xe5, 0x8a, 0xfe, 0xe0, 0xf1, 0x09, 0xbf, 0x02, 0xb6, 0x64, 0xd5, 0xe4,
+	0xb1, 0xb1, 0xb3, 0xe6, 0xb1, 0xf1, 0xe1, 0xf2, 0xd8, 0x2e, 0x5c, 0x8a, 0xf5, 0x39, 0xfc, 0x16,
+	0xd6, 0x03, 0x4a, 0x84, 0xa2, 0xa0, 0x29, 0x2b, 0x5f, 0xa1, 0x72, 0xa9, 0x4b, 0xd7, 0x96, 0x6a,
+	0x1e, 0xed, 0xc5, 0x58, 0x76, 0x37, 0xb8, 0xc7, 0x25, 0x77, 0xa5, 0x20, 0xfa, 0x0e, 0x2c, 0x8a,
+	0x45, 0xba, 0x55, 0x4e, 0xf6, 0x53, 0x39, 0x2f, 0x04, 0x3b, 0xf4, 0x6d, 0xc3, 0x5c, 0x8d, 0xe0,
+	0x88, 0xed, 0x11, 0xcc, 0x5a, 0xaa, 0xa0, 0x9f, 0xaa, 0xd9, 0x96, 0x4c, 0xac, 0x27, 0x01, 0x0f,
+	0x0a, 0x69, 0x78, 0xf0, 0x11, 0x2c, 0xa7, 0x4f, 0xc2, 0x09, 0xf6, 0x1d, 0x56, 0xf3, 0xa8, 0x13,
+	0x0b, 0x4c, 0xf5, 0x75, 0x6c, 0x29, 0x75, 0x32, 0x8f, 0xf7, 0x77, 0x6b, 0x1e, 0xdd, 0x50, 0xfa,
+	0x77, 0x92, 0x3b, 0x70, 0x09, 0xc3, 0x5e, 0x9d, 0x9a, 0xd3, 0x03, 0x44, 0x4a, 0x7b, 0x13, 0x5b,
+	0x52, 0xaa, 0x1b, 0xad, 0x15, 0x4f, 0x87, 0xd6, 0x5e, 0x82, 0x99, 0x96, 0x1e, 0x99, 0x58, 0x44,
+	0x15, 0x9d, 0xb4, 0x8b, 0x31, 0x79, 0x4b, 0x50, 0xd1, 0x4d, 0x18, 0xab, 0x11, 0xec, 0x92, 0x48,
+	0x15, 0xc9, 0xcb, 0xda, 0x95, 0x1e, 0x0a, 0x16, 0x5b, 0xb1, 0x66, 0x15, 0x8d, 0xb9, 0x73, 0x29,
+	0x1a, 0xcf, 0xb7, 0xde, 0xe9, 0x4a, 0xd2, 0xc2, 0xa9, 0x4b, 0x12, 0xfa, 0x18, 0x66, 0xe3, 0x44,
+	0xc9, 0x63, 0x0b, 0xd3, 0x43, 0x6a, 0x5e, 0x10, 0x98, 0xe1, 0x76, 0x5f, 0xcc, 0xa0, 0x4b, 0xa4,
+	0xf6, 0x4c, 0x5b, 0x1d, 0xa7, 0x53, 0xeb, 0x2f, 0x23, 0xb0, 0xb8, 0xe1, 0xba, 0xba, 0x2e, 0x2a,
+	0x55, 0x45, 0x8c, 0x8e, 0x2a, 0xf2, 0x9c, 0x52, 0xee, 0x5d, 0x98, 0x6c, 0xa3, 0xc7, 0xfc, 0x20,
+	0xe8, 0x71, 0x82, 0xa9, 0x5f, 0x3c, 0x5d, 0xb7, 0xf2, 0x91, 0x6a, 0x1a, 0xf2, 0x36, 0xc4, 0xa4,
+	0x1d, 0xb7, 0x33, 0x61, 0xa9, 0x34, 0xa3, 0x52, 0xc2, 0xe8, 0x10, 0x09, 0x4b, 0xf4, 0x18, 0x71,
+	0x62, 0xb8, 0x0b, 0x63, 0x34, 0x68, 0x46, 0x55, 0x99, 0x80, 0x8b, 0x15, 0x2b, 0x13, 0x50, 0x63,
+	0x7a, 0xf8, 0x54, 0x70, 0xda, 0x4a, 0x42, 0x53, 0x6e, 0xc7, 0x75, 0xe5, 0x36, 0xd4, 0xc4, 0xec,
+	0x44, 0xbf, 0x57, 0x11, 0xfd, 0xa9, 0x96, 0x3b, 0x42, 0x58, 0xbd, 0x51, 0x74, 0xc4, 0x71, 0x69,
+	0x13, 0x16, 0x74, 0x8c, 0x1a, 0x4c, 0xb4, 0x90, 0xc4, 0x44, 0x93, 0x49, 0xbc, 0x73, 0x0c, 0x17,
+	0xbb, 0x6c, 0x50, 0xf5, 0x5c, 0x77, 0x09, 0x8d, 0xf3, 0xba, 0x84, 0xd6, 0xef, 0xc6, 0x44, 0x4c,
+	0xeb, 0x40, 0xd6, 0xd7, 0x11, 0xd3, 0xbc, 0x05, 0x15, 0xc7, 0xed, 0xb4, 0x97, 0x96, 0x58, 0xa2,
+	0x28, 0xe9, 0x5b, 0xb1, 0x01, 0xa9, 0xe8, 0x1f, 0x39, 0x53, 0xf4, 0x8f, 0x0e, 0x17, 0xfd, 0x63,
+	0x67, 0x8f, 0xfe, 0xf1, 0x73, 0x88, 0xfe, 0x09, 0x5d, 0xf4, 0xfb, 0x60, 0xe2, 0xc4, 0x51, 0x6e,
+	0x79, 0x34, 0xe4, 0x51, 0xc1, 0x1b, 0x50, 0x85, 0x09, 0x2a, 0x3d, 0x6e, 0x41, 0x86, 0xa4, 0x9d,
+	0xa9, 0x53, 0x7b, 0xdb, 0x60, 0x80, 0xdb, 0xa6, 0x89, 0xb7, 0xc1, 0x6e, 0x1b, 0x7a, 0x1d, 0x26,
+	0xc2, 0xc8, 0x0b, 0x22, 0x8f, 0x9d, 0x98, 0x85, 0xec, 0xb7, 0x8e, 0x9b, 0x15, 0xf9, 0xd6, 0xd1,
+	0x62, 0x3e, 0x97, 0x6b, 0xfa, 0x45, 0x1e, 0xcc, 0x2c, 0x2f, 0xa1, 0x6f, 0xc3, 0x4c, 0x1b, 0xdb,
+	0x88, 0x7e, 0xdb, 0x34, 0x7a, 0x40, 0x06, 0xd5, 0x59, 0x8a, 0x47, 0x11, 0xbb, 0x8d, 0x4f, 0xc5,
+	0xb8, 0x0b, 0x6e, 0xe6, 0x86, 0x83, 0x9b, 0x09, 0x00, 0x96, 0x1f, 0x16, 0x80, 0x8d, 0x9c, 0x3f,
+	0x00, 0x1b, 0x3d, 0x1f, 0x00, 0x36, 0x76, 0x6e, 0x00, 0x6c, 0x5c, 0x07, 0xc0, 0xac, 0x3f, 0x1a,
+	0x22, 0x0b, 0x6b, 0xbb, 0xaa, 0xe7, 0x9a, 0x85, 0xd1, 0x43, 0x40, 0x47, 0x1e, 0xf5, 0xf6, 0xbc,
+	0xba, 0x68, 0x8f, 0x54, 0xa2, 0xc9, 0xf5, 0x4b, 0x34, 0x73, 0x6d, 0x21, 0x95, 0x63, 0xac, 0x2f,
+	0x0c, 0x58, 0x10, 0xdd, 0x74, 0xec, 0x90, 0x38, 0x9b, 0xdf, 0xeb, 0x6c, 0x99, 0x5f, 0xd6, 0xfa,
+	0x51, 0x27, 0x3b, 0x60, 0xb3, 0x7c, 0x16, 0x3c, 0x32, 0x58, 0x2f, 0x6d, 0x7d, 0x6e, 0xc0, 0x85,
+	0x0e, 0x0b, 0xd5, 0xf9, 0xbc, 0x0d, 0x53, 0xe2, 0xe9, 0xce, 0x89, 0x08, 0x6d, 0xd6, 0xe3, 0x3d,
+	0xf6, 0x0e, 0xb9, 0x82, 0x90, 0xb0, 0x85, 0x00, 0xda, 0x81, 0x62, 0xac, 0xe0, 0xfb, 0xa4, 0xca,
+	0x88, 0xdb, 0xf3, 0xe1, 0x42, 0x3e, 0x58, 0x28, 0x4e, 0x7b, 0xfa, 0x59, 0x72, 0x68, 0xfd, 0xc3,
+	0x80, 0x15, 0x69, 0x98, 0x2b, 0xf8, 0xf8, 0x7e, 0xef, 0x05, 0x8d, 0xb0, 0x4e, 0x38, 0xb3, 0x72,
+	0xe5, 0xe3, 0xce, 0xf3, 0xb8, 0xad, 0x5d, 0xa8, 0x9f, 0x9e, 0xaf, 0xe0, 0x6c, 0x2e, 0xc2, 0xb8,
+	0x90, 0x55, 0x38, 0x71, 0xd2, 0x1e, 0xe3, 0xc3, 0x1d, 0xd7, 0x7a, 0x01, 0xae, 0xf5, 0x30, 0x4f,
+	0x1e, 0x8c, 0xf5, 0x57, 0x03, 0xae, 0xdc, 0xe3, 0x3d, 0x45, 0xfd, 0x71, 0x93, 0x51, 0x86, 0x7d,
+	0xd7, 0xf3, 0x0f, 0x38, 0xec, 0x1e, 0x08, 0x66, 0xa4, 0x1e, 0x60, 0x72, 0x1d, 0x0f, 0x30, 0x0f,
+	0xa0, 0xd8, 0xda, 0x54, 0xfb, 0x41, 0xbd, 0x98, 0x91, 0x21, 0xe2, 0x9d, 0xc9, 0x0c, 0xc1, 0x12,
+	0xa3, 0xb3, 0x60, 0x09, 0xeb, 0x2a, 0x2c, 0x65, 0x6c, 0x4f, 0x39, 0xe0, 0xe7, 0x06, 0x2c, 0xd9,
+	0xa4, 0x1a, 0x44, 0xee, 0x26, 0xae, 0x1e, 0x86, 0x11, 0xa1, 0xb4, 0x19, 0x11, 0xd1, 0xc2, 0x0c,
+	0xe2, 0x81, 0x94, 0x6d, 0xb9, 0xe1, 0x4e, 0x0e, 0xc1, 0x88, 0xe8, 0xaa, 0xf2, 0xe2, 0xbb, 0x90,
+	0xf8, 0x6d, 0xad, 0xc0, 0x72, 0x96, 0x35, 0xca, 0xe0, 0x1f, 0xc0, 0xc5, 0x2d, 0x42, 0xab, 0x91,
+	0xb7, 0x47, 0x5a, 0x3a, 0x95, 0xa5, 0xdb, 0x9d, 0x41, 0xfb, 0x8a, 0xd6, 0x94, 0x0c, 0xf1, 0xc1,
+	0x62, 0xd5, 0xfa, 0xb7, 0x01, 0x66, 0xb7, 0x06, 0x75, 0xcf, 0xdf, 0x80, 0x71, 0x79, 0xfe, 0xf2,
+	0xab, 0x6d, 0xa1, 0x72, 0x35, 0xf3, 0xe5, 0x8f, 0x44, 0x02, 0xbc, 0xc4, 0xfc, 0xbc, 0xdf, 0x6c,
+	0x87, 0x0b, 0x65, 0x98, 0x35, 0xa9, 0x99, 0xeb, 0xd1, 0x6f, 0xc6, 0x6b, 0x3f, 0x15, 0xac, 0x76,
+	0x91, 0xa5, 0xc6, 0xe8, 0x03, 0x4d, 0x45, 0xc8, 0xf7, 0x70, 0xca, 0xc0, 0x90, 0x9c, 0xc2, 0x92,
+	0x88, 0xcc, 0x4e, 0x7e, 0x1a, 0x9f, 0xc2, 0x22, 0x8c, 0xa9, 0x3a, 0x26, 0x83, 0x45, 0x8d, 0xce,
+	0x12, 0x2a, 0xd6, 0x4f, 0x72, 0xb0, 0x9c, 0xb5, 0xaa, 0x72, 0xfd, 0x33, 0x58, 0x6a, 0xbf, 0xe0,
+	0xb5, 0x1c, 0x99, 0xf8, 0x16, 0x2c, 0x0f, 0xa4, 0x3c, 0xd8, 0xee, 0x1f, 0x11, 0x86, 0x5d, 0xcc,
+	0xb0, 0x5d, 0x4a, 0x82, 0xcb, 0xf4, 0xd2, 0x7c, 0xc9, 0xd6, 0x77, 0x18, 0xed, 0x92, 0xb9, 0xd3,
+	0x2d, 0xe9, 0x26, 0x1a, 0xad, 0xf4, 0x92, 0xd6, 0x6d, 0xb8, 0xfc, 0x80, 0xb4, 0xdc, 0x40, 0x37,
+	0x4f, 0x24, 0x38, 0xe8, 0xe3, 0x7b, 0xeb, 0xb7, 0x23, 0x70, 0x45, 0x2f, 0xa7, 0xbc, 0xf7, 0x99,
+	0x01, 0x8b, 0x9a, 0xbd, 0x34, 0x70, 0xa8, 0xfc, 0xf6, 0x38, 0x1b, 0x47, 0xf4, 0x52, 0x5c, 0xde,
+	0xea, 0xd8, 0xcb, 0x23, 0x1c, 0x4a, 0xe8, 0x3c, 0xef, 0x76, 0xcf, 0x08, 0x33, 0x34, 0xa7, 0xc8,
+	0xcd, 0xc8, 0x9d, 0xc9, 0x8c, 0x8d, 0x8e, 0x53, 0x6c, 0x9b, 0x81, 0xbb, 0x67, 0x4a, 0x9f, 0xf2,
+	0x2b, 0xae, 0xb7, 0x5b, 0x03, 0xc8, 0x1f, 0xa6, 0xbf, 0x25, 0xf4, 0x68, 0x61, 0xb2, 0xf2, 0x46,
+	0xf2, 0x1b, 0xff, 0xa7, 0x69, 0x0c, 0xff, 0x55, 0xae, 0x6d, 0xfd, 0x3a, 0x07, 0x2f, 0xbe, 0x17,
+	0xba, 0x98, 0x91, 0xac, 0x74, 0xf0, 0xbc, 0x6b, 0xc2, 0xb9, 0x15, 0x4d, 0x5d, 0xfe, 0x1b, 0x39,
+	0x8f, 0xfc, 0xf7, 0x12, 0x5c, 0xef, 0xe3, 0x22, 0x55, 0xa8, 0x7e, 0x93, 0x83, 0xeb, 0x36, 0xd9,
+	0x8f, 0x08, 0xad, 0xfd, 0xcf, 0x9b, 0x59, 0xde, 0x5c, 0x85, 0x1b, 0xfd, 0x7c, 0xa4, 0xdc, 0xf9,
+	0xfb, 0x1c, 0x5c, 0xb2, 0x49, 0x23, 0x38, 0x6a, 0x39, 0x7e, 0xe0, 0xd7, 0xa0, 0xb3, 0xb8, 0x30,
+	0x01, 0x2f, 0xf3, 0xe2, 0x21, 0x46, 0xc1, 0xcb, 0x8c, 0x27, 0xa6, 0x91, 0x73, 0xf8, 0x52, 0xd5,
+	0xfb, 0xf1, 0xa7, 0x04, 0x13, 0x41, 0x48, 0x22, 0xcc, 0x82, 0x48, 0xb4, 0x9c, 0x93, 0x76, 0x6b,
+	0xcc, 0xd3, 0x7f, 0x44, 0x30, 0x0d, 0xe2, 0x16, 0x52, 0x8d, 0xac, 0xdb, 0x50, 0xd2, 0xb9, 0x4e,
+	0xe5, 0xfe, 0xc4, 0x16, 0x8d, 0xe4, 0x16, 0x2b, 0x9f, 0xcd, 0x40, 0xe1, 0x91, 0x4a, 0x21, 0x1b,
+	0x4f, 0x76, 0xd0, 0x8f, 0x0c, 0x98, 0xd7, 0x7c, 0xc6, 0x46, 0xb7, 0x86, 0xfc, 0xea, 0x2d, 0x8e,
+	0xac, 0x74, 0xfb, 0x54, 0xdf, 0xca, 0x93, 0x46, 0x24, 0xf3, 0xe4, 0x00, 0x46, 0x68, 0x5e, 0x75,
+	0x4a, 0xa7, 0x7b, 0x7c, 0x47, 0x47, 0x30, 0xd3, 0xf1, 0x20, 0x8a, 0x5e, 0x1d, 0xf6, 0xfd, 0xb6,
+	0xb4, 0x3e, 0x84, 0x44, 0x6a, 0xdd, 0xd4, 0xbe, 0x5f, 0x1d, 0xf6, 0x25, 0xab, 0xb4, 0x3e, 0x84,
+	0x84, 0x5a, 0x37, 0x84, 0xe9, 0x54, 0x63, 0x8b, 0xca, 0xd9, 0x3a, 0x74, 0x3d, 0x7a, 0x69, 0x6d,
+	0x60, 0x7e, 0xb5, 0xe2, 0x2f, 0x0c, 0xb8, 0x24, 0x07, 0x9a, 0xf6, 0x0d, 0xdd, 0xcd, 0x56, 0xd7,
+	0xaf, 0x25, 0x2d, 0xbd, 0x79, 0x2a, 0x59, 0x65, 0xd6, 0x4f, 0x0d, 0xb8, 0xa0, 0x6d, 0xa8, 0xd0,
+	0x6b, 0xd9, 0x6a, 0x7b, 0x35, 0x98, 0xa5, 0xd7, 0x87, 0x96, 0x53, 0xa6, 0xfc, 0xcc, 0x80, 0x45,
+	0x7d, 0xaf, 0x84, 0x5e, 0xef, 0xb5, 0xc5, 0x1e, 0xbd, 0x5e, 0xe9, 0xce, 0xf0, 0x82, 0xca, 0x9a,
+	0x13, 0x98, 0xed, 0x44, 0x18, 0x68, 0x7d, 0x18, 0x34, 0x22, 0x0d, 0x38, 0x05, 0x80, 0x11, 0x8e,
+	0xd0, 0x37, 0x07, 0xbd, 0x1c, 0xd1, 0xb3, 0x89, 0xe9, 0xe5, 0x88, 0x3e, 0x7d, 0xc8, 0x8f, 0x0d,
+	0x58, 0xd0, 0x41, 0x51, 0x74, 0x7b, 0x58, 0xe8, 0x2a, 0x2d, 0x79, 0xed, 0x74, 0x88, 0x17, 0xfd,
+	0xca, 0x80, 0xa5, 0x9e, 0x40, 0x05, 0xbd, 0x95, 0xad, 0x79, 0x10, 0x10, 0x58, 0x7a, 0xfb, 0xd4,
+	0xf2, 0xca, 0xc4, 0xcf, 0x0d, 0x58, 0xee, 0x5d, 0xfd, 0xd1, 0xdb, 0xbd, 0x02, 0x72, 0x00, 0x6c,
+	0x55, 0xfa, 0xd6, 0xe9, 0x15, 0x28, 0x2b, 0x7f, 0x08, 0xa8, 0xbb, 0x78, 0xa2, 0x9b, 0xbd, 0xf4,
+	0x66, 0xa0, 0x94, 0xd2, 0xad, 0xe1, 0x84, 0xa4, 0x01, 0x9b, 0x0f, 0xfe, 0xf4, 0xe5, 0xb2, 0xf1,
+	0xe7, 0x2f, 0x97, 0x8d, 0xbf, 0x7d, 0xb9, 0x6c, 0x7c, 0xf7, 0x8d, 0x03, 0x8f, 0xd5, 0x9a, 0x7b,
+	0xe5, 0x6a, 0xd0, 0x58, 0x4b, 0xfd, 0xa1, 0x79, 0xf9, 0x80, 0xf8, 0xf2, 0x2f, 0xf3, 0x93, 0xff,
+	0x1c, 0xf0, 0x66, 0xfc, 0xfb, 0x68, 0x7d, 0x6f, 0x4c, 0xcc, 0xde, 0xfc, 0xcf, 0x00, 0x3a, 0x70,
+	0xdd, 0xad, 0x4a, 0x30, 0x00, 0x00,
 }
 
 func (m *TaskListPartition) Marshal() (dAtA []byte, err error) {
@@ -2808,6 +3107,11 @@ func (m *PollForActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.PrefetchCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.PrefetchCount))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.IsolationGroup) > 0 {
 		i -= len(m.IsolationGroup)
 		copy(dAtA[i:], m.IsolationGroup)
@@ -2875,6 +3179,22 @@ func (m *PollForActivityTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PrefetchedTasks) > 0 {
+		for iNdEx := len(m.PrefetchedTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrefetchedTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
 	if m.AutoConfigHint != nil {
 		{
 			size, err := m.AutoConfigHint.MarshalToSizedBuffer(dAtA[:i])
@@ -3261,6 +3581,18 @@ func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Priority != nil {
+		{
+			size, err := m.Priority.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.PartitionConfig) > 0 {
 		for k := range m.PartitionConfig {
 			v := m.PartitionConfig[k]
@@ -3485,6 +3817,18 @@ func (m *AddActivityTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.VisibilityTimeout != nil {
+		{
+			size, err := m.VisibilityTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.PartitionConfig != nil {
 		{
 			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
@@ -3793,7 +4137,7 @@ func (m *CancelOutstandingPollResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeTaskListRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordBackpressureHintRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3803,12 +4147,12 @@ func (m *DescribeTaskListRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeTaskListRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordBackpressureHintRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordBackpressureHintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3817,10 +4161,89 @@ func (m *DescribeTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+	if m.Hint != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Hint))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordBackpressureHintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordBackpressureHintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordBackpressureHintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeTaskListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeTaskListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4298,6 +4721,120 @@ func (m *RefreshTaskListPartitionConfigResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *RemoveTaskListTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveTaskListTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveTaskListTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveTaskListTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveTaskListTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveTaskListTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintService(dAtA []byte, offset int, v uint64) int {
 	offset -= sovService(v)
 	base := offset
@@ -4548,6 +5085,9 @@ func (m *PollForActivityTaskRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.PrefetchCount != 0 {
+		n += 1 + sovService(uint64(m.PrefetchCount))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4635,6 +5175,12 @@ func (m *PollForActivityTaskResponse) Size() (n int) {
 		l = m.AutoConfigHint.Size()
 		n += 2 + l + sovService(uint64(l))
 	}
+	if len(m.PrefetchedTasks) > 0 {
+		for _, e := range m.PrefetchedTasks {
+			l = e.Size()
+			n += 2 + l + sovService(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4751,6 +5297,10 @@ func (m *AddActivityTaskRequest) Size() (n int) {
 			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
 		}
 	}
+	if m.Priority != nil {
+		l = m.Priority.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4806,6 +5356,10 @@ func (m *AddActivityTaskResponse) Size() (n int) {
 		l = m.PartitionConfig.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.VisibilityTimeout != nil {
+		l = m.VisibilityTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4939,6 +5493,41 @@ func (m *CancelOutstandingPollResponse) Size() (n int) {
 	return n
 }
 
+func (m *RecordBackpressureHintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Hint != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RecordBackpressureHintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *DescribeTaskListRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5161,19 +5750,72 @@ func (m *RefreshTaskListPartitionConfigResponse) Size() (n int) {
 	return n
 }
 
-func sovService(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozService(x uint64) (n int) {
-	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *RemoveTaskListTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovService(uint64(m.TaskId))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ScheduleId != 0 {
+		n += 1 + sovService(uint64(m.ScheduleId))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *TaskListPartition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+
+func (m *RemoveTaskListTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovService(uint64(m.TaskId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TaskListPartition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowService
 			}
@@ -6855,6 +7497,25 @@ func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.IsolationGroup = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefetchCount", wireType)
+			}
+			m.PrefetchCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrefetchCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -7563,6 +8224,40 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefetchedTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrefetchedTasks = append(m.PrefetchedTasks, &PollForActivityTaskResponse{})
+			if err := m.PrefetchedTasks[len(m.PrefetchedTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -8494,6 +9189,42 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PartitionConfig[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Priority == nil {
+				m.Priority = &types.Int32Value{}
+			}
+			if err := m.Priority.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -8863,6 +9594,42 @@ func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VisibilityTimeout == nil {
+				m.VisibilityTimeout = &types.Duration{}
+			}
+			if err := m.VisibilityTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -9654,7 +10421,7 @@ func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordBackpressureHintRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9677,17 +10444,17 @@ func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTaskListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordBackpressureHintRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordBackpressureHintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -9697,33 +10464,29 @@ func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.DescribeTaskListRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -9733,24 +10496,39 @@ func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hint", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Hint = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -9773,7 +10551,7 @@ func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordBackpressureHintResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9796,15 +10574,66 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTaskListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordBackpressureHintResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordBackpressureHintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTaskListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pollers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9831,16 +10660,18 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pollers = append(m.Pollers, &v1.PollerInfo{})
-			if err := m.Pollers[len(m.Pollers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Request == nil {
+				m.Request = &v1.DescribeTaskListRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskListStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -9850,12 +10681,129 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTaskListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pollers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pollers = append(m.Pollers, &v1.PollerInfo{})
+			if err := m.Pollers[len(m.Pollers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
 			postIndex := iNdEx + msglen
@@ -11010,6 +11958,333 @@ func (m *RefreshTaskListPartitionConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RemoveTaskListTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveTaskListTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveTaskListTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveTaskListTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveTaskListTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveTaskListTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
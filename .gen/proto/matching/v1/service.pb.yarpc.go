@@ -51,11 +51,13 @@ type MatchingAPIYARPCClient interface {
 	QueryWorkflow(context.Context, *QueryWorkflowRequest, ...yarpc.CallOption) (*QueryWorkflowResponse, error)
 	RespondQueryTaskCompleted(context.Context, *RespondQueryTaskCompletedRequest, ...yarpc.CallOption) (*RespondQueryTaskCompletedResponse, error)
 	CancelOutstandingPoll(context.Context, *CancelOutstandingPollRequest, ...yarpc.CallOption) (*CancelOutstandingPollResponse, error)
+	RecordBackpressureHint(context.Context, *RecordBackpressureHintRequest, ...yarpc.CallOption) (*RecordBackpressureHintResponse, error)
 	DescribeTaskList(context.Context, *DescribeTaskListRequest, ...yarpc.CallOption) (*DescribeTaskListResponse, error)
 	ListTaskListPartitions(context.Context, *ListTaskListPartitionsRequest, ...yarpc.CallOption) (*ListTaskListPartitionsResponse, error)
 	GetTaskListsByDomain(context.Context, *GetTaskListsByDomainRequest, ...yarpc.CallOption) (*GetTaskListsByDomainResponse, error)
 	UpdateTaskListPartitionConfig(context.Context, *UpdateTaskListPartitionConfigRequest, ...yarpc.CallOption) (*UpdateTaskListPartitionConfigResponse, error)
 	RefreshTaskListPartitionConfig(context.Context, *RefreshTaskListPartitionConfigRequest, ...yarpc.CallOption) (*RefreshTaskListPartitionConfigResponse, error)
+	RemoveTaskListTask(context.Context, *RemoveTaskListTaskRequest, ...yarpc.CallOption) (*RemoveTaskListTaskResponse, error)
 }
 
 func newMatchingAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) MatchingAPIYARPCClient {
@@ -83,11 +85,13 @@ type MatchingAPIYARPCServer interface {
 	QueryWorkflow(context.Context, *QueryWorkflowRequest) (*QueryWorkflowResponse, error)
 	RespondQueryTaskCompleted(context.Context, *RespondQueryTaskCompletedRequest) (*RespondQueryTaskCompletedResponse, error)
 	CancelOutstandingPoll(context.Context, *CancelOutstandingPollRequest) (*CancelOutstandingPollResponse, error)
+	RecordBackpressureHint(context.Context, *RecordBackpressureHintRequest) (*RecordBackpressureHintResponse, error)
 	DescribeTaskList(context.Context, *DescribeTaskListRequest) (*DescribeTaskListResponse, error)
 	ListTaskListPartitions(context.Context, *ListTaskListPartitionsRequest) (*ListTaskListPartitionsResponse, error)
 	GetTaskListsByDomain(context.Context, *GetTaskListsByDomainRequest) (*GetTaskListsByDomainResponse, error)
 	UpdateTaskListPartitionConfig(context.Context, *UpdateTaskListPartitionConfigRequest) (*UpdateTaskListPartitionConfigResponse, error)
 	RefreshTaskListPartitionConfig(context.Context, *RefreshTaskListPartitionConfigRequest) (*RefreshTaskListPartitionConfigResponse, error)
+	RemoveTaskListTask(context.Context, *RemoveTaskListTaskRequest) (*RemoveTaskListTaskResponse, error)
 }
 
 type buildMatchingAPIYARPCProceduresParams struct {
@@ -171,6 +175,16 @@ func buildMatchingAPIYARPCProcedures(params buildMatchingAPIYARPCProceduresParam
 						},
 					),
 				},
+				{
+					MethodName: "RecordBackpressureHint",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RecordBackpressureHint,
+							NewRequest:  newMatchingAPIServiceRecordBackpressureHintYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 				{
 					MethodName: "DescribeTaskList",
 					Handler: protobuf.NewUnaryHandler(
@@ -221,6 +235,16 @@ func buildMatchingAPIYARPCProcedures(params buildMatchingAPIYARPCProceduresParam
 						},
 					),
 				},
+				{
+					MethodName: "RemoveTaskListTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RemoveTaskListTask,
+							NewRequest:  newMatchingAPIServiceRemoveTaskListTaskYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
@@ -423,6 +447,18 @@ func (c *_MatchingAPIYARPCCaller) CancelOutstandingPoll(ctx context.Context, req
 	return response, err
 }
 
+func (c *_MatchingAPIYARPCCaller) RecordBackpressureHint(ctx context.Context, request *RecordBackpressureHintRequest, options ...yarpc.CallOption) (*RecordBackpressureHintResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RecordBackpressureHint", request, newMatchingAPIServiceRecordBackpressureHintYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RecordBackpressureHintResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceRecordBackpressureHintYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 func (c *_MatchingAPIYARPCCaller) DescribeTaskList(ctx context.Context, request *DescribeTaskListRequest, options ...yarpc.CallOption) (*DescribeTaskListResponse, error) {
 	responseMessage, err := c.streamClient.Call(ctx, "DescribeTaskList", request, newMatchingAPIServiceDescribeTaskListYARPCResponse, options...)
 	if responseMessage == nil {
@@ -483,6 +519,18 @@ func (c *_MatchingAPIYARPCCaller) RefreshTaskListPartitionConfig(ctx context.Con
 	return response, err
 }
 
+func (c *_MatchingAPIYARPCCaller) RemoveTaskListTask(ctx context.Context, request *RemoveTaskListTaskRequest, options ...yarpc.CallOption) (*RemoveTaskListTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RemoveTaskListTask", request, newMatchingAPIServiceRemoveTaskListTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RemoveTaskListTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceRemoveTaskListTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 type _MatchingAPIYARPCHandler struct {
 	server MatchingAPIYARPCServer
 }
@@ -599,6 +647,22 @@ func (h *_MatchingAPIYARPCHandler) CancelOutstandingPoll(ctx context.Context, re
 	return response, err
 }
 
+func (h *_MatchingAPIYARPCHandler) RecordBackpressureHint(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RecordBackpressureHintRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RecordBackpressureHintRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceRecordBackpressureHintYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RecordBackpressureHint(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (h *_MatchingAPIYARPCHandler) DescribeTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
 	var request *DescribeTaskListRequest
 	var ok bool
@@ -679,6 +743,22 @@ func (h *_MatchingAPIYARPCHandler) RefreshTaskListPartitionConfig(ctx context.Co
 	return response, err
 }
 
+func (h *_MatchingAPIYARPCHandler) RemoveTaskListTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RemoveTaskListTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RemoveTaskListTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceRemoveTaskListTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RemoveTaskListTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func newMatchingAPIServicePollForDecisionTaskYARPCRequest() proto.Message {
 	return &PollForDecisionTaskRequest{}
 }
@@ -735,6 +815,14 @@ func newMatchingAPIServiceCancelOutstandingPollYARPCResponse() proto.Message {
 	return &CancelOutstandingPollResponse{}
 }
 
+func newMatchingAPIServiceRecordBackpressureHintYARPCRequest() proto.Message {
+	return &RecordBackpressureHintRequest{}
+}
+
+func newMatchingAPIServiceRecordBackpressureHintYARPCResponse() proto.Message {
+	return &RecordBackpressureHintResponse{}
+}
+
 func newMatchingAPIServiceDescribeTaskListYARPCRequest() proto.Message {
 	return &DescribeTaskListRequest{}
 }
@@ -775,6 +863,14 @@ func newMatchingAPIServiceRefreshTaskListPartitionConfigYARPCResponse() proto.Me
 	return &RefreshTaskListPartitionConfigResponse{}
 }
 
+func newMatchingAPIServiceRemoveTaskListTaskYARPCRequest() proto.Message {
+	return &RemoveTaskListTaskRequest{}
+}
+
+func newMatchingAPIServiceRemoveTaskListTaskYARPCResponse() proto.Message {
+	return &RemoveTaskListTaskResponse{}
+}
+
 var (
 	emptyMatchingAPIServicePollForDecisionTaskYARPCRequest             = &PollForDecisionTaskRequest{}
 	emptyMatchingAPIServicePollForDecisionTaskYARPCResponse            = &PollForDecisionTaskResponse{}
@@ -790,6 +886,8 @@ var (
 	emptyMatchingAPIServiceRespondQueryTaskCompletedYARPCResponse      = &RespondQueryTaskCompletedResponse{}
 	emptyMatchingAPIServiceCancelOutstandingPollYARPCRequest           = &CancelOutstandingPollRequest{}
 	emptyMatchingAPIServiceCancelOutstandingPollYARPCResponse          = &CancelOutstandingPollResponse{}
+	emptyMatchingAPIServiceRecordBackpressureHintYARPCRequest          = &RecordBackpressureHintRequest{}
+	emptyMatchingAPIServiceRecordBackpressureHintYARPCResponse         = &RecordBackpressureHintResponse{}
 	emptyMatchingAPIServiceDescribeTaskListYARPCRequest                = &DescribeTaskListRequest{}
 	emptyMatchingAPIServiceDescribeTaskListYARPCResponse               = &DescribeTaskListResponse{}
 	emptyMatchingAPIServiceListTaskListPartitionsYARPCRequest          = &ListTaskListPartitionsRequest{}
@@ -800,168 +898,186 @@ var (
 	emptyMatchingAPIServiceUpdateTaskListPartitionConfigYARPCResponse  = &UpdateTaskListPartitionConfigResponse{}
 	emptyMatchingAPIServiceRefreshTaskListPartitionConfigYARPCRequest  = &RefreshTaskListPartitionConfigRequest{}
 	emptyMatchingAPIServiceRefreshTaskListPartitionConfigYARPCResponse = &RefreshTaskListPartitionConfigResponse{}
+	emptyMatchingAPIServiceRemoveTaskListTaskYARPCRequest              = &RemoveTaskListTaskRequest{}
+	emptyMatchingAPIServiceRemoveTaskListTaskYARPCResponse             = &RemoveTaskListTaskResponse{}
 )
 
 var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 	// uber/cadence/matching/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x5b, 0x6f, 0x1b, 0xc7,
-		0xf5, 0xc7, 0x4a, 0xa2, 0x2e, 0x87, 0x12, 0x25, 0x8d, 0x14, 0x79, 0x4d, 0x5b, 0xb6, 0x4c, 0xc7,
-		0x8e, 0xf2, 0xff, 0xa7, 0x54, 0xa4, 0xd8, 0xae, 0x63, 0xa3, 0x71, 0x75, 0xb1, 0x6c, 0x16, 0x71,
-		0xed, 0xac, 0x15, 0x1b, 0x68, 0x03, 0x6f, 0x47, 0xdc, 0x91, 0xb8, 0x15, 0xb9, 0xbb, 0xde, 0x99,
-		0x95, 0xc2, 0x3c, 0xf4, 0xa1, 0x68, 0x8b, 0x02, 0x7d, 0x6d, 0xdf, 0xdb, 0xa6, 0x9f, 0xa3, 0x9f,
-		0xa3, 0x40, 0xd0, 0x87, 0x3e, 0xf4, 0x03, 0xb4, 0x40, 0x1f, 0x0b, 0x14, 0x73, 0x59, 0x72, 0x97,
-		0x9c, 0xe5, 0x45, 0x92, 0x9d, 0x3e, 0xf4, 0x4d, 0x3b, 0x73, 0xee, 0x73, 0xce, 0xf9, 0x9d, 0x19,
-		0x0a, 0x6e, 0x46, 0xfb, 0x24, 0x5c, 0xab, 0x62, 0x87, 0x78, 0x55, 0xb2, 0xd6, 0xc0, 0xac, 0x5a,
-		0x73, 0xbd, 0xc3, 0xb5, 0xe3, 0xf5, 0x35, 0x4a, 0xc2, 0x63, 0xb7, 0x4a, 0xca, 0x41, 0xe8, 0x33,
-		0x1f, 0x99, 0x9c, 0xae, 0xac, 0xe8, 0xca, 0x31, 0x5d, 0xf9, 0x78, 0xbd, 0x78, 0xe5, 0xd0, 0xf7,
-		0x0f, 0xeb, 0x64, 0x4d, 0xd0, 0xed, 0x47, 0x07, 0x6b, 0x4e, 0x14, 0x62, 0xe6, 0xfa, 0x9e, 0xe4,
-		0x2c, 0x5e, 0xed, 0xdc, 0x67, 0x6e, 0x83, 0x50, 0x86, 0x1b, 0x81, 0x22, 0xe8, 0x12, 0x70, 0x12,
-		0xe2, 0x20, 0x20, 0x21, 0x55, 0xfb, 0x2b, 0x29, 0x13, 0x71, 0xe0, 0x72, 0xeb, 0xaa, 0x7e, 0xa3,
-		0xd1, 0x56, 0xa1, 0xa3, 0x78, 0x1d, 0x91, 0xb0, 0xa9, 0x08, 0x4a, 0x3a, 0x02, 0x86, 0xe9, 0x51,
-		0xdd, 0xa5, 0x4c, 0xd1, 0xac, 0xea, 0x68, 0x54, 0x10, 0xec, 0x13, 0x3f, 0x3c, 0x22, 0xa1, 0xa2,
-		0xfc, 0xbf, 0x7e, 0x94, 0x07, 0x75, 0xff, 0x44, 0xd1, 0x5e, 0xd3, 0xd1, 0xd6, 0x5c, 0xca, 0xfc,
-		0x96, 0x71, 0xef, 0xa6, 0x48, 0x68, 0x0d, 0x87, 0xc4, 0xe9, 0xa6, 0xba, 0x91, 0x41, 0x95, 0xf6,
-		0xa2, 0xf4, 0x09, 0xcc, 0xef, 0x61, 0x7a, 0xf4, 0xa9, 0x4b, 0xd9, 0x33, 0x1c, 0x32, 0x97, 0x1f,
-		0x04, 0x7a, 0x1f, 0xe6, 0x5c, 0xea, 0xd7, 0xc5, 0xa9, 0xd8, 0x87, 0xa1, 0x1f, 0x05, 0xd4, 0x34,
-		0x56, 0x46, 0x57, 0xa7, 0xac, 0xd9, 0xd6, 0xfa, 0x23, 0xb1, 0x5c, 0xfa, 0xdb, 0x18, 0x5c, 0xe8,
-		0x12, 0xb0, 0xed, 0x7b, 0x07, 0xee, 0x21, 0x32, 0x61, 0xe2, 0x98, 0x84, 0xd4, 0xf5, 0x3d, 0xd3,
-		0x58, 0x31, 0x56, 0x47, 0xad, 0xf8, 0x13, 0x6d, 0xc0, 0x82, 0x17, 0x35, 0xec, 0x90, 0x60, 0xc7,
-		0x0e, 0x62, 0x2e, 0x6a, 0x8e, 0xac, 0x18, 0xab, 0xb9, 0xad, 0x11, 0xd3, 0xb0, 0xe6, 0xbd, 0xa8,
-		0x61, 0x11, 0xec, 0xb4, 0x44, 0x52, 0x74, 0x0b, 0x16, 0x39, 0xcf, 0x49, 0xe8, 0x32, 0x92, 0x64,
-		0x1a, 0x6d, 0x31, 0x21, 0x2f, 0x6a, 0xbc, 0xe4, 0xdb, 0x09, 0x2e, 0x0f, 0x66, 0x3b, 0xb5, 0x8c,
-		0xad, 0x8c, 0xae, 0xe6, 0x37, 0x1e, 0x96, 0xb3, 0x32, 0xb4, 0x9c, 0xe1, 0x4f, 0x39, 0x6d, 0xd0,
-		0x43, 0x8f, 0x85, 0x4d, 0xab, 0x10, 0xa6, 0xad, 0x7c, 0x0d, 0x73, 0x5d, 0x16, 0xe6, 0x84, 0xc2,
-		0xdd, 0xe1, 0x15, 0x76, 0x38, 0x23, 0x35, 0xce, 0x9e, 0xa4, 0x57, 0x8b, 0x1e, 0x2c, 0x68, 0x2c,
-		0x43, 0x73, 0x30, 0x7a, 0x44, 0x9a, 0x22, 0xf2, 0x39, 0x8b, 0xff, 0x89, 0x36, 0x21, 0x77, 0x8c,
-		0xeb, 0x11, 0x11, 0x71, 0xce, 0x6f, 0xfc, 0xff, 0x10, 0x06, 0x59, 0x92, 0xf3, 0xde, 0xc8, 0x5d,
-		0xa3, 0xe8, 0xc3, 0xa2, 0xce, 0xb0, 0x37, 0xa6, 0xb0, 0xf4, 0x13, 0x98, 0xff, 0xd4, 0xc7, 0xce,
-		0x16, 0xae, 0x63, 0xaf, 0x4a, 0xc2, 0xc7, 0xae, 0xc7, 0x28, 0xba, 0x0e, 0x33, 0xfb, 0xb8, 0x7a,
-		0x54, 0xf7, 0x0f, 0xed, 0xaa, 0x1f, 0x79, 0x4c, 0xa5, 0xd8, 0xb4, 0x5a, 0xdc, 0xe6, 0x6b, 0xe8,
-		0x26, 0xcc, 0x86, 0x98, 0x1f, 0x06, 0x09, 0x6d, 0x4a, 0xaa, 0xbe, 0xe7, 0x08, 0x53, 0x0c, 0x6b,
-		0x86, 0x2f, 0x3f, 0x23, 0xe1, 0x73, 0xb1, 0x58, 0xfa, 0x87, 0x01, 0xc5, 0x67, 0x7e, 0xbd, 0xbe,
-		0xeb, 0x87, 0x3b, 0xa4, 0xea, 0xf2, 0x1c, 0xe5, 0x16, 0x59, 0xe4, 0x75, 0x44, 0x28, 0x43, 0x15,
-		0x98, 0x08, 0xe5, 0x9f, 0x42, 0x4b, 0x7e, 0x63, 0x2d, 0xed, 0x09, 0x0e, 0x5c, 0xee, 0x44, 0xb6,
-		0x04, 0x2b, 0xe6, 0x47, 0x97, 0x60, 0xca, 0xf1, 0x1b, 0xd8, 0xf5, 0x6c, 0x57, 0xda, 0x32, 0x65,
-		0x4d, 0xca, 0x85, 0x8a, 0xc3, 0x37, 0x03, 0xbf, 0x5e, 0x27, 0x21, 0xdf, 0x1c, 0x95, 0x9b, 0x72,
-		0xa1, 0xe2, 0xa0, 0x1b, 0x50, 0x38, 0xf0, 0xc3, 0x13, 0x1c, 0x3a, 0xc4, 0xb1, 0x0f, 0x42, 0xbf,
-		0x61, 0x8e, 0x09, 0x8a, 0x99, 0xd6, 0xea, 0x6e, 0xe8, 0x37, 0xd0, 0x7b, 0x30, 0xdb, 0x51, 0xbb,
-		0x66, 0x4e, 0xd0, 0x15, 0xd2, 0xa5, 0x5b, 0xfa, 0x73, 0x1e, 0x2e, 0x69, 0x2d, 0xa6, 0x81, 0xef,
-		0x51, 0x82, 0x96, 0x01, 0x78, 0xaf, 0xb0, 0x99, 0x7f, 0x44, 0x64, 0x01, 0x4f, 0x5b, 0x53, 0x7c,
-		0x65, 0x8f, 0x2f, 0xa0, 0xcf, 0x01, 0xc5, 0xad, 0xcb, 0x26, 0x5f, 0x92, 0x6a, 0xc4, 0x25, 0xab,
-		0x83, 0xbe, 0xa9, 0x0d, 0xcf, 0x4b, 0x45, 0xfe, 0x30, 0xa6, 0xb6, 0xe6, 0x4f, 0x3a, 0x97, 0xd0,
-		0x2e, 0xcc, 0xb4, 0xc4, 0xb2, 0x66, 0x40, 0x44, 0x18, 0xf2, 0x1b, 0xd7, 0x7a, 0x4a, 0xdc, 0x6b,
-		0x06, 0xc4, 0x9a, 0x3e, 0x49, 0x7c, 0xa1, 0x17, 0x70, 0x31, 0x08, 0xc9, 0xb1, 0xeb, 0x47, 0xd4,
-		0xa6, 0x0c, 0x87, 0x8c, 0x38, 0x36, 0x39, 0x26, 0x1e, 0xe3, 0xa1, 0x1d, 0x13, 0x32, 0x2f, 0x95,
-		0x25, 0x90, 0x94, 0x63, 0x20, 0x29, 0x57, 0x3c, 0x76, 0xe7, 0xd6, 0x0b, 0x9e, 0x77, 0xd6, 0x52,
-		0xcc, 0xfd, 0x5c, 0x32, 0x3f, 0xe4, 0xbc, 0x15, 0x07, 0xad, 0xc2, 0x5c, 0x97, 0xb8, 0x9c, 0xc8,
-		0xbc, 0x02, 0x4d, 0x53, 0x9a, 0x30, 0x81, 0x19, 0x23, 0x8d, 0x80, 0x99, 0xe3, 0xa2, 0x24, 0xe2,
-		0x4f, 0x54, 0x82, 0x19, 0x8f, 0x7c, 0xc9, 0xda, 0x02, 0x26, 0x84, 0x80, 0x3c, 0x5f, 0x8c, 0xb9,
-		0x3f, 0x00, 0x94, 0x4a, 0x6f, 0xbb, 0xe6, 0x7a, 0xcc, 0x9c, 0x14, 0x84, 0x73, 0xc9, 0x1c, 0xe7,
-		0xd5, 0x80, 0xee, 0x82, 0x49, 0x99, 0x5b, 0x3d, 0x6a, 0xb6, 0x8f, 0xc2, 0x26, 0x1e, 0xde, 0xaf,
-		0x13, 0xc7, 0x9c, 0x5a, 0x31, 0x56, 0x27, 0xad, 0x25, 0xb9, 0xdf, 0x0a, 0xf4, 0x43, 0xb9, 0x8b,
-		0xee, 0x42, 0x4e, 0x00, 0x9f, 0x09, 0x22, 0x26, 0xa5, 0x9e, 0x71, 0xfe, 0x8c, 0x53, 0x5a, 0x92,
-		0x01, 0x59, 0x30, 0xe3, 0xa8, 0xbc, 0xb1, 0x5d, 0xef, 0xc0, 0x37, 0xf3, 0x42, 0xc2, 0x77, 0xd2,
-		0x12, 0x24, 0xf0, 0x88, 0x12, 0x0f, 0xb1, 0x47, 0x5d, 0xe2, 0xb1, 0x38, 0xdb, 0x2a, 0xde, 0x81,
-		0x6f, 0x4d, 0x3b, 0x89, 0x2f, 0xf4, 0x0a, 0x2e, 0x77, 0x27, 0x95, 0x2d, 0xd2, 0x90, 0x63, 0x96,
-		0x39, 0x2d, 0x54, 0x2c, 0x6b, 0x8d, 0x8c, 0x5b, 0x88, 0x75, 0xb1, 0x2b, 0xab, 0xe2, 0x2d, 0x54,
-		0x86, 0x05, 0x19, 0x74, 0x8e, 0x94, 0xc4, 0x8e, 0xd1, 0x69, 0x46, 0x9c, 0xcf, 0xbc, 0xd8, 0x7a,
-		0xce, 0x77, 0x5e, 0x28, 0x9c, 0xba, 0x06, 0xd3, 0xfb, 0x21, 0xf6, 0xaa, 0x35, 0x55, 0x05, 0x05,
-		0x51, 0x05, 0x79, 0xb9, 0x26, 0xeb, 0x60, 0x13, 0x0a, 0xb4, 0x5a, 0x23, 0x4e, 0x54, 0x27, 0x8e,
-		0xcd, 0x47, 0x15, 0x73, 0x56, 0x18, 0x59, 0xec, 0xca, 0xae, 0xbd, 0x78, 0x8e, 0xb1, 0x66, 0x5a,
-		0x1c, 0x7c, 0x0d, 0x7d, 0x0f, 0xa6, 0xe3, 0x9c, 0x12, 0x02, 0xe6, 0xfa, 0x0a, 0xc8, 0x2b, 0x7a,
-		0xc1, 0xfe, 0x05, 0x4c, 0xf0, 0x13, 0x71, 0x09, 0x35, 0xe7, 0x05, 0xd2, 0x6c, 0x65, 0xf7, 0xd9,
-		0x1e, 0x05, 0x5f, 0xfe, 0x4c, 0x0a, 0x91, 0x28, 0x13, 0x8b, 0xe4, 0x21, 0x63, 0x3e, 0xc3, 0x75,
-		0x5b, 0x8d, 0x17, 0xf6, 0x7e, 0x93, 0x11, 0x6a, 0x22, 0x91, 0x89, 0xf3, 0x62, 0xeb, 0xb1, 0xdc,
-		0xd9, 0xe2, 0x1b, 0xe8, 0x0b, 0x98, 0x6b, 0x41, 0x9f, 0x5d, 0x15, 0x38, 0x66, 0x2e, 0x08, 0x87,
-		0xd6, 0x87, 0x06, 0x40, 0x6b, 0x36, 0xe8, 0x18, 0x29, 0x7e, 0x0c, 0x0b, 0x75, 0x1f, 0x3b, 0xf6,
-		0xbe, 0xc2, 0x02, 0x51, 0x16, 0xd4, 0x5c, 0xec, 0x87, 0x2f, 0x5d, 0xf8, 0x61, 0xcd, 0xd7, 0xbb,
-		0x20, 0xe5, 0x09, 0xcc, 0xe1, 0x88, 0xf9, 0xca, 0x6a, 0x59, 0x71, 0xef, 0x08, 0xc9, 0xd7, 0xb5,
-		0x19, 0xb7, 0x19, 0x31, 0x5f, 0xda, 0xc5, 0xf9, 0xad, 0x02, 0x4e, 0x7d, 0x17, 0x5f, 0xc1, 0x74,
-		0x32, 0xa4, 0x49, 0x7c, 0x9c, 0x92, 0xf8, 0x78, 0x37, 0x8d, 0x8f, 0x03, 0x15, 0x5f, 0x1b, 0x16,
-		0x13, 0xa0, 0xb5, 0x59, 0x65, 0xee, 0xb1, 0xcb, 0x9a, 0xa7, 0x07, 0x2d, 0x8d, 0x84, 0xff, 0x46,
-		0xd0, 0xfa, 0x1d, 0xb4, 0x40, 0x2b, 0x6d, 0xf1, 0xb7, 0x0a, 0x5a, 0x57, 0x21, 0x8f, 0x95, 0x35,
-		0xed, 0x20, 0x40, 0xbc, 0x54, 0x71, 0x38, 0xaa, 0xb5, 0x08, 0x04, 0xaa, 0x8d, 0xf5, 0x40, 0xb5,
-		0x96, 0x63, 0x02, 0xd5, 0x70, 0xe2, 0x0b, 0x6d, 0x40, 0xce, 0xf5, 0x82, 0x88, 0x89, 0xe8, 0xe4,
-		0x37, 0x2e, 0xeb, 0x4f, 0x14, 0x37, 0x79, 0x6e, 0x5b, 0x92, 0x54, 0xd3, 0xa0, 0xc6, 0xcf, 0xda,
-		0xa0, 0x26, 0x86, 0x6b, 0x50, 0x7b, 0x70, 0x31, 0x96, 0x67, 0xf3, 0xf2, 0xaa, 0xfb, 0x94, 0x08,
-		0x41, 0x7e, 0x24, 0x21, 0x2d, 0xbf, 0x71, 0xb1, 0x4b, 0xd6, 0x8e, 0xba, 0x15, 0x5a, 0x4b, 0x31,
-		0xef, 0x9e, 0xbf, 0xcd, 0x39, 0xf7, 0x24, 0x23, 0xfa, 0x21, 0x2c, 0x09, 0x25, 0xdd, 0x22, 0xa7,
-		0xfa, 0x89, 0x5c, 0x10, 0x8c, 0x1d, 0xf2, 0x76, 0x61, 0xbe, 0x46, 0x70, 0xc8, 0xf6, 0x09, 0x66,
-		0x2d, 0x51, 0xd0, 0x4f, 0xd4, 0x5c, 0x8b, 0x27, 0x96, 0x93, 0xc0, 0xfd, 0x7c, 0x1a, 0xf7, 0x5f,
-		0xc1, 0x95, 0xf4, 0x49, 0xd8, 0xfe, 0x81, 0xcd, 0x6a, 0x2e, 0xb5, 0x63, 0x86, 0xe9, 0xbe, 0x81,
-		0x2d, 0xa6, 0x4e, 0xe6, 0xe9, 0xc1, 0x5e, 0xcd, 0xa5, 0x9b, 0x4a, 0x7e, 0x25, 0xe9, 0x81, 0x43,
-		0x18, 0x76, 0xeb, 0x54, 0x60, 0x5b, 0xbf, 0x4c, 0x69, 0x3b, 0xb1, 0x23, 0xb9, 0xba, 0xc7, 0xb0,
-		0xc2, 0xe9, 0xc6, 0xb0, 0xf7, 0x60, 0xb6, 0x25, 0x47, 0x76, 0x0c, 0x01, 0x8f, 0x53, 0x56, 0x21,
-		0x5e, 0xde, 0x11, 0xab, 0xe8, 0x23, 0x18, 0xaf, 0x11, 0xec, 0x90, 0x50, 0xa1, 0xdf, 0x25, 0xad,
-		0xa6, 0xc7, 0x82, 0xc4, 0x52, 0xa4, 0x59, 0x68, 0x30, 0x7f, 0x2e, 0x68, 0xf0, 0x66, 0x81, 0x4c,
-		0x87, 0x35, 0x8b, 0xa7, 0xc6, 0x9a, 0xd2, 0x5f, 0xc6, 0x60, 0x69, 0xd3, 0x71, 0x74, 0x97, 0x97,
-		0x54, 0xf3, 0x36, 0x3a, 0x9a, 0xf7, 0x1b, 0x6a, 0x88, 0xf7, 0x60, 0xaa, 0x3d, 0xb4, 0x8d, 0x0e,
-		0x32, 0xb4, 0x4d, 0xb2, 0x78, 0x46, 0xbb, 0x0a, 0xf9, 0x56, 0xb7, 0x50, 0xb3, 0xfa, 0xa8, 0x05,
-		0xf1, 0x52, 0xc5, 0xe9, 0x6c, 0x27, 0xaa, 0x09, 0xa8, 0x82, 0xcd, 0x0d, 0xd1, 0x4e, 0xc4, 0x68,
-		0x1f, 0x97, 0xed, 0x3d, 0x18, 0xa7, 0x7e, 0x14, 0x56, 0x65, 0x7b, 0x2c, 0x74, 0x82, 0x71, 0x62,
-		0x8e, 0xc5, 0xf4, 0xe8, 0xb9, 0xa0, 0xb4, 0x14, 0x87, 0x06, 0xe5, 0x26, 0x74, 0x28, 0x17, 0x68,
-		0x32, 0x6a, 0xb2, 0xdf, 0x63, 0x84, 0xfe, 0x54, 0xcb, 0x1d, 0x09, 0xa6, 0x9e, 0x06, 0x3a, 0xb2,
-		0xac, 0xb8, 0x05, 0x8b, 0x3a, 0x42, 0xcd, 0x28, 0xb2, 0x98, 0x1c, 0x45, 0xa6, 0x92, 0x63, 0xc6,
-		0x09, 0x5c, 0xe8, 0xb2, 0x41, 0xa1, 0xad, 0xae, 0x44, 0x8c, 0xf3, 0x2a, 0x91, 0xd2, 0x3f, 0x73,
-		0x22, 0xa7, 0x75, 0xb3, 0xcd, 0xb7, 0x91, 0xd3, 0xfc, 0xe6, 0x27, 0x8e, 0xdb, 0x6e, 0xab, 0x96,
-		0x48, 0x5f, 0x90, 0xeb, 0x3b, 0xb1, 0x01, 0xa9, 0xec, 0x1f, 0x3b, 0x53, 0xf6, 0xe7, 0x86, 0xcb,
-		0xfe, 0xf1, 0xb3, 0x67, 0xff, 0xc4, 0x39, 0x64, 0xff, 0xa4, 0x2e, 0xfb, 0x3d, 0x30, 0x71, 0xe2,
-		0x28, 0x77, 0x5c, 0x1a, 0xf0, 0xac, 0xe0, 0xf7, 0x3e, 0x85, 0xd8, 0x1b, 0x3d, 0xaa, 0x20, 0x83,
-		0xd3, 0xca, 0x94, 0xa9, 0xad, 0x36, 0x18, 0xa0, 0xda, 0x34, 0xf9, 0xf6, 0x16, 0xab, 0xed, 0x9b,
-		0x51, 0x30, 0xb3, 0x9c, 0x45, 0x3f, 0x80, 0xd9, 0xf6, 0x00, 0x21, 0x6e, 0xab, 0xaa, 0xdc, 0xf4,
-		0xb8, 0xac, 0xee, 0x65, 0xe2, 0x49, 0xc1, 0x6a, 0x0f, 0x81, 0xe2, 0xbb, 0x6b, 0xa6, 0x1b, 0x19,
-		0x6e, 0xa6, 0x4b, 0x4c, 0x39, 0xa3, 0xc3, 0x4e, 0x39, 0x63, 0xe7, 0x3f, 0xe5, 0xe4, 0xce, 0x67,
-		0xca, 0x19, 0x3f, 0xb7, 0x29, 0x67, 0x42, 0x37, 0xe5, 0xa8, 0x5e, 0xaa, 0xbd, 0xb9, 0xbc, 0xd9,
-		0x5e, 0xfa, 0x8d, 0x01, 0x8b, 0xe2, 0x02, 0x19, 0x7b, 0x11, 0x77, 0xd2, 0xed, 0xce, 0x5b, 0xe2,
-		0xfb, 0x5a, 0xe7, 0x75, 0xbc, 0x03, 0xde, 0x0f, 0xcf, 0x32, 0x0b, 0x0c, 0x76, 0x7d, 0x2c, 0x7d,
-		0x6d, 0xc0, 0x3b, 0x1d, 0x16, 0xaa, 0xa8, 0x3e, 0x80, 0x69, 0xf1, 0x5a, 0x65, 0x87, 0x84, 0x46,
-		0xf5, 0xd8, 0xc7, 0xde, 0x79, 0x92, 0x17, 0x1c, 0x96, 0x60, 0x40, 0x15, 0x28, 0xc4, 0x02, 0x7e,
-		0x4a, 0xaa, 0x8c, 0x38, 0x3d, 0xef, 0xea, 0xf2, 0x8e, 0xae, 0x28, 0xad, 0x99, 0xd7, 0xc9, 0xcf,
-		0xd2, 0xdf, 0x0d, 0x58, 0x91, 0x86, 0x39, 0x82, 0x8e, 0xfb, 0xbb, 0xed, 0x37, 0x82, 0x3a, 0xe1,
-		0xc4, 0x2a, 0x94, 0x4f, 0x3b, 0xcf, 0xe3, 0xb6, 0x56, 0x51, 0x3f, 0x39, 0x6f, 0xe1, 0x6c, 0x2e,
-		0xc0, 0x84, 0xe0, 0x55, 0x33, 0xda, 0x94, 0x35, 0xce, 0x3f, 0x2b, 0x4e, 0xe9, 0x3a, 0x5c, 0xeb,
-		0x61, 0x9e, 0x3c, 0x98, 0xd2, 0x5f, 0x0d, 0xb8, 0xbc, 0xcd, 0xa7, 0xed, 0xfa, 0xd3, 0x88, 0x51,
-		0x86, 0x3d, 0xc7, 0xf5, 0x0e, 0xf9, 0xcd, 0x7e, 0x20, 0x88, 0x4f, 0xbd, 0x39, 0x8c, 0x74, 0xbc,
-		0x39, 0x3c, 0x82, 0x42, 0xcb, 0xa9, 0xf6, 0x1b, 0x72, 0x21, 0xa3, 0xac, 0x63, 0xcf, 0x64, 0x59,
-		0xb3, 0xc4, 0xd7, 0x59, 0x70, 0xbc, 0x74, 0x15, 0x96, 0x33, 0xdc, 0x53, 0x01, 0xf8, 0x19, 0x5c,
-		0xd8, 0x21, 0xb4, 0x1a, 0xba, 0xfb, 0xa4, 0xc5, 0xae, 0x5c, 0xdf, 0xed, 0xcc, 0x81, 0x0f, 0xb4,
-		0x5a, 0x33, 0xd8, 0x07, 0x3b, 0xfa, 0xd2, 0xbf, 0x0d, 0x30, 0xbb, 0x25, 0xa8, 0xb2, 0xf9, 0x18,
-		0x26, 0x64, 0x38, 0xe5, 0xef, 0x7e, 0xf9, 0x8d, 0xab, 0x99, 0x6f, 0x47, 0x24, 0x14, 0x38, 0x1c,
-		0xd3, 0xf3, 0x8b, 0x4d, 0x3b, 0xfa, 0x94, 0x61, 0x16, 0x51, 0x55, 0x32, 0xd7, 0x7b, 0xc6, 0xee,
-		0xb9, 0x20, 0xb5, 0x0a, 0x2c, 0xf5, 0x8d, 0x5e, 0x6a, 0xda, 0xe2, 0x68, 0x8f, 0xa0, 0x0c, 0xdc,
-		0x11, 0x29, 0x2c, 0x8b, 0x83, 0xee, 0xa4, 0xa7, 0xf1, 0x29, 0x2c, 0xc1, 0xb8, 0xea, 0xe5, 0x32,
-		0xfb, 0xd4, 0x57, 0x3a, 0x2b, 0x46, 0x86, 0xcb, 0x8a, 0x5f, 0x8d, 0xc0, 0x95, 0x2c, 0xad, 0x2a,
-		0xf4, 0xaf, 0x61, 0xb9, 0xfd, 0x54, 0xd4, 0x0a, 0x64, 0xe2, 0xd7, 0x44, 0x79, 0x20, 0xe5, 0xc1,
-		0xbc, 0x7f, 0x42, 0x18, 0x76, 0x30, 0xc3, 0x56, 0x31, 0x39, 0x27, 0xa5, 0x55, 0x73, 0x95, 0xad,
-		0x97, 0x7c, 0xad, 0xca, 0x91, 0xd3, 0xa9, 0x74, 0x12, 0x77, 0x86, 0xb4, 0xca, 0xd2, 0x6d, 0xb8,
-		0xf4, 0x88, 0xb4, 0xc2, 0x40, 0xb7, 0x9a, 0x12, 0x20, 0xfb, 0xc4, 0xbe, 0xf4, 0xa7, 0x31, 0xb8,
-		0xac, 0xe7, 0x53, 0xd1, 0xfb, 0x85, 0x01, 0x4b, 0x1a, 0x5f, 0x1a, 0x38, 0x50, 0x71, 0x7b, 0x9a,
-		0x0d, 0xa6, 0xbd, 0x04, 0x97, 0x77, 0x3a, 0x7c, 0x79, 0x82, 0x03, 0x39, 0x05, 0x2e, 0x38, 0xdd,
-		0x3b, 0xc2, 0x0c, 0xcd, 0x29, 0x72, 0x33, 0x46, 0xce, 0x64, 0xc6, 0x66, 0xc7, 0x29, 0xb6, 0xcd,
-		0xc0, 0xdd, 0x3b, 0xc5, 0xaf, 0x78, 0x89, 0xeb, 0xed, 0xd6, 0x0c, 0xa5, 0x8f, 0xd3, 0xaf, 0xd1,
-		0x3d, 0xa6, 0xf1, 0xac, 0xbe, 0x91, 0xfc, 0x95, 0xf8, 0xab, 0xf4, 0x1c, 0xfb, 0x36, 0x75, 0x97,
-		0xfe, 0x30, 0x02, 0xef, 0x7e, 0x1e, 0x38, 0x98, 0x91, 0xac, 0x76, 0x30, 0x08, 0xc8, 0x9c, 0xa1,
-		0xd0, 0xcf, 0x0f, 0x83, 0x74, 0xfd, 0x6f, 0xec, 0x3c, 0xfa, 0xdf, 0x7b, 0x70, 0xa3, 0x4f, 0x88,
-		0x14, 0x50, 0xfd, 0x71, 0x04, 0x6e, 0x58, 0xe4, 0x20, 0x24, 0xb4, 0xf6, 0xbf, 0x68, 0x66, 0x45,
-		0x73, 0x15, 0x6e, 0xf6, 0x8b, 0x91, 0x0c, 0xe7, 0xc6, 0xbf, 0xa6, 0x21, 0xff, 0x44, 0xe5, 0xf3,
-		0xe6, 0xb3, 0x0a, 0xfa, 0xb9, 0x01, 0x0b, 0x9a, 0x5f, 0xe5, 0xd0, 0xad, 0x21, 0x7f, 0xc4, 0x13,
-		0x47, 0x50, 0xbc, 0x7d, 0xaa, 0x9f, 0xfe, 0x92, 0x46, 0x24, 0x8b, 0x76, 0x00, 0x23, 0x34, 0xb7,
-		0xe5, 0x01, 0x8c, 0xd0, 0xde, 0x80, 0x8e, 0x61, 0xb6, 0xe3, 0xa1, 0x09, 0x7d, 0x38, 0xec, 0xbb,
-		0x58, 0x71, 0x7d, 0x08, 0x8e, 0x94, 0xde, 0x94, 0xdf, 0x1f, 0x0e, 0xfb, 0x42, 0xd0, 0x47, 0xaf,
-		0xd6, 0xdf, 0x00, 0x66, 0x52, 0x97, 0x16, 0x54, 0xce, 0x96, 0xa1, 0xbb, 0x7f, 0x15, 0xd7, 0x06,
-		0xa6, 0x57, 0x1a, 0x7f, 0x6b, 0xc0, 0xc5, 0xcc, 0xd1, 0x1c, 0xdd, 0xcb, 0x16, 0xd7, 0xef, 0xba,
-		0x51, 0xbc, 0x7f, 0x2a, 0x5e, 0x65, 0xd6, 0xaf, 0x0d, 0x78, 0x47, 0x3b, 0x2c, 0xa3, 0x3b, 0xd9,
-		0x62, 0x7b, 0x5d, 0x1e, 0x8a, 0xdf, 0x1d, 0x9a, 0x4f, 0x99, 0xd2, 0x84, 0xb9, 0x4e, 0x80, 0x41,
-		0xeb, 0xc3, 0x80, 0x91, 0xd4, 0x7f, 0x0a, 0xfc, 0x42, 0xbf, 0x31, 0x60, 0x49, 0x3f, 0x1b, 0xa2,
-		0x1e, 0xee, 0xf4, 0x9c, 0x61, 0x8b, 0x77, 0x87, 0x67, 0x54, 0xd6, 0xfc, 0xd2, 0x80, 0x45, 0xdd,
-		0x24, 0x82, 0x6e, 0x0f, 0x3b, 0xb9, 0x48, 0x4b, 0xee, 0x9c, 0x6e, 0xe0, 0x41, 0xbf, 0x37, 0x60,
-		0xb9, 0x27, 0x4e, 0xa1, 0x4f, 0xb2, 0x25, 0x0f, 0x32, 0x03, 0x14, 0x1f, 0x9c, 0x9a, 0x5f, 0x99,
-		0xf8, 0xb5, 0x01, 0x57, 0x7a, 0x37, 0x7f, 0xf4, 0xa0, 0x57, 0x79, 0x0c, 0x00, 0xad, 0xc5, 0xef,
-		0x9f, 0x5e, 0x80, 0xb4, 0x72, 0xeb, 0xfe, 0x8f, 0x3e, 0x3e, 0x74, 0x59, 0x2d, 0xda, 0x2f, 0x57,
-		0xfd, 0xc6, 0x5a, 0xea, 0x9f, 0x43, 0xcb, 0x87, 0xc4, 0x93, 0xff, 0x4d, 0x9b, 0xfc, 0x87, 0xde,
-		0xfb, 0xf1, 0xdf, 0xc7, 0xeb, 0xfb, 0xe3, 0x62, 0xf7, 0xa3, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-		0x3d, 0x55, 0x76, 0xc1, 0xfe, 0x2b, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4f, 0x6f, 0x1b, 0xc7,
+		0x15, 0xc7, 0x92, 0xfa, 0xfb, 0x28, 0x51, 0xd2, 0x48, 0x96, 0xd7, 0xb4, 0x65, 0xcb, 0x9b, 0xd8,
+		0x51, 0xda, 0x94, 0x8a, 0x68, 0x3b, 0x71, 0x1c, 0x34, 0xa9, 0x64, 0x59, 0xb1, 0x8a, 0xb8, 0x76,
+		0xd6, 0x4a, 0x02, 0xb4, 0x81, 0x37, 0x23, 0xee, 0x48, 0xdc, 0x8a, 0xdc, 0x5d, 0xef, 0x0c, 0xa5,
+		0x30, 0x87, 0x16, 0x28, 0xd2, 0xa2, 0x40, 0x7b, 0xec, 0xa1, 0xc7, 0xb6, 0xe9, 0xad, 0x9f, 0xa0,
+		0x97, 0x02, 0xfd, 0x16, 0x05, 0x82, 0x1e, 0x7a, 0xe8, 0x07, 0xe8, 0xa9, 0xa7, 0x02, 0xc5, 0xfc,
+		0x59, 0x72, 0x97, 0x9c, 0xe5, 0x1f, 0x49, 0x4e, 0x7a, 0xe8, 0x8d, 0xf3, 0xe6, 0xbd, 0x37, 0x6f,
+		0xde, 0xbc, 0x79, 0xef, 0xf7, 0x66, 0x25, 0xb8, 0xd9, 0xdc, 0x27, 0xd1, 0x7a, 0x15, 0xbb, 0xc4,
+		0xaf, 0x92, 0xf5, 0x06, 0x66, 0xd5, 0x9a, 0xe7, 0x1f, 0xae, 0x1f, 0x6f, 0xac, 0x53, 0x12, 0x1d,
+		0x7b, 0x55, 0x52, 0x0e, 0xa3, 0x80, 0x05, 0xc8, 0xe4, 0x7c, 0x65, 0xc5, 0x57, 0x8e, 0xf9, 0xca,
+		0xc7, 0x1b, 0xa5, 0xab, 0x87, 0x41, 0x70, 0x58, 0x27, 0xeb, 0x82, 0x6f, 0xbf, 0x79, 0xb0, 0xee,
+		0x36, 0x23, 0xcc, 0xbc, 0xc0, 0x97, 0x92, 0xa5, 0x6b, 0xdd, 0xf3, 0xcc, 0x6b, 0x10, 0xca, 0x70,
+		0x23, 0x54, 0x0c, 0x3d, 0x0a, 0x4e, 0x22, 0x1c, 0x86, 0x24, 0xa2, 0x6a, 0x7e, 0x35, 0x65, 0x22,
+		0x0e, 0x3d, 0x6e, 0x5d, 0x35, 0x68, 0x34, 0x3a, 0x4b, 0xe8, 0x38, 0x9e, 0x37, 0x49, 0xd4, 0x52,
+		0x0c, 0x96, 0x8e, 0x81, 0x61, 0x7a, 0x54, 0xf7, 0x28, 0x53, 0x3c, 0x6b, 0x3a, 0x1e, 0xe5, 0x04,
+		0xe7, 0x24, 0x88, 0x8e, 0x48, 0xa4, 0x38, 0xbf, 0x35, 0x88, 0xf3, 0xa0, 0x1e, 0x9c, 0x28, 0xde,
+		0xeb, 0x3a, 0xde, 0x9a, 0x47, 0x59, 0xd0, 0x36, 0xee, 0xe5, 0x14, 0x0b, 0xad, 0xe1, 0x88, 0xb8,
+		0xbd, 0x5c, 0x37, 0x32, 0xb8, 0xd2, 0xbb, 0xb0, 0xde, 0x81, 0x85, 0x3d, 0x4c, 0x8f, 0xde, 0xf7,
+		0x28, 0x7b, 0x82, 0x23, 0xe6, 0xf1, 0x83, 0x40, 0xaf, 0xc2, 0xbc, 0x47, 0x83, 0xba, 0x38, 0x15,
+		0xe7, 0x30, 0x0a, 0x9a, 0x21, 0x35, 0x8d, 0xd5, 0xfc, 0xda, 0xb4, 0x3d, 0xd7, 0xa6, 0xbf, 0x27,
+		0xc8, 0xd6, 0x3f, 0xc6, 0xe0, 0x62, 0x8f, 0x82, 0xfb, 0x81, 0x7f, 0xe0, 0x1d, 0x22, 0x13, 0x26,
+		0x8f, 0x49, 0x44, 0xbd, 0xc0, 0x37, 0x8d, 0x55, 0x63, 0x2d, 0x6f, 0xc7, 0x43, 0x54, 0x81, 0x45,
+		0xbf, 0xd9, 0x70, 0x22, 0x82, 0x5d, 0x27, 0x8c, 0xa5, 0xa8, 0x99, 0x5b, 0x35, 0xd6, 0xc6, 0xb7,
+		0x72, 0xa6, 0x61, 0x2f, 0xf8, 0xcd, 0x86, 0x4d, 0xb0, 0xdb, 0x56, 0x49, 0xd1, 0x6d, 0x58, 0xe2,
+		0x32, 0x27, 0x91, 0xc7, 0x48, 0x52, 0x28, 0xdf, 0x16, 0x42, 0x7e, 0xb3, 0xf1, 0x31, 0x9f, 0x4e,
+		0x48, 0xf9, 0x30, 0xd7, 0xbd, 0xca, 0xd8, 0x6a, 0x7e, 0xad, 0x50, 0x79, 0x50, 0xce, 0x8a, 0xd0,
+		0x72, 0xc6, 0x7e, 0xca, 0x69, 0x83, 0x1e, 0xf8, 0x2c, 0x6a, 0xd9, 0xc5, 0x28, 0x6d, 0xe5, 0x73,
+		0x98, 0xef, 0xb1, 0x70, 0x5c, 0x2c, 0xb8, 0x33, 0xfa, 0x82, 0x5d, 0x9b, 0x91, 0x2b, 0xce, 0x9d,
+		0xa4, 0xa9, 0x25, 0x1f, 0x16, 0x35, 0x96, 0xa1, 0x79, 0xc8, 0x1f, 0x91, 0x96, 0xf0, 0xfc, 0xb8,
+		0xcd, 0x7f, 0xa2, 0x4d, 0x18, 0x3f, 0xc6, 0xf5, 0x26, 0x11, 0x7e, 0x2e, 0x54, 0xbe, 0x3d, 0x82,
+		0x41, 0xb6, 0x94, 0xbc, 0x97, 0xbb, 0x6b, 0x94, 0x02, 0x58, 0xd2, 0x19, 0xf6, 0xc2, 0x16, 0xb4,
+		0x3e, 0x85, 0x85, 0xf7, 0x03, 0xec, 0x6e, 0xe1, 0x3a, 0xf6, 0xab, 0x24, 0x7a, 0xe8, 0xf9, 0x8c,
+		0xa2, 0x97, 0x60, 0x76, 0x1f, 0x57, 0x8f, 0xea, 0xc1, 0xa1, 0x53, 0x0d, 0x9a, 0x3e, 0x53, 0x21,
+		0x36, 0xa3, 0x88, 0xf7, 0x39, 0x0d, 0xdd, 0x84, 0xb9, 0x08, 0xf3, 0xc3, 0x20, 0x91, 0x43, 0x49,
+		0x35, 0xf0, 0x5d, 0x61, 0x8a, 0x61, 0xcf, 0x72, 0xf2, 0x13, 0x12, 0x3d, 0x15, 0x44, 0xeb, 0x5f,
+		0x06, 0x94, 0x9e, 0x04, 0xf5, 0xfa, 0x4e, 0x10, 0x6d, 0x93, 0xaa, 0xc7, 0x63, 0x94, 0x5b, 0x64,
+		0x93, 0xe7, 0x4d, 0x42, 0x19, 0xda, 0x85, 0xc9, 0x48, 0xfe, 0x14, 0xab, 0x14, 0x2a, 0xeb, 0xe9,
+		0x9d, 0xe0, 0xd0, 0xe3, 0x9b, 0xc8, 0xd6, 0x60, 0xc7, 0xf2, 0xe8, 0x32, 0x4c, 0xbb, 0x41, 0x03,
+		0x7b, 0xbe, 0xe3, 0x49, 0x5b, 0xa6, 0xed, 0x29, 0x49, 0xd8, 0x75, 0xf9, 0x64, 0x18, 0xd4, 0xeb,
+		0x24, 0xe2, 0x93, 0x79, 0x39, 0x29, 0x09, 0xbb, 0x2e, 0xba, 0x01, 0xc5, 0x83, 0x20, 0x3a, 0xc1,
+		0x91, 0x4b, 0x5c, 0xe7, 0x20, 0x0a, 0x1a, 0xe6, 0x98, 0xe0, 0x98, 0x6d, 0x53, 0x77, 0xa2, 0xa0,
+		0x81, 0x5e, 0x81, 0xb9, 0xae, 0xbb, 0x6b, 0x8e, 0x0b, 0xbe, 0x62, 0xfa, 0xea, 0x5a, 0x7f, 0x29,
+		0xc0, 0x65, 0xad, 0xc5, 0x34, 0x0c, 0x7c, 0x4a, 0xd0, 0x0a, 0x00, 0xcf, 0x15, 0x0e, 0x0b, 0x8e,
+		0x88, 0xbc, 0xc0, 0x33, 0xf6, 0x34, 0xa7, 0xec, 0x71, 0x02, 0xfa, 0x10, 0x50, 0x9c, 0xba, 0x1c,
+		0xf2, 0x19, 0xa9, 0x36, 0xb9, 0x66, 0x75, 0xd0, 0x37, 0xb5, 0xee, 0xf9, 0x58, 0xb1, 0x3f, 0x88,
+		0xb9, 0xed, 0x85, 0x93, 0x6e, 0x12, 0xda, 0x81, 0xd9, 0xb6, 0x5a, 0xd6, 0x0a, 0x89, 0x70, 0x43,
+		0xa1, 0x72, 0xbd, 0xaf, 0xc6, 0xbd, 0x56, 0x48, 0xec, 0x99, 0x93, 0xc4, 0x08, 0x7d, 0x04, 0x97,
+		0xc2, 0x88, 0x1c, 0x7b, 0x41, 0x93, 0x3a, 0x94, 0xe1, 0x88, 0x11, 0xd7, 0x21, 0xc7, 0xc4, 0x67,
+		0xdc, 0xb5, 0x63, 0x42, 0xe7, 0xe5, 0xb2, 0x2c, 0x24, 0xe5, 0xb8, 0x90, 0x94, 0x77, 0x7d, 0xf6,
+		0xc6, 0xed, 0x8f, 0x78, 0xdc, 0xd9, 0xcb, 0xb1, 0xf4, 0x53, 0x29, 0xfc, 0x80, 0xcb, 0xee, 0xba,
+		0x68, 0x0d, 0xe6, 0x7b, 0xd4, 0x8d, 0x8b, 0xc8, 0x2b, 0xd2, 0x34, 0xa7, 0x09, 0x93, 0x98, 0x31,
+		0xd2, 0x08, 0x99, 0x39, 0x21, 0xae, 0x44, 0x3c, 0x44, 0x16, 0xcc, 0xfa, 0xe4, 0x33, 0xd6, 0x51,
+		0x30, 0x29, 0x14, 0x14, 0x38, 0x31, 0x96, 0x7e, 0x0d, 0x50, 0x2a, 0xbc, 0x9d, 0x9a, 0xe7, 0x33,
+		0x73, 0x4a, 0x30, 0xce, 0x27, 0x63, 0x9c, 0xdf, 0x06, 0x74, 0x17, 0x4c, 0xca, 0xbc, 0xea, 0x51,
+		0xab, 0x73, 0x14, 0x0e, 0xf1, 0xf1, 0x7e, 0x9d, 0xb8, 0xe6, 0xf4, 0xaa, 0xb1, 0x36, 0x65, 0x2f,
+		0xcb, 0xf9, 0xb6, 0xa3, 0x1f, 0xc8, 0x59, 0x74, 0x17, 0xc6, 0x45, 0xe1, 0x33, 0x41, 0xf8, 0xc4,
+		0xea, 0xeb, 0xe7, 0x0f, 0x38, 0xa7, 0x2d, 0x05, 0x90, 0x0d, 0xb3, 0xae, 0x8a, 0x1b, 0xc7, 0xf3,
+		0x0f, 0x02, 0xb3, 0x20, 0x34, 0x7c, 0x27, 0xad, 0x41, 0x16, 0x1e, 0x71, 0xc5, 0x23, 0xec, 0x53,
+		0x8f, 0xf8, 0x2c, 0x8e, 0xb6, 0x5d, 0xff, 0x20, 0xb0, 0x67, 0xdc, 0xc4, 0x08, 0x3d, 0x83, 0x2b,
+		0xbd, 0x41, 0xe5, 0x88, 0x30, 0xe4, 0x35, 0xcb, 0x9c, 0x11, 0x4b, 0xac, 0x68, 0x8d, 0x8c, 0x53,
+		0x88, 0x7d, 0xa9, 0x27, 0xaa, 0xe2, 0x29, 0x54, 0x86, 0x45, 0xe9, 0x74, 0x5e, 0x29, 0x89, 0x13,
+		0x57, 0xa7, 0x59, 0x71, 0x3e, 0x0b, 0x62, 0xea, 0x29, 0x9f, 0xf9, 0x48, 0x4e, 0xa0, 0xeb, 0x30,
+		0xb3, 0x1f, 0x61, 0xbf, 0x5a, 0x53, 0xb7, 0xa0, 0x28, 0x6e, 0x41, 0x41, 0xd2, 0xe4, 0x3d, 0xd8,
+		0x84, 0x22, 0xad, 0xd6, 0x88, 0xdb, 0xac, 0x13, 0xd7, 0xe1, 0x50, 0xc5, 0x9c, 0x13, 0x46, 0x96,
+		0x7a, 0xa2, 0x6b, 0x2f, 0xc6, 0x31, 0xf6, 0x6c, 0x5b, 0x82, 0xd3, 0xd0, 0x77, 0x61, 0x26, 0x8e,
+		0x29, 0xa1, 0x60, 0x7e, 0xa0, 0x82, 0x82, 0xe2, 0x17, 0xe2, 0x9f, 0xc0, 0x24, 0x3f, 0x11, 0x8f,
+		0x50, 0x73, 0x41, 0x54, 0x9a, 0xad, 0xec, 0x3c, 0xdb, 0xe7, 0xc2, 0x97, 0x3f, 0x90, 0x4a, 0x64,
+		0x95, 0x89, 0x55, 0x72, 0x97, 0xb1, 0x80, 0xe1, 0xba, 0xa3, 0xe0, 0x85, 0xb3, 0xdf, 0x62, 0x84,
+		0x9a, 0x48, 0x44, 0xe2, 0x82, 0x98, 0x7a, 0x28, 0x67, 0xb6, 0xf8, 0x04, 0xfa, 0x04, 0xe6, 0xdb,
+		0xa5, 0xcf, 0xa9, 0x8a, 0x3a, 0x66, 0x2e, 0x8a, 0x0d, 0x6d, 0x8c, 0x5c, 0x00, 0xed, 0xb9, 0x30,
+		0x4d, 0x40, 0x3f, 0x82, 0xc5, 0x7a, 0x80, 0x5d, 0x67, 0x5f, 0xd5, 0x02, 0x71, 0x2d, 0xa8, 0xb9,
+		0x34, 0xa8, 0xbe, 0xf4, 0xd4, 0x0f, 0x7b, 0xa1, 0xde, 0x4d, 0x42, 0x8f, 0x60, 0x1e, 0x37, 0x59,
+		0xa0, 0xac, 0x96, 0x37, 0xee, 0x82, 0xd0, 0xfc, 0x92, 0x36, 0xe2, 0x36, 0x9b, 0x2c, 0x90, 0x76,
+		0x71, 0x79, 0xbb, 0x88, 0x53, 0xe3, 0xd2, 0x33, 0x98, 0x49, 0xba, 0x34, 0x59, 0x1f, 0xa7, 0x65,
+		0x7d, 0xbc, 0x9b, 0xae, 0x8f, 0x43, 0x5d, 0xbe, 0x4e, 0x59, 0xfc, 0x6d, 0xae, 0x5d, 0xb4, 0x36,
+		0xab, 0xcc, 0x3b, 0xf6, 0x58, 0xeb, 0xf4, 0x45, 0x4b, 0xa3, 0xe1, 0x7f, 0xb0, 0x68, 0x71, 0x7d,
+		0x61, 0x44, 0x0e, 0x08, 0xab, 0xd6, 0x54, 0xd9, 0x97, 0xb9, 0x75, 0x36, 0xa6, 0x8a, 0x9c, 0x68,
+		0xfd, 0x1b, 0xe0, 0xb2, 0x76, 0x63, 0xdf, 0x68, 0x6d, 0xbb, 0x06, 0x05, 0xac, 0xac, 0xe9, 0xf8,
+		0x0a, 0x62, 0xd2, 0xae, 0xcb, 0x8b, 0x5f, 0x9b, 0x41, 0x14, 0xbf, 0xb1, 0x3e, 0xc5, 0xaf, 0xbd,
+		0x31, 0x51, 0xfc, 0x70, 0x62, 0x84, 0x2a, 0x30, 0xee, 0xf9, 0x61, 0x93, 0x09, 0x27, 0x16, 0x2a,
+		0x57, 0xf4, 0x07, 0x8f, 0x5b, 0xfc, 0x0a, 0xd8, 0x92, 0x55, 0x93, 0xc7, 0x26, 0xce, 0x9a, 0xc7,
+		0x26, 0x47, 0xcb, 0x63, 0x7b, 0x70, 0x29, 0xd6, 0xe7, 0xf0, 0x5b, 0x58, 0x0f, 0x28, 0x11, 0x8a,
+		0x82, 0xa6, 0xac, 0x7c, 0x85, 0xca, 0xa5, 0x1e, 0x5d, 0xdb, 0xaa, 0x79, 0xb4, 0x97, 0x63, 0xd9,
+		0xbd, 0xe0, 0x3e, 0x97, 0xdc, 0x93, 0x82, 0xe8, 0x07, 0xb0, 0x2c, 0x16, 0xe9, 0x55, 0x39, 0x3d,
+		0x48, 0xe5, 0xa2, 0x10, 0xec, 0xd2, 0xb7, 0x03, 0x0b, 0x35, 0x82, 0x23, 0xb6, 0x4f, 0x30, 0x6b,
+		0xab, 0x82, 0x41, 0xaa, 0xe6, 0xdb, 0x32, 0xb1, 0x9e, 0x04, 0x3c, 0x28, 0xa4, 0xe1, 0xc1, 0x33,
+		0xb8, 0x9a, 0x3e, 0x09, 0x27, 0x38, 0x70, 0x58, 0xcd, 0xa3, 0x4e, 0x2c, 0x30, 0x33, 0xd0, 0xb1,
+		0xa5, 0xd4, 0xc9, 0x3c, 0x3e, 0xd8, 0xab, 0x79, 0x74, 0x53, 0xe9, 0xdf, 0x4d, 0xee, 0xc0, 0x25,
+		0x0c, 0x7b, 0x75, 0x6a, 0xce, 0x0e, 0x11, 0x29, 0x9d, 0x4d, 0x6c, 0x4b, 0xa9, 0x5e, 0xb4, 0x56,
+		0x3c, 0x1d, 0x5a, 0x7b, 0x05, 0xe6, 0xda, 0x7a, 0x64, 0x62, 0x11, 0x55, 0x74, 0xda, 0x2e, 0xc6,
+		0xe4, 0x6d, 0x41, 0x45, 0xb7, 0x60, 0xa2, 0x46, 0xb0, 0x4b, 0x22, 0x55, 0x24, 0x2f, 0x6b, 0x57,
+		0x7a, 0x28, 0x58, 0x6c, 0xc5, 0x9a, 0x55, 0x34, 0x16, 0xce, 0xa5, 0x68, 0xbc, 0xd8, 0x7a, 0xa7,
+		0x2b, 0x49, 0x4b, 0xa7, 0x2e, 0x49, 0xe8, 0x53, 0x98, 0x8f, 0x13, 0x25, 0x8f, 0x2d, 0x4c, 0x8f,
+		0xa8, 0x79, 0x41, 0x60, 0x86, 0x3b, 0x03, 0x31, 0x83, 0x2e, 0x91, 0xda, 0x73, 0x1d, 0x75, 0x9c,
+		0x4e, 0xad, 0xbf, 0x8d, 0xc1, 0xf2, 0xa6, 0xeb, 0xea, 0xba, 0xa8, 0x54, 0x15, 0x31, 0xba, 0xaa,
+		0xc8, 0x0b, 0x4a, 0xb9, 0xf7, 0x60, 0xba, 0x83, 0x1e, 0xf3, 0xc3, 0xa0, 0xc7, 0x29, 0xa6, 0x7e,
+		0xf1, 0x74, 0xdd, 0xce, 0x47, 0xaa, 0x69, 0xc8, 0xdb, 0x10, 0x93, 0x76, 0xdd, 0xee, 0x84, 0xa5,
+		0xd2, 0x8c, 0x4a, 0x09, 0xe3, 0x23, 0x24, 0x2c, 0xd1, 0x63, 0xc4, 0x89, 0xe1, 0x1e, 0x4c, 0xd0,
+		0xa0, 0x19, 0x55, 0x65, 0x02, 0x2e, 0x56, 0xac, 0x4c, 0x40, 0x8d, 0xe9, 0xd1, 0x53, 0xc1, 0x69,
+		0x2b, 0x09, 0x4d, 0xb9, 0x9d, 0xd4, 0x95, 0xdb, 0x50, 0x13, 0xb3, 0x53, 0x83, 0x5e, 0x45, 0xf4,
+		0xa7, 0x5a, 0xee, 0x0a, 0x61, 0xf5, 0x46, 0xd1, 0x15, 0xc7, 0xa5, 0x2d, 0x58, 0xd2, 0x31, 0x6a,
+		0x30, 0xd1, 0x52, 0x12, 0x13, 0x4d, 0x27, 0xf1, 0xce, 0x09, 0x5c, 0xec, 0xb1, 0x41, 0xd5, 0x73,
+		0xdd, 0x25, 0x34, 0xce, 0xeb, 0x12, 0x5a, 0x7f, 0x9a, 0x10, 0x31, 0xad, 0x03, 0x59, 0xdf, 0x44,
+		0x4c, 0xf3, 0x16, 0x54, 0x1c, 0xb7, 0xd3, 0x59, 0x5a, 0x62, 0x89, 0xa2, 0xa4, 0x6f, 0xc7, 0x06,
+		0xa4, 0xa2, 0x7f, 0xec, 0x4c, 0xd1, 0x3f, 0x3e, 0x5a, 0xf4, 0x4f, 0x9c, 0x3d, 0xfa, 0x27, 0xcf,
+		0x21, 0xfa, 0xa7, 0x74, 0xd1, 0xef, 0x83, 0x89, 0x13, 0x47, 0xb9, 0xed, 0xd1, 0x90, 0x47, 0x05,
+		0x6f, 0x40, 0x15, 0x26, 0xa8, 0xf4, 0xb9, 0x05, 0x19, 0x92, 0x76, 0xa6, 0x4e, 0xed, 0x6d, 0x83,
+		0x21, 0x6e, 0x9b, 0x26, 0xde, 0x86, 0xbb, 0x6d, 0xe8, 0x4d, 0x98, 0x0a, 0x23, 0x2f, 0x88, 0x3c,
+		0xd6, 0x32, 0x0b, 0xd9, 0x6f, 0x1d, 0xb7, 0x2a, 0xf2, 0xad, 0xa3, 0xcd, 0x7c, 0x2e, 0xd7, 0xf4,
+		0xab, 0x3c, 0x98, 0x59, 0x5e, 0x42, 0xdf, 0x87, 0xb9, 0x0e, 0xb6, 0x11, 0xfd, 0xb6, 0x69, 0xf4,
+		0x81, 0x0c, 0xaa, 0xb3, 0x14, 0x8f, 0x22, 0x76, 0x07, 0x9f, 0x8a, 0x71, 0x0f, 0xdc, 0xcc, 0x8d,
+		0x06, 0x37, 0x13, 0x00, 0x2c, 0x3f, 0x2a, 0x00, 0x1b, 0x3b, 0x7f, 0x00, 0x36, 0x7e, 0x3e, 0x00,
+		0x6c, 0xe2, 0xdc, 0x00, 0xd8, 0xa4, 0x0e, 0x80, 0x59, 0x7f, 0x35, 0x44, 0x16, 0xd6, 0x76, 0x55,
+		0x2f, 0x34, 0x0b, 0xa3, 0x87, 0x80, 0x8e, 0x3d, 0xea, 0xed, 0x7b, 0x75, 0xd1, 0x1e, 0xa9, 0x44,
+		0x93, 0x1b, 0x94, 0x68, 0x16, 0x3a, 0x42, 0x2a, 0xc7, 0x58, 0x5f, 0x19, 0xb0, 0x24, 0xba, 0xe9,
+		0xd8, 0x21, 0x71, 0x36, 0xbf, 0xdf, 0xdd, 0x32, 0xbf, 0xaa, 0xf5, 0xa3, 0x4e, 0x76, 0xc8, 0x66,
+		0xf9, 0x2c, 0x78, 0x64, 0xb8, 0x5e, 0xda, 0xfa, 0xd2, 0x80, 0x0b, 0x5d, 0x16, 0xaa, 0xf3, 0x79,
+		0x17, 0x66, 0xc4, 0xd3, 0x9d, 0x13, 0x11, 0xda, 0xac, 0xc7, 0x7b, 0xec, 0x1f, 0x72, 0x05, 0x21,
+		0x61, 0x0b, 0x01, 0xb4, 0x0b, 0xc5, 0x58, 0xc1, 0x8f, 0x49, 0x95, 0x11, 0xb7, 0xef, 0xc3, 0x85,
+		0x7c, 0xb0, 0x50, 0x9c, 0xf6, 0xec, 0xf3, 0xe4, 0xd0, 0xfa, 0xa7, 0x01, 0xab, 0xd2, 0x30, 0x57,
+		0xf0, 0xf1, 0xfd, 0xde, 0x0f, 0x1a, 0x61, 0x9d, 0x70, 0x66, 0xe5, 0xca, 0xc7, 0xdd, 0xe7, 0x71,
+		0x47, 0xbb, 0xd0, 0x20, 0x3d, 0x5f, 0xc3, 0xd9, 0x5c, 0x84, 0x49, 0x21, 0xab, 0x70, 0xe2, 0xb4,
+		0x3d, 0xc1, 0x87, 0xbb, 0xae, 0xf5, 0x12, 0x5c, 0xef, 0x63, 0x9e, 0x3c, 0x18, 0xeb, 0xef, 0x06,
+		0x5c, 0xb9, 0xcf, 0x7b, 0x8a, 0xfa, 0xe3, 0x26, 0xa3, 0x0c, 0xfb, 0xae, 0xe7, 0x1f, 0x72, 0xd8,
+		0x3d, 0x14, 0xcc, 0x48, 0x3d, 0xc0, 0xe4, 0xba, 0x1e, 0x60, 0xde, 0x83, 0x62, 0x7b, 0x53, 0x9d,
+		0x07, 0xf5, 0x62, 0x46, 0x86, 0x88, 0x77, 0x26, 0x33, 0x04, 0x4b, 0x8c, 0xce, 0x82, 0x25, 0xac,
+		0x6b, 0xb0, 0x92, 0xb1, 0x3d, 0xe5, 0x80, 0x5f, 0x1b, 0xb0, 0x62, 0x93, 0x6a, 0x10, 0xb9, 0x5b,
+		0xb8, 0x7a, 0x14, 0x46, 0x84, 0xd2, 0x66, 0x44, 0x44, 0x0b, 0x33, 0x8c, 0x07, 0x52, 0xb6, 0xe5,
+		0x46, 0x3b, 0x39, 0x04, 0x63, 0xa2, 0xab, 0xca, 0x8b, 0xef, 0x42, 0xe2, 0xb7, 0xb5, 0x0a, 0x57,
+		0xb3, 0xac, 0x51, 0x06, 0xff, 0x04, 0x2e, 0x6e, 0x13, 0x5a, 0x8d, 0xbc, 0x7d, 0xd2, 0xd6, 0xa9,
+		0x2c, 0xdd, 0xe9, 0x0e, 0xda, 0xd7, 0xb4, 0xa6, 0x64, 0x88, 0x0f, 0x17, 0xab, 0xd6, 0x7f, 0x0c,
+		0x30, 0x7b, 0x35, 0xa8, 0x7b, 0xfe, 0x16, 0x4c, 0xca, 0xf3, 0x97, 0x5f, 0x6d, 0x0b, 0x95, 0x6b,
+		0x99, 0x2f, 0x7f, 0x24, 0x12, 0xe0, 0x25, 0xe6, 0xe7, 0xfd, 0x66, 0x27, 0x5c, 0x28, 0xc3, 0xac,
+		0x49, 0xcd, 0x5c, 0x9f, 0x7e, 0x33, 0x5e, 0xfb, 0xa9, 0x60, 0xb5, 0x8b, 0x2c, 0x35, 0x46, 0x1f,
+		0x6b, 0x2a, 0x42, 0xbe, 0x8f, 0x53, 0x86, 0x86, 0xe4, 0x14, 0x56, 0x44, 0x64, 0x76, 0xf3, 0xd3,
+		0xf8, 0x14, 0x96, 0x61, 0x42, 0xd5, 0x31, 0x19, 0x2c, 0x6a, 0x74, 0x96, 0x50, 0xb1, 0x7e, 0x91,
+		0x83, 0xab, 0x59, 0xab, 0x2a, 0xd7, 0x3f, 0x87, 0x95, 0xce, 0x0b, 0x5e, 0xdb, 0x91, 0x89, 0x6f,
+		0xc1, 0xf2, 0x40, 0xca, 0xc3, 0xed, 0xfe, 0x11, 0x61, 0xd8, 0xc5, 0x0c, 0xdb, 0xa5, 0x24, 0xb8,
+		0x4c, 0x2f, 0xcd, 0x97, 0x6c, 0x7f, 0x87, 0xd1, 0x2e, 0x99, 0x3b, 0xdd, 0x92, 0x6e, 0xa2, 0xd1,
+		0x4a, 0x2f, 0x69, 0xdd, 0x81, 0xcb, 0xef, 0x91, 0xb6, 0x1b, 0xe8, 0x56, 0x4b, 0x82, 0x83, 0x01,
+		0xbe, 0xb7, 0xfe, 0x38, 0x06, 0x57, 0xf4, 0x72, 0xca, 0x7b, 0x5f, 0x18, 0xb0, 0xac, 0xd9, 0x4b,
+		0x03, 0x87, 0xca, 0x6f, 0x8f, 0xb3, 0x71, 0x44, 0x3f, 0xc5, 0xe5, 0xed, 0xae, 0xbd, 0x3c, 0xc2,
+		0xa1, 0x84, 0xce, 0x8b, 0x6e, 0xef, 0x8c, 0x30, 0x43, 0x73, 0x8a, 0xdc, 0x8c, 0xdc, 0x99, 0xcc,
+		0xd8, 0xec, 0x3a, 0xc5, 0x8e, 0x19, 0xb8, 0x77, 0xa6, 0xf4, 0x39, 0xbf, 0xe2, 0x7a, 0xbb, 0x35,
+		0x80, 0xfc, 0x61, 0xfa, 0x5b, 0x42, 0x9f, 0x16, 0x26, 0x2b, 0x6f, 0x24, 0xbf, 0xf1, 0x7f, 0x9e,
+		0xc6, 0xf0, 0x5f, 0xe7, 0xda, 0xd6, 0xef, 0x73, 0xf0, 0xf2, 0x87, 0xa1, 0x8b, 0x19, 0xc9, 0x4a,
+		0x07, 0x2f, 0xba, 0x26, 0x9c, 0x5b, 0xd1, 0xd4, 0xe5, 0xbf, 0xb1, 0xf3, 0xc8, 0x7f, 0xaf, 0xc0,
+		0x8d, 0x01, 0x2e, 0x52, 0x85, 0xea, 0x0f, 0x39, 0xb8, 0x61, 0x93, 0x83, 0x88, 0xd0, 0xda, 0xff,
+		0xbd, 0x99, 0xe5, 0xcd, 0x35, 0xb8, 0x39, 0xc8, 0x47, 0xca, 0x9d, 0x7f, 0xce, 0xc1, 0x25, 0x9b,
+		0x34, 0x82, 0xe3, 0xb6, 0xe3, 0x87, 0x7e, 0x0d, 0x3a, 0x8b, 0x0b, 0x13, 0xf0, 0x32, 0x2f, 0x1e,
+		0x62, 0x14, 0xbc, 0xcc, 0x78, 0x62, 0x1a, 0x3b, 0x87, 0x2f, 0x55, 0xfd, 0x1f, 0x7f, 0x4a, 0x30,
+		0x15, 0x84, 0x24, 0xc2, 0x2c, 0x88, 0x44, 0xcb, 0x39, 0x6d, 0xb7, 0xc7, 0x3c, 0xfd, 0x47, 0x04,
+		0xd3, 0x20, 0x6e, 0x21, 0xd5, 0xc8, 0xba, 0x03, 0x25, 0x9d, 0xeb, 0x54, 0xee, 0x4f, 0x6c, 0xd1,
+		0x48, 0x6e, 0xb1, 0xf2, 0xc5, 0x1c, 0x14, 0x1e, 0xa9, 0x14, 0xb2, 0xf9, 0x64, 0x17, 0xfd, 0xcc,
+		0x80, 0x45, 0xcd, 0x67, 0x6c, 0x74, 0x7b, 0xc4, 0xaf, 0xde, 0xe2, 0xc8, 0x4a, 0x77, 0x4e, 0xf5,
+		0xad, 0x3c, 0x69, 0x44, 0x32, 0x4f, 0x0e, 0x61, 0x84, 0xe6, 0x55, 0xa7, 0x74, 0xba, 0xc7, 0x77,
+		0x74, 0x0c, 0x73, 0x5d, 0x0f, 0xa2, 0xe8, 0xf5, 0x51, 0xdf, 0x6f, 0x4b, 0x1b, 0x23, 0x48, 0xa4,
+		0xd6, 0x4d, 0xed, 0xfb, 0xf5, 0x51, 0x5f, 0xb2, 0x4a, 0x1b, 0x23, 0x48, 0xa8, 0x75, 0x43, 0x98,
+		0x4d, 0x35, 0xb6, 0xa8, 0x9c, 0xad, 0x43, 0xd7, 0xa3, 0x97, 0xd6, 0x87, 0xe6, 0x57, 0x2b, 0xfe,
+		0xc6, 0x80, 0x4b, 0x72, 0xa0, 0x69, 0xdf, 0xd0, 0xbd, 0x6c, 0x75, 0x83, 0x5a, 0xd2, 0xd2, 0xdb,
+		0xa7, 0x92, 0x55, 0x66, 0xfd, 0xd2, 0x80, 0x0b, 0xda, 0x86, 0x0a, 0xbd, 0x91, 0xad, 0xb6, 0x5f,
+		0x83, 0x59, 0x7a, 0x73, 0x64, 0x39, 0x65, 0xca, 0xaf, 0x0c, 0x58, 0xd6, 0xf7, 0x4a, 0xe8, 0xcd,
+		0x7e, 0x5b, 0xec, 0xd3, 0xeb, 0x95, 0xee, 0x8e, 0x2e, 0xa8, 0xac, 0x69, 0xc1, 0x7c, 0x37, 0xc2,
+		0x40, 0x1b, 0xa3, 0xa0, 0x11, 0x69, 0xc0, 0x29, 0x00, 0x8c, 0x70, 0x84, 0xbe, 0x39, 0xe8, 0xe7,
+		0x88, 0xbe, 0x4d, 0x4c, 0x3f, 0x47, 0x0c, 0xe8, 0x43, 0x7e, 0x6e, 0xc0, 0x92, 0x0e, 0x8a, 0xa2,
+		0x3b, 0xa3, 0x42, 0x57, 0x69, 0xc9, 0x1b, 0xa7, 0x43, 0xbc, 0xe8, 0x77, 0x06, 0xac, 0xf4, 0x05,
+		0x2a, 0xe8, 0x9d, 0x6c, 0xcd, 0xc3, 0x80, 0xc0, 0xd2, 0xbb, 0xa7, 0x96, 0x57, 0x26, 0x7e, 0x69,
+		0xc0, 0xd5, 0xfe, 0xd5, 0x1f, 0xbd, 0xdb, 0x2f, 0x20, 0x87, 0xc0, 0x56, 0xa5, 0xef, 0x9d, 0x5e,
+		0x81, 0xb2, 0xf2, 0xa7, 0x80, 0x7a, 0x8b, 0x27, 0xba, 0xd5, 0x4f, 0x6f, 0x06, 0x4a, 0x29, 0xdd,
+		0x1e, 0x4d, 0x48, 0x1a, 0xb0, 0xf5, 0xf6, 0x0f, 0xdf, 0x3a, 0xf4, 0x58, 0xad, 0xb9, 0x5f, 0xae,
+		0x06, 0x8d, 0xf5, 0xd4, 0x1f, 0x97, 0x97, 0x0f, 0x89, 0x2f, 0xff, 0x1a, 0x3f, 0xf9, 0x0f, 0x01,
+		0x6f, 0xc7, 0xbf, 0x8f, 0x37, 0xf6, 0x27, 0xc4, 0xec, 0xad, 0xff, 0x0e, 0x00, 0x5e, 0xae, 0x59,
+		0x0b, 0x3e, 0x30, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -976,7 +1092,7 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
 		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
 		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
-		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
 	},
 	// google/protobuf/timestamp.proto
 	[]byte{
@@ -991,7 +1107,7 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
 		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
 		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
-		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
 	},
 	// google/protobuf/wrappers.proto
 	[]byte{
@@ -1010,76 +1126,75 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
 		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
 		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
-		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x92, 0x48, 0x30, 0x06,
-		0x02, 0x00, 0x00,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/common.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x51, 0x73, 0xdb, 0xc4,
-		0x13, 0xff, 0x2b, 0x8e, 0x9d, 0x64, 0xed, 0x26, 0xfe, 0x5f, 0x48, 0xe2, 0xa4, 0x04, 0x52, 0xcd,
-		0x30, 0x0d, 0x1d, 0x90, 0x27, 0xee, 0x4b, 0x87, 0x4e, 0x01, 0x27, 0x76, 0x12, 0xb5, 0xc1, 0x36,
-		0xb2, 0x69, 0xa6, 0x30, 0x83, 0xe6, 0x2c, 0x9d, 0xdc, 0xc3, 0xd2, 0x9d, 0x38, 0x9d, 0x9c, 0xf8,
-		0x85, 0xe1, 0x93, 0xf0, 0xc0, 0xd7, 0xe1, 0x91, 0x2f, 0xc4, 0x48, 0x3a, 0xc5, 0x76, 0x71, 0xa6,
-		0x3c, 0x30, 0xbc, 0xdd, 0xed, 0xef, 0xb7, 0xbb, 0xbf, 0x3b, 0xed, 0xae, 0x0e, 0x8e, 0xe2, 0x21,
-		0x11, 0x75, 0x07, 0xbb, 0x84, 0x39, 0xa4, 0x8e, 0x43, 0x5a, 0x9f, 0x9c, 0xd4, 0x1d, 0x1e, 0x04,
-		0x9c, 0x19, 0xa1, 0xe0, 0x92, 0xa3, 0xed, 0x84, 0x61, 0x28, 0x86, 0x81, 0x43, 0x6a, 0x4c, 0x4e,
-		0x0e, 0x3e, 0x1a, 0x71, 0x3e, 0xf2, 0x49, 0x3d, 0xa5, 0x0c, 0x63, 0xaf, 0xee, 0xc6, 0x02, 0x4b,
-		0x9a, 0x3b, 0xe9, 0xaf, 0xe0, 0xff, 0xd7, 0x5c, 0x8c, 0x3d, 0x9f, 0xdf, 0xb4, 0x6f, 0x89, 0x13,
-		0x27, 0x10, 0xfa, 0x18, 0xca, 0x37, 0xca, 0x68, 0x53, 0xb7, 0xa6, 0x1d, 0x69, 0xc7, 0x1b, 0x16,
-		0xe4, 0x26, 0xd3, 0x45, 0x3b, 0x50, 0x12, 0x31, 0x4b, 0xb0, 0x95, 0x14, 0x2b, 0x8a, 0x98, 0x99,
-		0xae, 0xae, 0x43, 0x25, 0x0f, 0x36, 0x98, 0x86, 0x04, 0x21, 0x58, 0x65, 0x38, 0x20, 0x2a, 0x40,
-		0xba, 0x4e, 0x38, 0x4d, 0x47, 0xd2, 0x09, 0x95, 0xd3, 0x7b, 0x39, 0x87, 0xb0, 0xd6, 0xc3, 0x53,
-		0x9f, 0x63, 0x37, 0x81, 0x5d, 0x2c, 0x71, 0x0a, 0x57, 0xac, 0x74, 0xad, 0x3f, 0x87, 0xb5, 0x73,
-		0x4c, 0xfd, 0x58, 0x10, 0xb4, 0x0b, 0x25, 0x41, 0x70, 0xc4, 0x99, 0xf2, 0x57, 0x3b, 0x54, 0x83,
-		0x35, 0x97, 0x48, 0x4c, 0xfd, 0x28, 0x55, 0x58, 0xb1, 0xf2, 0xad, 0xfe, 0x9b, 0x06, 0xab, 0xdf,
-		0x90, 0x80, 0xa3, 0x17, 0x50, 0xf2, 0x28, 0xf1, 0xdd, 0xa8, 0xa6, 0x1d, 0x15, 0x8e, 0xcb, 0x8d,
-		0x4f, 0x8c, 0x25, 0xf7, 0x67, 0x24, 0x54, 0xe3, 0x3c, 0xe5, 0xb5, 0x99, 0x14, 0x53, 0x4b, 0x39,
-		0x1d, 0x5c, 0x43, 0x79, 0xce, 0x8c, 0xaa, 0x50, 0x18, 0x93, 0xa9, 0x52, 0x91, 0x2c, 0x51, 0x03,
-		0x8a, 0x13, 0xec, 0xc7, 0x24, 0x15, 0x50, 0x6e, 0x7c, 0xb8, 0x34, 0xbc, 0x3a, 0xa6, 0x95, 0x51,
-		0xbf, 0x58, 0x79, 0xa6, 0xe9, 0xbf, 0x6b, 0x50, 0xba, 0x24, 0xd8, 0x25, 0x02, 0x7d, 0xf5, 0x8e,
-		0xc4, 0xc7, 0x4b, 0x63, 0x64, 0xe4, 0xff, 0x56, 0xe4, 0x9f, 0x1a, 0x54, 0xfb, 0x04, 0x0b, 0xe7,
-		0x6d, 0x53, 0x4a, 0x41, 0x87, 0xb1, 0x24, 0x11, 0xb2, 0x61, 0x93, 0x32, 0x97, 0xdc, 0x12, 0xd7,
-		0x5e, 0x90, 0xfd, 0x6c, 0x69, 0xd4, 0x77, 0xdd, 0x0d, 0x33, 0xf3, 0x9d, 0x3f, 0xc7, 0x03, 0x3a,
-		0x6f, 0x3b, 0xf8, 0x11, 0xd0, 0xdf, 0x49, 0xff, 0xe2, 0xa9, 0x3c, 0x58, 0x6f, 0x61, 0x89, 0x4f,
-		0x7d, 0x3e, 0x44, 0xe7, 0xf0, 0x80, 0x30, 0x87, 0xbb, 0x94, 0x8d, 0x6c, 0x39, 0x0d, 0xb3, 0x02,
-		0xdd, 0x6c, 0x3c, 0x5a, 0x1a, 0xab, 0xad, 0x98, 0x49, 0x45, 0x5b, 0x15, 0x32, 0xb7, 0xbb, 0x2b,
-		0xe0, 0x95, 0xb9, 0x02, 0xee, 0x65, 0x4d, 0x47, 0xc4, 0x6b, 0x22, 0x22, 0xca, 0x99, 0xc9, 0x3c,
-		0x9e, 0x10, 0x69, 0x10, 0xfa, 0x79, 0x23, 0x24, 0x6b, 0xf4, 0x18, 0xb6, 0x3c, 0x82, 0x65, 0x2c,
-		0x88, 0x3d, 0xc9, 0xa8, 0xaa, 0xe1, 0x36, 0x95, 0x59, 0x05, 0xd0, 0x5f, 0xc1, 0x5e, 0x3f, 0x0e,
-		0x43, 0x2e, 0x24, 0x71, 0xcf, 0x7c, 0x4a, 0x98, 0x54, 0x48, 0x94, 0xf4, 0xea, 0x88, 0xdb, 0x91,
-		0x3b, 0x56, 0x91, 0x8b, 0x23, 0xde, 0x77, 0xc7, 0x68, 0x1f, 0xd6, 0x7f, 0xc2, 0x13, 0x9c, 0x02,
-		0x59, 0xcc, 0xb5, 0x64, 0xdf, 0x77, 0xc7, 0xfa, 0xaf, 0x05, 0x28, 0x5b, 0x44, 0x8a, 0x69, 0x8f,
-		0xfb, 0xd4, 0x99, 0xa2, 0x16, 0x54, 0x29, 0xa3, 0x92, 0x62, 0xdf, 0xa6, 0x4c, 0x12, 0x31, 0xc1,
-		0x99, 0xca, 0x72, 0x63, 0xdf, 0xc8, 0xc6, 0x8b, 0x91, 0x8f, 0x17, 0xa3, 0xa5, 0xc6, 0x8b, 0xb5,
-		0xa5, 0x5c, 0x4c, 0xe5, 0x81, 0xea, 0xb0, 0x3d, 0xc4, 0xce, 0x98, 0x7b, 0x9e, 0xed, 0x70, 0xe2,
-		0x79, 0xd4, 0x49, 0x64, 0xa6, 0xb9, 0x35, 0x0b, 0x29, 0xe8, 0x6c, 0x86, 0x24, 0x69, 0x03, 0x7c,
-		0x4b, 0x83, 0x38, 0x98, 0xa5, 0x2d, 0xbc, 0x37, 0xad, 0x72, 0xb9, 0x4b, 0xfb, 0xe9, 0x2c, 0x0a,
-		0x96, 0x92, 0x04, 0xa1, 0x8c, 0x6a, 0xab, 0x47, 0xda, 0x71, 0xf1, 0x8e, 0xda, 0x54, 0x66, 0xf4,
-		0x02, 0x1e, 0x32, 0xce, 0x6c, 0x91, 0x1c, 0x1d, 0x0f, 0x7d, 0x62, 0x13, 0x21, 0xb8, 0xb0, 0xb3,
-		0x91, 0x12, 0xd5, 0x8a, 0x47, 0x85, 0xe3, 0x0d, 0xab, 0xc6, 0x38, 0xb3, 0x72, 0x46, 0x3b, 0x21,
-		0x58, 0x19, 0x8e, 0x5e, 0xc2, 0x36, 0xb9, 0x0d, 0x69, 0x26, 0x64, 0x26, 0xb9, 0xf4, 0x3e, 0xc9,
-		0x68, 0xe6, 0x95, 0xab, 0xd6, 0x03, 0xd8, 0x33, 0x23, 0xee, 0xa7, 0xc6, 0x0b, 0xc1, 0xe3, 0xb0,
-		0x87, 0x85, 0xa4, 0xe9, 0x70, 0x5e, 0x32, 0x30, 0xd1, 0x97, 0x50, 0x8c, 0x24, 0x96, 0x59, 0xc1,
-		0x6f, 0x36, 0x8e, 0x97, 0x16, 0xe9, 0x62, 0xc0, 0x7e, 0xc2, 0xb7, 0x32, 0x37, 0x7d, 0x02, 0x0f,
-		0x17, 0xd1, 0x33, 0xce, 0x3c, 0x3a, 0x52, 0x0a, 0xd1, 0x35, 0x54, 0x69, 0x0e, 0xdb, 0xa3, 0x04,
-		0xcf, 0x5b, 0xfb, 0xb3, 0x7f, 0x90, 0xe9, 0x4e, 0xba, 0xb5, 0x45, 0x17, 0x80, 0x48, 0xff, 0x43,
-		0x83, 0x83, 0x66, 0x34, 0x65, 0x4e, 0xfe, 0xdb, 0x58, 0xcc, 0x5b, 0x83, 0x35, 0xc2, 0x92, 0x7b,
-		0xce, 0xfe, 0x41, 0xeb, 0x56, 0xbe, 0x45, 0x0d, 0xd8, 0x09, 0x05, 0x71, 0x89, 0x47, 0x19, 0x71,
-		0xed, 0x9f, 0x63, 0x12, 0x13, 0x3b, 0xbd, 0x95, 0xac, 0x94, 0xb7, 0x67, 0xe0, 0xb7, 0x09, 0xd6,
-		0x49, 0x2e, 0xe9, 0x10, 0x20, 0x23, 0xa6, 0xed, 0x5c, 0x48, 0x89, 0x1b, 0xa9, 0x25, 0x6d, 0xd4,
-		0xaf, 0xa1, 0x92, 0xc1, 0x4e, 0xaa, 0x21, 0x2d, 0x92, 0x72, 0xe3, 0x70, 0xe9, 0x01, 0xf3, 0x29,
-		0x61, 0x95, 0x53, 0x97, 0x4c, 0xf5, 0x93, 0x1b, 0xa8, 0xcc, 0x0f, 0x02, 0xb4, 0x0f, 0x3b, 0xed,
-		0xce, 0x59, 0xb7, 0x65, 0x76, 0x2e, 0xec, 0xc1, 0x9b, 0x5e, 0xdb, 0x36, 0x3b, 0xaf, 0x9b, 0x57,
-		0x66, 0xab, 0xfa, 0x3f, 0x74, 0x00, 0xbb, 0x8b, 0xd0, 0xe0, 0xd2, 0x32, 0xcf, 0x07, 0xd6, 0x75,
-		0x55, 0x43, 0xbb, 0x80, 0x16, 0xb1, 0x97, 0xfd, 0x6e, 0xa7, 0xba, 0x82, 0x6a, 0xf0, 0xc1, 0xa2,
-		0xbd, 0x67, 0x75, 0x07, 0xdd, 0xa7, 0xd5, 0xc2, 0x93, 0x5f, 0x60, 0x7b, 0xc9, 0xc7, 0x45, 0x8f,
-		0xe0, 0xd0, 0xec, 0x77, 0xaf, 0x9a, 0x03, 0xb3, 0xdb, 0xb1, 0x2f, 0xac, 0xee, 0x77, 0x3d, 0xbb,
-		0x3f, 0x68, 0x0e, 0xe6, 0x75, 0xdc, 0x4b, 0xb9, 0x6c, 0x37, 0xaf, 0x06, 0x97, 0x6f, 0xaa, 0xda,
-		0xfd, 0x94, 0x96, 0xd5, 0x34, 0x3b, 0xed, 0x56, 0x75, 0xe5, 0xf4, 0x07, 0xd8, 0x73, 0x78, 0xb0,
-		0xec, 0xa6, 0x4e, 0xcb, 0x67, 0xe9, 0x13, 0xa5, 0x97, 0x54, 0x7d, 0x4f, 0xfb, 0xfe, 0x64, 0x44,
-		0xe5, 0xdb, 0x78, 0x68, 0x38, 0x3c, 0xa8, 0xcf, 0x3f, 0x68, 0x3e, 0xa7, 0xae, 0x5f, 0x1f, 0xf1,
-		0xec, 0x99, 0xa2, 0x5e, 0x37, 0xcf, 0x71, 0x48, 0x27, 0x27, 0xc3, 0x52, 0x6a, 0x7b, 0xfa, 0x57,
-		0x00, 0x00, 0x00, 0xff, 0xff, 0x57, 0xd9, 0xb2, 0xe0, 0x01, 0x09, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdf, 0x72, 0xdb, 0xc4,
+		0x17, 0xfe, 0x29, 0x8e, 0x9d, 0xe4, 0xd8, 0x4d, 0xfc, 0xdb, 0x90, 0xc4, 0x49, 0x09, 0xa4, 0x9a,
+		0x61, 0x1a, 0x3a, 0x20, 0x4f, 0xdc, 0x9b, 0x0e, 0x9d, 0x02, 0x4e, 0xec, 0x24, 0x6a, 0x83, 0x6d,
+		0x64, 0xd3, 0x4c, 0x61, 0x06, 0xcd, 0x5a, 0x5a, 0xb9, 0x8b, 0xa5, 0x5d, 0xb1, 0x5a, 0x39, 0xf1,
+		0x0d, 0xc3, 0x93, 0x70, 0xc1, 0xeb, 0x70, 0xc9, 0x0b, 0x31, 0xab, 0x3f, 0xb1, 0x5d, 0x9c, 0x29,
+		0x17, 0x0c, 0x77, 0xbb, 0xe7, 0xfb, 0xce, 0x39, 0xdf, 0xae, 0xce, 0x39, 0x5a, 0x38, 0x8a, 0x87,
+		0x44, 0xd4, 0x1d, 0xec, 0x12, 0xe6, 0x90, 0x3a, 0x0e, 0x69, 0x7d, 0x72, 0x52, 0x77, 0x78, 0x10,
+		0x70, 0x66, 0x84, 0x82, 0x4b, 0x8e, 0xb6, 0x15, 0xc3, 0xc8, 0x18, 0x06, 0x0e, 0xa9, 0x31, 0x39,
+		0x39, 0xf8, 0x68, 0xc4, 0xf9, 0xc8, 0x27, 0xf5, 0x84, 0x32, 0x8c, 0xbd, 0xba, 0x1b, 0x0b, 0x2c,
+		0x69, 0xee, 0xa4, 0xbf, 0x82, 0xff, 0x5f, 0x73, 0x31, 0xf6, 0x7c, 0x7e, 0xd3, 0xbe, 0x25, 0x4e,
+		0xac, 0x20, 0xf4, 0x31, 0x94, 0x6f, 0x32, 0xa3, 0x4d, 0xdd, 0x9a, 0x76, 0xa4, 0x1d, 0x6f, 0x58,
+		0x90, 0x9b, 0x4c, 0x17, 0xed, 0x40, 0x49, 0xc4, 0x4c, 0x61, 0x2b, 0x09, 0x56, 0x14, 0x31, 0x33,
+		0x5d, 0x5d, 0x87, 0x4a, 0x1e, 0x6c, 0x30, 0x0d, 0x09, 0x42, 0xb0, 0xca, 0x70, 0x40, 0xb2, 0x00,
+		0xc9, 0x5a, 0x71, 0x9a, 0x8e, 0xa4, 0x13, 0x2a, 0xa7, 0xf7, 0x72, 0x0e, 0x61, 0xad, 0x87, 0xa7,
+		0x3e, 0xc7, 0xae, 0x82, 0x5d, 0x2c, 0x71, 0x02, 0x57, 0xac, 0x64, 0xad, 0x3f, 0x87, 0xb5, 0x73,
+		0x4c, 0xfd, 0x58, 0x10, 0xb4, 0x0b, 0x25, 0x41, 0x70, 0xc4, 0x59, 0xe6, 0x9f, 0xed, 0x50, 0x0d,
+		0xd6, 0x5c, 0x22, 0x31, 0xf5, 0xa3, 0x44, 0x61, 0xc5, 0xca, 0xb7, 0xfa, 0x6f, 0x1a, 0xac, 0x7e,
+		0x43, 0x02, 0x8e, 0x5e, 0x40, 0xc9, 0xa3, 0xc4, 0x77, 0xa3, 0x9a, 0x76, 0x54, 0x38, 0x2e, 0x37,
+		0x3e, 0x31, 0x96, 0xdc, 0x9f, 0xa1, 0xa8, 0xc6, 0x79, 0xc2, 0x6b, 0x33, 0x29, 0xa6, 0x56, 0xe6,
+		0x74, 0x70, 0x0d, 0xe5, 0x39, 0x33, 0xaa, 0x42, 0x61, 0x4c, 0xa6, 0x99, 0x0a, 0xb5, 0x44, 0x0d,
+		0x28, 0x4e, 0xb0, 0x1f, 0x93, 0x44, 0x40, 0xb9, 0xf1, 0xe1, 0xd2, 0xf0, 0xd9, 0x31, 0xad, 0x94,
+		0xfa, 0xc5, 0xca, 0x33, 0x4d, 0xff, 0x5d, 0x83, 0xd2, 0x25, 0xc1, 0x2e, 0x11, 0xe8, 0xab, 0x77,
+		0x24, 0x3e, 0x5e, 0x1a, 0x23, 0x25, 0xff, 0xb7, 0x22, 0xff, 0xd4, 0xa0, 0xda, 0x27, 0x58, 0x38,
+		0x6f, 0x9b, 0x52, 0x0a, 0x3a, 0x8c, 0x25, 0x89, 0x90, 0x0d, 0x9b, 0x94, 0xb9, 0xe4, 0x96, 0xb8,
+		0xf6, 0x82, 0xec, 0x67, 0x4b, 0xa3, 0xbe, 0xeb, 0x6e, 0x98, 0xa9, 0xef, 0xfc, 0x39, 0x1e, 0xd0,
+		0x79, 0xdb, 0xc1, 0x8f, 0x80, 0xfe, 0x4e, 0xfa, 0x17, 0x4f, 0xe5, 0xc1, 0x7a, 0x0b, 0x4b, 0x7c,
+		0xea, 0xf3, 0x21, 0x3a, 0x87, 0x07, 0x84, 0x39, 0xdc, 0xa5, 0x6c, 0x64, 0xcb, 0x69, 0x98, 0x16,
+		0xe8, 0x66, 0xe3, 0xd1, 0xd2, 0x58, 0xed, 0x8c, 0xa9, 0x2a, 0xda, 0xaa, 0x90, 0xb9, 0xdd, 0x5d,
+		0x01, 0xaf, 0xcc, 0x15, 0x70, 0x2f, 0x6d, 0x3a, 0x22, 0x5e, 0x13, 0x11, 0x51, 0xce, 0x4c, 0xe6,
+		0x71, 0x45, 0xa4, 0x41, 0xe8, 0xe7, 0x8d, 0xa0, 0xd6, 0xe8, 0x31, 0x6c, 0x79, 0x04, 0xcb, 0x58,
+		0x10, 0x7b, 0x92, 0x52, 0xb3, 0x86, 0xdb, 0xcc, 0xcc, 0x59, 0x00, 0xfd, 0x15, 0xec, 0xf5, 0xe3,
+		0x30, 0xe4, 0x42, 0x12, 0xf7, 0xcc, 0xa7, 0x84, 0xc9, 0x0c, 0x89, 0x54, 0xaf, 0x8e, 0xb8, 0x1d,
+		0xb9, 0xe3, 0x2c, 0x72, 0x71, 0xc4, 0xfb, 0xee, 0x18, 0xed, 0xc3, 0xfa, 0x4f, 0x78, 0x82, 0x13,
+		0x20, 0x8d, 0xb9, 0xa6, 0xf6, 0x7d, 0x77, 0xac, 0xff, 0x5a, 0x80, 0xb2, 0x45, 0xa4, 0x98, 0xf6,
+		0xb8, 0x4f, 0x9d, 0x29, 0x6a, 0x41, 0x95, 0x32, 0x2a, 0x29, 0xf6, 0x6d, 0xca, 0x24, 0x11, 0x13,
+		0x9c, 0xaa, 0x2c, 0x37, 0xf6, 0x8d, 0x74, 0xbc, 0x18, 0xf9, 0x78, 0x31, 0x5a, 0xd9, 0x78, 0xb1,
+		0xb6, 0x32, 0x17, 0x33, 0xf3, 0x40, 0x75, 0xd8, 0x1e, 0x62, 0x67, 0xcc, 0x3d, 0xcf, 0x76, 0x38,
+		0xf1, 0x3c, 0xea, 0x28, 0x99, 0x49, 0x6e, 0xcd, 0x42, 0x19, 0x74, 0x36, 0x43, 0x54, 0xda, 0x00,
+		0xdf, 0xd2, 0x20, 0x0e, 0x66, 0x69, 0x0b, 0xef, 0x4d, 0x9b, 0xb9, 0xdc, 0xa5, 0xfd, 0x74, 0x16,
+		0x05, 0x4b, 0x49, 0x82, 0x50, 0x46, 0xb5, 0xd5, 0x23, 0xed, 0xb8, 0x78, 0x47, 0x6d, 0x66, 0x66,
+		0xf4, 0x02, 0x1e, 0x32, 0xce, 0x6c, 0xa1, 0x8e, 0x8e, 0x87, 0x3e, 0xb1, 0x89, 0x10, 0x5c, 0xd8,
+		0xe9, 0x48, 0x89, 0x6a, 0xc5, 0xa3, 0xc2, 0xf1, 0x86, 0x55, 0x63, 0x9c, 0x59, 0x39, 0xa3, 0xad,
+		0x08, 0x56, 0x8a, 0xa3, 0x97, 0xb0, 0x4d, 0x6e, 0x43, 0x9a, 0x0a, 0x99, 0x49, 0x2e, 0xbd, 0x4f,
+		0x32, 0x9a, 0x79, 0xe5, 0xaa, 0xf5, 0x00, 0xf6, 0xcc, 0x88, 0xfb, 0x89, 0xf1, 0x42, 0xf0, 0x38,
+		0xec, 0x61, 0x21, 0xa9, 0xda, 0x2d, 0x1b, 0x98, 0xe8, 0x4b, 0x28, 0x46, 0x12, 0xcb, 0xb4, 0xe0,
+		0x37, 0x1b, 0xc7, 0x4b, 0x8b, 0x74, 0x31, 0x60, 0x5f, 0xf1, 0xad, 0xd4, 0x4d, 0x9f, 0xc0, 0xc3,
+		0x45, 0xf4, 0x8c, 0x33, 0x8f, 0x8e, 0x32, 0x85, 0xe8, 0x1a, 0xaa, 0x34, 0x87, 0xed, 0x91, 0xc2,
+		0xf3, 0xd6, 0xfe, 0xec, 0x1f, 0x64, 0xba, 0x93, 0x6e, 0x6d, 0xd1, 0x05, 0x20, 0xd2, 0xff, 0xd0,
+		0xe0, 0xa0, 0x19, 0x4d, 0x99, 0x93, 0xff, 0x36, 0x16, 0xf3, 0xd6, 0x60, 0x8d, 0x30, 0x75, 0xcf,
+		0xe9, 0x3f, 0x68, 0xdd, 0xca, 0xb7, 0xa8, 0x01, 0x3b, 0xa1, 0x20, 0x2e, 0xf1, 0x28, 0x23, 0xae,
+		0xfd, 0x73, 0x4c, 0x62, 0x62, 0x27, 0xb7, 0x92, 0x96, 0xf2, 0xf6, 0x0c, 0xfc, 0x56, 0x61, 0x1d,
+		0x75, 0x49, 0x87, 0x00, 0x29, 0x31, 0x69, 0xe7, 0x42, 0x42, 0xdc, 0x48, 0x2c, 0x49, 0xa3, 0x7e,
+		0x0d, 0x95, 0x14, 0x76, 0x12, 0x0d, 0x49, 0x91, 0x94, 0x1b, 0x87, 0x4b, 0x0f, 0x98, 0x4f, 0x09,
+		0xab, 0x9c, 0xb8, 0xa4, 0xaa, 0x9f, 0xdc, 0x40, 0x65, 0x7e, 0x10, 0xa0, 0x7d, 0xd8, 0x69, 0x77,
+		0xce, 0xba, 0x2d, 0xb3, 0x73, 0x61, 0x0f, 0xde, 0xf4, 0xda, 0xb6, 0xd9, 0x79, 0xdd, 0xbc, 0x32,
+		0x5b, 0xd5, 0xff, 0xa1, 0x03, 0xd8, 0x5d, 0x84, 0x06, 0x97, 0x96, 0x79, 0x3e, 0xb0, 0xae, 0xab,
+		0x1a, 0xda, 0x05, 0xb4, 0x88, 0xbd, 0xec, 0x77, 0x3b, 0xd5, 0x15, 0x54, 0x83, 0x0f, 0x16, 0xed,
+		0x3d, 0xab, 0x3b, 0xe8, 0x3e, 0xad, 0x16, 0x9e, 0xfc, 0x02, 0xdb, 0x4b, 0x3e, 0x2e, 0x7a, 0x04,
+		0x87, 0x66, 0xbf, 0x7b, 0xd5, 0x1c, 0x98, 0xdd, 0x8e, 0x7d, 0x61, 0x75, 0xbf, 0xeb, 0xd9, 0xfd,
+		0x41, 0x73, 0x30, 0xaf, 0xe3, 0x5e, 0xca, 0x65, 0xbb, 0x79, 0x35, 0xb8, 0x7c, 0x53, 0xd5, 0xee,
+		0xa7, 0xb4, 0xac, 0xa6, 0xd9, 0x69, 0xb7, 0xaa, 0x2b, 0xa7, 0x3f, 0xc0, 0x9e, 0xc3, 0x83, 0x65,
+		0x37, 0x75, 0x5a, 0x3e, 0x4b, 0x9e, 0x28, 0x3d, 0x55, 0xf5, 0x3d, 0xed, 0xfb, 0x93, 0x11, 0x95,
+		0x6f, 0xe3, 0xa1, 0xe1, 0xf0, 0xa0, 0x3e, 0xff, 0xa0, 0xf9, 0x9c, 0xba, 0x7e, 0x7d, 0xc4, 0xd3,
+		0x67, 0x4a, 0xf6, 0xba, 0x79, 0x8e, 0x43, 0x3a, 0x39, 0x19, 0x96, 0x12, 0xdb, 0xd3, 0xbf, 0x06,
+		0x00, 0x57, 0xd9, 0xb2, 0xe0, 0x01, 0x09, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/query.proto
 	[]byte{
@@ -1115,864 +1230,861 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0x5c, 0xdb, 0x34, 0x3e, 0x88, 0xb5, 0xab, 0x3b, 0x78, 0x11, 0xd0, 0x69, 0xd5, 0x8b, 0x5e, 0x41,
 		0x11, 0xd0, 0xca, 0x2f, 0xc8, 0x12, 0xee, 0xba, 0x93, 0x30, 0xfd, 0x9c, 0x8d, 0xa4, 0x80, 0x4e,
 		0xe5, 0xcd, 0x93, 0x7b, 0x1d, 0x8e, 0x23, 0x79, 0x42, 0xe5, 0xe2, 0xd2, 0xca, 0xfb, 0xbb, 0xf4,
-		0x93, 0x70, 0xd6, 0x1d, 0xed, 0x14, 0xd8, 0xdb, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x69,
-		0x28, 0x5b, 0xfb, 0x03, 0x00, 0x00,
+		0x93, 0x70, 0xd6, 0x1d, 0xed, 0x14, 0xd8, 0xdb, 0x5f, 0x03, 0x00, 0xbd, 0x69, 0x28, 0x5b, 0xfb,
+		0x03, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x73, 0xdb, 0xc8,
-		0xd1, 0x7e, 0x41, 0x4a, 0xb2, 0xd4, 0xd4, 0x07, 0x34, 0x92, 0x2c, 0x5a, 0xde, 0xb5, 0x65, 0xee,
-		0xda, 0x2b, 0xf3, 0x5d, 0x49, 0x2b, 0xef, 0x87, 0xd7, 0x56, 0x1c, 0x07, 0x02, 0x21, 0x0b, 0x36,
-		0x05, 0x32, 0x43, 0xd0, 0xb2, 0xb6, 0x92, 0xa0, 0x20, 0x72, 0x24, 0x21, 0x26, 0x01, 0x16, 0x30,
-		0xb4, 0xad, 0x7b, 0xaa, 0x72, 0x4e, 0x4e, 0xa9, 0x9c, 0xf2, 0x03, 0x92, 0x4a, 0xa5, 0x72, 0xc8,
-		0x29, 0x95, 0x5f, 0x90, 0x6b, 0xfe, 0x42, 0x2a, 0xff, 0x22, 0x35, 0x83, 0x01, 0x09, 0x7e, 0x82,
-		0x4a, 0xaa, 0x36, 0x37, 0xa1, 0xe7, 0x79, 0x1a, 0x3d, 0x3d, 0xdd, 0x4f, 0x0f, 0x21, 0xc8, 0xb5,
-		0xcf, 0x88, 0xbf, 0x5b, 0xb3, 0xeb, 0xc4, 0xad, 0x91, 0x5d, 0xbb, 0xe5, 0xec, 0xbe, 0xdb, 0xdb,
-		0x7d, 0xef, 0xf9, 0x6f, 0xcf, 0x1b, 0xde, 0xfb, 0x9d, 0x96, 0xef, 0x51, 0x0f, 0xad, 0x30, 0xcc,
-		0x8e, 0xc0, 0xec, 0xd8, 0x2d, 0x67, 0xe7, 0xdd, 0xde, 0xc6, 0x9d, 0x0b, 0xcf, 0xbb, 0x68, 0x90,
-		0x5d, 0x0e, 0x39, 0x6b, 0x9f, 0xef, 0xd6, 0xdb, 0xbe, 0x4d, 0x1d, 0xcf, 0x0d, 0x49, 0x1b, 0x77,
-		0xfb, 0xd7, 0xa9, 0xd3, 0x24, 0x01, 0xb5, 0x9b, 0x2d, 0x01, 0xd8, 0x1c, 0xf6, 0xe6, 0x9a, 0xd7,
-		0x6c, 0x76, 0x5c, 0x0c, 0x8d, 0x8d, 0xda, 0xc1, 0xdb, 0x86, 0x13, 0xd0, 0x10, 0x93, 0xfb, 0xc3,
-		0x2c, 0xac, 0x9d, 0x88, 0x70, 0xb5, 0x0f, 0xa4, 0xd6, 0x66, 0x21, 0xe8, 0xee, 0xb9, 0x87, 0xaa,
-		0x80, 0xa2, 0x7d, 0x58, 0x24, 0x5a, 0xc9, 0x4a, 0x9b, 0xd2, 0x56, 0xe6, 0xd1, 0x83, 0x9d, 0x21,
-		0x5b, 0xda, 0x19, 0xf0, 0x83, 0x97, 0xdf, 0xf7, 0x9b, 0xd0, 0xd7, 0x30, 0x45, 0xaf, 0x5a, 0x24,
-		0x9b, 0xe2, 0x8e, 0xee, 0x8d, 0x75, 0x64, 0x5e, 0xb5, 0x08, 0xe6, 0x70, 0xf4, 0x04, 0x20, 0xa0,
-		0xb6, 0x4f, 0x2d, 0x96, 0x86, 0x6c, 0x9a, 0x93, 0x37, 0x76, 0xc2, 0x1c, 0xed, 0x44, 0x39, 0xda,
-		0x31, 0xa3, 0x1c, 0xe1, 0x39, 0x8e, 0x66, 0xcf, 0x8c, 0x5a, 0x6b, 0x78, 0x01, 0x09, 0xa9, 0x53,
-		0xc9, 0x54, 0x8e, 0xe6, 0x54, 0x13, 0xe6, 0x43, 0x6a, 0x40, 0x6d, 0xda, 0x0e, 0xb2, 0xd3, 0x9b,
-		0xd2, 0xd6, 0xe2, 0xa3, 0xbd, 0xc9, 0x76, 0xaf, 0x32, 0x66, 0x85, 0x13, 0x71, 0xa6, 0xd6, 0x7d,
-		0x40, 0xf7, 0x61, 0xf1, 0xd2, 0x09, 0xa8, 0xe7, 0x5f, 0x59, 0x0d, 0xe2, 0x5e, 0xd0, 0xcb, 0xec,
-		0xcc, 0xa6, 0xb4, 0x95, 0xc6, 0x0b, 0xc2, 0x5a, 0xe4, 0x46, 0xf4, 0x13, 0x58, 0x6b, 0xd9, 0x3e,
-		0x71, 0x69, 0x37, 0xfd, 0x96, 0xe3, 0x9e, 0x7b, 0xd9, 0x1b, 0x7c, 0x0b, 0x5b, 0x43, 0xa3, 0x28,
-		0x73, 0x46, 0xcf, 0x49, 0xe2, 0x95, 0xd6, 0xa0, 0x11, 0x29, 0xb0, 0xd8, 0x75, 0xcb, 0x33, 0x33,
-		0x9b, 0x98, 0x99, 0x85, 0x0e, 0x83, 0x67, 0x67, 0x1b, 0xa6, 0x9a, 0xa4, 0xe9, 0x65, 0xe7, 0x38,
-		0xf1, 0xd6, 0xd0, 0x78, 0x8e, 0x49, 0xd3, 0xc3, 0x1c, 0x86, 0x30, 0x2c, 0x07, 0xc4, 0xf6, 0x6b,
-		0x97, 0x96, 0x4d, 0xa9, 0xef, 0x9c, 0xb5, 0x29, 0x09, 0xb2, 0xc0, 0xb9, 0xf7, 0x87, 0x72, 0x2b,
-		0x1c, 0xad, 0x74, 0xc0, 0x58, 0x0e, 0xfa, 0x2c, 0xa8, 0x08, 0xcb, 0x76, 0x9b, 0x7a, 0x96, 0x4f,
-		0x02, 0x42, 0xad, 0x96, 0xe7, 0xb8, 0x34, 0xc8, 0x66, 0xb8, 0xcf, 0xcd, 0xa1, 0x3e, 0x31, 0x03,
-		0x96, 0x39, 0x0e, 0x2f, 0x31, 0x6a, 0xcc, 0x80, 0x6e, 0xc3, 0x1c, 0x6b, 0x0f, 0x8b, 0xf5, 0x47,
-		0x76, 0x7e, 0x53, 0xda, 0x9a, 0xc3, 0xb3, 0xcc, 0x50, 0x74, 0x02, 0x8a, 0xd6, 0xe1, 0x86, 0x13,
-		0x58, 0x35, 0xdf, 0x73, 0xb3, 0x0b, 0x9b, 0xd2, 0xd6, 0x2c, 0x9e, 0x71, 0x02, 0xd5, 0xf7, 0x5c,
-		0xb4, 0x0f, 0x99, 0x76, 0xab, 0x6e, 0x53, 0x51, 0x60, 0x8b, 0x89, 0x69, 0x84, 0x10, 0xce, 0x73,
-		0xf8, 0x73, 0x90, 0x5b, 0xb6, 0x4f, 0x1d, 0x7e, 0x0c, 0x35, 0xcf, 0x3d, 0x77, 0x2e, 0xb2, 0x4b,
-		0x9b, 0xe9, 0xad, 0xcc, 0xa3, 0xe7, 0x93, 0x55, 0x19, 0x3b, 0x4c, 0x76, 0xea, 0xa1, 0x0b, 0x95,
-		0x7b, 0xd0, 0x5c, 0xea, 0x5f, 0xe1, 0xa5, 0x56, 0xaf, 0x75, 0xe3, 0x00, 0x56, 0x87, 0x01, 0x91,
-		0x0c, 0xe9, 0xb7, 0xe4, 0x8a, 0xb7, 0xf6, 0x1c, 0x66, 0x7f, 0xa2, 0x55, 0x98, 0x7e, 0x67, 0x37,
-		0xda, 0x61, 0x97, 0xce, 0xe1, 0xf0, 0xe1, 0x69, 0xea, 0x5b, 0x29, 0xf7, 0x9b, 0x14, 0xdc, 0x19,
-		0xac, 0x74, 0xee, 0x4c, 0xe8, 0x17, 0x7a, 0x1a, 0xcf, 0x62, 0xa8, 0x17, 0x1f, 0x0f, 0xdd, 0x8b,
-		0x29, 0x52, 0x1b, 0x4b, 0xb2, 0x0d, 0x9b, 0xdd, 0xaa, 0x14, 0x0d, 0xef, 0x59, 0xdd, 0xf6, 0xf5,
-		0xda, 0x54, 0x28, 0xc7, 0xad, 0x81, 0x04, 0x17, 0x44, 0x00, 0xf8, 0xa3, 0x8e, 0x8b, 0x0a, 0x17,
-		0x01, 0x4f, 0x8d, 0x1a, 0xda, 0x6b, 0x53, 0x74, 0x02, 0xb7, 0x79, 0x78, 0x23, 0xbc, 0xa7, 0x93,
-		0xbc, 0xaf, 0x33, 0xf6, 0x10, 0xc7, 0xb9, 0xbf, 0x4b, 0xb0, 0x32, 0xa4, 0xfd, 0x58, 0x55, 0xd5,
-		0xbd, 0xa6, 0xed, 0xb8, 0x96, 0x53, 0x17, 0x49, 0x9e, 0x0d, 0x0d, 0x7a, 0x1d, 0xdd, 0x85, 0x8c,
-		0x58, 0x74, 0xed, 0x66, 0x94, 0x6f, 0x08, 0x4d, 0x86, 0xdd, 0x24, 0x23, 0x64, 0x38, 0xfd, 0xdf,
-		0xca, 0xf0, 0x3d, 0x98, 0x77, 0x5c, 0x87, 0x3a, 0x36, 0x25, 0x75, 0x16, 0xd7, 0x14, 0x57, 0xa0,
-		0x4c, 0xc7, 0xa6, 0xd7, 0x73, 0xbf, 0x92, 0x60, 0x4d, 0xfb, 0x40, 0x89, 0xef, 0xda, 0x8d, 0xef,
-		0x65, 0x34, 0xf4, 0xc7, 0x94, 0x1a, 0x8c, 0xe9, 0x2f, 0x33, 0xb0, 0x52, 0x26, 0x6e, 0xdd, 0x71,
-		0x2f, 0x94, 0x1a, 0x75, 0xde, 0x39, 0xf4, 0x8a, 0x47, 0x74, 0x17, 0x32, 0xb6, 0x78, 0xee, 0x66,
-		0x19, 0x22, 0x93, 0x5e, 0x47, 0x87, 0xb0, 0xd0, 0x01, 0x24, 0xce, 0x9f, 0xc8, 0x35, 0x9f, 0x3f,
-		0xf3, 0x76, 0xec, 0x09, 0x3d, 0x87, 0x69, 0x36, 0x0b, 0xc2, 0x11, 0xb4, 0xf8, 0xe8, 0xe1, 0x70,
-		0x11, 0xee, 0x8d, 0x90, 0xc9, 0x3e, 0xc1, 0x21, 0x0f, 0xe9, 0xb0, 0x7c, 0x49, 0x6c, 0x9f, 0x9e,
-		0x11, 0x9b, 0x5a, 0x75, 0x42, 0x6d, 0xa7, 0x11, 0x88, 0xa1, 0xf4, 0xd1, 0x08, 0x45, 0xbf, 0x6a,
-		0x78, 0x76, 0x1d, 0xcb, 0x1d, 0x5a, 0x21, 0x64, 0xa1, 0x97, 0xb0, 0xd2, 0xb0, 0x03, 0x6a, 0x75,
-		0xfd, 0x71, 0x01, 0x9a, 0x4e, 0x14, 0xa0, 0x65, 0x46, 0x3b, 0x8a, 0x58, 0x5c, 0x87, 0x0e, 0x81,
-		0x1b, 0xc3, 0xae, 0x20, 0xf5, 0xd0, 0xd3, 0x4c, 0xa2, 0xa7, 0x25, 0x46, 0xaa, 0x84, 0x1c, 0xee,
-		0x27, 0x0b, 0x37, 0x6c, 0x4a, 0x49, 0xb3, 0x45, 0xf9, 0x98, 0x9a, 0xc6, 0xd1, 0x23, 0x7a, 0x08,
-		0x72, 0xd3, 0xfe, 0xe0, 0x34, 0xdb, 0x4d, 0x4b, 0x98, 0x02, 0x3e, 0x72, 0xa6, 0xf1, 0x92, 0xb0,
-		0x2b, 0xc2, 0xcc, 0x66, 0x53, 0x50, 0xbb, 0x24, 0xf5, 0x76, 0x23, 0x8a, 0x64, 0x2e, 0x79, 0x36,
-		0x75, 0x18, 0x3c, 0x0e, 0x15, 0x96, 0xc8, 0x87, 0x96, 0x13, 0xf6, 0x6c, 0xe8, 0x03, 0x12, 0x7d,
-		0x2c, 0x76, 0x29, 0xdc, 0xc9, 0x73, 0x98, 0xe7, 0x49, 0x39, 0xb7, 0x9d, 0x46, 0xdb, 0x27, 0x62,
-		0xb0, 0x0c, 0x3f, 0xa6, 0xc3, 0x10, 0x83, 0x33, 0x8c, 0x21, 0x1e, 0xd0, 0x17, 0xb0, 0xca, 0x1d,
-		0xb0, 0x5a, 0x27, 0xbe, 0xe5, 0xd4, 0x89, 0x4b, 0x1d, 0x7a, 0x25, 0x66, 0x0b, 0x62, 0x6b, 0x27,
-		0x7c, 0x49, 0x17, 0x2b, 0xe8, 0x1b, 0x58, 0x8f, 0x8e, 0xa0, 0x9f, 0xb4, 0xc0, 0x49, 0x6b, 0x62,
-		0xb9, 0x8f, 0x77, 0x17, 0x32, 0x51, 0x02, 0x58, 0x03, 0x2c, 0xf2, 0xd6, 0x81, 0xc8, 0xa4, 0xd7,
-		0x73, 0x7f, 0x4e, 0xc1, 0x2d, 0x51, 0x97, 0xea, 0xa5, 0xd3, 0xa8, 0x7f, 0x2f, 0x1d, 0xfd, 0x79,
-		0xcc, 0x2d, 0xeb, 0xba, 0xb8, 0xc8, 0xc9, 0xef, 0x63, 0xb7, 0x3c, 0x2e, 0x75, 0xfd, 0xfd, 0x9f,
-		0x1e, 0xe8, 0x7f, 0xf4, 0x1a, 0xc4, 0x65, 0x46, 0xa8, 0x76, 0xcb, 0x6b, 0x38, 0xb5, 0x2b, 0xde,
-		0x3f, 0x8b, 0x23, 0x02, 0x0d, 0x25, 0x99, 0x2b, 0x75, 0x99, 0xa3, 0xf1, 0x72, 0xab, 0xdf, 0x84,
-		0x6e, 0xc2, 0x4c, 0xa8, 0xb9, 0xbc, 0x7b, 0xe6, 0xb0, 0x78, 0xca, 0xfd, 0x33, 0xd5, 0xd1, 0x9b,
-		0x02, 0xa9, 0x39, 0x41, 0x94, 0xaf, 0x8e, 0x0c, 0x48, 0xc9, 0x32, 0x10, 0x11, 0x7b, 0x64, 0x60,
-		0xb0, 0xc4, 0x53, 0xd7, 0x2d, 0xf1, 0x67, 0x30, 0xdf, 0xd3, 0xad, 0xc9, 0x97, 0xe2, 0x4c, 0x30,
-		0xbc, 0x53, 0xa7, 0x7a, 0x3b, 0x15, 0xc3, 0xba, 0xe7, 0x3b, 0x17, 0x8e, 0x6b, 0x37, 0xac, 0xbe,
-		0x20, 0x93, 0xb5, 0x65, 0x2d, 0xa2, 0x56, 0x7a, 0x82, 0xed, 0xab, 0xcf, 0x99, 0x81, 0xfa, 0xfc,
-		0x6b, 0x0a, 0x6e, 0x45, 0x82, 0x59, 0xf4, 0x6a, 0x76, 0xa3, 0xe0, 0x04, 0x2d, 0x9b, 0xd6, 0x2e,
-		0x27, 0xd3, 0xf7, 0xff, 0x7d, 0x3e, 0x7f, 0x06, 0x77, 0x7a, 0x23, 0xb0, 0xbc, 0x73, 0x8b, 0x5e,
-		0x3a, 0x81, 0x15, 0x4f, 0xf3, 0x78, 0x87, 0x1b, 0x3d, 0x11, 0x95, 0xce, 0xcd, 0x4b, 0x27, 0x10,
-		0xaa, 0x88, 0x3e, 0x06, 0xe0, 0xf7, 0x16, 0xea, 0xbd, 0x25, 0x61, 0x99, 0xce, 0x63, 0x7e, 0xd1,
-		0x32, 0x99, 0x21, 0xf7, 0x12, 0x32, 0xf1, 0xab, 0xec, 0x3e, 0xcc, 0x88, 0xdb, 0xb0, 0xc4, 0x6f,
-		0x93, 0x9f, 0x24, 0xdc, 0x86, 0xf9, 0x0f, 0x05, 0x41, 0xc9, 0xfd, 0x31, 0x05, 0x8b, 0xbd, 0x4b,
-		0xe8, 0x33, 0x58, 0x3a, 0x73, 0x5c, 0xdb, 0xbf, 0xb2, 0x6a, 0x97, 0xa4, 0xf6, 0x36, 0x68, 0x37,
-		0xc5, 0x21, 0x2c, 0x86, 0x66, 0x55, 0x58, 0xd1, 0x1a, 0xcc, 0xf8, 0x6d, 0x37, 0x1a, 0xdf, 0x73,
-		0x78, 0xda, 0x6f, 0xb3, 0x7b, 0xce, 0x33, 0xb8, 0x7d, 0xee, 0xf8, 0x01, 0x1b, 0x79, 0x61, 0x37,
-		0x58, 0x35, 0xaf, 0xd9, 0x6a, 0x90, 0x9e, 0x56, 0xcf, 0x72, 0x48, 0xd4, 0x2f, 0x6a, 0x04, 0xe0,
-		0xf4, 0xf9, 0x9a, 0x4f, 0xec, 0xce, 0xd9, 0x24, 0xa7, 0x32, 0x23, 0xf0, 0x42, 0xc8, 0x17, 0xb8,
-		0xb4, 0x3b, 0xee, 0xc5, 0xa4, 0x75, 0x3c, 0x1f, 0x11, 0xb8, 0x83, 0x3b, 0x00, 0xfc, 0x27, 0x06,
-		0xb5, 0xcf, 0x1a, 0xe1, 0x5c, 0x9c, 0xc5, 0x31, 0x4b, 0xfe, 0x4f, 0x12, 0xac, 0x0e, 0x9b, 0xfa,
-		0x28, 0x07, 0x77, 0xca, 0x9a, 0x51, 0xd0, 0x8d, 0x17, 0x96, 0xa2, 0x9a, 0xfa, 0x6b, 0xdd, 0x3c,
-		0xb5, 0x2a, 0xa6, 0x62, 0x6a, 0x96, 0x6e, 0xbc, 0x56, 0x8a, 0x7a, 0x41, 0xfe, 0x3f, 0xf4, 0x29,
-		0x6c, 0x8e, 0xc0, 0x54, 0xd4, 0x23, 0xad, 0x50, 0x2d, 0x6a, 0x05, 0x59, 0x1a, 0xe3, 0xa9, 0x62,
-		0x2a, 0xd8, 0xd4, 0x0a, 0x72, 0x0a, 0xfd, 0x3f, 0x7c, 0x36, 0x02, 0xa3, 0x2a, 0x86, 0xaa, 0x15,
-		0x2d, 0xac, 0xfd, 0xb8, 0xaa, 0x55, 0x18, 0x38, 0x9d, 0xff, 0x45, 0x37, 0xe6, 0x1e, 0x89, 0x8a,
-		0xbf, 0xa9, 0xa0, 0xa9, 0x7a, 0x45, 0x2f, 0x19, 0xe3, 0x62, 0xee, 0xc3, 0x8c, 0x88, 0xb9, 0x1f,
-		0x15, 0xc5, 0x9c, 0xff, 0x65, 0xaa, 0xfb, 0x05, 0x42, 0xaf, 0x63, 0xd2, 0xee, 0x88, 0xf2, 0xa7,
-		0xb0, 0x79, 0x52, 0xc2, 0xaf, 0x0e, 0x8b, 0xa5, 0x13, 0x4b, 0x2f, 0x58, 0x58, 0xab, 0x56, 0x34,
-		0xab, 0x5c, 0x2a, 0xea, 0xea, 0x69, 0x2c, 0x92, 0x6f, 0xe1, 0xab, 0x91, 0x28, 0xa5, 0xc8, 0xac,
-		0x85, 0x6a, 0xb9, 0xa8, 0xab, 0xec, 0xad, 0x87, 0x8a, 0x5e, 0xd4, 0x0a, 0x56, 0xc9, 0x28, 0x9e,
-		0xca, 0x12, 0xfa, 0x1c, 0xb6, 0x26, 0x65, 0xca, 0x29, 0xb4, 0x0d, 0x0f, 0x47, 0xa2, 0xb1, 0xf6,
-		0x52, 0x53, 0xcd, 0x18, 0x3c, 0x8d, 0xf6, 0x60, 0x7b, 0x24, 0xdc, 0xd4, 0xf0, 0xb1, 0x6e, 0xf0,
-		0x84, 0x1e, 0x5a, 0xb8, 0x6a, 0x18, 0xba, 0xf1, 0x42, 0x9e, 0xca, 0xff, 0x4e, 0x82, 0xe5, 0x81,
-		0x69, 0x85, 0xee, 0xc2, 0xed, 0xb2, 0x82, 0x35, 0xc3, 0xb4, 0xd4, 0x62, 0x69, 0x58, 0x02, 0x46,
-		0x00, 0x94, 0x03, 0xc5, 0x28, 0x94, 0x0c, 0x59, 0x42, 0x0f, 0x20, 0x37, 0x0c, 0x20, 0x6a, 0x41,
-		0x94, 0x86, 0x9c, 0x42, 0xf7, 0xe0, 0xe3, 0x61, 0xb8, 0x4e, 0xb4, 0x72, 0x3a, 0xff, 0xaf, 0x14,
-		0x7c, 0x34, 0xee, 0x43, 0x07, 0xab, 0xc0, 0xce, 0xb6, 0xb5, 0x37, 0x9a, 0x5a, 0x35, 0xd9, 0x99,
-		0x87, 0xfe, 0xd8, 0xc9, 0x57, 0x2b, 0xb1, 0xc8, 0xe3, 0x29, 0x1d, 0x01, 0x56, 0x4b, 0xc7, 0xe5,
-		0xa2, 0x66, 0xf2, 0x6a, 0xca, 0xc3, 0x83, 0x24, 0x78, 0x78, 0xc0, 0x72, 0xaa, 0xe7, 0x6c, 0x47,
-		0xb9, 0xe6, 0xfb, 0x66, 0xad, 0x80, 0x76, 0x20, 0x9f, 0x84, 0xee, 0x64, 0xa1, 0x20, 0x4f, 0xa1,
-		0xaf, 0xe0, 0x8b, 0xe4, 0xc0, 0x0d, 0x53, 0x37, 0xaa, 0x5a, 0xc1, 0x52, 0x2a, 0x96, 0xa1, 0x9d,
-		0xc8, 0xd3, 0x93, 0x6c, 0xd7, 0xd4, 0x8f, 0x59, 0x7d, 0x56, 0x4d, 0x79, 0x26, 0xff, 0x37, 0x09,
-		0x6e, 0xaa, 0x9e, 0x4b, 0x1d, 0xb7, 0x4d, 0x94, 0xc0, 0x20, 0xef, 0xf5, 0xf0, 0x22, 0xe4, 0xf9,
-		0xe8, 0x3e, 0xdc, 0x8b, 0xfc, 0x0b, 0xf7, 0x96, 0x6e, 0xe8, 0xa6, 0xae, 0x98, 0x25, 0x1c, 0xcb,
-		0xef, 0x58, 0x18, 0x6b, 0xc8, 0x82, 0x86, 0xc3, 0xbc, 0x8e, 0x86, 0x61, 0xcd, 0xc4, 0xa7, 0xa2,
-		0x14, 0x42, 0x85, 0x19, 0x8d, 0x55, 0x31, 0xeb, 0x6f, 0xd1, 0xff, 0x72, 0x3a, 0xff, 0x7b, 0x09,
-		0x32, 0xe2, 0xd7, 0x31, 0xff, 0xf1, 0x94, 0x85, 0x55, 0xb6, 0xc1, 0x52, 0xd5, 0xb4, 0xcc, 0xd3,
-		0xb2, 0xd6, 0x5b, 0xc3, 0x3d, 0x2b, 0x5c, 0x1e, 0x2c, 0xb3, 0x14, 0x66, 0x27, 0x54, 0x92, 0x5e,
-		0x80, 0x78, 0x0b, 0xc3, 0x70, 0xb0, 0x9c, 0x1a, 0x8b, 0x09, 0xfd, 0xa4, 0xd1, 0x06, 0xdc, 0xec,
-		0xc1, 0x1c, 0x69, 0x0a, 0x36, 0x0f, 0x34, 0xc5, 0x94, 0xa7, 0xf2, 0xbf, 0x95, 0xe0, 0x56, 0xa4,
-		0x84, 0x26, 0x1b, 0xac, 0x4e, 0x93, 0xd4, 0x4b, 0x6d, 0xaa, 0xda, 0xed, 0x80, 0xa0, 0x87, 0x70,
-		0xbf, 0xa3, 0x61, 0xa6, 0x52, 0x79, 0xd5, 0x3d, 0x2b, 0x4b, 0x55, 0x58, 0x73, 0x77, 0x77, 0x93,
-		0x08, 0x15, 0x21, 0xc8, 0x12, 0xfa, 0x0c, 0x3e, 0x19, 0x0f, 0xc5, 0x5a, 0x45, 0x33, 0xe5, 0x54,
-		0xfe, 0x1f, 0x19, 0x58, 0x8f, 0x07, 0xc7, 0x7e, 0x62, 0x90, 0x7a, 0x18, 0xda, 0x03, 0xc8, 0xf5,
-		0x3a, 0x11, 0x3a, 0xd7, 0x1f, 0xd7, 0x1e, 0x6c, 0x8f, 0xc1, 0x55, 0x8d, 0x23, 0xc5, 0x28, 0xb0,
-		0xe7, 0x08, 0x24, 0x4b, 0xe8, 0x39, 0xec, 0x8f, 0xa1, 0x1c, 0x28, 0x85, 0x6e, 0x96, 0x3b, 0x13,
-		0x47, 0x31, 0x4d, 0xac, 0x1f, 0x54, 0x4d, 0xad, 0x22, 0xa7, 0x90, 0x06, 0x4a, 0x82, 0x83, 0x5e,
-		0x1d, 0x1a, 0xea, 0x26, 0x8d, 0x9e, 0xc0, 0xd7, 0x49, 0x71, 0x84, 0x25, 0xa3, 0x1f, 0x6b, 0x38,
-		0x4e, 0x9d, 0x42, 0x4f, 0xe1, 0x9b, 0x04, 0xaa, 0x78, 0xf3, 0x00, 0x77, 0x1a, 0xed, 0xc3, 0xe3,
-		0xc4, 0xe8, 0xd5, 0x12, 0x2e, 0x58, 0xc7, 0x0a, 0x7e, 0xd5, 0x4b, 0x9e, 0x41, 0x3a, 0x68, 0x49,
-		0x2f, 0x16, 0xea, 0x66, 0x0d, 0xd1, 0x85, 0x98, 0xab, 0x1b, 0x13, 0x64, 0x91, 0x19, 0x12, 0xdc,
-		0xcc, 0xa2, 0x17, 0xa0, 0x4e, 0x96, 0x8a, 0xf1, 0x8e, 0xe6, 0xd0, 0x1b, 0x30, 0xaf, 0x77, 0xaa,
-		0xda, 0x1b, 0x53, 0xc3, 0x86, 0x92, 0xe4, 0x19, 0xd0, 0x33, 0x78, 0x92, 0x98, 0xb4, 0x5e, 0xfd,
-		0x89, 0xd1, 0x33, 0xe8, 0x31, 0x7c, 0x39, 0x86, 0x1e, 0xaf, 0x91, 0xee, 0xad, 0x40, 0x2f, 0xc8,
-		0xf3, 0xe8, 0x6b, 0xd8, 0x1b, 0x43, 0xe4, 0x5d, 0x68, 0x55, 0x4c, 0x5d, 0x7d, 0x75, 0x1a, 0x2e,
-		0x17, 0xf5, 0x8a, 0x29, 0x2f, 0xa0, 0x1f, 0xc1, 0x0f, 0xc6, 0xd0, 0x3a, 0x9b, 0x65, 0x7f, 0x68,
-		0x38, 0xd6, 0x62, 0x0c, 0x56, 0xc5, 0x9a, 0xbc, 0x38, 0xc1, 0x99, 0x54, 0xf4, 0x17, 0xc9, 0x99,
-		0x5b, 0x42, 0x2a, 0x3c, 0x9f, 0xa8, 0x45, 0xd4, 0x23, 0xbd, 0x58, 0x18, 0xee, 0x44, 0x46, 0x5f,
-		0xc2, 0xee, 0x18, 0x27, 0x87, 0x25, 0xac, 0x6a, 0x62, 0x62, 0x75, 0x44, 0x62, 0x19, 0x7d, 0x03,
-		0x8f, 0xc6, 0x91, 0x14, 0xbd, 0x58, 0x7a, 0xad, 0xe1, 0x7e, 0x1e, 0x62, 0x63, 0x74, 0xb2, 0xad,
-		0xeb, 0x46, 0xb9, 0x6a, 0x5a, 0x15, 0xfd, 0x3b, 0x4d, 0x5e, 0x61, 0x63, 0x34, 0xf1, 0xa4, 0xa2,
-		0x5c, 0xc9, 0xab, 0x83, 0x62, 0x3c, 0xf0, 0x92, 0x03, 0xdd, 0x50, 0xf0, 0xa9, 0xbc, 0x96, 0x50,
-		0x7b, 0x83, 0x42, 0xd7, 0x53, 0x42, 0x37, 0x27, 0xd9, 0x8e, 0xa6, 0x60, 0xf5, 0x28, 0x9e, 0xf1,
-		0x75, 0x36, 0x75, 0xee, 0xf1, 0x2f, 0x32, 0x03, 0xf7, 0xaa, 0xb8, 0xc4, 0xef, 0xc1, 0x76, 0x78,
-		0x6e, 0x43, 0xaa, 0x60, 0x84, 0xda, 0x1f, 0xc0, 0x0f, 0x27, 0xa3, 0x74, 0xd6, 0x95, 0x22, 0xd6,
-		0x94, 0xc2, 0x69, 0xe7, 0x4a, 0x2a, 0xe5, 0x7f, 0x9d, 0x82, 0xbc, 0x6a, 0xbb, 0x35, 0xd2, 0x88,
-		0xbe, 0x04, 0x8f, 0x8d, 0x72, 0x1f, 0x1e, 0x4f, 0xd0, 0xef, 0x23, 0xe2, 0x3d, 0x81, 0xca, 0x75,
-		0xc9, 0x55, 0xe3, 0x95, 0x51, 0x3a, 0x31, 0xc6, 0x11, 0x64, 0x09, 0x19, 0xf0, 0xf2, 0xba, 0x8e,
-		0x07, 0x52, 0xd2, 0xbd, 0x87, 0xa6, 0x78, 0x52, 0x2a, 0xce, 0x05, 0xff, 0x2c, 0x3e, 0x59, 0x52,
-		0x44, 0x19, 0xff, 0x67, 0x49, 0xb9, 0x2e, 0x79, 0xe2, 0xa4, 0x5c, 0xd7, 0xf1, 0xb8, 0xa4, 0x1c,
-		0xfc, 0x14, 0xd6, 0x6b, 0x5e, 0x73, 0xd8, 0x57, 0x86, 0x83, 0x85, 0x28, 0x3d, 0x65, 0xf6, 0x33,
-		0xbb, 0x2c, 0x7d, 0xb7, 0x77, 0xe1, 0xd0, 0xcb, 0xf6, 0xd9, 0x4e, 0xcd, 0x6b, 0xee, 0xc6, 0xff,
-		0x47, 0xbd, 0xed, 0xd4, 0x1b, 0xbb, 0x17, 0x5e, 0xf8, 0x3f, 0x6f, 0xf1, 0x0f, 0xeb, 0x7d, 0xbb,
-		0xe5, 0xbc, 0xdb, 0x3b, 0x9b, 0xe1, 0xb6, 0x2f, 0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x00, 0x8a,
-		0xd0, 0x5a, 0x70, 0x1f, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcf, 0x72, 0xdb, 0xc8,
+		0xf1, 0xfe, 0x81, 0x94, 0x64, 0xa9, 0xa9, 0x3f, 0xd0, 0x48, 0xb2, 0x68, 0x79, 0xd7, 0x96, 0xb9,
+		0x6b, 0x5b, 0xe6, 0x6f, 0x45, 0xad, 0xec, 0xb5, 0xbd, 0xb6, 0xe2, 0x38, 0x10, 0x08, 0x59, 0xb0,
+		0x29, 0x90, 0x19, 0x80, 0x96, 0xb5, 0x95, 0x04, 0x05, 0x91, 0x90, 0x84, 0x98, 0x04, 0x58, 0xc0,
+		0xd0, 0xb6, 0xee, 0xa9, 0xca, 0x39, 0x39, 0xa5, 0x72, 0xca, 0x03, 0x24, 0x95, 0x4a, 0xe5, 0x90,
+		0x53, 0x2a, 0x4f, 0x90, 0x6b, 0x5e, 0x21, 0x95, 0xb7, 0x48, 0xcd, 0x60, 0x40, 0x82, 0x7f, 0x41,
+		0x25, 0x55, 0x9b, 0x9b, 0xd0, 0xf3, 0x7d, 0x8d, 0x9e, 0x9e, 0xee, 0xaf, 0x87, 0x10, 0xe4, 0xda,
+		0xa7, 0xb6, 0xbf, 0x53, 0xb3, 0xea, 0xb6, 0x5b, 0xb3, 0x77, 0xac, 0x96, 0xb3, 0xf3, 0x61, 0x77,
+		0xe7, 0xa3, 0xe7, 0xbf, 0x3f, 0x6b, 0x78, 0x1f, 0x0b, 0x2d, 0xdf, 0x23, 0x1e, 0x5a, 0xa1, 0x98,
+		0x02, 0xc7, 0x14, 0xac, 0x96, 0x53, 0xf8, 0xb0, 0xbb, 0x71, 0xeb, 0xdc, 0xf3, 0xce, 0x1b, 0xf6,
+		0x0e, 0x83, 0x9c, 0xb6, 0xcf, 0x76, 0xea, 0x6d, 0xdf, 0x22, 0x8e, 0xe7, 0x86, 0xa4, 0x8d, 0xdb,
+		0xfd, 0xeb, 0xc4, 0x69, 0xda, 0x01, 0xb1, 0x9a, 0x2d, 0x0e, 0xd8, 0x1c, 0xf6, 0xe6, 0x9a, 0xd7,
+		0x6c, 0x76, 0x5c, 0x0c, 0x8d, 0x8d, 0x58, 0xc1, 0xfb, 0x86, 0x13, 0x90, 0x10, 0x93, 0xfb, 0xc3,
+		0x2c, 0xac, 0x1d, 0xf3, 0x70, 0x95, 0x4f, 0x76, 0xad, 0x4d, 0x43, 0x50, 0xdd, 0x33, 0x0f, 0x55,
+		0x01, 0x45, 0xfb, 0x30, 0xed, 0x68, 0x25, 0x2b, 0x6c, 0x0a, 0x5b, 0x99, 0x87, 0xf7, 0x0a, 0x43,
+		0xb6, 0x54, 0x18, 0xf0, 0x83, 0x97, 0x3f, 0xf6, 0x9b, 0xd0, 0x63, 0x98, 0x22, 0x97, 0x2d, 0x3b,
+		0x9b, 0x62, 0x8e, 0xee, 0x8c, 0x75, 0x64, 0x5c, 0xb6, 0x6c, 0xcc, 0xe0, 0xe8, 0x19, 0x40, 0x40,
+		0x2c, 0x9f, 0x98, 0x34, 0x0d, 0xd9, 0x34, 0x23, 0x6f, 0x14, 0xc2, 0x1c, 0x15, 0xa2, 0x1c, 0x15,
+		0x8c, 0x28, 0x47, 0x78, 0x8e, 0xa1, 0xe9, 0x33, 0xa5, 0xd6, 0x1a, 0x5e, 0x60, 0x87, 0xd4, 0xa9,
+		0x64, 0x2a, 0x43, 0x33, 0xaa, 0x01, 0xf3, 0x21, 0x35, 0x20, 0x16, 0x69, 0x07, 0xd9, 0xe9, 0x4d,
+		0x61, 0x6b, 0xf1, 0xe1, 0xee, 0x64, 0xbb, 0x97, 0x29, 0x53, 0x67, 0x44, 0x9c, 0xa9, 0x75, 0x1f,
+		0xd0, 0x5d, 0x58, 0xbc, 0x70, 0x02, 0xe2, 0xf9, 0x97, 0x66, 0xc3, 0x76, 0xcf, 0xc9, 0x45, 0x76,
+		0x66, 0x53, 0xd8, 0x4a, 0xe3, 0x05, 0x6e, 0x2d, 0x31, 0x23, 0xfa, 0x09, 0xac, 0xb5, 0x2c, 0xdf,
+		0x76, 0x49, 0x37, 0xfd, 0xa6, 0xe3, 0x9e, 0x79, 0xd9, 0x6b, 0x6c, 0x0b, 0x5b, 0x43, 0xa3, 0xa8,
+		0x30, 0x46, 0xcf, 0x49, 0xe2, 0x95, 0xd6, 0xa0, 0x11, 0x49, 0xb0, 0xd8, 0x75, 0xcb, 0x32, 0x33,
+		0x9b, 0x98, 0x99, 0x85, 0x0e, 0x83, 0x65, 0x67, 0x1b, 0xa6, 0x9a, 0x76, 0xd3, 0xcb, 0xce, 0x31,
+		0xe2, 0x8d, 0xa1, 0xf1, 0x1c, 0xd9, 0x4d, 0x0f, 0x33, 0x18, 0xc2, 0xb0, 0x1c, 0xd8, 0x96, 0x5f,
+		0xbb, 0x30, 0x2d, 0x42, 0x7c, 0xe7, 0xb4, 0x4d, 0xec, 0x20, 0x0b, 0x8c, 0x7b, 0x77, 0x28, 0x57,
+		0x67, 0x68, 0xa9, 0x03, 0xc6, 0x62, 0xd0, 0x67, 0x41, 0x25, 0x58, 0xb6, 0xda, 0xc4, 0x33, 0x7d,
+		0x3b, 0xb0, 0x89, 0xd9, 0xf2, 0x1c, 0x97, 0x04, 0xd9, 0x0c, 0xf3, 0xb9, 0x39, 0xd4, 0x27, 0xa6,
+		0xc0, 0x0a, 0xc3, 0xe1, 0x25, 0x4a, 0x8d, 0x19, 0xd0, 0x4d, 0x98, 0xa3, 0xed, 0x61, 0xd2, 0xfe,
+		0xc8, 0xce, 0x6f, 0x0a, 0x5b, 0x73, 0x78, 0x96, 0x1a, 0x4a, 0x4e, 0x40, 0xd0, 0x3a, 0x5c, 0x73,
+		0x02, 0xb3, 0xe6, 0x7b, 0x6e, 0x76, 0x61, 0x53, 0xd8, 0x9a, 0xc5, 0x33, 0x4e, 0x20, 0xfb, 0x9e,
+		0x8b, 0xf6, 0x20, 0xd3, 0x6e, 0xd5, 0x2d, 0xc2, 0x0b, 0x6c, 0x31, 0x31, 0x8d, 0x10, 0xc2, 0x59,
+		0x0e, 0x7f, 0x0e, 0x62, 0xcb, 0xf2, 0x89, 0xc3, 0x8e, 0xa1, 0xe6, 0xb9, 0x67, 0xce, 0x79, 0x76,
+		0x69, 0x33, 0xbd, 0x95, 0x79, 0xf8, 0x72, 0xb2, 0x2a, 0xa3, 0x87, 0x59, 0xa8, 0x44, 0x2e, 0x64,
+		0xe6, 0x41, 0x71, 0x89, 0x7f, 0x89, 0x97, 0x5a, 0xbd, 0xd6, 0x8d, 0x7d, 0x58, 0x1d, 0x06, 0x44,
+		0x22, 0xa4, 0xdf, 0xdb, 0x97, 0xac, 0xb5, 0xe7, 0x30, 0xfd, 0x13, 0xad, 0xc2, 0xf4, 0x07, 0xab,
+		0xd1, 0x0e, 0xbb, 0x74, 0x0e, 0x87, 0x0f, 0xcf, 0x53, 0xdf, 0x0a, 0xb9, 0xdf, 0xa4, 0xe0, 0xd6,
+		0x60, 0xa5, 0x33, 0x67, 0x5c, 0xbf, 0xd0, 0xf3, 0x78, 0x16, 0x43, 0xbd, 0xf8, 0x7c, 0xe8, 0x5e,
+		0x0c, 0x9e, 0xda, 0x58, 0x92, 0x2d, 0xd8, 0xec, 0x56, 0x25, 0x6f, 0x78, 0xcf, 0xec, 0xb6, 0xaf,
+		0xd7, 0x26, 0x5c, 0x39, 0x6e, 0x0c, 0x24, 0xb8, 0xc8, 0x03, 0xc0, 0x9f, 0x75, 0x5c, 0xe8, 0x4c,
+		0x04, 0x3c, 0x39, 0x6a, 0x68, 0xaf, 0x4d, 0xd0, 0x31, 0xdc, 0x64, 0xe1, 0x8d, 0xf0, 0x9e, 0x4e,
+		0xf2, 0xbe, 0x4e, 0xd9, 0x43, 0x1c, 0xe7, 0xfe, 0x2e, 0xc0, 0xca, 0x90, 0xf6, 0xa3, 0x55, 0x55,
+		0xf7, 0x9a, 0x96, 0xe3, 0x9a, 0x4e, 0x9d, 0x27, 0x79, 0x36, 0x34, 0xa8, 0x75, 0x74, 0x1b, 0x32,
+		0x7c, 0xd1, 0xb5, 0x9a, 0x51, 0xbe, 0x21, 0x34, 0x69, 0x56, 0xd3, 0x1e, 0x21, 0xc3, 0xe9, 0xff,
+		0x56, 0x86, 0xef, 0xc0, 0xbc, 0xe3, 0x3a, 0xc4, 0xb1, 0x88, 0x5d, 0xa7, 0x71, 0x4d, 0x31, 0x05,
+		0xca, 0x74, 0x6c, 0x6a, 0x3d, 0xf7, 0x2b, 0x01, 0xd6, 0x94, 0x4f, 0xc4, 0xf6, 0x5d, 0xab, 0xf1,
+		0xbd, 0x8c, 0x86, 0xfe, 0x98, 0x52, 0x83, 0x31, 0xfd, 0x65, 0x06, 0x56, 0x2a, 0xb6, 0x5b, 0x77,
+		0xdc, 0x73, 0xa9, 0x46, 0x9c, 0x0f, 0x0e, 0xb9, 0x64, 0x11, 0xdd, 0x86, 0x8c, 0xc5, 0x9f, 0xbb,
+		0x59, 0x86, 0xc8, 0xa4, 0xd6, 0xd1, 0x01, 0x2c, 0x74, 0x00, 0x89, 0xf3, 0x27, 0x72, 0xcd, 0xe6,
+		0xcf, 0xbc, 0x15, 0x7b, 0x42, 0x2f, 0x61, 0x9a, 0xce, 0x82, 0x70, 0x04, 0x2d, 0x3e, 0x7c, 0x30,
+		0x5c, 0x84, 0x7b, 0x23, 0xa4, 0xb2, 0x6f, 0xe3, 0x90, 0x87, 0x54, 0x58, 0xbe, 0xb0, 0x2d, 0x9f,
+		0x9c, 0xda, 0x16, 0x31, 0xeb, 0x36, 0xb1, 0x9c, 0x46, 0xc0, 0x87, 0xd2, 0x67, 0x23, 0x14, 0xfd,
+		0xb2, 0xe1, 0x59, 0x75, 0x2c, 0x76, 0x68, 0xc5, 0x90, 0x85, 0x5e, 0xc3, 0x4a, 0xc3, 0x0a, 0x88,
+		0xd9, 0xf5, 0xc7, 0x04, 0x68, 0x3a, 0x51, 0x80, 0x96, 0x29, 0xed, 0x30, 0x62, 0x51, 0x3b, 0x3a,
+		0x00, 0x66, 0x0c, 0xbb, 0xc2, 0xae, 0x87, 0x9e, 0x66, 0x12, 0x3d, 0x2d, 0x51, 0x92, 0x1e, 0x72,
+		0x98, 0x9f, 0x2c, 0x5c, 0xb3, 0x08, 0xb1, 0x9b, 0x2d, 0xc2, 0xc6, 0xd4, 0x34, 0x8e, 0x1e, 0xd1,
+		0x03, 0x10, 0x9b, 0xd6, 0x27, 0xa7, 0xd9, 0x6e, 0x9a, 0xdc, 0x14, 0xb0, 0x91, 0x33, 0x8d, 0x97,
+		0xb8, 0x5d, 0xe2, 0x66, 0x3a, 0x9b, 0x82, 0xda, 0x85, 0x5d, 0x6f, 0x37, 0xa2, 0x48, 0xe6, 0x92,
+		0x67, 0x53, 0x87, 0xc1, 0xe2, 0x90, 0x61, 0xc9, 0xfe, 0xd4, 0x72, 0xc2, 0x9e, 0x0d, 0x7d, 0x40,
+		0xa2, 0x8f, 0xc5, 0x2e, 0x85, 0x39, 0x79, 0x09, 0xf3, 0x2c, 0x29, 0x67, 0x96, 0xd3, 0x68, 0xfb,
+		0x76, 0x36, 0x33, 0xe6, 0x98, 0x0e, 0x42, 0x0c, 0xce, 0x50, 0x06, 0x7f, 0x40, 0x5f, 0xc3, 0x2a,
+		0x73, 0x40, 0x6b, 0xdd, 0xf6, 0x4d, 0xa7, 0x6e, 0xbb, 0xc4, 0x21, 0x97, 0x7c, 0xb6, 0x20, 0xba,
+		0x76, 0xcc, 0x96, 0x54, 0xbe, 0x82, 0x9e, 0xc0, 0x7a, 0x74, 0x04, 0xfd, 0xa4, 0x05, 0x46, 0x5a,
+		0xe3, 0xcb, 0x7d, 0xbc, 0xdb, 0x90, 0x89, 0x12, 0x40, 0x1b, 0x60, 0x91, 0xb5, 0x0e, 0x44, 0x26,
+		0xb5, 0x9e, 0xfb, 0x73, 0x0a, 0x6e, 0xf0, 0xba, 0x94, 0x2f, 0x9c, 0x46, 0xfd, 0x7b, 0xe9, 0xe8,
+		0xaf, 0x62, 0x6e, 0x69, 0xd7, 0xc5, 0x45, 0x4e, 0xfc, 0x18, 0xbb, 0xe5, 0x31, 0xa9, 0xeb, 0xef,
+		0xff, 0xf4, 0x40, 0xff, 0xa3, 0xb7, 0xc0, 0x2f, 0x33, 0x5c, 0xb5, 0x5b, 0x5e, 0xc3, 0xa9, 0x5d,
+		0xb2, 0xfe, 0x59, 0x1c, 0x11, 0x68, 0x28, 0xc9, 0x4c, 0xa9, 0x2b, 0x0c, 0x8d, 0x97, 0x5b, 0xfd,
+		0x26, 0x74, 0x1d, 0x66, 0x42, 0xcd, 0x65, 0xdd, 0x33, 0x87, 0xf9, 0x53, 0xee, 0x9f, 0xa9, 0x8e,
+		0xde, 0x14, 0xed, 0x9a, 0x13, 0x44, 0xf9, 0xea, 0xc8, 0x80, 0x90, 0x2c, 0x03, 0x11, 0xb1, 0x47,
+		0x06, 0x06, 0x4b, 0x3c, 0x75, 0xd5, 0x12, 0x7f, 0x01, 0xf3, 0x3d, 0xdd, 0x9a, 0x7c, 0x29, 0xce,
+		0x04, 0xc3, 0x3b, 0x75, 0xaa, 0xb7, 0x53, 0x31, 0xac, 0x7b, 0xbe, 0x73, 0xee, 0xb8, 0x56, 0xc3,
+		0xec, 0x0b, 0x32, 0x59, 0x5b, 0xd6, 0x22, 0xaa, 0xde, 0x13, 0x6c, 0x5f, 0x7d, 0xce, 0x0c, 0xd4,
+		0xe7, 0x5f, 0x53, 0x70, 0x23, 0x12, 0xcc, 0x92, 0x57, 0xb3, 0x1a, 0x45, 0x27, 0x68, 0x59, 0xa4,
+		0x76, 0x31, 0x99, 0xbe, 0xff, 0xef, 0xf3, 0xf9, 0x33, 0xb8, 0xd5, 0x1b, 0x81, 0xe9, 0x9d, 0x99,
+		0xe4, 0xc2, 0x09, 0xcc, 0x78, 0x9a, 0xc7, 0x3b, 0xdc, 0xe8, 0x89, 0xa8, 0x7c, 0x66, 0x5c, 0x38,
+		0x01, 0x57, 0x45, 0xf4, 0x39, 0x00, 0xbb, 0xb7, 0x10, 0xef, 0xbd, 0x1d, 0x96, 0xe9, 0x3c, 0x66,
+		0x17, 0x2d, 0x83, 0x1a, 0x72, 0xaf, 0x21, 0x13, 0xbf, 0xca, 0xee, 0xc1, 0x0c, 0xbf, 0x0d, 0x0b,
+		0xec, 0x36, 0xf9, 0x45, 0xc2, 0x6d, 0x98, 0xfd, 0x50, 0xe0, 0x94, 0xdc, 0x1f, 0x53, 0xb0, 0xd8,
+		0xbb, 0x84, 0xee, 0xc3, 0xd2, 0xa9, 0xe3, 0x5a, 0xfe, 0xa5, 0x59, 0xbb, 0xb0, 0x6b, 0xef, 0x83,
+		0x76, 0x93, 0x1f, 0xc2, 0x62, 0x68, 0x96, 0xb9, 0x15, 0xad, 0xc1, 0x8c, 0xdf, 0x76, 0xa3, 0xf1,
+		0x3d, 0x87, 0xa7, 0xfd, 0x36, 0xbd, 0xe7, 0xbc, 0x80, 0x9b, 0x67, 0x8e, 0x1f, 0xd0, 0x91, 0x17,
+		0x76, 0x83, 0x59, 0xf3, 0x9a, 0xad, 0x86, 0xdd, 0xd3, 0xea, 0x59, 0x06, 0x89, 0xfa, 0x45, 0x8e,
+		0x00, 0x8c, 0x3e, 0x5f, 0xf3, 0x6d, 0xab, 0x73, 0x36, 0xc9, 0xa9, 0xcc, 0x70, 0x3c, 0x17, 0xf2,
+		0x05, 0x26, 0xed, 0x8e, 0x7b, 0x3e, 0x69, 0x1d, 0xcf, 0x47, 0x04, 0xe6, 0xe0, 0x16, 0x00, 0xfb,
+		0x89, 0x41, 0xac, 0xd3, 0x46, 0x38, 0x17, 0x67, 0x71, 0xcc, 0x92, 0xff, 0x93, 0x00, 0xab, 0xc3,
+		0xa6, 0x3e, 0xca, 0xc1, 0xad, 0x8a, 0xa2, 0x15, 0x55, 0xed, 0x95, 0x29, 0xc9, 0x86, 0xfa, 0x56,
+		0x35, 0x4e, 0x4c, 0xdd, 0x90, 0x0c, 0xc5, 0x54, 0xb5, 0xb7, 0x52, 0x49, 0x2d, 0x8a, 0xff, 0x87,
+		0xbe, 0x84, 0xcd, 0x11, 0x18, 0x5d, 0x3e, 0x54, 0x8a, 0xd5, 0x92, 0x52, 0x14, 0x85, 0x31, 0x9e,
+		0x74, 0x43, 0xc2, 0x86, 0x52, 0x14, 0x53, 0xe8, 0xff, 0xe1, 0xfe, 0x08, 0x8c, 0x2c, 0x69, 0xb2,
+		0x52, 0x32, 0xb1, 0xf2, 0xe3, 0xaa, 0xa2, 0x53, 0x70, 0x3a, 0xff, 0x8b, 0x6e, 0xcc, 0x3d, 0x12,
+		0x15, 0x7f, 0x53, 0x51, 0x91, 0x55, 0x5d, 0x2d, 0x6b, 0xe3, 0x62, 0xee, 0xc3, 0x8c, 0x88, 0xb9,
+		0x1f, 0x15, 0xc5, 0x9c, 0xff, 0x65, 0xaa, 0xfb, 0x05, 0x42, 0xad, 0x63, 0xbb, 0xdd, 0x11, 0xe5,
+		0x2f, 0x61, 0xf3, 0xb8, 0x8c, 0xdf, 0x1c, 0x94, 0xca, 0xc7, 0xa6, 0x5a, 0x34, 0xb1, 0x52, 0xd5,
+		0x15, 0xb3, 0x52, 0x2e, 0xa9, 0xf2, 0x49, 0x2c, 0x92, 0x6f, 0xe1, 0x9b, 0x91, 0x28, 0xa9, 0x44,
+		0xad, 0xc5, 0x6a, 0xa5, 0xa4, 0xca, 0xf4, 0xad, 0x07, 0x92, 0x5a, 0x52, 0x8a, 0x66, 0x59, 0x2b,
+		0x9d, 0x88, 0x02, 0xfa, 0x0a, 0xb6, 0x26, 0x65, 0x8a, 0x29, 0xb4, 0x0d, 0x0f, 0x46, 0xa2, 0xb1,
+		0xf2, 0x5a, 0x91, 0x8d, 0x18, 0x3c, 0x8d, 0x76, 0x61, 0x7b, 0x24, 0xdc, 0x50, 0xf0, 0x91, 0xaa,
+		0xb1, 0x84, 0x1e, 0x98, 0xb8, 0xaa, 0x69, 0xaa, 0xf6, 0x4a, 0x9c, 0xca, 0xff, 0x4e, 0x80, 0xe5,
+		0x81, 0x69, 0x85, 0x6e, 0xc3, 0xcd, 0x8a, 0x84, 0x15, 0xcd, 0x30, 0xe5, 0x52, 0x79, 0x58, 0x02,
+		0x46, 0x00, 0xa4, 0x7d, 0x49, 0x2b, 0x96, 0x35, 0x51, 0x40, 0xf7, 0x20, 0x37, 0x0c, 0xc0, 0x6b,
+		0x81, 0x97, 0x86, 0x98, 0x42, 0x77, 0xe0, 0xf3, 0x61, 0xb8, 0x4e, 0xb4, 0x62, 0x3a, 0xff, 0xaf,
+		0x14, 0x7c, 0x36, 0xee, 0x43, 0x07, 0xad, 0xc0, 0xce, 0xb6, 0x95, 0x77, 0x8a, 0x5c, 0x35, 0xe8,
+		0x99, 0x87, 0xfe, 0xe8, 0xc9, 0x57, 0xf5, 0x58, 0xe4, 0xf1, 0x94, 0x8e, 0x00, 0xcb, 0xe5, 0xa3,
+		0x4a, 0x49, 0x31, 0x58, 0x35, 0xe5, 0xe1, 0x5e, 0x12, 0x3c, 0x3c, 0x60, 0x31, 0xd5, 0x73, 0xb6,
+		0xa3, 0x5c, 0xb3, 0x7d, 0xd3, 0x56, 0x40, 0x05, 0xc8, 0x27, 0xa1, 0x3b, 0x59, 0x28, 0x8a, 0x53,
+		0xe8, 0x1b, 0xf8, 0x3a, 0x39, 0x70, 0xcd, 0x50, 0xb5, 0xaa, 0x52, 0x34, 0x25, 0xdd, 0xd4, 0x94,
+		0x63, 0x71, 0x7a, 0x92, 0xed, 0x1a, 0xea, 0x11, 0xad, 0xcf, 0xaa, 0x21, 0xce, 0xe4, 0xff, 0x26,
+		0xc0, 0x75, 0xd9, 0x73, 0x89, 0xe3, 0xb6, 0x6d, 0x29, 0xd0, 0xec, 0x8f, 0x6a, 0x78, 0x11, 0xf2,
+		0x7c, 0x74, 0x17, 0xee, 0x44, 0xfe, 0xb9, 0x7b, 0x53, 0xd5, 0x54, 0x43, 0x95, 0x8c, 0x32, 0x8e,
+		0xe5, 0x77, 0x2c, 0x8c, 0x36, 0x64, 0x51, 0xc1, 0x61, 0x5e, 0x47, 0xc3, 0xb0, 0x62, 0xe0, 0x13,
+		0x5e, 0x0a, 0xa1, 0xc2, 0x8c, 0xc6, 0xca, 0xb8, 0xac, 0x75, 0xfa, 0x5f, 0x4c, 0xe7, 0x7f, 0x2f,
+		0x40, 0x86, 0xff, 0x3a, 0x66, 0x3f, 0x9e, 0xb2, 0xb0, 0x4a, 0x37, 0x58, 0xae, 0x1a, 0xa6, 0x71,
+		0x52, 0x51, 0x7a, 0x6b, 0xb8, 0x67, 0x85, 0xc9, 0x83, 0x69, 0x94, 0xc3, 0xec, 0x84, 0x4a, 0xd2,
+		0x0b, 0xe0, 0x6f, 0xa1, 0x18, 0x06, 0x16, 0x53, 0x63, 0x31, 0xa1, 0x9f, 0x34, 0xda, 0x80, 0xeb,
+		0x3d, 0x98, 0x43, 0x45, 0xc2, 0xc6, 0xbe, 0x22, 0x19, 0xe2, 0x54, 0xfe, 0xb7, 0x02, 0xdc, 0x88,
+		0x94, 0x90, 0x7e, 0x9b, 0xa0, 0xa1, 0xd7, 0xcb, 0x6d, 0x22, 0x5b, 0xed, 0xc0, 0x46, 0x0f, 0xe0,
+		0x6e, 0x47, 0xc3, 0x0c, 0x49, 0x7f, 0xd3, 0x3d, 0x2b, 0x53, 0x96, 0xaa, 0x7a, 0x7c, 0x37, 0x89,
+		0x50, 0x1e, 0x82, 0x28, 0xa0, 0xfb, 0xf0, 0xc5, 0x78, 0x28, 0x56, 0x74, 0xc5, 0x10, 0x53, 0xf9,
+		0x7f, 0x64, 0x60, 0x3d, 0x1e, 0x1c, 0xfd, 0x89, 0x61, 0xd7, 0xc3, 0xd0, 0xee, 0x41, 0xae, 0xd7,
+		0x09, 0xd7, 0xb9, 0xfe, 0xb8, 0x76, 0x61, 0x7b, 0x0c, 0xae, 0xaa, 0x1d, 0x4a, 0x5a, 0x91, 0x3e,
+		0x47, 0x20, 0x51, 0x40, 0x2f, 0x61, 0x6f, 0x0c, 0x65, 0x5f, 0x2a, 0x76, 0xb3, 0xdc, 0x99, 0x38,
+		0x92, 0x61, 0x60, 0x75, 0xbf, 0x6a, 0x28, 0xba, 0x98, 0x42, 0x0a, 0x48, 0x09, 0x0e, 0x7a, 0x75,
+		0x68, 0xa8, 0x9b, 0x34, 0x7a, 0x06, 0x8f, 0x93, 0xe2, 0x08, 0x4b, 0x46, 0x3d, 0x52, 0x70, 0x9c,
+		0x3a, 0x85, 0x9e, 0xc3, 0x93, 0x04, 0x2a, 0x7f, 0xf3, 0x00, 0x77, 0x1a, 0xed, 0xc1, 0xd3, 0xc4,
+		0xe8, 0xe5, 0x32, 0x2e, 0x9a, 0x47, 0x12, 0x7e, 0xd3, 0x4b, 0x9e, 0x41, 0x2a, 0x28, 0x49, 0x2f,
+		0xe6, 0xea, 0x66, 0x0e, 0xd1, 0x85, 0x98, 0xab, 0x6b, 0x13, 0x64, 0x91, 0x1a, 0x12, 0xdc, 0xcc,
+		0xa2, 0x57, 0x20, 0x4f, 0x96, 0x8a, 0xf1, 0x8e, 0xe6, 0xd0, 0x3b, 0x30, 0xae, 0x76, 0xaa, 0xca,
+		0x3b, 0x43, 0xc1, 0x9a, 0x94, 0xe4, 0x19, 0xd0, 0x0b, 0x78, 0x96, 0x98, 0xb4, 0x5e, 0xfd, 0x89,
+		0xd1, 0x33, 0xe8, 0x29, 0x3c, 0x1a, 0x43, 0x8f, 0xd7, 0x48, 0xf7, 0x56, 0xa0, 0x16, 0xc5, 0x79,
+		0xf4, 0x18, 0x76, 0xc7, 0x10, 0x59, 0x17, 0x9a, 0xba, 0xa1, 0xca, 0x6f, 0x4e, 0xc2, 0xe5, 0x92,
+		0xaa, 0x1b, 0xe2, 0x02, 0xfa, 0x11, 0xfc, 0x60, 0x0c, 0xad, 0xb3, 0x59, 0xfa, 0x87, 0x82, 0x63,
+		0x2d, 0x46, 0x61, 0x55, 0xac, 0x88, 0x8b, 0x13, 0x9c, 0x89, 0xae, 0xbe, 0x4a, 0xce, 0xdc, 0x12,
+		0x92, 0xe1, 0xe5, 0x44, 0x2d, 0x22, 0x1f, 0xaa, 0xa5, 0xe2, 0x70, 0x27, 0x22, 0x7a, 0x04, 0x3b,
+		0x63, 0x9c, 0x1c, 0x94, 0xb1, 0xac, 0xf0, 0x89, 0xd5, 0x11, 0x89, 0x65, 0xf4, 0x04, 0x1e, 0x8e,
+		0x23, 0x49, 0x6a, 0xa9, 0xfc, 0x56, 0xc1, 0xfd, 0x3c, 0x44, 0xc7, 0xe8, 0x64, 0x5b, 0x57, 0xb5,
+		0x4a, 0xd5, 0x30, 0x75, 0xf5, 0x3b, 0x45, 0x5c, 0xa1, 0x63, 0x34, 0xf1, 0xa4, 0xa2, 0x5c, 0x89,
+		0xab, 0x83, 0x62, 0x3c, 0xf0, 0x92, 0x7d, 0x55, 0x93, 0xf0, 0x89, 0xb8, 0x96, 0x50, 0x7b, 0x83,
+		0x42, 0xd7, 0x53, 0x42, 0xd7, 0x27, 0xd9, 0x8e, 0x22, 0x61, 0xf9, 0x30, 0x9e, 0xf1, 0x75, 0x3a,
+		0x75, 0xee, 0xb0, 0x2f, 0x32, 0x03, 0xf7, 0xaa, 0xb8, 0xc4, 0xef, 0xc2, 0x76, 0x78, 0x6e, 0x43,
+		0xaa, 0x60, 0x84, 0xda, 0xef, 0xc3, 0x0f, 0x27, 0xa3, 0x74, 0xd6, 0xa5, 0x12, 0x56, 0xa4, 0xe2,
+		0x49, 0xe7, 0x4a, 0x2a, 0xe4, 0x7f, 0x9d, 0x82, 0xbc, 0x6c, 0xb9, 0x35, 0xbb, 0x11, 0x7d, 0x09,
+		0x1e, 0x1b, 0xe5, 0x1e, 0x3c, 0x9d, 0xa0, 0xdf, 0x47, 0xc4, 0x7b, 0x0c, 0xfa, 0x55, 0xc9, 0x55,
+		0xed, 0x8d, 0x56, 0x3e, 0xd6, 0xc6, 0x11, 0x44, 0x01, 0x69, 0xf0, 0xfa, 0xaa, 0x8e, 0x07, 0x52,
+		0xd2, 0xbd, 0x87, 0xa6, 0x58, 0x52, 0x74, 0xe7, 0xdc, 0xb5, 0x26, 0x4e, 0x0a, 0x2f, 0xe3, 0xff,
+		0x2c, 0x29, 0x57, 0x25, 0x4f, 0x9c, 0x94, 0xab, 0x3a, 0x1e, 0x97, 0x94, 0xfd, 0x9f, 0xc2, 0x7a,
+		0xcd, 0x6b, 0x0e, 0xfb, 0xca, 0xb0, 0xbf, 0x10, 0xa5, 0xa7, 0x42, 0x7f, 0x66, 0x57, 0x84, 0xef,
+		0x76, 0xcf, 0x1d, 0x72, 0xd1, 0x3e, 0x2d, 0xd4, 0xbc, 0xe6, 0x4e, 0xfc, 0x7f, 0xd4, 0xdb, 0x4e,
+		0xbd, 0xb1, 0x73, 0xee, 0x85, 0xff, 0xf3, 0xe6, 0xff, 0xb0, 0xde, 0xb3, 0x5a, 0xce, 0x87, 0xdd,
+		0xd3, 0x19, 0x66, 0x7b, 0xf4, 0xef, 0x01, 0x00, 0x00, 0x8a, 0xd0, 0x5a, 0x70, 0x1f, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/tasklist.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xeb, 0x4e, 0xe3, 0x46,
-		0x14, 0x6e, 0x6e, 0x5c, 0x4e, 0x16, 0x30, 0x03, 0x2c, 0x49, 0xb6, 0xdb, 0xb2, 0xf9, 0x81, 0x28,
-		0x6a, 0x1d, 0x41, 0x5b, 0xa9, 0x6a, 0xab, 0xed, 0x06, 0x82, 0x76, 0x2d, 0x2e, 0x8b, 0x1c, 0x2f,
-		0x15, 0x95, 0x2a, 0x77, 0x62, 0x0f, 0x61, 0xea, 0xcb, 0x58, 0x9e, 0x71, 0x02, 0x4f, 0xd0, 0x37,
-		0xe8, 0xc3, 0xf4, 0x1d, 0xfa, 0x4e, 0xd5, 0x8c, 0x9d, 0x90, 0x8b, 0x41, 0xdd, 0x1f, 0xfb, 0x2f,
-		0x73, 0xce, 0x7c, 0xf3, 0x9d, 0xef, 0xdc, 0x62, 0x68, 0x26, 0x3d, 0x12, 0xb7, 0x1c, 0xec, 0x92,
-		0xd0, 0x21, 0x2d, 0x1c, 0xd1, 0xd6, 0xe0, 0xa0, 0x25, 0x30, 0xf7, 0x7c, 0xca, 0x85, 0x1e, 0xc5,
-		0x4c, 0x30, 0xb4, 0x21, 0xef, 0xe8, 0xd9, 0x1d, 0x1d, 0x47, 0x54, 0x1f, 0x1c, 0x34, 0xbe, 0xe8,
-		0x33, 0xd6, 0xf7, 0x49, 0x4b, 0x5d, 0xe9, 0x25, 0x37, 0x2d, 0x37, 0x89, 0xb1, 0xa0, 0x2c, 0x4c,
-		0x41, 0x8d, 0x2f, 0x67, 0xfd, 0x82, 0x06, 0x84, 0x0b, 0x1c, 0x44, 0xd9, 0x85, 0xb9, 0x07, 0x86,
-		0x31, 0x8e, 0x22, 0x12, 0xf3, 0xd4, 0xdf, 0xfc, 0x00, 0x4b, 0x16, 0xe6, 0xde, 0x19, 0xe5, 0x02,
-		0x21, 0x28, 0x87, 0x38, 0x20, 0xb5, 0xc2, 0x4e, 0x61, 0x6f, 0xd9, 0x54, 0xbf, 0xd1, 0xf7, 0x50,
-		0xf6, 0x68, 0xe8, 0xd6, 0x8a, 0x3b, 0x85, 0xbd, 0xd5, 0xc3, 0x57, 0x7a, 0x4e, 0x90, 0xfa, 0xe8,
-		0x81, 0x53, 0x1a, 0xba, 0xa6, 0xba, 0xde, 0xc4, 0xa0, 0x8d, 0xac, 0xe7, 0x44, 0x60, 0x17, 0x0b,
-		0x8c, 0xce, 0x61, 0x33, 0xc0, 0x77, 0xb6, 0x94, 0xcd, 0xed, 0x88, 0xc4, 0x36, 0x27, 0x0e, 0x0b,
-		0x5d, 0x45, 0x57, 0x3d, 0xfc, 0x5c, 0x4f, 0x23, 0xd5, 0x47, 0x91, 0xea, 0x1d, 0x96, 0xf4, 0x7c,
-		0x72, 0x85, 0xfd, 0x84, 0x98, 0xeb, 0x01, 0xbe, 0x93, 0x0f, 0xf2, 0x4b, 0x12, 0x77, 0x15, 0xac,
-		0xf9, 0x01, 0xea, 0x23, 0x8a, 0x4b, 0x1c, 0x0b, 0x2a, 0xb3, 0x32, 0xe6, 0xd2, 0xa0, 0xe4, 0x91,
-		0xfb, 0x4c, 0x89, 0xfc, 0x89, 0x76, 0x61, 0x8d, 0x0d, 0x43, 0x12, 0xdb, 0xb7, 0x8c, 0x0b, 0x5b,
-		0xe9, 0x2c, 0x2a, 0xef, 0x8a, 0x32, 0xbf, 0x63, 0x5c, 0x5c, 0xe0, 0x80, 0x34, 0x3d, 0xd8, 0x32,
-		0x38, 0xf3, 0x55, 0x92, 0xdf, 0xc6, 0x2c, 0x89, 0xce, 0x89, 0x88, 0xa9, 0xc3, 0x51, 0x0b, 0x36,
-		0x43, 0x32, 0xcc, 0x0f, 0xbf, 0x60, 0xae, 0x87, 0x64, 0x38, 0x1d, 0x20, 0x7a, 0x05, 0xcf, 0x22,
-		0xe6, 0xfb, 0x24, 0xb6, 0x1d, 0x96, 0x84, 0x42, 0xd1, 0x95, 0xcc, 0x6a, 0x6a, 0x3b, 0x96, 0xa6,
-		0xe6, 0x5f, 0x65, 0x58, 0x1d, 0x89, 0xe8, 0x0a, 0x2c, 0x12, 0x8e, 0xbe, 0x06, 0xd4, 0xc3, 0x8e,
-		0xe7, 0xb3, 0x7e, 0x0a, 0xb3, 0x6f, 0x69, 0x28, 0x14, 0x49, 0xc9, 0xd4, 0x32, 0x8f, 0x02, 0xbf,
-		0xa3, 0xa1, 0x40, 0x2f, 0x01, 0x62, 0x82, 0x5d, 0xdb, 0x27, 0x03, 0xe2, 0x67, 0x0c, 0xcb, 0xd2,
-		0x72, 0x26, 0x0d, 0xe8, 0x05, 0x2c, 0x63, 0xc7, 0xcb, 0xbc, 0x25, 0xe5, 0x5d, 0xc2, 0x8e, 0x97,
-		0x3a, 0x77, 0x61, 0x2d, 0xc6, 0x82, 0x4c, 0x6a, 0x29, 0x2b, 0x2d, 0x2b, 0xd2, 0xfc, 0xa0, 0xa3,
-		0x03, 0x2b, 0x52, 0xb4, 0x4d, 0x5d, 0xbb, 0xe7, 0x33, 0xc7, 0xab, 0x55, 0x54, 0xc1, 0x76, 0x1e,
-		0xed, 0x05, 0xa3, 0x73, 0x24, 0xef, 0x99, 0x55, 0x09, 0x33, 0x5c, 0x75, 0x40, 0x03, 0xd8, 0xa6,
-		0xa3, 0xbc, 0xda, 0x7d, 0x99, 0x58, 0x3b, 0x48, 0x33, 0x5b, 0x5b, 0xd8, 0x29, 0xed, 0x55, 0x0f,
-		0x5f, 0x3f, 0xd9, 0x5b, 0x69, 0x76, 0xf4, 0xdc, 0xd2, 0x9c, 0x84, 0x22, 0xbe, 0x37, 0xb7, 0xe8,
-		0x47, 0x95, 0x6d, 0xf1, 0x91, 0xb2, 0x35, 0x04, 0x34, 0x1e, 0x67, 0xc9, 0x69, 0xac, 0x37, 0x50,
-		0x19, 0xc8, 0x1e, 0x55, 0xd9, 0xaf, 0x1e, 0xee, 0xe7, 0xca, 0xc8, 0x7d, 0xd1, 0x4c, 0x81, 0x3f,
-		0x16, 0x7f, 0x28, 0x34, 0x7f, 0x81, 0xea, 0x44, 0xea, 0x50, 0x1d, 0x96, 0xb8, 0xc0, 0xb1, 0xb0,
-		0xa9, 0x9b, 0xd5, 0x7e, 0x51, 0x9d, 0x0d, 0x17, 0x6d, 0xc1, 0x02, 0x09, 0x5d, 0xe9, 0x48, 0xcb,
-		0x5d, 0x21, 0xa1, 0x6b, 0xb8, 0xcd, 0xbf, 0x0b, 0x00, 0x97, 0xaa, 0xb5, 0x8c, 0xf0, 0x86, 0xa1,
-		0x0e, 0x68, 0x3e, 0xe6, 0xc2, 0xc6, 0x8e, 0x43, 0x38, 0xb7, 0xe5, 0x5a, 0xc8, 0x06, 0xad, 0x31,
-		0x37, 0x68, 0xd6, 0x68, 0x67, 0x98, 0xab, 0x12, 0xd3, 0x56, 0x10, 0x69, 0x44, 0x0d, 0x58, 0xa2,
-		0x2e, 0x09, 0x05, 0x15, 0xf7, 0xd9, 0xb4, 0x8c, 0xcf, 0x79, 0xed, 0x53, 0xca, 0x69, 0x9f, 0xe6,
-		0x3f, 0x05, 0xa8, 0x77, 0x05, 0x75, 0xbc, 0xfb, 0x93, 0x3b, 0xe2, 0x24, 0x32, 0x09, 0x6d, 0x21,
-		0x62, 0xda, 0x4b, 0x04, 0xe1, 0xe8, 0x2d, 0x68, 0x43, 0x16, 0x7b, 0x24, 0x56, 0x15, 0xb2, 0xe5,
-		0x3e, 0xcc, 0xe2, 0x7c, 0xf9, 0x64, 0x3f, 0x98, 0xab, 0x29, 0x6c, 0xbc, 0xbc, 0x2c, 0xa8, 0x73,
-		0xe7, 0x96, 0xb8, 0x89, 0x4f, 0x6c, 0xc1, 0xec, 0x34, 0x7b, 0x52, 0x36, 0x4b, 0x44, 0x56, 0x9a,
-		0xfa, 0xfc, 0x8a, 0xc9, 0xb6, 0xa9, 0xf9, 0x7c, 0x84, 0xb5, 0x58, 0x57, 0x22, 0xad, 0x14, 0xd8,
-		0x7c, 0x0d, 0xeb, 0x73, 0x4b, 0x06, 0x7d, 0x05, 0xda, 0x4c, 0x2b, 0xf3, 0x5a, 0x61, 0xa7, 0xb4,
-		0xb7, 0x6c, 0xae, 0x4d, 0xf7, 0x20, 0x6f, 0xfe, 0x5b, 0x86, 0xed, 0xb9, 0x07, 0x8e, 0x59, 0x78,
-		0x43, 0xfb, 0xa8, 0x06, 0x8b, 0x03, 0x12, 0x73, 0xca, 0xc2, 0x51, 0x89, 0xb3, 0x23, 0x3a, 0x84,
-		0x8d, 0x30, 0x09, 0x6c, 0x35, 0xd9, 0xd1, 0x08, 0xc5, 0x95, 0x8a, 0xca, 0x51, 0xb1, 0x26, 0xdb,
-		0x36, 0x09, 0x4c, 0x82, 0xdd, 0xf1, 0x93, 0x1c, 0x7d, 0x07, 0x9b, 0x12, 0x33, 0x8c, 0xa9, 0xac,
-		0xc9, 0x03, 0xa8, 0x34, 0x06, 0xa1, 0x30, 0x09, 0x7e, 0x95, 0xee, 0x09, 0x14, 0x85, 0xb5, 0x59,
-		0x96, 0xb2, 0x9a, 0xc6, 0x37, 0x4f, 0x66, 0x7f, 0x46, 0x8a, 0x3e, 0x1d, 0x4b, 0x3a, 0x8f, 0xab,
-		0xf1, 0x74, 0x80, 0x3e, 0x68, 0x73, 0xc1, 0x55, 0x14, 0x57, 0xfb, 0xa3, 0xb8, 0x66, 0x24, 0xa4,
-		0x64, 0x6b, 0xc3, 0x69, 0x6b, 0x83, 0xc2, 0x46, 0x4e, 0x50, 0x93, 0xe3, 0x5b, 0x49, 0xc7, 0xf7,
-		0xe7, 0xe9, 0xf1, 0xdd, 0xfd, 0x7f, 0xb1, 0x4c, 0x8c, 0x6e, 0xe3, 0x4f, 0xd8, 0xcc, 0x8b, 0xe9,
-		0x53, 0x70, 0xed, 0xff, 0x01, 0xcf, 0x26, 0xff, 0x6d, 0x51, 0x03, 0x9e, 0x5b, 0xed, 0xee, 0xa9,
-		0x7d, 0x66, 0x74, 0x2d, 0xfb, 0xd4, 0xb8, 0xe8, 0xd8, 0xc6, 0xc5, 0x55, 0xfb, 0xcc, 0xe8, 0x68,
-		0x9f, 0xa1, 0x3a, 0x6c, 0xcd, 0xf8, 0x2e, 0xde, 0x9b, 0xe7, 0xed, 0x33, 0xad, 0x90, 0xe3, 0xea,
-		0x5a, 0xc6, 0xf1, 0xe9, 0xb5, 0x56, 0xdc, 0x77, 0x1f, 0x18, 0xac, 0xfb, 0x88, 0x4c, 0x33, 0x58,
-		0xd7, 0x97, 0x27, 0x13, 0x0c, 0x2f, 0x60, 0x7b, 0xc6, 0xd7, 0x39, 0x39, 0x36, 0xba, 0xc6, 0xfb,
-		0x0b, 0xad, 0x90, 0xe3, 0x6c, 0x1f, 0x5b, 0xc6, 0x95, 0x61, 0x5d, 0x6b, 0xc5, 0xa3, 0xdf, 0x61,
-		0xdb, 0x61, 0x41, 0x9e, 0xfe, 0xa3, 0x95, 0x71, 0x02, 0xe4, 0x94, 0x5e, 0x16, 0x7e, 0x3b, 0xe8,
-		0x53, 0x71, 0x9b, 0xf4, 0x74, 0x87, 0x05, 0xad, 0xc9, 0xef, 0xa8, 0x6f, 0xa8, 0xeb, 0xb7, 0xfa,
-		0x2c, 0xfd, 0xb4, 0xc9, 0x3e, 0xaa, 0x7e, 0xc2, 0x11, 0x1d, 0x1c, 0xf4, 0x16, 0x94, 0xed, 0xdb,
-		0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xf6, 0xce, 0x1e, 0x78, 0x09, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xed, 0x4e, 0xe3, 0x46,
+		0x17, 0x7e, 0xf3, 0xb5, 0xc0, 0xc9, 0x02, 0x66, 0x80, 0x25, 0xc9, 0xbe, 0xdb, 0xb2, 0xf9, 0x81,
+		0x28, 0x6a, 0x1d, 0x41, 0x5b, 0xa9, 0x6a, 0xab, 0xed, 0x06, 0x82, 0x76, 0x2d, 0x3e, 0x16, 0x39,
+		0x5e, 0x2a, 0x2a, 0x55, 0xee, 0xc4, 0x1e, 0xc2, 0x34, 0xb6, 0xc7, 0x9a, 0x19, 0x27, 0x70, 0x05,
+		0xbd, 0x83, 0x5e, 0x4c, 0xef, 0xa1, 0xf7, 0x54, 0xcd, 0xd8, 0x81, 0x7c, 0x18, 0xd4, 0xfd, 0xd1,
+		0x7f, 0x99, 0x73, 0xe6, 0x99, 0xe7, 0x3c, 0xe7, 0x2b, 0x86, 0x66, 0xd2, 0x23, 0xbc, 0xe5, 0x61,
+		0x9f, 0x44, 0x1e, 0x69, 0xe1, 0x98, 0xb6, 0x86, 0xfb, 0x2d, 0x89, 0xc5, 0x20, 0xa0, 0x42, 0x9a,
+		0x31, 0x67, 0x92, 0xa1, 0x75, 0x75, 0xc7, 0xcc, 0xee, 0x98, 0x38, 0xa6, 0xe6, 0x70, 0xbf, 0xf1,
+		0x59, 0x9f, 0xb1, 0x7e, 0x40, 0x5a, 0xfa, 0x4a, 0x2f, 0xb9, 0x6e, 0xf9, 0x09, 0xc7, 0x92, 0xb2,
+		0x28, 0x05, 0x35, 0x3e, 0x9f, 0xf5, 0x4b, 0x1a, 0x12, 0x21, 0x71, 0x18, 0x67, 0x17, 0xe6, 0x1e,
+		0x18, 0x71, 0x1c, 0xc7, 0x84, 0x8b, 0xd4, 0xdf, 0xfc, 0x08, 0x8b, 0x0e, 0x16, 0x83, 0x53, 0x2a,
+		0x24, 0x42, 0x50, 0x8e, 0x70, 0x48, 0x6a, 0x85, 0xed, 0xc2, 0xee, 0x92, 0xad, 0x7f, 0xa3, 0x6f,
+		0xa1, 0x3c, 0xa0, 0x91, 0x5f, 0x2b, 0x6e, 0x17, 0x76, 0x57, 0x0e, 0x5e, 0x9b, 0x39, 0x41, 0x9a,
+		0xe3, 0x07, 0x4e, 0x68, 0xe4, 0xdb, 0xfa, 0x7a, 0x13, 0x83, 0x31, 0xb6, 0x9e, 0x11, 0x89, 0x7d,
+		0x2c, 0x31, 0x3a, 0x83, 0x8d, 0x10, 0xdf, 0xba, 0x4a, 0xb6, 0x70, 0x63, 0xc2, 0x5d, 0x41, 0x3c,
+		0x16, 0xf9, 0x9a, 0xae, 0x7a, 0xf0, 0x7f, 0x33, 0x8d, 0xd4, 0x1c, 0x47, 0x6a, 0x76, 0x58, 0xd2,
+		0x0b, 0xc8, 0x25, 0x0e, 0x12, 0x62, 0xaf, 0x85, 0xf8, 0x56, 0x3d, 0x28, 0x2e, 0x08, 0xef, 0x6a,
+		0x58, 0xf3, 0x23, 0xd4, 0xc7, 0x14, 0x17, 0x98, 0x4b, 0xaa, 0xb2, 0x72, 0xcf, 0x65, 0x40, 0x69,
+		0x40, 0xee, 0x32, 0x25, 0xea, 0x27, 0xda, 0x81, 0x55, 0x36, 0x8a, 0x08, 0x77, 0x6f, 0x98, 0x90,
+		0xae, 0xd6, 0x59, 0xd4, 0xde, 0x65, 0x6d, 0x7e, 0xcf, 0x84, 0x3c, 0xc7, 0x21, 0x69, 0x0e, 0x60,
+		0xd3, 0x12, 0x2c, 0xd0, 0x49, 0x7e, 0xc7, 0x59, 0x12, 0x9f, 0x11, 0xc9, 0xa9, 0x27, 0x50, 0x0b,
+		0x36, 0x22, 0x32, 0xca, 0x0f, 0xbf, 0x60, 0xaf, 0x45, 0x64, 0x34, 0x1d, 0x20, 0x7a, 0x0d, 0xcf,
+		0x63, 0x16, 0x04, 0x84, 0xbb, 0x1e, 0x4b, 0x22, 0xa9, 0xe9, 0x4a, 0x76, 0x35, 0xb5, 0x1d, 0x29,
+		0x53, 0xf3, 0x8f, 0x32, 0xac, 0x8c, 0x45, 0x74, 0x25, 0x96, 0x89, 0x40, 0x5f, 0x02, 0xea, 0x61,
+		0x6f, 0x10, 0xb0, 0x7e, 0x0a, 0x73, 0x6f, 0x68, 0x24, 0x35, 0x49, 0xc9, 0x36, 0x32, 0x8f, 0x06,
+		0xbf, 0xa7, 0x91, 0x44, 0xaf, 0x00, 0x38, 0xc1, 0xbe, 0x1b, 0x90, 0x21, 0x09, 0x32, 0x86, 0x25,
+		0x65, 0x39, 0x55, 0x06, 0xf4, 0x12, 0x96, 0xb0, 0x37, 0xc8, 0xbc, 0x25, 0xed, 0x5d, 0xc4, 0xde,
+		0x20, 0x75, 0xee, 0xc0, 0x2a, 0xc7, 0x92, 0x4c, 0x6a, 0x29, 0x6b, 0x2d, 0xcb, 0xca, 0xfc, 0xa0,
+		0xa3, 0x03, 0xcb, 0x4a, 0xb4, 0x4b, 0x7d, 0xb7, 0x17, 0x30, 0x6f, 0x50, 0xab, 0xe8, 0x82, 0x6d,
+		0x3f, 0xda, 0x0b, 0x56, 0xe7, 0x50, 0xdd, 0xb3, 0xab, 0x0a, 0x66, 0xf9, 0xfa, 0x80, 0x86, 0xb0,
+		0x45, 0xc7, 0x79, 0x75, 0xfb, 0x2a, 0xb1, 0x6e, 0x98, 0x66, 0xb6, 0xf6, 0x6c, 0xbb, 0xb4, 0x5b,
+		0x3d, 0x78, 0xf3, 0x64, 0x6f, 0xa5, 0xd9, 0x31, 0x73, 0x4b, 0x73, 0x1c, 0x49, 0x7e, 0x67, 0x6f,
+		0xd2, 0x4f, 0x2a, 0xdb, 0xc2, 0x23, 0x65, 0x6b, 0x48, 0x68, 0x3c, 0xce, 0x92, 0xd3, 0x58, 0x6f,
+		0xa1, 0x32, 0x54, 0x3d, 0xaa, 0xb3, 0x5f, 0x3d, 0xd8, 0xcb, 0x95, 0x91, 0xfb, 0xa2, 0x9d, 0x02,
+		0xbf, 0x2f, 0x7e, 0x57, 0x68, 0xfe, 0x04, 0xd5, 0x89, 0xd4, 0xa1, 0x3a, 0x2c, 0x0a, 0x89, 0xb9,
+		0x74, 0xa9, 0x9f, 0xd5, 0x7e, 0x41, 0x9f, 0x2d, 0x1f, 0x6d, 0xc2, 0x33, 0x12, 0xf9, 0xca, 0x91,
+		0x96, 0xbb, 0x42, 0x22, 0xdf, 0xf2, 0x9b, 0x7f, 0x16, 0x00, 0x2e, 0x74, 0x6b, 0x59, 0xd1, 0x35,
+		0x43, 0x1d, 0x30, 0x02, 0x2c, 0xa4, 0x8b, 0x3d, 0x8f, 0x08, 0xe1, 0xaa, 0xb5, 0x90, 0x0d, 0x5a,
+		0x63, 0x6e, 0xd0, 0x9c, 0xf1, 0xce, 0xb0, 0x57, 0x14, 0xa6, 0xad, 0x21, 0xca, 0x88, 0x1a, 0xb0,
+		0x48, 0x7d, 0x12, 0x49, 0x2a, 0xef, 0xb2, 0x69, 0xb9, 0x3f, 0xe7, 0xb5, 0x4f, 0x29, 0xa7, 0x7d,
+		0x9a, 0x7f, 0x15, 0xa0, 0xde, 0x95, 0xd4, 0x1b, 0xdc, 0x1d, 0xdf, 0x12, 0x2f, 0x51, 0x49, 0x68,
+		0x4b, 0xc9, 0x69, 0x2f, 0x91, 0x44, 0xa0, 0x77, 0x60, 0x8c, 0x18, 0x1f, 0x10, 0xae, 0x2b, 0xe4,
+		0xaa, 0x7d, 0x98, 0xc5, 0xf9, 0xea, 0xc9, 0x7e, 0xb0, 0x57, 0x52, 0xd8, 0xf8, 0x8c, 0x1c, 0xa8,
+		0x0b, 0xef, 0x86, 0xf8, 0x49, 0x40, 0x5c, 0xc9, 0xdc, 0x34, 0x7b, 0x4a, 0x36, 0x4b, 0x64, 0x56,
+		0x9a, 0xfa, 0xfc, 0x8a, 0xc9, 0xb6, 0xa9, 0xfd, 0x62, 0x8c, 0x75, 0x58, 0x57, 0x21, 0x9d, 0x14,
+		0xd8, 0x7c, 0x03, 0x6b, 0x73, 0x4b, 0x06, 0x7d, 0x01, 0xc6, 0x4c, 0x2b, 0x8b, 0x5a, 0x61, 0xbb,
+		0xb4, 0xbb, 0x64, 0xaf, 0x4e, 0xf7, 0xa0, 0x68, 0xfe, 0x5d, 0x86, 0xad, 0xb9, 0x07, 0x8e, 0x58,
+		0x74, 0x4d, 0xfb, 0xa8, 0x06, 0x0b, 0x43, 0xc2, 0x05, 0x65, 0xd1, 0xb8, 0xc4, 0xd9, 0x11, 0x1d,
+		0xc0, 0x7a, 0x94, 0x84, 0xae, 0x9e, 0xec, 0x78, 0x8c, 0x12, 0x5a, 0x45, 0xe5, 0xb0, 0x58, 0x53,
+		0x6d, 0x9b, 0x84, 0x36, 0xc1, 0xfe, 0xfd, 0x93, 0x02, 0x7d, 0x03, 0x1b, 0x0a, 0x33, 0xe2, 0x54,
+		0xd5, 0xe4, 0x01, 0x54, 0xba, 0x07, 0xa1, 0x28, 0x09, 0x7f, 0x56, 0xee, 0x09, 0x14, 0x85, 0xd5,
+		0x59, 0x96, 0xb2, 0x9e, 0xc6, 0xb7, 0x4f, 0x66, 0x7f, 0x46, 0x8a, 0x39, 0x1d, 0x4b, 0x3a, 0x8f,
+		0x2b, 0x7c, 0x3a, 0xc0, 0x00, 0x8c, 0xb9, 0xe0, 0x2a, 0x9a, 0xab, 0xfd, 0x49, 0x5c, 0x33, 0x12,
+		0x52, 0xb2, 0xd5, 0xd1, 0xb4, 0xb5, 0x41, 0x61, 0x3d, 0x27, 0xa8, 0xc9, 0xf1, 0xad, 0xa4, 0xe3,
+		0xfb, 0xe3, 0xf4, 0xf8, 0xee, 0xfc, 0xbb, 0x58, 0x26, 0x46, 0xb7, 0xf1, 0x3b, 0x6c, 0xe4, 0xc5,
+		0xf4, 0x5f, 0x70, 0xed, 0xfd, 0x06, 0xcf, 0x27, 0xff, 0x6d, 0x51, 0x03, 0x5e, 0x38, 0xed, 0xee,
+		0x89, 0x7b, 0x6a, 0x75, 0x1d, 0xf7, 0xc4, 0x3a, 0xef, 0xb8, 0xd6, 0xf9, 0x65, 0xfb, 0xd4, 0xea,
+		0x18, 0xff, 0x43, 0x75, 0xd8, 0x9c, 0xf1, 0x9d, 0x7f, 0xb0, 0xcf, 0xda, 0xa7, 0x46, 0x21, 0xc7,
+		0xd5, 0x75, 0xac, 0xa3, 0x93, 0x2b, 0xa3, 0xb8, 0xe7, 0x3f, 0x30, 0x38, 0x77, 0x31, 0x99, 0x66,
+		0x70, 0xae, 0x2e, 0x8e, 0x27, 0x18, 0x5e, 0xc2, 0xd6, 0x8c, 0xaf, 0x73, 0x7c, 0x64, 0x75, 0xad,
+		0x0f, 0xe7, 0x46, 0x21, 0xc7, 0xd9, 0x3e, 0x72, 0xac, 0x4b, 0xcb, 0xb9, 0x32, 0x8a, 0x87, 0xbf,
+		0xc2, 0x96, 0xc7, 0xc2, 0x3c, 0xfd, 0x87, 0xcb, 0xf7, 0x09, 0x50, 0x53, 0x7a, 0x51, 0xf8, 0x65,
+		0xbf, 0x4f, 0xe5, 0x4d, 0xd2, 0x33, 0x3d, 0x16, 0xb6, 0x26, 0xbf, 0xa3, 0xbe, 0xa2, 0x7e, 0xd0,
+		0xea, 0xb3, 0xf4, 0xd3, 0x26, 0xfb, 0xa8, 0xfa, 0x01, 0xc7, 0x74, 0xb8, 0xdf, 0x7b, 0xa6, 0x6d,
+		0x5f, 0xff, 0x33, 0x00, 0xe6, 0xf6, 0xce, 0x1e, 0x78, 0x09, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/service_worker.proto
 	[]byte{
 		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5b, 0x6f, 0xdb, 0xc8,
-		0xf5, 0x07, 0x7d, 0xf7, 0xf1, 0x4d, 0x1e, 0xe3, 0xef, 0xbf, 0xac, 0xdc, 0x1c, 0xa5, 0x49, 0xbc,
-		0x6d, 0x2a, 0x37, 0xde, 0x6d, 0x36, 0x9b, 0x6c, 0x8a, 0xfa, 0x12, 0x23, 0x2e, 0x36, 0x5b, 0x2f,
-		0xa3, 0x4d, 0x80, 0x2d, 0x10, 0x62, 0x44, 0x8e, 0xac, 0x81, 0x29, 0x8e, 0x42, 0x0e, 0xa5, 0xe8,
-		0xa5, 0x0f, 0x7d, 0xdc, 0xf6, 0xa1, 0x40, 0xd1, 0xed, 0x4b, 0x81, 0x3c, 0xf7, 0x03, 0xf4, 0xcb,
-		0xb4, 0x4f, 0x7d, 0xee, 0x67, 0x28, 0x50, 0x70, 0x66, 0x48, 0x51, 0xd2, 0x90, 0xba, 0xb4, 0x80,
-		0x17, 0xe8, 0x9b, 0x39, 0xfc, 0x9d, 0xc3, 0x33, 0xe7, 0x77, 0xe6, 0xcc, 0x6f, 0xc6, 0x82, 0xbd,
-		0xb0, 0x46, 0xfc, 0x7d, 0x1b, 0x3b, 0xc4, 0xb3, 0xc9, 0x3e, 0x6e, 0xd1, 0xfd, 0xf6, 0xc3, 0xfd,
-		0x80, 0xf8, 0x6d, 0x6a, 0x13, 0xab, 0xc3, 0xfc, 0x4b, 0xe2, 0x57, 0x5a, 0x3e, 0xe3, 0x0c, 0x6d,
-		0x45, 0xc8, 0x8a, 0x42, 0x56, 0x70, 0x8b, 0x56, 0xda, 0x0f, 0x4b, 0x37, 0x2f, 0x18, 0xbb, 0x70,
-		0xc9, 0xbe, 0x80, 0xd4, 0xc2, 0xfa, 0xbe, 0x13, 0xfa, 0x98, 0x53, 0xe6, 0x49, 0xa3, 0xd2, 0xad,
-		0xc1, 0xf7, 0x9c, 0x36, 0x49, 0xc0, 0x71, 0xb3, 0xa5, 0x00, 0x43, 0x0e, 0x3a, 0x3e, 0x6e, 0xb5,
-		0x88, 0x1f, 0xa8, 0xf7, 0xbb, 0xba, 0xf8, 0x6c, 0xd6, 0x6c, 0x26, 0x9f, 0x28, 0xeb, 0x10, 0x0e,
-		0xb1, 0x69, 0xd0, 0x0b, 0xe3, 0xb6, 0x0e, 0xd3, 0xa0, 0x01, 0x67, 0x7e, 0x37, 0x8e, 0x54, 0x07,
-		0x79, 0x17, 0x92, 0x04, 0xa0, 0xfd, 0x0e, 0xc7, 0xc1, 0xa5, 0x4b, 0x03, 0x9e, 0x87, 0x89, 0xb2,
-		0x58, 0x77, 0x59, 0x47, 0x62, 0xca, 0x7f, 0x35, 0xa0, 0x74, 0xce, 0x5c, 0xf7, 0x94, 0xf9, 0x27,
-		0x2a, 0xca, 0x2a, 0x0e, 0x2e, 0x4d, 0xf2, 0x2e, 0x24, 0x01, 0x47, 0xdb, 0xb0, 0xe0, 0xb0, 0x26,
-		0xa6, 0x5e, 0xd1, 0xd8, 0x35, 0xf6, 0x96, 0x4d, 0xf5, 0x84, 0x9e, 0xc0, 0x72, 0xf4, 0x31, 0x2b,
-		0xfa, 0x5a, 0x71, 0x66, 0xd7, 0xd8, 0x5b, 0x39, 0xb8, 0x51, 0xd1, 0x50, 0x52, 0x89, 0x9c, 0x7d,
-		0x41, 0x03, 0x6e, 0x2e, 0x71, 0xf5, 0x17, 0x2a, 0xc1, 0x12, 0x75, 0x88, 0xc7, 0x29, 0xef, 0x16,
-		0x67, 0x85, 0xd7, 0xe4, 0x19, 0xdd, 0x87, 0x8d, 0x1a, 0xf5, 0xb0, 0xdf, 0xb5, 0xec, 0x06, 0xb1,
-		0x2f, 0x83, 0xb0, 0x59, 0x9c, 0x13, 0x90, 0x75, 0x39, 0x7c, 0xac, 0x46, 0xcb, 0xff, 0x5a, 0x82,
-		0x6b, 0xda, 0xb8, 0x83, 0x16, 0xf3, 0x02, 0x82, 0x6e, 0x00, 0x88, 0x00, 0x39, 0xbb, 0x24, 0x32,
-		0xf8, 0x55, 0x53, 0x84, 0x5c, 0x8d, 0x06, 0xd0, 0xd7, 0x80, 0xe2, 0x44, 0x58, 0xe4, 0x3d, 0xb1,
-		0xc3, 0xa8, 0x4a, 0xd4, 0x44, 0xee, 0x69, 0x27, 0xf2, 0x46, 0xc1, 0x9f, 0xc7, 0x68, 0x73, 0xb3,
-		0x33, 0x38, 0x84, 0x4e, 0x61, 0x2d, 0x71, 0xcb, 0xbb, 0x2d, 0x22, 0xe6, 0xb7, 0x72, 0x70, 0x3b,
-		0xd7, 0x63, 0xb5, 0xdb, 0x22, 0xe6, 0x6a, 0x27, 0xf5, 0x84, 0x5e, 0xc3, 0x4e, 0xcb, 0x27, 0x6d,
-		0xca, 0xc2, 0xc0, 0x0a, 0x38, 0xf6, 0x39, 0x71, 0x2c, 0xd2, 0x26, 0x1e, 0xb7, 0xa8, 0x23, 0x12,
-		0xb2, 0x72, 0x70, 0xad, 0x22, 0x6b, 0xb5, 0x12, 0xd7, 0x6a, 0xe5, 0xcc, 0xe3, 0x8f, 0x3e, 0x79,
-		0x8d, 0xdd, 0x90, 0x98, 0xdb, 0xb1, 0xf5, 0x2b, 0x69, 0xfc, 0x3c, 0xb2, 0x3d, 0x73, 0xd0, 0x1e,
-		0x14, 0x86, 0xdc, 0xcd, 0xef, 0x1a, 0x7b, 0xb3, 0xe6, 0x7a, 0xd0, 0x8f, 0x2c, 0xc2, 0x22, 0xe6,
-		0x9c, 0x34, 0x5b, 0xbc, 0xb8, 0x20, 0x00, 0xf1, 0x23, 0x7a, 0x00, 0xa8, 0x86, 0xed, 0x4b, 0x97,
-		0x5d, 0x58, 0x36, 0x0b, 0x3d, 0x6e, 0x35, 0xa8, 0xc7, 0x8b, 0x8b, 0x02, 0x54, 0x50, 0x6f, 0x8e,
-		0xa3, 0x17, 0x2f, 0xa8, 0xc7, 0xd1, 0x23, 0x58, 0x54, 0x95, 0x5d, 0x5c, 0x12, 0x71, 0x5f, 0xd7,
-		0xe6, 0xe2, 0x85, 0xc4, 0x98, 0x31, 0x18, 0xdd, 0x83, 0x0d, 0x8f, 0xbc, 0xe7, 0x56, 0x0b, 0x5f,
-		0x10, 0x45, 0xe2, 0xb2, 0x20, 0x71, 0x2d, 0x1a, 0x3e, 0xc7, 0x17, 0x44, 0x12, 0xf9, 0x18, 0xe6,
-		0xc5, 0xb2, 0x28, 0x82, 0xf0, 0x5e, 0xce, 0xcd, 0xf4, 0x57, 0x11, 0xd2, 0x94, 0x06, 0xe8, 0x2d,
-		0x5c, 0x1f, 0x2e, 0x01, 0xab, 0x57, 0xd5, 0x2b, 0xe3, 0x54, 0xf5, 0xce, 0x50, 0x0d, 0xc4, 0xaf,
-		0xd0, 0x21, 0xac, 0x07, 0x76, 0x83, 0x38, 0xa1, 0x4b, 0x1c, 0x2b, 0x6a, 0x34, 0xc5, 0x55, 0xe1,
-		0xb1, 0x34, 0x44, 0x5c, 0x35, 0xee, 0x42, 0xe6, 0x5a, 0x62, 0x11, 0x8d, 0xa1, 0x67, 0xb0, 0x1a,
-		0xd3, 0x25, 0x1c, 0xac, 0x8d, 0x74, 0xb0, 0xa2, 0xf0, 0xc2, 0xfc, 0x0d, 0x2c, 0x46, 0x53, 0xa5,
-		0x24, 0x28, 0xae, 0xef, 0xce, 0xee, 0xad, 0x1c, 0x3c, 0xd3, 0x4e, 0x26, 0x67, 0x19, 0x55, 0xbe,
-		0x92, 0xf6, 0xcf, 0x3d, 0x1e, 0x91, 0xa3, 0xbc, 0xa1, 0x32, 0x08, 0x16, 0x7a, 0x35, 0xb4, 0x21,
-		0xd8, 0x5f, 0x89, 0x06, 0xe3, 0x02, 0xaa, 0xc0, 0x16, 0x67, 0x1c, 0xbb, 0x96, 0x62, 0xd4, 0xaa,
-		0x75, 0x39, 0x09, 0x8a, 0x05, 0x81, 0xdc, 0x14, 0xaf, 0x14, 0xe9, 0x47, 0xd1, 0x0b, 0xf4, 0x12,
-		0x0a, 0x38, 0xe4, 0xcc, 0xb2, 0x99, 0x57, 0xa7, 0x17, 0xb2, 0xa8, 0x36, 0xc5, 0x7c, 0xef, 0x68,
-		0xa3, 0x3e, 0x0c, 0x39, 0x3b, 0x16, 0xd8, 0xa8, 0xce, 0xcc, 0x75, 0xdc, 0xf7, 0x5c, 0x7a, 0x0b,
-		0xab, 0xe9, 0xd8, 0x51, 0x01, 0x66, 0x2f, 0x49, 0x57, 0x75, 0xb1, 0xe8, 0xcf, 0xa8, 0x72, 0xda,
-		0xd1, 0x62, 0x51, 0xab, 0x7e, 0xac, 0xca, 0x11, 0x06, 0x4f, 0x66, 0x1e, 0x1b, 0xe5, 0xbf, 0xcc,
-		0xc3, 0x1d, 0x99, 0x25, 0x27, 0x9d, 0xb8, 0x63, 0xd6, 0x6c, 0xb9, 0x84, 0x13, 0x27, 0x6e, 0xa0,
-		0x23, 0xfa, 0xd0, 0x53, 0x58, 0x8e, 0x37, 0x87, 0xa0, 0x38, 0x23, 0x48, 0xd2, 0x57, 0x5c, 0xfc,
-		0x11, 0xb3, 0x87, 0x47, 0x3f, 0x82, 0xcd, 0x5e, 0xe1, 0xda, 0xcc, 0xe3, 0xe4, 0x3d, 0x17, 0x1d,
-		0x67, 0xd5, 0x2c, 0x24, 0x2f, 0x8e, 0xe5, 0x78, 0x5f, 0xd7, 0x9d, 0x1b, 0xe8, 0xba, 0xbf, 0x82,
-		0xcd, 0x80, 0x53, 0xfb, 0xb2, 0x6b, 0x61, 0xce, 0x7d, 0x5a, 0x0b, 0x23, 0xa6, 0xe6, 0x45, 0x5a,
-		0x2a, 0xda, 0x68, 0x5e, 0x09, 0x74, 0x52, 0xf3, 0x87, 0x89, 0x95, 0x59, 0x90, 0x8e, 0x7a, 0x23,
-		0xe8, 0x53, 0x28, 0xfa, 0x84, 0x87, 0xbe, 0x67, 0x79, 0xa4, 0x63, 0xc5, 0xd1, 0x8b, 0x85, 0x26,
-		0x5a, 0xcb, 0x92, 0xf9, 0x7f, 0xf2, 0xfd, 0x97, 0xa4, 0x93, 0x4e, 0x25, 0x3a, 0x82, 0x9b, 0x75,
-		0xe6, 0xdb, 0xc4, 0xb2, 0x7d, 0x82, 0x39, 0xd1, 0x98, 0x2f, 0x0a, 0xf3, 0x92, 0x40, 0x1d, 0x0b,
-		0xd0, 0xa0, 0x0f, 0xcd, 0x7e, 0xb2, 0xa4, 0xdb, 0x4f, 0x10, 0x83, 0x35, 0xd1, 0x16, 0x2c, 0x9f,
-		0x04, 0xa1, 0xcb, 0x83, 0xe2, 0xb2, 0x20, 0xe3, 0x17, 0xda, 0xe9, 0x8f, 0x41, 0x7c, 0x45, 0x56,
-		0x8c, 0x74, 0x26, 0x97, 0xcf, 0xea, 0xbb, 0xd4, 0x50, 0x89, 0xc2, 0xe6, 0x10, 0x44, 0x53, 0xa5,
-		0x3f, 0xeb, 0xaf, 0xd2, 0xbd, 0x31, 0xaa, 0x54, 0x38, 0x4c, 0xd7, 0xea, 0x87, 0x59, 0xf8, 0x41,
-		0x7e, 0xc8, 0x6a, 0xd3, 0xfc, 0x1a, 0xd6, 0xfa, 0x13, 0x6c, 0x88, 0x8f, 0xfe, 0x64, 0xd2, 0xb6,
-		0x61, 0xae, 0x3a, 0x69, 0x12, 0x3e, 0x18, 0x70, 0x13, 0xdb, 0x9c, 0xb6, 0x29, 0xa7, 0x24, 0xb0,
-		0x38, 0xb3, 0x1c, 0x1a, 0xb4, 0x30, 0xb7, 0x1b, 0x96, 0xcb, 0x6c, 0xec, 0xba, 0x5d, 0x55, 0xfa,
-		0xdf, 0x4c, 0x91, 0x6d, 0xd5, 0xa8, 0x0e, 0x13, 0xff, 0x55, 0x76, 0xa2, 0xbc, 0x7f, 0x21, 0x9d,
-		0xcb, 0xec, 0x5f, 0xc3, 0xd9, 0x88, 0xd2, 0xaf, 0x61, 0x77, 0x94, 0x03, 0x0d, 0x37, 0x27, 0xfd,
-		0xdc, 0xe8, 0x97, 0x8a, 0xf2, 0xdb, 0x15, 0xbe, 0x62, 0xc7, 0x67, 0x5e, 0x9d, 0xa5, 0x19, 0xfa,
-		0xcd, 0x0c, 0xec, 0x6a, 0xa6, 0x79, 0x8a, 0xa9, 0x3b, 0x76, 0x2b, 0x39, 0x82, 0x79, 0x1b, 0x87,
-		0x81, 0x8c, 0x66, 0xfd, 0xe0, 0x41, 0x6e, 0x1b, 0xe9, 0x79, 0x3f, 0x8e, 0x6c, 0x4c, 0x69, 0x1a,
-		0xed, 0xd6, 0x0e, 0xe1, 0x98, 0xba, 0x81, 0x52, 0x2e, 0xfa, 0xdd, 0xfa, 0x1c, 0x77, 0x5d, 0x86,
-		0x1d, 0x33, 0x06, 0xe7, 0x36, 0x17, 0xcd, 0x12, 0x9c, 0xd7, 0x4a, 0xba, 0x3b, 0x70, 0x3b, 0x27,
-		0x07, 0x92, 0xe7, 0xf2, 0x3f, 0x7a, 0x7a, 0x35, 0xce, 0xec, 0x55, 0xea, 0xd5, 0x57, 0x80, 0x12,
-		0xbf, 0x56, 0x93, 0x70, 0xec, 0x60, 0x8e, 0x95, 0x42, 0xbb, 0x9b, 0xfb, 0x81, 0x97, 0x0a, 0x6c,
-		0x16, 0xf8, 0xc0, 0x48, 0xf9, 0x6f, 0x3d, 0x6d, 0xdb, 0x3f, 0xc7, 0x2b, 0xd5, 0xb6, 0xb7, 0x60,
-		0x45, 0x2d, 0xa1, 0x6e, 0xb4, 0xe5, 0xcb, 0x4c, 0x40, 0x3c, 0x74, 0xe6, 0x44, 0xe2, 0x37, 0x01,
-		0x08, 0xf1, 0x3b, 0x97, 0x23, 0x7e, 0x93, 0x89, 0x09, 0xf1, 0x8b, 0x53, 0x4f, 0xe8, 0x00, 0xe6,
-		0xa9, 0xd7, 0x0a, 0xb9, 0xda, 0x81, 0xf2, 0x4b, 0x50, 0x42, 0x35, 0x62, 0x6b, 0xe1, 0x3f, 0x15,
-		0x5b, 0x8b, 0x93, 0x89, 0xad, 0x2a, 0xec, 0xc4, 0xfe, 0xa2, 0x0e, 0x67, 0xbb, 0x2c, 0x20, 0xc2,
-		0x11, 0x0b, 0xb9, 0x92, 0xbe, 0x3b, 0x43, 0xbe, 0x4e, 0xd4, 0xf9, 0xd4, 0xdc, 0x8e, 0x6d, 0xab,
-		0xec, 0x38, 0xb2, 0xac, 0x4a, 0x43, 0xf4, 0x25, 0x6c, 0x8b, 0x8f, 0x0c, 0xbb, 0x5c, 0x1e, 0xe5,
-		0x72, 0x4b, 0x18, 0x0e, 0xf8, 0x3b, 0x85, 0xcd, 0x06, 0xc1, 0x3e, 0xaf, 0x11, 0xcc, 0x13, 0x57,
-		0x30, 0xca, 0x55, 0x21, 0xb1, 0x89, 0xfd, 0xa4, 0x8e, 0x07, 0x91, 0x4e, 0x9e, 0xef, 0x1d, 0x0f,
-		0xde, 0xc2, 0xcd, 0x7e, 0x26, 0x2c, 0x56, 0xb7, 0x78, 0x83, 0x06, 0x56, 0x6c, 0x30, 0x5a, 0x06,
-		0x97, 0xfa, 0x98, 0xf9, 0x65, 0xbd, 0xda, 0xa0, 0xc1, 0xa1, 0xf2, 0x7f, 0x96, 0x9e, 0x41, 0xdc,
-		0xac, 0xd6, 0xc6, 0xa8, 0x94, 0xde, 0x24, 0x4e, 0x54, 0xd7, 0x1a, 0x3a, 0xad, 0xad, 0x4f, 0x77,
-		0x5a, 0xbb, 0x0f, 0x1b, 0x89, 0x1f, 0xd5, 0x7d, 0x36, 0x64, 0x87, 0x8b, 0x87, 0x4f, 0x64, 0x17,
-		0xfa, 0x18, 0x16, 0x1a, 0x04, 0x3b, 0xc4, 0x17, 0x32, 0x38, 0x3a, 0xc3, 0x69, 0xcf, 0x42, 0x02,
-		0x62, 0x2a, 0xe8, 0x7f, 0x59, 0x18, 0x97, 0xbf, 0x33, 0x12, 0xe1, 0x9a, 0x6e, 0x2e, 0x93, 0x0a,
-		0xd7, 0x4f, 0x60, 0x41, 0x2a, 0x25, 0xd5, 0x58, 0xf2, 0x73, 0xaf, 0xb0, 0x79, 0xad, 0xb4, 0x7c,
-		0x2f, 0x11, 0x29, 0x19, 0x71, 0xa9, 0x1d, 0xe0, 0xb7, 0x33, 0x70, 0x3f, 0x0f, 0x78, 0xd4, 0x3d,
-		0x3b, 0x19, 0xb5, 0x1d, 0x5c, 0x55, 0x8b, 0xec, 0x65, 0x6d, 0x6e, 0xca, 0xac, 0xcd, 0x0f, 0x64,
-		0xed, 0x87, 0xb0, 0x37, 0x3a, 0x19, 0x2a, 0x73, 0x7f, 0x34, 0x12, 0x95, 0x91, 0x06, 0x4f, 0xa4,
-		0x32, 0x1e, 0xc1, 0x62, 0x1d, 0x53, 0x37, 0xf4, 0x49, 0x2e, 0xf1, 0xa7, 0x12, 0x63, 0xc6, 0xe0,
-		0x5c, 0xe6, 0x7b, 0x1b, 0xbf, 0x2e, 0x2c, 0x15, 0xfc, 0xb7, 0x33, 0xda, 0xfa, 0x90, 0xa8, 0xef,
-		0x33, 0xe7, 0xa9, 0x8c, 0xcd, 0x4d, 0x9b, 0xb1, 0x41, 0xd6, 0xef, 0xc3, 0xdd, 0x11, 0xb9, 0x50,
-		0x59, 0xfb, 0x93, 0x01, 0x65, 0x5d, 0x7d, 0x60, 0xcf, 0x26, 0x13, 0x91, 0x1e, 0x77, 0xda, 0x99,
-		0x69, 0x65, 0xe1, 0x20, 0xe9, 0x77, 0xf5, 0x6d, 0x28, 0x09, 0x4c, 0x4d, 0xe0, 0x77, 0x33, 0x70,
-		0x2f, 0x07, 0xf7, 0x3d, 0x27, 0x3e, 0xce, 0xda, 0xdc, 0xb4, 0x59, 0x1b, 0x24, 0xfe, 0x23, 0x7d,
-		0xef, 0xeb, 0xcb, 0x46, 0x1f, 0xf5, 0x36, 0xf3, 0xfb, 0xa0, 0x2f, 0xe2, 0x4d, 0xf0, 0x0a, 0xa9,
-		0x3f, 0x8f, 0xa8, 0xcf, 0x09, 0x4c, 0xc9, 0xdc, 0x8f, 0xa0, 0x60, 0x8b, 0x89, 0x59, 0xbe, 0x8c,
-		0x95, 0x38, 0x22, 0xbe, 0x25, 0x73, 0x43, 0x8e, 0x9b, 0xf1, 0xb0, 0xaa, 0x92, 0x4c, 0x97, 0xff,
-		0x6b, 0x55, 0x52, 0x8d, 0xaa, 0x64, 0x44, 0x36, 0x26, 0x4f, 0xf2, 0xdf, 0x7b, 0xdb, 0x87, 0xb8,
-		0x68, 0x98, 0x46, 0x36, 0xfc, 0x7c, 0x40, 0x36, 0x8c, 0x7f, 0x9f, 0x11, 0x6f, 0x86, 0xaf, 0x61,
-		0x4b, 0xfe, 0x23, 0xc8, 0x6a, 0x13, 0x5f, 0xdc, 0x54, 0x50, 0xaf, 0xce, 0xd4, 0x71, 0x35, 0x9b,
-		0x28, 0xe2, 0xbf, 0x96, 0x70, 0x71, 0xf4, 0xde, 0xec, 0x0c, 0x0e, 0xa5, 0x36, 0x21, 0xdd, 0xe4,
-		0x62, 0xed, 0x61, 0x40, 0xc9, 0x24, 0x01, 0xe1, 0xf2, 0x02, 0x2c, 0x39, 0x2c, 0x5e, 0x49, 0x6d,
-		0x95, 0x6f, 0xc0, 0x35, 0x6d, 0x30, 0x2a, 0x58, 0x1f, 0xd6, 0xfb, 0xb5, 0x20, 0x7a, 0x00, 0x88,
-		0x78, 0xb8, 0xe6, 0x12, 0x2b, 0xa5, 0x28, 0x15, 0xdd, 0x05, 0xf9, 0xa6, 0x67, 0x81, 0x0e, 0x60,
-		0xbb, 0xc5, 0x5c, 0x97, 0xf8, 0x56, 0x07, 0x53, 0x79, 0x5a, 0xb0, 0xa8, 0x67, 0x35, 0x65, 0x27,
-		0x98, 0x35, 0x91, 0x7c, 0xfb, 0x06, 0x53, 0x71, 0x2c, 0x38, 0xf3, 0x5e, 0x06, 0x07, 0xff, 0xdc,
-		0x80, 0x65, 0x99, 0xee, 0xc3, 0xf3, 0x33, 0xf4, 0x1e, 0xb6, 0x34, 0xb7, 0x44, 0x68, 0x7f, 0xfc,
-		0xfb, 0x24, 0x91, 0xd7, 0xd2, 0xc4, 0x17, 0x50, 0xe8, 0x0f, 0x06, 0x5c, 0xcf, 0xbb, 0x37, 0x42,
-		0x8f, 0xa7, 0xbd, 0xd8, 0x2b, 0x7d, 0x36, 0xf5, 0x25, 0x15, 0xfa, 0xd6, 0x80, 0x9d, 0xcc, 0x2b,
-		0x0e, 0xf4, 0xd3, 0x71, 0x1d, 0xf7, 0x09, 0xb6, 0xd2, 0xa3, 0x49, 0xcd, 0x54, 0x30, 0x3d, 0x72,
-		0xd2, 0x5d, 0x22, 0x9f, 0x1c, 0xcd, 0x95, 0x4b, 0x3e, 0x39, 0xda, 0xfb, 0x8b, 0x14, 0x39, 0x5a,
-		0xd1, 0x9a, 0x4f, 0x4e, 0xde, 0xa9, 0x25, 0x9f, 0x9c, 0xdc, 0x73, 0x05, 0xfa, 0xa0, 0x57, 0xc7,
-		0x7d, 0x52, 0x1a, 0x7d, 0x3e, 0xb1, 0xff, 0xd4, 0xde, 0x53, 0x7a, 0x36, 0xa5, 0xf5, 0x70, 0xf9,
-		0x0c, 0xcb, 0xbe, 0xfc, 0xf2, 0xc9, 0xd4, 0xfb, 0xf9, 0xe5, 0x93, 0xad, 0xc7, 0xd1, 0x77, 0x06,
-		0xdc, 0xc8, 0xd5, 0xa0, 0xe8, 0xb3, 0xc9, 0x3c, 0xa7, 0x13, 0xf5, 0x64, 0x1a, 0x53, 0x15, 0xd8,
-		0xef, 0x0d, 0xd1, 0x16, 0xb3, 0x34, 0x12, 0xfa, 0x74, 0x6c, 0x12, 0xfa, 0x45, 0x72, 0xe9, 0xf1,
-		0xe4, 0x86, 0x2a, 0xa4, 0x3f, 0x1b, 0x70, 0x6b, 0x84, 0x6c, 0x43, 0x4f, 0x27, 0xf5, 0x9e, 0xce,
-		0xd7, 0xe7, 0xd3, 0x19, 0xf7, 0x65, 0x2c, 0x53, 0x2f, 0x64, 0x66, 0x6c, 0x94, 0xb6, 0xcc, 0xcc,
-		0xd8, 0x68, 0xed, 0x27, 0x33, 0x96, 0x2b, 0x61, 0x32, 0x33, 0x36, 0x8e, 0x0c, 0xcc, 0xcc, 0xd8,
-		0x78, 0xaa, 0x29, 0xb5, 0x12, 0x87, 0xd5, 0x42, 0xfe, 0x4a, 0xcc, 0x94, 0x4e, 0xf9, 0x2b, 0x31,
-		0x5b, 0x94, 0x44, 0x8d, 0x5c, 0x23, 0x03, 0x32, 0x1a, 0x79, 0xb6, 0x7a, 0xc9, 0x68, 0xe4, 0x39,
-		0x0a, 0xe3, 0xa8, 0x06, 0xff, 0x6f, 0xb3, 0xa6, 0xce, 0xec, 0x08, 0x49, 0x15, 0xf0, 0x4a, 0xfe,
-		0x76, 0xe7, 0xdc, 0x67, 0x9c, 0x9d, 0x1b, 0xdf, 0x3c, 0xbc, 0xa0, 0xbc, 0x11, 0xd6, 0x2a, 0x36,
-		0x6b, 0xee, 0xa7, 0x7f, 0x9c, 0xf2, 0x63, 0xea, 0xb8, 0xfb, 0x17, 0x4c, 0xfe, 0xee, 0x46, 0xfd,
-		0x52, 0xe5, 0x29, 0x6e, 0xd1, 0xf6, 0xc3, 0xda, 0x82, 0x18, 0xfb, 0xf8, 0xdf, 0x01, 0x00, 0x00,
-		0xff, 0xff, 0x83, 0x33, 0x80, 0xed, 0x1b, 0x24, 0x00, 0x00,
+		0x15, 0x06, 0x65, 0xcb, 0x97, 0xe3, 0x9b, 0x3c, 0x46, 0x5d, 0x9a, 0xce, 0xc5, 0x91, 0x93, 0xd8,
+		0xdb, 0x6e, 0xe5, 0xc4, 0xbb, 0xf5, 0x3a, 0xb7, 0xa2, 0xbe, 0xc4, 0x88, 0x8b, 0xcd, 0xd6, 0xcb,
+		0x68, 0x13, 0x60, 0x0b, 0x84, 0x18, 0x91, 0x63, 0x6b, 0x60, 0x8a, 0xa3, 0x90, 0x43, 0x2b, 0x7a,
+		0xe9, 0x43, 0x1f, 0xb7, 0x7d, 0x28, 0x50, 0x74, 0xfb, 0x52, 0x20, 0xcf, 0xfd, 0x01, 0xfd, 0x33,
+		0xed, 0x53, 0x9f, 0xfb, 0x1b, 0x0a, 0x14, 0x9c, 0x19, 0x4a, 0x94, 0x44, 0x51, 0x97, 0x16, 0x70,
+		0x80, 0x7d, 0x33, 0x67, 0xbe, 0xf3, 0xf1, 0xcc, 0x39, 0x87, 0x67, 0xbe, 0x19, 0x0b, 0xb6, 0xc3,
+		0x0a, 0xf1, 0x77, 0x6c, 0xec, 0x10, 0xcf, 0x26, 0x3b, 0xb8, 0x4e, 0x77, 0xae, 0x1e, 0xee, 0x04,
+		0xc4, 0xbf, 0xa2, 0x36, 0xb1, 0x1a, 0xcc, 0xbf, 0x24, 0x7e, 0xa9, 0xee, 0x33, 0xce, 0xd0, 0x4a,
+		0x84, 0x2c, 0x29, 0x64, 0x09, 0xd7, 0x69, 0xe9, 0xea, 0xa1, 0x71, 0xeb, 0x82, 0xb1, 0x0b, 0x97,
+		0xec, 0x08, 0x48, 0x25, 0x3c, 0xdf, 0x71, 0x42, 0x1f, 0x73, 0xca, 0x3c, 0x69, 0x64, 0xdc, 0xee,
+		0x9e, 0xe7, 0xb4, 0x46, 0x02, 0x8e, 0x6b, 0x75, 0x05, 0xe8, 0x21, 0x68, 0xf8, 0xb8, 0x5e, 0x27,
+		0x7e, 0xa0, 0xe6, 0x37, 0xd2, 0xfc, 0xb3, 0x59, 0xad, 0xd6, 0x7a, 0x45, 0x31, 0x0d, 0xe1, 0x10,
+		0x9b, 0x06, 0x6d, 0x37, 0xee, 0xa4, 0x61, 0xaa, 0x34, 0xe0, 0xcc, 0x6f, 0xc6, 0x9e, 0xa6, 0x41,
+		0xde, 0x85, 0xc4, 0x6f, 0x66, 0xbd, 0x87, 0xe3, 0xe0, 0xd2, 0xa5, 0x01, 0xcf, 0xc2, 0x44, 0x51,
+		0x3c, 0x77, 0x59, 0x43, 0x62, 0x8a, 0x7f, 0xd7, 0xc0, 0x38, 0x63, 0xae, 0x7b, 0xc2, 0xfc, 0x63,
+		0xe5, 0x65, 0x19, 0x07, 0x97, 0x26, 0x79, 0x17, 0x92, 0x80, 0xa3, 0x55, 0x98, 0x72, 0x58, 0x0d,
+		0x53, 0x4f, 0xd7, 0x36, 0xb4, 0xed, 0x59, 0x53, 0x3d, 0xa1, 0xc7, 0x30, 0x1b, 0xbd, 0xcc, 0x8a,
+		0xde, 0xa6, 0xe7, 0x36, 0xb4, 0xed, 0xb9, 0xdd, 0x9b, 0xa5, 0x94, 0x94, 0x94, 0x22, 0xb2, 0x2f,
+		0x69, 0xc0, 0xcd, 0x19, 0xae, 0xfe, 0x42, 0x06, 0xcc, 0x50, 0x87, 0x78, 0x9c, 0xf2, 0xa6, 0x3e,
+		0x21, 0x58, 0x5b, 0xcf, 0x68, 0x0b, 0x96, 0x2a, 0xd4, 0xc3, 0x7e, 0xd3, 0xb2, 0xab, 0xc4, 0xbe,
+		0x0c, 0xc2, 0x9a, 0x3e, 0x29, 0x20, 0x8b, 0x72, 0xf8, 0x48, 0x8d, 0x16, 0xff, 0x33, 0x03, 0xeb,
+		0xa9, 0x7e, 0x07, 0x75, 0xe6, 0x05, 0x04, 0xdd, 0x04, 0x10, 0x0e, 0x72, 0x76, 0x49, 0xa4, 0xf3,
+		0xf3, 0xa6, 0x70, 0xb9, 0x1c, 0x0d, 0xa0, 0x6f, 0x00, 0xc5, 0x81, 0xb0, 0xc8, 0x7b, 0x62, 0x87,
+		0x51, 0x95, 0xa8, 0x85, 0xdc, 0x4f, 0x5d, 0xc8, 0x1b, 0x05, 0x7f, 0x1e, 0xa3, 0xcd, 0xe5, 0x46,
+		0xf7, 0x10, 0x3a, 0x81, 0x85, 0x16, 0x2d, 0x6f, 0xd6, 0x89, 0x58, 0xdf, 0xdc, 0xee, 0x9d, 0x4c,
+		0xc6, 0x72, 0xb3, 0x4e, 0xcc, 0xf9, 0x46, 0xe2, 0x09, 0xbd, 0x86, 0xb5, 0xba, 0x4f, 0xae, 0x28,
+		0x0b, 0x03, 0x2b, 0xe0, 0xd8, 0xe7, 0xc4, 0xb1, 0xc8, 0x15, 0xf1, 0xb8, 0x45, 0x1d, 0x11, 0x90,
+		0xb9, 0xdd, 0xf5, 0x92, 0xac, 0xd5, 0x52, 0x5c, 0xab, 0xa5, 0x53, 0x8f, 0xef, 0x7d, 0xfe, 0x1a,
+		0xbb, 0x21, 0x31, 0x57, 0x63, 0xeb, 0x57, 0xd2, 0xf8, 0x79, 0x64, 0x7b, 0xea, 0xa0, 0x6d, 0x28,
+		0xf4, 0xd0, 0xe5, 0x37, 0xb4, 0xed, 0x09, 0x73, 0x31, 0xe8, 0x44, 0xea, 0x30, 0x8d, 0x39, 0x27,
+		0xb5, 0x3a, 0xd7, 0xa7, 0x04, 0x20, 0x7e, 0x44, 0x9f, 0x02, 0xaa, 0x60, 0xfb, 0xd2, 0x65, 0x17,
+		0x96, 0xcd, 0x42, 0x8f, 0x5b, 0x55, 0xea, 0x71, 0x7d, 0x5a, 0x80, 0x0a, 0x6a, 0xe6, 0x28, 0x9a,
+		0x78, 0x41, 0x3d, 0x8e, 0xf6, 0x60, 0x5a, 0x55, 0xb6, 0x3e, 0x23, 0xfc, 0xbe, 0x91, 0x1a, 0x8b,
+		0x17, 0x12, 0x63, 0xc6, 0x60, 0x74, 0x1f, 0x96, 0x3c, 0xf2, 0x9e, 0x5b, 0x75, 0x7c, 0x41, 0x54,
+		0x12, 0x67, 0x45, 0x12, 0x17, 0xa2, 0xe1, 0x33, 0x7c, 0x41, 0x64, 0x22, 0xf7, 0x21, 0x2f, 0x3e,
+		0x0b, 0x1d, 0x04, 0x7b, 0x31, 0x33, 0xd2, 0x5f, 0x47, 0x48, 0x53, 0x1a, 0xa0, 0xb7, 0x70, 0xa3,
+		0xb7, 0x04, 0xac, 0x76, 0x55, 0xcf, 0x0d, 0x53, 0xd5, 0x6b, 0x3d, 0x35, 0x10, 0x4f, 0xa1, 0x03,
+		0x58, 0x0c, 0xec, 0x2a, 0x71, 0x42, 0x97, 0x38, 0x56, 0xd4, 0x68, 0xf4, 0x79, 0xc1, 0x68, 0xf4,
+		0x24, 0xae, 0x1c, 0x77, 0x21, 0x73, 0xa1, 0x65, 0x11, 0x8d, 0xa1, 0x67, 0x30, 0x1f, 0xa7, 0x4b,
+		0x10, 0x2c, 0x0c, 0x24, 0x98, 0x53, 0x78, 0x61, 0xfe, 0x06, 0xa6, 0xa3, 0xa5, 0x52, 0x12, 0xe8,
+		0x8b, 0x1b, 0x13, 0xdb, 0x73, 0xbb, 0xcf, 0x52, 0x17, 0x93, 0xf1, 0x19, 0x95, 0xbe, 0x96, 0xf6,
+		0xcf, 0x3d, 0x1e, 0x25, 0x47, 0xb1, 0xa1, 0x22, 0x88, 0x2c, 0xb4, 0x6b, 0x68, 0x49, 0x64, 0x7f,
+		0x2e, 0x1a, 0x8c, 0x0b, 0xa8, 0x04, 0x2b, 0x9c, 0x71, 0xec, 0x5a, 0x2a, 0xa3, 0x56, 0xa5, 0xc9,
+		0x49, 0xa0, 0x17, 0x04, 0x72, 0x59, 0x4c, 0xa9, 0xa4, 0x1f, 0x46, 0x13, 0xe8, 0x25, 0x14, 0x70,
+		0xc8, 0x99, 0x65, 0x33, 0xef, 0x9c, 0x5e, 0xc8, 0xa2, 0x5a, 0x16, 0xeb, 0xdd, 0x4c, 0xf5, 0xfa,
+		0x20, 0xe4, 0xec, 0x48, 0x60, 0xa3, 0x3a, 0x33, 0x17, 0x71, 0xc7, 0xb3, 0xf1, 0x16, 0xe6, 0x93,
+		0xbe, 0xa3, 0x02, 0x4c, 0x5c, 0x92, 0xa6, 0xea, 0x62, 0xd1, 0x9f, 0x51, 0xe5, 0x5c, 0x45, 0x1f,
+		0x8b, 0x9e, 0x1b, 0xbe, 0x72, 0x84, 0xc1, 0xe3, 0xdc, 0xbe, 0x56, 0xfc, 0x5b, 0x1e, 0x36, 0x65,
+		0x94, 0x9c, 0x64, 0xe0, 0x8e, 0x58, 0xad, 0xee, 0x12, 0x4e, 0x9c, 0xb8, 0x81, 0x0e, 0xe8, 0x43,
+		0x4f, 0x60, 0x36, 0xde, 0x1c, 0x02, 0x3d, 0xb7, 0x31, 0xd1, 0xb7, 0xe2, 0xe2, 0x97, 0x98, 0x6d,
+		0x3c, 0xfa, 0x29, 0x2c, 0xb7, 0x0b, 0xd7, 0x66, 0x1e, 0x27, 0xef, 0xb9, 0xe8, 0x38, 0xf3, 0x66,
+		0xa1, 0x35, 0x71, 0x24, 0xc7, 0x3b, 0xba, 0xee, 0x64, 0x57, 0xd7, 0xfd, 0x0d, 0x2c, 0x07, 0x9c,
+		0xda, 0x97, 0x4d, 0x0b, 0x73, 0xee, 0xd3, 0x4a, 0x18, 0x65, 0x2a, 0x2f, 0xc2, 0x52, 0x4a, 0xf5,
+		0xe6, 0x95, 0x40, 0xb7, 0x6a, 0xfe, 0xa0, 0x65, 0x65, 0x16, 0x24, 0x51, 0x7b, 0x04, 0x7d, 0x01,
+		0xba, 0x4f, 0x78, 0xe8, 0x7b, 0x96, 0x47, 0x1a, 0x56, 0xec, 0xbd, 0xf8, 0xd0, 0x44, 0x6b, 0x99,
+		0x31, 0x7f, 0x24, 0xe7, 0xbf, 0x22, 0x8d, 0x64, 0x28, 0xd1, 0x21, 0xdc, 0x3a, 0x67, 0xbe, 0x4d,
+		0x2c, 0xdb, 0x27, 0x98, 0x93, 0x14, 0xf3, 0x69, 0x61, 0x6e, 0x08, 0xd4, 0x91, 0x00, 0x75, 0x73,
+		0xa4, 0xec, 0x27, 0x33, 0x69, 0xfb, 0x09, 0x62, 0xb0, 0x20, 0xda, 0x82, 0xe5, 0x93, 0x20, 0x74,
+		0x79, 0xa0, 0xcf, 0x8a, 0x64, 0xfc, 0x2a, 0x75, 0xf9, 0x43, 0x24, 0xbe, 0x24, 0x2b, 0x46, 0x92,
+		0xc9, 0xcf, 0x67, 0xfe, 0x5d, 0x62, 0xc8, 0xa0, 0xb0, 0xdc, 0x03, 0x49, 0xa9, 0xd2, 0x5f, 0x74,
+		0x56, 0xe9, 0xf6, 0x10, 0x55, 0x2a, 0x08, 0x93, 0xb5, 0xfa, 0x61, 0x02, 0xee, 0x66, 0xbb, 0xac,
+		0x36, 0xcd, 0x6f, 0x60, 0xa1, 0x33, 0xc0, 0x9a, 0x78, 0xe9, 0x83, 0x51, 0xdb, 0x86, 0x39, 0xef,
+		0x24, 0x93, 0xf0, 0x41, 0x83, 0x5b, 0xd8, 0xe6, 0xf4, 0x8a, 0x72, 0x4a, 0x02, 0x8b, 0x33, 0xcb,
+		0xa1, 0x41, 0x1d, 0x73, 0xbb, 0x6a, 0xb9, 0xcc, 0xc6, 0xae, 0xdb, 0x54, 0xa5, 0xff, 0xed, 0x18,
+		0xd1, 0x56, 0x8d, 0xea, 0xa0, 0xc5, 0x5f, 0x66, 0xc7, 0x8a, 0xfd, 0x4b, 0x49, 0x2e, 0xa3, 0xbf,
+		0x8e, 0xfb, 0x23, 0x8c, 0xdf, 0xc2, 0xc6, 0x20, 0x82, 0x94, 0xdc, 0x1c, 0x77, 0xe6, 0x26, 0xfd,
+		0x53, 0x51, 0xbc, 0x4d, 0xc1, 0x15, 0x13, 0x9f, 0x7a, 0xe7, 0x2c, 0x99, 0xa1, 0xdf, 0xe5, 0x60,
+		0x23, 0x65, 0x99, 0x27, 0x98, 0xba, 0x43, 0xb7, 0x92, 0x43, 0xc8, 0xdb, 0x38, 0x0c, 0xa4, 0x37,
+		0x8b, 0xbb, 0x9f, 0x66, 0xb6, 0x91, 0x36, 0xfb, 0x51, 0x64, 0x63, 0x4a, 0xd3, 0x68, 0xb7, 0x76,
+		0x08, 0xc7, 0xd4, 0x0d, 0xf4, 0x89, 0x8c, 0xdd, 0xfa, 0x0c, 0x37, 0x5d, 0x86, 0x1d, 0x33, 0x06,
+		0x67, 0x36, 0x97, 0x94, 0x4f, 0x30, 0x9f, 0x2a, 0xe9, 0x36, 0xe1, 0x4e, 0x46, 0x0c, 0x64, 0x9e,
+		0x8b, 0xff, 0x6a, 0xeb, 0xd5, 0x38, 0xb2, 0xd7, 0xa9, 0x57, 0x5f, 0x01, 0x6a, 0xf1, 0x5a, 0x35,
+		0xc2, 0xb1, 0x83, 0x39, 0x56, 0x0a, 0xed, 0x5e, 0xe6, 0x0b, 0x5e, 0x2a, 0xb0, 0x59, 0xe0, 0x5d,
+		0x23, 0xc5, 0x7f, 0xb4, 0xb5, 0x6d, 0xe7, 0x1a, 0xaf, 0x55, 0xdb, 0xde, 0x86, 0x39, 0xf5, 0x09,
+		0x35, 0xa3, 0x2d, 0x5f, 0x46, 0x02, 0xe2, 0xa1, 0x53, 0x27, 0x12, 0xbf, 0x2d, 0x80, 0x10, 0xbf,
+		0x93, 0x19, 0xe2, 0xb7, 0xb5, 0x30, 0x21, 0x7e, 0x71, 0xe2, 0x09, 0xed, 0x42, 0x9e, 0x7a, 0xf5,
+		0x90, 0xeb, 0xf9, 0x21, 0x4a, 0x50, 0x42, 0x53, 0xc4, 0xd6, 0xd4, 0xff, 0x2a, 0xb6, 0xa6, 0x47,
+		0x13, 0x5b, 0x65, 0x58, 0x8b, 0xf9, 0xa2, 0x0e, 0x67, 0xbb, 0x2c, 0x20, 0x82, 0x88, 0x85, 0x5c,
+		0x49, 0xdf, 0xb5, 0x1e, 0xae, 0x63, 0x75, 0x3e, 0x35, 0x57, 0x63, 0xdb, 0x32, 0x3b, 0x8a, 0x2c,
+		0xcb, 0xd2, 0x10, 0x7d, 0x05, 0xab, 0xe2, 0x25, 0xbd, 0x94, 0xb3, 0x83, 0x28, 0x57, 0x84, 0x61,
+		0x17, 0xdf, 0x09, 0x2c, 0x57, 0x09, 0xf6, 0x79, 0x85, 0x60, 0xde, 0xa2, 0x82, 0x41, 0x54, 0x85,
+		0x96, 0x4d, 0xcc, 0x93, 0x38, 0x1e, 0x44, 0x3a, 0x39, 0xdf, 0x3e, 0x1e, 0xbc, 0x85, 0x5b, 0x9d,
+		0x99, 0xb0, 0xd8, 0xb9, 0xc5, 0xab, 0x34, 0xb0, 0x62, 0x83, 0xc1, 0x32, 0xd8, 0xe8, 0xc8, 0xcc,
+		0xaf, 0xcf, 0xcb, 0x55, 0x1a, 0x1c, 0x28, 0xfe, 0xd3, 0xe4, 0x0a, 0xe2, 0x66, 0xb5, 0x30, 0x44,
+		0xa5, 0xb4, 0x17, 0x71, 0xac, 0xba, 0x56, 0xcf, 0x69, 0x6d, 0x71, 0xbc, 0xd3, 0xda, 0x16, 0x2c,
+		0xb5, 0x78, 0x54, 0xf7, 0x59, 0x92, 0x1d, 0x2e, 0x1e, 0x3e, 0x16, 0xa3, 0xe8, 0x33, 0x98, 0xaa,
+		0x12, 0xec, 0x10, 0x5f, 0x2f, 0xa8, 0x33, 0x5c, 0xea, 0x59, 0x48, 0x40, 0x4c, 0x05, 0xfd, 0x3f,
+		0x0b, 0xe3, 0xe2, 0xf7, 0x5a, 0x4b, 0xb8, 0x26, 0x9b, 0xcb, 0xa8, 0xc2, 0xf5, 0x73, 0x98, 0x92,
+		0x4a, 0x49, 0xcf, 0x0d, 0x11, 0x7b, 0x85, 0xcd, 0x6a, 0xa5, 0xc5, 0xfb, 0x70, 0x37, 0xdb, 0x2f,
+		0xb5, 0x03, 0xfc, 0x3e, 0x07, 0x5b, 0x59, 0xc0, 0xc3, 0xe6, 0xe9, 0xf1, 0xa0, 0xed, 0xe0, 0xba,
+		0x5a, 0x64, 0x3b, 0x6a, 0x93, 0x63, 0x46, 0x2d, 0xdf, 0x15, 0xb5, 0x9f, 0xc0, 0xf6, 0xe0, 0x60,
+		0xa8, 0xc8, 0xfd, 0x59, 0x83, 0x8d, 0x14, 0xf0, 0x48, 0x2a, 0x63, 0x0f, 0xa6, 0xcf, 0x31, 0x75,
+		0x43, 0x9f, 0x64, 0x26, 0xfe, 0x44, 0x62, 0xcc, 0x18, 0x9c, 0x99, 0xf9, 0xf6, 0xc6, 0x9f, 0xe6,
+		0x96, 0x72, 0xfe, 0xbb, 0x1c, 0xdc, 0xed, 0x8b, 0xfa, 0x98, 0x73, 0x9e, 0x88, 0xd8, 0xe4, 0xb8,
+		0x11, 0xeb, 0xce, 0xfa, 0x16, 0xdc, 0x1b, 0x10, 0x0b, 0x15, 0xb5, 0xbf, 0x68, 0x50, 0x4c, 0xab,
+		0x0f, 0xec, 0xd9, 0x64, 0xa4, 0xa4, 0xc7, 0x9d, 0x36, 0x37, 0xae, 0x2c, 0xec, 0x4e, 0xfa, 0x3d,
+		0xd8, 0xcc, 0x74, 0x4c, 0x2d, 0xe0, 0x0f, 0x39, 0xb8, 0x9f, 0x81, 0xfb, 0xc8, 0x13, 0x1f, 0x47,
+		0x6d, 0x72, 0xdc, 0xa8, 0x75, 0x27, 0xfe, 0x13, 0xd8, 0x1a, 0x18, 0x8d, 0x8e, 0xd4, 0xdb, 0xcc,
+		0xef, 0x80, 0xbe, 0x88, 0x37, 0xc1, 0x6b, 0x4c, 0xfd, 0x19, 0x6c, 0x66, 0x3a, 0xa6, 0x64, 0xee,
+		0x27, 0x50, 0xb0, 0xc5, 0xc2, 0x2c, 0x5f, 0xfa, 0x4a, 0x1c, 0xe1, 0xdf, 0x8c, 0xb9, 0x24, 0xc7,
+		0xcd, 0x78, 0x58, 0x55, 0x49, 0x5f, 0xca, 0x1f, 0x5a, 0x95, 0x94, 0x61, 0x6b, 0x60, 0x34, 0x46,
+		0x0f, 0xf2, 0x3f, 0xdb, 0xdb, 0x87, 0xb8, 0x68, 0x18, 0x47, 0x36, 0xfc, 0xb2, 0x4b, 0x36, 0x0c,
+		0x7f, 0x9f, 0x11, 0x6f, 0x86, 0xaf, 0x61, 0x45, 0xfe, 0x23, 0xc8, 0xba, 0x22, 0xbe, 0xb8, 0xa9,
+		0xa0, 0xde, 0x39, 0xd3, 0x27, 0x06, 0x24, 0x8a, 0xf8, 0xaf, 0x25, 0x5c, 0x1c, 0xbd, 0x97, 0x1b,
+		0xdd, 0x43, 0x89, 0x4d, 0x28, 0x6d, 0x71, 0xb1, 0xf6, 0xd0, 0xc0, 0x30, 0x49, 0x40, 0xb8, 0xbc,
+		0x00, 0x6b, 0x1d, 0x16, 0xaf, 0xa5, 0xb6, 0x8a, 0x37, 0x61, 0x3d, 0xd5, 0x19, 0xe5, 0xac, 0x0f,
+		0x8b, 0x9d, 0x5a, 0x30, 0xba, 0xba, 0x27, 0x1e, 0xae, 0xb8, 0xc4, 0x4a, 0x28, 0x4a, 0x95, 0xee,
+		0x82, 0x9c, 0x69, 0x5b, 0xa0, 0x5d, 0x58, 0xad, 0x33, 0xd7, 0x25, 0xbe, 0xd5, 0xc0, 0x54, 0x9e,
+		0x16, 0x2c, 0xea, 0x59, 0x35, 0xd9, 0x09, 0x26, 0x4c, 0x24, 0x67, 0xdf, 0x60, 0x2a, 0x8e, 0x05,
+		0xa7, 0xde, 0xcb, 0x60, 0xf7, 0xdf, 0x4b, 0x30, 0x2b, 0xc3, 0x7d, 0x70, 0x76, 0x8a, 0xde, 0xc3,
+		0x4a, 0xca, 0x2d, 0x11, 0xda, 0x19, 0xfe, 0x3e, 0x49, 0xc4, 0xd5, 0x18, 0xf9, 0x02, 0x0a, 0xfd,
+		0x49, 0x83, 0x1b, 0x59, 0xf7, 0x46, 0x68, 0x7f, 0xdc, 0x8b, 0x3d, 0xe3, 0xd1, 0xd8, 0x97, 0x54,
+		0xe8, 0x3b, 0x0d, 0xd6, 0xfa, 0x5e, 0x71, 0xa0, 0x9f, 0x0f, 0x4b, 0xdc, 0x21, 0xd8, 0x8c, 0xbd,
+		0x51, 0xcd, 0x94, 0x33, 0xed, 0xe4, 0x24, 0xbb, 0x44, 0x76, 0x72, 0x52, 0xae, 0x5c, 0x8c, 0x07,
+		0xc3, 0x1b, 0xf4, 0x26, 0x27, 0x55, 0xb4, 0x66, 0x27, 0x27, 0xeb, 0xd4, 0x62, 0x3c, 0x1a, 0xc3,
+		0x52, 0x79, 0xf5, 0x21, 0x5d, 0x1d, 0x77, 0x48, 0x69, 0xf4, 0x74, 0x64, 0xfe, 0xc4, 0xde, 0x63,
+		0x3c, 0x1b, 0xd3, 0xba, 0xb7, 0x7c, 0x7a, 0x65, 0x5f, 0x76, 0xf9, 0xf4, 0xd5, 0xfb, 0xc6, 0xde,
+		0xa8, 0x66, 0xca, 0x99, 0xef, 0x35, 0xb8, 0x99, 0xa9, 0x41, 0xd1, 0xa3, 0xd1, 0x98, 0x93, 0x81,
+		0x7a, 0x3c, 0x8e, 0xa9, 0x72, 0xec, 0x8f, 0x1a, 0xac, 0xa7, 0x20, 0x63, 0x8d, 0x84, 0xbe, 0x18,
+		0x3a, 0x09, 0x9d, 0x22, 0xd9, 0xd8, 0x1f, 0xdd, 0x50, 0xb9, 0xf4, 0x57, 0x0d, 0x6e, 0x0f, 0x90,
+		0x6d, 0xe8, 0xc9, 0xa8, 0xec, 0xc9, 0x78, 0x3d, 0x1d, 0xcf, 0xb8, 0x23, 0x62, 0x7d, 0xf5, 0x42,
+		0xdf, 0x88, 0x0d, 0xd2, 0x96, 0xc6, 0xfe, 0xe8, 0x86, 0x1d, 0x11, 0xcb, 0x94, 0x30, 0x7d, 0x23,
+		0x36, 0x8c, 0x0c, 0x34, 0x9e, 0x8e, 0x67, 0xdc, 0xfb, 0x25, 0xf6, 0xaa, 0x85, 0xec, 0x2f, 0xb1,
+		0xaf, 0x74, 0x32, 0xf6, 0x46, 0x35, 0x6b, 0x37, 0xf2, 0x14, 0x19, 0xd0, 0xa7, 0x91, 0xf7, 0x57,
+		0x2f, 0xc6, 0x83, 0xe1, 0x0d, 0xe4, 0x9b, 0x0f, 0x2b, 0xf0, 0x63, 0x9b, 0xd5, 0xd2, 0xcc, 0x0e,
+		0x91, 0x54, 0x01, 0xaf, 0xe4, 0x6f, 0x77, 0xce, 0x7c, 0xc6, 0xd9, 0x99, 0xf6, 0xed, 0xc3, 0x0b,
+		0xca, 0xab, 0x61, 0xa5, 0x64, 0xb3, 0xda, 0x4e, 0xf2, 0xc7, 0x29, 0x3f, 0xa3, 0x8e, 0xbb, 0x73,
+		0xc1, 0xe4, 0xef, 0x6e, 0xd4, 0x2f, 0x55, 0x9e, 0xe0, 0x3a, 0xbd, 0x7a, 0x58, 0x99, 0x12, 0x63,
+		0x9f, 0xfd, 0x77, 0x00, 0x83, 0x33, 0x80, 0xed, 0x1b, 0x24, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/decision.proto
 	[]byte{
 		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6e, 0x14, 0xc7,
 		0x16, 0xbe, 0xed, 0x9f, 0xf9, 0x39, 0x1e, 0x03, 0x2e, 0x83, 0xb1, 0xc1, 0x60, 0x33, 0x57, 0xd7,
 		0x5c, 0xb0, 0x3c, 0x63, 0x1b, 0x2e, 0x42, 0x80, 0xd0, 0xc5, 0x06, 0x0b, 0x4b, 0x60, 0xac, 0xc6,
-		0x04, 0x29, 0x52, 0xd4, 0x2a, 0x57, 0x97, 0xed, 0x8a, 0x7b, 0xba, 0x27, 0xd5, 0xd5, 0x1e, 0x26,
+		0x04, 0x29, 0x52, 0xd4, 0x2a, 0x57, 0x97, 0xed, 0x8a, 0x7b, 0xba, 0x26, 0xd5, 0xd5, 0x1e, 0x26,
 		0x52, 0xa4, 0xac, 0x92, 0x6c, 0xf2, 0x00, 0x91, 0xb2, 0xca, 0x2a, 0xd9, 0x90, 0x6d, 0xa2, 0xac,
-		0xf2, 0x08, 0x59, 0xe4, 0x49, 0xf2, 0x02, 0x51, 0x55, 0x57, 0xf7, 0x8c, 0xc7, 0x3d, 0x3d, 0xdd,
-		0x86, 0xb0, 0x73, 0xd7, 0x9c, 0xf3, 0xd5, 0x57, 0x75, 0x4e, 0x9f, 0xef, 0x1b, 0x0f, 0x54, 0x83,
-		0x5d, 0xca, 0xeb, 0x04, 0xdb, 0xd4, 0x25, 0xb4, 0x8e, 0x9b, 0xac, 0x7e, 0xb4, 0x52, 0xb7, 0x29,
-		0x61, 0x3e, 0xf3, 0xdc, 0x5a, 0x93, 0x7b, 0xc2, 0x43, 0x93, 0x32, 0xa6, 0xa6, 0x63, 0x6a, 0xb8,
-		0xc9, 0x6a, 0x47, 0x2b, 0x97, 0xae, 0xee, 0x7b, 0xde, 0xbe, 0x43, 0xeb, 0x2a, 0x64, 0x37, 0xd8,
-		0xab, 0xdb, 0x01, 0xc7, 0x22, 0x4e, 0xba, 0x34, 0x9f, 0x04, 0x4c, 0xbc, 0x46, 0x23, 0x8e, 0x48,
-		0xdc, 0x5a, 0x60, 0xff, 0xd0, 0x61, 0xbe, 0x48, 0x8b, 0x69, 0x79, 0xfc, 0x70, 0xcf, 0xf1, 0x5a,
-		0x61, 0x4c, 0xf5, 0x9b, 0x09, 0x28, 0x3d, 0xd6, 0x8c, 0xd1, 0x77, 0x06, 0xdc, 0xf4, 0xc9, 0x01,
-		0xb5, 0x03, 0x87, 0x5a, 0x98, 0x08, 0x76, 0xc4, 0x44, 0xdb, 0x92, 0xa8, 0x56, 0x74, 0x2a, 0x0b,
-		0x0b, 0xc1, 0xd9, 0x6e, 0x20, 0xa8, 0x3f, 0x6d, 0xcc, 0x1b, 0xff, 0x1d, 0x5b, 0xbd, 0x5f, 0x4b,
-		0x38, 0x61, 0xed, 0xa5, 0x86, 0x79, 0xa4, 0x51, 0x76, 0xb0, 0x7f, 0x18, 0xed, 0xf3, 0x28, 0x86,
-		0x78, 0xfa, 0x2f, 0x73, 0xc1, 0xcf, 0x14, 0x89, 0x3e, 0x87, 0x39, 0x5f, 0x60, 0x2e, 0x2c, 0xc1,
-		0x1a, 0x94, 0x27, 0xf2, 0x19, 0x52, 0x7c, 0x56, 0x92, 0xf9, 0xc8, 0xdc, 0x1d, 0x99, 0x9a, 0xc8,
-		0x62, 0xd6, 0x4f, 0xf9, 0x1c, 0xfd, 0x68, 0x80, 0xbc, 0xfd, 0xa6, 0x43, 0x05, 0xb5, 0xa2, 0x0b,
-		0xb4, 0xe8, 0x1b, 0x4a, 0x02, 0x59, 0xb4, 0x44, 0x32, 0xc3, 0x8a, 0xcc, 0xff, 0x13, 0xc9, 0xac,
-		0x6b, 0xac, 0xd7, 0x1a, 0xea, 0x49, 0x84, 0x94, 0xc8, 0x6d, 0x91, 0x64, 0x0f, 0x47, 0xdf, 0x1b,
-		0xb0, 0xb8, 0x87, 0x99, 0x93, 0x95, 0xe6, 0x88, 0xa2, 0xf9, 0x20, 0x91, 0xe6, 0x06, 0x66, 0x4e,
-		0x36, 0x8a, 0xd7, 0xf7, 0xb2, 0x85, 0xa2, 0x9f, 0x0c, 0x58, 0xe6, 0xf4, 0xb3, 0x80, 0xfa, 0xc2,
-		0x22, 0xd8, 0x25, 0xd4, 0xc9, 0xd0, 0x67, 0xa3, 0x29, 0x57, 0x69, 0x86, 0x60, 0xeb, 0x0a, 0x6b,
-		0x60, 0xb3, 0x2d, 0xf2, 0xec, 0xe1, 0xe8, 0x0b, 0x98, 0xd7, 0x14, 0xfb, 0xb7, 0x5c, 0x41, 0x51,
-		0x5b, 0x4d, 0xae, 0xb2, 0x4a, 0xee, 0xdf, 0x73, 0x57, 0x48, 0x5a, 0x00, 0xfa, 0xc1, 0x80, 0x25,
-		0xbd, 0x7f, 0xc6, 0x5a, 0x16, 0x15, 0x99, 0x87, 0x29, 0x64, 0xb2, 0x55, 0xf3, 0x06, 0xc9, 0x1a,
-		0x8c, 0xfe, 0x30, 0xe0, 0x61, 0x4f, 0x3d, 0xe9, 0x1b, 0x41, 0xb9, 0x8b, 0x33, 0xb3, 0x2e, 0x29,
-		0xd6, 0xcf, 0x07, 0x57, 0xf7, 0x89, 0x06, 0xce, 0x76, 0x88, 0xbb, 0xfc, 0x94, 0xb9, 0xe8, 0x4b,
-		0x03, 0xae, 0x71, 0x4a, 0x3c, 0x6e, 0x5b, 0x0d, 0xcc, 0x0f, 0xfb, 0x54, 0xbe, 0xac, 0x68, 0xdf,
-		0xea, 0x43, 0x5b, 0x66, 0x3f, 0x57, 0xc9, 0x89, 0xe4, 0xae, 0xf2, 0xd4, 0x08, 0xf4, 0xab, 0x01,
-		0x77, 0x88, 0xe7, 0x0a, 0xe6, 0x06, 0xd4, 0xc2, 0xbe, 0xe5, 0xd2, 0x56, 0xd6, 0xeb, 0x04, 0xc5,
-		0xeb, 0x49, 0x9f, 0xb9, 0x13, 0x42, 0x3e, 0xf2, 0xb7, 0x68, 0x2b, 0xdb, 0x35, 0x2e, 0x93, 0x9c,
-		0x39, 0xe8, 0x67, 0x03, 0x56, 0xc3, 0x49, 0x4d, 0x0e, 0x98, 0x63, 0x67, 0xe5, 0x3d, 0xa6, 0x78,
-		0xaf, 0xf5, 0x1f, 0xde, 0xeb, 0x12, 0x2d, 0x1b, 0xe9, 0x25, 0x3f, 0x4f, 0x02, 0xfa, 0xcd, 0x80,
-		0x3b, 0x3e, 0xdb, 0x97, 0x3d, 0x9b, 0xb7, 0x79, 0x2b, 0x8a, 0xf5, 0x46, 0x32, 0x6b, 0x05, 0x99,
-		0xaf, 0x6b, 0x57, 0xfc, 0xbc, 0x49, 0xe8, 0x17, 0x03, 0xfe, 0x17, 0x34, 0x7d, 0xca, 0x45, 0x87,
-		0xb4, 0x4f, 0x31, 0x27, 0x07, 0x5d, 0x44, 0x13, 0xc9, 0x8f, 0xa7, 0xb4, 0xca, 0x2b, 0x85, 0x18,
-		0xed, 0xff, 0x52, 0xe1, 0x75, 0x36, 0x4d, 0x6e, 0x95, 0x20, 0x67, 0xce, 0x5a, 0x05, 0xa0, 0x43,
-		0xa7, 0xfa, 0x6d, 0x01, 0x16, 0xb2, 0xd9, 0x06, 0x34, 0x07, 0x63, 0xb1, 0x6c, 0x30, 0x5b, 0x19,
-		0x91, 0xb2, 0x09, 0xd1, 0xd2, 0xa6, 0x8d, 0x36, 0x60, 0xbc, 0xa3, 0x2b, 0xed, 0x26, 0xd5, 0xde,
-		0xe0, 0x5a, 0xe2, 0x59, 0xe3, 0xcd, 0xda, 0x4d, 0x6a, 0x56, 0x70, 0xd7, 0x13, 0x9a, 0x82, 0x82,
-		0xed, 0x35, 0x30, 0x73, 0x95, 0x9e, 0x97, 0x4d, 0xfd, 0x84, 0xee, 0x41, 0x59, 0xc9, 0x95, 0x74,
-		0x5b, 0x5a, 0x43, 0xaf, 0x24, 0x62, 0xcb, 0x03, 0x3c, 0x63, 0xbe, 0x30, 0x4b, 0x42, 0xff, 0x85,
-		0x56, 0x61, 0x94, 0xb9, 0xcd, 0x40, 0x68, 0x5d, 0x9b, 0x4d, 0xcc, 0xdb, 0xc6, 0x6d, 0xc7, 0xc3,
-		0xb6, 0x19, 0x86, 0xa2, 0x1d, 0x98, 0x89, 0x8d, 0x99, 0xf0, 0x2c, 0xe2, 0x78, 0x3e, 0x55, 0xb2,
-		0xe4, 0x05, 0x42, 0x8b, 0xd0, 0x4c, 0x2d, 0x34, 0x95, 0xb5, 0xc8, 0x54, 0xd6, 0x1e, 0x6b, 0x53,
-		0x69, 0x4e, 0x45, 0xb9, 0x3b, 0xde, 0xba, 0xcc, 0xdc, 0x09, 0x13, 0x7b, 0x51, 0x3b, 0xfe, 0x4a,
-		0xa2, 0x16, 0x73, 0xa0, 0xc6, 0xee, 0x4a, 0xa2, 0x6e, 0xc1, 0x94, 0x46, 0xea, 0x25, 0x5a, 0x1a,
-		0x04, 0x39, 0x19, 0xda, 0xb0, 0xe3, 0x2c, 0x37, 0x60, 0xe2, 0x80, 0x62, 0x2e, 0x76, 0x29, 0xee,
-		0xb0, 0x2b, 0x0f, 0x82, 0x3a, 0x17, 0xe7, 0x44, 0x38, 0xeb, 0x50, 0xe1, 0x54, 0xf0, 0xb6, 0xd5,
-		0xf4, 0x1c, 0x46, 0xda, 0x7a, 0xe2, 0xcc, 0xf7, 0x99, 0xe0, 0x82, 0xb7, 0xb7, 0x55, 0x9c, 0x39,
-		0xc6, 0x3b, 0x0f, 0xe8, 0x16, 0x14, 0x0e, 0x28, 0xb6, 0x29, 0xd7, 0xaf, 0xfe, 0xe5, 0xc4, 0xf4,
-		0xa7, 0x2a, 0xc4, 0xd4, 0xa1, 0xe8, 0x36, 0x4c, 0x45, 0x22, 0xe9, 0x78, 0x04, 0x3b, 0x96, 0xcd,
-		0xfc, 0x26, 0x16, 0xe4, 0x40, 0xbd, 0x82, 0x25, 0xf3, 0xbc, 0xfe, 0xf4, 0x99, 0xfc, 0xf0, 0xb1,
-		0xfe, 0xac, 0xfa, 0xb5, 0x01, 0xb3, 0x69, 0xb6, 0x15, 0xcd, 0x40, 0x29, 0x74, 0x26, 0xf1, 0x2b,
-		0x50, 0x54, 0xcf, 0x9b, 0x36, 0x7a, 0x06, 0x17, 0xe2, 0x1a, 0xec, 0x31, 0xde, 0x29, 0xc1, 0xd0,
-		0xa0, 0x7b, 0x43, 0xba, 0x04, 0x1b, 0x8c, 0x47, 0x15, 0xa8, 0x12, 0x58, 0xcc, 0x61, 0x59, 0xd1,
-		0x6d, 0x28, 0x70, 0xea, 0x07, 0x8e, 0xd0, 0xdf, 0x10, 0xd2, 0x3b, 0x5c, 0xc7, 0x56, 0x31, 0x5c,
-		0xcf, 0x68, 0x38, 0xd1, 0x1d, 0x28, 0x4a, 0xc3, 0x19, 0x70, 0x9a, 0xba, 0xc3, 0x46, 0x18, 0x63,
-		0x46, 0xc1, 0xd5, 0x2d, 0x58, 0xcc, 0xe1, 0x17, 0x07, 0x4e, 0x99, 0xea, 0x3d, 0xb8, 0x92, 0x6a,
-		0xf2, 0x52, 0x2a, 0x54, 0x25, 0x70, 0x23, 0xb3, 0x27, 0x93, 0x07, 0xb6, 0xa9, 0xc0, 0xcc, 0xf1,
-		0x33, 0x5d, 0x69, 0x14, 0x5c, 0xfd, 0xcb, 0x80, 0xbb, 0xa7, 0xf5, 0x50, 0x5d, 0xb3, 0xcf, 0x38,
-		0x36, 0xfb, 0x5e, 0x01, 0x3a, 0xa9, 0x8e, 0xba, 0xb1, 0x16, 0x12, 0x79, 0x9d, 0xd8, 0xcd, 0x9c,
-		0x68, 0xf5, 0x2e, 0xa1, 0x69, 0x28, 0x4a, 0xaf, 0xc1, 0x3d, 0x47, 0xcd, 0xda, 0x8a, 0x19, 0x3d,
-		0xa2, 0x1a, 0x4c, 0xf6, 0x58, 0x09, 0xcf, 0x75, 0xda, 0x6a, 0xec, 0x96, 0xcc, 0x09, 0xd2, 0x2d,
-		0xf3, 0x2f, 0x5c, 0xa7, 0x5d, 0x7d, 0x6b, 0xc0, 0xd5, 0x74, 0x0b, 0x26, 0x4b, 0xab, 0xbd, 0x9d,
-		0x8b, 0x1b, 0x34, 0x2a, 0x6d, 0xb8, 0xb4, 0x85, 0x1b, 0xb4, 0xfb, 0xc6, 0x87, 0x72, 0xdc, 0x78,
-		0xd7, 0x7c, 0x18, 0xce, 0x3c, 0x1f, 0xaa, 0x6f, 0x4b, 0xb0, 0x9c, 0xd7, 0x9b, 0x49, 0x89, 0x8b,
-		0xef, 0x43, 0x49, 0x9c, 0x91, 0x22, 0x71, 0x11, 0x60, 0x28, 0x71, 0xad, 0xae, 0xa7, 0xe3, 0x52,
-		0x36, 0x74, 0x4a, 0x29, 0x1b, 0xce, 0x2e, 0x65, 0x18, 0xe6, 0x3b, 0x9e, 0xaa, 0x8f, 0x50, 0x8c,
-		0x0c, 0x9a, 0x52, 0xb3, 0x31, 0xc4, 0xcb, 0x04, 0xc5, 0x78, 0x0d, 0x97, 0xd5, 0x91, 0xfa, 0xa0,
-		0x8f, 0x0e, 0x42, 0xbf, 0x28, 0xb3, 0x93, 0x80, 0x5f, 0xc0, 0xd4, 0x2e, 0x26, 0x87, 0xde, 0xde,
-		0x9e, 0xc6, 0x66, 0xae, 0xa0, 0xfc, 0x08, 0x3b, 0x83, 0x35, 0xf8, 0xbc, 0x4e, 0x54, 0xb0, 0x9b,
-		0x3a, 0xed, 0x84, 0x26, 0x15, 0x4f, 0xa3, 0x49, 0x9b, 0x50, 0x66, 0x2e, 0x13, 0x0c, 0x0b, 0x8f,
-		0x2b, 0x8d, 0x3d, 0xb3, 0xba, 0x38, 0xd8, 0xff, 0x6f, 0x46, 0x29, 0x66, 0x27, 0xbb, 0x7b, 0xb2,
-		0x96, 0x73, 0x4c, 0x56, 0x64, 0xc2, 0x94, 0x83, 0xe5, 0x77, 0xc0, 0x50, 0x26, 0x64, 0x69, 0xb5,
-		0x04, 0x40, 0x86, 0xce, 0x38, 0x2f, 0x73, 0xd7, 0xe3, 0x54, 0x53, 0x65, 0xa2, 0x7f, 0xc3, 0x38,
-		0xe1, 0xb2, 0x47, 0xb4, 0xcd, 0x50, 0x82, 0x5d, 0x36, 0x2b, 0x72, 0x31, 0xf2, 0x89, 0xa7, 0xd3,
-		0xe3, 0x25, 0x18, 0x69, 0xd0, 0x86, 0xa7, 0x0d, 0xf0, 0x4c, 0x62, 0xca, 0x73, 0xda, 0xf0, 0x4c,
-		0x15, 0x86, 0x4c, 0x98, 0x38, 0x61, 0xa8, 0xa7, 0xcf, 0xa8, 0xdc, 0xff, 0x24, 0x3b, 0xff, 0x1e,
-		0xeb, 0x6b, 0x9e, 0xf3, 0x7b, 0x56, 0xd0, 0x03, 0xa8, 0x7c, 0xca, 0x84, 0xa0, 0x3c, 0x6c, 0xa4,
-		0xe9, 0xb3, 0x83, 0xfa, 0x67, 0x2c, 0x0c, 0x57, 0xed, 0x53, 0xfd, 0xb3, 0x08, 0x4b, 0xb9, 0xbe,
-		0x14, 0xf5, 0x1d, 0xe6, 0x73, 0x30, 0x16, 0x4f, 0x11, 0x66, 0xab, 0xf7, 0xbf, 0x6c, 0x42, 0xb4,
-		0x14, 0x3a, 0xe9, 0xe3, 0x63, 0x66, 0xf8, 0x3d, 0x8c, 0x99, 0x0f, 0xe0, 0x98, 0xb3, 0x8c, 0x99,
-		0xc2, 0x3f, 0x3a, 0x66, 0x8a, 0xa7, 0x1e, 0x33, 0x1f, 0xc1, 0x64, 0x13, 0x73, 0xea, 0x0a, 0x8d,
-		0xa8, 0x87, 0x43, 0xf8, 0x6a, 0x2f, 0xf4, 0x39, 0xbd, 0x8c, 0x57, 0x28, 0x7a, 0x44, 0x4c, 0x34,
-		0x7b, 0x97, 0xba, 0x25, 0xb6, 0x7c, 0x5c, 0x62, 0x09, 0x4c, 0x77, 0xb5, 0x81, 0xc5, 0x69, 0xd0,
-		0xd9, 0x16, 0xd4, 0xb6, 0x37, 0x53, 0x0b, 0xbe, 0x69, 0x9b, 0x32, 0x45, 0x6f, 0x7d, 0xa1, 0x95,
-		0xb4, 0xfc, 0x7e, 0x0c, 0xf8, 0x89, 0xa9, 0x50, 0x49, 0x9d, 0x0a, 0xe3, 0xf9, 0xa7, 0xc2, 0x99,
-		0x77, 0x98, 0x0a, 0x67, 0xdf, 0x69, 0x2a, 0x54, 0x7f, 0x1f, 0x82, 0x95, 0xdc, 0xff, 0x36, 0xf8,
-		0xd0, 0x46, 0x6d, 0x0e, 0xc6, 0xf4, 0x7f, 0x4b, 0x94, 0x77, 0x0a, 0xbf, 0x18, 0x43, 0xb8, 0xa4,
-		0xbc, 0x53, 0xfc, 0xba, 0x8e, 0x64, 0x7f, 0x5d, 0xbb, 0x5a, 0x73, 0x34, 0x93, 0xfb, 0x2b, 0xf4,
-		0x73, 0x7f, 0x5f, 0x19, 0xb0, 0x9c, 0xf7, 0xbf, 0x17, 0xc9, 0xc5, 0x34, 0xde, 0xa9, 0x98, 0x6b,
-		0x9f, 0xc0, 0x45, 0xe2, 0x35, 0x92, 0xb2, 0xd7, 0xc6, 0x23, 0x0a, 0xdb, 0x72, 0x28, 0x6c, 0x1b,
-		0x1f, 0xaf, 0xec, 0x33, 0x71, 0x10, 0xec, 0xd6, 0x88, 0xd7, 0xa8, 0x77, 0xff, 0x6a, 0xb3, 0xc4,
-		0x6c, 0xa7, 0xbe, 0xef, 0x85, 0x3f, 0x14, 0xe9, 0x9f, 0x70, 0xee, 0xe3, 0x26, 0x3b, 0x5a, 0xd9,
-		0x2d, 0xa8, 0xb5, 0x5b, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x7f, 0xc1, 0xe0, 0x1d, 0x85, 0x1a,
-		0x00, 0x00,
+		0xf2, 0x08, 0x59, 0xe4, 0x49, 0xf2, 0x02, 0x51, 0x57, 0x57, 0xf7, 0x8c, 0xc7, 0x3d, 0x3d, 0xdd,
+		0x86, 0xb0, 0x73, 0xd7, 0x9c, 0xf3, 0xd5, 0x57, 0x75, 0x4e, 0x9f, 0xef, 0xf3, 0x0c, 0x54, 0xfd,
+		0x5d, 0x2a, 0xea, 0x04, 0xdb, 0xd4, 0x25, 0xb4, 0x8e, 0x9b, 0xac, 0x7e, 0xb4, 0x52, 0xb7, 0x29,
+		0x61, 0x1e, 0xe3, 0x6e, 0xad, 0x29, 0xb8, 0xe4, 0x68, 0x32, 0x88, 0xa9, 0xe9, 0x98, 0x1a, 0x6e,
+		0xb2, 0xda, 0xd1, 0xca, 0xa5, 0xab, 0xfb, 0x9c, 0xef, 0x3b, 0xb4, 0xae, 0x42, 0x76, 0xfd, 0xbd,
+		0xba, 0xed, 0x0b, 0x2c, 0xe3, 0xa4, 0x4b, 0xf3, 0x49, 0xc0, 0x84, 0x37, 0x1a, 0x71, 0x44, 0xe2,
+		0xd6, 0x12, 0x7b, 0x87, 0x0e, 0xf3, 0x64, 0x5a, 0x4c, 0x8b, 0x8b, 0xc3, 0x3d, 0x87, 0xb7, 0xc2,
+		0x98, 0xea, 0x37, 0x13, 0x50, 0x7a, 0xac, 0x19, 0xa3, 0xef, 0x0c, 0xb8, 0xe9, 0x91, 0x03, 0x6a,
+		0xfb, 0x0e, 0xb5, 0x30, 0x91, 0xec, 0x88, 0xc9, 0xb6, 0x15, 0xa0, 0x5a, 0xd1, 0xa9, 0x2c, 0x2c,
+		0xa5, 0x60, 0xbb, 0xbe, 0xa4, 0xde, 0xb4, 0x31, 0x6f, 0xfc, 0x77, 0x6c, 0xf5, 0x7e, 0x2d, 0xe1,
+		0x84, 0xb5, 0x97, 0x1a, 0xe6, 0x91, 0x46, 0xd9, 0xc1, 0xde, 0x61, 0xb4, 0xcf, 0xa3, 0x18, 0xe2,
+		0xe9, 0xbf, 0xcc, 0x05, 0x2f, 0x53, 0x24, 0xfa, 0x1c, 0xe6, 0x3c, 0x89, 0x85, 0xb4, 0x24, 0x6b,
+		0x50, 0x91, 0xc8, 0x67, 0x48, 0xf1, 0x59, 0x49, 0xe6, 0x13, 0xe4, 0xee, 0x04, 0xa9, 0x89, 0x2c,
+		0x66, 0xbd, 0x94, 0xcf, 0xd1, 0x8f, 0x06, 0x04, 0xb7, 0xdf, 0x74, 0xa8, 0xa4, 0x56, 0x74, 0x81,
+		0x16, 0x7d, 0x43, 0x89, 0x1f, 0x14, 0x2d, 0x91, 0xcc, 0xb0, 0x22, 0xf3, 0xff, 0x44, 0x32, 0xeb,
+		0x1a, 0xeb, 0xb5, 0x86, 0x7a, 0x12, 0x21, 0x25, 0x72, 0x5b, 0x24, 0xd9, 0xc3, 0xd1, 0xf7, 0x06,
+		0x2c, 0xee, 0x61, 0xe6, 0x64, 0xa5, 0x39, 0xa2, 0x68, 0x3e, 0x48, 0xa4, 0xb9, 0x81, 0x99, 0x93,
+		0x8d, 0xe2, 0xf5, 0xbd, 0x6c, 0xa1, 0xe8, 0x27, 0x03, 0x96, 0x05, 0xfd, 0xcc, 0xa7, 0x9e, 0xb4,
+		0x08, 0x76, 0x09, 0x75, 0x32, 0xf4, 0xd9, 0x68, 0xca, 0x55, 0x9a, 0x21, 0xd8, 0xba, 0xc2, 0x1a,
+		0xd8, 0x6c, 0x8b, 0x22, 0x7b, 0x38, 0xfa, 0x02, 0xe6, 0x35, 0xc5, 0xfe, 0x2d, 0x57, 0x50, 0xd4,
+		0x56, 0x93, 0xab, 0xac, 0x92, 0xfb, 0xf7, 0xdc, 0x15, 0x92, 0x16, 0x80, 0x7e, 0x30, 0x60, 0x49,
+		0xef, 0x9f, 0xb1, 0x96, 0x45, 0x45, 0xe6, 0x61, 0x0a, 0x99, 0x6c, 0xd5, 0xbc, 0x41, 0xb2, 0x06,
+		0xa3, 0x3f, 0x0c, 0x78, 0xd8, 0x53, 0x4f, 0xfa, 0x46, 0x52, 0xe1, 0xe2, 0xcc, 0xac, 0x4b, 0x8a,
+		0xf5, 0xf3, 0xc1, 0xd5, 0x7d, 0xa2, 0x81, 0xb3, 0x1d, 0xe2, 0xae, 0x38, 0x65, 0x2e, 0xfa, 0xd2,
+		0x80, 0x6b, 0x82, 0x12, 0x2e, 0x6c, 0xab, 0x81, 0xc5, 0x61, 0x9f, 0xca, 0x97, 0x15, 0xed, 0x5b,
+		0x7d, 0x68, 0x07, 0xd9, 0xcf, 0x55, 0x72, 0x22, 0xb9, 0xab, 0x22, 0x35, 0x02, 0xfd, 0x6a, 0xc0,
+		0x1d, 0xc2, 0x5d, 0xc9, 0x5c, 0x9f, 0x5a, 0xd8, 0xb3, 0x5c, 0xda, 0xca, 0x7a, 0x9d, 0xa0, 0x78,
+		0x3d, 0xe9, 0x33, 0x77, 0x42, 0xc8, 0x47, 0xde, 0x16, 0x6d, 0x65, 0xbb, 0xc6, 0x65, 0x92, 0x33,
+		0x07, 0xfd, 0x6c, 0xc0, 0x6a, 0x38, 0xa9, 0xc9, 0x01, 0x73, 0xec, 0xac, 0xbc, 0xc7, 0x14, 0xef,
+		0xb5, 0xfe, 0xc3, 0x7b, 0x3d, 0x40, 0xcb, 0x46, 0x7a, 0xc9, 0xcb, 0x93, 0x80, 0x7e, 0x33, 0xe0,
+		0x8e, 0xc7, 0xf6, 0x5d, 0x9c, 0xbf, 0x79, 0x2b, 0x8a, 0xf5, 0x46, 0x32, 0x6b, 0x05, 0x99, 0xaf,
+		0x6b, 0x57, 0xbc, 0xbc, 0x49, 0xe8, 0x17, 0x03, 0xfe, 0xe7, 0x37, 0x3d, 0x2a, 0x64, 0x87, 0xb4,
+		0x47, 0xb1, 0x20, 0x07, 0x5d, 0x44, 0x13, 0xc9, 0x8f, 0xa7, 0xb4, 0xca, 0x2b, 0x85, 0x18, 0xed,
+		0xff, 0x52, 0xe1, 0x75, 0x36, 0x4d, 0x6e, 0x15, 0x3f, 0x67, 0xce, 0x5a, 0x05, 0xa0, 0x43, 0xa7,
+		0xfa, 0x6d, 0x01, 0x16, 0xb2, 0xd9, 0x06, 0x34, 0x07, 0x63, 0xb1, 0x6c, 0x30, 0x5b, 0x19, 0x91,
+		0xb2, 0x09, 0xd1, 0xd2, 0xa6, 0x8d, 0x36, 0x60, 0x3c, 0x0e, 0x90, 0xed, 0x26, 0xd5, 0xde, 0xe0,
+		0x5a, 0xe2, 0x59, 0xe3, 0xcd, 0xda, 0x4d, 0x6a, 0x56, 0x70, 0xd7, 0x13, 0x9a, 0x82, 0x82, 0xcd,
+		0x1b, 0x98, 0xb9, 0x4a, 0xcf, 0xcb, 0xa6, 0x7e, 0x42, 0xf7, 0xa0, 0xac, 0xe4, 0x2a, 0x70, 0x5b,
+		0x5a, 0x43, 0xaf, 0x24, 0x62, 0x07, 0x07, 0x78, 0xc6, 0x3c, 0x69, 0x96, 0xa4, 0xfe, 0x0b, 0xad,
+		0xc2, 0x28, 0x73, 0x9b, 0xbe, 0xd4, 0xba, 0x36, 0x9b, 0x98, 0xb7, 0x8d, 0xdb, 0x0e, 0xc7, 0xb6,
+		0x19, 0x86, 0xa2, 0x1d, 0x98, 0x89, 0x8d, 0x99, 0xe4, 0x16, 0x71, 0xb8, 0x47, 0x95, 0x2c, 0x71,
+		0x5f, 0x6a, 0x11, 0x9a, 0xa9, 0x85, 0xa6, 0xb2, 0x16, 0x99, 0xca, 0xda, 0x63, 0x6d, 0x2a, 0xcd,
+		0xa9, 0x28, 0x77, 0x87, 0xaf, 0x07, 0x99, 0x3b, 0x61, 0x62, 0x2f, 0x6a, 0xc7, 0x5f, 0x05, 0xa8,
+		0xc5, 0x1c, 0xa8, 0xb1, 0xbb, 0x0a, 0x50, 0xb7, 0x60, 0x4a, 0x23, 0xf5, 0x12, 0x2d, 0x0d, 0x82,
+		0x9c, 0x0c, 0x6d, 0xd8, 0x71, 0x96, 0x1b, 0x30, 0x71, 0x40, 0xb1, 0x90, 0xbb, 0x14, 0x77, 0xd8,
+		0x95, 0x07, 0x41, 0x9d, 0x8b, 0x73, 0x22, 0x9c, 0x75, 0xa8, 0x08, 0x2a, 0x45, 0xdb, 0x6a, 0x72,
+		0x87, 0x91, 0xb6, 0x9e, 0x38, 0xf3, 0x7d, 0x26, 0xb8, 0x14, 0xed, 0x6d, 0x15, 0x67, 0x8e, 0x89,
+		0xce, 0x03, 0xba, 0x05, 0x85, 0x03, 0x8a, 0x6d, 0x2a, 0xf4, 0xab, 0x7f, 0x39, 0x31, 0xfd, 0xa9,
+		0x0a, 0x31, 0x75, 0x28, 0xba, 0x0d, 0x53, 0x91, 0x48, 0x3a, 0x9c, 0x60, 0xc7, 0xb2, 0x99, 0xd7,
+		0xc4, 0x92, 0x1c, 0xa8, 0x57, 0xb0, 0x64, 0x9e, 0xd7, 0x9f, 0x3e, 0x0b, 0x3e, 0x7c, 0xac, 0x3f,
+		0xab, 0x7e, 0x6d, 0xc0, 0x6c, 0x9a, 0x6d, 0x45, 0x33, 0x50, 0x0a, 0x9d, 0x49, 0xfc, 0x0a, 0x14,
+		0xd5, 0xf3, 0xa6, 0x8d, 0x9e, 0xc1, 0x85, 0xb8, 0x06, 0x7b, 0x4c, 0x74, 0x4a, 0x30, 0x34, 0xe8,
+		0xde, 0x90, 0x2e, 0xc1, 0x06, 0x13, 0x51, 0x05, 0xaa, 0x04, 0x16, 0x73, 0x58, 0x56, 0x74, 0x1b,
+		0x0a, 0x82, 0x7a, 0xbe, 0x23, 0xa7, 0x8d, 0x0c, 0x1d, 0xae, 0x63, 0xab, 0x18, 0xae, 0x67, 0x34,
+		0x9c, 0xe8, 0x0e, 0x14, 0x03, 0xc3, 0xe9, 0x0b, 0x9a, 0xba, 0xc3, 0x46, 0x18, 0x63, 0x46, 0xc1,
+		0xd5, 0x2d, 0x58, 0xcc, 0xe1, 0x17, 0x07, 0x4e, 0x99, 0xea, 0x3d, 0xb8, 0x92, 0x6a, 0xf2, 0x52,
+		0x2a, 0x54, 0x25, 0x70, 0x23, 0xb3, 0x27, 0x0b, 0x0e, 0x6c, 0x53, 0x89, 0x99, 0xe3, 0x65, 0xba,
+		0xd2, 0x28, 0xb8, 0xfa, 0x97, 0x01, 0x77, 0x4f, 0xeb, 0xa1, 0xba, 0x66, 0x9f, 0x71, 0x6c, 0xf6,
+		0xbd, 0x02, 0x74, 0x52, 0x1d, 0x75, 0x63, 0x2d, 0x24, 0xf2, 0x3a, 0xb1, 0x9b, 0x39, 0xd1, 0xea,
+		0x5d, 0x42, 0xd3, 0x50, 0x24, 0xdc, 0x95, 0x82, 0x3b, 0x6a, 0xd6, 0x56, 0xcc, 0xe8, 0x11, 0xd5,
+		0x60, 0xb2, 0xc7, 0x4a, 0x70, 0xd7, 0x69, 0xab, 0xb1, 0x5b, 0x32, 0x27, 0x48, 0xb7, 0xcc, 0xbf,
+		0x70, 0x9d, 0x76, 0xf5, 0xad, 0x01, 0x57, 0xd3, 0x2d, 0x58, 0x50, 0x5a, 0xed, 0xed, 0x5c, 0xdc,
+		0xa0, 0x51, 0x69, 0xc3, 0xa5, 0x2d, 0xdc, 0xa0, 0xdd, 0x37, 0x3e, 0x94, 0xe3, 0xc6, 0xbb, 0xe6,
+		0xc3, 0x70, 0xe6, 0xf9, 0x50, 0x7d, 0x5b, 0x82, 0xe5, 0xbc, 0xde, 0x2c, 0x90, 0xb8, 0xf8, 0x3e,
+		0x94, 0xc4, 0x19, 0x29, 0x12, 0x17, 0x01, 0x86, 0x12, 0xd7, 0xea, 0x7a, 0x3a, 0x2e, 0x65, 0x43,
+		0xa7, 0x94, 0xb2, 0xe1, 0xec, 0x52, 0x86, 0x61, 0xbe, 0xe3, 0xa9, 0xfa, 0x08, 0xc5, 0xc8, 0xa0,
+		0x29, 0x35, 0x1b, 0x43, 0xbc, 0x4c, 0x50, 0x8c, 0xd7, 0x70, 0x59, 0x1d, 0xa9, 0x0f, 0xfa, 0xe8,
+		0x20, 0xf4, 0x8b, 0x41, 0x76, 0x12, 0xf0, 0x0b, 0x98, 0xda, 0xc5, 0xe4, 0x90, 0xef, 0xed, 0x69,
+		0x6c, 0xe6, 0x4a, 0x2a, 0x8e, 0xb0, 0x33, 0x58, 0x83, 0xcf, 0xeb, 0x44, 0x05, 0xbb, 0xa9, 0xd3,
+		0x4e, 0x68, 0x52, 0xf1, 0x34, 0x9a, 0xb4, 0x09, 0x65, 0xe6, 0x32, 0xc9, 0xb0, 0xe4, 0x42, 0x69,
+		0xec, 0x99, 0xd5, 0xc5, 0xc1, 0xfe, 0x7f, 0x33, 0x4a, 0x31, 0x3b, 0xd9, 0xdd, 0x93, 0xb5, 0x9c,
+		0x63, 0xb2, 0x22, 0x13, 0xa6, 0x1c, 0x1c, 0xfc, 0x0f, 0x18, 0xca, 0x44, 0x50, 0x5a, 0x2d, 0x01,
+		0x90, 0xa1, 0x33, 0xce, 0x07, 0xb9, 0xeb, 0x71, 0xaa, 0xa9, 0x32, 0xd1, 0xbf, 0x61, 0x9c, 0x88,
+		0xa0, 0x47, 0xb4, 0xcd, 0x50, 0x82, 0x5d, 0x36, 0x2b, 0xc1, 0x62, 0xe4, 0x13, 0x4f, 0xa7, 0xc7,
+		0x4b, 0x30, 0xd2, 0xa0, 0x0d, 0xae, 0x0d, 0xf0, 0x4c, 0x62, 0xca, 0x73, 0xda, 0xe0, 0xa6, 0x0a,
+		0x43, 0x26, 0x4c, 0x9c, 0x30, 0xd4, 0xd3, 0x67, 0x54, 0xee, 0x7f, 0x92, 0x9d, 0x7f, 0x8f, 0xf5,
+		0x35, 0xcf, 0x79, 0x3d, 0x2b, 0xe8, 0x01, 0x54, 0x3e, 0x65, 0x52, 0x52, 0x11, 0x36, 0xd2, 0xf4,
+		0xd9, 0x41, 0xfd, 0x33, 0x16, 0x86, 0xab, 0xf6, 0xa9, 0xfe, 0x59, 0x84, 0xa5, 0x5c, 0xff, 0x14,
+		0xf5, 0x1d, 0xe6, 0x73, 0x30, 0x16, 0x4f, 0x11, 0x66, 0xab, 0xf7, 0xbf, 0x6c, 0x42, 0xb4, 0x14,
+		0x3a, 0xe9, 0xe3, 0x63, 0x66, 0xf8, 0x3d, 0x8c, 0x99, 0x0f, 0xe0, 0x98, 0xb3, 0x8c, 0x99, 0xc2,
+		0x3f, 0x3a, 0x66, 0x8a, 0xa7, 0x1e, 0x33, 0x1f, 0xc1, 0x64, 0x13, 0x0b, 0xea, 0x4a, 0x8d, 0xa8,
+		0x87, 0x43, 0xf8, 0x6a, 0x2f, 0xf4, 0x39, 0x7d, 0x10, 0xaf, 0x50, 0xf4, 0x88, 0x98, 0x68, 0xf6,
+		0x2e, 0x75, 0x4b, 0x6c, 0xf9, 0xb8, 0xc4, 0x12, 0x98, 0xee, 0x6a, 0x03, 0x4b, 0x50, 0xbf, 0xb3,
+		0x2d, 0xa8, 0x6d, 0x6f, 0xa6, 0x16, 0x7c, 0xd3, 0x36, 0xa9, 0x1f, 0xed, 0x63, 0x5e, 0x68, 0x25,
+		0x2d, 0xbf, 0x1f, 0x03, 0x7e, 0x62, 0x2a, 0x54, 0x52, 0xa7, 0xc2, 0x78, 0xfe, 0xa9, 0x70, 0xe6,
+		0x1d, 0xa6, 0xc2, 0xd9, 0x77, 0x9a, 0x0a, 0xd5, 0xdf, 0x87, 0x60, 0x25, 0xf7, 0xd7, 0x06, 0x1f,
+		0xda, 0xa8, 0xcd, 0xc1, 0x98, 0xfe, 0xb6, 0x44, 0x79, 0xa7, 0xf0, 0x1f, 0x63, 0x08, 0x97, 0x94,
+		0x77, 0x8a, 0x5f, 0xd7, 0x91, 0xec, 0xaf, 0x6b, 0x57, 0x6b, 0x8e, 0x66, 0x72, 0x7f, 0x85, 0x7e,
+		0xee, 0xef, 0x2b, 0x03, 0x96, 0xf3, 0x7e, 0x7b, 0x91, 0x5c, 0x4c, 0xe3, 0x9d, 0x8a, 0xb9, 0xf6,
+		0x09, 0x5c, 0x24, 0xbc, 0x91, 0x94, 0xbd, 0x36, 0x1e, 0x51, 0xd8, 0x16, 0x5c, 0xf2, 0x6d, 0xe3,
+		0xe3, 0x95, 0x7d, 0x26, 0x0f, 0xfc, 0xdd, 0x1a, 0xe1, 0x8d, 0x7a, 0xf7, 0xaf, 0x36, 0x4b, 0xcc,
+		0x76, 0xea, 0xfb, 0x3c, 0xfc, 0xa1, 0x48, 0xff, 0x84, 0x73, 0x1f, 0x37, 0xd9, 0xd1, 0xca, 0x6e,
+		0x41, 0xad, 0xdd, 0xfa, 0x7b, 0x00, 0x7f, 0xc1, 0xe0, 0x1d, 0x85, 0x1a, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/history.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0x57,
-		0xf5, 0xef, 0xec, 0xda, 0x6b, 0xef, 0x59, 0xc7, 0xb1, 0x6f, 0x1c, 0xc7, 0xce, 0xa7, 0x33, 0x49,
-		0x13, 0xd7, 0x71, 0xd6, 0x89, 0x93, 0x26, 0x4d, 0xd2, 0x8f, 0xbf, 0xed, 0xd8, 0xea, 0x4a, 0xfe,
-		0x27, 0xf9, 0x4f, 0x9c, 0xf4, 0x0f, 0xaa, 0xb4, 0x8c, 0x77, 0xae, 0xe3, 0xc1, 0xbb, 0x33, 0xdb,
-		0x99, 0xbb, 0xde, 0x18, 0xc1, 0x13, 0x0f, 0x48, 0x88, 0xaa, 0x54, 0x15, 0x12, 0x95, 0x40, 0x20,
-		0x24, 0x10, 0x05, 0xa4, 0x22, 0x10, 0xe2, 0xeb, 0x05, 0x90, 0x50, 0x91, 0x40, 0x85, 0x27, 0x5e,
-		0xfa, 0x8a, 0x10, 0x7d, 0xe3, 0x81, 0xf2, 0x86, 0x84, 0xe6, 0xce, 0x9d, 0xdd, 0x9d, 0xd9, 0x7b,
-		0x67, 0xee, 0xac, 0x9d, 0x16, 0xd4, 0xbc, 0x79, 0xee, 0x9c, 0x73, 0xe6, 0x77, 0xef, 0xf9, 0xb8,
-		0xe7, 0xde, 0x73, 0xd6, 0x70, 0xb2, 0xb1, 0x8e, 0x9d, 0xb9, 0x8a, 0x6e, 0x60, 0xab, 0x82, 0xe7,
-		0xf4, 0xba, 0x39, 0xb7, 0x7d, 0x71, 0x6e, 0xd3, 0x74, 0x89, 0xed, 0xec, 0x14, 0xeb, 0x8e, 0x4d,
-		0x6c, 0x74, 0xc0, 0x23, 0x29, 0x32, 0x92, 0xa2, 0x5e, 0x37, 0x8b, 0xdb, 0x17, 0x0f, 0x1f, 0x7f,
-		0x60, 0xdb, 0x0f, 0xaa, 0x78, 0x8e, 0x92, 0xac, 0x37, 0x36, 0xe6, 0x8c, 0x86, 0xa3, 0x13, 0xd3,
-		0xb6, 0x7c, 0xa6, 0xc3, 0x27, 0xa2, 0xef, 0x89, 0x59, 0xc3, 0x2e, 0xd1, 0x6b, 0x75, 0x46, 0x30,
-		0xc5, 0xfb, 0x70, 0xc5, 0xae, 0xd5, 0x5a, 0x22, 0x54, 0x1e, 0x05, 0xd1, 0xdd, 0xad, 0xaa, 0xe9,
-		0x92, 0x38, 0x9a, 0xa6, 0xed, 0x6c, 0x6d, 0x54, 0xed, 0xa6, 0x4f, 0xa3, 0xde, 0x84, 0x81, 0x17,
-		0xfd, 0x09, 0xa1, 0x6b, 0x90, 0xc3, 0xdb, 0xd8, 0x22, 0xee, 0x84, 0x32, 0x95, 0x9d, 0x2e, 0xcc,
-		0x9f, 0x2c, 0x72, 0xe6, 0x56, 0x64, 0xd4, 0xcb, 0x1e, 0xa5, 0xc6, 0x18, 0xd4, 0xf7, 0xaf, 0xc2,
-		0x50, 0xe7, 0x0b, 0x34, 0x09, 0x83, 0xf4, 0x55, 0xd9, 0x34, 0x26, 0x94, 0x29, 0x65, 0x3a, 0xab,
-		0x0d, 0xd0, 0xe7, 0x92, 0x81, 0xae, 0x01, 0xf8, 0xaf, 0xbc, 0x49, 0x4f, 0x64, 0xa6, 0x94, 0xe9,
-		0xc2, 0xfc, 0xe1, 0xa2, 0xbf, 0x22, 0xc5, 0x60, 0x45, 0x8a, 0x6b, 0xc1, 0x8a, 0x68, 0x79, 0x4a,
-		0xed, 0x3d, 0xa3, 0x09, 0x18, 0xd8, 0xc6, 0x8e, 0x6b, 0xda, 0xd6, 0x44, 0xd6, 0x17, 0xca, 0x1e,
-		0xd1, 0x21, 0x18, 0xf0, 0x26, 0xef, 0x7d, 0xae, 0x8f, 0xbe, 0xc9, 0x79, 0x8f, 0x25, 0x03, 0x7d,
-		0x4d, 0x81, 0x73, 0xc1, 0x94, 0xcb, 0xf8, 0x21, 0xae, 0x34, 0x3c, 0x3d, 0x94, 0x5d, 0xa2, 0x3b,
-		0x04, 0x1b, 0x65, 0x1f, 0x89, 0x4e, 0x88, 0x63, 0xae, 0x37, 0x08, 0x76, 0x27, 0xfa, 0x29, 0x9e,
-		0x67, 0xb9, 0x53, 0x7f, 0x89, 0xc9, 0x59, 0x0e, 0xc4, 0xdc, 0xf5, 0xa5, 0xd0, 0x29, 0x2f, 0xb4,
-		0x64, 0xbc, 0xf8, 0x84, 0x76, 0xb6, 0x29, 0x47, 0x8a, 0xbe, 0xa5, 0xc0, 0x79, 0x0e, 0xbc, 0x8a,
-		0x5d, 0xab, 0x57, 0x31, 0x17, 0x60, 0x8e, 0x02, 0x7c, 0x5e, 0x0e, 0xe0, 0x52, 0x20, 0xa7, 0x1b,
-		0xe2, 0x53, 0x4d, 0x59, 0x62, 0xf4, 0xa6, 0x02, 0x33, 0x1c, 0x90, 0x1b, 0xba, 0x59, 0xe5, 0x21,
-		0x1c, 0xa0, 0x08, 0x6f, 0xc8, 0x21, 0x5c, 0xa1, 0x42, 0xba, 0xe1, 0x9d, 0x69, 0x4a, 0x51, 0xa2,
-		0x6f, 0xf2, 0x17, 0xd0, 0xb3, 0x2d, 0xa3, 0x6c, 0x37, 0x48, 0x37, 0xbc, 0x41, 0x0a, 0xef, 0x39,
-		0x39, 0x78, 0x9e, 0xd9, 0x19, 0xb7, 0x1b, 0xa4, 0x1b, 0xe0, 0x74, 0x53, 0x92, 0x16, 0xbd, 0xa1,
-		0xc0, 0xb4, 0x81, 0x2b, 0xa6, 0x4b, 0x81, 0x79, 0x56, 0xea, 0x56, 0x36, 0xb1, 0xd1, 0xe0, 0x2e,
-		0x5e, 0x9e, 0xa2, 0xbb, 0xc6, 0x45, 0x77, 0x93, 0x09, 0x59, 0xd3, 0xdd, 0xad, 0xbb, 0x81, 0x88,
-		0x6e, 0x64, 0xa7, 0x0d, 0x09, 0x3a, 0xf4, 0xaa, 0x02, 0x67, 0x22, 0xa8, 0x44, 0x3e, 0x01, 0x14,
-		0xd3, 0xd5, 0x64, 0x4c, 0x22, 0x77, 0x50, 0x8d, 0x44, 0x2a, 0xce, 0x2a, 0xc5, 0x38, 0x41, 0x41,
-		0x72, 0x95, 0x62, 0xec, 0x3f, 0xb4, 0x4a, 0x42, 0xd3, 0x7f, 0xbd, 0x0b, 0x55, 0x8c, 0x65, 0x0d,
-		0x51, 0x54, 0xcf, 0x24, 0xa2, 0x12, 0x1b, 0xd5, 0x29, 0x23, 0x99, 0x0c, 0x7d, 0x51, 0x81, 0x27,
-		0xc3, 0x98, 0x44, 0x9e, 0xb8, 0x8f, 0x02, 0xba, 0x92, 0x08, 0x48, 0xe4, 0x84, 0x27, 0x8d, 0x24,
-		0x22, 0xaa, 0x36, 0xbd, 0x42, 0xcc, 0x6d, 0x93, 0xec, 0x24, 0x1a, 0xf7, 0x70, 0x8c, 0xda, 0x16,
-		0x98, 0x90, 0x24, 0xe3, 0xd6, 0x25, 0xe8, 0xa8, 0x71, 0x47, 0x50, 0x89, 0x8c, 0x7b, 0x7f, 0x8c,
-		0x71, 0x87, 0x30, 0x09, 0x8d, 0x5b, 0x4f, 0xa4, 0xe2, 0xac, 0x52, 0x8c, 0x71, 0x8f, 0x48, 0xae,
-		0x52, 0x9c, 0x71, 0xeb, 0x12, 0x74, 0xd4, 0x90, 0xc2, 0xa8, 0x44, 0x86, 0x34, 0x1a, 0x63, 0x48,
-		0x9d, 0x90, 0x84, 0x86, 0xa4, 0x27, 0x11, 0x51, 0x4f, 0x0b, 0x83, 0x89, 0xf1, 0x34, 0x14, 0xe3,
-		0x69, 0x9d, 0x78, 0x62, 0x3c, 0x4d, 0x4f, 0x26, 0x43, 0x4d, 0x38, 0xee, 0x81, 0x70, 0xc4, 0xd6,
-		0x73, 0x80, 0x02, 0xb9, 0xc0, 0x05, 0xe2, 0x49, 0x75, 0x84, 0x66, 0x73, 0x84, 0x88, 0x5f, 0xa3,
-		0x57, 0xe0, 0xa8, 0xff, 0xe1, 0x0d, 0xd3, 0xe1, 0x7d, 0x76, 0x8c, 0x7e, 0xb6, 0x28, 0xfe, 0xec,
-		0x8a, 0xc7, 0xd7, 0xfd, 0xd1, 0x49, 0x22, 0x7a, 0x89, 0xbe, 0xa3, 0xc0, 0x5c, 0xc4, 0x44, 0x75,
-		0xab, 0x82, 0xab, 0x65, 0x07, 0xbf, 0xd2, 0xc0, 0x2e, 0x77, 0xf6, 0x07, 0x29, 0x8c, 0x17, 0x92,
-		0x2d, 0x95, 0x4a, 0xd2, 0x02, 0x41, 0xdd, 0xb8, 0x66, 0x74, 0x69, 0x6a, 0xf4, 0x63, 0x05, 0x2e,
-		0x33, 0x4c, 0x01, 0x44, 0x39, 0x23, 0x1e, 0xa7, 0x68, 0x97, 0xb8, 0x68, 0xd9, 0xd7, 0xfc, 0x4f,
-		0xcb, 0x58, 0x74, 0xd1, 0x49, 0xc5, 0x81, 0xbe, 0xac, 0xc0, 0x59, 0xde, 0xf2, 0xf2, 0x80, 0x1e,
-		0x92, 0xb4, 0xee, 0x25, 0x26, 0x21, 0xc1, 0xba, 0x05, 0x64, 0xe8, 0x33, 0x70, 0xc2, 0x37, 0x32,
-		0x31, 0x92, 0x09, 0x8a, 0xe4, 0xa2, 0xd8, 0xce, 0xc4, 0x10, 0x7c, 0x03, 0x16, 0x7d, 0xfb, 0x0b,
-		0x0a, 0x9c, 0x66, 0xca, 0x63, 0x86, 0x2e, 0x50, 0xda, 0x24, 0x45, 0xf0, 0x34, 0x17, 0x81, 0x2f,
-		0xdc, 0xb7, 0x77, 0x81, 0x9a, 0xa6, 0x2a, 0x09, 0x34, 0xe8, 0x73, 0x30, 0x55, 0xd3, 0x9d, 0x2d,
-		0xec, 0x94, 0x1d, 0x5c, 0xb1, 0x1d, 0x83, 0x07, 0xe2, 0x30, 0x05, 0x31, 0xcf, 0x05, 0xf1, 0xbf,
-		0x94, 0x59, 0x63, 0xbc, 0xdd, 0x08, 0x8e, 0xd5, 0xe2, 0x08, 0xd0, 0x37, 0x14, 0x98, 0xe5, 0x9d,
-		0x4f, 0xcc, 0x07, 0x96, 0xce, 0x5d, 0x90, 0x23, 0x69, 0xd2, 0xd7, 0xbb, 0x4c, 0x8c, 0x4c, 0xfa,
-		0x2a, 0xa0, 0x45, 0xdf, 0x56, 0xa0, 0xc8, 0xcb, 0xb0, 0xb1, 0x53, 0x33, 0x2d, 0x9d, 0x1b, 0x17,
-		0x8e, 0xc6, 0xc4, 0x85, 0xee, 0x14, 0xbb, 0x25, 0x88, 0x13, 0x17, 0x9a, 0xd2, 0xd4, 0xe8, 0x27,
-		0x0a, 0x5c, 0xe6, 0x1d, 0xa5, 0x12, 0xa3, 0xd8, 0x31, 0x8a, 0xf6, 0xa6, 0xe4, 0x89, 0x2a, 0x29,
-		0x94, 0xcd, 0x35, 0xd3, 0xb1, 0x88, 0x2c, 0x40, 0xec, 0x94, 0xc7, 0xd3, 0x58, 0x80, 0xd8, 0x41,
-		0xa7, 0x9b, 0x92, 0xb4, 0xe8, 0x2f, 0x0a, 0x2c, 0x47, 0x22, 0x2e, 0x7e, 0x48, 0xb0, 0x63, 0xe9,
-		0xd5, 0x32, 0x07, 0xb9, 0x69, 0x99, 0xc4, 0xe4, 0x1b, 0xc6, 0x09, 0x0a, 0xfd, 0x6e, 0x72, 0x08,
-		0x5e, 0x66, 0xf2, 0xbb, 0xe6, 0x53, 0x0a, 0x84, 0x77, 0x4f, 0xe8, 0x79, 0x67, 0x57, 0x12, 0xd0,
-		0x7b, 0x0a, 0x2c, 0xa6, 0x98, 0xa6, 0x28, 0x62, 0x4d, 0xd1, 0x39, 0xde, 0xd9, 0xc5, 0x1c, 0x45,
-		0xc1, 0xec, 0x86, 0xd3, 0x3b, 0x3b, 0x7a, 0x57, 0x81, 0xe7, 0xe2, 0xa6, 0x93, 0xec, 0x27, 0x27,
-		0xe9, 0xc4, 0x56, 0xb9, 0x13, 0x13, 0x82, 0x49, 0xf4, 0x97, 0xab, 0xb8, 0x37, 0x56, 0x9a, 0x07,
-		0x70, 0xaf, 0x4e, 0x2c, 0x62, 0x5a, 0x0d, 0x6c, 0x94, 0x75, 0xb7, 0x6c, 0xe1, 0x66, 0xf7, 0x3c,
-		0xd4, 0x98, 0x3c, 0x80, 0x73, 0x83, 0xc2, 0xc4, 0x2d, 0xb8, 0xb7, 0x70, 0x93, 0x93, 0x07, 0x34,
-		0x53, 0x71, 0xa0, 0xdf, 0x28, 0x70, 0x8d, 0x66, 0x93, 0xe5, 0xca, 0xa6, 0x59, 0x35, 0x52, 0xfa,
-		0xcf, 0x29, 0x0a, 0xfd, 0x45, 0x2e, 0x74, 0x9a, 0x4a, 0x2e, 0x79, 0x42, 0xd3, 0x38, 0xcd, 0x25,
-		0x37, 0x3d, 0x1b, 0xfa, 0xb9, 0x02, 0x57, 0x12, 0x26, 0x21, 0xf2, 0x8e, 0xd3, 0x74, 0x06, 0xcb,
-		0x69, 0x67, 0x20, 0x72, 0x89, 0x0b, 0x6e, 0x4a, 0x1e, 0xf4, 0x03, 0x05, 0x2e, 0x0a, 0x51, 0x0b,
-		0xf3, 0xfc, 0x27, 0x29, 0xec, 0x05, 0x7e, 0x1a, 0xc2, 0xfd, 0xba, 0x30, 0xf1, 0x9f, 0xad, 0xa4,
-		0xa0, 0x47, 0x3f, 0x52, 0xe0, 0x92, 0x10, 0x6e, 0xcc, 0x21, 0xf2, 0x4c, 0x8c, 0x91, 0xf3, 0x01,
-		0xc7, 0x1c, 0x27, 0x8b, 0x95, 0x54, 0x1c, 0xe8, 0x2d, 0x05, 0x2e, 0xa4, 0xb6, 0x8c, 0xb3, 0x14,
-		0xf1, 0xff, 0xa4, 0x40, 0x2c, 0x32, 0x8a, 0x73, 0x95, 0x14, 0xf6, 0xf0, 0xb6, 0x02, 0xf3, 0xe2,
-		0x05, 0x16, 0x6e, 0xc2, 0xd3, 0x14, 0xed, 0x62, 0x9a, 0xf5, 0x15, 0xee, 0xc4, 0xe7, 0x2b, 0x69,
-		0x18, 0xd0, 0x0f, 0xe3, 0x4c, 0x22, 0xe6, 0xd0, 0xfc, 0x54, 0x6a, 0xc8, 0xe2, 0xe3, 0xb3, 0x00,
-		0xb2, 0xe8, 0x20, 0xed, 0xe5, 0x66, 0x62, 0xc8, 0x31, 0x99, 0xe4, 0x4c, 0x4c, 0x6e, 0x26, 0xc0,
-		0x1c, 0x93, 0x4e, 0xce, 0x55, 0xd2, 0xb1, 0xd0, 0x4d, 0xd3, 0x4f, 0xc5, 0x7b, 0xcd, 0x78, 0xce,
-		0xc5, 0x6c, 0x9a, 0x7e, 0xc6, 0xdd, 0x4b, 0xaa, 0x73, 0xd5, 0xed, 0x8d, 0x15, 0xfd, 0x56, 0x81,
-		0xeb, 0x12, 0x13, 0x12, 0xf9, 0xe8, 0x2c, 0x9d, 0x4d, 0xa9, 0x97, 0xd9, 0x88, 0x9c, 0xf5, 0xb2,
-		0xdb, 0x03, 0x1f, 0xfa, 0x99, 0x02, 0x4f, 0xc7, 0x4d, 0x40, 0x7c, 0x7e, 0x3a, 0x1f, 0xb3, 0x01,
-		0x09, 0x41, 0x88, 0xcf, 0x51, 0x17, 0x70, 0x4a, 0x1e, 0x1a, 0x70, 0x1a, 0x75, 0x17, 0x3b, 0xa4,
-		0x0d, 0xdc, 0xc5, 0xba, 0x53, 0xd9, 0xec, 0x80, 0xd9, 0x8d, 0xbb, 0x18, 0xe3, 0xbd, 0xf7, 0xa8,
-		0xb8, 0x00, 0xc1, 0x5d, 0x2a, 0xac, 0xfd, 0x45, 0x8e, 0xf7, 0x36, 0xd2, 0x30, 0x2c, 0x0e, 0x01,
-		0xb4, 0x81, 0xa8, 0xaf, 0x0d, 0xc3, 0x59, 0xd9, 0xdd, 0x6b, 0x05, 0xf6, 0xb5, 0xe6, 0x48, 0x76,
-		0xea, 0x98, 0xd6, 0x02, 0x45, 0x95, 0xc5, 0x40, 0xe8, 0xda, 0x4e, 0x1d, 0x6b, 0x43, 0xcd, 0x8e,
-		0x27, 0xf4, 0x32, 0x1c, 0xac, 0xeb, 0x8e, 0xb7, 0x22, 0x9d, 0x4e, 0xb7, 0x61, 0xb3, 0xf2, 0xe1,
-		0x34, 0x57, 0xde, 0x1d, 0xca, 0xd1, 0xe1, 0x13, 0x1b, 0xb6, 0x76, 0xa0, 0xde, 0x3d, 0x88, 0xae,
-		0x43, 0x9e, 0xde, 0xc8, 0x54, 0x4d, 0x97, 0xd0, 0xc2, 0x62, 0x61, 0xfe, 0x18, 0xff, 0xca, 0x43,
-		0x77, 0xb7, 0x56, 0x4d, 0x97, 0x68, 0x83, 0x84, 0xfd, 0x85, 0xe6, 0xa1, 0xdf, 0xb4, 0xea, 0x0d,
-		0x42, 0xcb, 0x8e, 0x85, 0xf9, 0xa3, 0x02, 0x24, 0x3b, 0x55, 0x5b, 0x37, 0x34, 0x9f, 0x14, 0xe9,
-		0x30, 0x15, 0x49, 0x39, 0xca, 0xc4, 0x2e, 0x57, 0xaa, 0xb6, 0x8b, 0x69, 0xfc, 0xb6, 0x1b, 0x84,
-		0xd5, 0x21, 0x27, 0xbb, 0xea, 0xa2, 0x37, 0x59, 0x25, 0x59, 0x3b, 0x8a, 0x43, 0x6b, 0xbf, 0x66,
-		0x2f, 0x79, 0xfc, 0x6b, 0x3e, 0x3b, 0x7a, 0x09, 0x8e, 0xb4, 0xaf, 0xbd, 0xbb, 0xa5, 0xe7, 0x92,
-		0xa4, 0x1f, 0x22, 0xc1, 0x65, 0x76, 0x44, 0xf0, 0x0d, 0x38, 0xdc, 0xce, 0xb0, 0xdb, 0xb3, 0x70,
-		0x1a, 0x56, 0xd9, 0x34, 0x68, 0xe9, 0x2f, 0xaf, 0x1d, 0x6a, 0x51, 0xb4, 0xd6, 0x59, 0x6b, 0x58,
-		0x25, 0x03, 0x95, 0x20, 0xcf, 0x42, 0xa5, 0xed, 0xd0, 0x3a, 0xdc, 0xf0, 0xfc, 0x39, 0x7e, 0x68,
-		0x67, 0x02, 0x68, 0x0a, 0x5d, 0x0a, 0x58, 0xb4, 0x36, 0x37, 0x2a, 0xc1, 0x68, 0x1b, 0x87, 0x17,
-		0xae, 0x1a, 0x0e, 0x66, 0xc5, 0x33, 0xbe, 0x0e, 0x56, 0x7c, 0x1a, 0x6d, 0xa4, 0xc5, 0xc6, 0x46,
-		0x90, 0x06, 0xe3, 0x55, 0xdd, 0x3b, 0xf3, 0xf9, 0xe9, 0x0c, 0x9d, 0x0e, 0x76, 0x1b, 0x55, 0xc2,
-		0x0a, 0x5f, 0xf1, 0x3a, 0x1d, 0xf3, 0x78, 0x97, 0x5a, 0xac, 0x1a, 0xe5, 0x44, 0xd7, 0x60, 0xd2,
-		0x76, 0xcc, 0x07, 0xa6, 0x1f, 0x68, 0x23, 0xab, 0x54, 0xa0, 0xab, 0x34, 0x1e, 0x10, 0x44, 0x16,
-		0xe9, 0x30, 0x0c, 0x9a, 0x06, 0xb6, 0x88, 0x49, 0x76, 0x68, 0x45, 0x29, 0xaf, 0xb5, 0x9e, 0xd1,
-		0x25, 0x18, 0xdf, 0x30, 0x1d, 0x97, 0x74, 0xcb, 0xdc, 0x47, 0x29, 0x0f, 0xd0, 0xb7, 0x11, 0x81,
-		0x4b, 0x30, 0xe4, 0x60, 0xe2, 0xec, 0x94, 0xeb, 0x76, 0xd5, 0xac, 0xec, 0xb0, 0x2a, 0xcc, 0x94,
-		0xe0, 0x80, 0x4a, 0x9c, 0x9d, 0x3b, 0x94, 0x4e, 0x2b, 0x38, 0xed, 0x07, 0x34, 0x01, 0x03, 0x3a,
-		0x21, 0xb8, 0x56, 0x27, 0xb4, 0x62, 0xd2, 0xaf, 0x05, 0x8f, 0x68, 0x09, 0xf6, 0xe3, 0x87, 0x75,
-		0xd3, 0x37, 0x1c, 0xbf, 0xa8, 0x3f, 0x92, 0x58, 0xd4, 0x1f, 0x6e, 0xb3, 0xd0, 0xca, 0xfe, 0x29,
-		0xd8, 0x57, 0x71, 0x3c, 0x6f, 0x60, 0x15, 0x1d, 0x5a, 0x71, 0xc8, 0x6b, 0x43, 0xde, 0x60, 0x50,
-		0xe5, 0x41, 0xff, 0x0f, 0x47, 0xfc, 0xd9, 0x87, 0xab, 0x5f, 0xeb, 0x7a, 0x65, 0xcb, 0xde, 0xd8,
-		0x60, 0x45, 0x81, 0x18, 0xa3, 0x9e, 0xa0, 0xdc, 0x9d, 0x85, 0xaf, 0x45, 0x9f, 0x15, 0x9d, 0x87,
-		0xbe, 0x1a, 0xae, 0xd9, 0xec, 0x3a, 0x7f, 0x92, 0x7f, 0xd1, 0x87, 0x6b, 0xb6, 0x46, 0xc9, 0x90,
-		0x06, 0xa3, 0x5d, 0x11, 0x9b, 0xdd, 0xc9, 0x3f, 0xc9, 0xdf, 0x1b, 0x23, 0x11, 0x56, 0x1b, 0x71,
-		0x23, 0x23, 0xe8, 0x1e, 0x8c, 0xd7, 0x1d, 0xbc, 0x5d, 0xd6, 0x1b, 0xc4, 0xf6, 0xec, 0x0f, 0x93,
-		0x72, 0xdd, 0x36, 0x2d, 0x12, 0xdc, 0xb2, 0x8b, 0xf4, 0xe5, 0x62, 0x72, 0x87, 0xd2, 0x69, 0x07,
-		0x3c, 0xfe, 0x85, 0x06, 0xb1, 0x3b, 0x06, 0xd1, 0x25, 0xc8, 0x6d, 0x62, 0xdd, 0xc0, 0x0e, 0xbb,
-		0xfe, 0x3e, 0xc2, 0x6f, 0xea, 0xa0, 0x24, 0x1a, 0x23, 0x45, 0xab, 0x30, 0xe6, 0x2f, 0x74, 0xbb,
-		0x96, 0x47, 0xf5, 0x7a, 0x28, 0x51, 0xaf, 0x88, 0xf2, 0xb5, 0xea, 0x72, 0x54, 0xb7, 0x9f, 0x85,
-		0x91, 0xba, 0xee, 0x10, 0x33, 0x38, 0x9e, 0x6f, 0x98, 0x0f, 0x26, 0x26, 0x68, 0x87, 0xc9, 0xff,
-		0xed, 0xa6, 0xcd, 0xc2, 0x8b, 0xef, 0xbe, 0xd0, 0x25, 0x2a, 0x73, 0xd9, 0x22, 0xce, 0x8e, 0xb6,
-		0xbf, 0x1e, 0x1e, 0x45, 0xc7, 0x00, 0x82, 0x4b, 0x1d, 0xd3, 0xa0, 0xd7, 0xc9, 0x79, 0x2d, 0xcf,
-		0x46, 0x4a, 0xc6, 0xe1, 0x45, 0x18, 0xe3, 0xc9, 0x41, 0x23, 0x90, 0xdd, 0xc2, 0x3b, 0x74, 0xbf,
-		0xca, 0x6b, 0xde, 0x9f, 0x68, 0x0c, 0xfa, 0xb7, 0xf5, 0x6a, 0xc3, 0x6f, 0x59, 0xc9, 0x6b, 0xfe,
-		0xc3, 0xf5, 0xcc, 0x33, 0x8a, 0xfa, 0x96, 0x02, 0x4f, 0xc9, 0x1f, 0x8e, 0x2e, 0x43, 0x8e, 0x85,
-		0x17, 0x45, 0x22, 0xbc, 0x30, 0x5a, 0xb4, 0x02, 0x53, 0xf1, 0xd5, 0x71, 0xd3, 0xa0, 0xc0, 0xb2,
-		0xda, 0x51, 0x71, 0x61, 0xbb, 0x64, 0xa8, 0xdf, 0x55, 0xe0, 0x8c, 0x64, 0x8e, 0x75, 0x05, 0x06,
-		0x82, 0xc0, 0xaa, 0x48, 0x04, 0xd6, 0x80, 0x78, 0xcf, 0xa0, 0xda, 0x30, 0x2d, 0x7d, 0xc0, 0x58,
-		0x82, 0x21, 0xb6, 0xb7, 0xb5, 0xf3, 0x8c, 0x61, 0x81, 0xcf, 0xb0, 0xad, 0x8c, 0xa6, 0x19, 0x05,
-		0xd2, 0x7e, 0x50, 0xff, 0xa0, 0xc0, 0x69, 0x99, 0x1e, 0x8b, 0x70, 0xc2, 0xa0, 0xa4, 0x4b, 0x18,
-		0x6e, 0xc1, 0xb8, 0x60, 0x53, 0xce, 0x24, 0xc5, 0xaf, 0x03, 0x2e, 0x67, 0x43, 0xee, 0x08, 0xcc,
-		0xd9, 0x50, 0x60, 0x56, 0x5f, 0x55, 0x40, 0x4d, 0x6e, 0xcf, 0x40, 0xb3, 0x80, 0xa2, 0x25, 0xfb,
-		0x56, 0xd3, 0xd6, 0x88, 0x1b, 0x5a, 0x82, 0xc8, 0xee, 0x94, 0x89, 0xec, 0x4e, 0x61, 0x57, 0xcb,
-		0x46, 0x5c, 0x4d, 0xfd, 0x7b, 0x64, 0x79, 0x85, 0x1e, 0x92, 0x0e, 0xd1, 0x34, 0x8c, 0x84, 0xaf,
-		0x6d, 0x5a, 0xe6, 0x35, 0xec, 0x76, 0xcc, 0x38, 0x82, 0x3d, 0x1b, 0xc1, 0x7e, 0x16, 0xf6, 0xaf,
-		0x9b, 0x96, 0xee, 0xec, 0x94, 0x2b, 0x9b, 0xb8, 0xb2, 0xe5, 0x36, 0x6a, 0x34, 0xa3, 0xcb, 0x6b,
-		0xc3, 0xfe, 0xf0, 0x12, 0x1b, 0x45, 0xe7, 0x60, 0x34, 0x7c, 0xd9, 0x88, 0x1f, 0xfa, 0xd9, 0xda,
-		0x90, 0x36, 0x82, 0x3b, 0xef, 0x00, 0xf1, 0x43, 0xa2, 0x7e, 0x3f, 0x0b, 0xa7, 0x24, 0x3a, 0x3f,
-		0x1e, 0xd9, 0x8c, 0xa3, 0x6e, 0x91, 0xed, 0xc1, 0x2d, 0xd0, 0x71, 0x28, 0xac, 0xeb, 0x2e, 0x0e,
-		0x32, 0x0d, 0x7f, 0x59, 0xf2, 0xde, 0x90, 0x9f, 0x5f, 0x1c, 0x05, 0xb0, 0x70, 0x33, 0x78, 0xdd,
-		0xef, 0x2f, 0xac, 0x85, 0x9b, 0xfe, 0xdb, 0x59, 0x40, 0x1b, 0xb6, 0xb3, 0xc5, 0x90, 0x06, 0xed,
-		0x7b, 0x39, 0x7f, 0x6a, 0xde, 0x1b, 0x8a, 0xf5, 0x3e, 0xeb, 0xe3, 0x1b, 0xf7, 0x82, 0xa3, 0xee,
-		0xda, 0x16, 0x4b, 0x25, 0xd9, 0x13, 0xba, 0x09, 0xfd, 0x15, 0xbd, 0xe1, 0x62, 0x96, 0x35, 0x16,
-		0xa5, 0x7b, 0x6c, 0x96, 0x3c, 0x2e, 0xcd, 0x67, 0x8e, 0x18, 0x68, 0x3e, 0x6a, 0xa0, 0xef, 0x64,
-		0xe1, 0x64, 0x62, 0x5b, 0xcc, 0x23, 0xd3, 0xd5, 0x62, 0x30, 0x45, 0x5f, 0x49, 0xb3, 0x92, 0x5d,
-		0x3b, 0xa1, 0x09, 0x76, 0x84, 0xec, 0xbe, 0x34, 0x21, 0xbb, 0xd3, 0x33, 0xfa, 0x23, 0x9e, 0x11,
-		0x51, 0x7f, 0x2e, 0x5e, 0xfd, 0x03, 0x52, 0xea, 0x1f, 0x14, 0xa8, 0x9f, 0xe3, 0x85, 0x79, 0xae,
-		0x17, 0x86, 0x35, 0x09, 0x51, 0x4d, 0x7e, 0x3d, 0x07, 0xa7, 0x65, 0x1a, 0x8a, 0xd0, 0x09, 0x28,
-		0xb4, 0xaa, 0xf2, 0x4c, 0x8b, 0x79, 0x0d, 0x82, 0xa1, 0x92, 0xe1, 0x9d, 0x60, 0xdb, 0x65, 0x7b,
-		0xcf, 0x85, 0x32, 0x31, 0x27, 0xd8, 0xd6, 0x27, 0xe9, 0x09, 0x56, 0xef, 0x78, 0xf2, 0x0c, 0xdb,
-		0xb0, 0x6b, 0xba, 0x69, 0xb1, 0xc8, 0xc3, 0x9e, 0xc2, 0x5b, 0x49, 0x5f, 0x8f, 0x67, 0xcf, 0x9c,
-		0xfc, 0xd9, 0x73, 0x0d, 0x26, 0x03, 0x1b, 0xed, 0xde, 0x81, 0x06, 0x92, 0x76, 0xa0, 0xf1, 0x80,
-		0x37, 0xb2, 0x09, 0x45, 0xa4, 0xb2, 0x0d, 0x8e, 0x49, 0x1d, 0x4c, 0x21, 0xd5, 0x3f, 0x72, 0x32,
-		0xa9, 0xe2, 0xad, 0x32, 0xdf, 0xd3, 0x56, 0xb9, 0x02, 0xa3, 0x9b, 0x58, 0x77, 0xc8, 0x3a, 0xd6,
-		0xdb, 0xe8, 0x20, 0x49, 0xd4, 0x48, 0x8b, 0xa7, 0x2d, 0x27, 0x39, 0xc1, 0x29, 0x24, 0x27, 0x38,
-		0x5d, 0x07, 0xb3, 0xa1, 0x5e, 0x0e, 0x66, 0xed, 0x04, 0x7f, 0x9f, 0x74, 0x82, 0xaf, 0xfe, 0x4d,
-		0x01, 0x35, 0xb9, 0xb9, 0xed, 0x43, 0x4b, 0x0d, 0x3a, 0x93, 0x98, 0xbe, 0xf0, 0xe9, 0xf2, 0x05,
-		0x18, 0xa2, 0x87, 0xf3, 0x20, 0xac, 0xf5, 0x4b, 0x84, 0xb5, 0x82, 0xc7, 0xc1, 0x1e, 0xd4, 0x3f,
-		0x29, 0xe1, 0x50, 0xb0, 0xc7, 0x79, 0x39, 0x7f, 0x89, 0x32, 0x29, 0x76, 0x83, 0x6c, 0x62, 0xae,
-		0xd2, 0x17, 0x5e, 0x4c, 0xf5, 0x8f, 0x0a, 0x9c, 0x4c, 0xee, 0x38, 0xea, 0x35, 0x7d, 0xff, 0x28,
-		0x66, 0xf4, 0xcb, 0x0c, 0x9c, 0x92, 0xe8, 0xdb, 0xf3, 0xe6, 0x64, 0x60, 0xa2, 0x9b, 0x55, 0x57,
-		0x4a, 0x49, 0x01, 0xf1, 0x23, 0x9b, 0x53, 0x34, 0xbf, 0xea, 0xeb, 0x25, 0xbf, 0xda, 0xb5, 0x89,
-		0x7f, 0x45, 0x81, 0x19, 0xf9, 0x76, 0x3b, 0x99, 0x3d, 0x6f, 0x6f, 0x0e, 0x70, 0x6f, 0x2b, 0x90,
-		0xb2, 0xb1, 0x2e, 0x19, 0xdb, 0x58, 0x90, 0x25, 0xb1, 0x53, 0xb8, 0x9f, 0xf7, 0xc8, 0x20, 0xce,
-		0x4a, 0x20, 0x7e, 0x33, 0x62, 0x87, 0xa2, 0x12, 0x5c, 0xaf, 0x76, 0xb8, 0x02, 0x53, 0x55, 0x9d,
-		0x74, 0x34, 0x98, 0x44, 0xdb, 0x2d, 0xda, 0x2b, 0xeb, 0xd3, 0xf1, 0x54, 0xe9, 0x67, 0x55, 0x1c,
-		0x7b, 0xce, 0xa6, 0xb0, 0xe7, 0xbe, 0x44, 0x1f, 0x8d, 0xe4, 0x81, 0xea, 0xbb, 0x0a, 0x1c, 0x89,
-		0x69, 0x69, 0x45, 0x93, 0x30, 0xe8, 0xb7, 0xf2, 0xb5, 0xf4, 0x36, 0x40, 0x9f, 0x4b, 0x06, 0x5a,
-		0x85, 0x83, 0xad, 0x8d, 0x7c, 0xc3, 0x74, 0x52, 0x1c, 0x79, 0x11, 0xdb, 0xc7, 0x57, 0x4c, 0x07,
-		0xa7, 0xd9, 0x7e, 0x65, 0x94, 0xfd, 0x29, 0x98, 0x14, 0xf6, 0xca, 0xc6, 0xcd, 0x46, 0x3a, 0xa5,
-		0x57, 0xdf, 0x51, 0xe0, 0x68, 0x5c, 0x9b, 0xe4, 0x9e, 0x7c, 0x65, 0xaf, 0xd6, 0x23, 0x36, 0x40,
-		0xff, 0x54, 0x81, 0xa9, 0xa4, 0x76, 0xcb, 0xb8, 0xd9, 0x3c, 0x52, 0xb7, 0x8d, 0x45, 0xfe, 0xaf,
-		0x01, 0x48, 0xd9, 0xd5, 0x83, 0xe6, 0x60, 0x8c, 0x36, 0x0e, 0x45, 0xef, 0xd8, 0xfd, 0x39, 0x8d,
-		0x5a, 0xb8, 0x19, 0xb9, 0x61, 0xef, 0x2a, 0x73, 0x65, 0x7a, 0x2b, 0x73, 0x3d, 0x2e, 0x44, 0xc9,
-		0x17, 0xa2, 0x64, 0x6c, 0x67, 0x40, 0xc2, 0x76, 0x6e, 0xc3, 0x38, 0x2b, 0x20, 0x30, 0x8c, 0xa6,
-		0x45, 0xb0, 0xb3, 0xad, 0x57, 0x93, 0xcf, 0x2d, 0x63, 0x8c, 0x91, 0xc2, 0x2b, 0x31, 0xb6, 0x70,
-		0x91, 0x2b, 0xbf, 0xab, 0x22, 0x57, 0x47, 0x0a, 0x07, 0x69, 0x52, 0x38, 0x71, 0x45, 0xab, 0xd0,
-		0x73, 0x45, 0xab, 0x7d, 0xce, 0x18, 0x92, 0x2f, 0x24, 0x04, 0x75, 0x95, 0x7d, 0xbb, 0xa8, 0xab,
-		0x0c, 0xef, 0xaa, 0xae, 0xa2, 0xfe, 0x55, 0x81, 0xb9, 0xb4, 0xad, 0x85, 0xad, 0x68, 0xa5, 0x74,
-		0x46, 0xab, 0xb8, 0xf3, 0xcd, 0x3a, 0x1c, 0x6a, 0xb5, 0x23, 0x44, 0x4a, 0xd4, 0xbe, 0x1f, 0xcf,
-		0xc4, 0x36, 0x1c, 0x84, 0x8b, 0xd4, 0x07, 0x31, 0x6f, 0x38, 0x72, 0x86, 0xea, 0x8b, 0xde, 0x79,
-		0x7c, 0x4f, 0xe1, 0xdc, 0x97, 0x8b, 0x36, 0x1e, 0x19, 0xef, 0x51, 0x24, 0xbc, 0xa7, 0x23, 0x11,
-		0xca, 0xa4, 0x48, 0x84, 0xd4, 0x0f, 0x14, 0x38, 0x16, 0xdb, 0x39, 0xef, 0x65, 0x82, 0xac, 0x2f,
-		0xdf, 0xd2, 0x6b, 0x81, 0x26, 0xc0, 0x1f, 0xba, 0xa5, 0xd7, 0x70, 0xaf, 0x9f, 0xde, 0xb3, 0x4d,
-		0xa7, 0xed, 0x10, 0x7d, 0xf2, 0x07, 0xef, 0x5f, 0xf0, 0x94, 0x24, 0xea, 0x14, 0x39, 0x01, 0x05,
-		0xd6, 0xab, 0xd3, 0xb9, 0x04, 0xfe, 0x10, 0x5d, 0x82, 0x56, 0xcc, 0xcf, 0xc8, 0xc7, 0xfc, 0xb8,
-		0x4b, 0xf0, 0x04, 0x0b, 0xfb, 0xaa, 0x02, 0x33, 0x29, 0x9a, 0xa7, 0xda, 0x77, 0xb9, 0x4a, 0xe8,
-		0x2e, 0xb7, 0x57, 0xc5, 0xc5, 0x20, 0x57, 0x7f, 0x9d, 0x81, 0xe7, 0x77, 0xd7, 0x40, 0xbe, 0x67,
-		0x2e, 0xd1, 0xbe, 0xe9, 0xcb, 0x84, 0x6e, 0xfa, 0xee, 0x01, 0xea, 0x6e, 0x54, 0x62, 0xd1, 0xe1,
-		0x8c, 0x5c, 0x21, 0x54, 0x1b, 0xed, 0xea, 0x36, 0x46, 0x13, 0x30, 0x50, 0xb1, 0x2d, 0xe2, 0xd8,
-		0x55, 0xaa, 0xb0, 0x21, 0x2d, 0x78, 0x44, 0x45, 0x38, 0x10, 0xe9, 0xb9, 0xb3, 0xad, 0xaa, 0x9f,
-		0xd7, 0x0f, 0x6a, 0xa3, 0xa1, 0x56, 0xb8, 0xdb, 0x56, 0x75, 0x47, 0x7d, 0x23, 0x0b, 0x37, 0x76,
-		0xd1, 0xa0, 0x8e, 0xee, 0x75, 0x46, 0xcd, 0x61, 0xc1, 0xcf, 0x3f, 0xa4, 0x24, 0x87, 0xee, 0xb4,
-		0xf7, 0xe8, 0x34, 0x2a, 0xbc, 0x81, 0xe5, 0xeb, 0xa5, 0x6f, 0xb7, 0x7a, 0x99, 0x05, 0x14, 0x6d,
-		0x0b, 0x64, 0xd5, 0x91, 0xac, 0x36, 0x62, 0x86, 0x8c, 0xd0, 0xbf, 0x00, 0x0b, 0xb4, 0x98, 0x0b,
-		0x69, 0x51, 0xfd, 0xb3, 0x02, 0x57, 0x7b, 0xec, 0xae, 0x17, 0x60, 0x50, 0x04, 0x18, 0x3e, 0x5c,
-		0xc3, 0x55, 0x5f, 0xcb, 0xc2, 0xd5, 0x1e, 0x3b, 0x20, 0xff, 0x5b, 0x7d, 0x35, 0x12, 0xd0, 0xfb,
-		0xc4, 0x01, 0xbd, 0x5f, 0x3e, 0xa0, 0x0b, 0x4d, 0x47, 0x14, 0x00, 0x06, 0x44, 0x01, 0xe0, 0x4b,
-		0x59, 0xb8, 0xdc, 0x4b, 0x17, 0xa7, 0x9c, 0xe7, 0x4b, 0x49, 0x7e, 0xec, 0xf9, 0x6d, 0xcf, 0x7f,
-		0x5f, 0x81, 0x0b, 0x69, 0x3b, 0x52, 0xff, 0xa3, 0x5d, 0x5e, 0xbc, 0x57, 0xa9, 0xbf, 0x57, 0xe0,
-		0x7c, 0xaa, 0x2e, 0xd6, 0x3d, 0x0b, 0x01, 0xdc, 0x33, 0x47, 0x66, 0x77, 0x67, 0x8e, 0xf7, 0xf2,
-		0x70, 0xa9, 0x87, 0x9f, 0xe3, 0x74, 0xa8, 0x43, 0x09, 0xa9, 0xe3, 0x04, 0x14, 0x5a, 0xea, 0x60,
-		0x36, 0x9f, 0xd7, 0x20, 0x18, 0xe2, 0x5d, 0x40, 0x64, 0xf7, 0xe0, 0x02, 0xa2, 0xd7, 0x6a, 0x64,
-		0xff, 0xde, 0x5e, 0x40, 0xe4, 0x1e, 0xe9, 0x05, 0xc4, 0x40, 0xcf, 0x17, 0x10, 0xf7, 0x81, 0x35,
-		0x13, 0x33, 0x89, 0xac, 0x88, 0xe7, 0x37, 0x28, 0x9c, 0x89, 0xe9, 0x48, 0xa6, 0x52, 0x58, 0x29,
-		0x6f, 0xb4, 0x1e, 0x1d, 0xea, 0x74, 0x92, 0x7c, 0x38, 0x9e, 0xcb, 0x98, 0x3c, 0x48, 0x98, 0x7c,
-		0x05, 0x26, 0x3a, 0xcc, 0xa9, 0xec, 0xe0, 0x46, 0x1b, 0x7e, 0x81, 0xc2, 0x9f, 0x89, 0x35, 0x9c,
-		0x92, 0xa1, 0x79, 0x2c, 0x6c, 0x0a, 0x07, 0x9b, 0xbc, 0xe1, 0xae, 0xe2, 0xe6, 0xbe, 0x5e, 0x8a,
-		0x9b, 0x5d, 0x6d, 0xa1, 0xc3, 0x9c, 0xb6, 0xd0, 0xf6, 0x41, 0x6c, 0x7f, 0xfa, 0x9b, 0x89, 0x91,
-		0x5d, 0xdc, 0x4c, 0x8c, 0xee, 0xae, 0xe3, 0xf3, 0x3a, 0x14, 0x0c, 0x5c, 0xd5, 0x77, 0x7c, 0xd3,
-		0x4c, 0x6e, 0x5f, 0x05, 0x4a, 0x4d, 0x4d, 0x11, 0x3d, 0x0b, 0x43, 0x9f, 0x36, 0x09, 0x09, 0xfe,
-		0x35, 0x45, 0xab, 0x71, 0x55, 0xc8, 0x5c, 0xf0, 0xc9, 0x5b, 0xdc, 0x7e, 0x7f, 0xa7, 0xd3, 0xb0,
-		0xca, 0x3a, 0x61, 0xad, 0xab, 0x71, 0x7d, 0x9d, 0x40, 0xe9, 0xb5, 0x86, 0xb5, 0x40, 0xd4, 0xd7,
-		0xb3, 0x70, 0x21, 0xed, 0x4f, 0xf5, 0x3e, 0xfa, 0xd0, 0xb6, 0x1a, 0xe4, 0x28, 0x7e, 0x8d, 0xee,
-		0x4a, 0xea, 0xdf, 0x99, 0x85, 0x52, 0x93, 0x0e, 0x27, 0xed, 0x0f, 0x3b, 0x29, 0x7f, 0x03, 0xce,
-		0x09, 0x36, 0xe0, 0x3d, 0xba, 0xc5, 0x54, 0x7f, 0x97, 0x81, 0xd9, 0x34, 0xbf, 0x43, 0x14, 0xea,
-		0x83, 0xbf, 0xf3, 0x67, 0x76, 0xbb, 0xf3, 0xef, 0x95, 0x16, 0xf9, 0xab, 0xdb, 0x27, 0x58, 0xdd,
-		0x76, 0x64, 0xe8, 0x97, 0xbf, 0xa2, 0xf9, 0x20, 0x03, 0x29, 0x7f, 0x21, 0xf9, 0xf1, 0x58, 0x4c,
-		0x5e, 0x41, 0xaa, 0x9f, 0x5b, 0x90, 0x6a, 0x77, 0x52, 0xe4, 0xe4, 0x3b, 0x29, 0xd4, 0x7f, 0x64,
-		0xe0, 0xdc, 0x5e, 0x44, 0x94, 0x8f, 0xe9, 0xa2, 0x77, 0xd4, 0x0a, 0x72, 0x29, 0x6a, 0x05, 0xea,
-		0x3f, 0x33, 0x70, 0x3e, 0xd5, 0x0f, 0x56, 0x1f, 0x2f, 0x7c, 0xd7, 0xc2, 0x07, 0xd7, 0x99, 0xb9,
-		0x34, 0x57, 0xe0, 0x9f, 0xcf, 0x8a, 0x16, 0x5e, 0xd4, 0xfd, 0xf2, 0x78, 0xe1, 0x63, 0x9b, 0x6f,
-		0x72, 0xbd, 0xf4, 0xfc, 0xff, 0x2a, 0x03, 0x73, 0x29, 0x7f, 0x48, 0xfc, 0x58, 0x0f, 0x21, 0x3d,
-		0xcc, 0x10, 0xd8, 0x4f, 0xff, 0x5c, 0x31, 0xab, 0x04, 0x3b, 0xf4, 0x53, 0xc7, 0x60, 0x72, 0xf9,
-		0xfe, 0xf2, 0xad, 0xb5, 0xf2, 0x4a, 0x69, 0x75, 0x6d, 0x59, 0x2b, 0xaf, 0x7d, 0xe2, 0xce, 0x72,
-		0xb9, 0x74, 0xeb, 0xfe, 0xc2, 0x6a, 0xe9, 0xe6, 0xc8, 0x13, 0xe8, 0x04, 0x1c, 0xe9, 0x7e, 0xbd,
-		0xb0, 0xba, 0x5a, 0xa6, 0xa3, 0x23, 0x0a, 0x3a, 0x09, 0xc7, 0xba, 0x09, 0x96, 0x56, 0x6f, 0xdf,
-		0x5d, 0x66, 0x24, 0x99, 0xc5, 0x97, 0xe1, 0x50, 0xc5, 0xae, 0xf1, 0xd6, 0x60, 0x31, 0xf8, 0x57,
-		0xb4, 0x77, 0xbc, 0x3c, 0xf6, 0x8e, 0xf2, 0xc9, 0x8b, 0x0f, 0x4c, 0xb2, 0xd9, 0x58, 0x2f, 0x56,
-		0xec, 0xda, 0x5c, 0xe7, 0xbf, 0xc4, 0x3d, 0x6f, 0x1a, 0xd5, 0xb9, 0x07, 0xb6, 0xff, 0x6f, 0x78,
-		0xd9, 0xff, 0xc7, 0xbd, 0xa1, 0xd7, 0xcd, 0xed, 0x8b, 0xeb, 0x39, 0x3a, 0x76, 0xe9, 0xdf, 0x01,
-		0x00, 0x00, 0xff, 0xff, 0xf6, 0x46, 0xec, 0xce, 0x02, 0x58, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0xc7,
+		0x91, 0xf6, 0xec, 0x92, 0x4b, 0x6d, 0x2d, 0x45, 0x91, 0x4d, 0x8a, 0x5a, 0x4a, 0x94, 0x44, 0xad,
+		0x64, 0x89, 0xa6, 0xc8, 0xa5, 0x44, 0xc9, 0x92, 0x25, 0xf9, 0xe7, 0x48, 0x8a, 0x84, 0x16, 0xe0,
+		0x49, 0xbc, 0x11, 0x25, 0xdf, 0x1d, 0x0c, 0xec, 0x0d, 0x77, 0x9a, 0xe2, 0x1c, 0x77, 0x77, 0xd6,
+		0x33, 0xbd, 0x5c, 0xf1, 0x70, 0xf7, 0x74, 0x0f, 0x07, 0x1c, 0xce, 0xf0, 0x19, 0x46, 0x80, 0x18,
+		0x48, 0x90, 0x20, 0x40, 0x82, 0x38, 0x09, 0xe0, 0x20, 0x41, 0x90, 0xbf, 0x97, 0x24, 0x40, 0xe0,
+		0x00, 0x09, 0x9c, 0x3c, 0xe5, 0xc5, 0xaf, 0x41, 0x10, 0xbf, 0xe5, 0x21, 0xce, 0x5b, 0x80, 0x60,
+		0x7a, 0x7a, 0x76, 0x77, 0x66, 0xba, 0x67, 0x7a, 0x96, 0x94, 0x9d, 0xc0, 0x7a, 0xe3, 0xf4, 0x54,
+		0xd5, 0x7c, 0xd5, 0x5d, 0x55, 0x5d, 0xdd, 0x55, 0x4b, 0x38, 0xd3, 0xdc, 0xc4, 0xd6, 0x7c, 0x45,
+		0xd3, 0x71, 0xbd, 0x82, 0xe7, 0xb5, 0x86, 0x31, 0xbf, 0x7b, 0x79, 0x7e, 0xdb, 0xb0, 0x89, 0x69,
+		0xed, 0x15, 0x1b, 0x96, 0x49, 0x4c, 0x34, 0xea, 0x90, 0x14, 0x19, 0x49, 0x51, 0x6b, 0x18, 0xc5,
+		0xdd, 0xcb, 0xc7, 0x4f, 0x3d, 0x32, 0xcd, 0x47, 0x55, 0x3c, 0x4f, 0x49, 0x36, 0x9b, 0x5b, 0xf3,
+		0x7a, 0xd3, 0xd2, 0x88, 0x61, 0xd6, 0x5d, 0xa6, 0xe3, 0xa7, 0x83, 0xef, 0x89, 0x51, 0xc3, 0x36,
+		0xd1, 0x6a, 0x0d, 0x46, 0x30, 0xc5, 0xfb, 0x70, 0xc5, 0xac, 0xd5, 0xda, 0x22, 0x0a, 0x3c, 0x0a,
+		0xa2, 0xd9, 0x3b, 0x55, 0xc3, 0x26, 0x51, 0x34, 0x2d, 0xd3, 0xda, 0xd9, 0xaa, 0x9a, 0x2d, 0x97,
+		0xa6, 0x70, 0x1b, 0x06, 0xee, 0xb8, 0x0a, 0xa1, 0x1b, 0x90, 0xc1, 0xbb, 0xb8, 0x4e, 0xec, 0xbc,
+		0x32, 0x95, 0x9e, 0xce, 0x2d, 0x9c, 0x29, 0x72, 0x74, 0x2b, 0x32, 0xea, 0x15, 0x87, 0x52, 0x65,
+		0x0c, 0x85, 0x8f, 0xae, 0xc3, 0x60, 0xf7, 0x0b, 0x34, 0x01, 0x87, 0xe8, 0xab, 0xb2, 0xa1, 0xe7,
+		0x95, 0x29, 0x65, 0x3a, 0xad, 0x0e, 0xd0, 0xe7, 0x92, 0x8e, 0x6e, 0x00, 0xb8, 0xaf, 0x1c, 0xa5,
+		0xf3, 0xa9, 0x29, 0x65, 0x3a, 0xb7, 0x70, 0xbc, 0xe8, 0xce, 0x48, 0xd1, 0x9b, 0x91, 0xe2, 0x86,
+		0x37, 0x23, 0x6a, 0x96, 0x52, 0x3b, 0xcf, 0x28, 0x0f, 0x03, 0xbb, 0xd8, 0xb2, 0x0d, 0xb3, 0x9e,
+		0x4f, 0xbb, 0x42, 0xd9, 0x23, 0x3a, 0x06, 0x03, 0x8e, 0xf2, 0xce, 0xe7, 0xfa, 0xe8, 0x9b, 0x8c,
+		0xf3, 0x58, 0xd2, 0xd1, 0x17, 0x14, 0xb8, 0xe8, 0xa9, 0x5c, 0xc6, 0x8f, 0x71, 0xa5, 0xe9, 0xac,
+		0x43, 0xd9, 0x26, 0x9a, 0x45, 0xb0, 0x5e, 0x76, 0x91, 0x68, 0x84, 0x58, 0xc6, 0x66, 0x93, 0x60,
+		0x3b, 0xdf, 0x4f, 0xf1, 0xbc, 0xc8, 0x55, 0xfd, 0x55, 0x26, 0x67, 0xc5, 0x13, 0x73, 0xdf, 0x95,
+		0x42, 0x55, 0x5e, 0x6c, 0xcb, 0xb8, 0xf3, 0x8c, 0x7a, 0xa1, 0x25, 0x47, 0x8a, 0xbe, 0xa2, 0xc0,
+		0x1c, 0x07, 0x5e, 0xc5, 0xac, 0x35, 0xaa, 0x98, 0x0b, 0x30, 0x43, 0x01, 0xbe, 0x2c, 0x07, 0x70,
+		0xd9, 0x93, 0x13, 0x86, 0xf8, 0x5c, 0x4b, 0x96, 0x18, 0xbd, 0xa3, 0xc0, 0x0c, 0x07, 0xe4, 0x96,
+		0x66, 0x54, 0x79, 0x08, 0x07, 0x28, 0xc2, 0x5b, 0x72, 0x08, 0x57, 0xa9, 0x90, 0x30, 0xbc, 0xf3,
+		0x2d, 0x29, 0x4a, 0xf4, 0x65, 0xfe, 0x04, 0x3a, 0xb6, 0xa5, 0x97, 0xcd, 0x26, 0x09, 0xc3, 0x3b,
+		0x44, 0xe1, 0xbd, 0x24, 0x07, 0xcf, 0x31, 0x3b, 0xfd, 0x5e, 0x93, 0x84, 0x01, 0x4e, 0xb7, 0x24,
+		0x69, 0xd1, 0xdb, 0x0a, 0x4c, 0xeb, 0xb8, 0x62, 0xd8, 0x14, 0x98, 0x63, 0xa5, 0x76, 0x65, 0x1b,
+		0xeb, 0x4d, 0xee, 0xe4, 0x65, 0x29, 0xba, 0x1b, 0x5c, 0x74, 0xb7, 0x99, 0x90, 0x0d, 0xcd, 0xde,
+		0xb9, 0xef, 0x89, 0x08, 0x23, 0x3b, 0xa7, 0x4b, 0xd0, 0xa1, 0x37, 0x14, 0x38, 0x1f, 0x40, 0x25,
+		0xf2, 0x09, 0xa0, 0x98, 0xae, 0xc7, 0x63, 0x12, 0xb9, 0x43, 0x41, 0x8f, 0xa5, 0xe2, 0xcc, 0x52,
+		0x84, 0x13, 0xe4, 0x24, 0x67, 0x29, 0xc2, 0xfe, 0xcf, 0xe9, 0x12, 0x74, 0xe8, 0xad, 0x10, 0xaa,
+		0x08, 0xcb, 0x1a, 0xa4, 0xa8, 0x5e, 0x88, 0x45, 0x25, 0x36, 0xaa, 0xb3, 0x7a, 0x3c, 0x19, 0xfa,
+		0x5f, 0x05, 0x9e, 0xf5, 0x63, 0x12, 0x79, 0xe2, 0x61, 0x0a, 0xe8, 0x5a, 0x2c, 0x20, 0x91, 0x13,
+		0x9e, 0xd1, 0xe3, 0x88, 0xe8, 0xb2, 0x69, 0x15, 0x62, 0xec, 0x1a, 0x64, 0x2f, 0xd6, 0xb8, 0x87,
+		0x22, 0x96, 0x6d, 0x91, 0x09, 0x89, 0x33, 0x6e, 0x4d, 0x82, 0x8e, 0x1a, 0x77, 0x00, 0x95, 0xc8,
+		0xb8, 0x8f, 0x44, 0x18, 0xb7, 0x0f, 0x93, 0xd0, 0xb8, 0xb5, 0x58, 0x2a, 0xce, 0x2c, 0x45, 0x18,
+		0xf7, 0xb0, 0xe4, 0x2c, 0x45, 0x19, 0xb7, 0x26, 0x41, 0x47, 0x0d, 0xc9, 0x8f, 0x4a, 0x64, 0x48,
+		0x23, 0x11, 0x86, 0xd4, 0x0d, 0x49, 0x68, 0x48, 0x5a, 0x1c, 0x11, 0xf5, 0x34, 0x3f, 0x98, 0x08,
+		0x4f, 0x43, 0x11, 0x9e, 0xd6, 0x8d, 0x27, 0xc2, 0xd3, 0xb4, 0x78, 0x32, 0xd4, 0x82, 0x53, 0x0e,
+		0x08, 0x4b, 0x6c, 0x3d, 0xa3, 0x14, 0xc8, 0x25, 0x2e, 0x10, 0x47, 0xaa, 0x25, 0x34, 0x9b, 0x13,
+		0x44, 0xfc, 0x1a, 0xbd, 0x0e, 0x93, 0xee, 0x87, 0xb7, 0x0c, 0x8b, 0xf7, 0xd9, 0x31, 0xfa, 0xd9,
+		0xa2, 0xf8, 0xb3, 0xab, 0x86, 0x15, 0x92, 0x7a, 0xe7, 0x19, 0x75, 0x82, 0x88, 0x5e, 0xa2, 0xaf,
+		0x29, 0x30, 0x1f, 0x30, 0x51, 0xad, 0x5e, 0xc1, 0xd5, 0xb2, 0x85, 0x5f, 0x6f, 0x62, 0x9b, 0xab,
+		0xfd, 0x51, 0x0a, 0xe3, 0x95, 0x78, 0x4b, 0xa5, 0x92, 0x54, 0x4f, 0x50, 0x18, 0xd7, 0x8c, 0x26,
+		0x4d, 0x8d, 0xbe, 0xab, 0xc0, 0x55, 0x86, 0xc9, 0x83, 0x28, 0x67, 0xc4, 0xe3, 0x14, 0xed, 0x32,
+		0x17, 0x2d, 0xfb, 0x9a, 0xfb, 0x69, 0x19, 0x8b, 0x2e, 0x5a, 0x89, 0x38, 0xd0, 0xff, 0x2b, 0x70,
+		0x81, 0x37, 0xbd, 0x3c, 0xa0, 0xc7, 0x24, 0xad, 0x7b, 0x99, 0x49, 0x88, 0xb1, 0x6e, 0x01, 0x19,
+		0xfa, 0x0f, 0x38, 0xed, 0x1a, 0x99, 0x18, 0x49, 0x9e, 0x22, 0xb9, 0x2c, 0xb6, 0x33, 0x31, 0x84,
+		0x49, 0x12, 0xf1, 0x1e, 0xfd, 0x8f, 0x02, 0xe7, 0xd8, 0xe2, 0x31, 0x43, 0x17, 0x2c, 0xda, 0x04,
+		0x45, 0xf0, 0x3c, 0x17, 0x81, 0x2b, 0xdc, 0xb5, 0x77, 0xc1, 0x32, 0x4d, 0x55, 0x62, 0x68, 0xd0,
+		0x7f, 0xc1, 0x54, 0x4d, 0xb3, 0x76, 0xb0, 0x55, 0xb6, 0x70, 0xc5, 0xb4, 0x74, 0x1e, 0x88, 0xe3,
+		0x14, 0xc4, 0x02, 0x17, 0xc4, 0x3f, 0x52, 0x66, 0x95, 0xf1, 0x86, 0x11, 0x9c, 0xac, 0x45, 0x11,
+		0xa0, 0x2f, 0x29, 0x30, 0xcb, 0x3b, 0x9f, 0x18, 0x8f, 0xea, 0x1a, 0x77, 0x42, 0x4e, 0x24, 0x49,
+		0x5f, 0xef, 0x33, 0x31, 0x32, 0xe9, 0xab, 0x80, 0x16, 0x7d, 0x55, 0x81, 0x22, 0x07, 0x21, 0xc1,
+		0x56, 0xcd, 0xa8, 0x6b, 0xdc, 0xb8, 0x30, 0x19, 0x11, 0x17, 0xc2, 0x29, 0x76, 0x5b, 0x10, 0x27,
+		0x2e, 0xb4, 0xa4, 0xa9, 0xd1, 0xf7, 0x14, 0xb8, 0xca, 0x3b, 0x4a, 0xc5, 0x46, 0xb1, 0x93, 0x14,
+		0xed, 0x6d, 0xc9, 0x13, 0x55, 0x5c, 0x28, 0x9b, 0x6f, 0x25, 0x63, 0x11, 0x59, 0x80, 0xd8, 0x29,
+		0x4f, 0x25, 0xb1, 0x00, 0xb1, 0x83, 0x4e, 0xb7, 0x24, 0x69, 0xd1, 0xef, 0x14, 0x58, 0x09, 0x44,
+		0x5c, 0xfc, 0x98, 0x60, 0xab, 0xae, 0x55, 0xcb, 0x1c, 0xe4, 0x46, 0xdd, 0x20, 0x06, 0xdf, 0x30,
+		0x4e, 0x53, 0xe8, 0xf7, 0xe3, 0x43, 0xf0, 0x0a, 0x93, 0x1f, 0xd2, 0xa7, 0xe4, 0x09, 0x0f, 0x2b,
+		0xf4, 0xb2, 0xb5, 0x2f, 0x09, 0xe8, 0x43, 0x05, 0x96, 0x12, 0xa8, 0x29, 0x8a, 0x58, 0x53, 0x54,
+		0xc7, 0xf5, 0x7d, 0xe8, 0x28, 0x0a, 0x66, 0xb7, 0xac, 0xde, 0xd9, 0xd1, 0x07, 0x0a, 0xbc, 0x14,
+		0xa5, 0x4e, 0xbc, 0x9f, 0x9c, 0xa1, 0x8a, 0xad, 0x71, 0x15, 0x13, 0x82, 0x89, 0xf5, 0x97, 0xeb,
+		0xb8, 0x37, 0x56, 0x9a, 0x07, 0xf0, 0xf4, 0x30, 0xeb, 0xc4, 0xa8, 0x37, 0xb1, 0x5e, 0xd6, 0xec,
+		0x72, 0x1d, 0xb7, 0xc2, 0x7a, 0x14, 0x22, 0xf2, 0x80, 0x30, 0x08, 0x4f, 0xdc, 0xa2, 0x7d, 0x17,
+		0xb7, 0xc2, 0xf0, 0x8b, 0xad, 0x44, 0x1c, 0xe8, 0x67, 0x0a, 0xdc, 0xa0, 0xd9, 0x64, 0xb9, 0xb2,
+		0x6d, 0x54, 0xf5, 0x84, 0xfe, 0x73, 0x96, 0x42, 0xbf, 0xc3, 0x85, 0x4e, 0x53, 0xc9, 0x65, 0x47,
+		0x68, 0x12, 0xa7, 0xb9, 0x62, 0x27, 0x67, 0x43, 0x3f, 0x54, 0xe0, 0x5a, 0x8c, 0x12, 0x22, 0xef,
+		0x38, 0x47, 0x35, 0x58, 0x49, 0xaa, 0x81, 0xc8, 0x25, 0x2e, 0xd9, 0x09, 0x79, 0xd0, 0xb7, 0x14,
+		0xb8, 0x2c, 0x44, 0x2d, 0xcc, 0xf3, 0x9f, 0xa5, 0xb0, 0x17, 0xf9, 0x69, 0x08, 0xf7, 0xeb, 0xc2,
+		0xc4, 0x7f, 0xb6, 0x92, 0x80, 0x1e, 0x7d, 0x47, 0x81, 0x2b, 0x42, 0xb8, 0x11, 0x87, 0xc8, 0xf3,
+		0x11, 0x46, 0xce, 0x07, 0x1c, 0x71, 0x9c, 0x2c, 0x56, 0x12, 0x71, 0xa0, 0x77, 0x15, 0xb8, 0x94,
+		0xd8, 0x32, 0x2e, 0x50, 0xc4, 0xff, 0x90, 0x00, 0xb1, 0xc8, 0x28, 0x2e, 0x56, 0x12, 0xd8, 0xc3,
+		0x7b, 0x0a, 0x2c, 0x88, 0x27, 0x58, 0xb8, 0x09, 0x4f, 0x53, 0xb4, 0x4b, 0x49, 0xe6, 0x57, 0xb8,
+		0x13, 0xcf, 0x55, 0x92, 0x30, 0xa0, 0x6f, 0x47, 0x99, 0x44, 0xc4, 0xa1, 0xf9, 0xb9, 0xc4, 0x90,
+		0xc5, 0xc7, 0xe7, 0xb9, 0x4a, 0x12, 0x06, 0x9a, 0x9b, 0x89, 0x21, 0x47, 0x64, 0x92, 0x33, 0x11,
+		0xb9, 0x99, 0x00, 0x73, 0x44, 0x3a, 0x39, 0x5f, 0x49, 0xc6, 0x42, 0x37, 0x4d, 0x37, 0x15, 0xef,
+		0x35, 0xe3, 0xb9, 0x18, 0xb1, 0x69, 0xba, 0x19, 0x77, 0x2f, 0xa9, 0xce, 0x75, 0xbb, 0x37, 0x56,
+		0xf4, 0x73, 0x05, 0x6e, 0x4a, 0x28, 0x24, 0xf2, 0xd1, 0x59, 0xaa, 0x4d, 0xa9, 0x17, 0x6d, 0x44,
+		0xce, 0x7a, 0xd5, 0xee, 0x81, 0x0f, 0xfd, 0x40, 0x81, 0xe7, 0xa3, 0x14, 0x10, 0x9f, 0x9f, 0xe6,
+		0x22, 0x36, 0x20, 0x21, 0x08, 0xf1, 0x39, 0xea, 0x12, 0x4e, 0xc8, 0x43, 0x03, 0x4e, 0xb3, 0x61,
+		0x63, 0x8b, 0x74, 0x80, 0xdb, 0x58, 0xb3, 0x2a, 0xdb, 0x5d, 0x30, 0xc3, 0xb8, 0x8b, 0x11, 0xde,
+		0xfb, 0x80, 0x8a, 0xf3, 0x10, 0xdc, 0xa7, 0xc2, 0x3a, 0x5f, 0xe4, 0x78, 0x6f, 0x33, 0x09, 0xc3,
+		0xd2, 0x20, 0x40, 0x07, 0x48, 0xe1, 0xcd, 0x21, 0xb8, 0x20, 0xbb, 0x7b, 0xad, 0xc2, 0xe1, 0xb6,
+		0x8e, 0x64, 0xaf, 0x81, 0x69, 0x2d, 0x50, 0x54, 0x59, 0xf4, 0x84, 0x6e, 0xec, 0x35, 0xb0, 0x3a,
+		0xd8, 0xea, 0x7a, 0x42, 0xaf, 0xc1, 0xd1, 0x86, 0x66, 0x39, 0x33, 0xd2, 0xed, 0x74, 0x5b, 0x26,
+		0x2b, 0x1f, 0x4e, 0x73, 0xe5, 0xad, 0x53, 0x8e, 0x2e, 0x9f, 0xd8, 0x32, 0xd5, 0xd1, 0x46, 0x78,
+		0x10, 0xdd, 0x84, 0x2c, 0xbd, 0x91, 0xa9, 0x1a, 0x36, 0xa1, 0x85, 0xc5, 0xdc, 0xc2, 0x49, 0xfe,
+		0x95, 0x87, 0x66, 0xef, 0xac, 0x19, 0x36, 0x51, 0x0f, 0x11, 0xf6, 0x17, 0x5a, 0x80, 0x7e, 0xa3,
+		0xde, 0x68, 0x12, 0x5a, 0x76, 0xcc, 0x2d, 0x4c, 0x0a, 0x90, 0xec, 0x55, 0x4d, 0x4d, 0x57, 0x5d,
+		0x52, 0xa4, 0xc1, 0x54, 0x20, 0xe5, 0x28, 0x13, 0xb3, 0x5c, 0xa9, 0x9a, 0x36, 0xa6, 0xf1, 0xdb,
+		0x6c, 0x12, 0x56, 0x87, 0x9c, 0x08, 0xd5, 0x45, 0x6f, 0xb3, 0x4a, 0xb2, 0x3a, 0x89, 0x7d, 0x73,
+		0xbf, 0x61, 0x2e, 0x3b, 0xfc, 0x1b, 0x2e, 0x3b, 0x7a, 0x15, 0x4e, 0x74, 0xae, 0xbd, 0xc3, 0xd2,
+		0x33, 0x71, 0xd2, 0x8f, 0x11, 0xef, 0x32, 0x3b, 0x20, 0xf8, 0x16, 0x1c, 0xef, 0x64, 0xd8, 0x1d,
+		0x2d, 0xac, 0x66, 0xdd, 0xa9, 0xbd, 0x3a, 0xa5, 0xbf, 0xac, 0x7a, 0xac, 0x4d, 0xd1, 0x9e, 0x67,
+		0xb5, 0x59, 0x2f, 0xe9, 0xa8, 0x04, 0x59, 0x16, 0x2a, 0x4d, 0x8b, 0xd6, 0xe1, 0x86, 0x16, 0x2e,
+		0xf2, 0x43, 0x3b, 0x13, 0x40, 0x53, 0xe8, 0x92, 0xc7, 0xa2, 0x76, 0xb8, 0x51, 0x09, 0x46, 0x3a,
+		0x38, 0x9c, 0x70, 0xd5, 0xb4, 0x70, 0x3e, 0x1b, 0xb1, 0x06, 0xab, 0x2e, 0x8d, 0x3a, 0xdc, 0x66,
+		0x63, 0x23, 0x48, 0x85, 0xf1, 0xaa, 0xe6, 0x9c, 0xf9, 0xdc, 0x74, 0x86, 0xaa, 0x83, 0xed, 0x66,
+		0x95, 0xe4, 0x21, 0x42, 0x9e, 0xb7, 0xa6, 0x63, 0x0e, 0xef, 0x72, 0x9b, 0x55, 0xa5, 0x9c, 0xe8,
+		0x06, 0x4c, 0x98, 0x96, 0xf1, 0xc8, 0x70, 0x03, 0x6d, 0x60, 0x96, 0x72, 0x74, 0x96, 0xc6, 0x3d,
+		0x82, 0xc0, 0x24, 0x1d, 0x87, 0x43, 0x86, 0x8e, 0xeb, 0xc4, 0x20, 0x7b, 0xb4, 0xa2, 0x94, 0x55,
+		0xdb, 0xcf, 0xe8, 0x0a, 0x8c, 0x6f, 0x19, 0x96, 0x4d, 0xc2, 0x32, 0x0f, 0x53, 0xca, 0x51, 0xfa,
+		0x36, 0x20, 0x70, 0x19, 0x06, 0x2d, 0x4c, 0xac, 0xbd, 0x72, 0xc3, 0xac, 0x1a, 0x95, 0x3d, 0x56,
+		0x85, 0x99, 0x12, 0x1c, 0x50, 0x89, 0xb5, 0xb7, 0x4e, 0xe9, 0xd4, 0x9c, 0xd5, 0x79, 0x70, 0x4a,
+		0xef, 0x1a, 0x21, 0xb8, 0xd6, 0x20, 0xb4, 0x62, 0xd2, 0xaf, 0x7a, 0x8f, 0x68, 0x19, 0x8e, 0xe0,
+		0xc7, 0x0d, 0xc3, 0x35, 0x1c, 0xb7, 0xa8, 0x3f, 0x1c, 0x5b, 0xd4, 0x1f, 0xea, 0xb0, 0x38, 0x83,
+		0xe8, 0x2c, 0x1c, 0xae, 0x58, 0x8e, 0x37, 0xb0, 0x8a, 0x0e, 0xad, 0x38, 0x64, 0xd5, 0x41, 0x67,
+		0xd0, 0xab, 0xf2, 0xa0, 0x7f, 0x86, 0x13, 0xae, 0xf6, 0xfe, 0xea, 0xd7, 0xa6, 0x56, 0xd9, 0x31,
+		0xb7, 0xb6, 0xf2, 0x28, 0xce, 0xa8, 0xf3, 0x94, 0xbb, 0xbb, 0xf0, 0xb5, 0xe4, 0xb2, 0xa2, 0x39,
+		0xe8, 0xab, 0xe1, 0x9a, 0xc9, 0xae, 0xf3, 0x27, 0xf8, 0x17, 0x7d, 0xb8, 0x66, 0xaa, 0x94, 0x0c,
+		0xa9, 0x30, 0x12, 0x8a, 0xd8, 0xec, 0x4e, 0xfe, 0x59, 0xfe, 0xde, 0x18, 0x88, 0xb0, 0xea, 0xb0,
+		0x1d, 0x18, 0x41, 0x0f, 0x60, 0xbc, 0x61, 0xe1, 0xdd, 0xb2, 0xd6, 0x24, 0xa6, 0x63, 0x7f, 0x98,
+		0x94, 0x1b, 0xa6, 0x51, 0x27, 0xde, 0x2d, 0xbb, 0x68, 0xbd, 0x6c, 0x4c, 0xd6, 0x29, 0x9d, 0x3a,
+		0xea, 0xf0, 0x2f, 0x36, 0x89, 0xd9, 0x35, 0x88, 0xae, 0x40, 0x66, 0x1b, 0x6b, 0x3a, 0xb6, 0xd8,
+		0xf5, 0xf7, 0x09, 0x7e, 0x53, 0x07, 0x25, 0x51, 0x19, 0x29, 0x5a, 0x83, 0x31, 0x77, 0xa2, 0x3b,
+		0xb5, 0x3c, 0xba, 0xae, 0xc7, 0x62, 0xd7, 0x15, 0x51, 0xbe, 0x76, 0x5d, 0x8e, 0xae, 0xed, 0x7f,
+		0xc2, 0x70, 0x43, 0xb3, 0x88, 0xe1, 0x1d, 0xcf, 0xb7, 0x8c, 0x47, 0xf9, 0x3c, 0xed, 0x30, 0xf9,
+		0xa7, 0xfd, 0xb4, 0x59, 0x14, 0xd7, 0x3d, 0xa1, 0xcb, 0x54, 0xe6, 0x4a, 0x9d, 0x58, 0x7b, 0xea,
+		0x91, 0x86, 0x7f, 0x14, 0x9d, 0x04, 0xf0, 0x2e, 0x75, 0x0c, 0x9d, 0x5e, 0x27, 0x67, 0xd5, 0x2c,
+		0x1b, 0x29, 0xe9, 0xc7, 0x97, 0x60, 0x8c, 0x27, 0x07, 0x0d, 0x43, 0x7a, 0x07, 0xef, 0xd1, 0xfd,
+		0x2a, 0xab, 0x3a, 0x7f, 0xa2, 0x31, 0xe8, 0xdf, 0xd5, 0xaa, 0x4d, 0xb7, 0x65, 0x25, 0xab, 0xba,
+		0x0f, 0x37, 0x53, 0x2f, 0x28, 0x85, 0x77, 0x15, 0x78, 0x4e, 0xfe, 0x70, 0x74, 0x15, 0x32, 0x2c,
+		0xbc, 0x28, 0x12, 0xe1, 0x85, 0xd1, 0xa2, 0x55, 0x98, 0x8a, 0xae, 0x8e, 0x1b, 0x3a, 0x05, 0x96,
+		0x56, 0x27, 0xc5, 0x85, 0xed, 0x92, 0x5e, 0xf8, 0xba, 0x02, 0xe7, 0x25, 0x73, 0xac, 0x6b, 0x30,
+		0xe0, 0x05, 0x56, 0x45, 0x22, 0xb0, 0x7a, 0xc4, 0x07, 0x06, 0xd5, 0x84, 0x69, 0xe9, 0x03, 0xc6,
+		0x32, 0x0c, 0xb2, 0xbd, 0xad, 0x93, 0x67, 0x0c, 0x09, 0x7c, 0x86, 0x6d, 0x65, 0x34, 0xcd, 0xc8,
+		0x91, 0xce, 0x43, 0xe1, 0x57, 0x0a, 0x9c, 0x93, 0xe9, 0xb1, 0xf0, 0x27, 0x0c, 0x4a, 0xb2, 0x84,
+		0xe1, 0x2e, 0x8c, 0x0b, 0x36, 0xe5, 0x54, 0x5c, 0xfc, 0x1a, 0xb5, 0x39, 0x1b, 0x72, 0x57, 0x60,
+		0x4e, 0xfb, 0x02, 0x73, 0xe1, 0x0d, 0x05, 0x0a, 0xf1, 0xed, 0x19, 0x68, 0x16, 0x50, 0xb0, 0x64,
+		0xdf, 0x6e, 0xda, 0x1a, 0xb6, 0x7d, 0x53, 0x10, 0xd8, 0x9d, 0x52, 0x81, 0xdd, 0xc9, 0xef, 0x6a,
+		0xe9, 0x80, 0xab, 0x15, 0xfe, 0x18, 0x98, 0x5e, 0xa1, 0x87, 0x24, 0x43, 0x34, 0x0d, 0xc3, 0xfe,
+		0x6b, 0x9b, 0xb6, 0x79, 0x0d, 0xd9, 0x5d, 0x1a, 0x07, 0xb0, 0xa7, 0x03, 0xd8, 0x2f, 0xc0, 0x91,
+		0x4d, 0xa3, 0xae, 0x59, 0x7b, 0xe5, 0xca, 0x36, 0xae, 0xec, 0xd8, 0xcd, 0x1a, 0xcd, 0xe8, 0xb2,
+		0xea, 0x90, 0x3b, 0xbc, 0xcc, 0x46, 0xd1, 0x45, 0x18, 0xf1, 0x5f, 0x36, 0xe2, 0xc7, 0x6e, 0xb6,
+		0x36, 0xa8, 0x0e, 0xe3, 0xee, 0x3b, 0x40, 0xfc, 0x98, 0x14, 0xbe, 0x99, 0x86, 0xb3, 0x12, 0x9d,
+		0x1f, 0x4f, 0x4c, 0xe3, 0xa0, 0x5b, 0xa4, 0x7b, 0x70, 0x0b, 0x74, 0x0a, 0x72, 0x9b, 0x9a, 0x8d,
+		0xbd, 0x4c, 0xc3, 0x9d, 0x96, 0xac, 0x33, 0xe4, 0xe6, 0x17, 0x93, 0x00, 0xce, 0x3d, 0x2b, 0x7b,
+		0xdd, 0xef, 0x4e, 0x6c, 0x1d, 0xb7, 0xdc, 0xb7, 0xb3, 0x80, 0xb6, 0x4c, 0x6b, 0x87, 0x21, 0xf5,
+		0xda, 0xf7, 0x32, 0xae, 0x6a, 0xce, 0x1b, 0x8a, 0xf5, 0xa1, 0x3b, 0x8e, 0xc6, 0x9d, 0xe0, 0xa8,
+		0xd9, 0x66, 0x9d, 0xa5, 0x92, 0xec, 0x09, 0xdd, 0x86, 0xfe, 0x8a, 0xd6, 0xb4, 0x31, 0xcb, 0x1a,
+		0x8b, 0xd2, 0x3d, 0x36, 0xcb, 0x0e, 0x97, 0xea, 0x32, 0x07, 0x0c, 0x34, 0x1b, 0x34, 0xd0, 0xf7,
+		0xd3, 0x70, 0x26, 0xb6, 0x2d, 0xe6, 0x89, 0xad, 0xd5, 0x92, 0xa7, 0xa2, 0xbb, 0x48, 0xb3, 0x92,
+		0x5d, 0x3b, 0x3e, 0x05, 0xbb, 0x42, 0x76, 0x5f, 0x92, 0x90, 0xdd, 0xed, 0x19, 0xfd, 0x01, 0xcf,
+		0x08, 0x2c, 0x7f, 0x26, 0x7a, 0xf9, 0x07, 0xa4, 0x96, 0xff, 0x90, 0x60, 0xf9, 0x39, 0x5e, 0x98,
+		0xe5, 0x7a, 0xa1, 0x7f, 0x25, 0x21, 0xb8, 0x92, 0x5f, 0xcc, 0xc0, 0x39, 0x99, 0x86, 0x22, 0x74,
+		0x1a, 0x72, 0xed, 0xaa, 0x3c, 0x5b, 0xc5, 0xac, 0x0a, 0xde, 0x50, 0x49, 0x77, 0x4e, 0xb0, 0x6d,
+		0x02, 0xea, 0x42, 0xa9, 0x88, 0x13, 0x6c, 0xfb, 0x93, 0xf4, 0x04, 0xab, 0x75, 0x3d, 0x39, 0x86,
+		0xad, 0x9b, 0x35, 0xcd, 0xa8, 0xb3, 0xc8, 0xc3, 0x9e, 0xfc, 0x5b, 0x49, 0x5f, 0x8f, 0x67, 0xcf,
+		0x8c, 0xfc, 0xd9, 0x73, 0x03, 0x26, 0x3c, 0x1b, 0x0d, 0xef, 0x40, 0x03, 0x71, 0x3b, 0xd0, 0xb8,
+		0xc7, 0x1b, 0xd8, 0x84, 0x02, 0x52, 0xd9, 0x06, 0xc7, 0xa4, 0x1e, 0x4a, 0x20, 0xd5, 0x3d, 0x72,
+		0x32, 0xa9, 0xe2, 0xad, 0x32, 0xdb, 0xd3, 0x56, 0xb9, 0x0a, 0x23, 0xdb, 0x58, 0xb3, 0xc8, 0x26,
+		0xd6, 0x3a, 0xe8, 0x20, 0x4e, 0xd4, 0x70, 0x9b, 0xa7, 0x23, 0x27, 0x3e, 0xc1, 0xc9, 0xc5, 0x27,
+		0x38, 0xa1, 0x83, 0xd9, 0x60, 0x2f, 0x07, 0xb3, 0x4e, 0x82, 0x7f, 0x58, 0x3a, 0xc1, 0x2f, 0xfc,
+		0x41, 0x81, 0x42, 0x7c, 0x73, 0xdb, 0x27, 0x96, 0x1a, 0x74, 0x27, 0x31, 0x7d, 0xfe, 0xd3, 0xe5,
+		0x2b, 0x30, 0x48, 0x0f, 0xe7, 0x5e, 0x58, 0xeb, 0x97, 0x08, 0x6b, 0x39, 0x87, 0x83, 0x3d, 0x14,
+		0x7e, 0xa3, 0xf8, 0x43, 0xc1, 0x01, 0xe7, 0xe5, 0xfc, 0x29, 0x4a, 0x25, 0xd8, 0x0d, 0xd2, 0xb1,
+		0xb9, 0x4a, 0x9f, 0x7f, 0x32, 0x0b, 0xbf, 0x56, 0xe0, 0x4c, 0x7c, 0xc7, 0x51, 0xaf, 0xe9, 0xfb,
+		0xa7, 0xa1, 0xd1, 0x8f, 0x53, 0x70, 0x56, 0xa2, 0x6f, 0xcf, 0xd1, 0x49, 0xc7, 0x44, 0x33, 0xaa,
+		0xb6, 0xd4, 0x22, 0x79, 0xc4, 0x4f, 0x4c, 0xa7, 0x60, 0x7e, 0xd5, 0xd7, 0x4b, 0x7e, 0xb5, 0x6f,
+		0x13, 0xff, 0x9c, 0x02, 0x33, 0xf2, 0xed, 0x76, 0x32, 0x7b, 0xde, 0xc1, 0x1c, 0xe0, 0xde, 0x53,
+		0x20, 0x61, 0x63, 0x5d, 0x3c, 0xb6, 0x31, 0x2f, 0x4b, 0x62, 0xa7, 0x70, 0xfa, 0x20, 0x85, 0x38,
+		0x2d, 0x81, 0xf8, 0x9d, 0x80, 0x1d, 0x8a, 0x4a, 0x70, 0xbd, 0xda, 0xe1, 0x2a, 0x4c, 0x55, 0x35,
+		0xd2, 0xd5, 0x60, 0x12, 0x6c, 0xb7, 0xe8, 0xcc, 0xac, 0x4b, 0xc7, 0x5b, 0x4a, 0x37, 0xab, 0xe2,
+		0xd8, 0x73, 0x3a, 0x81, 0x3d, 0xf7, 0xc5, 0xfa, 0x68, 0x20, 0x0f, 0x2c, 0x7c, 0xa0, 0xc0, 0x89,
+		0x88, 0x96, 0x56, 0xe7, 0x27, 0x3f, 0x6e, 0x2b, 0x5f, 0x7b, 0xdd, 0x06, 0xe8, 0x73, 0x49, 0x47,
+		0x6b, 0x70, 0xb4, 0xbd, 0x91, 0x6f, 0x19, 0x56, 0x82, 0x23, 0x2f, 0x62, 0xfb, 0xb8, 0xd3, 0xb2,
+		0x9a, 0x64, 0xfb, 0x95, 0x59, 0xec, 0x7f, 0x83, 0x09, 0x61, 0xaf, 0x6c, 0x94, 0x36, 0xd2, 0x29,
+		0x7d, 0xe1, 0x7d, 0x05, 0x26, 0xa3, 0xda, 0x24, 0x0f, 0xe4, 0x2b, 0x07, 0x35, 0x1f, 0x91, 0x01,
+		0xfa, 0xfb, 0x0a, 0x4c, 0xc5, 0xb5, 0x5b, 0x46, 0x69, 0xf3, 0x44, 0xdd, 0x36, 0x12, 0xf9, 0x5f,
+		0x06, 0x20, 0x61, 0x57, 0x0f, 0x9a, 0x87, 0x31, 0xda, 0x38, 0x14, 0xbc, 0x63, 0x77, 0x75, 0x1a,
+		0xa9, 0xe3, 0x56, 0xe0, 0x86, 0x3d, 0x54, 0xe6, 0x4a, 0xf5, 0x56, 0xe6, 0x7a, 0x5a, 0x88, 0x92,
+		0x2f, 0x44, 0xc9, 0xd8, 0xce, 0x80, 0x84, 0xed, 0xdc, 0x83, 0x71, 0x56, 0x40, 0x60, 0x18, 0x8d,
+		0x3a, 0xc1, 0xd6, 0xae, 0x56, 0x8d, 0x3f, 0xb7, 0x8c, 0x31, 0x46, 0x0a, 0xaf, 0xc4, 0xd8, 0xfc,
+		0x45, 0xae, 0xec, 0xbe, 0x8a, 0x5c, 0x5d, 0x29, 0x1c, 0x24, 0x49, 0xe1, 0xc4, 0x15, 0xad, 0x5c,
+		0xcf, 0x15, 0xad, 0xce, 0x39, 0x63, 0x50, 0xbe, 0x90, 0xe0, 0xd5, 0x55, 0x0e, 0xef, 0xa3, 0xae,
+		0x32, 0xb4, 0xaf, 0xba, 0x4a, 0xe1, 0xf7, 0x0a, 0xcc, 0x27, 0x6d, 0x2d, 0x6c, 0x47, 0x2b, 0xa5,
+		0x3b, 0x5a, 0x45, 0x9d, 0x6f, 0x36, 0xe1, 0x58, 0xbb, 0x1d, 0x21, 0x50, 0xa2, 0x76, 0xfd, 0x78,
+		0x26, 0xb2, 0xe1, 0xc0, 0x5f, 0xa4, 0x3e, 0x8a, 0x79, 0xc3, 0x81, 0x33, 0x54, 0x5f, 0xf0, 0xce,
+		0xe3, 0x1b, 0x0a, 0x4c, 0x0b, 0x14, 0xe5, 0x15, 0xe6, 0xe3, 0xbd, 0x47, 0x91, 0xf0, 0x9e, 0xae,
+		0x44, 0x28, 0x95, 0x20, 0x11, 0x2a, 0x7c, 0xac, 0xc0, 0xc9, 0xc8, 0xce, 0x79, 0x27, 0x13, 0x64,
+		0x7d, 0xf9, 0x75, 0xad, 0xe6, 0xad, 0x04, 0xb8, 0x43, 0x77, 0xb5, 0x1a, 0xee, 0xf5, 0xd3, 0x07,
+		0xb6, 0xe9, 0x74, 0x1c, 0xa2, 0x4f, 0xfe, 0xe0, 0xfd, 0x23, 0xde, 0x22, 0x89, 0x3a, 0x45, 0x4e,
+		0x43, 0x8e, 0xf5, 0xea, 0x74, 0x4f, 0x81, 0x3b, 0x44, 0xa7, 0xa0, 0x1d, 0xf3, 0x53, 0xf2, 0x31,
+		0x3f, 0xea, 0x12, 0x3c, 0xc6, 0xc2, 0x3e, 0xaf, 0xc0, 0x4c, 0x82, 0xe6, 0xa9, 0xce, 0x5d, 0xae,
+		0xe2, 0xbb, 0xcb, 0xed, 0x75, 0xe1, 0x22, 0x90, 0x17, 0x7e, 0x9a, 0x82, 0x97, 0xf7, 0xd7, 0x40,
+		0x7e, 0x60, 0x2e, 0xd1, 0xb9, 0xe9, 0x4b, 0xf9, 0x6e, 0xfa, 0x1e, 0x00, 0x0a, 0x37, 0x2a, 0xb1,
+		0xe8, 0x70, 0x5e, 0xae, 0x10, 0xaa, 0x8e, 0x84, 0xba, 0x8d, 0x9d, 0xab, 0x93, 0x8a, 0x59, 0x27,
+		0x96, 0x59, 0xa5, 0x0b, 0x36, 0xa8, 0x7a, 0x8f, 0xa8, 0x08, 0xa3, 0x81, 0x9e, 0x3b, 0xb3, 0x5e,
+		0x75, 0xf3, 0xfa, 0x43, 0xea, 0x88, 0xaf, 0x15, 0xee, 0x5e, 0xbd, 0xba, 0x57, 0x78, 0x3b, 0x0d,
+		0xb7, 0xf6, 0xd1, 0xa0, 0x8e, 0x1e, 0x74, 0x47, 0xcd, 0x21, 0xc1, 0xcf, 0x3f, 0xa4, 0x24, 0xfb,
+		0xee, 0xb4, 0x0f, 0xe8, 0x34, 0x2a, 0xbc, 0x81, 0xe5, 0xaf, 0x4b, 0xdf, 0x7e, 0xd7, 0x65, 0x16,
+		0x50, 0xb0, 0x2d, 0x90, 0x55, 0x47, 0xd2, 0xea, 0xb0, 0xe1, 0x33, 0x42, 0xf7, 0x02, 0xcc, 0x5b,
+		0xc5, 0x8c, 0x6f, 0x15, 0x0b, 0xbf, 0x55, 0xe0, 0x7a, 0x8f, 0xdd, 0xf5, 0x02, 0x0c, 0x8a, 0x00,
+		0xc3, 0x27, 0x6b, 0xb8, 0x85, 0x37, 0xd3, 0x70, 0xbd, 0xc7, 0x0e, 0xc8, 0xbf, 0x57, 0x5f, 0x0d,
+		0x04, 0xf4, 0x3e, 0x71, 0x40, 0xef, 0x97, 0x0f, 0xe8, 0x42, 0xd3, 0x11, 0x05, 0x80, 0x01, 0x51,
+		0x00, 0xf8, 0xbf, 0x34, 0x5c, 0xed, 0xa5, 0x8b, 0x53, 0xce, 0xf3, 0xa5, 0x24, 0x3f, 0xf5, 0xfc,
+		0x8e, 0xe7, 0x7f, 0xa4, 0xc0, 0xa5, 0xa4, 0x1d, 0xa9, 0x7f, 0xd3, 0x2e, 0x2f, 0xde, 0xab, 0x0a,
+		0xbf, 0x54, 0x60, 0x2e, 0x51, 0x17, 0xeb, 0x81, 0x85, 0x00, 0xee, 0x99, 0x23, 0xb5, 0xbf, 0x33,
+		0xc7, 0x87, 0x59, 0xb8, 0xd2, 0xc3, 0xcf, 0x71, 0xba, 0x96, 0x43, 0xf1, 0x2d, 0xc7, 0x69, 0xc8,
+		0xb5, 0x97, 0x83, 0xd9, 0x7c, 0x56, 0x05, 0x6f, 0x88, 0x77, 0x01, 0x91, 0x3e, 0x80, 0x0b, 0x88,
+		0x5e, 0xab, 0x91, 0xfd, 0x07, 0x7b, 0x01, 0x91, 0x79, 0xa2, 0x17, 0x10, 0x03, 0x3d, 0x5f, 0x40,
+		0x3c, 0x04, 0xd6, 0x4c, 0xcc, 0x24, 0xb2, 0x22, 0x9e, 0xdb, 0xa0, 0x70, 0x3e, 0xa2, 0x23, 0x99,
+		0x4a, 0x61, 0xa5, 0xbc, 0x91, 0x46, 0x70, 0xa8, 0xdb, 0x49, 0xb2, 0xfe, 0x78, 0x2e, 0x63, 0xf2,
+		0x20, 0x61, 0xf2, 0x15, 0xc8, 0x77, 0x99, 0x53, 0xd9, 0xc2, 0xcd, 0x0e, 0xfc, 0x1c, 0x85, 0x3f,
+		0x13, 0x69, 0x38, 0x25, 0x5d, 0xc5, 0x4d, 0x0f, 0xaf, 0x7a, 0xb4, 0xc5, 0x1b, 0x0e, 0x15, 0x37,
+		0x0f, 0xf7, 0x52, 0xdc, 0x0c, 0xb5, 0x85, 0x0e, 0x71, 0xda, 0x42, 0x3b, 0x07, 0xb1, 0x23, 0xc9,
+		0x6f, 0x26, 0x86, 0xf7, 0x71, 0x33, 0x31, 0xb2, 0xbf, 0x8e, 0xcf, 0x9b, 0x90, 0xd3, 0x71, 0x55,
+		0xdb, 0x73, 0x4d, 0x33, 0xbe, 0x7d, 0x15, 0x28, 0x35, 0x35, 0x45, 0xf4, 0x22, 0x0c, 0xfe, 0xbb,
+		0x41, 0x88, 0xf7, 0xaf, 0x29, 0xf2, 0xa3, 0x71, 0xcc, 0x39, 0x97, 0xbc, 0xcd, 0xed, 0xf6, 0x77,
+		0x3a, 0x17, 0x9b, 0x1a, 0xc9, 0x8f, 0xc5, 0xf6, 0x75, 0x02, 0xa5, 0x57, 0x9b, 0xf5, 0x45, 0x52,
+		0x78, 0x2b, 0x0d, 0x97, 0x92, 0xfe, 0x54, 0xef, 0xd3, 0x0f, 0x6d, 0x6b, 0x5e, 0x8e, 0xe2, 0xd6,
+		0xe8, 0xae, 0x25, 0xfe, 0x9d, 0x99, 0x2f, 0x35, 0xe9, 0x72, 0xd2, 0x7e, 0xbf, 0x93, 0xf2, 0x37,
+		0xe0, 0x8c, 0x60, 0x03, 0x3e, 0xa0, 0x5b, 0xcc, 0xc2, 0x2f, 0x52, 0x30, 0x9b, 0xe4, 0x77, 0x88,
+		0xc2, 0xf5, 0xe0, 0xef, 0xfc, 0xa9, 0xfd, 0xee, 0xfc, 0x07, 0xb5, 0x8a, 0xfc, 0xd9, 0xed, 0x13,
+		0xcc, 0x6e, 0x27, 0x32, 0xf4, 0xcb, 0x5f, 0xd1, 0x7c, 0x9c, 0x82, 0x84, 0xbf, 0x90, 0xfc, 0x6c,
+		0x4c, 0x26, 0xaf, 0x20, 0xd5, 0xcf, 0x2d, 0x48, 0x75, 0x3a, 0x29, 0x32, 0xf2, 0x9d, 0x14, 0x85,
+		0x3f, 0xa5, 0xe0, 0xe2, 0x41, 0x44, 0x94, 0xcf, 0xe8, 0xa4, 0x77, 0xd5, 0x0a, 0x32, 0x09, 0x6a,
+		0x05, 0x85, 0x3f, 0xa7, 0x60, 0x2e, 0xd1, 0x0f, 0x56, 0x9f, 0x4e, 0x7c, 0x68, 0xe2, 0xbd, 0xeb,
+		0xcc, 0x4c, 0x92, 0x2b, 0xf0, 0xff, 0x4e, 0x8b, 0x26, 0x5e, 0xd4, 0xfd, 0xf2, 0x74, 0xe2, 0x23,
+		0x9b, 0x6f, 0x32, 0xbd, 0xf4, 0xfc, 0xff, 0x24, 0x05, 0xf3, 0x09, 0x7f, 0x48, 0xfc, 0x74, 0x1d,
+		0x7c, 0xeb, 0x30, 0x43, 0xe0, 0x08, 0xfd, 0x73, 0xd5, 0xa8, 0x12, 0x6c, 0xd1, 0x4f, 0x9d, 0x84,
+		0x89, 0x95, 0x87, 0x2b, 0x77, 0x37, 0xca, 0xab, 0xa5, 0xb5, 0x8d, 0x15, 0xb5, 0xbc, 0xf1, 0x2f,
+		0xeb, 0x2b, 0xe5, 0xd2, 0xdd, 0x87, 0x8b, 0x6b, 0xa5, 0xdb, 0xc3, 0xcf, 0xa0, 0xd3, 0x70, 0x22,
+		0xfc, 0x7a, 0x71, 0x6d, 0xad, 0x4c, 0x47, 0x87, 0x15, 0x74, 0x06, 0x4e, 0x86, 0x09, 0x96, 0xd7,
+		0xee, 0xdd, 0x5f, 0x61, 0x24, 0xa9, 0xa5, 0xd7, 0xe0, 0x58, 0xc5, 0xac, 0xf1, 0xe6, 0x60, 0xc9,
+		0xfb, 0x57, 0xb4, 0xeb, 0x96, 0x49, 0xcc, 0x75, 0xe5, 0x5f, 0x2f, 0x3f, 0x32, 0xc8, 0x76, 0x73,
+		0xb3, 0x58, 0x31, 0x6b, 0xf3, 0xdd, 0xff, 0x12, 0x77, 0xce, 0xd0, 0xab, 0xf3, 0x8f, 0x4c, 0xf7,
+		0xdf, 0xf0, 0xb2, 0xff, 0x8f, 0x7b, 0x4b, 0x6b, 0x18, 0xbb, 0x97, 0x37, 0x33, 0x74, 0xec, 0xca,
+		0x5f, 0x07, 0x00, 0xf6, 0x46, 0xec, 0xce, 0x02, 0x58, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/service_workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x5b, 0x6f, 0xdc, 0xc6,
-		0xf5, 0x07, 0x75, 0xd7, 0x59, 0x49, 0x96, 0xc7, 0xba, 0xac, 0x57, 0xd6, 0x8d, 0x8e, 0xf3, 0xd7,
-		0xdf, 0xb1, 0x57, 0xb5, 0x14, 0x5f, 0xe2, 0xa4, 0x0d, 0x64, 0xd9, 0x72, 0x54, 0xd8, 0x81, 0x4a,
-		0xa9, 0x31, 0xda, 0x17, 0x62, 0x44, 0x8e, 0x56, 0x63, 0x71, 0x49, 0x6a, 0x66, 0x28, 0x65, 0x93,
-		0x87, 0xa2, 0x45, 0x90, 0x02, 0xbd, 0xa1, 0x7d, 0x2c, 0x50, 0xa0, 0x0f, 0x7d, 0xc8, 0x53, 0xf3,
-		0xd2, 0xaf, 0x50, 0xe4, 0x7b, 0xf4, 0x13, 0xf4, 0x13, 0x34, 0x28, 0x38, 0x33, 0xdc, 0x2b, 0xc9,
-		0xdd, 0x95, 0x11, 0xd8, 0xe9, 0xdb, 0xce, 0xcc, 0xf9, 0x9d, 0x39, 0x73, 0x6e, 0x73, 0xe6, 0x2c,
-		0xe1, 0x66, 0x74, 0x48, 0xd8, 0xba, 0x83, 0x5d, 0xe2, 0x3b, 0x64, 0x1d, 0x87, 0x74, 0xfd, 0xec,
-		0xce, 0x3a, 0x27, 0xec, 0x8c, 0x3a, 0xc4, 0x3e, 0x0f, 0xd8, 0xc9, 0x91, 0x17, 0x9c, 0x97, 0x43,
-		0x16, 0x88, 0x00, 0x5d, 0x89, 0x69, 0xcb, 0x9a, 0xb6, 0x8c, 0x43, 0x5a, 0x3e, 0xbb, 0x53, 0x5a,
-		0xaa, 0x04, 0x41, 0xc5, 0x23, 0xeb, 0x92, 0xe4, 0x30, 0x3a, 0x5a, 0x77, 0x23, 0x86, 0x05, 0x0d,
-		0x7c, 0x05, 0x2a, 0x2d, 0xb7, 0xaf, 0x0b, 0x5a, 0x25, 0x5c, 0xe0, 0x6a, 0xa8, 0x09, 0x56, 0xd2,
-		0x24, 0x70, 0x82, 0x6a, 0xb5, 0xce, 0x62, 0x35, 0x8d, 0xe2, 0x98, 0x72, 0x11, 0xb0, 0x5a, 0xb2,
-		0x4b, 0x1a, 0xc9, 0x69, 0x44, 0xea, 0x04, 0x66, 0x1a, 0x81, 0xc0, 0xfc, 0xc4, 0xa3, 0x5c, 0xe4,
-		0xd1, 0xb4, 0xea, 0xc0, 0xfc, 0xa7, 0x01, 0xcb, 0x56, 0x2c, 0x3f, 0x13, 0x2f, 0xf4, 0xca, 0x93,
-		0x4f, 0x89, 0x13, 0xc5, 0x27, 0xb6, 0xc8, 0x69, 0x44, 0xb8, 0x40, 0x73, 0x30, 0xe2, 0x06, 0x55,
-		0x4c, 0xfd, 0xa2, 0xb1, 0x62, 0xac, 0x8d, 0x5b, 0x7a, 0x84, 0x7e, 0x0a, 0x28, 0xe1, 0x66, 0x93,
-		0x04, 0x54, 0x1c, 0x58, 0x31, 0xd6, 0x0a, 0x1b, 0x6f, 0x97, 0x53, 0x94, 0x5b, 0xee, 0xdc, 0xe2,
-		0xf2, 0x79, 0xfb, 0x14, 0x2a, 0xc1, 0x18, 0x75, 0x89, 0x2f, 0xa8, 0xa8, 0x15, 0x07, 0xe5, 0x86,
-		0xf5, 0x71, 0x2c, 0x0a, 0x23, 0x98, 0x07, 0x7e, 0x71, 0x48, 0x89, 0xa2, 0x46, 0xe6, 0xdf, 0x0d,
-		0x58, 0x79, 0x4c, 0x71, 0xc5, 0x0f, 0x38, 0xf9, 0x1e, 0x9c, 0xc3, 0xfc, 0xca, 0x80, 0xd5, 0x1c,
-		0x79, 0x79, 0x18, 0xf8, 0x9c, 0x64, 0x0a, 0xfc, 0x12, 0x16, 0x5d, 0x05, 0x16, 0xd4, 0xb1, 0x5f,
-		0x59, 0xf6, 0x85, 0x06, 0xb3, 0x8e, 0x45, 0xf3, 0x9b, 0x31, 0x58, 0xdc, 0xbf, 0x90, 0x7b, 0x2c,
-		0x43, 0xa1, 0x2e, 0x1a, 0x75, 0xa5, 0x4c, 0xe3, 0x16, 0x24, 0x53, 0xbb, 0x2e, 0xda, 0x81, 0xc9,
-		0x3a, 0x81, 0xa8, 0x85, 0x44, 0x6a, 0xa9, 0xb0, 0xb1, 0x9a, 0x2b, 0xf6, 0x41, 0x2d, 0x24, 0xd6,
-		0xc4, 0x79, 0xd3, 0x08, 0x3d, 0x84, 0xf1, 0xd8, 0xf3, 0xed, 0xd8, 0xf5, 0xa5, 0x5f, 0x14, 0x36,
-		0x16, 0x53, 0x79, 0x1c, 0x60, 0x7e, 0xf2, 0x8c, 0x72, 0x61, 0x8d, 0x09, 0xfd, 0x0b, 0x6d, 0xc0,
-		0x30, 0xf5, 0xc3, 0x48, 0x14, 0x87, 0x25, 0xee, 0x5a, 0x2a, 0x6e, 0x0f, 0xd7, 0xbc, 0x00, 0xbb,
-		0x96, 0x22, 0x45, 0x18, 0x56, 0xea, 0xaa, 0xb6, 0x65, 0xe8, 0xd8, 0x22, 0xb0, 0x1d, 0x2f, 0xe0,
-		0xc4, 0x8e, 0xb3, 0x41, 0x10, 0x89, 0xe2, 0x88, 0x64, 0x77, 0xb5, 0xac, 0xb2, 0x45, 0x39, 0xc9,
-		0x16, 0xe5, 0xc7, 0x3a, 0x9b, 0x58, 0xd7, 0xea, 0x2c, 0xa4, 0x76, 0x0f, 0x82, 0xed, 0x18, 0x7f,
-		0xa0, 0xe0, 0xe8, 0x05, 0x2c, 0xc8, 0x23, 0x65, 0x70, 0x1f, 0xed, 0xc6, 0x7d, 0x3e, 0x46, 0xa7,
-		0x31, 0x6e, 0x76, 0xca, 0xb1, 0xb6, 0xe0, 0x5a, 0x04, 0x60, 0xca, 0xa6, 0xb1, 0xbd, 0xc6, 0xe5,
-		0xea, 0xb8, 0x9e, 0xd9, 0x75, 0x91, 0x03, 0xc5, 0x26, 0x7b, 0xda, 0x8c, 0x44, 0x9c, 0xd8, 0x61,
-		0xe0, 0x51, 0xa7, 0x56, 0x84, 0x15, 0x63, 0x6d, 0x6a, 0xe3, 0x66, 0xae, 0xe5, 0x76, 0x5d, 0x2b,
-		0x86, 0xec, 0x49, 0x84, 0x35, 0x7b, 0x9e, 0x36, 0x8d, 0xb6, 0x61, 0x82, 0x11, 0xc1, 0x6a, 0x09,
-		0xe3, 0x82, 0x3c, 0xe9, 0x4a, 0x2a, 0x63, 0x2b, 0x26, 0xd4, 0xec, 0x0a, 0xac, 0x31, 0x40, 0xd7,
-		0x61, 0xd2, 0x61, 0xb1, 0x6d, 0x9c, 0x63, 0xe2, 0x46, 0x1e, 0x29, 0x4e, 0xc8, 0xb3, 0x4c, 0xc4,
-		0x93, 0xfb, 0x7a, 0x0e, 0xdd, 0x86, 0xa1, 0x2a, 0xa9, 0x06, 0xc5, 0x49, 0xad, 0xcb, 0xb4, 0x1d,
-		0x9e, 0x93, 0x6a, 0x60, 0x49, 0x32, 0x64, 0xc1, 0x65, 0x4e, 0x30, 0x73, 0x8e, 0x6d, 0x2c, 0x04,
-		0xa3, 0x87, 0x91, 0x20, 0xbc, 0x38, 0x25, 0xb1, 0x37, 0x52, 0xb1, 0xfb, 0x92, 0x7a, 0xab, 0x4e,
-		0x6c, 0x4d, 0xf3, 0xb6, 0x19, 0xb4, 0x09, 0x23, 0xc7, 0x04, 0xbb, 0x84, 0x15, 0x2f, 0x49, 0x46,
-		0x0b, 0xa9, 0x8c, 0x3e, 0x92, 0x24, 0x96, 0x26, 0x45, 0x0f, 0xa1, 0xe0, 0x12, 0x0f, 0xd7, 0x94,
-		0x6f, 0x14, 0xa7, 0xbb, 0xb9, 0x02, 0x48, 0x6a, 0xe9, 0x0b, 0xe8, 0x03, 0x98, 0x78, 0x49, 0x85,
-		0x20, 0x4c, 0x83, 0x2f, 0x77, 0x03, 0x17, 0x14, 0x79, 0x1d, 0x7d, 0x44, 0x19, 0x17, 0x36, 0x8b,
-		0x7c, 0x1b, 0x8b, 0x22, 0x92, 0xe8, 0x52, 0x07, 0xfa, 0x20, 0xb9, 0x11, 0x2d, 0x90, 0xf4, 0x56,
-		0xe4, 0x6f, 0x09, 0xf3, 0x3e, 0x2c, 0x65, 0xe5, 0x11, 0x9d, 0xee, 0x66, 0x61, 0x24, 0xe6, 0x4c,
-		0x5d, 0x9d, 0x48, 0x86, 0x59, 0xe4, 0xef, 0xba, 0x26, 0x03, 0x33, 0x1d, 0xb8, 0xc5, 0x6b, 0xbe,
-		0x93, 0x64, 0xa1, 0x67, 0x30, 0xaa, 0x5d, 0x55, 0xa2, 0x0b, 0x1b, 0x1b, 0xe9, 0x56, 0xc9, 0x4b,
-		0x65, 0x56, 0xc2, 0xc2, 0xbc, 0x01, 0xd7, 0x73, 0xf7, 0x54, 0x12, 0x9b, 0xef, 0xc1, 0x4a, 0xf6,
-		0xe5, 0x99, 0x7f, 0xaa, 0x6f, 0x06, 0x60, 0x69, 0x9f, 0x56, 0x7c, 0xec, 0x7d, 0x1f, 0xee, 0xdd,
-		0xd6, 0xd4, 0x30, 0xd4, 0x9e, 0x1a, 0x96, 0xa1, 0xc0, 0xe5, 0x59, 0x6c, 0x1f, 0x57, 0x89, 0xcc,
-		0xa5, 0xe3, 0x16, 0xa8, 0xa9, 0x8f, 0x71, 0x95, 0xa0, 0x0f, 0x61, 0x42, 0x13, 0xa8, 0x6c, 0x3b,
-		0xd2, 0x43, 0xb6, 0xd5, 0x2c, 0x77, 0x65, 0xce, 0x2d, 0xc2, 0xa8, 0x13, 0xf8, 0x82, 0x05, 0x9e,
-		0x4c, 0x7e, 0x13, 0x56, 0x32, 0x34, 0x57, 0x61, 0x39, 0x53, 0x8f, 0xda, 0x4c, 0xdf, 0x1a, 0xf0,
-		0x7f, 0x9a, 0x86, 0x8a, 0xe3, 0xfc, 0xdb, 0xec, 0x05, 0x4c, 0xaa, 0xa4, 0xfb, 0xea, 0xde, 0x34,
-		0x21, 0x19, 0x25, 0x8c, 0xdb, 0x74, 0x34, 0xd0, 0x55, 0x47, 0x83, 0xaf, 0xa0, 0xa3, 0xa1, 0x56,
-		0x1d, 0x6d, 0xc1, 0x5a, 0xf7, 0xf3, 0xe7, 0xfb, 0xeb, 0x97, 0x06, 0xdc, 0xea, 0xc6, 0xa3, 0x25,
-		0x20, 0x3f, 0x69, 0x0f, 0xc8, 0x0f, 0xd2, 0x55, 0xd8, 0x9b, 0x5d, 0x1a, 0xa1, 0xb9, 0x0e, 0xb7,
-		0x7b, 0x94, 0x43, 0x5b, 0xff, 0xeb, 0x01, 0x58, 0xb4, 0x08, 0x27, 0x6f, 0x4c, 0x81, 0xdb, 0x28,
-		0x62, 0x07, 0x9b, 0x8b, 0x58, 0x74, 0x1f, 0x8a, 0x2e, 0x71, 0x28, 0x8f, 0xcb, 0x8a, 0x23, 0xea,
-		0x53, 0x7e, 0x6c, 0x93, 0x33, 0xe2, 0xd7, 0x43, 0x6e, 0xd0, 0x9a, 0x4d, 0xd6, 0x77, 0xe4, 0xf2,
-		0x93, 0x78, 0x75, 0xd7, 0x6d, 0x8b, 0xce, 0xe1, 0xf6, 0xe8, 0x2c, 0xc3, 0x15, 0x7e, 0x42, 0x43,
-		0x5b, 0x7b, 0x17, 0x23, 0x38, 0x0c, 0xbd, 0x9a, 0x8c, 0xc1, 0x31, 0xeb, 0x72, 0xbc, 0xa4, 0x14,
-		0x6a, 0xa9, 0x85, 0x38, 0x53, 0x67, 0xe9, 0x2b, 0xdf, 0x47, 0xfe, 0x32, 0x00, 0x37, 0xb4, 0x4e,
-		0xb7, 0xb1, 0xef, 0x90, 0xff, 0x85, 0xd4, 0x36, 0x03, 0xc3, 0x0e, 0x8e, 0x78, 0x92, 0xd4, 0xd4,
-		0x00, 0x6d, 0xc2, 0x9c, 0xba, 0x0a, 0x1b, 0x85, 0xa0, 0x56, 0xc8, 0x88, 0x24, 0xbb, 0x22, 0x57,
-		0x1b, 0x32, 0x49, 0xf5, 0xac, 0xc1, 0xdb, 0xdd, 0xb4, 0xa3, 0x5d, 0xf6, 0x1f, 0x03, 0xb0, 0x7a,
-		0x40, 0x58, 0x95, 0xfa, 0x58, 0x90, 0x37, 0xdd, 0x6d, 0xef, 0xc1, 0xa8, 0x4b, 0x04, 0xa6, 0x1e,
-		0xd7, 0xc5, 0x77, 0x7e, 0xca, 0x4a, 0x88, 0x5b, 0x8c, 0x32, 0xdc, 0x66, 0x94, 0x0b, 0xe9, 0xf7,
-		0x2d, 0x30, 0xf3, 0x94, 0xa6, 0x75, 0xfb, 0xa7, 0xf8, 0xa9, 0x48, 0xb8, 0xc3, 0xe8, 0xe1, 0x9b,
-		0xa2, 0x5a, 0xf3, 0xdb, 0x41, 0x58, 0xcd, 0x91, 0x49, 0x47, 0x9d, 0x07, 0xf3, 0x0d, 0x75, 0x38,
-		0x81, 0x7f, 0x44, 0x2b, 0xba, 0x4e, 0xd3, 0x19, 0x76, 0xb3, 0x37, 0x09, 0xb6, 0x9b, 0xa1, 0xd6,
-		0x1c, 0x49, 0x9d, 0x47, 0x87, 0x30, 0xdf, 0x79, 0x54, 0x9b, 0xfa, 0x47, 0x81, 0x3e, 0xef, 0xcd,
-		0xde, 0x76, 0xdb, 0xf5, 0x8f, 0x82, 0x46, 0xb5, 0xdf, 0x32, 0x8d, 0x5e, 0x00, 0x0a, 0x89, 0xef,
-		0x52, 0xbf, 0x62, 0x63, 0x47, 0xd0, 0x33, 0x2a, 0x28, 0xe1, 0xc5, 0xc1, 0x95, 0xc1, 0xb5, 0xc2,
-		0xc6, 0x5a, 0xba, 0x17, 0x29, 0xf2, 0x2d, 0x45, 0x5d, 0x93, 0xcc, 0x2f, 0x87, 0x2d, 0x93, 0x94,
-		0x70, 0xf4, 0x33, 0x98, 0x4e, 0x18, 0x3b, 0xc7, 0xd4, 0x73, 0x19, 0xf1, 0x8b, 0x43, 0x92, 0x6d,
-		0x39, 0x8f, 0xed, 0x76, 0x4c, 0xdb, 0x2a, 0xf9, 0xa5, 0xb0, 0x69, 0x89, 0x11, 0x1f, 0xed, 0x37,
-		0x58, 0x27, 0xd9, 0x58, 0x3f, 0x1e, 0x73, 0x25, 0x7e, 0xac, 0x69, 0x5b, 0x98, 0x26, 0x93, 0xe6,
-		0x17, 0x83, 0x30, 0xf3, 0x93, 0x88, 0xb0, 0x5a, 0xa2, 0xbe, 0xd7, 0x14, 0xe3, 0x0f, 0x60, 0x58,
-		0x76, 0x99, 0x74, 0xf1, 0x61, 0xe6, 0x72, 0x92, 0x02, 0x5b, 0x0a, 0x80, 0x6c, 0x98, 0x93, 0x3f,
-		0x6c, 0x46, 0x5e, 0x12, 0x47, 0xc4, 0xfe, 0xe9, 0x52, 0x29, 0xd4, 0x90, 0x7c, 0x1b, 0xfe, 0x7f,
-		0x2a, 0x2b, 0xc5, 0x42, 0x22, 0xb6, 0x13, 0x80, 0x35, 0x73, 0x9a, 0x32, 0x1b, 0xfb, 0xa3, 0xda,
-		0xc0, 0x09, 0x7c, 0x4e, 0xb9, 0x20, 0xbe, 0x53, 0xb3, 0x3d, 0x72, 0x46, 0x3c, 0xa9, 0xfe, 0xac,
-		0xd7, 0xa7, 0xdc, 0x61, 0xbb, 0x01, 0x79, 0x16, 0x23, 0xac, 0xd9, 0xd3, 0xb4, 0x69, 0xf3, 0x6f,
-		0x06, 0xcc, 0xb6, 0x99, 0x41, 0xc7, 0xde, 0x87, 0x30, 0x91, 0x1c, 0x8f, 0x47, 0x5e, 0x52, 0xd2,
-		0x74, 0x29, 0xce, 0xf4, 0x39, 0x62, 0x00, 0xda, 0x85, 0xa9, 0x66, 0xfd, 0x10, 0x57, 0x1b, 0xcb,
-		0xec, 0xa6, 0x17, 0xe2, 0x5a, 0x93, 0xa7, 0xcd, 0x43, 0xf3, 0xdf, 0x06, 0xcc, 0x27, 0xd9, 0xa2,
-		0xde, 0xd2, 0xe8, 0xe2, 0x2f, 0x2d, 0x3d, 0x92, 0x81, 0xfe, 0x7a, 0x24, 0x4f, 0x61, 0xaa, 0x8e,
-		0x6d, 0x34, 0x6a, 0xa6, 0x32, 0x1a, 0x35, 0x09, 0x03, 0xd5, 0xa8, 0x11, 0x4d, 0xa3, 0xb8, 0xc0,
-		0xa1, 0xbe, 0xe3, 0x45, 0x2e, 0xb1, 0x1b, 0x0c, 0xb9, 0xc0, 0x22, 0x52, 0x57, 0xc7, 0x98, 0x35,
-		0xab, 0xd7, 0x13, 0x26, 0xfb, 0x72, 0xd1, 0xfc, 0x8f, 0x01, 0xc5, 0xce, 0x13, 0x6b, 0xd3, 0xbc,
-		0x07, 0xa3, 0x61, 0xe0, 0x79, 0x84, 0xf1, 0xa2, 0x21, 0x43, 0x7c, 0x39, 0xdd, 0x2a, 0x92, 0x46,
-		0x86, 0x5f, 0x42, 0x8f, 0x9e, 0xc3, 0x74, 0x87, 0x20, 0x4a, 0x39, 0xd7, 0x73, 0xcf, 0xa6, 0xc4,
-		0xb2, 0xa6, 0x44, 0xcb, 0x18, 0xbd, 0x80, 0xe9, 0x10, 0x33, 0x41, 0x9b, 0x12, 0xb4, 0x0e, 0xa4,
-		0x5b, 0xb9, 0xec, 0xf6, 0x12, 0x90, 0xca, 0xc0, 0xd6, 0xa5, 0xb0, 0x75, 0xc2, 0xbc, 0x0b, 0x0b,
-		0x4f, 0x89, 0x48, 0xc8, 0xf9, 0xa3, 0xda, 0x63, 0x69, 0xd5, 0x2e, 0x46, 0x37, 0xff, 0x30, 0x04,
-		0xd7, 0xd2, 0x71, 0x5a, 0x75, 0xbf, 0x80, 0xb9, 0x7a, 0xc5, 0xd9, 0x50, 0x44, 0x15, 0x87, 0x5a,
-		0x93, 0x3f, 0x4e, 0x15, 0x3b, 0x8f, 0x65, 0x39, 0x49, 0x69, 0x09, 0xc5, 0x73, 0x1c, 0x3e, 0xf1,
-		0x05, 0xab, 0x59, 0x57, 0xdc, 0xce, 0x95, 0x58, 0x00, 0x9d, 0xf8, 0x6b, 0x6d, 0x02, 0x0c, 0x5c,
-		0x54, 0x80, 0xe4, 0x6a, 0xe8, 0x14, 0x00, 0x77, 0xae, 0x94, 0xa2, 0xd8, 0xb1, 0xd2, 0x25, 0x46,
-		0xd3, 0x30, 0x78, 0x42, 0x6a, 0x5a, 0xa7, 0xf1, 0x4f, 0xb4, 0x0d, 0xc3, 0x67, 0xd8, 0x8b, 0x88,
-		0x76, 0x92, 0xdb, 0xa9, 0xd2, 0x65, 0x39, 0xaa, 0xa5, 0xb0, 0x0f, 0x07, 0x1e, 0x18, 0xf1, 0xb6,
-		0x59, 0x72, 0x7e, 0x87, 0xdb, 0x9a, 0x1c, 0x16, 0x65, 0x30, 0xb6, 0xfb, 0x1d, 0xff, 0x0e, 0xd3,
-		0x87, 0xf9, 0xe5, 0x00, 0x2c, 0x65, 0xed, 0xaa, 0xfd, 0xf0, 0x14, 0x16, 0x53, 0xdc, 0xa0, 0x1e,
-		0x05, 0x49, 0x60, 0x97, 0x7b, 0x8b, 0xa2, 0xe7, 0x44, 0x60, 0x17, 0x0b, 0x6c, 0x95, 0xda, 0x2d,
-		0xde, 0xd8, 0x3a, 0xde, 0x32, 0xc5, 0xf5, 0x9b, 0xb6, 0x1c, 0xb8, 0xd8, 0x96, 0xed, 0x5e, 0xde,
-		0xd8, 0xd2, 0x9c, 0x87, 0xd9, 0xa7, 0x44, 0x6c, 0x7b, 0x11, 0x17, 0x3a, 0x11, 0xe9, 0x27, 0xed,
-		0xaf, 0x0c, 0x98, 0x6b, 0x5f, 0xd1, 0x9a, 0x39, 0x86, 0xab, 0x3c, 0x0a, 0xc3, 0x80, 0x09, 0xe2,
-		0xda, 0x8e, 0x47, 0xe3, 0xe7, 0xe0, 0x19, 0x61, 0x5c, 0x6b, 0x25, 0x3b, 0xb7, 0xec, 0x27, 0xa8,
-		0x6d, 0x09, 0xfa, 0x44, 0x63, 0xac, 0x79, 0x9e, 0xbe, 0x60, 0xfe, 0x76, 0x10, 0xcc, 0xa7, 0x29,
-		0x8f, 0xbe, 0x8f, 0xd4, 0x1f, 0x53, 0xaf, 0xa9, 0x20, 0x59, 0x80, 0xf1, 0x10, 0x57, 0x88, 0xcd,
-		0xe9, 0x67, 0xea, 0xda, 0x19, 0xb6, 0xc6, 0xe2, 0x89, 0x7d, 0xfa, 0x19, 0x41, 0x6f, 0xc3, 0x25,
-		0x9f, 0x7c, 0x1a, 0x5b, 0xad, 0x42, 0x6c, 0x11, 0x9c, 0x10, 0x5f, 0x37, 0x3e, 0x26, 0xe3, 0xe9,
-		0x3d, 0x5c, 0x21, 0x07, 0xf1, 0x24, 0x7a, 0x07, 0xd0, 0x39, 0xa6, 0xc2, 0x3e, 0x0a, 0x98, 0xed,
-		0x93, 0x73, 0xf5, 0xaa, 0x96, 0x55, 0xc3, 0x98, 0x75, 0x29, 0x5e, 0xd9, 0x09, 0xd8, 0xc7, 0xe4,
-		0x5c, 0x3e, 0xa7, 0x91, 0x0d, 0x57, 0xf5, 0x7f, 0x71, 0xfa, 0xf5, 0x7d, 0x44, 0x3d, 0x41, 0x98,
-		0xba, 0xf8, 0x46, 0xe4, 0xc5, 0xf7, 0x56, 0xea, 0x79, 0x24, 0x7c, 0x47, 0x12, 0xcb, 0xbb, 0x6f,
-		0x4e, 0xb3, 0x69, 0x9b, 0x47, 0xd7, 0x61, 0x52, 0x3e, 0xc7, 0x31, 0x73, 0x8e, 0xe9, 0x19, 0x56,
-		0x0d, 0xad, 0x31, 0x6b, 0x22, 0x9e, 0xdc, 0xd2, 0x73, 0xe6, 0xbf, 0x0c, 0xb8, 0x9e, 0x6b, 0x0d,
-		0xed, 0x1f, 0xf7, 0x60, 0x54, 0x6f, 0x93, 0x5b, 0x92, 0x24, 0xb0, 0x84, 0x18, 0xfd, 0x08, 0x0a,
-		0x0c, 0x9f, 0xdb, 0x09, 0x56, 0x39, 0x7b, 0x7a, 0x48, 0x3f, 0xc6, 0x02, 0x3f, 0xf2, 0x82, 0x43,
-		0x0b, 0x18, 0x3e, 0xd7, 0x8c, 0xd2, 0x54, 0x3f, 0x98, 0xa6, 0xfa, 0x12, 0x8c, 0xa9, 0x73, 0x12,
-		0x57, 0x5f, 0xf1, 0xf5, 0xb1, 0x59, 0x83, 0x89, 0x1d, 0x82, 0x45, 0xc4, 0xc8, 0x8e, 0x87, 0x2b,
-		0x1c, 0x51, 0xd8, 0x48, 0x79, 0x71, 0x60, 0x8f, 0x11, 0xec, 0xc6, 0x65, 0x5f, 0x35, 0xf4, 0x48,
-		0x1c, 0x06, 0x84, 0xb1, 0x80, 0xd9, 0xc4, 0xc7, 0x87, 0x1e, 0x51, 0x1d, 0x88, 0x31, 0xeb, 0x76,
-		0x87, 0xeb, 0x6c, 0x29, 0xdc, 0x76, 0x02, 0x7b, 0x12, 0xa3, 0x9e, 0x28, 0x90, 0xf9, 0x3b, 0x03,
-		0x16, 0x2c, 0x72, 0xc4, 0x08, 0x3f, 0xae, 0xff, 0xb1, 0x84, 0xf9, 0x09, 0x7f, 0x4d, 0xef, 0xbf,
-		0x25, 0xb8, 0x96, 0x2e, 0x8d, 0xb2, 0xf2, 0xc6, 0x57, 0x57, 0xa0, 0x90, 0xac, 0x6c, 0xed, 0xed,
-		0xa2, 0x5f, 0x1b, 0x50, 0xcc, 0x6a, 0x3c, 0xa3, 0x77, 0x33, 0xfe, 0x2c, 0xc9, 0xfd, 0x93, 0xb7,
-		0x74, 0xb7, 0x4f, 0x94, 0xf6, 0xbf, 0x5f, 0x1a, 0x30, 0x97, 0xde, 0x84, 0x43, 0x17, 0x68, 0x99,
-		0x96, 0x36, 0xfb, 0xc2, 0x68, 0x19, 0xfe, 0x68, 0xc0, 0x42, 0x4e, 0x23, 0x10, 0xdd, 0xef, 0x83,
-		0x69, 0x73, 0x0b, 0xb3, 0xf4, 0xa0, 0x7f, 0xa0, 0x16, 0xe9, 0x0b, 0x03, 0xe6, 0x33, 0xba, 0xd2,
-		0x68, 0x33, 0xaf, 0x0f, 0x9a, 0xa5, 0x98, 0x77, 0xfb, 0x03, 0x69, 0x31, 0xfe, 0x6a, 0xc0, 0x4a,
-		0xb7, 0x66, 0x29, 0x7a, 0xa5, 0xbe, 0x6c, 0xe9, 0x87, 0x17, 0x44, 0x6b, 0x09, 0xbf, 0x36, 0xe0,
-		0x46, 0x4f, 0xed, 0x5c, 0xb4, 0x75, 0xa1, 0x8d, 0x5a, 0xec, 0xf9, 0xe8, 0x55, 0x58, 0x34, 0x39,
-		0x7c, 0x7a, 0x77, 0x34, 0xc3, 0xe1, 0x73, 0x5b, 0xcf, 0x19, 0x0e, 0xdf, 0xa5, 0xfd, 0xfa, 0x67,
-		0x03, 0x96, 0xf2, 0x3b, 0x89, 0xe8, 0x61, 0x06, 0xdf, 0x1e, 0x9a, 0xb3, 0xa5, 0xf7, 0x2f, 0x84,
-		0xd5, 0xb2, 0xfd, 0xde, 0x80, 0x52, 0x76, 0x17, 0x0e, 0xdd, 0x4b, 0xaf, 0xa7, 0xba, 0xf5, 0x3a,
-		0x4b, 0xf7, 0xfb, 0xc6, 0x69, 0x79, 0x7e, 0x63, 0xc0, 0xd5, 0xcc, 0xd6, 0x1a, 0xba, 0x9b, 0x5b,
-		0x4a, 0x67, 0x4a, 0x73, 0xaf, 0x5f, 0x98, 0x16, 0xe6, 0x08, 0x26, 0x5b, 0xda, 0x0b, 0x28, 0xa7,
-		0x2b, 0xd2, 0xd6, 0x09, 0x2a, 0xdd, 0xec, 0x85, 0x54, 0xef, 0x13, 0xc0, 0x74, 0xfb, 0x73, 0x00,
-		0xdd, 0xea, 0xf1, 0xd5, 0xa0, 0x76, 0xeb, 0xef, 0x8d, 0x81, 0x3e, 0x87, 0x99, 0xb4, 0x47, 0x19,
-		0xfa, 0x41, 0x1f, 0xef, 0x37, 0xb5, 0xf1, 0x9d, 0xbe, 0x5f, 0x7c, 0x32, 0x24, 0xd3, 0x1f, 0x18,
-		0x19, 0x21, 0x99, 0xfb, 0x06, 0xca, 0x08, 0xc9, 0x2e, 0x2f, 0x18, 0x0a, 0x53, 0xad, 0x15, 0x3c,
-		0xba, 0x99, 0x75, 0x90, 0xce, 0x07, 0x40, 0xe9, 0x9d, 0x9e, 0x68, 0x9b, 0xae, 0xbb, 0x9c, 0xd2,
-		0x30, 0xe3, 0xba, 0xeb, 0x5e, 0xda, 0x67, 0x5c, 0x77, 0xbd, 0x54, 0xa1, 0x9f, 0xc3, 0x4c, 0x5a,
-		0xfd, 0x92, 0x61, 0xfe, 0x9c, 0xc2, 0x2b, 0xc3, 0xfc, 0x79, 0xc5, 0x91, 0x8a, 0xf0, 0xac, 0x6f,
-		0xa9, 0xb2, 0x22, 0xbc, 0xcb, 0xb7, 0x62, 0x59, 0x11, 0xde, 0xed, 0x93, 0xad, 0x47, 0x2e, 0xcc,
-		0x3b, 0x41, 0x35, 0x0d, 0xfc, 0x68, 0x26, 0x41, 0xed, 0xab, 0xcf, 0x11, 0xf7, 0x58, 0x20, 0x82,
-		0x3d, 0xe3, 0xe7, 0x77, 0x2a, 0x54, 0x1c, 0x47, 0x87, 0x65, 0x27, 0xa8, 0xae, 0x37, 0x7f, 0xb1,
-		0x77, 0x9b, 0xba, 0xde, 0x7a, 0x25, 0x50, 0x5f, 0x1a, 0xea, 0xcf, 0xf7, 0xde, 0xc7, 0x21, 0x3d,
-		0xbb, 0x73, 0x38, 0x22, 0xe7, 0x36, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x9d, 0x72, 0xa3, 0x04,
-		0xee, 0x28, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x4b, 0x6f, 0x1c, 0xc7,
+		0x11, 0xc6, 0x2c, 0xdf, 0xb5, 0x24, 0x45, 0xb5, 0x44, 0x72, 0xb5, 0x14, 0x5f, 0x23, 0xcb, 0x61,
+		0x64, 0x69, 0x19, 0x92, 0xd6, 0xc3, 0xb2, 0x13, 0x83, 0xa2, 0x44, 0x99, 0x81, 0x64, 0x30, 0x43,
+		0xc6, 0x42, 0x72, 0x19, 0x34, 0x67, 0x9a, 0xcb, 0x16, 0x67, 0x67, 0x86, 0xdd, 0x3d, 0xa4, 0xd7,
+		0x39, 0x04, 0x09, 0x0c, 0x07, 0xc8, 0x0b, 0xc9, 0x31, 0x40, 0x80, 0x1c, 0x72, 0xf0, 0x29, 0xbe,
+		0xe4, 0x2f, 0x04, 0xfe, 0x1f, 0xf9, 0x05, 0xf9, 0x05, 0x31, 0x82, 0xee, 0xe9, 0xd9, 0x17, 0x67,
+		0x66, 0x77, 0x29, 0x18, 0x92, 0x73, 0xdb, 0xee, 0xae, 0xaf, 0xba, 0xba, 0xba, 0xaa, 0xba, 0xaa,
+		0x76, 0xe0, 0x56, 0x74, 0x40, 0xd8, 0xaa, 0x83, 0x5d, 0xe2, 0x3b, 0x64, 0x15, 0x87, 0x74, 0xf5,
+		0x74, 0x6d, 0x95, 0x13, 0x76, 0x4a, 0x1d, 0x62, 0x9f, 0x05, 0xec, 0xf8, 0xd0, 0x0b, 0xce, 0x2a,
+		0x21, 0x0b, 0x44, 0x80, 0xae, 0x48, 0xda, 0x8a, 0xa6, 0xad, 0xe0, 0x90, 0x56, 0x4e, 0xd7, 0xca,
+		0x0b, 0xd5, 0x20, 0xa8, 0x7a, 0x64, 0x55, 0x91, 0x1c, 0x44, 0x87, 0xab, 0x6e, 0xc4, 0xb0, 0xa0,
+		0x81, 0x1f, 0x83, 0xca, 0x8b, 0x9d, 0xeb, 0x82, 0xd6, 0x08, 0x17, 0xb8, 0x16, 0x6a, 0x82, 0xa5,
+		0x34, 0x09, 0x9c, 0xa0, 0x56, 0x6b, 0xb0, 0x58, 0x4e, 0xa3, 0x38, 0xa2, 0x5c, 0x04, 0xac, 0x9e,
+		0xec, 0x92, 0x46, 0x72, 0x12, 0x91, 0x06, 0x81, 0x99, 0x46, 0x20, 0x30, 0x3f, 0xf6, 0x28, 0x17,
+		0x79, 0x34, 0xed, 0x3a, 0x30, 0xff, 0x65, 0xc0, 0xa2, 0x25, 0xe5, 0x67, 0xe2, 0x85, 0x5e, 0x79,
+		0xf2, 0x29, 0x71, 0x22, 0x79, 0x62, 0x8b, 0x9c, 0x44, 0x84, 0x0b, 0x34, 0x03, 0xc3, 0x6e, 0x50,
+		0xc3, 0xd4, 0x2f, 0x19, 0x4b, 0xc6, 0xca, 0x98, 0xa5, 0x47, 0xe8, 0xa7, 0x80, 0x12, 0x6e, 0x36,
+		0x49, 0x40, 0xa5, 0xc2, 0x92, 0xb1, 0x52, 0x5c, 0x7f, 0xbb, 0x92, 0xa2, 0xdc, 0xca, 0xf9, 0x2d,
+		0x2e, 0x9f, 0x75, 0x4e, 0xa1, 0x32, 0x8c, 0x52, 0x97, 0xf8, 0x82, 0x8a, 0x7a, 0x69, 0x40, 0x6d,
+		0xd8, 0x18, 0x4b, 0x51, 0x18, 0xc1, 0x3c, 0xf0, 0x4b, 0x83, 0xb1, 0x28, 0xf1, 0xc8, 0xfc, 0x87,
+		0x01, 0x4b, 0x8f, 0x29, 0xae, 0xfa, 0x01, 0x27, 0xdf, 0x81, 0x73, 0x98, 0x5f, 0x1a, 0xb0, 0x9c,
+		0x23, 0x2f, 0x0f, 0x03, 0x9f, 0x93, 0x4c, 0x81, 0x5f, 0xc2, 0xbc, 0x1b, 0x83, 0x05, 0x75, 0xec,
+		0x57, 0x96, 0x7d, 0xae, 0xc9, 0xec, 0xdc, 0xa2, 0xf9, 0xf5, 0x28, 0xcc, 0xef, 0x5d, 0xc8, 0x3c,
+		0x16, 0xa1, 0xd8, 0x10, 0x8d, 0xba, 0x4a, 0xa6, 0x31, 0x0b, 0x92, 0xa9, 0x1d, 0x17, 0x6d, 0xc3,
+		0x44, 0x83, 0x40, 0xd4, 0x43, 0xa2, 0xb4, 0x54, 0x5c, 0x5f, 0xce, 0x15, 0x7b, 0xbf, 0x1e, 0x12,
+		0x6b, 0xfc, 0xac, 0x65, 0x84, 0x1e, 0xc2, 0x98, 0xb4, 0x7c, 0x5b, 0x9a, 0xbe, 0xb2, 0x8b, 0xe2,
+		0xfa, 0x7c, 0x2a, 0x8f, 0x7d, 0xcc, 0x8f, 0x9f, 0x51, 0x2e, 0xac, 0x51, 0xa1, 0x7f, 0xa1, 0x75,
+		0x18, 0xa2, 0x7e, 0x18, 0x89, 0xd2, 0x90, 0xc2, 0x5d, 0x4f, 0xc5, 0xed, 0xe2, 0xba, 0x17, 0x60,
+		0xd7, 0x8a, 0x49, 0x11, 0x86, 0xa5, 0x86, 0xaa, 0x6d, 0xe5, 0x3a, 0xb6, 0x08, 0x6c, 0xc7, 0x0b,
+		0x38, 0xb1, 0x65, 0x34, 0x08, 0x22, 0x51, 0x1a, 0x56, 0xec, 0xae, 0x55, 0xe2, 0x68, 0x51, 0x49,
+		0xa2, 0x45, 0xe5, 0xb1, 0x8e, 0x26, 0xd6, 0xf5, 0x06, 0x0b, 0xa5, 0xdd, 0xfd, 0x60, 0x4b, 0xe2,
+		0xf7, 0x63, 0x38, 0x7a, 0x01, 0x73, 0xea, 0x48, 0x19, 0xdc, 0x47, 0xba, 0x71, 0x9f, 0x95, 0xe8,
+		0x34, 0xc6, 0xad, 0x46, 0x39, 0xda, 0xe1, 0x5c, 0xf3, 0x00, 0x2c, 0xbe, 0x53, 0x79, 0x5f, 0x63,
+		0x6a, 0x75, 0x4c, 0xcf, 0xec, 0xb8, 0xc8, 0x81, 0x52, 0xcb, 0x7d, 0xda, 0x8c, 0x44, 0x9c, 0xd8,
+		0x61, 0xe0, 0x51, 0xa7, 0x5e, 0x82, 0x25, 0x63, 0x65, 0x72, 0xfd, 0x56, 0xee, 0xcd, 0xed, 0xb8,
+		0x96, 0x84, 0xec, 0x2a, 0x84, 0x35, 0x7d, 0x96, 0x36, 0x8d, 0xb6, 0x60, 0x9c, 0x11, 0xc1, 0xea,
+		0x09, 0xe3, 0xa2, 0x3a, 0xe9, 0x52, 0x2a, 0x63, 0x4b, 0x12, 0x6a, 0x76, 0x45, 0xd6, 0x1c, 0xa0,
+		0x1b, 0x30, 0xe1, 0x30, 0x79, 0x37, 0xce, 0x11, 0x71, 0x23, 0x8f, 0x94, 0xc6, 0xd5, 0x59, 0xc6,
+		0xe5, 0xe4, 0x9e, 0x9e, 0x43, 0x77, 0x60, 0xb0, 0x46, 0x6a, 0x41, 0x69, 0x42, 0xeb, 0x32, 0x6d,
+		0x87, 0xe7, 0xa4, 0x16, 0x58, 0x8a, 0x0c, 0x59, 0x70, 0x99, 0x13, 0xcc, 0x9c, 0x23, 0x1b, 0x0b,
+		0xc1, 0xe8, 0x41, 0x24, 0x08, 0x2f, 0x4d, 0x2a, 0xec, 0xcd, 0x54, 0xec, 0x9e, 0xa2, 0xde, 0x6c,
+		0x10, 0x5b, 0x53, 0xbc, 0x63, 0x06, 0x6d, 0xc0, 0xf0, 0x11, 0xc1, 0x2e, 0x61, 0xa5, 0x4b, 0x8a,
+		0xd1, 0x5c, 0x2a, 0xa3, 0x8f, 0x14, 0x89, 0xa5, 0x49, 0xd1, 0x43, 0x28, 0xba, 0xc4, 0xc3, 0xf5,
+		0xd8, 0x36, 0x4a, 0x53, 0xdd, 0x4c, 0x01, 0x14, 0xb5, 0xb2, 0x05, 0xf4, 0x01, 0x8c, 0xbf, 0xa4,
+		0x42, 0x10, 0xa6, 0xc1, 0x97, 0xbb, 0x81, 0x8b, 0x31, 0x79, 0x03, 0x7d, 0x48, 0x19, 0x17, 0x36,
+		0x8b, 0x7c, 0x1b, 0x8b, 0x12, 0x52, 0xe8, 0xf2, 0x39, 0xf4, 0x7e, 0xf2, 0x22, 0x5a, 0xa0, 0xe8,
+		0xad, 0xc8, 0xdf, 0x14, 0xe6, 0x7d, 0x58, 0xc8, 0x8a, 0x23, 0x3a, 0xdc, 0x4d, 0xc3, 0xb0, 0xe4,
+		0x4c, 0x5d, 0x1d, 0x48, 0x86, 0x58, 0xe4, 0xef, 0xb8, 0x26, 0x03, 0x33, 0x1d, 0xb8, 0xc9, 0xeb,
+		0xbe, 0x93, 0x44, 0xa1, 0x67, 0x30, 0xa2, 0x4d, 0x55, 0xa1, 0x8b, 0xeb, 0xeb, 0xe9, 0xb7, 0x92,
+		0x17, 0xca, 0xac, 0x84, 0x85, 0x79, 0x13, 0x6e, 0xe4, 0xee, 0x19, 0x4b, 0x6c, 0xbe, 0x07, 0x4b,
+		0xd9, 0x8f, 0x67, 0xfe, 0xa9, 0xbe, 0x2e, 0xc0, 0xc2, 0x1e, 0xad, 0xfa, 0xd8, 0xfb, 0x2e, 0xbc,
+		0xbb, 0xed, 0xa1, 0x61, 0xb0, 0x33, 0x34, 0x2c, 0x42, 0x91, 0xab, 0xb3, 0xd8, 0x3e, 0xae, 0x11,
+		0x15, 0x4b, 0xc7, 0x2c, 0x88, 0xa7, 0x3e, 0xc6, 0x35, 0x82, 0x3e, 0x84, 0x71, 0x4d, 0x10, 0x47,
+		0xdb, 0xe1, 0x1e, 0xa2, 0xad, 0x66, 0xb9, 0xa3, 0x62, 0x6e, 0x09, 0x46, 0x9c, 0xc0, 0x17, 0x2c,
+		0xf0, 0x54, 0xf0, 0x1b, 0xb7, 0x92, 0xa1, 0xb9, 0x0c, 0x8b, 0x99, 0x7a, 0xd4, 0xd7, 0xf4, 0x8d,
+		0x01, 0xdf, 0xd3, 0x34, 0x54, 0x1c, 0xe5, 0xbf, 0x66, 0x2f, 0x60, 0x22, 0x0e, 0xba, 0xaf, 0x6e,
+		0x4d, 0xe3, 0x8a, 0x51, 0xc2, 0xb8, 0x43, 0x47, 0x85, 0xae, 0x3a, 0x1a, 0x78, 0x05, 0x1d, 0x0d,
+		0xb6, 0xeb, 0x68, 0x13, 0x56, 0xba, 0x9f, 0x3f, 0xdf, 0x5e, 0xbf, 0x30, 0xe0, 0x76, 0x37, 0x1e,
+		0x6d, 0x0e, 0xf9, 0x49, 0xa7, 0x43, 0x7e, 0x90, 0xae, 0xc2, 0xde, 0xee, 0xa5, 0xe9, 0x9a, 0xab,
+		0x70, 0xa7, 0x47, 0x39, 0xf4, 0xed, 0x7f, 0x55, 0x80, 0x79, 0x8b, 0x70, 0xf2, 0xc6, 0x24, 0xb8,
+		0xcd, 0x24, 0x76, 0xa0, 0x35, 0x89, 0x45, 0xf7, 0xa1, 0xe4, 0x12, 0x87, 0x72, 0x99, 0x56, 0x1c,
+		0x52, 0x9f, 0xf2, 0x23, 0x9b, 0x9c, 0x12, 0xbf, 0xe1, 0x72, 0x03, 0xd6, 0x74, 0xb2, 0xbe, 0xad,
+		0x96, 0x9f, 0xc8, 0xd5, 0x1d, 0xb7, 0xc3, 0x3b, 0x87, 0x3a, 0xbd, 0xb3, 0x02, 0x57, 0xf8, 0x31,
+		0x0d, 0x6d, 0x6d, 0x5d, 0x8c, 0xe0, 0x30, 0xf4, 0xea, 0xca, 0x07, 0x47, 0xad, 0xcb, 0x72, 0x29,
+		0x56, 0xa8, 0x15, 0x2f, 0xc8, 0x48, 0x9d, 0xa5, 0xaf, 0x7c, 0x1b, 0xf9, 0x6b, 0x01, 0x6e, 0x6a,
+		0x9d, 0x6e, 0x61, 0xdf, 0x21, 0xff, 0x0f, 0xa1, 0xed, 0x2a, 0x0c, 0x39, 0x38, 0xe2, 0x49, 0x50,
+		0x8b, 0x07, 0x68, 0x03, 0x66, 0xe2, 0xa7, 0xb0, 0x99, 0x08, 0x6a, 0x85, 0x0c, 0x2b, 0xb2, 0x2b,
+		0x6a, 0xb5, 0x29, 0x93, 0x52, 0xcf, 0x0a, 0xbc, 0xdd, 0x4d, 0x3b, 0xda, 0x64, 0xff, 0x59, 0x80,
+		0xe5, 0x7d, 0xc2, 0x6a, 0xd4, 0xc7, 0x82, 0xbc, 0xe9, 0x66, 0x7b, 0x0f, 0x46, 0x5c, 0x22, 0x30,
+		0xf5, 0x78, 0x69, 0xb0, 0x87, 0x90, 0x95, 0x10, 0xb7, 0x5d, 0xca, 0x50, 0xc7, 0xa5, 0x5c, 0x48,
+		0xbf, 0x6f, 0x81, 0x99, 0xa7, 0x34, 0xad, 0xdb, 0x3f, 0xcb, 0x52, 0x91, 0x70, 0x87, 0xd1, 0x83,
+		0x37, 0x45, 0xb5, 0xe6, 0x37, 0x03, 0xb0, 0x9c, 0x23, 0x93, 0xf6, 0x3a, 0x0f, 0x66, 0x9b, 0xea,
+		0x70, 0x02, 0xff, 0x90, 0x56, 0x75, 0x9e, 0xa6, 0x23, 0xec, 0x46, 0x6f, 0x12, 0x6c, 0xb5, 0x42,
+		0xad, 0x19, 0x92, 0x3a, 0x8f, 0x0e, 0x60, 0xf6, 0xfc, 0x51, 0x6d, 0xea, 0x1f, 0x06, 0xfa, 0xbc,
+		0xb7, 0x7a, 0xdb, 0x6d, 0xc7, 0x3f, 0x0c, 0x9a, 0xd9, 0x7e, 0xdb, 0x34, 0x7a, 0x01, 0x28, 0x24,
+		0xbe, 0x4b, 0xfd, 0xaa, 0x8d, 0x1d, 0x41, 0x4f, 0xa9, 0xa0, 0x84, 0x97, 0x06, 0x96, 0x06, 0x56,
+		0x8a, 0xeb, 0x2b, 0xe9, 0x56, 0x14, 0x93, 0x6f, 0xc6, 0xd4, 0x75, 0xc5, 0xfc, 0x72, 0xd8, 0x36,
+		0x49, 0x09, 0x47, 0x3f, 0x83, 0xa9, 0x84, 0xb1, 0x73, 0x44, 0x3d, 0x97, 0x11, 0xd9, 0x31, 0x90,
+		0x6c, 0x2b, 0x79, 0x6c, 0xb7, 0x24, 0x6d, 0xbb, 0xe4, 0x97, 0xc2, 0x96, 0x25, 0x46, 0x7c, 0xb4,
+		0xd7, 0x64, 0x9d, 0x44, 0x63, 0x5d, 0x3c, 0xe6, 0x4a, 0xfc, 0x58, 0xd3, 0xb6, 0x31, 0x4d, 0x26,
+		0xcd, 0xcf, 0x07, 0xe0, 0xea, 0x4f, 0x64, 0x7b, 0x27, 0x51, 0xdf, 0x6b, 0xf2, 0xf1, 0x07, 0x30,
+		0xa4, 0xba, 0x4c, 0x3a, 0xf9, 0x30, 0x73, 0x39, 0x29, 0x81, 0xad, 0x18, 0x80, 0x6c, 0x98, 0x51,
+		0x3f, 0x6c, 0x46, 0x5e, 0x12, 0x47, 0x48, 0xfb, 0x74, 0xa9, 0x12, 0x6a, 0x50, 0xd5, 0x86, 0xdf,
+		0x4f, 0x65, 0x15, 0xb3, 0x50, 0x88, 0xad, 0x04, 0x60, 0x5d, 0x3d, 0x49, 0x99, 0x95, 0xf6, 0x18,
+		0x6f, 0xe0, 0x04, 0x3e, 0xa7, 0x5c, 0x10, 0xdf, 0xa9, 0xdb, 0x1e, 0x39, 0x25, 0x5e, 0x69, 0x28,
+		0xa7, 0xfa, 0x54, 0x3b, 0x6c, 0x35, 0x21, 0xcf, 0x24, 0xc2, 0x9a, 0x3e, 0x49, 0x9b, 0x36, 0xff,
+		0x6e, 0xc0, 0x74, 0xc7, 0x35, 0x68, 0xdf, 0xfb, 0x10, 0xc6, 0x93, 0xe3, 0xf1, 0xc8, 0x4b, 0x52,
+		0x9a, 0x2e, 0xc9, 0x99, 0x3e, 0x87, 0x04, 0xa0, 0x1d, 0x98, 0x6c, 0xd5, 0x0f, 0x71, 0x4b, 0x85,
+		0x1c, 0x15, 0xb7, 0xe8, 0x85, 0xb8, 0xd6, 0xc4, 0x49, 0xeb, 0xd0, 0xfc, 0x8f, 0x01, 0xb3, 0x49,
+		0xb4, 0x68, 0xb4, 0x34, 0xba, 0xd8, 0x4b, 0x5b, 0x8f, 0xa4, 0xd0, 0x5f, 0x8f, 0xe4, 0x29, 0x4c,
+		0x36, 0xb0, 0xcd, 0x46, 0xcd, 0xe4, 0xfa, 0x72, 0x2e, 0x83, 0xb8, 0x51, 0x23, 0x5a, 0x46, 0x32,
+		0xc1, 0xa1, 0xbe, 0xe3, 0x45, 0x2e, 0xb1, 0x9b, 0x0c, 0xb9, 0xc0, 0x22, 0x8a, 0x9f, 0x8e, 0x51,
+		0x6b, 0x5a, 0xaf, 0x27, 0x4c, 0xf6, 0xd4, 0xa2, 0xf9, 0x5f, 0x03, 0x4a, 0xe7, 0x4f, 0xac, 0xaf,
+		0xe6, 0x3d, 0x18, 0x09, 0x03, 0xcf, 0x23, 0x8c, 0x97, 0x0c, 0xe5, 0xe2, 0x8b, 0xe9, 0xb7, 0xa2,
+		0x68, 0x94, 0xfb, 0x25, 0xf4, 0xe8, 0x39, 0x4c, 0x9d, 0x13, 0x24, 0x56, 0xce, 0x8d, 0xdc, 0xb3,
+		0xc5, 0x62, 0x59, 0x93, 0xa2, 0x6d, 0x8c, 0x5e, 0xc0, 0x54, 0x88, 0x99, 0xa0, 0x2d, 0x01, 0x5a,
+		0x3b, 0xd2, 0xed, 0x5c, 0x76, 0xbb, 0x09, 0x28, 0x8e, 0xc0, 0xd6, 0xa5, 0xb0, 0x7d, 0xc2, 0xbc,
+		0x0b, 0x73, 0x4f, 0x89, 0x48, 0xc8, 0xf9, 0xa3, 0xfa, 0x63, 0x75, 0xab, 0x5d, 0x2e, 0xdd, 0xfc,
+		0xe3, 0x20, 0x5c, 0x4f, 0xc7, 0x69, 0xd5, 0xfd, 0x12, 0x66, 0x1a, 0x19, 0x67, 0x53, 0x11, 0x35,
+		0x1c, 0x6a, 0x4d, 0xfe, 0x38, 0x55, 0xec, 0x3c, 0x96, 0x95, 0x24, 0xa4, 0x25, 0x14, 0xcf, 0x71,
+		0xf8, 0xc4, 0x17, 0xac, 0x6e, 0x5d, 0x71, 0xcf, 0xaf, 0x48, 0x01, 0x74, 0xe0, 0xaf, 0x77, 0x08,
+		0x50, 0xb8, 0xa8, 0x00, 0xc9, 0xd3, 0x70, 0x5e, 0x00, 0x7c, 0x7e, 0xa5, 0x1c, 0x49, 0xc3, 0x4a,
+		0x97, 0x18, 0x4d, 0xc1, 0xc0, 0x31, 0xa9, 0x6b, 0x9d, 0xca, 0x9f, 0x68, 0x0b, 0x86, 0x4e, 0xb1,
+		0x17, 0x11, 0x6d, 0x24, 0x77, 0x52, 0xa5, 0xcb, 0x32, 0x54, 0x2b, 0xc6, 0x3e, 0x2c, 0x3c, 0x30,
+		0xe4, 0xb6, 0x59, 0x72, 0x7e, 0x8b, 0xdb, 0x9a, 0x1c, 0xe6, 0x95, 0x33, 0x76, 0xda, 0x1d, 0xff,
+		0x16, 0xc3, 0x87, 0xf9, 0x45, 0x01, 0x16, 0xb2, 0x76, 0xd5, 0x76, 0x78, 0x02, 0xf3, 0x29, 0x66,
+		0xd0, 0xf0, 0x82, 0xc4, 0xb1, 0x2b, 0xbd, 0x79, 0xd1, 0x73, 0x22, 0xb0, 0x8b, 0x05, 0xb6, 0xca,
+		0x9d, 0x37, 0xde, 0xdc, 0x5a, 0x6e, 0x99, 0x62, 0xfa, 0x2d, 0x5b, 0x16, 0x2e, 0xb6, 0x65, 0xa7,
+		0x95, 0x37, 0xb7, 0x34, 0x67, 0x61, 0xfa, 0x29, 0x11, 0x5b, 0x5e, 0xc4, 0x85, 0x0e, 0x44, 0xba,
+		0xa4, 0xfd, 0xb5, 0x01, 0x33, 0x9d, 0x2b, 0x5a, 0x33, 0x47, 0x70, 0x8d, 0x47, 0x61, 0x18, 0x30,
+		0x41, 0x5c, 0xdb, 0xf1, 0xa8, 0x2c, 0x07, 0x4f, 0x09, 0xe3, 0x5a, 0x2b, 0xd9, 0xb1, 0x65, 0x2f,
+		0x41, 0x6d, 0x29, 0xd0, 0x27, 0x1a, 0x63, 0xcd, 0xf2, 0xf4, 0x05, 0xf3, 0x77, 0x03, 0x60, 0x3e,
+		0x4d, 0x29, 0xfa, 0x3e, 0x8a, 0xff, 0x98, 0x7a, 0x4d, 0x09, 0xc9, 0x1c, 0x8c, 0x85, 0xb8, 0x4a,
+		0x6c, 0x4e, 0x3f, 0x8b, 0x9f, 0x9d, 0x21, 0x6b, 0x54, 0x4e, 0xec, 0xd1, 0xcf, 0x08, 0x7a, 0x1b,
+		0x2e, 0xf9, 0xe4, 0x53, 0x79, 0x6b, 0x55, 0x62, 0x8b, 0xe0, 0x98, 0xf8, 0xba, 0xf1, 0x31, 0x21,
+		0xa7, 0x77, 0x71, 0x95, 0xec, 0xcb, 0x49, 0xf4, 0x0e, 0xa0, 0x33, 0x4c, 0x85, 0x7d, 0x18, 0x30,
+		0xdb, 0x27, 0x67, 0x71, 0x55, 0xad, 0xb2, 0x86, 0x51, 0xeb, 0x92, 0x5c, 0xd9, 0x0e, 0xd8, 0xc7,
+		0xe4, 0x4c, 0x95, 0xd3, 0xc8, 0x86, 0x6b, 0xfa, 0xbf, 0x38, 0x5d, 0x7d, 0x1f, 0x52, 0x4f, 0x76,
+		0x4c, 0xd5, 0xc3, 0x37, 0xac, 0x1e, 0xbe, 0xb7, 0x52, 0xcf, 0xa3, 0xe0, 0xdb, 0x8a, 0x58, 0xbd,
+		0x7d, 0x33, 0x9a, 0x4d, 0xc7, 0xbc, 0xec, 0x4e, 0xab, 0x72, 0x5c, 0x36, 0x83, 0xe9, 0x29, 0x8e,
+		0x1b, 0x5a, 0xa3, 0xd6, 0xb8, 0x9c, 0xdc, 0xd4, 0x73, 0xe6, 0xbf, 0x0d, 0xb8, 0x91, 0x7b, 0x1b,
+		0xda, 0x3e, 0xee, 0xc1, 0x88, 0xde, 0x26, 0x37, 0x25, 0x49, 0x60, 0x09, 0x31, 0xfa, 0x11, 0x14,
+		0x19, 0x3e, 0xb3, 0x13, 0x6c, 0x6c, 0xec, 0xe9, 0x2e, 0xfd, 0x18, 0x0b, 0xfc, 0xc8, 0x0b, 0x0e,
+		0x2c, 0x60, 0xf8, 0x4c, 0x33, 0x4a, 0x53, 0xfd, 0x40, 0x9a, 0xea, 0xcb, 0x30, 0x1a, 0x9f, 0x93,
+		0xb8, 0xfa, 0x89, 0x6f, 0x8c, 0xcd, 0x3a, 0x8c, 0x6f, 0x13, 0x2c, 0x22, 0x46, 0xb6, 0x3d, 0x5c,
+		0xe5, 0x88, 0xc2, 0x7a, 0x4a, 0xc5, 0x81, 0x3d, 0x46, 0xb0, 0x2b, 0xd3, 0xbe, 0x5a, 0xe8, 0x11,
+		0xe9, 0x06, 0x84, 0xb1, 0x80, 0xd9, 0xc4, 0xc7, 0x07, 0x1e, 0x89, 0x3b, 0x10, 0xa3, 0xd6, 0x9d,
+		0x73, 0xa6, 0xb3, 0x19, 0xe3, 0xb6, 0x12, 0xd8, 0x13, 0x89, 0x7a, 0x12, 0x83, 0xcc, 0xdf, 0x1b,
+		0x30, 0x67, 0x91, 0x43, 0x46, 0xf8, 0x51, 0xe3, 0x8f, 0x25, 0xcc, 0x8f, 0xf9, 0x6b, 0xaa, 0xff,
+		0x16, 0xe0, 0x7a, 0xba, 0x34, 0xf1, 0x2d, 0xaf, 0x7f, 0x79, 0x05, 0x8a, 0xc9, 0xca, 0xe6, 0xee,
+		0x0e, 0xfa, 0x8d, 0x01, 0xa5, 0xac, 0xc6, 0x33, 0x7a, 0x37, 0xe3, 0xcf, 0x92, 0xdc, 0x3f, 0x79,
+		0xcb, 0x77, 0xfb, 0x44, 0x69, 0xfb, 0xfb, 0x95, 0x01, 0x33, 0xe9, 0x4d, 0x38, 0x74, 0x81, 0x96,
+		0x69, 0x79, 0xa3, 0x2f, 0x8c, 0x96, 0xe1, 0x4f, 0x06, 0xcc, 0xe5, 0x34, 0x02, 0xd1, 0xfd, 0x3e,
+		0x98, 0xb6, 0xb6, 0x30, 0xcb, 0x0f, 0xfa, 0x07, 0x6a, 0x91, 0x3e, 0x37, 0x60, 0x36, 0xa3, 0x2b,
+		0x8d, 0x36, 0xf2, 0xfa, 0xa0, 0x59, 0x8a, 0x79, 0xb7, 0x3f, 0x90, 0x16, 0xe3, 0x6f, 0x06, 0x2c,
+		0x75, 0x6b, 0x96, 0xa2, 0x57, 0xea, 0xcb, 0x96, 0x7f, 0x78, 0x41, 0xb4, 0x96, 0xf0, 0x2b, 0x03,
+		0x6e, 0xf6, 0xd4, 0xce, 0x45, 0x9b, 0x17, 0xda, 0xa8, 0xed, 0x3e, 0x1f, 0xbd, 0x0a, 0x8b, 0x16,
+		0x83, 0x4f, 0xef, 0x8e, 0x66, 0x18, 0x7c, 0x6e, 0xeb, 0xb9, 0xbc, 0xd1, 0x17, 0x46, 0xcb, 0xf0,
+		0x17, 0x03, 0x16, 0x34, 0x83, 0x8c, 0x4e, 0x22, 0x7a, 0x98, 0xc1, 0xb7, 0x87, 0xe6, 0x6c, 0xf9,
+		0xfd, 0x0b, 0x61, 0xb5, 0x6c, 0x7f, 0x30, 0xa0, 0x9c, 0xdd, 0x85, 0x43, 0xf7, 0xd2, 0xf3, 0xa9,
+		0x6e, 0xbd, 0xce, 0xf2, 0xfd, 0xbe, 0x71, 0x5a, 0x9e, 0xdf, 0x1a, 0x70, 0x2d, 0xb3, 0xb5, 0x86,
+		0xee, 0xe6, 0xa6, 0xd2, 0x99, 0xd2, 0xdc, 0xeb, 0x17, 0xa6, 0x85, 0x39, 0x84, 0x89, 0xb6, 0xf6,
+		0x02, 0xca, 0xe9, 0x8a, 0x74, 0x74, 0x82, 0xca, 0xb7, 0x7a, 0x21, 0xd5, 0xfb, 0x04, 0x30, 0xd5,
+		0x59, 0x0e, 0xa0, 0xdb, 0x3d, 0x56, 0x0d, 0xf1, 0x6e, 0xfd, 0xd5, 0x18, 0xe8, 0x17, 0x70, 0x35,
+		0xad, 0x28, 0x43, 0x3f, 0xe8, 0xa3, 0x7e, 0x8b, 0x37, 0x5e, 0xeb, 0xbb, 0xe2, 0x53, 0x2e, 0x99,
+		0x5e, 0x60, 0x64, 0xb8, 0x64, 0x6e, 0x0d, 0x94, 0xe1, 0x92, 0x5d, 0x2a, 0x18, 0x0a, 0x93, 0xed,
+		0x19, 0x3c, 0xba, 0x95, 0x75, 0x90, 0xf3, 0x05, 0x40, 0xf9, 0x9d, 0x9e, 0x68, 0x5b, 0x9e, 0xbb,
+		0x9c, 0xd4, 0x30, 0xe3, 0xb9, 0xeb, 0x9e, 0xda, 0x97, 0x1f, 0xf4, 0x0f, 0x6c, 0x5e, 0x7f, 0x5a,
+		0xfe, 0x92, 0x71, 0xfd, 0x39, 0x89, 0x57, 0x79, 0xad, 0x0f, 0x44, 0xab, 0x87, 0x67, 0x7d, 0x4b,
+		0x95, 0xe5, 0xe1, 0x5d, 0xbe, 0x15, 0x2b, 0xdf, 0xeb, 0x17, 0x16, 0x0b, 0xf3, 0xc8, 0x85, 0x59,
+		0x27, 0xa8, 0xa5, 0x81, 0x1f, 0x5d, 0x4d, 0x50, 0x7b, 0xf1, 0xe7, 0x88, 0xbb, 0x2c, 0x10, 0xc1,
+		0xae, 0xf1, 0xf3, 0xb5, 0x2a, 0x15, 0x47, 0xd1, 0x41, 0xc5, 0x09, 0x6a, 0xab, 0xad, 0x5f, 0xec,
+		0xdd, 0xa1, 0xae, 0xb7, 0x5a, 0x0d, 0xe2, 0x2f, 0x0d, 0xf5, 0xe7, 0x7b, 0xef, 0xe3, 0x90, 0x9e,
+		0xae, 0x1d, 0x0c, 0xab, 0xb9, 0x8d, 0xff, 0x0d, 0x00, 0x9d, 0x72, 0xa3, 0x04, 0xee, 0x28, 0x00,
+		0x00,
 	},
 	// uber/cadence/shared/v1/history.proto
 	[]byte{
@@ -1993,8 +2105,8 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0xb3, 0xa4, 0x83, 0x02, 0x7f, 0x39, 0xf3, 0x44, 0x7c, 0x8b, 0xb6, 0xcb, 0x9f, 0xbc, 0x41, 0x6f,
 		0xf8, 0x6f, 0xd2, 0x9f, 0x9e, 0xfd, 0xea, 0xe6, 0xcf, 0xe7, 0xdb, 0x85, 0x7a, 0xb6, 0xf6, 0x6e,
 		0x2e, 0x5f, 0x2f, 0xe6, 0xc2, 0x96, 0x75, 0x81, 0x99, 0x5a, 0x90, 0xe0, 0xf8, 0x78, 0x0e, 0x92,
-		0x34, 0x07, 0x5f, 0x3f, 0xf4, 0x55, 0xfb, 0xe5, 0xd2, 0x62, 0xab, 0xd9, 0x9c, 0x7f, 0x07, 0x00,
-		0x00, 0xff, 0xff, 0xc6, 0xf4, 0xa6, 0x9c, 0x12, 0x02, 0x00, 0x00,
+		0x34, 0x07, 0x5f, 0x3f, 0xf4, 0x55, 0xfb, 0xe5, 0xd2, 0x62, 0xab, 0xd9, 0x9c, 0x7f, 0x0f, 0x00,
+		0xc6, 0xf4, 0xa6, 0x9c, 0x12, 0x02, 0x00, 0x00,
 	},
 	// uber/cadence/admin/v1/history.proto
 	[]byte{
@@ -2011,8 +2123,7 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0x0d, 0x6e, 0x23, 0x4d, 0x3d, 0xac, 0xce, 0xd4, 0xc3, 0x74, 0x63, 0x10, 0x44, 0x9f, 0x93, 0x79,
 		0x94, 0x69, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x72, 0x48, 0xe8,
 		0x66, 0xa6, 0xe4, 0xe8, 0xa7, 0xe7, 0xeb, 0x83, 0xfd, 0x0f, 0x0f, 0x16, 0x6b, 0x30, 0xa3, 0xcc,
-		0x30, 0x89, 0x0d, 0x2c, 0x6e, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x44, 0x14, 0xd7, 0xd4, 0x3e,
-		0x01, 0x00, 0x00,
+		0x30, 0x89, 0x0d, 0x2c, 0x6e, 0x0c, 0x18, 0x00, 0x44, 0x14, 0xd7, 0xd4, 0x3e, 0x01, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/tasklist.proto
 	[]byte{
@@ -2026,8 +2137,8 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0x14, 0x92, 0xe2, 0x12, 0x43, 0x96, 0x70, 0x71, 0x8a, 0x77, 0x72, 0x74, 0xf6, 0xf6, 0xf1, 0x77,
 		0x17, 0x60, 0x72, 0x32, 0x8f, 0x32, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
 		0xd5, 0x47, 0x71, 0xa7, 0x5e, 0x7a, 0x6a, 0x9e, 0x3e, 0xd8, 0x65, 0x08, 0x27, 0x5b, 0x43, 0x58,
-		0x65, 0x86, 0x49, 0x6c, 0x60, 0x19, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x73, 0x06,
-		0x1c, 0xdc, 0x00, 0x00, 0x00,
+		0x65, 0x86, 0x49, 0x6c, 0x60, 0x19, 0x63, 0xc0, 0x00, 0x1f, 0x73, 0x06, 0x1c, 0xdc, 0x00, 0x00,
+		0x00,
 	},
 }
 
@@ -174,7 +174,10 @@ func (cf *rpcClientFactory) NewMatchingClientWithTimeout(
 	client := matching.NewClient(
 		rawClient,
 		peerResolver,
-		matching.NewMultiLoadBalancer(defaultLoadBalancer, loadBalancers, domainIDToName, cf.dynConfig, cf.logger),
+		matching.NewRoutingKeyLoadBalancer(
+			matching.NewMultiLoadBalancer(defaultLoadBalancer, loadBalancers, domainIDToName, cf.dynConfig, cf.logger),
+			partitionConfigProvider,
+		),
 		partitionConfigProvider,
 	)
 	client = timeoutwrapper.NewMatchingClient(client, longPollTimeout, timeout)
@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"sort"
 	"sync"
 	"time"
 
@@ -337,6 +338,75 @@ func (c *clientImpl) DescribeWorkflowExecution(
 	return response, nil
 }
 
+func (c *clientImpl) BatchDescribeWorkflowExecutions(
+	ctx context.Context,
+	request *types.HistoryBatchDescribeWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*types.BatchDescribeWorkflowExecutionsResponse, error) {
+	executions := request.Request.GetExecutions()
+	requestsByPeer := make(map[string]*types.HistoryBatchDescribeWorkflowExecutionsRequest)
+	// indexes of the executions of each peer request in the original request
+	indexesByPeer := make(map[string][]int)
+
+	for i, execution := range executions {
+		peer, err := c.peerResolver.FromWorkflowID(execution.GetWorkflowID())
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := requestsByPeer[peer]; !ok {
+			requestsByPeer[peer] = &types.HistoryBatchDescribeWorkflowExecutionsRequest{
+				DomainUUID: request.DomainUUID,
+				Request: &types.BatchDescribeWorkflowExecutionsRequest{
+					Domain: request.Request.GetDomain(),
+				},
+			}
+		}
+
+		req := requestsByPeer[peer]
+		req.Request.Executions = append(req.Request.Executions, execution)
+		indexesByPeer[peer] = append(indexesByPeer[peer], i)
+	}
+
+	results := make([]*types.DescribeWorkflowExecutionResult, len(executions))
+	var wg sync.WaitGroup
+	wg.Add(len(requestsByPeer))
+	for peer, req := range requestsByPeer {
+		go func(peer string, req *types.HistoryBatchDescribeWorkflowExecutionsRequest) {
+			defer wg.Done()
+
+			var response *types.BatchDescribeWorkflowExecutionsResponse
+			op := func(ctx context.Context, peer string) error {
+				// the call options are shared by the goroutines of every peer, append to a copy
+				peerOpts := make([]yarpc.CallOption, 0, len(opts)+1)
+				peerOpts = append(peerOpts, opts...)
+				var err error
+				response, err = c.client.BatchDescribeWorkflowExecutions(ctx, req, append(peerOpts, yarpc.WithShardKey(peer))...)
+				return err
+			}
+			err := c.executeWithRedirect(ctx, peer, op)
+			// results of a peer are returned in the order of its request,
+			// a failed call is reported on every execution it was asked to describe
+			for j, i := range indexesByPeer[peer] {
+				if err != nil || j >= len(response.GetResults()) {
+					result := &types.DescribeWorkflowExecutionResult{Execution: executions[i]}
+					if err != nil {
+						result.Error = err.Error()
+					} else {
+						result.Error = "missing result"
+					}
+					results[i] = result
+					continue
+				}
+				results[i] = response.Results[j]
+			}
+		}(peer, req)
+	}
+	wg.Wait()
+
+	return &types.BatchDescribeWorkflowExecutionsResponse{Results: results}, nil
+}
+
 func (c *clientImpl) RecordDecisionTaskStarted(
 	ctx context.Context,
 	request *types.RecordDecisionTaskStartedRequest,
@@ -483,6 +553,26 @@ func (c *clientImpl) RespondActivityTaskCanceled(
 	return err
 }
 
+func (c *clientImpl) RespondActivityTaskNack(
+	ctx context.Context,
+	request *types.HistoryRespondActivityTaskNackRequest,
+	opts ...yarpc.CallOption,
+) error {
+	taskToken, err := c.tokenSerializer.Deserialize(request.NackRequest.TaskToken)
+	if err != nil {
+		return err
+	}
+	peer, err := c.peerResolver.FromWorkflowID(taskToken.WorkflowID)
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.RespondActivityTaskNack(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
 func (c *clientImpl) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	request *types.HistoryRecordActivityTaskHeartbeatRequest,
@@ -524,6 +614,306 @@ func (c *clientImpl) RequestCancelWorkflowExecution(
 	return c.executeWithRedirect(ctx, peer, op)
 }
 
+func (c *clientImpl) SignalActivity(
+	ctx context.Context,
+	request *types.HistorySignalActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.SignalRequest.GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.SignalActivity(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	return c.executeWithRedirect(ctx, peer, op)
+}
+
+func (c *clientImpl) GetActivityEffectiveConfig(
+	ctx context.Context,
+	request *types.HistoryGetActivityEffectiveConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetActivityEffectiveConfigResponse, error) {
+	peer, err := c.peerResolver.FromWorkflowID(request.Request.GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	var response *types.GetActivityEffectiveConfigResponse
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		response, err = c.client.GetActivityEffectiveConfig(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) GetActivityNextRetryTime(
+	ctx context.Context,
+	request *types.HistoryGetActivityNextRetryTimeRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetActivityNextRetryTimeResponse, error) {
+	peer, err := c.peerResolver.FromWorkflowID(request.Request.GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	var response *types.GetActivityNextRetryTimeResponse
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		response, err = c.client.GetActivityNextRetryTime(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) ReplayActivityCompletions(
+	ctx context.Context,
+	request *types.HistoryReplayActivityCompletionsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ReplayActivityCompletionsResponse, error) {
+	peer, err := c.peerResolver.FromWorkflowID(request.Request.GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	var response *types.ReplayActivityCompletionsResponse
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		response, err = c.client.ReplayActivityCompletions(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) GetActivityRescheduleReasons(
+	ctx context.Context,
+	request *types.HistoryGetActivityRescheduleReasonsRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetActivityRescheduleReasonsResponse, error) {
+	peer, err := c.peerResolver.FromWorkflowID(request.Request.GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	var response *types.GetActivityRescheduleReasonsResponse
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		response, err = c.client.GetActivityRescheduleReasons(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) GetActivityWorkerHistory(
+	ctx context.Context,
+	request *types.HistoryGetActivityWorkerHistoryRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetActivityWorkerHistoryResponse, error) {
+	peer, err := c.peerResolver.FromWorkflowID(request.Request.GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	var response *types.GetActivityWorkerHistoryResponse
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		response, err = c.client.GetActivityWorkerHistory(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) GetActivityRetryStats(
+	ctx context.Context,
+	request *types.HistoryGetActivityRetryStatsRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetActivityRetryStatsResponse, error) {
+
+	peers, err := c.peerResolver.GetAllPeers()
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	responses := make([]*types.GetActivityRetryStatsResponse, 0, len(peers))
+
+	g := &errgroup.Group{}
+	for _, peer := range peers {
+		peer := peer
+		g.Go(func() (e error) {
+			defer func() { log.CapturePanic(recover(), c.logger, &e) }()
+
+			response, err := c.client.GetActivityRetryStats(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+			if err == nil {
+				mu.Lock()
+				responses = append(responses, response)
+				mu.Unlock()
+			}
+
+			return err
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	// each host only aggregates the attempts closed on it, so the stats of the domain are the sum over the hosts
+	stats := &types.GetActivityRetryStatsResponse{}
+	for _, response := range responses {
+		if response.StartTime != 0 && (stats.StartTime == 0 || response.StartTime < stats.StartTime) {
+			stats.StartTime = response.StartTime
+		}
+		if response.EndTime > stats.EndTime {
+			stats.EndTime = response.EndTime
+		}
+		stats.TotalAttempts += response.TotalAttempts
+		stats.RetriedAttempts += response.RetriedAttempts
+		stats.Successes += response.Successes
+		stats.FirstAttemptSuccesses += response.FirstAttemptSuccesses
+	}
+	return stats, nil
+}
+
+func (c *clientImpl) GetActivityTokenValidationFailures(
+	ctx context.Context,
+	request *types.HistoryGetActivityTokenValidationFailuresRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetActivityTokenValidationFailuresResponse, error) {
+
+	peers, err := c.peerResolver.GetAllPeers()
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	var failures []*types.ActivityTokenValidationFailure
+
+	g := &errgroup.Group{}
+	for _, peer := range peers {
+		peer := peer
+		g.Go(func() (e error) {
+			defer func() { log.CapturePanic(recover(), c.logger, &e) }()
+
+			response, err := c.client.GetActivityTokenValidationFailures(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+			if err == nil {
+				mu.Lock()
+				failures = append(failures, response.GetFailures()...)
+				mu.Unlock()
+			}
+
+			return err
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(failures, func(i, j int) bool {
+		return failures[i].GetTimestamp() < failures[j].GetTimestamp()
+	})
+	return &types.GetActivityTokenValidationFailuresResponse{Failures: failures}, nil
+}
+
+func (c *clientImpl) GetActivityAttemptTimings(
+	ctx context.Context,
+	request *types.HistoryGetActivityAttemptTimingsRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetActivityAttemptTimingsResponse, error) {
+	peer, err := c.peerResolver.FromWorkflowID(request.Request.GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	var response *types.GetActivityAttemptTimingsResponse
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		response, err = c.client.GetActivityAttemptTimings(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) ExpireActivityHeartbeat(
+	ctx context.Context,
+	request *types.HistoryExpireActivityHeartbeatRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.Request.GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.ExpireActivityHeartbeat(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	return c.executeWithRedirect(ctx, peer, op)
+}
+
+func (c *clientImpl) ResetActivityAttempts(
+	ctx context.Context,
+	request *types.HistoryResetActivityAttemptsRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.Request.GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.ResetActivityAttempts(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	return c.executeWithRedirect(ctx, peer, op)
+}
+
+func (c *clientImpl) RedriveTimedOutActivities(
+	ctx context.Context,
+	request *types.HistoryRedriveTimedOutActivitiesRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.Request.GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.RedriveTimedOutActivities(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	return c.executeWithRedirect(ctx, peer, op)
+}
+
+func (c *clientImpl) CancelPendingActivities(
+	ctx context.Context,
+	request *types.HistoryCancelPendingActivitiesRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.Request.GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.CancelPendingActivities(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	return c.executeWithRedirect(ctx, peer, op)
+}
+
 func (c *clientImpl) SignalWorkflowExecution(
 	ctx context.Context,
 	request *types.HistorySignalWorkflowExecutionRequest,
@@ -132,6 +132,77 @@ func TestClient_withResponse(t *testing.T) {
 			},
 			want: &types.DescribeWorkflowExecutionResponse{},
 		},
+		{
+			name: "BatchDescribeWorkflowExecutions",
+			op: func(c Client) (any, error) {
+				return c.BatchDescribeWorkflowExecutions(context.Background(), &types.HistoryBatchDescribeWorkflowExecutionsRequest{
+					Request: &types.BatchDescribeWorkflowExecutionsRequest{
+						Executions: []*types.WorkflowExecution{
+							{WorkflowID: "test-workflow-0"},
+							{WorkflowID: "test-workflow-1"},
+							{WorkflowID: "test-workflow-2"},
+						},
+					},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow-0").Return("test-peer-0", nil).Times(1)
+				p.EXPECT().FromWorkflowID("test-workflow-1").Return("test-peer-1", nil).Times(1)
+				p.EXPECT().FromWorkflowID("test-workflow-2").Return("test-peer-0", nil).Times(1)
+				c.EXPECT().BatchDescribeWorkflowExecutions(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-0")}).
+					Return(&types.BatchDescribeWorkflowExecutionsResponse{
+						Results: []*types.DescribeWorkflowExecutionResult{
+							{Execution: &types.WorkflowExecution{WorkflowID: "test-workflow-0"}, Response: &types.DescribeWorkflowExecutionResponse{}},
+							{Execution: &types.WorkflowExecution{WorkflowID: "test-workflow-2"}, Response: &types.DescribeWorkflowExecutionResponse{}},
+						},
+					}, nil).Times(1)
+				c.EXPECT().BatchDescribeWorkflowExecutions(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-1")}).
+					Return(nil, &types.InternalServiceError{Message: "test-error"}).Times(1)
+			},
+			want: &types.BatchDescribeWorkflowExecutionsResponse{
+				Results: []*types.DescribeWorkflowExecutionResult{
+					{Execution: &types.WorkflowExecution{WorkflowID: "test-workflow-0"}, Response: &types.DescribeWorkflowExecutionResponse{}},
+					{Execution: &types.WorkflowExecution{WorkflowID: "test-workflow-1"}, Error: "test-error"},
+					{Execution: &types.WorkflowExecution{WorkflowID: "test-workflow-2"}, Response: &types.DescribeWorkflowExecutionResponse{}},
+				},
+			},
+		},
+		{
+			name: "BatchDescribeWorkflowExecutions with call options",
+			op: func(c Client) (any, error) {
+				// spare capacity makes the peers of the batch share the backing array of the options
+				opts := make([]yarpc.CallOption, 1, 4)
+				opts[0] = yarpc.WithHeader("test-key", "test-value")
+				return c.BatchDescribeWorkflowExecutions(context.Background(), &types.HistoryBatchDescribeWorkflowExecutionsRequest{
+					Request: &types.BatchDescribeWorkflowExecutionsRequest{
+						Executions: []*types.WorkflowExecution{
+							{WorkflowID: "test-workflow-0"},
+							{WorkflowID: "test-workflow-1"},
+						},
+					},
+				}, opts...)
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow-0").Return("test-peer-0", nil).Times(1)
+				p.EXPECT().FromWorkflowID("test-workflow-1").Return("test-peer-1", nil).Times(1)
+				for i := 0; i < 2; i++ {
+					peer := fmt.Sprintf("test-peer-%d", i)
+					execution := &types.WorkflowExecution{WorkflowID: fmt.Sprintf("test-workflow-%d", i)}
+					c.EXPECT().BatchDescribeWorkflowExecutions(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithHeader("test-key", "test-value"), yarpc.WithShardKey(peer)}).
+						Return(&types.BatchDescribeWorkflowExecutionsResponse{
+							Results: []*types.DescribeWorkflowExecutionResult{
+								{Execution: execution, Response: &types.DescribeWorkflowExecutionResponse{}},
+							},
+						}, nil).Times(1)
+				}
+			},
+			want: &types.BatchDescribeWorkflowExecutionsResponse{
+				Results: []*types.DescribeWorkflowExecutionResult{
+					{Execution: &types.WorkflowExecution{WorkflowID: "test-workflow-0"}, Response: &types.DescribeWorkflowExecutionResponse{}},
+					{Execution: &types.WorkflowExecution{WorkflowID: "test-workflow-1"}, Response: &types.DescribeWorkflowExecutionResponse{}},
+				},
+			},
+		},
 		{
 			name: "RecordActivityTaskHeartbeat",
 			op: func(c Client) (any, error) {
@@ -376,6 +447,70 @@ func TestClient_withResponse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "GetActivityRetryStats",
+			op: func(c Client) (any, error) {
+				return c.GetActivityRetryStats(context.Background(), &types.HistoryGetActivityRetryStatsRequest{})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().GetAllPeers().Return([]string{"test-peer-0", "test-peer-1"}, nil).Times(1)
+				c.EXPECT().GetActivityRetryStats(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-0")}).
+					Return(&types.GetActivityRetryStatsResponse{
+						StartTime:             10,
+						EndTime:               20,
+						TotalAttempts:         3,
+						RetriedAttempts:       1,
+						Successes:             2,
+						FirstAttemptSuccesses: 1,
+					}, nil).Times(1)
+				c.EXPECT().GetActivityRetryStats(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-1")}).
+					Return(&types.GetActivityRetryStatsResponse{
+						StartTime:             11,
+						EndTime:               21,
+						TotalAttempts:         4,
+						RetriedAttempts:       2,
+						Successes:             3,
+						FirstAttemptSuccesses: 2,
+					}, nil).Times(1)
+			},
+			want: &types.GetActivityRetryStatsResponse{
+				StartTime:             10,
+				EndTime:               21,
+				TotalAttempts:         7,
+				RetriedAttempts:       3,
+				Successes:             5,
+				FirstAttemptSuccesses: 3,
+			},
+		},
+		{
+			name: "GetActivityTokenValidationFailures",
+			op: func(c Client) (any, error) {
+				return c.GetActivityTokenValidationFailures(context.Background(), &types.HistoryGetActivityTokenValidationFailuresRequest{})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().GetAllPeers().Return([]string{"test-peer-0", "test-peer-1"}, nil).Times(1)
+				c.EXPECT().GetActivityTokenValidationFailures(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-0")}).
+					Return(&types.GetActivityTokenValidationFailuresResponse{
+						Failures: []*types.ActivityTokenValidationFailure{
+							{ActivityID: "a", Timestamp: common.Int64Ptr(3)},
+							{ActivityID: "b", Timestamp: common.Int64Ptr(5)},
+						},
+					}, nil).Times(1)
+				c.EXPECT().GetActivityTokenValidationFailures(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-1")}).
+					Return(&types.GetActivityTokenValidationFailuresResponse{
+						Failures: []*types.ActivityTokenValidationFailure{
+							{ActivityID: "c", Timestamp: common.Int64Ptr(4)},
+						},
+					}, nil).Times(1)
+			},
+			want: &types.GetActivityTokenValidationFailuresResponse{
+				Failures: []*types.ActivityTokenValidationFailure{
+					{ActivityID: "a", Timestamp: common.Int64Ptr(3)},
+					{ActivityID: "c", Timestamp: common.Int64Ptr(4)},
+					{ActivityID: "b", Timestamp: common.Int64Ptr(5)},
+				},
+			},
+		},
 		{
 			name: "QueryWorkflow",
 			op: func(c Client) (any, error) {
@@ -839,6 +974,30 @@ func TestClient_withResponse(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			name: "GetActivityRetryStats fail",
+			op: func(c Client) (any, error) {
+				return c.GetActivityRetryStats(context.Background(), &types.HistoryGetActivityRetryStatsRequest{})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().GetAllPeers().Return([]string{"test-peer"}, nil).Times(1)
+				c.EXPECT().GetActivityRetryStats(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil, fmt.Errorf("GetActivityRetryStats failed")).Times(1)
+			},
+			wantError: true,
+		},
+		{
+			name: "GetActivityTokenValidationFailures fail",
+			op: func(c Client) (any, error) {
+				return c.GetActivityTokenValidationFailures(context.Background(), &types.HistoryGetActivityTokenValidationFailuresRequest{})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().GetAllPeers().Return([]string{"test-peer"}, nil).Times(1)
+				c.EXPECT().GetActivityTokenValidationFailures(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil, fmt.Errorf("GetActivityTokenValidationFailures failed")).Times(1)
+			},
+			wantError: true,
+		},
 		{
 			name: "QueryWorkflow fail",
 			op: func(c Client) (any, error) {
@@ -1207,6 +1366,21 @@ func TestClient_withNoResponse(t *testing.T) {
 					Return(nil).Times(1)
 			},
 		},
+		{
+			name: "RespondActivityTaskNack",
+			op: func(c Client) error {
+				return c.RespondActivityTaskNack(context.Background(), &types.HistoryRespondActivityTaskNackRequest{
+					NackRequest: &types.RespondActivityTaskNackRequest{
+						TaskToken: []byte(`{"workflowId": "test-workflow"}`),
+					},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow").Return("test-peer", nil).Times(1)
+				c.EXPECT().RespondActivityTaskNack(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil).Times(1)
+			},
+		},
 		{
 			name: "RespondDecisionTaskFailed",
 			op: func(c Client) error {
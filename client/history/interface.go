@@ -42,7 +42,17 @@ type Client interface {
 	DescribeHistoryHost(context.Context, *types.DescribeHistoryHostRequest, ...yarpc.CallOption) (*types.DescribeHistoryHostResponse, error)
 	DescribeMutableState(context.Context, *types.DescribeMutableStateRequest, ...yarpc.CallOption) (*types.DescribeMutableStateResponse, error)
 	DescribeQueue(context.Context, *types.DescribeQueueRequest, ...yarpc.CallOption) (*types.DescribeQueueResponse, error)
+	BatchDescribeWorkflowExecutions(context.Context, *types.HistoryBatchDescribeWorkflowExecutionsRequest, ...yarpc.CallOption) (*types.BatchDescribeWorkflowExecutionsResponse, error)
+	CancelPendingActivities(context.Context, *types.HistoryCancelPendingActivitiesRequest, ...yarpc.CallOption) error
 	DescribeWorkflowExecution(context.Context, *types.HistoryDescribeWorkflowExecutionRequest, ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error)
+	ExpireActivityHeartbeat(context.Context, *types.HistoryExpireActivityHeartbeatRequest, ...yarpc.CallOption) error
+	GetActivityAttemptTimings(context.Context, *types.HistoryGetActivityAttemptTimingsRequest, ...yarpc.CallOption) (*types.GetActivityAttemptTimingsResponse, error)
+	GetActivityEffectiveConfig(context.Context, *types.HistoryGetActivityEffectiveConfigRequest, ...yarpc.CallOption) (*types.GetActivityEffectiveConfigResponse, error)
+	GetActivityNextRetryTime(context.Context, *types.HistoryGetActivityNextRetryTimeRequest, ...yarpc.CallOption) (*types.GetActivityNextRetryTimeResponse, error)
+	GetActivityRescheduleReasons(context.Context, *types.HistoryGetActivityRescheduleReasonsRequest, ...yarpc.CallOption) (*types.GetActivityRescheduleReasonsResponse, error)
+	GetActivityRetryStats(context.Context, *types.HistoryGetActivityRetryStatsRequest, ...yarpc.CallOption) (*types.GetActivityRetryStatsResponse, error)
+	GetActivityTokenValidationFailures(context.Context, *types.HistoryGetActivityTokenValidationFailuresRequest, ...yarpc.CallOption) (*types.GetActivityTokenValidationFailuresResponse, error)
+	GetActivityWorkerHistory(context.Context, *types.HistoryGetActivityWorkerHistoryRequest, ...yarpc.CallOption) (*types.GetActivityWorkerHistoryResponse, error)
 	GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest, ...yarpc.CallOption) (*types.GetCrossClusterTasksResponse, error)
 	GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest, ...yarpc.CallOption) (*types.GetDLQReplicationMessagesResponse, error)
 	CountDLQMessages(context.Context, *types.CountDLQMessagesRequest, ...yarpc.CallOption) (*types.HistoryCountDLQMessagesResponse, error)
@@ -59,21 +69,26 @@ type Client interface {
 	RecordActivityTaskStarted(context.Context, *types.RecordActivityTaskStartedRequest, ...yarpc.CallOption) (*types.RecordActivityTaskStartedResponse, error)
 	RecordChildExecutionCompleted(context.Context, *types.RecordChildExecutionCompletedRequest, ...yarpc.CallOption) error
 	RecordDecisionTaskStarted(context.Context, *types.RecordDecisionTaskStartedRequest, ...yarpc.CallOption) (*types.RecordDecisionTaskStartedResponse, error)
+	RedriveTimedOutActivities(context.Context, *types.HistoryRedriveTimedOutActivitiesRequest, ...yarpc.CallOption) error
 	RefreshWorkflowTasks(context.Context, *types.HistoryRefreshWorkflowTasksRequest, ...yarpc.CallOption) error
 	RemoveSignalMutableState(context.Context, *types.RemoveSignalMutableStateRequest, ...yarpc.CallOption) error
 	RemoveTask(context.Context, *types.RemoveTaskRequest, ...yarpc.CallOption) error
+	ReplayActivityCompletions(context.Context, *types.HistoryReplayActivityCompletionsRequest, ...yarpc.CallOption) (*types.ReplayActivityCompletionsResponse, error)
 	ReplicateEventsV2(context.Context, *types.ReplicateEventsV2Request, ...yarpc.CallOption) error
 	RequestCancelWorkflowExecution(context.Context, *types.HistoryRequestCancelWorkflowExecutionRequest, ...yarpc.CallOption) error
+	ResetActivityAttempts(context.Context, *types.HistoryResetActivityAttemptsRequest, ...yarpc.CallOption) error
 	ResetQueue(context.Context, *types.ResetQueueRequest, ...yarpc.CallOption) error
 	ResetStickyTaskList(context.Context, *types.HistoryResetStickyTaskListRequest, ...yarpc.CallOption) (*types.HistoryResetStickyTaskListResponse, error)
 	ResetWorkflowExecution(context.Context, *types.HistoryResetWorkflowExecutionRequest, ...yarpc.CallOption) (*types.ResetWorkflowExecutionResponse, error)
 	RespondActivityTaskCanceled(context.Context, *types.HistoryRespondActivityTaskCanceledRequest, ...yarpc.CallOption) error
 	RespondActivityTaskCompleted(context.Context, *types.HistoryRespondActivityTaskCompletedRequest, ...yarpc.CallOption) error
 	RespondActivityTaskFailed(context.Context, *types.HistoryRespondActivityTaskFailedRequest, ...yarpc.CallOption) error
+	RespondActivityTaskNack(context.Context, *types.HistoryRespondActivityTaskNackRequest, ...yarpc.CallOption) error
 	RespondCrossClusterTasksCompleted(context.Context, *types.RespondCrossClusterTasksCompletedRequest, ...yarpc.CallOption) (*types.RespondCrossClusterTasksCompletedResponse, error)
 	RespondDecisionTaskCompleted(context.Context, *types.HistoryRespondDecisionTaskCompletedRequest, ...yarpc.CallOption) (*types.HistoryRespondDecisionTaskCompletedResponse, error)
 	RespondDecisionTaskFailed(context.Context, *types.HistoryRespondDecisionTaskFailedRequest, ...yarpc.CallOption) error
 	ScheduleDecisionTask(context.Context, *types.ScheduleDecisionTaskRequest, ...yarpc.CallOption) error
+	SignalActivity(context.Context, *types.HistorySignalActivityRequest, ...yarpc.CallOption) error
 	SignalWithStartWorkflowExecution(context.Context, *types.HistorySignalWithStartWorkflowExecutionRequest, ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error)
 	SignalWorkflowExecution(context.Context, *types.HistorySignalWorkflowExecutionRequest, ...yarpc.CallOption) error
 	StartWorkflowExecution(context.Context, *types.HistoryStartWorkflowExecutionRequest, ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error)
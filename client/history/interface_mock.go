@@ -65,6 +65,45 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// BatchDescribeWorkflowExecutions mocks base method.
+func (m *MockClient) BatchDescribeWorkflowExecutions(arg0 context.Context, arg1 *types.HistoryBatchDescribeWorkflowExecutionsRequest, arg2 ...yarpc.CallOption) (*types.BatchDescribeWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchDescribeWorkflowExecutions", varargs...)
+	ret0, _ := ret[0].(*types.BatchDescribeWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchDescribeWorkflowExecutions indicates an expected call of BatchDescribeWorkflowExecutions.
+func (mr *MockClientMockRecorder) BatchDescribeWorkflowExecutions(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDescribeWorkflowExecutions", reflect.TypeOf((*MockClient)(nil).BatchDescribeWorkflowExecutions), varargs...)
+}
+
+// CancelPendingActivities mocks base method.
+func (m *MockClient) CancelPendingActivities(arg0 context.Context, arg1 *types.HistoryCancelPendingActivitiesRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelPendingActivities", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelPendingActivities indicates an expected call of CancelPendingActivities.
+func (mr *MockClientMockRecorder) CancelPendingActivities(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPendingActivities", reflect.TypeOf((*MockClient)(nil).CancelPendingActivities), varargs...)
+}
+
 // CloseShard mocks base method.
 func (m *MockClient) CloseShard(arg0 context.Context, arg1 *types.CloseShardRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -184,6 +223,165 @@ func (mr *MockClientMockRecorder) DescribeWorkflowExecution(arg0, arg1 any, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockClient)(nil).DescribeWorkflowExecution), varargs...)
 }
 
+// ExpireActivityHeartbeat mocks base method.
+func (m *MockClient) ExpireActivityHeartbeat(arg0 context.Context, arg1 *types.HistoryExpireActivityHeartbeatRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExpireActivityHeartbeat", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireActivityHeartbeat indicates an expected call of ExpireActivityHeartbeat.
+func (mr *MockClientMockRecorder) ExpireActivityHeartbeat(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireActivityHeartbeat", reflect.TypeOf((*MockClient)(nil).ExpireActivityHeartbeat), varargs...)
+}
+
+// GetActivityAttemptTimings mocks base method.
+func (m *MockClient) GetActivityAttemptTimings(arg0 context.Context, arg1 *types.HistoryGetActivityAttemptTimingsRequest, arg2 ...yarpc.CallOption) (*types.GetActivityAttemptTimingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetActivityAttemptTimings", varargs...)
+	ret0, _ := ret[0].(*types.GetActivityAttemptTimingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityAttemptTimings indicates an expected call of GetActivityAttemptTimings.
+func (mr *MockClientMockRecorder) GetActivityAttemptTimings(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityAttemptTimings", reflect.TypeOf((*MockClient)(nil).GetActivityAttemptTimings), varargs...)
+}
+
+// GetActivityEffectiveConfig mocks base method.
+func (m *MockClient) GetActivityEffectiveConfig(arg0 context.Context, arg1 *types.HistoryGetActivityEffectiveConfigRequest, arg2 ...yarpc.CallOption) (*types.GetActivityEffectiveConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetActivityEffectiveConfig", varargs...)
+	ret0, _ := ret[0].(*types.GetActivityEffectiveConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityEffectiveConfig indicates an expected call of GetActivityEffectiveConfig.
+func (mr *MockClientMockRecorder) GetActivityEffectiveConfig(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityEffectiveConfig", reflect.TypeOf((*MockClient)(nil).GetActivityEffectiveConfig), varargs...)
+}
+
+// GetActivityNextRetryTime mocks base method.
+func (m *MockClient) GetActivityNextRetryTime(arg0 context.Context, arg1 *types.HistoryGetActivityNextRetryTimeRequest, arg2 ...yarpc.CallOption) (*types.GetActivityNextRetryTimeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetActivityNextRetryTime", varargs...)
+	ret0, _ := ret[0].(*types.GetActivityNextRetryTimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityNextRetryTime indicates an expected call of GetActivityNextRetryTime.
+func (mr *MockClientMockRecorder) GetActivityNextRetryTime(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityNextRetryTime", reflect.TypeOf((*MockClient)(nil).GetActivityNextRetryTime), varargs...)
+}
+
+// GetActivityRescheduleReasons mocks base method.
+func (m *MockClient) GetActivityRescheduleReasons(arg0 context.Context, arg1 *types.HistoryGetActivityRescheduleReasonsRequest, arg2 ...yarpc.CallOption) (*types.GetActivityRescheduleReasonsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetActivityRescheduleReasons", varargs...)
+	ret0, _ := ret[0].(*types.GetActivityRescheduleReasonsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityRescheduleReasons indicates an expected call of GetActivityRescheduleReasons.
+func (mr *MockClientMockRecorder) GetActivityRescheduleReasons(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityRescheduleReasons", reflect.TypeOf((*MockClient)(nil).GetActivityRescheduleReasons), varargs...)
+}
+
+// GetActivityRetryStats mocks base method.
+func (m *MockClient) GetActivityRetryStats(arg0 context.Context, arg1 *types.HistoryGetActivityRetryStatsRequest, arg2 ...yarpc.CallOption) (*types.GetActivityRetryStatsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetActivityRetryStats", varargs...)
+	ret0, _ := ret[0].(*types.GetActivityRetryStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityRetryStats indicates an expected call of GetActivityRetryStats.
+func (mr *MockClientMockRecorder) GetActivityRetryStats(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityRetryStats", reflect.TypeOf((*MockClient)(nil).GetActivityRetryStats), varargs...)
+}
+
+// GetActivityTokenValidationFailures mocks base method.
+func (m *MockClient) GetActivityTokenValidationFailures(arg0 context.Context, arg1 *types.HistoryGetActivityTokenValidationFailuresRequest, arg2 ...yarpc.CallOption) (*types.GetActivityTokenValidationFailuresResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetActivityTokenValidationFailures", varargs...)
+	ret0, _ := ret[0].(*types.GetActivityTokenValidationFailuresResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityTokenValidationFailures indicates an expected call of GetActivityTokenValidationFailures.
+func (mr *MockClientMockRecorder) GetActivityTokenValidationFailures(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityTokenValidationFailures", reflect.TypeOf((*MockClient)(nil).GetActivityTokenValidationFailures), varargs...)
+}
+
+// GetActivityWorkerHistory mocks base method.
+func (m *MockClient) GetActivityWorkerHistory(arg0 context.Context, arg1 *types.HistoryGetActivityWorkerHistoryRequest, arg2 ...yarpc.CallOption) (*types.GetActivityWorkerHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetActivityWorkerHistory", varargs...)
+	ret0, _ := ret[0].(*types.GetActivityWorkerHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityWorkerHistory indicates an expected call of GetActivityWorkerHistory.
+func (mr *MockClientMockRecorder) GetActivityWorkerHistory(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityWorkerHistory", reflect.TypeOf((*MockClient)(nil).GetActivityWorkerHistory), varargs...)
+}
+
 // GetCrossClusterTasks mocks base method.
 func (m *MockClient) GetCrossClusterTasks(arg0 context.Context, arg1 *types.GetCrossClusterTasksRequest, arg2 ...yarpc.CallOption) (*types.GetCrossClusterTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -520,6 +718,25 @@ func (mr *MockClientMockRecorder) RecordDecisionTaskStarted(arg0, arg1 any, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDecisionTaskStarted", reflect.TypeOf((*MockClient)(nil).RecordDecisionTaskStarted), varargs...)
 }
 
+// RedriveTimedOutActivities mocks base method.
+func (m *MockClient) RedriveTimedOutActivities(arg0 context.Context, arg1 *types.HistoryRedriveTimedOutActivitiesRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RedriveTimedOutActivities", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedriveTimedOutActivities indicates an expected call of RedriveTimedOutActivities.
+func (mr *MockClientMockRecorder) RedriveTimedOutActivities(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedriveTimedOutActivities", reflect.TypeOf((*MockClient)(nil).RedriveTimedOutActivities), varargs...)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockClient) RefreshWorkflowTasks(arg0 context.Context, arg1 *types.HistoryRefreshWorkflowTasksRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -577,6 +794,26 @@ func (mr *MockClientMockRecorder) RemoveTask(arg0, arg1 any, arg2 ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockClient)(nil).RemoveTask), varargs...)
 }
 
+// ReplayActivityCompletions mocks base method.
+func (m *MockClient) ReplayActivityCompletions(arg0 context.Context, arg1 *types.HistoryReplayActivityCompletionsRequest, arg2 ...yarpc.CallOption) (*types.ReplayActivityCompletionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReplayActivityCompletions", varargs...)
+	ret0, _ := ret[0].(*types.ReplayActivityCompletionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayActivityCompletions indicates an expected call of ReplayActivityCompletions.
+func (mr *MockClientMockRecorder) ReplayActivityCompletions(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayActivityCompletions", reflect.TypeOf((*MockClient)(nil).ReplayActivityCompletions), varargs...)
+}
+
 // ReplicateEventsV2 mocks base method.
 func (m *MockClient) ReplicateEventsV2(arg0 context.Context, arg1 *types.ReplicateEventsV2Request, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -615,6 +852,25 @@ func (mr *MockClientMockRecorder) RequestCancelWorkflowExecution(arg0, arg1 any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockClient)(nil).RequestCancelWorkflowExecution), varargs...)
 }
 
+// ResetActivityAttempts mocks base method.
+func (m *MockClient) ResetActivityAttempts(arg0 context.Context, arg1 *types.HistoryResetActivityAttemptsRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetActivityAttempts", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivityAttempts indicates an expected call of ResetActivityAttempts.
+func (mr *MockClientMockRecorder) ResetActivityAttempts(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityAttempts", reflect.TypeOf((*MockClient)(nil).ResetActivityAttempts), varargs...)
+}
+
 // ResetQueue mocks base method.
 func (m *MockClient) ResetQueue(arg0 context.Context, arg1 *types.ResetQueueRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -731,6 +987,25 @@ func (mr *MockClientMockRecorder) RespondActivityTaskFailed(arg0, arg1 any, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondActivityTaskFailed", reflect.TypeOf((*MockClient)(nil).RespondActivityTaskFailed), varargs...)
 }
 
+// RespondActivityTaskNack mocks base method.
+func (m *MockClient) RespondActivityTaskNack(arg0 context.Context, arg1 *types.HistoryRespondActivityTaskNackRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RespondActivityTaskNack", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RespondActivityTaskNack indicates an expected call of RespondActivityTaskNack.
+func (mr *MockClientMockRecorder) RespondActivityTaskNack(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondActivityTaskNack", reflect.TypeOf((*MockClient)(nil).RespondActivityTaskNack), varargs...)
+}
+
 // RespondCrossClusterTasksCompleted mocks base method.
 func (m *MockClient) RespondCrossClusterTasksCompleted(arg0 context.Context, arg1 *types.RespondCrossClusterTasksCompletedRequest, arg2 ...yarpc.CallOption) (*types.RespondCrossClusterTasksCompletedResponse, error) {
 	m.ctrl.T.Helper()
@@ -809,6 +1084,25 @@ func (mr *MockClientMockRecorder) ScheduleDecisionTask(arg0, arg1 any, arg2 ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleDecisionTask", reflect.TypeOf((*MockClient)(nil).ScheduleDecisionTask), varargs...)
 }
 
+// SignalActivity mocks base method.
+func (m *MockClient) SignalActivity(arg0 context.Context, arg1 *types.HistorySignalActivityRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SignalActivity", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignalActivity indicates an expected call of SignalActivity.
+func (mr *MockClientMockRecorder) SignalActivity(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalActivity", reflect.TypeOf((*MockClient)(nil).SignalActivity), varargs...)
+}
+
 // SignalWithStartWorkflowExecution mocks base method.
 func (m *MockClient) SignalWithStartWorkflowExecution(arg0 context.Context, arg1 *types.HistorySignalWithStartWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
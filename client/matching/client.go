@@ -230,6 +230,18 @@ func (c *clientImpl) CancelOutstandingPoll(
 	return c.client.CancelOutstandingPoll(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
 }
 
+func (c *clientImpl) RecordBackpressureHint(
+	ctx context.Context,
+	request *types.MatchingRecordBackpressureHintRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromTaskList(request.TaskList.GetName())
+	if err != nil {
+		return err
+	}
+	return c.client.RecordBackpressureHint(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+}
+
 func (c *clientImpl) DescribeTaskList(
 	ctx context.Context,
 	request *types.MatchingDescribeTaskListRequest,
@@ -323,3 +335,15 @@ func (c *clientImpl) RefreshTaskListPartitionConfig(
 	}
 	return c.client.RefreshTaskListPartitionConfig(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
 }
+
+func (c *clientImpl) RemoveTaskListTask(
+	ctx context.Context,
+	request *types.MatchingRemoveTaskListTaskRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingRemoveTaskListTaskResponse, error) {
+	peer, err := c.peerResolver.FromTaskList(request.TaskList.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return c.client.RemoveTaskListTask(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+}
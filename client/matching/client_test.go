@@ -121,6 +121,26 @@ func TestClient_withoutResponse(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			name: "RecordBackpressureHint",
+			op: func(c Client) error {
+				return c.RecordBackpressureHint(context.Background(), testMatchingRecordBackpressureHintRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", nil)
+				c.EXPECT().RecordBackpressureHint(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(nil)
+			},
+		},
+		{
+			name: "RecordBackpressureHint - Error in resolving peer",
+			op: func(c Client) error {
+				return c.RecordBackpressureHint(context.Background(), testMatchingRecordBackpressureHintRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", assert.AnError)
+			},
+			wantError: true,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -503,6 +523,28 @@ func TestClient_withResponse(t *testing.T) {
 			want:      nil,
 			wantError: true,
 		},
+		{
+			name: "RemoveTaskListTask",
+			op: func(c Client) (any, error) {
+				return c.RemoveTaskListTask(context.Background(), testMatchingRemoveTaskListTaskRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", nil)
+				c.EXPECT().RemoveTaskListTask(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(&types.MatchingRemoveTaskListTaskResponse{TaskID: 1}, nil)
+			},
+			want: &types.MatchingRemoveTaskListTaskResponse{TaskID: 1},
+		},
+		{
+			name: "RemoveTaskListTask - Error in resolving peer",
+			op: func(c Client) (any, error) {
+				return c.RemoveTaskListTask(context.Background(), testMatchingRemoveTaskListTaskRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -557,6 +599,14 @@ func testCancelOutstandingPollRequest() *types.CancelOutstandingPollRequest {
 	}
 }
 
+func testMatchingRecordBackpressureHintRequest() *types.MatchingRecordBackpressureHintRequest {
+	return &types.MatchingRecordBackpressureHintRequest{
+		DomainUUID: _testDomainUUID,
+		TaskList:   &types.TaskList{Name: _testTaskList},
+		Hint:       0.5,
+	}
+}
+
 func testMatchingPollForActivityTaskRequest() *types.MatchingPollForActivityTaskRequest {
 	return &types.MatchingPollForActivityTaskRequest{
 		DomainUUID:  _testDomainUUID,
@@ -644,3 +694,13 @@ func testMatchingRefreshTaskListPartitionConfigRequest() *types.MatchingRefreshT
 		},
 	}
 }
+
+func testMatchingRemoveTaskListTaskRequest() *types.MatchingRemoveTaskListTaskRequest {
+	return &types.MatchingRemoveTaskListTaskRequest{
+		DomainUUID: _testDomainUUID,
+		TaskList:   &types.TaskList{Name: _testTaskList},
+		TaskID:     1,
+		Operator:   "operator",
+		Reason:     "reason",
+	}
+}
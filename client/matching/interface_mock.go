@@ -244,6 +244,25 @@ func (mr *MockClientMockRecorder) QueryWorkflow(arg0, arg1 any, arg2 ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockClient)(nil).QueryWorkflow), varargs...)
 }
 
+// RecordBackpressureHint mocks base method.
+func (m *MockClient) RecordBackpressureHint(arg0 context.Context, arg1 *types.MatchingRecordBackpressureHintRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecordBackpressureHint", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordBackpressureHint indicates an expected call of RecordBackpressureHint.
+func (mr *MockClientMockRecorder) RecordBackpressureHint(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordBackpressureHint", reflect.TypeOf((*MockClient)(nil).RecordBackpressureHint), varargs...)
+}
+
 // RefreshTaskListPartitionConfig mocks base method.
 func (m *MockClient) RefreshTaskListPartitionConfig(arg0 context.Context, arg1 *types.MatchingRefreshTaskListPartitionConfigRequest, arg2 ...yarpc.CallOption) (*types.MatchingRefreshTaskListPartitionConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -264,6 +283,26 @@ func (mr *MockClientMockRecorder) RefreshTaskListPartitionConfig(arg0, arg1 any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTaskListPartitionConfig", reflect.TypeOf((*MockClient)(nil).RefreshTaskListPartitionConfig), varargs...)
 }
 
+// RemoveTaskListTask mocks base method.
+func (m *MockClient) RemoveTaskListTask(arg0 context.Context, arg1 *types.MatchingRemoveTaskListTaskRequest, arg2 ...yarpc.CallOption) (*types.MatchingRemoveTaskListTaskResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveTaskListTask", varargs...)
+	ret0, _ := ret[0].(*types.MatchingRemoveTaskListTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTaskListTask indicates an expected call of RemoveTaskListTask.
+func (mr *MockClientMockRecorder) RemoveTaskListTask(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTaskListTask", reflect.TypeOf((*MockClient)(nil).RemoveTaskListTask), varargs...)
+}
+
 // RespondQueryTaskCompleted mocks base method.
 func (m *MockClient) RespondQueryTaskCompleted(arg0 context.Context, arg1 *types.MatchingRespondQueryTaskCompletedRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -62,7 +62,7 @@ func (lb *multiLoadBalancer) PickWritePartition(
 	taskListType int,
 	req WriteRequest,
 ) string {
-	if !lb.canRedirectToPartition(req) {
+	if !canRedirectToPartition(req) {
 		return req.GetTaskList().GetName()
 	}
 	domainName, err := lb.domainIDToName(req.GetDomainUUID())
@@ -83,7 +83,7 @@ func (lb *multiLoadBalancer) PickReadPartition(
 	req ReadRequest,
 	isolationGroup string,
 ) string {
-	if !lb.canRedirectToPartition(req) {
+	if !canRedirectToPartition(req) {
 		return req.GetTaskList().GetName()
 	}
 	domainName, err := lb.domainIDToName(req.GetDomainUUID())
@@ -105,7 +105,7 @@ func (lb *multiLoadBalancer) UpdateWeight(
 	partition string,
 	info *types.LoadBalancerHints,
 ) {
-	if !lb.canRedirectToPartition(req) {
+	if !canRedirectToPartition(req) {
 		return
 	}
 	domainName, err := lb.domainIDToName(req.GetDomainUUID())
@@ -121,6 +121,6 @@ func (lb *multiLoadBalancer) UpdateWeight(
 	loadBalancer.UpdateWeight(taskListType, req, partition, info)
 }
 
-func (lb *multiLoadBalancer) canRedirectToPartition(req ReadRequest) bool {
+func canRedirectToPartition(req ReadRequest) bool {
 	return req.GetForwardedFrom() == "" && req.GetTaskList().GetKind() != types.TaskListKindSticky && !strings.HasPrefix(req.GetTaskList().GetName(), common.ReservedTaskListPrefix)
 }
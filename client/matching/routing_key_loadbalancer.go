@@ -0,0 +1,118 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package matching
+
+import (
+	"github.com/dgryski/go-farm"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// routingKeyRequest is implemented by write requests that can carry a routing key,
+	// currently only AddActivityTaskRequest
+	routingKeyRequest interface {
+		GetRoutingKey() string
+	}
+
+	// routingKeyLoadBalancer sends every task with the same routing key to the same
+	// write partition so that workers polling that partition get better cache locality.
+	//
+	// Partitions are chosen with jump consistent hashing, so when the number of write
+	// partitions changes from n to m only about |n-m|/max(n,m) of the keys move to a
+	// different partition; keys are never pinned across a resize.
+	//
+	// The routing key only selects the partition a task is written to, it is not a
+	// guarantee of delivery to a particular poller. If the selected partition has no
+	// poller waiting, the task goes through the regular forwarding path towards the
+	// root partition and may be picked up by any poller of the task list.
+	routingKeyLoadBalancer struct {
+		provider PartitionConfigProvider
+		fallback LoadBalancer
+	}
+)
+
+// NewRoutingKeyLoadBalancer returns a load balancer which consistently hashes
+// write requests with a routing key to a task list partition and delegates
+// everything else to the fallback load balancer
+func NewRoutingKeyLoadBalancer(fallback LoadBalancer, provider PartitionConfigProvider) LoadBalancer {
+	return &routingKeyLoadBalancer{
+		provider: provider,
+		fallback: fallback,
+	}
+}
+
+func (lb *routingKeyLoadBalancer) PickWritePartition(
+	taskListType int,
+	req WriteRequest,
+) string {
+	routingKey := getRoutingKey(req)
+	if routingKey == "" || !canRedirectToPartition(req) {
+		return lb.fallback.PickWritePartition(taskListType, req)
+	}
+
+	taskList := *req.GetTaskList()
+	nPartitions := lb.provider.GetNumberOfWritePartitions(req.GetDomainUUID(), taskList, taskListType)
+	if nPartitions <= 1 {
+		return taskList.GetName()
+	}
+
+	p := jumpConsistentHash(farm.Fingerprint64([]byte(routingKey)), nPartitions)
+	return getPartitionTaskListName(taskList.GetName(), p)
+}
+
+func (lb *routingKeyLoadBalancer) PickReadPartition(
+	taskListType int,
+	req ReadRequest,
+	isolationGroup string,
+) string {
+	return lb.fallback.PickReadPartition(taskListType, req, isolationGroup)
+}
+
+func (lb *routingKeyLoadBalancer) UpdateWeight(
+	taskListType int,
+	req ReadRequest,
+	partition string,
+	info *types.LoadBalancerHints,
+) {
+	lb.fallback.UpdateWeight(taskListType, req, partition, info)
+}
+
+func getRoutingKey(req WriteRequest) string {
+	if r, ok := req.(routingKeyRequest); ok {
+		return r.GetRoutingKey()
+	}
+	return ""
+}
+
+// jumpConsistentHash maps the key to a bucket in [0, numBuckets) as described in
+// "A Fast, Minimal Memory, Consistent Hash Algorithm" by Lamping and Veach
+func jumpConsistentHash(key uint64, numBuckets int) int {
+	var b, j int64 = -1, 0
+	for j < int64(numBuckets) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int(b)
+}
@@ -0,0 +1,107 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/dgryski/go-farm"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestRoutingKeyPickWritePartition(t *testing.T) {
+	tl := "tl"
+	cases := []struct {
+		name           string
+		routingKey     string
+		forwardedFrom  string
+		numWrite       int
+		shouldFallback bool
+	}{
+		{
+			name:           "no routing key",
+			numWrite:       4,
+			shouldFallback: true,
+		},
+		{
+			name:           "forwarded request",
+			routingKey:     "key",
+			forwardedFrom:  "/__cadence_sys/tl/1",
+			numWrite:       4,
+			shouldFallback: true,
+		},
+		{
+			name:       "single partition",
+			routingKey: "key",
+			numWrite:   1,
+		},
+		{
+			name:       "multiple partitions",
+			routingKey: "key",
+			numWrite:   4,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fallback := NewMockLoadBalancer(ctrl)
+			cfg := NewMockPartitionConfigProvider(ctrl)
+			cfg.EXPECT().GetNumberOfWritePartitions(gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.numWrite).AnyTimes()
+			lb := NewRoutingKeyLoadBalancer(fallback, cfg)
+			req := &types.AddActivityTaskRequest{
+				DomainUUID:    "domainId",
+				TaskList:      &types.TaskList{Name: tl},
+				ForwardedFrom: tc.forwardedFrom,
+				RoutingKey:    tc.routingKey,
+			}
+			if tc.shouldFallback {
+				fallback.EXPECT().PickWritePartition(int(types.TaskListTypeActivity), req).Return("fallback").Times(1)
+				assert.Equal(t, "fallback", lb.PickWritePartition(int(types.TaskListTypeActivity), req))
+				return
+			}
+			p := lb.PickWritePartition(int(types.TaskListTypeActivity), req)
+			expected := getPartitionTaskListName(tl, jumpConsistentHash(farm.Fingerprint64([]byte(tc.routingKey)), tc.numWrite))
+			assert.Equal(t, expected, p)
+			// the same key always goes to the same partition
+			assert.Equal(t, p, lb.PickWritePartition(int(types.TaskListTypeActivity), req))
+		})
+	}
+}
+
+func TestJumpConsistentHash(t *testing.T) {
+	for key := uint64(0); key < 1000; key++ {
+		assert.Equal(t, 0, jumpConsistentHash(key, 1))
+		for n := 2; n <= 10; n++ {
+			before := jumpConsistentHash(key, n-1)
+			after := jumpConsistentHash(key, n)
+			assert.True(t, after >= 0 && after < n)
+			// growing the number of buckets only ever moves keys to the new bucket
+			if after != before {
+				assert.Equal(t, n-1, after)
+			}
+		}
+	}
+}
@@ -35,6 +35,16 @@ import (
 	"github.com/uber/cadence/common/types/mapper/proto"
 )
 
+func (g historyClient) BatchDescribeWorkflowExecutions(ctx context.Context, hp1 *types.HistoryBatchDescribeWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (bp1 *types.BatchDescribeWorkflowExecutionsResponse, err error) {
+	response, err := g.c.BatchDescribeWorkflowExecutions(ctx, proto.FromHistoryBatchDescribeWorkflowExecutionsRequest(hp1), p1...)
+	return proto.ToHistoryBatchDescribeWorkflowExecutionsResponse(response), proto.ToError(err)
+}
+
+func (g historyClient) CancelPendingActivities(ctx context.Context, hp1 *types.HistoryCancelPendingActivitiesRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.CancelPendingActivities(ctx, proto.FromHistoryCancelPendingActivitiesRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g historyClient) CloseShard(ctx context.Context, cp1 *types.CloseShardRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.CloseShard(ctx, proto.FromHistoryCloseShardRequest(cp1), p1...)
 	return proto.ToError(err)
@@ -65,6 +75,46 @@ func (g historyClient) DescribeWorkflowExecution(ctx context.Context, hp1 *types
 	return proto.ToHistoryDescribeWorkflowExecutionResponse(response), proto.ToError(err)
 }
 
+func (g historyClient) ExpireActivityHeartbeat(ctx context.Context, hp1 *types.HistoryExpireActivityHeartbeatRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.ExpireActivityHeartbeat(ctx, proto.FromHistoryExpireActivityHeartbeatRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
+func (g historyClient) GetActivityAttemptTimings(ctx context.Context, hp1 *types.HistoryGetActivityAttemptTimingsRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityAttemptTimingsResponse, err error) {
+	response, err := g.c.GetActivityAttemptTimings(ctx, proto.FromHistoryGetActivityAttemptTimingsRequest(hp1), p1...)
+	return proto.ToHistoryGetActivityAttemptTimingsResponse(response), proto.ToError(err)
+}
+
+func (g historyClient) GetActivityEffectiveConfig(ctx context.Context, hp1 *types.HistoryGetActivityEffectiveConfigRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityEffectiveConfigResponse, err error) {
+	response, err := g.c.GetActivityEffectiveConfig(ctx, proto.FromHistoryGetActivityEffectiveConfigRequest(hp1), p1...)
+	return proto.ToHistoryGetActivityEffectiveConfigResponse(response), proto.ToError(err)
+}
+
+func (g historyClient) GetActivityNextRetryTime(ctx context.Context, hp1 *types.HistoryGetActivityNextRetryTimeRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityNextRetryTimeResponse, err error) {
+	response, err := g.c.GetActivityNextRetryTime(ctx, proto.FromHistoryGetActivityNextRetryTimeRequest(hp1), p1...)
+	return proto.ToHistoryGetActivityNextRetryTimeResponse(response), proto.ToError(err)
+}
+
+func (g historyClient) GetActivityRescheduleReasons(ctx context.Context, hp1 *types.HistoryGetActivityRescheduleReasonsRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityRescheduleReasonsResponse, err error) {
+	response, err := g.c.GetActivityRescheduleReasons(ctx, proto.FromHistoryGetActivityRescheduleReasonsRequest(hp1), p1...)
+	return proto.ToHistoryGetActivityRescheduleReasonsResponse(response), proto.ToError(err)
+}
+
+func (g historyClient) GetActivityRetryStats(ctx context.Context, hp1 *types.HistoryGetActivityRetryStatsRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityRetryStatsResponse, err error) {
+	response, err := g.c.GetActivityRetryStats(ctx, proto.FromHistoryGetActivityRetryStatsRequest(hp1), p1...)
+	return proto.ToHistoryGetActivityRetryStatsResponse(response), proto.ToError(err)
+}
+
+func (g historyClient) GetActivityTokenValidationFailures(ctx context.Context, hp1 *types.HistoryGetActivityTokenValidationFailuresRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityTokenValidationFailuresResponse, err error) {
+	response, err := g.c.GetActivityTokenValidationFailures(ctx, proto.FromHistoryGetActivityTokenValidationFailuresRequest(hp1), p1...)
+	return proto.ToHistoryGetActivityTokenValidationFailuresResponse(response), proto.ToError(err)
+}
+
+func (g historyClient) GetActivityWorkerHistory(ctx context.Context, hp1 *types.HistoryGetActivityWorkerHistoryRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityWorkerHistoryResponse, err error) {
+	response, err := g.c.GetActivityWorkerHistory(ctx, proto.FromHistoryGetActivityWorkerHistoryRequest(hp1), p1...)
+	return proto.ToHistoryGetActivityWorkerHistoryResponse(response), proto.ToError(err)
+}
+
 func (g historyClient) GetCrossClusterTasks(ctx context.Context, gp1 *types.GetCrossClusterTasksRequest, p1 ...yarpc.CallOption) (gp2 *types.GetCrossClusterTasksResponse, err error) {
 	response, err := g.c.GetCrossClusterTasks(ctx, proto.FromHistoryGetCrossClusterTasksRequest(gp1), p1...)
 	return proto.ToHistoryGetCrossClusterTasksResponse(response), proto.ToError(err)
@@ -150,6 +200,11 @@ func (g historyClient) RecordDecisionTaskStarted(ctx context.Context, rp1 *types
 	return proto.ToHistoryRecordDecisionTaskStartedResponse(response), proto.ToError(err)
 }
 
+func (g historyClient) RedriveTimedOutActivities(ctx context.Context, hp1 *types.HistoryRedriveTimedOutActivitiesRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.RedriveTimedOutActivities(ctx, proto.FromHistoryRedriveTimedOutActivitiesRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g historyClient) RefreshWorkflowTasks(ctx context.Context, hp1 *types.HistoryRefreshWorkflowTasksRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.RefreshWorkflowTasks(ctx, proto.FromHistoryRefreshWorkflowTasksRequest(hp1), p1...)
 	return proto.ToError(err)
@@ -165,6 +220,11 @@ func (g historyClient) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskRequ
 	return proto.ToError(err)
 }
 
+func (g historyClient) ReplayActivityCompletions(ctx context.Context, hp1 *types.HistoryReplayActivityCompletionsRequest, p1 ...yarpc.CallOption) (rp1 *types.ReplayActivityCompletionsResponse, err error) {
+	response, err := g.c.ReplayActivityCompletions(ctx, proto.FromHistoryReplayActivityCompletionsRequest(hp1), p1...)
+	return proto.ToHistoryReplayActivityCompletionsResponse(response), proto.ToError(err)
+}
+
 func (g historyClient) ReplicateEventsV2(ctx context.Context, rp1 *types.ReplicateEventsV2Request, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.ReplicateEventsV2(ctx, proto.FromHistoryReplicateEventsV2Request(rp1), p1...)
 	return proto.ToError(err)
@@ -175,6 +235,11 @@ func (g historyClient) RequestCancelWorkflowExecution(ctx context.Context, hp1 *
 	return proto.ToError(err)
 }
 
+func (g historyClient) ResetActivityAttempts(ctx context.Context, hp1 *types.HistoryResetActivityAttemptsRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.ResetActivityAttempts(ctx, proto.FromHistoryResetActivityAttemptsRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g historyClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.ResetQueue(ctx, proto.FromHistoryResetQueueRequest(rp1), p1...)
 	return proto.ToError(err)
@@ -205,6 +270,11 @@ func (g historyClient) RespondActivityTaskFailed(ctx context.Context, hp1 *types
 	return proto.ToError(err)
 }
 
+func (g historyClient) RespondActivityTaskNack(ctx context.Context, hp1 *types.HistoryRespondActivityTaskNackRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.RespondActivityTaskNack(ctx, proto.FromHistoryRespondActivityTaskNackRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g historyClient) RespondCrossClusterTasksCompleted(ctx context.Context, rp1 *types.RespondCrossClusterTasksCompletedRequest, p1 ...yarpc.CallOption) (rp2 *types.RespondCrossClusterTasksCompletedResponse, err error) {
 	response, err := g.c.RespondCrossClusterTasksCompleted(ctx, proto.FromHistoryRespondCrossClusterTasksCompletedRequest(rp1), p1...)
 	return proto.ToHistoryRespondCrossClusterTasksCompletedResponse(response), proto.ToError(err)
@@ -225,6 +295,11 @@ func (g historyClient) ScheduleDecisionTask(ctx context.Context, sp1 *types.Sche
 	return proto.ToError(err)
 }
 
+func (g historyClient) SignalActivity(ctx context.Context, hp1 *types.HistorySignalActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.SignalActivity(ctx, proto.FromHistorySignalActivityRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g historyClient) SignalWithStartWorkflowExecution(ctx context.Context, hp1 *types.HistorySignalWithStartWorkflowExecutionRequest, p1 ...yarpc.CallOption) (sp1 *types.StartWorkflowExecutionResponse, err error) {
 	response, err := g.c.SignalWithStartWorkflowExecution(ctx, proto.FromHistorySignalWithStartWorkflowExecutionRequest(hp1), p1...)
 	return proto.ToHistorySignalWithStartWorkflowExecutionResponse(response), proto.ToError(err)
@@ -80,11 +80,21 @@ func (g matchingClient) QueryWorkflow(ctx context.Context, mp1 *types.MatchingQu
 	return proto.ToMatchingQueryWorkflowResponse(response), proto.ToError(err)
 }
 
+func (g matchingClient) RecordBackpressureHint(ctx context.Context, mp1 *types.MatchingRecordBackpressureHintRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.RecordBackpressureHint(ctx, proto.FromMatchingRecordBackpressureHintRequest(mp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g matchingClient) RefreshTaskListPartitionConfig(ctx context.Context, mp1 *types.MatchingRefreshTaskListPartitionConfigRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingRefreshTaskListPartitionConfigResponse, err error) {
 	response, err := g.c.RefreshTaskListPartitionConfig(ctx, proto.FromMatchingRefreshTaskListPartitionConfigRequest(mp1), p1...)
 	return proto.ToMatchingRefreshTaskListPartitionConfigResponse(response), proto.ToError(err)
 }
 
+func (g matchingClient) RemoveTaskListTask(ctx context.Context, mp1 *types.MatchingRemoveTaskListTaskRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingRemoveTaskListTaskResponse, err error) {
+	response, err := g.c.RemoveTaskListTask(ctx, proto.FromMatchingRemoveTaskListTaskRequest(mp1), p1...)
+	return proto.ToMatchingRemoveTaskListTaskResponse(response), proto.ToError(err)
+}
+
 func (g matchingClient) RespondQueryTaskCompleted(ctx context.Context, mp1 *types.MatchingRespondQueryTaskCompletedRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.RespondQueryTaskCompleted(ctx, proto.FromMatchingRespondQueryTaskCompletedRequest(mp1), p1...)
 	return proto.ToError(err)
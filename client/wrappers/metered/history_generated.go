@@ -50,6 +50,32 @@ func NewHistoryClient(client history.Client, metricsClient metrics.Client) histo
 	}
 }
 
+func (c *historyClient) BatchDescribeWorkflowExecutions(ctx context.Context, hp1 *types.HistoryBatchDescribeWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (bp1 *types.BatchDescribeWorkflowExecutionsResponse, err error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientBatchDescribeWorkflowExecutionsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientBatchDescribeWorkflowExecutionsScope, metrics.CadenceClientLatency)
+	bp1, err = c.client.BatchDescribeWorkflowExecutions(ctx, hp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientBatchDescribeWorkflowExecutionsScope, metrics.CadenceClientFailures)
+	}
+	return bp1, err
+}
+
+func (c *historyClient) CancelPendingActivities(ctx context.Context, hp1 *types.HistoryCancelPendingActivitiesRequest, p1 ...yarpc.CallOption) (err error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientCancelPendingActivitiesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientCancelPendingActivitiesScope, metrics.CadenceClientLatency)
+	err = c.client.CancelPendingActivities(ctx, hp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientCancelPendingActivitiesScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *historyClient) CloseShard(ctx context.Context, cp1 *types.CloseShardRequest, p1 ...yarpc.CallOption) (err error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientCloseShardScope, metrics.CadenceClientRequests)
 
@@ -128,6 +154,110 @@ func (c *historyClient) DescribeWorkflowExecution(ctx context.Context, hp1 *type
 	return dp1, err
 }
 
+func (c *historyClient) ExpireActivityHeartbeat(ctx context.Context, hp1 *types.HistoryExpireActivityHeartbeatRequest, p1 ...yarpc.CallOption) (err error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientExpireActivityHeartbeatScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientExpireActivityHeartbeatScope, metrics.CadenceClientLatency)
+	err = c.client.ExpireActivityHeartbeat(ctx, hp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientExpireActivityHeartbeatScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *historyClient) GetActivityAttemptTimings(ctx context.Context, hp1 *types.HistoryGetActivityAttemptTimingsRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityAttemptTimingsResponse, err error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetActivityAttemptTimingsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetActivityAttemptTimingsScope, metrics.CadenceClientLatency)
+	gp1, err = c.client.GetActivityAttemptTimings(ctx, hp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetActivityAttemptTimingsScope, metrics.CadenceClientFailures)
+	}
+	return gp1, err
+}
+
+func (c *historyClient) GetActivityEffectiveConfig(ctx context.Context, hp1 *types.HistoryGetActivityEffectiveConfigRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityEffectiveConfigResponse, err error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetActivityEffectiveConfigScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetActivityEffectiveConfigScope, metrics.CadenceClientLatency)
+	gp1, err = c.client.GetActivityEffectiveConfig(ctx, hp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetActivityEffectiveConfigScope, metrics.CadenceClientFailures)
+	}
+	return gp1, err
+}
+
+func (c *historyClient) GetActivityNextRetryTime(ctx context.Context, hp1 *types.HistoryGetActivityNextRetryTimeRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityNextRetryTimeResponse, err error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetActivityNextRetryTimeScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetActivityNextRetryTimeScope, metrics.CadenceClientLatency)
+	gp1, err = c.client.GetActivityNextRetryTime(ctx, hp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetActivityNextRetryTimeScope, metrics.CadenceClientFailures)
+	}
+	return gp1, err
+}
+
+func (c *historyClient) GetActivityRescheduleReasons(ctx context.Context, hp1 *types.HistoryGetActivityRescheduleReasonsRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityRescheduleReasonsResponse, err error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetActivityRescheduleReasonsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetActivityRescheduleReasonsScope, metrics.CadenceClientLatency)
+	gp1, err = c.client.GetActivityRescheduleReasons(ctx, hp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetActivityRescheduleReasonsScope, metrics.CadenceClientFailures)
+	}
+	return gp1, err
+}
+
+func (c *historyClient) GetActivityRetryStats(ctx context.Context, hp1 *types.HistoryGetActivityRetryStatsRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityRetryStatsResponse, err error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetActivityRetryStatsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetActivityRetryStatsScope, metrics.CadenceClientLatency)
+	gp1, err = c.client.GetActivityRetryStats(ctx, hp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetActivityRetryStatsScope, metrics.CadenceClientFailures)
+	}
+	return gp1, err
+}
+
+func (c *historyClient) GetActivityTokenValidationFailures(ctx context.Context, hp1 *types.HistoryGetActivityTokenValidationFailuresRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityTokenValidationFailuresResponse, err error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetActivityTokenValidationFailuresScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetActivityTokenValidationFailuresScope, metrics.CadenceClientLatency)
+	gp1, err = c.client.GetActivityTokenValidationFailures(ctx, hp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetActivityTokenValidationFailuresScope, metrics.CadenceClientFailures)
+	}
+	return gp1, err
+}
+
+func (c *historyClient) GetActivityWorkerHistory(ctx context.Context, hp1 *types.HistoryGetActivityWorkerHistoryRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityWorkerHistoryResponse, err error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetActivityWorkerHistoryScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetActivityWorkerHistoryScope, metrics.CadenceClientLatency)
+	gp1, err = c.client.GetActivityWorkerHistory(ctx, hp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetActivityWorkerHistoryScope, metrics.CadenceClientFailures)
+	}
+	return gp1, err
+}
+
 func (c *historyClient) GetCrossClusterTasks(ctx context.Context, gp1 *types.GetCrossClusterTasksRequest, p1 ...yarpc.CallOption) (gp2 *types.GetCrossClusterTasksResponse, err error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientGetCrossClusterTasksScope, metrics.CadenceClientRequests)
 
@@ -349,6 +479,19 @@ func (c *historyClient) RecordDecisionTaskStarted(ctx context.Context, rp1 *type
 	return rp2, err
 }
 
+func (c *historyClient) RedriveTimedOutActivities(ctx context.Context, hp1 *types.HistoryRedriveTimedOutActivitiesRequest, p1 ...yarpc.CallOption) (err error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientRedriveTimedOutActivitiesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRedriveTimedOutActivitiesScope, metrics.CadenceClientLatency)
+	err = c.client.RedriveTimedOutActivities(ctx, hp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRedriveTimedOutActivitiesScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *historyClient) RefreshWorkflowTasks(ctx context.Context, hp1 *types.HistoryRefreshWorkflowTasksRequest, p1 ...yarpc.CallOption) (err error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientRefreshWorkflowTasksScope, metrics.CadenceClientRequests)
 
@@ -388,6 +531,19 @@ func (c *historyClient) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskReq
 	return err
 }
 
+func (c *historyClient) ReplayActivityCompletions(ctx context.Context, hp1 *types.HistoryReplayActivityCompletionsRequest, p1 ...yarpc.CallOption) (rp1 *types.ReplayActivityCompletionsResponse, err error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientReplayActivityCompletionsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientReplayActivityCompletionsScope, metrics.CadenceClientLatency)
+	rp1, err = c.client.ReplayActivityCompletions(ctx, hp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientReplayActivityCompletionsScope, metrics.CadenceClientFailures)
+	}
+	return rp1, err
+}
+
 func (c *historyClient) ReplicateEventsV2(ctx context.Context, rp1 *types.ReplicateEventsV2Request, p1 ...yarpc.CallOption) (err error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientReplicateEventsV2Scope, metrics.CadenceClientRequests)
 
@@ -414,6 +570,19 @@ func (c *historyClient) RequestCancelWorkflowExecution(ctx context.Context, hp1
 	return err
 }
 
+func (c *historyClient) ResetActivityAttempts(ctx context.Context, hp1 *types.HistoryResetActivityAttemptsRequest, p1 ...yarpc.CallOption) (err error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientResetActivityAttemptsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientResetActivityAttemptsScope, metrics.CadenceClientLatency)
+	err = c.client.ResetActivityAttempts(ctx, hp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientResetActivityAttemptsScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *historyClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest, p1 ...yarpc.CallOption) (err error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientResetQueueScope, metrics.CadenceClientRequests)
 
@@ -492,6 +661,19 @@ func (c *historyClient) RespondActivityTaskFailed(ctx context.Context, hp1 *type
 	return err
 }
 
+func (c *historyClient) RespondActivityTaskNack(ctx context.Context, hp1 *types.HistoryRespondActivityTaskNackRequest, p1 ...yarpc.CallOption) (err error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientRespondActivityTaskNackScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRespondActivityTaskNackScope, metrics.CadenceClientLatency)
+	err = c.client.RespondActivityTaskNack(ctx, hp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRespondActivityTaskNackScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *historyClient) RespondCrossClusterTasksCompleted(ctx context.Context, rp1 *types.RespondCrossClusterTasksCompletedRequest, p1 ...yarpc.CallOption) (rp2 *types.RespondCrossClusterTasksCompletedResponse, err error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientRespondCrossClusterTasksCompletedScope, metrics.CadenceClientRequests)
 
@@ -544,6 +726,19 @@ func (c *historyClient) ScheduleDecisionTask(ctx context.Context, sp1 *types.Sch
 	return err
 }
 
+func (c *historyClient) SignalActivity(ctx context.Context, hp1 *types.HistorySignalActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientSignalActivityScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientSignalActivityScope, metrics.CadenceClientLatency)
+	err = c.client.SignalActivity(ctx, hp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientSignalActivityScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *historyClient) SignalWithStartWorkflowExecution(ctx context.Context, hp1 *types.HistorySignalWithStartWorkflowExecutionRequest, p1 ...yarpc.CallOption) (sp1 *types.StartWorkflowExecutionResponse, err error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientSignalWithStartWorkflowExecutionScope, metrics.CadenceClientRequests)
 
@@ -178,6 +178,19 @@ func (c *matchingClient) QueryWorkflow(ctx context.Context, mp1 *types.MatchingQ
 	return qp1, err
 }
 
+func (c *matchingClient) RecordBackpressureHint(ctx context.Context, mp1 *types.MatchingRecordBackpressureHintRequest, p1 ...yarpc.CallOption) (err error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientRecordBackpressureHintScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientRecordBackpressureHintScope, metrics.CadenceClientLatency)
+	err = c.client.RecordBackpressureHint(ctx, mp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientRecordBackpressureHintScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *matchingClient) RefreshTaskListPartitionConfig(ctx context.Context, mp1 *types.MatchingRefreshTaskListPartitionConfigRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingRefreshTaskListPartitionConfigResponse, err error) {
 	c.metricsClient.IncCounter(metrics.MatchingClientRefreshTaskListPartitionConfigScope, metrics.CadenceClientRequests)
 	c.emitForwardedFromStats(metrics.MatchingClientRefreshTaskListPartitionConfigScope, mp1)
@@ -192,6 +205,20 @@ func (c *matchingClient) RefreshTaskListPartitionConfig(ctx context.Context, mp1
 	return mp2, err
 }
 
+func (c *matchingClient) RemoveTaskListTask(ctx context.Context, mp1 *types.MatchingRemoveTaskListTaskRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingRemoveTaskListTaskResponse, err error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientRemoveTaskListTaskScope, metrics.CadenceClientRequests)
+	c.emitForwardedFromStats(metrics.MatchingClientRemoveTaskListTaskScope, mp1)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientRemoveTaskListTaskScope, metrics.CadenceClientLatency)
+	mp2, err = c.client.RemoveTaskListTask(ctx, mp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientRemoveTaskListTaskScope, metrics.CadenceClientFailures)
+	}
+	return mp2, err
+}
+
 func (c *matchingClient) RespondQueryTaskCompleted(ctx context.Context, mp1 *types.MatchingRespondQueryTaskCompletedRequest, p1 ...yarpc.CallOption) (err error) {
 	c.metricsClient.IncCounter(metrics.MatchingClientRespondQueryTaskCompletedScope, metrics.CadenceClientRequests)
 	c.emitForwardedFromStats(metrics.MatchingClientRespondQueryTaskCompletedScope, mp1)
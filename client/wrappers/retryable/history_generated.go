@@ -53,6 +53,24 @@ func NewHistoryClient(client history.Client, policy backoff.RetryPolicy, isRetry
 	}
 }
 
+func (c *historyClient) BatchDescribeWorkflowExecutions(ctx context.Context, hp1 *types.HistoryBatchDescribeWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (bp1 *types.BatchDescribeWorkflowExecutionsResponse, err error) {
+	var resp *types.BatchDescribeWorkflowExecutionsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.BatchDescribeWorkflowExecutions(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *historyClient) CancelPendingActivities(ctx context.Context, hp1 *types.HistoryCancelPendingActivitiesRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.CancelPendingActivities(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *historyClient) CloseShard(ctx context.Context, cp1 *types.CloseShardRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func() error {
 		return c.client.CloseShard(ctx, cp1, p1...)
@@ -115,6 +133,90 @@ func (c *historyClient) DescribeWorkflowExecution(ctx context.Context, hp1 *type
 	return resp, err
 }
 
+func (c *historyClient) ExpireActivityHeartbeat(ctx context.Context, hp1 *types.HistoryExpireActivityHeartbeatRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.ExpireActivityHeartbeat(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *historyClient) GetActivityAttemptTimings(ctx context.Context, hp1 *types.HistoryGetActivityAttemptTimingsRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityAttemptTimingsResponse, err error) {
+	var resp *types.GetActivityAttemptTimingsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetActivityAttemptTimings(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *historyClient) GetActivityEffectiveConfig(ctx context.Context, hp1 *types.HistoryGetActivityEffectiveConfigRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityEffectiveConfigResponse, err error) {
+	var resp *types.GetActivityEffectiveConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetActivityEffectiveConfig(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *historyClient) GetActivityNextRetryTime(ctx context.Context, hp1 *types.HistoryGetActivityNextRetryTimeRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityNextRetryTimeResponse, err error) {
+	var resp *types.GetActivityNextRetryTimeResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetActivityNextRetryTime(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *historyClient) GetActivityRescheduleReasons(ctx context.Context, hp1 *types.HistoryGetActivityRescheduleReasonsRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityRescheduleReasonsResponse, err error) {
+	var resp *types.GetActivityRescheduleReasonsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetActivityRescheduleReasons(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *historyClient) GetActivityRetryStats(ctx context.Context, hp1 *types.HistoryGetActivityRetryStatsRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityRetryStatsResponse, err error) {
+	var resp *types.GetActivityRetryStatsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetActivityRetryStats(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *historyClient) GetActivityTokenValidationFailures(ctx context.Context, hp1 *types.HistoryGetActivityTokenValidationFailuresRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityTokenValidationFailuresResponse, err error) {
+	var resp *types.GetActivityTokenValidationFailuresResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetActivityTokenValidationFailures(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *historyClient) GetActivityWorkerHistory(ctx context.Context, hp1 *types.HistoryGetActivityWorkerHistoryRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityWorkerHistoryResponse, err error) {
+	var resp *types.GetActivityWorkerHistoryResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetActivityWorkerHistory(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *historyClient) GetCrossClusterTasks(ctx context.Context, gp1 *types.GetCrossClusterTasksRequest, p1 ...yarpc.CallOption) (gp2 *types.GetCrossClusterTasksResponse, err error) {
 	var resp *types.GetCrossClusterTasksResponse
 	op := func() error {
@@ -286,6 +388,13 @@ func (c *historyClient) RecordDecisionTaskStarted(ctx context.Context, rp1 *type
 	return resp, err
 }
 
+func (c *historyClient) RedriveTimedOutActivities(ctx context.Context, hp1 *types.HistoryRedriveTimedOutActivitiesRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.RedriveTimedOutActivities(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *historyClient) RefreshWorkflowTasks(ctx context.Context, hp1 *types.HistoryRefreshWorkflowTasksRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func() error {
 		return c.client.RefreshWorkflowTasks(ctx, hp1, p1...)
@@ -307,6 +416,17 @@ func (c *historyClient) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskReq
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *historyClient) ReplayActivityCompletions(ctx context.Context, hp1 *types.HistoryReplayActivityCompletionsRequest, p1 ...yarpc.CallOption) (rp1 *types.ReplayActivityCompletionsResponse, err error) {
+	var resp *types.ReplayActivityCompletionsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ReplayActivityCompletions(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *historyClient) ReplicateEventsV2(ctx context.Context, rp1 *types.ReplicateEventsV2Request, p1 ...yarpc.CallOption) (err error) {
 	op := func() error {
 		return c.client.ReplicateEventsV2(ctx, rp1, p1...)
@@ -321,6 +441,13 @@ func (c *historyClient) RequestCancelWorkflowExecution(ctx context.Context, hp1
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *historyClient) ResetActivityAttempts(ctx context.Context, hp1 *types.HistoryResetActivityAttemptsRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.ResetActivityAttempts(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *historyClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func() error {
 		return c.client.ResetQueue(ctx, rp1, p1...)
@@ -371,6 +498,13 @@ func (c *historyClient) RespondActivityTaskFailed(ctx context.Context, hp1 *type
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *historyClient) RespondActivityTaskNack(ctx context.Context, hp1 *types.HistoryRespondActivityTaskNackRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.RespondActivityTaskNack(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *historyClient) RespondCrossClusterTasksCompleted(ctx context.Context, rp1 *types.RespondCrossClusterTasksCompletedRequest, p1 ...yarpc.CallOption) (rp2 *types.RespondCrossClusterTasksCompletedResponse, err error) {
 	var resp *types.RespondCrossClusterTasksCompletedResponse
 	op := func() error {
@@ -407,6 +541,13 @@ func (c *historyClient) ScheduleDecisionTask(ctx context.Context, sp1 *types.Sch
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *historyClient) SignalActivity(ctx context.Context, hp1 *types.HistorySignalActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.SignalActivity(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *historyClient) SignalWithStartWorkflowExecution(ctx context.Context, hp1 *types.HistorySignalWithStartWorkflowExecutionRequest, p1 ...yarpc.CallOption) (sp1 *types.StartWorkflowExecutionResponse, err error) {
 	var resp *types.StartWorkflowExecutionResponse
 	op := func() error {
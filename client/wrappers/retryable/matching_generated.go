@@ -148,6 +148,13 @@ func (c *matchingClient) QueryWorkflow(ctx context.Context, mp1 *types.MatchingQ
 	return resp, err
 }
 
+func (c *matchingClient) RecordBackpressureHint(ctx context.Context, mp1 *types.MatchingRecordBackpressureHintRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.RecordBackpressureHint(ctx, mp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *matchingClient) RefreshTaskListPartitionConfig(ctx context.Context, mp1 *types.MatchingRefreshTaskListPartitionConfigRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingRefreshTaskListPartitionConfigResponse, err error) {
 	var resp *types.MatchingRefreshTaskListPartitionConfigResponse
 	op := func() error {
@@ -159,6 +166,17 @@ func (c *matchingClient) RefreshTaskListPartitionConfig(ctx context.Context, mp1
 	return resp, err
 }
 
+func (c *matchingClient) RemoveTaskListTask(ctx context.Context, mp1 *types.MatchingRemoveTaskListTaskRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingRemoveTaskListTaskResponse, err error) {
+	var resp *types.MatchingRemoveTaskListTaskResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RemoveTaskListTask(ctx, mp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *matchingClient) RespondQueryTaskCompleted(ctx context.Context, mp1 *types.MatchingRespondQueryTaskCompletedRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func() error {
 		return c.client.RespondQueryTaskCompleted(ctx, mp1, p1...)
@@ -35,6 +35,16 @@ import (
 	"github.com/uber/cadence/common/types/mapper/thrift"
 )
 
+func (g historyClient) BatchDescribeWorkflowExecutions(ctx context.Context, hp1 *types.HistoryBatchDescribeWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (bp1 *types.BatchDescribeWorkflowExecutionsResponse, err error) {
+	response, err := g.c.BatchDescribeWorkflowExecutions(ctx, thrift.FromHistoryBatchDescribeWorkflowExecutionsRequest(hp1), p1...)
+	return thrift.ToHistoryBatchDescribeWorkflowExecutionsResponse(response), thrift.ToError(err)
+}
+
+func (g historyClient) CancelPendingActivities(ctx context.Context, hp1 *types.HistoryCancelPendingActivitiesRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.CancelPendingActivities(ctx, thrift.FromHistoryCancelPendingActivitiesRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g historyClient) CloseShard(ctx context.Context, cp1 *types.CloseShardRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.CloseShard(ctx, thrift.FromHistoryCloseShardRequest(cp1), p1...)
 	return thrift.ToError(err)
@@ -64,6 +74,46 @@ func (g historyClient) DescribeWorkflowExecution(ctx context.Context, hp1 *types
 	return thrift.ToHistoryDescribeWorkflowExecutionResponse(response), thrift.ToError(err)
 }
 
+func (g historyClient) ExpireActivityHeartbeat(ctx context.Context, hp1 *types.HistoryExpireActivityHeartbeatRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.ExpireActivityHeartbeat(ctx, thrift.FromHistoryExpireActivityHeartbeatRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
+
+func (g historyClient) GetActivityAttemptTimings(ctx context.Context, hp1 *types.HistoryGetActivityAttemptTimingsRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityAttemptTimingsResponse, err error) {
+	response, err := g.c.GetActivityAttemptTimings(ctx, thrift.FromHistoryGetActivityAttemptTimingsRequest(hp1), p1...)
+	return thrift.ToHistoryGetActivityAttemptTimingsResponse(response), thrift.ToError(err)
+}
+
+func (g historyClient) GetActivityEffectiveConfig(ctx context.Context, hp1 *types.HistoryGetActivityEffectiveConfigRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityEffectiveConfigResponse, err error) {
+	response, err := g.c.GetActivityEffectiveConfig(ctx, thrift.FromHistoryGetActivityEffectiveConfigRequest(hp1), p1...)
+	return thrift.ToHistoryGetActivityEffectiveConfigResponse(response), thrift.ToError(err)
+}
+
+func (g historyClient) GetActivityNextRetryTime(ctx context.Context, hp1 *types.HistoryGetActivityNextRetryTimeRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityNextRetryTimeResponse, err error) {
+	response, err := g.c.GetActivityNextRetryTime(ctx, thrift.FromHistoryGetActivityNextRetryTimeRequest(hp1), p1...)
+	return thrift.ToHistoryGetActivityNextRetryTimeResponse(response), thrift.ToError(err)
+}
+
+func (g historyClient) GetActivityRescheduleReasons(ctx context.Context, hp1 *types.HistoryGetActivityRescheduleReasonsRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityRescheduleReasonsResponse, err error) {
+	response, err := g.c.GetActivityRescheduleReasons(ctx, thrift.FromHistoryGetActivityRescheduleReasonsRequest(hp1), p1...)
+	return thrift.ToHistoryGetActivityRescheduleReasonsResponse(response), thrift.ToError(err)
+}
+
+func (g historyClient) GetActivityRetryStats(ctx context.Context, hp1 *types.HistoryGetActivityRetryStatsRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityRetryStatsResponse, err error) {
+	response, err := g.c.GetActivityRetryStats(ctx, thrift.FromHistoryGetActivityRetryStatsRequest(hp1), p1...)
+	return thrift.ToHistoryGetActivityRetryStatsResponse(response), thrift.ToError(err)
+}
+
+func (g historyClient) GetActivityTokenValidationFailures(ctx context.Context, hp1 *types.HistoryGetActivityTokenValidationFailuresRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityTokenValidationFailuresResponse, err error) {
+	response, err := g.c.GetActivityTokenValidationFailures(ctx, thrift.FromHistoryGetActivityTokenValidationFailuresRequest(hp1), p1...)
+	return thrift.ToHistoryGetActivityTokenValidationFailuresResponse(response), thrift.ToError(err)
+}
+
+func (g historyClient) GetActivityWorkerHistory(ctx context.Context, hp1 *types.HistoryGetActivityWorkerHistoryRequest, p1 ...yarpc.CallOption) (gp1 *types.GetActivityWorkerHistoryResponse, err error) {
+	response, err := g.c.GetActivityWorkerHistory(ctx, thrift.FromHistoryGetActivityWorkerHistoryRequest(hp1), p1...)
+	return thrift.ToHistoryGetActivityWorkerHistoryResponse(response), thrift.ToError(err)
+}
+
 func (g historyClient) GetCrossClusterTasks(ctx context.Context, gp1 *types.GetCrossClusterTasksRequest, p1 ...yarpc.CallOption) (gp2 *types.GetCrossClusterTasksResponse, err error) {
 	response, err := g.c.GetCrossClusterTasks(ctx, thrift.FromHistoryGetCrossClusterTasksRequest(gp1), p1...)
 	return thrift.ToHistoryGetCrossClusterTasksResponse(response), thrift.ToError(err)
@@ -149,6 +199,11 @@ func (g historyClient) RecordDecisionTaskStarted(ctx context.Context, rp1 *types
 	return thrift.ToHistoryRecordDecisionTaskStartedResponse(response), thrift.ToError(err)
 }
 
+func (g historyClient) RedriveTimedOutActivities(ctx context.Context, hp1 *types.HistoryRedriveTimedOutActivitiesRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.RedriveTimedOutActivities(ctx, thrift.FromHistoryRedriveTimedOutActivitiesRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g historyClient) RefreshWorkflowTasks(ctx context.Context, hp1 *types.HistoryRefreshWorkflowTasksRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.RefreshWorkflowTasks(ctx, thrift.FromHistoryRefreshWorkflowTasksRequest(hp1), p1...)
 	return thrift.ToError(err)
@@ -164,6 +219,11 @@ func (g historyClient) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskRequ
 	return thrift.ToError(err)
 }
 
+func (g historyClient) ReplayActivityCompletions(ctx context.Context, hp1 *types.HistoryReplayActivityCompletionsRequest, p1 ...yarpc.CallOption) (rp1 *types.ReplayActivityCompletionsResponse, err error) {
+	response, err := g.c.ReplayActivityCompletions(ctx, thrift.FromHistoryReplayActivityCompletionsRequest(hp1), p1...)
+	return thrift.ToHistoryReplayActivityCompletionsResponse(response), thrift.ToError(err)
+}
+
 func (g historyClient) ReplicateEventsV2(ctx context.Context, rp1 *types.ReplicateEventsV2Request, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.ReplicateEventsV2(ctx, thrift.FromHistoryReplicateEventsV2Request(rp1), p1...)
 	return thrift.ToError(err)
@@ -174,6 +234,11 @@ func (g historyClient) RequestCancelWorkflowExecution(ctx context.Context, hp1 *
 	return thrift.ToError(err)
 }
 
+func (g historyClient) ResetActivityAttempts(ctx context.Context, hp1 *types.HistoryResetActivityAttemptsRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.ResetActivityAttempts(ctx, thrift.FromHistoryResetActivityAttemptsRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g historyClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.ResetQueue(ctx, thrift.FromHistoryResetQueueRequest(rp1), p1...)
 	return thrift.ToError(err)
@@ -204,6 +269,11 @@ func (g historyClient) RespondActivityTaskFailed(ctx context.Context, hp1 *types
 	return thrift.ToError(err)
 }
 
+func (g historyClient) RespondActivityTaskNack(ctx context.Context, hp1 *types.HistoryRespondActivityTaskNackRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.RespondActivityTaskNack(ctx, thrift.FromHistoryRespondActivityTaskNackRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g historyClient) RespondCrossClusterTasksCompleted(ctx context.Context, rp1 *types.RespondCrossClusterTasksCompletedRequest, p1 ...yarpc.CallOption) (rp2 *types.RespondCrossClusterTasksCompletedResponse, err error) {
 	response, err := g.c.RespondCrossClusterTasksCompleted(ctx, thrift.FromHistoryRespondCrossClusterTasksCompletedRequest(rp1), p1...)
 	return thrift.ToHistoryRespondCrossClusterTasksCompletedResponse(response), thrift.ToError(err)
@@ -224,6 +294,11 @@ func (g historyClient) ScheduleDecisionTask(ctx context.Context, sp1 *types.Sche
 	return thrift.ToError(err)
 }
 
+func (g historyClient) SignalActivity(ctx context.Context, hp1 *types.HistorySignalActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.SignalActivity(ctx, thrift.FromHistorySignalActivityRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g historyClient) SignalWithStartWorkflowExecution(ctx context.Context, hp1 *types.HistorySignalWithStartWorkflowExecutionRequest, p1 ...yarpc.CallOption) (sp1 *types.StartWorkflowExecutionResponse, err error) {
 	response, err := g.c.SignalWithStartWorkflowExecution(ctx, thrift.FromHistorySignalWithStartWorkflowExecutionRequest(hp1), p1...)
 	return thrift.ToHistorySignalWithStartWorkflowExecutionResponse(response), thrift.ToError(err)
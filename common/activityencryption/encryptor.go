@@ -0,0 +1,122 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package activityencryption
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// Encryptor encrypts the payloads of activities scheduled with an EncryptionKeyID. History encrypts
+	// the Input when the activity is scheduled and the Result when it completes, before persisting them,
+	// and both are decrypted when handed out again: the Input to the worker running the activity and
+	// the history events to workflow workers and GetWorkflowExecutionHistory callers. Raw history, used by
+	// replication and archival, stays encrypted.
+	//
+	// The key ID is recorded on the events and never changes for a payload, so an implementation must
+	// keep decrypting payloads encrypted before a key was rotated. The usual way is to resolve the key ID
+	// to its current key version on Encrypt and embed that version in the ciphertext, so that Decrypt
+	// picks the version the payload was encrypted with. Retiring a key version makes the payloads encrypted
+	// with it unreadable.
+	//
+	// Encrypt runs while the workflow is locked and should not block.
+	Encryptor interface {
+		Encrypt(ctx context.Context, request *Request) ([]byte, error)
+		Decrypt(ctx context.Context, request *Request) ([]byte, error)
+	}
+
+	// Request is the payload being encrypted or decrypted
+	Request struct {
+		DomainName string
+		KeyID      string
+		Payload    []byte
+	}
+
+	nopEncryptor struct{}
+)
+
+// NewNopEncryptor creates an encryptor leaving payloads as they are
+func NewNopEncryptor() Encryptor {
+	return &nopEncryptor{}
+}
+
+func (e *nopEncryptor) Encrypt(
+	ctx context.Context,
+	request *Request,
+) ([]byte, error) {
+	return request.Payload, nil
+}
+
+func (e *nopEncryptor) Decrypt(
+	ctx context.Context,
+	request *Request,
+) ([]byte, error) {
+	return request.Payload, nil
+}
+
+// DecryptEvents decrypts, in place, the Input of the scheduled events and the Result of the completed
+// events of activities scheduled with an EncryptionKeyID
+func DecryptEvents(
+	ctx context.Context,
+	encryptor Encryptor,
+	domainName string,
+	events []*types.HistoryEvent,
+) error {
+
+	for _, event := range events {
+		var err error
+		switch event.GetEventType() {
+		case types.EventTypeActivityTaskScheduled:
+			attributes := event.ActivityTaskScheduledEventAttributes
+			attributes.Input, err = DecryptPayload(ctx, encryptor, domainName, attributes.EncryptionKeyID, attributes.Input)
+		case types.EventTypeActivityTaskCompleted:
+			attributes := event.ActivityTaskCompletedEventAttributes
+			attributes.Result, err = DecryptPayload(ctx, encryptor, domainName, attributes.EncryptionKeyID, attributes.Result)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecryptPayload decrypts the payload if it is encrypted with the key
+func DecryptPayload(
+	ctx context.Context,
+	encryptor Encryptor,
+	domainName string,
+	keyID string,
+	payload []byte,
+) ([]byte, error) {
+
+	if keyID == "" || len(payload) == 0 {
+		return payload, nil
+	}
+	return encryptor.Decrypt(ctx, &Request{
+		DomainName: domainName,
+		KeyID:      keyID,
+		Payload:    payload,
+	})
+}
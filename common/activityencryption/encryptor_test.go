@@ -0,0 +1,144 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package activityencryption
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type prefixEncryptor struct{}
+
+func (e *prefixEncryptor) Encrypt(ctx context.Context, request *Request) ([]byte, error) {
+	return append([]byte(request.KeyID+":"), request.Payload...), nil
+}
+
+func (e *prefixEncryptor) Decrypt(ctx context.Context, request *Request) ([]byte, error) {
+	prefix := request.KeyID + ":"
+	if len(request.Payload) < len(prefix) || string(request.Payload[:len(prefix)]) != prefix {
+		return nil, errors.New("payload not encrypted with key")
+	}
+	return request.Payload[len(prefix):], nil
+}
+
+func TestDecryptEvents(t *testing.T) {
+	tests := map[string]struct {
+		events   []*types.HistoryEvent
+		expected []*types.HistoryEvent
+		err      bool
+	}{
+		"encrypted payloads": {
+			events: []*types.HistoryEvent{
+				{
+					EventType: types.EventTypeActivityTaskScheduled.Ptr(),
+					ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+						Input:           []byte("key:input"),
+						EncryptionKeyID: "key",
+					},
+				},
+				{
+					EventType: types.EventTypeActivityTaskCompleted.Ptr(),
+					ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+						Result:          []byte("key:result"),
+						EncryptionKeyID: "key",
+					},
+				},
+			},
+			expected: []*types.HistoryEvent{
+				{
+					EventType: types.EventTypeActivityTaskScheduled.Ptr(),
+					ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+						Input:           []byte("input"),
+						EncryptionKeyID: "key",
+					},
+				},
+				{
+					EventType: types.EventTypeActivityTaskCompleted.Ptr(),
+					ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+						Result:          []byte("result"),
+						EncryptionKeyID: "key",
+					},
+				},
+			},
+		},
+		"payloads without key": {
+			events: []*types.HistoryEvent{
+				{
+					EventType: types.EventTypeActivityTaskScheduled.Ptr(),
+					ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+						Input: []byte("input"),
+					},
+				},
+				{
+					EventType: types.EventTypeWorkflowExecutionStarted.Ptr(),
+					WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+						Input: []byte("input"),
+					},
+				},
+			},
+			expected: []*types.HistoryEvent{
+				{
+					EventType: types.EventTypeActivityTaskScheduled.Ptr(),
+					ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+						Input: []byte("input"),
+					},
+				},
+				{
+					EventType: types.EventTypeWorkflowExecutionStarted.Ptr(),
+					WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+						Input: []byte("input"),
+					},
+				},
+			},
+		},
+		"decryption failure": {
+			events: []*types.HistoryEvent{
+				{
+					EventType: types.EventTypeActivityTaskCompleted.Ptr(),
+					ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+						Result:          []byte("other:result"),
+						EncryptionKeyID: "key",
+					},
+				},
+			},
+			err: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := DecryptEvents(context.Background(), &prefixEncryptor{}, "domain", test.events)
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, test.events)
+		})
+	}
+}
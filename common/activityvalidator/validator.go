@@ -0,0 +1,59 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package activityvalidator
+
+import "context"
+
+type (
+	// Validator inspects the result of an activity before history records its completion.
+	// Returning a non-nil error rejects the result: history records the attempt as failed
+	// with common.FailureReasonActivityResultRejected instead of completing the activity,
+	// so the usual retry policy applies.
+	//
+	// Validate runs while the workflow is locked and should not block.
+	Validator interface {
+		Validate(ctx context.Context, request *Request) error
+	}
+
+	// Request is the activity result being validated
+	Request struct {
+		DomainName   string
+		WorkflowType string
+		ActivityType string
+		Result       []byte
+	}
+
+	nopValidator struct{}
+)
+
+// NewNopValidator creates a validator accepting every result
+func NewNopValidator() Validator {
+	return &nopValidator{}
+}
+
+func (v *nopValidator) Validate(
+	ctx context.Context,
+	request *Request,
+) error {
+	return nil
+}
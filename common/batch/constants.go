@@ -0,0 +1,83 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batch
+
+import (
+	"time"
+
+	"go.uber.org/cadence"
+)
+
+const (
+	// BatcherTaskListName is the tasklist name
+	BatcherTaskListName = "cadence-sys-batcher-tasklist"
+	// BatchWFTypeName is the workflow type
+	BatchWFTypeName = "cadence-sys-batch-workflow"
+	// InfiniteDuration is a long duration(20 yrs) we used for infinite workflow running
+	InfiniteDuration = 20 * 365 * 24 * time.Hour
+
+	// NonRetriableReason is the reason of the errors on which the batch activity gives up
+	NonRetriableReason = "non-retriable-error"
+
+	// DefaultRPS is the default RPS
+	DefaultRPS = 50
+	// DefaultConcurrency is the default concurrency
+	DefaultConcurrency = 5
+	// DefaultPageSize is the default page size
+	DefaultPageSize = 1000
+	// DefaultAttemptsOnRetryableError is the default value for AttemptsOnRetryableError
+	DefaultAttemptsOnRetryableError = 50
+	// DefaultActivityHeartBeatTimeout is the default value for ActivityHeartBeatTimeout
+	DefaultActivityHeartBeatTimeout = time.Second * 10
+	// DefaultMaxActivityRetries is the default value for MaxActivityRetries
+	DefaultMaxActivityRetries = 4
+	// DefaultDrainTimeout is the default value for DrainTimeout
+	DefaultDrainTimeout = time.Minute
+)
+
+const (
+	// BatchTypeTerminate is batch type for terminating workflows
+	BatchTypeTerminate = "terminate"
+	// BatchTypeCancel is the batch type for canceling workflows
+	BatchTypeCancel = "cancel"
+	// BatchTypeSignal is batch type for signaling workflows
+	BatchTypeSignal = "signal"
+	// BatchTypeReplicate is batch type for replicating workflows
+	BatchTypeReplicate = "replicate"
+	// BatchTypeRedriveActivities is batch type for re-driving the timed out activities of workflows
+	BatchTypeRedriveActivities = "redrive_activities"
+	// BatchTypeTerminateWithDrain is batch type for terminating workflows after draining their pending activities
+	BatchTypeTerminateWithDrain = "terminate_with_drain"
+)
+
+// AllBatchTypes is the batch types we supported
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate, BatchTypeRedriveActivities, BatchTypeTerminateWithDrain}
+
+var (
+	// BatchActivityRetryPolicy is the retry policy of the batch activity
+	BatchActivityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:          10 * time.Second,
+		BackoffCoefficient:       1.7,
+		MaximumInterval:          5 * time.Minute,
+		ExpirationInterval:       InfiniteDuration,
+		NonRetriableErrorReasons: []string{NonRetriableReason},
+	}
+)
@@ -0,0 +1,77 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package compression compresses payloads stored on behalf of the user, such as activity heartbeat details.
+// Compressed payloads start with a codec marker, so they can be stored next to uncompressed ones and told apart on read.
+package compression
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+)
+
+// gzipMarker prefixes payloads compressed with gzip, it starts with a zero byte which does not start
+// a payload encoded by any of the client data converters
+var gzipMarker = []byte("\x00cadence-gzip\x00")
+
+// Compress compresses the payload with gzip and prefixes it with the codec marker
+func Compress(data []byte) ([]byte, error) {
+	if len(data) == 0 || IsCompressed(data) {
+		return data, nil
+	}
+
+	var buf bytes.Buffer
+	buf.Write(gzipMarker)
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		return nil, fmt.Errorf("unable to compress payload: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("unable to compress payload: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// IsCompressed returns true if the payload starts with the codec marker
+func IsCompressed(data []byte) bool {
+	return bytes.HasPrefix(data, gzipMarker)
+}
+
+// Inflate returns the uncompressed payload, payloads without the codec marker are returned as is
+func Inflate(data []byte) ([]byte, error) {
+	if !IsCompressed(data) {
+		return data, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(data[len(gzipMarker):]))
+	if err != nil {
+		return nil, fmt.Errorf("unable to inflate payload: %v", err)
+	}
+	defer reader.Close()
+	inflated, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("unable to inflate payload: %v", err)
+	}
+	return inflated, nil
+}
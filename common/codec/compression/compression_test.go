@@ -0,0 +1,66 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package compression
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressInflate(t *testing.T) {
+	payload := bytes.Repeat([]byte("checkpoint"), 100)
+
+	compressed, err := Compress(payload)
+	require.NoError(t, err)
+	assert.True(t, IsCompressed(compressed))
+	assert.Less(t, len(compressed), len(payload))
+
+	recompressed, err := Compress(compressed)
+	require.NoError(t, err)
+	assert.Equal(t, compressed, recompressed)
+
+	inflated, err := Inflate(compressed)
+	require.NoError(t, err)
+	assert.Equal(t, payload, inflated)
+}
+
+func TestInflateUncompressed(t *testing.T) {
+	for _, payload := range [][]byte{nil, {}, []byte(`{"progress":10}`)} {
+		assert.False(t, IsCompressed(payload))
+		inflated, err := Inflate(payload)
+		require.NoError(t, err)
+		assert.Equal(t, payload, inflated)
+	}
+
+	compressed, err := Compress(nil)
+	require.NoError(t, err)
+	assert.Nil(t, compressed)
+}
+
+func TestInflateCorrupted(t *testing.T) {
+	_, err := Inflate(append(append([]byte{}, gzipMarker...), []byte("not gzip")...))
+	assert.Error(t, err)
+}
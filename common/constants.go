@@ -240,6 +240,14 @@ const MemoKeyForOperator = "operator"
 // ReservedTaskListPrefix is the required naming prefix for any task list partition other than partition 0
 const ReservedTaskListPrefix = "/__cadence_sys/"
 
+// PendingActivitiesQueryType is the reserved query type answered by history without involving the decider,
+// with a JSON snapshot of the pending activities of the workflow
+const PendingActivitiesQueryType = "__pending_activities"
+
+// ActivityResultTombstone replaces the result of ActivityTaskCompleted events returned by
+// GetWorkflowExecutionHistory once they are older than the activity result retention of the domain
+const ActivityResultTombstone = "__cadence_activity_result_purged"
+
 type (
 	// VisibilityOperation is an enum that represents visibility message types
 	VisibilityOperation string
@@ -282,6 +290,22 @@ const (
 const (
 	// WorkflowIDRateLimitReason is the reason set in ServiceBusyError when workflow ID rate limit is exceeded
 	WorkflowIDRateLimitReason = "external-workflow-id-rate-limit"
+	// ActivityCapabilityMismatchReason is the reason set in ServiceBusyError when a poller does not advertise
+	// the capabilities required by the activity it was matched with
+	ActivityCapabilityMismatchReason = "activity-capability-mismatch"
+	// ActivityHeartbeatThrottledReason is the reason set in ServiceBusyError when the frontend rejects a heartbeat
+	// above the heartbeat concurrency limit of the domain
+	ActivityHeartbeatThrottledReason = "activity-heartbeat-throttled"
+)
+
+const (
+	// ActivityHeartbeatSnapshotMarkerName is the name of the marker recorded by a RecordActivityHeartbeatSnapshot decision
+	ActivityHeartbeatSnapshotMarkerName = "ActivityHeartbeatSnapshot"
+	// ActivityHeartbeatSnapshotActivityIDHeader is the marker header field holding the ID of the snapshotted activity
+	ActivityHeartbeatSnapshotActivityIDHeader = "activityID"
+	// ActivityAttemptsResetMarkerName is the name of the marker recorded when the retry attempts of an activity
+	// are reset. Workflows may not record markers with this name.
+	ActivityAttemptsResetMarkerName = "ActivityAttemptsReset"
 )
 
 type (
@@ -652,6 +652,18 @@ const (
 	// Default value: 0
 	// Allowed filters: DomainName
 	FrontendDecisionResultCountLimit
+	// FrontendActivityHeartbeatRPS is the per-domain rate limit of RecordActivityTaskHeartbeat requests on a frontend host, 0 means unlimited
+	// KeyName: frontend.activityHeartbeatRPS
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	FrontendActivityHeartbeatRPS
+	// FrontendListActivityTypesMaxWorkflows is max number of workflow runs ListActivityTypes reads the history of, the response is truncated beyond it
+	// KeyName: frontend.listActivityTypesMaxWorkflows
+	// Value type: Int
+	// Default value: 1000
+	// Allowed filters: DomainName
+	FrontendListActivityTypesMaxWorkflows
 	// FrontendHistoryMgrNumConns is for persistence cluster.NumConns
 	// KeyName: frontend.historyMgrNumConns
 	// Value type: Int
@@ -812,6 +824,12 @@ const (
 	// Default value: 20
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingForwarderMaxChildrenPerNode
+	// MatchingMaxActivityPrefetchCount caps the number of additional activity tasks a single poll may lease through PrefetchCount, 0 disables prefetching
+	// KeyName: matching.maxActivityPrefetchCount
+	// Value type: Int
+	// Default value: 4
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingMaxActivityPrefetchCount
 	// MatchingReadRangeSize is the read range size for the task reader
 	// KeyName: matching.readRangeSize
 	// Value type: Int
@@ -957,6 +975,18 @@ const (
 	// Default value: 100
 	// Allowed filters: N/A
 	TimerTaskBatchSize
+	// TimerProcessorBatchSize overrides TimerTaskBatchSize for the timer processors of a shard, 0 uses TimerTaskBatchSize
+	// KeyName: history.timerProcessorBatchSize
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: ShardID
+	TimerProcessorBatchSize
+	// TimerProcessorMaxPendingTasks is the max number of timer tasks a timer processor of a shard loads before they are processed, 0 means no limit
+	// KeyName: history.timerProcessorMaxPendingTasks
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: ShardID
+	TimerProcessorMaxPendingTasks
 	// TimerTaskDeleteBatchSize is batch size for timer processor to delete timer tasks
 	// KeyName: history.timerTaskDeleteBatchSize
 	// Value type: Int
@@ -1191,6 +1221,36 @@ const (
 	// Default value: 0
 	// Allowed filters: DomainName
 	MaxActivityCountDispatchByDomain
+	// MaximumPendingSignalsPerActivity is max number of undelivered signals buffered for a single pending activity
+	// KeyName: history.maximumPendingSignalsPerActivity
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: DomainName
+	MaximumPendingSignalsPerActivity
+	// ActivityFallbackTaskListScheduleToStartTimeouts is the number of consecutive ScheduleToStart timeouts after which an activity with a fallback task list is moved to it
+	// KeyName: history.activityFallbackTaskListScheduleToStartTimeouts
+	// Value type: Int
+	// Default value: 3
+	// Allowed filters: DomainName
+	ActivityFallbackTaskListScheduleToStartTimeouts
+	// ActivityStalledHeartbeatThreshold is the number of consecutive heartbeats not advancing the reported progress after which an activity is flagged as stalled. 0 disables the detection
+	// KeyName: history.activityStalledHeartbeatThreshold
+	// Value type: Int
+	// Default value: 5
+	// Allowed filters: DomainName
+	ActivityStalledHeartbeatThreshold
+	// IdempotentActivityRetryMaximumAttempts is the MaximumAttempts of the retry policy given to activities scheduled as Idempotent without a RetryPolicy, 0 disables the default policy
+	// KeyName: history.idempotentActivityRetryMaximumAttempts
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: DomainName
+	IdempotentActivityRetryMaximumAttempts
+	// MaxBatchDescribeWorkflowExecutionsSize is max number of workflow executions that can be described in a single BatchDescribeWorkflowExecutions call
+	// KeyName: history.maxBatchDescribeWorkflowExecutionsSize
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	MaxBatchDescribeWorkflowExecutionsSize
 
 	// key for history replication
 
@@ -1221,6 +1281,34 @@ const (
 	// Allowed filters: DomainName
 	WorkflowIDInternalRPS
 
+	// ActivityTypeCircuitBreakerMinRequests is the minimum number of attempt outcomes of an activity type within the failure-rate window before its circuit breaker can open
+	// KeyName: history.activityTypeCircuitBreakerMinRequests
+	// Value type: Int
+	// Default value: 20
+	// Allowed filters: DomainName
+	ActivityTypeCircuitBreakerMinRequests
+
+	// WorkflowCounterLimit is the maximum number of named counters a workflow execution can hold, an activity completion incrementing a new counter past the limit is recorded without incrementing it
+	// KeyName: history.workflowCounterLimit
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: DomainName
+	WorkflowCounterLimit
+
+	// ActivityMaxNacks is the number of times an activity attempt can be nacked back to its task list, a further nack fails the attempt
+	// KeyName: history.activityMaxNacks
+	// Value type: Int
+	// Default value: 5
+	// Allowed filters: DomainName
+	ActivityMaxNacks
+
+	// ActivityResultArchivalThreshold is the size in bytes above which the result of a completed activity is written to the blobstore and history only records a reference to it, 0 disables it
+	// KeyName: history.activityResultArchivalThreshold
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	ActivityResultArchivalThreshold
+
 	// key for worker
 
 	// WorkerPersistenceMaxQPS is the max qps worker host can query DB
@@ -1622,6 +1710,12 @@ const (
 	// Default value: false
 	// Allowed filters: DomainID
 	MatchingEnableTaskInfoLogByDomainID
+	// MatchingEnableRemoveTaskListTask allows the RemoveTaskListTask admin API to drop activity tasks from the backlog of the task lists of the domain
+	// KeyName: matching.enableRemoveTaskListTask
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	MatchingEnableRemoveTaskListTask
 	// MatchingEnableTasklistGuardAgainstOwnershipShardLoss
 	// enables guards to prevent tasklists from processing if there is any detection that the host
 	// no longer is active or owns the shard
@@ -1751,6 +1845,18 @@ const (
 	// Default value: true
 	// Allowed filters: DomainName
 	EnableActivityLocalDispatchByDomain
+	// RejectActivityTimeoutsOverCap fails the decisions scheduling an activity with a timeout over its cap, see ActivityScheduleToCloseTimeoutCap, instead of clamping the timeout to the cap
+	// KeyName: history.rejectActivityTimeoutsOverCap
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	RejectActivityTimeoutsOverCap
+	// DisableActivityRetries makes every activity failure and timeout final in the domain regardless of the retry policy of the activity. Intended as an emergency switch to stop retries from amplifying load
+	// KeyName: history.disableActivityRetries
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	DisableActivityRetries
 	// HistoryEnableTaskInfoLogByDomainID is enables info level logs for decision/activity task based on the request domainID
 	// KeyName: history.enableTaskInfoLogByDomainID
 	// Value type: Bool
@@ -2037,6 +2143,54 @@ const (
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingEnableClientAutoConfig
 
+	// EnableActivityResultDedup stores the result of a completed activity as a reference to an earlier completed activity of the same run with an identical result
+	// KeyName: history.enableActivityResultDedup
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableActivityResultDedup
+
+	// EnableActivityHeartbeatWorkflowCloseStatus answers the heartbeat of an activity of a closed workflow run with the close status of the run and CancelRequested, instead of failing it with WorkflowExecutionAlreadyCompletedError
+	// KeyName: history.enableActivityHeartbeatWorkflowCloseStatus
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableActivityHeartbeatWorkflowCloseStatus
+
+	// EnableActivityTypeCircuitBreaker holds the dispatch of the activities of an activity type while the share of its attempts that fail or time out is above history.activityTypeCircuitBreakerFailureRate
+	// KeyName: history.enableActivityTypeCircuitBreaker
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableActivityTypeCircuitBreaker
+
+	// EnableActivityRetryStats aggregates the outcome of the activity attempts of a domain in memory of each history host, for GetActivityRetryStats
+	// KeyName: history.enableActivityRetryStats
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableActivityRetryStats
+	// EnableExpireActivityHeartbeat allows the ExpireActivityHeartbeat admin API to fire the heartbeat timeout of the activities of the domain right away, for tests only
+	// KeyName: history.enableExpireActivityHeartbeat
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableExpireActivityHeartbeat
+
+	// MatchingEnableActivityBackpressure lowers the dispatch rate of an activity task list while its workers report downstream saturation through backpressure hints
+	// KeyName: matching.enableActivityBackpressure
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: domainName, taskListName, taskListType
+	MatchingEnableActivityBackpressure
+
+	// MatchingEnableLatencyAwarePollerSelection prefers the pollers of an activity task list which historically complete their activities faster, based on a rolling latency estimate per poller identity. Slower pollers wait for a head start before they match tasks while faster pollers are waiting
+	// KeyName: matching.enableLatencyAwarePollerSelection
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: domainName, taskListName, taskListType
+	MatchingEnableLatencyAwarePollerSelection
+
 	// LastBoolKey must be the last one in this const group
 	LastBoolKey
 )
@@ -2209,8 +2363,29 @@ const (
 	// Default value: 0.5
 	HistoryGlobalRatelimiterNewDataWeight
 
+	// ActivityTypeCircuitBreakerFailureRate is the share of failed and timed out attempts of an activity type within the failure-rate window that opens its circuit breaker
+	// KeyName: history.activityTypeCircuitBreakerFailureRate
+	// Value type: Float64
+	// Default value: 0.5
+	// Allowed filters: DomainName
+	ActivityTypeCircuitBreakerFailureRate
+
 	MatchingPartitionDownscaleFactor
 
+	// MatchingActivityBackpressureMinDispatchRatio is the fraction of the dispatch rate an activity task list keeps when its workers report full saturation
+	// KeyName: matching.activityBackpressureMinDispatchRatio
+	// Value type: Float64
+	// Default value: 0.1
+	// Allowed filters: domainName, taskListName, taskListType
+	MatchingActivityBackpressureMinDispatchRatio
+
+	// MatchingActivityTaskStealThreshold is the fraction of its heartbeat timeout a started idempotent activity may go without a heartbeat before it is offered to another poller and the completion of the original worker is rejected. Only activities declared idempotent, with a heartbeat timeout and a retry policy are stolen. 0 disables it
+	// KeyName: matching.activityTaskStealThreshold
+	// Value type: Float64
+	// Default value: 0
+	// Allowed filters: domainName, taskListName, taskListType
+	MatchingActivityTaskStealThreshold
+
 	// Key for shard distributor
 
 	// ShardDistributorErrorInjectionRate is rate for injecting random error in shard distributor client
@@ -2262,6 +2437,18 @@ const (
 	// Default value: string(common.EncodingTypeThriftRW)
 	// Allowed filters: DomainName
 	DefaultEventEncoding
+	// ActivityAuditLogSink is the sink the audit log of activity lifecycle transitions of the domain is written to, either "kafka" or "file". The audit log is disabled when empty
+	// KeyName: history.activityAuditLogSink
+	// Value type: String
+	// Default value: ""
+	// Allowed filters: DomainName
+	ActivityAuditLogSink
+	// ActivityAuditLogFilePath is the path of the file the file sink of the activity audit log appends records to
+	// KeyName: history.activityAuditLogFilePath
+	// Value type: String
+	// Default value: ""
+	// Allowed filters: N/A
+	ActivityAuditLogFilePath
 	// AdminOperationToken is the token to pass admin checking
 	// KeyName: history.adminOperationToken
 	// Value type: String
@@ -2346,6 +2533,24 @@ const (
 	// Default value: 10s (10*time.Second)
 	// Allowed filters: N/A
 	DomainFailoverRefreshInterval
+	// FrontendActivityResultRetention is how long activity results are returned by GetWorkflowExecutionHistory, older ActivityTaskCompleted results are replaced by a tombstone, 0 disables it
+	// KeyName: frontend.activityResultRetention
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	FrontendActivityResultRetention
+	// FrontendActivityHeartbeatQueueTimeout is how long a heartbeat above FrontendActivityHeartbeatRPS waits for a rate limit token before it is rejected, 0 rejects it right away
+	// KeyName: frontend.activityHeartbeatQueueTimeout
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	FrontendActivityHeartbeatQueueTimeout
+	// FrontendActivityHeartbeatRetryAfter is the retry-after hint returned with a heartbeat rejected as above FrontendActivityHeartbeatRPS
+	// KeyName: frontend.activityHeartbeatRetryAfter
+	// Value type: Duration
+	// Default value: 1s (time.Second)
+	// Allowed filters: DomainName
+	FrontendActivityHeartbeatRetryAfter
 	// GlobalRatelimiterUpdateInterval controls how frequently ratelimiter usage information is submitted to aggregators.
 	// This value is shared between limiting and aggregating hosts (frontend and history).
 	// KeyName: frontend.globalRatelimiterUpdateInterval
@@ -2395,6 +2600,13 @@ const (
 	MatchingAdaptiveScalerUpdateInterval
 	MatchingQPSTrackerInterval
 
+	// MatchingActivityTaskVisibilityTimeout is the time a started activity task may go without a heartbeat or completion before it is dispatched to another poller. Each heartbeat restarts the window, a shorter activity heartbeat timeout takes precedence and only activities with a retry policy are redispatched. 0 disables it
+	// KeyName: matching.activityTaskVisibilityTimeout
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingActivityTaskVisibilityTimeout
+
 	// HistoryLongPollExpirationInterval is the long poll expiration interval in the history service
 	// KeyName: history.longPollExpirationInterval
 	// Value type: Duration
@@ -2618,6 +2830,90 @@ const (
 	// Default value: 30m (30*time.Minute)
 	// Allowed filters: DomainName
 	ActivityMaxScheduleToStartTimeoutForRetry
+	// ActivityCompletionDedupWindow is how long a completed activity is remembered so that a retried completion with the same task token succeeds instead of failing, 0 disables it
+	// KeyName: history.activityCompletionDedupWindow
+	// Value type: Duration
+	// Default value: 1m (time.Minute)
+	// Allowed filters: DomainName
+	ActivityCompletionDedupWindow
+	// ClosedActivityHeartbeatDetailsRetention is how long the last heartbeat details of a closed activity are kept in the cached mutable state for DescribeWorkflowExecution, 0 disables it
+	// KeyName: history.closedActivityHeartbeatDetailsRetention
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	ClosedActivityHeartbeatDetailsRetention
+	// ActivityMaxStartToCloseTimeout is the largest StartToClose timeout an activity can request through a heartbeat, 0 disables heartbeat extensions
+	// KeyName: history.activityMaxStartToCloseTimeout
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	ActivityMaxStartToCloseTimeout
+	// DefaultActivityHeartbeatTimeout is the HeartbeatTimeout of activities scheduled without one, an explicit 0 on the decision still disables heartbeat timeouts. 0 disables the default
+	// KeyName: history.defaultActivityHeartbeatTimeout
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	DefaultActivityHeartbeatTimeout
+	// ActivityScheduleToCloseTimeoutCap is the largest ScheduleToClose timeout an activity can be scheduled with, larger ones are clamped or rejected according to RejectActivityTimeoutsOverCap. 0 disables the cap
+	// KeyName: history.activityScheduleToCloseTimeoutCap
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	ActivityScheduleToCloseTimeoutCap
+	// ActivityScheduleToStartTimeoutCap is the largest ScheduleToStart timeout an activity can be scheduled with, larger ones are clamped or rejected according to RejectActivityTimeoutsOverCap. 0 disables the cap
+	// KeyName: history.activityScheduleToStartTimeoutCap
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	ActivityScheduleToStartTimeoutCap
+	// ActivityStartToCloseTimeoutCap is the largest StartToClose timeout an activity can be scheduled with, FirstAttemptStartToClose included, larger ones are clamped or rejected according to RejectActivityTimeoutsOverCap. 0 disables the cap
+	// KeyName: history.activityStartToCloseTimeoutCap
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	ActivityStartToCloseTimeoutCap
+	// ActivityHeartbeatTimeoutCap is the largest Heartbeat timeout an activity can be scheduled with, larger ones are clamped or rejected according to RejectActivityTimeoutsOverCap. 0 disables the cap
+	// KeyName: history.activityHeartbeatTimeoutCap
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	ActivityHeartbeatTimeoutCap
+	// ActivityHeartbeatTimerCoalesceInterval is the interval between the sweeps checking the heartbeat timeouts of the activities of a workflow, which replace the timer per heartbeat deadline. Which activity times out is still decided per activity, but a timeout is detected up to the interval late. 0 disables coalescing
+	// KeyName: history.activityHeartbeatTimerCoalesceInterval
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	ActivityHeartbeatTimerCoalesceInterval
+	// IdempotentActivityRetryInitialInterval is the InitialInterval of the retry policy given to activities scheduled as Idempotent without a RetryPolicy
+	// KeyName: history.idempotentActivityRetryInitialInterval
+	// Value type: Duration
+	// Default value: time.Second
+	// Allowed filters: DomainName
+	IdempotentActivityRetryInitialInterval
+	// ActivityCancellationAckTimeout is the time a worker has to acknowledge the cancellation of a started activity by closing it before the unacknowledged cancellation is reported with a metric and a log. 0 disables the report
+	// KeyName: history.activityCancellationAckTimeout
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	ActivityCancellationAckTimeout
+	// ActivityCancellationForceTimeout is the time after the cancellation request of a started activity after which an unacknowledged cancellation is forced by recording ActivityTaskCanceled. 0 disables forcing cancellations
+	// KeyName: history.activityCancellationForceTimeout
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	ActivityCancellationForceTimeout
+	// ActivityMaxCancellationCheckpointGrace is the longest CancellationCheckpointGraceSeconds an activity can be scheduled with, larger values are rejected
+	// KeyName: history.activityMaxCancellationCheckpointGrace
+	// Value type: Duration
+	// Default value: 1m (time.Minute)
+	// Allowed filters: DomainName
+	ActivityMaxCancellationCheckpointGrace
+	// ActivityCancellationGracePeriod is the time a worker is given to cancel a started activity after its cancellation was requested. Heartbeat responses requesting the cancellation carry the resulting deadline, which is never later than a forced cancellation. 0 asks for the cancellation right away
+	// KeyName: history.activityCancellationGracePeriod
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	ActivityCancellationGracePeriod
 	// ReplicationTaskFetcherAggregationInterval determines how frequently the fetch requests are sent
 	// KeyName: history.ReplicationTaskFetcherAggregationInterval
 	// Value type: Duration
@@ -2740,6 +3036,27 @@ const (
 	// Default value: 30 seconds
 	HistoryGlobalRatelimiterGCAfter
 
+	// ActivityTypeCircuitBreakerWindow is the sliding window over which the failure rate of an activity type is computed
+	// KeyName: history.activityTypeCircuitBreakerWindow
+	// Value type: Duration
+	// Default value: 1m (time.Minute)
+	// Allowed filters: DomainName
+	ActivityTypeCircuitBreakerWindow
+
+	// ActivityTypeCircuitBreakerOpenDuration is how long the circuit breaker of an activity type stays open before it half-opens and lets a probe activity through
+	// KeyName: history.activityTypeCircuitBreakerOpenDuration
+	// Value type: Duration
+	// Default value: 30s (30*time.Second)
+	// Allowed filters: DomainName
+	ActivityTypeCircuitBreakerOpenDuration
+
+	// ActivityRetryStatsRetention is how long the aggregated outcome of the activity attempts of a domain is kept for GetActivityRetryStats
+	// KeyName: history.activityRetryStatsRetention
+	// Value type: Duration
+	// Default value: 1h (time.Hour)
+	// Allowed filters: DomainName
+	ActivityRetryStatsRetention
+
 	// LocalPollWaitTime is the wait time for a poller to wait before considering request forwarding
 	// KeyName: matching.localPollWaitTime
 	// Value type: Duration
@@ -2754,6 +3071,34 @@ const (
 	// Allowed filters: domainName, taskListName, taskListType
 	LocalTaskWaitTime
 
+	// MatchingActivityDispatchJitter is the upper bound of the random delay matching applies before dispatching each activity task, to spread out bursts of activities scheduled together. 0 disables the jitter
+	// KeyName: matching.activityDispatchJitter
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: domainName, taskListName, taskListType
+	MatchingActivityDispatchJitter
+
+	// MatchingRegionAffinityFallbackTimeout is how long an activity task scheduled with RegionAffinity is only dispatched to pollers of the current cluster before pollers forwarded from other clusters may pick it up
+	// KeyName: matching.regionAffinityFallbackTimeout
+	// Value type: Duration
+	// Default value: 5s (5*time.Second)
+	// Allowed filters: domainName, taskListName, taskListType
+	MatchingRegionAffinityFallbackTimeout
+
+	// MatchingActivityBackpressureHalfLife is the time after which the backpressure level of an activity task list halves when its workers stop reporting saturation
+	// KeyName: matching.activityBackpressureHalfLife
+	// Value type: Duration
+	// Default value: 30s (30*time.Second)
+	// Allowed filters: domainName, taskListName, taskListType
+	MatchingActivityBackpressureHalfLife
+
+	// MatchingLatencyAwarePollerHeadStart is how long the slower pollers of an activity task list with latency-aware poller selection wait before they match tasks, while faster pollers are waiting
+	// KeyName: matching.latencyAwarePollerHeadStart
+	// Value type: Duration
+	// Default value: 50ms (50*time.Millisecond)
+	// Allowed filters: domainName, taskListName, taskListType
+	MatchingLatencyAwarePollerHeadStart
+
 	// TaskIsolationDuration is the time period for which we attempt to respect tasklist isolation before allowing any poller to process the task
 	// KeyName: matching.taskIsolationDuration
 	// Value type: Duration
@@ -2816,6 +3161,48 @@ const (
 	// Default value: see common.ConvertIntMapToDynamicConfigMapProperty(DefaultStuckTaskSplitThreshold) in code base
 	// Allowed filters: N/A
 	QueueProcessorStuckTaskSplitThreshold
+	// ActivityStubOutcomes maps activity type names to canned outcomes that history records without dispatching the activity to a worker. Intended for integration tests only
+	// KeyName: history.activityStubOutcomes
+	// Value type: Map
+	// Default value: nil
+	// Allowed filters: DomainName
+	ActivityStubOutcomes
+	// ActivityResultValidation maps activity type names to true for the activity types whose completion results history passes to the activity result validator. The key "*" matches every activity type
+	// KeyName: history.activityResultValidation
+	// Value type: Map
+	// Default value: nil
+	// Allowed filters: DomainName
+	ActivityResultValidation
+	// ActivityMaintenanceWindows maps activity type names to a daily or otherwise recurring maintenance window, e.g. {cronSchedule: "0 2 * * *", duration: "1h"}, during which history suspends the heartbeat and StartToClose timeouts of the activities of that type. The cron schedule is evaluated in UTC and the key "*" matches every activity type. ScheduleToClose timeouts are not suspended and still fire during a window
+	// KeyName: history.activityMaintenanceWindows
+	// Value type: Map
+	// Default value: nil
+	// Allowed filters: DomainName
+	ActivityMaintenanceWindows
+	// DisabledActivityKillSwitchTags maps kill switch tags to true for the tags whose switch is on, history then holds the dispatch of the activities scheduled with the tag until the switch is turned off, without failing them
+	// KeyName: history.disabledActivityKillSwitchTags
+	// Value type: Map
+	// Default value: nil
+	// Allowed filters: DomainName
+	DisabledActivityKillSwitchTags
+	// ActivityFailureAlertSinks maps AlertOnFailure tags to the name of the alert sink history notifies when an activity scheduled with the tag fails for good, i.e. once its retries are exhausted. Tags without a sink do not alert
+	// KeyName: history.activityFailureAlertSinks
+	// Value type: Map
+	// Default value: nil
+	// Allowed filters: DomainName
+	ActivityFailureAlertSinks
+	// DefaultActivityHeader maps header field names to the string values history adds to the Header of every activity scheduled in the domain, e.g. a tenant ID or trace baggage. Fields set by the decider take precedence. The merged header is recorded on the scheduled event
+	// KeyName: history.defaultActivityHeader
+	// Value type: Map
+	// Default value: nil
+	// Allowed filters: DomainName
+	DefaultActivityHeader
+	// FrontendActivityLogLevel maps activity type names to the log level hinted to the workers polling activities of that type, e.g. "debug". The key "*" matches every activity type
+	// KeyName: frontend.activityLogLevel
+	// Value type: Map
+	// Default value: nil
+	// Allowed filters: DomainName
+	FrontendActivityLogLevel
 
 	// LastMapKey must be the last one in this const group
 	LastMapKey
@@ -3127,6 +3514,18 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "FrontendDecisionResultCountLimit is max number of decisions per RespondDecisionTaskCompleted request",
 		DefaultValue: 0,
 	},
+	FrontendActivityHeartbeatRPS: {
+		KeyName:      "frontend.activityHeartbeatRPS",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendActivityHeartbeatRPS is the per-domain rate limit of RecordActivityTaskHeartbeat requests on a frontend host, 0 means unlimited",
+		DefaultValue: 0,
+	},
+	FrontendListActivityTypesMaxWorkflows: {
+		KeyName:      "frontend.listActivityTypesMaxWorkflows",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendListActivityTypesMaxWorkflows is max number of workflow runs ListActivityTypes reads the history of, the response is truncated beyond it",
+		DefaultValue: 1000,
+	},
 	FrontendHistoryMgrNumConns: {
 		KeyName:      "frontend.historyMgrNumConns",
 		Description:  "Deprecated: not used. FrontendHistoryMgrNumConns is for persistence cluster.NumConns",
@@ -3273,6 +3672,12 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MatchingForwarderMaxChildrenPerNode is the max number of children per node in the task list partition tree",
 		DefaultValue: 20,
 	},
+	MatchingMaxActivityPrefetchCount: {
+		KeyName:      "matching.maxActivityPrefetchCount",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingMaxActivityPrefetchCount caps the number of additional activity tasks a single poll may lease through PrefetchCount, 0 disables prefetching",
+		DefaultValue: 4,
+	},
 	MatchingReadRangeSize: {
 		KeyName:      "matching.readRangeSize",
 		Description:  "MatchingReadRangeSize is the read range size for the task reader",
@@ -3407,6 +3812,18 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "TimerTaskBatchSize is batch size for timer processor to process tasks",
 		DefaultValue: 100,
 	},
+	TimerProcessorBatchSize: {
+		KeyName:      "history.timerProcessorBatchSize",
+		Filters:      []Filter{ShardID},
+		Description:  "TimerProcessorBatchSize overrides TimerTaskBatchSize for the timer processors of a shard, 0 uses TimerTaskBatchSize",
+		DefaultValue: 0,
+	},
+	TimerProcessorMaxPendingTasks: {
+		KeyName:      "history.timerProcessorMaxPendingTasks",
+		Filters:      []Filter{ShardID},
+		Description:  "TimerProcessorMaxPendingTasks is the max number of timer tasks a timer processor of a shard loads before they are processed, 0 means no limit",
+		DefaultValue: 0,
+	},
 	TimerTaskDeleteBatchSize: {
 		KeyName:      "history.timerTaskDeleteBatchSize",
 		Description:  "TimerTaskDeleteBatchSize is batch size for timer processor to delete timer tasks",
@@ -3610,6 +4027,35 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MaxActivityCountDispatchByDomain max # of activity tasks to dispatch to matching before creating transfer tasks. This is an performance optimization to skip activity scheduling efforts.",
 		DefaultValue: 0,
 	},
+	MaximumPendingSignalsPerActivity: {
+		KeyName:      "history.maximumPendingSignalsPerActivity",
+		Filters:      []Filter{DomainName},
+		Description:  "MaximumPendingSignalsPerActivity is max number of undelivered signals buffered for a single pending activity",
+		DefaultValue: 100,
+	},
+	ActivityFallbackTaskListScheduleToStartTimeouts: {
+		KeyName:      "history.activityFallbackTaskListScheduleToStartTimeouts",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityFallbackTaskListScheduleToStartTimeouts is the number of consecutive ScheduleToStart timeouts after which an activity with a fallback task list is moved to it",
+		DefaultValue: 3,
+	},
+	ActivityStalledHeartbeatThreshold: {
+		KeyName:      "history.activityStalledHeartbeatThreshold",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityStalledHeartbeatThreshold is the number of consecutive heartbeats not advancing the reported progress after which an activity is flagged as stalled. 0 disables the detection",
+		DefaultValue: 5,
+	},
+	IdempotentActivityRetryMaximumAttempts: {
+		KeyName:      "history.idempotentActivityRetryMaximumAttempts",
+		Filters:      []Filter{DomainName},
+		Description:  "IdempotentActivityRetryMaximumAttempts is the MaximumAttempts of the retry policy given to activities scheduled as Idempotent without a RetryPolicy, 0 disables the default policy",
+		DefaultValue: 10,
+	},
+	MaxBatchDescribeWorkflowExecutionsSize: {
+		KeyName:      "history.maxBatchDescribeWorkflowExecutionsSize",
+		Description:  "MaxBatchDescribeWorkflowExecutionsSize is max number of workflow executions that can be described in a single BatchDescribeWorkflowExecutions call",
+		DefaultValue: 100,
+	},
 	ReplicationTaskFetcherParallelism: {
 		KeyName:      "history.ReplicationTaskFetcherParallelism",
 		Description:  "ReplicationTaskFetcherParallelism determines how many go routines we spin up for fetching tasks",
@@ -3633,6 +4079,30 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "WorkflowIDInternalRPS is the rate limit per workflowID for internal calls",
 		DefaultValue: UnlimitedRPS,
 	},
+	ActivityTypeCircuitBreakerMinRequests: {
+		KeyName:      "history.activityTypeCircuitBreakerMinRequests",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityTypeCircuitBreakerMinRequests is the minimum number of attempt outcomes of an activity type within the failure-rate window before its circuit breaker can open",
+		DefaultValue: 20,
+	},
+	WorkflowCounterLimit: {
+		KeyName:      "history.workflowCounterLimit",
+		Filters:      []Filter{DomainName},
+		Description:  "WorkflowCounterLimit is the maximum number of named counters a workflow execution can hold, an activity completion incrementing a new counter past the limit is recorded without incrementing it",
+		DefaultValue: 100,
+	},
+	ActivityMaxNacks: {
+		KeyName:      "history.activityMaxNacks",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityMaxNacks is the number of times an activity attempt can be nacked back to its task list, a further nack fails the attempt",
+		DefaultValue: 5,
+	},
+	ActivityResultArchivalThreshold: {
+		KeyName:      "history.activityResultArchivalThreshold",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityResultArchivalThreshold is the size in bytes above which the result of a completed activity is written to the blobstore and history only records a reference to it, 0 disables it",
+		DefaultValue: 0,
+	},
 	WorkerPersistenceMaxQPS: {
 		KeyName:      "worker.persistenceMaxQPS",
 		Description:  "WorkerPersistenceMaxQPS is the max qps worker host can query DB",
@@ -3992,6 +4462,12 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "MatchingEnableTaskInfoLogByDomainID is enables info level logs for decision/activity task based on the request domainID",
 		DefaultValue: false,
 	},
+	MatchingEnableRemoveTaskListTask: {
+		KeyName:      "matching.enableRemoveTaskListTask",
+		Filters:      []Filter{DomainName},
+		Description:  "MatchingEnableRemoveTaskListTask allows the RemoveTaskListTask admin API to drop activity tasks from the backlog of the task lists of the domain",
+		DefaultValue: false,
+	},
 	MatchingEnableTasklistGuardAgainstOwnershipShardLoss: {
 		KeyName:      "matching.enableTasklistGuardAgainstOwnershipLoss",
 		Description:  "allows guards to ensure that tasklists don't continue processing if there's signal that they've lost ownership",
@@ -4125,6 +4601,18 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableActivityLocalDispatchByDomain is allows worker to dispatch activity tasks through local tunnel after decisions are made. This is an performance optimization to skip activity scheduling efforts",
 		DefaultValue: true,
 	},
+	RejectActivityTimeoutsOverCap: {
+		KeyName:      "history.rejectActivityTimeoutsOverCap",
+		Filters:      []Filter{DomainName},
+		Description:  "RejectActivityTimeoutsOverCap fails the decisions scheduling an activity with a timeout over its cap, see ActivityScheduleToCloseTimeoutCap, instead of clamping the timeout to the cap",
+		DefaultValue: false,
+	},
+	DisableActivityRetries: {
+		KeyName:      "history.disableActivityRetries",
+		Filters:      []Filter{DomainName},
+		Description:  "DisableActivityRetries makes every activity failure and timeout final in the domain regardless of the retry policy of the activity. Intended as an emergency switch to stop retries from amplifying load",
+		DefaultValue: false,
+	},
 	HistoryEnableTaskInfoLogByDomainID: {
 		KeyName:      "history.enableTaskInfoLogByDomainID",
 		Filters:      []Filter{DomainID},
@@ -4371,6 +4859,48 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "MatchingEnableClientAutoConfig is to enable auto config on worker side",
 		DefaultValue: false,
 	},
+	EnableActivityResultDedup: {
+		KeyName:      "history.enableActivityResultDedup",
+		Filters:      []Filter{DomainName},
+		Description:  "EnableActivityResultDedup stores the result of a completed activity as a reference to an earlier completed activity of the same run with an identical result",
+		DefaultValue: false,
+	},
+	EnableActivityHeartbeatWorkflowCloseStatus: {
+		KeyName:      "history.enableActivityHeartbeatWorkflowCloseStatus",
+		Filters:      []Filter{DomainName},
+		Description:  "EnableActivityHeartbeatWorkflowCloseStatus answers the heartbeat of an activity of a closed workflow run with the close status of the run and CancelRequested, instead of failing it with WorkflowExecutionAlreadyCompletedError",
+		DefaultValue: false,
+	},
+	EnableActivityTypeCircuitBreaker: {
+		KeyName:      "history.enableActivityTypeCircuitBreaker",
+		Filters:      []Filter{DomainName},
+		Description:  "EnableActivityTypeCircuitBreaker holds the dispatch of the activities of an activity type while the share of its attempts that fail or time out is above history.activityTypeCircuitBreakerFailureRate",
+		DefaultValue: false,
+	},
+	EnableActivityRetryStats: {
+		KeyName:      "history.enableActivityRetryStats",
+		Filters:      []Filter{DomainName},
+		Description:  "EnableActivityRetryStats aggregates the outcome of the activity attempts of a domain in memory of each history host, for GetActivityRetryStats",
+		DefaultValue: false,
+	},
+	EnableExpireActivityHeartbeat: {
+		KeyName:      "history.enableExpireActivityHeartbeat",
+		Filters:      []Filter{DomainName},
+		Description:  "EnableExpireActivityHeartbeat allows the ExpireActivityHeartbeat admin API to fire the heartbeat timeout of the activities of the domain right away, for tests only",
+		DefaultValue: false,
+	},
+	MatchingEnableActivityBackpressure: {
+		KeyName:      "matching.enableActivityBackpressure",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingEnableActivityBackpressure lowers the dispatch rate of an activity task list while its workers report downstream saturation through backpressure hints",
+		DefaultValue: false,
+	},
+	MatchingEnableLatencyAwarePollerSelection: {
+		KeyName:      "matching.enableLatencyAwarePollerSelection",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingEnableLatencyAwarePollerSelection prefers the pollers of an activity task list which historically complete their activities faster, based on a rolling latency estimate per poller identity. Slower pollers wait for a head start before they match tasks while faster pollers are waiting",
+		DefaultValue: false,
+	},
 }
 
 var FloatKeys = map[FloatKey]DynamicFloat{
@@ -4517,12 +5047,30 @@ var FloatKeys = map[FloatKey]DynamicFloat{
 		Description:  "HistoryGlobalRatelimiterNewDataWeight defines how much weight to give each host's newest data, per update.  Must be between 0 and 1, higher values match new values more closely after a single update",
 		DefaultValue: 0.5,
 	},
+	ActivityTypeCircuitBreakerFailureRate: {
+		KeyName:      "history.activityTypeCircuitBreakerFailureRate",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityTypeCircuitBreakerFailureRate is the share of failed and timed out attempts of an activity type within the failure-rate window that opens its circuit breaker",
+		DefaultValue: 0.5,
+	},
 	MatchingPartitionDownscaleFactor: {
 		KeyName:      "matching.partitionDownscaleFactor",
 		Description:  "MatchingPartitionDownscaleFactor introduces hysteresis to prevent oscillation by setting a lower QPS threshold for downscaling, ensuring partitions are only removed when the load decreases significantly below the capacity of fewer partitions.",
 		Filters:      []Filter{DomainName, TaskListName, TaskType},
 		DefaultValue: 0.75,
 	},
+	MatchingActivityBackpressureMinDispatchRatio: {
+		KeyName:      "matching.activityBackpressureMinDispatchRatio",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingActivityBackpressureMinDispatchRatio is the fraction of the dispatch rate an activity task list keeps when its workers report full saturation",
+		DefaultValue: 0.1,
+	},
+	MatchingActivityTaskStealThreshold: {
+		KeyName:      "matching.activityTaskStealThreshold",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingActivityTaskStealThreshold is the fraction of its heartbeat timeout a started idempotent activity may go without a heartbeat before it is offered to another poller and the completion of the original worker is rejected. Only activities declared idempotent, with a heartbeat timeout and a retry policy are stolen. 0 disables it",
+		DefaultValue: 0,
+	},
 	ShardDistributorErrorInjectionRate: {
 		KeyName:      "sharddistributor.errorInjectionRate",
 		Description:  "ShardDistributorInjectionRate is rate for injecting random error in shard distributor client",
@@ -4557,6 +5105,17 @@ var StringKeys = map[StringKey]DynamicString{
 		Description:  "DefaultEventEncoding is the encoding type for history events",
 		DefaultValue: string(common.EncodingTypeThriftRW),
 	},
+	ActivityAuditLogSink: {
+		KeyName:      "history.activityAuditLogSink",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityAuditLogSink is the sink the audit log of activity lifecycle transitions of the domain is written to, either \"kafka\" or \"file\". The audit log is disabled when empty",
+		DefaultValue: "",
+	},
+	ActivityAuditLogFilePath: {
+		KeyName:      "history.activityAuditLogFilePath",
+		Description:  "ActivityAuditLogFilePath is the path of the file the file sink of the activity audit log appends records to",
+		DefaultValue: "",
+	},
 	AdminOperationToken: {
 		KeyName:      "history.adminOperationToken",
 		Description:  "AdminOperationToken is the token to pass admin checking",
@@ -4657,6 +5216,24 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "DomainFailoverRefreshInterval is the domain failover refresh timer",
 		DefaultValue: time.Second * 10,
 	},
+	FrontendActivityResultRetention: {
+		KeyName:      "frontend.activityResultRetention",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendActivityResultRetention is how long activity results are returned by GetWorkflowExecutionHistory, older ActivityTaskCompleted results are replaced by a tombstone, 0 disables it",
+		DefaultValue: 0,
+	},
+	FrontendActivityHeartbeatQueueTimeout: {
+		KeyName:      "frontend.activityHeartbeatQueueTimeout",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendActivityHeartbeatQueueTimeout is how long a heartbeat above FrontendActivityHeartbeatRPS waits for a rate limit token before it is rejected, 0 rejects it right away",
+		DefaultValue: 0,
+	},
+	FrontendActivityHeartbeatRetryAfter: {
+		KeyName:      "frontend.activityHeartbeatRetryAfter",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendActivityHeartbeatRetryAfter is the retry-after hint returned with a heartbeat rejected as above FrontendActivityHeartbeatRPS",
+		DefaultValue: time.Second,
+	},
 	GlobalRatelimiterUpdateInterval: {
 		KeyName:      "frontend.globalRatelimiterUpdateInterval",
 		Description:  "GlobalRatelimiterUpdateInterval defines how often each global ratelimiter collection submits load information, and the expected update rate in aggregators (used to determine when hosts are lost)",
@@ -4721,6 +5298,12 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "MatchingQPSTrackerInterval is the interval for qps tracker's loop. Changes are not reflected until service restart",
 		DefaultValue: time.Second * 10,
 	},
+	MatchingActivityTaskVisibilityTimeout: {
+		KeyName:      "matching.activityTaskVisibilityTimeout",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingActivityTaskVisibilityTimeout is the time a started activity task may go without a heartbeat or completion before it is dispatched to another poller. Each heartbeat restarts the window, a shorter activity heartbeat timeout takes precedence and only activities with a retry policy are redispatched. 0 disables it",
+		DefaultValue: 0,
+	},
 	HistoryLongPollExpirationInterval: {
 		KeyName:      "history.longPollExpirationInterval",
 		Filters:      []Filter{DomainName},
@@ -4909,6 +5492,90 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "ActivityMaxScheduleToStartTimeoutForRetry is maximum value allowed when overwritting the schedule to start timeout for activities with retry policy",
 		DefaultValue: time.Minute * 30,
 	},
+	ActivityCompletionDedupWindow: {
+		KeyName:      "history.activityCompletionDedupWindow",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityCompletionDedupWindow is how long a completed activity is remembered so that a retried completion with the same task token succeeds instead of failing, 0 disables it",
+		DefaultValue: time.Minute,
+	},
+	ClosedActivityHeartbeatDetailsRetention: {
+		KeyName:      "history.closedActivityHeartbeatDetailsRetention",
+		Filters:      []Filter{DomainName},
+		Description:  "ClosedActivityHeartbeatDetailsRetention is how long the last heartbeat details of a closed activity are kept in the cached mutable state for DescribeWorkflowExecution, 0 disables it",
+		DefaultValue: 0,
+	},
+	ActivityMaxStartToCloseTimeout: {
+		KeyName:      "history.activityMaxStartToCloseTimeout",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityMaxStartToCloseTimeout is the largest StartToClose timeout an activity can request through a heartbeat, 0 disables heartbeat extensions",
+		DefaultValue: 0,
+	},
+	DefaultActivityHeartbeatTimeout: {
+		KeyName:      "history.defaultActivityHeartbeatTimeout",
+		Filters:      []Filter{DomainName},
+		Description:  "DefaultActivityHeartbeatTimeout is the HeartbeatTimeout of activities scheduled without one, an explicit 0 on the decision still disables heartbeat timeouts. 0 disables the default",
+		DefaultValue: 0,
+	},
+	ActivityScheduleToCloseTimeoutCap: {
+		KeyName:      "history.activityScheduleToCloseTimeoutCap",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityScheduleToCloseTimeoutCap is the largest ScheduleToClose timeout an activity can be scheduled with, larger ones are clamped or rejected according to RejectActivityTimeoutsOverCap. 0 disables the cap",
+		DefaultValue: 0,
+	},
+	ActivityScheduleToStartTimeoutCap: {
+		KeyName:      "history.activityScheduleToStartTimeoutCap",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityScheduleToStartTimeoutCap is the largest ScheduleToStart timeout an activity can be scheduled with, larger ones are clamped or rejected according to RejectActivityTimeoutsOverCap. 0 disables the cap",
+		DefaultValue: 0,
+	},
+	ActivityStartToCloseTimeoutCap: {
+		KeyName:      "history.activityStartToCloseTimeoutCap",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityStartToCloseTimeoutCap is the largest StartToClose timeout an activity can be scheduled with, FirstAttemptStartToClose included, larger ones are clamped or rejected according to RejectActivityTimeoutsOverCap. 0 disables the cap",
+		DefaultValue: 0,
+	},
+	ActivityHeartbeatTimeoutCap: {
+		KeyName:      "history.activityHeartbeatTimeoutCap",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityHeartbeatTimeoutCap is the largest Heartbeat timeout an activity can be scheduled with, larger ones are clamped or rejected according to RejectActivityTimeoutsOverCap. 0 disables the cap",
+		DefaultValue: 0,
+	},
+	ActivityHeartbeatTimerCoalesceInterval: {
+		KeyName:      "history.activityHeartbeatTimerCoalesceInterval",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityHeartbeatTimerCoalesceInterval is the interval between the sweeps checking the heartbeat timeouts of the activities of a workflow, which replace the timer per heartbeat deadline. Which activity times out is still decided per activity, but a timeout is detected up to the interval late. 0 disables coalescing",
+		DefaultValue: 0,
+	},
+	IdempotentActivityRetryInitialInterval: {
+		KeyName:      "history.idempotentActivityRetryInitialInterval",
+		Filters:      []Filter{DomainName},
+		Description:  "IdempotentActivityRetryInitialInterval is the InitialInterval of the retry policy given to activities scheduled as Idempotent without a RetryPolicy",
+		DefaultValue: time.Second,
+	},
+	ActivityCancellationAckTimeout: {
+		KeyName:      "history.activityCancellationAckTimeout",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityCancellationAckTimeout is the time a worker has to acknowledge the cancellation of a started activity by closing it before the unacknowledged cancellation is reported with a metric and a log. 0 disables the report",
+		DefaultValue: 0,
+	},
+	ActivityCancellationForceTimeout: {
+		KeyName:      "history.activityCancellationForceTimeout",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityCancellationForceTimeout is the time after the cancellation request of a started activity after which an unacknowledged cancellation is forced by recording ActivityTaskCanceled. 0 disables forcing cancellations",
+		DefaultValue: 0,
+	},
+	ActivityMaxCancellationCheckpointGrace: {
+		KeyName:      "history.activityMaxCancellationCheckpointGrace",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityMaxCancellationCheckpointGrace is the longest CancellationCheckpointGraceSeconds an activity can be scheduled with, larger values are rejected",
+		DefaultValue: time.Minute,
+	},
+	ActivityCancellationGracePeriod: {
+		KeyName:      "history.activityCancellationGracePeriod",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityCancellationGracePeriod is the time a worker is given to cancel a started activity after its cancellation was requested. Heartbeat responses requesting the cancellation carry the resulting deadline, which is never later than a forced cancellation. 0 asks for the cancellation right away",
+		DefaultValue: 0,
+	},
 	ReplicationTaskFetcherAggregationInterval: {
 		KeyName:      "history.ReplicationTaskFetcherAggregationInterval",
 		Description:  "ReplicationTaskFetcherAggregationInterval determines how frequently the fetch requests are sent",
@@ -5022,6 +5689,24 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "HistoryGlobalRatelimiterGCAfter defines how long to wait until a host's data is considered entirely useless, e.g. host has likely disappeared, its weight is very low, and the data can be deleted.",
 		DefaultValue: 30 * time.Second,
 	},
+	ActivityTypeCircuitBreakerWindow: {
+		KeyName:      "history.activityTypeCircuitBreakerWindow",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityTypeCircuitBreakerWindow is the sliding window over which the failure rate of an activity type is computed",
+		DefaultValue: time.Minute,
+	},
+	ActivityTypeCircuitBreakerOpenDuration: {
+		KeyName:      "history.activityTypeCircuitBreakerOpenDuration",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityTypeCircuitBreakerOpenDuration is how long the circuit breaker of an activity type stays open before it half-opens and lets a probe activity through",
+		DefaultValue: 30 * time.Second,
+	},
+	ActivityRetryStatsRetention: {
+		KeyName:      "history.activityRetryStatsRetention",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityRetryStatsRetention is how long the aggregated outcome of the activity attempts of a domain is kept for GetActivityRetryStats",
+		DefaultValue: time.Hour,
+	},
 	LocalPollWaitTime: {
 		KeyName:      "matching.localPollWaitTime",
 		Filters:      []Filter{DomainName, TaskListName, TaskType},
@@ -5034,6 +5719,30 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "LocalTaskWaitTime is the time a task waits for a poller to arrive before considering task forwarding",
 		DefaultValue: time.Millisecond * 10,
 	},
+	MatchingActivityDispatchJitter: {
+		KeyName:      "matching.activityDispatchJitter",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingActivityDispatchJitter is the upper bound of the random delay matching applies before dispatching each activity task, to spread out bursts of activities scheduled together. 0 disables the jitter",
+		DefaultValue: 0,
+	},
+	MatchingRegionAffinityFallbackTimeout: {
+		KeyName:      "matching.regionAffinityFallbackTimeout",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingRegionAffinityFallbackTimeout is how long an activity task scheduled with RegionAffinity is only dispatched to pollers of the current cluster before pollers forwarded from other clusters may pick it up",
+		DefaultValue: time.Second * 5,
+	},
+	MatchingActivityBackpressureHalfLife: {
+		KeyName:      "matching.activityBackpressureHalfLife",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingActivityBackpressureHalfLife is the time after which the backpressure level of an activity task list halves when its workers stop reporting saturation",
+		DefaultValue: time.Second * 30,
+	},
+	MatchingLatencyAwarePollerHeadStart: {
+		KeyName:      "matching.latencyAwarePollerHeadStart",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingLatencyAwarePollerHeadStart is how long the slower pollers of an activity task list with latency-aware poller selection wait before they match tasks, while faster pollers are waiting",
+		DefaultValue: time.Millisecond * 50,
+	},
 	TaskIsolationDuration: {
 		KeyName:      "matching.taskIsolationDuration",
 		Filters:      []Filter{DomainName, TaskListName, TaskType},
@@ -5079,6 +5788,48 @@ var MapKeys = map[MapKey]DynamicMap{
 		Description:  "QueueProcessorStuckTaskSplitThreshold is the threshold for the number of attempts of a task",
 		DefaultValue: common.ConvertIntMapToDynamicConfigMapProperty(map[int]int{0: 100, 1: 10000}),
 	},
+	ActivityStubOutcomes: {
+		KeyName:      "history.activityStubOutcomes",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityStubOutcomes maps activity type names to canned outcomes that history records without dispatching the activity to a worker. Intended for integration tests only",
+		DefaultValue: nil,
+	},
+	ActivityResultValidation: {
+		KeyName:      "history.activityResultValidation",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityResultValidation maps activity type names to true for the activity types whose completion results history passes to the activity result validator. The key \"*\" matches every activity type",
+		DefaultValue: nil,
+	},
+	ActivityMaintenanceWindows: {
+		KeyName:      "history.activityMaintenanceWindows",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityMaintenanceWindows maps activity type names to a daily or otherwise recurring maintenance window, e.g. {cronSchedule: \"0 2 * * *\", duration: \"1h\"}, during which history suspends the heartbeat and StartToClose timeouts of the activities of that type. The cron schedule is evaluated in UTC and the key \"*\" matches every activity type. ScheduleToClose timeouts are not suspended and still fire during a window",
+		DefaultValue: nil,
+	},
+	DisabledActivityKillSwitchTags: {
+		KeyName:      "history.disabledActivityKillSwitchTags",
+		Filters:      []Filter{DomainName},
+		Description:  "DisabledActivityKillSwitchTags maps kill switch tags to true for the tags whose switch is on, history then holds the dispatch of the activities scheduled with the tag until the switch is turned off, without failing them",
+		DefaultValue: nil,
+	},
+	ActivityFailureAlertSinks: {
+		KeyName:      "history.activityFailureAlertSinks",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityFailureAlertSinks maps AlertOnFailure tags to the name of the alert sink history notifies when an activity scheduled with the tag fails for good, i.e. once its retries are exhausted. Tags without a sink do not alert",
+		DefaultValue: nil,
+	},
+	DefaultActivityHeader: {
+		KeyName:      "history.defaultActivityHeader",
+		Filters:      []Filter{DomainName},
+		Description:  "DefaultActivityHeader maps header field names to the string values history adds to the Header of every activity scheduled in the domain, e.g. a tenant ID or trace baggage. Fields set by the decider take precedence. The merged header is recorded on the scheduled event",
+		DefaultValue: nil,
+	},
+	FrontendActivityLogLevel: {
+		KeyName:      "frontend.activityLogLevel",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendActivityLogLevel maps activity type names to the log level hinted to the workers polling activities of that type, e.g. \"debug\". The key \"*\" matches every activity type",
+		DefaultValue: nil,
+	},
 }
 
 var ListKeys = map[ListKey]DynamicList{
@@ -0,0 +1,124 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package jsonpath evaluates the subset of JSONPath addressing a single value of a JSON document:
+// the root $ followed by .name member and [n] array index segments, e.g. $.order.items[0].id
+package jsonpath
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Path is a parsed JSON path
+	Path struct {
+		raw      string
+		segments []segment
+	}
+
+	segment struct {
+		name  string
+		index int
+		// isIndex is true for [n] segments
+		isIndex bool
+	}
+)
+
+// ErrNoMatch is returned by Lookup when the document has no value at the path
+var ErrNoMatch = errors.New("no value matches the path")
+
+// Parse parses a JSON path
+func Parse(path string) (*Path, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("path %q does not start with $", path)
+	}
+	p := &Path{raw: path}
+	rest := path[1:]
+	for len(rest) > 0 {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("path %q has an empty member name", path)
+			}
+			p.segments = append(p.segments, segment{name: rest[:end]})
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("path %q has an unterminated index", path)
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("path %q has an invalid index %q", path, rest[1:end])
+			}
+			p.segments = append(p.segments, segment{index: index, isIndex: true})
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("path %q has an unexpected character %q", path, rest[0])
+		}
+	}
+	return p, nil
+}
+
+// String returns the path as it was parsed
+func (p *Path) String() string {
+	return p.raw
+}
+
+// Lookup returns the compact JSON encoding of the value at the path in the document,
+// ErrNoMatch if there is none
+func (p *Path) Lookup(document []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	for _, s := range p.segments {
+		if s.isIndex {
+			array, ok := value.([]interface{})
+			if !ok || s.index >= len(array) {
+				return nil, ErrNoMatch
+			}
+			value = array[s.index]
+			continue
+		}
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, ErrNoMatch
+		}
+		if value, ok = object[s.name]; !ok {
+			return nil, ErrNoMatch
+		}
+	}
+	return json.Marshal(value)
+}
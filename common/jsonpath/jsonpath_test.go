@@ -0,0 +1,79 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package jsonpath
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for _, path := range []string{"$", "$.a", "$.a.b", "$[0]", "$.a[1][2].b"} {
+		p, err := Parse(path)
+		assert.NoError(t, err, path)
+		assert.Equal(t, path, p.String())
+	}
+	for _, path := range []string{"", "a.b", "$.", "$..a", "$.a[", "$.a[-1]", "$.a[x]", "$a"} {
+		_, err := Parse(path)
+		assert.Error(t, err, path)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	document := []byte(`{"order":{"id":"o-1","total":12.50,"items":[{"sku":"a"},{"sku":"b"}],"paid":true}}`)
+	tests := map[string]struct {
+		path     string
+		expected string
+		err      error
+	}{
+		"string":       {path: "$.order.id", expected: `"o-1"`},
+		"number":       {path: "$.order.total", expected: `12.50`},
+		"bool":         {path: "$.order.paid", expected: `true`},
+		"array index":  {path: "$.order.items[1].sku", expected: `"b"`},
+		"object":       {path: "$.order.items[0]", expected: `{"sku":"a"}`},
+		"missing":      {path: "$.order.customer", err: ErrNoMatch},
+		"out of range": {path: "$.order.items[2]", err: ErrNoMatch},
+		"not an array": {path: "$.order[0]", err: ErrNoMatch},
+		"not object":   {path: "$.order.id.value", err: ErrNoMatch},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, err := Parse(test.path)
+			require.NoError(t, err)
+			value, err := p.Lookup(document)
+			if test.err != nil {
+				assert.Equal(t, test.err, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, string(value))
+		})
+	}
+
+	p, err := Parse("$.a")
+	require.NoError(t, err)
+	_, err = p.Lookup([]byte("not json"))
+	assert.Error(t, err)
+}
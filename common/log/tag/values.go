@@ -58,6 +58,11 @@ var (
 	WorkflowActionActivityTaskCancelRequested = workflowAction("add-activitytask-cancel-requested-event")
 	WorkflowActionActivityTaskCancelFailed    = workflowAction("add-activitytask-cancel-failed-event")
 	WorkflowActionActivityTaskRetry           = workflowAction("add-activitytask-retry-event")
+	WorkflowActionActivityTaskAttemptsReset   = workflowAction("reset-activitytask-attempts")
+	WorkflowActionActivityTaskRedispatch      = workflowAction("redispatch-activitytask")
+	WorkflowActionActivityTaskSteal           = workflowAction("steal-activitytask")
+	WorkflowActionActivityTaskNack            = workflowAction("nack-activitytask")
+	WorkflowActionActivityHeartbeatExpire     = workflowAction("expire-activity-heartbeat")
 
 	// timer
 	WorkflowActionTimerStarted      = workflowAction("add-timer-started-event")
@@ -377,55 +382,70 @@ var (
 	FrontendClientOperationListTaskListPartitions                = clientOperation("frontend-list-task-list-partitions")
 	FrontendClientOperationGetTaskListsByDomain                  = clientOperation("frontend-get-task-list-for-domain")
 
-	HistoryClientOperationStartWorkflowExecution            = clientOperation("history-start-wf-execution")
-	HistoryClientOperationDescribeHistoryHost               = clientOperation("history-describe-history-host")
-	HistoryClientOperationCloseShard                        = clientOperation("history-close-shard")
-	HistoryClientOperationResetQueue                        = clientOperation("history-reset-queue")
-	HistoryClientOperationDescribeQueue                     = clientOperation("history-describe-queue")
-	HistoryClientOperationRemoveTask                        = clientOperation("history-remove-task")
-	HistoryClientOperationDescribeMutableState              = clientOperation("history-describe-mutable-state")
-	HistoryClientOperationGetMutableState                   = clientOperation("history-get-mutable-state")
-	HistoryClientOperationPollMutableState                  = clientOperation("history-poll-mutable-state")
-	HistoryClientOperationResetStickyTaskList               = clientOperation("history-reset-task-list")
-	HistoryClientOperationDescribeWorkflowExecution         = clientOperation("history-describe-wf-execution")
-	HistoryClientOperationRecordDecisionTaskStarted         = clientOperation("history-record-decision-task-started")
-	HistoryClientOperationRecordActivityTaskStarted         = clientOperation("history-record-activity-task-started")
-	HistoryClientOperationRecordDecisionTaskCompleted       = clientOperation("history-record-decision-task-completed")
-	HistoryClientOperationRecordDecisionTaskFailed          = clientOperation("history-record-decision-task-failed")
-	HistoryClientOperationRecordActivityTaskCompleted       = clientOperation("history-record-activity-task-completed")
-	HistoryClientOperationRecordActivityTaskFailed          = clientOperation("history-record-activity-task-failed")
-	HistoryClientOperationRecordActivityTaskCanceled        = clientOperation("history-record-activity-task-canceled")
-	HistoryClientOperationRecordActivityTaskHeartbeat       = clientOperation("history-record-activity-task-heartbeat")
-	HistoryClientOperationRequestCancelWorkflowExecution    = clientOperation("history-request-cancel-wf-execution")
-	HistoryClientOperationSignalWorkflowExecution           = clientOperation("history-signal-wf-execution")
-	HistoryClientOperationSignalWithStartWorkflowExecution  = clientOperation("history-signal-with-start-wf-execution")
-	HistoryClientOperationRemoveSignalMutableState          = clientOperation("history-remove-signal-mutable-state")
-	HistoryClientOperationTerminateWorkflowExecution        = clientOperation("history-terminate-wf-execution")
-	HistoryClientOperationResetWorkflowExecution            = clientOperation("history-reset-wf-execution")
-	HistoryClientOperationScheduleDecisionTask              = clientOperation("history-schedule-decision-task")
-	HistoryClientOperationRecordChildExecutionCompleted     = clientOperation("history-record-child-execution-completed")
-	HistoryClientOperationReplicateEventsV2                 = clientOperation("history-replicate-events-v2")
-	HistoryClientOperationSyncShardStatus                   = clientOperation("history-sync-shard-status")
-	HistoryClientOperationSyncActivity                      = clientOperation("history-sync-activity")
-	HistoryClientOperationGetReplicationMessages            = clientOperation("history-get-replication-messages")
-	HistoryClientOperationGetDLQReplicationMessages         = clientOperation("history-get-dlq-replication-messages")
-	HistoryClientOperationQueryWorkflow                     = clientOperation("history-query-wf")
-	HistoryClientOperationReapplyEvents                     = clientOperation("history-reapply-events")
-	HistoryClientOperationCountDLQMessages                  = clientOperation("history-count-dlq-messages")
-	HistoryClientOperationReadDLQMessages                   = clientOperation("history-read-dlq-messages")
-	HistoryClientOperationPurgeDLQMessages                  = clientOperation("history-purge-dlq-messages")
-	HistoryClientOperationMergeDLQMessages                  = clientOperation("history-merge-dlq-messages")
-	HistoryClientOperationRefreshWorkflowTasks              = clientOperation("history-refresh-wf-tasks")
-	HistoryClientOperationNotifyFailoverMarkers             = clientOperation("history-notify-failover-markers")
-	HistoryClientOperationGetCrossClusterTasks              = clientOperation("history-get-cross-cluster-tasks")
-	HistoryClientOperationRespondCrossClusterTasksCompleted = clientOperation("history-respond-cross-cluster-tasks-completed")
-	HistoryClientOperationGetFailoverInfo                   = clientOperation("history-get-failover-info")
-	HistoryClientOperationRespondActivityTaskCanceled       = clientOperation("history-respond-activity-task-canceled")
-	HistoryClientOperationRespondActivityTaskCompleted      = clientOperation("history-respond-activity-task-completed")
-	HistoryClientOperationRespondActivityTaskFailed         = clientOperation("history-respond-activity-task-failed")
-	HistoryClientOperationRespondDecisionTaskCompleted      = clientOperation("history-respond-decision-task-completed")
-	HistoryClientOperationRespondDecisionTaskFailed         = clientOperation("history-respond-decision-task-failed")
-	HistoryClientOperationRatelimitUpdate                   = clientOperation("history-ratelimit-update")
+	HistoryClientOperationStartWorkflowExecution             = clientOperation("history-start-wf-execution")
+	HistoryClientOperationDescribeHistoryHost                = clientOperation("history-describe-history-host")
+	HistoryClientOperationCloseShard                         = clientOperation("history-close-shard")
+	HistoryClientOperationResetQueue                         = clientOperation("history-reset-queue")
+	HistoryClientOperationDescribeQueue                      = clientOperation("history-describe-queue")
+	HistoryClientOperationRemoveTask                         = clientOperation("history-remove-task")
+	HistoryClientOperationDescribeMutableState               = clientOperation("history-describe-mutable-state")
+	HistoryClientOperationGetMutableState                    = clientOperation("history-get-mutable-state")
+	HistoryClientOperationPollMutableState                   = clientOperation("history-poll-mutable-state")
+	HistoryClientOperationResetStickyTaskList                = clientOperation("history-reset-task-list")
+	HistoryClientOperationDescribeWorkflowExecution          = clientOperation("history-describe-wf-execution")
+	HistoryClientOperationBatchDescribeWorkflowExecutions    = clientOperation("history-batch-describe-wf-executions")
+	HistoryClientOperationRecordDecisionTaskStarted          = clientOperation("history-record-decision-task-started")
+	HistoryClientOperationRecordActivityTaskStarted          = clientOperation("history-record-activity-task-started")
+	HistoryClientOperationRecordDecisionTaskCompleted        = clientOperation("history-record-decision-task-completed")
+	HistoryClientOperationRecordDecisionTaskFailed           = clientOperation("history-record-decision-task-failed")
+	HistoryClientOperationRecordActivityTaskCompleted        = clientOperation("history-record-activity-task-completed")
+	HistoryClientOperationRecordActivityTaskFailed           = clientOperation("history-record-activity-task-failed")
+	HistoryClientOperationRecordActivityTaskCanceled         = clientOperation("history-record-activity-task-canceled")
+	HistoryClientOperationRecordActivityTaskHeartbeat        = clientOperation("history-record-activity-task-heartbeat")
+	HistoryClientOperationRequestCancelWorkflowExecution     = clientOperation("history-request-cancel-wf-execution")
+	HistoryClientOperationSignalWorkflowExecution            = clientOperation("history-signal-wf-execution")
+	HistoryClientOperationSignalActivity                     = clientOperation("history-signal-activity")
+	HistoryClientOperationCancelPendingActivities            = clientOperation("history-cancel-pending-activities")
+	HistoryClientOperationRedriveTimedOutActivities          = clientOperation("history-redrive-timed-out-activities")
+	HistoryClientOperationResetActivityAttempts              = clientOperation("history-reset-activity-attempts")
+	HistoryClientOperationExpireActivityHeartbeat            = clientOperation("history-expire-activity-heartbeat")
+	HistoryClientOperationGetActivityAttemptTimings          = clientOperation("history-get-activity-attempt-timings")
+	HistoryClientOperationGetActivityTokenValidationFailures = clientOperation("history-get-activity-token-validation-failures")
+	HistoryClientOperationGetActivityRetryStats              = clientOperation("history-get-activity-retry-stats")
+	HistoryClientOperationRespondActivityTaskNack            = clientOperation("history-respond-activity-task-nack")
+	HistoryClientOperationGetActivityWorkerHistory           = clientOperation("history-get-activity-worker-history")
+	HistoryClientOperationGetActivityRescheduleReasons       = clientOperation("history-get-activity-reschedule-reasons")
+	HistoryClientOperationReplayActivityCompletions          = clientOperation("history-replay-activity-completions")
+	HistoryClientOperationGetActivityNextRetryTime           = clientOperation("history-get-activity-next-retry-time")
+	HistoryClientOperationGetActivityEffectiveConfig         = clientOperation("history-get-activity-effective-config")
+	HistoryClientOperationSignalWithStartWorkflowExecution   = clientOperation("history-signal-with-start-wf-execution")
+	HistoryClientOperationRemoveSignalMutableState           = clientOperation("history-remove-signal-mutable-state")
+	HistoryClientOperationTerminateWorkflowExecution         = clientOperation("history-terminate-wf-execution")
+	HistoryClientOperationResetWorkflowExecution             = clientOperation("history-reset-wf-execution")
+	HistoryClientOperationScheduleDecisionTask               = clientOperation("history-schedule-decision-task")
+	HistoryClientOperationRecordChildExecutionCompleted      = clientOperation("history-record-child-execution-completed")
+	HistoryClientOperationReplicateEventsV2                  = clientOperation("history-replicate-events-v2")
+	HistoryClientOperationSyncShardStatus                    = clientOperation("history-sync-shard-status")
+	HistoryClientOperationSyncActivity                       = clientOperation("history-sync-activity")
+	HistoryClientOperationGetReplicationMessages             = clientOperation("history-get-replication-messages")
+	HistoryClientOperationGetDLQReplicationMessages          = clientOperation("history-get-dlq-replication-messages")
+	HistoryClientOperationQueryWorkflow                      = clientOperation("history-query-wf")
+	HistoryClientOperationReapplyEvents                      = clientOperation("history-reapply-events")
+	HistoryClientOperationCountDLQMessages                   = clientOperation("history-count-dlq-messages")
+	HistoryClientOperationReadDLQMessages                    = clientOperation("history-read-dlq-messages")
+	HistoryClientOperationPurgeDLQMessages                   = clientOperation("history-purge-dlq-messages")
+	HistoryClientOperationMergeDLQMessages                   = clientOperation("history-merge-dlq-messages")
+	HistoryClientOperationRefreshWorkflowTasks               = clientOperation("history-refresh-wf-tasks")
+	HistoryClientOperationNotifyFailoverMarkers              = clientOperation("history-notify-failover-markers")
+	HistoryClientOperationGetCrossClusterTasks               = clientOperation("history-get-cross-cluster-tasks")
+	HistoryClientOperationRespondCrossClusterTasksCompleted  = clientOperation("history-respond-cross-cluster-tasks-completed")
+	HistoryClientOperationGetFailoverInfo                    = clientOperation("history-get-failover-info")
+	HistoryClientOperationRespondActivityTaskCanceled        = clientOperation("history-respond-activity-task-canceled")
+	HistoryClientOperationRespondActivityTaskCompleted       = clientOperation("history-respond-activity-task-completed")
+	HistoryClientOperationRespondActivityTaskFailed          = clientOperation("history-respond-activity-task-failed")
+	HistoryClientOperationRespondDecisionTaskCompleted       = clientOperation("history-respond-decision-task-completed")
+	HistoryClientOperationRespondDecisionTaskFailed          = clientOperation("history-respond-decision-task-failed")
+	HistoryClientOperationRatelimitUpdate                    = clientOperation("history-ratelimit-update")
 
 	MatchingClientOperationAddActivityTask                = clientOperation("matching-add-activity-task")
 	MatchingClientOperationAddDecisionTask                = clientOperation("matching-add-decision-task")
@@ -434,12 +454,14 @@ var (
 	MatchingClientOperationQueryWorkflow                  = clientOperation("matching-query-wf")
 	MatchingClientOperationQueryTaskCompleted             = clientOperation("matching-query-task-completed")
 	MatchingClientOperationCancelOutstandingPoll          = clientOperation("matching-cancel-outstanding-poll")
+	MatchingClientOperationRecordBackpressureHint         = clientOperation("matching-record-backpressure-hint")
 	MatchingClientOperationDescribeTaskList               = clientOperation("matching-describe-task-list")
 	MatchingClientOperationListTaskListPartitions         = clientOperation("matching-list-task-list-partitions")
 	MatchingClientOperationGetTaskListsByDomain           = clientOperation("matching-get-task-list-for-domain")
 	MatchingClientOperationRespondQueryTaskCompleted      = clientOperation("matching-respond-query-task-completed")
 	MatchingClientOperationUpdateTaskListPartitionConfig  = clientOperation("matching-update-task-list-partition-config")
 	MatchingClientOperationRefreshTaskListPartitionConfig = clientOperation("matching-refresh-task-list-partition-config")
+	MatchingClientOperationRemoveTaskListTask             = clientOperation("matching-remove-task-list-task")
 
 	ShardDistributorClientOperationGetShardOwner = clientOperation("shard-distributor-get-shard-owner")
 )
@@ -119,6 +119,13 @@ func (p *producerImpl) getProducerMessage(message interface{}) (*sarama.Producer
 			Value: sarama.ByteEncoder(message.GetPayload()),
 		}
 		return msg, nil
+	case *indexer.ActivityAuditMessage:
+		msg := &sarama.ProducerMessage{
+			Topic: p.topic,
+			Key:   sarama.StringEncoder(message.GetWorkflowID()),
+			Value: sarama.ByteEncoder(message.GetPayload()),
+		}
+		return msg, nil
 	case *sqlblobs.AsyncRequestMessage:
 		payload, err := p.serializeThrift(message)
 		if err != nil {
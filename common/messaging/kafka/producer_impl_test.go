@@ -70,6 +70,14 @@ func TestPublish(t *testing.T) {
 			},
 			hasErr: false,
 		},
+		{
+			name: "Publish activity audit message succeeded",
+			message: &indexer.ActivityAuditMessage{
+				WorkflowID: common.StringPtr("test-workflow-id"),
+				Payload:    []byte(`{"activityID":"test-activity-id"}`),
+			},
+			hasErr: false,
+		},
 		{
 			name:    "Unrecognized message type",
 			message: "This is not a recognized message type",
@@ -346,6 +346,8 @@ const (
 	HistoryClientResetStickyTaskListScope
 	// HistoryClientDescribeWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientDescribeWorkflowExecutionScope
+	// HistoryClientBatchDescribeWorkflowExecutionsScope tracks RPC calls to history service
+	HistoryClientBatchDescribeWorkflowExecutionsScope
 	// HistoryClientRecordDecisionTaskStartedScope tracks RPC calls to history service
 	HistoryClientRecordDecisionTaskStartedScope
 	// HistoryClientRecordActivityTaskStartedScope tracks RPC calls to history service
@@ -354,6 +356,34 @@ const (
 	HistoryClientRequestCancelWorkflowExecutionScope
 	// HistoryClientSignalWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientSignalWorkflowExecutionScope
+	// HistoryClientSignalActivityScope tracks RPC calls to history service
+	HistoryClientSignalActivityScope
+	// HistoryClientCancelPendingActivitiesScope tracks RPC calls to history service
+	HistoryClientCancelPendingActivitiesScope
+	// HistoryClientRedriveTimedOutActivitiesScope tracks RPC calls to history service
+	HistoryClientRedriveTimedOutActivitiesScope
+	// HistoryClientResetActivityAttemptsScope tracks RPC calls to history service
+	HistoryClientResetActivityAttemptsScope
+	// HistoryClientExpireActivityHeartbeatScope tracks RPC calls to history service
+	HistoryClientExpireActivityHeartbeatScope
+	// HistoryClientGetActivityAttemptTimingsScope tracks RPC calls to history service
+	HistoryClientGetActivityAttemptTimingsScope
+	// HistoryClientGetActivityTokenValidationFailuresScope tracks RPC calls to history service
+	HistoryClientGetActivityTokenValidationFailuresScope
+	// HistoryClientGetActivityRetryStatsScope tracks RPC calls to history service
+	HistoryClientGetActivityRetryStatsScope
+	// HistoryClientRespondActivityTaskNackScope tracks RPC calls to history service
+	HistoryClientRespondActivityTaskNackScope
+	// HistoryClientGetActivityWorkerHistoryScope tracks RPC calls to history service
+	HistoryClientGetActivityWorkerHistoryScope
+	// HistoryClientGetActivityRescheduleReasonsScope tracks RPC calls to history service
+	HistoryClientGetActivityRescheduleReasonsScope
+	// HistoryClientReplayActivityCompletionsScope tracks RPC calls to history service
+	HistoryClientReplayActivityCompletionsScope
+	// HistoryClientGetActivityNextRetryTimeScope tracks RPC calls to history service
+	HistoryClientGetActivityNextRetryTimeScope
+	// HistoryClientGetActivityEffectiveConfigScope tracks RPC calls to history service
+	HistoryClientGetActivityEffectiveConfigScope
 	// HistoryClientSignalWithStartWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientSignalWithStartWorkflowExecutionScope
 	// HistoryClientRemoveSignalMutableStateScope tracks RPC calls to history service
@@ -421,6 +451,8 @@ const (
 	MatchingClientRespondQueryTaskCompletedScope
 	// MatchingClientCancelOutstandingPollScope tracks RPC calls to matching service
 	MatchingClientCancelOutstandingPollScope
+	// MatchingClientRecordBackpressureHintScope tracks RPC calls to matching service
+	MatchingClientRecordBackpressureHintScope
 	// MatchingClientDescribeTaskListScope tracks RPC calls to matching service
 	MatchingClientDescribeTaskListScope
 	// MatchingClientListTaskListPartitionsScope tracks RPC calls to matching service
@@ -431,6 +463,8 @@ const (
 	MatchingClientUpdateTaskListPartitionConfigScope
 	// MatchingClientRefreshTaskListPartitionConfigScope tracks RPC calls to matching service
 	MatchingClientRefreshTaskListPartitionConfigScope
+	// MatchingClientRemoveTaskListTaskScope tracks RPC calls to matching service
+	MatchingClientRemoveTaskListTaskScope
 
 	// FrontendClientDeprecateDomainScope tracks RPC calls to frontend service
 	FrontendClientDeprecateDomainScope
@@ -915,6 +949,22 @@ const (
 	AdminGetCrossClusterTasksScope
 	// AdminRespondCrossClusterTasksCompletedScope is the metric scope for admin.AdminRespondCrossClusterTasksCompleted
 	AdminRespondCrossClusterTasksCompletedScope
+	// AdminDecodeTaskTokenScope is the metric scope for admin.DecodeTaskToken
+	AdminDecodeTaskTokenScope
+	// AdminBatchTerminateScope is the metric scope for admin.BatchTerminate
+	AdminBatchTerminateScope
+	// AdminDescribeBatchTerminateScope is the metric scope for admin.DescribeBatchTerminate
+	AdminDescribeBatchTerminateScope
+	// AdminRemoveTaskListTaskScope is the metric scope for admin.RemoveTaskListTask
+	AdminRemoveTaskListTaskScope
+	// AdminReplayActivityCompletionsScope is the metric scope for admin.ReplayActivityCompletions
+	AdminReplayActivityCompletionsScope
+	// AdminExpireActivityHeartbeatScope is the metric scope for admin.ExpireActivityHeartbeat
+	AdminExpireActivityHeartbeatScope
+	// AdminRedriveTimedOutActivitiesScope is the metric scope for admin.RedriveTimedOutActivities
+	AdminRedriveTimedOutActivitiesScope
+	// AdminCancelPendingActivitiesScope is the metric scope for admin.CancelPendingActivities
+	AdminCancelPendingActivitiesScope
 	// AdminGetDynamicConfigScope is the metric scope for admin.GetDynamicConfig
 	AdminGetDynamicConfigScope
 	// AdminUpdateDynamicConfigScope is the metric scope for admin.UpdateDynamicConfig
@@ -957,6 +1007,8 @@ const (
 	FrontendPollForActivityTaskScope
 	// FrontendRecordActivityTaskHeartbeatScope is the metric scope for frontend.RecordActivityTaskHeartbeat
 	FrontendRecordActivityTaskHeartbeatScope
+	// FrontendRenewActivityLeaseScope is the metric scope for frontend.RenewActivityLease
+	FrontendRenewActivityLeaseScope
 	// FrontendRecordActivityTaskHeartbeatByIDScope is the metric scope for frontend.RespondDecisionTaskCompleted
 	FrontendRecordActivityTaskHeartbeatByIDScope
 	// FrontendRespondDecisionTaskCompletedScope is the metric scope for frontend.RespondDecisionTaskCompleted
@@ -985,6 +1037,28 @@ const (
 	FrontendPollForWorklfowExecutionRawHistoryScope
 	// FrontendSignalWorkflowExecutionScope is the metric scope for frontend.SignalWorkflowExecution
 	FrontendSignalWorkflowExecutionScope
+	// FrontendSignalActivityScope is the metric scope for frontend.SignalActivity
+	FrontendSignalActivityScope
+	// FrontendResetActivityAttemptsScope is the metric scope for frontend.ResetActivityAttempts
+	FrontendResetActivityAttemptsScope
+	// FrontendGetActivityAttemptTimingsScope is the metric scope for frontend.GetActivityAttemptTimings
+	FrontendGetActivityAttemptTimingsScope
+	// FrontendGetActivityTokenValidationFailuresScope is the metric scope for frontend.GetActivityTokenValidationFailures
+	FrontendGetActivityTokenValidationFailuresScope
+	// FrontendGetActivityRetryStatsScope is the metric scope for frontend.GetActivityRetryStats
+	FrontendGetActivityRetryStatsScope
+	// FrontendRespondActivityTaskNackScope is the metric scope for frontend.RespondActivityTaskNack
+	FrontendRespondActivityTaskNackScope
+	// FrontendGetActivityWorkerHistoryScope is the metric scope for frontend.GetActivityWorkerHistory
+	FrontendGetActivityWorkerHistoryScope
+	// FrontendGetActivityRescheduleReasonsScope is the metric scope for frontend.GetActivityRescheduleReasons
+	FrontendGetActivityRescheduleReasonsScope
+	// FrontendReplayActivityCompletionsScope is the metric scope for frontend.ReplayActivityCompletions
+	FrontendReplayActivityCompletionsScope
+	// FrontendGetActivityNextRetryTimeScope is the metric scope for frontend.GetActivityNextRetryTime
+	FrontendGetActivityNextRetryTimeScope
+	// FrontendGetActivityEffectiveConfigScope is the metric scope for frontend.GetActivityEffectiveConfig
+	FrontendGetActivityEffectiveConfigScope
 	// FrontendSignalWithStartWorkflowExecutionScope is the metric scope for frontend.SignalWithStartWorkflowExecution
 	FrontendSignalWithStartWorkflowExecutionScope
 	// FrontendSignalWithStartWorkflowExecutionAsyncScope is the metric scope for frontend.SignalWithStartWorkflowExecutionAsync
@@ -1017,8 +1091,14 @@ const (
 	FrontendQueryWorkflowScope
 	// FrontendDescribeWorkflowExecutionScope is the metric scope for frontend.DescribeWorkflowExecution
 	FrontendDescribeWorkflowExecutionScope
+	// FrontendBatchDescribeWorkflowExecutionsScope is the metric scope for frontend.BatchDescribeWorkflowExecutions
+	FrontendBatchDescribeWorkflowExecutionsScope
 	// FrontendDiagnoseWorkflowExecutionScope is the metric scope for frontend.DescribeWorkflowExecution
 	FrontendDiagnoseWorkflowExecutionScope
+	// FrontendListPendingActivityScheduleAttributesScope is the metric scope for frontend.ListPendingActivityScheduleAttributes
+	FrontendListPendingActivityScheduleAttributesScope
+	// FrontendListActivityTypesScope is the metric scope for frontend.ListActivityTypes
+	FrontendListActivityTypesScope
 	// FrontendDescribeWorkflowExecutionStatusScope is a custom metric for more
 	// rich details about workflow description calls, including workflow open/closed status
 	FrontendDescribeWorkflowExecutionStatusScope
@@ -1030,6 +1110,8 @@ const (
 	FrontendGetTaskListsByDomainScope
 	// FrontendRefreshWorkflowTasksScope is the metric scope for frontend.RefreshWorkflowTasks
 	FrontendRefreshWorkflowTasksScope
+	// FrontendWaitForActivityCompletionScope is the metric scope for frontend.WaitForActivityCompletion
+	FrontendWaitForActivityCompletionScope
 	// FrontendResetStickyTaskListScope is the metric scope for frontend.ResetStickyTaskList
 	FrontendResetStickyTaskListScope
 	// FrontendListDomainsScope is the metric scope for frontend.ListDomain
@@ -1074,12 +1156,38 @@ const (
 	HistoryResetStickyTaskListScope
 	// HistoryDescribeWorkflowExecutionScope tracks DescribeWorkflowExecution API calls received by service
 	HistoryDescribeWorkflowExecutionScope
+	// HistoryBatchDescribeWorkflowExecutionsScope tracks BatchDescribeWorkflowExecutions API calls received by service
+	HistoryBatchDescribeWorkflowExecutionsScope
 	// HistoryRecordDecisionTaskStartedScope tracks RecordDecisionTaskStarted API calls received by service
 	HistoryRecordDecisionTaskStartedScope
 	// HistoryRecordActivityTaskStartedScope tracks RecordActivityTaskStarted API calls received by service
 	HistoryRecordActivityTaskStartedScope
 	// HistorySignalWorkflowExecutionScope tracks SignalWorkflowExecution API calls received by service
 	HistorySignalWorkflowExecutionScope
+	// HistorySignalActivityScope tracks SignalActivity API calls received by service
+	HistorySignalActivityScope
+	// HistoryCancelPendingActivitiesScope tracks CancelPendingActivities API calls received by service
+	HistoryCancelPendingActivitiesScope
+	// HistoryRedriveTimedOutActivitiesScope tracks RedriveTimedOutActivities API calls received by service
+	HistoryRedriveTimedOutActivitiesScope
+	// HistoryResetActivityAttemptsScope tracks ResetActivityAttempts API calls received by service
+	HistoryResetActivityAttemptsScope
+	// HistoryGetActivityAttemptTimingsScope tracks GetActivityAttemptTimings API calls received by service
+	HistoryGetActivityAttemptTimingsScope
+	// HistoryGetActivityTokenValidationFailuresScope tracks GetActivityTokenValidationFailures API calls received by service
+	HistoryGetActivityTokenValidationFailuresScope
+	// HistoryGetActivityRetryStatsScope tracks GetActivityRetryStats API calls received by service
+	HistoryGetActivityRetryStatsScope
+	// HistoryRespondActivityTaskNackScope tracks RespondActivityTaskNack API calls received by service
+	HistoryRespondActivityTaskNackScope
+	// HistoryGetActivityWorkerHistoryScope tracks GetActivityWorkerHistory API calls received by service
+	HistoryGetActivityWorkerHistoryScope
+	// HistoryGetActivityRescheduleReasonsScope tracks GetActivityRescheduleReasons API calls received by service
+	HistoryGetActivityRescheduleReasonsScope
+	// HistoryGetActivityNextRetryTimeScope tracks GetActivityNextRetryTime API calls received by service
+	HistoryGetActivityNextRetryTimeScope
+	// HistoryGetActivityEffectiveConfigScope tracks GetActivityEffectiveConfig API calls received by service
+	HistoryGetActivityEffectiveConfigScope
 	// HistorySignalWithStartWorkflowExecutionScope tracks SignalWithStartWorkflowExecution API calls received by service
 	HistorySignalWithStartWorkflowExecutionScope
 	// HistoryRemoveSignalMutableStateScope tracks RemoveSignalMutableState API calls received by service
@@ -1120,6 +1228,10 @@ const (
 	HistoryShardControllerScope
 	// HistoryReapplyEventsScope tracks ReapplyEvents API calls received by service
 	HistoryReapplyEventsScope
+	// HistoryReplayActivityCompletionsScope tracks ReplayActivityCompletions API calls received by service
+	HistoryReplayActivityCompletionsScope
+	// HistoryExpireActivityHeartbeatScope tracks ExpireActivityHeartbeat API calls received by service
+	HistoryExpireActivityHeartbeatScope
 	// HistoryRefreshWorkflowTasksScope tracks RefreshWorkflowTasks API calls received by service
 	HistoryRefreshWorkflowTasksScope
 	// HistoryNotifyFailoverMarkersScope is the scope used by notify failover marker API
@@ -1316,6 +1428,8 @@ const (
 	LargeExecutionCountShardScope
 	// LargeExecutionBlobShardScope is the scope to track large blobs for hotshard detection
 	LargeExecutionBlobShardScope
+	// ActivityTypeCircuitBreakerScope is the scope used by the circuit breakers of activity types
+	ActivityTypeCircuitBreakerScope
 
 	NumHistoryScopes
 )
@@ -1342,6 +1456,8 @@ const (
 	MatchingRespondQueryTaskCompletedScope
 	// MatchingCancelOutstandingPollScope tracks CancelOutstandingPoll API calls received by service
 	MatchingCancelOutstandingPollScope
+	// MatchingRecordBackpressureHintScope tracks RecordBackpressureHint API calls received by service
+	MatchingRecordBackpressureHintScope
 	// MatchingDescribeTaskListScope tracks DescribeTaskList API calls received by service
 	MatchingDescribeTaskListScope
 	// MatchingListTaskListPartitionsScope tracks ListTaskListPartitions API calls received by service
@@ -1352,6 +1468,8 @@ const (
 	MatchingUpdateTaskListPartitionConfigScope
 	// MatchingRefreshTaskListPartitionConfigScope tracks RefreshTaskListPartitionConfig API calls received by service
 	MatchingRefreshTaskListPartitionConfigScope
+	// MatchingRemoveTaskListTaskScope tracks RemoveTaskListTask API calls received by service
+	MatchingRemoveTaskListTaskScope
 
 	NumMatchingScopes
 )
@@ -1514,53 +1632,68 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 
 		ClusterMetadataArchivalConfigScope: {operation: "ArchivalConfig"},
 
-		HistoryClientStartWorkflowExecutionScope:            {operation: "HistoryClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientDescribeHistoryHostScope:               {operation: "HistoryClientDescribeHistoryHost", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRemoveTaskScope:                        {operation: "HistoryClientRemoveTask", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientCloseShardScope:                        {operation: "HistoryClientCloseShard", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientResetQueueScope:                        {operation: "HistoryClientResetQueue", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientDescribeQueueScope:                     {operation: "HistoryClientDescribeQueue", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRecordActivityTaskHeartbeatScope:       {operation: "HistoryClientRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRespondDecisionTaskCompletedScope:      {operation: "HistoryClientRespondDecisionTaskCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRespondDecisionTaskFailedScope:         {operation: "HistoryClientRespondDecisionTaskFailed", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRespondActivityTaskCompletedScope:      {operation: "HistoryClientRespondActivityTaskCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRespondActivityTaskFailedScope:         {operation: "HistoryClientRespondActivityTaskFailed", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRespondActivityTaskCanceledScope:       {operation: "HistoryClientRespondActivityTaskCanceled", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientDescribeMutableStateScope:              {operation: "HistoryClientDescribeMutableState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientGetMutableStateScope:                   {operation: "HistoryClientGetMutableState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientPollMutableStateScope:                  {operation: "HistoryClientPollMutableState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientResetStickyTaskListScope:               {operation: "HistoryClientResetStickyTaskList", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientDescribeWorkflowExecutionScope:         {operation: "HistoryClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRecordDecisionTaskStartedScope:         {operation: "HistoryClientRecordDecisionTaskStarted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRecordActivityTaskStartedScope:         {operation: "HistoryClientRecordActivityTaskStarted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRequestCancelWorkflowExecutionScope:    {operation: "HistoryClientRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientSignalWorkflowExecutionScope:           {operation: "HistoryClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientSignalWithStartWorkflowExecutionScope:  {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRemoveSignalMutableStateScope:          {operation: "HistoryClientRemoveSignalMutableState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientTerminateWorkflowExecutionScope:        {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientResetWorkflowExecutionScope:            {operation: "HistoryClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientScheduleDecisionTaskScope:              {operation: "HistoryClientScheduleDecisionTask", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRecordChildExecutionCompletedScope:     {operation: "HistoryClientRecordChildExecutionCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientReplicateEventsV2Scope:                 {operation: "HistoryClientReplicateEventsV2", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientSyncShardStatusScope:                   {operation: "HistoryClientSyncShardStatus", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientSyncActivityScope:                      {operation: "HistoryClientSyncActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientGetReplicationTasksScope:               {operation: "HistoryClientGetReplicationTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientGetDLQReplicationTasksScope:            {operation: "HistoryClientGetDLQReplicationTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientQueryWorkflowScope:                     {operation: "HistoryClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientReapplyEventsScope:                     {operation: "HistoryClientReapplyEvents", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientCountDLQMessagesScope:                  {operation: "HistoryClientCountDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientReadDLQMessagesScope:                   {operation: "HistoryClientReadDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientPurgeDLQMessagesScope:                  {operation: "HistoryClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientMergeDLQMessagesScope:                  {operation: "HistoryClientMergeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRefreshWorkflowTasksScope:              {operation: "HistoryClientRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientNotifyFailoverMarkersScope:             {operation: "HistoryClientNotifyFailoverMarkers", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientGetCrossClusterTasksScope:              {operation: "HistoryClientGetCrossClusterTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRespondCrossClusterTasksCompletedScope: {operation: "HistoryClientRespondCrossClusterTasksCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientGetFailoverInfoScope:                   {operation: "HistoryClientGetFailoverInfo", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientGetDLQReplicationMessagesScope:         {operation: "HistoryClientGetDLQReplicationMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientGetReplicationMessagesScope:            {operation: "HistoryClientGetReplicationMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientWfIDCacheScope:                         {operation: "HistoryClientWfIDCache", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRatelimitUpdateScope:                   {operation: "HistoryClientRatelimitUpdate", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientStartWorkflowExecutionScope:             {operation: "HistoryClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientDescribeHistoryHostScope:                {operation: "HistoryClientDescribeHistoryHost", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRemoveTaskScope:                         {operation: "HistoryClientRemoveTask", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientCloseShardScope:                         {operation: "HistoryClientCloseShard", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientResetQueueScope:                         {operation: "HistoryClientResetQueue", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientDescribeQueueScope:                      {operation: "HistoryClientDescribeQueue", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRecordActivityTaskHeartbeatScope:        {operation: "HistoryClientRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRespondDecisionTaskCompletedScope:       {operation: "HistoryClientRespondDecisionTaskCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRespondDecisionTaskFailedScope:          {operation: "HistoryClientRespondDecisionTaskFailed", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRespondActivityTaskCompletedScope:       {operation: "HistoryClientRespondActivityTaskCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRespondActivityTaskFailedScope:          {operation: "HistoryClientRespondActivityTaskFailed", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRespondActivityTaskCanceledScope:        {operation: "HistoryClientRespondActivityTaskCanceled", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientDescribeMutableStateScope:               {operation: "HistoryClientDescribeMutableState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetMutableStateScope:                    {operation: "HistoryClientGetMutableState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientPollMutableStateScope:                   {operation: "HistoryClientPollMutableState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientResetStickyTaskListScope:                {operation: "HistoryClientResetStickyTaskList", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientDescribeWorkflowExecutionScope:          {operation: "HistoryClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientBatchDescribeWorkflowExecutionsScope:    {operation: "HistoryClientBatchDescribeWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRecordDecisionTaskStartedScope:          {operation: "HistoryClientRecordDecisionTaskStarted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRecordActivityTaskStartedScope:          {operation: "HistoryClientRecordActivityTaskStarted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRequestCancelWorkflowExecutionScope:     {operation: "HistoryClientRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientSignalWorkflowExecutionScope:            {operation: "HistoryClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientSignalActivityScope:                     {operation: "HistoryClientSignalActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientCancelPendingActivitiesScope:            {operation: "HistoryClientCancelPendingActivities", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRedriveTimedOutActivitiesScope:          {operation: "HistoryClientRedriveTimedOutActivities", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientResetActivityAttemptsScope:              {operation: "HistoryClientResetActivityAttempts", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientExpireActivityHeartbeatScope:            {operation: "HistoryClientExpireActivityHeartbeat", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetActivityAttemptTimingsScope:          {operation: "HistoryClientGetActivityAttemptTimings", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetActivityTokenValidationFailuresScope: {operation: "HistoryClientGetActivityTokenValidationFailures", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetActivityRetryStatsScope:              {operation: "HistoryClientGetActivityRetryStats", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRespondActivityTaskNackScope:            {operation: "HistoryClientRespondActivityTaskNack", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetActivityWorkerHistoryScope:           {operation: "HistoryClientGetActivityWorkerHistory", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetActivityRescheduleReasonsScope:       {operation: "HistoryClientGetActivityRescheduleReasons", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientReplayActivityCompletionsScope:          {operation: "HistoryClientReplayActivityCompletions", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetActivityNextRetryTimeScope:           {operation: "HistoryClientGetActivityNextRetryTime", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetActivityEffectiveConfigScope:         {operation: "HistoryClientGetActivityEffectiveConfig", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientSignalWithStartWorkflowExecutionScope:   {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRemoveSignalMutableStateScope:           {operation: "HistoryClientRemoveSignalMutableState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientTerminateWorkflowExecutionScope:         {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientResetWorkflowExecutionScope:             {operation: "HistoryClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientScheduleDecisionTaskScope:               {operation: "HistoryClientScheduleDecisionTask", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRecordChildExecutionCompletedScope:      {operation: "HistoryClientRecordChildExecutionCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientReplicateEventsV2Scope:                  {operation: "HistoryClientReplicateEventsV2", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientSyncShardStatusScope:                    {operation: "HistoryClientSyncShardStatus", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientSyncActivityScope:                       {operation: "HistoryClientSyncActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetReplicationTasksScope:                {operation: "HistoryClientGetReplicationTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetDLQReplicationTasksScope:             {operation: "HistoryClientGetDLQReplicationTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientQueryWorkflowScope:                      {operation: "HistoryClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientReapplyEventsScope:                      {operation: "HistoryClientReapplyEvents", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientCountDLQMessagesScope:                   {operation: "HistoryClientCountDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientReadDLQMessagesScope:                    {operation: "HistoryClientReadDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientPurgeDLQMessagesScope:                   {operation: "HistoryClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientMergeDLQMessagesScope:                   {operation: "HistoryClientMergeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRefreshWorkflowTasksScope:               {operation: "HistoryClientRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientNotifyFailoverMarkersScope:              {operation: "HistoryClientNotifyFailoverMarkers", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetCrossClusterTasksScope:               {operation: "HistoryClientGetCrossClusterTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRespondCrossClusterTasksCompletedScope:  {operation: "HistoryClientRespondCrossClusterTasksCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetFailoverInfoScope:                    {operation: "HistoryClientGetFailoverInfo", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetDLQReplicationMessagesScope:          {operation: "HistoryClientGetDLQReplicationMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetReplicationMessagesScope:             {operation: "HistoryClientGetReplicationMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientWfIDCacheScope:                          {operation: "HistoryClientWfIDCache", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRatelimitUpdateScope:                    {operation: "HistoryClientRatelimitUpdate", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 
 		MatchingClientPollForDecisionTaskScope:            {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientPollForActivityTaskScope:            {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
@@ -1569,11 +1702,13 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingClientQueryWorkflowScope:                  {operation: "MatchingClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientRespondQueryTaskCompletedScope:      {operation: "MatchingClientRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientCancelOutstandingPollScope:          {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientRecordBackpressureHintScope:         {operation: "MatchingClientRecordBackpressureHint", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientDescribeTaskListScope:               {operation: "MatchingClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientListTaskListPartitionsScope:         {operation: "MatchingClientListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientGetTaskListsByDomainScope:           {operation: "MatchingClientGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientUpdateTaskListPartitionConfigScope:  {operation: "MatchingClientUpdateTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientRefreshTaskListPartitionConfigScope: {operation: "MatchingClientRefreshTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientRemoveTaskListTaskScope:             {operation: "MatchingClientRemoveTaskListTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 
 		FrontendClientDeprecateDomainScope:                       {operation: "FrontendClientDeprecateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientDescribeDomainScope:                        {operation: "FrontendClientDescribeDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1812,6 +1947,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminResendReplicationTasksScope:            {operation: "ResendReplicationTasks"},
 		AdminGetCrossClusterTasksScope:              {operation: "AdminGetCrossClusterTasks"},
 		AdminRespondCrossClusterTasksCompletedScope: {operation: "AdminRespondCrossClusterTasksCompleted"},
+		AdminDecodeTaskTokenScope:                   {operation: "AdminDecodeTaskToken"},
+		AdminBatchTerminateScope:                    {operation: "AdminBatchTerminate"},
+		AdminDescribeBatchTerminateScope:            {operation: "AdminDescribeBatchTerminate"},
+		AdminRemoveTaskListTaskScope:                {operation: "AdminRemoveTaskListTask"},
+		AdminReplayActivityCompletionsScope:         {operation: "AdminReplayActivityCompletions"},
+		AdminExpireActivityHeartbeatScope:           {operation: "AdminExpireActivityHeartbeat"},
+		AdminRedriveTimedOutActivitiesScope:         {operation: "AdminRedriveTimedOutActivities"},
+		AdminCancelPendingActivitiesScope:           {operation: "AdminCancelPendingActivities"},
 		AdminGetDynamicConfigScope:                  {operation: "AdminGetDynamicConfig"},
 		AdminUpdateDynamicConfigScope:               {operation: "AdminUpdateDynamicConfig"},
 		AdminRestoreDynamicConfigScope:              {operation: "AdminRestoreDynamicConfig"},
@@ -1831,6 +1974,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendPollForDecisionTaskScope:                   {operation: "PollForDecisionTask"},
 		FrontendPollForActivityTaskScope:                   {operation: "PollForActivityTask"},
 		FrontendRecordActivityTaskHeartbeatScope:           {operation: "RecordActivityTaskHeartbeat"},
+		FrontendRenewActivityLeaseScope:                    {operation: "RenewActivityLease"},
 		FrontendRecordActivityTaskHeartbeatByIDScope:       {operation: "RecordActivityTaskHeartbeatByID"},
 		FrontendRespondDecisionTaskCompletedScope:          {operation: "RespondDecisionTaskCompleted"},
 		FrontendRespondDecisionTaskFailedScope:             {operation: "RespondDecisionTaskFailed"},
@@ -1845,6 +1989,17 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendGetWorkflowExecutionRawHistoryScope:        {operation: "GetWorkflowExecutionRawHistory"},
 		FrontendPollForWorklfowExecutionRawHistoryScope:    {operation: "PollForWorklfowExecutionRawHistory"},
 		FrontendSignalWorkflowExecutionScope:               {operation: "SignalWorkflowExecution"},
+		FrontendSignalActivityScope:                        {operation: "SignalActivity"},
+		FrontendResetActivityAttemptsScope:                 {operation: "ResetActivityAttempts"},
+		FrontendGetActivityAttemptTimingsScope:             {operation: "GetActivityAttemptTimings"},
+		FrontendGetActivityTokenValidationFailuresScope:    {operation: "GetActivityTokenValidationFailures"},
+		FrontendGetActivityRetryStatsScope:                 {operation: "GetActivityRetryStats"},
+		FrontendRespondActivityTaskNackScope:               {operation: "RespondActivityTaskNack"},
+		FrontendGetActivityWorkerHistoryScope:              {operation: "GetActivityWorkerHistory"},
+		FrontendGetActivityRescheduleReasonsScope:          {operation: "GetActivityRescheduleReasons"},
+		FrontendReplayActivityCompletionsScope:             {operation: "ReplayActivityCompletions"},
+		FrontendGetActivityNextRetryTimeScope:              {operation: "GetActivityNextRetryTime"},
+		FrontendGetActivityEffectiveConfigScope:            {operation: "GetActivityEffectiveConfig"},
 		FrontendSignalWithStartWorkflowExecutionScope:      {operation: "SignalWithStartWorkflowExecution"},
 		FrontendSignalWithStartWorkflowExecutionAsyncScope: {operation: "SignalWithStartWorkflowExecutionAsync"},
 		FrontendTerminateWorkflowExecutionScope:            {operation: "TerminateWorkflowExecution"},
@@ -1863,11 +2018,15 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendDeprecateDomainScope:                       {operation: "DeprecateDomain"},
 		FrontendQueryWorkflowScope:                         {operation: "QueryWorkflow"},
 		FrontendDescribeWorkflowExecutionScope:             {operation: "DescribeWorkflowExecution"},
+		FrontendBatchDescribeWorkflowExecutionsScope:       {operation: "BatchDescribeWorkflowExecutions"},
 		FrontendDiagnoseWorkflowExecutionScope:             {operation: "DiagnoseWorkflowExecution"},
+		FrontendListPendingActivityScheduleAttributesScope: {operation: "ListPendingActivityScheduleAttributes"},
+		FrontendListActivityTypesScope:                     {operation: "ListActivityTypes"},
 		FrontendDescribeWorkflowExecutionStatusScope:       {operation: "DescribeWorkflowExecutionStatus"},
 		FrontendListTaskListPartitionsScope:                {operation: "FrontendListTaskListPartitions"},
 		FrontendGetTaskListsByDomainScope:                  {operation: "FrontendGetTaskListsByDomain"},
 		FrontendRefreshWorkflowTasksScope:                  {operation: "FrontendRefreshWorkflowTasks"},
+		FrontendWaitForActivityCompletionScope:             {operation: "WaitForActivityCompletion"},
 		FrontendDescribeTaskListScope:                      {operation: "DescribeTaskList"},
 		FrontendResetStickyTaskListScope:                   {operation: "ResetStickyTaskList"},
 		FrontendGetSearchAttributesScope:                   {operation: "GetSearchAttributes"},
@@ -1889,9 +2048,22 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryPollMutableStateScope:                                    {operation: "PollMutableState"},
 		HistoryResetStickyTaskListScope:                                 {operation: "ResetStickyTaskListScope"},
 		HistoryDescribeWorkflowExecutionScope:                           {operation: "DescribeWorkflowExecution"},
+		HistoryBatchDescribeWorkflowExecutionsScope:                     {operation: "BatchDescribeWorkflowExecutions"},
 		HistoryRecordDecisionTaskStartedScope:                           {operation: "RecordDecisionTaskStarted"},
 		HistoryRecordActivityTaskStartedScope:                           {operation: "RecordActivityTaskStarted"},
 		HistorySignalWorkflowExecutionScope:                             {operation: "SignalWorkflowExecution"},
+		HistorySignalActivityScope:                                      {operation: "SignalActivity"},
+		HistoryCancelPendingActivitiesScope:                             {operation: "CancelPendingActivities"},
+		HistoryRedriveTimedOutActivitiesScope:                           {operation: "RedriveTimedOutActivities"},
+		HistoryResetActivityAttemptsScope:                               {operation: "ResetActivityAttempts"},
+		HistoryGetActivityAttemptTimingsScope:                           {operation: "GetActivityAttemptTimings"},
+		HistoryGetActivityTokenValidationFailuresScope:                  {operation: "GetActivityTokenValidationFailures"},
+		HistoryGetActivityRetryStatsScope:                               {operation: "GetActivityRetryStats"},
+		HistoryRespondActivityTaskNackScope:                             {operation: "RespondActivityTaskNack"},
+		HistoryGetActivityWorkerHistoryScope:                            {operation: "GetActivityWorkerHistory"},
+		HistoryGetActivityRescheduleReasonsScope:                        {operation: "GetActivityRescheduleReasons"},
+		HistoryGetActivityNextRetryTimeScope:                            {operation: "GetActivityNextRetryTime"},
+		HistoryGetActivityEffectiveConfigScope:                          {operation: "GetActivityEffectiveConfig"},
 		HistorySignalWithStartWorkflowExecutionScope:                    {operation: "SignalWithStartWorkflowExecution"},
 		HistoryRemoveSignalMutableStateScope:                            {operation: "RemoveSignalMutableState"},
 		HistoryTerminateWorkflowExecutionScope:                          {operation: "TerminateWorkflowExecution"},
@@ -1915,6 +2087,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryMergeDLQMessagesScope:                                    {operation: "MergeDLQMessages"},
 		HistoryShardControllerScope:                                     {operation: "ShardController"},
 		HistoryReapplyEventsScope:                                       {operation: "EventReapplication"},
+		HistoryReplayActivityCompletionsScope:                           {operation: "ReplayActivityCompletions"},
+		HistoryExpireActivityHeartbeatScope:                             {operation: "ExpireActivityHeartbeat"},
 		HistoryRefreshWorkflowTasksScope:                                {operation: "RefreshWorkflowTasks"},
 		HistoryNotifyFailoverMarkersScope:                               {operation: "NotifyFailoverMarkers"},
 		HistoryGetCrossClusterTasksScope:                                {operation: "GetCrossClusterTasks"},
@@ -2010,6 +2184,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		LargeExecutionSizeShardScope:                                    {operation: "LargeExecutionSizeShard"},
 		LargeExecutionCountShardScope:                                   {operation: "LargeExecutionCountShard"},
 		LargeExecutionBlobShardScope:                                    {operation: "LargeExecutionBlobShard"},
+		ActivityTypeCircuitBreakerScope:                                 {operation: "ActivityTypeCircuitBreaker"},
 	},
 	// Matching Scope Names
 	Matching: {
@@ -2023,11 +2198,13 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingQueryWorkflowScope:                  {operation: "QueryWorkflow"},
 		MatchingRespondQueryTaskCompletedScope:      {operation: "RespondQueryTaskCompleted"},
 		MatchingCancelOutstandingPollScope:          {operation: "CancelOutstandingPoll"},
+		MatchingRecordBackpressureHintScope:         {operation: "RecordBackpressureHint"},
 		MatchingDescribeTaskListScope:               {operation: "DescribeTaskList"},
 		MatchingListTaskListPartitionsScope:         {operation: "ListTaskListPartitions"},
 		MatchingGetTaskListsByDomainScope:           {operation: "GetTaskListsByDomain"},
 		MatchingUpdateTaskListPartitionConfigScope:  {operation: "UpdateTaskListPartitionConfig"},
 		MatchingRefreshTaskListPartitionConfigScope: {operation: "RefreshTaskListPartitionConfig"},
+		MatchingRemoveTaskListTaskScope:             {operation: "RemoveTaskListTask"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -2206,6 +2383,9 @@ const (
 	DescribeWorkflowStatusCount
 	DescribeWorkflowStatusError
 
+	ActivityHeartbeatThrottledCounter
+	ActivityHeartbeatQueueLatency
+
 	GracefulFailoverLatency
 	GracefulFailoverFailure
 
@@ -2318,6 +2498,7 @@ const (
 	TaskBatchCompleteFailure
 	TaskProcessingLatency
 	TaskQueueLatency
+	ActivityTimerTaskLatencyPerShard
 	ScheduleToStartHistoryQueueLatencyPerTaskList
 
 	TaskRequestsPerDomain
@@ -2380,7 +2561,19 @@ const (
 	ClusterMetadataResolvingMinFailoverVersionCounter
 
 	ActivityE2ELatency
+	ActivityExecutionLatencyHistogram
+	ActivityQueueLatencyHistogram
+	ActivityHeartbeatGap
 	ActivityLostCounter
+	ActivityRedispatchCounter
+	ActivityStolenCounter
+	ActivityTimeoutMaintenanceDeferredCounter
+	WorkflowCounterLimitExceededCounter
+	ActivityTimeoutClampedCounter
+	ActivityCancellationAckTimeoutCounter
+	ActivityCancellationForcedCounter
+	ActivityCancellationCheckpointGraceExceededCounter
+	ActivityFailedPerCategoryCounter
 	AckLevelUpdateCounter
 	AckLevelUpdateFailedCounter
 	DecisionTypeScheduleActivityCounter
@@ -2407,6 +2600,17 @@ const (
 	DataInconsistentCounter
 	TimerResurrectionCounter
 	ActivityResurrectionCounter
+	ActivityRetrySuppressedCounter
+	ActivityResultRejectedCounter
+	ActivityResultSizeHintExceededCounter
+	ActivityResultSearchAttributeSkippedCounter
+	ActivityTokenValidationFailureCounter
+	ActivityStalledCounter
+	ActivityTypeCircuitBreakerStateGauge
+	ActivityTypeCircuitBreakerFailureRateGauge
+	ActivityTypeCircuitBreakerOpenedCounter
+	ActivityTypeCircuitBreakerHeldCounter
+	ActivityKillSwitchHeldCounter
 	AutoResetPointsLimitExceededCounter
 	AutoResetPointCorruptionCounter
 	ConcurrencyUpdateFailureCounter
@@ -2651,6 +2855,7 @@ const (
 	PollLocalMatchAfterForwardFailedLatencyPerTaskList
 	PollDecisionTaskAlreadyStartedCounterPerTaskList
 	PollActivityTaskAlreadyStartedCounterPerTaskList
+	PollActivityTaskCapabilityMismatchCounterPerTaskList
 	TaskListReadWritePartitionMismatchGauge
 	TaskListPollerPartitionMismatchGauge
 	EstimatedAddTaskQPSGauge
@@ -2660,6 +2865,8 @@ const (
 	StandbyClusterTasksNotStartedCounterPerTaskList
 	StandbyClusterTasksCompletionFailurePerTaskList
 	TaskIsolationLeakPerTaskList
+	CrossRegionDispatchPerTaskListCounter
+	RegionAffinityDispatchTimeoutPerTaskListCounter
 	NumMatchingMetrics
 )
 
@@ -2995,6 +3202,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DescribeWorkflowStatusError: {metricName: "describe_wf_error", metricType: Counter},
 		DescribeWorkflowStatusCount: {metricName: "describe_wf_status", metricType: Counter},
 
+		ActivityHeartbeatThrottledCounter: {metricName: "activity_heartbeat_throttled", metricType: Counter},
+		ActivityHeartbeatQueueLatency:     {metricName: "activity_heartbeat_queue_latency", metricType: Timer},
+
 		AsyncRequestPayloadSize: {metricName: "async_request_payload_size_per_domain", metricRollupName: "async_request_payload_size", metricType: Timer},
 
 		GlobalRatelimiterStartupUsageHistogram: {metricName: "global_ratelimiter_startup_usage_histogram", metricType: Histogram, buckets: GlobalRatelimiterUsageHistogram},
@@ -3022,16 +3232,17 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskListPartitionConfigNumWriteGauge: {metricName: "task_list_partition_config_num_write", metricType: Gauge},
 	},
 	History: {
-		TaskRequests:             {metricName: "task_requests", metricType: Counter},
-		TaskLatency:              {metricName: "task_latency", metricType: Timer},
-		TaskAttemptTimer:         {metricName: "task_attempt", metricType: Timer},
-		TaskFailures:             {metricName: "task_errors", metricType: Counter},
-		TaskDiscarded:            {metricName: "task_errors_discarded", metricType: Counter},
-		TaskStandbyRetryCounter:  {metricName: "task_errors_standby_retry_counter", metricType: Counter},
-		TaskNotActiveCounter:     {metricName: "task_errors_not_active_counter", metricType: Counter},
-		TaskLimitExceededCounter: {metricName: "task_errors_limit_exceeded_counter", metricType: Counter},
-		TaskProcessingLatency:    {metricName: "task_latency_processing", metricType: Timer},
-		TaskQueueLatency:         {metricName: "task_latency_queue", metricType: Timer},
+		TaskRequests:                     {metricName: "task_requests", metricType: Counter},
+		TaskLatency:                      {metricName: "task_latency", metricType: Timer},
+		TaskAttemptTimer:                 {metricName: "task_attempt", metricType: Timer},
+		TaskFailures:                     {metricName: "task_errors", metricType: Counter},
+		TaskDiscarded:                    {metricName: "task_errors_discarded", metricType: Counter},
+		TaskStandbyRetryCounter:          {metricName: "task_errors_standby_retry_counter", metricType: Counter},
+		TaskNotActiveCounter:             {metricName: "task_errors_not_active_counter", metricType: Counter},
+		TaskLimitExceededCounter:         {metricName: "task_errors_limit_exceeded_counter", metricType: Counter},
+		TaskProcessingLatency:            {metricName: "task_latency_processing", metricType: Timer},
+		TaskQueueLatency:                 {metricName: "task_latency_queue", metricType: Timer},
+		ActivityTimerTaskLatencyPerShard: {metricName: "activity_timer_task_latency_per_shard", metricType: Timer},
 		ScheduleToStartHistoryQueueLatencyPerTaskList: {metricName: "schedule_to_start_history_queue_latency_per_tl", metricType: Timer},
 
 		// per domain task metrics
@@ -3091,7 +3302,19 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ClusterMetadataResolvingFailoverVersionCounter:               {metricName: "resolving_failover_version_counter", metricType: Counter},
 		ClusterMetadataResolvingMinFailoverVersionCounter:            {metricName: "resolving_min_failover_version_counter", metricType: Counter},
 		ActivityE2ELatency:                                           {metricName: "activity_end_to_end_latency", metricType: Timer},
+		ActivityExecutionLatencyHistogram:                            {metricName: "activity_execution_latency_histogram", metricType: Histogram, buckets: ActivityExecutionLatencyBuckets},
+		ActivityQueueLatencyHistogram:                                {metricName: "activity_queue_latency_histogram", metricType: Histogram, buckets: ActivityQueueLatencyBuckets},
+		ActivityHeartbeatGap:                                         {metricName: "activity_heartbeat_gap", metricType: Timer},
 		ActivityLostCounter:                                          {metricName: "activity_lost", metricType: Counter},
+		ActivityRedispatchCounter:                                    {metricName: "activity_redispatch", metricType: Counter},
+		ActivityStolenCounter:                                        {metricName: "activity_stolen", metricType: Counter},
+		ActivityTimeoutMaintenanceDeferredCounter:                    {metricName: "activity_timeout_maintenance_deferred", metricType: Counter},
+		WorkflowCounterLimitExceededCounter:                          {metricName: "workflow_counter_limit_exceeded", metricType: Counter},
+		ActivityTimeoutClampedCounter:                                {metricName: "activity_timeout_clamped", metricType: Counter},
+		ActivityCancellationAckTimeoutCounter:                        {metricName: "activity_cancellation_ack_timeout", metricType: Counter},
+		ActivityCancellationForcedCounter:                            {metricName: "activity_cancellation_forced", metricType: Counter},
+		ActivityCancellationCheckpointGraceExceededCounter:           {metricName: "activity_cancellation_checkpoint_grace_exceeded", metricType: Counter},
+		ActivityFailedPerCategoryCounter:                             {metricName: "activity_failed_per_category", metricType: Counter},
 		AckLevelUpdateCounter:                                        {metricName: "ack_level_update", metricType: Counter},
 		AckLevelUpdateFailedCounter:                                  {metricName: "ack_level_update_failed", metricType: Counter},
 		DecisionTypeScheduleActivityCounter:                          {metricName: "schedule_activity_decision", metricType: Counter},
@@ -3118,6 +3341,17 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DataInconsistentCounter:                                      {metricName: "data_inconsistent", metricType: Counter},
 		TimerResurrectionCounter:                                     {metricName: "timer_resurrection", metricType: Counter},
 		ActivityResurrectionCounter:                                  {metricName: "activity_resurrection", metricType: Counter},
+		ActivityRetrySuppressedCounter:                               {metricName: "activity_retry_suppressed", metricType: Counter},
+		ActivityResultRejectedCounter:                                {metricName: "activity_result_rejected", metricType: Counter},
+		ActivityResultSizeHintExceededCounter:                        {metricName: "activity_result_size_hint_exceeded", metricType: Counter},
+		ActivityResultSearchAttributeSkippedCounter:                  {metricName: "activity_result_search_attribute_skipped", metricType: Counter},
+		ActivityTokenValidationFailureCounter:                        {metricName: "activity_token_validation_failure", metricType: Counter},
+		ActivityStalledCounter:                                       {metricName: "activity_stalled", metricType: Counter},
+		ActivityTypeCircuitBreakerStateGauge:                         {metricName: "activity_type_circuit_breaker_state", metricType: Gauge},
+		ActivityTypeCircuitBreakerFailureRateGauge:                   {metricName: "activity_type_circuit_breaker_failure_rate", metricType: Gauge},
+		ActivityTypeCircuitBreakerOpenedCounter:                      {metricName: "activity_type_circuit_breaker_opened", metricType: Counter},
+		ActivityTypeCircuitBreakerHeldCounter:                        {metricName: "activity_type_circuit_breaker_held", metricType: Counter},
+		ActivityKillSwitchHeldCounter:                                {metricName: "activity_kill_switch_held", metricType: Counter},
 		AutoResetPointsLimitExceededCounter:                          {metricName: "auto_reset_points_exceed_limit", metricType: Counter},
 		AutoResetPointCorruptionCounter:                              {metricName: "auto_reset_point_corruption", metricType: Counter},
 		ConcurrencyUpdateFailureCounter:                              {metricName: "concurrency_update_failure", metricType: Counter},
@@ -3359,6 +3593,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PollLocalMatchAfterForwardFailedLatencyPerTaskList:      {metricName: "poll_local_match_after_forward_failed_latency_per_tl", metricRollupName: "poll_local_match_after_forward_failed_latency", metricType: Timer},
 		PollDecisionTaskAlreadyStartedCounterPerTaskList:        {metricName: "poll_decision_task_already_started_per_tl", metricType: Counter},
 		PollActivityTaskAlreadyStartedCounterPerTaskList:        {metricName: "poll_activity_task_already_started_per_tl", metricType: Counter},
+		PollActivityTaskCapabilityMismatchCounterPerTaskList:    {metricName: "poll_activity_task_capability_mismatch_per_tl", metricType: Counter},
 		TaskListReadWritePartitionMismatchGauge:                 {metricName: "tasklist_read_write_partition_mismatch", metricType: Gauge},
 		TaskListPollerPartitionMismatchGauge:                    {metricName: "tasklist_poller_partition_mismatch", metricType: Gauge},
 		EstimatedAddTaskQPSGauge:                                {metricName: "estimated_add_task_qps_per_tl", metricType: Gauge},
@@ -3368,6 +3603,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		StandbyClusterTasksNotStartedCounterPerTaskList:         {metricName: "standby_cluster_tasks_not_started_per_tl", metricType: Counter},
 		StandbyClusterTasksCompletionFailurePerTaskList:         {metricName: "standby_cluster_tasks_completion_failure_per_tl", metricType: Counter},
 		TaskIsolationLeakPerTaskList:                            {metricName: "task_isolation_leak_per_tl", metricRollupName: "task_isolation_leak"},
+		CrossRegionDispatchPerTaskListCounter:                   {metricName: "cross_region_dispatches_per_tl", metricRollupName: "cross_region_dispatches", metricType: Counter},
+		RegionAffinityDispatchTimeoutPerTaskListCounter:         {metricName: "region_affinity_dispatch_timeouts_per_tl", metricRollupName: "region_affinity_dispatch_timeouts", metricType: Counter},
 	},
 	Worker: {
 		ReplicatorMessages:                            {metricName: "replicator_messages"},
@@ -3523,6 +3760,14 @@ var PersistenceLatencyBuckets = tally.DurationBuckets([]time.Duration{
 	60 * time.Second,
 })
 
+// ActivityExecutionLatencyBuckets contains duration buckets for measuring how long activities
+// take from being started to being completed, from 10ms up to roughly a day.
+var ActivityExecutionLatencyBuckets = tally.MustMakeExponentialDurationBuckets(10*time.Millisecond, 2, 24)
+
+// ActivityQueueLatencyBuckets contains duration buckets for measuring how long activities wait
+// from being scheduled to being started by a worker, from 1ms up to roughly a day.
+var ActivityQueueLatencyBuckets = tally.MustMakeExponentialDurationBuckets(time.Millisecond, 2, 27)
+
 // GlobalRatelimiterUsageHistogram contains buckets for tracking how many ratelimiters are
 // in which various states (startup, healthy, failing, as well as aggregator-side quantities, deleted, etc).
 //
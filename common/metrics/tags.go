@@ -64,6 +64,9 @@ const (
 	workflowTerminationReason = "workflow_termination_reason"
 	workflowCloseStatus       = "workflow_close_status"
 	isolationEnabled          = "isolation_enabled"
+	activityRetry             = "is_retry"
+	activityFailureCategory   = "failure_category"
+	activityTokenFailureCause = "token_failure_cause"
 	isolationGroup            = "isolation_group"
 	originalIsolationGroup    = "original_isolation_group"
 	leakCause                 = "leak_cause"
@@ -335,6 +338,25 @@ func IsolationEnabledTag(enabled bool) Tag {
 	return simpleMetric{key: isolationEnabled, value: v}
 }
 
+// ActivityRetryTag returns whether the activity attempt is a retry
+func ActivityRetryTag(isRetry bool) Tag {
+	v := "false"
+	if isRetry {
+		v = "true"
+	}
+	return simpleMetric{key: activityRetry, value: v}
+}
+
+// ActivityFailureCategoryTag returns a new activity failure category tag
+func ActivityFailureCategoryTag(category string) Tag {
+	return metricWithUnknown(activityFailureCategory, category)
+}
+
+// ActivityTokenFailureCauseTag returns a new tag of why the task token of an activity was rejected
+func ActivityTokenFailureCauseTag(cause string) Tag {
+	return metricWithUnknown(activityTokenFailureCause, cause)
+}
+
 func TopicTag(value string) Tag {
 	return metricWithUnknown(topic, value)
 }
@@ -28,6 +28,8 @@ type configKey struct{}
 
 type isolationGroupKey struct{}
 
+type sourceClusterKey struct{}
+
 // ConfigFromContext retrieves infomation about the partition config of the context
 // which is used for tasklist isolation
 func ConfigFromContext(ctx context.Context) map[string]string {
@@ -57,3 +59,18 @@ func IsolationGroupFromContext(ctx context.Context) string {
 func ContextWithIsolationGroup(ctx context.Context, isolationGroup string) context.Context {
 	return context.WithValue(ctx, isolationGroupKey{}, isolationGroup)
 }
+
+// SourceClusterFromContext retrieves the cluster a request was forwarded from,
+// empty if the request was not forwarded from another cluster
+func SourceClusterFromContext(ctx context.Context) string {
+	val, ok := ctx.Value(sourceClusterKey{}).(string)
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// ContextWithSourceCluster stores the cluster a request was forwarded from into the given context
+func ContextWithSourceCluster(ctx context.Context, sourceCluster string) context.Context {
+	return context.WithValue(ctx, sourceClusterKey{}, sourceCluster)
+}
@@ -35,4 +35,8 @@ func TestContext(t *testing.T) {
 
 	isolationGroup := "zone1"
 	assert.Equal(t, isolationGroup, IsolationGroupFromContext(ContextWithIsolationGroup(context.Background(), isolationGroup)))
+
+	sourceCluster := "cluster0"
+	assert.Equal(t, sourceCluster, SourceClusterFromContext(ContextWithSourceCluster(context.Background(), sourceCluster)))
+	assert.Empty(t, SourceClusterFromContext(context.Background()))
 }
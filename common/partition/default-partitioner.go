@@ -38,6 +38,12 @@ const (
 	IsolationGroupKey         = "isolation-group"
 	OriginalIsolationGroupKey = "original-isolation-group"
 	WorkflowIDKey             = "wf-id"
+	// RegionAffinityKey marks an activity task that prefers pollers of the cluster it was scheduled in,
+	// it is not used for partitioning but kept in the partition config so that it's persisted with the task
+	RegionAffinityKey = "region-affinity"
+	// PriorityKey holds the priority of an activity task, backlogged tasks with a priority are dispatched ahead
+	// of the tasks without one. Like RegionAffinityKey it's kept in the partition config to be persisted with the task
+	PriorityKey = "priority"
 )
 
 var (
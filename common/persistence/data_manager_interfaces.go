@@ -389,6 +389,18 @@ type (
 		Memo                               map[string][]byte
 		SearchAttributes                   map[string][]byte
 		PartitionConfig                    map[string]string
+		// Priority inherited by activities which do not set their own
+		Priority *int32
+		// Lower bound in seconds of the retry backoff
+		MinimumInterval int32
+		// Named counters incremented by activity completions
+		Counters map[string]int64
+		// The decision task following the completion of an activity batch is flagged
+		NotifyOnActivityBatchComplete bool
+		// An activity batch completed since the last completed decision which was told about it
+		ActivityBatchCompleted bool
+		// Started ID of the decision which was told about the completed activity batch
+		ActivityBatchNotifiedStartedID int64
 		// for retry
 		Attempt            int32
 		HasRetryPolicy     bool
@@ -609,6 +621,86 @@ type (
 		LastFailureDetails []byte
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
+		// Longest observed interval between two heartbeats of the current attempt
+		MaxHeartbeatGap time.Duration
+		// Signals accepted for the activity but not yet delivered through a heartbeat response
+		PendingSignals []*types.ActivitySignal
+		// Routing key matching hashes to pick a consistent tasklist partition
+		RoutingKey string
+		// Effective priority recorded on the scheduled event
+		Priority *int32
+		// StartToClose timeout in seconds which overrides StartToCloseTimeout for the first attempt
+		FirstAttemptStartToCloseTimeout int32
+		// Search attributes upserted into visibility while the activity is running
+		SearchAttributes map[string][]byte
+		// Visibility timeout in seconds applied by matching when the current attempt was dispatched
+		VisibilityTimeout int32
+		// Seconds without a heartbeat after which the current attempt is offered to another poller
+		StealTimeout int32
+		// StartToClose timeout in seconds granted to the current attempt through a heartbeat
+		GrantedStartToCloseTimeout int32
+		// Execution time budget in seconds shared by all attempts
+		MaxTotalExecutionSeconds int32
+		// Execution time consumed by the previous attempts
+		TotalExecutionTime time.Duration
+		// Sequence of the last heartbeat details accepted
+		HeartbeatSequence int64
+		// Set when the current attempt was started by a prefetch lease which the worker has not acknowledged yet
+		PrefetchLeased bool
+		// Task list to move to after repeated ScheduleToStart timeouts
+		FallbackTaskList string
+		// Consecutive ScheduleToStart timeouts of the activity
+		ScheduleToStartTimeouts int32
+		// Key the input and the result of the activity are encrypted with
+		EncryptionKeyID string
+		// Last progress reported by a heartbeat of the attempt
+		ProgressPercent *int32
+		// Consecutive heartbeats of the attempt which did not advance the progress
+		StalledHeartbeats int32
+		// Lower bound in seconds of the retry backoff
+		MinimumInterval int32
+		// Time at which the cancellation of the activity was requested
+		CancelRequestedTime time.Time
+		// Seconds after the cancellation request after which an unacknowledged cancellation is reported
+		CancelAckTimeout int32
+		// Seconds after the cancellation request after which an unacknowledged cancellation is forced
+		CancelForceTimeout int32
+		// Time at which the unacknowledged cancellation of the activity was reported
+		CancelAckTimeoutExceededTime time.Time
+		// Seconds to wait for a final checkpoint heartbeat once the cancellation was delivered to the worker
+		CancellationCheckpointGrace int32
+		// Time at which a heartbeat response delivered the cancellation of the activity to the worker
+		CancelDeliveredTime time.Time
+		// Activity scheduled with the result of the activity once it completes
+		NextActivity *types.ScheduleActivityTaskDecisionAttributes
+		// Causes for which the activity was scheduled again, oldest first
+		RescheduleReasons []*types.ActivityRescheduleReason
+		// Time spent in maintenance windows added to the StartToClose timeout of the attempt
+		MaintenancePausedTime time.Duration
+		// Timeouts of the attempt deferred past the end of a maintenance window
+		MaintenanceTimeoutsDeferred int32
+		// Times the attempt was nacked back to its task list by a worker
+		NackCount int32
+		// Reason given by the worker which nacked the attempt last
+		LastNackReason string
+		// A started attempt of the activity is never delivered again
+		AtMostOnce bool
+		// Tag of the alert sink notified when the activity fails for good
+		AlertOnFailure string
+		// ID of the activity whose completion the dispatch of the activity waits for
+		DependsOnActivityID string
+		// What is done with the activity when the activity it depends on does not complete
+		OnDependencyFailure types.ActivityDependencyFailurePolicy
+		// The activity was declared free of side effects, only such activities are stolen
+		Idempotent bool
+		// Not written to database - advisory size of the result declared when the activity was scheduled
+		ExpectedResultSizeBytes int64
+		// Paths of the values extracted from the result of the activity into search attributes once it completes
+		ResultSearchAttributes []*types.ActivityResultSearchAttribute
+		// Time at which ExpireActivityHeartbeat expired the heartbeat timer of the attempt
+		HeartbeatExpiredTime time.Time
+		// Task lists of the attempts of the activity, starting with the one it was scheduled on
+		TaskListEscalation []string
 	}
 
 	// TimerInfo details - metadata about user timer info.
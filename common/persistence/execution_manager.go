@@ -197,6 +197,12 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		SearchAttributes:                   info.SearchAttributes,
 		Memo:                               info.Memo,
 		PartitionConfig:                    info.PartitionConfig,
+		Priority:                           info.Priority,
+		MinimumInterval:                    info.MinimumInterval,
+		ActivityBatchNotifiedStartedID:     info.ActivityBatchNotifiedStartedID,
+		ActivityBatchCompleted:             info.ActivityBatchCompleted,
+		NotifyOnActivityBatchComplete:      info.NotifyOnActivityBatchComplete,
+		Counters:                           info.Counters,
 	}
 	newStats := &ExecutionStats{
 		HistorySize: info.HistorySize,
@@ -280,6 +286,22 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 		if err != nil {
 			return nil, err
 		}
+		nextActivity, err := m.serializer.DeserializeScheduleActivityTaskDecisionAttributes(v.NextActivity)
+		if err != nil {
+			return nil, err
+		}
+		pendingSignals, err := m.serializer.DeserializeActivitySignals(v.PendingSignals)
+		if err != nil {
+			return nil, err
+		}
+		resultSearchAttributes, err := m.serializer.DeserializeActivityResultSearchAttributes(v.ResultSearchAttributes)
+		if err != nil {
+			return nil, err
+		}
+		rescheduleReasons, err := m.serializer.DeserializeActivityRescheduleReasons(v.RescheduleReasons)
+		if err != nil {
+			return nil, err
+		}
 		a := &ActivityInfo{
 			ScheduledEvent: scheduledEvent,
 			StartedEvent:   startedEvent,
@@ -316,6 +338,45 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			LastWorkerIdentity:                      v.LastWorkerIdentity,
 			LastFailureDetails:                      v.LastFailureDetails,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
+			MaxHeartbeatGap:                         v.MaxHeartbeatGap,
+			PendingSignals:                          pendingSignals,
+			RoutingKey:                              v.RoutingKey,
+			Priority:                                v.Priority,
+			FirstAttemptStartToCloseTimeout:         v.FirstAttemptStartToCloseTimeout,
+			SearchAttributes:                        v.SearchAttributes,
+			VisibilityTimeout:                       v.VisibilityTimeout,
+			StealTimeout:                            v.StealTimeout,
+			GrantedStartToCloseTimeout:              v.GrantedStartToCloseTimeout,
+			MaxTotalExecutionSeconds:                v.MaxTotalExecutionSeconds,
+			TotalExecutionTime:                      v.TotalExecutionTime,
+			HeartbeatSequence:                       v.HeartbeatSequence,
+			PrefetchLeased:                          v.PrefetchLeased,
+			FallbackTaskList:                        v.FallbackTaskList,
+			ScheduleToStartTimeouts:                 v.ScheduleToStartTimeouts,
+			EncryptionKeyID:                         v.EncryptionKeyID,
+			ProgressPercent:                         v.ProgressPercent,
+			StalledHeartbeats:                       v.StalledHeartbeats,
+			MinimumInterval:                         v.MinimumInterval,
+			CancelRequestedTime:                     v.CancelRequestedTime,
+			CancelAckTimeout:                        v.CancelAckTimeout,
+			CancelForceTimeout:                      v.CancelForceTimeout,
+			CancelAckTimeoutExceededTime:            v.CancelAckTimeoutExceededTime,
+			NextActivity:                            nextActivity,
+			RescheduleReasons:                       rescheduleReasons,
+			AtMostOnce:                              v.AtMostOnce,
+			DependsOnActivityID:                     v.DependsOnActivityID,
+			OnDependencyFailure:                     v.OnDependencyFailure,
+			TaskListEscalation:                      v.TaskListEscalation,
+			MaintenancePausedTime:                   v.MaintenancePausedTime,
+			MaintenanceTimeoutsDeferred:             v.MaintenanceTimeoutsDeferred,
+			NackCount:                               v.NackCount,
+			LastNackReason:                          v.LastNackReason,
+			CancellationCheckpointGrace:             v.CancellationCheckpointGrace,
+			CancelDeliveredTime:                     v.CancelDeliveredTime,
+			AlertOnFailure:                          v.AlertOnFailure,
+			Idempotent:                              v.Idempotent,
+			HeartbeatExpiredTime:                    v.HeartbeatExpiredTime,
+			ResultSearchAttributes:                  resultSearchAttributes,
 		}
 		newInfos[k] = a
 	}
@@ -408,6 +469,22 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 		if err != nil {
 			return nil, err
 		}
+		nextActivity, err := m.serializer.SerializeScheduleActivityTaskDecisionAttributes(v.NextActivity, encoding)
+		if err != nil {
+			return nil, err
+		}
+		pendingSignals, err := m.serializer.SerializeActivitySignals(v.PendingSignals, encoding)
+		if err != nil {
+			return nil, err
+		}
+		resultSearchAttributes, err := m.serializer.SerializeActivityResultSearchAttributes(v.ResultSearchAttributes, encoding)
+		if err != nil {
+			return nil, err
+		}
+		rescheduleReasons, err := m.serializer.SerializeActivityRescheduleReasons(v.RescheduleReasons, encoding)
+		if err != nil {
+			return nil, err
+		}
 		i := &InternalActivityInfo{
 			Version:                                 v.Version,
 			ScheduleID:                              v.ScheduleID,
@@ -443,6 +520,45 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			LastWorkerIdentity:                      v.LastWorkerIdentity,
 			LastFailureDetails:                      v.LastFailureDetails,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
+			MaxHeartbeatGap:                         v.MaxHeartbeatGap,
+			PendingSignals:                          pendingSignals,
+			RoutingKey:                              v.RoutingKey,
+			Priority:                                v.Priority,
+			FirstAttemptStartToCloseTimeout:         v.FirstAttemptStartToCloseTimeout,
+			SearchAttributes:                        v.SearchAttributes,
+			VisibilityTimeout:                       v.VisibilityTimeout,
+			StealTimeout:                            v.StealTimeout,
+			GrantedStartToCloseTimeout:              v.GrantedStartToCloseTimeout,
+			MaxTotalExecutionSeconds:                v.MaxTotalExecutionSeconds,
+			TotalExecutionTime:                      v.TotalExecutionTime,
+			HeartbeatSequence:                       v.HeartbeatSequence,
+			PrefetchLeased:                          v.PrefetchLeased,
+			FallbackTaskList:                        v.FallbackTaskList,
+			ScheduleToStartTimeouts:                 v.ScheduleToStartTimeouts,
+			EncryptionKeyID:                         v.EncryptionKeyID,
+			ProgressPercent:                         v.ProgressPercent,
+			StalledHeartbeats:                       v.StalledHeartbeats,
+			MinimumInterval:                         v.MinimumInterval,
+			CancelRequestedTime:                     v.CancelRequestedTime,
+			CancelAckTimeout:                        v.CancelAckTimeout,
+			CancelForceTimeout:                      v.CancelForceTimeout,
+			CancelAckTimeoutExceededTime:            v.CancelAckTimeoutExceededTime,
+			NextActivity:                            nextActivity,
+			RescheduleReasons:                       rescheduleReasons,
+			AtMostOnce:                              v.AtMostOnce,
+			DependsOnActivityID:                     v.DependsOnActivityID,
+			OnDependencyFailure:                     v.OnDependencyFailure,
+			TaskListEscalation:                      v.TaskListEscalation,
+			MaintenancePausedTime:                   v.MaintenancePausedTime,
+			MaintenanceTimeoutsDeferred:             v.MaintenanceTimeoutsDeferred,
+			NackCount:                               v.NackCount,
+			LastNackReason:                          v.LastNackReason,
+			CancellationCheckpointGrace:             v.CancellationCheckpointGrace,
+			CancelDeliveredTime:                     v.CancelDeliveredTime,
+			AlertOnFailure:                          v.AlertOnFailure,
+			Idempotent:                              v.Idempotent,
+			HeartbeatExpiredTime:                    v.HeartbeatExpiredTime,
+			ResultSearchAttributes:                  resultSearchAttributes,
 		}
 		newInfos = append(newInfos, i)
 	}
@@ -525,6 +641,12 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		Memo:                               info.Memo,
 		SearchAttributes:                   info.SearchAttributes,
 		PartitionConfig:                    info.PartitionConfig,
+		Priority:                           info.Priority,
+		MinimumInterval:                    info.MinimumInterval,
+		ActivityBatchNotifiedStartedID:     info.ActivityBatchNotifiedStartedID,
+		ActivityBatchCompleted:             info.ActivityBatchCompleted,
+		NotifyOnActivityBatchComplete:      info.NotifyOnActivityBatchComplete,
+		Counters:                           info.Counters,
 
 		// attributes which are not related to mutable state
 		HistorySize: stats.HistorySize,
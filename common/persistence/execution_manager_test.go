@@ -322,6 +322,10 @@ func TestExecutionManager_GetWorkflowExecution(t *testing.T) {
 	mockedSerializer.EXPECT().DeserializeEvent(activityTwo.StartedEvent).Return(&types.HistoryEvent{
 		ID: 2,
 	}, nil).Times(1)
+	mockedSerializer.EXPECT().DeserializeScheduleActivityTaskDecisionAttributes(gomock.Nil()).Return(nil, nil).Times(2)
+	mockedSerializer.EXPECT().DeserializeActivitySignals(gomock.Nil()).Return(nil, nil).Times(2)
+	mockedSerializer.EXPECT().DeserializeActivityResultSearchAttributes(gomock.Nil()).Return(nil, nil).Times(2)
+	mockedSerializer.EXPECT().DeserializeActivityRescheduleReasons(gomock.Nil()).Return(nil, nil).Times(2)
 
 	mockedSerializer.EXPECT().DeserializeEvent(wfCompletionEvent).Return(wfCompletionEventData, nil).Times(1)
 	mockedSerializer.EXPECT().DeserializeResetPoints(gomock.Any()).Return(&types.ResetPoints{}, nil).Times(1)
@@ -389,6 +393,10 @@ func TestExecutionManager_UpdateWorkflowExecution(t *testing.T) {
 
 	mockedSerializer.EXPECT().SerializeEvent(completionEvent(), common.EncodingTypeThriftRW).Return(expectedInfo.ExecutionInfo.CompletionEvent, nil).Times(2)
 	mockedSerializer.EXPECT().SerializeEvent(activityStartedEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
+	mockedSerializer.EXPECT().SerializeScheduleActivityTaskDecisionAttributes(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+	mockedSerializer.EXPECT().SerializeActivitySignals(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+	mockedSerializer.EXPECT().SerializeActivityResultSearchAttributes(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+	mockedSerializer.EXPECT().SerializeActivityRescheduleReasons(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
 	mockedSerializer.EXPECT().SerializeEvent(activityScheduledEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 	mockedSerializer.EXPECT().SerializeEvent(childWorkflowScheduledEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 	mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
@@ -454,6 +462,10 @@ func TestSerializeWorkflowSnapshot(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeVersionHistories(gomock.Any(), gomock.Any()).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(activityScheduledEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(activityStartedEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeScheduleActivityTaskDecisionAttributes(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivitySignals(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivityResultSearchAttributes(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivityRescheduleReasons(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowScheduledEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleCheckSumData(), nil).Times(1)
@@ -551,6 +563,10 @@ func TestSerializeWorkflowSnapshot(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeVersionHistories(gomock.Any(), gomock.Any()).Return(sampleTestCheckSumData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(activityScheduledEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(activityStartedEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeScheduleActivityTaskDecisionAttributes(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivitySignals(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivityResultSearchAttributes(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivityRescheduleReasons(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowScheduledEvent(), common.EncodingTypeThriftRW).Return(nil, assert.AnError).Times(1)
 			},
 			input: sampleWorkflowSnapshot(),
@@ -566,6 +582,10 @@ func TestSerializeWorkflowSnapshot(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeVersionHistories(gomock.Any(), gomock.Any()).Return(sampleTestCheckSumData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(activityScheduledEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(activityStartedEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeScheduleActivityTaskDecisionAttributes(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivitySignals(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivityResultSearchAttributes(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivityRescheduleReasons(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowScheduledEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), common.EncodingTypeThriftRW).Return(nil, assert.AnError).Times(1)
 			},
@@ -1010,6 +1030,10 @@ func TestCreateWorkflowExecution(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeVersionHistories(gomock.Any(), gomock.Any()).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(activityScheduledEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(activityStartedEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeScheduleActivityTaskDecisionAttributes(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivitySignals(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivityResultSearchAttributes(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivityRescheduleReasons(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowScheduledEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleCheckSumData(), nil).Times(1)
@@ -1039,6 +1063,10 @@ func TestCreateWorkflowExecution(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeVersionHistories(gomock.Any(), gomock.Any()).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(activityScheduledEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(activityStartedEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeScheduleActivityTaskDecisionAttributes(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivitySignals(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivityResultSearchAttributes(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivityRescheduleReasons(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowScheduledEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleCheckSumData(), nil).Times(1)
@@ -1112,6 +1140,10 @@ func TestConflictResolveWorkflowExecution(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeVersionHistories(gomock.Any(), gomock.Any()).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(activityScheduledEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(activityStartedEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeScheduleActivityTaskDecisionAttributes(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivitySignals(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivityResultSearchAttributes(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivityRescheduleReasons(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowScheduledEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleCheckSumData(), nil).Times(1)
@@ -1170,6 +1202,10 @@ func TestConflictResolveWorkflowExecution(t *testing.T) {
 
 				// Mutation call
 				mockedSerializer.EXPECT().SerializeEvent(activityStartedEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeScheduleActivityTaskDecisionAttributes(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivitySignals(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivityResultSearchAttributes(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeActivityRescheduleReasons(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(activityScheduledEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeResetPoints(gomock.Any(), gomock.Any()).Return(sampleResetPointsData(), nil).Times(1)
 
@@ -1179,6 +1215,10 @@ func TestConflictResolveWorkflowExecution(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeVersionHistories(gomock.Any(), gomock.Any()).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(activityScheduledEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(2)
 				mockedSerializer.EXPECT().SerializeEvent(activityStartedEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(2)
+				mockedSerializer.EXPECT().SerializeScheduleActivityTaskDecisionAttributes(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(2)
+				mockedSerializer.EXPECT().SerializeActivitySignals(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(2)
+				mockedSerializer.EXPECT().SerializeActivityResultSearchAttributes(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(2)
+				mockedSerializer.EXPECT().SerializeActivityRescheduleReasons(gomock.Nil(), common.EncodingTypeThriftRW).Return(nil, nil).Times(2)
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowScheduledEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), common.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleCheckSumData(), nil).Times(2)
@@ -82,7 +82,13 @@ const (
 		`expiration_seconds: ?, ` +
 		`search_attributes: ?, ` +
 		`memo: ?, ` +
-		`partition_config: ? ` +
+		`partition_config: ?, ` +
+		`minimum_interval: ?, ` +
+		`counters: ?, ` +
+		`notify_on_activity_batch_complete: ?, ` +
+		`activity_batch_completed: ?, ` +
+		`activity_batch_notified_started_id: ?, ` +
+		`priority: ? ` +
 		`}`
 
 	templateTransferTaskType = `{` +
@@ -168,6 +174,45 @@ const (
 		`last_failure_reason: ?, ` +
 		`last_worker_identity: ?, ` +
 		`last_failure_details: ?, ` +
+		`routing_key: ?, ` +
+		`visibility_timeout: ?, ` +
+		`prefetch_leased: ?, ` +
+		`fallback_task_list: ?, ` +
+		`schedule_to_start_timeouts: ?, ` +
+		`encryption_key_id: ?, ` +
+		`next_activity: ?, ` +
+		`at_most_once: ?, ` +
+		`depends_on_activity_id: ?, ` +
+		`on_dependency_failure: ?, ` +
+		`task_list_escalation: ?, ` +
+		`first_attempt_start_to_close_timeout: ?, ` +
+		`max_heartbeat_gap: ?, ` +
+		`granted_start_to_close_timeout: ?, ` +
+		`max_total_execution_seconds: ?, ` +
+		`total_execution_time: ?, ` +
+		`heartbeat_sequence: ?, ` +
+		`minimum_interval: ?, ` +
+		`cancel_requested_time: ?, ` +
+		`cancel_ack_timeout: ?, ` +
+		`cancel_force_timeout: ?, ` +
+		`cancel_ack_timeout_exceeded_time: ?, ` +
+		`maintenance_paused_time: ?, ` +
+		`maintenance_timeouts_deferred: ?, ` +
+		`nack_count: ?, ` +
+		`last_nack_reason: ?, ` +
+		`cancellation_checkpoint_grace: ?, ` +
+		`cancel_delivered_time: ?, ` +
+		`alert_on_failure: ?, ` +
+		`steal_timeout: ?, ` +
+		`idempotent: ?, ` +
+		`progress_percent: ?, ` +
+		`stalled_heartbeats: ?, ` +
+		`heartbeat_expired_time: ?, ` +
+		`pending_signals: ?, ` +
+		`search_attributes: ?, ` +
+		`reschedule_reasons: ?, ` +
+		`result_search_attributes: ?, ` +
+		`priority: ?, ` +
 		`event_data_encoding: ?` +
 		`}`
 
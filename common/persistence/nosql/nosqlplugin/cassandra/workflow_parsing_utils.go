@@ -175,6 +175,18 @@ func parseWorkflowExecutionInfo(result map[string]interface{}) *persistence.Inte
 			info.Memo = v.(map[string][]byte)
 		case "partition_config":
 			info.PartitionConfig = v.(map[string]string)
+		case "minimum_interval":
+			info.MinimumInterval = int32(v.(int))
+		case "counters":
+			info.Counters = v.(map[string]int64)
+		case "notify_on_activity_batch_complete":
+			info.NotifyOnActivityBatchComplete = v.(bool)
+		case "activity_batch_completed":
+			info.ActivityBatchCompleted = v.(bool)
+		case "activity_batch_notified_started_id":
+			info.ActivityBatchNotifiedStartedID = v.(int64)
+		case "priority":
+			info.Priority = priorityFromCassandra(v.(int))
 		}
 	}
 	info.CompletionEvent = persistence.NewDataBlob(completionEventData, completionEventEncoding)
@@ -231,6 +243,22 @@ func parseReplicationInfo(
 	return info
 }
 
+// progressPercentFromCassandra reads -1 back as an attempt which reported no progress
+func progressPercentFromCassandra(progressPercent int) *int32 {
+	if progressPercent < 0 {
+		return nil
+	}
+	return common.Int32Ptr(int32(progressPercent))
+}
+
+// priorityFromCassandra reads -1 back as a workflow or activity which has no priority
+func priorityFromCassandra(priority int) *int32 {
+	if priority < 0 {
+		return nil
+	}
+	return common.Int32Ptr(int32(priority))
+}
+
 func parseActivityInfo(
 	domainID string,
 	result map[string]interface{},
@@ -238,7 +266,7 @@ func parseActivityInfo(
 
 	info := &persistence.InternalActivityInfo{}
 	var sharedEncoding common.EncodingType
-	var scheduledEventData, startedEventData []byte
+	var scheduledEventData, startedEventData, nextActivityData, pendingSignalsData, rescheduleReasonsData, resultSearchAttributesData []byte
 	for k, v := range result {
 		switch k {
 		case "version":
@@ -305,6 +333,84 @@ func parseActivityInfo(
 			info.LastWorkerIdentity = v.(string)
 		case "last_failure_details":
 			info.LastFailureDetails = v.([]byte)
+		case "routing_key":
+			info.RoutingKey = v.(string)
+		case "visibility_timeout":
+			info.VisibilityTimeout = int32(v.(int))
+		case "prefetch_leased":
+			info.PrefetchLeased = v.(bool)
+		case "fallback_task_list":
+			info.FallbackTaskList = v.(string)
+		case "schedule_to_start_timeouts":
+			info.ScheduleToStartTimeouts = int32(v.(int))
+		case "encryption_key_id":
+			info.EncryptionKeyID = v.(string)
+		case "next_activity":
+			nextActivityData = v.([]byte)
+		case "at_most_once":
+			info.AtMostOnce = v.(bool)
+		case "depends_on_activity_id":
+			info.DependsOnActivityID = v.(string)
+		case "on_dependency_failure":
+			info.OnDependencyFailure = types.ActivityDependencyFailurePolicy(v.(int))
+		case "task_list_escalation":
+			info.TaskListEscalation = v.([]string)
+		case "first_attempt_start_to_close_timeout":
+			info.FirstAttemptStartToCloseTimeout = int32(v.(int))
+		case "max_heartbeat_gap":
+			info.MaxHeartbeatGap = time.Duration(v.(int64))
+		case "granted_start_to_close_timeout":
+			info.GrantedStartToCloseTimeout = int32(v.(int))
+		case "max_total_execution_seconds":
+			info.MaxTotalExecutionSeconds = int32(v.(int))
+		case "total_execution_time":
+			info.TotalExecutionTime = time.Duration(v.(int64))
+		case "heartbeat_sequence":
+			info.HeartbeatSequence = v.(int64)
+		case "minimum_interval":
+			info.MinimumInterval = int32(v.(int))
+		case "cancel_requested_time":
+			info.CancelRequestedTime = v.(time.Time)
+		case "cancel_ack_timeout":
+			info.CancelAckTimeout = int32(v.(int))
+		case "cancel_force_timeout":
+			info.CancelForceTimeout = int32(v.(int))
+		case "cancel_ack_timeout_exceeded_time":
+			info.CancelAckTimeoutExceededTime = v.(time.Time)
+		case "maintenance_paused_time":
+			info.MaintenancePausedTime = time.Duration(v.(int64))
+		case "maintenance_timeouts_deferred":
+			info.MaintenanceTimeoutsDeferred = int32(v.(int))
+		case "nack_count":
+			info.NackCount = int32(v.(int))
+		case "last_nack_reason":
+			info.LastNackReason = v.(string)
+		case "cancellation_checkpoint_grace":
+			info.CancellationCheckpointGrace = int32(v.(int))
+		case "cancel_delivered_time":
+			info.CancelDeliveredTime = v.(time.Time)
+		case "alert_on_failure":
+			info.AlertOnFailure = v.(string)
+		case "steal_timeout":
+			info.StealTimeout = int32(v.(int))
+		case "idempotent":
+			info.Idempotent = v.(bool)
+		case "progress_percent":
+			info.ProgressPercent = progressPercentFromCassandra(v.(int))
+		case "stalled_heartbeats":
+			info.StalledHeartbeats = int32(v.(int))
+		case "heartbeat_expired_time":
+			info.HeartbeatExpiredTime = v.(time.Time)
+		case "pending_signals":
+			pendingSignalsData = v.([]byte)
+		case "search_attributes":
+			info.SearchAttributes = v.(map[string][]byte)
+		case "reschedule_reasons":
+			rescheduleReasonsData = v.([]byte)
+		case "result_search_attributes":
+			resultSearchAttributesData = v.([]byte)
+		case "priority":
+			info.Priority = priorityFromCassandra(v.(int))
 		case "event_data_encoding":
 			sharedEncoding = common.EncodingType(v.(string))
 		}
@@ -312,6 +418,10 @@ func parseActivityInfo(
 	info.DomainID = domainID
 	info.ScheduledEvent = persistence.NewDataBlob(scheduledEventData, sharedEncoding)
 	info.StartedEvent = persistence.NewDataBlob(startedEventData, sharedEncoding)
+	info.NextActivity = persistence.NewDataBlob(nextActivityData, sharedEncoding)
+	info.PendingSignals = persistence.NewDataBlob(pendingSignalsData, sharedEncoding)
+	info.ResultSearchAttributes = persistence.NewDataBlob(resultSearchAttributesData, sharedEncoding)
+	info.RescheduleReasons = persistence.NewDataBlob(rescheduleReasonsData, sharedEncoding)
 
 	return info
 }
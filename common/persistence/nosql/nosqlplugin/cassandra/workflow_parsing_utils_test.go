@@ -114,6 +114,12 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 				"search_attributes":                     searchAttributes,
 				"memo":                                  memo,
 				"partition_config":                      partitionConfig,
+				"priority":                              -1,
+				"activity_batch_notified_started_id":    int64(17),
+				"activity_batch_completed":              true,
+				"notify_on_activity_batch_complete":     true,
+				"counters":                              map[string]int64{"completed": 2},
+				"minimum_interval":                      15,
 				"completion_event":                      completionEventData,
 				"completion_event_data_encoding":        "Proto3",
 				"auto_reset_points":                     autoResetPointsData,
@@ -168,6 +174,12 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 				NonRetriableErrors:                 []string{"error1", "error2"},
 				Memo:                               memo,
 				PartitionConfig:                    partitionConfig,
+				Priority:                           nil,
+				ActivityBatchNotifiedStartedID:     int64(17),
+				ActivityBatchCompleted:             true,
+				NotifyOnActivityBatchComplete:      true,
+				Counters:                           map[string]int64{"completed": 2},
+				MinimumInterval:                    15,
 			},
 		},
 		{
@@ -291,75 +303,153 @@ func Test_parseReplicationState(t *testing.T) {
 func Test_parseActivityInfo(t *testing.T) {
 	timeNow := time.Now()
 	testInput := map[string]interface{}{
-		"version":                   int64(1),
-		"schedule_id":               int64(2),
-		"scheduled_event_batch_id":  int64(3),
-		"scheduled_event":           []byte("scheduled_event"),
-		"scheduled_time":            timeNow,
-		"started_id":                int64(4),
-		"started_event":             []byte("started_event"),
-		"started_time":              timeNow,
-		"activity_id":               "activity_id",
-		"request_id":                "request_id",
-		"details":                   []byte("details"),
-		"schedule_to_start_timeout": 5,
-		"schedule_to_close_timeout": 6,
-		"start_to_close_timeout":    7,
-		"heart_beat_timeout":        8,
-		"cancel_requested":          true,
-		"cancel_request_id":         int64(9),
-		"last_hb_updated_time":      timeNow,
-		"timer_task_status":         9,
-		"attempt":                   10,
-		"task_list":                 "task_list",
-		"started_identity":          "started_identity",
-		"has_retry_policy":          true,
-		"init_interval":             11,
-		"backoff_coefficient":       1.5,
-		"max_interval":              12,
-		"max_attempts":              13,
-		"expiration_time":           timeNow,
-		"non_retriable_errors":      []string{"error1", "error2"},
-		"last_failure_reason":       "last_failure_reason",
-		"last_worker_identity":      "last_worker_identity",
-		"last_failure_details":      []byte("last_failure_details"),
-		"event_data_encoding":       "Proto3",
+		"version":                              int64(1),
+		"schedule_id":                          int64(2),
+		"scheduled_event_batch_id":             int64(3),
+		"scheduled_event":                      []byte("scheduled_event"),
+		"scheduled_time":                       timeNow,
+		"started_id":                           int64(4),
+		"started_event":                        []byte("started_event"),
+		"started_time":                         timeNow,
+		"activity_id":                          "activity_id",
+		"request_id":                           "request_id",
+		"details":                              []byte("details"),
+		"schedule_to_start_timeout":            5,
+		"schedule_to_close_timeout":            6,
+		"start_to_close_timeout":               7,
+		"heart_beat_timeout":                   8,
+		"cancel_requested":                     true,
+		"cancel_request_id":                    int64(9),
+		"last_hb_updated_time":                 timeNow,
+		"timer_task_status":                    9,
+		"attempt":                              10,
+		"task_list":                            "task_list",
+		"started_identity":                     "started_identity",
+		"has_retry_policy":                     true,
+		"init_interval":                        11,
+		"backoff_coefficient":                  1.5,
+		"max_interval":                         12,
+		"max_attempts":                         13,
+		"expiration_time":                      timeNow,
+		"non_retriable_errors":                 []string{"error1", "error2"},
+		"last_failure_reason":                  "last_failure_reason",
+		"last_worker_identity":                 "last_worker_identity",
+		"last_failure_details":                 []byte("last_failure_details"),
+		"routing_key":                          "routing_key",
+		"visibility_timeout":                   30,
+		"prefetch_leased":                      true,
+		"fallback_task_list":                   "fallback_task_list",
+		"schedule_to_start_timeouts":           2,
+		"encryption_key_id":                    "encryption_key_id",
+		"next_activity":                        []byte("next_activity"),
+		"at_most_once":                         true,
+		"depends_on_activity_id":               "depends_on_activity_id",
+		"on_dependency_failure":                1,
+		"task_list_escalation":                 []string{"fast", "fallback"},
+		"first_attempt_start_to_close_timeout": 1,
+		"max_heartbeat_gap":                    int64(time.Second),
+		"granted_start_to_close_timeout":       1,
+		"max_total_execution_seconds":          1,
+		"total_execution_time":                 int64(time.Second),
+		"heartbeat_sequence":                   int64(1),
+		"minimum_interval":                     1,
+		"cancel_requested_time":                time.Unix(1, 0),
+		"cancel_ack_timeout":                   1,
+		"cancel_force_timeout":                 1,
+		"cancel_ack_timeout_exceeded_time":     time.Unix(1, 0),
+		"maintenance_paused_time":              int64(time.Second),
+		"maintenance_timeouts_deferred":        1,
+		"nack_count":                           1,
+		"last_nack_reason":                     "a",
+		"cancellation_checkpoint_grace":        1,
+		"cancel_delivered_time":                time.Unix(1, 0),
+		"alert_on_failure":                     "a",
+		"steal_timeout":                        1,
+		"idempotent":                           true,
+		"progress_percent":                     50,
+		"stalled_heartbeats":                   1,
+		"heartbeat_expired_time":               time.Unix(1, 0),
+		"pending_signals":                      []byte("pending_signals"),
+		"search_attributes":                    map[string][]byte{"key": []byte("value")},
+		"reschedule_reasons":                   []byte("reschedule_reasons"),
+		"result_search_attributes":             []byte("result_search_attributes"),
+		"priority":                             -1,
+		"event_data_encoding":                  "Proto3",
 	}
 
 	expected := &persistence.InternalActivityInfo{
-		Version:                  int64(1),
-		ScheduleID:               int64(2),
-		ScheduledEventBatchID:    int64(3),
-		ScheduledEvent:           persistence.NewDataBlob([]byte("scheduled_event"), "Proto3"),
-		ScheduledTime:            timeNow,
-		StartedID:                int64(4),
-		StartedEvent:             persistence.NewDataBlob([]byte("started_event"), "Proto3"),
-		StartedTime:              timeNow,
-		ActivityID:               "activity_id",
-		RequestID:                "request_id",
-		Details:                  []byte("details"),
-		ScheduleToStartTimeout:   common.SecondsToDuration(int64(5)),
-		ScheduleToCloseTimeout:   common.SecondsToDuration(int64(6)),
-		StartToCloseTimeout:      common.SecondsToDuration(int64(7)),
-		HeartbeatTimeout:         common.SecondsToDuration(int64(8)),
-		CancelRequested:          true,
-		CancelRequestID:          int64(9),
-		LastHeartBeatUpdatedTime: timeNow,
-		TimerTaskStatus:          int32(9),
-		Attempt:                  int32(10),
-		TaskList:                 "task_list",
-		StartedIdentity:          "started_identity",
-		HasRetryPolicy:           true,
-		InitialInterval:          common.SecondsToDuration(int64(11)),
-		BackoffCoefficient:       1.5,
-		MaximumInterval:          common.SecondsToDuration(int64(12)),
-		MaximumAttempts:          int32(13),
-		ExpirationTime:           timeNow,
-		NonRetriableErrors:       []string{"error1", "error2"},
-		LastFailureReason:        "last_failure_reason",
-		LastWorkerIdentity:       "last_worker_identity",
-		LastFailureDetails:       []byte("last_failure_details"),
-		DomainID:                 "domain_id",
+		Version:                         int64(1),
+		ScheduleID:                      int64(2),
+		ScheduledEventBatchID:           int64(3),
+		ScheduledEvent:                  persistence.NewDataBlob([]byte("scheduled_event"), "Proto3"),
+		ScheduledTime:                   timeNow,
+		StartedID:                       int64(4),
+		StartedEvent:                    persistence.NewDataBlob([]byte("started_event"), "Proto3"),
+		StartedTime:                     timeNow,
+		ActivityID:                      "activity_id",
+		RequestID:                       "request_id",
+		Details:                         []byte("details"),
+		ScheduleToStartTimeout:          common.SecondsToDuration(int64(5)),
+		ScheduleToCloseTimeout:          common.SecondsToDuration(int64(6)),
+		StartToCloseTimeout:             common.SecondsToDuration(int64(7)),
+		HeartbeatTimeout:                common.SecondsToDuration(int64(8)),
+		CancelRequested:                 true,
+		CancelRequestID:                 int64(9),
+		LastHeartBeatUpdatedTime:        timeNow,
+		TimerTaskStatus:                 int32(9),
+		Attempt:                         int32(10),
+		TaskList:                        "task_list",
+		StartedIdentity:                 "started_identity",
+		HasRetryPolicy:                  true,
+		InitialInterval:                 common.SecondsToDuration(int64(11)),
+		BackoffCoefficient:              1.5,
+		MaximumInterval:                 common.SecondsToDuration(int64(12)),
+		MaximumAttempts:                 int32(13),
+		ExpirationTime:                  timeNow,
+		NonRetriableErrors:              []string{"error1", "error2"},
+		LastFailureReason:               "last_failure_reason",
+		LastWorkerIdentity:              "last_worker_identity",
+		LastFailureDetails:              []byte("last_failure_details"),
+		RoutingKey:                      "routing_key",
+		VisibilityTimeout:               30,
+		PrefetchLeased:                  true,
+		FallbackTaskList:                "fallback_task_list",
+		ScheduleToStartTimeouts:         2,
+		EncryptionKeyID:                 "encryption_key_id",
+		NextActivity:                    persistence.NewDataBlob([]byte("next_activity"), "Proto3"),
+		AtMostOnce:                      true,
+		DependsOnActivityID:             "depends_on_activity_id",
+		OnDependencyFailure:             1,
+		TaskListEscalation:              []string{"fast", "fallback"},
+		FirstAttemptStartToCloseTimeout: 1,
+		MaxHeartbeatGap:                 time.Second,
+		GrantedStartToCloseTimeout:      1,
+		MaxTotalExecutionSeconds:        1,
+		TotalExecutionTime:              time.Second,
+		HeartbeatSequence:               1,
+		MinimumInterval:                 1,
+		CancelRequestedTime:             time.Unix(1, 0),
+		CancelAckTimeout:                1,
+		CancelForceTimeout:              1,
+		CancelAckTimeoutExceededTime:    time.Unix(1, 0),
+		MaintenancePausedTime:           time.Second,
+		MaintenanceTimeoutsDeferred:     1,
+		NackCount:                       1,
+		LastNackReason:                  "a",
+		CancellationCheckpointGrace:     1,
+		CancelDeliveredTime:             time.Unix(1, 0),
+		AlertOnFailure:                  "a",
+		StealTimeout:                    1,
+		Idempotent:                      true,
+		ProgressPercent:                 common.Int32Ptr(50),
+		StalledHeartbeats:               1,
+		HeartbeatExpiredTime:            time.Unix(1, 0),
+		PendingSignals:                  persistence.NewDataBlob([]byte("pending_signals"), common.EncodingTypeThriftRW),
+		SearchAttributes:                map[string][]byte{"key": []byte("value")},
+		RescheduleReasons:               persistence.NewDataBlob([]byte("reschedule_reasons"), common.EncodingTypeThriftRW),
+		ResultSearchAttributes:          persistence.NewDataBlob([]byte("result_search_attributes"), common.EncodingTypeThriftRW),
+		Priority:                        nil,
+		DomainID:                        "domain_id",
 	}
 
 	assert.Equal(t, expected, parseActivityInfo("domain_id", testInput))
@@ -1041,6 +1041,45 @@ func resetActivityInfoMap(activityInfos map[int64]*persistence.InternalActivityI
 		aInfo["last_failure_reason"] = a.LastFailureReason
 		aInfo["last_worker_identity"] = a.LastWorkerIdentity
 		aInfo["last_failure_details"] = a.LastFailureDetails
+		aInfo["routing_key"] = a.RoutingKey
+		aInfo["visibility_timeout"] = a.VisibilityTimeout
+		aInfo["prefetch_leased"] = a.PrefetchLeased
+		aInfo["fallback_task_list"] = a.FallbackTaskList
+		aInfo["schedule_to_start_timeouts"] = a.ScheduleToStartTimeouts
+		aInfo["encryption_key_id"] = a.EncryptionKeyID
+		aInfo["next_activity"] = a.NextActivity.GetData()
+		aInfo["at_most_once"] = a.AtMostOnce
+		aInfo["depends_on_activity_id"] = a.DependsOnActivityID
+		aInfo["on_dependency_failure"] = int32(a.OnDependencyFailure)
+		aInfo["task_list_escalation"] = a.TaskListEscalation
+		aInfo["first_attempt_start_to_close_timeout"] = a.FirstAttemptStartToCloseTimeout
+		aInfo["max_heartbeat_gap"] = int64(a.MaxHeartbeatGap)
+		aInfo["granted_start_to_close_timeout"] = a.GrantedStartToCloseTimeout
+		aInfo["max_total_execution_seconds"] = a.MaxTotalExecutionSeconds
+		aInfo["total_execution_time"] = int64(a.TotalExecutionTime)
+		aInfo["heartbeat_sequence"] = a.HeartbeatSequence
+		aInfo["minimum_interval"] = a.MinimumInterval
+		aInfo["cancel_requested_time"] = a.CancelRequestedTime
+		aInfo["cancel_ack_timeout"] = a.CancelAckTimeout
+		aInfo["cancel_force_timeout"] = a.CancelForceTimeout
+		aInfo["cancel_ack_timeout_exceeded_time"] = a.CancelAckTimeoutExceededTime
+		aInfo["maintenance_paused_time"] = int64(a.MaintenancePausedTime)
+		aInfo["maintenance_timeouts_deferred"] = a.MaintenanceTimeoutsDeferred
+		aInfo["nack_count"] = a.NackCount
+		aInfo["last_nack_reason"] = a.LastNackReason
+		aInfo["cancellation_checkpoint_grace"] = a.CancellationCheckpointGrace
+		aInfo["cancel_delivered_time"] = a.CancelDeliveredTime
+		aInfo["alert_on_failure"] = a.AlertOnFailure
+		aInfo["steal_timeout"] = a.StealTimeout
+		aInfo["idempotent"] = a.Idempotent
+		aInfo["progress_percent"] = progressPercentToCassandra(a.ProgressPercent)
+		aInfo["stalled_heartbeats"] = a.StalledHeartbeats
+		aInfo["heartbeat_expired_time"] = a.HeartbeatExpiredTime
+		aInfo["pending_signals"] = a.PendingSignals.GetData()
+		aInfo["search_attributes"] = a.SearchAttributes
+		aInfo["reschedule_reasons"] = a.RescheduleReasons.GetData()
+		aInfo["result_search_attributes"] = a.ResultSearchAttributes.GetData()
+		aInfo["priority"] = priorityToCassandra(a.Priority)
 
 		aMap[a.ScheduleID] = aInfo
 	}
@@ -1048,6 +1087,22 @@ func resetActivityInfoMap(activityInfos map[int64]*persistence.InternalActivityI
 	return aMap, nil
 }
 
+// progressPercentToCassandra writes -1 for an attempt which reported no progress, as a null int reads back as 0
+func progressPercentToCassandra(progressPercent *int32) int32 {
+	if progressPercent == nil {
+		return -1
+	}
+	return *progressPercent
+}
+
+// priorityToCassandra writes -1 for a workflow or activity which has no priority, as a null int reads back as 0
+func priorityToCassandra(priority *int32) int32 {
+	if priority == nil {
+		return -1
+	}
+	return *priority
+}
+
 func updateActivityInfos(
 	batch gocql.Batch,
 	shardID int,
@@ -1093,6 +1148,45 @@ func updateActivityInfos(
 			a.LastFailureReason,
 			a.LastWorkerIdentity,
 			a.LastFailureDetails,
+			a.RoutingKey,
+			a.VisibilityTimeout,
+			a.PrefetchLeased,
+			a.FallbackTaskList,
+			a.ScheduleToStartTimeouts,
+			a.EncryptionKeyID,
+			a.NextActivity.GetData(),
+			a.AtMostOnce,
+			a.DependsOnActivityID,
+			int32(a.OnDependencyFailure),
+			a.TaskListEscalation,
+			a.FirstAttemptStartToCloseTimeout,
+			int64(a.MaxHeartbeatGap),
+			a.GrantedStartToCloseTimeout,
+			a.MaxTotalExecutionSeconds,
+			int64(a.TotalExecutionTime),
+			a.HeartbeatSequence,
+			a.MinimumInterval,
+			a.CancelRequestedTime,
+			a.CancelAckTimeout,
+			a.CancelForceTimeout,
+			a.CancelAckTimeoutExceededTime,
+			int64(a.MaintenancePausedTime),
+			a.MaintenanceTimeoutsDeferred,
+			a.NackCount,
+			a.LastNackReason,
+			a.CancellationCheckpointGrace,
+			a.CancelDeliveredTime,
+			a.AlertOnFailure,
+			a.StealTimeout,
+			a.Idempotent,
+			progressPercentToCassandra(a.ProgressPercent),
+			a.StalledHeartbeats,
+			a.HeartbeatExpiredTime,
+			a.PendingSignals.GetData(),
+			a.SearchAttributes,
+			a.RescheduleReasons.GetData(),
+			a.ResultSearchAttributes.GetData(),
+			priorityToCassandra(a.Priority),
 			a.ScheduledEvent.GetEncodingString(),
 			timeStamp,
 			shardID,
@@ -1401,6 +1495,12 @@ func updateWorkflowExecution(
 		execution.SearchAttributes,
 		execution.Memo,
 		execution.PartitionConfig,
+		execution.MinimumInterval,
+		execution.Counters,
+		execution.NotifyOnActivityBatchComplete,
+		execution.ActivityBatchCompleted,
+		execution.ActivityBatchNotifiedStartedID,
+		priorityToCassandra(execution.Priority),
 		execution.NextEventID,
 		execution.VersionHistories.Data,
 		execution.VersionHistories.GetEncodingString(),
@@ -1499,6 +1599,12 @@ func createWorkflowExecution(
 		execution.SearchAttributes,
 		execution.Memo,
 		execution.PartitionConfig,
+		execution.MinimumInterval,
+		execution.Counters,
+		execution.NotifyOnActivityBatchComplete,
+		execution.ActivityBatchCompleted,
+		execution.ActivityBatchNotifiedStartedID,
+		priorityToCassandra(execution.Priority),
 		execution.NextEventID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
@@ -1992,26 +1992,26 @@ func TestResetActivityInfos(t *testing.T) {
 			wantQueries: []string{
 				`UPDATE executions SET activity_map = map[` +
 					`1:map[` +
-					`activity_id:activity1 attempt:3 backoff_coefficient:0 cancel_request_id:0 cancel_requested:false ` +
-					`details:[] event_data_encoding:thriftrw expiration_time:0001-01-01 00:00:00 +0000 UTC has_retry_policy:true ` +
-					`heart_beat_timeout:60 init_interval:0 last_failure_details:[] last_failure_reason:retry reason ` +
-					`last_hb_updated_time:0001-01-01 00:00:00 +0000 UTC last_worker_identity: max_attempts:5 max_interval:0 ` +
-					`non_retriable_errors:[] request_id: schedule_id:1 schedule_to_close_timeout:120 schedule_to_start_timeout:60 ` +
-					`scheduled_event:[116 104 114 105 102 116 45 101 110 99 111 100 101 100 45 115 99 104 101 100 117 108 101 100 45 101 118 101 110 116 45 100 97 116 97] ` +
-					`scheduled_event_batch_id:0 scheduled_time:2023-12-19 22:08:41 +0000 UTC start_to_close_timeout:180 ` +
+					`activity_id:activity1 alert_on_failure: at_most_once:false attempt:3 backoff_coefficient:0 cancel_ack_timeout:0 cancel_ack_timeout_exceeded_time:0001-01-01 00:00:00 +0000 UTC cancel_delivered_time:0001-01-01 00:00:00 +0000 UTC cancel_force_timeout:0 cancel_request_id:0 cancel_requested:false ` +
+					`cancel_requested_time:0001-01-01 00:00:00 +0000 UTC cancellation_checkpoint_grace:0 depends_on_activity_id: details:[] encryption_key_id: event_data_encoding:thriftrw expiration_time:0001-01-01 00:00:00 +0000 UTC fallback_task_list: first_attempt_start_to_close_timeout:0 granted_start_to_close_timeout:0 has_retry_policy:true ` +
+					`heart_beat_timeout:60 heartbeat_expired_time:0001-01-01 00:00:00 +0000 UTC heartbeat_sequence:0 idempotent:false init_interval:0 last_failure_details:[] last_failure_reason:retry reason ` +
+					`last_hb_updated_time:0001-01-01 00:00:00 +0000 UTC last_nack_reason: last_worker_identity: maintenance_paused_time:0 maintenance_timeouts_deferred:0 max_attempts:5 max_heartbeat_gap:0 max_interval:0 ` +
+					`max_total_execution_seconds:0 minimum_interval:0 nack_count:0 next_activity:[] non_retriable_errors:[] on_dependency_failure:0 pending_signals:[] prefetch_leased:false priority:-1 progress_percent:-1 request_id: reschedule_reasons:[] result_search_attributes:[] routing_key: schedule_id:1 schedule_to_close_timeout:120 schedule_to_start_timeout:60 ` +
+					`schedule_to_start_timeouts:0 scheduled_event:[116 104 114 105 102 116 45 101 110 99 111 100 101 100 45 115 99 104 101 100 117 108 101 100 45 101 118 101 110 116 45 100 97 116 97] ` +
+					`priority:-1 reschedule_reasons:[] result_search_attributes:[] scheduled_event_batch_id:0 scheduled_time:2023-12-19 22:08:41 +0000 UTC search_attributes:map[] stalled_heartbeats:0 start_to_close_timeout:180 ` +
 					`started_event:[116 104 114 105 102 116 45 101 110 99 111 100 101 100 45 115 116 97 114 116 101 100 45 101 118 101 110 116 45 100 97 116 97] ` +
-					`started_id:2 started_identity: started_time:0001-01-01 00:00:00 +0000 UTC task_list:tasklist1 timer_task_status:0 version:1` +
+					`started_id:2 started_identity: started_time:0001-01-01 00:00:00 +0000 UTC steal_timeout:0 task_list:tasklist1 task_list_escalation:[] timer_task_status:0 total_execution_time:0 version:1 visibility_timeout:0` +
 					`] ` +
 					`2:map[` +
-					`activity_id:activity2 attempt:1 backoff_coefficient:0 cancel_request_id:0 cancel_requested:false ` +
-					`details:[] event_data_encoding:thriftrw expiration_time:0001-01-01 00:00:00 +0000 UTC has_retry_policy:true ` +
-					`heart_beat_timeout:60 init_interval:0 last_failure_details:[] last_failure_reason:another retry reason ` +
-					`last_hb_updated_time:0001-01-01 00:00:00 +0000 UTC last_worker_identity: max_attempts:5 max_interval:0 ` +
-					`non_retriable_errors:[] request_id: schedule_id:2 schedule_to_close_timeout:120 schedule_to_start_timeout:60 ` +
-					`scheduled_event:[116 104 114 105 102 116 45 101 110 99 111 100 101 100 45 115 99 104 101 100 117 108 101 100 45 101 118 101 110 116 45 100 97 116 97] ` +
-					`scheduled_event_batch_id:0 scheduled_time:2023-12-19 22:08:41 +0000 UTC start_to_close_timeout:180 ` +
+					`activity_id:activity2 alert_on_failure: at_most_once:false attempt:1 backoff_coefficient:0 cancel_ack_timeout:0 cancel_ack_timeout_exceeded_time:0001-01-01 00:00:00 +0000 UTC cancel_delivered_time:0001-01-01 00:00:00 +0000 UTC cancel_force_timeout:0 cancel_request_id:0 cancel_requested:false ` +
+					`cancel_requested_time:0001-01-01 00:00:00 +0000 UTC cancellation_checkpoint_grace:0 depends_on_activity_id: details:[] encryption_key_id: event_data_encoding:thriftrw expiration_time:0001-01-01 00:00:00 +0000 UTC fallback_task_list: first_attempt_start_to_close_timeout:0 granted_start_to_close_timeout:0 has_retry_policy:true ` +
+					`heart_beat_timeout:60 heartbeat_expired_time:0001-01-01 00:00:00 +0000 UTC heartbeat_sequence:0 idempotent:false init_interval:0 last_failure_details:[] last_failure_reason:another retry reason ` +
+					`last_hb_updated_time:0001-01-01 00:00:00 +0000 UTC last_nack_reason: last_worker_identity: maintenance_paused_time:0 maintenance_timeouts_deferred:0 max_attempts:5 max_heartbeat_gap:0 max_interval:0 ` +
+					`max_total_execution_seconds:0 minimum_interval:0 nack_count:0 next_activity:[] non_retriable_errors:[] on_dependency_failure:0 pending_signals:[] prefetch_leased:false priority:-1 progress_percent:-1 request_id: reschedule_reasons:[] result_search_attributes:[] routing_key: schedule_id:2 schedule_to_close_timeout:120 schedule_to_start_timeout:60 ` +
+					`schedule_to_start_timeouts:0 scheduled_event:[116 104 114 105 102 116 45 101 110 99 111 100 101 100 45 115 99 104 101 100 117 108 101 100 45 101 118 101 110 116 45 100 97 116 97] ` +
+					`priority:-1 reschedule_reasons:[] result_search_attributes:[] scheduled_event_batch_id:0 scheduled_time:2023-12-19 22:08:41 +0000 UTC search_attributes:map[] stalled_heartbeats:0 start_to_close_timeout:180 ` +
 					`started_event:[116 104 114 105 102 116 45 101 110 99 111 100 101 100 45 115 116 97 114 116 101 100 45 101 118 101 110 116 45 100 97 116 97] ` +
-					`started_id:3 started_identity: started_time:0001-01-01 00:00:00 +0000 UTC task_list:tasklist1 timer_task_status:0 version:1` +
+					`started_id:3 started_identity: started_time:0001-01-01 00:00:00 +0000 UTC steal_timeout:0 task_list:tasklist1 task_list_escalation:[] timer_task_status:0 total_execution_time:0 version:1 visibility_timeout:0` +
 					`]` +
 					`] , last_updated_time = 2025-01-06T15:00:00Z WHERE ` +
 					`shard_id = 1000 and type = 1 and domain_id = domain1 and workflow_id = workflow1 and ` +
@@ -2098,7 +2098,7 @@ func TestUpdateActivityInfos(t *testing.T) {
 					`timer_task_status: 0, attempt: 3, task_list: tasklist1, started_identity: , has_retry_policy: true, ` +
 					`init_interval: 0, backoff_coefficient: 0, max_interval: 0, expiration_time: 0001-01-01T00:00:00Z, ` +
 					`max_attempts: 5, non_retriable_errors: [], last_failure_reason: retry reason, last_worker_identity: , ` +
-					`last_failure_details: [], event_data_encoding: thriftrw` +
+					`last_failure_details: [], routing_key: , visibility_timeout: 0, prefetch_leased: false, fallback_task_list: , schedule_to_start_timeouts: 0, encryption_key_id: , next_activity: [], at_most_once: false, depends_on_activity_id: , on_dependency_failure: 0, task_list_escalation: [], first_attempt_start_to_close_timeout: 0, max_heartbeat_gap: 0, granted_start_to_close_timeout: 0, max_total_execution_seconds: 0, total_execution_time: 0, heartbeat_sequence: 0, minimum_interval: 0, cancel_requested_time: 0001-01-01T00:00:00Z, cancel_ack_timeout: 0, cancel_force_timeout: 0, cancel_ack_timeout_exceeded_time: 0001-01-01T00:00:00Z, maintenance_paused_time: 0, maintenance_timeouts_deferred: 0, nack_count: 0, last_nack_reason: , cancellation_checkpoint_grace: 0, cancel_delivered_time: 0001-01-01T00:00:00Z, alert_on_failure: , steal_timeout: 0, idempotent: false, progress_percent: -1, stalled_heartbeats: 0, heartbeat_expired_time: 0001-01-01T00:00:00Z, pending_signals: [], search_attributes: map[], reschedule_reasons: [], result_search_attributes: [], priority: -1, event_data_encoding: thriftrw` +
 					`} , last_updated_time = 2025-01-06T15:00:00Z WHERE ` +
 					`shard_id = 1000 and type = 1 and domain_id = domain1 and workflow_id = workflow1 and ` +
 					`run_id = runid1 and visibility_ts = 946684800000 and task_id = -10 `,
@@ -2600,7 +2600,7 @@ func TestUpdateWorkflowExecution(t *testing.T) {
 					`client_feature_version: , client_impl: , auto_reset_points: [], auto_reset_points_encoding: , attempt: 0, has_retry_policy: false, ` +
 					`init_interval: 0, backoff_coefficient: 0, max_interval: 0, expiration_time: 0001-01-01T00:00:00Z, max_attempts: 0, ` +
 					`non_retriable_errors: [], event_store_version: 2, branch_token: [], cron_schedule: , expiration_seconds: 0, search_attributes: map[], ` +
-					`memo: map[], partition_config: map[] ` +
+					`memo: map[], partition_config: map[], minimum_interval: 0, counters: map[], notify_on_activity_batch_complete: false, activity_batch_completed: false, activity_batch_notified_started_id: 0, priority: -1 ` +
 					`}, next_event_id = 0 , version_histories = [] , version_histories_encoding =  , checksum = {version: 0, flavor: 0, value: [] }, workflow_last_write_version = 0 , workflow_state = 0 , last_updated_time = 2025-01-06T15:00:00Z ` +
 					`WHERE ` +
 					`shard_id = 1000 and type = 1 and domain_id = domain1 and workflow_id = workflow1 and ` +
@@ -2679,7 +2679,7 @@ func TestCreateWorkflowExecution(t *testing.T) {
 					`cancel_requested: false, cancel_request_id: , sticky_task_list: , sticky_schedule_to_start_timeout: 0,client_library_version: , client_feature_version: , ` +
 					`client_impl: , auto_reset_points: [], auto_reset_points_encoding: , attempt: 0, has_retry_policy: false, init_interval: 0, ` +
 					`backoff_coefficient: 0, max_interval: 0, expiration_time: 0001-01-01T00:00:00Z, max_attempts: 0, non_retriable_errors: [], ` +
-					`event_store_version: 2, branch_token: [], cron_schedule: , expiration_seconds: 0, search_attributes: map[], memo: map[], partition_config: map[] ` +
+					`event_store_version: 2, branch_token: [], cron_schedule: , expiration_seconds: 0, search_attributes: map[], memo: map[], partition_config: map[], minimum_interval: 0, counters: map[], notify_on_activity_batch_complete: false, activity_batch_completed: false, activity_batch_notified_started_id: 0, priority: -1 ` +
 					`}, 0, 946684800000, -10, [], , {version: 0, flavor: 0, value: [] }, 0, 0, 2025-01-06T15:00:00Z) IF NOT EXISTS `,
 			},
 		},
@@ -884,6 +884,54 @@ func (w *WorkflowExecutionInfo) GetChecksumEncoding() (o string) {
 	return
 }
 
+// GetMinimumInterval internal sql blob getter
+func (w *WorkflowExecutionInfo) GetMinimumInterval() (o int32) {
+	if w != nil {
+		return w.MinimumInterval
+	}
+	return
+}
+
+// GetCounters internal sql blob getter
+func (w *WorkflowExecutionInfo) GetCounters() (o map[string]int64) {
+	if w != nil {
+		return w.Counters
+	}
+	return
+}
+
+// GetNotifyOnActivityBatchComplete internal sql blob getter
+func (w *WorkflowExecutionInfo) GetNotifyOnActivityBatchComplete() (o bool) {
+	if w != nil {
+		return w.NotifyOnActivityBatchComplete
+	}
+	return
+}
+
+// GetActivityBatchCompleted internal sql blob getter
+func (w *WorkflowExecutionInfo) GetActivityBatchCompleted() (o bool) {
+	if w != nil {
+		return w.ActivityBatchCompleted
+	}
+	return
+}
+
+// GetActivityBatchNotifiedStartedID internal sql blob getter
+func (w *WorkflowExecutionInfo) GetActivityBatchNotifiedStartedID() (o int64) {
+	if w != nil {
+		return w.ActivityBatchNotifiedStartedID
+	}
+	return
+}
+
+// GetPriority internal sql blob getter
+func (w *WorkflowExecutionInfo) GetPriority() (o *int32) {
+	if w != nil {
+		return w.Priority
+	}
+	return
+}
+
 // GetVersion internal sql blob getter
 func (a *ActivityInfo) GetVersion() (o int64) {
 	if a != nil {
@@ -1132,6 +1180,350 @@ func (a *ActivityInfo) GetRetryNonRetryableErrors() (o []string) {
 	return
 }
 
+// GetRoutingKey internal sql blob getter
+func (a *ActivityInfo) GetRoutingKey() (o string) {
+	if a != nil {
+		return a.RoutingKey
+	}
+	return
+}
+
+// GetVisibilityTimeout internal sql blob getter
+func (a *ActivityInfo) GetVisibilityTimeout() (o int32) {
+	if a != nil {
+		return a.VisibilityTimeout
+	}
+	return
+}
+
+// GetPrefetchLeased internal sql blob getter
+func (a *ActivityInfo) GetPrefetchLeased() (o bool) {
+	if a != nil {
+		return a.PrefetchLeased
+	}
+	return
+}
+
+// GetFallbackTaskList internal sql blob getter
+func (a *ActivityInfo) GetFallbackTaskList() (o string) {
+	if a != nil {
+		return a.FallbackTaskList
+	}
+	return
+}
+
+// GetScheduleToStartTimeouts internal sql blob getter
+func (a *ActivityInfo) GetScheduleToStartTimeouts() (o int32) {
+	if a != nil {
+		return a.ScheduleToStartTimeouts
+	}
+	return
+}
+
+// GetEncryptionKeyID internal sql blob getter
+func (a *ActivityInfo) GetEncryptionKeyID() (o string) {
+	if a != nil {
+		return a.EncryptionKeyID
+	}
+	return
+}
+
+// GetNextActivity internal sql blob getter
+func (a *ActivityInfo) GetNextActivity() (o []byte) {
+	if a != nil {
+		return a.NextActivity
+	}
+	return
+}
+
+// GetNextActivityEncoding internal sql blob getter
+func (a *ActivityInfo) GetNextActivityEncoding() (o string) {
+	if a != nil {
+		return a.NextActivityEncoding
+	}
+	return
+}
+
+// GetAtMostOnce internal sql blob getter
+func (a *ActivityInfo) GetAtMostOnce() (o bool) {
+	if a != nil {
+		return a.AtMostOnce
+	}
+	return
+}
+
+// GetDependsOnActivityID internal sql blob getter
+func (a *ActivityInfo) GetDependsOnActivityID() (o string) {
+	if a != nil {
+		return a.DependsOnActivityID
+	}
+	return
+}
+
+// GetOnDependencyFailure internal sql blob getter
+func (a *ActivityInfo) GetOnDependencyFailure() (o int32) {
+	if a != nil {
+		return a.OnDependencyFailure
+	}
+	return
+}
+
+// GetTaskListEscalation internal sql blob getter
+func (a *ActivityInfo) GetTaskListEscalation() (o []string) {
+	if a != nil {
+		return a.TaskListEscalation
+	}
+	return
+}
+
+// GetFirstAttemptStartToCloseTimeout internal sql blob getter
+func (a *ActivityInfo) GetFirstAttemptStartToCloseTimeout() (o int32) {
+	if a != nil {
+		return a.FirstAttemptStartToCloseTimeout
+	}
+	return
+}
+
+// GetMaxHeartbeatGap internal sql blob getter
+func (a *ActivityInfo) GetMaxHeartbeatGap() (o time.Duration) {
+	if a != nil {
+		return a.MaxHeartbeatGap
+	}
+	return
+}
+
+// GetGrantedStartToCloseTimeout internal sql blob getter
+func (a *ActivityInfo) GetGrantedStartToCloseTimeout() (o int32) {
+	if a != nil {
+		return a.GrantedStartToCloseTimeout
+	}
+	return
+}
+
+// GetMaxTotalExecutionSeconds internal sql blob getter
+func (a *ActivityInfo) GetMaxTotalExecutionSeconds() (o int32) {
+	if a != nil {
+		return a.MaxTotalExecutionSeconds
+	}
+	return
+}
+
+// GetTotalExecutionTime internal sql blob getter
+func (a *ActivityInfo) GetTotalExecutionTime() (o time.Duration) {
+	if a != nil {
+		return a.TotalExecutionTime
+	}
+	return
+}
+
+// GetHeartbeatSequence internal sql blob getter
+func (a *ActivityInfo) GetHeartbeatSequence() (o int64) {
+	if a != nil {
+		return a.HeartbeatSequence
+	}
+	return
+}
+
+// GetMinimumInterval internal sql blob getter
+func (a *ActivityInfo) GetMinimumInterval() (o int32) {
+	if a != nil {
+		return a.MinimumInterval
+	}
+	return
+}
+
+// GetCancelRequestedTime internal sql blob getter
+func (a *ActivityInfo) GetCancelRequestedTime() time.Time {
+	if a != nil {
+		return a.CancelRequestedTime
+	}
+	return time.Unix(0, 0)
+}
+
+// GetCancelAckTimeout internal sql blob getter
+func (a *ActivityInfo) GetCancelAckTimeout() (o int32) {
+	if a != nil {
+		return a.CancelAckTimeout
+	}
+	return
+}
+
+// GetCancelForceTimeout internal sql blob getter
+func (a *ActivityInfo) GetCancelForceTimeout() (o int32) {
+	if a != nil {
+		return a.CancelForceTimeout
+	}
+	return
+}
+
+// GetCancelAckTimeoutExceededTime internal sql blob getter
+func (a *ActivityInfo) GetCancelAckTimeoutExceededTime() time.Time {
+	if a != nil {
+		return a.CancelAckTimeoutExceededTime
+	}
+	return time.Unix(0, 0)
+}
+
+// GetMaintenancePausedTime internal sql blob getter
+func (a *ActivityInfo) GetMaintenancePausedTime() (o time.Duration) {
+	if a != nil {
+		return a.MaintenancePausedTime
+	}
+	return
+}
+
+// GetMaintenanceTimeoutsDeferred internal sql blob getter
+func (a *ActivityInfo) GetMaintenanceTimeoutsDeferred() (o int32) {
+	if a != nil {
+		return a.MaintenanceTimeoutsDeferred
+	}
+	return
+}
+
+// GetNackCount internal sql blob getter
+func (a *ActivityInfo) GetNackCount() (o int32) {
+	if a != nil {
+		return a.NackCount
+	}
+	return
+}
+
+// GetLastNackReason internal sql blob getter
+func (a *ActivityInfo) GetLastNackReason() (o string) {
+	if a != nil {
+		return a.LastNackReason
+	}
+	return
+}
+
+// GetCancellationCheckpointGrace internal sql blob getter
+func (a *ActivityInfo) GetCancellationCheckpointGrace() (o int32) {
+	if a != nil {
+		return a.CancellationCheckpointGrace
+	}
+	return
+}
+
+// GetCancelDeliveredTime internal sql blob getter
+func (a *ActivityInfo) GetCancelDeliveredTime() time.Time {
+	if a != nil {
+		return a.CancelDeliveredTime
+	}
+	return time.Unix(0, 0)
+}
+
+// GetAlertOnFailure internal sql blob getter
+func (a *ActivityInfo) GetAlertOnFailure() (o string) {
+	if a != nil {
+		return a.AlertOnFailure
+	}
+	return
+}
+
+// GetStealTimeout internal sql blob getter
+func (a *ActivityInfo) GetStealTimeout() (o int32) {
+	if a != nil {
+		return a.StealTimeout
+	}
+	return
+}
+
+// GetIdempotent internal sql blob getter
+func (a *ActivityInfo) GetIdempotent() (o bool) {
+	if a != nil {
+		return a.Idempotent
+	}
+	return
+}
+
+// GetProgressPercent internal sql blob getter
+func (a *ActivityInfo) GetProgressPercent() (o *int32) {
+	if a != nil {
+		return a.ProgressPercent
+	}
+	return
+}
+
+// GetStalledHeartbeats internal sql blob getter
+func (a *ActivityInfo) GetStalledHeartbeats() (o int32) {
+	if a != nil {
+		return a.StalledHeartbeats
+	}
+	return
+}
+
+// GetHeartbeatExpiredTime internal sql blob getter
+func (a *ActivityInfo) GetHeartbeatExpiredTime() time.Time {
+	if a != nil {
+		return a.HeartbeatExpiredTime
+	}
+	return time.Unix(0, 0)
+}
+
+// GetPendingSignals internal sql blob getter
+func (a *ActivityInfo) GetPendingSignals() (o []byte) {
+	if a != nil {
+		return a.PendingSignals
+	}
+	return
+}
+
+// GetPendingSignalsEncoding internal sql blob getter
+func (a *ActivityInfo) GetPendingSignalsEncoding() (o string) {
+	if a != nil {
+		return a.PendingSignalsEncoding
+	}
+	return
+}
+
+// GetSearchAttributes internal sql blob getter
+func (a *ActivityInfo) GetSearchAttributes() (o map[string][]byte) {
+	if a != nil {
+		return a.SearchAttributes
+	}
+	return
+}
+
+// GetRescheduleReasons internal sql blob getter
+func (a *ActivityInfo) GetRescheduleReasons() (o []byte) {
+	if a != nil {
+		return a.RescheduleReasons
+	}
+	return
+}
+
+// GetRescheduleReasonsEncoding internal sql blob getter
+func (a *ActivityInfo) GetRescheduleReasonsEncoding() (o string) {
+	if a != nil {
+		return a.RescheduleReasonsEncoding
+	}
+	return
+}
+
+// GetResultSearchAttributes internal sql blob getter
+func (a *ActivityInfo) GetResultSearchAttributes() (o []byte) {
+	if a != nil {
+		return a.ResultSearchAttributes
+	}
+	return
+}
+
+// GetResultSearchAttributesEncoding internal sql blob getter
+func (a *ActivityInfo) GetResultSearchAttributesEncoding() (o string) {
+	if a != nil {
+		return a.ResultSearchAttributesEncoding
+	}
+	return
+}
+
+// GetPriority internal sql blob getter
+func (a *ActivityInfo) GetPriority() (o *int32) {
+	if a != nil {
+		return a.Priority
+	}
+	return
+}
+
 // GetVersion internal sql blob getter
 func (c *ChildExecutionInfo) GetVersion() (o int64) {
 	if c != nil {
@@ -25,6 +25,7 @@ package serialization
 import (
 	"time"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -34,6 +35,8 @@ var (
 
 var expectedNil = map[string]map[string]any{
 	"*serialization.WorkflowExecutionInfo": {
+		"GetActivityBatchCompleted":             false,
+		"GetActivityBatchNotifiedStartedID":     int64(0),
 		"GetAutoResetPoints":                    []uint8(nil),
 		"GetAutoResetPointsEncoding":            "",
 		"GetCancelRequestID":                    "",
@@ -44,6 +47,7 @@ var expectedNil = map[string]map[string]any{
 		"GetCloseStatus":                        int32(0),
 		"GetCompletionEvent":                    []uint8(nil),
 		"GetCompletionEventEncoding":            "",
+		"GetCounters":                           map[string]int64(nil),
 		"GetCreateRequestID":                    "",
 		"GetCompletionEventBatchID":             int64(0),
 		"GetCronSchedule":                       "",
@@ -58,7 +62,10 @@ var expectedNil = map[string]map[string]any{
 		"GetDecisionTimeout":                    time.Duration(0),
 		"GetLastFirstEventID":                   int64(0),
 		"GetLastProcessedEvent":                 int64(0),
+		"GetMinimumInterval":                    int32(0),
+		"GetNotifyOnActivityBatchComplete":      false,
 		"GetParentWorkflowID":                   "",
+		"GetPriority":                           (*int32)(nil),
 		"GetStartVersion":                       int64(0),
 		"GetTaskList":                           "",
 		"GetDecisionVersion":                    int64(0),
@@ -188,37 +195,80 @@ var expectedNil = map[string]map[string]any{
 		"GetWorkflowTypeName":       "",
 	},
 	"*serialization.ActivityInfo": {
-		"GetActivityID":               "",
-		"GetAttempt":                  int32(0),
-		"GetCancelRequestID":          int64(0),
-		"GetCancelRequested":          false,
-		"GetHasRetryPolicy":           false,
-		"GetHeartbeatTimeout":         time.Duration(0),
-		"GetRequestID":                "",
-		"GetRetryBackoffCoefficient":  float64(0),
-		"GetRetryExpirationTimestamp": zeroUnix,
-		"GetRetryInitialInterval":     time.Duration(0),
-		"GetRetryLastFailureDetails":  []uint8(nil),
-		"GetRetryLastFailureReason":   "",
-		"GetRetryLastWorkerIdentity":  "",
-		"GetRetryMaximumAttempts":     int32(0),
-		"GetRetryMaximumInterval":     time.Duration(0),
-		"GetRetryNonRetryableErrors":  []string(nil),
-		"GetScheduleToCloseTimeout":   time.Duration(0),
-		"GetScheduleToStartTimeout":   time.Duration(0),
-		"GetScheduledEvent":           []uint8(nil),
-		"GetScheduledEventBatchID":    int64(0),
-		"GetScheduledEventEncoding":   "",
-		"GetScheduledTimestamp":       zeroUnix,
-		"GetStartToCloseTimeout":      time.Duration(0),
-		"GetStartedEvent":             []uint8(nil),
-		"GetStartedEventEncoding":     "",
-		"GetStartedID":                int64(0),
-		"GetStartedIdentity":          "",
-		"GetStartedTimestamp":         zeroUnix,
-		"GetTaskList":                 "",
-		"GetTimerTaskStatus":          int32(0),
-		"GetVersion":                  int64(0),
+		"GetActivityID":                      "",
+		"GetAlertOnFailure":                  "",
+		"GetAtMostOnce":                      false,
+		"GetAttempt":                         int32(0),
+		"GetCancelAckTimeout":                int32(0),
+		"GetCancelAckTimeoutExceededTime":    zeroUnix,
+		"GetCancelDeliveredTime":             zeroUnix,
+		"GetCancelForceTimeout":              int32(0),
+		"GetCancelRequestID":                 int64(0),
+		"GetCancelRequested":                 false,
+		"GetCancelRequestedTime":             zeroUnix,
+		"GetCancellationCheckpointGrace":     int32(0),
+		"GetDependsOnActivityID":             "",
+		"GetEncryptionKeyID":                 "",
+		"GetFallbackTaskList":                "",
+		"GetFirstAttemptStartToCloseTimeout": int32(0),
+		"GetGrantedStartToCloseTimeout":      int32(0),
+		"GetHasRetryPolicy":                  false,
+		"GetHeartbeatExpiredTime":            zeroUnix,
+		"GetHeartbeatSequence":               int64(0),
+		"GetHeartbeatTimeout":                time.Duration(0),
+		"GetIdempotent":                      false,
+		"GetLastNackReason":                  "",
+		"GetMaintenancePausedTime":           time.Duration(0),
+		"GetMaintenanceTimeoutsDeferred":     int32(0),
+		"GetMaxHeartbeatGap":                 time.Duration(0),
+		"GetMaxTotalExecutionSeconds":        int32(0),
+		"GetMinimumInterval":                 int32(0),
+		"GetNackCount":                       int32(0),
+		"GetNextActivity":                    []uint8(nil),
+		"GetNextActivityEncoding":            "",
+		"GetOnDependencyFailure":             int32(0),
+		"GetPendingSignals":                  []uint8(nil),
+		"GetPendingSignalsEncoding":          "",
+		"GetPrefetchLeased":                  false,
+		"GetPriority":                        (*int32)(nil),
+		"GetProgressPercent":                 (*int32)(nil),
+		"GetRequestID":                       "",
+		"GetRescheduleReasons":               []uint8(nil),
+		"GetRescheduleReasonsEncoding":       "",
+		"GetResultSearchAttributes":          []uint8(nil),
+		"GetResultSearchAttributesEncoding":  "",
+		"GetRetryBackoffCoefficient":         float64(0),
+		"GetRetryExpirationTimestamp":        zeroUnix,
+		"GetRetryInitialInterval":            time.Duration(0),
+		"GetRetryLastFailureDetails":         []uint8(nil),
+		"GetRetryLastFailureReason":          "",
+		"GetRetryLastWorkerIdentity":         "",
+		"GetRetryMaximumAttempts":            int32(0),
+		"GetRetryMaximumInterval":            time.Duration(0),
+		"GetRetryNonRetryableErrors":         []string(nil),
+		"GetRoutingKey":                      "",
+		"GetScheduleToCloseTimeout":          time.Duration(0),
+		"GetScheduleToStartTimeout":          time.Duration(0),
+		"GetScheduleToStartTimeouts":         int32(0),
+		"GetScheduledEvent":                  []uint8(nil),
+		"GetScheduledEventBatchID":           int64(0),
+		"GetScheduledEventEncoding":          "",
+		"GetScheduledTimestamp":              zeroUnix,
+		"GetSearchAttributes":                map[string][]uint8(nil),
+		"GetStalledHeartbeats":               int32(0),
+		"GetStartToCloseTimeout":             time.Duration(0),
+		"GetStartedEvent":                    []uint8(nil),
+		"GetStartedEventEncoding":            "",
+		"GetStartedID":                       int64(0),
+		"GetStartedIdentity":                 "",
+		"GetStartedTimestamp":                zeroUnix,
+		"GetStealTimeout":                    int32(0),
+		"GetTaskList":                        "",
+		"GetTaskListEscalation":              []string(nil),
+		"GetTimerTaskStatus":                 int32(0),
+		"GetTotalExecutionTime":              time.Duration(0),
+		"GetVersion":                         int64(0),
+		"GetVisibilityTimeout":               int32(0),
 	},
 	"*serialization.HistoryTreeInfo": {
 		"GetAncestors":        []*types.HistoryBranchRange(nil),
@@ -274,6 +324,8 @@ var expectedNil = map[string]map[string]any{
 
 var expectedEmpty = map[string]map[string]any{
 	"*serialization.WorkflowExecutionInfo": {
+		"GetActivityBatchCompleted":             false,
+		"GetActivityBatchNotifiedStartedID":     int64(0),
 		"GetAutoResetPoints":                    []uint8(nil),
 		"GetAutoResetPointsEncoding":            "",
 		"GetCancelRequestID":                    "",
@@ -284,6 +336,7 @@ var expectedEmpty = map[string]map[string]any{
 		"GetCloseStatus":                        int32(0),
 		"GetCompletionEvent":                    []uint8(nil),
 		"GetCompletionEventEncoding":            "",
+		"GetCounters":                           map[string]int64(nil),
 		"GetCreateRequestID":                    "",
 		"GetCompletionEventBatchID":             int64(0),
 		"GetCronSchedule":                       "",
@@ -298,7 +351,10 @@ var expectedEmpty = map[string]map[string]any{
 		"GetDecisionTimeout":                    time.Duration(0),
 		"GetLastFirstEventID":                   int64(0),
 		"GetLastProcessedEvent":                 int64(0),
+		"GetMinimumInterval":                    int32(0),
+		"GetNotifyOnActivityBatchComplete":      false,
 		"GetParentWorkflowID":                   "",
+		"GetPriority":                           (*int32)(nil),
 		"GetStartVersion":                       int64(0),
 		"GetTaskList":                           "",
 		"GetDecisionVersion":                    int64(0),
@@ -428,37 +484,80 @@ var expectedEmpty = map[string]map[string]any{
 		"GetWorkflowTypeName":       "",
 	},
 	"*serialization.ActivityInfo": {
-		"GetActivityID":               "",
-		"GetAttempt":                  int32(0),
-		"GetCancelRequestID":          int64(0),
-		"GetCancelRequested":          false,
-		"GetHasRetryPolicy":           false,
-		"GetHeartbeatTimeout":         time.Duration(0),
-		"GetRequestID":                "",
-		"GetRetryBackoffCoefficient":  float64(0),
-		"GetRetryExpirationTimestamp": time.Time{},
-		"GetRetryInitialInterval":     time.Duration(0),
-		"GetRetryLastFailureDetails":  []uint8(nil),
-		"GetRetryLastFailureReason":   "",
-		"GetRetryLastWorkerIdentity":  "",
-		"GetRetryMaximumAttempts":     int32(0),
-		"GetRetryMaximumInterval":     time.Duration(0),
-		"GetRetryNonRetryableErrors":  []string(nil),
-		"GetScheduleToCloseTimeout":   time.Duration(0),
-		"GetScheduleToStartTimeout":   time.Duration(0),
-		"GetScheduledEvent":           []uint8(nil),
-		"GetScheduledEventBatchID":    int64(0),
-		"GetScheduledEventEncoding":   "",
-		"GetScheduledTimestamp":       time.Time{},
-		"GetStartToCloseTimeout":      time.Duration(0),
-		"GetStartedEvent":             []uint8(nil),
-		"GetStartedEventEncoding":     "",
-		"GetStartedID":                int64(0),
-		"GetStartedIdentity":          "",
-		"GetStartedTimestamp":         time.Time{},
-		"GetTaskList":                 "",
-		"GetTimerTaskStatus":          int32(0),
-		"GetVersion":                  int64(0),
+		"GetActivityID":                      "",
+		"GetAlertOnFailure":                  "",
+		"GetAtMostOnce":                      false,
+		"GetAttempt":                         int32(0),
+		"GetCancelAckTimeout":                int32(0),
+		"GetCancelAckTimeoutExceededTime":    time.Time{},
+		"GetCancelDeliveredTime":             time.Time{},
+		"GetCancelForceTimeout":              int32(0),
+		"GetCancelRequestID":                 int64(0),
+		"GetCancelRequested":                 false,
+		"GetCancelRequestedTime":             time.Time{},
+		"GetCancellationCheckpointGrace":     int32(0),
+		"GetDependsOnActivityID":             "",
+		"GetEncryptionKeyID":                 "",
+		"GetFallbackTaskList":                "",
+		"GetFirstAttemptStartToCloseTimeout": int32(0),
+		"GetGrantedStartToCloseTimeout":      int32(0),
+		"GetHasRetryPolicy":                  false,
+		"GetHeartbeatExpiredTime":            time.Time{},
+		"GetHeartbeatSequence":               int64(0),
+		"GetHeartbeatTimeout":                time.Duration(0),
+		"GetIdempotent":                      false,
+		"GetLastNackReason":                  "",
+		"GetMaintenancePausedTime":           time.Duration(0),
+		"GetMaintenanceTimeoutsDeferred":     int32(0),
+		"GetMaxHeartbeatGap":                 time.Duration(0),
+		"GetMaxTotalExecutionSeconds":        int32(0),
+		"GetMinimumInterval":                 int32(0),
+		"GetNackCount":                       int32(0),
+		"GetNextActivity":                    []uint8(nil),
+		"GetNextActivityEncoding":            "",
+		"GetOnDependencyFailure":             int32(0),
+		"GetPendingSignals":                  []uint8(nil),
+		"GetPendingSignalsEncoding":          "",
+		"GetPrefetchLeased":                  false,
+		"GetPriority":                        (*int32)(nil),
+		"GetProgressPercent":                 (*int32)(nil),
+		"GetRequestID":                       "",
+		"GetRescheduleReasons":               []uint8(nil),
+		"GetRescheduleReasonsEncoding":       "",
+		"GetResultSearchAttributes":          []uint8(nil),
+		"GetResultSearchAttributesEncoding":  "",
+		"GetRetryBackoffCoefficient":         float64(0),
+		"GetRetryExpirationTimestamp":        time.Time{},
+		"GetRetryInitialInterval":            time.Duration(0),
+		"GetRetryLastFailureDetails":         []uint8(nil),
+		"GetRetryLastFailureReason":          "",
+		"GetRetryLastWorkerIdentity":         "",
+		"GetRetryMaximumAttempts":            int32(0),
+		"GetRetryMaximumInterval":            time.Duration(0),
+		"GetRetryNonRetryableErrors":         []string(nil),
+		"GetRoutingKey":                      "",
+		"GetScheduleToCloseTimeout":          time.Duration(0),
+		"GetScheduleToStartTimeout":          time.Duration(0),
+		"GetScheduleToStartTimeouts":         int32(0),
+		"GetScheduledEvent":                  []uint8(nil),
+		"GetScheduledEventBatchID":           int64(0),
+		"GetScheduledEventEncoding":          "",
+		"GetScheduledTimestamp":              time.Time{},
+		"GetSearchAttributes":                map[string][]uint8(nil),
+		"GetStalledHeartbeats":               int32(0),
+		"GetStartToCloseTimeout":             time.Duration(0),
+		"GetStartedEvent":                    []uint8(nil),
+		"GetStartedEventEncoding":            "",
+		"GetStartedID":                       int64(0),
+		"GetStartedIdentity":                 "",
+		"GetStartedTimestamp":                time.Time{},
+		"GetStealTimeout":                    int32(0),
+		"GetTaskList":                        "",
+		"GetTaskListEscalation":              []string(nil),
+		"GetTimerTaskStatus":                 int32(0),
+		"GetTotalExecutionTime":              time.Duration(0),
+		"GetVersion":                         int64(0),
+		"GetVisibilityTimeout":               int32(0),
 	},
 	"*serialization.HistoryTreeInfo": {
 		"GetAncestors":        []*types.HistoryBranchRange(nil),
@@ -514,6 +613,8 @@ var expectedEmpty = map[string]map[string]any{
 
 var expectedNonEmpty = map[string]map[string]any{
 	"*serialization.WorkflowExecutionInfo": {
+		"GetActivityBatchCompleted":             true,
+		"GetActivityBatchNotifiedStartedID":     int64(1),
 		"GetAutoResetPoints":                    []byte("resetpoints"),
 		"GetAutoResetPointsEncoding":            "",
 		"GetCancelRequestID":                    "",
@@ -524,6 +625,7 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetCloseStatus":                        int32(6),
 		"GetCompletionEvent":                    []byte("completionEvent"),
 		"GetCompletionEventEncoding":            "completionEventEncoding",
+		"GetCounters":                           map[string]int64{"completed": 2},
 		"GetCreateRequestID":                    "",
 		"GetCompletionEventBatchID":             int64(2),
 		"GetCronSchedule":                       "",
@@ -538,7 +640,10 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetDecisionTimeout":                    time.Duration(4),
 		"GetLastFirstEventID":                   int64(7),
 		"GetLastProcessedEvent":                 int64(0),
+		"GetMinimumInterval":                    int32(1),
+		"GetNotifyOnActivityBatchComplete":      true,
 		"GetParentWorkflowID":                   "parentWorkflowID",
+		"GetPriority":                           common.Int32Ptr(1),
 		"GetStartVersion":                       int64(0),
 		"GetTaskList":                           "taskList",
 		"GetDecisionVersion":                    int64(0),
@@ -670,37 +775,80 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetWorkflowTypeName":       "workflowTypeName",
 	},
 	"*serialization.ActivityInfo": {
-		"GetActivityID":               "activityID",
-		"GetAttempt":                  int32(6),
-		"GetCancelRequestID":          int64(4),
-		"GetCancelRequested":          true,
-		"GetHasRetryPolicy":           true,
-		"GetHeartbeatTimeout":         time.Duration(4),
-		"GetRequestID":                "requestID",
-		"GetRetryBackoffCoefficient":  float64(8),
-		"GetRetryExpirationTimestamp": activeInfoRetryExpirationTime,
-		"GetRetryInitialInterval":     time.Duration(5),
-		"GetRetryLastFailureDetails":  []byte("retryLastFailureDetails"),
-		"GetRetryLastFailureReason":   "retryLastFailureReason",
-		"GetRetryLastWorkerIdentity":  "retryLastWorkerIdentity",
-		"GetRetryMaximumAttempts":     int32(7),
-		"GetRetryMaximumInterval":     time.Duration(6),
-		"GetRetryNonRetryableErrors":  []string{"error1", "error2"},
-		"GetScheduleToCloseTimeout":   time.Duration(1),
-		"GetScheduleToStartTimeout":   time.Duration(2),
-		"GetScheduledEvent":           []byte("scheduledEvent"),
-		"GetScheduledEventBatchID":    int64(2),
-		"GetScheduledEventEncoding":   "scheduledEventEncoding",
-		"GetScheduledTimestamp":       activityInfoScheduledTime,
-		"GetStartToCloseTimeout":      time.Duration(3),
-		"GetStartedEvent":             []byte("startedEvent"),
-		"GetStartedEventEncoding":     "startedEventEncoding",
-		"GetStartedID":                int64(3),
-		"GetStartedIdentity":          "startedIdentity",
-		"GetStartedTimestamp":         activeInfoStartedTime,
-		"GetTaskList":                 "taskList",
-		"GetTimerTaskStatus":          int32(5),
-		"GetVersion":                  int64(1),
+		"GetActivityID":                      "activityID",
+		"GetAlertOnFailure":                  "a",
+		"GetAtMostOnce":                      true,
+		"GetAttempt":                         int32(6),
+		"GetCancelAckTimeout":                int32(1),
+		"GetCancelAckTimeoutExceededTime":    time.Unix(1, 0),
+		"GetCancelDeliveredTime":             time.Unix(1, 0),
+		"GetCancelForceTimeout":              int32(1),
+		"GetCancelRequestID":                 int64(4),
+		"GetCancelRequested":                 true,
+		"GetCancelRequestedTime":             time.Unix(1, 0),
+		"GetCancellationCheckpointGrace":     int32(1),
+		"GetDependsOnActivityID":             "dependsOnActivityID",
+		"GetEncryptionKeyID":                 "encryptionKeyID",
+		"GetFallbackTaskList":                "fallbackTaskList",
+		"GetFirstAttemptStartToCloseTimeout": int32(1),
+		"GetGrantedStartToCloseTimeout":      int32(1),
+		"GetHasRetryPolicy":                  true,
+		"GetHeartbeatExpiredTime":            time.Unix(1, 0),
+		"GetHeartbeatSequence":               int64(1),
+		"GetHeartbeatTimeout":                time.Duration(4),
+		"GetIdempotent":                      true,
+		"GetLastNackReason":                  "a",
+		"GetMaintenancePausedTime":           time.Second,
+		"GetMaintenanceTimeoutsDeferred":     int32(1),
+		"GetMaxHeartbeatGap":                 time.Second,
+		"GetMaxTotalExecutionSeconds":        int32(1),
+		"GetMinimumInterval":                 int32(1),
+		"GetNackCount":                       int32(1),
+		"GetNextActivity":                    []byte("nextActivity"),
+		"GetNextActivityEncoding":            "nextActivityEncoding",
+		"GetOnDependencyFailure":             int32(1),
+		"GetPendingSignals":                  []byte("pendingSignals"),
+		"GetPendingSignalsEncoding":          "pendingSignalsEncoding",
+		"GetPrefetchLeased":                  true,
+		"GetPriority":                        common.Int32Ptr(1),
+		"GetProgressPercent":                 common.Int32Ptr(1),
+		"GetRequestID":                       "requestID",
+		"GetRescheduleReasons":               []byte("rescheduleReasons"),
+		"GetRescheduleReasonsEncoding":       "rescheduleReasonsEncoding",
+		"GetResultSearchAttributes":          []byte("resultSearchAttributes"),
+		"GetResultSearchAttributesEncoding":  "resultSearchAttributesEncoding",
+		"GetRetryBackoffCoefficient":         float64(8),
+		"GetRetryExpirationTimestamp":        activeInfoRetryExpirationTime,
+		"GetRetryInitialInterval":            time.Duration(5),
+		"GetRetryLastFailureDetails":         []byte("retryLastFailureDetails"),
+		"GetRetryLastFailureReason":          "retryLastFailureReason",
+		"GetRetryLastWorkerIdentity":         "retryLastWorkerIdentity",
+		"GetRetryMaximumAttempts":            int32(7),
+		"GetRetryMaximumInterval":            time.Duration(6),
+		"GetRetryNonRetryableErrors":         []string{"error1", "error2"},
+		"GetRoutingKey":                      "routingKey",
+		"GetScheduleToCloseTimeout":          time.Duration(1),
+		"GetScheduleToStartTimeout":          time.Duration(2),
+		"GetScheduleToStartTimeouts":         int32(2),
+		"GetScheduledEvent":                  []byte("scheduledEvent"),
+		"GetScheduledEventBatchID":           int64(2),
+		"GetScheduledEventEncoding":          "scheduledEventEncoding",
+		"GetScheduledTimestamp":              activityInfoScheduledTime,
+		"GetSearchAttributes":                map[string][]uint8{"key": []byte("value")},
+		"GetStalledHeartbeats":               int32(1),
+		"GetStartToCloseTimeout":             time.Duration(3),
+		"GetStartedEvent":                    []byte("startedEvent"),
+		"GetStartedEventEncoding":            "startedEventEncoding",
+		"GetStartedID":                       int64(3),
+		"GetStartedIdentity":                 "startedIdentity",
+		"GetStartedTimestamp":                activeInfoStartedTime,
+		"GetStealTimeout":                    int32(1),
+		"GetTaskList":                        "taskList",
+		"GetTaskListEscalation":              []string{"fast", "fallback"},
+		"GetTimerTaskStatus":                 int32(5),
+		"GetTotalExecutionTime":              time.Second,
+		"GetVersion":                         int64(1),
+		"GetVisibilityTimeout":               int32(30),
 	},
 	"*serialization.HistoryTreeInfo": {
 		"GetAncestors": []*types.HistoryBranchRange{
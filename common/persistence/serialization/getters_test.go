@@ -132,23 +132,29 @@ var (
 func TestGettersForInfos(t *testing.T) {
 	for _, info := range []any{
 		&WorkflowExecutionInfo{
-			ParentDomainID:          parentDomainID,
-			ParentWorkflowID:        "parentWorkflowID",
-			ParentRunID:             parentRunID,
-			InitiatedID:             1,
-			CompletionEventBatchID:  common.Int64Ptr(2),
-			CompletionEvent:         []byte("completionEvent"),
-			CompletionEventEncoding: "completionEventEncoding",
-			TaskList:                "taskList",
-			WorkflowTypeName:        "workflowTypeName",
-			WorkflowTimeout:         3,
-			DecisionTimeout:         4,
-			ExecutionContext:        []byte("executionContext"),
-			State:                   5,
-			CloseStatus:             6,
-			LastFirstEventID:        7,
-			AutoResetPoints:         []byte("resetpoints"),
-			SearchAttributes:        map[string][]byte{"key": []byte("value")},
+			ParentDomainID:                 parentDomainID,
+			ParentWorkflowID:               "parentWorkflowID",
+			ParentRunID:                    parentRunID,
+			InitiatedID:                    1,
+			CompletionEventBatchID:         common.Int64Ptr(2),
+			CompletionEvent:                []byte("completionEvent"),
+			CompletionEventEncoding:        "completionEventEncoding",
+			TaskList:                       "taskList",
+			WorkflowTypeName:               "workflowTypeName",
+			WorkflowTimeout:                3,
+			DecisionTimeout:                4,
+			ExecutionContext:               []byte("executionContext"),
+			State:                          5,
+			CloseStatus:                    6,
+			LastFirstEventID:               7,
+			AutoResetPoints:                []byte("resetpoints"),
+			SearchAttributes:               map[string][]byte{"key": []byte("value")},
+			MinimumInterval:                1,
+			Counters:                       map[string]int64{"completed": 2},
+			NotifyOnActivityBatchComplete:  true,
+			ActivityBatchCompleted:         true,
+			ActivityBatchNotifiedStartedID: 1,
+			Priority:                       common.Int32Ptr(1),
 		},
 		&TransferTaskInfo{
 			DomainID:                taskDomainID,
@@ -241,37 +247,80 @@ func TestGettersForInfos(t *testing.T) {
 			ParentClosePolicy:      1,
 		},
 		&ActivityInfo{
-			Version:                  1,
-			ScheduledEventBatchID:    2,
-			ScheduledEvent:           []byte("scheduledEvent"),
-			ScheduledEventEncoding:   "scheduledEventEncoding",
-			ScheduledTimestamp:       activityInfoScheduledTime,
-			StartedID:                3,
-			StartedEvent:             []byte("startedEvent"),
-			StartedEventEncoding:     "startedEventEncoding",
-			StartedTimestamp:         activeInfoStartedTime,
-			ActivityID:               "activityID",
-			RequestID:                "requestID",
-			ScheduleToCloseTimeout:   time.Duration(1),
-			ScheduleToStartTimeout:   time.Duration(2),
-			StartToCloseTimeout:      time.Duration(3),
-			HeartbeatTimeout:         time.Duration(4),
-			CancelRequested:          true,
-			CancelRequestID:          4,
-			TimerTaskStatus:          5,
-			Attempt:                  6,
-			TaskList:                 "taskList",
-			StartedIdentity:          "startedIdentity",
-			HasRetryPolicy:           true,
-			RetryInitialInterval:     time.Duration(5),
-			RetryMaximumInterval:     time.Duration(6),
-			RetryMaximumAttempts:     7,
-			RetryExpirationTimestamp: activeInfoRetryExpirationTime,
-			RetryBackoffCoefficient:  8,
-			RetryNonRetryableErrors:  []string{"error1", "error2"},
-			RetryLastWorkerIdentity:  "retryLastWorkerIdentity",
-			RetryLastFailureReason:   "retryLastFailureReason",
-			RetryLastFailureDetails:  []byte("retryLastFailureDetails"),
+			Version:                         1,
+			ScheduledEventBatchID:           2,
+			ScheduledEvent:                  []byte("scheduledEvent"),
+			ScheduledEventEncoding:          "scheduledEventEncoding",
+			ScheduledTimestamp:              activityInfoScheduledTime,
+			StartedID:                       3,
+			StartedEvent:                    []byte("startedEvent"),
+			StartedEventEncoding:            "startedEventEncoding",
+			StartedTimestamp:                activeInfoStartedTime,
+			ActivityID:                      "activityID",
+			RequestID:                       "requestID",
+			ScheduleToCloseTimeout:          time.Duration(1),
+			ScheduleToStartTimeout:          time.Duration(2),
+			StartToCloseTimeout:             time.Duration(3),
+			HeartbeatTimeout:                time.Duration(4),
+			CancelRequested:                 true,
+			CancelRequestID:                 4,
+			TimerTaskStatus:                 5,
+			Attempt:                         6,
+			TaskList:                        "taskList",
+			StartedIdentity:                 "startedIdentity",
+			HasRetryPolicy:                  true,
+			RetryInitialInterval:            time.Duration(5),
+			RetryMaximumInterval:            time.Duration(6),
+			RetryMaximumAttempts:            7,
+			RetryExpirationTimestamp:        activeInfoRetryExpirationTime,
+			RetryBackoffCoefficient:         8,
+			RetryNonRetryableErrors:         []string{"error1", "error2"},
+			RetryLastWorkerIdentity:         "retryLastWorkerIdentity",
+			RetryLastFailureReason:          "retryLastFailureReason",
+			RetryLastFailureDetails:         []byte("retryLastFailureDetails"),
+			RoutingKey:                      "routingKey",
+			VisibilityTimeout:               30,
+			PrefetchLeased:                  true,
+			FallbackTaskList:                "fallbackTaskList",
+			ScheduleToStartTimeouts:         2,
+			EncryptionKeyID:                 "encryptionKeyID",
+			NextActivity:                    []byte("nextActivity"),
+			NextActivityEncoding:            "nextActivityEncoding",
+			AtMostOnce:                      true,
+			DependsOnActivityID:             "dependsOnActivityID",
+			OnDependencyFailure:             1,
+			TaskListEscalation:              []string{"fast", "fallback"},
+			FirstAttemptStartToCloseTimeout: 1,
+			MaxHeartbeatGap:                 time.Second,
+			GrantedStartToCloseTimeout:      1,
+			MaxTotalExecutionSeconds:        1,
+			TotalExecutionTime:              time.Second,
+			HeartbeatSequence:               1,
+			MinimumInterval:                 1,
+			CancelRequestedTime:             time.Unix(1, 0),
+			CancelAckTimeout:                1,
+			CancelForceTimeout:              1,
+			CancelAckTimeoutExceededTime:    time.Unix(1, 0),
+			MaintenancePausedTime:           time.Second,
+			MaintenanceTimeoutsDeferred:     1,
+			NackCount:                       1,
+			LastNackReason:                  "a",
+			CancellationCheckpointGrace:     1,
+			CancelDeliveredTime:             time.Unix(1, 0),
+			AlertOnFailure:                  "a",
+			StealTimeout:                    1,
+			Idempotent:                      true,
+			ProgressPercent:                 common.Int32Ptr(1),
+			StalledHeartbeats:               1,
+			HeartbeatExpiredTime:            time.Unix(1, 0),
+			PendingSignals:                  []byte("pendingSignals"),
+			PendingSignalsEncoding:          "pendingSignalsEncoding",
+			SearchAttributes:                map[string][]byte{"key": []byte("value")},
+			RescheduleReasons:               []byte("rescheduleReasons"),
+			RescheduleReasonsEncoding:       "rescheduleReasonsEncoding",
+			ResultSearchAttributes:          []byte("resultSearchAttributes"),
+			ResultSearchAttributesEncoding:  "resultSearchAttributesEncoding",
+			Priority:                        common.Int32Ptr(1),
 		},
 		&HistoryTreeInfo{
 			CreatedTimestamp: historyTreeEventCreatedTime,
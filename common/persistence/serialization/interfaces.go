@@ -171,41 +171,90 @@ type (
 		PartitionConfig                    map[string]string
 		Checksum                           []byte
 		ChecksumEncoding                   string
+		MinimumInterval                    int32
+		Counters                           map[string]int64
+		NotifyOnActivityBatchComplete      bool
+		ActivityBatchCompleted             bool
+		ActivityBatchNotifiedStartedID     int64
+		Priority                           *int32
 	}
 
 	// ActivityInfo blob in a serialization agnostic format
 	ActivityInfo struct {
-		Version                  int64
-		ScheduledEventBatchID    int64
-		ScheduledEvent           []byte
-		ScheduledEventEncoding   string
-		ScheduledTimestamp       time.Time
-		StartedID                int64
-		StartedEvent             []byte
-		StartedEventEncoding     string
-		StartedTimestamp         time.Time
-		ActivityID               string
-		RequestID                string
-		ScheduleToStartTimeout   time.Duration
-		ScheduleToCloseTimeout   time.Duration
-		StartToCloseTimeout      time.Duration
-		HeartbeatTimeout         time.Duration
-		CancelRequested          bool
-		CancelRequestID          int64
-		TimerTaskStatus          int32
-		Attempt                  int32
-		TaskList                 string
-		StartedIdentity          string
-		HasRetryPolicy           bool
-		RetryInitialInterval     time.Duration
-		RetryMaximumInterval     time.Duration
-		RetryMaximumAttempts     int32
-		RetryExpirationTimestamp time.Time
-		RetryBackoffCoefficient  float64
-		RetryNonRetryableErrors  []string
-		RetryLastFailureReason   string
-		RetryLastWorkerIdentity  string
-		RetryLastFailureDetails  []byte
+		Version                         int64
+		ScheduledEventBatchID           int64
+		ScheduledEvent                  []byte
+		ScheduledEventEncoding          string
+		ScheduledTimestamp              time.Time
+		StartedID                       int64
+		StartedEvent                    []byte
+		StartedEventEncoding            string
+		StartedTimestamp                time.Time
+		ActivityID                      string
+		RequestID                       string
+		ScheduleToStartTimeout          time.Duration
+		ScheduleToCloseTimeout          time.Duration
+		StartToCloseTimeout             time.Duration
+		HeartbeatTimeout                time.Duration
+		CancelRequested                 bool
+		CancelRequestID                 int64
+		TimerTaskStatus                 int32
+		Attempt                         int32
+		TaskList                        string
+		StartedIdentity                 string
+		HasRetryPolicy                  bool
+		RetryInitialInterval            time.Duration
+		RetryMaximumInterval            time.Duration
+		RetryMaximumAttempts            int32
+		RetryExpirationTimestamp        time.Time
+		RetryBackoffCoefficient         float64
+		RetryNonRetryableErrors         []string
+		RetryLastFailureReason          string
+		RetryLastWorkerIdentity         string
+		RetryLastFailureDetails         []byte
+		RoutingKey                      string
+		VisibilityTimeout               int32
+		PrefetchLeased                  bool
+		FallbackTaskList                string
+		ScheduleToStartTimeouts         int32
+		EncryptionKeyID                 string
+		NextActivity                    []byte
+		NextActivityEncoding            string
+		AtMostOnce                      bool
+		DependsOnActivityID             string
+		OnDependencyFailure             int32
+		TaskListEscalation              []string
+		FirstAttemptStartToCloseTimeout int32
+		MaxHeartbeatGap                 time.Duration
+		GrantedStartToCloseTimeout      int32
+		MaxTotalExecutionSeconds        int32
+		TotalExecutionTime              time.Duration
+		HeartbeatSequence               int64
+		MinimumInterval                 int32
+		CancelRequestedTime             time.Time
+		CancelAckTimeout                int32
+		CancelForceTimeout              int32
+		CancelAckTimeoutExceededTime    time.Time
+		MaintenancePausedTime           time.Duration
+		MaintenanceTimeoutsDeferred     int32
+		NackCount                       int32
+		LastNackReason                  string
+		CancellationCheckpointGrace     int32
+		CancelDeliveredTime             time.Time
+		AlertOnFailure                  string
+		StealTimeout                    int32
+		Idempotent                      bool
+		ProgressPercent                 *int32
+		StalledHeartbeats               int32
+		HeartbeatExpiredTime            time.Time
+		PendingSignals                  []byte
+		PendingSignalsEncoding          string
+		SearchAttributes                map[string][]byte
+		RescheduleReasons               []byte
+		RescheduleReasonsEncoding       string
+		ResultSearchAttributes          []byte
+		ResultSearchAttributesEncoding  string
+		Priority                        *int32
 	}
 
 	// ChildExecutionInfo blob in a serialization agnostic format
@@ -362,3 +362,16 @@ func TestParser_WorkflowExecution_with_cron(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, info, result)
 }
+
+func TestParser_WorkflowExecution_with_counters(t *testing.T) {
+	info := &persistence.InternalWorkflowExecutionInfo{
+		Counters: map[string]int64{"processed": 2, "failed": 1},
+	}
+	parser, err := NewParser(common.EncodingTypeThriftRW, common.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	blob, err := parser.WorkflowExecutionInfoToBlob(FromInternalWorkflowExecutionInfo(info))
+	require.NoError(t, err)
+	result, err := parser.WorkflowExecutionInfoFromBlob(blob.Data, string(blob.Encoding))
+	require.NoError(t, err)
+	assert.Equal(t, info.Counters, ToInternalWorkflowExecutionInfo(result).Counters)
+}
@@ -75,6 +75,12 @@ func ToInternalWorkflowExecutionInfo(info *WorkflowExecutionInfo) *persistence.I
 		FirstExecutionRunID:                info.FirstExecutionRunID.String(),
 		PartitionConfig:                    info.PartitionConfig,
 		IsCron:                             info.IsCron,
+		MinimumInterval:                    info.GetMinimumInterval(),
+		Counters:                           info.GetCounters(),
+		NotifyOnActivityBatchComplete:      info.GetNotifyOnActivityBatchComplete(),
+		ActivityBatchCompleted:             info.GetActivityBatchCompleted(),
+		ActivityBatchNotifiedStartedID:     info.GetActivityBatchNotifiedStartedID(),
+		Priority:                           info.GetPriority(),
 	}
 	if info.ParentDomainID != nil {
 		result.ParentDomainID = info.ParentDomainID.String()
@@ -158,6 +164,12 @@ func FromInternalWorkflowExecutionInfo(executionInfo *persistence.InternalWorkfl
 		FirstExecutionRunID:                MustParseUUID(executionInfo.FirstExecutionRunID),
 		PartitionConfig:                    executionInfo.PartitionConfig,
 		IsCron:                             executionInfo.IsCron,
+		MinimumInterval:                    executionInfo.MinimumInterval,
+		Counters:                           executionInfo.Counters,
+		NotifyOnActivityBatchComplete:      executionInfo.NotifyOnActivityBatchComplete,
+		ActivityBatchCompleted:             executionInfo.ActivityBatchCompleted,
+		ActivityBatchNotifiedStartedID:     executionInfo.ActivityBatchNotifiedStartedID,
+		Priority:                           executionInfo.Priority,
 	}
 
 	if executionInfo.CompletionEvent != nil {
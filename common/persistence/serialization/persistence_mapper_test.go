@@ -90,6 +90,12 @@ func TestInternalWorkflowExecutionInfo(t *testing.T) {
 		HistorySize:                        int64(rand.Intn(1000)),
 		PartitionConfig:                    map[string]string{"zone": "dca1"},
 		IsCron:                             true,
+		MinimumInterval:                    int32(rand.Intn(1000)),
+		Counters:                           map[string]int64{"completed": int64(rand.Intn(1000))},
+		NotifyOnActivityBatchComplete:      true,
+		ActivityBatchCompleted:             true,
+		ActivityBatchNotifiedStartedID:     int64(rand.Intn(1000)),
+		Priority:                           common.Int32Ptr(int32(rand.Intn(1000))),
 	}
 	actual := ToInternalWorkflowExecutionInfo(FromInternalWorkflowExecutionInfo(expected))
 	assert.Equal(t, expected.ParentDomainID, actual.ParentDomainID)
@@ -146,4 +152,10 @@ func TestInternalWorkflowExecutionInfo(t *testing.T) {
 	assert.Equal(t, expected.HistorySize, actual.HistorySize)
 	assert.Equal(t, expected.PartitionConfig, actual.PartitionConfig)
 	assert.Equal(t, expected.IsCron, actual.IsCron)
+	assert.Equal(t, expected.MinimumInterval, actual.MinimumInterval)
+	assert.Equal(t, expected.Counters, actual.Counters)
+	assert.Equal(t, expected.NotifyOnActivityBatchComplete, actual.NotifyOnActivityBatchComplete)
+	assert.Equal(t, expected.ActivityBatchCompleted, actual.ActivityBatchCompleted)
+	assert.Equal(t, expected.ActivityBatchNotifiedStartedID, actual.ActivityBatchNotifiedStartedID)
+	assert.Equal(t, expected.Priority, actual.Priority)
 }
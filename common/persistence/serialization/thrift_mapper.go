@@ -258,6 +258,12 @@ func workflowExecutionInfoToThrift(info *WorkflowExecutionInfo) *sqlblobs.Workfl
 		PartitionConfig:                         info.PartitionConfig,
 		Checksum:                                info.Checksum,
 		ChecksumEncoding:                        &info.ChecksumEncoding,
+		MinimumIntervalSeconds:                  &info.MinimumInterval,
+		Counters:                                info.Counters,
+		NotifyOnActivityBatchComplete:           &info.NotifyOnActivityBatchComplete,
+		ActivityBatchCompleted:                  &info.ActivityBatchCompleted,
+		ActivityBatchNotifiedStartedID:          &info.ActivityBatchNotifiedStartedID,
+		Priority:                                info.Priority,
 	}
 }
 
@@ -329,6 +335,12 @@ func workflowExecutionInfoFromThrift(info *sqlblobs.WorkflowExecutionInfo) *Work
 		IsCron:                             info.GetCronSchedule() != "",
 		Checksum:                           info.Checksum,
 		ChecksumEncoding:                   info.GetChecksumEncoding(),
+		MinimumInterval:                    info.GetMinimumIntervalSeconds(),
+		Counters:                           info.Counters,
+		NotifyOnActivityBatchComplete:      info.GetNotifyOnActivityBatchComplete(),
+		ActivityBatchCompleted:             info.GetActivityBatchCompleted(),
+		ActivityBatchNotifiedStartedID:     info.GetActivityBatchNotifiedStartedID(),
+		Priority:                           info.Priority,
 	}
 }
 
@@ -337,37 +349,80 @@ func activityInfoToThrift(info *ActivityInfo) *sqlblobs.ActivityInfo {
 		return nil
 	}
 	return &sqlblobs.ActivityInfo{
-		Version:                       &info.Version,
-		ScheduledEventBatchID:         &info.ScheduledEventBatchID,
-		ScheduledEvent:                info.ScheduledEvent,
-		ScheduledEventEncoding:        &info.ScheduledEventEncoding,
-		ScheduledTimeNanos:            timeToUnixNanoPtr(info.ScheduledTimestamp),
-		StartedID:                     &info.StartedID,
-		StartedEvent:                  info.StartedEvent,
-		StartedEventEncoding:          &info.StartedEventEncoding,
-		StartedTimeNanos:              timeToUnixNanoPtr(info.StartedTimestamp),
-		ActivityID:                    &info.ActivityID,
-		RequestID:                     &info.RequestID,
-		ScheduleToStartTimeoutSeconds: durationToSecondsInt32Ptr(info.ScheduleToStartTimeout),
-		ScheduleToCloseTimeoutSeconds: durationToSecondsInt32Ptr(info.ScheduleToCloseTimeout),
-		StartToCloseTimeoutSeconds:    durationToSecondsInt32Ptr(info.StartToCloseTimeout),
-		HeartbeatTimeoutSeconds:       durationToSecondsInt32Ptr(info.HeartbeatTimeout),
-		CancelRequested:               &info.CancelRequested,
-		CancelRequestID:               &info.CancelRequestID,
-		TimerTaskStatus:               &info.TimerTaskStatus,
-		Attempt:                       &info.Attempt,
-		TaskList:                      &info.TaskList,
-		StartedIdentity:               &info.StartedIdentity,
-		HasRetryPolicy:                &info.HasRetryPolicy,
-		RetryInitialIntervalSeconds:   durationToSecondsInt32Ptr(info.RetryInitialInterval),
-		RetryMaximumIntervalSeconds:   durationToSecondsInt32Ptr(info.RetryMaximumInterval),
-		RetryMaximumAttempts:          &info.RetryMaximumAttempts,
-		RetryExpirationTimeNanos:      timeToUnixNanoPtr(info.RetryExpirationTimestamp),
-		RetryBackoffCoefficient:       &info.RetryBackoffCoefficient,
-		RetryNonRetryableErrors:       info.RetryNonRetryableErrors,
-		RetryLastFailureReason:        &info.RetryLastFailureReason,
-		RetryLastWorkerIdentity:       &info.RetryLastWorkerIdentity,
-		RetryLastFailureDetails:       info.RetryLastFailureDetails,
+		Version:                                &info.Version,
+		ScheduledEventBatchID:                  &info.ScheduledEventBatchID,
+		ScheduledEvent:                         info.ScheduledEvent,
+		ScheduledEventEncoding:                 &info.ScheduledEventEncoding,
+		ScheduledTimeNanos:                     timeToUnixNanoPtr(info.ScheduledTimestamp),
+		StartedID:                              &info.StartedID,
+		StartedEvent:                           info.StartedEvent,
+		StartedEventEncoding:                   &info.StartedEventEncoding,
+		StartedTimeNanos:                       timeToUnixNanoPtr(info.StartedTimestamp),
+		ActivityID:                             &info.ActivityID,
+		RequestID:                              &info.RequestID,
+		ScheduleToStartTimeoutSeconds:          durationToSecondsInt32Ptr(info.ScheduleToStartTimeout),
+		ScheduleToCloseTimeoutSeconds:          durationToSecondsInt32Ptr(info.ScheduleToCloseTimeout),
+		StartToCloseTimeoutSeconds:             durationToSecondsInt32Ptr(info.StartToCloseTimeout),
+		HeartbeatTimeoutSeconds:                durationToSecondsInt32Ptr(info.HeartbeatTimeout),
+		CancelRequested:                        &info.CancelRequested,
+		CancelRequestID:                        &info.CancelRequestID,
+		TimerTaskStatus:                        &info.TimerTaskStatus,
+		Attempt:                                &info.Attempt,
+		TaskList:                               &info.TaskList,
+		StartedIdentity:                        &info.StartedIdentity,
+		HasRetryPolicy:                         &info.HasRetryPolicy,
+		RetryInitialIntervalSeconds:            durationToSecondsInt32Ptr(info.RetryInitialInterval),
+		RetryMaximumIntervalSeconds:            durationToSecondsInt32Ptr(info.RetryMaximumInterval),
+		RetryMaximumAttempts:                   &info.RetryMaximumAttempts,
+		RetryExpirationTimeNanos:               timeToUnixNanoPtr(info.RetryExpirationTimestamp),
+		RetryBackoffCoefficient:                &info.RetryBackoffCoefficient,
+		RetryNonRetryableErrors:                info.RetryNonRetryableErrors,
+		RetryLastFailureReason:                 &info.RetryLastFailureReason,
+		RetryLastWorkerIdentity:                &info.RetryLastWorkerIdentity,
+		RetryLastFailureDetails:                info.RetryLastFailureDetails,
+		RoutingKey:                             &info.RoutingKey,
+		VisibilityTimeoutSeconds:               &info.VisibilityTimeout,
+		PrefetchLeased:                         &info.PrefetchLeased,
+		FallbackTaskList:                       &info.FallbackTaskList,
+		ScheduleToStartTimeouts:                &info.ScheduleToStartTimeouts,
+		EncryptionKeyID:                        &info.EncryptionKeyID,
+		NextActivity:                           info.NextActivity,
+		NextActivityEncoding:                   &info.NextActivityEncoding,
+		AtMostOnce:                             &info.AtMostOnce,
+		DependsOnActivityID:                    &info.DependsOnActivityID,
+		OnDependencyFailure:                    &info.OnDependencyFailure,
+		TaskListEscalation:                     info.TaskListEscalation,
+		FirstAttemptStartToCloseTimeoutSeconds: &info.FirstAttemptStartToCloseTimeout,
+		MaxHeartbeatGapNanos:                   common.Int64Ptr(int64(info.MaxHeartbeatGap)),
+		GrantedStartToCloseTimeoutSeconds:      &info.GrantedStartToCloseTimeout,
+		MaxTotalExecutionSeconds:               &info.MaxTotalExecutionSeconds,
+		TotalExecutionTimeNanos:                common.Int64Ptr(int64(info.TotalExecutionTime)),
+		HeartbeatSequence:                      &info.HeartbeatSequence,
+		MinimumIntervalSeconds:                 &info.MinimumInterval,
+		CancelRequestedTimeNanos:               timeToUnixNanoPtr(info.CancelRequestedTime),
+		CancelAckTimeoutSeconds:                &info.CancelAckTimeout,
+		CancelForceTimeoutSeconds:              &info.CancelForceTimeout,
+		CancelAckTimeoutExceededTimeNanos:      timeToUnixNanoPtr(info.CancelAckTimeoutExceededTime),
+		MaintenancePausedTimeNanos:             common.Int64Ptr(int64(info.MaintenancePausedTime)),
+		MaintenanceTimeoutsDeferred:            &info.MaintenanceTimeoutsDeferred,
+		NackCount:                              &info.NackCount,
+		LastNackReason:                         &info.LastNackReason,
+		CancellationCheckpointGraceSeconds:     &info.CancellationCheckpointGrace,
+		CancelDeliveredTimeNanos:               timeToUnixNanoPtr(info.CancelDeliveredTime),
+		AlertOnFailure:                         &info.AlertOnFailure,
+		StealTimeoutSeconds:                    &info.StealTimeout,
+		Idempotent:                             &info.Idempotent,
+		ProgressPercent:                        info.ProgressPercent,
+		StalledHeartbeats:                      &info.StalledHeartbeats,
+		HeartbeatExpiredTimeNanos:              timeToUnixNanoPtr(info.HeartbeatExpiredTime),
+		PendingSignals:                         info.PendingSignals,
+		PendingSignalsEncoding:                 &info.PendingSignalsEncoding,
+		SearchAttributes:                       info.SearchAttributes,
+		RescheduleReasons:                      info.RescheduleReasons,
+		RescheduleReasonsEncoding:              &info.RescheduleReasonsEncoding,
+		ResultSearchAttributes:                 info.ResultSearchAttributes,
+		ResultSearchAttributesEncoding:         &info.ResultSearchAttributesEncoding,
+		Priority:                               info.Priority,
 	}
 }
 
@@ -376,37 +431,80 @@ func activityInfoFromThrift(info *sqlblobs.ActivityInfo) *ActivityInfo {
 		return nil
 	}
 	return &ActivityInfo{
-		Version:                  info.GetVersion(),
-		ScheduledEventBatchID:    info.GetScheduledEventBatchID(),
-		ScheduledEvent:           info.ScheduledEvent,
-		ScheduledEventEncoding:   info.GetScheduledEventEncoding(),
-		ScheduledTimestamp:       timeFromUnixNano(info.GetScheduledTimeNanos()),
-		StartedID:                info.GetStartedID(),
-		StartedEvent:             info.StartedEvent,
-		StartedEventEncoding:     info.GetStartedEventEncoding(),
-		StartedTimestamp:         timeFromUnixNano(info.GetStartedTimeNanos()),
-		ActivityID:               info.GetActivityID(),
-		RequestID:                info.GetRequestID(),
-		ScheduleToStartTimeout:   common.SecondsToDuration(int64(info.GetScheduleToStartTimeoutSeconds())),
-		ScheduleToCloseTimeout:   common.SecondsToDuration(int64(info.GetScheduleToCloseTimeoutSeconds())),
-		StartToCloseTimeout:      common.SecondsToDuration(int64(info.GetStartToCloseTimeoutSeconds())),
-		HeartbeatTimeout:         common.SecondsToDuration(int64(info.GetHeartbeatTimeoutSeconds())),
-		CancelRequested:          info.GetCancelRequested(),
-		CancelRequestID:          info.GetCancelRequestID(),
-		TimerTaskStatus:          info.GetTimerTaskStatus(),
-		Attempt:                  info.GetAttempt(),
-		TaskList:                 info.GetTaskList(),
-		StartedIdentity:          info.GetStartedIdentity(),
-		HasRetryPolicy:           info.GetHasRetryPolicy(),
-		RetryInitialInterval:     common.SecondsToDuration(int64(info.GetRetryInitialIntervalSeconds())),
-		RetryMaximumInterval:     common.SecondsToDuration(int64(info.GetRetryMaximumIntervalSeconds())),
-		RetryMaximumAttempts:     info.GetRetryMaximumAttempts(),
-		RetryExpirationTimestamp: timeFromUnixNano(info.GetRetryExpirationTimeNanos()),
-		RetryBackoffCoefficient:  info.GetRetryBackoffCoefficient(),
-		RetryNonRetryableErrors:  info.RetryNonRetryableErrors,
-		RetryLastFailureReason:   info.GetRetryLastFailureReason(),
-		RetryLastWorkerIdentity:  info.GetRetryLastWorkerIdentity(),
-		RetryLastFailureDetails:  info.RetryLastFailureDetails,
+		Version:                         info.GetVersion(),
+		ScheduledEventBatchID:           info.GetScheduledEventBatchID(),
+		ScheduledEvent:                  info.ScheduledEvent,
+		ScheduledEventEncoding:          info.GetScheduledEventEncoding(),
+		ScheduledTimestamp:              timeFromUnixNano(info.GetScheduledTimeNanos()),
+		StartedID:                       info.GetStartedID(),
+		StartedEvent:                    info.StartedEvent,
+		StartedEventEncoding:            info.GetStartedEventEncoding(),
+		StartedTimestamp:                timeFromUnixNano(info.GetStartedTimeNanos()),
+		ActivityID:                      info.GetActivityID(),
+		RequestID:                       info.GetRequestID(),
+		ScheduleToStartTimeout:          common.SecondsToDuration(int64(info.GetScheduleToStartTimeoutSeconds())),
+		ScheduleToCloseTimeout:          common.SecondsToDuration(int64(info.GetScheduleToCloseTimeoutSeconds())),
+		StartToCloseTimeout:             common.SecondsToDuration(int64(info.GetStartToCloseTimeoutSeconds())),
+		HeartbeatTimeout:                common.SecondsToDuration(int64(info.GetHeartbeatTimeoutSeconds())),
+		CancelRequested:                 info.GetCancelRequested(),
+		CancelRequestID:                 info.GetCancelRequestID(),
+		TimerTaskStatus:                 info.GetTimerTaskStatus(),
+		Attempt:                         info.GetAttempt(),
+		TaskList:                        info.GetTaskList(),
+		StartedIdentity:                 info.GetStartedIdentity(),
+		HasRetryPolicy:                  info.GetHasRetryPolicy(),
+		RetryInitialInterval:            common.SecondsToDuration(int64(info.GetRetryInitialIntervalSeconds())),
+		RetryMaximumInterval:            common.SecondsToDuration(int64(info.GetRetryMaximumIntervalSeconds())),
+		RetryMaximumAttempts:            info.GetRetryMaximumAttempts(),
+		RetryExpirationTimestamp:        timeFromUnixNano(info.GetRetryExpirationTimeNanos()),
+		RetryBackoffCoefficient:         info.GetRetryBackoffCoefficient(),
+		RetryNonRetryableErrors:         info.RetryNonRetryableErrors,
+		RetryLastFailureReason:          info.GetRetryLastFailureReason(),
+		RetryLastWorkerIdentity:         info.GetRetryLastWorkerIdentity(),
+		RetryLastFailureDetails:         info.RetryLastFailureDetails,
+		RoutingKey:                      info.GetRoutingKey(),
+		VisibilityTimeout:               info.GetVisibilityTimeoutSeconds(),
+		PrefetchLeased:                  info.GetPrefetchLeased(),
+		FallbackTaskList:                info.GetFallbackTaskList(),
+		ScheduleToStartTimeouts:         info.GetScheduleToStartTimeouts(),
+		EncryptionKeyID:                 info.GetEncryptionKeyID(),
+		NextActivity:                    info.NextActivity,
+		NextActivityEncoding:            info.GetNextActivityEncoding(),
+		AtMostOnce:                      info.GetAtMostOnce(),
+		DependsOnActivityID:             info.GetDependsOnActivityID(),
+		OnDependencyFailure:             info.GetOnDependencyFailure(),
+		TaskListEscalation:              info.TaskListEscalation,
+		FirstAttemptStartToCloseTimeout: info.GetFirstAttemptStartToCloseTimeoutSeconds(),
+		MaxHeartbeatGap:                 time.Duration(info.GetMaxHeartbeatGapNanos()),
+		GrantedStartToCloseTimeout:      info.GetGrantedStartToCloseTimeoutSeconds(),
+		MaxTotalExecutionSeconds:        info.GetMaxTotalExecutionSeconds(),
+		TotalExecutionTime:              time.Duration(info.GetTotalExecutionTimeNanos()),
+		HeartbeatSequence:               info.GetHeartbeatSequence(),
+		MinimumInterval:                 info.GetMinimumIntervalSeconds(),
+		CancelRequestedTime:             timeFromUnixNano(info.GetCancelRequestedTimeNanos()),
+		CancelAckTimeout:                info.GetCancelAckTimeoutSeconds(),
+		CancelForceTimeout:              info.GetCancelForceTimeoutSeconds(),
+		CancelAckTimeoutExceededTime:    timeFromUnixNano(info.GetCancelAckTimeoutExceededTimeNanos()),
+		MaintenancePausedTime:           time.Duration(info.GetMaintenancePausedTimeNanos()),
+		MaintenanceTimeoutsDeferred:     info.GetMaintenanceTimeoutsDeferred(),
+		NackCount:                       info.GetNackCount(),
+		LastNackReason:                  info.GetLastNackReason(),
+		CancellationCheckpointGrace:     info.GetCancellationCheckpointGraceSeconds(),
+		CancelDeliveredTime:             timeFromUnixNano(info.GetCancelDeliveredTimeNanos()),
+		AlertOnFailure:                  info.GetAlertOnFailure(),
+		StealTimeout:                    info.GetStealTimeoutSeconds(),
+		Idempotent:                      info.GetIdempotent(),
+		ProgressPercent:                 info.ProgressPercent,
+		StalledHeartbeats:               info.GetStalledHeartbeats(),
+		HeartbeatExpiredTime:            timeFromUnixNano(info.GetHeartbeatExpiredTimeNanos()),
+		PendingSignals:                  info.PendingSignals,
+		PendingSignalsEncoding:          info.GetPendingSignalsEncoding(),
+		SearchAttributes:                info.SearchAttributes,
+		RescheduleReasons:               info.RescheduleReasons,
+		RescheduleReasonsEncoding:       info.GetRescheduleReasonsEncoding(),
+		ResultSearchAttributes:          info.ResultSearchAttributes,
+		ResultSearchAttributesEncoding:  info.GetResultSearchAttributesEncoding(),
+		Priority:                        info.Priority,
 	}
 }
 
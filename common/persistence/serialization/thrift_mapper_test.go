@@ -243,6 +243,12 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 		PartitionConfig:                    map[string]string{"zone": "dca1"},
 		Checksum:                           []byte("Checksum"),
 		ChecksumEncoding:                   "ChecksumEncoding",
+		MinimumInterval:                    1,
+		Counters:                           map[string]int64{"completed": 2},
+		NotifyOnActivityBatchComplete:      true,
+		ActivityBatchCompleted:             true,
+		ActivityBatchNotifiedStartedID:     1,
+		Priority:                           common.Int32Ptr(1),
 	}
 	actual := workflowExecutionInfoFromThrift(workflowExecutionInfoToThrift(expected))
 	assert.Equal(t, expected.ParentDomainID, actual.ParentDomainID)
@@ -306,43 +312,92 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 	assert.Equal(t, expected.PartitionConfig, actual.PartitionConfig)
 	assert.Equal(t, expected.Checksum, actual.Checksum)
 	assert.Equal(t, expected.ChecksumEncoding, actual.ChecksumEncoding)
+	assert.Equal(t, expected.MinimumInterval, actual.MinimumInterval)
+	assert.Equal(t, expected.Counters, actual.Counters)
+	assert.Equal(t, expected.NotifyOnActivityBatchComplete, actual.NotifyOnActivityBatchComplete)
+	assert.Equal(t, expected.ActivityBatchCompleted, actual.ActivityBatchCompleted)
+	assert.Equal(t, expected.ActivityBatchNotifiedStartedID, actual.ActivityBatchNotifiedStartedID)
+	assert.Equal(t, expected.Priority, actual.Priority)
 	assert.Nil(t, workflowExecutionInfoFromThrift(nil))
 	assert.Nil(t, workflowExecutionInfoToThrift(nil))
 }
 
 func TestActivityInfo(t *testing.T) {
 	expected := &ActivityInfo{
-		Version:                  int64(rand.Intn(1000)),
-		ScheduledEventBatchID:    int64(rand.Intn(1000)),
-		ScheduledEvent:           []byte("ScheduledEvent"),
-		ScheduledEventEncoding:   "ScheduledEventEncoding",
-		ScheduledTimestamp:       time.Now(),
-		StartedID:                int64(rand.Intn(1000)),
-		StartedEvent:             []byte("StartedEvent"),
-		StartedEventEncoding:     "StartedEventEncoding",
-		StartedTimestamp:         time.Now(),
-		ActivityID:               "ActivityID",
-		RequestID:                "RequestID",
-		ScheduleToStartTimeout:   time.Minute * time.Duration(rand.Intn(10)),
-		ScheduleToCloseTimeout:   time.Minute * time.Duration(rand.Intn(10)),
-		StartToCloseTimeout:      time.Minute * time.Duration(rand.Intn(10)),
-		HeartbeatTimeout:         time.Minute * time.Duration(rand.Intn(10)),
-		CancelRequested:          true,
-		CancelRequestID:          int64(rand.Intn(1000)),
-		TimerTaskStatus:          int32(rand.Intn(1000)),
-		Attempt:                  int32(rand.Intn(1000)),
-		TaskList:                 "TaskList",
-		StartedIdentity:          "StartedIdentity",
-		HasRetryPolicy:           true,
-		RetryInitialInterval:     time.Minute * time.Duration(rand.Intn(10)),
-		RetryMaximumInterval:     time.Minute * time.Duration(rand.Intn(10)),
-		RetryMaximumAttempts:     int32(rand.Intn(1000)),
-		RetryExpirationTimestamp: time.Time{},
-		RetryBackoffCoefficient:  rand.Float64() * 1000,
-		RetryNonRetryableErrors:  []string{"RetryNonRetryableErrors"},
-		RetryLastFailureReason:   "RetryLastFailureReason",
-		RetryLastWorkerIdentity:  "RetryLastWorkerIdentity",
-		RetryLastFailureDetails:  []byte("RetryLastFailureDetails"),
+		Version:                         int64(rand.Intn(1000)),
+		ScheduledEventBatchID:           int64(rand.Intn(1000)),
+		ScheduledEvent:                  []byte("ScheduledEvent"),
+		ScheduledEventEncoding:          "ScheduledEventEncoding",
+		ScheduledTimestamp:              time.Now(),
+		StartedID:                       int64(rand.Intn(1000)),
+		StartedEvent:                    []byte("StartedEvent"),
+		StartedEventEncoding:            "StartedEventEncoding",
+		StartedTimestamp:                time.Now(),
+		ActivityID:                      "ActivityID",
+		RequestID:                       "RequestID",
+		ScheduleToStartTimeout:          time.Minute * time.Duration(rand.Intn(10)),
+		ScheduleToCloseTimeout:          time.Minute * time.Duration(rand.Intn(10)),
+		StartToCloseTimeout:             time.Minute * time.Duration(rand.Intn(10)),
+		HeartbeatTimeout:                time.Minute * time.Duration(rand.Intn(10)),
+		CancelRequested:                 true,
+		CancelRequestID:                 int64(rand.Intn(1000)),
+		TimerTaskStatus:                 int32(rand.Intn(1000)),
+		Attempt:                         int32(rand.Intn(1000)),
+		TaskList:                        "TaskList",
+		StartedIdentity:                 "StartedIdentity",
+		HasRetryPolicy:                  true,
+		RetryInitialInterval:            time.Minute * time.Duration(rand.Intn(10)),
+		RetryMaximumInterval:            time.Minute * time.Duration(rand.Intn(10)),
+		RetryMaximumAttempts:            int32(rand.Intn(1000)),
+		RetryExpirationTimestamp:        time.Time{},
+		RetryBackoffCoefficient:         rand.Float64() * 1000,
+		RetryNonRetryableErrors:         []string{"RetryNonRetryableErrors"},
+		RetryLastFailureReason:          "RetryLastFailureReason",
+		RetryLastWorkerIdentity:         "RetryLastWorkerIdentity",
+		RetryLastFailureDetails:         []byte("RetryLastFailureDetails"),
+		RoutingKey:                      "routingKey",
+		VisibilityTimeout:               30,
+		PrefetchLeased:                  true,
+		FallbackTaskList:                "fallbackTaskList",
+		ScheduleToStartTimeouts:         2,
+		EncryptionKeyID:                 "encryptionKeyID",
+		NextActivity:                    []byte("nextActivity"),
+		NextActivityEncoding:            "nextActivityEncoding",
+		AtMostOnce:                      true,
+		DependsOnActivityID:             "dependsOnActivityID",
+		OnDependencyFailure:             1,
+		TaskListEscalation:              []string{"fast", "fallback"},
+		FirstAttemptStartToCloseTimeout: 1,
+		MaxHeartbeatGap:                 time.Second,
+		GrantedStartToCloseTimeout:      1,
+		MaxTotalExecutionSeconds:        1,
+		TotalExecutionTime:              time.Second,
+		HeartbeatSequence:               1,
+		MinimumInterval:                 1,
+		CancelRequestedTime:             time.Unix(1, 0),
+		CancelAckTimeout:                1,
+		CancelForceTimeout:              1,
+		CancelAckTimeoutExceededTime:    time.Unix(1, 0),
+		MaintenancePausedTime:           time.Second,
+		MaintenanceTimeoutsDeferred:     1,
+		NackCount:                       1,
+		LastNackReason:                  "a",
+		CancellationCheckpointGrace:     1,
+		CancelDeliveredTime:             time.Unix(1, 0),
+		AlertOnFailure:                  "a",
+		StealTimeout:                    1,
+		Idempotent:                      true,
+		ProgressPercent:                 common.Int32Ptr(1),
+		StalledHeartbeats:               1,
+		HeartbeatExpiredTime:            time.Unix(1, 0),
+		PendingSignals:                  []byte("pendingSignals"),
+		PendingSignalsEncoding:          "pendingSignalsEncoding",
+		SearchAttributes:                map[string][]byte{"key": []byte("value")},
+		RescheduleReasons:               []byte("rescheduleReasons"),
+		RescheduleReasonsEncoding:       "rescheduleReasonsEncoding",
+		ResultSearchAttributes:          []byte("resultSearchAttributes"),
+		ResultSearchAttributesEncoding:  "resultSearchAttributesEncoding",
+		Priority:                        common.Int32Ptr(1),
 	}
 	actual := activityInfoFromThrift(activityInfoToThrift(expected))
 	assert.Equal(t, expected.Version, actual.Version)
@@ -367,6 +422,49 @@ func TestActivityInfo(t *testing.T) {
 	assert.Equal(t, expected.RetryLastFailureReason, actual.RetryLastFailureReason)
 	assert.Equal(t, expected.RetryLastWorkerIdentity, actual.RetryLastWorkerIdentity)
 	assert.Equal(t, expected.RetryLastFailureDetails, actual.RetryLastFailureDetails)
+	assert.Equal(t, expected.RoutingKey, actual.RoutingKey)
+	assert.Equal(t, expected.VisibilityTimeout, actual.VisibilityTimeout)
+	assert.Equal(t, expected.PrefetchLeased, actual.PrefetchLeased)
+	assert.Equal(t, expected.FallbackTaskList, actual.FallbackTaskList)
+	assert.Equal(t, expected.ScheduleToStartTimeouts, actual.ScheduleToStartTimeouts)
+	assert.Equal(t, expected.EncryptionKeyID, actual.EncryptionKeyID)
+	assert.Equal(t, expected.NextActivity, actual.NextActivity)
+	assert.Equal(t, expected.NextActivityEncoding, actual.NextActivityEncoding)
+	assert.Equal(t, expected.AtMostOnce, actual.AtMostOnce)
+	assert.Equal(t, expected.DependsOnActivityID, actual.DependsOnActivityID)
+	assert.Equal(t, expected.OnDependencyFailure, actual.OnDependencyFailure)
+	assert.Equal(t, expected.TaskListEscalation, actual.TaskListEscalation)
+	assert.Equal(t, expected.FirstAttemptStartToCloseTimeout, actual.FirstAttemptStartToCloseTimeout)
+	assert.Equal(t, expected.MaxHeartbeatGap, actual.MaxHeartbeatGap)
+	assert.Equal(t, expected.GrantedStartToCloseTimeout, actual.GrantedStartToCloseTimeout)
+	assert.Equal(t, expected.MaxTotalExecutionSeconds, actual.MaxTotalExecutionSeconds)
+	assert.Equal(t, expected.TotalExecutionTime, actual.TotalExecutionTime)
+	assert.Equal(t, expected.HeartbeatSequence, actual.HeartbeatSequence)
+	assert.Equal(t, expected.MinimumInterval, actual.MinimumInterval)
+	assert.Equal(t, expected.CancelRequestedTime, actual.CancelRequestedTime)
+	assert.Equal(t, expected.CancelAckTimeout, actual.CancelAckTimeout)
+	assert.Equal(t, expected.CancelForceTimeout, actual.CancelForceTimeout)
+	assert.Equal(t, expected.CancelAckTimeoutExceededTime, actual.CancelAckTimeoutExceededTime)
+	assert.Equal(t, expected.MaintenancePausedTime, actual.MaintenancePausedTime)
+	assert.Equal(t, expected.MaintenanceTimeoutsDeferred, actual.MaintenanceTimeoutsDeferred)
+	assert.Equal(t, expected.NackCount, actual.NackCount)
+	assert.Equal(t, expected.LastNackReason, actual.LastNackReason)
+	assert.Equal(t, expected.CancellationCheckpointGrace, actual.CancellationCheckpointGrace)
+	assert.Equal(t, expected.CancelDeliveredTime, actual.CancelDeliveredTime)
+	assert.Equal(t, expected.AlertOnFailure, actual.AlertOnFailure)
+	assert.Equal(t, expected.StealTimeout, actual.StealTimeout)
+	assert.Equal(t, expected.Idempotent, actual.Idempotent)
+	assert.Equal(t, expected.ProgressPercent, actual.ProgressPercent)
+	assert.Equal(t, expected.StalledHeartbeats, actual.StalledHeartbeats)
+	assert.Equal(t, expected.HeartbeatExpiredTime, actual.HeartbeatExpiredTime)
+	assert.Equal(t, expected.PendingSignals, actual.PendingSignals)
+	assert.Equal(t, expected.PendingSignalsEncoding, actual.PendingSignalsEncoding)
+	assert.Equal(t, expected.SearchAttributes, actual.SearchAttributes)
+	assert.Equal(t, expected.RescheduleReasons, actual.RescheduleReasons)
+	assert.Equal(t, expected.RescheduleReasonsEncoding, actual.RescheduleReasonsEncoding)
+	assert.Equal(t, expected.ResultSearchAttributes, actual.ResultSearchAttributes)
+	assert.Equal(t, expected.ResultSearchAttributesEncoding, actual.ResultSearchAttributesEncoding)
+	assert.Equal(t, expected.Priority, actual.Priority)
 	assert.True(t, (expected.ScheduleToStartTimeout-actual.ScheduleToStartTimeout) < time.Second)
 	assert.True(t, (expected.ScheduleToCloseTimeout-actual.ScheduleToCloseTimeout) < time.Second)
 	assert.True(t, (expected.StartToCloseTimeout-actual.StartToCloseTimeout) < time.Second)
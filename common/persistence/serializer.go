@@ -85,6 +85,22 @@ type (
 		SerializeAsyncWorkflowsConfig(config *types.AsyncWorkflowConfiguration, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeAsyncWorkflowsConfig(data *DataBlob) (*types.AsyncWorkflowConfiguration, error)
 
+		// serialize/deserialize the attributes of the activity scheduled once an activity completes
+		SerializeScheduleActivityTaskDecisionAttributes(attributes *types.ScheduleActivityTaskDecisionAttributes, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeScheduleActivityTaskDecisionAttributes(data *DataBlob) (*types.ScheduleActivityTaskDecisionAttributes, error)
+
+		// serialize/deserialize the signals pending delivery to an activity
+		SerializeActivitySignals(signals []*types.ActivitySignal, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeActivitySignals(data *DataBlob) ([]*types.ActivitySignal, error)
+
+		// serialize/deserialize the causes for which an activity was scheduled again
+		SerializeActivityRescheduleReasons(rescheduleReasons []*types.ActivityRescheduleReason, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeActivityRescheduleReasons(data *DataBlob) ([]*types.ActivityRescheduleReason, error)
+
+		// serialize/deserialize the search attributes extracted from the result of an activity
+		SerializeActivityResultSearchAttributes(resultSearchAttributes []*types.ActivityResultSearchAttribute, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeActivityResultSearchAttributes(data *DataBlob) ([]*types.ActivityResultSearchAttribute, error)
+
 		// serialize/deserialize checksum
 		SerializeChecksum(sum checksum.Checksum, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeChecksum(data *DataBlob) (checksum.Checksum, error)
@@ -305,6 +321,79 @@ func (t *serializerImpl) DeserializeAsyncWorkflowsConfig(data *DataBlob) (*types
 	return &cfg, err
 }
 
+func (t *serializerImpl) SerializeScheduleActivityTaskDecisionAttributes(attributes *types.ScheduleActivityTaskDecisionAttributes, encodingType common.EncodingType) (*DataBlob, error) {
+	if attributes == nil {
+		return nil, nil
+	}
+	return t.serialize(attributes, encodingType)
+}
+
+func (t *serializerImpl) DeserializeScheduleActivityTaskDecisionAttributes(data *DataBlob) (*types.ScheduleActivityTaskDecisionAttributes, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var attributes types.ScheduleActivityTaskDecisionAttributes
+	err := t.deserialize(data, &attributes)
+	return &attributes, err
+}
+
+func (t *serializerImpl) SerializeActivitySignals(signals []*types.ActivitySignal, encodingType common.EncodingType) (*DataBlob, error) {
+	if signals == nil {
+		return nil, nil
+	}
+	return t.serialize(signals, encodingType)
+}
+
+func (t *serializerImpl) DeserializeActivitySignals(data *DataBlob) ([]*types.ActivitySignal, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var signals []*types.ActivitySignal
+	if len(data.Data) == 0 {
+		return signals, nil
+	}
+	err := t.deserialize(data, &signals)
+	return signals, err
+}
+
+func (t *serializerImpl) SerializeActivityRescheduleReasons(rescheduleReasons []*types.ActivityRescheduleReason, encodingType common.EncodingType) (*DataBlob, error) {
+	if rescheduleReasons == nil {
+		return nil, nil
+	}
+	return t.serialize(rescheduleReasons, encodingType)
+}
+
+func (t *serializerImpl) DeserializeActivityRescheduleReasons(data *DataBlob) ([]*types.ActivityRescheduleReason, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var rescheduleReasons []*types.ActivityRescheduleReason
+	if len(data.Data) == 0 {
+		return rescheduleReasons, nil
+	}
+	err := t.deserialize(data, &rescheduleReasons)
+	return rescheduleReasons, err
+}
+
+func (t *serializerImpl) SerializeActivityResultSearchAttributes(resultSearchAttributes []*types.ActivityResultSearchAttribute, encodingType common.EncodingType) (*DataBlob, error) {
+	if resultSearchAttributes == nil {
+		return nil, nil
+	}
+	return t.serialize(resultSearchAttributes, encodingType)
+}
+
+func (t *serializerImpl) DeserializeActivityResultSearchAttributes(data *DataBlob) ([]*types.ActivityResultSearchAttribute, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var resultSearchAttributes []*types.ActivityResultSearchAttribute
+	if len(data.Data) == 0 {
+		return resultSearchAttributes, nil
+	}
+	err := t.deserialize(data, &resultSearchAttributes)
+	return resultSearchAttributes, err
+}
+
 func (t *serializerImpl) SerializeChecksum(sum checksum.Checksum, encodingType common.EncodingType) (*DataBlob, error) {
 	if len(sum.Value) == 0 {
 		return nil, nil
@@ -378,6 +467,14 @@ func (t *serializerImpl) thriftrwEncode(input interface{}) ([]byte, error) {
 		return t.thriftrwEncoder.Encode(thrift.FromIsolationGroupConfig(input))
 	case *types.AsyncWorkflowConfiguration:
 		return t.thriftrwEncoder.Encode(thrift.FromDomainAsyncWorkflowConfiguraton(input))
+	case *types.ScheduleActivityTaskDecisionAttributes:
+		return t.thriftrwEncoder.Encode(thrift.FromScheduleActivityTaskDecisionAttributes(input))
+	case []*types.ActivitySignal:
+		return t.thriftrwEncoder.Encode(&history.ActivitySignals{Signals: thrift.FromActivitySignalArray(input)})
+	case []*types.ActivityRescheduleReason:
+		return t.thriftrwEncoder.Encode(&history.ActivityRescheduleReasons{Reasons: thrift.FromActivityRescheduleReasonArray(input)})
+	case []*types.ActivityResultSearchAttribute:
+		return t.thriftrwEncoder.Encode(&history.ActivityResultSearchAttributes{Attributes: thrift.FromActivityResultSearchAttributeArray(input)})
 	default:
 		return nil, nil
 	}
@@ -486,6 +583,34 @@ func (t *serializerImpl) thriftrwDecode(data []byte, target interface{}) error {
 		}
 		*target = *thrift.ToDomainAsyncWorkflowConfiguraton(&thriftTarget)
 		return nil
+	case *types.ScheduleActivityTaskDecisionAttributes:
+		thriftTarget := workflow.ScheduleActivityTaskDecisionAttributes{}
+		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
+			return err
+		}
+		*target = *thrift.ToScheduleActivityTaskDecisionAttributes(&thriftTarget)
+		return nil
+	case *[]*types.ActivitySignal:
+		thriftTarget := history.ActivitySignals{}
+		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
+			return err
+		}
+		*target = thrift.ToActivitySignalArray(thriftTarget.GetSignals())
+		return nil
+	case *[]*types.ActivityRescheduleReason:
+		thriftTarget := history.ActivityRescheduleReasons{}
+		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
+			return err
+		}
+		*target = thrift.ToActivityRescheduleReasonArray(thriftTarget.GetReasons())
+		return nil
+	case *[]*types.ActivityResultSearchAttribute:
+		thriftTarget := history.ActivityResultSearchAttributes{}
+		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
+			return err
+		}
+		*target = thrift.ToActivityResultSearchAttributeArray(thriftTarget.GetAttributes())
+		return nil
 	default:
 		return nil
 	}
@@ -65,6 +65,51 @@ func (m *MockPayloadSerializer) EXPECT() *MockPayloadSerializerMockRecorder {
 	return m.recorder
 }
 
+// DeserializeActivityRescheduleReasons mocks base method.
+func (m *MockPayloadSerializer) DeserializeActivityRescheduleReasons(data *DataBlob) ([]*types.ActivityRescheduleReason, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeserializeActivityRescheduleReasons", data)
+	ret0, _ := ret[0].([]*types.ActivityRescheduleReason)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeserializeActivityRescheduleReasons indicates an expected call of DeserializeActivityRescheduleReasons.
+func (mr *MockPayloadSerializerMockRecorder) DeserializeActivityRescheduleReasons(data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeserializeActivityRescheduleReasons", reflect.TypeOf((*MockPayloadSerializer)(nil).DeserializeActivityRescheduleReasons), data)
+}
+
+// DeserializeActivityResultSearchAttributes mocks base method.
+func (m *MockPayloadSerializer) DeserializeActivityResultSearchAttributes(data *DataBlob) ([]*types.ActivityResultSearchAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeserializeActivityResultSearchAttributes", data)
+	ret0, _ := ret[0].([]*types.ActivityResultSearchAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeserializeActivityResultSearchAttributes indicates an expected call of DeserializeActivityResultSearchAttributes.
+func (mr *MockPayloadSerializerMockRecorder) DeserializeActivityResultSearchAttributes(data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeserializeActivityResultSearchAttributes", reflect.TypeOf((*MockPayloadSerializer)(nil).DeserializeActivityResultSearchAttributes), data)
+}
+
+// DeserializeActivitySignals mocks base method.
+func (m *MockPayloadSerializer) DeserializeActivitySignals(data *DataBlob) ([]*types.ActivitySignal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeserializeActivitySignals", data)
+	ret0, _ := ret[0].([]*types.ActivitySignal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeserializeActivitySignals indicates an expected call of DeserializeActivitySignals.
+func (mr *MockPayloadSerializerMockRecorder) DeserializeActivitySignals(data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeserializeActivitySignals", reflect.TypeOf((*MockPayloadSerializer)(nil).DeserializeActivitySignals), data)
+}
+
 // DeserializeAsyncWorkflowsConfig mocks base method.
 func (m *MockPayloadSerializer) DeserializeAsyncWorkflowsConfig(data *DataBlob) (*types.AsyncWorkflowConfiguration, error) {
 	m.ctrl.T.Helper()
@@ -215,6 +260,21 @@ func (mr *MockPayloadSerializerMockRecorder) DeserializeResetPoints(data any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeserializeResetPoints", reflect.TypeOf((*MockPayloadSerializer)(nil).DeserializeResetPoints), data)
 }
 
+// DeserializeScheduleActivityTaskDecisionAttributes mocks base method.
+func (m *MockPayloadSerializer) DeserializeScheduleActivityTaskDecisionAttributes(data *DataBlob) (*types.ScheduleActivityTaskDecisionAttributes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeserializeScheduleActivityTaskDecisionAttributes", data)
+	ret0, _ := ret[0].(*types.ScheduleActivityTaskDecisionAttributes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeserializeScheduleActivityTaskDecisionAttributes indicates an expected call of DeserializeScheduleActivityTaskDecisionAttributes.
+func (mr *MockPayloadSerializerMockRecorder) DeserializeScheduleActivityTaskDecisionAttributes(data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeserializeScheduleActivityTaskDecisionAttributes", reflect.TypeOf((*MockPayloadSerializer)(nil).DeserializeScheduleActivityTaskDecisionAttributes), data)
+}
+
 // DeserializeVersionHistories mocks base method.
 func (m *MockPayloadSerializer) DeserializeVersionHistories(data *DataBlob) (*types.VersionHistories, error) {
 	m.ctrl.T.Helper()
@@ -245,6 +305,51 @@ func (mr *MockPayloadSerializerMockRecorder) DeserializeVisibilityMemo(data any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeserializeVisibilityMemo", reflect.TypeOf((*MockPayloadSerializer)(nil).DeserializeVisibilityMemo), data)
 }
 
+// SerializeActivityRescheduleReasons mocks base method.
+func (m *MockPayloadSerializer) SerializeActivityRescheduleReasons(rescheduleReasons []*types.ActivityRescheduleReason, encodingType common.EncodingType) (*DataBlob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SerializeActivityRescheduleReasons", rescheduleReasons, encodingType)
+	ret0, _ := ret[0].(*DataBlob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SerializeActivityRescheduleReasons indicates an expected call of SerializeActivityRescheduleReasons.
+func (mr *MockPayloadSerializerMockRecorder) SerializeActivityRescheduleReasons(rescheduleReasons, encodingType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerializeActivityRescheduleReasons", reflect.TypeOf((*MockPayloadSerializer)(nil).SerializeActivityRescheduleReasons), rescheduleReasons, encodingType)
+}
+
+// SerializeActivityResultSearchAttributes mocks base method.
+func (m *MockPayloadSerializer) SerializeActivityResultSearchAttributes(resultSearchAttributes []*types.ActivityResultSearchAttribute, encodingType common.EncodingType) (*DataBlob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SerializeActivityResultSearchAttributes", resultSearchAttributes, encodingType)
+	ret0, _ := ret[0].(*DataBlob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SerializeActivityResultSearchAttributes indicates an expected call of SerializeActivityResultSearchAttributes.
+func (mr *MockPayloadSerializerMockRecorder) SerializeActivityResultSearchAttributes(resultSearchAttributes, encodingType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerializeActivityResultSearchAttributes", reflect.TypeOf((*MockPayloadSerializer)(nil).SerializeActivityResultSearchAttributes), resultSearchAttributes, encodingType)
+}
+
+// SerializeActivitySignals mocks base method.
+func (m *MockPayloadSerializer) SerializeActivitySignals(signals []*types.ActivitySignal, encodingType common.EncodingType) (*DataBlob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SerializeActivitySignals", signals, encodingType)
+	ret0, _ := ret[0].(*DataBlob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SerializeActivitySignals indicates an expected call of SerializeActivitySignals.
+func (mr *MockPayloadSerializerMockRecorder) SerializeActivitySignals(signals, encodingType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerializeActivitySignals", reflect.TypeOf((*MockPayloadSerializer)(nil).SerializeActivitySignals), signals, encodingType)
+}
+
 // SerializeAsyncWorkflowsConfig mocks base method.
 func (m *MockPayloadSerializer) SerializeAsyncWorkflowsConfig(config *types.AsyncWorkflowConfiguration, encodingType common.EncodingType) (*DataBlob, error) {
 	m.ctrl.T.Helper()
@@ -395,6 +500,21 @@ func (mr *MockPayloadSerializerMockRecorder) SerializeResetPoints(event, encodin
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerializeResetPoints", reflect.TypeOf((*MockPayloadSerializer)(nil).SerializeResetPoints), event, encodingType)
 }
 
+// SerializeScheduleActivityTaskDecisionAttributes mocks base method.
+func (m *MockPayloadSerializer) SerializeScheduleActivityTaskDecisionAttributes(attributes *types.ScheduleActivityTaskDecisionAttributes, encodingType common.EncodingType) (*DataBlob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SerializeScheduleActivityTaskDecisionAttributes", attributes, encodingType)
+	ret0, _ := ret[0].(*DataBlob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SerializeScheduleActivityTaskDecisionAttributes indicates an expected call of SerializeScheduleActivityTaskDecisionAttributes.
+func (mr *MockPayloadSerializerMockRecorder) SerializeScheduleActivityTaskDecisionAttributes(attributes, encodingType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerializeScheduleActivityTaskDecisionAttributes", reflect.TypeOf((*MockPayloadSerializer)(nil).SerializeScheduleActivityTaskDecisionAttributes), attributes, encodingType)
+}
+
 // SerializeVersionHistories mocks base method.
 func (m *MockPayloadSerializer) SerializeVersionHistories(histories *types.VersionHistories, encodingType common.EncodingType) (*DataBlob, error) {
 	m.ctrl.T.Helper()
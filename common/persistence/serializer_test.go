@@ -211,6 +211,58 @@ func TestSerializers(t *testing.T) {
 				return serializer.DeserializeAsyncWorkflowsConfig(data)
 			},
 		},
+		{
+			name: "schedule activity task decision attributes",
+			payloads: map[string]any{
+				"nil":    (*types.ScheduleActivityTaskDecisionAttributes)(nil),
+				"normal": generateScheduleActivityTaskDecisionAttributes(),
+			},
+			serializeFn: func(payload any, encoding common.EncodingType) (*DataBlob, error) {
+				return serializer.SerializeScheduleActivityTaskDecisionAttributes(payload.(*types.ScheduleActivityTaskDecisionAttributes), encoding)
+			},
+			deserializeFn: func(data *DataBlob) (any, error) {
+				return serializer.DeserializeScheduleActivityTaskDecisionAttributes(data)
+			},
+		},
+		{
+			name: "activity signals",
+			payloads: map[string]any{
+				"nil":    ([]*types.ActivitySignal)(nil),
+				"normal": generateActivitySignals(),
+			},
+			serializeFn: func(payload any, encoding common.EncodingType) (*DataBlob, error) {
+				return serializer.SerializeActivitySignals(payload.([]*types.ActivitySignal), encoding)
+			},
+			deserializeFn: func(data *DataBlob) (any, error) {
+				return serializer.DeserializeActivitySignals(data)
+			},
+		},
+		{
+			name: "activity reschedule reasons",
+			payloads: map[string]any{
+				"nil":    ([]*types.ActivityRescheduleReason)(nil),
+				"normal": generateActivityRescheduleReasons(),
+			},
+			serializeFn: func(payload any, encoding common.EncodingType) (*DataBlob, error) {
+				return serializer.SerializeActivityRescheduleReasons(payload.([]*types.ActivityRescheduleReason), encoding)
+			},
+			deserializeFn: func(data *DataBlob) (any, error) {
+				return serializer.DeserializeActivityRescheduleReasons(data)
+			},
+		},
+		{
+			name: "activity result search attributes",
+			payloads: map[string]any{
+				"nil":    ([]*types.ActivityResultSearchAttribute)(nil),
+				"normal": generateActivityResultSearchAttributes(),
+			},
+			serializeFn: func(payload any, encoding common.EncodingType) (*DataBlob, error) {
+				return serializer.SerializeActivityResultSearchAttributes(payload.([]*types.ActivityResultSearchAttribute), encoding)
+			},
+			deserializeFn: func(data *DataBlob) (any, error) {
+				return serializer.DeserializeActivityResultSearchAttributes(data)
+			},
+		},
 		{
 			name: "checksum",
 			payloads: map[string]any{
@@ -495,3 +547,66 @@ func generateChecksum() checksum.Checksum {
 		Value:   []byte("test-checksum"),
 	}
 }
+
+func generateScheduleActivityTaskDecisionAttributes() *types.ScheduleActivityTaskDecisionAttributes {
+	return &types.ScheduleActivityTaskDecisionAttributes{
+		ActivityID:   "activity-1",
+		ActivityType: &types.ActivityType{Name: "activity-type"},
+		Domain:       "domain",
+		TaskList:     &types.TaskList{Name: "task-list"},
+		NextActivity: &types.ScheduleActivityTaskDecisionAttributes{
+			ActivityID:   "activity-2",
+			ActivityType: &types.ActivityType{Name: "activity-type"},
+			Domain:       "domain",
+			TaskList:     &types.TaskList{Name: "task-list"},
+		},
+	}
+}
+
+func generateActivitySignals() []*types.ActivitySignal {
+	return []*types.ActivitySignal{
+		{
+			SignalName: "signal-1",
+			Identity:   "identity",
+			Timestamp:  common.Int64Ptr(time.Now().UnixNano()),
+		},
+		{
+			SignalName: "signal-2",
+			Identity:   "identity",
+			Timestamp:  common.Int64Ptr(time.Now().UnixNano()),
+		},
+	}
+}
+
+func generateActivityRescheduleReasons() []*types.ActivityRescheduleReason {
+	return []*types.ActivityRescheduleReason{
+		{
+			Attempt:          0,
+			Cause:            types.ActivityRescheduleCauseFailure.Ptr(),
+			Timestamp:        common.Int64Ptr(time.Now().UnixNano()),
+			Identity:         "identity",
+			StartedTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+			TaskList:         "tasklist",
+		},
+		{
+			Attempt:   1,
+			Cause:     types.ActivityRescheduleCauseHeartbeatTimeout.Ptr(),
+			Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+			Identity:  "identity",
+			TaskList:  "tasklist",
+		},
+	}
+}
+
+func generateActivityResultSearchAttributes() []*types.ActivityResultSearchAttribute {
+	return []*types.ActivityResultSearchAttribute{
+		{
+			JSONPath:           "$.order.id",
+			SearchAttributeKey: "OrderID",
+		},
+		{
+			JSONPath:           "$.order.items[0].sku",
+			SearchAttributeKey: "FirstItemSKU",
+		},
+	}
+}
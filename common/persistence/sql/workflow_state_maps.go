@@ -49,39 +49,86 @@ func updateActivityInfos(
 		for i, activityInfo := range activityInfos {
 			scheduledEvent, scheduledEncoding := persistence.FromDataBlob(activityInfo.ScheduledEvent)
 			startEvent, startEncoding := persistence.FromDataBlob(activityInfo.StartedEvent)
+			nextActivity, nextActivityEncoding := persistence.FromDataBlob(activityInfo.NextActivity)
+			pendingSignals, pendingSignalsEncoding := persistence.FromDataBlob(activityInfo.PendingSignals)
+			resultSearchAttributes, resultSearchAttributesEncoding := persistence.FromDataBlob(activityInfo.ResultSearchAttributes)
+			rescheduleReasons, rescheduleReasonsEncoding := persistence.FromDataBlob(activityInfo.RescheduleReasons)
 
 			info := &serialization.ActivityInfo{
-				Version:                  activityInfo.Version,
-				ScheduledEventBatchID:    activityInfo.ScheduledEventBatchID,
-				ScheduledEvent:           scheduledEvent,
-				ScheduledEventEncoding:   scheduledEncoding,
-				ScheduledTimestamp:       activityInfo.ScheduledTime,
-				StartedID:                activityInfo.StartedID,
-				StartedEvent:             startEvent,
-				StartedEventEncoding:     startEncoding,
-				StartedTimestamp:         activityInfo.StartedTime,
-				ActivityID:               activityInfo.ActivityID,
-				RequestID:                activityInfo.RequestID,
-				ScheduleToStartTimeout:   activityInfo.ScheduleToStartTimeout,
-				ScheduleToCloseTimeout:   activityInfo.ScheduleToCloseTimeout,
-				StartToCloseTimeout:      activityInfo.StartToCloseTimeout,
-				HeartbeatTimeout:         activityInfo.HeartbeatTimeout,
-				CancelRequested:          activityInfo.CancelRequested,
-				CancelRequestID:          activityInfo.CancelRequestID,
-				TimerTaskStatus:          activityInfo.TimerTaskStatus,
-				Attempt:                  activityInfo.Attempt,
-				TaskList:                 activityInfo.TaskList,
-				StartedIdentity:          activityInfo.StartedIdentity,
-				HasRetryPolicy:           activityInfo.HasRetryPolicy,
-				RetryInitialInterval:     activityInfo.InitialInterval,
-				RetryBackoffCoefficient:  activityInfo.BackoffCoefficient,
-				RetryMaximumInterval:     activityInfo.MaximumInterval,
-				RetryExpirationTimestamp: activityInfo.ExpirationTime,
-				RetryMaximumAttempts:     activityInfo.MaximumAttempts,
-				RetryNonRetryableErrors:  activityInfo.NonRetriableErrors,
-				RetryLastFailureReason:   activityInfo.LastFailureReason,
-				RetryLastWorkerIdentity:  activityInfo.LastWorkerIdentity,
-				RetryLastFailureDetails:  activityInfo.LastFailureDetails,
+				Version:                         activityInfo.Version,
+				ScheduledEventBatchID:           activityInfo.ScheduledEventBatchID,
+				ScheduledEvent:                  scheduledEvent,
+				ScheduledEventEncoding:          scheduledEncoding,
+				ScheduledTimestamp:              activityInfo.ScheduledTime,
+				StartedID:                       activityInfo.StartedID,
+				StartedEvent:                    startEvent,
+				StartedEventEncoding:            startEncoding,
+				StartedTimestamp:                activityInfo.StartedTime,
+				ActivityID:                      activityInfo.ActivityID,
+				RequestID:                       activityInfo.RequestID,
+				ScheduleToStartTimeout:          activityInfo.ScheduleToStartTimeout,
+				ScheduleToCloseTimeout:          activityInfo.ScheduleToCloseTimeout,
+				StartToCloseTimeout:             activityInfo.StartToCloseTimeout,
+				HeartbeatTimeout:                activityInfo.HeartbeatTimeout,
+				CancelRequested:                 activityInfo.CancelRequested,
+				CancelRequestID:                 activityInfo.CancelRequestID,
+				TimerTaskStatus:                 activityInfo.TimerTaskStatus,
+				Attempt:                         activityInfo.Attempt,
+				TaskList:                        activityInfo.TaskList,
+				StartedIdentity:                 activityInfo.StartedIdentity,
+				HasRetryPolicy:                  activityInfo.HasRetryPolicy,
+				RetryInitialInterval:            activityInfo.InitialInterval,
+				RetryBackoffCoefficient:         activityInfo.BackoffCoefficient,
+				RetryMaximumInterval:            activityInfo.MaximumInterval,
+				RetryExpirationTimestamp:        activityInfo.ExpirationTime,
+				RetryMaximumAttempts:            activityInfo.MaximumAttempts,
+				RetryNonRetryableErrors:         activityInfo.NonRetriableErrors,
+				RetryLastFailureReason:          activityInfo.LastFailureReason,
+				RetryLastWorkerIdentity:         activityInfo.LastWorkerIdentity,
+				RetryLastFailureDetails:         activityInfo.LastFailureDetails,
+				RoutingKey:                      activityInfo.RoutingKey,
+				VisibilityTimeout:               activityInfo.VisibilityTimeout,
+				PrefetchLeased:                  activityInfo.PrefetchLeased,
+				FallbackTaskList:                activityInfo.FallbackTaskList,
+				ScheduleToStartTimeouts:         activityInfo.ScheduleToStartTimeouts,
+				EncryptionKeyID:                 activityInfo.EncryptionKeyID,
+				NextActivity:                    nextActivity,
+				NextActivityEncoding:            nextActivityEncoding,
+				AtMostOnce:                      activityInfo.AtMostOnce,
+				DependsOnActivityID:             activityInfo.DependsOnActivityID,
+				OnDependencyFailure:             int32(activityInfo.OnDependencyFailure),
+				TaskListEscalation:              activityInfo.TaskListEscalation,
+				FirstAttemptStartToCloseTimeout: activityInfo.FirstAttemptStartToCloseTimeout,
+				MaxHeartbeatGap:                 activityInfo.MaxHeartbeatGap,
+				GrantedStartToCloseTimeout:      activityInfo.GrantedStartToCloseTimeout,
+				MaxTotalExecutionSeconds:        activityInfo.MaxTotalExecutionSeconds,
+				TotalExecutionTime:              activityInfo.TotalExecutionTime,
+				HeartbeatSequence:               activityInfo.HeartbeatSequence,
+				MinimumInterval:                 activityInfo.MinimumInterval,
+				CancelRequestedTime:             activityInfo.CancelRequestedTime,
+				CancelAckTimeout:                activityInfo.CancelAckTimeout,
+				CancelForceTimeout:              activityInfo.CancelForceTimeout,
+				CancelAckTimeoutExceededTime:    activityInfo.CancelAckTimeoutExceededTime,
+				MaintenancePausedTime:           activityInfo.MaintenancePausedTime,
+				MaintenanceTimeoutsDeferred:     activityInfo.MaintenanceTimeoutsDeferred,
+				NackCount:                       activityInfo.NackCount,
+				LastNackReason:                  activityInfo.LastNackReason,
+				CancellationCheckpointGrace:     activityInfo.CancellationCheckpointGrace,
+				CancelDeliveredTime:             activityInfo.CancelDeliveredTime,
+				AlertOnFailure:                  activityInfo.AlertOnFailure,
+				StealTimeout:                    activityInfo.StealTimeout,
+				Idempotent:                      activityInfo.Idempotent,
+				ProgressPercent:                 activityInfo.ProgressPercent,
+				StalledHeartbeats:               activityInfo.StalledHeartbeats,
+				HeartbeatExpiredTime:            activityInfo.HeartbeatExpiredTime,
+				PendingSignals:                  pendingSignals,
+				PendingSignalsEncoding:          pendingSignalsEncoding,
+				SearchAttributes:                activityInfo.SearchAttributes,
+				RescheduleReasons:               rescheduleReasons,
+				RescheduleReasonsEncoding:       rescheduleReasonsEncoding,
+				ResultSearchAttributes:          resultSearchAttributes,
+				ResultSearchAttributesEncoding:  resultSearchAttributesEncoding,
+				Priority:                        activityInfo.Priority,
 			}
 			blob, err := parser.ActivityInfoToBlob(info)
 			if err != nil {
@@ -147,38 +194,77 @@ func getActivityInfoMap(
 			return nil, err
 		}
 		info := &persistence.InternalActivityInfo{
-			DomainID:                 row.DomainID.String(),
-			ScheduleID:               row.ScheduleID,
-			Details:                  row.LastHeartbeatDetails,
-			LastHeartBeatUpdatedTime: row.LastHeartbeatUpdatedTime,
-			Version:                  decoded.GetVersion(),
-			ScheduledEventBatchID:    decoded.GetScheduledEventBatchID(),
-			ScheduledEvent:           persistence.NewDataBlob(decoded.ScheduledEvent, common.EncodingType(decoded.GetScheduledEventEncoding())),
-			ScheduledTime:            decoded.GetScheduledTimestamp(),
-			StartedID:                decoded.GetStartedID(),
-			StartedTime:              decoded.GetStartedTimestamp(),
-			ActivityID:               decoded.GetActivityID(),
-			RequestID:                decoded.GetRequestID(),
-			ScheduleToStartTimeout:   decoded.GetScheduleToStartTimeout(),
-			ScheduleToCloseTimeout:   decoded.GetScheduleToCloseTimeout(),
-			StartToCloseTimeout:      decoded.GetStartToCloseTimeout(),
-			HeartbeatTimeout:         decoded.GetHeartbeatTimeout(),
-			CancelRequested:          decoded.GetCancelRequested(),
-			CancelRequestID:          decoded.GetCancelRequestID(),
-			TimerTaskStatus:          decoded.GetTimerTaskStatus(),
-			Attempt:                  decoded.GetAttempt(),
-			StartedIdentity:          decoded.GetStartedIdentity(),
-			TaskList:                 decoded.GetTaskList(),
-			HasRetryPolicy:           decoded.GetHasRetryPolicy(),
-			InitialInterval:          decoded.GetRetryInitialInterval(),
-			BackoffCoefficient:       decoded.GetRetryBackoffCoefficient(),
-			MaximumInterval:          decoded.GetRetryMaximumInterval(),
-			ExpirationTime:           decoded.GetRetryExpirationTimestamp(),
-			MaximumAttempts:          decoded.GetRetryMaximumAttempts(),
-			NonRetriableErrors:       decoded.GetRetryNonRetryableErrors(),
-			LastFailureReason:        decoded.GetRetryLastFailureReason(),
-			LastWorkerIdentity:       decoded.GetRetryLastWorkerIdentity(),
-			LastFailureDetails:       decoded.GetRetryLastFailureDetails(),
+			DomainID:                        row.DomainID.String(),
+			ScheduleID:                      row.ScheduleID,
+			Details:                         row.LastHeartbeatDetails,
+			LastHeartBeatUpdatedTime:        row.LastHeartbeatUpdatedTime,
+			Version:                         decoded.GetVersion(),
+			ScheduledEventBatchID:           decoded.GetScheduledEventBatchID(),
+			ScheduledEvent:                  persistence.NewDataBlob(decoded.ScheduledEvent, common.EncodingType(decoded.GetScheduledEventEncoding())),
+			ScheduledTime:                   decoded.GetScheduledTimestamp(),
+			StartedID:                       decoded.GetStartedID(),
+			StartedTime:                     decoded.GetStartedTimestamp(),
+			ActivityID:                      decoded.GetActivityID(),
+			RequestID:                       decoded.GetRequestID(),
+			ScheduleToStartTimeout:          decoded.GetScheduleToStartTimeout(),
+			ScheduleToCloseTimeout:          decoded.GetScheduleToCloseTimeout(),
+			StartToCloseTimeout:             decoded.GetStartToCloseTimeout(),
+			HeartbeatTimeout:                decoded.GetHeartbeatTimeout(),
+			CancelRequested:                 decoded.GetCancelRequested(),
+			CancelRequestID:                 decoded.GetCancelRequestID(),
+			TimerTaskStatus:                 decoded.GetTimerTaskStatus(),
+			Attempt:                         decoded.GetAttempt(),
+			StartedIdentity:                 decoded.GetStartedIdentity(),
+			TaskList:                        decoded.GetTaskList(),
+			HasRetryPolicy:                  decoded.GetHasRetryPolicy(),
+			InitialInterval:                 decoded.GetRetryInitialInterval(),
+			BackoffCoefficient:              decoded.GetRetryBackoffCoefficient(),
+			MaximumInterval:                 decoded.GetRetryMaximumInterval(),
+			ExpirationTime:                  decoded.GetRetryExpirationTimestamp(),
+			MaximumAttempts:                 decoded.GetRetryMaximumAttempts(),
+			NonRetriableErrors:              decoded.GetRetryNonRetryableErrors(),
+			LastFailureReason:               decoded.GetRetryLastFailureReason(),
+			LastWorkerIdentity:              decoded.GetRetryLastWorkerIdentity(),
+			LastFailureDetails:              decoded.GetRetryLastFailureDetails(),
+			RoutingKey:                      decoded.GetRoutingKey(),
+			VisibilityTimeout:               decoded.GetVisibilityTimeout(),
+			PrefetchLeased:                  decoded.GetPrefetchLeased(),
+			FallbackTaskList:                decoded.GetFallbackTaskList(),
+			ScheduleToStartTimeouts:         decoded.GetScheduleToStartTimeouts(),
+			EncryptionKeyID:                 decoded.GetEncryptionKeyID(),
+			NextActivity:                    persistence.NewDataBlob(decoded.NextActivity, common.EncodingType(decoded.GetNextActivityEncoding())),
+			AtMostOnce:                      decoded.GetAtMostOnce(),
+			DependsOnActivityID:             decoded.GetDependsOnActivityID(),
+			OnDependencyFailure:             types.ActivityDependencyFailurePolicy(decoded.GetOnDependencyFailure()),
+			TaskListEscalation:              decoded.GetTaskListEscalation(),
+			FirstAttemptStartToCloseTimeout: decoded.GetFirstAttemptStartToCloseTimeout(),
+			MaxHeartbeatGap:                 decoded.GetMaxHeartbeatGap(),
+			GrantedStartToCloseTimeout:      decoded.GetGrantedStartToCloseTimeout(),
+			MaxTotalExecutionSeconds:        decoded.GetMaxTotalExecutionSeconds(),
+			TotalExecutionTime:              decoded.GetTotalExecutionTime(),
+			HeartbeatSequence:               decoded.GetHeartbeatSequence(),
+			MinimumInterval:                 decoded.GetMinimumInterval(),
+			CancelRequestedTime:             decoded.GetCancelRequestedTime(),
+			CancelAckTimeout:                decoded.GetCancelAckTimeout(),
+			CancelForceTimeout:              decoded.GetCancelForceTimeout(),
+			CancelAckTimeoutExceededTime:    decoded.GetCancelAckTimeoutExceededTime(),
+			MaintenancePausedTime:           decoded.GetMaintenancePausedTime(),
+			MaintenanceTimeoutsDeferred:     decoded.GetMaintenanceTimeoutsDeferred(),
+			NackCount:                       decoded.GetNackCount(),
+			LastNackReason:                  decoded.GetLastNackReason(),
+			CancellationCheckpointGrace:     decoded.GetCancellationCheckpointGrace(),
+			CancelDeliveredTime:             decoded.GetCancelDeliveredTime(),
+			AlertOnFailure:                  decoded.GetAlertOnFailure(),
+			StealTimeout:                    decoded.GetStealTimeout(),
+			Idempotent:                      decoded.GetIdempotent(),
+			ProgressPercent:                 decoded.GetProgressPercent(),
+			StalledHeartbeats:               decoded.GetStalledHeartbeats(),
+			HeartbeatExpiredTime:            decoded.GetHeartbeatExpiredTime(),
+			PendingSignals:                  persistence.NewDataBlob(decoded.PendingSignals, common.EncodingType(decoded.GetPendingSignalsEncoding())),
+			SearchAttributes:                decoded.GetSearchAttributes(),
+			RescheduleReasons:               persistence.NewDataBlob(decoded.RescheduleReasons, common.EncodingType(decoded.GetRescheduleReasonsEncoding())),
+			ResultSearchAttributes:          persistence.NewDataBlob(decoded.ResultSearchAttributes, common.EncodingType(decoded.GetResultSearchAttributesEncoding())),
+			Priority:                        decoded.GetPriority(),
 		}
 		if decoded.StartedEvent != nil {
 			info.StartedEvent = persistence.NewDataBlob(decoded.StartedEvent, common.EncodingType(decoded.GetStartedEventEncoding()))
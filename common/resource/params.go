@@ -26,6 +26,8 @@ import (
 
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activityencryption"
+	"github.com/uber/cadence/common/activityvalidator"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/provider"
 	"github.com/uber/cadence/common/asyncworkflow/queue"
@@ -90,10 +92,18 @@ type (
 		OSConfig                   *config.ElasticSearchConfig
 		AsyncWorkflowQueueProvider queue.Provider
 		TimeSource                 clock.TimeSource
+		// ServiceTimeSource is used by integration tests to drive the clock of the service, e.g. the timers of
+		// history, with a mocked time source. If nil, the service runs on the real time source
+		ServiceTimeSource clock.TimeSource
 		// HistoryClientFn is used by integration tests to mock a history client
 		HistoryClientFn func() history.Client
 		// NewPersistenceBeanFn can be used to override the default persistence bean creation in unit tests to avoid DB setup
 		NewPersistenceBeanFn  func(persistenceClient.Factory, *persistenceClient.Params, *service.Config) (persistenceClient.Bean, error)
 		DiagnosticsInvariants []invariant.Invariant
+		// ActivityResultValidator can be nil. If nil, history accepts every activity result
+		ActivityResultValidator activityvalidator.Validator
+		// ActivityPayloadEncryptor can be nil. If nil, payloads of activities scheduled with an EncryptionKeyID
+		// are persisted unencrypted
+		ActivityPayloadEncryptor activityencryption.Encryptor
 	}
 )
@@ -319,6 +319,11 @@ func New(
 	}
 	partitioner := ensurePartitionerOrDefault(params, isolationGroupState)
 
+	timeSource := params.ServiceTimeSource
+	if timeSource == nil {
+		timeSource = clock.NewRealTimeSource()
+	}
+
 	ratelimiterAggs := qrpc.New(
 		historyRawClient, // no retries, will retry internally if needed
 		clientBean.GetHistoryPeers(),
@@ -340,7 +345,7 @@ func New(
 
 		domainCache:             domainCache,
 		domainMetricsScopeCache: domainMetricsScopeCache,
-		timeSource:              clock.NewRealTimeSource(),
+		timeSource:              timeSource,
 		payloadSerializer:       persistence.NewPayloadSerializer(),
 		metricsClient:           params.MetricsClient,
 		messagingClient:         params.MessagingClient,
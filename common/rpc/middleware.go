@@ -206,11 +206,11 @@ func (m *HeaderForwardingMiddleware) Call(ctx context.Context, request *transpor
 // The middleware should always be applied after any other middleware that inject partition config into the context
 // so that it can overwrites the partition config into the context
 // The purpose of this middleware is to make sure the partition config doesn't change when a request is forwarded from
-// passive cluster to the active cluster
+// passive cluster to the active cluster. It also records the cluster the request was forwarded from into the context
 type ForwardPartitionConfigMiddleware struct{}
 
 func (m *ForwardPartitionConfigMiddleware) Handle(ctx context.Context, req *transport.Request, resw transport.ResponseWriter, h transport.UnaryHandler) error {
-	if _, ok := req.Headers.Get(common.AutoforwardingClusterHeaderName); ok {
+	if sourceCluster, ok := req.Headers.Get(common.AutoforwardingClusterHeaderName); ok {
 		var partitionConfig map[string]string
 		if blob, ok := req.Headers.Get(common.PartitionConfigHeaderName); ok && len(blob) > 0 {
 			if err := json.Unmarshal([]byte(blob), &partitionConfig); err != nil {
@@ -220,6 +220,7 @@ func (m *ForwardPartitionConfigMiddleware) Handle(ctx context.Context, req *tran
 		ctx = partition.ContextWithConfig(ctx, partitionConfig)
 		isolationGroup, _ := req.Headers.Get(common.IsolationGroupHeaderName)
 		ctx = partition.ContextWithIsolationGroup(ctx, isolationGroup)
+		ctx = partition.ContextWithSourceCluster(ctx, sourceCluster)
 	}
 	return h.Handle(ctx, req, resw)
 }
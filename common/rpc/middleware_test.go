@@ -186,6 +186,7 @@ func TestForwardPartitionConfigMiddleware(t *testing.T) {
 			ctx                     context.Context
 			expectedPartitionConfig map[string]string
 			expectedIsolationGroup  string
+			expectedSourceCluster   string
 		}{
 			{
 				message: "it injects partition config into context",
@@ -196,6 +197,7 @@ func TestForwardPartitionConfigMiddleware(t *testing.T) {
 				ctx:                     context.Background(),
 				expectedPartitionConfig: partitionConfig,
 				expectedIsolationGroup:  "abc",
+				expectedSourceCluster:   "cluster0",
 			},
 			{
 				message: "it overwrites the existing partition config in the context",
@@ -206,6 +208,7 @@ func TestForwardPartitionConfigMiddleware(t *testing.T) {
 				ctx:                     partition.ContextWithIsolationGroup(partition.ContextWithConfig(context.Background(), map[string]string{"z": "x"}), "fff"),
 				expectedPartitionConfig: partitionConfig,
 				expectedIsolationGroup:  "abc",
+				expectedSourceCluster:   "cluster0",
 			},
 			{
 				message: "it overwrites the existing partition config in the context with nil config",
@@ -214,6 +217,7 @@ func TestForwardPartitionConfigMiddleware(t *testing.T) {
 				ctx:                     partition.ContextWithIsolationGroup(partition.ContextWithConfig(context.Background(), map[string]string{"z": "x"}), "fff"),
 				expectedPartitionConfig: nil,
 				expectedIsolationGroup:  "",
+				expectedSourceCluster:   "cluster0",
 			},
 			{
 				message: "it injects partition config into context only if the request is an auto-fowarding request",
@@ -234,6 +238,7 @@ func TestForwardPartitionConfigMiddleware(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.expectedPartitionConfig, partition.ConfigFromContext(h.ctx))
 				assert.Equal(t, tt.expectedIsolationGroup, partition.IsolationGroupFromContext(h.ctx))
+				assert.Equal(t, tt.expectedSourceCluster, partition.SourceClusterFromContext(h.ctx))
 			})
 		}
 	})
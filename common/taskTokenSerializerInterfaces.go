@@ -41,6 +41,8 @@ type (
 		ScheduleAttempt int64  `json:"scheduleAttempt"`
 		ActivityID      string `json:"activityId"`
 		ActivityType    string `json:"activityType"`
+		// TaskList is the task list an activity task was dispatched from
+		TaskList string `json:"taskList,omitempty"`
 	}
 
 	// QueryTaskToken identifies a query task
@@ -36,6 +36,46 @@ func (v *AddSearchAttributeRequest) GetSearchAttribute() (o map[string]IndexedVa
 	return
 }
 
+// CancelPendingActivitiesRequest is an internal type (TBD...)
+type CancelPendingActivitiesRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Details           []byte             `json:"details,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *CancelPendingActivitiesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *CancelPendingActivitiesRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetDetails is an internal getter (TBD...)
+func (v *CancelPendingActivitiesRequest) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *CancelPendingActivitiesRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // DescribeClusterResponse is an internal type (TBD...)
 type DescribeClusterResponse struct {
 	SupportedClientVersions *SupportedClientVersions    `json:"supportedClientVersions,omitempty"`
@@ -81,6 +121,38 @@ func (v *AdminDescribeWorkflowExecutionResponse) GetMutableStateInDatabase() (o
 	return
 }
 
+// ExpireActivityHeartbeatRequest is an internal type (TBD...)
+type ExpireActivityHeartbeatRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	// ActivityID is the ID of the started activity whose heartbeat timeout fires
+	ActivityID string `json:"activityID,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ExpireActivityHeartbeatRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ExpireActivityHeartbeatRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *ExpireActivityHeartbeatRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
 // GetWorkflowExecutionRawHistoryV2Request is an internal type (TBD...)
 type GetWorkflowExecutionRawHistoryV2Request struct {
 	Domain            string             `json:"domain,omitempty"`
@@ -196,6 +268,37 @@ type PersistenceInfo struct {
 	Features []*PersistenceFeature `json:"features,omitempty"`
 }
 
+// RedriveTimedOutActivitiesRequest is an internal type (TBD...)
+type RedriveTimedOutActivitiesRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *RedriveTimedOutActivitiesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *RedriveTimedOutActivitiesRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *RedriveTimedOutActivitiesRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // ResendReplicationTasksRequest is an internal type (TBD...)
 type ResendReplicationTasksRequest struct {
 	DomainID      string `json:"domainID,omitempty"`
@@ -232,6 +335,72 @@ func (v *ResendReplicationTasksRequest) GetRemoteCluster() (o string) {
 	return
 }
 
+// ReplayActivityCompletionsRequest is an internal type (TBD...)
+type ReplayActivityCompletionsRequest struct {
+	Domain string `json:"domain,omitempty"`
+	// WorkflowExecution is the run created by the reset, the completions are replayed into it
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	// BaseRunID is the run which was reset, the completions are read from its history
+	BaseRunID string `json:"baseRunID,omitempty"`
+	// ScheduledEventIDs are the ActivityTaskScheduled event IDs of the base run whose results are replayed
+	ScheduledEventIDs []int64 `json:"scheduledEventIDs,omitempty"`
+	Identity          string  `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ReplayActivityCompletionsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ReplayActivityCompletionsRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetBaseRunID is an internal getter (TBD...)
+func (v *ReplayActivityCompletionsRequest) GetBaseRunID() (o string) {
+	if v != nil {
+		return v.BaseRunID
+	}
+	return
+}
+
+// GetScheduledEventIDs is an internal getter (TBD...)
+func (v *ReplayActivityCompletionsRequest) GetScheduledEventIDs() (o []int64) {
+	if v != nil && v.ScheduledEventIDs != nil {
+		return v.ScheduledEventIDs
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *ReplayActivityCompletionsRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// ReplayActivityCompletionsResponse is an internal type (TBD...)
+type ReplayActivityCompletionsResponse struct {
+	// ScheduledEventIDs maps the scheduled event IDs of the base run to the ones of the activities completed in the new run
+	ScheduledEventIDs map[int64]int64 `json:"scheduledEventIDs,omitempty"`
+}
+
+// GetScheduledEventIDs is an internal getter (TBD...)
+func (v *ReplayActivityCompletionsResponse) GetScheduledEventIDs() (o map[int64]int64) {
+	if v != nil && v.ScheduledEventIDs != nil {
+		return v.ScheduledEventIDs
+	}
+	return
+}
+
 // RingInfo is an internal type (TBD...)
 type RingInfo struct {
 	Role        string      `json:"role,omitempty"`
@@ -438,3 +607,103 @@ type UpdateTaskListPartitionConfigRequest struct {
 }
 
 type UpdateTaskListPartitionConfigResponse struct{}
+
+// RemoveTaskListTaskRequest is an internal type (TBD...)
+type RemoveTaskListTaskRequest struct {
+	Domain            string
+	TaskList          *TaskList
+	TaskID            int64
+	WorkflowExecution *WorkflowExecution
+	ScheduleID        int64
+	Operator          string
+	Reason            string
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *RemoveTaskListTaskRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// RemoveTaskListTaskResponse is an internal type (TBD...)
+type RemoveTaskListTaskResponse struct {
+	TaskID int64
+}
+
+// DecodeTaskTokenRequest is an internal type (TBD...)
+type DecodeTaskTokenRequest struct {
+	TaskToken []byte `json:"taskToken,omitempty"`
+}
+
+// GetTaskToken is an internal getter (TBD...)
+func (v *DecodeTaskTokenRequest) GetTaskToken() (o []byte) {
+	if v != nil {
+		return v.TaskToken
+	}
+	return
+}
+
+// DecodeTaskTokenResponse holds the identifiers carried by a decision or activity task token
+type DecodeTaskTokenResponse struct {
+	Domain          string `json:"domain,omitempty"`
+	DomainID        string `json:"domainId,omitempty"`
+	WorkflowID      string `json:"workflowId,omitempty"`
+	RunID           string `json:"runId,omitempty"`
+	WorkflowType    string `json:"workflowType,omitempty"`
+	ScheduleID      int64  `json:"scheduleId,omitempty"`
+	ScheduleAttempt int64  `json:"scheduleAttempt,omitempty"`
+	ActivityID      string `json:"activityId,omitempty"`
+	ActivityType    string `json:"activityType,omitempty"`
+}
+
+// BatchTerminateRequest starts a batch job terminating the workflows matched by a visibility query
+// once their pending activities had a chance to be cancelled
+type BatchTerminateRequest struct {
+	Domain string `json:"domain,omitempty"`
+	Query  string `json:"query,omitempty"`
+	Reason string `json:"reason,omitempty"`
+	// DrainTimeoutInSeconds bounds the wait for the pending activities of each workflow
+	DrainTimeoutInSeconds int32 `json:"drainTimeoutInSeconds,omitempty"`
+	// RequestsPerSecond bounds the rate at which workflows are processed
+	RequestsPerSecond int32 `json:"requestsPerSecond,omitempty"`
+	// Concurrency is the number of workflows drained in parallel
+	Concurrency       int32  `json:"concurrency,omitempty"`
+	TerminateChildren *bool  `json:"terminateChildren,omitempty"`
+	Identity          string `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *BatchTerminateRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// BatchTerminateResponse is an internal type (TBD...)
+type BatchTerminateResponse struct {
+	JobID string `json:"jobId,omitempty"`
+}
+
+// DescribeBatchTerminateRequest is an internal type (TBD...)
+type DescribeBatchTerminateRequest struct {
+	JobID string `json:"jobId,omitempty"`
+}
+
+// GetJobID is an internal getter (TBD...)
+func (v *DescribeBatchTerminateRequest) GetJobID() (o string) {
+	if v != nil {
+		return v.JobID
+	}
+	return
+}
+
+// DescribeBatchTerminateResponse reports the progress of a batch terminate job, CloseStatus is unset while it runs
+type DescribeBatchTerminateResponse struct {
+	CloseStatus   *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
+	TotalEstimate int64                         `json:"totalEstimate,omitempty"`
+	SuccessCount  int64                         `json:"successCount,omitempty"`
+	ErrorCount    int64                         `json:"errorCount,omitempty"`
+}
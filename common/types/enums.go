@@ -84,5 +84,9 @@ func DecisionTypeValues() []DecisionType {
 		DecisionTypeStartChildWorkflowExecution,
 		DecisionTypeSignalExternalWorkflowExecution,
 		DecisionTypeUpsertWorkflowSearchAttributes,
+		DecisionTypeScheduleActivityTasksBatch,
+		DecisionTypeRecordActivityHeartbeatSnapshot,
+		DecisionTypeReplaceActivityTask,
+		DecisionTypeScheduleWeightedActivity,
 	}
 }
@@ -35,5 +35,5 @@ func Test_EventTypeValues(t *testing.T) {
 
 func Test_DecisionTypeValues(t *testing.T) {
 	result := DecisionTypeValues()
-	require.Equal(t, 13, len(result))
+	require.Equal(t, 17, len(result))
 }
@@ -40,10 +40,48 @@ type DescribeMutableStateResponse struct {
 	MutableStateInDatabase string `json:"mutableStateInDatabase,omitempty"`
 }
 
+// HistoryBatchDescribeWorkflowExecutionsRequest is an internal type (TBD...)
+type HistoryBatchDescribeWorkflowExecutionsRequest struct {
+	DomainUUID string                                  `json:"domainUUID,omitempty"`
+	Request    *BatchDescribeWorkflowExecutionsRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryBatchDescribeWorkflowExecutionsRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// HistoryCancelPendingActivitiesRequest is an internal type (TBD...)
+type HistoryCancelPendingActivitiesRequest struct {
+	DomainUUID string                          `json:"domainUUID,omitempty"`
+	Request    *CancelPendingActivitiesRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryCancelPendingActivitiesRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
 // HistoryDescribeWorkflowExecutionRequest is an internal type (TBD...)
 type HistoryDescribeWorkflowExecutionRequest struct {
 	DomainUUID string                            `json:"domainUUID,omitempty"`
 	Request    *DescribeWorkflowExecutionRequest `json:"request,omitempty"`
+	// IncludeScheduledEventAttributes sets the scheduled event attributes of the pending activities in the response
+	IncludeScheduledEventAttributes bool `json:"includeScheduledEventAttributes,omitempty"`
+}
+
+// GetIncludeScheduledEventAttributes is an internal getter (TBD...)
+func (v *HistoryDescribeWorkflowExecutionRequest) GetIncludeScheduledEventAttributes() (o bool) {
+	if v != nil {
+		return v.IncludeScheduledEventAttributes
+	}
+	return
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -234,6 +272,20 @@ func (v *GetMutableStateResponse) GetIsStickyTaskListEnabled() (o bool) {
 	return
 }
 
+// HistoryRedriveTimedOutActivitiesRequest is an internal type (TBD...)
+type HistoryRedriveTimedOutActivitiesRequest struct {
+	DomainUUID string                            `json:"domainUUID,omitempty"`
+	Request    *RedriveTimedOutActivitiesRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryRedriveTimedOutActivitiesRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
 // NotifyFailoverMarkersRequest is an internal type (TBD...)
 type NotifyFailoverMarkersRequest struct {
 	FailoverMarkerTokens []*FailoverMarkerToken `json:"failoverMarkerTokens,omitempty"`
@@ -383,6 +435,118 @@ type ProcessingQueueStates struct {
 	StatesByCluster map[string][]*ProcessingQueueState `json:"statesByCluster,omitempty"`
 }
 
+// HistoryExpireActivityHeartbeatRequest is an internal type (TBD...)
+type HistoryExpireActivityHeartbeatRequest struct {
+	DomainUUID string                          `json:"domainUUID,omitempty"`
+	Request    *ExpireActivityHeartbeatRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryExpireActivityHeartbeatRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// HistoryGetActivityEffectiveConfigRequest is an internal type (TBD...)
+type HistoryGetActivityEffectiveConfigRequest struct {
+	DomainUUID string                             `json:"domainUUID,omitempty"`
+	Request    *GetActivityEffectiveConfigRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryGetActivityEffectiveConfigRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// HistoryGetActivityNextRetryTimeRequest is an internal type (TBD...)
+type HistoryGetActivityNextRetryTimeRequest struct {
+	DomainUUID string                           `json:"domainUUID,omitempty"`
+	Request    *GetActivityNextRetryTimeRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryGetActivityNextRetryTimeRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// HistoryGetActivityRescheduleReasonsRequest is an internal type (TBD...)
+type HistoryGetActivityRescheduleReasonsRequest struct {
+	DomainUUID string                               `json:"domainUUID,omitempty"`
+	Request    *GetActivityRescheduleReasonsRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryGetActivityRescheduleReasonsRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// HistoryGetActivityRetryStatsRequest is an internal type (TBD...)
+type HistoryGetActivityRetryStatsRequest struct {
+	DomainUUID string                        `json:"domainUUID,omitempty"`
+	Request    *GetActivityRetryStatsRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryGetActivityRetryStatsRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// HistoryGetActivityTokenValidationFailuresRequest is an internal type (TBD...)
+type HistoryGetActivityTokenValidationFailuresRequest struct {
+	DomainUUID string                                     `json:"domainUUID,omitempty"`
+	Request    *GetActivityTokenValidationFailuresRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryGetActivityTokenValidationFailuresRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// HistoryGetActivityAttemptTimingsRequest is an internal type (TBD...)
+type HistoryGetActivityAttemptTimingsRequest struct {
+	DomainUUID string                            `json:"domainUUID,omitempty"`
+	Request    *GetActivityAttemptTimingsRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryGetActivityAttemptTimingsRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// HistoryGetActivityWorkerHistoryRequest is an internal type (TBD...)
+type HistoryGetActivityWorkerHistoryRequest struct {
+	DomainUUID string                           `json:"domainUUID,omitempty"`
+	Request    *GetActivityWorkerHistoryRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryGetActivityWorkerHistoryRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
 // HistoryQueryWorkflowRequest is an internal type (TBD...)
 type HistoryQueryWorkflowRequest struct {
 	DomainUUID string                `json:"domainUUID,omitempty"`
@@ -444,6 +608,16 @@ func (v *HistoryReapplyEventsRequest) GetRequest() (o *ReapplyEventsRequest) {
 type HistoryRecordActivityTaskHeartbeatRequest struct {
 	DomainUUID       string                              `json:"domainUUID,omitempty"`
 	HeartbeatRequest *RecordActivityTaskHeartbeatRequest `json:"heartbeatRequest,omitempty"`
+	// RenewLeaseOnly resets the heartbeat timer of the activity without replacing its stored heartbeat details
+	RenewLeaseOnly bool `json:"renewLeaseOnly,omitempty"`
+}
+
+// GetRenewLeaseOnly is an internal getter (TBD...)
+func (v *HistoryRecordActivityTaskHeartbeatRequest) GetRenewLeaseOnly() (o bool) {
+	if v != nil {
+		return v.RenewLeaseOnly
+	}
+	return
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -462,6 +636,36 @@ type RecordActivityTaskStartedRequest struct {
 	TaskID            int64                       `json:"taskId,omitempty"`
 	RequestID         string                      `json:"requestId,omitempty"`
 	PollRequest       *PollForActivityTaskRequest `json:"pollRequest,omitempty"`
+	// VisibilityTimeoutSeconds is the visibility timeout matching applies to the started activity, 0 if disabled
+	VisibilityTimeoutSeconds int32 `json:"visibilityTimeoutSeconds,omitempty"`
+	// StealThreshold is the fraction of the heartbeat timeout after which matching offers the started activity to another poller, 0 if disabled
+	StealThreshold float64 `json:"stealThreshold,omitempty"`
+	// Prefetched is set when the task is leased to a poller ahead of execution, see PollForActivityTaskRequest.PrefetchCount
+	Prefetched bool `json:"prefetched,omitempty"`
+}
+
+// GetVisibilityTimeoutSeconds is an internal getter (TBD...)
+func (v *RecordActivityTaskStartedRequest) GetVisibilityTimeoutSeconds() (o int32) {
+	if v != nil {
+		return v.VisibilityTimeoutSeconds
+	}
+	return
+}
+
+// GetStealThreshold is an internal getter (TBD...)
+func (v *RecordActivityTaskStartedRequest) GetStealThreshold() (o float64) {
+	if v != nil {
+		return v.StealThreshold
+	}
+	return
+}
+
+// GetPrefetched is an internal getter (TBD...)
+func (v *RecordActivityTaskStartedRequest) GetPrefetched() (o bool) {
+	if v != nil {
+		return v.Prefetched
+	}
+	return
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -505,6 +709,7 @@ type RecordActivityTaskStartedResponse struct {
 	HeartbeatDetails                []byte        `json:"heartbeatDetails,omitempty"`
 	WorkflowType                    *WorkflowType `json:"workflowType,omitempty"`
 	WorkflowDomain                  string        `json:"workflowDomain,omitempty"`
+	WasCancelBeforeStart            bool          `json:"wasCancelBeforeStart,omitempty"`
 }
 
 // GetAttempt is an internal getter (TBD...)
@@ -523,6 +728,14 @@ func (v *RecordActivityTaskStartedResponse) GetScheduledTimestampOfThisAttempt()
 	return
 }
 
+// GetWasCancelBeforeStart is an internal getter (TBD...)
+func (v *RecordActivityTaskStartedResponse) GetWasCancelBeforeStart() (o bool) {
+	if v != nil {
+		return v.WasCancelBeforeStart
+	}
+	return
+}
+
 // RecordChildExecutionCompletedRequest is an internal type (TBD...)
 type RecordChildExecutionCompletedRequest struct {
 	DomainUUID         string             `json:"domainUUID,omitempty"`
@@ -592,6 +805,16 @@ type RecordDecisionTaskStartedResponse struct {
 	StartedTimestamp          *int64                    `json:"startedTimestamp,omitempty"`
 	Queries                   map[string]*WorkflowQuery `json:"queries,omitempty"`
 	HistorySize               int64                     `json:"historySize,omitempty"`
+	// ActivityBatchCompleted is set when an activity batch completed since the last completed decision
+	ActivityBatchCompleted bool `json:"activityBatchCompleted,omitempty"`
+}
+
+// GetActivityBatchCompleted is an internal getter (TBD...)
+func (v *RecordDecisionTaskStartedResponse) GetActivityBatchCompleted() (o bool) {
+	if v != nil {
+		return v.ActivityBatchCompleted
+	}
+	return
 }
 
 // GetPreviousStartedEventID is an internal getter (TBD...)
@@ -713,6 +936,34 @@ func (v *HistoryRequestCancelWorkflowExecutionRequest) GetChildWorkflowOnly() (o
 	return
 }
 
+// HistoryReplayActivityCompletionsRequest is an internal type (TBD...)
+type HistoryReplayActivityCompletionsRequest struct {
+	DomainUUID string                            `json:"domainUUID,omitempty"`
+	Request    *ReplayActivityCompletionsRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryReplayActivityCompletionsRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// HistoryResetActivityAttemptsRequest is an internal type (TBD...)
+type HistoryResetActivityAttemptsRequest struct {
+	DomainUUID string                        `json:"domainUUID,omitempty"`
+	Request    *ResetActivityAttemptsRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryResetActivityAttemptsRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
 // HistoryResetStickyTaskListRequest is an internal type (TBD...)
 type HistoryResetStickyTaskListRequest struct {
 	DomainUUID string             `json:"domainUUID,omitempty"`
@@ -787,6 +1038,20 @@ func (v *HistoryRespondActivityTaskFailedRequest) GetDomainUUID() (o string) {
 	return
 }
 
+// HistoryRespondActivityTaskNackRequest is an internal type (TBD...)
+type HistoryRespondActivityTaskNackRequest struct {
+	DomainUUID  string                          `json:"domainUUID,omitempty"`
+	NackRequest *RespondActivityTaskNackRequest `json:"nackRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryRespondActivityTaskNackRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
 // HistoryRespondDecisionTaskCompletedRequest is an internal type (TBD...)
 type HistoryRespondDecisionTaskCompletedRequest struct {
 	DomainUUID      string                               `json:"domainUUID,omitempty"`
@@ -813,6 +1078,16 @@ func (v *HistoryRespondDecisionTaskCompletedRequest) GetCompleteRequest() (o *Re
 type HistoryRespondDecisionTaskCompletedResponse struct {
 	StartedResponse             *RecordDecisionTaskStartedResponse    `json:"startedResponse,omitempty"`
 	ActivitiesToDispatchLocally map[string]*ActivityLocalDispatchInfo `json:"activitiesToDispatchLocally,omitempty"`
+	// GeneratedActivityIDs are the activity IDs generated for the AutoGenerateActivityID decisions, keyed by decision index
+	GeneratedActivityIDs map[int32]string `json:"generatedActivityIDs,omitempty"`
+}
+
+// GetGeneratedActivityIDs is an internal getter (TBD...)
+func (v *HistoryRespondDecisionTaskCompletedResponse) GetGeneratedActivityIDs() (o map[int32]string) {
+	if v != nil {
+		return v.GeneratedActivityIDs
+	}
+	return
 }
 
 // HistoryRespondDecisionTaskFailedRequest is an internal type (TBD...)
@@ -881,6 +1156,20 @@ func (v *HistorySignalWithStartWorkflowExecutionRequest) GetPartitionConfig() (o
 	return
 }
 
+// HistorySignalActivityRequest is an internal type (TBD...)
+type HistorySignalActivityRequest struct {
+	DomainUUID    string                 `json:"domainUUID,omitempty"`
+	SignalRequest *SignalActivityRequest `json:"signalRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistorySignalActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
 // HistorySignalWorkflowExecutionRequest is an internal type (TBD...)
 type HistorySignalWorkflowExecutionRequest struct {
 	DomainUUID                string                          `json:"domainUUID,omitempty"`
@@ -168,6 +168,48 @@ func ToHistoryDescribeQueueResponse(t *historyv1.DescribeQueueResponse) *types.D
 	}
 }
 
+func FromHistoryBatchDescribeWorkflowExecutionsRequest(t *types.HistoryBatchDescribeWorkflowExecutionsRequest) *historyv1.BatchDescribeWorkflowExecutionsRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.BatchDescribeWorkflowExecutionsRequest{
+		DomainId:           t.DomainUUID,
+		Domain:             t.Request.Domain,
+		WorkflowExecutions: FromHistoryWorkflowExecutionArray(t.Request.Executions),
+	}
+}
+
+func ToHistoryBatchDescribeWorkflowExecutionsRequest(t *historyv1.BatchDescribeWorkflowExecutionsRequest) *types.HistoryBatchDescribeWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryBatchDescribeWorkflowExecutionsRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.BatchDescribeWorkflowExecutionsRequest{
+			Domain:     t.Domain,
+			Executions: ToHistoryWorkflowExecutionArray(t.WorkflowExecutions),
+		},
+	}
+}
+
+func FromHistoryBatchDescribeWorkflowExecutionsResponse(t *types.BatchDescribeWorkflowExecutionsResponse) *historyv1.BatchDescribeWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.BatchDescribeWorkflowExecutionsResponse{
+		Results: FromHistoryDescribeWorkflowExecutionResultArray(t.Results),
+	}
+}
+
+func ToHistoryBatchDescribeWorkflowExecutionsResponse(t *historyv1.BatchDescribeWorkflowExecutionsResponse) *types.BatchDescribeWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.BatchDescribeWorkflowExecutionsResponse{
+		Results: ToHistoryDescribeWorkflowExecutionResultArray(t.Results),
+	}
+}
+
 func FromHistoryDescribeWorkflowExecutionRequest(t *types.HistoryDescribeWorkflowExecutionRequest) *historyv1.DescribeWorkflowExecutionRequest {
 	if t == nil {
 		return nil
@@ -734,8 +776,9 @@ func FromHistoryRecordActivityTaskHeartbeatRequest(t *types.HistoryRecordActivit
 		return nil
 	}
 	return &historyv1.RecordActivityTaskHeartbeatRequest{
-		DomainId: t.DomainUUID,
-		Request:  FromRecordActivityTaskHeartbeatRequest(t.HeartbeatRequest),
+		DomainId:       t.DomainUUID,
+		Request:        FromRecordActivityTaskHeartbeatRequest(t.HeartbeatRequest),
+		RenewLeaseOnly: t.RenewLeaseOnly,
 	}
 }
 
@@ -746,6 +789,7 @@ func ToHistoryRecordActivityTaskHeartbeatRequest(t *historyv1.RecordActivityTask
 	return &types.HistoryRecordActivityTaskHeartbeatRequest{
 		DomainUUID:       t.DomainId,
 		HeartbeatRequest: ToRecordActivityTaskHeartbeatRequest(t.Request),
+		RenewLeaseOnly:   t.RenewLeaseOnly,
 	}
 }
 
@@ -754,7 +798,9 @@ func FromHistoryRecordActivityTaskHeartbeatResponse(t *types.RecordActivityTaskH
 		return nil
 	}
 	return &historyv1.RecordActivityTaskHeartbeatResponse{
-		CancelRequested: t.CancelRequested,
+		CancelRequested:     t.CancelRequested,
+		Signals:             FromHistoryActivitySignalArray(t.Signals),
+		WorkflowCloseStatus: FromWorkflowExecutionCloseStatus(t.WorkflowCloseStatus),
 	}
 }
 
@@ -763,7 +809,9 @@ func ToHistoryRecordActivityTaskHeartbeatResponse(t *historyv1.RecordActivityTas
 		return nil
 	}
 	return &types.RecordActivityTaskHeartbeatResponse{
-		CancelRequested: t.CancelRequested,
+		CancelRequested:     t.CancelRequested,
+		Signals:             ToHistoryActivitySignalArray(t.Signals),
+		WorkflowCloseStatus: ToWorkflowExecutionCloseStatus(t.WorkflowCloseStatus),
 	}
 }
 
@@ -778,6 +826,8 @@ func FromHistoryRecordActivityTaskStartedRequest(t *types.RecordActivityTaskStar
 		TaskId:            t.TaskID,
 		RequestId:         t.RequestID,
 		PollRequest:       FromPollForActivityTaskRequest(t.PollRequest),
+		VisibilityTimeout: secondsToDuration(&t.VisibilityTimeoutSeconds),
+		Prefetched:        t.Prefetched,
 	}
 }
 
@@ -786,12 +836,14 @@ func ToHistoryRecordActivityTaskStartedRequest(t *historyv1.RecordActivityTaskSt
 		return nil
 	}
 	return &types.RecordActivityTaskStartedRequest{
-		DomainUUID:        t.DomainId,
-		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
-		ScheduleID:        t.ScheduleId,
-		TaskID:            t.TaskId,
-		RequestID:         t.RequestId,
-		PollRequest:       ToPollForActivityTaskRequest(t.PollRequest),
+		DomainUUID:               t.DomainId,
+		WorkflowExecution:        ToWorkflowExecution(t.WorkflowExecution),
+		ScheduleID:               t.ScheduleId,
+		TaskID:                   t.TaskId,
+		RequestID:                t.RequestId,
+		PollRequest:              ToPollForActivityTaskRequest(t.PollRequest),
+		VisibilityTimeoutSeconds: common.Int32Default(durationToSeconds(t.VisibilityTimeout)),
+		Prefetched:               t.Prefetched,
 	}
 }
 
@@ -1275,6 +1327,38 @@ func ToHistoryScheduleDecisionTaskRequest(t *historyv1.ScheduleDecisionTaskReque
 	}
 }
 
+func FromHistorySignalActivityRequest(t *types.HistorySignalActivityRequest) *historyv1.SignalActivityRequest {
+	if t == nil || t.SignalRequest == nil {
+		return nil
+	}
+	return &historyv1.SignalActivityRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.SignalRequest.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.SignalRequest.WorkflowExecution),
+		ActivityId:        t.SignalRequest.ActivityID,
+		SignalName:        t.SignalRequest.SignalName,
+		Input:             FromPayload(t.SignalRequest.Input),
+		Identity:          t.SignalRequest.Identity,
+	}
+}
+
+func ToHistorySignalActivityRequest(t *historyv1.SignalActivityRequest) *types.HistorySignalActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistorySignalActivityRequest{
+		DomainUUID: t.DomainId,
+		SignalRequest: &types.SignalActivityRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+			SignalName:        t.SignalName,
+			Input:             ToPayload(t.Input),
+			Identity:          t.Identity,
+		},
+	}
+}
+
 func FromHistorySignalWithStartWorkflowExecutionRequest(t *types.HistorySignalWithStartWorkflowExecutionRequest) *historyv1.SignalWithStartWorkflowExecutionRequest {
 	if t == nil {
 		return nil
@@ -1622,3 +1706,946 @@ func ToHistoryRatelimitUpdateResponse(t *historyv1.RatelimitUpdateResponse) *typ
 		Any: ToAny(t.Data),
 	}
 }
+
+func FromHistoryActivitySignal(t *types.ActivitySignal) *historyv1.ActivitySignal {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ActivitySignal{
+		SignalName: t.SignalName,
+		Input:      FromPayload(t.Input),
+		Identity:   t.Identity,
+		Timestamp:  unixNanoToTime(t.Timestamp),
+	}
+}
+
+func ToHistoryActivitySignal(t *historyv1.ActivitySignal) *types.ActivitySignal {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivitySignal{
+		SignalName: t.SignalName,
+		Input:      ToPayload(t.Input),
+		Identity:   t.Identity,
+		Timestamp:  timeToUnixNano(t.Timestamp),
+	}
+}
+
+func FromHistoryActivitySignalArray(t []*types.ActivitySignal) []*historyv1.ActivitySignal {
+	if t == nil {
+		return nil
+	}
+	v := make([]*historyv1.ActivitySignal, len(t))
+	for i := range t {
+		v[i] = FromHistoryActivitySignal(t[i])
+	}
+	return v
+}
+
+func ToHistoryActivitySignalArray(t []*historyv1.ActivitySignal) []*types.ActivitySignal {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ActivitySignal, len(t))
+	for i := range t {
+		v[i] = ToHistoryActivitySignal(t[i])
+	}
+	return v
+}
+
+func FromHistoryDescribeWorkflowExecutionResult(t *types.DescribeWorkflowExecutionResult) *historyv1.DescribeWorkflowExecutionResult {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.DescribeWorkflowExecutionResult{
+		WorkflowExecution: FromWorkflowExecution(t.Execution),
+		Response:          FromHistoryDescribeWorkflowExecutionResponse(t.Response),
+		Error:             t.Error,
+	}
+}
+
+func ToHistoryDescribeWorkflowExecutionResult(t *historyv1.DescribeWorkflowExecutionResult) *types.DescribeWorkflowExecutionResult {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeWorkflowExecutionResult{
+		Execution: ToWorkflowExecution(t.WorkflowExecution),
+		Response:  ToHistoryDescribeWorkflowExecutionResponse(t.Response),
+		Error:     t.Error,
+	}
+}
+
+func FromHistoryDescribeWorkflowExecutionResultArray(t []*types.DescribeWorkflowExecutionResult) []*historyv1.DescribeWorkflowExecutionResult {
+	if t == nil {
+		return nil
+	}
+	v := make([]*historyv1.DescribeWorkflowExecutionResult, len(t))
+	for i := range t {
+		v[i] = FromHistoryDescribeWorkflowExecutionResult(t[i])
+	}
+	return v
+}
+
+func ToHistoryDescribeWorkflowExecutionResultArray(t []*historyv1.DescribeWorkflowExecutionResult) []*types.DescribeWorkflowExecutionResult {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DescribeWorkflowExecutionResult, len(t))
+	for i := range t {
+		v[i] = ToHistoryDescribeWorkflowExecutionResult(t[i])
+	}
+	return v
+}
+
+func FromHistoryWorkflowExecutionArray(t []*types.WorkflowExecution) []*apiv1.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.WorkflowExecution, len(t))
+	for i := range t {
+		v[i] = FromWorkflowExecution(t[i])
+	}
+	return v
+}
+
+func ToHistoryWorkflowExecutionArray(t []*apiv1.WorkflowExecution) []*types.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkflowExecution, len(t))
+	for i := range t {
+		v[i] = ToWorkflowExecution(t[i])
+	}
+	return v
+}
+
+func FromHistoryGetActivityEffectiveConfigRequest(t *types.HistoryGetActivityEffectiveConfigRequest) *historyv1.GetActivityEffectiveConfigRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.GetActivityEffectiveConfigRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		ActivityId:        t.Request.ActivityID,
+	}
+}
+
+func ToHistoryGetActivityEffectiveConfigRequest(t *historyv1.GetActivityEffectiveConfigRequest) *types.HistoryGetActivityEffectiveConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetActivityEffectiveConfigRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.GetActivityEffectiveConfigRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+		},
+	}
+}
+
+func FromHistoryGetActivityEffectiveConfigResponse(t *types.GetActivityEffectiveConfigResponse) *historyv1.GetActivityEffectiveConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetActivityEffectiveConfigResponse{
+		ActivityId:                        t.ActivityID,
+		TaskList:                          FromTaskList(t.TaskList),
+		ScheduleToStartTimeout:            secondsToDuration(&t.ScheduleToStartTimeoutSeconds),
+		StartToCloseTimeout:               secondsToDuration(&t.StartToCloseTimeoutSeconds),
+		FirstAttemptStartToCloseTimeout:   secondsToDuration(&t.FirstAttemptStartToCloseSeconds),
+		ScheduleToCloseTimeout:            secondsToDuration(&t.ScheduleToCloseTimeoutSeconds),
+		HeartbeatTimeout:                  secondsToDuration(&t.HeartbeatTimeoutSeconds),
+		RetryPolicy:                       FromRetryPolicy(t.RetryPolicy),
+		Attempt:                           t.Attempt,
+		CurrentAttemptStartToCloseTimeout: secondsToDuration(&t.CurrentAttemptStartToCloseTimeoutSeconds),
+	}
+}
+
+func ToHistoryGetActivityEffectiveConfigResponse(t *historyv1.GetActivityEffectiveConfigResponse) *types.GetActivityEffectiveConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityEffectiveConfigResponse{
+		ActivityID:                               t.ActivityId,
+		TaskList:                                 ToTaskList(t.TaskList),
+		ScheduleToStartTimeoutSeconds:            common.Int32Default(durationToSeconds(t.ScheduleToStartTimeout)),
+		StartToCloseTimeoutSeconds:               common.Int32Default(durationToSeconds(t.StartToCloseTimeout)),
+		FirstAttemptStartToCloseSeconds:          common.Int32Default(durationToSeconds(t.FirstAttemptStartToCloseTimeout)),
+		ScheduleToCloseTimeoutSeconds:            common.Int32Default(durationToSeconds(t.ScheduleToCloseTimeout)),
+		HeartbeatTimeoutSeconds:                  common.Int32Default(durationToSeconds(t.HeartbeatTimeout)),
+		RetryPolicy:                              ToRetryPolicy(t.RetryPolicy),
+		Attempt:                                  t.Attempt,
+		CurrentAttemptStartToCloseTimeoutSeconds: common.Int32Default(durationToSeconds(t.CurrentAttemptStartToCloseTimeout)),
+	}
+}
+
+func FromHistoryGetActivityNextRetryTimeRequest(t *types.HistoryGetActivityNextRetryTimeRequest) *historyv1.GetActivityNextRetryTimeRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.GetActivityNextRetryTimeRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		ActivityId:        t.Request.ActivityID,
+	}
+}
+
+func ToHistoryGetActivityNextRetryTimeRequest(t *historyv1.GetActivityNextRetryTimeRequest) *types.HistoryGetActivityNextRetryTimeRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetActivityNextRetryTimeRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.GetActivityNextRetryTimeRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+		},
+	}
+}
+
+func FromHistoryGetActivityNextRetryTimeResponse(t *types.GetActivityNextRetryTimeResponse) *historyv1.GetActivityNextRetryTimeResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetActivityNextRetryTimeResponse{
+		ActivityId:    t.ActivityID,
+		Attempt:       t.Attempt,
+		NextRetryTime: unixNanoToTime(t.NextRetryTimestamp),
+		LastFailure:   FromFailure(t.LastFailureReason, nil),
+	}
+}
+
+func ToHistoryGetActivityNextRetryTimeResponse(t *historyv1.GetActivityNextRetryTimeResponse) *types.GetActivityNextRetryTimeResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityNextRetryTimeResponse{
+		ActivityID:         t.ActivityId,
+		Attempt:            t.Attempt,
+		NextRetryTimestamp: timeToUnixNano(t.NextRetryTime),
+		LastFailureReason:  ToFailureReason(t.LastFailure),
+	}
+}
+
+func FromHistoryReplayActivityCompletionsRequest(t *types.HistoryReplayActivityCompletionsRequest) *historyv1.ReplayActivityCompletionsRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.ReplayActivityCompletionsRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		BaseRunId:         t.Request.BaseRunID,
+		ScheduledEventIds: t.Request.ScheduledEventIDs,
+		Identity:          t.Request.Identity,
+	}
+}
+
+func ToHistoryReplayActivityCompletionsRequest(t *historyv1.ReplayActivityCompletionsRequest) *types.HistoryReplayActivityCompletionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryReplayActivityCompletionsRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.ReplayActivityCompletionsRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			BaseRunID:         t.BaseRunId,
+			ScheduledEventIDs: t.ScheduledEventIds,
+			Identity:          t.Identity,
+		},
+	}
+}
+
+func FromHistoryReplayActivityCompletionsResponse(t *types.ReplayActivityCompletionsResponse) *historyv1.ReplayActivityCompletionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ReplayActivityCompletionsResponse{
+		ScheduledEventIds: t.ScheduledEventIDs,
+	}
+}
+
+func ToHistoryReplayActivityCompletionsResponse(t *historyv1.ReplayActivityCompletionsResponse) *types.ReplayActivityCompletionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplayActivityCompletionsResponse{
+		ScheduledEventIDs: t.ScheduledEventIds,
+	}
+}
+
+func FromHistoryGetActivityRescheduleReasonsRequest(t *types.HistoryGetActivityRescheduleReasonsRequest) *historyv1.GetActivityRescheduleReasonsRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.GetActivityRescheduleReasonsRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		ActivityId:        t.Request.ActivityID,
+	}
+}
+
+func ToHistoryGetActivityRescheduleReasonsRequest(t *historyv1.GetActivityRescheduleReasonsRequest) *types.HistoryGetActivityRescheduleReasonsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetActivityRescheduleReasonsRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.GetActivityRescheduleReasonsRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+		},
+	}
+}
+
+func FromHistoryGetActivityRescheduleReasonsResponse(t *types.GetActivityRescheduleReasonsResponse) *historyv1.GetActivityRescheduleReasonsResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetActivityRescheduleReasonsResponse{
+		ActivityId: t.ActivityID,
+		Reasons:    FromHistoryActivityRescheduleReasonArray(t.Reasons),
+	}
+}
+
+func ToHistoryGetActivityRescheduleReasonsResponse(t *historyv1.GetActivityRescheduleReasonsResponse) *types.GetActivityRescheduleReasonsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityRescheduleReasonsResponse{
+		ActivityID: t.ActivityId,
+		Reasons:    ToHistoryActivityRescheduleReasonArray(t.Reasons),
+	}
+}
+
+func FromHistoryActivityRescheduleReason(t *types.ActivityRescheduleReason) *historyv1.ActivityRescheduleReason {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ActivityRescheduleReason{
+		Attempt:          t.Attempt,
+		Cause:            FromActivityRescheduleCause(t.Cause),
+		Timestamp:        unixNanoToTime(t.Timestamp),
+		Identity:         t.Identity,
+		StartedTimestamp: unixNanoToTime(t.StartedTimestamp),
+		TaskList:         t.TaskList,
+	}
+}
+
+func ToHistoryActivityRescheduleReason(t *historyv1.ActivityRescheduleReason) *types.ActivityRescheduleReason {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityRescheduleReason{
+		Attempt:          t.Attempt,
+		Cause:            ToActivityRescheduleCause(t.Cause),
+		Timestamp:        timeToUnixNano(t.Timestamp),
+		Identity:         t.Identity,
+		StartedTimestamp: timeToUnixNano(t.StartedTimestamp),
+		TaskList:         t.TaskList,
+	}
+}
+
+func FromHistoryActivityRescheduleReasonArray(t []*types.ActivityRescheduleReason) []*historyv1.ActivityRescheduleReason {
+	if t == nil {
+		return nil
+	}
+	v := make([]*historyv1.ActivityRescheduleReason, len(t))
+	for i := range t {
+		v[i] = FromHistoryActivityRescheduleReason(t[i])
+	}
+	return v
+}
+
+func ToHistoryActivityRescheduleReasonArray(t []*historyv1.ActivityRescheduleReason) []*types.ActivityRescheduleReason {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ActivityRescheduleReason, len(t))
+	for i := range t {
+		v[i] = ToHistoryActivityRescheduleReason(t[i])
+	}
+	return v
+}
+
+func FromActivityRescheduleCause(t *types.ActivityRescheduleCause) historyv1.ActivityRescheduleCause {
+	if t == nil {
+		return historyv1.ActivityRescheduleCause_ACTIVITY_RESCHEDULE_CAUSE_INVALID
+	}
+	switch *t {
+	case types.ActivityRescheduleCauseFailure:
+		return historyv1.ActivityRescheduleCause_ACTIVITY_RESCHEDULE_CAUSE_FAILURE
+	case types.ActivityRescheduleCauseHeartbeatTimeout:
+		return historyv1.ActivityRescheduleCause_ACTIVITY_RESCHEDULE_CAUSE_HEARTBEAT_TIMEOUT
+	case types.ActivityRescheduleCauseStartToCloseTimeout:
+		return historyv1.ActivityRescheduleCause_ACTIVITY_RESCHEDULE_CAUSE_START_TO_CLOSE_TIMEOUT
+	case types.ActivityRescheduleCauseScheduleToStartTimeout:
+		return historyv1.ActivityRescheduleCause_ACTIVITY_RESCHEDULE_CAUSE_SCHEDULE_TO_START_TIMEOUT
+	}
+	panic("unexpected enum value")
+}
+
+func ToActivityRescheduleCause(t historyv1.ActivityRescheduleCause) *types.ActivityRescheduleCause {
+	switch t {
+	case historyv1.ActivityRescheduleCause_ACTIVITY_RESCHEDULE_CAUSE_INVALID:
+		return nil
+	case historyv1.ActivityRescheduleCause_ACTIVITY_RESCHEDULE_CAUSE_FAILURE:
+		return types.ActivityRescheduleCauseFailure.Ptr()
+	case historyv1.ActivityRescheduleCause_ACTIVITY_RESCHEDULE_CAUSE_HEARTBEAT_TIMEOUT:
+		return types.ActivityRescheduleCauseHeartbeatTimeout.Ptr()
+	case historyv1.ActivityRescheduleCause_ACTIVITY_RESCHEDULE_CAUSE_START_TO_CLOSE_TIMEOUT:
+		return types.ActivityRescheduleCauseStartToCloseTimeout.Ptr()
+	case historyv1.ActivityRescheduleCause_ACTIVITY_RESCHEDULE_CAUSE_SCHEDULE_TO_START_TIMEOUT:
+		return types.ActivityRescheduleCauseScheduleToStartTimeout.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+func FromHistoryGetActivityWorkerHistoryRequest(t *types.HistoryGetActivityWorkerHistoryRequest) *historyv1.GetActivityWorkerHistoryRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.GetActivityWorkerHistoryRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		ActivityId:        t.Request.ActivityID,
+	}
+}
+
+func ToHistoryGetActivityWorkerHistoryRequest(t *historyv1.GetActivityWorkerHistoryRequest) *types.HistoryGetActivityWorkerHistoryRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetActivityWorkerHistoryRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.GetActivityWorkerHistoryRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+		},
+	}
+}
+
+func FromHistoryGetActivityWorkerHistoryResponse(t *types.GetActivityWorkerHistoryResponse) *historyv1.GetActivityWorkerHistoryResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetActivityWorkerHistoryResponse{
+		ActivityId: t.ActivityID,
+		Attempts:   FromHistoryActivityWorkerAttemptArray(t.Attempts),
+	}
+}
+
+func ToHistoryGetActivityWorkerHistoryResponse(t *historyv1.GetActivityWorkerHistoryResponse) *types.GetActivityWorkerHistoryResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityWorkerHistoryResponse{
+		ActivityID: t.ActivityId,
+		Attempts:   ToHistoryActivityWorkerAttemptArray(t.Attempts),
+	}
+}
+
+func FromHistoryActivityWorkerAttempt(t *types.ActivityWorkerAttempt) *historyv1.ActivityWorkerAttempt {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ActivityWorkerAttempt{
+		ScheduledEventId: t.ScheduledEventID,
+		Attempt:          t.Attempt,
+		Identity:         t.Identity,
+		Outcome:          FromActivityAttemptOutcome(t.Outcome),
+		StartedTimestamp: unixNanoToTime(t.StartedTimestamp),
+		EndTimestamp:     unixNanoToTime(t.EndTimestamp),
+		TaskList:         t.TaskList,
+	}
+}
+
+func ToHistoryActivityWorkerAttempt(t *historyv1.ActivityWorkerAttempt) *types.ActivityWorkerAttempt {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityWorkerAttempt{
+		ScheduledEventID: t.ScheduledEventId,
+		Attempt:          t.Attempt,
+		Identity:         t.Identity,
+		Outcome:          ToActivityAttemptOutcome(t.Outcome),
+		StartedTimestamp: timeToUnixNano(t.StartedTimestamp),
+		EndTimestamp:     timeToUnixNano(t.EndTimestamp),
+		TaskList:         t.TaskList,
+	}
+}
+
+func FromHistoryActivityWorkerAttemptArray(t []*types.ActivityWorkerAttempt) []*historyv1.ActivityWorkerAttempt {
+	if t == nil {
+		return nil
+	}
+	v := make([]*historyv1.ActivityWorkerAttempt, len(t))
+	for i := range t {
+		v[i] = FromHistoryActivityWorkerAttempt(t[i])
+	}
+	return v
+}
+
+func ToHistoryActivityWorkerAttemptArray(t []*historyv1.ActivityWorkerAttempt) []*types.ActivityWorkerAttempt {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ActivityWorkerAttempt, len(t))
+	for i := range t {
+		v[i] = ToHistoryActivityWorkerAttempt(t[i])
+	}
+	return v
+}
+
+func FromActivityAttemptOutcome(t *types.EventType) historyv1.ActivityAttemptOutcome {
+	if t == nil {
+		return historyv1.ActivityAttemptOutcome_ACTIVITY_ATTEMPT_OUTCOME_INVALID
+	}
+	switch *t {
+	case types.EventTypeActivityTaskCompleted:
+		return historyv1.ActivityAttemptOutcome_ACTIVITY_ATTEMPT_OUTCOME_COMPLETED
+	case types.EventTypeActivityTaskFailed:
+		return historyv1.ActivityAttemptOutcome_ACTIVITY_ATTEMPT_OUTCOME_FAILED
+	case types.EventTypeActivityTaskTimedOut:
+		return historyv1.ActivityAttemptOutcome_ACTIVITY_ATTEMPT_OUTCOME_TIMED_OUT
+	case types.EventTypeActivityTaskCanceled:
+		return historyv1.ActivityAttemptOutcome_ACTIVITY_ATTEMPT_OUTCOME_CANCELED
+	}
+	panic("unexpected enum value")
+}
+
+func ToActivityAttemptOutcome(t historyv1.ActivityAttemptOutcome) *types.EventType {
+	switch t {
+	case historyv1.ActivityAttemptOutcome_ACTIVITY_ATTEMPT_OUTCOME_INVALID:
+		return nil
+	case historyv1.ActivityAttemptOutcome_ACTIVITY_ATTEMPT_OUTCOME_COMPLETED:
+		return types.EventTypeActivityTaskCompleted.Ptr()
+	case historyv1.ActivityAttemptOutcome_ACTIVITY_ATTEMPT_OUTCOME_FAILED:
+		return types.EventTypeActivityTaskFailed.Ptr()
+	case historyv1.ActivityAttemptOutcome_ACTIVITY_ATTEMPT_OUTCOME_TIMED_OUT:
+		return types.EventTypeActivityTaskTimedOut.Ptr()
+	case historyv1.ActivityAttemptOutcome_ACTIVITY_ATTEMPT_OUTCOME_CANCELED:
+		return types.EventTypeActivityTaskCanceled.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+func FromHistoryRespondActivityTaskNackRequest(t *types.HistoryRespondActivityTaskNackRequest) *historyv1.RespondActivityTaskNackRequest {
+	if t == nil || t.NackRequest == nil {
+		return nil
+	}
+	return &historyv1.RespondActivityTaskNackRequest{
+		DomainId:  t.DomainUUID,
+		TaskToken: t.NackRequest.TaskToken,
+		Reason:    t.NackRequest.Reason,
+		Identity:  t.NackRequest.Identity,
+	}
+}
+
+func ToHistoryRespondActivityTaskNackRequest(t *historyv1.RespondActivityTaskNackRequest) *types.HistoryRespondActivityTaskNackRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryRespondActivityTaskNackRequest{
+		DomainUUID: t.DomainId,
+		NackRequest: &types.RespondActivityTaskNackRequest{
+			TaskToken: t.TaskToken,
+			Reason:    t.Reason,
+			Identity:  t.Identity,
+		},
+	}
+}
+
+func FromHistoryGetActivityRetryStatsRequest(t *types.HistoryGetActivityRetryStatsRequest) *historyv1.GetActivityRetryStatsRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.GetActivityRetryStatsRequest{
+		DomainId:  t.DomainUUID,
+		Domain:    t.Request.Domain,
+		StartTime: unixNanoToTime(t.Request.StartTime),
+		EndTime:   unixNanoToTime(t.Request.EndTime),
+	}
+}
+
+func ToHistoryGetActivityRetryStatsRequest(t *historyv1.GetActivityRetryStatsRequest) *types.HistoryGetActivityRetryStatsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetActivityRetryStatsRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.GetActivityRetryStatsRequest{
+			Domain:    t.Domain,
+			StartTime: timeToUnixNano(t.StartTime),
+			EndTime:   timeToUnixNano(t.EndTime),
+		},
+	}
+}
+
+func FromHistoryGetActivityRetryStatsResponse(t *types.GetActivityRetryStatsResponse) *historyv1.GetActivityRetryStatsResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetActivityRetryStatsResponse{
+		StartTime:             t.StartTime,
+		EndTime:               t.EndTime,
+		TotalAttempts:         t.TotalAttempts,
+		RetriedAttempts:       t.RetriedAttempts,
+		Successes:             t.Successes,
+		FirstAttemptSuccesses: t.FirstAttemptSuccesses,
+	}
+}
+
+func ToHistoryGetActivityRetryStatsResponse(t *historyv1.GetActivityRetryStatsResponse) *types.GetActivityRetryStatsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityRetryStatsResponse{
+		StartTime:             t.StartTime,
+		EndTime:               t.EndTime,
+		TotalAttempts:         t.TotalAttempts,
+		RetriedAttempts:       t.RetriedAttempts,
+		Successes:             t.Successes,
+		FirstAttemptSuccesses: t.FirstAttemptSuccesses,
+	}
+}
+
+func FromHistoryGetActivityTokenValidationFailuresRequest(t *types.HistoryGetActivityTokenValidationFailuresRequest) *historyv1.GetActivityTokenValidationFailuresRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.GetActivityTokenValidationFailuresRequest{
+		DomainId: t.DomainUUID,
+		Domain:   t.Request.Domain,
+	}
+}
+
+func ToHistoryGetActivityTokenValidationFailuresRequest(t *historyv1.GetActivityTokenValidationFailuresRequest) *types.HistoryGetActivityTokenValidationFailuresRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetActivityTokenValidationFailuresRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.GetActivityTokenValidationFailuresRequest{
+			Domain: t.Domain,
+		},
+	}
+}
+
+func FromHistoryGetActivityTokenValidationFailuresResponse(t *types.GetActivityTokenValidationFailuresResponse) *historyv1.GetActivityTokenValidationFailuresResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetActivityTokenValidationFailuresResponse{
+		Failures: FromHistoryActivityTokenValidationFailureArray(t.Failures),
+	}
+}
+
+func ToHistoryGetActivityTokenValidationFailuresResponse(t *historyv1.GetActivityTokenValidationFailuresResponse) *types.GetActivityTokenValidationFailuresResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityTokenValidationFailuresResponse{
+		Failures: ToHistoryActivityTokenValidationFailureArray(t.Failures),
+	}
+}
+
+func FromHistoryActivityTokenValidationFailure(t *types.ActivityTokenValidationFailure) *historyv1.ActivityTokenValidationFailure {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ActivityTokenValidationFailure{
+		Operation:       t.Operation,
+		Cause:           FromActivityTokenValidationFailureCause(t.Cause),
+		WorkflowId:      t.WorkflowID,
+		RunId:           t.RunID,
+		ActivityId:      t.ActivityID,
+		ActivityType:    t.ActivityType,
+		ScheduleId:      t.ScheduleID,
+		ScheduleAttempt: t.ScheduleAttempt,
+		Timestamp:       unixNanoToTime(t.Timestamp),
+	}
+}
+
+func ToHistoryActivityTokenValidationFailure(t *historyv1.ActivityTokenValidationFailure) *types.ActivityTokenValidationFailure {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityTokenValidationFailure{
+		Operation:       t.Operation,
+		Cause:           ToActivityTokenValidationFailureCause(t.Cause),
+		WorkflowID:      t.WorkflowId,
+		RunID:           t.RunId,
+		ActivityID:      t.ActivityId,
+		ActivityType:    t.ActivityType,
+		ScheduleID:      t.ScheduleId,
+		ScheduleAttempt: t.ScheduleAttempt,
+		Timestamp:       timeToUnixNano(t.Timestamp),
+	}
+}
+
+func FromHistoryActivityTokenValidationFailureArray(t []*types.ActivityTokenValidationFailure) []*historyv1.ActivityTokenValidationFailure {
+	if t == nil {
+		return nil
+	}
+	v := make([]*historyv1.ActivityTokenValidationFailure, len(t))
+	for i := range t {
+		v[i] = FromHistoryActivityTokenValidationFailure(t[i])
+	}
+	return v
+}
+
+func ToHistoryActivityTokenValidationFailureArray(t []*historyv1.ActivityTokenValidationFailure) []*types.ActivityTokenValidationFailure {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ActivityTokenValidationFailure, len(t))
+	for i := range t {
+		v[i] = ToHistoryActivityTokenValidationFailure(t[i])
+	}
+	return v
+}
+
+func FromActivityTokenValidationFailureCause(t *types.ActivityTokenValidationFailureCause) historyv1.ActivityTokenValidationFailureCause {
+	if t == nil {
+		return historyv1.ActivityTokenValidationFailureCause_ACTIVITY_TOKEN_VALIDATION_FAILURE_CAUSE_INVALID
+	}
+	switch *t {
+	case types.ActivityTokenValidationFailureCauseExpired:
+		return historyv1.ActivityTokenValidationFailureCause_ACTIVITY_TOKEN_VALIDATION_FAILURE_CAUSE_EXPIRED
+	case types.ActivityTokenValidationFailureCauseAlreadyCompleted:
+		return historyv1.ActivityTokenValidationFailureCause_ACTIVITY_TOKEN_VALIDATION_FAILURE_CAUSE_ALREADY_COMPLETED
+	case types.ActivityTokenValidationFailureCauseWrongRun:
+		return historyv1.ActivityTokenValidationFailureCause_ACTIVITY_TOKEN_VALIDATION_FAILURE_CAUSE_WRONG_RUN
+	case types.ActivityTokenValidationFailureCauseMalformed:
+		return historyv1.ActivityTokenValidationFailureCause_ACTIVITY_TOKEN_VALIDATION_FAILURE_CAUSE_MALFORMED
+	}
+	panic("unexpected enum value")
+}
+
+func ToActivityTokenValidationFailureCause(t historyv1.ActivityTokenValidationFailureCause) *types.ActivityTokenValidationFailureCause {
+	switch t {
+	case historyv1.ActivityTokenValidationFailureCause_ACTIVITY_TOKEN_VALIDATION_FAILURE_CAUSE_INVALID:
+		return nil
+	case historyv1.ActivityTokenValidationFailureCause_ACTIVITY_TOKEN_VALIDATION_FAILURE_CAUSE_EXPIRED:
+		return types.ActivityTokenValidationFailureCauseExpired.Ptr()
+	case historyv1.ActivityTokenValidationFailureCause_ACTIVITY_TOKEN_VALIDATION_FAILURE_CAUSE_ALREADY_COMPLETED:
+		return types.ActivityTokenValidationFailureCauseAlreadyCompleted.Ptr()
+	case historyv1.ActivityTokenValidationFailureCause_ACTIVITY_TOKEN_VALIDATION_FAILURE_CAUSE_WRONG_RUN:
+		return types.ActivityTokenValidationFailureCauseWrongRun.Ptr()
+	case historyv1.ActivityTokenValidationFailureCause_ACTIVITY_TOKEN_VALIDATION_FAILURE_CAUSE_MALFORMED:
+		return types.ActivityTokenValidationFailureCauseMalformed.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+func FromHistoryGetActivityAttemptTimingsRequest(t *types.HistoryGetActivityAttemptTimingsRequest) *historyv1.GetActivityAttemptTimingsRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.GetActivityAttemptTimingsRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		ActivityId:        t.Request.ActivityID,
+	}
+}
+
+func ToHistoryGetActivityAttemptTimingsRequest(t *historyv1.GetActivityAttemptTimingsRequest) *types.HistoryGetActivityAttemptTimingsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetActivityAttemptTimingsRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.GetActivityAttemptTimingsRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+		},
+	}
+}
+
+func FromHistoryGetActivityAttemptTimingsResponse(t *types.GetActivityAttemptTimingsResponse) *historyv1.GetActivityAttemptTimingsResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetActivityAttemptTimingsResponse{
+		ActivityId: t.ActivityID,
+		Attempts:   FromHistoryActivityAttemptTimingArray(t.Attempts),
+	}
+}
+
+func ToHistoryGetActivityAttemptTimingsResponse(t *historyv1.GetActivityAttemptTimingsResponse) *types.GetActivityAttemptTimingsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityAttemptTimingsResponse{
+		ActivityID: t.ActivityId,
+		Attempts:   ToHistoryActivityAttemptTimingArray(t.Attempts),
+	}
+}
+
+func FromHistoryActivityAttemptTiming(t *types.ActivityAttemptTiming) *historyv1.ActivityAttemptTiming {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ActivityAttemptTiming{
+		ScheduledEventId: t.ScheduledEventID,
+		Attempt:          t.Attempt,
+		StartedTimestamp: unixNanoToTime(t.StartedTimestamp),
+		EndTimestamp:     unixNanoToTime(t.EndTimestamp),
+		Outcome:          FromActivityAttemptOutcome(t.Outcome),
+	}
+}
+
+func ToHistoryActivityAttemptTiming(t *historyv1.ActivityAttemptTiming) *types.ActivityAttemptTiming {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityAttemptTiming{
+		ScheduledEventID: t.ScheduledEventId,
+		Attempt:          t.Attempt,
+		StartedTimestamp: timeToUnixNano(t.StartedTimestamp),
+		EndTimestamp:     timeToUnixNano(t.EndTimestamp),
+		Outcome:          ToActivityAttemptOutcome(t.Outcome),
+	}
+}
+
+func FromHistoryActivityAttemptTimingArray(t []*types.ActivityAttemptTiming) []*historyv1.ActivityAttemptTiming {
+	if t == nil {
+		return nil
+	}
+	v := make([]*historyv1.ActivityAttemptTiming, len(t))
+	for i := range t {
+		v[i] = FromHistoryActivityAttemptTiming(t[i])
+	}
+	return v
+}
+
+func ToHistoryActivityAttemptTimingArray(t []*historyv1.ActivityAttemptTiming) []*types.ActivityAttemptTiming {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ActivityAttemptTiming, len(t))
+	for i := range t {
+		v[i] = ToHistoryActivityAttemptTiming(t[i])
+	}
+	return v
+}
+
+func FromHistoryExpireActivityHeartbeatRequest(t *types.HistoryExpireActivityHeartbeatRequest) *historyv1.ExpireActivityHeartbeatRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.ExpireActivityHeartbeatRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		ActivityId:        t.Request.ActivityID,
+	}
+}
+
+func ToHistoryExpireActivityHeartbeatRequest(t *historyv1.ExpireActivityHeartbeatRequest) *types.HistoryExpireActivityHeartbeatRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryExpireActivityHeartbeatRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.ExpireActivityHeartbeatRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+		},
+	}
+}
+
+func FromHistoryResetActivityAttemptsRequest(t *types.HistoryResetActivityAttemptsRequest) *historyv1.ResetActivityAttemptsRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.ResetActivityAttemptsRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		ActivityId:        t.Request.ActivityID,
+		Identity:          t.Request.Identity,
+	}
+}
+
+func ToHistoryResetActivityAttemptsRequest(t *historyv1.ResetActivityAttemptsRequest) *types.HistoryResetActivityAttemptsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryResetActivityAttemptsRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.ResetActivityAttemptsRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+			Identity:          t.Identity,
+		},
+	}
+}
+
+func FromHistoryRedriveTimedOutActivitiesRequest(t *types.HistoryRedriveTimedOutActivitiesRequest) *historyv1.RedriveTimedOutActivitiesRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.RedriveTimedOutActivitiesRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		Identity:          t.Request.Identity,
+	}
+}
+
+func ToHistoryRedriveTimedOutActivitiesRequest(t *historyv1.RedriveTimedOutActivitiesRequest) *types.HistoryRedriveTimedOutActivitiesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryRedriveTimedOutActivitiesRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.RedriveTimedOutActivitiesRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			Identity:          t.Identity,
+		},
+	}
+}
+
+func FromHistoryCancelPendingActivitiesRequest(t *types.HistoryCancelPendingActivitiesRequest) *historyv1.CancelPendingActivitiesRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.CancelPendingActivitiesRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		Details:           t.Request.Details,
+		Identity:          t.Request.Identity,
+	}
+}
+
+func ToHistoryCancelPendingActivitiesRequest(t *historyv1.CancelPendingActivitiesRequest) *types.HistoryCancelPendingActivitiesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryCancelPendingActivitiesRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.CancelPendingActivitiesRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			Details:           t.Details,
+			Identity:          t.Identity,
+		},
+	}
+}
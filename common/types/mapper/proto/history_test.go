@@ -66,6 +66,17 @@ func TestHistoryDescribeQueueResponse(t *testing.T) {
 		assert.Equal(t, item, ToHistoryDescribeQueueResponse(FromHistoryDescribeQueueResponse(item)))
 	}
 }
+func TestHistoryBatchDescribeWorkflowExecutionsRequest(t *testing.T) {
+	for _, item := range []*types.HistoryBatchDescribeWorkflowExecutionsRequest{nil, &testdata.HistoryBatchDescribeWorkflowExecutionsRequest} {
+		assert.Equal(t, item, ToHistoryBatchDescribeWorkflowExecutionsRequest(FromHistoryBatchDescribeWorkflowExecutionsRequest(item)))
+	}
+	assert.Nil(t, FromHistoryBatchDescribeWorkflowExecutionsRequest(&types.HistoryBatchDescribeWorkflowExecutionsRequest{}))
+}
+func TestHistoryBatchDescribeWorkflowExecutionsResponse(t *testing.T) {
+	for _, item := range []*types.BatchDescribeWorkflowExecutionsResponse{nil, {}, &testdata.BatchDescribeWorkflowExecutionsResponse} {
+		assert.Equal(t, item, ToHistoryBatchDescribeWorkflowExecutionsResponse(FromHistoryBatchDescribeWorkflowExecutionsResponse(item)))
+	}
+}
 func TestHistoryDescribeWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.HistoryDescribeWorkflowExecutionRequest{nil, {}, &testdata.HistoryDescribeWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToHistoryDescribeWorkflowExecutionRequest(FromHistoryDescribeWorkflowExecutionRequest(item)))
@@ -299,6 +310,12 @@ func TestHistorySignalWithStartWorkflowExecutionResponse(t *testing.T) {
 		assert.Equal(t, item, ToHistorySignalWithStartWorkflowExecutionResponse(FromHistorySignalWithStartWorkflowExecutionResponse(item)))
 	}
 }
+func TestHistorySignalActivityRequest(t *testing.T) {
+	for _, item := range []*types.HistorySignalActivityRequest{nil, &testdata.HistorySignalActivityRequest} {
+		assert.Equal(t, item, ToHistorySignalActivityRequest(FromHistorySignalActivityRequest(item)))
+	}
+	assert.Nil(t, FromHistorySignalActivityRequest(&types.HistorySignalActivityRequest{}))
+}
 func TestHistorySignalWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.HistorySignalWorkflowExecutionRequest{nil, {}, &testdata.HistorySignalWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToHistorySignalWorkflowExecutionRequest(FromHistorySignalWorkflowExecutionRequest(item)))
@@ -393,3 +410,121 @@ func TestRatelimitUpdate(t *testing.T) {
 		assert.Equal(t, &historyv1.RatelimitUpdateResponse{Data: proto}, FromHistoryRatelimitUpdateResponse(&types.RatelimitUpdateResponse{Any: internal}), "response from internal")
 	})
 }
+func TestHistoryGetActivityEffectiveConfigRequest(t *testing.T) {
+	for _, item := range []*types.HistoryGetActivityEffectiveConfigRequest{nil, &testdata.HistoryGetActivityEffectiveConfigRequest} {
+		assert.Equal(t, item, ToHistoryGetActivityEffectiveConfigRequest(FromHistoryGetActivityEffectiveConfigRequest(item)))
+	}
+	assert.Nil(t, FromHistoryGetActivityEffectiveConfigRequest(&types.HistoryGetActivityEffectiveConfigRequest{}))
+}
+func TestHistoryGetActivityEffectiveConfigResponse(t *testing.T) {
+	for _, item := range []*types.GetActivityEffectiveConfigResponse{nil, {}, &testdata.GetActivityEffectiveConfigResponse} {
+		assert.Equal(t, item, ToHistoryGetActivityEffectiveConfigResponse(FromHistoryGetActivityEffectiveConfigResponse(item)))
+	}
+}
+func TestHistoryGetActivityNextRetryTimeRequest(t *testing.T) {
+	for _, item := range []*types.HistoryGetActivityNextRetryTimeRequest{nil, &testdata.HistoryGetActivityNextRetryTimeRequest} {
+		assert.Equal(t, item, ToHistoryGetActivityNextRetryTimeRequest(FromHistoryGetActivityNextRetryTimeRequest(item)))
+	}
+	assert.Nil(t, FromHistoryGetActivityNextRetryTimeRequest(&types.HistoryGetActivityNextRetryTimeRequest{}))
+}
+func TestHistoryGetActivityNextRetryTimeResponse(t *testing.T) {
+	for _, item := range []*types.GetActivityNextRetryTimeResponse{nil, {}, &testdata.GetActivityNextRetryTimeResponse} {
+		assert.Equal(t, item, ToHistoryGetActivityNextRetryTimeResponse(FromHistoryGetActivityNextRetryTimeResponse(item)))
+	}
+}
+func TestHistoryReplayActivityCompletionsRequest(t *testing.T) {
+	for _, item := range []*types.HistoryReplayActivityCompletionsRequest{nil, &testdata.HistoryReplayActivityCompletionsRequest} {
+		assert.Equal(t, item, ToHistoryReplayActivityCompletionsRequest(FromHistoryReplayActivityCompletionsRequest(item)))
+	}
+	assert.Nil(t, FromHistoryReplayActivityCompletionsRequest(&types.HistoryReplayActivityCompletionsRequest{}))
+}
+func TestHistoryReplayActivityCompletionsResponse(t *testing.T) {
+	for _, item := range []*types.ReplayActivityCompletionsResponse{nil, {}, &testdata.ReplayActivityCompletionsResponse} {
+		assert.Equal(t, item, ToHistoryReplayActivityCompletionsResponse(FromHistoryReplayActivityCompletionsResponse(item)))
+	}
+}
+func TestHistoryGetActivityRescheduleReasonsRequest(t *testing.T) {
+	for _, item := range []*types.HistoryGetActivityRescheduleReasonsRequest{nil, &testdata.HistoryGetActivityRescheduleReasonsRequest} {
+		assert.Equal(t, item, ToHistoryGetActivityRescheduleReasonsRequest(FromHistoryGetActivityRescheduleReasonsRequest(item)))
+	}
+	assert.Nil(t, FromHistoryGetActivityRescheduleReasonsRequest(&types.HistoryGetActivityRescheduleReasonsRequest{}))
+}
+func TestHistoryGetActivityRescheduleReasonsResponse(t *testing.T) {
+	for _, item := range []*types.GetActivityRescheduleReasonsResponse{nil, {}, &testdata.GetActivityRescheduleReasonsResponse} {
+		assert.Equal(t, item, ToHistoryGetActivityRescheduleReasonsResponse(FromHistoryGetActivityRescheduleReasonsResponse(item)))
+	}
+}
+func TestHistoryGetActivityWorkerHistoryRequest(t *testing.T) {
+	for _, item := range []*types.HistoryGetActivityWorkerHistoryRequest{nil, &testdata.HistoryGetActivityWorkerHistoryRequest} {
+		assert.Equal(t, item, ToHistoryGetActivityWorkerHistoryRequest(FromHistoryGetActivityWorkerHistoryRequest(item)))
+	}
+	assert.Nil(t, FromHistoryGetActivityWorkerHistoryRequest(&types.HistoryGetActivityWorkerHistoryRequest{}))
+}
+func TestHistoryGetActivityWorkerHistoryResponse(t *testing.T) {
+	for _, item := range []*types.GetActivityWorkerHistoryResponse{nil, {}, &testdata.GetActivityWorkerHistoryResponse} {
+		assert.Equal(t, item, ToHistoryGetActivityWorkerHistoryResponse(FromHistoryGetActivityWorkerHistoryResponse(item)))
+	}
+}
+func TestHistoryRespondActivityTaskNackRequest(t *testing.T) {
+	for _, item := range []*types.HistoryRespondActivityTaskNackRequest{nil, &testdata.HistoryRespondActivityTaskNackRequest} {
+		assert.Equal(t, item, ToHistoryRespondActivityTaskNackRequest(FromHistoryRespondActivityTaskNackRequest(item)))
+	}
+	assert.Nil(t, FromHistoryRespondActivityTaskNackRequest(&types.HistoryRespondActivityTaskNackRequest{}))
+}
+func TestHistoryGetActivityRetryStatsRequest(t *testing.T) {
+	for _, item := range []*types.HistoryGetActivityRetryStatsRequest{nil, &testdata.HistoryGetActivityRetryStatsRequest} {
+		assert.Equal(t, item, ToHistoryGetActivityRetryStatsRequest(FromHistoryGetActivityRetryStatsRequest(item)))
+	}
+	assert.Nil(t, FromHistoryGetActivityRetryStatsRequest(&types.HistoryGetActivityRetryStatsRequest{}))
+}
+func TestHistoryGetActivityRetryStatsResponse(t *testing.T) {
+	for _, item := range []*types.GetActivityRetryStatsResponse{nil, {}, &testdata.GetActivityRetryStatsResponse} {
+		assert.Equal(t, item, ToHistoryGetActivityRetryStatsResponse(FromHistoryGetActivityRetryStatsResponse(item)))
+	}
+}
+func TestHistoryGetActivityTokenValidationFailuresRequest(t *testing.T) {
+	for _, item := range []*types.HistoryGetActivityTokenValidationFailuresRequest{nil, &testdata.HistoryGetActivityTokenValidationFailuresRequest} {
+		assert.Equal(t, item, ToHistoryGetActivityTokenValidationFailuresRequest(FromHistoryGetActivityTokenValidationFailuresRequest(item)))
+	}
+	assert.Nil(t, FromHistoryGetActivityTokenValidationFailuresRequest(&types.HistoryGetActivityTokenValidationFailuresRequest{}))
+}
+func TestHistoryGetActivityTokenValidationFailuresResponse(t *testing.T) {
+	for _, item := range []*types.GetActivityTokenValidationFailuresResponse{nil, {}, &testdata.GetActivityTokenValidationFailuresResponse} {
+		assert.Equal(t, item, ToHistoryGetActivityTokenValidationFailuresResponse(FromHistoryGetActivityTokenValidationFailuresResponse(item)))
+	}
+}
+func TestHistoryGetActivityAttemptTimingsRequest(t *testing.T) {
+	for _, item := range []*types.HistoryGetActivityAttemptTimingsRequest{nil, &testdata.HistoryGetActivityAttemptTimingsRequest} {
+		assert.Equal(t, item, ToHistoryGetActivityAttemptTimingsRequest(FromHistoryGetActivityAttemptTimingsRequest(item)))
+	}
+	assert.Nil(t, FromHistoryGetActivityAttemptTimingsRequest(&types.HistoryGetActivityAttemptTimingsRequest{}))
+}
+func TestHistoryGetActivityAttemptTimingsResponse(t *testing.T) {
+	for _, item := range []*types.GetActivityAttemptTimingsResponse{nil, {}, &testdata.GetActivityAttemptTimingsResponse} {
+		assert.Equal(t, item, ToHistoryGetActivityAttemptTimingsResponse(FromHistoryGetActivityAttemptTimingsResponse(item)))
+	}
+}
+func TestHistoryExpireActivityHeartbeatRequest(t *testing.T) {
+	for _, item := range []*types.HistoryExpireActivityHeartbeatRequest{nil, &testdata.HistoryExpireActivityHeartbeatRequest} {
+		assert.Equal(t, item, ToHistoryExpireActivityHeartbeatRequest(FromHistoryExpireActivityHeartbeatRequest(item)))
+	}
+	assert.Nil(t, FromHistoryExpireActivityHeartbeatRequest(&types.HistoryExpireActivityHeartbeatRequest{}))
+}
+func TestHistoryResetActivityAttemptsRequest(t *testing.T) {
+	for _, item := range []*types.HistoryResetActivityAttemptsRequest{nil, &testdata.HistoryResetActivityAttemptsRequest} {
+		assert.Equal(t, item, ToHistoryResetActivityAttemptsRequest(FromHistoryResetActivityAttemptsRequest(item)))
+	}
+	assert.Nil(t, FromHistoryResetActivityAttemptsRequest(&types.HistoryResetActivityAttemptsRequest{}))
+}
+func TestHistoryRedriveTimedOutActivitiesRequest(t *testing.T) {
+	for _, item := range []*types.HistoryRedriveTimedOutActivitiesRequest{nil, &testdata.HistoryRedriveTimedOutActivitiesRequest} {
+		assert.Equal(t, item, ToHistoryRedriveTimedOutActivitiesRequest(FromHistoryRedriveTimedOutActivitiesRequest(item)))
+	}
+	assert.Nil(t, FromHistoryRedriveTimedOutActivitiesRequest(&types.HistoryRedriveTimedOutActivitiesRequest{}))
+}
+func TestHistoryCancelPendingActivitiesRequest(t *testing.T) {
+	for _, item := range []*types.HistoryCancelPendingActivitiesRequest{nil, &testdata.HistoryCancelPendingActivitiesRequest} {
+		assert.Equal(t, item, ToHistoryCancelPendingActivitiesRequest(FromHistoryCancelPendingActivitiesRequest(item)))
+	}
+	assert.Nil(t, FromHistoryCancelPendingActivitiesRequest(&types.HistoryCancelPendingActivitiesRequest{}))
+}
@@ -41,6 +41,7 @@ func FromMatchingAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matchi
 		ForwardedFrom:            t.ForwardedFrom,
 		ActivityTaskDispatchInfo: FromActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:          t.PartitionConfig,
+		Priority:                 fromInt32Value(t.Priority),
 	}
 }
 
@@ -59,6 +60,7 @@ func ToMatchingAddActivityTaskRequest(t *matchingv1.AddActivityTaskRequest) *typ
 		ForwardedFrom:                 t.ForwardedFrom,
 		ActivityTaskDispatchInfo:      ToActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      toInt32Value(t.Priority),
 	}
 }
 
@@ -99,7 +101,8 @@ func FromMatchingAddActivityTaskResponse(t *types.AddActivityTaskResponse) *matc
 		return nil
 	}
 	return &matchingv1.AddActivityTaskResponse{
-		PartitionConfig: FromTaskListPartitionConfig(t.PartitionConfig),
+		PartitionConfig:   FromTaskListPartitionConfig(t.PartitionConfig),
+		VisibilityTimeout: secondsToDuration(&t.VisibilityTimeoutSeconds),
 	}
 }
 
@@ -108,7 +111,8 @@ func ToMatchingAddActivityTaskResponse(t *matchingv1.AddActivityTaskResponse) *t
 		return nil
 	}
 	return &types.AddActivityTaskResponse{
-		PartitionConfig: ToTaskListPartitionConfig(t.PartitionConfig),
+		PartitionConfig:          ToTaskListPartitionConfig(t.PartitionConfig),
+		VisibilityTimeoutSeconds: common.Int32Default(durationToSeconds(t.VisibilityTimeout)),
 	}
 }
 
@@ -192,6 +196,28 @@ func ToMatchingCancelOutstandingPollRequest(t *matchingv1.CancelOutstandingPollR
 	}
 }
 
+func FromMatchingRecordBackpressureHintRequest(t *types.MatchingRecordBackpressureHintRequest) *matchingv1.RecordBackpressureHintRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.RecordBackpressureHintRequest{
+		DomainId: t.DomainUUID,
+		TaskList: FromTaskList(t.TaskList),
+		Hint:     t.Hint,
+	}
+}
+
+func ToMatchingRecordBackpressureHintRequest(t *matchingv1.RecordBackpressureHintRequest) *types.MatchingRecordBackpressureHintRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingRecordBackpressureHintRequest{
+		DomainUUID: t.DomainId,
+		TaskList:   ToTaskList(t.TaskList),
+		Hint:       t.Hint,
+	}
+}
+
 func FromMatchingDescribeTaskListRequest(t *types.MatchingDescribeTaskListRequest) *matchingv1.DescribeTaskListRequest {
 	if t == nil {
 		return nil
@@ -346,6 +372,7 @@ func FromMatchingPollForActivityTaskRequest(t *types.MatchingPollForActivityTask
 		PollerId:       t.PollerID,
 		ForwardedFrom:  t.ForwardedFrom,
 		IsolationGroup: t.IsolationGroup,
+		PrefetchCount:  t.PollRequest.GetPrefetchCount(),
 	}
 }
 
@@ -353,13 +380,18 @@ func ToMatchingPollForActivityTaskRequest(t *matchingv1.PollForActivityTaskReque
 	if t == nil {
 		return nil
 	}
-	return &types.MatchingPollForActivityTaskRequest{
+	request := &types.MatchingPollForActivityTaskRequest{
 		PollRequest:    ToPollForActivityTaskRequest(t.Request),
 		DomainUUID:     t.DomainId,
 		PollerID:       t.PollerId,
 		ForwardedFrom:  t.ForwardedFrom,
 		IsolationGroup: t.IsolationGroup,
 	}
+	// api.v1.PollForActivityTaskRequest has no prefetch count, it is carried next to it
+	if request.PollRequest != nil {
+		request.PollRequest.PrefetchCount = t.PrefetchCount
+	}
+	return request
 }
 
 func FromTaskListPartitionConfig(t *types.TaskListPartitionConfig) *matchingv1.TaskListPartitionConfig {
@@ -456,6 +488,7 @@ func FromMatchingPollForActivityTaskResponse(t *types.MatchingPollForActivityTas
 		PartitionConfig:            FromTaskListPartitionConfig(t.PartitionConfig),
 		LoadBalancerHints:          FromLoadBalancerHints(t.LoadBalancerHints),
 		AutoConfigHint:             FromAutoConfigHint(t.AutoConfigHint),
+		PrefetchedTasks:            FromMatchingPollForActivityTaskResponseArray(t.PrefetchedTasks),
 	}
 }
 
@@ -483,9 +516,32 @@ func ToMatchingPollForActivityTaskResponse(t *matchingv1.PollForActivityTaskResp
 		PartitionConfig:                 ToTaskListPartitionConfig(t.PartitionConfig),
 		LoadBalancerHints:               ToLoadBalancerHints(t.LoadBalancerHints),
 		AutoConfigHint:                  ToAutoConfigHint(t.AutoConfigHint),
+		PrefetchedTasks:                 ToMatchingPollForActivityTaskResponseArray(t.PrefetchedTasks),
 	}
 }
 
+func FromMatchingPollForActivityTaskResponseArray(t []*types.MatchingPollForActivityTaskResponse) []*matchingv1.PollForActivityTaskResponse {
+	if t == nil {
+		return nil
+	}
+	v := make([]*matchingv1.PollForActivityTaskResponse, len(t))
+	for i := range t {
+		v[i] = FromMatchingPollForActivityTaskResponse(t[i])
+	}
+	return v
+}
+
+func ToMatchingPollForActivityTaskResponseArray(t []*matchingv1.PollForActivityTaskResponse) []*types.MatchingPollForActivityTaskResponse {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.MatchingPollForActivityTaskResponse, len(t))
+	for i := range t {
+		v[i] = ToMatchingPollForActivityTaskResponse(t[i])
+	}
+	return v
+}
+
 func FromMatchingPollForDecisionTaskRequest(t *types.MatchingPollForDecisionTaskRequest) *matchingv1.PollForDecisionTaskRequest {
 	if t == nil {
 		return nil
@@ -714,6 +770,54 @@ func ToMatchingRefreshTaskListPartitionConfigResponse(t *matchingv1.RefreshTaskL
 	return &types.MatchingRefreshTaskListPartitionConfigResponse{}
 }
 
+func FromMatchingRemoveTaskListTaskRequest(t *types.MatchingRemoveTaskListTaskRequest) *matchingv1.RemoveTaskListTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.RemoveTaskListTaskRequest{
+		DomainId:          t.DomainUUID,
+		TaskList:          FromTaskList(t.TaskList),
+		TaskId:            t.TaskID,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ScheduleId:        t.ScheduleID,
+		Operator:          t.Operator,
+		Reason:            t.Reason,
+	}
+}
+
+func ToMatchingRemoveTaskListTaskRequest(t *matchingv1.RemoveTaskListTaskRequest) *types.MatchingRemoveTaskListTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingRemoveTaskListTaskRequest{
+		DomainUUID:        t.DomainId,
+		TaskList:          ToTaskList(t.TaskList),
+		TaskID:            t.TaskId,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ScheduleID:        t.ScheduleId,
+		Operator:          t.Operator,
+		Reason:            t.Reason,
+	}
+}
+
+func FromMatchingRemoveTaskListTaskResponse(t *types.MatchingRemoveTaskListTaskResponse) *matchingv1.RemoveTaskListTaskResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.RemoveTaskListTaskResponse{
+		TaskId: t.TaskID,
+	}
+}
+
+func ToMatchingRemoveTaskListTaskResponse(t *matchingv1.RemoveTaskListTaskResponse) *types.MatchingRemoveTaskListTaskResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingRemoveTaskListTaskResponse{
+		TaskID: t.TaskId,
+	}
+}
+
 func FromLoadBalancerHints(t *types.LoadBalancerHints) *matchingv1.LoadBalancerHints {
 	if t == nil {
 		return nil
@@ -60,6 +60,12 @@ func TestMatchingCancelOutstandingPollRequest(t *testing.T) {
 	}
 }
 
+func TestMatchingRecordBackpressureHintRequest(t *testing.T) {
+	for _, item := range []*types.MatchingRecordBackpressureHintRequest{nil, {}, &testdata.MatchingRecordBackpressureHintRequest} {
+		assert.Equal(t, item, ToMatchingRecordBackpressureHintRequest(FromMatchingRecordBackpressureHintRequest(item)))
+	}
+}
+
 func TestMatchingDescribeTaskListRequest(t *testing.T) {
 	for _, item := range []*types.MatchingDescribeTaskListRequest{nil, {}, &testdata.MatchingDescribeTaskListRequest} {
 		assert.Equal(t, item, ToMatchingDescribeTaskListRequest(FromMatchingDescribeTaskListRequest(item)))
@@ -174,6 +180,18 @@ func TestMatchingRefreshTaskListPartitionConfigResponse(t *testing.T) {
 	}
 }
 
+func TestMatchingRemoveTaskListTaskRequest(t *testing.T) {
+	for _, item := range []*types.MatchingRemoveTaskListTaskRequest{nil, {}, &testdata.MatchingRemoveTaskListTaskRequest} {
+		assert.Equal(t, item, ToMatchingRemoveTaskListTaskRequest(FromMatchingRemoveTaskListTaskRequest(item)))
+	}
+}
+
+func TestMatchingRemoveTaskListTaskResponse(t *testing.T) {
+	for _, item := range []*types.MatchingRemoveTaskListTaskResponse{nil, {}, &testdata.MatchingRemoveTaskListTaskResponse} {
+		assert.Equal(t, item, ToMatchingRemoveTaskListTaskResponse(FromMatchingRemoveTaskListTaskResponse(item)))
+	}
+}
+
 func TestToMatchingTaskListPartitionConfig(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -29,8 +29,18 @@ import (
 )
 
 var (
-	FromAdminRefreshWorkflowTasksRequest = FromRefreshWorkflowTasksRequest
-	ToAdminRefreshWorkflowTasksRequest   = ToRefreshWorkflowTasksRequest
+	FromAdminRefreshWorkflowTasksRequest       = FromRefreshWorkflowTasksRequest
+	ToAdminRefreshWorkflowTasksRequest         = ToRefreshWorkflowTasksRequest
+	FromAdminReplayActivityCompletionsRequest  = FromReplayActivityCompletionsRequest
+	ToAdminReplayActivityCompletionsRequest    = ToReplayActivityCompletionsRequest
+	FromAdminReplayActivityCompletionsResponse = FromReplayActivityCompletionsResponse
+	ToAdminReplayActivityCompletionsResponse   = ToReplayActivityCompletionsResponse
+	FromAdminExpireActivityHeartbeatRequest    = FromExpireActivityHeartbeatRequest
+	ToAdminExpireActivityHeartbeatRequest      = ToExpireActivityHeartbeatRequest
+	FromAdminRedriveTimedOutActivitiesRequest  = FromRedriveTimedOutActivitiesRequest
+	ToAdminRedriveTimedOutActivitiesRequest    = ToRedriveTimedOutActivitiesRequest
+	FromAdminCancelPendingActivitiesRequest    = FromCancelPendingActivitiesRequest
+	ToAdminCancelPendingActivitiesRequest      = ToCancelPendingActivitiesRequest
 )
 
 // FromAdminAddSearchAttributeRequest converts internal AddSearchAttributeRequest type to thrift
@@ -992,5 +1002,213 @@ func ToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(in *admin.UpdateDomain
 	return &types.UpdateDomainAsyncWorkflowConfiguratonResponse{}
 }
 
+// FromAdminRemoveTaskListTaskRequest converts internal RemoveTaskListTaskRequest type to thrift
+func FromAdminRemoveTaskListTaskRequest(t *types.RemoveTaskListTaskRequest) *admin.RemoveTaskListTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.RemoveTaskListTaskRequest{
+		Domain:            &t.Domain,
+		TaskList:          FromTaskList(t.TaskList),
+		TaskID:            &t.TaskID,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ScheduleID:        &t.ScheduleID,
+		Operator:          &t.Operator,
+		Reason:            &t.Reason,
+	}
+}
+
+// ToAdminRemoveTaskListTaskRequest converts thrift RemoveTaskListTaskRequest type to internal
+func ToAdminRemoveTaskListTaskRequest(t *admin.RemoveTaskListTaskRequest) *types.RemoveTaskListTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RemoveTaskListTaskRequest{
+		Domain:            t.GetDomain(),
+		TaskList:          ToTaskList(t.TaskList),
+		TaskID:            t.GetTaskID(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ScheduleID:        t.GetScheduleID(),
+		Operator:          t.GetOperator(),
+		Reason:            t.GetReason(),
+	}
+}
+
+// FromAdminRemoveTaskListTaskResponse converts internal RemoveTaskListTaskResponse type to thrift
+func FromAdminRemoveTaskListTaskResponse(t *types.RemoveTaskListTaskResponse) *admin.RemoveTaskListTaskResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.RemoveTaskListTaskResponse{
+		TaskID: &t.TaskID,
+	}
+}
+
+// ToAdminRemoveTaskListTaskResponse converts thrift RemoveTaskListTaskResponse type to internal
+func ToAdminRemoveTaskListTaskResponse(t *admin.RemoveTaskListTaskResponse) *types.RemoveTaskListTaskResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.RemoveTaskListTaskResponse{
+		TaskID: t.GetTaskID(),
+	}
+}
+
 func strPtr(s string) *string                                             { return &s }
 func igStatePtr(s shared.IsolationGroupState) *shared.IsolationGroupState { return &s }
+
+// FromAdminDecodeTaskTokenRequest converts internal DecodeTaskTokenRequest type to thrift
+func FromAdminDecodeTaskTokenRequest(t *types.DecodeTaskTokenRequest) *admin.DecodeTaskTokenRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.DecodeTaskTokenRequest{
+		TaskToken: t.TaskToken,
+	}
+}
+
+// ToAdminDecodeTaskTokenRequest converts thrift DecodeTaskTokenRequest type to internal
+func ToAdminDecodeTaskTokenRequest(t *admin.DecodeTaskTokenRequest) *types.DecodeTaskTokenRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DecodeTaskTokenRequest{
+		TaskToken: t.TaskToken,
+	}
+}
+
+// FromAdminDecodeTaskTokenResponse converts internal DecodeTaskTokenResponse type to thrift
+func FromAdminDecodeTaskTokenResponse(t *types.DecodeTaskTokenResponse) *admin.DecodeTaskTokenResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.DecodeTaskTokenResponse{
+		Domain:          &t.Domain,
+		DomainID:        &t.DomainID,
+		WorkflowID:      &t.WorkflowID,
+		RunID:           &t.RunID,
+		WorkflowType:    &t.WorkflowType,
+		ScheduleID:      &t.ScheduleID,
+		ScheduleAttempt: &t.ScheduleAttempt,
+		ActivityID:      &t.ActivityID,
+		ActivityType:    &t.ActivityType,
+	}
+}
+
+// ToAdminDecodeTaskTokenResponse converts thrift DecodeTaskTokenResponse type to internal
+func ToAdminDecodeTaskTokenResponse(t *admin.DecodeTaskTokenResponse) *types.DecodeTaskTokenResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DecodeTaskTokenResponse{
+		Domain:          t.GetDomain(),
+		DomainID:        t.GetDomainID(),
+		WorkflowID:      t.GetWorkflowID(),
+		RunID:           t.GetRunID(),
+		WorkflowType:    t.GetWorkflowType(),
+		ScheduleID:      t.GetScheduleID(),
+		ScheduleAttempt: t.GetScheduleAttempt(),
+		ActivityID:      t.GetActivityID(),
+		ActivityType:    t.GetActivityType(),
+	}
+}
+
+// FromAdminBatchTerminateRequest converts internal BatchTerminateRequest type to thrift
+func FromAdminBatchTerminateRequest(t *types.BatchTerminateRequest) *admin.BatchTerminateRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.BatchTerminateRequest{
+		Domain:                &t.Domain,
+		Query:                 &t.Query,
+		Reason:                &t.Reason,
+		DrainTimeoutInSeconds: &t.DrainTimeoutInSeconds,
+		RequestsPerSecond:     &t.RequestsPerSecond,
+		Concurrency:           &t.Concurrency,
+		TerminateChildren:     t.TerminateChildren,
+		Identity:              &t.Identity,
+	}
+}
+
+// ToAdminBatchTerminateRequest converts thrift BatchTerminateRequest type to internal
+func ToAdminBatchTerminateRequest(t *admin.BatchTerminateRequest) *types.BatchTerminateRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.BatchTerminateRequest{
+		Domain:                t.GetDomain(),
+		Query:                 t.GetQuery(),
+		Reason:                t.GetReason(),
+		DrainTimeoutInSeconds: t.GetDrainTimeoutInSeconds(),
+		RequestsPerSecond:     t.GetRequestsPerSecond(),
+		Concurrency:           t.GetConcurrency(),
+		TerminateChildren:     t.TerminateChildren,
+		Identity:              t.GetIdentity(),
+	}
+}
+
+// FromAdminBatchTerminateResponse converts internal BatchTerminateResponse type to thrift
+func FromAdminBatchTerminateResponse(t *types.BatchTerminateResponse) *admin.BatchTerminateResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.BatchTerminateResponse{
+		JobID: &t.JobID,
+	}
+}
+
+// ToAdminBatchTerminateResponse converts thrift BatchTerminateResponse type to internal
+func ToAdminBatchTerminateResponse(t *admin.BatchTerminateResponse) *types.BatchTerminateResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.BatchTerminateResponse{
+		JobID: t.GetJobID(),
+	}
+}
+
+// FromAdminDescribeBatchTerminateRequest converts internal DescribeBatchTerminateRequest type to thrift
+func FromAdminDescribeBatchTerminateRequest(t *types.DescribeBatchTerminateRequest) *admin.DescribeBatchTerminateRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.DescribeBatchTerminateRequest{
+		JobID: &t.JobID,
+	}
+}
+
+// ToAdminDescribeBatchTerminateRequest converts thrift DescribeBatchTerminateRequest type to internal
+func ToAdminDescribeBatchTerminateRequest(t *admin.DescribeBatchTerminateRequest) *types.DescribeBatchTerminateRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeBatchTerminateRequest{
+		JobID: t.GetJobID(),
+	}
+}
+
+// FromAdminDescribeBatchTerminateResponse converts internal DescribeBatchTerminateResponse type to thrift
+func FromAdminDescribeBatchTerminateResponse(t *types.DescribeBatchTerminateResponse) *admin.DescribeBatchTerminateResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.DescribeBatchTerminateResponse{
+		CloseStatus:   FromWorkflowExecutionCloseStatus(t.CloseStatus),
+		TotalEstimate: &t.TotalEstimate,
+		SuccessCount:  &t.SuccessCount,
+		ErrorCount:    &t.ErrorCount,
+	}
+}
+
+// ToAdminDescribeBatchTerminateResponse converts thrift DescribeBatchTerminateResponse type to internal
+func ToAdminDescribeBatchTerminateResponse(t *admin.DescribeBatchTerminateResponse) *types.DescribeBatchTerminateResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeBatchTerminateResponse{
+		CloseStatus:   ToWorkflowExecutionCloseStatus(t.CloseStatus),
+		TotalEstimate: t.GetTotalEstimate(),
+		SuccessCount:  t.GetSuccessCount(),
+		ErrorCount:    t.GetErrorCount(),
+	}
+}
@@ -194,6 +194,16 @@ func TestAdminRemoveTaskRequest(t *testing.T) {
 		assert.Equal(t, item, ToAdminRemoveTaskRequest(FromAdminRemoveTaskRequest(item)))
 	}
 }
+func TestAdminRemoveTaskListTaskRequest(t *testing.T) {
+	for _, item := range []*types.RemoveTaskListTaskRequest{nil, {}, &testdata.AdminRemoveTaskListTaskRequest} {
+		assert.Equal(t, item, ToAdminRemoveTaskListTaskRequest(FromAdminRemoveTaskListTaskRequest(item)))
+	}
+}
+func TestAdminRemoveTaskListTaskResponse(t *testing.T) {
+	for _, item := range []*types.RemoveTaskListTaskResponse{nil, {}, &testdata.AdminRemoveTaskListTaskResponse} {
+		assert.Equal(t, item, ToAdminRemoveTaskListTaskResponse(FromAdminRemoveTaskListTaskResponse(item)))
+	}
+}
 func TestAdminResendReplicationTasksRequest(t *testing.T) {
 	for _, item := range []*types.ResendReplicationTasksRequest{nil, {}, &testdata.AdminResendReplicationTasksRequest} {
 		assert.Equal(t, item, ToAdminResendReplicationTasksRequest(FromAdminResendReplicationTasksRequest(item)))
@@ -975,3 +985,33 @@ func TestToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(t *testing.T) {
 		})
 	}
 }
+func TestAdminDecodeTaskTokenRequest(t *testing.T) {
+	for _, item := range []*types.DecodeTaskTokenRequest{nil, {}, &testdata.AdminDecodeTaskTokenRequest} {
+		assert.Equal(t, item, ToAdminDecodeTaskTokenRequest(FromAdminDecodeTaskTokenRequest(item)))
+	}
+}
+func TestAdminDecodeTaskTokenResponse(t *testing.T) {
+	for _, item := range []*types.DecodeTaskTokenResponse{nil, {}, &testdata.AdminDecodeTaskTokenResponse} {
+		assert.Equal(t, item, ToAdminDecodeTaskTokenResponse(FromAdminDecodeTaskTokenResponse(item)))
+	}
+}
+func TestAdminBatchTerminateRequest(t *testing.T) {
+	for _, item := range []*types.BatchTerminateRequest{nil, {}, &testdata.AdminBatchTerminateRequest} {
+		assert.Equal(t, item, ToAdminBatchTerminateRequest(FromAdminBatchTerminateRequest(item)))
+	}
+}
+func TestAdminBatchTerminateResponse(t *testing.T) {
+	for _, item := range []*types.BatchTerminateResponse{nil, {}, &testdata.AdminBatchTerminateResponse} {
+		assert.Equal(t, item, ToAdminBatchTerminateResponse(FromAdminBatchTerminateResponse(item)))
+	}
+}
+func TestAdminDescribeBatchTerminateRequest(t *testing.T) {
+	for _, item := range []*types.DescribeBatchTerminateRequest{nil, {}, &testdata.AdminDescribeBatchTerminateRequest} {
+		assert.Equal(t, item, ToAdminDescribeBatchTerminateRequest(FromAdminDescribeBatchTerminateRequest(item)))
+	}
+}
+func TestAdminDescribeBatchTerminateResponse(t *testing.T) {
+	for _, item := range []*types.DescribeBatchTerminateResponse{nil, {}, &testdata.AdminDescribeBatchTerminateResponse} {
+		assert.Equal(t, item, ToAdminDescribeBatchTerminateResponse(FromAdminDescribeBatchTerminateResponse(item)))
+	}
+}
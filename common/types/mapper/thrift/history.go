@@ -26,72 +26,112 @@ import (
 )
 
 var (
-	FromHistoryDescribeHistoryHostRequest                = FromAdminDescribeHistoryHostRequest
-	ToHistoryDescribeHistoryHostRequest                  = ToAdminDescribeHistoryHostRequest
-	FromHistoryDescribeHistoryHostResponse               = FromAdminDescribeHistoryHostResponse
-	ToHistoryDescribeHistoryHostResponse                 = ToAdminDescribeHistoryHostResponse
-	FromHistoryCloseShardRequest                         = FromAdminCloseShardRequest
-	ToHistoryCloseShardRequest                           = ToAdminCloseShardRequest
-	FromHistoryDescribeQueueRequest                      = FromAdminDescribeQueueRequest
-	ToHistoryDescribeQueueRequest                        = ToAdminDescribeQueueRequest
-	FromHistoryDescribeQueueResponse                     = FromAdminDescribeQueueResponse
-	ToHistoryDescribeQueueResponse                       = ToAdminDescribeQueueResponse
-	FromHistoryDescribeWorkflowExecutionResponse         = FromDescribeWorkflowExecutionResponse
-	ToHistoryDescribeWorkflowExecutionResponse           = ToDescribeWorkflowExecutionResponse
-	FromHistoryGetCrossClusterTasksRequest               = FromAdminGetCrossClusterTasksRequest
-	ToHistoryGetCrossClusterTasksRequest                 = ToAdminGetCrossClusterTasksRequest
-	FromHistoryGetCrossClusterTasksResponse              = FromAdminGetCrossClusterTasksResponse
-	ToHistoryGetCrossClusterTasksResponse                = ToAdminGetCrossClusterTasksResponse
-	FromHistoryGetDLQReplicationMessagesRequest          = FromAdminGetDLQReplicationMessagesRequest
-	ToHistoryGetDLQReplicationMessagesRequest            = ToAdminGetDLQReplicationMessagesRequest
-	FromHistoryGetDLQReplicationMessagesResponse         = FromAdminGetDLQReplicationMessagesResponse
-	ToHistoryGetDLQReplicationMessagesResponse           = ToAdminGetDLQReplicationMessagesResponse
-	FromHistoryGetFailoverInfoRequest                    = FromGetFailoverInfoRequest
-	ToHistoryGetFailoverInfoRequest                      = ToGetFailoverInfoRequest
-	FromHistoryGetFailoverInfoResponse                   = FromGetFailoverInfoResponse
-	ToHistoryGetFailoverInfoResponse                     = ToGetFailoverInfoResponse
-	FromHistoryGetReplicationMessagesRequest             = FromAdminGetReplicationMessagesRequest
-	ToHistoryGetReplicationMessagesRequest               = ToAdminGetReplicationMessagesRequest
-	FromHistoryGetReplicationMessagesResponse            = FromAdminGetReplicationMessagesResponse
-	ToHistoryGetReplicationMessagesResponse              = ToAdminGetReplicationMessagesResponse
-	FromHistoryMergeDLQMessagesRequest                   = FromAdminMergeDLQMessagesRequest
-	ToHistoryMergeDLQMessagesRequest                     = ToAdminMergeDLQMessagesRequest
-	FromHistoryMergeDLQMessagesResponse                  = FromAdminMergeDLQMessagesResponse
-	ToHistoryMergeDLQMessagesResponse                    = ToAdminMergeDLQMessagesResponse
-	FromHistoryPurgeDLQMessagesRequest                   = FromAdminPurgeDLQMessagesRequest
-	ToHistoryPurgeDLQMessagesRequest                     = ToAdminPurgeDLQMessagesRequest
-	FromHistoryReadDLQMessagesRequest                    = FromAdminReadDLQMessagesRequest
-	ToHistoryReadDLQMessagesRequest                      = ToAdminReadDLQMessagesRequest
-	FromHistoryReadDLQMessagesResponse                   = FromAdminReadDLQMessagesResponse
-	ToHistoryReadDLQMessagesResponse                     = ToAdminReadDLQMessagesResponse
-	FromHistoryRecordActivityTaskHeartbeatResponse       = FromRecordActivityTaskHeartbeatResponse
-	ToHistoryRecordActivityTaskHeartbeatResponse         = ToRecordActivityTaskHeartbeatResponse
-	FromHistoryRecordActivityTaskStartedRequest          = FromRecordActivityTaskStartedRequest
-	ToHistoryRecordActivityTaskStartedRequest            = ToRecordActivityTaskStartedRequest
-	FromHistoryRecordActivityTaskStartedResponse         = FromRecordActivityTaskStartedResponse
-	ToHistoryRecordActivityTaskStartedResponse           = ToRecordActivityTaskStartedResponse
-	FromHistoryRecordChildExecutionCompletedRequest      = FromRecordChildExecutionCompletedRequest
-	ToHistoryRecordChildExecutionCompletedRequest        = ToRecordChildExecutionCompletedRequest
-	FromHistoryRecordDecisionTaskStartedRequest          = FromRecordDecisionTaskStartedRequest
-	ToHistoryRecordDecisionTaskStartedRequest            = ToRecordDecisionTaskStartedRequest
-	FromHistoryRecordDecisionTaskStartedResponse         = FromRecordDecisionTaskStartedResponse
-	ToHistoryRecordDecisionTaskStartedResponse           = ToRecordDecisionTaskStartedResponse
-	FromHistoryRemoveTaskRequest                         = FromAdminRemoveTaskRequest
-	ToHistoryRemoveTaskRequest                           = ToAdminRemoveTaskRequest
-	FromHistoryResetQueueRequest                         = FromAdminResetQueueRequest
-	ToHistoryResetQueueRequest                           = ToAdminResetQueueRequest
-	FromHistoryResetWorkflowExecutionResponse            = FromResetWorkflowExecutionResponse
-	ToHistoryResetWorkflowExecutionResponse              = ToResetWorkflowExecutionResponse
-	FromHistoryRespondCrossClusterTasksCompletedRequest  = FromAdminRespondCrossClusterTasksCompletedRequest
-	ToHistoryRespondCrossClusterTasksCompletedRequest    = ToAdminRespondCrossClusterTasksCompletedRequest
-	FromHistoryRespondCrossClusterTasksCompletedResponse = FromAdminRespondCrossClusterTasksCompletedResponse
-	ToHistoryRespondCrossClusterTasksCompletedResponse   = ToAdminRespondCrossClusterTasksCompletedResponse
-	FromHistorySignalWithStartWorkflowExecutionResponse  = FromStartWorkflowExecutionResponse
-	ToHistorySignalWithStartWorkflowExecutionResponse    = ToStartWorkflowExecutionResponse
-	FromHistoryStartWorkflowExecutionResponse            = FromStartWorkflowExecutionResponse
-	ToHistoryStartWorkflowExecutionResponse              = ToStartWorkflowExecutionResponse
+	FromHistoryDescribeHistoryHostRequest                 = FromAdminDescribeHistoryHostRequest
+	ToHistoryDescribeHistoryHostRequest                   = ToAdminDescribeHistoryHostRequest
+	FromHistoryDescribeHistoryHostResponse                = FromAdminDescribeHistoryHostResponse
+	ToHistoryDescribeHistoryHostResponse                  = ToAdminDescribeHistoryHostResponse
+	FromHistoryCloseShardRequest                          = FromAdminCloseShardRequest
+	ToHistoryCloseShardRequest                            = ToAdminCloseShardRequest
+	FromHistoryDescribeQueueRequest                       = FromAdminDescribeQueueRequest
+	ToHistoryDescribeQueueRequest                         = ToAdminDescribeQueueRequest
+	FromHistoryDescribeQueueResponse                      = FromAdminDescribeQueueResponse
+	ToHistoryDescribeQueueResponse                        = ToAdminDescribeQueueResponse
+	FromHistoryBatchDescribeWorkflowExecutionsResponse    = FromBatchDescribeWorkflowExecutionsResponse
+	ToHistoryBatchDescribeWorkflowExecutionsResponse      = ToBatchDescribeWorkflowExecutionsResponse
+	FromHistoryDescribeWorkflowExecutionResponse          = FromDescribeWorkflowExecutionResponse
+	ToHistoryDescribeWorkflowExecutionResponse            = ToDescribeWorkflowExecutionResponse
+	FromHistoryGetCrossClusterTasksRequest                = FromAdminGetCrossClusterTasksRequest
+	ToHistoryGetCrossClusterTasksRequest                  = ToAdminGetCrossClusterTasksRequest
+	FromHistoryGetCrossClusterTasksResponse               = FromAdminGetCrossClusterTasksResponse
+	ToHistoryGetCrossClusterTasksResponse                 = ToAdminGetCrossClusterTasksResponse
+	FromHistoryGetDLQReplicationMessagesRequest           = FromAdminGetDLQReplicationMessagesRequest
+	ToHistoryGetDLQReplicationMessagesRequest             = ToAdminGetDLQReplicationMessagesRequest
+	FromHistoryGetDLQReplicationMessagesResponse          = FromAdminGetDLQReplicationMessagesResponse
+	ToHistoryGetDLQReplicationMessagesResponse            = ToAdminGetDLQReplicationMessagesResponse
+	FromHistoryGetFailoverInfoRequest                     = FromGetFailoverInfoRequest
+	ToHistoryGetFailoverInfoRequest                       = ToGetFailoverInfoRequest
+	FromHistoryGetFailoverInfoResponse                    = FromGetFailoverInfoResponse
+	ToHistoryGetFailoverInfoResponse                      = ToGetFailoverInfoResponse
+	FromHistoryGetReplicationMessagesRequest              = FromAdminGetReplicationMessagesRequest
+	ToHistoryGetReplicationMessagesRequest                = ToAdminGetReplicationMessagesRequest
+	FromHistoryGetReplicationMessagesResponse             = FromAdminGetReplicationMessagesResponse
+	ToHistoryGetReplicationMessagesResponse               = ToAdminGetReplicationMessagesResponse
+	FromHistoryMergeDLQMessagesRequest                    = FromAdminMergeDLQMessagesRequest
+	ToHistoryMergeDLQMessagesRequest                      = ToAdminMergeDLQMessagesRequest
+	FromHistoryMergeDLQMessagesResponse                   = FromAdminMergeDLQMessagesResponse
+	ToHistoryMergeDLQMessagesResponse                     = ToAdminMergeDLQMessagesResponse
+	FromHistoryPurgeDLQMessagesRequest                    = FromAdminPurgeDLQMessagesRequest
+	ToHistoryPurgeDLQMessagesRequest                      = ToAdminPurgeDLQMessagesRequest
+	FromHistoryReadDLQMessagesRequest                     = FromAdminReadDLQMessagesRequest
+	ToHistoryReadDLQMessagesRequest                       = ToAdminReadDLQMessagesRequest
+	FromHistoryReadDLQMessagesResponse                    = FromAdminReadDLQMessagesResponse
+	ToHistoryReadDLQMessagesResponse                      = ToAdminReadDLQMessagesResponse
+	FromHistoryRecordActivityTaskHeartbeatResponse        = FromRecordActivityTaskHeartbeatResponse
+	ToHistoryRecordActivityTaskHeartbeatResponse          = ToRecordActivityTaskHeartbeatResponse
+	FromHistoryRecordActivityTaskStartedRequest           = FromRecordActivityTaskStartedRequest
+	ToHistoryRecordActivityTaskStartedRequest             = ToRecordActivityTaskStartedRequest
+	FromHistoryRecordActivityTaskStartedResponse          = FromRecordActivityTaskStartedResponse
+	ToHistoryRecordActivityTaskStartedResponse            = ToRecordActivityTaskStartedResponse
+	FromHistoryRecordChildExecutionCompletedRequest       = FromRecordChildExecutionCompletedRequest
+	ToHistoryRecordChildExecutionCompletedRequest         = ToRecordChildExecutionCompletedRequest
+	FromHistoryRecordDecisionTaskStartedRequest           = FromRecordDecisionTaskStartedRequest
+	ToHistoryRecordDecisionTaskStartedRequest             = ToRecordDecisionTaskStartedRequest
+	FromHistoryRecordDecisionTaskStartedResponse          = FromRecordDecisionTaskStartedResponse
+	ToHistoryRecordDecisionTaskStartedResponse            = ToRecordDecisionTaskStartedResponse
+	FromHistoryRemoveTaskRequest                          = FromAdminRemoveTaskRequest
+	ToHistoryRemoveTaskRequest                            = ToAdminRemoveTaskRequest
+	FromHistoryResetQueueRequest                          = FromAdminResetQueueRequest
+	ToHistoryResetQueueRequest                            = ToAdminResetQueueRequest
+	FromHistoryResetWorkflowExecutionResponse             = FromResetWorkflowExecutionResponse
+	ToHistoryResetWorkflowExecutionResponse               = ToResetWorkflowExecutionResponse
+	FromHistoryRespondCrossClusterTasksCompletedRequest   = FromAdminRespondCrossClusterTasksCompletedRequest
+	ToHistoryRespondCrossClusterTasksCompletedRequest     = ToAdminRespondCrossClusterTasksCompletedRequest
+	FromHistoryRespondCrossClusterTasksCompletedResponse  = FromAdminRespondCrossClusterTasksCompletedResponse
+	ToHistoryRespondCrossClusterTasksCompletedResponse    = ToAdminRespondCrossClusterTasksCompletedResponse
+	FromHistorySignalWithStartWorkflowExecutionResponse   = FromStartWorkflowExecutionResponse
+	ToHistorySignalWithStartWorkflowExecutionResponse     = ToStartWorkflowExecutionResponse
+	FromHistoryStartWorkflowExecutionResponse             = FromStartWorkflowExecutionResponse
+	ToHistoryStartWorkflowExecutionResponse               = ToStartWorkflowExecutionResponse
+	FromHistoryGetActivityEffectiveConfigResponse         = FromGetActivityEffectiveConfigResponse
+	ToHistoryGetActivityEffectiveConfigResponse           = ToGetActivityEffectiveConfigResponse
+	FromHistoryGetActivityNextRetryTimeResponse           = FromGetActivityNextRetryTimeResponse
+	ToHistoryGetActivityNextRetryTimeResponse             = ToGetActivityNextRetryTimeResponse
+	FromHistoryReplayActivityCompletionsResponse          = FromReplayActivityCompletionsResponse
+	ToHistoryReplayActivityCompletionsResponse            = ToReplayActivityCompletionsResponse
+	FromHistoryGetActivityRescheduleReasonsResponse       = FromGetActivityRescheduleReasonsResponse
+	ToHistoryGetActivityRescheduleReasonsResponse         = ToGetActivityRescheduleReasonsResponse
+	FromHistoryGetActivityWorkerHistoryResponse           = FromGetActivityWorkerHistoryResponse
+	ToHistoryGetActivityWorkerHistoryResponse             = ToGetActivityWorkerHistoryResponse
+	FromHistoryGetActivityRetryStatsResponse              = FromGetActivityRetryStatsResponse
+	ToHistoryGetActivityRetryStatsResponse                = ToGetActivityRetryStatsResponse
+	FromHistoryGetActivityTokenValidationFailuresResponse = FromGetActivityTokenValidationFailuresResponse
+	ToHistoryGetActivityTokenValidationFailuresResponse   = ToGetActivityTokenValidationFailuresResponse
+	FromHistoryGetActivityAttemptTimingsResponse          = FromGetActivityAttemptTimingsResponse
+	ToHistoryGetActivityAttemptTimingsResponse            = ToGetActivityAttemptTimingsResponse
 )
 
+// FromHistoryCancelPendingActivitiesRequest converts internal CancelPendingActivitiesRequest type to thrift
+func FromHistoryCancelPendingActivitiesRequest(t *types.HistoryCancelPendingActivitiesRequest) *history.CancelPendingActivitiesRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.CancelPendingActivitiesRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromCancelPendingActivitiesRequest(t.Request),
+	}
+}
+
+// ToHistoryCancelPendingActivitiesRequest converts thrift CancelPendingActivitiesRequest type to internal
+func ToHistoryCancelPendingActivitiesRequest(t *history.CancelPendingActivitiesRequest) *types.HistoryCancelPendingActivitiesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryCancelPendingActivitiesRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToCancelPendingActivitiesRequest(t.Request),
+	}
+}
+
 // FromHistoryDescribeMutableStateRequest converts internal DescribeMutableStateRequest type to thrift
 func FromHistoryDescribeMutableStateRequest(t *types.DescribeMutableStateRequest) *history.DescribeMutableStateRequest {
 	if t == nil {
@@ -103,6 +143,270 @@ func FromHistoryDescribeMutableStateRequest(t *types.DescribeMutableStateRequest
 	}
 }
 
+// FromHistoryExpireActivityHeartbeatRequest converts internal ExpireActivityHeartbeatRequest type to thrift
+func FromHistoryExpireActivityHeartbeatRequest(t *types.HistoryExpireActivityHeartbeatRequest) *history.ExpireActivityHeartbeatRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.ExpireActivityHeartbeatRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromExpireActivityHeartbeatRequest(t.Request),
+	}
+}
+
+// FromHistoryRedriveTimedOutActivitiesRequest converts internal RedriveTimedOutActivitiesRequest type to thrift
+func FromHistoryRedriveTimedOutActivitiesRequest(t *types.HistoryRedriveTimedOutActivitiesRequest) *history.RedriveTimedOutActivitiesRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.RedriveTimedOutActivitiesRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromRedriveTimedOutActivitiesRequest(t.Request),
+	}
+}
+
+// ToHistoryRedriveTimedOutActivitiesRequest converts thrift RedriveTimedOutActivitiesRequest type to internal
+func ToHistoryRedriveTimedOutActivitiesRequest(t *history.RedriveTimedOutActivitiesRequest) *types.HistoryRedriveTimedOutActivitiesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryRedriveTimedOutActivitiesRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToRedriveTimedOutActivitiesRequest(t.Request),
+	}
+}
+
+// FromHistoryResetActivityAttemptsRequest converts internal ResetActivityAttemptsRequest type to thrift
+func FromHistoryResetActivityAttemptsRequest(t *types.HistoryResetActivityAttemptsRequest) *history.ResetActivityAttemptsRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.ResetActivityAttemptsRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromResetActivityAttemptsRequest(t.Request),
+	}
+}
+
+// ToHistoryResetActivityAttemptsRequest converts thrift ResetActivityAttemptsRequest type to internal
+func ToHistoryResetActivityAttemptsRequest(t *history.ResetActivityAttemptsRequest) *types.HistoryResetActivityAttemptsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryResetActivityAttemptsRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToResetActivityAttemptsRequest(t.Request),
+	}
+}
+
+// ToHistoryExpireActivityHeartbeatRequest converts thrift ExpireActivityHeartbeatRequest type to internal
+func ToHistoryExpireActivityHeartbeatRequest(t *history.ExpireActivityHeartbeatRequest) *types.HistoryExpireActivityHeartbeatRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryExpireActivityHeartbeatRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToExpireActivityHeartbeatRequest(t.Request),
+	}
+}
+
+// FromHistoryGetActivityAttemptTimingsRequest converts internal GetActivityAttemptTimingsRequest type to thrift
+func FromHistoryGetActivityAttemptTimingsRequest(t *types.HistoryGetActivityAttemptTimingsRequest) *history.GetActivityAttemptTimingsRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.GetActivityAttemptTimingsRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromGetActivityAttemptTimingsRequest(t.Request),
+	}
+}
+
+// ToHistoryGetActivityAttemptTimingsRequest converts thrift GetActivityAttemptTimingsRequest type to internal
+func ToHistoryGetActivityAttemptTimingsRequest(t *history.GetActivityAttemptTimingsRequest) *types.HistoryGetActivityAttemptTimingsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetActivityAttemptTimingsRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToGetActivityAttemptTimingsRequest(t.Request),
+	}
+}
+
+// FromHistoryGetActivityEffectiveConfigRequest converts internal GetActivityEffectiveConfigRequest type to thrift
+func FromHistoryGetActivityEffectiveConfigRequest(t *types.HistoryGetActivityEffectiveConfigRequest) *history.GetActivityEffectiveConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.GetActivityEffectiveConfigRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromGetActivityEffectiveConfigRequest(t.Request),
+	}
+}
+
+// FromHistoryGetActivityNextRetryTimeRequest converts internal GetActivityNextRetryTimeRequest type to thrift
+func FromHistoryGetActivityNextRetryTimeRequest(t *types.HistoryGetActivityNextRetryTimeRequest) *history.GetActivityNextRetryTimeRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.GetActivityNextRetryTimeRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromGetActivityNextRetryTimeRequest(t.Request),
+	}
+}
+
+// FromHistoryGetActivityRescheduleReasonsRequest converts internal GetActivityRescheduleReasonsRequest type to thrift
+func FromHistoryGetActivityRescheduleReasonsRequest(t *types.HistoryGetActivityRescheduleReasonsRequest) *history.GetActivityRescheduleReasonsRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.GetActivityRescheduleReasonsRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromGetActivityRescheduleReasonsRequest(t.Request),
+	}
+}
+
+// FromHistoryGetActivityRetryStatsRequest converts internal GetActivityRetryStatsRequest type to thrift
+func FromHistoryGetActivityRetryStatsRequest(t *types.HistoryGetActivityRetryStatsRequest) *history.GetActivityRetryStatsRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.GetActivityRetryStatsRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromGetActivityRetryStatsRequest(t.Request),
+	}
+}
+
+// FromHistoryGetActivityTokenValidationFailuresRequest converts internal GetActivityTokenValidationFailuresRequest type to thrift
+func FromHistoryGetActivityTokenValidationFailuresRequest(t *types.HistoryGetActivityTokenValidationFailuresRequest) *history.GetActivityTokenValidationFailuresRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.GetActivityTokenValidationFailuresRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromGetActivityTokenValidationFailuresRequest(t.Request),
+	}
+}
+
+// ToHistoryGetActivityTokenValidationFailuresRequest converts thrift GetActivityTokenValidationFailuresRequest type to internal
+func ToHistoryGetActivityTokenValidationFailuresRequest(t *history.GetActivityTokenValidationFailuresRequest) *types.HistoryGetActivityTokenValidationFailuresRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetActivityTokenValidationFailuresRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToGetActivityTokenValidationFailuresRequest(t.Request),
+	}
+}
+
+// ToHistoryGetActivityRetryStatsRequest converts thrift GetActivityRetryStatsRequest type to internal
+func ToHistoryGetActivityRetryStatsRequest(t *history.GetActivityRetryStatsRequest) *types.HistoryGetActivityRetryStatsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetActivityRetryStatsRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToGetActivityRetryStatsRequest(t.Request),
+	}
+}
+
+// FromHistoryGetActivityWorkerHistoryRequest converts internal GetActivityWorkerHistoryRequest type to thrift
+func FromHistoryGetActivityWorkerHistoryRequest(t *types.HistoryGetActivityWorkerHistoryRequest) *history.GetActivityWorkerHistoryRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.GetActivityWorkerHistoryRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromGetActivityWorkerHistoryRequest(t.Request),
+	}
+}
+
+// FromHistoryRespondActivityTaskNackRequest converts internal RespondActivityTaskNackRequest type to thrift
+func FromHistoryRespondActivityTaskNackRequest(t *types.HistoryRespondActivityTaskNackRequest) *history.RespondActivityTaskNackRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.RespondActivityTaskNackRequest{
+		DomainUUID:  &t.DomainUUID,
+		NackRequest: FromRespondActivityTaskNackRequest(t.NackRequest),
+	}
+}
+
+// ToHistoryRespondActivityTaskNackRequest converts thrift RespondActivityTaskNackRequest type to internal
+func ToHistoryRespondActivityTaskNackRequest(t *history.RespondActivityTaskNackRequest) *types.HistoryRespondActivityTaskNackRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryRespondActivityTaskNackRequest{
+		DomainUUID:  t.GetDomainUUID(),
+		NackRequest: ToRespondActivityTaskNackRequest(t.NackRequest),
+	}
+}
+
+// ToHistoryGetActivityWorkerHistoryRequest converts thrift GetActivityWorkerHistoryRequest type to internal
+func ToHistoryGetActivityWorkerHistoryRequest(t *history.GetActivityWorkerHistoryRequest) *types.HistoryGetActivityWorkerHistoryRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetActivityWorkerHistoryRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToGetActivityWorkerHistoryRequest(t.Request),
+	}
+}
+
+// ToHistoryGetActivityRescheduleReasonsRequest converts thrift GetActivityRescheduleReasonsRequest type to internal
+func ToHistoryGetActivityRescheduleReasonsRequest(t *history.GetActivityRescheduleReasonsRequest) *types.HistoryGetActivityRescheduleReasonsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetActivityRescheduleReasonsRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToGetActivityRescheduleReasonsRequest(t.Request),
+	}
+}
+
+// FromHistoryReplayActivityCompletionsRequest converts internal ReplayActivityCompletionsRequest type to thrift
+func FromHistoryReplayActivityCompletionsRequest(t *types.HistoryReplayActivityCompletionsRequest) *history.ReplayActivityCompletionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.ReplayActivityCompletionsRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromReplayActivityCompletionsRequest(t.Request),
+	}
+}
+
+// ToHistoryReplayActivityCompletionsRequest converts thrift ReplayActivityCompletionsRequest type to internal
+func ToHistoryReplayActivityCompletionsRequest(t *history.ReplayActivityCompletionsRequest) *types.HistoryReplayActivityCompletionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryReplayActivityCompletionsRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToReplayActivityCompletionsRequest(t.Request),
+	}
+}
+
+// ToHistoryGetActivityNextRetryTimeRequest converts thrift GetActivityNextRetryTimeRequest type to internal
+func ToHistoryGetActivityNextRetryTimeRequest(t *history.GetActivityNextRetryTimeRequest) *types.HistoryGetActivityNextRetryTimeRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetActivityNextRetryTimeRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToGetActivityNextRetryTimeRequest(t.Request),
+	}
+}
+
+// ToHistoryGetActivityEffectiveConfigRequest converts thrift GetActivityEffectiveConfigRequest type to internal
+func ToHistoryGetActivityEffectiveConfigRequest(t *history.GetActivityEffectiveConfigRequest) *types.HistoryGetActivityEffectiveConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetActivityEffectiveConfigRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToGetActivityEffectiveConfigRequest(t.Request),
+	}
+}
+
 // ToHistoryDescribeMutableStateRequest converts thrift DescribeMutableStateRequest type to internal
 func ToHistoryDescribeMutableStateRequest(t *history.DescribeMutableStateRequest) *types.DescribeMutableStateRequest {
 	if t == nil {
@@ -136,6 +440,28 @@ func ToHistoryDescribeMutableStateResponse(t *history.DescribeMutableStateRespon
 	}
 }
 
+// FromHistoryBatchDescribeWorkflowExecutionsRequest converts internal BatchDescribeWorkflowExecutionsRequest type to thrift
+func FromHistoryBatchDescribeWorkflowExecutionsRequest(t *types.HistoryBatchDescribeWorkflowExecutionsRequest) *history.BatchDescribeWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.BatchDescribeWorkflowExecutionsRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromBatchDescribeWorkflowExecutionsRequest(t.Request),
+	}
+}
+
+// ToHistoryBatchDescribeWorkflowExecutionsRequest converts thrift BatchDescribeWorkflowExecutionsRequest type to internal
+func ToHistoryBatchDescribeWorkflowExecutionsRequest(t *history.BatchDescribeWorkflowExecutionsRequest) *types.HistoryBatchDescribeWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryBatchDescribeWorkflowExecutionsRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToBatchDescribeWorkflowExecutionsRequest(t.Request),
+	}
+}
+
 // FromHistoryDescribeWorkflowExecutionRequest converts internal DescribeWorkflowExecutionRequest type to thrift
 func FromHistoryDescribeWorkflowExecutionRequest(t *types.HistoryDescribeWorkflowExecutionRequest) *history.DescribeWorkflowExecutionRequest {
 	if t == nil {
@@ -544,6 +870,7 @@ func FromHistoryRecordActivityTaskHeartbeatRequest(t *types.HistoryRecordActivit
 	return &history.RecordActivityTaskHeartbeatRequest{
 		DomainUUID:       &t.DomainUUID,
 		HeartbeatRequest: FromRecordActivityTaskHeartbeatRequest(t.HeartbeatRequest),
+		RenewLeaseOnly:   &t.RenewLeaseOnly,
 	}
 }
 
@@ -555,6 +882,7 @@ func ToHistoryRecordActivityTaskHeartbeatRequest(t *history.RecordActivityTaskHe
 	return &types.HistoryRecordActivityTaskHeartbeatRequest{
 		DomainUUID:       t.GetDomainUUID(),
 		HeartbeatRequest: ToRecordActivityTaskHeartbeatRequest(t.HeartbeatRequest),
+		RenewLeaseOnly:   t.GetRenewLeaseOnly(),
 	}
 }
 
@@ -564,12 +892,14 @@ func FromRecordActivityTaskStartedRequest(t *types.RecordActivityTaskStartedRequ
 		return nil
 	}
 	return &history.RecordActivityTaskStartedRequest{
-		DomainUUID:        &t.DomainUUID,
-		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
-		ScheduleId:        &t.ScheduleID,
-		TaskId:            &t.TaskID,
-		RequestId:         &t.RequestID,
-		PollRequest:       FromPollForActivityTaskRequest(t.PollRequest),
+		DomainUUID:               &t.DomainUUID,
+		WorkflowExecution:        FromWorkflowExecution(t.WorkflowExecution),
+		ScheduleId:               &t.ScheduleID,
+		TaskId:                   &t.TaskID,
+		RequestId:                &t.RequestID,
+		PollRequest:              FromPollForActivityTaskRequest(t.PollRequest),
+		VisibilityTimeoutSeconds: &t.VisibilityTimeoutSeconds,
+		Prefetched:               &t.Prefetched,
 	}
 }
 
@@ -579,12 +909,14 @@ func ToRecordActivityTaskStartedRequest(t *history.RecordActivityTaskStartedRequ
 		return nil
 	}
 	return &types.RecordActivityTaskStartedRequest{
-		DomainUUID:        t.GetDomainUUID(),
-		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
-		ScheduleID:        t.GetScheduleId(),
-		TaskID:            t.GetTaskId(),
-		RequestID:         t.GetRequestId(),
-		PollRequest:       ToPollForActivityTaskRequest(t.PollRequest),
+		DomainUUID:               t.GetDomainUUID(),
+		WorkflowExecution:        ToWorkflowExecution(t.WorkflowExecution),
+		ScheduleID:               t.GetScheduleId(),
+		TaskID:                   t.GetTaskId(),
+		RequestID:                t.GetRequestId(),
+		PollRequest:              ToPollForActivityTaskRequest(t.PollRequest),
+		VisibilityTimeoutSeconds: t.GetVisibilityTimeoutSeconds(),
+		Prefetched:               t.GetPrefetched(),
 	}
 }
 
@@ -1068,6 +1400,28 @@ func ToShardOwnershipLostError(t *history.ShardOwnershipLostError) *types.ShardO
 	}
 }
 
+// FromHistorySignalActivityRequest converts internal SignalActivityRequest type to thrift
+func FromHistorySignalActivityRequest(t *types.HistorySignalActivityRequest) *history.SignalActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.SignalActivityRequest{
+		DomainUUID:    &t.DomainUUID,
+		SignalRequest: FromSignalActivityRequest(t.SignalRequest),
+	}
+}
+
+// ToHistorySignalActivityRequest converts thrift SignalActivityRequest type to internal
+func ToHistorySignalActivityRequest(t *history.SignalActivityRequest) *types.HistorySignalActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistorySignalActivityRequest{
+		DomainUUID:    t.GetDomainUUID(),
+		SignalRequest: ToSignalActivityRequest(t.SignalRequest),
+	}
+}
+
 // FromHistorySignalWithStartWorkflowExecutionRequest converts internal SignalWithStartWorkflowExecutionRequest type to thrift
 func FromHistorySignalWithStartWorkflowExecutionRequest(t *types.HistorySignalWithStartWorkflowExecutionRequest) *history.SignalWithStartWorkflowExecutionRequest {
 	if t == nil {
@@ -34,6 +34,188 @@ import (
 	"github.com/uber/cadence/common/types/testdata"
 )
 
+func TestHistoryCancelPendingActivitiesRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryCancelPendingActivitiesRequest{
+		nil,
+		{},
+		&testdata.HistoryCancelPendingActivitiesRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryCancelPendingActivitiesRequest(original)
+		roundTripObj := ToHistoryCancelPendingActivitiesRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryExpireActivityHeartbeatRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryExpireActivityHeartbeatRequest{
+		nil,
+		{},
+		&testdata.HistoryExpireActivityHeartbeatRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryExpireActivityHeartbeatRequest(original)
+		roundTripObj := ToHistoryExpireActivityHeartbeatRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryGetActivityAttemptTimingsRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryGetActivityAttemptTimingsRequest{
+		nil,
+		{},
+		&testdata.HistoryGetActivityAttemptTimingsRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryGetActivityAttemptTimingsRequest(original)
+		roundTripObj := ToHistoryGetActivityAttemptTimingsRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryGetActivityEffectiveConfigRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryGetActivityEffectiveConfigRequest{
+		nil,
+		{},
+		&testdata.HistoryGetActivityEffectiveConfigRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryGetActivityEffectiveConfigRequest(original)
+		roundTripObj := ToHistoryGetActivityEffectiveConfigRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryGetActivityNextRetryTimeRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryGetActivityNextRetryTimeRequest{
+		nil,
+		{},
+		&testdata.HistoryGetActivityNextRetryTimeRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryGetActivityNextRetryTimeRequest(original)
+		roundTripObj := ToHistoryGetActivityNextRetryTimeRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryGetActivityRescheduleReasonsRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryGetActivityRescheduleReasonsRequest{
+		nil,
+		{},
+		&testdata.HistoryGetActivityRescheduleReasonsRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryGetActivityRescheduleReasonsRequest(original)
+		roundTripObj := ToHistoryGetActivityRescheduleReasonsRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryGetActivityRetryStatsRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryGetActivityRetryStatsRequest{
+		nil,
+		{},
+		&testdata.HistoryGetActivityRetryStatsRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryGetActivityRetryStatsRequest(original)
+		roundTripObj := ToHistoryGetActivityRetryStatsRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryGetActivityTokenValidationFailuresRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryGetActivityTokenValidationFailuresRequest{
+		nil,
+		{},
+		&testdata.HistoryGetActivityTokenValidationFailuresRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryGetActivityTokenValidationFailuresRequest(original)
+		roundTripObj := ToHistoryGetActivityTokenValidationFailuresRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryGetActivityWorkerHistoryRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryGetActivityWorkerHistoryRequest{
+		nil,
+		{},
+		&testdata.HistoryGetActivityWorkerHistoryRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryGetActivityWorkerHistoryRequest(original)
+		roundTripObj := ToHistoryGetActivityWorkerHistoryRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryRedriveTimedOutActivitiesRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryRedriveTimedOutActivitiesRequest{
+		nil,
+		{},
+		&testdata.HistoryRedriveTimedOutActivitiesRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryRedriveTimedOutActivitiesRequest(original)
+		roundTripObj := ToHistoryRedriveTimedOutActivitiesRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryReplayActivityCompletionsRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryReplayActivityCompletionsRequest{
+		nil,
+		{},
+		&testdata.HistoryReplayActivityCompletionsRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryReplayActivityCompletionsRequest(original)
+		roundTripObj := ToHistoryReplayActivityCompletionsRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryResetActivityAttemptsRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryResetActivityAttemptsRequest{
+		nil,
+		{},
+		&testdata.HistoryResetActivityAttemptsRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryResetActivityAttemptsRequest(original)
+		roundTripObj := ToHistoryResetActivityAttemptsRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryRespondActivityTaskNackRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryRespondActivityTaskNackRequest{
+		nil,
+		{},
+		&testdata.HistoryRespondActivityTaskNackRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryRespondActivityTaskNackRequest(original)
+		roundTripObj := ToHistoryRespondActivityTaskNackRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestHistoryTerminateWorkflowExecutionRequestConversion(t *testing.T) {
 	for _, item := range []*types.HistoryTerminateWorkflowExecutionRequest{nil, {}, &testdata.HistoryTerminateWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToHistoryTerminateWorkflowExecutionRequest(FromHistoryTerminateWorkflowExecutionRequest(item)))
@@ -68,6 +250,20 @@ func TestDescribeMutableStateResponseConversion(t *testing.T) {
 	}
 }
 
+func TestHistoryBatchDescribeWorkflowExecutionsRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryBatchDescribeWorkflowExecutionsRequest{
+		nil,
+		{},
+		&testdata.HistoryBatchDescribeWorkflowExecutionsRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryBatchDescribeWorkflowExecutionsRequest(original)
+		roundTripObj := ToHistoryBatchDescribeWorkflowExecutionsRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestHistoryDescribeWorkflowExecutionRequestConversion(t *testing.T) {
 	testCases := []*types.HistoryDescribeWorkflowExecutionRequest{
 		nil,
@@ -602,6 +798,20 @@ func TestHistorySignalWithStartWorkflowExecutionRequestConversion(t *testing.T)
 	}
 }
 
+func TestHistorySignalActivityRequestConversion(t *testing.T) {
+	testCases := []*types.HistorySignalActivityRequest{
+		nil,
+		{},
+		&testdata.HistorySignalActivityRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistorySignalActivityRequest(original)
+		roundTripObj := ToHistorySignalActivityRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestHistorySignalWorkflowExecutionRequestConversion(t *testing.T) {
 	testCases := []*types.HistorySignalWorkflowExecutionRequest{
 		nil,
@@ -56,6 +56,7 @@ func FromMatchingAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matchi
 		ForwardedFrom:                 &t.ForwardedFrom,
 		ActivityTaskDispatchInfo:      FromActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.Priority,
 	}
 }
 
@@ -75,6 +76,7 @@ func ToMatchingAddActivityTaskRequest(t *matching.AddActivityTaskRequest) *types
 		ForwardedFrom:                 t.GetForwardedFrom(),
 		ActivityTaskDispatchInfo:      ToActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.Priority,
 	}
 }
 
@@ -169,6 +171,30 @@ func ToMatchingCancelOutstandingPollRequest(t *matching.CancelOutstandingPollReq
 	}
 }
 
+// FromMatchingRecordBackpressureHintRequest converts internal RecordBackpressureHintRequest type to thrift
+func FromMatchingRecordBackpressureHintRequest(t *types.MatchingRecordBackpressureHintRequest) *matching.RecordBackpressureHintRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.RecordBackpressureHintRequest{
+		DomainUUID: &t.DomainUUID,
+		TaskList:   FromTaskList(t.TaskList),
+		Hint:       &t.Hint,
+	}
+}
+
+// ToMatchingRecordBackpressureHintRequest converts thrift RecordBackpressureHintRequest type to internal
+func ToMatchingRecordBackpressureHintRequest(t *matching.RecordBackpressureHintRequest) *types.MatchingRecordBackpressureHintRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingRecordBackpressureHintRequest{
+		DomainUUID: t.GetDomainUUID(),
+		TaskList:   ToTaskList(t.TaskList),
+		Hint:       t.GetHint(),
+	}
+}
+
 // FromMatchingDescribeTaskListRequest converts internal DescribeTaskListRequest type to thrift
 func FromMatchingDescribeTaskListRequest(t *types.MatchingDescribeTaskListRequest) *matching.DescribeTaskListRequest {
 	if t == nil {
@@ -347,6 +373,7 @@ func FromMatchingPollForActivityTaskResponse(t *types.MatchingPollForActivityTas
 		WorkflowDomain:                  &t.WorkflowDomain,
 		Header:                          FromHeader(t.Header),
 		AutoConfigHint:                  FromAutoConfigHint(t.AutoConfigHint),
+		PrefetchedTasks:                 FromMatchingPollForActivityTaskResponseArray(t.PrefetchedTasks),
 	}
 }
 
@@ -372,7 +399,32 @@ func ToMatchingPollForActivityTaskResponse(t *shared.PollForActivityTaskResponse
 		WorkflowDomain:                  t.GetWorkflowDomain(),
 		Header:                          ToHeader(t.Header),
 		AutoConfigHint:                  ToAutoConfigHint(t.AutoConfigHint),
+		PrefetchedTasks:                 ToMatchingPollForActivityTaskResponseArray(t.PrefetchedTasks),
+	}
+}
+
+// FromMatchingPollForActivityTaskResponseArray converts internal PollForActivityTaskResponse type array to thrift
+func FromMatchingPollForActivityTaskResponseArray(t []*types.MatchingPollForActivityTaskResponse) []*shared.PollForActivityTaskResponse {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.PollForActivityTaskResponse, len(t))
+	for i := range t {
+		v[i] = FromMatchingPollForActivityTaskResponse(t[i])
+	}
+	return v
+}
+
+// ToMatchingPollForActivityTaskResponseArray converts thrift PollForActivityTaskResponse type array to internal
+func ToMatchingPollForActivityTaskResponseArray(t []*shared.PollForActivityTaskResponse) []*types.MatchingPollForActivityTaskResponse {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.MatchingPollForActivityTaskResponse, len(t))
+	for i := range t {
+		v[i] = ToMatchingPollForActivityTaskResponse(t[i])
 	}
+	return v
 }
 
 // FromMatchingQueryWorkflowRequest converts internal QueryWorkflowRequest type to thrift
@@ -134,6 +134,31 @@ func TestMatchingCancelOutStandingPollRequest(t *testing.T) {
 	}
 }
 
+func TestMatchingRecordBackpressureHintRequest(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		input *types.MatchingRecordBackpressureHintRequest
+	}{
+		{
+			desc:  "non-nil input test",
+			input: &testdata.MatchingRecordBackpressureHintRequest,
+		},
+		{
+			desc:  "empty input test",
+			input: &types.MatchingRecordBackpressureHintRequest{},
+		},
+		{
+			desc:  "nil input test",
+			input: nil,
+		},
+	}
+	for _, tc := range testCases {
+		thriftObj := FromMatchingRecordBackpressureHintRequest(tc.input)
+		roundTripObj := ToMatchingRecordBackpressureHintRequest(thriftObj)
+		assert.Equal(t, tc.input, roundTripObj)
+	}
+}
+
 func TestMatchingDescribeTaskListRequest(t *testing.T) {
 	testCases := []struct {
 		desc  string
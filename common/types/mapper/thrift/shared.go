@@ -47,6 +47,552 @@ func FromAccessDeniedError(t *types.AccessDeniedError) *shared.AccessDeniedError
 	}
 }
 
+// FromActivityAttemptTiming converts internal ActivityAttemptTiming type to thrift
+func FromActivityAttemptTiming(t *types.ActivityAttemptTiming) *shared.ActivityAttemptTiming {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityAttemptTiming{
+		ScheduledEventId: &t.ScheduledEventID,
+		Attempt:          &t.Attempt,
+		StartedTimestamp: t.StartedTimestamp,
+		EndTimestamp:     t.EndTimestamp,
+		Outcome:          FromEventType(t.Outcome),
+	}
+}
+
+// FromActivityTypeCount converts internal ActivityTypeCount type to thrift
+func FromActivityTypeCount(t *types.ActivityTypeCount) *shared.ActivityTypeCount {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityTypeCount{
+		ActivityType: FromActivityType(t.ActivityType),
+		Count:        &t.Count,
+	}
+}
+
+// FromCancelPendingActivitiesRequest converts internal CancelPendingActivitiesRequest type to thrift
+func FromCancelPendingActivitiesRequest(t *types.CancelPendingActivitiesRequest) *shared.CancelPendingActivitiesRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.CancelPendingActivitiesRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Details:           t.Details,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToCancelPendingActivitiesRequest converts thrift CancelPendingActivitiesRequest type to internal
+func ToCancelPendingActivitiesRequest(t *shared.CancelPendingActivitiesRequest) *types.CancelPendingActivitiesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CancelPendingActivitiesRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Details:           t.Details,
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// FromExpireActivityHeartbeatRequest converts internal ExpireActivityHeartbeatRequest type to thrift
+func FromExpireActivityHeartbeatRequest(t *types.ExpireActivityHeartbeatRequest) *shared.ExpireActivityHeartbeatRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ExpireActivityHeartbeatRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        &t.ActivityID,
+	}
+}
+
+// FromRedriveTimedOutActivitiesRequest converts internal RedriveTimedOutActivitiesRequest type to thrift
+func FromRedriveTimedOutActivitiesRequest(t *types.RedriveTimedOutActivitiesRequest) *shared.RedriveTimedOutActivitiesRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RedriveTimedOutActivitiesRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Identity:          &t.Identity,
+	}
+}
+
+// ToRedriveTimedOutActivitiesRequest converts thrift RedriveTimedOutActivitiesRequest type to internal
+func ToRedriveTimedOutActivitiesRequest(t *shared.RedriveTimedOutActivitiesRequest) *types.RedriveTimedOutActivitiesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RedriveTimedOutActivitiesRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// FromResetActivityAttemptsRequest converts internal ResetActivityAttemptsRequest type to thrift
+func FromResetActivityAttemptsRequest(t *types.ResetActivityAttemptsRequest) *shared.ResetActivityAttemptsRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ResetActivityAttemptsRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        &t.ActivityID,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToResetActivityAttemptsRequest converts thrift ResetActivityAttemptsRequest type to internal
+func ToResetActivityAttemptsRequest(t *shared.ResetActivityAttemptsRequest) *types.ResetActivityAttemptsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetActivityAttemptsRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityID(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// ToExpireActivityHeartbeatRequest converts thrift ExpireActivityHeartbeatRequest type to internal
+func ToExpireActivityHeartbeatRequest(t *shared.ExpireActivityHeartbeatRequest) *types.ExpireActivityHeartbeatRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ExpireActivityHeartbeatRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityID(),
+	}
+}
+
+// FromListActivityTypesRequest converts internal ListActivityTypesRequest type to thrift
+func FromListActivityTypesRequest(t *types.ListActivityTypesRequest) *shared.ListActivityTypesRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListActivityTypesRequest{
+		Domain:       &t.Domain,
+		EarliestTime: t.EarliestTime,
+		LatestTime:   t.LatestTime,
+	}
+}
+
+// FromListActivityTypesResponse converts internal ListActivityTypesResponse type to thrift
+func FromListActivityTypesResponse(t *types.ListActivityTypesResponse) *shared.ListActivityTypesResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListActivityTypesResponse{
+		ActivityTypes: FromActivityTypeCountArray(t.ActivityTypes),
+		Truncated:     &t.Truncated,
+	}
+}
+
+// ToListActivityTypesResponse converts thrift ListActivityTypesResponse type to internal
+func ToListActivityTypesResponse(t *shared.ListActivityTypesResponse) *types.ListActivityTypesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListActivityTypesResponse{
+		ActivityTypes: ToActivityTypeCountArray(t.ActivityTypes),
+		Truncated:     t.GetTruncated(),
+	}
+}
+
+// ToListActivityTypesRequest converts thrift ListActivityTypesRequest type to internal
+func ToListActivityTypesRequest(t *shared.ListActivityTypesRequest) *types.ListActivityTypesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListActivityTypesRequest{
+		Domain:       t.GetDomain(),
+		EarliestTime: t.EarliestTime,
+		LatestTime:   t.LatestTime,
+	}
+}
+
+// ToActivityTypeCount converts thrift ActivityTypeCount type to internal
+func ToActivityTypeCount(t *shared.ActivityTypeCount) *types.ActivityTypeCount {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityTypeCount{
+		ActivityType: ToActivityType(t.ActivityType),
+		Count:        t.GetCount(),
+	}
+}
+
+// FromGetActivityAttemptTimingsRequest converts internal GetActivityAttemptTimingsRequest type to thrift
+func FromGetActivityAttemptTimingsRequest(t *types.GetActivityAttemptTimingsRequest) *shared.GetActivityAttemptTimingsRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetActivityAttemptTimingsRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        &t.ActivityID,
+	}
+}
+
+// FromGetActivityAttemptTimingsResponse converts internal GetActivityAttemptTimingsResponse type to thrift
+func FromGetActivityAttemptTimingsResponse(t *types.GetActivityAttemptTimingsResponse) *shared.GetActivityAttemptTimingsResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetActivityAttemptTimingsResponse{
+		ActivityID: &t.ActivityID,
+		Attempts:   FromActivityAttemptTimingArray(t.Attempts),
+	}
+}
+
+// ToGetActivityAttemptTimingsResponse converts thrift GetActivityAttemptTimingsResponse type to internal
+func ToGetActivityAttemptTimingsResponse(t *shared.GetActivityAttemptTimingsResponse) *types.GetActivityAttemptTimingsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityAttemptTimingsResponse{
+		ActivityID: t.GetActivityID(),
+		Attempts:   ToActivityAttemptTimingArray(t.Attempts),
+	}
+}
+
+// ToGetActivityAttemptTimingsRequest converts thrift GetActivityAttemptTimingsRequest type to internal
+func ToGetActivityAttemptTimingsRequest(t *shared.GetActivityAttemptTimingsRequest) *types.GetActivityAttemptTimingsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityAttemptTimingsRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityID(),
+	}
+}
+
+// ToActivityAttemptTiming converts thrift ActivityAttemptTiming type to internal
+func ToActivityAttemptTiming(t *shared.ActivityAttemptTiming) *types.ActivityAttemptTiming {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityAttemptTiming{
+		ScheduledEventID: t.GetScheduledEventId(),
+		Attempt:          t.GetAttempt(),
+		StartedTimestamp: t.StartedTimestamp,
+		EndTimestamp:     t.EndTimestamp,
+		Outcome:          ToEventType(t.Outcome),
+	}
+}
+
+// FromActivityResultSearchAttribute converts internal ActivityResultSearchAttribute type to thrift
+func FromActivityResultSearchAttribute(t *types.ActivityResultSearchAttribute) *shared.ActivityResultSearchAttribute {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityResultSearchAttribute{
+		JsonPath:           &t.JSONPath,
+		SearchAttributeKey: &t.SearchAttributeKey,
+	}
+}
+
+// ToActivityResultSearchAttribute converts thrift ActivityResultSearchAttribute type to internal
+func ToActivityResultSearchAttribute(t *shared.ActivityResultSearchAttribute) *types.ActivityResultSearchAttribute {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityResultSearchAttribute{
+		JSONPath:           t.GetJsonPath(),
+		SearchAttributeKey: t.GetSearchAttributeKey(),
+	}
+}
+
+// FromActivityRescheduleReason converts internal ActivityRescheduleReason type to thrift
+func FromActivityRescheduleReason(t *types.ActivityRescheduleReason) *shared.ActivityRescheduleReason {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityRescheduleReason{
+		Attempt:          &t.Attempt,
+		Cause:            FromActivityRescheduleCause(t.Cause),
+		Timestamp:        t.Timestamp,
+		Identity:         &t.Identity,
+		StartedTimestamp: t.StartedTimestamp,
+		TaskList:         &t.TaskList,
+	}
+}
+
+// FromGetActivityRescheduleReasonsRequest converts internal GetActivityRescheduleReasonsRequest type to thrift
+func FromGetActivityRescheduleReasonsRequest(t *types.GetActivityRescheduleReasonsRequest) *shared.GetActivityRescheduleReasonsRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetActivityRescheduleReasonsRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        &t.ActivityID,
+	}
+}
+
+// FromGetActivityRescheduleReasonsResponse converts internal GetActivityRescheduleReasonsResponse type to thrift
+func FromGetActivityRescheduleReasonsResponse(t *types.GetActivityRescheduleReasonsResponse) *shared.GetActivityRescheduleReasonsResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetActivityRescheduleReasonsResponse{
+		ActivityID: &t.ActivityID,
+		Reasons:    FromActivityRescheduleReasonArray(t.Reasons),
+	}
+}
+
+// ToGetActivityRescheduleReasonsResponse converts thrift GetActivityRescheduleReasonsResponse type to internal
+func ToGetActivityRescheduleReasonsResponse(t *shared.GetActivityRescheduleReasonsResponse) *types.GetActivityRescheduleReasonsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityRescheduleReasonsResponse{
+		ActivityID: t.GetActivityID(),
+		Reasons:    ToActivityRescheduleReasonArray(t.Reasons),
+	}
+}
+
+// ToGetActivityRescheduleReasonsRequest converts thrift GetActivityRescheduleReasonsRequest type to internal
+func ToGetActivityRescheduleReasonsRequest(t *shared.GetActivityRescheduleReasonsRequest) *types.GetActivityRescheduleReasonsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityRescheduleReasonsRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityID(),
+	}
+}
+
+// ToActivityRescheduleReason converts thrift ActivityRescheduleReason type to internal
+func ToActivityRescheduleReason(t *shared.ActivityRescheduleReason) *types.ActivityRescheduleReason {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityRescheduleReason{
+		Attempt:          t.GetAttempt(),
+		Cause:            ToActivityRescheduleCause(t.Cause),
+		Timestamp:        t.Timestamp,
+		Identity:         t.GetIdentity(),
+		StartedTimestamp: t.StartedTimestamp,
+		TaskList:         t.GetTaskList(),
+	}
+}
+
+// FromGetActivityEffectiveConfigRequest converts internal GetActivityEffectiveConfigRequest type to thrift
+func FromGetActivityEffectiveConfigRequest(t *types.GetActivityEffectiveConfigRequest) *shared.GetActivityEffectiveConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetActivityEffectiveConfigRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        &t.ActivityID,
+	}
+}
+
+// FromGetActivityEffectiveConfigResponse converts internal GetActivityEffectiveConfigResponse type to thrift
+func FromGetActivityEffectiveConfigResponse(t *types.GetActivityEffectiveConfigResponse) *shared.GetActivityEffectiveConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetActivityEffectiveConfigResponse{
+		ActivityID:                               &t.ActivityID,
+		TaskList:                                 FromTaskList(t.TaskList),
+		ScheduleToStartTimeoutSeconds:            &t.ScheduleToStartTimeoutSeconds,
+		StartToCloseTimeoutSeconds:               &t.StartToCloseTimeoutSeconds,
+		FirstAttemptStartToCloseSeconds:          &t.FirstAttemptStartToCloseSeconds,
+		ScheduleToCloseTimeoutSeconds:            &t.ScheduleToCloseTimeoutSeconds,
+		HeartbeatTimeoutSeconds:                  &t.HeartbeatTimeoutSeconds,
+		RetryPolicy:                              FromRetryPolicy(t.RetryPolicy),
+		Attempt:                                  &t.Attempt,
+		CurrentAttemptStartToCloseTimeoutSeconds: &t.CurrentAttemptStartToCloseTimeoutSeconds,
+	}
+}
+
+// FromGetActivityNextRetryTimeRequest converts internal GetActivityNextRetryTimeRequest type to thrift
+func FromGetActivityNextRetryTimeRequest(t *types.GetActivityNextRetryTimeRequest) *shared.GetActivityNextRetryTimeRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetActivityNextRetryTimeRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        &t.ActivityID,
+	}
+}
+
+// FromGetActivityNextRetryTimeResponse converts internal GetActivityNextRetryTimeResponse type to thrift
+func FromGetActivityNextRetryTimeResponse(t *types.GetActivityNextRetryTimeResponse) *shared.GetActivityNextRetryTimeResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetActivityNextRetryTimeResponse{
+		ActivityID:         &t.ActivityID,
+		Attempt:            &t.Attempt,
+		NextRetryTimestamp: t.NextRetryTimestamp,
+		LastFailureReason:  t.LastFailureReason,
+	}
+}
+
+// FromReplayActivityCompletionsRequest converts internal ReplayActivityCompletionsRequest type to thrift
+func FromReplayActivityCompletionsRequest(t *types.ReplayActivityCompletionsRequest) *shared.ReplayActivityCompletionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ReplayActivityCompletionsRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		BaseRunID:         &t.BaseRunID,
+		ScheduledEventIDs: t.ScheduledEventIDs,
+		Identity:          &t.Identity,
+	}
+}
+
+// FromReplayActivityCompletionsResponse converts internal ReplayActivityCompletionsResponse type to thrift
+func FromReplayActivityCompletionsResponse(t *types.ReplayActivityCompletionsResponse) *shared.ReplayActivityCompletionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ReplayActivityCompletionsResponse{
+		ScheduledEventIDs: t.ScheduledEventIDs,
+	}
+}
+
+// FromWaitForActivityCompletionRequest converts internal WaitForActivityCompletionRequest type to thrift
+func FromWaitForActivityCompletionRequest(t *types.WaitForActivityCompletionRequest) *shared.WaitForActivityCompletionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.WaitForActivityCompletionRequest{
+		Domain:         &t.Domain,
+		Execution:      FromWorkflowExecution(t.Execution),
+		ActivityID:     &t.ActivityID,
+		TimeoutSeconds: &t.TimeoutSeconds,
+	}
+}
+
+// FromWaitForActivityCompletionResponse converts internal WaitForActivityCompletionResponse type to thrift
+func FromWaitForActivityCompletionResponse(t *types.WaitForActivityCompletionResponse) *shared.WaitForActivityCompletionResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.WaitForActivityCompletionResponse{
+		CloseEvent: FromHistoryEvent(t.CloseEvent),
+	}
+}
+
+// ToWaitForActivityCompletionResponse converts thrift WaitForActivityCompletionResponse type to internal
+func ToWaitForActivityCompletionResponse(t *shared.WaitForActivityCompletionResponse) *types.WaitForActivityCompletionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.WaitForActivityCompletionResponse{
+		CloseEvent: ToHistoryEvent(t.CloseEvent),
+	}
+}
+
+// ToWaitForActivityCompletionRequest converts thrift WaitForActivityCompletionRequest type to internal
+func ToWaitForActivityCompletionRequest(t *shared.WaitForActivityCompletionRequest) *types.WaitForActivityCompletionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.WaitForActivityCompletionRequest{
+		Domain:         t.GetDomain(),
+		Execution:      ToWorkflowExecution(t.Execution),
+		ActivityID:     t.GetActivityID(),
+		TimeoutSeconds: t.GetTimeoutSeconds(),
+	}
+}
+
+// ToReplayActivityCompletionsResponse converts thrift ReplayActivityCompletionsResponse type to internal
+func ToReplayActivityCompletionsResponse(t *shared.ReplayActivityCompletionsResponse) *types.ReplayActivityCompletionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplayActivityCompletionsResponse{
+		ScheduledEventIDs: t.ScheduledEventIDs,
+	}
+}
+
+// ToReplayActivityCompletionsRequest converts thrift ReplayActivityCompletionsRequest type to internal
+func ToReplayActivityCompletionsRequest(t *shared.ReplayActivityCompletionsRequest) *types.ReplayActivityCompletionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplayActivityCompletionsRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		BaseRunID:         t.GetBaseRunID(),
+		ScheduledEventIDs: t.ScheduledEventIDs,
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// ToGetActivityNextRetryTimeResponse converts thrift GetActivityNextRetryTimeResponse type to internal
+func ToGetActivityNextRetryTimeResponse(t *shared.GetActivityNextRetryTimeResponse) *types.GetActivityNextRetryTimeResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityNextRetryTimeResponse{
+		ActivityID:         t.GetActivityID(),
+		Attempt:            t.GetAttempt(),
+		NextRetryTimestamp: t.NextRetryTimestamp,
+		LastFailureReason:  t.LastFailureReason,
+	}
+}
+
+// ToGetActivityNextRetryTimeRequest converts thrift GetActivityNextRetryTimeRequest type to internal
+func ToGetActivityNextRetryTimeRequest(t *shared.GetActivityNextRetryTimeRequest) *types.GetActivityNextRetryTimeRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityNextRetryTimeRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityID(),
+	}
+}
+
+// ToGetActivityEffectiveConfigResponse converts thrift GetActivityEffectiveConfigResponse type to internal
+func ToGetActivityEffectiveConfigResponse(t *shared.GetActivityEffectiveConfigResponse) *types.GetActivityEffectiveConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityEffectiveConfigResponse{
+		ActivityID:                               t.GetActivityID(),
+		TaskList:                                 ToTaskList(t.TaskList),
+		ScheduleToStartTimeoutSeconds:            t.GetScheduleToStartTimeoutSeconds(),
+		StartToCloseTimeoutSeconds:               t.GetStartToCloseTimeoutSeconds(),
+		FirstAttemptStartToCloseSeconds:          t.GetFirstAttemptStartToCloseSeconds(),
+		ScheduleToCloseTimeoutSeconds:            t.GetScheduleToCloseTimeoutSeconds(),
+		HeartbeatTimeoutSeconds:                  t.GetHeartbeatTimeoutSeconds(),
+		RetryPolicy:                              ToRetryPolicy(t.RetryPolicy),
+		Attempt:                                  t.GetAttempt(),
+		CurrentAttemptStartToCloseTimeoutSeconds: t.GetCurrentAttemptStartToCloseTimeoutSeconds(),
+	}
+}
+
+// ToGetActivityEffectiveConfigRequest converts thrift GetActivityEffectiveConfigRequest type to internal
+func ToGetActivityEffectiveConfigRequest(t *shared.GetActivityEffectiveConfigRequest) *types.GetActivityEffectiveConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityEffectiveConfigRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityID(),
+	}
+}
+
 // ToAccessDeniedError converts thrift AccessDeniedError type to internal
 func ToAccessDeniedError(t *shared.AccessDeniedError) *types.AccessDeniedError {
 	if t == nil {
@@ -85,6 +631,32 @@ func ToActivityLocalDispatchInfo(t *shared.ActivityLocalDispatchInfo) *types.Act
 	}
 }
 
+// FromActivitySignal converts internal ActivitySignal type to thrift
+func FromActivitySignal(t *types.ActivitySignal) *shared.ActivitySignal {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivitySignal{
+		SignalName: &t.SignalName,
+		Input:      t.Input,
+		Identity:   &t.Identity,
+		Timestamp:  t.Timestamp,
+	}
+}
+
+// ToActivitySignal converts thrift ActivitySignal type to internal
+func ToActivitySignal(t *shared.ActivitySignal) *types.ActivitySignal {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivitySignal{
+		SignalName: t.GetSignalName(),
+		Input:      t.Input,
+		Identity:   t.GetIdentity(),
+		Timestamp:  t.Timestamp,
+	}
+}
+
 // FromActivityTaskCancelRequestedEventAttributes converts internal ActivityTaskCancelRequestedEventAttributes type to thrift
 func FromActivityTaskCancelRequestedEventAttributes(t *types.ActivityTaskCancelRequestedEventAttributes) *shared.ActivityTaskCancelRequestedEventAttributes {
 	if t == nil {
@@ -141,23 +713,29 @@ func FromActivityTaskCompletedEventAttributes(t *types.ActivityTaskCompletedEven
 		return nil
 	}
 	return &shared.ActivityTaskCompletedEventAttributes{
-		Result:           t.Result,
-		ScheduledEventId: &t.ScheduledEventID,
-		StartedEventId:   &t.StartedEventID,
-		Identity:         &t.Identity,
+		Result:                          t.Result,
+		ScheduledEventId:                &t.ScheduledEventID,
+		StartedEventId:                  &t.StartedEventID,
+		Identity:                        &t.Identity,
+		EncryptionKeyId:                 &t.EncryptionKeyID,
+		ResultReferenceScheduledEventId: &t.ResultReferenceScheduledEventID,
+		ResultBlobKey:                   &t.ResultBlobKey,
 	}
 }
 
 // ToActivityTaskCompletedEventAttributes converts thrift ActivityTaskCompletedEventAttributes type to internal
 func ToActivityTaskCompletedEventAttributes(t *shared.ActivityTaskCompletedEventAttributes) *types.ActivityTaskCompletedEventAttributes {
 	if t == nil {
-		return nil
-	}
-	return &types.ActivityTaskCompletedEventAttributes{
-		Result:           t.Result,
-		ScheduledEventID: t.GetScheduledEventId(),
-		StartedEventID:   t.GetStartedEventId(),
-		Identity:         t.GetIdentity(),
+		return nil
+	}
+	return &types.ActivityTaskCompletedEventAttributes{
+		Result:                          t.Result,
+		ScheduledEventID:                t.GetScheduledEventId(),
+		StartedEventID:                  t.GetStartedEventId(),
+		Identity:                        t.GetIdentity(),
+		EncryptionKeyID:                 t.GetEncryptionKeyId(),
+		ResultReferenceScheduledEventID: t.GetResultReferenceScheduledEventId(),
+		ResultBlobKey:                   t.GetResultBlobKey(),
 	}
 }
 
@@ -207,6 +785,11 @@ func FromActivityTaskScheduledEventAttributes(t *types.ActivityTaskScheduledEven
 		DecisionTaskCompletedEventId:  &t.DecisionTaskCompletedEventID,
 		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
 		Header:                        FromHeader(t.Header),
+		RoutingKey:                    &t.RoutingKey,
+		FallbackTaskList:              FromTaskList(t.FallbackTaskList),
+		NextActivity:                  FromScheduleActivityTaskDecisionAttributes(t.NextActivity),
+		EncryptionKeyId:               &t.EncryptionKeyID,
+		Priority:                      t.Priority,
 	}
 }
 
@@ -228,6 +811,11 @@ func ToActivityTaskScheduledEventAttributes(t *shared.ActivityTaskScheduledEvent
 		DecisionTaskCompletedEventID:  t.GetDecisionTaskCompletedEventId(),
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
+		RoutingKey:                    t.GetRoutingKey(),
+		FallbackTaskList:              ToTaskList(t.FallbackTaskList),
+		NextActivity:                  ToScheduleActivityTaskDecisionAttributes(t.NextActivity),
+		EncryptionKeyID:               t.GetEncryptionKeyId(),
+		Priority:                      t.Priority,
 	}
 }
 
@@ -243,6 +831,7 @@ func FromActivityTaskStartedEventAttributes(t *types.ActivityTaskStartedEventAtt
 		Attempt:            &t.Attempt,
 		LastFailureReason:  t.LastFailureReason,
 		LastFailureDetails: t.LastFailureDetails,
+		TaskList:           FromTaskList(t.TaskList),
 	}
 }
 
@@ -258,6 +847,7 @@ func ToActivityTaskStartedEventAttributes(t *shared.ActivityTaskStartedEventAttr
 		Attempt:            t.GetAttempt(),
 		LastFailureReason:  t.LastFailureReason,
 		LastFailureDetails: t.LastFailureDetails,
+		TaskList:           ToTaskList(t.TaskList),
 	}
 }
 
@@ -291,6 +881,42 @@ func ToActivityTaskTimedOutEventAttributes(t *shared.ActivityTaskTimedOutEventAt
 	}
 }
 
+// FromActivityTokenValidationFailure converts internal ActivityTokenValidationFailure type to thrift
+func FromActivityTokenValidationFailure(t *types.ActivityTokenValidationFailure) *shared.ActivityTokenValidationFailure {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityTokenValidationFailure{
+		Operation:       &t.Operation,
+		Cause:           FromActivityTokenValidationFailureCause(t.Cause),
+		WorkflowID:      &t.WorkflowID,
+		RunID:           &t.RunID,
+		ActivityID:      &t.ActivityID,
+		ActivityType:    &t.ActivityType,
+		ScheduleID:      &t.ScheduleID,
+		ScheduleAttempt: &t.ScheduleAttempt,
+		Timestamp:       t.Timestamp,
+	}
+}
+
+// ToActivityTokenValidationFailure converts thrift ActivityTokenValidationFailure type to internal
+func ToActivityTokenValidationFailure(t *shared.ActivityTokenValidationFailure) *types.ActivityTokenValidationFailure {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityTokenValidationFailure{
+		Operation:       t.GetOperation(),
+		Cause:           ToActivityTokenValidationFailureCause(t.Cause),
+		WorkflowID:      t.GetWorkflowID(),
+		RunID:           t.GetRunID(),
+		ActivityID:      t.GetActivityID(),
+		ActivityType:    t.GetActivityType(),
+		ScheduleID:      t.GetScheduleID(),
+		ScheduleAttempt: t.GetScheduleAttempt(),
+		Timestamp:       t.Timestamp,
+	}
+}
+
 // FromActivityType converts internal ActivityType type to thrift
 func FromActivityType(t *types.ActivityType) *shared.ActivityType {
 	if t == nil {
@@ -311,6 +937,38 @@ func ToActivityType(t *shared.ActivityType) *types.ActivityType {
 	}
 }
 
+// FromActivityWorkerAttempt converts internal ActivityWorkerAttempt type to thrift
+func FromActivityWorkerAttempt(t *types.ActivityWorkerAttempt) *shared.ActivityWorkerAttempt {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityWorkerAttempt{
+		ScheduledEventId: &t.ScheduledEventID,
+		Attempt:          &t.Attempt,
+		Identity:         &t.Identity,
+		Outcome:          FromEventType(t.Outcome),
+		StartedTimestamp: t.StartedTimestamp,
+		EndTimestamp:     t.EndTimestamp,
+		TaskList:         &t.TaskList,
+	}
+}
+
+// ToActivityWorkerAttempt converts thrift ActivityWorkerAttempt type to internal
+func ToActivityWorkerAttempt(t *shared.ActivityWorkerAttempt) *types.ActivityWorkerAttempt {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityWorkerAttempt{
+		ScheduledEventID: t.GetScheduledEventId(),
+		Attempt:          t.GetAttempt(),
+		Identity:         t.GetIdentity(),
+		Outcome:          ToEventType(t.Outcome),
+		StartedTimestamp: t.StartedTimestamp,
+		EndTimestamp:     t.EndTimestamp,
+		TaskList:         t.GetTaskList(),
+	}
+}
+
 // FromArchivalStatus converts internal ArchivalStatus type to thrift
 func FromArchivalStatus(t *types.ArchivalStatus) *shared.ArchivalStatus {
 	if t == nil {
@@ -343,6 +1001,48 @@ func ToArchivalStatus(t *shared.ArchivalStatus) *types.ArchivalStatus {
 	panic("unexpected enum value")
 }
 
+// FromBatchDescribeWorkflowExecutionsRequest converts internal BatchDescribeWorkflowExecutionsRequest type to thrift
+func FromBatchDescribeWorkflowExecutionsRequest(t *types.BatchDescribeWorkflowExecutionsRequest) *shared.BatchDescribeWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.BatchDescribeWorkflowExecutionsRequest{
+		Domain:     &t.Domain,
+		Executions: FromWorkflowExecutionArray(t.Executions),
+	}
+}
+
+// ToBatchDescribeWorkflowExecutionsRequest converts thrift BatchDescribeWorkflowExecutionsRequest type to internal
+func ToBatchDescribeWorkflowExecutionsRequest(t *shared.BatchDescribeWorkflowExecutionsRequest) *types.BatchDescribeWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.BatchDescribeWorkflowExecutionsRequest{
+		Domain:     t.GetDomain(),
+		Executions: ToWorkflowExecutionArray(t.Executions),
+	}
+}
+
+// FromBatchDescribeWorkflowExecutionsResponse converts internal BatchDescribeWorkflowExecutionsResponse type to thrift
+func FromBatchDescribeWorkflowExecutionsResponse(t *types.BatchDescribeWorkflowExecutionsResponse) *shared.BatchDescribeWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.BatchDescribeWorkflowExecutionsResponse{
+		Results: FromDescribeWorkflowExecutionResultArray(t.Results),
+	}
+}
+
+// ToBatchDescribeWorkflowExecutionsResponse converts thrift BatchDescribeWorkflowExecutionsResponse type to internal
+func ToBatchDescribeWorkflowExecutionsResponse(t *shared.BatchDescribeWorkflowExecutionsResponse) *types.BatchDescribeWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.BatchDescribeWorkflowExecutionsResponse{
+		Results: ToDescribeWorkflowExecutionResultArray(t.Results),
+	}
+}
+
 // FromBadBinaries converts internal BadBinaries type to thrift
 func FromBadBinaries(t *types.BadBinaries) *shared.BadBinaries {
 	if t == nil {
@@ -793,6 +1493,146 @@ func ToAdminCloseShardRequest(t *shared.CloseShardRequest) *types.CloseShardRequ
 	}
 }
 
+// FromGetActivityRetryStatsRequest converts internal GetActivityRetryStatsRequest type to thrift
+func FromGetActivityRetryStatsRequest(t *types.GetActivityRetryStatsRequest) *shared.GetActivityRetryStatsRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetActivityRetryStatsRequest{
+		Domain:    &t.Domain,
+		StartTime: t.StartTime,
+		EndTime:   t.EndTime,
+	}
+}
+
+// ToGetActivityRetryStatsRequest converts thrift GetActivityRetryStatsRequest type to internal
+func ToGetActivityRetryStatsRequest(t *shared.GetActivityRetryStatsRequest) *types.GetActivityRetryStatsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityRetryStatsRequest{
+		Domain:    t.GetDomain(),
+		StartTime: t.StartTime,
+		EndTime:   t.EndTime,
+	}
+}
+
+// FromGetActivityRetryStatsResponse converts internal GetActivityRetryStatsResponse type to thrift
+func FromGetActivityRetryStatsResponse(t *types.GetActivityRetryStatsResponse) *shared.GetActivityRetryStatsResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetActivityRetryStatsResponse{
+		StartTime:             &t.StartTime,
+		EndTime:               &t.EndTime,
+		TotalAttempts:         &t.TotalAttempts,
+		RetriedAttempts:       &t.RetriedAttempts,
+		Successes:             &t.Successes,
+		FirstAttemptSuccesses: &t.FirstAttemptSuccesses,
+	}
+}
+
+// ToGetActivityRetryStatsResponse converts thrift GetActivityRetryStatsResponse type to internal
+func ToGetActivityRetryStatsResponse(t *shared.GetActivityRetryStatsResponse) *types.GetActivityRetryStatsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityRetryStatsResponse{
+		StartTime:             t.GetStartTime(),
+		EndTime:               t.GetEndTime(),
+		TotalAttempts:         t.GetTotalAttempts(),
+		RetriedAttempts:       t.GetRetriedAttempts(),
+		Successes:             t.GetSuccesses(),
+		FirstAttemptSuccesses: t.GetFirstAttemptSuccesses(),
+	}
+}
+
+// FromGetActivityTokenValidationFailuresRequest converts internal GetActivityTokenValidationFailuresRequest type to thrift
+func FromGetActivityTokenValidationFailuresRequest(t *types.GetActivityTokenValidationFailuresRequest) *shared.GetActivityTokenValidationFailuresRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetActivityTokenValidationFailuresRequest{
+		Domain: &t.Domain,
+	}
+}
+
+// ToGetActivityTokenValidationFailuresRequest converts thrift GetActivityTokenValidationFailuresRequest type to internal
+func ToGetActivityTokenValidationFailuresRequest(t *shared.GetActivityTokenValidationFailuresRequest) *types.GetActivityTokenValidationFailuresRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityTokenValidationFailuresRequest{
+		Domain: t.GetDomain(),
+	}
+}
+
+// FromGetActivityTokenValidationFailuresResponse converts internal GetActivityTokenValidationFailuresResponse type to thrift
+func FromGetActivityTokenValidationFailuresResponse(t *types.GetActivityTokenValidationFailuresResponse) *shared.GetActivityTokenValidationFailuresResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetActivityTokenValidationFailuresResponse{
+		Failures: FromActivityTokenValidationFailureArray(t.Failures),
+	}
+}
+
+// ToGetActivityTokenValidationFailuresResponse converts thrift GetActivityTokenValidationFailuresResponse type to internal
+func ToGetActivityTokenValidationFailuresResponse(t *shared.GetActivityTokenValidationFailuresResponse) *types.GetActivityTokenValidationFailuresResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityTokenValidationFailuresResponse{
+		Failures: ToActivityTokenValidationFailureArray(t.Failures),
+	}
+}
+
+// FromGetActivityWorkerHistoryRequest converts internal GetActivityWorkerHistoryRequest type to thrift
+func FromGetActivityWorkerHistoryRequest(t *types.GetActivityWorkerHistoryRequest) *shared.GetActivityWorkerHistoryRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetActivityWorkerHistoryRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        &t.ActivityID,
+	}
+}
+
+// ToGetActivityWorkerHistoryRequest converts thrift GetActivityWorkerHistoryRequest type to internal
+func ToGetActivityWorkerHistoryRequest(t *shared.GetActivityWorkerHistoryRequest) *types.GetActivityWorkerHistoryRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityWorkerHistoryRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityID(),
+	}
+}
+
+// FromGetActivityWorkerHistoryResponse converts internal GetActivityWorkerHistoryResponse type to thrift
+func FromGetActivityWorkerHistoryResponse(t *types.GetActivityWorkerHistoryResponse) *shared.GetActivityWorkerHistoryResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetActivityWorkerHistoryResponse{
+		ActivityID: &t.ActivityID,
+		Attempts:   FromActivityWorkerAttemptArray(t.Attempts),
+	}
+}
+
+// ToGetActivityWorkerHistoryResponse converts thrift GetActivityWorkerHistoryResponse type to internal
+func ToGetActivityWorkerHistoryResponse(t *shared.GetActivityWorkerHistoryResponse) *types.GetActivityWorkerHistoryResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetActivityWorkerHistoryResponse{
+		ActivityID: t.GetActivityID(),
+		Attempts:   ToActivityWorkerAttemptArray(t.Attempts),
+	}
+}
+
 // FromGetClusterInfoResponse converts internal ClusterInfo type to thrift
 func FromGetClusterInfoResponse(t *types.ClusterInfo) *shared.ClusterInfo {
 	if t == nil {
@@ -1727,6 +2567,30 @@ func ToAdminDescribeQueueResponse(t *shared.DescribeQueueResponse) *types.Descri
 	}
 }
 
+// FromDescribeWorkflowExecutionResult converts internal DescribeWorkflowExecutionResult type to thrift
+func FromDescribeWorkflowExecutionResult(t *types.DescribeWorkflowExecutionResult) *shared.DescribeWorkflowExecutionResult {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeWorkflowExecutionResult{
+		Execution: FromWorkflowExecution(t.Execution),
+		Response:  FromDescribeWorkflowExecutionResponse(t.Response),
+		Error:     &t.Error,
+	}
+}
+
+// ToDescribeWorkflowExecutionResult converts thrift DescribeWorkflowExecutionResult type to internal
+func ToDescribeWorkflowExecutionResult(t *shared.DescribeWorkflowExecutionResult) *types.DescribeWorkflowExecutionResult {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeWorkflowExecutionResult{
+		Execution: ToWorkflowExecution(t.Execution),
+		Response:  ToDescribeWorkflowExecutionResponse(t.Response),
+		Error:     t.GetError(),
+	}
+}
+
 // FromDescribeTaskListRequest converts internal DescribeTaskListRequest type to thrift
 func FromDescribeTaskListRequest(t *types.DescribeTaskListRequest) *shared.DescribeTaskListRequest {
 	if t == nil {
@@ -3121,6 +3985,48 @@ func ToListOpenWorkflowExecutionsResponse(t *shared.ListOpenWorkflowExecutionsRe
 	}
 }
 
+// FromListPendingActivityScheduleAttributesRequest converts internal ListPendingActivityScheduleAttributesRequest type to thrift
+func FromListPendingActivityScheduleAttributesRequest(t *types.ListPendingActivityScheduleAttributesRequest) *shared.ListPendingActivityScheduleAttributesRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListPendingActivityScheduleAttributesRequest{
+		Domain:    &t.Domain,
+		Execution: FromWorkflowExecution(t.Execution),
+	}
+}
+
+// ToListPendingActivityScheduleAttributesRequest converts thrift ListPendingActivityScheduleAttributesRequest type to internal
+func ToListPendingActivityScheduleAttributesRequest(t *shared.ListPendingActivityScheduleAttributesRequest) *types.ListPendingActivityScheduleAttributesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListPendingActivityScheduleAttributesRequest{
+		Domain:    t.GetDomain(),
+		Execution: ToWorkflowExecution(t.Execution),
+	}
+}
+
+// FromListPendingActivityScheduleAttributesResponse converts internal ListPendingActivityScheduleAttributesResponse type to thrift
+func FromListPendingActivityScheduleAttributesResponse(t *types.ListPendingActivityScheduleAttributesResponse) *shared.ListPendingActivityScheduleAttributesResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListPendingActivityScheduleAttributesResponse{
+		PendingActivities: FromPendingActivityScheduleAttributesArray(t.PendingActivities),
+	}
+}
+
+// ToListPendingActivityScheduleAttributesResponse converts thrift ListPendingActivityScheduleAttributesResponse type to internal
+func ToListPendingActivityScheduleAttributesResponse(t *shared.ListPendingActivityScheduleAttributesResponse) *types.ListPendingActivityScheduleAttributesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListPendingActivityScheduleAttributesResponse{
+		PendingActivities: ToPendingActivityScheduleAttributesArray(t.PendingActivities),
+	}
+}
+
 // FromListTaskListPartitionsRequest converts internal ListTaskListPartitionsRequest type to thrift
 func FromListTaskListPartitionsRequest(t *types.ListTaskListPartitionsRequest) *shared.ListTaskListPartitionsRequest {
 	if t == nil {
@@ -3553,6 +4459,7 @@ func FromPollForActivityTaskRequest(t *types.PollForActivityTaskRequest) *shared
 		TaskList:         FromTaskList(t.TaskList),
 		Identity:         &t.Identity,
 		TaskListMetadata: FromTaskListMetadata(t.TaskListMetadata),
+		PrefetchCount:    &t.PrefetchCount,
 	}
 }
 
@@ -3566,6 +4473,7 @@ func ToPollForActivityTaskRequest(t *shared.PollForActivityTaskRequest) *types.P
 		TaskList:         ToTaskList(t.TaskList),
 		Identity:         t.GetIdentity(),
 		TaskListMetadata: ToTaskListMetadata(t.TaskListMetadata),
+		PrefetchCount:    t.GetPrefetchCount(),
 	}
 }
 
@@ -3592,6 +4500,7 @@ func FromPollForActivityTaskResponse(t *types.PollForActivityTaskResponse) *shar
 		WorkflowDomain:                  &t.WorkflowDomain,
 		Header:                          FromHeader(t.Header),
 		AutoConfigHint:                  FromAutoConfigHint(t.AutoConfigHint),
+		PrefetchedTasks:                 FromPollForActivityTaskResponseArray(t.PrefetchedTasks),
 	}
 }
 
@@ -3618,7 +4527,32 @@ func ToPollForActivityTaskResponse(t *shared.PollForActivityTaskResponse) *types
 		WorkflowDomain:                  t.GetWorkflowDomain(),
 		Header:                          ToHeader(t.Header),
 		AutoConfigHint:                  ToAutoConfigHint(t.AutoConfigHint),
+		PrefetchedTasks:                 ToPollForActivityTaskResponseArray(t.PrefetchedTasks),
+	}
+}
+
+// FromPollForActivityTaskResponseArray converts internal PollForActivityTaskResponse type array to thrift
+func FromPollForActivityTaskResponseArray(t []*types.PollForActivityTaskResponse) []*shared.PollForActivityTaskResponse {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.PollForActivityTaskResponse, len(t))
+	for i := range t {
+		v[i] = FromPollForActivityTaskResponse(t[i])
+	}
+	return v
+}
+
+// ToPollForActivityTaskResponseArray converts thrift PollForActivityTaskResponse type array to internal
+func ToPollForActivityTaskResponseArray(t []*shared.PollForActivityTaskResponse) []*types.PollForActivityTaskResponse {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.PollForActivityTaskResponse, len(t))
+	for i := range t {
+		v[i] = ToPollForActivityTaskResponse(t[i])
 	}
+	return v
 }
 
 // FromPollForDecisionTaskRequest converts internal PollForDecisionTaskRequest type to thrift
@@ -4025,7 +4959,9 @@ func FromRecordActivityTaskHeartbeatResponse(t *types.RecordActivityTaskHeartbea
 		return nil
 	}
 	return &shared.RecordActivityTaskHeartbeatResponse{
-		CancelRequested: &t.CancelRequested,
+		CancelRequested:     &t.CancelRequested,
+		Signals:             FromActivitySignalArray(t.Signals),
+		WorkflowCloseStatus: FromWorkflowExecutionCloseStatus(t.WorkflowCloseStatus),
 	}
 }
 
@@ -4035,7 +4971,9 @@ func ToRecordActivityTaskHeartbeatResponse(t *shared.RecordActivityTaskHeartbeat
 		return nil
 	}
 	return &types.RecordActivityTaskHeartbeatResponse{
-		CancelRequested: t.GetCancelRequested(),
+		CancelRequested:     t.GetCancelRequested(),
+		Signals:             ToActivitySignalArray(t.Signals),
+		WorkflowCloseStatus: ToWorkflowExecutionCloseStatus(t.WorkflowCloseStatus),
 	}
 }
 
@@ -4179,6 +5117,50 @@ func ToAdminRemoveTaskRequest(t *shared.RemoveTaskRequest) *types.RemoveTaskRequ
 	}
 }
 
+// FromRenewActivityLeaseRequest converts internal RenewActivityLeaseRequest type to thrift
+func FromRenewActivityLeaseRequest(t *types.RenewActivityLeaseRequest) *shared.RenewActivityLeaseRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RenewActivityLeaseRequest{
+		TaskToken: t.TaskToken,
+		Identity:  &t.Identity,
+	}
+}
+
+// ToRenewActivityLeaseRequest converts thrift RenewActivityLeaseRequest type to internal
+func ToRenewActivityLeaseRequest(t *shared.RenewActivityLeaseRequest) *types.RenewActivityLeaseRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RenewActivityLeaseRequest{
+		TaskToken: t.TaskToken,
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// FromRenewActivityLeaseResponse converts internal RenewActivityLeaseResponse type to thrift
+func FromRenewActivityLeaseResponse(t *types.RenewActivityLeaseResponse) *shared.RenewActivityLeaseResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.RenewActivityLeaseResponse{
+		CancelRequested:     &t.CancelRequested,
+		WorkflowCloseStatus: FromWorkflowExecutionCloseStatus(t.WorkflowCloseStatus),
+	}
+}
+
+// ToRenewActivityLeaseResponse converts thrift RenewActivityLeaseResponse type to internal
+func ToRenewActivityLeaseResponse(t *shared.RenewActivityLeaseResponse) *types.RenewActivityLeaseResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.RenewActivityLeaseResponse{
+		CancelRequested:     t.GetCancelRequested(),
+		WorkflowCloseStatus: ToWorkflowExecutionCloseStatus(t.WorkflowCloseStatus),
+	}
+}
+
 // FromRequestCancelActivityTaskDecisionAttributes converts internal RequestCancelActivityTaskDecisionAttributes type to thrift
 func FromRequestCancelActivityTaskDecisionAttributes(t *types.RequestCancelActivityTaskDecisionAttributes) *shared.RequestCancelActivityTaskDecisionAttributes {
 	if t == nil {
@@ -4591,9 +5573,10 @@ func FromRespondActivityTaskCompletedRequest(t *types.RespondActivityTaskComplet
 		return nil
 	}
 	return &shared.RespondActivityTaskCompletedRequest{
-		TaskToken: t.TaskToken,
-		Result:    t.Result,
-		Identity:  &t.Identity,
+		TaskToken:        t.TaskToken,
+		Result:           t.Result,
+		Identity:         &t.Identity,
+		BackpressureHint: t.BackpressureHint,
 	}
 }
 
@@ -4603,9 +5586,10 @@ func ToRespondActivityTaskCompletedRequest(t *shared.RespondActivityTaskComplete
 		return nil
 	}
 	return &types.RespondActivityTaskCompletedRequest{
-		TaskToken: t.TaskToken,
-		Result:    t.Result,
-		Identity:  t.GetIdentity(),
+		TaskToken:        t.TaskToken,
+		Result:           t.Result,
+		Identity:         t.GetIdentity(),
+		BackpressureHint: t.BackpressureHint,
 	}
 }
 
@@ -4641,28 +5625,54 @@ func ToRespondActivityTaskFailedByIDRequest(t *shared.RespondActivityTaskFailedB
 	}
 }
 
-// FromRespondActivityTaskFailedRequest converts internal RespondActivityTaskFailedRequest type to thrift
-func FromRespondActivityTaskFailedRequest(t *types.RespondActivityTaskFailedRequest) *shared.RespondActivityTaskFailedRequest {
+// FromRespondActivityTaskFailedRequest converts internal RespondActivityTaskFailedRequest type to thrift
+func FromRespondActivityTaskFailedRequest(t *types.RespondActivityTaskFailedRequest) *shared.RespondActivityTaskFailedRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskFailedRequest{
+		TaskToken:        t.TaskToken,
+		Reason:           t.Reason,
+		Details:          t.Details,
+		Identity:         &t.Identity,
+		BackpressureHint: t.BackpressureHint,
+	}
+}
+
+// ToRespondActivityTaskFailedRequest converts thrift RespondActivityTaskFailedRequest type to internal
+func ToRespondActivityTaskFailedRequest(t *shared.RespondActivityTaskFailedRequest) *types.RespondActivityTaskFailedRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RespondActivityTaskFailedRequest{
+		TaskToken:        t.TaskToken,
+		Reason:           t.Reason,
+		Details:          t.Details,
+		Identity:         t.GetIdentity(),
+		BackpressureHint: t.BackpressureHint,
+	}
+}
+
+// FromRespondActivityTaskNackRequest converts internal RespondActivityTaskNackRequest type to thrift
+func FromRespondActivityTaskNackRequest(t *types.RespondActivityTaskNackRequest) *shared.RespondActivityTaskNackRequest {
 	if t == nil {
 		return nil
 	}
-	return &shared.RespondActivityTaskFailedRequest{
+	return &shared.RespondActivityTaskNackRequest{
 		TaskToken: t.TaskToken,
-		Reason:    t.Reason,
-		Details:   t.Details,
+		Reason:    &t.Reason,
 		Identity:  &t.Identity,
 	}
 }
 
-// ToRespondActivityTaskFailedRequest converts thrift RespondActivityTaskFailedRequest type to internal
-func ToRespondActivityTaskFailedRequest(t *shared.RespondActivityTaskFailedRequest) *types.RespondActivityTaskFailedRequest {
+// ToRespondActivityTaskNackRequest converts thrift RespondActivityTaskNackRequest type to internal
+func ToRespondActivityTaskNackRequest(t *shared.RespondActivityTaskNackRequest) *types.RespondActivityTaskNackRequest {
 	if t == nil {
 		return nil
 	}
-	return &types.RespondActivityTaskFailedRequest{
+	return &types.RespondActivityTaskNackRequest{
 		TaskToken: t.TaskToken,
-		Reason:    t.Reason,
-		Details:   t.Details,
+		Reason:    t.GetReason(),
 		Identity:  t.GetIdentity(),
 	}
 }
@@ -4887,6 +5897,11 @@ func FromScheduleActivityTaskDecisionAttributes(t *types.ScheduleActivityTaskDec
 		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
 		Header:                        FromHeader(t.Header),
 		RequestLocalDispatch:          &t.RequestLocalDispatch,
+		RoutingKey:                    &t.RoutingKey,
+		FallbackTaskList:              FromTaskList(t.FallbackTaskList),
+		NextActivity:                  FromScheduleActivityTaskDecisionAttributes(t.NextActivity),
+		EncryptionKeyId:               &t.EncryptionKeyID,
+		Priority:                      t.Priority,
 	}
 }
 
@@ -4908,6 +5923,11 @@ func ToScheduleActivityTaskDecisionAttributes(t *shared.ScheduleActivityTaskDeci
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
 		RequestLocalDispatch:          t.GetRequestLocalDispatch(),
+		RoutingKey:                    t.GetRoutingKey(),
+		FallbackTaskList:              ToTaskList(t.FallbackTaskList),
+		NextActivity:                  ToScheduleActivityTaskDecisionAttributes(t.NextActivity),
+		EncryptionKeyID:               t.GetEncryptionKeyId(),
+		Priority:                      t.Priority,
 	}
 }
 
@@ -4937,8 +5957,9 @@ func FromServiceBusyError(t *types.ServiceBusyError) *shared.ServiceBusyError {
 		return nil
 	}
 	return &shared.ServiceBusyError{
-		Message: t.Message,
-		Reason:  &t.Reason,
+		Message:      t.Message,
+		Reason:       &t.Reason,
+		RetryAfterMs: &t.RetryAfterMs,
 	}
 }
 
@@ -4948,8 +5969,39 @@ func ToServiceBusyError(t *shared.ServiceBusyError) *types.ServiceBusyError {
 		return nil
 	}
 	return &types.ServiceBusyError{
-		Message: t.Message,
-		Reason:  t.GetReason(),
+		Message:      t.Message,
+		Reason:       t.GetReason(),
+		RetryAfterMs: t.GetRetryAfterMs(),
+	}
+}
+
+// FromSignalActivityRequest converts internal SignalActivityRequest type to thrift
+func FromSignalActivityRequest(t *types.SignalActivityRequest) *shared.SignalActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.SignalActivityRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        &t.ActivityID,
+		SignalName:        &t.SignalName,
+		Input:             t.Input,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToSignalActivityRequest converts thrift SignalActivityRequest type to internal
+func ToSignalActivityRequest(t *shared.SignalActivityRequest) *types.SignalActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.SignalActivityRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityId(),
+		SignalName:        t.GetSignalName(),
+		Input:             t.Input,
+		Identity:          t.GetIdentity(),
 	}
 }
 
@@ -5433,6 +6485,7 @@ func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *
 		DelayStartSeconds:                   t.DelayStartSeconds,
 		JitterStartSeconds:                  t.JitterStartSeconds,
 		FirstRunAtTimestamp:                 t.FirstRunAtTimeStamp,
+		Priority:                            t.Priority,
 	}
 }
 
@@ -5460,6 +6513,7 @@ func ToStartWorkflowExecutionRequest(t *shared.StartWorkflowExecutionRequest) *t
 		DelayStartSeconds:                   t.DelayStartSeconds,
 		JitterStartSeconds:                  t.JitterStartSeconds,
 		FirstRunAtTimeStamp:                 t.FirstRunAtTimestamp,
+		Priority:                            t.Priority,
 	}
 }
 
@@ -5661,13 +6715,14 @@ func FromTaskListStatus(t *types.TaskListStatus) *shared.TaskListStatus {
 		return nil
 	}
 	return &shared.TaskListStatus{
-		BacklogCountHint:      &t.BacklogCountHint,
-		ReadLevel:             &t.ReadLevel,
-		AckLevel:              &t.AckLevel,
-		RatePerSecond:         &t.RatePerSecond,
-		TaskIDBlock:           FromTaskIDBlock(t.TaskIDBlock),
-		IsolationGroupMetrics: FromIsolationGroupMetricsMap(t.IsolationGroupMetrics),
-		NewTasksPerSecond:     &t.NewTasksPerSecond,
+		BacklogCountHint:          &t.BacklogCountHint,
+		ReadLevel:                 &t.ReadLevel,
+		AckLevel:                  &t.AckLevel,
+		RatePerSecond:             &t.RatePerSecond,
+		TaskIDBlock:               FromTaskIDBlock(t.TaskIDBlock),
+		IsolationGroupMetrics:     FromIsolationGroupMetricsMap(t.IsolationGroupMetrics),
+		NewTasksPerSecond:         &t.NewTasksPerSecond,
+		BackpressureRatePerSecond: &t.BackpressureRatePerSecond,
 	}
 }
 
@@ -5677,13 +6732,14 @@ func ToTaskListStatus(t *shared.TaskListStatus) *types.TaskListStatus {
 		return nil
 	}
 	return &types.TaskListStatus{
-		BacklogCountHint:      t.GetBacklogCountHint(),
-		ReadLevel:             t.GetReadLevel(),
-		AckLevel:              t.GetAckLevel(),
-		RatePerSecond:         t.GetRatePerSecond(),
-		TaskIDBlock:           ToTaskIDBlock(t.TaskIDBlock),
-		IsolationGroupMetrics: ToIsolationGroupMetricsMap(t.GetIsolationGroupMetrics()),
-		NewTasksPerSecond:     t.GetNewTasksPerSecond(),
+		BacklogCountHint:          t.GetBacklogCountHint(),
+		ReadLevel:                 t.GetReadLevel(),
+		AckLevel:                  t.GetAckLevel(),
+		RatePerSecond:             t.GetRatePerSecond(),
+		TaskIDBlock:               ToTaskIDBlock(t.TaskIDBlock),
+		IsolationGroupMetrics:     ToIsolationGroupMetricsMap(t.GetIsolationGroupMetrics()),
+		NewTasksPerSecond:         t.GetNewTasksPerSecond(),
+		BackpressureRatePerSecond: t.GetBackpressureRatePerSecond(),
 	}
 }
 
@@ -6552,6 +7608,7 @@ func FromWorkflowExecutionStartedEventAttributes(t *types.WorkflowExecutionStart
 		Header:                              FromHeader(t.Header),
 		PartitionConfig:                     t.PartitionConfig,
 		RequestId:                           &t.RequestID,
+		Priority:                            t.Priority,
 	}
 }
 
@@ -6590,6 +7647,7 @@ func ToWorkflowExecutionStartedEventAttributes(t *shared.WorkflowExecutionStarte
 		Header:                              ToHeader(t.Header),
 		PartitionConfig:                     t.PartitionConfig,
 		RequestID:                           t.GetRequestId(),
+		Priority:                            t.Priority,
 	}
 }
 
@@ -6767,6 +7825,246 @@ func ToWorkflowTypeFilter(t *shared.WorkflowTypeFilter) *types.WorkflowTypeFilte
 	}
 }
 
+// FromActivitySignalArray converts internal ActivitySignal type array to thrift
+func FromActivitySignalArray(t []*types.ActivitySignal) []*shared.ActivitySignal {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ActivitySignal, len(t))
+	for i := range t {
+		v[i] = FromActivitySignal(t[i])
+	}
+	return v
+}
+
+// ToActivitySignalArray converts thrift ActivitySignal type array to internal
+func ToActivitySignalArray(t []*shared.ActivitySignal) []*types.ActivitySignal {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ActivitySignal, len(t))
+	for i := range t {
+		v[i] = ToActivitySignal(t[i])
+	}
+	return v
+}
+
+// FromWorkflowExecutionArray converts internal WorkflowExecution type array to thrift
+func FromWorkflowExecutionArray(t []*types.WorkflowExecution) []*shared.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.WorkflowExecution, len(t))
+	for i := range t {
+		v[i] = FromWorkflowExecution(t[i])
+	}
+	return v
+}
+
+// ToWorkflowExecutionArray converts thrift WorkflowExecution type array to internal
+func ToWorkflowExecutionArray(t []*shared.WorkflowExecution) []*types.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkflowExecution, len(t))
+	for i := range t {
+		v[i] = ToWorkflowExecution(t[i])
+	}
+	return v
+}
+
+// FromDescribeWorkflowExecutionResultArray converts internal DescribeWorkflowExecutionResult type array to thrift
+func FromDescribeWorkflowExecutionResultArray(t []*types.DescribeWorkflowExecutionResult) []*shared.DescribeWorkflowExecutionResult {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.DescribeWorkflowExecutionResult, len(t))
+	for i := range t {
+		v[i] = FromDescribeWorkflowExecutionResult(t[i])
+	}
+	return v
+}
+
+// ToDescribeWorkflowExecutionResultArray converts thrift DescribeWorkflowExecutionResult type array to internal
+func ToDescribeWorkflowExecutionResultArray(t []*shared.DescribeWorkflowExecutionResult) []*types.DescribeWorkflowExecutionResult {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DescribeWorkflowExecutionResult, len(t))
+	for i := range t {
+		v[i] = ToDescribeWorkflowExecutionResult(t[i])
+	}
+	return v
+}
+
+// FromActivityResultSearchAttributeArray converts internal ActivityResultSearchAttribute type array to thrift
+func FromActivityResultSearchAttributeArray(t []*types.ActivityResultSearchAttribute) []*shared.ActivityResultSearchAttribute {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ActivityResultSearchAttribute, len(t))
+	for i := range t {
+		v[i] = FromActivityResultSearchAttribute(t[i])
+	}
+	return v
+}
+
+// ToActivityResultSearchAttributeArray converts thrift ActivityResultSearchAttribute type array to internal
+func ToActivityResultSearchAttributeArray(t []*shared.ActivityResultSearchAttribute) []*types.ActivityResultSearchAttribute {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ActivityResultSearchAttribute, len(t))
+	for i := range t {
+		v[i] = ToActivityResultSearchAttribute(t[i])
+	}
+	return v
+}
+
+// FromActivityRescheduleReasonArray converts internal ActivityRescheduleReason type array to thrift
+func FromActivityRescheduleReasonArray(t []*types.ActivityRescheduleReason) []*shared.ActivityRescheduleReason {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ActivityRescheduleReason, len(t))
+	for i := range t {
+		v[i] = FromActivityRescheduleReason(t[i])
+	}
+	return v
+}
+
+// ToActivityRescheduleReasonArray converts thrift ActivityRescheduleReason type array to internal
+func ToActivityRescheduleReasonArray(t []*shared.ActivityRescheduleReason) []*types.ActivityRescheduleReason {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ActivityRescheduleReason, len(t))
+	for i := range t {
+		v[i] = ToActivityRescheduleReason(t[i])
+	}
+	return v
+}
+
+// FromActivityWorkerAttemptArray converts internal ActivityWorkerAttempt type array to thrift
+func FromActivityWorkerAttemptArray(t []*types.ActivityWorkerAttempt) []*shared.ActivityWorkerAttempt {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ActivityWorkerAttempt, len(t))
+	for i := range t {
+		v[i] = FromActivityWorkerAttempt(t[i])
+	}
+	return v
+}
+
+// ToActivityWorkerAttemptArray converts thrift ActivityWorkerAttempt type array to internal
+func ToActivityWorkerAttemptArray(t []*shared.ActivityWorkerAttempt) []*types.ActivityWorkerAttempt {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ActivityWorkerAttempt, len(t))
+	for i := range t {
+		v[i] = ToActivityWorkerAttempt(t[i])
+	}
+	return v
+}
+
+// FromPendingActivityScheduleAttributesArray converts internal PendingActivityScheduleAttributes type array to thrift
+func FromPendingActivityScheduleAttributesArray(t []*types.PendingActivityScheduleAttributes) []*shared.PendingActivityScheduleAttributes {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.PendingActivityScheduleAttributes, len(t))
+	for i := range t {
+		v[i] = FromPendingActivityScheduleAttributes(t[i])
+	}
+	return v
+}
+
+// ToPendingActivityScheduleAttributesArray converts thrift PendingActivityScheduleAttributes type array to internal
+func ToPendingActivityScheduleAttributesArray(t []*shared.PendingActivityScheduleAttributes) []*types.PendingActivityScheduleAttributes {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.PendingActivityScheduleAttributes, len(t))
+	for i := range t {
+		v[i] = ToPendingActivityScheduleAttributes(t[i])
+	}
+	return v
+}
+
+// FromActivityTokenValidationFailureArray converts internal ActivityTokenValidationFailure type array to thrift
+func FromActivityTokenValidationFailureArray(t []*types.ActivityTokenValidationFailure) []*shared.ActivityTokenValidationFailure {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ActivityTokenValidationFailure, len(t))
+	for i := range t {
+		v[i] = FromActivityTokenValidationFailure(t[i])
+	}
+	return v
+}
+
+// ToActivityTokenValidationFailureArray converts thrift ActivityTokenValidationFailure type array to internal
+func ToActivityTokenValidationFailureArray(t []*shared.ActivityTokenValidationFailure) []*types.ActivityTokenValidationFailure {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ActivityTokenValidationFailure, len(t))
+	for i := range t {
+		v[i] = ToActivityTokenValidationFailure(t[i])
+	}
+	return v
+}
+
+// FromActivityAttemptTimingArray converts internal ActivityAttemptTiming type array to thrift
+func FromActivityAttemptTimingArray(t []*types.ActivityAttemptTiming) []*shared.ActivityAttemptTiming {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ActivityAttemptTiming, len(t))
+	for i := range t {
+		v[i] = FromActivityAttemptTiming(t[i])
+	}
+	return v
+}
+
+// ToActivityAttemptTimingArray converts thrift ActivityAttemptTiming type array to internal
+func ToActivityAttemptTimingArray(t []*shared.ActivityAttemptTiming) []*types.ActivityAttemptTiming {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ActivityAttemptTiming, len(t))
+	for i := range t {
+		v[i] = ToActivityAttemptTiming(t[i])
+	}
+	return v
+}
+
+// FromActivityTypeCountArray converts internal ActivityTypeCount type array to thrift
+func FromActivityTypeCountArray(t []*types.ActivityTypeCount) []*shared.ActivityTypeCount {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ActivityTypeCount, len(t))
+	for i := range t {
+		v[i] = FromActivityTypeCount(t[i])
+	}
+	return v
+}
+
+// ToActivityTypeCountArray converts thrift ActivityTypeCount type array to internal
+func ToActivityTypeCountArray(t []*shared.ActivityTypeCount) []*types.ActivityTypeCount {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ActivityTypeCount, len(t))
+	for i := range t {
+		v[i] = ToActivityTypeCount(t[i])
+	}
+	return v
+}
+
 // FromPendingActivityInfoArray converts internal PendingActivityInfo type array to thrift
 func FromPendingActivityInfoArray(t []*types.PendingActivityInfo) []*shared.PendingActivityInfo {
 	if t == nil {
@@ -6791,6 +8089,30 @@ func ToPendingActivityInfoArray(t []*shared.PendingActivityInfo) []*types.Pendin
 	return v
 }
 
+// FromPendingActivityScheduleAttributes converts internal PendingActivityScheduleAttributes type to thrift
+func FromPendingActivityScheduleAttributes(t *types.PendingActivityScheduleAttributes) *shared.PendingActivityScheduleAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.PendingActivityScheduleAttributes{
+		ActivityID:               &t.ActivityID,
+		ScheduleID:               &t.ScheduleID,
+		ScheduledEventAttributes: FromActivityTaskScheduledEventAttributes(t.ScheduledEventAttributes),
+	}
+}
+
+// ToPendingActivityScheduleAttributes converts thrift PendingActivityScheduleAttributes type to internal
+func ToPendingActivityScheduleAttributes(t *shared.PendingActivityScheduleAttributes) *types.PendingActivityScheduleAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.PendingActivityScheduleAttributes{
+		ActivityID:               t.GetActivityID(),
+		ScheduleID:               t.GetScheduleID(),
+		ScheduledEventAttributes: ToActivityTaskScheduledEventAttributes(t.ScheduledEventAttributes),
+	}
+}
+
 // FromHistoryEventArray converts internal HistoryEvent type array to thrift
 func FromHistoryEventArray(t []*types.HistoryEvent) []*shared.HistoryEvent {
 	if t == nil {
@@ -8223,3 +9545,91 @@ func ToAutoConfigHint(t *shared.AutoConfigHint) *types.AutoConfigHint {
 		EnableAutoConfig:   *t.EnableAutoConfig,
 	}
 }
+
+// FromActivityRescheduleCause converts internal ActivityRescheduleCause type to thrift
+func FromActivityRescheduleCause(t *types.ActivityRescheduleCause) *shared.ActivityRescheduleCause {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.ActivityRescheduleCauseFailure:
+		v := shared.ActivityRescheduleCauseFailure
+		return &v
+	case types.ActivityRescheduleCauseHeartbeatTimeout:
+		v := shared.ActivityRescheduleCauseHeartbeatTimeout
+		return &v
+	case types.ActivityRescheduleCauseStartToCloseTimeout:
+		v := shared.ActivityRescheduleCauseStartToCloseTimeout
+		return &v
+	case types.ActivityRescheduleCauseScheduleToStartTimeout:
+		v := shared.ActivityRescheduleCauseScheduleToStartTimeout
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToActivityRescheduleCause converts thrift ActivityRescheduleCause type to internal
+func ToActivityRescheduleCause(t *shared.ActivityRescheduleCause) *types.ActivityRescheduleCause {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.ActivityRescheduleCauseFailure:
+		v := types.ActivityRescheduleCauseFailure
+		return &v
+	case shared.ActivityRescheduleCauseHeartbeatTimeout:
+		v := types.ActivityRescheduleCauseHeartbeatTimeout
+		return &v
+	case shared.ActivityRescheduleCauseStartToCloseTimeout:
+		v := types.ActivityRescheduleCauseStartToCloseTimeout
+		return &v
+	case shared.ActivityRescheduleCauseScheduleToStartTimeout:
+		v := types.ActivityRescheduleCauseScheduleToStartTimeout
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// FromActivityTokenValidationFailureCause converts internal ActivityTokenValidationFailureCause type to thrift
+func FromActivityTokenValidationFailureCause(t *types.ActivityTokenValidationFailureCause) *shared.ActivityTokenValidationFailureCause {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.ActivityTokenValidationFailureCauseExpired:
+		v := shared.ActivityTokenValidationFailureCauseExpired
+		return &v
+	case types.ActivityTokenValidationFailureCauseAlreadyCompleted:
+		v := shared.ActivityTokenValidationFailureCauseAlreadyCompleted
+		return &v
+	case types.ActivityTokenValidationFailureCauseWrongRun:
+		v := shared.ActivityTokenValidationFailureCauseWrongRun
+		return &v
+	case types.ActivityTokenValidationFailureCauseMalformed:
+		v := shared.ActivityTokenValidationFailureCauseMalformed
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToActivityTokenValidationFailureCause converts thrift ActivityTokenValidationFailureCause type to internal
+func ToActivityTokenValidationFailureCause(t *shared.ActivityTokenValidationFailureCause) *types.ActivityTokenValidationFailureCause {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.ActivityTokenValidationFailureCauseExpired:
+		v := types.ActivityTokenValidationFailureCauseExpired
+		return &v
+	case shared.ActivityTokenValidationFailureCauseAlreadyCompleted:
+		v := types.ActivityTokenValidationFailureCauseAlreadyCompleted
+		return &v
+	case shared.ActivityTokenValidationFailureCauseWrongRun:
+		v := types.ActivityTokenValidationFailureCauseWrongRun
+		return &v
+	case shared.ActivityTokenValidationFailureCauseMalformed:
+		v := types.ActivityTokenValidationFailureCauseMalformed
+		return &v
+	}
+	panic("unexpected enum value")
+}
@@ -36,6 +36,90 @@ import (
 	"github.com/uber/cadence/common/types/testdata"
 )
 
+func TestActivityAttemptTimingConversion(t *testing.T) {
+	testCases := []*types.ActivityAttemptTiming{
+		nil,
+		{},
+		&testdata.ActivityAttemptTiming,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromActivityAttemptTiming(original)
+		roundTripObj := ToActivityAttemptTiming(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestActivityResultSearchAttributeConversion(t *testing.T) {
+	testCases := []*types.ActivityResultSearchAttribute{
+		nil,
+		{},
+		&testdata.ActivityResultSearchAttribute,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromActivityResultSearchAttribute(original)
+		roundTripObj := ToActivityResultSearchAttribute(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestActivityRescheduleReasonConversion(t *testing.T) {
+	testCases := []*types.ActivityRescheduleReason{
+		nil,
+		{},
+		&testdata.ActivityRescheduleReason,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromActivityRescheduleReason(original)
+		roundTripObj := ToActivityRescheduleReason(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestActivityTypeCountConversion(t *testing.T) {
+	testCases := []*types.ActivityTypeCount{
+		nil,
+		{},
+		&testdata.ActivityTypeCount,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromActivityTypeCount(original)
+		roundTripObj := ToActivityTypeCount(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestActivityWorkerAttemptConversion(t *testing.T) {
+	testCases := []*types.ActivityWorkerAttempt{
+		nil,
+		{},
+		&testdata.ActivityWorkerAttempt,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromActivityWorkerAttempt(original)
+		roundTripObj := ToActivityWorkerAttempt(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestCancelPendingActivitiesRequestConversion(t *testing.T) {
+	testCases := []*types.CancelPendingActivitiesRequest{
+		nil,
+		{},
+		&testdata.CancelPendingActivitiesRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromCancelPendingActivitiesRequest(original)
+		roundTripObj := ToCancelPendingActivitiesRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestCrossClusterTaskInfoConversion(t *testing.T) {
 	for _, item := range []*types.CrossClusterTaskInfo{nil, {}, &testdata.CrossClusterTaskInfo} {
 		assert.Equal(t, item, ToCrossClusterTaskInfo(FromCrossClusterTaskInfo(item)))
@@ -84,6 +168,216 @@ func TestCrossClusterTaskRequestMapConversion(t *testing.T) {
 	}
 }
 
+func TestExpireActivityHeartbeatRequestConversion(t *testing.T) {
+	testCases := []*types.ExpireActivityHeartbeatRequest{
+		nil,
+		{},
+		&testdata.ExpireActivityHeartbeatRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromExpireActivityHeartbeatRequest(original)
+		roundTripObj := ToExpireActivityHeartbeatRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetActivityAttemptTimingsRequestConversion(t *testing.T) {
+	testCases := []*types.GetActivityAttemptTimingsRequest{
+		nil,
+		{},
+		&testdata.GetActivityAttemptTimingsRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromGetActivityAttemptTimingsRequest(original)
+		roundTripObj := ToGetActivityAttemptTimingsRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetActivityAttemptTimingsResponseConversion(t *testing.T) {
+	testCases := []*types.GetActivityAttemptTimingsResponse{
+		nil,
+		{},
+		&testdata.GetActivityAttemptTimingsResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromGetActivityAttemptTimingsResponse(original)
+		roundTripObj := ToGetActivityAttemptTimingsResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetActivityEffectiveConfigRequestConversion(t *testing.T) {
+	testCases := []*types.GetActivityEffectiveConfigRequest{
+		nil,
+		{},
+		&testdata.GetActivityEffectiveConfigRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromGetActivityEffectiveConfigRequest(original)
+		roundTripObj := ToGetActivityEffectiveConfigRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetActivityEffectiveConfigResponseConversion(t *testing.T) {
+	testCases := []*types.GetActivityEffectiveConfigResponse{
+		nil,
+		{},
+		&testdata.GetActivityEffectiveConfigResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromGetActivityEffectiveConfigResponse(original)
+		roundTripObj := ToGetActivityEffectiveConfigResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetActivityNextRetryTimeRequestConversion(t *testing.T) {
+	testCases := []*types.GetActivityNextRetryTimeRequest{
+		nil,
+		{},
+		&testdata.GetActivityNextRetryTimeRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromGetActivityNextRetryTimeRequest(original)
+		roundTripObj := ToGetActivityNextRetryTimeRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetActivityNextRetryTimeResponseConversion(t *testing.T) {
+	testCases := []*types.GetActivityNextRetryTimeResponse{
+		nil,
+		{},
+		&testdata.GetActivityNextRetryTimeResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromGetActivityNextRetryTimeResponse(original)
+		roundTripObj := ToGetActivityNextRetryTimeResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetActivityRescheduleReasonsRequestConversion(t *testing.T) {
+	testCases := []*types.GetActivityRescheduleReasonsRequest{
+		nil,
+		{},
+		&testdata.GetActivityRescheduleReasonsRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromGetActivityRescheduleReasonsRequest(original)
+		roundTripObj := ToGetActivityRescheduleReasonsRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetActivityRescheduleReasonsResponseConversion(t *testing.T) {
+	testCases := []*types.GetActivityRescheduleReasonsResponse{
+		nil,
+		{},
+		&testdata.GetActivityRescheduleReasonsResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromGetActivityRescheduleReasonsResponse(original)
+		roundTripObj := ToGetActivityRescheduleReasonsResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetActivityRetryStatsRequestConversion(t *testing.T) {
+	testCases := []*types.GetActivityRetryStatsRequest{
+		nil,
+		{},
+		&testdata.GetActivityRetryStatsRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromGetActivityRetryStatsRequest(original)
+		roundTripObj := ToGetActivityRetryStatsRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetActivityRetryStatsResponseConversion(t *testing.T) {
+	testCases := []*types.GetActivityRetryStatsResponse{
+		nil,
+		{},
+		&testdata.GetActivityRetryStatsResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromGetActivityRetryStatsResponse(original)
+		roundTripObj := ToGetActivityRetryStatsResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetActivityTokenValidationFailuresRequestConversion(t *testing.T) {
+	testCases := []*types.GetActivityTokenValidationFailuresRequest{
+		nil,
+		{},
+		&testdata.GetActivityTokenValidationFailuresRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromGetActivityTokenValidationFailuresRequest(original)
+		roundTripObj := ToGetActivityTokenValidationFailuresRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetActivityTokenValidationFailuresResponseConversion(t *testing.T) {
+	testCases := []*types.GetActivityTokenValidationFailuresResponse{
+		nil,
+		{},
+		&testdata.GetActivityTokenValidationFailuresResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromGetActivityTokenValidationFailuresResponse(original)
+		roundTripObj := ToGetActivityTokenValidationFailuresResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetActivityWorkerHistoryRequestConversion(t *testing.T) {
+	testCases := []*types.GetActivityWorkerHistoryRequest{
+		nil,
+		{},
+		&testdata.GetActivityWorkerHistoryRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromGetActivityWorkerHistoryRequest(original)
+		roundTripObj := ToGetActivityWorkerHistoryRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetActivityWorkerHistoryResponseConversion(t *testing.T) {
+	testCases := []*types.GetActivityWorkerHistoryResponse{
+		nil,
+		{},
+		&testdata.GetActivityWorkerHistoryResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromGetActivityWorkerHistoryResponse(original)
+		roundTripObj := ToGetActivityWorkerHistoryResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestGetTaskFailedCauseMapConversion(t *testing.T) {
 	for _, item := range []map[int32]types.GetTaskFailedCause{nil, {}, testdata.GetCrossClusterTaskFailedCauseMap} {
 		assert.Equal(t, item, ToGetTaskFailedCauseMap(FromGetTaskFailedCauseMap(item)))
@@ -102,6 +396,104 @@ func TestGetCrossClusterTasksResponseConversion(t *testing.T) {
 	}
 }
 
+func TestListActivityTypesRequestConversion(t *testing.T) {
+	testCases := []*types.ListActivityTypesRequest{
+		nil,
+		{},
+		&testdata.ListActivityTypesRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromListActivityTypesRequest(original)
+		roundTripObj := ToListActivityTypesRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestListActivityTypesResponseConversion(t *testing.T) {
+	testCases := []*types.ListActivityTypesResponse{
+		nil,
+		{},
+		&testdata.ListActivityTypesResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromListActivityTypesResponse(original)
+		roundTripObj := ToListActivityTypesResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestRedriveTimedOutActivitiesRequestConversion(t *testing.T) {
+	testCases := []*types.RedriveTimedOutActivitiesRequest{
+		nil,
+		{},
+		&testdata.RedriveTimedOutActivitiesRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromRedriveTimedOutActivitiesRequest(original)
+		roundTripObj := ToRedriveTimedOutActivitiesRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestReplayActivityCompletionsRequestConversion(t *testing.T) {
+	testCases := []*types.ReplayActivityCompletionsRequest{
+		nil,
+		{},
+		&testdata.ReplayActivityCompletionsRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromReplayActivityCompletionsRequest(original)
+		roundTripObj := ToReplayActivityCompletionsRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestReplayActivityCompletionsResponseConversion(t *testing.T) {
+	testCases := []*types.ReplayActivityCompletionsResponse{
+		nil,
+		{},
+		&testdata.ReplayActivityCompletionsResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromReplayActivityCompletionsResponse(original)
+		roundTripObj := ToReplayActivityCompletionsResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestResetActivityAttemptsRequestConversion(t *testing.T) {
+	testCases := []*types.ResetActivityAttemptsRequest{
+		nil,
+		{},
+		&testdata.ResetActivityAttemptsRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromResetActivityAttemptsRequest(original)
+		roundTripObj := ToResetActivityAttemptsRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestRespondActivityTaskNackRequestConversion(t *testing.T) {
+	testCases := []*types.RespondActivityTaskNackRequest{
+		nil,
+		{},
+		&testdata.RespondActivityTaskNackRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromRespondActivityTaskNackRequest(original)
+		roundTripObj := ToRespondActivityTaskNackRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestRespondCrossClusterTasksCompletedRequestConversion(t *testing.T) {
 	for _, item := range []*types.RespondCrossClusterTasksCompletedRequest{nil, {}, &testdata.RespondCrossClusterTasksCompletedRequest} {
 		assert.Equal(t, item, ToAdminRespondCrossClusterTasksCompletedRequest(FromAdminRespondCrossClusterTasksCompletedRequest(item)))
@@ -278,6 +670,34 @@ func TestIsolationGroupFromDomainBlobConversion(t *testing.T) {
 	}
 }
 
+func TestWaitForActivityCompletionRequestConversion(t *testing.T) {
+	testCases := []*types.WaitForActivityCompletionRequest{
+		nil,
+		{},
+		&testdata.WaitForActivityCompletionRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromWaitForActivityCompletionRequest(original)
+		roundTripObj := ToWaitForActivityCompletionRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestWaitForActivityCompletionResponseConversion(t *testing.T) {
+	testCases := []*types.WaitForActivityCompletionResponse{
+		nil,
+		{},
+		&testdata.WaitForActivityCompletionResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromWaitForActivityCompletionResponse(original)
+		roundTripObj := ToWaitForActivityCompletionResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestWorkflowExecutionInfoConversion(t *testing.T) {
 	for _, item := range []*types.WorkflowExecutionInfo{nil, {}, &testdata.WorkflowExecutionInfo, &testdata.CronWorkflowExecutionInfo} {
 		assert.Equal(t, item, ToWorkflowExecutionInfo(FromWorkflowExecutionInfo(item)))
@@ -345,6 +765,9 @@ func TestActivityTaskCompletedEventAttributesConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.ActivityTaskCompletedEventAttributes,
+		{ScheduledEventID: testdata.EventID1, EncryptionKeyID: "encryption-key-id"},
+		{ScheduledEventID: testdata.EventID2, ResultReferenceScheduledEventID: testdata.EventID1},
+		{ScheduledEventID: testdata.EventID1, ResultBlobKey: "activity-results/key"},
 	}
 
 	for _, original := range testCases {
@@ -373,6 +796,11 @@ func TestActivityTaskScheduledEventAttributesConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.ActivityTaskScheduledEventAttributes,
+		{ActivityID: testdata.ActivityID, RoutingKey: "routing-key"},
+		{ActivityID: testdata.ActivityID, FallbackTaskList: &types.TaskList{Name: "fallback-task-list"}},
+		{ActivityID: testdata.ActivityID, NextActivity: &types.ScheduleActivityTaskDecisionAttributes{ActivityID: "next-activity-id"}},
+		{ActivityID: testdata.ActivityID, EncryptionKeyID: "encryption-key-id"},
+		{ActivityID: testdata.ActivityID, Priority: common.Int32Ptr(5)},
 	}
 
 	for _, original := range testCases {
@@ -387,6 +815,7 @@ func TestActivityTaskStartedEventAttributesConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.ActivityTaskStartedEventAttributes,
+		{ScheduledEventID: testdata.EventID1, TaskList: &types.TaskList{Name: "fallback-task-list"}},
 	}
 
 	for _, original := range testCases {
@@ -409,6 +838,19 @@ func TestActivityTaskTimedEventAttributesConversion(t *testing.T) {
 		assert.Equal(t, original, roundTripObj)
 	}
 }
+func TestActivityTokenValidationFailureConversion(t *testing.T) {
+	testCases := []*types.ActivityTokenValidationFailure{
+		nil,
+		{},
+		&testdata.ActivityTokenValidationFailure,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromActivityTokenValidationFailure(original)
+		roundTripObj := ToActivityTokenValidationFailure(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
 
 func TestArchivalStatusConversion(t *testing.T) {
 	enabledStatus := types.ArchivalStatus(1)
@@ -1109,6 +1551,34 @@ func TestDescribeTaskListResponseConversion(t *testing.T) {
 	}
 }
 
+func TestBatchDescribeWorkflowExecutionsRequestConversion(t *testing.T) {
+	testCases := []*types.BatchDescribeWorkflowExecutionsRequest{
+		nil,
+		{},
+		&testdata.BatchDescribeWorkflowExecutionsRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromBatchDescribeWorkflowExecutionsRequest(original)
+		roundTripObj := ToBatchDescribeWorkflowExecutionsRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestBatchDescribeWorkflowExecutionsResponseConversion(t *testing.T) {
+	testCases := []*types.BatchDescribeWorkflowExecutionsResponse{
+		nil,
+		{},
+		&testdata.BatchDescribeWorkflowExecutionsResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromBatchDescribeWorkflowExecutionsResponse(original)
+		roundTripObj := ToBatchDescribeWorkflowExecutionsResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestDescribeWorkflowExecutionRequestConversion(t *testing.T) {
 	testCases := []*types.DescribeWorkflowExecutionRequest{
 		nil,
@@ -1515,6 +1985,11 @@ func TestHistoryEventConversion(t *testing.T) {
 			EventType:                               types.EventTypeWorkflowExecutionStarted.Ptr(),
 			WorkflowExecutionStartedEventAttributes: &testdata.WorkflowExecutionStartedEventAttributes,
 		},
+		{
+			ID:                                      5,
+			EventType:                               types.EventTypeWorkflowExecutionStarted.Ptr(),
+			WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{Priority: common.Int32Ptr(5)},
+		},
 	}
 
 	for _, original := range testCases {
@@ -1711,6 +2186,32 @@ func TestListOpenWorkflowExecutionsResponseConversion(t *testing.T) {
 		assert.Equal(t, original, roundTripObj)
 	}
 }
+func TestListPendingActivityScheduleAttributesRequestConversion(t *testing.T) {
+	testCases := []*types.ListPendingActivityScheduleAttributesRequest{
+		nil,
+		{},
+		&testdata.ListPendingActivityScheduleAttributesRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromListPendingActivityScheduleAttributesRequest(original)
+		roundTripObj := ToListPendingActivityScheduleAttributesRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+func TestListPendingActivityScheduleAttributesResponseConversion(t *testing.T) {
+	testCases := []*types.ListPendingActivityScheduleAttributesResponse{
+		nil,
+		{},
+		&testdata.ListPendingActivityScheduleAttributesResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromListPendingActivityScheduleAttributesResponse(original)
+		roundTripObj := ToListPendingActivityScheduleAttributesResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
 
 func TestListTaskListPartitionsRequestConversion(t *testing.T) {
 	testCases := []*types.ListTaskListPartitionsRequest{
@@ -1869,6 +2370,19 @@ func TestPendingActivityInfoConversion(t *testing.T) {
 		assert.Equal(t, original, roundTripObj)
 	}
 }
+func TestPendingActivityScheduleAttributesConversion(t *testing.T) {
+	testCases := []*types.PendingActivityScheduleAttributes{
+		nil,
+		{},
+		&testdata.PendingActivityScheduleAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromPendingActivityScheduleAttributes(original)
+		roundTripObj := ToPendingActivityScheduleAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
 
 func TestPendingActivityStateConversion(t *testing.T) {
 	testCases := []*types.PendingActivityState{
@@ -2159,6 +2673,7 @@ func TestRecordActivityTaskHeartbeatResponseConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.RecordActivityTaskHeartbeatResponse,
+		&testdata.HistoryRecordActivityTaskHeartbeatResponse,
 	}
 
 	for _, original := range testCases {
@@ -2238,6 +2753,34 @@ func TestRemoveTaskRequestConversion(t *testing.T) {
 	}
 }
 
+func TestRenewActivityLeaseRequestConversion(t *testing.T) {
+	testCases := []*types.RenewActivityLeaseRequest{
+		nil,
+		{},
+		&testdata.RenewActivityLeaseRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromRenewActivityLeaseRequest(original)
+		roundTripObj := ToRenewActivityLeaseRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestRenewActivityLeaseResponseConversion(t *testing.T) {
+	testCases := []*types.RenewActivityLeaseResponse{
+		nil,
+		{},
+		&testdata.RenewActivityLeaseResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromRenewActivityLeaseResponse(original)
+		roundTripObj := ToRenewActivityLeaseResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestRequestCancelActivityTaskDecisionAttributesConversion(t *testing.T) {
 	testCases := []*types.RequestCancelActivityTaskDecisionAttributes{
 		nil,
@@ -2453,6 +2996,7 @@ func TestRespondActivityTaskCompletedRequestConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.RespondActivityTaskCompletedRequest,
+		{TaskToken: testdata.TaskToken, BackpressureHint: common.Float64Ptr(0.8)},
 	}
 
 	for _, original := range testCases {
@@ -2481,6 +3025,7 @@ func TestRespondActivityTaskFailedRequestConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.RespondActivityTaskFailedRequest,
+		{TaskToken: testdata.TaskToken, BackpressureHint: common.Float64Ptr(0.8)},
 	}
 
 	for _, original := range testCases {
@@ -2579,6 +3124,11 @@ func TestScheduleActivityTaskDecisionAttributesConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.ScheduleActivityTaskDecisionAttributes,
+		{ActivityID: testdata.ActivityID, RoutingKey: "routing-key"},
+		{ActivityID: testdata.ActivityID, FallbackTaskList: &types.TaskList{Name: "fallback-task-list"}},
+		{ActivityID: testdata.ActivityID, NextActivity: &types.ScheduleActivityTaskDecisionAttributes{ActivityID: "next-activity-id"}},
+		{ActivityID: testdata.ActivityID, EncryptionKeyID: "encryption-key-id"},
+		{ActivityID: testdata.ActivityID, Priority: common.Int32Ptr(5)},
 	}
 
 	for _, original := range testCases {
@@ -2607,6 +3157,7 @@ func TestServiceBusyErrorConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.ServiceBusyError,
+		{Message: testdata.ErrorMessage, RetryAfterMs: 2000},
 	}
 
 	for _, original := range testCases {
@@ -2686,6 +3237,20 @@ func TestSignalWithStartWorkflowExecutionRequestConversion(t *testing.T) {
 	}
 }
 
+func TestSignalActivityRequestConversion(t *testing.T) {
+	testCases := []*types.SignalActivityRequest{
+		nil,
+		{},
+		&testdata.SignalActivityRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromSignalActivityRequest(original)
+		roundTripObj := ToSignalActivityRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestSignalWorkflowExecutionRequestConversion(t *testing.T) {
 	testCases := []*types.SignalWorkflowExecutionRequest{
 		nil,
@@ -2775,6 +3340,7 @@ func TestStartWorkflowExecutionRequestConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.StartWorkflowExecutionRequest,
+		{WorkflowID: testdata.WorkflowID, Priority: common.Int32Ptr(5)},
 	}
 
 	for _, original := range testCases {
@@ -2957,6 +3523,7 @@ func TestTaskListStatusConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.TaskListStatus,
+		{RatePerSecond: testdata.RatePerSecond, BackpressureRatePerSecond: 5},
 	}
 
 	for _, original := range testCases {
@@ -38,6 +38,9 @@ type AddActivityTaskRequest struct {
 	ForwardedFrom                 string                    `json:"forwardedFrom,omitempty"`
 	ActivityTaskDispatchInfo      *ActivityTaskDispatchInfo `json:"activityTaskDispatchInfo,omitempty"`
 	PartitionConfig               map[string]string
+	RoutingKey                    string `json:"routingKey,omitempty"`
+	Priority                      *int32 `json:"priority,omitempty"`
+	RegionAffinity                bool   `json:"regionAffinity,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -112,6 +115,30 @@ func (v *AddActivityTaskRequest) GetPartitionConfig() (o map[string]string) {
 	return
 }
 
+// GetRoutingKey is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetRoutingKey() (o string) {
+	if v != nil {
+		return v.RoutingKey
+	}
+	return
+}
+
+// GetPriority is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+	return
+}
+
+// GetRegionAffinity is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetRegionAffinity() (o bool) {
+	if v != nil {
+		return v.RegionAffinity
+	}
+	return
+}
+
 // ActivityTaskDispatchInfo is an internal type (TBD...)
 type ActivityTaskDispatchInfo struct {
 	ScheduledEvent                  *HistoryEvent `json:"scheduledEvent,omitempty"`
@@ -121,6 +148,7 @@ type ActivityTaskDispatchInfo struct {
 	HeartbeatDetails                []byte        `json:"heartbeatDetails,omitempty"`
 	WorkflowType                    *WorkflowType `json:"workflowType,omitempty"`
 	WorkflowDomain                  string        `json:"workflowDomain,omitempty"`
+	WasCancelBeforeStart            bool          `json:"wasCancelBeforeStart,omitempty"`
 }
 
 // AddDecisionTaskRequest is an internal type (TBD...)
@@ -201,6 +229,28 @@ func (v *AddDecisionTaskRequest) GetPartitionConfig() (o map[string]string) {
 
 type AddActivityTaskResponse struct {
 	PartitionConfig *TaskListPartitionConfig
+	// VisibilityTimeoutSeconds is the visibility timeout matching applies when the task is sync matched
+	// with ActivityTaskDispatchInfo, 0 if disabled
+	VisibilityTimeoutSeconds int32
+	// StealThreshold is the fraction of the heartbeat timeout after which matching offers the task to another poller
+	// when it is sync matched with ActivityTaskDispatchInfo, 0 if disabled
+	StealThreshold float64
+}
+
+// GetVisibilityTimeoutSeconds is an internal getter (TBD...)
+func (v *AddActivityTaskResponse) GetVisibilityTimeoutSeconds() (o int32) {
+	if v != nil {
+		return v.VisibilityTimeoutSeconds
+	}
+	return
+}
+
+// GetStealThreshold is an internal getter (TBD...)
+func (v *AddActivityTaskResponse) GetStealThreshold() (o float64) {
+	if v != nil {
+		return v.StealThreshold
+	}
+	return
 }
 
 type AddDecisionTaskResponse struct {
@@ -247,6 +297,38 @@ func (v *CancelOutstandingPollRequest) GetPollerID() (o string) {
 	return
 }
 
+// MatchingRecordBackpressureHintRequest is an internal type (TBD...)
+type MatchingRecordBackpressureHintRequest struct {
+	DomainUUID string    `json:"domainUUID,omitempty"`
+	TaskList   *TaskList `json:"taskList,omitempty"`
+	// Hint is the saturation reported by the worker, from 0 (healthy) to 1 (saturated)
+	Hint float64 `json:"hint,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingRecordBackpressureHintRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *MatchingRecordBackpressureHintRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetHint is an internal getter (TBD...)
+func (v *MatchingRecordBackpressureHintRequest) GetHint() (o float64) {
+	if v != nil {
+		return v.Hint
+	}
+	return
+}
+
 // MatchingDescribeTaskListRequest is an internal type (TBD...)
 type MatchingDescribeTaskListRequest struct {
 	DomainUUID  string                   `json:"domainUUID,omitempty"`
@@ -311,6 +393,7 @@ type MatchingPollForActivityTaskRequest struct {
 	PollRequest    *PollForActivityTaskRequest `json:"pollRequest,omitempty"`
 	ForwardedFrom  string                      `json:"forwardedFrom,omitempty"`
 	IsolationGroup string
+	SourceCluster  string `json:"sourceCluster,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -361,6 +444,14 @@ func (v *MatchingPollForActivityTaskRequest) GetIsolationGroup() (o string) {
 	return
 }
 
+// GetSourceCluster is an internal getter (TBD...)
+func (v *MatchingPollForActivityTaskRequest) GetSourceCluster() (o string) {
+	if v != nil {
+		return v.SourceCluster
+	}
+	return
+}
+
 // MatchingPollForDecisionTaskRequest is an internal type (TBD...)
 type MatchingPollForDecisionTaskRequest struct {
 	DomainUUID     string                      `json:"domainUUID,omitempty"`
@@ -451,6 +542,16 @@ type MatchingPollForDecisionTaskResponse struct {
 	PartitionConfig           *TaskListPartitionConfig
 	LoadBalancerHints         *LoadBalancerHints
 	AutoConfigHint            *AutoConfigHint
+	// ActivityBatchCompleted is set when an activity batch completed since the last completed decision
+	ActivityBatchCompleted bool `json:"activityBatchCompleted,omitempty"`
+}
+
+// GetActivityBatchCompleted is an internal getter (TBD...)
+func (v *MatchingPollForDecisionTaskResponse) GetActivityBatchCompleted() (o bool) {
+	if v != nil {
+		return v.ActivityBatchCompleted
+	}
+	return
 }
 
 // GetWorkflowExecution is an internal getter (TBD...)
@@ -522,6 +623,19 @@ type MatchingPollForActivityTaskResponse struct {
 	PartitionConfig                 *TaskListPartitionConfig
 	LoadBalancerHints               *LoadBalancerHints
 	AutoConfigHint                  *AutoConfigHint
+	WasCancelBeforeStart            bool `json:"wasCancelBeforeStart,omitempty"`
+	// PrefetchedTasks are the tasks leased to the poller when the poll asked for PrefetchCount tasks
+	PrefetchedTasks []*MatchingPollForActivityTaskResponse `json:"prefetchedTasks,omitempty"`
+	// ExpectedResultSizeBytes is the advisory result size hint the activity was scheduled with
+	ExpectedResultSizeBytes *int64 `json:"expectedResultSizeBytes,omitempty"`
+}
+
+// GetExpectedResultSizeBytes is an internal getter (TBD...)
+func (v *MatchingPollForActivityTaskResponse) GetExpectedResultSizeBytes() (o int64) {
+	if v != nil && v.ExpectedResultSizeBytes != nil {
+		return *v.ExpectedResultSizeBytes
+	}
+	return
 }
 
 // MatchingQueryWorkflowRequest is an internal type (TBD...)
@@ -691,3 +805,88 @@ type LoadBalancerHints struct {
 	BacklogCount  int64
 	RatePerSecond float64
 }
+
+// MatchingRemoveTaskListTaskRequest drops a single activity task from the backlog of a task list, the task is
+// identified either by its TaskID or by the WorkflowExecution and ScheduleID of the activity.
+// It is a recovery tool for tasks wedging a task list, the workflow is not updated and the activity is
+// only scheduled again once it times out.
+type MatchingRemoveTaskListTaskRequest struct {
+	DomainUUID        string
+	TaskList          *TaskList
+	TaskID            int64
+	WorkflowExecution *WorkflowExecution
+	ScheduleID        int64
+	// Operator and Reason are required, they are logged with the removed task
+	Operator string
+	Reason   string
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingRemoveTaskListTaskRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *MatchingRemoveTaskListTaskRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskID is an internal getter (TBD...)
+func (v *MatchingRemoveTaskListTaskRequest) GetTaskID() (o int64) {
+	if v != nil {
+		return v.TaskID
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *MatchingRemoveTaskListTaskRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *MatchingRemoveTaskListTaskRequest) GetScheduleID() (o int64) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetOperator is an internal getter (TBD...)
+func (v *MatchingRemoveTaskListTaskRequest) GetOperator() (o string) {
+	if v != nil {
+		return v.Operator
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *MatchingRemoveTaskListTaskRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// MatchingRemoveTaskListTaskResponse is an internal type (TBD...)
+type MatchingRemoveTaskListTaskResponse struct {
+	// TaskID of the removed task
+	TaskID int64
+}
+
+// GetTaskID is an internal getter (TBD...)
+func (v *MatchingRemoveTaskListTaskResponse) GetTaskID() (o int64) {
+	if v != nil {
+		return v.TaskID
+	}
+	return
+}
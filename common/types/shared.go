@@ -32,6 +32,185 @@ type AccessDeniedError struct {
 	Message string `json:"message,required"`
 }
 
+// ActivityDependencyFailurePolicy is what history does with an activity scheduled with DependsOnActivityID when
+// the activity it depends on fails, times out or is canceled
+type ActivityDependencyFailurePolicy int32
+
+// Ptr is a helper function for getting pointer value
+func (e ActivityDependencyFailurePolicy) Ptr() *ActivityDependencyFailurePolicy {
+	return &e
+}
+
+// String returns a readable string representation of ActivityDependencyFailurePolicy.
+func (e ActivityDependencyFailurePolicy) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "FAIL"
+	case 1:
+		return "SKIP"
+	}
+	return fmt.Sprintf("ActivityDependencyFailurePolicy(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *ActivityDependencyFailurePolicy) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "FAIL":
+		*e = ActivityDependencyFailurePolicyFail
+		return nil
+	case "SKIP":
+		*e = ActivityDependencyFailurePolicySkip
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ActivityDependencyFailurePolicy", err)
+		}
+		*e = ActivityDependencyFailurePolicy(val)
+		return nil
+	}
+}
+
+// MarshalText encodes ActivityDependencyFailurePolicy to text.
+func (e ActivityDependencyFailurePolicy) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// ActivityDependencyFailurePolicyFail fails the activity with FailureReasonActivityDependencyFailed, without retrying it
+	ActivityDependencyFailurePolicyFail ActivityDependencyFailurePolicy = iota
+	// ActivityDependencyFailurePolicySkip cancels the activity without dispatching it
+	ActivityDependencyFailurePolicySkip
+)
+
+// ActivityDispatchWindow is a time of day range an activity is only dispatched to workers in.
+// Holding an activity back until the window opens doesn't suspend its ScheduleToStart and ScheduleToClose
+// timeouts: an activity whose timeouts expire before the window opens times out without being dispatched.
+type ActivityDispatchWindow struct {
+	// StartTimeOfDay is the time the window opens, formatted as HH:MM
+	StartTimeOfDay string `json:"startTimeOfDay,omitempty"`
+	// EndTimeOfDay is the time the window closes, formatted as HH:MM. A window ending before it starts spans midnight
+	EndTimeOfDay string `json:"endTimeOfDay,omitempty"`
+	// Timezone is the IANA name of the timezone of the window, UTC if empty
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// GetStartTimeOfDay is an internal getter (TBD...)
+func (v *ActivityDispatchWindow) GetStartTimeOfDay() (o string) {
+	if v != nil {
+		return v.StartTimeOfDay
+	}
+	return
+}
+
+// GetEndTimeOfDay is an internal getter (TBD...)
+func (v *ActivityDispatchWindow) GetEndTimeOfDay() (o string) {
+	if v != nil {
+		return v.EndTimeOfDay
+	}
+	return
+}
+
+// GetTimezone is an internal getter (TBD...)
+func (v *ActivityDispatchWindow) GetTimezone() (o string) {
+	if v != nil {
+		return v.Timezone
+	}
+	return
+}
+
+// ActivityResultSearchAttribute extracts a value out of the JSON result of a completed activity into a search
+// attribute of its workflow. History records the extracted values on the ActivityTaskCompleted event, replaying
+// the history never evaluates the path again.
+type ActivityResultSearchAttribute struct {
+	// JSONPath locates the value in the result: $ followed by .name and [n] segments, e.g. $.order.items[0].id
+	JSONPath string `json:"jsonPath,omitempty"`
+	// SearchAttributeKey is the search attribute the JSON encoding of the value is upserted into
+	SearchAttributeKey string `json:"searchAttributeKey,omitempty"`
+}
+
+// GetJSONPath is an internal getter (TBD...)
+func (v *ActivityResultSearchAttribute) GetJSONPath() (o string) {
+	if v != nil {
+		return v.JSONPath
+	}
+	return
+}
+
+// GetSearchAttributeKey is an internal getter (TBD...)
+func (v *ActivityResultSearchAttribute) GetSearchAttributeKey() (o string) {
+	if v != nil {
+		return v.SearchAttributeKey
+	}
+	return
+}
+
+// ActivityFailureCategory is an internal type (TBD...)
+type ActivityFailureCategory int32
+
+// Ptr is a helper function for getting pointer value
+func (e ActivityFailureCategory) Ptr() *ActivityFailureCategory {
+	return &e
+}
+
+// String returns a readable string representation of ActivityFailureCategory.
+func (e ActivityFailureCategory) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "TRANSIENT"
+	case 1:
+		return "PERMANENT"
+	case 2:
+		return "THROTTLED"
+	case 3:
+		return "INFRA"
+	}
+	return fmt.Sprintf("ActivityFailureCategory(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *ActivityFailureCategory) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "TRANSIENT":
+		*e = ActivityFailureCategoryTransient
+		return nil
+	case "PERMANENT":
+		*e = ActivityFailureCategoryPermanent
+		return nil
+	case "THROTTLED":
+		*e = ActivityFailureCategoryThrottled
+		return nil
+	case "INFRA":
+		*e = ActivityFailureCategoryInfra
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ActivityFailureCategory", err)
+		}
+		*e = ActivityFailureCategory(val)
+		return nil
+	}
+}
+
+// MarshalText encodes ActivityFailureCategory to text.
+func (e ActivityFailureCategory) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// ActivityFailureCategoryTransient is an option for ActivityFailureCategory
+	ActivityFailureCategoryTransient ActivityFailureCategory = iota
+	// ActivityFailureCategoryPermanent is an option for ActivityFailureCategory
+	ActivityFailureCategoryPermanent
+	// ActivityFailureCategoryThrottled is an option for ActivityFailureCategory
+	ActivityFailureCategoryThrottled
+	// ActivityFailureCategoryInfra is an option for ActivityFailureCategory
+	ActivityFailureCategoryInfra
+)
+
 // ActivityLocalDispatchInfo is an internal type (TBD...)
 type ActivityLocalDispatchInfo struct {
 	ActivityID                      string `json:"activityId,omitempty"`
@@ -41,51 +220,473 @@ type ActivityLocalDispatchInfo struct {
 	TaskToken                       []byte `json:"taskToken,omitempty"`
 }
 
-// GetScheduledTimestamp is an internal getter (TBD...)
-func (v *ActivityLocalDispatchInfo) GetScheduledTimestamp() (o int64) {
-	if v != nil && v.ScheduledTimestamp != nil {
-		return *v.ScheduledTimestamp
+// GetScheduledTimestamp is an internal getter (TBD...)
+func (v *ActivityLocalDispatchInfo) GetScheduledTimestamp() (o int64) {
+	if v != nil && v.ScheduledTimestamp != nil {
+		return *v.ScheduledTimestamp
+	}
+	return
+}
+
+// ActivityRescheduleCause is an internal type (TBD...)
+type ActivityRescheduleCause int32
+
+// Ptr is a helper function for getting pointer value
+func (e ActivityRescheduleCause) Ptr() *ActivityRescheduleCause {
+	return &e
+}
+
+// String returns a readable string representation of ActivityRescheduleCause.
+func (e ActivityRescheduleCause) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "FAILURE"
+	case 1:
+		return "HEARTBEAT_TIMEOUT"
+	case 2:
+		return "START_TO_CLOSE_TIMEOUT"
+	case 3:
+		return "SCHEDULE_TO_START_TIMEOUT"
+	}
+	return fmt.Sprintf("ActivityRescheduleCause(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *ActivityRescheduleCause) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "FAILURE":
+		*e = ActivityRescheduleCauseFailure
+		return nil
+	case "HEARTBEAT_TIMEOUT":
+		*e = ActivityRescheduleCauseHeartbeatTimeout
+		return nil
+	case "START_TO_CLOSE_TIMEOUT":
+		*e = ActivityRescheduleCauseStartToCloseTimeout
+		return nil
+	case "SCHEDULE_TO_START_TIMEOUT":
+		*e = ActivityRescheduleCauseScheduleToStartTimeout
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ActivityRescheduleCause", err)
+		}
+		*e = ActivityRescheduleCause(val)
+		return nil
+	}
+}
+
+// MarshalText encodes ActivityRescheduleCause to text.
+func (e ActivityRescheduleCause) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// ActivityRescheduleCauseFailure is an option for ActivityRescheduleCause
+	ActivityRescheduleCauseFailure ActivityRescheduleCause = iota
+	// ActivityRescheduleCauseHeartbeatTimeout is an option for ActivityRescheduleCause
+	ActivityRescheduleCauseHeartbeatTimeout
+	// ActivityRescheduleCauseStartToCloseTimeout is an option for ActivityRescheduleCause
+	ActivityRescheduleCauseStartToCloseTimeout
+	// ActivityRescheduleCauseScheduleToStartTimeout is an option for ActivityRescheduleCause
+	ActivityRescheduleCauseScheduleToStartTimeout
+)
+
+// ActivityMaintenancePause records how the maintenance windows of the domain suspended the heartbeat and
+// StartToClose timeouts of an activity attempt. The ScheduleToClose timeout is never suspended.
+type ActivityMaintenancePause struct {
+	// TimeoutsDeferred is the number of timeouts which fired during a window and were deferred past its end
+	TimeoutsDeferred int32 `json:"timeoutsDeferred,omitempty"`
+	// StartToCloseExtensionSeconds is the time spent in windows which was added to the StartToClose timeout
+	StartToCloseExtensionSeconds int64 `json:"startToCloseExtensionSeconds,omitempty"`
+}
+
+// GetTimeoutsDeferred is an internal getter (TBD...)
+func (v *ActivityMaintenancePause) GetTimeoutsDeferred() (o int32) {
+	if v != nil {
+		return v.TimeoutsDeferred
+	}
+	return
+}
+
+// GetStartToCloseExtensionSeconds is an internal getter (TBD...)
+func (v *ActivityMaintenancePause) GetStartToCloseExtensionSeconds() (o int64) {
+	if v != nil {
+		return v.StartToCloseExtensionSeconds
+	}
+	return
+}
+
+// ActivityRescheduleReason is an internal type (TBD...)
+type ActivityRescheduleReason struct {
+	// Attempt is the attempt which ended and caused the activity to be scheduled again
+	Attempt   int32                    `json:"attempt,omitempty"`
+	Cause     *ActivityRescheduleCause `json:"cause,omitempty"`
+	Timestamp *int64                   `json:"timestamp,omitempty"`
+	// Identity is the worker which started the attempt, empty if it was never started
+	Identity string `json:"identity,omitempty"`
+	// StartedTimestamp is when the attempt was started, nil if it was never started
+	StartedTimestamp *int64 `json:"startedTimestamp,omitempty"`
+	// TaskList is the task list the attempt was dispatched on
+	TaskList string `json:"taskList,omitempty"`
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *ActivityRescheduleReason) GetTaskList() (o string) {
+	if v != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetStartedTimestamp is an internal getter (TBD...)
+func (v *ActivityRescheduleReason) GetStartedTimestamp() (o int64) {
+	if v != nil && v.StartedTimestamp != nil {
+		return *v.StartedTimestamp
+	}
+	return
+}
+
+// GetAttempt is an internal getter (TBD...)
+func (v *ActivityRescheduleReason) GetAttempt() (o int32) {
+	if v != nil {
+		return v.Attempt
+	}
+	return
+}
+
+// GetCause is an internal getter (TBD...)
+func (v *ActivityRescheduleReason) GetCause() (o ActivityRescheduleCause) {
+	if v != nil && v.Cause != nil {
+		return *v.Cause
+	}
+	return
+}
+
+// GetTimestamp is an internal getter (TBD...)
+func (v *ActivityRescheduleReason) GetTimestamp() (o int64) {
+	if v != nil && v.Timestamp != nil {
+		return *v.Timestamp
+	}
+	return
+}
+
+// ActivitySignal is an internal type (TBD...)
+type ActivitySignal struct {
+	SignalName string `json:"signalName,omitempty"`
+	Input      []byte `json:"-"` // Filtering PII
+	Identity   string `json:"identity,omitempty"`
+	Timestamp  *int64 `json:"timestamp,omitempty"`
+}
+
+// GetSignalName is an internal getter (TBD...)
+func (v *ActivitySignal) GetSignalName() (o string) {
+	if v != nil {
+		return v.SignalName
+	}
+	return
+}
+
+// GetInput is an internal getter (TBD...)
+func (v *ActivitySignal) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+	return
+}
+
+// GetTimestamp is an internal getter (TBD...)
+func (v *ActivitySignal) GetTimestamp() (o int64) {
+	if v != nil && v.Timestamp != nil {
+		return *v.Timestamp
+	}
+	return
+}
+
+// ActivityTokenValidationFailureCause is why history rejected the task token of an activity
+type ActivityTokenValidationFailureCause int32
+
+// Ptr is a helper function for getting pointer value
+func (e ActivityTokenValidationFailureCause) Ptr() *ActivityTokenValidationFailureCause {
+	return &e
+}
+
+// String returns a readable string representation of ActivityTokenValidationFailureCause.
+func (e ActivityTokenValidationFailureCause) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "EXPIRED"
+	case 1:
+		return "ALREADY_COMPLETED"
+	case 2:
+		return "WRONG_RUN"
+	case 3:
+		return "MALFORMED"
+	}
+	return fmt.Sprintf("ActivityTokenValidationFailureCause(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *ActivityTokenValidationFailureCause) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "EXPIRED":
+		*e = ActivityTokenValidationFailureCauseExpired
+		return nil
+	case "ALREADY_COMPLETED":
+		*e = ActivityTokenValidationFailureCauseAlreadyCompleted
+		return nil
+	case "WRONG_RUN":
+		*e = ActivityTokenValidationFailureCauseWrongRun
+		return nil
+	case "MALFORMED":
+		*e = ActivityTokenValidationFailureCauseMalformed
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ActivityTokenValidationFailureCause", err)
+		}
+		*e = ActivityTokenValidationFailureCause(val)
+		return nil
+	}
+}
+
+// MarshalText encodes ActivityTokenValidationFailureCause to text.
+func (e ActivityTokenValidationFailureCause) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// ActivityTokenValidationFailureCauseExpired is a token of an earlier attempt of a pending activity, or of an
+	// attempt which is not started anymore
+	ActivityTokenValidationFailureCauseExpired ActivityTokenValidationFailureCause = iota
+	// ActivityTokenValidationFailureCauseAlreadyCompleted is a token of an activity which is not pending anymore
+	ActivityTokenValidationFailureCauseAlreadyCompleted
+	// ActivityTokenValidationFailureCauseWrongRun is a token of a workflow run which is closed or does not exist
+	ActivityTokenValidationFailureCauseWrongRun
+	// ActivityTokenValidationFailureCauseMalformed is a token which cannot be deserialized
+	ActivityTokenValidationFailureCauseMalformed
+)
+
+// ActivityTokenValidationFailure is a task token of an activity rejected by history
+type ActivityTokenValidationFailure struct {
+	// Operation is the API the token was passed to, e.g. RespondActivityTaskCompleted
+	Operation    string                               `json:"operation,omitempty"`
+	Cause        *ActivityTokenValidationFailureCause `json:"cause,omitempty"`
+	WorkflowID   string                               `json:"workflowID,omitempty"`
+	RunID        string                               `json:"runID,omitempty"`
+	ActivityID   string                               `json:"activityID,omitempty"`
+	ActivityType string                               `json:"activityType,omitempty"`
+	ScheduleID   int64                                `json:"scheduleID,omitempty"`
+	// ScheduleAttempt is the attempt the token was issued for
+	ScheduleAttempt int64  `json:"scheduleAttempt,omitempty"`
+	Timestamp       *int64 `json:"timestamp,omitempty"`
+}
+
+// GetOperation is an internal getter (TBD...)
+func (v *ActivityTokenValidationFailure) GetOperation() (o string) {
+	if v != nil {
+		return v.Operation
+	}
+	return
+}
+
+// GetCause is an internal getter (TBD...)
+func (v *ActivityTokenValidationFailure) GetCause() (o ActivityTokenValidationFailureCause) {
+	if v != nil && v.Cause != nil {
+		return *v.Cause
+	}
+	return
+}
+
+// GetActivityType is an internal getter (TBD...)
+func (v *ActivityTokenValidationFailure) GetActivityType() (o string) {
+	if v != nil {
+		return v.ActivityType
+	}
+	return
+}
+
+// GetTimestamp is an internal getter (TBD...)
+func (v *ActivityTokenValidationFailure) GetTimestamp() (o int64) {
+	if v != nil && v.Timestamp != nil {
+		return *v.Timestamp
+	}
+	return
+}
+
+// ActivityTaskCancelRequestedEventAttributes is an internal type (TBD...)
+type ActivityTaskCancelRequestedEventAttributes struct {
+	ActivityID                   string `json:"activityId,omitempty"`
+	DecisionTaskCompletedEventID int64  `json:"decisionTaskCompletedEventId,omitempty"`
+	// ReplacementActivityID is set when the cancellation was requested by a ReplaceActivityTask decision,
+	// it links the cancelled activity to the activity scheduled in its place
+	ReplacementActivityID string `json:"replacementActivityId,omitempty"`
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *ActivityTaskCancelRequestedEventAttributes) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetReplacementActivityID is an internal getter (TBD...)
+func (v *ActivityTaskCancelRequestedEventAttributes) GetReplacementActivityID() (o string) {
+	if v != nil {
+		return v.ReplacementActivityID
+	}
+	return
+}
+
+// ActivityTaskCanceledEventAttributes is an internal type (TBD...)
+type ActivityTaskCanceledEventAttributes struct {
+	Details                      []byte `json:"details,omitempty"`
+	LatestCancelRequestedEventID int64  `json:"latestCancelRequestedEventId,omitempty"`
+	ScheduledEventID             int64  `json:"scheduledEventId,omitempty"`
+	StartedEventID               int64  `json:"startedEventId,omitempty"`
+	Identity                     string `json:"identity,omitempty"`
+	// AttemptChainID is shared by the events of all attempts of the same activity
+	AttemptChainID string `json:"attemptChainId,omitempty"`
+	// MaintenancePause is set if maintenance windows suspended the timeouts of the attempt
+	MaintenancePause *ActivityMaintenancePause `json:"maintenancePause,omitempty"`
+}
+
+// GetMaintenancePause is an internal getter (TBD...)
+func (v *ActivityTaskCanceledEventAttributes) GetMaintenancePause() (o *ActivityMaintenancePause) {
+	if v != nil {
+		return v.MaintenancePause
+	}
+	return
+}
+
+// GetAttemptChainID is an internal getter (TBD...)
+func (v *ActivityTaskCanceledEventAttributes) GetAttemptChainID() (o string) {
+	if v != nil {
+		return v.AttemptChainID
+	}
+	return
+}
+
+// GetScheduledEventID is an internal getter (TBD...)
+func (v *ActivityTaskCanceledEventAttributes) GetScheduledEventID() (o int64) {
+	if v != nil {
+		return v.ScheduledEventID
+	}
+	return
+}
+
+// ActivityTaskCompletedEventAttributes is an internal type (TBD...)
+type ActivityTaskCompletedEventAttributes struct {
+	Result           []byte `json:"result,omitempty"`
+	ScheduledEventID int64  `json:"scheduledEventId,omitempty"`
+	StartedEventID   int64  `json:"startedEventId,omitempty"`
+	Identity         string `json:"identity,omitempty"`
+	// AttemptChainID is shared by the events of all attempts of the same activity
+	AttemptChainID string `json:"attemptChainId,omitempty"`
+	// EncryptionKeyID is copied from the scheduled event, Result is encrypted with this key
+	EncryptionKeyID string `json:"encryptionKeyId,omitempty"`
+	// ResultReferenceScheduledEventID, when set, is the scheduled event ID of an earlier completed activity of
+	// the same run whose result is identical, Result is left empty. GetWorkflowExecutionHistory resolves it.
+	ResultReferenceScheduledEventID int64 `json:"resultReferenceScheduledEventId,omitempty"`
+	// Synthesized is true when the result is the OnTimeoutDefaultResult of the activity recorded by history on a timeout, rather than a result reported by a worker
+	Synthesized bool `json:"synthesized,omitempty"`
+	// TimeoutType is the timeout a Synthesized result was recorded for
+	TimeoutType *TimeoutType `json:"timeoutType,omitempty"`
+	// ResultSchemaVersion is copied from the completion request so that deciders can branch on the format of Result during replay
+	ResultSchemaVersion *int32 `json:"resultSchemaVersion,omitempty"`
+	// MaintenancePause is set if maintenance windows suspended the timeouts of the attempt
+	MaintenancePause *ActivityMaintenancePause `json:"maintenancePause,omitempty"`
+	// IncrementedCounter is the workflow counter incremented by the completion, unset if it was over the counter limit
+	IncrementedCounter string `json:"incrementedCounter,omitempty"`
+	// ResultBlobKey, when set, is the blobstore key the result was written to as it was above the archival
+	// threshold of the domain, Result is left empty. GetWorkflowExecutionHistory resolves it.
+	ResultBlobKey string `json:"resultBlobKey,omitempty"`
+	// ResultSearchAttributes are the values extracted from Result by the ResultSearchAttributes of the scheduled event, upserted into the search attributes of the workflow
+	ResultSearchAttributes *SearchAttributes `json:"-"` // Filtering PII
+}
+
+// GetResultSearchAttributes is an internal getter (TBD...)
+func (v *ActivityTaskCompletedEventAttributes) GetResultSearchAttributes() (o *SearchAttributes) {
+	if v != nil {
+		return v.ResultSearchAttributes
+	}
+	return
+}
+
+// GetResultBlobKey is an internal getter (TBD...)
+func (v *ActivityTaskCompletedEventAttributes) GetResultBlobKey() (o string) {
+	if v != nil {
+		return v.ResultBlobKey
+	}
+	return
+}
+
+// GetIncrementedCounter is an internal getter (TBD...)
+func (v *ActivityTaskCompletedEventAttributes) GetIncrementedCounter() (o string) {
+	if v != nil {
+		return v.IncrementedCounter
+	}
+	return
+}
+
+// GetMaintenancePause is an internal getter (TBD...)
+func (v *ActivityTaskCompletedEventAttributes) GetMaintenancePause() (o *ActivityMaintenancePause) {
+	if v != nil {
+		return v.MaintenancePause
+	}
+	return
+}
+
+// GetResultSchemaVersion is an internal getter (TBD...)
+func (v *ActivityTaskCompletedEventAttributes) GetResultSchemaVersion() (o int32) {
+	if v != nil && v.ResultSchemaVersion != nil {
+		return *v.ResultSchemaVersion
 	}
 	return
 }
 
-// ActivityTaskCancelRequestedEventAttributes is an internal type (TBD...)
-type ActivityTaskCancelRequestedEventAttributes struct {
-	ActivityID                   string `json:"activityId,omitempty"`
-	DecisionTaskCompletedEventID int64  `json:"decisionTaskCompletedEventId,omitempty"`
+// GetTimeoutType is an internal getter (TBD...)
+func (v *ActivityTaskCompletedEventAttributes) GetTimeoutType() (o *TimeoutType) {
+	if v != nil {
+		return v.TimeoutType
+	}
+	return
 }
 
-// GetActivityID is an internal getter (TBD...)
-func (v *ActivityTaskCancelRequestedEventAttributes) GetActivityID() (o string) {
+// GetSynthesized is an internal getter (TBD...)
+func (v *ActivityTaskCompletedEventAttributes) GetSynthesized() (o bool) {
 	if v != nil {
-		return v.ActivityID
+		return v.Synthesized
 	}
 	return
 }
 
-// ActivityTaskCanceledEventAttributes is an internal type (TBD...)
-type ActivityTaskCanceledEventAttributes struct {
-	Details                      []byte `json:"details,omitempty"`
-	LatestCancelRequestedEventID int64  `json:"latestCancelRequestedEventId,omitempty"`
-	ScheduledEventID             int64  `json:"scheduledEventId,omitempty"`
-	StartedEventID               int64  `json:"startedEventId,omitempty"`
-	Identity                     string `json:"identity,omitempty"`
+// GetResultReferenceScheduledEventID is an internal getter (TBD...)
+func (v *ActivityTaskCompletedEventAttributes) GetResultReferenceScheduledEventID() (o int64) {
+	if v != nil {
+		return v.ResultReferenceScheduledEventID
+	}
+	return
 }
 
-// GetScheduledEventID is an internal getter (TBD...)
-func (v *ActivityTaskCanceledEventAttributes) GetScheduledEventID() (o int64) {
+// GetEncryptionKeyID is an internal getter (TBD...)
+func (v *ActivityTaskCompletedEventAttributes) GetEncryptionKeyID() (o string) {
 	if v != nil {
-		return v.ScheduledEventID
+		return v.EncryptionKeyID
 	}
 	return
 }
 
-// ActivityTaskCompletedEventAttributes is an internal type (TBD...)
-type ActivityTaskCompletedEventAttributes struct {
-	Result           []byte `json:"result,omitempty"`
-	ScheduledEventID int64  `json:"scheduledEventId,omitempty"`
-	StartedEventID   int64  `json:"startedEventId,omitempty"`
-	Identity         string `json:"identity,omitempty"`
+// GetAttemptChainID is an internal getter (TBD...)
+func (v *ActivityTaskCompletedEventAttributes) GetAttemptChainID() (o string) {
+	if v != nil {
+		return v.AttemptChainID
+	}
+	return
 }
 
 // GetScheduledEventID is an internal getter (TBD...)
@@ -111,6 +712,36 @@ type ActivityTaskFailedEventAttributes struct {
 	ScheduledEventID int64   `json:"scheduledEventId,omitempty"`
 	StartedEventID   int64   `json:"startedEventId,omitempty"`
 	Identity         string  `json:"identity,omitempty"`
+	// FailureCategory is the category the worker reported for the failure, nil if none was reported
+	FailureCategory *ActivityFailureCategory `json:"failureCategory,omitempty"`
+	// AttemptChainID is shared by the events of all attempts of the same activity
+	AttemptChainID string `json:"attemptChainId,omitempty"`
+	// MaintenancePause is set if maintenance windows suspended the timeouts of the attempt
+	MaintenancePause *ActivityMaintenancePause `json:"maintenancePause,omitempty"`
+}
+
+// GetMaintenancePause is an internal getter (TBD...)
+func (v *ActivityTaskFailedEventAttributes) GetMaintenancePause() (o *ActivityMaintenancePause) {
+	if v != nil {
+		return v.MaintenancePause
+	}
+	return
+}
+
+// GetAttemptChainID is an internal getter (TBD...)
+func (v *ActivityTaskFailedEventAttributes) GetAttemptChainID() (o string) {
+	if v != nil {
+		return v.AttemptChainID
+	}
+	return
+}
+
+// GetFailureCategory is an internal getter (TBD...)
+func (v *ActivityTaskFailedEventAttributes) GetFailureCategory() (o ActivityFailureCategory) {
+	if v != nil && v.FailureCategory != nil {
+		return *v.FailureCategory
+	}
+	return
 }
 
 // GetScheduledEventID is an internal getter (TBD...)
@@ -143,6 +774,277 @@ type ActivityTaskScheduledEventAttributes struct {
 	DecisionTaskCompletedEventID  int64         `json:"decisionTaskCompletedEventId,omitempty"`
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
+	Priority                      *int32        `json:"priority,omitempty"`
+	// FirstAttemptStartToCloseSeconds overrides StartToCloseTimeoutSeconds for the first attempt only
+	FirstAttemptStartToCloseSeconds *int32 `json:"firstAttemptStartToCloseSeconds,omitempty"`
+	// SearchAttributes are upserted into visibility while the activity is running
+	SearchAttributes *SearchAttributes `json:"-"` // Filtering PII
+	// AttemptChainID is shared by the events of all attempts of the same activity
+	AttemptChainID string `json:"attemptChainId,omitempty"`
+	// ParentInitiatedChildID is copied from the decision, activity timelines list the activity (and all its attempts)
+	// under the child workflow started by the StartChildWorkflowExecutionInitiated event with this ID
+	ParentInitiatedChildID *int64 `json:"parentInitiatedChildId,omitempty"`
+	// RequiredCapabilities are copied from the decision
+	RequiredCapabilities []string `json:"requiredCapabilities,omitempty"`
+	// FallbackTaskList is copied from the decision scheduling the activity
+	FallbackTaskList *TaskList `json:"fallbackTaskList,omitempty"`
+	// NextActivity is copied from the decision scheduling the activity
+	NextActivity *ScheduleActivityTaskDecisionAttributes `json:"nextActivity,omitempty"`
+	// ChainedFromScheduledEventID is the scheduled event ID of the activity whose result was forwarded as the input
+	// of this activity
+	ChainedFromScheduledEventID *int64 `json:"chainedFromScheduledEventId,omitempty"`
+	// EncryptionKeyID is copied from the decision, Input is encrypted with this key
+	EncryptionKeyID string `json:"encryptionKeyId,omitempty"`
+	// OrderedDispatch is copied from the decision
+	OrderedDispatch bool `json:"orderedDispatch,omitempty"`
+	// Idempotent is copied from the decision
+	Idempotent bool `json:"idempotent,omitempty"`
+	// RegionAffinity is copied from the decision
+	RegionAffinity bool `json:"regionAffinity,omitempty"`
+	// OnTimeoutDefaultResult is copied from the decision
+	OnTimeoutDefaultResult []byte `json:"onTimeoutDefaultResult,omitempty"`
+	// WeightedSelection is set when the activity was scheduled by a ScheduleWeightedActivity decision, it
+	// records the candidates and the seed ActivityType was selected with
+	WeightedSelection *WeightedActivitySelection `json:"weightedSelection,omitempty"`
+	// DispatchWindow is copied from the decision, with the timezone resolved
+	DispatchWindow *ActivityDispatchWindow `json:"dispatchWindow,omitempty"`
+	// CancellationCheckpointGraceSeconds is copied from the decision, 0 if history does not wait for a final checkpoint
+	CancellationCheckpointGraceSeconds *int32 `json:"cancellationCheckpointGraceSeconds,omitempty"`
+	// Tag of the kill switch holding the dispatch of the activity while it is on
+	KillSwitchTag string `json:"killSwitchTag,omitempty"`
+	// AtMostOnce is copied from the decision
+	AtMostOnce bool `json:"atMostOnce,omitempty"`
+	// AlertOnFailure is copied from the decision
+	AlertOnFailure string `json:"alertOnFailure,omitempty"`
+	// DependsOnActivityID is copied from the decision
+	DependsOnActivityID string `json:"dependsOnActivityID,omitempty"`
+	// OnDependencyFailure is copied from the decision
+	OnDependencyFailure *ActivityDependencyFailurePolicy `json:"onDependencyFailure,omitempty"`
+	// ExpectedResultSizeBytes is copied from the decision
+	ExpectedResultSizeBytes *int64 `json:"expectedResultSizeBytes,omitempty"`
+	// ResultSearchAttributes is copied from the decision
+	ResultSearchAttributes []*ActivityResultSearchAttribute `json:"resultSearchAttributes,omitempty"`
+	// TaskListEscalation is copied from the decision
+	TaskListEscalation []*TaskList `json:"taskListEscalation,omitempty"`
+	// RoutingKey is copied from the decision
+	RoutingKey string `json:"routingKey,omitempty"`
+}
+
+// GetTaskListEscalation is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetTaskListEscalation() (o []*TaskList) {
+	if v != nil {
+		return v.TaskListEscalation
+	}
+	return
+}
+
+// GetRoutingKey is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetRoutingKey() (o string) {
+	if v != nil {
+		return v.RoutingKey
+	}
+	return
+}
+
+// GetResultSearchAttributes is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetResultSearchAttributes() (o []*ActivityResultSearchAttribute) {
+	if v != nil {
+		return v.ResultSearchAttributes
+	}
+	return
+}
+
+// GetExpectedResultSizeBytes is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetExpectedResultSizeBytes() (o int64) {
+	if v != nil && v.ExpectedResultSizeBytes != nil {
+		return *v.ExpectedResultSizeBytes
+	}
+	return
+}
+
+// GetOnDependencyFailure is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetOnDependencyFailure() (o ActivityDependencyFailurePolicy) {
+	if v != nil && v.OnDependencyFailure != nil {
+		return *v.OnDependencyFailure
+	}
+	return
+}
+
+// GetDependsOnActivityID is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetDependsOnActivityID() (o string) {
+	if v != nil {
+		return v.DependsOnActivityID
+	}
+	return
+}
+
+// GetAlertOnFailure is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetAlertOnFailure() (o string) {
+	if v != nil {
+		return v.AlertOnFailure
+	}
+	return
+}
+
+// GetAtMostOnce is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetAtMostOnce() (o bool) {
+	if v != nil {
+		return v.AtMostOnce
+	}
+	return
+}
+
+// GetKillSwitchTag is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetKillSwitchTag() (o string) {
+	if v != nil {
+		return v.KillSwitchTag
+	}
+	return
+}
+
+// GetCancellationCheckpointGraceSeconds is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetCancellationCheckpointGraceSeconds() (o int32) {
+	if v != nil && v.CancellationCheckpointGraceSeconds != nil {
+		return *v.CancellationCheckpointGraceSeconds
+	}
+	return
+}
+
+// GetDispatchWindow is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetDispatchWindow() (o *ActivityDispatchWindow) {
+	if v != nil {
+		return v.DispatchWindow
+	}
+	return
+}
+
+// GetWeightedSelection is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetWeightedSelection() (o *WeightedActivitySelection) {
+	if v != nil {
+		return v.WeightedSelection
+	}
+	return
+}
+
+// GetOnTimeoutDefaultResult is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetOnTimeoutDefaultResult() (o []byte) {
+	if v != nil {
+		return v.OnTimeoutDefaultResult
+	}
+	return
+}
+
+// GetRegionAffinity is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetRegionAffinity() (o bool) {
+	if v != nil {
+		return v.RegionAffinity
+	}
+	return
+}
+
+// GetIdempotent is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetIdempotent() (o bool) {
+	if v != nil {
+		return v.Idempotent
+	}
+	return
+}
+
+// GetOrderedDispatch is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetOrderedDispatch() (o bool) {
+	if v != nil {
+		return v.OrderedDispatch
+	}
+	return
+}
+
+// GetEncryptionKeyID is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetEncryptionKeyID() (o string) {
+	if v != nil {
+		return v.EncryptionKeyID
+	}
+	return
+}
+
+// GetChainedFromScheduledEventID is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetChainedFromScheduledEventID() (o int64) {
+	if v != nil && v.ChainedFromScheduledEventID != nil {
+		return *v.ChainedFromScheduledEventID
+	}
+	return
+}
+
+// GetNextActivity is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetNextActivity() (o *ScheduleActivityTaskDecisionAttributes) {
+	if v != nil {
+		return v.NextActivity
+	}
+	return
+}
+
+// GetFallbackTaskList is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetFallbackTaskList() (o *TaskList) {
+	if v != nil {
+		return v.FallbackTaskList
+	}
+	return
+}
+
+// GetRequiredCapabilities is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetRequiredCapabilities() (o []string) {
+	if v != nil {
+		return v.RequiredCapabilities
+	}
+	return
+}
+
+// GetParentInitiatedChildID is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetParentInitiatedChildID() (o int64) {
+	if v != nil && v.ParentInitiatedChildID != nil {
+		return *v.ParentInitiatedChildID
+	}
+	return
+}
+
+// GetAttemptChainID is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetAttemptChainID() (o string) {
+	if v != nil {
+		return v.AttemptChainID
+	}
+	return
+}
+
+// GetSearchAttributes is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+	return
+}
+
+// GetPriority is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+	return
+}
+
+// GetFirstAttemptStartToCloseSeconds is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetFirstAttemptStartToCloseSeconds() (o int32) {
+	if v != nil && v.FirstAttemptStartToCloseSeconds != nil {
+		return *v.FirstAttemptStartToCloseSeconds
+	}
+	return
+}
+
+// GetStartToCloseTimeoutSecondsForAttempt returns the start to close timeout of the given attempt,
+// the first attempt uses FirstAttemptStartToCloseSeconds when it is set
+func (v *ActivityTaskScheduledEventAttributes) GetStartToCloseTimeoutSecondsForAttempt(attempt int64) (o int32) {
+	if attempt == 0 && v.GetFirstAttemptStartToCloseSeconds() > 0 {
+		return v.GetFirstAttemptStartToCloseSeconds()
+	}
+	return v.GetStartToCloseTimeoutSeconds()
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -217,6 +1119,57 @@ type ActivityTaskStartedEventAttributes struct {
 	Attempt            int32   `json:"attempt,omitempty"`
 	LastFailureReason  *string `json:"lastFailureReason,omitempty"`
 	LastFailureDetails []byte  `json:"lastFailureDetails,omitempty"`
+	// AttemptChainID is shared by the events of all attempts of the same activity
+	AttemptChainID string `json:"attemptChainId,omitempty"`
+	// TaskList is set when the attempt was dispatched to the fallback task list, or to a task list of the
+	// TaskListEscalation, instead of the scheduled one
+	TaskList *TaskList `json:"taskList,omitempty"`
+	// RetriedAttempts are the attempts before this one, oldest first, as far as the mutable state cache kept them
+	RetriedAttempts []*ActivityWorkerAttempt `json:"retriedAttempts,omitempty"`
+	// HeartbeatDetails and LastHeartbeatTimestamp are the latest heartbeat of the activity if it is still pending,
+	// they are never recorded and only set by GetWorkflowExecutionHistory with IncludePendingActivityHeartbeats
+	HeartbeatDetails       []byte `json:"heartbeatDetails,omitempty"`
+	LastHeartbeatTimestamp *int64 `json:"lastHeartbeatTimestamp,omitempty"`
+}
+
+// GetLastHeartbeatTimestamp is an internal getter (TBD...)
+func (v *ActivityTaskStartedEventAttributes) GetLastHeartbeatTimestamp() (o int64) {
+	if v != nil && v.LastHeartbeatTimestamp != nil {
+		return *v.LastHeartbeatTimestamp
+	}
+	return
+}
+
+// GetHeartbeatDetails is an internal getter (TBD...)
+func (v *ActivityTaskStartedEventAttributes) GetHeartbeatDetails() (o []byte) {
+	if v != nil {
+		return v.HeartbeatDetails
+	}
+	return
+}
+
+// GetRetriedAttempts is an internal getter (TBD...)
+func (v *ActivityTaskStartedEventAttributes) GetRetriedAttempts() (o []*ActivityWorkerAttempt) {
+	if v != nil {
+		return v.RetriedAttempts
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *ActivityTaskStartedEventAttributes) GetTaskList() (o *TaskList) {
+	if v != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetAttemptChainID is an internal getter (TBD...)
+func (v *ActivityTaskStartedEventAttributes) GetAttemptChainID() (o string) {
+	if v != nil {
+		return v.AttemptChainID
+	}
+	return
 }
 
 // GetScheduledEventID is an internal getter (TBD...)
@@ -243,6 +1196,46 @@ type ActivityTaskTimedOutEventAttributes struct {
 	TimeoutType        *TimeoutType `json:"timeoutType,omitempty"`
 	LastFailureReason  *string      `json:"lastFailureReason,omitempty"`
 	LastFailureDetails []byte       `json:"lastFailureDetails,omitempty"`
+	// AttemptChainID is shared by the events of all attempts of the same activity
+	AttemptChainID string `json:"attemptChainId,omitempty"`
+	// MaintenancePause is set if maintenance windows suspended the timeouts of the attempt
+	MaintenancePause *ActivityMaintenancePause `json:"maintenancePause,omitempty"`
+	// LastHeartbeatTimestamp is when the attempt heartbeated last, nil if it never did
+	LastHeartbeatTimestamp *int64 `json:"lastHeartbeatTimestamp,omitempty"`
+	// LastHeartbeatDetails are the details of the last heartbeat of the attempt, unlike Details they never carry anything specific to the timeout
+	LastHeartbeatDetails []byte `json:"lastHeartbeatDetails,omitempty"`
+}
+
+// GetLastHeartbeatDetails is an internal getter (TBD...)
+func (v *ActivityTaskTimedOutEventAttributes) GetLastHeartbeatDetails() (o []byte) {
+	if v != nil {
+		return v.LastHeartbeatDetails
+	}
+	return
+}
+
+// GetLastHeartbeatTimestamp is an internal getter (TBD...)
+func (v *ActivityTaskTimedOutEventAttributes) GetLastHeartbeatTimestamp() (o int64) {
+	if v != nil && v.LastHeartbeatTimestamp != nil {
+		return *v.LastHeartbeatTimestamp
+	}
+	return
+}
+
+// GetMaintenancePause is an internal getter (TBD...)
+func (v *ActivityTaskTimedOutEventAttributes) GetMaintenancePause() (o *ActivityMaintenancePause) {
+	if v != nil {
+		return v.MaintenancePause
+	}
+	return
+}
+
+// GetAttemptChainID is an internal getter (TBD...)
+func (v *ActivityTaskTimedOutEventAttributes) GetAttemptChainID() (o string) {
+	if v != nil {
+		return v.AttemptChainID
+	}
+	return
 }
 
 // GetScheduledEventID is an internal getter (TBD...)
@@ -353,17 +1346,52 @@ func (v *BadBinaryInfo) GetOperator() (o string) {
 	return
 }
 
-// GetCreatedTimeNano is an internal getter (TBD...)
-func (v *BadBinaryInfo) GetCreatedTimeNano() (o int64) {
-	if v != nil && v.CreatedTimeNano != nil {
-		return *v.CreatedTimeNano
+// GetCreatedTimeNano is an internal getter (TBD...)
+func (v *BadBinaryInfo) GetCreatedTimeNano() (o int64) {
+	if v != nil && v.CreatedTimeNano != nil {
+		return *v.CreatedTimeNano
+	}
+	return
+}
+
+// BadRequestError is an internal type (TBD...)
+type BadRequestError struct {
+	Message string `json:"message,required"`
+}
+
+// BatchDescribeWorkflowExecutionsRequest is an internal type (TBD...)
+type BatchDescribeWorkflowExecutionsRequest struct {
+	Domain     string               `json:"domain,omitempty"`
+	Executions []*WorkflowExecution `json:"executions,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *BatchDescribeWorkflowExecutionsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetExecutions is an internal getter (TBD...)
+func (v *BatchDescribeWorkflowExecutionsRequest) GetExecutions() (o []*WorkflowExecution) {
+	if v != nil && v.Executions != nil {
+		return v.Executions
 	}
 	return
 }
 
-// BadRequestError is an internal type (TBD...)
-type BadRequestError struct {
-	Message string `json:"message,required"`
+// BatchDescribeWorkflowExecutionsResponse is an internal type (TBD...)
+type BatchDescribeWorkflowExecutionsResponse struct {
+	Results []*DescribeWorkflowExecutionResult `json:"results,omitempty"`
+}
+
+// GetResults is an internal getter (TBD...)
+func (v *BatchDescribeWorkflowExecutionsResponse) GetResults() (o []*DescribeWorkflowExecutionResult) {
+	if v != nil && v.Results != nil {
+		return v.Results
+	}
+	return
 }
 
 // CancelExternalWorkflowExecutionFailedCause is an internal type (TBD...)
@@ -892,6 +1920,10 @@ type Decision struct {
 	StartChildWorkflowExecutionDecisionAttributes            *StartChildWorkflowExecutionDecisionAttributes            `json:"startChildWorkflowExecutionDecisionAttributes,omitempty"`
 	SignalExternalWorkflowExecutionDecisionAttributes        *SignalExternalWorkflowExecutionDecisionAttributes        `json:"signalExternalWorkflowExecutionDecisionAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesDecisionAttributes         *UpsertWorkflowSearchAttributesDecisionAttributes         `json:"upsertWorkflowSearchAttributesDecisionAttributes,omitempty"`
+	ScheduleActivityTasksBatchDecisionAttributes             *ScheduleActivityTasksBatchDecisionAttributes             `json:"scheduleActivityTasksBatchDecisionAttributes,omitempty"`
+	RecordActivityHeartbeatSnapshotDecisionAttributes        *RecordActivityHeartbeatSnapshotDecisionAttributes        `json:"recordActivityHeartbeatSnapshotDecisionAttributes,omitempty"`
+	ReplaceActivityTaskDecisionAttributes                    *ReplaceActivityTaskDecisionAttributes                    `json:"replaceActivityTaskDecisionAttributes,omitempty"`
+	ScheduleWeightedActivityDecisionAttributes               *ScheduleWeightedActivityDecisionAttributes               `json:"scheduleWeightedActivityDecisionAttributes,omitempty"`
 }
 
 // GetDecisionType is an internal getter (TBD...)
@@ -1400,6 +2432,14 @@ func (e DecisionType) String() string {
 		return "SignalExternalWorkflowExecution"
 	case 12:
 		return "UpsertWorkflowSearchAttributes"
+	case 13:
+		return "ScheduleActivityTasksBatch"
+	case 14:
+		return "RecordActivityHeartbeatSnapshot"
+	case 15:
+		return "ReplaceActivityTask"
+	case 16:
+		return "ScheduleWeightedActivity"
 	}
 	return fmt.Sprintf("DecisionType(%d)", w)
 }
@@ -1446,6 +2486,18 @@ func (e *DecisionType) UnmarshalText(value []byte) error {
 	case "UPSERTWORKFLOWSEARCHATTRIBUTES":
 		*e = DecisionTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "SCHEDULEACTIVITYTASKSBATCH":
+		*e = DecisionTypeScheduleActivityTasksBatch
+		return nil
+	case "RECORDACTIVITYHEARTBEATSNAPSHOT":
+		*e = DecisionTypeRecordActivityHeartbeatSnapshot
+		return nil
+	case "REPLACEACTIVITYTASK":
+		*e = DecisionTypeReplaceActivityTask
+		return nil
+	case "SCHEDULEWEIGHTEDACTIVITY":
+		*e = DecisionTypeScheduleWeightedActivity
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -1488,6 +2540,14 @@ const (
 	DecisionTypeSignalExternalWorkflowExecution
 	// DecisionTypeUpsertWorkflowSearchAttributes is an option for DecisionType
 	DecisionTypeUpsertWorkflowSearchAttributes
+	// DecisionTypeScheduleActivityTasksBatch is an option for DecisionType
+	DecisionTypeScheduleActivityTasksBatch
+	// DecisionTypeRecordActivityHeartbeatSnapshot is an option for DecisionType
+	DecisionTypeRecordActivityHeartbeatSnapshot
+	// DecisionTypeReplaceActivityTask is an option for DecisionType
+	DecisionTypeReplaceActivityTask
+	// DecisionTypeScheduleWeightedActivity is an option for DecisionType
+	DecisionTypeScheduleWeightedActivity
 )
 
 // DeprecateDomainRequest is an internal type (TBD...)
@@ -1711,6 +2771,38 @@ func (v *DescribeTaskListResponse) GetTaskListStatus() (o *TaskListStatus) {
 	return
 }
 
+// DescribeWorkflowExecutionResult is the outcome of describing a single execution in a batch,
+// exactly one of Response and Error is set
+type DescribeWorkflowExecutionResult struct {
+	Execution *WorkflowExecution                 `json:"execution,omitempty"`
+	Response  *DescribeWorkflowExecutionResponse `json:"response,omitempty"`
+	Error     string                             `json:"error,omitempty"`
+}
+
+// GetExecution is an internal getter (TBD...)
+func (v *DescribeWorkflowExecutionResult) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
+// GetResponse is an internal getter (TBD...)
+func (v *DescribeWorkflowExecutionResult) GetResponse() (o *DescribeWorkflowExecutionResponse) {
+	if v != nil && v.Response != nil {
+		return v.Response
+	}
+	return
+}
+
+// GetError is an internal getter (TBD...)
+func (v *DescribeWorkflowExecutionResult) GetError() (o string) {
+	if v != nil {
+		return v.Error
+	}
+	return
+}
+
 // DescribeWorkflowExecutionRequest is an internal type (TBD...)
 type DescribeWorkflowExecutionRequest struct {
 	Domain    string             `json:"domain,omitempty"`
@@ -1740,6 +2832,26 @@ type DescribeWorkflowExecutionResponse struct {
 	PendingActivities      []*PendingActivityInfo          `json:"pendingActivities,omitempty"`
 	PendingChildren        []*PendingChildExecutionInfo    `json:"pendingChildren,omitempty"`
 	PendingDecision        *PendingDecisionInfo            `json:"pendingDecision,omitempty"`
+	// RecentlyClosedActivities is only set when history.closedActivityHeartbeatDetailsRetention is enabled
+	RecentlyClosedActivities []*RecentlyClosedActivityInfo `json:"recentlyClosedActivities,omitempty"`
+	// WorkflowCounters holds the named counters incremented by activity completions
+	WorkflowCounters map[string]int64 `json:"workflowCounters,omitempty"`
+}
+
+// GetWorkflowCounters is an internal getter (TBD...)
+func (v *DescribeWorkflowExecutionResponse) GetWorkflowCounters() (o map[string]int64) {
+	if v != nil {
+		return v.WorkflowCounters
+	}
+	return
+}
+
+// GetRecentlyClosedActivities is an internal getter (TBD...)
+func (v *DescribeWorkflowExecutionResponse) GetRecentlyClosedActivities() (o []*RecentlyClosedActivityInfo) {
+	if v != nil && v.RecentlyClosedActivities != nil {
+		return v.RecentlyClosedActivities
+	}
+	return
 }
 
 // GetWorkflowExecutionInfo is an internal getter (TBD...)
@@ -2411,63 +3523,501 @@ const (
 	EventTypeUpsertWorkflowSearchAttributes
 )
 
-// ExternalWorkflowExecutionCancelRequestedEventAttributes is an internal type (TBD...)
-type ExternalWorkflowExecutionCancelRequestedEventAttributes struct {
-	InitiatedEventID  int64              `json:"initiatedEventId,omitempty"`
+// ExternalWorkflowExecutionCancelRequestedEventAttributes is an internal type (TBD...)
+type ExternalWorkflowExecutionCancelRequestedEventAttributes struct {
+	InitiatedEventID  int64              `json:"initiatedEventId,omitempty"`
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+}
+
+// GetInitiatedEventID is an internal getter (TBD...)
+func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) GetInitiatedEventID() (o int64) {
+	if v != nil {
+		return v.InitiatedEventID
+	}
+	return
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// ExternalWorkflowExecutionSignaledEventAttributes is an internal type (TBD...)
+type ExternalWorkflowExecutionSignaledEventAttributes struct {
+	InitiatedEventID  int64              `json:"initiatedEventId,omitempty"`
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Control           []byte             `json:"control,omitempty"`
+}
+
+// GetInitiatedEventID is an internal getter (TBD...)
+func (v *ExternalWorkflowExecutionSignaledEventAttributes) GetInitiatedEventID() (o int64) {
+	if v != nil {
+		return v.InitiatedEventID
+	}
+	return
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ExternalWorkflowExecutionSignaledEventAttributes) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// FailWorkflowExecutionDecisionAttributes is an internal type (TBD...)
+type FailWorkflowExecutionDecisionAttributes struct {
+	Reason  *string `json:"reason,omitempty"`
+	Details []byte  `json:"details,omitempty"`
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *FailWorkflowExecutionDecisionAttributes) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+	return
+}
+
+// GetActivityEffectiveConfigRequest is an internal type (TBD...)
+type GetActivityEffectiveConfigRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *GetActivityEffectiveConfigRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *GetActivityEffectiveConfigRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *GetActivityEffectiveConfigRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetActivityEffectiveConfigResponse is an internal type (TBD...)
+type GetActivityEffectiveConfigResponse struct {
+	ActivityID                      string       `json:"activityID,omitempty"`
+	TaskList                        *TaskList    `json:"taskList,omitempty"`
+	ScheduleToStartTimeoutSeconds   int32        `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	StartToCloseTimeoutSeconds      int32        `json:"startToCloseTimeoutSeconds,omitempty"`
+	FirstAttemptStartToCloseSeconds int32        `json:"firstAttemptStartToCloseSeconds,omitempty"`
+	ScheduleToCloseTimeoutSeconds   int32        `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+	HeartbeatTimeoutSeconds         int32        `json:"heartbeatTimeoutSeconds,omitempty"`
+	RetryPolicy                     *RetryPolicy `json:"retryPolicy,omitempty"`
+	Attempt                         int32        `json:"attempt,omitempty"`
+	// CurrentAttemptStartToCloseTimeoutSeconds is the StartToClose timeout which applies to Attempt
+	CurrentAttemptStartToCloseTimeoutSeconds int32 `json:"currentAttemptStartToCloseTimeoutSeconds,omitempty"`
+}
+
+// GetRetryPolicy is an internal getter (TBD...)
+func (v *GetActivityEffectiveConfigResponse) GetRetryPolicy() (o *RetryPolicy) {
+	if v != nil && v.RetryPolicy != nil {
+		return v.RetryPolicy
+	}
+	return
+}
+
+// GetActivityNextRetryTimeRequest is an internal type (TBD...)
+type GetActivityNextRetryTimeRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *GetActivityNextRetryTimeRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *GetActivityNextRetryTimeRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *GetActivityNextRetryTimeRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetActivityNextRetryTimeResponse is an internal type (TBD...)
+type GetActivityNextRetryTimeResponse struct {
+	ActivityID string `json:"activityID,omitempty"`
+	// Attempt is the attempt which will be dispatched at NextRetryTimestamp
+	Attempt            int32   `json:"attempt,omitempty"`
+	NextRetryTimestamp *int64  `json:"nextRetryTimestamp,omitempty"`
+	LastFailureReason  *string `json:"lastFailureReason,omitempty"`
+}
+
+// GetNextRetryTimestamp is an internal getter (TBD...)
+func (v *GetActivityNextRetryTimeResponse) GetNextRetryTimestamp() (o int64) {
+	if v != nil && v.NextRetryTimestamp != nil {
+		return *v.NextRetryTimestamp
+	}
+	return
+}
+
+// GetActivityRescheduleReasonsRequest is an internal type (TBD...)
+type GetActivityRescheduleReasonsRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *GetActivityRescheduleReasonsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *GetActivityRescheduleReasonsRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *GetActivityRescheduleReasonsRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetActivityRescheduleReasonsResponse is an internal type (TBD...)
+type GetActivityRescheduleReasonsResponse struct {
+	ActivityID string `json:"activityID,omitempty"`
+	// Reasons are ordered from the oldest reschedule to the latest one
+	Reasons []*ActivityRescheduleReason `json:"reasons,omitempty"`
+}
+
+// GetReasons is an internal getter (TBD...)
+func (v *GetActivityRescheduleReasonsResponse) GetReasons() (o []*ActivityRescheduleReason) {
+	if v != nil && v.Reasons != nil {
+		return v.Reasons
+	}
+	return
+}
+
+// GetActivityRetryStatsRequest is an internal type (TBD...)
+type GetActivityRetryStatsRequest struct {
+	Domain string `json:"domain,omitempty"`
+	// StartTime and EndTime bound the time range in unix nanoseconds, the range defaults to the retention of the stats
+	StartTime *int64 `json:"startTime,omitempty"`
+	EndTime   *int64 `json:"endTime,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *GetActivityRetryStatsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetStartTime is an internal getter (TBD...)
+func (v *GetActivityRetryStatsRequest) GetStartTime() (o int64) {
+	if v != nil && v.StartTime != nil {
+		return *v.StartTime
+	}
+	return
+}
+
+// GetEndTime is an internal getter (TBD...)
+func (v *GetActivityRetryStatsRequest) GetEndTime() (o int64) {
+	if v != nil && v.EndTime != nil {
+		return *v.EndTime
+	}
+	return
+}
+
+// GetActivityRetryStatsResponse is an internal type (TBD...)
+type GetActivityRetryStatsResponse struct {
+	StartTime int64 `json:"startTime,omitempty"`
+	EndTime   int64 `json:"endTime,omitempty"`
+	// TotalAttempts counts the activity attempts which completed, failed or timed out within the range
+	TotalAttempts int64 `json:"totalAttempts,omitempty"`
+	// RetriedAttempts counts those which were not the first attempt of their activity
+	RetriedAttempts int64 `json:"retriedAttempts,omitempty"`
+	// Successes counts those which completed
+	Successes int64 `json:"successes,omitempty"`
+	// FirstAttemptSuccesses counts those which completed on the first attempt of their activity
+	FirstAttemptSuccesses int64 `json:"firstAttemptSuccesses,omitempty"`
+}
+
+// GetTotalAttempts is an internal getter (TBD...)
+func (v *GetActivityRetryStatsResponse) GetTotalAttempts() (o int64) {
+	if v != nil {
+		return v.TotalAttempts
+	}
+	return
+}
+
+// GetRetriedAttempts is an internal getter (TBD...)
+func (v *GetActivityRetryStatsResponse) GetRetriedAttempts() (o int64) {
+	if v != nil {
+		return v.RetriedAttempts
+	}
+	return
+}
+
+// GetSuccesses is an internal getter (TBD...)
+func (v *GetActivityRetryStatsResponse) GetSuccesses() (o int64) {
+	if v != nil {
+		return v.Successes
+	}
+	return
+}
+
+// GetFirstAttemptSuccesses is an internal getter (TBD...)
+func (v *GetActivityRetryStatsResponse) GetFirstAttemptSuccesses() (o int64) {
+	if v != nil {
+		return v.FirstAttemptSuccesses
+	}
+	return
+}
+
+// GetActivityTokenValidationFailuresRequest is an internal type (TBD...)
+type GetActivityTokenValidationFailuresRequest struct {
+	Domain string `json:"domain,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *GetActivityTokenValidationFailuresRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetActivityTokenValidationFailuresResponse is an internal type (TBD...)
+type GetActivityTokenValidationFailuresResponse struct {
+	// Failures are ordered from the oldest failure to the latest one
+	Failures []*ActivityTokenValidationFailure `json:"failures,omitempty"`
+}
+
+// GetFailures is an internal getter (TBD...)
+func (v *GetActivityTokenValidationFailuresResponse) GetFailures() (o []*ActivityTokenValidationFailure) {
+	if v != nil && v.Failures != nil {
+		return v.Failures
+	}
+	return
+}
+
+// GetActivityWorkerHistoryRequest is an internal type (TBD...)
+type GetActivityWorkerHistoryRequest struct {
 	Domain            string             `json:"domain,omitempty"`
 	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
 }
 
-// GetInitiatedEventID is an internal getter (TBD...)
-func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) GetInitiatedEventID() (o int64) {
+// GetDomain is an internal getter (TBD...)
+func (v *GetActivityWorkerHistoryRequest) GetDomain() (o string) {
 	if v != nil {
-		return v.InitiatedEventID
+		return v.Domain
 	}
 	return
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) GetDomain() (o string) {
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *GetActivityWorkerHistoryRequest) GetWorkflowExecution() (o *WorkflowExecution) {
 	if v != nil {
-		return v.Domain
+		return v.WorkflowExecution
 	}
 	return
 }
 
-// ExternalWorkflowExecutionSignaledEventAttributes is an internal type (TBD...)
-type ExternalWorkflowExecutionSignaledEventAttributes struct {
-	InitiatedEventID  int64              `json:"initiatedEventId,omitempty"`
+// GetActivityID is an internal getter (TBD...)
+func (v *GetActivityWorkerHistoryRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetActivityWorkerHistoryResponse is an internal type (TBD...)
+type GetActivityWorkerHistoryResponse struct {
+	ActivityID string `json:"activityID,omitempty"`
+	// Attempts are ordered from the oldest attempt to the latest one, across every time the activity ID was scheduled
+	Attempts []*ActivityWorkerAttempt `json:"attempts,omitempty"`
+}
+
+// GetAttempts is an internal getter (TBD...)
+func (v *GetActivityWorkerHistoryResponse) GetAttempts() (o []*ActivityWorkerAttempt) {
+	if v != nil && v.Attempts != nil {
+		return v.Attempts
+	}
+	return
+}
+
+// GetActivityAttemptTimingsRequest is an internal type (TBD...)
+type GetActivityAttemptTimingsRequest struct {
 	Domain            string             `json:"domain,omitempty"`
 	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Control           []byte             `json:"control,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
 }
 
-// GetInitiatedEventID is an internal getter (TBD...)
-func (v *ExternalWorkflowExecutionSignaledEventAttributes) GetInitiatedEventID() (o int64) {
+// GetDomain is an internal getter (TBD...)
+func (v *GetActivityAttemptTimingsRequest) GetDomain() (o string) {
 	if v != nil {
-		return v.InitiatedEventID
+		return v.Domain
 	}
 	return
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *ExternalWorkflowExecutionSignaledEventAttributes) GetDomain() (o string) {
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *GetActivityAttemptTimingsRequest) GetWorkflowExecution() (o *WorkflowExecution) {
 	if v != nil {
-		return v.Domain
+		return v.WorkflowExecution
 	}
 	return
 }
 
-// FailWorkflowExecutionDecisionAttributes is an internal type (TBD...)
-type FailWorkflowExecutionDecisionAttributes struct {
-	Reason  *string `json:"reason,omitempty"`
-	Details []byte  `json:"details,omitempty"`
+// GetActivityID is an internal getter (TBD...)
+func (v *GetActivityAttemptTimingsRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
 }
 
-// GetReason is an internal getter (TBD...)
-func (v *FailWorkflowExecutionDecisionAttributes) GetReason() (o string) {
-	if v != nil && v.Reason != nil {
-		return *v.Reason
+// GetActivityAttemptTimingsResponse is an internal type (TBD...)
+type GetActivityAttemptTimingsResponse struct {
+	ActivityID string `json:"activityID,omitempty"`
+	// Attempts are ordered from the oldest attempt to the latest one, across every time the activity ID was scheduled
+	Attempts []*ActivityAttemptTiming `json:"attempts,omitempty"`
+}
+
+// GetAttempts is an internal getter (TBD...)
+func (v *GetActivityAttemptTimingsResponse) GetAttempts() (o []*ActivityAttemptTiming) {
+	if v != nil && v.Attempts != nil {
+		return v.Attempts
+	}
+	return
+}
+
+// ActivityAttemptTiming is when an attempt of an activity started and ended, and how it ended
+type ActivityAttemptTiming struct {
+	ScheduledEventID int64 `json:"scheduledEventId,omitempty"`
+	Attempt          int32 `json:"attempt,omitempty"`
+	// StartedTimestamp is when the attempt was started, nil if it was never started or the time is not known
+	StartedTimestamp *int64 `json:"startedTimestamp,omitempty"`
+	// EndTimestamp is when the attempt ended, nil while the attempt is pending or if the time is not known
+	EndTimestamp *int64 `json:"endTimestamp,omitempty"`
+	// Outcome is the type of the event the attempt ended with, nil while the attempt is pending
+	Outcome *EventType `json:"outcome,omitempty"`
+}
+
+// GetStartedTimestamp is an internal getter (TBD...)
+func (v *ActivityAttemptTiming) GetStartedTimestamp() (o int64) {
+	if v != nil && v.StartedTimestamp != nil {
+		return *v.StartedTimestamp
+	}
+	return
+}
+
+// GetEndTimestamp is an internal getter (TBD...)
+func (v *ActivityAttemptTiming) GetEndTimestamp() (o int64) {
+	if v != nil && v.EndTimestamp != nil {
+		return *v.EndTimestamp
+	}
+	return
+}
+
+// GetOutcome is an internal getter (TBD...)
+func (v *ActivityAttemptTiming) GetOutcome() (o EventType) {
+	if v != nil && v.Outcome != nil {
+		return *v.Outcome
+	}
+	return
+}
+
+// ActivityWorkerAttempt is an attempt of an activity and the worker it ran on
+type ActivityWorkerAttempt struct {
+	ScheduledEventID int64 `json:"scheduledEventId,omitempty"`
+	Attempt          int32 `json:"attempt,omitempty"`
+	// Identity is the worker the attempt was started by, empty if the attempt was never started
+	Identity string `json:"identity,omitempty"`
+	// Outcome is the type of the event the attempt ended with, ActivityTaskFailed or ActivityTaskTimedOut for an
+	// attempt which was retried. It is nil while the attempt is pending
+	Outcome *EventType `json:"outcome,omitempty"`
+	// StartedTimestamp is when the attempt was started, nil if it was never started or the time is not known
+	StartedTimestamp *int64 `json:"startedTimestamp,omitempty"`
+	// EndTimestamp is when the attempt ended, nil while the attempt is pending or if the time is not known
+	EndTimestamp *int64 `json:"endTimestamp,omitempty"`
+	// TaskList is the task list the attempt was dispatched on, empty if it is not known
+	TaskList string `json:"taskList,omitempty"`
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *ActivityWorkerAttempt) GetTaskList() (o string) {
+	if v != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetEndTimestamp is an internal getter (TBD...)
+func (v *ActivityWorkerAttempt) GetEndTimestamp() (o int64) {
+	if v != nil && v.EndTimestamp != nil {
+		return *v.EndTimestamp
+	}
+	return
+}
+
+// GetStartedTimestamp is an internal getter (TBD...)
+func (v *ActivityWorkerAttempt) GetStartedTimestamp() (o int64) {
+	if v != nil && v.StartedTimestamp != nil {
+		return *v.StartedTimestamp
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *ActivityWorkerAttempt) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetOutcome is an internal getter (TBD...)
+func (v *ActivityWorkerAttempt) GetOutcome() (o EventType) {
+	if v != nil && v.Outcome != nil {
+		return *v.Outcome
 	}
 	return
 }
@@ -2494,6 +4044,17 @@ type GetWorkflowExecutionHistoryRequest struct {
 	WaitForNewEvent        bool                    `json:"waitForNewEvent,omitempty"`
 	HistoryEventFilterType *HistoryEventFilterType `json:"HistoryEventFilterType,omitempty"`
 	SkipArchival           bool                    `json:"skipArchival,omitempty"`
+	// IncludePendingActivityHeartbeats annotates the ActivityTaskStarted events of the pending activities of a
+	// running workflow with their latest heartbeat, so the response reflects their live state
+	IncludePendingActivityHeartbeats bool `json:"includePendingActivityHeartbeats,omitempty"`
+}
+
+// GetIncludePendingActivityHeartbeats is an internal getter (TBD...)
+func (v *GetWorkflowExecutionHistoryRequest) GetIncludePendingActivityHeartbeats() (o bool) {
+	if v != nil {
+		return v.IncludePendingActivityHeartbeats
+	}
+	return
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -2630,6 +4191,14 @@ type Header struct {
 	Fields map[string][]byte `json:"fields,omitempty"`
 }
 
+// GetFields is an internal getter (TBD...)
+func (v *Header) GetFields() (o map[string][]byte) {
+	if v != nil && v.Fields != nil {
+		return v.Fields
+	}
+	return
+}
+
 // History is an internal type (TBD...)
 type History struct {
 	Events []*HistoryEvent `json:"events,omitempty"`
@@ -3213,6 +4782,85 @@ type LimitExceededError struct {
 	Message string `json:"message,required"`
 }
 
+// ListActivityTypesRequest is an internal type (TBD...)
+type ListActivityTypesRequest struct {
+	Domain string `json:"domain,omitempty"`
+	// EarliestTime and LatestTime bound the time range in unix nanoseconds in which activities were scheduled,
+	// LatestTime defaults to the current time
+	EarliestTime *int64 `json:"earliestTime,omitempty"`
+	LatestTime   *int64 `json:"latestTime,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ListActivityTypesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetEarliestTime is an internal getter (TBD...)
+func (v *ListActivityTypesRequest) GetEarliestTime() (o int64) {
+	if v != nil && v.EarliestTime != nil {
+		return *v.EarliestTime
+	}
+	return
+}
+
+// GetLatestTime is an internal getter (TBD...)
+func (v *ListActivityTypesRequest) GetLatestTime() (o int64) {
+	if v != nil && v.LatestTime != nil {
+		return *v.LatestTime
+	}
+	return
+}
+
+// ListActivityTypesResponse is an internal type (TBD...)
+type ListActivityTypesResponse struct {
+	ActivityTypes []*ActivityTypeCount `json:"activityTypes,omitempty"`
+	// Truncated is set when not all the workflow runs of the time range were looked at
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// GetActivityTypes is an internal getter (TBD...)
+func (v *ListActivityTypesResponse) GetActivityTypes() (o []*ActivityTypeCount) {
+	if v != nil && v.ActivityTypes != nil {
+		return v.ActivityTypes
+	}
+	return
+}
+
+// GetTruncated is an internal getter (TBD...)
+func (v *ListActivityTypesResponse) GetTruncated() (o bool) {
+	if v != nil {
+		return v.Truncated
+	}
+	return
+}
+
+// ActivityTypeCount is an internal type (TBD...)
+type ActivityTypeCount struct {
+	ActivityType *ActivityType `json:"activityType,omitempty"`
+	// Count is the number of times activities of the type were scheduled
+	Count int64 `json:"count,omitempty"`
+}
+
+// GetActivityType is an internal getter (TBD...)
+func (v *ActivityTypeCount) GetActivityType() (o *ActivityType) {
+	if v != nil && v.ActivityType != nil {
+		return v.ActivityType
+	}
+	return
+}
+
+// GetCount is an internal getter (TBD...)
+func (v *ActivityTypeCount) GetCount() (o int64) {
+	if v != nil {
+		return v.Count
+	}
+	return
+}
+
 // ListArchivedWorkflowExecutionsRequest is an internal type (TBD...)
 type ListArchivedWorkflowExecutionsRequest struct {
 	Domain        string `json:"domain,omitempty"`
@@ -3384,6 +5032,41 @@ func (v *ListOpenWorkflowExecutionsResponse) GetExecutions() (o []*WorkflowExecu
 	return
 }
 
+// ListPendingActivityScheduleAttributesRequest is an internal type (TBD...)
+type ListPendingActivityScheduleAttributesRequest struct {
+	Domain    string             `json:"domain,omitempty"`
+	Execution *WorkflowExecution `json:"execution,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ListPendingActivityScheduleAttributesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetExecution is an internal getter (TBD...)
+func (v *ListPendingActivityScheduleAttributesRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
+// ListPendingActivityScheduleAttributesResponse is an internal type (TBD...)
+type ListPendingActivityScheduleAttributesResponse struct {
+	PendingActivities []*PendingActivityScheduleAttributes `json:"pendingActivities,omitempty"`
+}
+
+// GetPendingActivities is an internal getter (TBD...)
+func (v *ListPendingActivityScheduleAttributesResponse) GetPendingActivities() (o []*PendingActivityScheduleAttributes) {
+	if v != nil && v.PendingActivities != nil {
+		return v.PendingActivities
+	}
+	return
+}
+
 // ListTaskListPartitionsRequest is an internal type (TBD...)
 type ListTaskListPartitionsRequest struct {
 	Domain   string    `json:"domain,omitempty"`
@@ -3605,6 +5288,67 @@ type PendingActivityInfo struct {
 	LastWorkerIdentity     string                `json:"lastWorkerIdentity,omitempty"`
 	LastFailureDetails     []byte                `json:"lastFailureDetails,omitempty"`
 	ScheduleID             int64                 `json:"scheduleID,omitempty"`
+	MaxHeartbeatGapMillis  int64                 `json:"maxHeartbeatGapMillis,omitempty"`
+	// ParentInitiatedChildID is taken from the scheduled event of the activity, nil if it was not scheduled on behalf of a child workflow
+	ParentInitiatedChildID *int64 `json:"parentInitiatedChildID,omitempty"`
+	// RemainingExecutionBudgetSeconds is what is left of MaxTotalExecutionSeconds of the retry policy, nil without a budget
+	RemainingExecutionBudgetSeconds *int32 `json:"remainingExecutionBudgetSeconds,omitempty"`
+	// Stalled is set when the last ActivityStalledHeartbeatThreshold heartbeats did not advance the reported progress
+	Stalled bool `json:"stalled,omitempty"`
+	// NackCount is the number of times the current attempt was nacked back to its task list
+	NackCount int32 `json:"nackCount,omitempty"`
+	// LastNackReason is the reason given by the last nack of the current attempt
+	LastNackReason string `json:"lastNackReason,omitempty"`
+	// ScheduledEventAttributes are the attributes of the ActivityTaskScheduled event of the activity, only set when requested
+	ScheduledEventAttributes *ActivityTaskScheduledEventAttributes `json:"scheduledEventAttributes,omitempty"`
+}
+
+// GetScheduledEventAttributes is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetScheduledEventAttributes() (o *ActivityTaskScheduledEventAttributes) {
+	if v != nil {
+		return v.ScheduledEventAttributes
+	}
+	return
+}
+
+// GetLastNackReason is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetLastNackReason() (o string) {
+	if v != nil {
+		return v.LastNackReason
+	}
+	return
+}
+
+// GetNackCount is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetNackCount() (o int32) {
+	if v != nil {
+		return v.NackCount
+	}
+	return
+}
+
+// GetStalled is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetStalled() (o bool) {
+	if v != nil {
+		return v.Stalled
+	}
+	return
+}
+
+// GetRemainingExecutionBudgetSeconds is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetRemainingExecutionBudgetSeconds() (o int32) {
+	if v != nil && v.RemainingExecutionBudgetSeconds != nil {
+		return *v.RemainingExecutionBudgetSeconds
+	}
+	return
+}
+
+// GetParentInitiatedChildID is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetParentInitiatedChildID() (o int64) {
+	if v != nil && v.ParentInitiatedChildID != nil {
+		return *v.ParentInitiatedChildID
+	}
+	return
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -3666,35 +5410,75 @@ func (v *PendingActivityInfo) GetLastFailureReason() (o string) {
 // GetStartedWorkerIdentity is an internal getter (TBD...)
 func (v *PendingActivityInfo) GetStartedWorkerIdentity() (o string) {
 	if v != nil {
-		return v.StartedWorkerIdentity
+		return v.StartedWorkerIdentity
+	}
+	return
+}
+
+// GetLastWorkerIdentity is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetLastWorkerIdentity() (o string) {
+	if v != nil {
+		return v.LastWorkerIdentity
+	}
+	return
+}
+
+// GetLastFailureDetails is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetLastFailureDetails() (o []byte) {
+	if v != nil && v.LastFailureDetails != nil {
+		return v.LastFailureDetails
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetScheduleID() (o int64) {
+	if v != nil {
+		return v.ScheduleID
 	}
 	return
 }
 
-// GetLastWorkerIdentity is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetLastWorkerIdentity() (o string) {
+// GetMaxHeartbeatGapMillis is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetMaxHeartbeatGapMillis() (o int64) {
 	if v != nil {
-		return v.LastWorkerIdentity
+		return v.MaxHeartbeatGapMillis
 	}
 	return
 }
 
-// GetLastFailureDetails is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetLastFailureDetails() (o []byte) {
-	if v != nil && v.LastFailureDetails != nil {
-		return v.LastFailureDetails
+// PendingActivityScheduleAttributes is an internal type (TBD...)
+type PendingActivityScheduleAttributes struct {
+	ActivityID string `json:"activityID,omitempty"`
+	ScheduleID int64  `json:"scheduleID,omitempty"`
+	// ScheduledEventAttributes are the attributes of the ActivityTaskScheduled event of the activity, as recorded
+	ScheduledEventAttributes *ActivityTaskScheduledEventAttributes `json:"scheduledEventAttributes,omitempty"`
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *PendingActivityScheduleAttributes) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
 	}
 	return
 }
 
 // GetScheduleID is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetScheduleID() (o int64) {
+func (v *PendingActivityScheduleAttributes) GetScheduleID() (o int64) {
 	if v != nil {
 		return v.ScheduleID
 	}
 	return
 }
 
+// GetScheduledEventAttributes is an internal getter (TBD...)
+func (v *PendingActivityScheduleAttributes) GetScheduledEventAttributes() (o *ActivityTaskScheduledEventAttributes) {
+	if v != nil && v.ScheduledEventAttributes != nil {
+		return v.ScheduledEventAttributes
+	}
+	return
+}
+
 // PendingActivityState is an internal type (TBD...)
 type PendingActivityState int32
 
@@ -3862,6 +5646,51 @@ type PollForActivityTaskRequest struct {
 	TaskList         *TaskList         `json:"taskList,omitempty"`
 	Identity         string            `json:"identity,omitempty"`
 	TaskListMetadata *TaskListMetadata `json:"taskListMetadata,omitempty"`
+	// Capabilities advertised by the poller, activities are only dispatched to pollers advertising all their required capabilities
+	Capabilities map[string]string `json:"capabilities,omitempty"`
+	// PrefetchCount asks for up to this many additional activity tasks, leased to the poller together with the
+	// polled task. Only tasks which are already available to the task list are prefetched, the poll never waits
+	// for them, and the count is capped per task list by matching.maxActivityPrefetchCount. The heartbeat timeout
+	// of a leased task only starts with its first heartbeat, which acknowledges that the worker started it and
+	// restarts its StartToClose timeout. A leased task that is never acknowledged expires with its StartToClose
+	// timeout and is retried according to its retry policy.
+	PrefetchCount int32 `json:"prefetchCount,omitempty"`
+	// AcceptCompressedHeartbeatDetails is set by pollers able to inflate heartbeat details stored compressed, see common/codec/compression, the details are inflated by history otherwise
+	AcceptCompressedHeartbeatDetails bool `json:"acceptCompressedHeartbeatDetails,omitempty"`
+}
+
+// GetAcceptCompressedHeartbeatDetails is an internal getter (TBD...)
+func (v *PollForActivityTaskRequest) GetAcceptCompressedHeartbeatDetails() (o bool) {
+	if v != nil {
+		return v.AcceptCompressedHeartbeatDetails
+	}
+	return
+}
+
+// GetPrefetchCount is an internal getter (TBD...)
+func (v *PollForActivityTaskRequest) GetPrefetchCount() (o int32) {
+	if v != nil {
+		return v.PrefetchCount
+	}
+	return
+}
+
+// GetCapabilities is an internal getter (TBD...)
+func (v *PollForActivityTaskRequest) GetCapabilities() (o map[string]string) {
+	if v != nil {
+		return v.Capabilities
+	}
+	return
+}
+
+// HasCapabilities returns true if the poller advertises every one of the required capabilities
+func (v *PollForActivityTaskRequest) HasCapabilities(required []string) bool {
+	for _, capability := range required {
+		if _, ok := v.GetCapabilities()[capability]; !ok {
+			return false
+		}
+	}
+	return true
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -3907,6 +5736,37 @@ type PollForActivityTaskResponse struct {
 	WorkflowDomain                  string             `json:"workflowDomain,omitempty"`
 	Header                          *Header            `json:"header,omitempty"`
 	AutoConfigHint                  *AutoConfigHint    `json:"autoConfigHint,omitempty"`
+	WasCancelBeforeStart            bool               `json:"wasCancelBeforeStart,omitempty"`
+	// PrefetchedTasks are the tasks leased to the poller when the poll asked for PrefetchCount tasks
+	PrefetchedTasks []*PollForActivityTaskResponse `json:"prefetchedTasks,omitempty"`
+	// LogLevel is an advisory hint, e.g. "debug", for the worker to adjust its logging while running this activity, see the frontend.activityLogLevel dynamic config. It is set for targeted debugging, it does not change what the activity does and has no effect on workflow determinism
+	LogLevel string `json:"logLevel,omitempty"`
+	// ExpectedResultSizeBytes is the advisory result size hint the activity was scheduled with
+	ExpectedResultSizeBytes *int64 `json:"expectedResultSizeBytes,omitempty"`
+}
+
+// GetExpectedResultSizeBytes is an internal getter (TBD...)
+func (v *PollForActivityTaskResponse) GetExpectedResultSizeBytes() (o int64) {
+	if v != nil && v.ExpectedResultSizeBytes != nil {
+		return *v.ExpectedResultSizeBytes
+	}
+	return
+}
+
+// GetLogLevel is an internal getter (TBD...)
+func (v *PollForActivityTaskResponse) GetLogLevel() (o string) {
+	if v != nil {
+		return v.LogLevel
+	}
+	return
+}
+
+// GetPrefetchedTasks is an internal getter (TBD...)
+func (v *PollForActivityTaskResponse) GetPrefetchedTasks() (o []*PollForActivityTaskResponse) {
+	if v != nil && v.PrefetchedTasks != nil {
+		return v.PrefetchedTasks
+	}
+	return
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -3917,6 +5777,14 @@ func (v *PollForActivityTaskResponse) GetActivityID() (o string) {
 	return
 }
 
+// GetWasCancelBeforeStart is an internal getter (TBD...)
+func (v *PollForActivityTaskResponse) GetWasCancelBeforeStart() (o bool) {
+	if v != nil {
+		return v.WasCancelBeforeStart
+	}
+	return
+}
+
 // PollForDecisionTaskRequest is an internal type (TBD...)
 type PollForDecisionTaskRequest struct {
 	Domain         string    `json:"domain,omitempty"`
@@ -3976,6 +5844,18 @@ type PollForDecisionTaskResponse struct {
 	NextEventID               int64                     `json:"nextEventId,omitempty"`
 	TotalHistoryBytes         int64                     `json:"historySize,omitempty"`
 	AutoConfigHint            *AutoConfigHint           `json:"autoConfigHint,omitempty"`
+	// ActivityBatchCompleted is set when every activity scheduled by an earlier decision, the activities they
+	// chained included, closed since the last completed decision. Only set for workflows started with
+	// NotifyOnActivityBatchComplete
+	ActivityBatchCompleted bool `json:"activityBatchCompleted,omitempty"`
+}
+
+// GetActivityBatchCompleted is an internal getter (TBD...)
+func (v *PollForDecisionTaskResponse) GetActivityBatchCompleted() (o bool) {
+	if v != nil {
+		return v.ActivityBatchCompleted
+	}
+	return
 }
 
 // GetTaskToken is an internal getter (TBD...)
@@ -4038,6 +5918,17 @@ type PollerInfo struct {
 	LastAccessTime *int64  `json:"lastAccessTime,omitempty"`
 	Identity       string  `json:"identity,omitempty"`
 	RatePerSecond  float64 `json:"ratePerSecond,omitempty"`
+	// ActivityLatencyEstimateInMs is the rolling estimate of the time the poller takes to come back for another
+	// activity task once it got one, set when the task list has latency-aware poller selection enabled
+	ActivityLatencyEstimateInMs int64 `json:"activityLatencyEstimateInMs,omitempty"`
+}
+
+// GetActivityLatencyEstimateInMs is an internal getter (TBD...)
+func (v *PollerInfo) GetActivityLatencyEstimateInMs() (o int64) {
+	if v != nil {
+		return v.ActivityLatencyEstimateInMs
+	}
+	return
 }
 
 // GetLastAccessTime is an internal getter (TBD...)
@@ -4380,6 +6271,46 @@ func (v *ReapplyEventsRequest) GetEvents() (o *DataBlob) {
 	return
 }
 
+// RecentlyClosedActivityInfo is an internal type (TBD...)
+type RecentlyClosedActivityInfo struct {
+	ActivityID             string `json:"activityID,omitempty"`
+	HeartbeatDetails       []byte `json:"heartbeatDetails,omitempty"`
+	LastHeartbeatTimestamp *int64 `json:"lastHeartbeatTimestamp,omitempty"`
+	CloseTimestamp         *int64 `json:"closeTimestamp,omitempty"`
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *RecentlyClosedActivityInfo) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetHeartbeatDetails is an internal getter (TBD...)
+func (v *RecentlyClosedActivityInfo) GetHeartbeatDetails() (o []byte) {
+	if v != nil && v.HeartbeatDetails != nil {
+		return v.HeartbeatDetails
+	}
+	return
+}
+
+// GetLastHeartbeatTimestamp is an internal getter (TBD...)
+func (v *RecentlyClosedActivityInfo) GetLastHeartbeatTimestamp() (o int64) {
+	if v != nil && v.LastHeartbeatTimestamp != nil {
+		return *v.LastHeartbeatTimestamp
+	}
+	return
+}
+
+// GetCloseTimestamp is an internal getter (TBD...)
+func (v *RecentlyClosedActivityInfo) GetCloseTimestamp() (o int64) {
+	if v != nil && v.CloseTimestamp != nil {
+		return *v.CloseTimestamp
+	}
+	return
+}
+
 // RecordActivityTaskHeartbeatByIDRequest is an internal type (TBD...)
 type RecordActivityTaskHeartbeatByIDRequest struct {
 	Domain     string `json:"domain,omitempty"`
@@ -4388,6 +6319,48 @@ type RecordActivityTaskHeartbeatByIDRequest struct {
 	ActivityID string `json:"activityID,omitempty"`
 	Details    []byte `json:"details,omitempty"`
 	Identity   string `json:"identity,omitempty"`
+	// RequestedStartToCloseTimeoutSeconds asks to extend the StartToClose timeout of the current attempt
+	RequestedStartToCloseTimeoutSeconds *int32 `json:"requestedStartToCloseTimeoutSeconds,omitempty"`
+	// HeartbeatSequence is a worker assigned, increasing sequence number of the heartbeat details, details older than the stored ones are not persisted
+	HeartbeatSequence *int64 `json:"heartbeatSequence,omitempty"`
+	// ProgressPercent is the worker reported progress of the activity, heartbeats which do not advance it are counted towards detecting a stalled activity
+	ProgressPercent *int32 `json:"progressPercent,omitempty"`
+	// CompressDetails asks history to store the Details compressed, see RecordActivityTaskHeartbeatRequest.CompressDetails
+	CompressDetails bool `json:"compressDetails,omitempty"`
+	// WorkerHealth is a hint about the health of the worker, see RecordActivityTaskHeartbeatRequest.WorkerHealth
+	WorkerHealth *WorkerHealth `json:"workerHealth,omitempty"`
+}
+
+// GetWorkerHealth is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatByIDRequest) GetWorkerHealth() (o WorkerHealth) {
+	if v != nil && v.WorkerHealth != nil {
+		return *v.WorkerHealth
+	}
+	return
+}
+
+// GetCompressDetails is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatByIDRequest) GetCompressDetails() (o bool) {
+	if v != nil {
+		return v.CompressDetails
+	}
+	return
+}
+
+// GetProgressPercent is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatByIDRequest) GetProgressPercent() (o int32) {
+	if v != nil && v.ProgressPercent != nil {
+		return *v.ProgressPercent
+	}
+	return
+}
+
+// GetHeartbeatSequence is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatByIDRequest) GetHeartbeatSequence() (o int64) {
+	if v != nil && v.HeartbeatSequence != nil {
+		return *v.HeartbeatSequence
+	}
+	return
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -4422,16 +6395,272 @@ func (v *RecordActivityTaskHeartbeatByIDRequest) GetActivityID() (o string) {
 	return
 }
 
+// GetRequestedStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatByIDRequest) GetRequestedStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.RequestedStartToCloseTimeoutSeconds != nil {
+		return *v.RequestedStartToCloseTimeoutSeconds
+	}
+	return
+}
+
 // RecordActivityTaskHeartbeatRequest is an internal type (TBD...)
 type RecordActivityTaskHeartbeatRequest struct {
 	TaskToken []byte `json:"taskToken,omitempty"`
 	Details   []byte `json:"details,omitempty"`
 	Identity  string `json:"identity,omitempty"`
+	// RequestedStartToCloseTimeoutSeconds asks to extend the StartToClose timeout of the current attempt
+	RequestedStartToCloseTimeoutSeconds *int32 `json:"requestedStartToCloseTimeoutSeconds,omitempty"`
+	// HeartbeatSequence is a worker assigned, increasing sequence number of the heartbeat details, details older than the stored ones are not persisted
+	HeartbeatSequence *int64 `json:"heartbeatSequence,omitempty"`
+	// ProgressPercent is the worker reported progress of the activity, heartbeats which do not advance it are counted towards detecting a stalled activity
+	ProgressPercent *int32 `json:"progressPercent,omitempty"`
+	// CompressDetails asks history to store the Details compressed, they are inflated on read for callers which do not accept compressed details
+	CompressDetails bool `json:"compressDetails,omitempty"`
+	// WorkerHealth is a hint about the health of the worker, a degraded worker may be asked to yield the activity
+	WorkerHealth *WorkerHealth `json:"workerHealth,omitempty"`
+}
+
+// GetWorkerHealth is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatRequest) GetWorkerHealth() (o WorkerHealth) {
+	if v != nil && v.WorkerHealth != nil {
+		return *v.WorkerHealth
+	}
+	return
+}
+
+// GetCompressDetails is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatRequest) GetCompressDetails() (o bool) {
+	if v != nil {
+		return v.CompressDetails
+	}
+	return
+}
+
+// GetProgressPercent is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatRequest) GetProgressPercent() (o int32) {
+	if v != nil && v.ProgressPercent != nil {
+		return *v.ProgressPercent
+	}
+	return
+}
+
+// GetHeartbeatSequence is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatRequest) GetHeartbeatSequence() (o int64) {
+	if v != nil && v.HeartbeatSequence != nil {
+		return *v.HeartbeatSequence
+	}
+	return
+}
+
+// GetRequestedStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatRequest) GetRequestedStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.RequestedStartToCloseTimeoutSeconds != nil {
+		return *v.RequestedStartToCloseTimeoutSeconds
+	}
+	return
 }
 
 // RecordActivityTaskHeartbeatResponse is an internal type (TBD...)
 type RecordActivityTaskHeartbeatResponse struct {
-	CancelRequested bool `json:"cancelRequested,omitempty"`
+	CancelRequested bool              `json:"cancelRequested,omitempty"`
+	Signals         []*ActivitySignal `json:"signals,omitempty"`
+	// StartToCloseDeadline is the granted StartToClose deadline in unix nanoseconds, set when an extension was accepted
+	StartToCloseDeadline *int64 `json:"startToCloseDeadline,omitempty"`
+	// PersistedSequence is the HeartbeatSequence of the details durably stored for the activity, set when the request carried a sequence
+	PersistedSequence *int64 `json:"persistedSequence,omitempty"`
+	// YieldRequested advises a worker which reported a degraded health to give up the activity, by failing it
+	// with a retryable failure so that matching dispatches the retry to another worker. Yielding fails the
+	// current attempt, which consumes an attempt of the retry policy like any other failure. It is only set
+	// when that failure would be retried
+	YieldRequested bool `json:"yieldRequested,omitempty"`
+	// WorkflowCloseStatus is set when the workflow run of the activity is closed, e.g. terminated or continued as new, and history.enableActivityHeartbeatWorkflowCloseStatus is enabled, such a heartbeat fails otherwise. The heartbeat is then not recorded and CancelRequested is set as well, the activity cannot be completed anymore and should be aborted
+	WorkflowCloseStatus *WorkflowExecutionCloseStatus `json:"workflowCloseStatus,omitempty"`
+	// CancelDeadline is the time in unix nanoseconds by which the worker is expected to have canceled the activity,
+	// set together with CancelRequested when the cancellation of the activity was requested
+	CancelDeadline *int64 `json:"cancelDeadline,omitempty"`
+}
+
+// GetCancelDeadline is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatResponse) GetCancelDeadline() (o int64) {
+	if v != nil && v.CancelDeadline != nil {
+		return *v.CancelDeadline
+	}
+	return
+}
+
+// GetWorkflowCloseStatus is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatResponse) GetWorkflowCloseStatus() (o *WorkflowExecutionCloseStatus) {
+	if v != nil {
+		return v.WorkflowCloseStatus
+	}
+	return
+}
+
+// GetYieldRequested is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatResponse) GetYieldRequested() (o bool) {
+	if v != nil {
+		return v.YieldRequested
+	}
+	return
+}
+
+// GetPersistedSequence is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatResponse) GetPersistedSequence() (o int64) {
+	if v != nil && v.PersistedSequence != nil {
+		return *v.PersistedSequence
+	}
+	return
+}
+
+// GetStartToCloseDeadline is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatResponse) GetStartToCloseDeadline() (o int64) {
+	if v != nil && v.StartToCloseDeadline != nil {
+		return *v.StartToCloseDeadline
+	}
+	return
+}
+
+// GetSignals is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatResponse) GetSignals() (o []*ActivitySignal) {
+	if v != nil && v.Signals != nil {
+		return v.Signals
+	}
+	return
+}
+
+// RecordActivityHeartbeatSnapshotDecisionAttributes captures the heartbeat details the pending activity
+// has at the time the decision is completed. The snapshot is recorded as an ordinary MarkerRecorded event
+// named ActivityHeartbeatSnapshotMarkerName, with the activity ID in its header and the details as its
+// details, so it is part of the history and reads the same on every replay. On replay, a client matches
+// the decision against that marker event, like a RecordMarker decision, and returns the recorded details
+// instead of reading the live ones.
+type RecordActivityHeartbeatSnapshotDecisionAttributes struct {
+	ActivityID string `json:"activityId,omitempty"`
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *RecordActivityHeartbeatSnapshotDecisionAttributes) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// ScheduleWeightedActivityDecisionAttributes schedules one activity whose type is selected by history among
+// Candidates, with a probability proportional to the weight of each candidate. The activity is scheduled with
+// ScheduleAttributes, which must not set an ActivityType, and the selection is recorded on the
+// WeightedSelection of the ActivityTaskScheduled event.
+//
+// The selection is deterministic: the same candidates and seed always select the same activity type. When
+// Seed is not set it is derived from the workflow ID and the activity ID, so every run of a workflow ID,
+// including the runs it continues as new into, selects the same activity type for the same activity ID,
+// which keeps A/B assignments stable per workflow. Replay does not select the activity type again, workers
+// replaying the history read it from the ActivityTaskScheduled event like for any other activity.
+type ScheduleWeightedActivityDecisionAttributes struct {
+	Candidates         []*WeightedActivityType                 `json:"candidates,omitempty"`
+	Seed               *int64                                  `json:"seed,omitempty"`
+	ScheduleAttributes *ScheduleActivityTaskDecisionAttributes `json:"scheduleAttributes,omitempty"`
+}
+
+// GetCandidates is an internal getter (TBD...)
+func (v *ScheduleWeightedActivityDecisionAttributes) GetCandidates() (o []*WeightedActivityType) {
+	if v != nil {
+		return v.Candidates
+	}
+	return
+}
+
+// GetSeed is an internal getter (TBD...)
+func (v *ScheduleWeightedActivityDecisionAttributes) GetSeed() (o int64) {
+	if v != nil && v.Seed != nil {
+		return *v.Seed
+	}
+	return
+}
+
+// GetScheduleAttributes is an internal getter (TBD...)
+func (v *ScheduleWeightedActivityDecisionAttributes) GetScheduleAttributes() (o *ScheduleActivityTaskDecisionAttributes) {
+	if v != nil {
+		return v.ScheduleAttributes
+	}
+	return
+}
+
+// WeightedActivityType is a candidate activity type of a ScheduleWeightedActivity decision
+type WeightedActivityType struct {
+	ActivityType *ActivityType `json:"activityType,omitempty"`
+	Weight       int32         `json:"weight,omitempty"`
+}
+
+// GetActivityType is an internal getter (TBD...)
+func (v *WeightedActivityType) GetActivityType() (o *ActivityType) {
+	if v != nil {
+		return v.ActivityType
+	}
+	return
+}
+
+// GetWeight is an internal getter (TBD...)
+func (v *WeightedActivityType) GetWeight() (o int32) {
+	if v != nil {
+		return v.Weight
+	}
+	return
+}
+
+// WeightedActivitySelection records how the activity type of an activity scheduled by a ScheduleWeightedActivity
+// decision was selected
+type WeightedActivitySelection struct {
+	Candidates []*WeightedActivityType `json:"candidates,omitempty"`
+	Seed       int64                   `json:"seed,omitempty"`
+}
+
+// GetCandidates is an internal getter (TBD...)
+func (v *WeightedActivitySelection) GetCandidates() (o []*WeightedActivityType) {
+	if v != nil {
+		return v.Candidates
+	}
+	return
+}
+
+// GetSeed is an internal getter (TBD...)
+func (v *WeightedActivitySelection) GetSeed() (o int64) {
+	if v != nil {
+		return v.Seed
+	}
+	return
+}
+
+// ReplaceActivityTaskDecisionAttributes cancels the pending activity ActivityID and schedules
+// NewScheduleAttributes in its place, both are applied together with the other decisions of the
+// decision task, so there is no window in which neither or both activities are wanted.
+// NewScheduleAttributes must use a new activity ID. The ActivityTaskCancelRequested event of the old
+// activity links to the replacement through its ReplacementActivityID.
+//
+// The old activity is cancelled like with a RequestCancelActivityTask decision: if it has not started it
+// is cancelled right away, otherwise the worker running it learns about the cancellation from the
+// response of its next heartbeat and is expected to report it with RespondActivityTaskCanceled. Until
+// then the old activity is still pending and may also complete or fail, workflows should ignore its
+// result. Activities which do not heartbeat are only cancelled when they time out.
+type ReplaceActivityTaskDecisionAttributes struct {
+	ActivityID            string                                  `json:"activityId,omitempty"`
+	NewScheduleAttributes *ScheduleActivityTaskDecisionAttributes `json:"newScheduleAttributes,omitempty"`
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *ReplaceActivityTaskDecisionAttributes) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetNewScheduleAttributes is an internal getter (TBD...)
+func (v *ReplaceActivityTaskDecisionAttributes) GetNewScheduleAttributes() (o *ScheduleActivityTaskDecisionAttributes) {
+	if v != nil {
+		return v.NewScheduleAttributes
+	}
+	return
 }
 
 // RecordMarkerDecisionAttributes is an internal type (TBD...)
@@ -4619,7 +6848,44 @@ func (v *RemoveTaskRequest) GetVisibilityTimestamp() (o int64) {
 // GetClusterName is an internal getter (TBD...)
 func (v *RemoveTaskRequest) GetClusterName() (o string) {
 	if v != nil {
-		return v.ClusterName
+		return v.ClusterName
+	}
+	return
+}
+
+// RenewActivityLeaseRequest resets the heartbeat timer of a started activity without replacing its heartbeat details
+type RenewActivityLeaseRequest struct {
+	TaskToken []byte `json:"taskToken,omitempty"`
+	Identity  string `json:"identity,omitempty"`
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *RenewActivityLeaseRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// RenewActivityLeaseResponse is an internal type (TBD...)
+type RenewActivityLeaseResponse struct {
+	CancelRequested bool `json:"cancelRequested,omitempty"`
+	// WorkflowCloseStatus is set when the workflow run of the activity is closed, see RecordActivityTaskHeartbeatResponse
+	WorkflowCloseStatus *WorkflowExecutionCloseStatus `json:"workflowCloseStatus,omitempty"`
+}
+
+// GetWorkflowCloseStatus is an internal getter (TBD...)
+func (v *RenewActivityLeaseResponse) GetWorkflowCloseStatus() (o *WorkflowExecutionCloseStatus) {
+	if v != nil {
+		return v.WorkflowCloseStatus
+	}
+	return
+}
+
+// GetCancelRequested is an internal getter (TBD...)
+func (v *RenewActivityLeaseResponse) GetCancelRequested() (o bool) {
+	if v != nil {
+		return v.CancelRequested
 	}
 	return
 }
@@ -4880,6 +7146,46 @@ func (v *ResetQueueRequest) GetType() (o int32) {
 	return
 }
 
+// ResetActivityAttemptsRequest is an internal type (TBD...)
+type ResetActivityAttemptsRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ResetActivityAttemptsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ResetActivityAttemptsRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *ResetActivityAttemptsRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *ResetActivityAttemptsRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // ResetStickyTaskListRequest is an internal type (TBD...)
 type ResetStickyTaskListRequest struct {
 	Domain    string             `json:"domain,omitempty"`
@@ -5050,6 +7356,26 @@ type RespondActivityTaskCompletedByIDRequest struct {
 	ActivityID string `json:"activityID,omitempty"`
 	Result     []byte `json:"result,omitempty"`
 	Identity   string `json:"identity,omitempty"`
+	// ResultSchemaVersion is the same advisory metadata as in RespondActivityTaskCompletedRequest
+	ResultSchemaVersion *int32 `json:"resultSchemaVersion,omitempty"`
+	// IncrementCounter is the same as in RespondActivityTaskCompletedRequest
+	IncrementCounter string `json:"incrementCounter,omitempty"`
+}
+
+// GetIncrementCounter is an internal getter (TBD...)
+func (v *RespondActivityTaskCompletedByIDRequest) GetIncrementCounter() (o string) {
+	if v != nil {
+		return v.IncrementCounter
+	}
+	return
+}
+
+// GetResultSchemaVersion is an internal getter (TBD...)
+func (v *RespondActivityTaskCompletedByIDRequest) GetResultSchemaVersion() (o int32) {
+	if v != nil && v.ResultSchemaVersion != nil {
+		return *v.ResultSchemaVersion
+	}
+	return
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -5097,6 +7423,36 @@ type RespondActivityTaskCompletedRequest struct {
 	TaskToken []byte `json:"taskToken,omitempty"`
 	Result    []byte `json:"result,omitempty"`
 	Identity  string `json:"identity,omitempty"`
+	// BackpressureHint reports the saturation of the worker's downstream dependencies, from 0 (healthy) to 1 (saturated)
+	BackpressureHint *float64 `json:"backpressureHint,omitempty"`
+	// ResultSchemaVersion is advisory metadata describing the format of Result, it is recorded as is and never validated by the server
+	ResultSchemaVersion *int32 `json:"resultSchemaVersion,omitempty"`
+	// IncrementCounter names a workflow counter incremented by the completion, counters are exposed by DescribeWorkflowExecution and bounded by history.workflowCounterLimit
+	IncrementCounter string `json:"incrementCounter,omitempty"`
+}
+
+// GetIncrementCounter is an internal getter (TBD...)
+func (v *RespondActivityTaskCompletedRequest) GetIncrementCounter() (o string) {
+	if v != nil {
+		return v.IncrementCounter
+	}
+	return
+}
+
+// GetResultSchemaVersion is an internal getter (TBD...)
+func (v *RespondActivityTaskCompletedRequest) GetResultSchemaVersion() (o int32) {
+	if v != nil && v.ResultSchemaVersion != nil {
+		return *v.ResultSchemaVersion
+	}
+	return
+}
+
+// GetBackpressureHint is an internal getter (TBD...)
+func (v *RespondActivityTaskCompletedRequest) GetBackpressureHint() (o float64) {
+	if v != nil && v.BackpressureHint != nil {
+		return *v.BackpressureHint
+	}
+	return
 }
 
 // GetIdentity is an internal getter (TBD...)
@@ -5116,6 +7472,16 @@ type RespondActivityTaskFailedByIDRequest struct {
 	Reason     *string `json:"reason,omitempty"`
 	Details    []byte  `json:"details,omitempty"`
 	Identity   string  `json:"identity,omitempty"`
+	// FailureCategory drives retries and failure metrics, failures without one are treated as transient
+	FailureCategory *ActivityFailureCategory `json:"failureCategory,omitempty"`
+}
+
+// GetFailureCategory is an internal getter (TBD...)
+func (v *RespondActivityTaskFailedByIDRequest) GetFailureCategory() (o ActivityFailureCategory) {
+	if v != nil && v.FailureCategory != nil {
+		return *v.FailureCategory
+	}
+	return
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -5164,6 +7530,26 @@ type RespondActivityTaskFailedRequest struct {
 	Reason    *string `json:"reason,omitempty"`
 	Details   []byte  `json:"details,omitempty"`
 	Identity  string  `json:"identity,omitempty"`
+	// FailureCategory drives retries and failure metrics, failures without one are treated as transient
+	FailureCategory *ActivityFailureCategory `json:"failureCategory,omitempty"`
+	// BackpressureHint reports the saturation of the worker's downstream dependencies, from 0 (healthy) to 1 (saturated)
+	BackpressureHint *float64 `json:"backpressureHint,omitempty"`
+}
+
+// GetBackpressureHint is an internal getter (TBD...)
+func (v *RespondActivityTaskFailedRequest) GetBackpressureHint() (o float64) {
+	if v != nil && v.BackpressureHint != nil {
+		return *v.BackpressureHint
+	}
+	return
+}
+
+// GetFailureCategory is an internal getter (TBD...)
+func (v *RespondActivityTaskFailedRequest) GetFailureCategory() (o ActivityFailureCategory) {
+	if v != nil && v.FailureCategory != nil {
+		return *v.FailureCategory
+	}
+	return
 }
 
 // GetReason is an internal getter (TBD...)
@@ -5190,6 +7576,38 @@ func (v *RespondActivityTaskFailedRequest) GetIdentity() (o string) {
 	return
 }
 
+// RespondActivityTaskNackRequest is an internal type (TBD...)
+type RespondActivityTaskNackRequest struct {
+	TaskToken []byte `json:"taskToken,omitempty"`
+	// Reason is recorded on the pending activity, the attempt is not counted
+	Reason   string `json:"reason,omitempty"`
+	Identity string `json:"identity,omitempty"`
+}
+
+// GetTaskToken is an internal getter (TBD...)
+func (v *RespondActivityTaskNackRequest) GetTaskToken() (o []byte) {
+	if v != nil && v.TaskToken != nil {
+		return v.TaskToken
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *RespondActivityTaskNackRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *RespondActivityTaskNackRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // RespondDecisionTaskCompletedRequest is an internal type (TBD...)
 type RespondDecisionTaskCompletedRequest struct {
 	TaskToken                  []byte                          `json:"taskToken,omitempty"`
@@ -5247,6 +7665,17 @@ func (v *RespondDecisionTaskCompletedRequest) GetQueryResults() (o map[string]*W
 type RespondDecisionTaskCompletedResponse struct {
 	DecisionTask                *PollForDecisionTaskResponse          `json:"decisionTask,omitempty"`
 	ActivitiesToDispatchLocally map[string]*ActivityLocalDispatchInfo `json:"activitiesToDispatchLocally,omitempty"`
+	// GeneratedActivityIDs are the activity IDs assigned by history to the AutoGenerateActivityID decisions of
+	// the request, keyed by the index of the decision
+	GeneratedActivityIDs map[int32]string `json:"generatedActivityIDs,omitempty"`
+}
+
+// GetGeneratedActivityIDs is an internal getter (TBD...)
+func (v *RespondDecisionTaskCompletedResponse) GetGeneratedActivityIDs() (o map[int32]string) {
+	if v != nil {
+		return v.GeneratedActivityIDs
+	}
+	return
 }
 
 // GetDecisionTask is an internal getter (TBD...)
@@ -5339,106 +7768,368 @@ type RetryPolicy struct {
 	MaximumAttempts             int32    `json:"maximumAttempts,omitempty"`
 	NonRetriableErrorReasons    []string `json:"nonRetriableErrorReasons,omitempty"`
 	ExpirationIntervalInSeconds int32    `json:"expirationIntervalInSeconds,omitempty"`
+	// MaxTotalExecutionSeconds caps the execution time summed over all attempts of an activity, backoff waits excluded.
+	// ScheduleToClose and ExpirationIntervalInSeconds still bound the wall-clock time including backoffs, retries stop
+	// at whichever limit is reached first. It is ignored by workflow retry policies
+	MaxTotalExecutionSeconds int32 `json:"maxTotalExecutionSeconds,omitempty"`
+	// MinimumIntervalInSeconds is the lower bound of every retry backoff, applied after the backoff coefficient
+	// and MaximumIntervalInSeconds. Unlike InitialIntervalInSeconds it does not affect how the backoff grows
+	MinimumIntervalInSeconds int32 `json:"minimumIntervalInSeconds,omitempty"`
+}
+
+// GetMinimumIntervalInSeconds is an internal getter (TBD...)
+func (v *RetryPolicy) GetMinimumIntervalInSeconds() (o int32) {
+	if v != nil {
+		return v.MinimumIntervalInSeconds
+	}
+	return
+}
+
+// GetMaxTotalExecutionSeconds is an internal getter (TBD...)
+func (v *RetryPolicy) GetMaxTotalExecutionSeconds() (o int32) {
+	if v != nil {
+		return v.MaxTotalExecutionSeconds
+	}
+	return
+}
+
+// GetInitialIntervalInSeconds is an internal getter (TBD...)
+func (v *RetryPolicy) GetInitialIntervalInSeconds() (o int32) {
+	if v != nil {
+		return v.InitialIntervalInSeconds
+	}
+	return
+}
+
+// GetBackoffCoefficient is an internal getter (TBD...)
+func (v *RetryPolicy) GetBackoffCoefficient() (o float64) {
+	if v != nil {
+		return v.BackoffCoefficient
+	}
+	return
+}
+
+// GetMaximumIntervalInSeconds is an internal getter (TBD...)
+func (v *RetryPolicy) GetMaximumIntervalInSeconds() (o int32) {
+	if v != nil {
+		return v.MaximumIntervalInSeconds
+	}
+	return
+}
+
+// GetMaximumAttempts is an internal getter (TBD...)
+func (v *RetryPolicy) GetMaximumAttempts() (o int32) {
+	if v != nil {
+		return v.MaximumAttempts
+	}
+	return
+}
+
+// GetNonRetriableErrorReasons is an internal getter (TBD...)
+func (v *RetryPolicy) GetNonRetriableErrorReasons() (o []string) {
+	if v != nil && v.NonRetriableErrorReasons != nil {
+		return v.NonRetriableErrorReasons
+	}
+	return
+}
+
+// GetExpirationIntervalInSeconds is an internal getter (TBD...)
+func (v *RetryPolicy) GetExpirationIntervalInSeconds() (o int32) {
+	if v != nil {
+		return v.ExpirationIntervalInSeconds
+	}
+	return
+}
+
+// RetryTaskV2Error is an internal type (TBD...)
+type RetryTaskV2Error struct {
+	Message           string `json:"message,required"`
+	DomainID          string `json:"domainId,omitempty"`
+	WorkflowID        string `json:"workflowId,omitempty"`
+	RunID             string `json:"runId,omitempty"`
+	StartEventID      *int64 `json:"startEventId,omitempty"`
+	StartEventVersion *int64 `json:"startEventVersion,omitempty"`
+	EndEventID        *int64 `json:"endEventId,omitempty"`
+	EndEventVersion   *int64 `json:"endEventVersion,omitempty"`
+}
+
+// GetDomainID is an internal getter (TBD...)
+func (v *RetryTaskV2Error) GetDomainID() (o string) {
+	if v != nil {
+		return v.DomainID
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *RetryTaskV2Error) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *RetryTaskV2Error) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+// ScheduleActivityTaskDecisionAttributes is an internal type (TBD...)
+type ScheduleActivityTaskDecisionAttributes struct {
+	ActivityID                    string        `json:"activityId,omitempty"`
+	ActivityType                  *ActivityType `json:"activityType,omitempty"`
+	Domain                        string        `json:"domain,omitempty"`
+	TaskList                      *TaskList     `json:"taskList,omitempty"`
+	Input                         []byte        `json:"input,omitempty"`
+	ScheduleToCloseTimeoutSeconds *int32        `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+	ScheduleToStartTimeoutSeconds *int32        `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	StartToCloseTimeoutSeconds    *int32        `json:"startToCloseTimeoutSeconds,omitempty"`
+	HeartbeatTimeoutSeconds       *int32        `json:"heartbeatTimeoutSeconds,omitempty"`
+	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
+	Header                        *Header       `json:"header,omitempty"`
+	RequestLocalDispatch          bool          `json:"requestLocalDispatch,omitempty"`
+	RoutingKey                    string        `json:"routingKey,omitempty"`
+	Priority                      *int32        `json:"priority,omitempty"`
+	// FirstAttemptStartToCloseSeconds overrides StartToCloseTimeoutSeconds for the first attempt only
+	FirstAttemptStartToCloseSeconds *int32 `json:"firstAttemptStartToCloseSeconds,omitempty"`
+	// SearchAttributes are upserted into visibility while the activity is running
+	SearchAttributes *SearchAttributes `json:"-"` // Filtering PII
+	// ParentInitiatedChildID is the initiated event ID of the child workflow the activity is scheduled on behalf of
+	ParentInitiatedChildID *int64 `json:"parentInitiatedChildId,omitempty"`
+	// RequiredCapabilities are the names of the capabilities a poller has to advertise to be handed the activity
+	RequiredCapabilities []string `json:"requiredCapabilities,omitempty"`
+	// FallbackTaskList is the task list the activity moves to after ActivityFallbackTaskListScheduleToStartTimeouts consecutive ScheduleToStart timeouts. Only effective for activities with a retry policy
+	FallbackTaskList *TaskList `json:"fallbackTaskList,omitempty"`
+	// NextActivity is scheduled by history on the same task list once this activity completes, with the result of this
+	// activity as its input. Retries of this activity happen first, and the next activity is not scheduled if this
+	// activity fails, times out or is canceled, nor if its ActivityID is in use when this activity completes. The
+	// next activity retries according to its own retry policy and can declare a NextActivity of its own
+	NextActivity *ScheduleActivityTaskDecisionAttributes `json:"nextActivity,omitempty"`
+	// EncryptionKeyID references the key the Input and the Result of the activity are encrypted with
+	// before history persists them, see common/activityencryption. Empty leaves the payloads unencrypted
+	EncryptionKeyID string `json:"encryptionKeyId,omitempty"`
+	// OrderedDispatch holds back the dispatch of the activity until every activity scheduled before it with
+	// OrderedDispatch on the same task list, by the same workflow run, has been started
+	OrderedDispatch bool `json:"orderedDispatch,omitempty"`
+	// Idempotent declares the activity free of side effects, without a RetryPolicy it gets an aggressive default one
+	Idempotent bool `json:"idempotent,omitempty"`
+	// RegionAffinity prefers pollers of the cluster the domain is active in, other clusters only get the activity after a fallback timeout
+	RegionAffinity bool `json:"regionAffinity,omitempty"`
+	// OnTimeoutDefaultResult, when set, completes the activity with this result instead of timing it out, the ActivityTaskCompleted event is flagged as Synthesized. It applies to every timeout type: ScheduleToStart, StartToClose, ScheduleToClose and Heartbeat, once the RetryPolicy, if any, gives up
+	OnTimeoutDefaultResult []byte `json:"onTimeoutDefaultResult,omitempty"`
+	// DispatchWindow holds the dispatch of every attempt of the activity back until the time of day window is open. It cannot be combined with RequestLocalDispatch
+	DispatchWindow *ActivityDispatchWindow `json:"dispatchWindow,omitempty"`
+	// CancellationCheckpointGraceSeconds makes history wait, at most this long, for one more heartbeat carrying the final checkpoint once a heartbeat response delivered the cancellation of the activity
+	CancellationCheckpointGraceSeconds *int32 `json:"cancellationCheckpointGraceSeconds,omitempty"`
+	// Tag of the kill switch holding the dispatch of the activity while it is on
+	KillSwitchTag string `json:"killSwitchTag,omitempty"`
+	// AtMostOnce never delivers the activity again once a worker started it: if that worker is lost, i.e. the
+	// attempt times out by StartToClose or Heartbeat, the activity times out instead of being retried or handed
+	// to another poller, and the decider has to handle it. Failures reported by the worker are still retried.
+	// Activities which can be made idempotent should keep the default at-least-once delivery, with which a lost
+	// worker costs a retry rather than a timed out activity
+	AtMostOnce bool `json:"atMostOnce,omitempty"`
+	// AlertOnFailure tags the activity for alerting: once it fails for good, i.e. fails or times out without
+	// being retried any longer, history notifies the alert sink the domain config maps the tag to
+	AlertOnFailure string `json:"alertOnFailure,omitempty"`
+	// AutoGenerateActivityID makes history assign the activity ID, which is then returned in the
+	// GeneratedActivityIDs of the RespondDecisionTaskCompletedResponse and recorded in the scheduled event, so
+	// replays read it from history. The ID is derived from the position of the decision in the decision task
+	// completion and is unique within the workflow run. ActivityID must be left empty
+	AutoGenerateActivityID bool `json:"autoGenerateActivityID,omitempty"`
+	// DependsOnActivityID holds back the dispatch of the activity until the pending activity of this workflow run
+	// with that ID completes, without a decision in between. If that activity fails for good, times out or is
+	// canceled instead, history applies OnDependencyFailure to this activity. The ScheduleToStart and
+	// ScheduleToClose timeouts of the activity run from when it is scheduled, so they include the wait for the
+	// activity it depends on and have to be set accordingly. It cannot be combined with RequestLocalDispatch
+	DependsOnActivityID string `json:"dependsOnActivityID,omitempty"`
+	// OnDependencyFailure is what history does with the activity when the activity of DependsOnActivityID does not
+	// complete, it is failed by default
+	OnDependencyFailure *ActivityDependencyFailurePolicy `json:"onDependencyFailure,omitempty"`
+	// ExpectedResultSizeBytes is an advisory hint of the size of the result of the activity, for capacity planning.
+	// The result is handled on its actual size
+	ExpectedResultSizeBytes *int64 `json:"expectedResultSizeBytes,omitempty"`
+	// ResultSearchAttributes are extracted from the result of the activity once it completes. A path which does
+	// not match the result, a result which is not JSON or a value over the search attribute size limit leaves its
+	// search attribute untouched without failing the completion. It cannot be combined with EncryptionKeyID
+	ResultSearchAttributes []*ActivityResultSearchAttribute `json:"resultSearchAttributes,omitempty"`
+	// TaskListEscalation moves the retries of the activity to other task lists: the first attempt, attempt 0, is dispatched
+	// on TaskList, attempt N on TaskListEscalation[N-1], and the attempts past the end of the list on its last task list.
+	// It does not change how many attempts are made, which the RetryPolicy alone decides, so it requires a RetryPolicy
+	// and cannot list more task lists than the MaximumAttempts of the policy allows retries. It cannot be combined
+	// with FallbackTaskList
+	TaskListEscalation []*TaskList `json:"taskListEscalation,omitempty"`
+}
+
+// GetTaskListEscalation is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetTaskListEscalation() (o []*TaskList) {
+	if v != nil {
+		return v.TaskListEscalation
+	}
+	return
+}
+
+// GetResultSearchAttributes is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetResultSearchAttributes() (o []*ActivityResultSearchAttribute) {
+	if v != nil {
+		return v.ResultSearchAttributes
+	}
+	return
+}
+
+// GetExpectedResultSizeBytes is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetExpectedResultSizeBytes() (o int64) {
+	if v != nil && v.ExpectedResultSizeBytes != nil {
+		return *v.ExpectedResultSizeBytes
+	}
+	return
+}
+
+// GetOnDependencyFailure is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetOnDependencyFailure() (o ActivityDependencyFailurePolicy) {
+	if v != nil && v.OnDependencyFailure != nil {
+		return *v.OnDependencyFailure
+	}
+	return
+}
+
+// GetDependsOnActivityID is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetDependsOnActivityID() (o string) {
+	if v != nil {
+		return v.DependsOnActivityID
+	}
+	return
+}
+
+// GetAutoGenerateActivityID is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetAutoGenerateActivityID() (o bool) {
+	if v != nil {
+		return v.AutoGenerateActivityID
+	}
+	return
+}
+
+// GetAlertOnFailure is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetAlertOnFailure() (o string) {
+	if v != nil {
+		return v.AlertOnFailure
+	}
+	return
+}
+
+// GetAtMostOnce is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetAtMostOnce() (o bool) {
+	if v != nil {
+		return v.AtMostOnce
+	}
+	return
+}
+
+// GetKillSwitchTag is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetKillSwitchTag() (o string) {
+	if v != nil {
+		return v.KillSwitchTag
+	}
+	return
+}
+
+// GetCancellationCheckpointGraceSeconds is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetCancellationCheckpointGraceSeconds() (o int32) {
+	if v != nil && v.CancellationCheckpointGraceSeconds != nil {
+		return *v.CancellationCheckpointGraceSeconds
+	}
+	return
 }
 
-// GetInitialIntervalInSeconds is an internal getter (TBD...)
-func (v *RetryPolicy) GetInitialIntervalInSeconds() (o int32) {
+// GetDispatchWindow is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetDispatchWindow() (o *ActivityDispatchWindow) {
 	if v != nil {
-		return v.InitialIntervalInSeconds
+		return v.DispatchWindow
 	}
 	return
 }
 
-// GetBackoffCoefficient is an internal getter (TBD...)
-func (v *RetryPolicy) GetBackoffCoefficient() (o float64) {
+// GetOnTimeoutDefaultResult is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetOnTimeoutDefaultResult() (o []byte) {
 	if v != nil {
-		return v.BackoffCoefficient
+		return v.OnTimeoutDefaultResult
 	}
 	return
 }
 
-// GetMaximumIntervalInSeconds is an internal getter (TBD...)
-func (v *RetryPolicy) GetMaximumIntervalInSeconds() (o int32) {
+// GetRegionAffinity is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetRegionAffinity() (o bool) {
 	if v != nil {
-		return v.MaximumIntervalInSeconds
+		return v.RegionAffinity
 	}
 	return
 }
 
-// GetMaximumAttempts is an internal getter (TBD...)
-func (v *RetryPolicy) GetMaximumAttempts() (o int32) {
+// GetIdempotent is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetIdempotent() (o bool) {
 	if v != nil {
-		return v.MaximumAttempts
+		return v.Idempotent
 	}
 	return
 }
 
-// GetNonRetriableErrorReasons is an internal getter (TBD...)
-func (v *RetryPolicy) GetNonRetriableErrorReasons() (o []string) {
-	if v != nil && v.NonRetriableErrorReasons != nil {
-		return v.NonRetriableErrorReasons
+// GetOrderedDispatch is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetOrderedDispatch() (o bool) {
+	if v != nil {
+		return v.OrderedDispatch
 	}
 	return
 }
 
-// GetExpirationIntervalInSeconds is an internal getter (TBD...)
-func (v *RetryPolicy) GetExpirationIntervalInSeconds() (o int32) {
+// GetEncryptionKeyID is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetEncryptionKeyID() (o string) {
 	if v != nil {
-		return v.ExpirationIntervalInSeconds
+		return v.EncryptionKeyID
 	}
 	return
 }
 
-// RetryTaskV2Error is an internal type (TBD...)
-type RetryTaskV2Error struct {
-	Message           string `json:"message,required"`
-	DomainID          string `json:"domainId,omitempty"`
-	WorkflowID        string `json:"workflowId,omitempty"`
-	RunID             string `json:"runId,omitempty"`
-	StartEventID      *int64 `json:"startEventId,omitempty"`
-	StartEventVersion *int64 `json:"startEventVersion,omitempty"`
-	EndEventID        *int64 `json:"endEventId,omitempty"`
-	EndEventVersion   *int64 `json:"endEventVersion,omitempty"`
+// GetNextActivity is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetNextActivity() (o *ScheduleActivityTaskDecisionAttributes) {
+	if v != nil {
+		return v.NextActivity
+	}
+	return
 }
 
-// GetDomainID is an internal getter (TBD...)
-func (v *RetryTaskV2Error) GetDomainID() (o string) {
+// GetFallbackTaskList is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetFallbackTaskList() (o *TaskList) {
 	if v != nil {
-		return v.DomainID
+		return v.FallbackTaskList
 	}
 	return
 }
 
-// GetWorkflowID is an internal getter (TBD...)
-func (v *RetryTaskV2Error) GetWorkflowID() (o string) {
+// GetRequiredCapabilities is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetRequiredCapabilities() (o []string) {
 	if v != nil {
-		return v.WorkflowID
+		return v.RequiredCapabilities
 	}
 	return
 }
 
-// GetRunID is an internal getter (TBD...)
-func (v *RetryTaskV2Error) GetRunID() (o string) {
-	if v != nil {
-		return v.RunID
+// GetParentInitiatedChildID is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetParentInitiatedChildID() (o int64) {
+	if v != nil && v.ParentInitiatedChildID != nil {
+		return *v.ParentInitiatedChildID
 	}
 	return
 }
 
-// ScheduleActivityTaskDecisionAttributes is an internal type (TBD...)
-type ScheduleActivityTaskDecisionAttributes struct {
-	ActivityID                    string        `json:"activityId,omitempty"`
-	ActivityType                  *ActivityType `json:"activityType,omitempty"`
-	Domain                        string        `json:"domain,omitempty"`
-	TaskList                      *TaskList     `json:"taskList,omitempty"`
-	Input                         []byte        `json:"input,omitempty"`
-	ScheduleToCloseTimeoutSeconds *int32        `json:"scheduleToCloseTimeoutSeconds,omitempty"`
-	ScheduleToStartTimeoutSeconds *int32        `json:"scheduleToStartTimeoutSeconds,omitempty"`
-	StartToCloseTimeoutSeconds    *int32        `json:"startToCloseTimeoutSeconds,omitempty"`
-	HeartbeatTimeoutSeconds       *int32        `json:"heartbeatTimeoutSeconds,omitempty"`
-	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
-	Header                        *Header       `json:"header,omitempty"`
-	RequestLocalDispatch          bool          `json:"requestLocalDispatch,omitempty"`
+// GetSearchAttributes is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+	return
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -5497,6 +8188,101 @@ func (v *ScheduleActivityTaskDecisionAttributes) GetHeartbeatTimeoutSeconds() (o
 	return
 }
 
+// GetRoutingKey is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetRoutingKey() (o string) {
+	if v != nil {
+		return v.RoutingKey
+	}
+	return
+}
+
+// GetPriority is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+	return
+}
+
+// GetFirstAttemptStartToCloseSeconds is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetFirstAttemptStartToCloseSeconds() (o int32) {
+	if v != nil && v.FirstAttemptStartToCloseSeconds != nil {
+		return *v.FirstAttemptStartToCloseSeconds
+	}
+	return
+}
+
+// ScheduleActivityTaskBatchEntry is a single activity of a ScheduleActivityTasksBatchDecisionAttributes
+type ScheduleActivityTaskBatchEntry struct {
+	ActivityID string `json:"activityId,omitempty"`
+	Input      []byte `json:"input,omitempty"`
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *ScheduleActivityTaskBatchEntry) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetInput is an internal getter (TBD...)
+func (v *ScheduleActivityTaskBatchEntry) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+	return
+}
+
+// ScheduleActivityTasksBatchDecisionAttributes schedules several activities which share everything
+// but their ID and input. It is expanded into one ActivityTaskScheduled event per activity, so the
+// resulting history is the same as with one ScheduleActivityTask decision per activity.
+type ScheduleActivityTasksBatchDecisionAttributes struct {
+	ActivityType                  *ActivityType                     `json:"activityType,omitempty"`
+	Domain                        string                            `json:"domain,omitempty"`
+	TaskList                      *TaskList                         `json:"taskList,omitempty"`
+	ScheduleToCloseTimeoutSeconds *int32                            `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+	ScheduleToStartTimeoutSeconds *int32                            `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	StartToCloseTimeoutSeconds    *int32                            `json:"startToCloseTimeoutSeconds,omitempty"`
+	HeartbeatTimeoutSeconds       *int32                            `json:"heartbeatTimeoutSeconds,omitempty"`
+	RetryPolicy                   *RetryPolicy                      `json:"retryPolicy,omitempty"`
+	Header                        *Header                           `json:"header,omitempty"`
+	RequestLocalDispatch          bool                              `json:"requestLocalDispatch,omitempty"`
+	Activities                    []*ScheduleActivityTaskBatchEntry `json:"activities,omitempty"`
+}
+
+// GetActivityType is an internal getter (TBD...)
+func (v *ScheduleActivityTasksBatchDecisionAttributes) GetActivityType() (o *ActivityType) {
+	if v != nil && v.ActivityType != nil {
+		return v.ActivityType
+	}
+	return
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ScheduleActivityTasksBatchDecisionAttributes) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *ScheduleActivityTasksBatchDecisionAttributes) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetActivities is an internal getter (TBD...)
+func (v *ScheduleActivityTasksBatchDecisionAttributes) GetActivities() (o []*ScheduleActivityTaskBatchEntry) {
+	if v != nil && v.Activities != nil {
+		return v.Activities
+	}
+	return
+}
+
 // SearchAttributes is an internal type (TBD...)
 type SearchAttributes struct {
 	IndexedFields map[string][]byte `json:"indexedFields,omitempty"`
@@ -5514,6 +8300,8 @@ func (v *SearchAttributes) GetIndexedFields() (o map[string][]byte) {
 type ServiceBusyError struct {
 	Message string `json:"message,required"`
 	Reason  string `json:"reason,omitempty"`
+	// RetryAfterMs hints how long the caller should wait before retrying, 0 if there is no hint
+	RetryAfterMs int64 `json:"retryAfterMs,omitempty"`
 }
 
 // SignalExternalWorkflowExecutionDecisionAttributes is an internal type (TBD...)
@@ -5791,6 +8579,64 @@ type SignalWithStartWorkflowExecutionAsyncRequest struct {
 type SignalWithStartWorkflowExecutionAsyncResponse struct {
 }
 
+// SignalActivityRequest is an internal type (TBD...)
+type SignalActivityRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+	SignalName        string             `json:"signalName,omitempty"`
+	Input             []byte             `json:"-"` // Filtering PII
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *SignalActivityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *SignalActivityRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *SignalActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetSignalName is an internal getter (TBD...)
+func (v *SignalActivityRequest) GetSignalName() (o string) {
+	if v != nil {
+		return v.SignalName
+	}
+	return
+}
+
+// GetInput is an internal getter (TBD...)
+func (v *SignalActivityRequest) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *SignalActivityRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // SignalWorkflowExecutionRequest is an internal type (TBD...)
 type SignalWorkflowExecutionRequest struct {
 	Domain            string             `json:"domain,omitempty"`
@@ -6144,6 +8990,18 @@ type StartWorkflowExecutionRequest struct {
 	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
 	JitterStartSeconds                  *int32                 `json:"jitterStartSeconds,omitempty"`
 	FirstRunAtTimeStamp                 *int64                 `json:"firstRunAtTimeStamp,omitempty"`
+	Priority                            *int32                 `json:"priority,omitempty"`
+	// NotifyOnActivityBatchComplete flags the decision task following the close of the last pending activity scheduled
+	// by a decision, see PollForDecisionTaskResponse.ActivityBatchCompleted. Continued as new runs inherit it
+	NotifyOnActivityBatchComplete bool `json:"notifyOnActivityBatchComplete,omitempty"`
+}
+
+// GetNotifyOnActivityBatchComplete is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetNotifyOnActivityBatchComplete() (o bool) {
+	if v != nil {
+		return v.NotifyOnActivityBatchComplete
+	}
+	return
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -6202,6 +9060,14 @@ func (v *StartWorkflowExecutionRequest) GetFirstRunAtTimeStamp() (o int64) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+	return
+}
+
 // GetRequestID is an internal getter (TBD...)
 func (v *StartWorkflowExecutionRequest) GetRequestID() (o string) {
 	if v != nil {
@@ -6415,6 +9281,16 @@ type TaskListStatus struct {
 	TaskIDBlock           *TaskIDBlock                      `json:"taskIDBlock,omitempty"`
 	IsolationGroupMetrics map[string]*IsolationGroupMetrics `json:"isolationGroupMetrics,omitempty"`
 	NewTasksPerSecond     float64                           `json:"newTasksPerSecond,omitempty"`
+	// BackpressureRatePerSecond is the dispatch rate derived from worker backpressure hints, zero when no backpressure applies
+	BackpressureRatePerSecond float64 `json:"backpressureRatePerSecond,omitempty"`
+}
+
+// GetBackpressureRatePerSecond is an internal getter (TBD...)
+func (v *TaskListStatus) GetBackpressureRatePerSecond() (o float64) {
+	if v != nil {
+		return v.BackpressureRatePerSecond
+	}
+	return
 }
 
 // GetBacklogCountHint is an internal getter (TBD...)
@@ -6852,6 +9728,113 @@ func (v *VersionHistoryItem) GetVersion() (o int64) {
 	return
 }
 
+// WaitForActivityCompletionRequest is an internal type (TBD...)
+type WaitForActivityCompletionRequest struct {
+	Domain     string             `json:"domain,omitempty"`
+	Execution  *WorkflowExecution `json:"execution,omitempty"`
+	ActivityID string             `json:"activityId,omitempty"`
+	// TimeoutSeconds bounds how long the call waits for the activity to complete, the deadline of the call applies too
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *WaitForActivityCompletionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetExecution is an internal getter (TBD...)
+func (v *WaitForActivityCompletionRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *WaitForActivityCompletionRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetTimeoutSeconds is an internal getter (TBD...)
+func (v *WaitForActivityCompletionRequest) GetTimeoutSeconds() (o int32) {
+	if v != nil {
+		return v.TimeoutSeconds
+	}
+	return
+}
+
+// WaitForActivityCompletionResponse is an internal type (TBD...)
+type WaitForActivityCompletionResponse struct {
+	// CloseEvent is the ActivityTaskCompleted, ActivityTaskFailed, ActivityTaskTimedOut or ActivityTaskCanceled
+	// event of the activity, it is nil if the activity did not complete before the wait timed out
+	CloseEvent *HistoryEvent `json:"closeEvent,omitempty"`
+}
+
+// GetCloseEvent is an internal getter (TBD...)
+func (v *WaitForActivityCompletionResponse) GetCloseEvent() (o *HistoryEvent) {
+	if v != nil && v.CloseEvent != nil {
+		return v.CloseEvent
+	}
+	return
+}
+
+// WorkerHealth is an internal type (TBD...)
+type WorkerHealth int32
+
+// Ptr is a helper function for getting pointer value
+func (e WorkerHealth) Ptr() *WorkerHealth {
+	return &e
+}
+
+// String returns a readable string representation of WorkerHealth.
+func (e WorkerHealth) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "HEALTHY"
+	case 1:
+		return "DEGRADED"
+	}
+	return fmt.Sprintf("WorkerHealth(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *WorkerHealth) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "HEALTHY":
+		*e = WorkerHealthHealthy
+		return nil
+	case "DEGRADED":
+		*e = WorkerHealthDegraded
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "WorkerHealth", err)
+		}
+		*e = WorkerHealth(val)
+		return nil
+	}
+}
+
+// MarshalText encodes WorkerHealth to text.
+func (e WorkerHealth) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// WorkerHealthHealthy is an option for WorkerHealth
+	WorkerHealthHealthy WorkerHealth = iota
+	// WorkerHealthDegraded is an option for WorkerHealth
+	WorkerHealthDegraded
+)
+
 // WorkerVersionInfo is an internal type (TBD...)
 type WorkerVersionInfo struct {
 	Impl           string `json:"impl,omitempty"`
@@ -7265,6 +10248,17 @@ type WorkflowExecutionStartedEventAttributes struct {
 	JitterStartSeconds                  *int32                  `json:"jitterStartSeconds,omitempty"`
 	PartitionConfig                     map[string]string
 	RequestID                           string `json:"requestId,omitempty"`
+	Priority                            *int32 `json:"priority,omitempty"`
+	// NotifyOnActivityBatchComplete is copied from the start request or inherited from the run continued as new
+	NotifyOnActivityBatchComplete bool `json:"notifyOnActivityBatchComplete,omitempty"`
+}
+
+// GetNotifyOnActivityBatchComplete is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetNotifyOnActivityBatchComplete() (o bool) {
+	if v != nil {
+		return v.NotifyOnActivityBatchComplete
+	}
+	return
 }
 
 // GetParentWorkflowDomain is an internal getter (TBD...)
@@ -7410,6 +10404,14 @@ func (v *WorkflowExecutionStartedEventAttributes) GetRequestID() (o string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+	return
+}
+
 // WorkflowExecutionTerminatedEventAttributes is an internal type (TBD...)
 type WorkflowExecutionTerminatedEventAttributes struct {
 	Reason   string `json:"reason,omitempty"`
@@ -74,3 +74,15 @@ func TestDataBlobDeepCopy(t *testing.T) {
 func identicalByteArray(a, b []byte) bool {
 	return len(a) == len(b) && unsafe.SliceData(a) == unsafe.SliceData(b)
 }
+
+func TestPollForActivityTaskRequest_HasCapabilities(t *testing.T) {
+	request := &PollForActivityTaskRequest{Capabilities: map[string]string{"gpu": "a100", "memory": "64g"}}
+	assert.True(t, request.HasCapabilities(nil))
+	assert.True(t, request.HasCapabilities([]string{"gpu"}))
+	assert.True(t, request.HasCapabilities([]string{"gpu", "memory"}))
+	assert.False(t, request.HasCapabilities([]string{"gpu", "ssd"}))
+
+	var nilRequest *PollForActivityTaskRequest
+	assert.True(t, nilRequest.HasCapabilities(nil))
+	assert.False(t, nilRequest.HasCapabilities([]string{"gpu"}))
+}
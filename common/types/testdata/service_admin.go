@@ -270,4 +270,80 @@ var (
 		PartitionConfig: &TaskListPartitionConfig,
 	}
 	AdminUpdateTaskListPartitionConfigResponse = types.UpdateTaskListPartitionConfigResponse{}
+
+	AdminRemoveTaskListTaskRequest = types.RemoveTaskListTaskRequest{
+		Domain:            DomainName,
+		TaskList:          &TaskList,
+		TaskID:            TaskID,
+		WorkflowExecution: &WorkflowExecution,
+		ScheduleID:        EventID1,
+		Operator:          "operator",
+		Reason:            Reason,
+	}
+	AdminRemoveTaskListTaskResponse = types.RemoveTaskListTaskResponse{
+		TaskID: TaskID,
+	}
+
+	ReplayActivityCompletionsRequest = types.ReplayActivityCompletionsRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		BaseRunID:         RunID,
+		ScheduledEventIDs: []int64{EventID1, EventID2},
+		Identity:          Identity,
+	}
+	ReplayActivityCompletionsResponse = types.ReplayActivityCompletionsResponse{
+		ScheduledEventIDs: map[int64]int64{EventID1: EventID2},
+	}
+	ExpireActivityHeartbeatRequest = types.ExpireActivityHeartbeatRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+	}
+	RedriveTimedOutActivitiesRequest = types.RedriveTimedOutActivitiesRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Identity:          Identity,
+	}
+	CancelPendingActivitiesRequest = types.CancelPendingActivitiesRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Details:           Payload1,
+		Identity:          Identity,
+	}
+	AdminDecodeTaskTokenRequest = types.DecodeTaskTokenRequest{
+		TaskToken: TaskToken,
+	}
+	AdminDecodeTaskTokenResponse = types.DecodeTaskTokenResponse{
+		Domain:          DomainName,
+		DomainID:        DomainID,
+		WorkflowID:      WorkflowID,
+		RunID:           RunID,
+		WorkflowType:    WorkflowTypeName,
+		ScheduleID:      EventID1,
+		ScheduleAttempt: int64(Attempt),
+		ActivityID:      ActivityID,
+		ActivityType:    ActivityTypeName,
+	}
+	AdminBatchTerminateRequest = types.BatchTerminateRequest{
+		Domain:                DomainName,
+		Query:                 VisibilityQuery,
+		Reason:                Reason,
+		DrainTimeoutInSeconds: 60,
+		RequestsPerSecond:     10,
+		Concurrency:           5,
+		TerminateChildren:     common.BoolPtr(true),
+		Identity:              Identity,
+	}
+	AdminBatchTerminateResponse = types.BatchTerminateResponse{
+		JobID: WorkflowID,
+	}
+	AdminDescribeBatchTerminateRequest = types.DescribeBatchTerminateRequest{
+		JobID: WorkflowID,
+	}
+	AdminDescribeBatchTerminateResponse = types.DescribeBatchTerminateResponse{
+		CloseStatus:   &WorkflowExecutionCloseStatus,
+		TotalEstimate: 100,
+		SuccessCount:  90,
+		ErrorCount:    10,
+	}
 )
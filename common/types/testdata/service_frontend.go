@@ -284,6 +284,12 @@ var (
 	RecordActivityTaskHeartbeatResponse = types.RecordActivityTaskHeartbeatResponse{
 		CancelRequested: true,
 	}
+	ActivitySignal = types.ActivitySignal{
+		SignalName: SignalName,
+		Input:      Payload1,
+		Identity:   Identity,
+		Timestamp:  &Timestamp1,
+	}
 	RecordActivityTaskHeartbeatByIDRequest = types.RecordActivityTaskHeartbeatByIDRequest{
 		Domain:     DomainName,
 		WorkflowID: WorkflowID,
@@ -340,6 +346,138 @@ var (
 		RequestID:         RequestID,
 		Control:           Control,
 	}
+	GetActivityEffectiveConfigRequest = types.GetActivityEffectiveConfigRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+	}
+	GetActivityEffectiveConfigResponse = types.GetActivityEffectiveConfigResponse{
+		ActivityID:                               ActivityID,
+		TaskList:                                 &TaskList,
+		ScheduleToStartTimeoutSeconds:            Duration1,
+		StartToCloseTimeoutSeconds:               Duration2,
+		FirstAttemptStartToCloseSeconds:          Duration3,
+		ScheduleToCloseTimeoutSeconds:            Duration4,
+		HeartbeatTimeoutSeconds:                  Duration1,
+		RetryPolicy:                              &RetryPolicy,
+		Attempt:                                  Attempt,
+		CurrentAttemptStartToCloseTimeoutSeconds: Duration2,
+	}
+	GetActivityNextRetryTimeRequest = types.GetActivityNextRetryTimeRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+	}
+	GetActivityNextRetryTimeResponse = types.GetActivityNextRetryTimeResponse{
+		ActivityID:         ActivityID,
+		Attempt:            Attempt,
+		NextRetryTimestamp: &Timestamp1,
+		LastFailureReason:  &FailureReason,
+	}
+	ActivityResultSearchAttribute = types.ActivityResultSearchAttribute{
+		JSONPath:           "$.order.id",
+		SearchAttributeKey: "CustomKeywordField",
+	}
+	ActivityRescheduleReason = types.ActivityRescheduleReason{
+		Attempt:          Attempt,
+		Cause:            types.ActivityRescheduleCauseHeartbeatTimeout.Ptr(),
+		Timestamp:        common.Int64Ptr(Timestamp2),
+		Identity:         Identity,
+		StartedTimestamp: common.Int64Ptr(Timestamp1),
+		TaskList:         TaskListName,
+	}
+	GetActivityRescheduleReasonsRequest = types.GetActivityRescheduleReasonsRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+	}
+	GetActivityRescheduleReasonsResponse = types.GetActivityRescheduleReasonsResponse{
+		ActivityID: ActivityID,
+		Reasons:    []*types.ActivityRescheduleReason{&ActivityRescheduleReason},
+	}
+	ActivityWorkerAttempt = types.ActivityWorkerAttempt{
+		ScheduledEventID: EventID1,
+		Attempt:          Attempt,
+		Identity:         Identity,
+		Outcome:          types.EventTypeActivityTaskFailed.Ptr(),
+		StartedTimestamp: common.Int64Ptr(Timestamp1),
+		EndTimestamp:     common.Int64Ptr(Timestamp2),
+		TaskList:         TaskListName,
+	}
+	GetActivityWorkerHistoryRequest = types.GetActivityWorkerHistoryRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+	}
+	GetActivityWorkerHistoryResponse = types.GetActivityWorkerHistoryResponse{
+		ActivityID: ActivityID,
+		Attempts:   []*types.ActivityWorkerAttempt{&ActivityWorkerAttempt},
+	}
+	RespondActivityTaskNackRequest = types.RespondActivityTaskNackRequest{
+		TaskToken: TaskToken,
+		Reason:    Reason,
+		Identity:  Identity,
+	}
+	GetActivityRetryStatsRequest = types.GetActivityRetryStatsRequest{
+		Domain:    DomainName,
+		StartTime: common.Int64Ptr(Timestamp1),
+		EndTime:   common.Int64Ptr(Timestamp2),
+	}
+	GetActivityRetryStatsResponse = types.GetActivityRetryStatsResponse{
+		StartTime:             Timestamp1,
+		EndTime:               Timestamp2,
+		TotalAttempts:         5,
+		RetriedAttempts:       2,
+		Successes:             3,
+		FirstAttemptSuccesses: 2,
+	}
+	ActivityTokenValidationFailure = types.ActivityTokenValidationFailure{
+		Operation:       "RespondActivityTaskCompleted",
+		Cause:           types.ActivityTokenValidationFailureCauseAlreadyCompleted.Ptr(),
+		WorkflowID:      WorkflowID,
+		RunID:           RunID,
+		ActivityID:      ActivityID,
+		ActivityType:    ActivityTypeName,
+		ScheduleID:      EventID1,
+		ScheduleAttempt: int64(Attempt),
+		Timestamp:       common.Int64Ptr(Timestamp1),
+	}
+	GetActivityTokenValidationFailuresRequest = types.GetActivityTokenValidationFailuresRequest{
+		Domain: DomainName,
+	}
+	GetActivityTokenValidationFailuresResponse = types.GetActivityTokenValidationFailuresResponse{
+		Failures: []*types.ActivityTokenValidationFailure{&ActivityTokenValidationFailure},
+	}
+	ActivityAttemptTiming = types.ActivityAttemptTiming{
+		ScheduledEventID: EventID1,
+		Attempt:          Attempt,
+		StartedTimestamp: common.Int64Ptr(Timestamp1),
+		EndTimestamp:     common.Int64Ptr(Timestamp2),
+		Outcome:          types.EventTypeActivityTaskTimedOut.Ptr(),
+	}
+	GetActivityAttemptTimingsRequest = types.GetActivityAttemptTimingsRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+	}
+	GetActivityAttemptTimingsResponse = types.GetActivityAttemptTimingsResponse{
+		ActivityID: ActivityID,
+		Attempts:   []*types.ActivityAttemptTiming{&ActivityAttemptTiming},
+	}
+	ResetActivityAttemptsRequest = types.ResetActivityAttemptsRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+		Identity:          Identity,
+	}
+	SignalActivityRequest = types.SignalActivityRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+		SignalName:        SignalName,
+		Input:             Payload1,
+		Identity:          Identity,
+	}
 	SignalWithStartWorkflowExecutionRequest = types.SignalWithStartWorkflowExecutionRequest{
 		Domain:                              DomainName,
 		WorkflowID:                          WorkflowID,
@@ -384,6 +522,16 @@ var (
 		Identity:            Identity,
 		FirstExecutionRunID: RunID,
 	}
+	BatchDescribeWorkflowExecutionsRequest = types.BatchDescribeWorkflowExecutionsRequest{
+		Domain:     DomainName,
+		Executions: []*types.WorkflowExecution{&WorkflowExecution},
+	}
+	BatchDescribeWorkflowExecutionsResponse = types.BatchDescribeWorkflowExecutionsResponse{
+		Results: []*types.DescribeWorkflowExecutionResult{
+			{Execution: &WorkflowExecution, Response: &DescribeWorkflowExecutionResponse},
+			{Execution: &WorkflowExecution, Error: ErrorMessage},
+		},
+	}
 	DescribeWorkflowExecutionRequest = types.DescribeWorkflowExecutionRequest{
 		Domain:    DomainName,
 		Execution: &WorkflowExecution,
@@ -458,4 +606,46 @@ var (
 	DescribeDomainResponseArray = []*types.DescribeDomainResponse{
 		&DescribeDomainResponse,
 	}
+	WaitForActivityCompletionRequest = types.WaitForActivityCompletionRequest{
+		Domain:         DomainName,
+		Execution:      &WorkflowExecution,
+		ActivityID:     ActivityID,
+		TimeoutSeconds: Duration1,
+	}
+	WaitForActivityCompletionResponse = types.WaitForActivityCompletionResponse{
+		CloseEvent: &HistoryEvent_ActivityTaskCompleted,
+	}
+	RenewActivityLeaseRequest = types.RenewActivityLeaseRequest{
+		TaskToken: TaskToken,
+		Identity:  Identity,
+	}
+	RenewActivityLeaseResponse = types.RenewActivityLeaseResponse{
+		CancelRequested:     true,
+		WorkflowCloseStatus: &WorkflowExecutionCloseStatus,
+	}
+	PendingActivityScheduleAttributes = types.PendingActivityScheduleAttributes{
+		ActivityID:               ActivityID,
+		ScheduleID:               EventID1,
+		ScheduledEventAttributes: &ActivityTaskScheduledEventAttributes,
+	}
+	ListPendingActivityScheduleAttributesRequest = types.ListPendingActivityScheduleAttributesRequest{
+		Domain:    DomainName,
+		Execution: &WorkflowExecution,
+	}
+	ListPendingActivityScheduleAttributesResponse = types.ListPendingActivityScheduleAttributesResponse{
+		PendingActivities: []*types.PendingActivityScheduleAttributes{&PendingActivityScheduleAttributes},
+	}
+	ActivityTypeCount = types.ActivityTypeCount{
+		ActivityType: &ActivityType,
+		Count:        int64(Attempt),
+	}
+	ListActivityTypesRequest = types.ListActivityTypesRequest{
+		Domain:       DomainName,
+		EarliestTime: common.Int64Ptr(Timestamp1),
+		LatestTime:   common.Int64Ptr(Timestamp2),
+	}
+	ListActivityTypesResponse = types.ListActivityTypesResponse{
+		ActivityTypes: []*types.ActivityTypeCount{&ActivityTypeCount},
+		Truncated:     true,
+	}
 )
@@ -37,6 +37,10 @@ var (
 		MutableStateInCache:    "MutableStateInCache",
 		MutableStateInDatabase: "MutableStateInDatabase",
 	}
+	HistoryBatchDescribeWorkflowExecutionsRequest = types.HistoryBatchDescribeWorkflowExecutionsRequest{
+		DomainUUID: DomainID,
+		Request:    &BatchDescribeWorkflowExecutionsRequest,
+	}
 	HistoryDescribeQueueRequest             = AdminDescribeQueueRequest
 	HistoryDescribeQueueResponse            = AdminDescribeQueueResponse
 	HistoryDescribeWorkflowExecutionRequest = types.HistoryDescribeWorkflowExecutionRequest{
@@ -123,15 +127,22 @@ var (
 	HistoryRecordActivityTaskHeartbeatRequest = types.HistoryRecordActivityTaskHeartbeatRequest{
 		DomainUUID:       DomainID,
 		HeartbeatRequest: &RecordActivityTaskHeartbeatRequest,
-	}
-	HistoryRecordActivityTaskHeartbeatResponse = RecordActivityTaskHeartbeatResponse
-	HistoryRecordActivityTaskStartedRequest    = types.RecordActivityTaskStartedRequest{
-		DomainUUID:        DomainID,
-		WorkflowExecution: &WorkflowExecution,
-		ScheduleID:        EventID1,
-		TaskID:            TaskID,
-		RequestID:         RequestID,
-		PollRequest:       &PollForActivityTaskRequest,
+		RenewLeaseOnly:   true,
+	}
+	HistoryRecordActivityTaskHeartbeatResponse = types.RecordActivityTaskHeartbeatResponse{
+		CancelRequested:     true,
+		Signals:             []*types.ActivitySignal{&ActivitySignal},
+		WorkflowCloseStatus: &WorkflowExecutionCloseStatus,
+	}
+	HistoryRecordActivityTaskStartedRequest = types.RecordActivityTaskStartedRequest{
+		DomainUUID:               DomainID,
+		WorkflowExecution:        &WorkflowExecution,
+		ScheduleID:               EventID1,
+		TaskID:                   TaskID,
+		RequestID:                RequestID,
+		PollRequest:              &PollForActivityTaskRequest,
+		VisibilityTimeoutSeconds: Duration1,
+		Prefetched:               true,
 	}
 	HistoryRecordActivityTaskStartedResponse = types.RecordActivityTaskStartedResponse{
 		ScheduledEvent:                  &HistoryEvent_WorkflowExecutionStarted,
@@ -248,6 +259,62 @@ var (
 	HistorySignalWithStartWorkflowExecutionResponse = types.StartWorkflowExecutionResponse{
 		RunID: RunID,
 	}
+	HistoryGetActivityEffectiveConfigRequest = types.HistoryGetActivityEffectiveConfigRequest{
+		DomainUUID: DomainID,
+		Request:    &GetActivityEffectiveConfigRequest,
+	}
+	HistoryGetActivityNextRetryTimeRequest = types.HistoryGetActivityNextRetryTimeRequest{
+		DomainUUID: DomainID,
+		Request:    &GetActivityNextRetryTimeRequest,
+	}
+	HistoryReplayActivityCompletionsRequest = types.HistoryReplayActivityCompletionsRequest{
+		DomainUUID: DomainID,
+		Request:    &ReplayActivityCompletionsRequest,
+	}
+	HistoryGetActivityRescheduleReasonsRequest = types.HistoryGetActivityRescheduleReasonsRequest{
+		DomainUUID: DomainID,
+		Request:    &GetActivityRescheduleReasonsRequest,
+	}
+	HistoryGetActivityWorkerHistoryRequest = types.HistoryGetActivityWorkerHistoryRequest{
+		DomainUUID: DomainID,
+		Request:    &GetActivityWorkerHistoryRequest,
+	}
+	HistoryRespondActivityTaskNackRequest = types.HistoryRespondActivityTaskNackRequest{
+		DomainUUID:  DomainID,
+		NackRequest: &RespondActivityTaskNackRequest,
+	}
+	HistoryGetActivityRetryStatsRequest = types.HistoryGetActivityRetryStatsRequest{
+		DomainUUID: DomainID,
+		Request:    &GetActivityRetryStatsRequest,
+	}
+	HistoryGetActivityTokenValidationFailuresRequest = types.HistoryGetActivityTokenValidationFailuresRequest{
+		DomainUUID: DomainID,
+		Request:    &GetActivityTokenValidationFailuresRequest,
+	}
+	HistoryGetActivityAttemptTimingsRequest = types.HistoryGetActivityAttemptTimingsRequest{
+		DomainUUID: DomainID,
+		Request:    &GetActivityAttemptTimingsRequest,
+	}
+	HistoryExpireActivityHeartbeatRequest = types.HistoryExpireActivityHeartbeatRequest{
+		DomainUUID: DomainID,
+		Request:    &ExpireActivityHeartbeatRequest,
+	}
+	HistoryResetActivityAttemptsRequest = types.HistoryResetActivityAttemptsRequest{
+		DomainUUID: DomainID,
+		Request:    &ResetActivityAttemptsRequest,
+	}
+	HistoryRedriveTimedOutActivitiesRequest = types.HistoryRedriveTimedOutActivitiesRequest{
+		DomainUUID: DomainID,
+		Request:    &RedriveTimedOutActivitiesRequest,
+	}
+	HistoryCancelPendingActivitiesRequest = types.HistoryCancelPendingActivitiesRequest{
+		DomainUUID: DomainID,
+		Request:    &CancelPendingActivitiesRequest,
+	}
+	HistorySignalActivityRequest = types.HistorySignalActivityRequest{
+		DomainUUID:    DomainID,
+		SignalRequest: &SignalActivityRequest,
+	}
 	HistorySignalWorkflowExecutionRequest = types.HistorySignalWorkflowExecutionRequest{
 		DomainUUID:                DomainID,
 		SignalRequest:             &SignalWorkflowExecutionRequest,
@@ -61,6 +61,7 @@ var (
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
 		PartitionConfig:               PartitionConfig,
+		Priority:                      common.Int32Ptr(5),
 	}
 	MatchingAddDecisionTaskRequest = types.AddDecisionTaskRequest{
 		DomainUUID:                    DomainID,
@@ -73,7 +74,8 @@ var (
 		PartitionConfig:               PartitionConfig,
 	}
 	MatchingAddActivityTaskResponse = types.AddActivityTaskResponse{
-		PartitionConfig: &TaskListPartitionConfig,
+		PartitionConfig:          &TaskListPartitionConfig,
+		VisibilityTimeoutSeconds: Duration1,
 	}
 	MatchingAddDecisionTaskResponse = types.AddDecisionTaskResponse{
 		PartitionConfig: &TaskListPartitionConfig,
@@ -84,6 +86,11 @@ var (
 		TaskList:     &TaskList,
 		PollerID:     PollerID,
 	}
+	MatchingRecordBackpressureHintRequest = types.MatchingRecordBackpressureHintRequest{
+		DomainUUID: DomainID,
+		TaskList:   &TaskList,
+		Hint:       0.5,
+	}
 	MatchingDescribeTaskListRequest = types.MatchingDescribeTaskListRequest{
 		DomainUUID:  DomainID,
 		DescRequest: &DescribeTaskListRequest,
@@ -101,12 +108,25 @@ var (
 		DecisionTaskListPartitions: TaskListPartitionMetadataArray,
 	}
 	MatchingPollForActivityTaskRequest = types.MatchingPollForActivityTaskRequest{
-		DomainUUID:     DomainID,
-		PollerID:       PollerID,
-		PollRequest:    &PollForActivityTaskRequest,
+		DomainUUID: DomainID,
+		PollerID:   PollerID,
+		PollRequest: &types.PollForActivityTaskRequest{
+			Domain:           DomainName,
+			TaskList:         &TaskList,
+			Identity:         Identity,
+			TaskListMetadata: &TaskListMetadata,
+			PrefetchCount:    2,
+		},
 		ForwardedFrom:  ForwardedFrom,
 		IsolationGroup: IsolationGroup,
 	}
+	MatchingPrefetchedActivityTaskResponse = types.MatchingPollForActivityTaskResponse{
+		TaskToken:         TaskToken,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+		ActivityType:      &ActivityType,
+		Attempt:           Attempt,
+	}
 	MatchingPollForActivityTaskResponse = types.MatchingPollForActivityTaskResponse{
 		TaskToken:                       TaskToken,
 		WorkflowExecution:               &WorkflowExecution,
@@ -127,6 +147,7 @@ var (
 		PartitionConfig:                 &TaskListPartitionConfig,
 		LoadBalancerHints:               &LoadBalancerHints,
 		AutoConfigHint:                  &AutoConfigHint,
+		PrefetchedTasks:                 []*types.MatchingPollForActivityTaskResponse{&MatchingPrefetchedActivityTaskResponse},
 	}
 	MatchingPollForDecisionTaskRequest = types.MatchingPollForDecisionTaskRequest{
 		DomainUUID:     DomainID,
@@ -207,4 +228,17 @@ var (
 		TaskListType:    &TaskListType,
 		PartitionConfig: &TaskListPartitionConfig,
 	}
+
+	MatchingRemoveTaskListTaskRequest = types.MatchingRemoveTaskListTaskRequest{
+		DomainUUID:        DomainID,
+		TaskList:          &TaskList,
+		TaskID:            TaskID,
+		WorkflowExecution: &WorkflowExecution,
+		ScheduleID:        EventID1,
+		Operator:          "operator",
+		Reason:            Reason,
+	}
+	MatchingRemoveTaskListTaskResponse = types.MatchingRemoveTaskListTaskResponse{
+		TaskID: TaskID,
+	}
 )
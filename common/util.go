@@ -94,6 +94,8 @@ const (
 
 	contextExpireThreshold = 10 * time.Millisecond
 
+	activityDispatchWindowTimeOfDayLayout = "15:04"
+
 	// FailureReasonCompleteResultExceedsLimit is failureReason for complete result exceeds limit
 	FailureReasonCompleteResultExceedsLimit = "COMPLETE_RESULT_EXCEEDS_LIMIT"
 	// FailureReasonFailureDetailsExceedsLimit is failureReason for failure details exceeds limit
@@ -110,6 +112,14 @@ const (
 	FailureReasonTransactionSizeExceedsLimit = "TRANSACTION_SIZE_EXCEEDS_LIMIT"
 	// FailureReasonDecisionAttemptsExceedsLimit is reason to fail workflow when decision attempts fail too many times
 	FailureReasonDecisionAttemptsExceedsLimit = "DECISION_ATTEMPTS_EXCEEDS_LIMIT"
+	// FailureReasonTimeoutPrefix is the prefix of the failureReason recorded for an activity attempt which timed out
+	FailureReasonTimeoutPrefix = "cadenceInternal:Timeout"
+	// FailureReasonActivityResultRejected is the failureReason recorded for an activity attempt whose result was rejected by the activity result validator
+	FailureReasonActivityResultRejected = "cadenceInternal:ActivityResultRejected"
+	// FailureReasonActivityNackLimitExceeded is the failureReason recorded for an activity attempt nacked once more than history.activityMaxNacks allows
+	FailureReasonActivityNackLimitExceeded = "cadenceInternal:ActivityNackLimitExceeded"
+	// FailureReasonActivityDependencyFailed is the failureReason recorded for an activity failed because the activity of its DependsOnActivityID did not complete
+	FailureReasonActivityDependencyFailed = "cadenceInternal:ActivityDependencyFailed"
 )
 
 var (
@@ -297,6 +307,7 @@ func FrontendRetry(err error) bool {
 // IsExpectedError checks if an error is expected to happen in normal operation of the system
 func IsExpectedError(err error) bool {
 	return IsServiceTransientError(err) ||
+		IsActivityCapabilityMismatchError(err) ||
 		IsEntityNotExistsError(err) ||
 		errors.As(err, new(*types.WorkflowExecutionAlreadyCompletedError))
 }
@@ -316,7 +327,8 @@ func IsServiceTransientError(err error) bool {
 	case errors.As(err, &typesInternalServiceError):
 		return true
 	case errors.As(err, &typesServiceBusyError):
-		return true
+		// retrying with the same poller can not succeed, the task has to go to another poller
+		return typesServiceBusyError.Reason != ActivityCapabilityMismatchReason
 	case errors.As(err, &typesShardOwnershipLostError):
 		return true
 	case errors.As(err, &typesTaskListNotOwnedByHostError):
@@ -340,6 +352,13 @@ func IsEntityNotExistsError(err error) bool {
 	return ok
 }
 
+// IsActivityCapabilityMismatchError checks if the error is returned because a poller does not advertise
+// the capabilities required by the activity it was matched with
+func IsActivityCapabilityMismatchError(err error) bool {
+	var sbErr *types.ServiceBusyError
+	return errors.As(err, &sbErr) && sbErr.Reason == ActivityCapabilityMismatchReason
+}
+
 // IsServiceBusyError checks if the error is a service busy error.
 func IsServiceBusyError(err error) bool {
 	switch err.(type) {
@@ -474,6 +493,7 @@ func CreateMatchingPollForDecisionTaskResponse(historyResponse *types.RecordDeci
 		StartedTimestamp:          historyResponse.StartedTimestamp,
 		Queries:                   historyResponse.Queries,
 		TotalHistoryBytes:         historyResponse.HistorySize,
+		ActivityBatchCompleted:    historyResponse.ActivityBatchCompleted,
 	}
 	if historyResponse.GetPreviousStartedEventID() != EmptyEventID {
 		matchingResp.PreviousStartedEventID = historyResponse.PreviousStartedEventID
@@ -563,18 +583,85 @@ func ValidateRetryPolicy(policy *types.RetryPolicy) error {
 	if policy.GetMaximumIntervalInSeconds() > 0 && policy.GetMaximumIntervalInSeconds() < policy.GetInitialIntervalInSeconds() {
 		return &types.BadRequestError{Message: "MaximumIntervalInSeconds cannot be less than InitialIntervalInSeconds on retry policy."}
 	}
+	if policy.GetMinimumIntervalInSeconds() < 0 {
+		return &types.BadRequestError{Message: "MinimumIntervalInSeconds cannot be less than 0 on retry policy."}
+	}
+	if policy.GetMaximumIntervalInSeconds() > 0 && policy.GetMaximumIntervalInSeconds() < policy.GetMinimumIntervalInSeconds() {
+		return &types.BadRequestError{Message: "MaximumIntervalInSeconds cannot be less than MinimumIntervalInSeconds on retry policy."}
+	}
 	if policy.GetMaximumAttempts() < 0 {
 		return &types.BadRequestError{Message: "MaximumAttempts cannot be less than 0 on retry policy."}
 	}
 	if policy.GetExpirationIntervalInSeconds() < 0 {
 		return &types.BadRequestError{Message: "ExpirationIntervalInSeconds cannot be less than 0 on retry policy."}
 	}
+	if policy.GetMaxTotalExecutionSeconds() < 0 {
+		return &types.BadRequestError{Message: "MaxTotalExecutionSeconds cannot be less than 0 on retry policy."}
+	}
 	if policy.GetMaximumAttempts() == 0 && policy.GetExpirationIntervalInSeconds() == 0 {
 		return &types.BadRequestError{Message: "MaximumAttempts and ExpirationIntervalInSeconds are both 0. At least one of them must be specified."}
 	}
 	return nil
 }
 
+// ValidateActivityDispatchWindow validates the dispatch window of an activity
+func ValidateActivityDispatchWindow(window *types.ActivityDispatchWindow) error {
+	if window == nil {
+		return nil
+	}
+	_, _, _, err := parseActivityDispatchWindow(window)
+	return err
+}
+
+// ActivityDispatchWindowOpensIn returns how long after now the dispatch window of an activity opens,
+// 0 if the window is open or if the activity has none
+func ActivityDispatchWindowOpensIn(window *types.ActivityDispatchWindow, now time.Time) (time.Duration, error) {
+	if window == nil {
+		return 0, nil
+	}
+	start, end, location, err := parseActivityDispatchWindow(window)
+	if err != nil {
+		return 0, err
+	}
+	// time of day is taken from the wall clock, so a window opens at the same local time across DST changes
+	local := now.In(location)
+	timeOfDay := time.Duration(local.Hour())*time.Hour +
+		time.Duration(local.Minute())*time.Minute +
+		time.Duration(local.Second())*time.Second +
+		time.Duration(local.Nanosecond())
+	switch {
+	case start < end && timeOfDay >= start && timeOfDay < end:
+		return 0, nil
+	case start > end && (timeOfDay >= start || timeOfDay < end):
+		return 0, nil
+	case timeOfDay < start:
+		return start - timeOfDay, nil
+	default:
+		return 24*time.Hour - timeOfDay + start, nil
+	}
+}
+
+func parseActivityDispatchWindow(window *types.ActivityDispatchWindow) (time.Duration, time.Duration, *time.Location, error) {
+	start, err := time.Parse(activityDispatchWindowTimeOfDayLayout, window.GetStartTimeOfDay())
+	if err != nil {
+		return 0, 0, nil, &types.BadRequestError{Message: "StartTimeOfDay of DispatchWindow must be formatted as HH:MM."}
+	}
+	end, err := time.Parse(activityDispatchWindowTimeOfDayLayout, window.GetEndTimeOfDay())
+	if err != nil {
+		return 0, 0, nil, &types.BadRequestError{Message: "EndTimeOfDay of DispatchWindow must be formatted as HH:MM."}
+	}
+	location, err := time.LoadLocation(window.GetTimezone())
+	if err != nil {
+		return 0, 0, nil, &types.BadRequestError{Message: fmt.Sprintf("Timezone of DispatchWindow is unknown: %v.", window.GetTimezone())}
+	}
+	startOfDay := time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute
+	endOfDay := time.Duration(end.Hour())*time.Hour + time.Duration(end.Minute())*time.Minute
+	if startOfDay == endOfDay {
+		return 0, 0, nil, &types.BadRequestError{Message: "StartTimeOfDay and EndTimeOfDay of DispatchWindow cannot be equal."}
+	}
+	return startOfDay, endOfDay, location, nil
+}
+
 // CreateHistoryStartWorkflowRequest create a start workflow request for history
 func CreateHistoryStartWorkflowRequest(
 	domainID string,
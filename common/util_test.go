@@ -86,6 +86,10 @@ func TestIsServiceTransientError(t *testing.T) {
 			err:  &types.ServiceBusyError{},
 			want: true,
 		},
+		"ServiceBusyError activity capability mismatch": {
+			err:  &types.ServiceBusyError{Reason: ActivityCapabilityMismatchReason},
+			want: false,
+		},
 		"ShardOwnershipLostError": {
 			err:  &types.ShardOwnershipLostError{},
 			want: true,
@@ -795,6 +799,23 @@ func TestValidateRetryPolicy_Error(t *testing.T) {
 			},
 			wantErr: &types.BadRequestError{Message: "MaximumIntervalInSeconds cannot be less than InitialIntervalInSeconds on retry policy."},
 		},
+		"MinimumIntervalInSeconds equals -1": {
+			policy: &types.RetryPolicy{
+				InitialIntervalInSeconds: 2,
+				BackoffCoefficient:       1,
+				MinimumIntervalInSeconds: -1,
+			},
+			wantErr: &types.BadRequestError{Message: "MinimumIntervalInSeconds cannot be less than 0 on retry policy."},
+		},
+		"MaximumIntervalInSeconds less than MinimumIntervalInSeconds": {
+			policy: &types.RetryPolicy{
+				InitialIntervalInSeconds: 2,
+				BackoffCoefficient:       1,
+				MaximumIntervalInSeconds: 5,
+				MinimumIntervalInSeconds: 6,
+			},
+			wantErr: &types.BadRequestError{Message: "MaximumIntervalInSeconds cannot be less than MinimumIntervalInSeconds on retry policy."},
+		},
 		"MaximumAttempts equals -1": {
 			policy: &types.RetryPolicy{
 				InitialIntervalInSeconds: 2,
@@ -814,6 +835,15 @@ func TestValidateRetryPolicy_Error(t *testing.T) {
 			},
 			wantErr: &types.BadRequestError{Message: "ExpirationIntervalInSeconds cannot be less than 0 on retry policy."},
 		},
+		"MaxTotalExecutionSeconds equals -1": {
+			policy: &types.RetryPolicy{
+				InitialIntervalInSeconds: 2,
+				BackoffCoefficient:       1,
+				MaximumAttempts:          3,
+				MaxTotalExecutionSeconds: -1,
+			},
+			wantErr: &types.BadRequestError{Message: "MaxTotalExecutionSeconds cannot be less than 0 on retry policy."},
+		},
 		"MaximumAttempts and ExpirationIntervalInSeconds equal 0": {
 			policy: &types.RetryPolicy{
 				InitialIntervalInSeconds:    2,
@@ -833,6 +863,67 @@ func TestValidateRetryPolicy_Error(t *testing.T) {
 	}
 }
 
+func TestValidateActivityDispatchWindow(t *testing.T) {
+	for name, c := range map[string]struct {
+		window  *types.ActivityDispatchWindow
+		wantErr error
+	}{
+		"nil window": {},
+		"valid window": {
+			window: &types.ActivityDispatchWindow{StartTimeOfDay: "09:00", EndTimeOfDay: "17:30", Timezone: "America/New_York"},
+		},
+		"window spanning midnight without timezone": {
+			window: &types.ActivityDispatchWindow{StartTimeOfDay: "22:00", EndTimeOfDay: "06:00"},
+		},
+		"invalid start": {
+			window:  &types.ActivityDispatchWindow{StartTimeOfDay: "9am", EndTimeOfDay: "17:00"},
+			wantErr: &types.BadRequestError{Message: "StartTimeOfDay of DispatchWindow must be formatted as HH:MM."},
+		},
+		"invalid end": {
+			window:  &types.ActivityDispatchWindow{StartTimeOfDay: "09:00", EndTimeOfDay: "24:00"},
+			wantErr: &types.BadRequestError{Message: "EndTimeOfDay of DispatchWindow must be formatted as HH:MM."},
+		},
+		"unknown timezone": {
+			window:  &types.ActivityDispatchWindow{StartTimeOfDay: "09:00", EndTimeOfDay: "17:00", Timezone: "Mars/Olympus"},
+			wantErr: &types.BadRequestError{Message: "Timezone of DispatchWindow is unknown: Mars/Olympus."},
+		},
+		"empty window": {
+			window:  &types.ActivityDispatchWindow{StartTimeOfDay: "09:00", EndTimeOfDay: "09:00"},
+			wantErr: &types.BadRequestError{Message: "StartTimeOfDay and EndTimeOfDay of DispatchWindow cannot be equal."},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.wantErr, ValidateActivityDispatchWindow(c.window))
+		})
+	}
+}
+
+func TestActivityDispatchWindowOpensIn(t *testing.T) {
+	businessHours := &types.ActivityDispatchWindow{StartTimeOfDay: "09:00", EndTimeOfDay: "17:00", Timezone: "UTC"}
+	overnight := &types.ActivityDispatchWindow{StartTimeOfDay: "22:00", EndTimeOfDay: "06:00", Timezone: "UTC"}
+	day := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	for name, c := range map[string]struct {
+		window *types.ActivityDispatchWindow
+		now    time.Time
+		want   time.Duration
+	}{
+		"no window":                        {now: day.Add(3 * time.Hour)},
+		"before window":                    {window: businessHours, now: day.Add(8 * time.Hour), want: time.Hour},
+		"window opening":                   {window: businessHours, now: day.Add(9 * time.Hour)},
+		"within window":                    {window: businessHours, now: day.Add(12 * time.Hour)},
+		"window closing":                   {window: businessHours, now: day.Add(17 * time.Hour), want: 16 * time.Hour},
+		"overnight window before midnight": {window: overnight, now: day.Add(23 * time.Hour)},
+		"overnight window after midnight":  {window: overnight, now: day.Add(5 * time.Hour)},
+		"overnight window closed":          {window: overnight, now: day.Add(12 * time.Hour), want: 10 * time.Hour},
+	} {
+		t.Run(name, func(t *testing.T) {
+			opensIn, err := ActivityDispatchWindowOpensIn(c.window, c.now)
+			require.NoError(t, err)
+			assert.Equal(t, c.want, opensIn)
+		})
+	}
+}
+
 func TestConvertGetTaskFailedCauseToErr(t *testing.T) {
 	for cause, wantErr := range map[types.GetTaskFailedCause]error{
 		types.GetTaskFailedCauseServiceBusy:        &types.ServiceBusyError{},
@@ -27,6 +27,7 @@ import (
 	"fmt"
 	"math/rand"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -883,14 +884,15 @@ func (s *IntegrationSuite) TestActivityTimeouts() {
 	}
 
 	poller := &TaskPoller{
-		Engine:          s.Engine,
-		Domain:          s.DomainName,
-		TaskList:        taskList,
-		Identity:        identity,
-		DecisionHandler: dtHandler,
-		ActivityHandler: atHandler,
-		Logger:          s.Logger,
-		T:               s.T(),
+		Engine:                  s.Engine,
+		Domain:                  s.DomainName,
+		TaskList:                taskList,
+		Identity:                identity,
+		DecisionHandler:         dtHandler,
+		ActivityHandler:         atHandler,
+		Logger:                  s.Logger,
+		T:                       s.T(),
+		ActivityDispatchTracker: NewActivityDispatchTracker(s.T()),
 	}
 
 	_, err := poller.PollAndProcessDecisionTask(false, false)
@@ -1113,6 +1115,246 @@ func (s *IntegrationSuite) TestActivityHeartbeatTimeouts() {
 	}
 }
 
+func (s *IntegrationSuite) TestActivityConcurrencyLimit() {
+	id := "integration-activity-concurrency-limit-test"
+	wt := "integration-activity-concurrency-limit-test-type"
+	tl := "integration-activity-concurrency-limit-test-tasklist"
+	identity := "worker1"
+	activityName := "concurrency_limit_activity"
+	activityCount := 6
+	concurrencyLimit := 2
+
+	workflowType := &types.WorkflowType{Name: wt}
+	taskList := &types.TaskList{Name: tl}
+
+	request := &types.StartWorkflowExecutionRequest{
+		RequestID:                           uuid.New(),
+		Domain:                              s.DomainName,
+		WorkflowID:                          id,
+		WorkflowType:                        workflowType,
+		TaskList:                            taskList,
+		Input:                               nil,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+		Identity:                            identity,
+	}
+
+	ctx, cancel := createContext()
+	defer cancel()
+	we, err0 := s.Engine.StartWorkflowExecution(ctx, request)
+	s.Nil(err0)
+
+	s.Logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.RunID))
+
+	workflowComplete := false
+	activitiesScheduled := false
+	activitiesCompleted := 0
+	dtHandler := func(execution *types.WorkflowExecution, wt *types.WorkflowType,
+		previousStartedEventID, startedEventID int64, history *types.History) ([]byte, []*types.Decision, error) {
+		if !activitiesScheduled {
+			activitiesScheduled = true
+			decisions := []*types.Decision{}
+			for i := 0; i < activityCount; i++ {
+				decisions = append(decisions, &types.Decision{
+					DecisionType: types.DecisionTypeScheduleActivityTask.Ptr(),
+					ScheduleActivityTaskDecisionAttributes: &types.ScheduleActivityTaskDecisionAttributes{
+						ActivityID:                    fmt.Sprintf("activity_%v", i),
+						ActivityType:                  &types.ActivityType{Name: activityName},
+						TaskList:                      &types.TaskList{Name: tl},
+						ScheduleToCloseTimeoutSeconds: common.Int32Ptr(60),
+						ScheduleToStartTimeoutSeconds: common.Int32Ptr(60),
+						StartToCloseTimeoutSeconds:    common.Int32Ptr(10),
+					},
+				})
+			}
+			return nil, decisions, nil
+		}
+
+		for _, event := range history.Events[previousStartedEventID:] {
+			if event.GetEventType() == types.EventTypeActivityTaskCompleted {
+				activitiesCompleted++
+			}
+		}
+		if activitiesCompleted == activityCount {
+			s.Logger.Info("Completing Workflow.")
+			workflowComplete = true
+			return nil, []*types.Decision{{
+				DecisionType: types.DecisionTypeCompleteWorkflowExecution.Ptr(),
+				CompleteWorkflowExecutionDecisionAttributes: &types.CompleteWorkflowExecutionDecisionAttributes{
+					Result: []byte("Done."),
+				},
+			}}, nil
+		}
+		return nil, []*types.Decision{}, nil
+	}
+
+	var lock sync.Mutex
+	running := 0
+	maxRunning := 0
+	atHandler := func(execution *types.WorkflowExecution, activityType *types.ActivityType,
+		activityID string, input []byte, taskToken []byte) ([]byte, bool, error) {
+		lock.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		lock.Unlock()
+
+		time.Sleep(time.Second)
+
+		lock.Lock()
+		running--
+		lock.Unlock()
+		return []byte("Activity Result."), false, nil
+	}
+
+	poller := &TaskPoller{
+		Engine:                  s.Engine,
+		Domain:                  s.DomainName,
+		TaskList:                taskList,
+		Identity:                identity,
+		DecisionHandler:         dtHandler,
+		ActivityHandler:         atHandler,
+		Logger:                  s.Logger,
+		T:                       s.T(),
+		ActivityDispatchTracker: NewActivityDispatchTracker(s.T()),
+		ConcurrencyLimit:        concurrencyLimit,
+	}
+
+	_, err := poller.PollAndProcessDecisionTask(false, false)
+	s.True(err == nil || err == tasklist.ErrNoTasks)
+
+	stopActivities := poller.PollAndProcessActivities()
+
+	s.Logger.Info("Waiting for workflow to complete", tag.WorkflowRunID(we.RunID))
+	for i := 0; i < 10 && !workflowComplete; i++ {
+		_, err := poller.PollAndProcessDecisionTask(false, false)
+		s.True(err == nil || err == tasklist.ErrNoTasks)
+	}
+	stopActivities()
+
+	s.True(workflowComplete)
+	s.Equal(activityCount, activitiesCompleted)
+	// the pool never processes more activities than its limit, and does use all of it
+	s.Equal(concurrencyLimit, maxRunning)
+}
+
+func (s *IntegrationSuite) TestActivityLatency() {
+	id := "integration-activity-latency-test"
+	wt := "integration-activity-latency-test-type"
+	tl := "integration-activity-latency-test-tasklist"
+	identity := "worker1"
+	activityName := "latency_activity"
+	activityCount := 4
+	latency := 3 * time.Second
+
+	workflowType := &types.WorkflowType{Name: wt}
+	taskList := &types.TaskList{Name: tl}
+
+	request := &types.StartWorkflowExecutionRequest{
+		RequestID:                           uuid.New(),
+		Domain:                              s.DomainName,
+		WorkflowID:                          id,
+		WorkflowType:                        workflowType,
+		TaskList:                            taskList,
+		Input:                               nil,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+		Identity:                            identity,
+	}
+
+	ctx, cancel := createContext()
+	defer cancel()
+	we, err0 := s.Engine.StartWorkflowExecution(ctx, request)
+	s.Nil(err0)
+
+	s.Logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.RunID))
+
+	workflowComplete := false
+	activitiesScheduled := false
+	activitiesCompleted := 0
+	activitiesTimedOut := 0
+	dtHandler := func(execution *types.WorkflowExecution, wt *types.WorkflowType,
+		previousStartedEventID, startedEventID int64, history *types.History) ([]byte, []*types.Decision, error) {
+		if !activitiesScheduled {
+			activitiesScheduled = true
+			decisions := []*types.Decision{}
+			for i := 0; i < activityCount; i++ {
+				decisions = append(decisions, &types.Decision{
+					DecisionType: types.DecisionTypeScheduleActivityTask.Ptr(),
+					ScheduleActivityTaskDecisionAttributes: &types.ScheduleActivityTaskDecisionAttributes{
+						ActivityID:                    fmt.Sprintf("activity_%v", i),
+						ActivityType:                  &types.ActivityType{Name: activityName},
+						TaskList:                      &types.TaskList{Name: tl},
+						ScheduleToCloseTimeoutSeconds: common.Int32Ptr(60),
+						ScheduleToStartTimeoutSeconds: common.Int32Ptr(60),
+						StartToCloseTimeoutSeconds:    common.Int32Ptr(10),
+						// shorter than the latency, the activities only complete thanks to the simulated heartbeats
+						HeartbeatTimeoutSeconds: common.Int32Ptr(2),
+					},
+				})
+			}
+			return nil, decisions, nil
+		}
+
+		for _, event := range history.Events[previousStartedEventID:] {
+			switch event.GetEventType() {
+			case types.EventTypeActivityTaskCompleted:
+				activitiesCompleted++
+			case types.EventTypeActivityTaskTimedOut:
+				activitiesTimedOut++
+			}
+		}
+		if activitiesCompleted+activitiesTimedOut == activityCount {
+			s.Logger.Info("Completing Workflow.")
+			workflowComplete = true
+			return nil, []*types.Decision{{
+				DecisionType: types.DecisionTypeCompleteWorkflowExecution.Ptr(),
+				CompleteWorkflowExecutionDecisionAttributes: &types.CompleteWorkflowExecutionDecisionAttributes{
+					Result: []byte("Done."),
+				},
+			}}, nil
+		}
+		return nil, []*types.Decision{}, nil
+	}
+
+	atHandler := func(execution *types.WorkflowExecution, activityType *types.ActivityType,
+		activityID string, input []byte, taskToken []byte) ([]byte, bool, error) {
+		return []byte("Activity Result."), false, nil
+	}
+
+	poller := &TaskPoller{
+		Engine:           s.Engine,
+		Domain:           s.DomainName,
+		TaskList:         taskList,
+		Identity:         identity,
+		DecisionHandler:  dtHandler,
+		ActivityHandler:  atHandler,
+		Logger:           s.Logger,
+		T:                s.T(),
+		ConcurrencyLimit: activityCount,
+		ActivityLatency:  NewActivityLatency(latency, time.Second, 500*time.Millisecond, 42),
+	}
+
+	_, err := poller.PollAndProcessDecisionTask(false, false)
+	s.True(err == nil || err == tasklist.ErrNoTasks)
+
+	start := time.Now()
+	stopActivities := poller.PollAndProcessActivities()
+
+	s.Logger.Info("Waiting for workflow to complete", tag.WorkflowRunID(we.RunID))
+	for i := 0; i < 10 && !workflowComplete; i++ {
+		_, err := poller.PollAndProcessDecisionTask(false, false)
+		s.True(err == nil || err == tasklist.ErrNoTasks)
+	}
+	stopActivities()
+
+	s.True(workflowComplete)
+	s.Equal(activityCount, activitiesCompleted)
+	s.Zero(activitiesTimedOut)
+	s.True(time.Since(start) >= latency)
+}
+
 func (s *IntegrationSuite) TestActivityCancellation() {
 	id := "integration-activity-cancellation-test"
 	wt := "integration-activity-cancellation-test-type"
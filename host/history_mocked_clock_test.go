@@ -0,0 +1,151 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package host
+
+import (
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+)
+
+// TestHistoryMockedClockIntegrationSuite runs the history service on a mocked clock, so that timeouts are tested
+// by advancing it rather than by waiting for them
+func TestHistoryMockedClockIntegrationSuite(t *testing.T) {
+	flag.Parse()
+
+	clusterConfig, err := GetTestClusterConfig("testdata/integration_test_cluster.yaml")
+	if err != nil {
+		panic(err)
+	}
+	clusterConfig.HistoryTimeSource = clock.NewMockedTimeSourceAt(time.Now())
+	testCluster := NewPersistenceTestCluster(t, clusterConfig)
+
+	s := new(HistoryMockedClockIntegrationSuite)
+	params := IntegrationBaseParams{
+		DefaultTestCluster:    testCluster,
+		VisibilityTestCluster: testCluster,
+		TestClusterConfig:     clusterConfig,
+	}
+	s.IntegrationBase = NewIntegrationBase(params)
+	suite.Run(t, s)
+}
+
+func (s *HistoryMockedClockIntegrationSuite) SetupSuite() {
+	s.setupSuite()
+}
+
+func (s *HistoryMockedClockIntegrationSuite) TearDownSuite() {
+	s.TearDownBaseSuite()
+}
+
+func (s *HistoryMockedClockIntegrationSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+func (s *HistoryMockedClockIntegrationSuite) TestActivityScheduleToStartTimeout() {
+	id := "integration-mocked-clock-activity-timeout-test"
+	wt := "integration-mocked-clock-activity-timeout-test-type"
+	tl := "integration-mocked-clock-activity-timeout-test-tasklist"
+	identity := "worker1"
+
+	request := &types.StartWorkflowExecutionRequest{
+		RequestID:                           uuid.New(),
+		Domain:                              s.DomainName,
+		WorkflowID:                          id,
+		WorkflowType:                        &types.WorkflowType{Name: wt},
+		TaskList:                            &types.TaskList{Name: tl},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(3600),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+		Identity:                            identity,
+	}
+
+	ctx, cancel := createContext()
+	defer cancel()
+	we, err := s.Engine.StartWorkflowExecution(ctx, request)
+	s.Nil(err)
+	s.Logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.RunID))
+
+	activityScheduled := false
+	activityTimedOut := false
+	dtHandler := func(execution *types.WorkflowExecution, wt *types.WorkflowType,
+		previousStartedEventID, startedEventID int64, history *types.History) ([]byte, []*types.Decision, error) {
+		if !activityScheduled {
+			activityScheduled = true
+			return nil, []*types.Decision{{
+				DecisionType: types.DecisionTypeScheduleActivityTask.Ptr(),
+				ScheduleActivityTaskDecisionAttributes: &types.ScheduleActivityTaskDecisionAttributes{
+					ActivityID:                    "A",
+					ActivityType:                  &types.ActivityType{Name: "timeout_activity"},
+					TaskList:                      &types.TaskList{Name: "NoWorker"},
+					ScheduleToCloseTimeoutSeconds: common.Int32Ptr(1200),
+					ScheduleToStartTimeoutSeconds: common.Int32Ptr(600),
+					StartToCloseTimeoutSeconds:    common.Int32Ptr(600),
+				},
+			}}, nil
+		}
+
+		for _, event := range history.Events {
+			if event.GetEventType() == types.EventTypeActivityTaskTimedOut &&
+				event.ActivityTaskTimedOutEventAttributes.GetTimeoutType() == types.TimeoutTypeScheduleToStart {
+				activityTimedOut = true
+			}
+		}
+		return nil, []*types.Decision{{
+			DecisionType: types.DecisionTypeCompleteWorkflowExecution.Ptr(),
+			CompleteWorkflowExecutionDecisionAttributes: &types.CompleteWorkflowExecutionDecisionAttributes{
+				Result: []byte("Done."),
+			},
+		}}, nil
+	}
+
+	poller := &TaskPoller{
+		Engine:          s.Engine,
+		Domain:          s.DomainName,
+		TaskList:        &types.TaskList{Name: tl},
+		Identity:        identity,
+		DecisionHandler: dtHandler,
+		Logger:          s.Logger,
+		T:               s.T(),
+	}
+
+	_, err = poller.PollAndProcessDecisionTask(false, false)
+	s.Nil(err)
+	s.True(activityScheduled)
+
+	// the 10 minutes ScheduleToStart timeout of the activity fires without waiting for it
+	s.TestClusterConfig.HistoryTimeSource.Advance(10*time.Minute + time.Second)
+
+	_, err = poller.PollAndProcessDecisionTask(false, false)
+	s.Nil(err)
+	s.True(activityTimedOut)
+}
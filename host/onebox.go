@@ -127,6 +127,7 @@ type (
 		pinotClient                   pinot.GenericClient
 		asyncWFQueues                 map[string]config.AsyncWorkflowQueueProvider
 		timeSource                    clock.TimeSource
+		historyTimeSource             clock.TimeSource
 
 		// dynamicconfig overrides per service
 		frontendDynCfgOverrides map[dynamicconfig.Key]interface{}
@@ -291,6 +292,7 @@ type (
 		PinotClient                   pinot.GenericClient
 		AsyncWFQueues                 map[string]config.AsyncWorkflowQueueProvider
 		TimeSource                    clock.TimeSource
+		HistoryTimeSource             clock.TimeSource
 
 		FrontendDynCfgOverrides map[dynamicconfig.Key]interface{}
 		HistoryDynCfgOverrides  map[dynamicconfig.Key]interface{}
@@ -326,6 +328,7 @@ func NewCadence(params *CadenceParams) Cadence {
 		pinotClient:                   params.PinotClient,
 		asyncWFQueues:                 params.AsyncWFQueues,
 		timeSource:                    params.TimeSource,
+		historyTimeSource:             params.HistoryTimeSource,
 		frontendDynCfgOverrides:       params.FrontendDynCfgOverrides,
 		historyDynCfgOverrides:        params.HistoryDynCfgOverrides,
 		matchingDynCfgOverrides:       params.MatchingDynCfgOverrides,
@@ -712,6 +715,7 @@ func (c *cadenceImpl) startHistory(hosts map[string][]membership.HostInfo, start
 		params.Logger = c.logger
 		params.ThrottledLogger = c.logger
 		params.TimeSource = c.timeSource
+		params.ServiceTimeSource = c.historyTimeSource
 		params.PProfInitializer = newPProfInitializerImpl(c.logger, pprofPorts[i])
 		params.MetricScope = tally.NewTestScope(service.History, make(map[string]string))
 		params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, c.logger))
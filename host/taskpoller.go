@@ -23,6 +23,7 @@ package host
 import (
 	"context"
 	"errors"
+	"math/rand"
 	"sync"
 	"testing"
 	"time"
@@ -59,9 +60,99 @@ type (
 		Logger                              log.Logger
 		T                                   *testing.T
 		CallOptions                         []yarpc.CallOption
+		// ActivityDispatchTracker, when set, fails the test if an activity task is dispatched twice
+		ActivityDispatchTracker *ActivityDispatchTracker
+		// ConcurrencyLimit is the number of activities PollAndProcessActivities processes concurrently, defaults to 1
+		ConcurrencyLimit int
+		// ActivityLatency, when set, delays every activity task before the ActivityHandler runs
+		ActivityLatency *ActivityLatency
+	}
+
+	// ActivityLatency simulates the work of activities for load tests: each activity sleeps for a base
+	// duration plus a random jitter, and heartbeats at a fixed interval while it sleeps. The jitter is
+	// drawn from a seeded source, so a suite generates the same sequence of latencies on every run.
+	ActivityLatency struct {
+		sync.Mutex
+		duration          time.Duration
+		jitter            time.Duration
+		heartbeatInterval time.Duration
+		random            *rand.Rand
+	}
+
+	// ActivityDispatchTracker records the activity tasks received by one or more pollers and fails the
+	// test when the same attempt of a scheduled activity is dispatched more than once. A timeout or
+	// failure followed by a retry bumps the attempt, so legitimate redispatches are not reported.
+	ActivityDispatchTracker struct {
+		sync.Mutex
+		t          *testing.T
+		serializer common.TaskTokenSerializer
+		dispatched map[activityDispatchKey]string
+	}
+
+	activityDispatchKey struct {
+		workflowID string
+		runID      string
+		scheduleID int64
+		attempt    int64
 	}
 )
 
+// NewActivityDispatchTracker creates an ActivityDispatchTracker which can be shared by concurrent pollers
+func NewActivityDispatchTracker(t *testing.T) *ActivityDispatchTracker {
+	return &ActivityDispatchTracker{
+		t:          t,
+		serializer: common.NewJSONTaskTokenSerializer(),
+		dispatched: make(map[activityDispatchKey]string),
+	}
+}
+
+// Record records an activity task token received by the poller with the given identity
+func (d *ActivityDispatchTracker) Record(taskToken []byte, identity string) {
+	token, err := d.serializer.Deserialize(taskToken)
+	if err != nil {
+		d.t.Errorf("failed to deserialize activity task token: %v", err)
+		return
+	}
+	key := activityDispatchKey{
+		workflowID: token.WorkflowID,
+		runID:      token.RunID,
+		scheduleID: token.ScheduleID,
+		attempt:    token.ScheduleAttempt,
+	}
+
+	d.Lock()
+	defer d.Unlock()
+	if previous, ok := d.dispatched[key]; ok {
+		d.t.Errorf("activity task dispatched twice: workflowID %v, runID %v, scheduleID %v, attempt %v, received by %v and %v",
+			key.workflowID, key.runID, key.scheduleID, key.attempt, previous, identity)
+		return
+	}
+	d.dispatched[key] = identity
+}
+
+// NewActivityLatency creates an ActivityLatency sleeping between duration and duration+jitter, heartbeating
+// every heartbeatInterval while sleeping. A zero jitter makes every activity sleep for duration, a zero
+// heartbeatInterval disables heartbeats. It can be shared by concurrent pollers
+func NewActivityLatency(duration, jitter, heartbeatInterval time.Duration, seed int64) *ActivityLatency {
+	return &ActivityLatency{
+		duration:          duration,
+		jitter:            jitter,
+		heartbeatInterval: heartbeatInterval,
+		random:            rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Next returns the latency of the next activity
+func (l *ActivityLatency) Next() time.Duration {
+	if l.jitter <= 0 {
+		return l.duration
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	return l.duration + time.Duration(l.random.Int63n(int64(l.jitter)+1))
+}
+
 // PollAndProcessDecisionTask for decision tasks
 func (p *TaskPoller) PollAndProcessDecisionTask(dumpHistory bool, dropTask bool) (isQueryTask bool, err error) {
 	return p.PollAndProcessDecisionTaskWithAttempt(dumpHistory, dropTask, false, false, int64(0))
@@ -375,49 +466,89 @@ func (p *TaskPoller) PollAndProcessActivityTask(dropTask bool) error {
 			return nil
 		}
 
+		if p.ActivityDispatchTracker != nil {
+			p.ActivityDispatchTracker.Record(response.TaskToken, p.Identity)
+		}
+
 		if dropTask {
 			p.Logger.Info("Dropping Activity task: ")
 			return nil
 		}
 		p.Logger.Debug("Received Activity task", tag.Value(response))
 
-		result, cancel, err2 := p.ActivityHandler(response.WorkflowExecution, response.ActivityType, response.ActivityID,
-			response.Input, response.TaskToken)
-		if cancel {
-			p.Logger.Info("Executing RespondActivityTaskCanceled")
-			ctx, ctxCancel := createContext()
-			taskErr := p.Engine.RespondActivityTaskCanceled(ctx, &types.RespondActivityTaskCanceledRequest{
-				TaskToken: response.TaskToken,
-				Details:   []byte("details"),
-				Identity:  p.Identity,
-			}, p.CallOptions...)
-			ctxCancel()
-			return taskErr
-		}
+		return p.respondActivityTask(response)
+	}
 
-		if err2 != nil {
-			ctx, ctxCancel := createContext()
-			taskErr := p.Engine.RespondActivityTaskFailed(ctx, &types.RespondActivityTaskFailedRequest{
-				TaskToken: response.TaskToken,
-				Reason:    common.StringPtr(err2.Error()),
-				Details:   []byte(err2.Error()),
-				Identity:  p.Identity,
-			}, p.CallOptions...)
-			ctxCancel()
-			return taskErr
-		}
+	return tasklist.ErrNoTasks
+}
 
-		ctx, ctxCancel = createContext()
-		taskErr := p.Engine.RespondActivityTaskCompleted(ctx, &types.RespondActivityTaskCompletedRequest{
+// respondActivityTask runs the activity handler on an activity task and responds with its outcome
+func (p *TaskPoller) respondActivityTask(response *types.PollForActivityTaskResponse) error {
+	result, cancel, err2 := p.handleActivityTask(response)
+	if cancel {
+		p.Logger.Info("Executing RespondActivityTaskCanceled")
+		ctx, ctxCancel := createContext()
+		taskErr := p.Engine.RespondActivityTaskCanceled(ctx, &types.RespondActivityTaskCanceledRequest{
 			TaskToken: response.TaskToken,
+			Details:   []byte("details"),
 			Identity:  p.Identity,
-			Result:    result,
 		}, p.CallOptions...)
 		ctxCancel()
 		return taskErr
 	}
 
-	return tasklist.ErrNoTasks
+	if err2 != nil {
+		ctx, ctxCancel := createContext()
+		taskErr := p.Engine.RespondActivityTaskFailed(ctx, &types.RespondActivityTaskFailedRequest{
+			TaskToken: response.TaskToken,
+			Reason:    common.StringPtr(err2.Error()),
+			Details:   []byte(err2.Error()),
+			Identity:  p.Identity,
+		}, p.CallOptions...)
+		ctxCancel()
+		return taskErr
+	}
+
+	ctx, ctxCancel := createContext()
+	taskErr := p.Engine.RespondActivityTaskCompleted(ctx, &types.RespondActivityTaskCompletedRequest{
+		TaskToken: response.TaskToken,
+		Identity:  p.Identity,
+		Result:    result,
+	}, p.CallOptions...)
+	ctxCancel()
+	return taskErr
+}
+
+// handleActivityTask runs the activity handler on an activity task, after the latency of ActivityLatency if set
+func (p *TaskPoller) handleActivityTask(response *types.PollForActivityTaskResponse) ([]byte, bool, error) {
+	if p.ActivityLatency != nil {
+		p.simulateActivityLatency(response.TaskToken)
+	}
+	return p.ActivityHandler(response.WorkflowExecution, response.ActivityType, response.ActivityID,
+		response.Input, response.TaskToken)
+}
+
+func (p *TaskPoller) simulateActivityLatency(taskToken []byte) {
+	deadline := time.Now().Add(p.ActivityLatency.Next())
+	interval := p.ActivityLatency.heartbeatInterval
+	for {
+		remaining := time.Until(deadline)
+		if interval <= 0 || interval >= remaining {
+			time.Sleep(remaining)
+			return
+		}
+
+		time.Sleep(interval)
+		ctx, ctxCancel := createContext()
+		_, err := p.Engine.RecordActivityTaskHeartbeat(ctx, &types.RecordActivityTaskHeartbeatRequest{
+			TaskToken: taskToken,
+			Identity:  p.Identity,
+		}, p.CallOptions...)
+		ctxCancel()
+		if err != nil {
+			p.Logger.Warn("Activity heartbeat failed while simulating latency", tag.Error(err))
+		}
+	}
 }
 
 // PollAndProcessActivityTaskWithID is similar to PollAndProcessActivityTask but using RespondActivityTask...ByID
@@ -445,14 +576,17 @@ func (p *TaskPoller) PollAndProcessActivityTaskWithID(dropTask bool) error {
 			return nil
 		}
 
+		if p.ActivityDispatchTracker != nil {
+			p.ActivityDispatchTracker.Record(response.TaskToken, p.Identity)
+		}
+
 		if dropTask {
 			p.Logger.Info("Dropping Activity task: ")
 			return nil
 		}
 		p.Logger.Debug("Received Activity task: %v", tag.Value(response))
 
-		result, cancel, err2 := p.ActivityHandler(response.WorkflowExecution, response.ActivityType, response.ActivityID,
-			response.Input, response.TaskToken)
+		result, cancel, err2 := p.handleActivityTask(response)
 		if cancel {
 			p.Logger.Info("Executing RespondActivityTaskCanceled")
 			ctx, ctxCancel := createContext()
@@ -511,6 +645,28 @@ func (p *TaskPoller) PollAndProcessDecisions() context.CancelFunc {
 	}
 }
 
+// PollAndProcessActivities polls and processes activity tasks in the background with up to ConcurrencyLimit
+// activities in flight, each worker of the pool only polls again once its previous activity is processed.
+// The returned function stops polling and waits for the activities in flight.
+func (p *TaskPoller) PollAndProcessActivities() context.CancelFunc {
+	concurrency := p.ConcurrencyLimit
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	ctx, cancel := context.WithCancel(context.Background())
+	for i := 0; i < concurrency; i++ {
+		go p.pollLoop(ctx, &wg, p.doPollActivityTask)
+	}
+
+	return func() {
+		cancel()
+		wg.Wait()
+	}
+}
+
 func (p *TaskPoller) pollLoop(ctx context.Context, wg *sync.WaitGroup, pollFunc func(context.Context) error) {
 	for {
 		select {
@@ -526,6 +682,32 @@ func (p *TaskPoller) pollLoop(ctx context.Context, wg *sync.WaitGroup, pollFunc
 	}
 }
 
+func (p *TaskPoller) doPollActivityTask(ctx context.Context) error {
+	pollCtx, cancel := context.WithTimeout(ctx, time.Second*90)
+	response, err := p.Engine.PollForActivityTask(pollCtx, &types.PollForActivityTaskRequest{
+		Domain:   p.Domain,
+		TaskList: p.TaskList,
+		Identity: p.Identity,
+	}, p.CallOptions...)
+	cancel()
+
+	if err != nil {
+		return err
+	}
+
+	if response == nil || len(response.TaskToken) == 0 {
+		p.Logger.Info("Empty Activity task: Polling again.")
+		return nil
+	}
+
+	if p.ActivityDispatchTracker != nil {
+		p.ActivityDispatchTracker.Record(response.TaskToken, p.Identity)
+	}
+	p.Logger.Debug("Received Activity task", tag.Value(response))
+
+	return p.respondActivityTask(response)
+}
+
 func (p *TaskPoller) doPollDecisionTask(ctx context.Context) error {
 	taskList := p.TaskList
 	pollCtx, cancel := context.WithTimeout(ctx, time.Second*90)
@@ -75,4 +75,9 @@ type (
 		*require.Assertions
 		*IntegrationBase
 	}
+
+	HistoryMockedClockIntegrationSuite struct {
+		*require.Assertions
+		*IntegrationBase
+	}
 )
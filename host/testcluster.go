@@ -99,7 +99,10 @@ type (
 		// TimeSource is used to override the time source of internal components.
 		// Note that most components don't respect this, and it's only used in a few places.
 		// e.g. async workflow test's consumer manager and domain manager
-		TimeSource                     clock.MockedTimeSource
+		TimeSource clock.MockedTimeSource
+		// HistoryTimeSource is used to override the time source of the history service, including its timer
+		// queue. Timers such as activity timeouts then only fire when the time source is advanced
+		HistoryTimeSource              clock.MockedTimeSource
 		FrontendDynamicConfigOverrides map[dynamicconfig.Key]interface{}
 		HistoryDynamicConfigOverrides  map[dynamicconfig.Key]interface{}
 		MatchingDynamicConfigOverrides map[dynamicconfig.Key]interface{}
@@ -176,6 +179,7 @@ func NewCluster(t *testing.T, options *TestClusterConfig, logger log.Logger, par
 		AuthorizationConfig:           aConfig,
 		AsyncWFQueues:                 options.AsyncWFQueues,
 		TimeSource:                    options.TimeSource,
+		HistoryTimeSource:             options.HistoryTimeSource,
 		FrontendDynCfgOverrides:       options.FrontendDynamicConfigOverrides,
 		HistoryDynCfgOverrides:        options.HistoryDynamicConfigOverrides,
 		MatchingDynCfgOverrides:       options.MatchingDynamicConfigOverrides,
@@ -35,6 +35,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/asyncworkflow/queueconfigapi"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/batch"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/codec"
@@ -61,6 +62,8 @@ const (
 	getDomainReplicationMessageBatchSize = 100
 	defaultLastMessageID                 = int64(-1)
 	endMessageID                         = int64(1<<63 - 1)
+
+	batchTerminateDecisionTimeoutInSeconds = 10
 )
 
 type (
@@ -74,6 +77,7 @@ type (
 		domainDLQHandler      domain.DLQMessageHandler
 		domainFailoverWatcher domain.FailoverWatcher
 		eventSerializer       persistence.PayloadSerializer
+		tokenSerializer       common.TaskTokenSerializer
 		esClient              elasticsearch.GenericClient
 		throttleRetry         *backoff.ThrottleRetry
 		isolationGroups       isolationgroupapi.Handler
@@ -144,6 +148,7 @@ func NewHandler(
 			resource.GetLogger(),
 		),
 		eventSerializer: persistence.NewPayloadSerializer(),
+		tokenSerializer: common.NewJSONTaskTokenSerializer(),
 		esClient:        params.ESClient,
 		throttleRetry: backoff.NewThrottleRetry(
 			backoff.WithRetryPolicy(adminServiceRetryPolicy),
@@ -1821,6 +1826,328 @@ func (adh *adminHandlerImpl) UpdateTaskListPartitionConfig(ctx context.Context,
 	return &types.UpdateTaskListPartitionConfigResponse{}, nil
 }
 
+// DecodeTaskToken returns the identifiers carried by an opaque task token so that
+// a failing token can be correlated to its workflow
+func (adh *adminHandlerImpl) DecodeTaskToken(ctx context.Context, request *types.DecodeTaskTokenRequest) (_ *types.DecodeTaskTokenResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminDecodeTaskTokenScope)
+	defer sw.Stop()
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if len(request.TaskToken) == 0 {
+		return nil, adh.error(validate.ErrTaskTokenNotSet, scope)
+	}
+	token, err := adh.tokenSerializer.Deserialize(request.TaskToken)
+	if err != nil {
+		return nil, adh.error(&types.BadRequestError{Message: fmt.Sprintf("Unable to decode task token: %v", err)}, scope)
+	}
+	if token.DomainID == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	domainName, err := adh.GetDomainCache().GetDomainName(token.DomainID)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.DecodeTaskTokenResponse{
+		Domain:          domainName,
+		DomainID:        token.DomainID,
+		WorkflowID:      token.WorkflowID,
+		RunID:           token.RunID,
+		WorkflowType:    token.WorkflowType,
+		ScheduleID:      token.ScheduleID,
+		ScheduleAttempt: token.ScheduleAttempt,
+		ActivityID:      token.ActivityID,
+		ActivityType:    token.ActivityType,
+	}, nil
+}
+
+// BatchTerminate starts a batch job terminating the workflows matched by a visibility query, the pending
+// activities of each workflow are cancel requested and drained for a bounded time before it is terminated
+func (adh *adminHandlerImpl) BatchTerminate(ctx context.Context, request *types.BatchTerminateRequest) (_ *types.BatchTerminateResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminBatchTerminateScope)
+	defer sw.Stop()
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.Domain == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	if request.Query == "" {
+		return nil, adh.error(validate.ErrQueryNotSet, scope)
+	}
+	if request.Reason == "" {
+		return nil, adh.error(&types.BadRequestError{Message: "Reason is not set on request."}, scope)
+	}
+	if request.DrainTimeoutInSeconds < 0 || request.RequestsPerSecond < 0 || request.Concurrency < 0 {
+		return nil, adh.error(&types.BadRequestError{Message: "DrainTimeoutInSeconds, RequestsPerSecond and Concurrency must not be negative."}, scope)
+	}
+	if _, err := adh.GetDomainCache().GetDomainID(request.Domain); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	params := batch.BatchParams{
+		DomainName: request.Domain,
+		Query:      request.Query,
+		Reason:     request.Reason,
+		BatchType:  batch.BatchTypeTerminateWithDrain,
+		TerminateParams: batch.TerminateParams{
+			TerminateChildren: request.TerminateChildren,
+		},
+		DrainParams: batch.DrainParams{
+			DrainTimeout: time.Duration(request.DrainTimeoutInSeconds) * time.Second,
+		},
+		RPS:         int(request.RequestsPerSecond),
+		Concurrency: int(request.Concurrency),
+	}
+	input, err := json.Marshal(params)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	memo, err := encodeJSONFields(map[string]interface{}{
+		"Reason": request.Reason,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	searchAttributes, err := encodeJSONFields(map[string]interface{}{
+		"CustomDomain": request.Domain,
+		"Operator":     request.Identity,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	jobID := uuid.New().String()
+	_, err = adh.GetFrontendClient().StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{
+		Domain:                              common.BatcherLocalDomainName,
+		RequestID:                           uuid.New().String(),
+		WorkflowID:                          jobID,
+		WorkflowType:                        &types.WorkflowType{Name: batch.BatchWFTypeName},
+		TaskList:                            &types.TaskList{Name: batch.BatcherTaskListName},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(batch.InfiniteDuration.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(batchTerminateDecisionTimeoutInSeconds),
+		Identity:                            request.Identity,
+		Memo:                                &types.Memo{Fields: memo},
+		SearchAttributes:                    &types.SearchAttributes{IndexedFields: searchAttributes},
+		RetryPolicy: &types.RetryPolicy{
+			InitialIntervalInSeconds:    int32(batch.BatchActivityRetryPolicy.InitialInterval.Seconds()),
+			BackoffCoefficient:          batch.BatchActivityRetryPolicy.BackoffCoefficient,
+			MaximumIntervalInSeconds:    int32(batch.BatchActivityRetryPolicy.MaximumInterval.Seconds()),
+			NonRetriableErrorReasons:    batch.BatchActivityRetryPolicy.NonRetriableErrorReasons,
+			ExpirationIntervalInSeconds: int32(batch.BatchActivityRetryPolicy.ExpirationInterval.Seconds()),
+		},
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.BatchTerminateResponse{JobID: jobID}, nil
+}
+
+// DescribeBatchTerminate reports whether a batch terminate job is still running along with its progress
+func (adh *adminHandlerImpl) DescribeBatchTerminate(ctx context.Context, request *types.DescribeBatchTerminateRequest) (_ *types.DescribeBatchTerminateResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminDescribeBatchTerminateScope)
+	defer sw.Stop()
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.JobID == "" {
+		return nil, adh.error(&types.BadRequestError{Message: "JobID is not set on request."}, scope)
+	}
+
+	resp, err := adh.GetFrontendClient().DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    common.BatcherLocalDomainName,
+		Execution: &types.WorkflowExecution{WorkflowID: request.JobID},
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	info := resp.GetWorkflowExecutionInfo()
+	if info.GetType().GetName() != batch.BatchWFTypeName {
+		return nil, adh.error(&types.BadRequestError{Message: fmt.Sprintf("%v is not a batch job.", request.JobID)}, scope)
+	}
+
+	result := &types.DescribeBatchTerminateResponse{
+		CloseStatus: info.CloseStatus,
+	}
+	// progress is only known from the heartbeats of the batch activity while the job runs
+	if info.CloseStatus == nil && len(resp.PendingActivities) > 0 && len(resp.PendingActivities[0].HeartbeatDetails) > 0 {
+		var hbd batch.HeartBeatDetails
+		if err := json.Unmarshal(resp.PendingActivities[0].HeartbeatDetails, &hbd); err != nil {
+			return nil, adh.error(err, scope)
+		}
+		result.TotalEstimate = hbd.TotalEstimate
+		result.SuccessCount = int64(hbd.SuccessCount)
+		result.ErrorCount = int64(hbd.ErrorCount)
+	}
+	return result, nil
+}
+
+// RemoveTaskListTask drops a single activity task from the backlog of a task list, the task is removed by
+// the matching host owning the task list
+func (adh *adminHandlerImpl) RemoveTaskListTask(ctx context.Context, request *types.RemoveTaskListTaskRequest) (_ *types.RemoveTaskListTaskResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminRemoveTaskListTaskScope)
+	defer sw.Stop()
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.Domain == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	if request.TaskList == nil || request.TaskList.GetName() == "" {
+		return nil, adh.error(validate.ErrTaskListNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.Domain)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	resp, err := adh.GetMatchingClient().RemoveTaskListTask(ctx, &types.MatchingRemoveTaskListTaskRequest{
+		DomainUUID:        domainID,
+		TaskList:          request.TaskList,
+		TaskID:            request.TaskID,
+		WorkflowExecution: request.WorkflowExecution,
+		ScheduleID:        request.ScheduleID,
+		Operator:          request.Operator,
+		Reason:            request.Reason,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.RemoveTaskListTaskResponse{TaskID: resp.GetTaskID()}, nil
+}
+
+// ReplayActivityCompletions replays the results of activities completed in the base run of a reset into the
+// run created by the reset, so that they are not executed again
+func (adh *adminHandlerImpl) ReplayActivityCompletions(ctx context.Context, request *types.ReplayActivityCompletionsRequest) (_ *types.ReplayActivityCompletionsResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminReplayActivityCompletionsScope)
+	defer sw.Stop()
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.Domain == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	if err := validate.CheckExecution(request.WorkflowExecution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.Domain)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	resp, err := adh.GetHistoryClient().ReplayActivityCompletions(ctx, &types.HistoryReplayActivityCompletionsRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// ExpireActivityHeartbeat fires the heartbeat timeout of a started activity right away, for tests
+func (adh *adminHandlerImpl) ExpireActivityHeartbeat(ctx context.Context, request *types.ExpireActivityHeartbeatRequest) (retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminExpireActivityHeartbeatScope)
+	defer sw.Stop()
+	if request == nil {
+		return adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.Domain == "" {
+		return adh.error(validate.ErrDomainNotSet, scope)
+	}
+	if err := validate.CheckExecution(request.WorkflowExecution); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.ActivityID == "" {
+		return adh.error(validate.ErrActivityIDNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.Domain)
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	err = adh.GetHistoryClient().ExpireActivityHeartbeat(ctx, &types.HistoryExpireActivityHeartbeatRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// RedriveTimedOutActivities reschedules right away the pending activities of a workflow which timed out and wait for their next retry
+func (adh *adminHandlerImpl) RedriveTimedOutActivities(ctx context.Context, request *types.RedriveTimedOutActivitiesRequest) (retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminRedriveTimedOutActivitiesScope)
+	defer sw.Stop()
+	if request == nil {
+		return adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.Domain == "" {
+		return adh.error(validate.ErrDomainNotSet, scope)
+	}
+	if err := validate.CheckExecution(request.WorkflowExecution); err != nil {
+		return adh.error(err, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.Domain)
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	err = adh.GetHistoryClient().RedriveTimedOutActivities(ctx, &types.HistoryRedriveTimedOutActivitiesRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// CancelPendingActivities requests the cancellation of the pending activities of a workflow, e.g. before terminating it
+func (adh *adminHandlerImpl) CancelPendingActivities(ctx context.Context, request *types.CancelPendingActivitiesRequest) (retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminCancelPendingActivitiesScope)
+	defer sw.Stop()
+	if request == nil {
+		return adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.Domain == "" {
+		return adh.error(validate.ErrDomainNotSet, scope)
+	}
+	if err := validate.CheckExecution(request.WorkflowExecution); err != nil {
+		return adh.error(err, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.Domain)
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	err = adh.GetHistoryClient().CancelPendingActivities(ctx, &types.HistoryCancelPendingActivitiesRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+func encodeJSONFields(fields map[string]interface{}) (map[string][]byte, error) {
+	encoded := make(map[string][]byte, len(fields))
+	for key, value := range fields {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		encoded[key] = data
+	}
+	return encoded, nil
+}
+
 func convertFromDataBlob(blob *types.DataBlob) (interface{}, error) {
 	switch *blob.EncodingType {
 	case types.EncodingTypeJSON:
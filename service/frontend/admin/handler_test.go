@@ -42,6 +42,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/asyncworkflow/queueconfigapi"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/batch"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/domain"
@@ -2951,3 +2952,755 @@ func TestUpdateTaskListPartitionConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeTaskToken(t *testing.T) {
+	serializer := common.NewJSONTaskTokenSerializer()
+	token, err := serializer.Serialize(&common.TaskToken{
+		DomainID:        "test-domain-id",
+		WorkflowID:      "test-workflow-id",
+		RunID:           "test-run-id",
+		WorkflowType:    "test-workflow-type",
+		ScheduleID:      5,
+		ScheduleAttempt: 2,
+		ActivityID:      "test-activity-id",
+		ActivityType:    "test-activity-type",
+	})
+	require.NoError(t, err)
+	tokenWithoutDomain, err := serializer.Serialize(&common.TaskToken{WorkflowID: "test-workflow-id"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		req           *types.DecodeTaskTokenRequest
+		setupMocks    func(mockDomainCache *cache.MockDomainCache)
+		expected      *types.DecodeTaskTokenResponse
+		expectedError string
+	}{
+		{
+			name: "success",
+			req:  &types.DecodeTaskTokenRequest{TaskToken: token},
+			setupMocks: func(mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainName("test-domain-id").Return("test-domain", nil)
+			},
+			expected: &types.DecodeTaskTokenResponse{
+				Domain:          "test-domain",
+				DomainID:        "test-domain-id",
+				WorkflowID:      "test-workflow-id",
+				RunID:           "test-run-id",
+				WorkflowType:    "test-workflow-type",
+				ScheduleID:      5,
+				ScheduleAttempt: 2,
+				ActivityID:      "test-activity-id",
+				ActivityType:    "test-activity-type",
+			},
+		},
+		{
+			name:          "nil request",
+			req:           nil,
+			setupMocks:    func(mockDomainCache *cache.MockDomainCache) {},
+			expectedError: "Request is nil.",
+		},
+		{
+			name:          "empty token",
+			req:           &types.DecodeTaskTokenRequest{},
+			setupMocks:    func(mockDomainCache *cache.MockDomainCache) {},
+			expectedError: "Task token not set on request.",
+		},
+		{
+			name:          "malformed token",
+			req:           &types.DecodeTaskTokenRequest{TaskToken: []byte("not-a-token")},
+			setupMocks:    func(mockDomainCache *cache.MockDomainCache) {},
+			expectedError: "Unable to decode task token",
+		},
+		{
+			name:          "token without domain",
+			req:           &types.DecodeTaskTokenRequest{TaskToken: tokenWithoutDomain},
+			setupMocks:    func(mockDomainCache *cache.MockDomainCache) {},
+			expectedError: "Domain not set on request.",
+		},
+		{
+			name: "domain cache error",
+			req:  &types.DecodeTaskTokenRequest{TaskToken: token},
+			setupMocks: func(mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainName("test-domain-id").Return("", errors.New("domain cache error"))
+			},
+			expectedError: "domain cache error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+			tc.setupMocks(mockDomainCache)
+			adh := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:        testlogger.New(t),
+					MetricsClient: metrics.NewNoopMetricsClient(),
+					DomainCache:   mockDomainCache,
+				},
+				tokenSerializer: serializer,
+			}
+
+			resp, err := adh.DecodeTaskToken(context.Background(), tc.req)
+
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, resp)
+			}
+		})
+	}
+}
+
+func TestBatchTerminate(t *testing.T) {
+	validRequest := func() *types.BatchTerminateRequest {
+		return &types.BatchTerminateRequest{
+			Domain:                "test-domain",
+			Query:                 "WorkflowType='test-workflow-type'",
+			Reason:                "decommission",
+			DrainTimeoutInSeconds: 30,
+			RequestsPerSecond:     10,
+			Identity:              "test-operator",
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		req           *types.BatchTerminateRequest
+		setupMocks    func(mockDomainCache *cache.MockDomainCache, mockFrontendClient *frontend.MockClient)
+		expectedError string
+	}{
+		{
+			name: "success",
+			req:  validRequest(),
+			setupMocks: func(mockDomainCache *cache.MockDomainCache, mockFrontendClient *frontend.MockClient) {
+				mockDomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				mockFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, request *types.StartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, common.BatcherLocalDomainName, request.Domain)
+						assert.Equal(t, batch.BatchWFTypeName, request.WorkflowType.Name)
+						assert.Equal(t, batch.BatcherTaskListName, request.TaskList.Name)
+						var params batch.BatchParams
+						require.NoError(t, json.Unmarshal(request.Input, &params))
+						assert.Equal(t, batch.BatchTypeTerminateWithDrain, params.BatchType)
+						assert.Equal(t, "test-domain", params.DomainName)
+						assert.Equal(t, 30*time.Second, params.DrainParams.DrainTimeout)
+						assert.Equal(t, 10, params.RPS)
+						return &types.StartWorkflowExecutionResponse{RunID: "test-run-id"}, nil
+					})
+			},
+		},
+		{
+			name:          "nil request",
+			req:           nil,
+			setupMocks:    func(mockDomainCache *cache.MockDomainCache, mockFrontendClient *frontend.MockClient) {},
+			expectedError: "Request is nil.",
+		},
+		{
+			name:          "missing query",
+			req:           &types.BatchTerminateRequest{Domain: "test-domain", Reason: "decommission"},
+			setupMocks:    func(mockDomainCache *cache.MockDomainCache, mockFrontendClient *frontend.MockClient) {},
+			expectedError: "WorkflowQuery is not set on request.",
+		},
+		{
+			name:          "negative rate",
+			req:           &types.BatchTerminateRequest{Domain: "test-domain", Query: "a=b", Reason: "decommission", RequestsPerSecond: -1},
+			setupMocks:    func(mockDomainCache *cache.MockDomainCache, mockFrontendClient *frontend.MockClient) {},
+			expectedError: "must not be negative",
+		},
+		{
+			name: "unknown domain",
+			req:  validRequest(),
+			setupMocks: func(mockDomainCache *cache.MockDomainCache, mockFrontendClient *frontend.MockClient) {
+				mockDomainCache.EXPECT().GetDomainID("test-domain").Return("", &types.EntityNotExistsError{Message: "domain not found"})
+			},
+			expectedError: "domain not found",
+		},
+		{
+			name: "start workflow error",
+			req:  validRequest(),
+			setupMocks: func(mockDomainCache *cache.MockDomainCache, mockFrontendClient *frontend.MockClient) {
+				mockDomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				mockFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, errors.New("start error"))
+			},
+			expectedError: "start error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+			mockFrontendClient := frontend.NewMockClient(ctrl)
+			tc.setupMocks(mockDomainCache, mockFrontendClient)
+			adh := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:         testlogger.New(t),
+					MetricsClient:  metrics.NewNoopMetricsClient(),
+					DomainCache:    mockDomainCache,
+					FrontendClient: mockFrontendClient,
+				},
+			}
+
+			resp, err := adh.BatchTerminate(context.Background(), tc.req)
+
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, resp.JobID)
+			}
+		})
+	}
+}
+
+func TestDescribeBatchTerminate(t *testing.T) {
+	progress, err := json.Marshal(batch.HeartBeatDetails{TotalEstimate: 10, SuccessCount: 4, ErrorCount: 1})
+	require.NoError(t, err)
+	batchType := &types.WorkflowType{Name: batch.BatchWFTypeName}
+
+	testCases := []struct {
+		name          string
+		req           *types.DescribeBatchTerminateRequest
+		describeResp  *types.DescribeWorkflowExecutionResponse
+		describeErr   error
+		expected      *types.DescribeBatchTerminateResponse
+		expectedError string
+	}{
+		{
+			name: "running job",
+			req:  &types.DescribeBatchTerminateRequest{JobID: "test-job-id"},
+			describeResp: &types.DescribeWorkflowExecutionResponse{
+				WorkflowExecutionInfo: &types.WorkflowExecutionInfo{Type: batchType},
+				PendingActivities:     []*types.PendingActivityInfo{{HeartbeatDetails: progress}},
+			},
+			expected: &types.DescribeBatchTerminateResponse{TotalEstimate: 10, SuccessCount: 4, ErrorCount: 1},
+		},
+		{
+			name: "completed job",
+			req:  &types.DescribeBatchTerminateRequest{JobID: "test-job-id"},
+			describeResp: &types.DescribeWorkflowExecutionResponse{
+				WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+					Type:        batchType,
+					CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+				},
+			},
+			expected: &types.DescribeBatchTerminateResponse{CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr()},
+		},
+		{
+			name: "not a batch job",
+			req:  &types.DescribeBatchTerminateRequest{JobID: "test-job-id"},
+			describeResp: &types.DescribeWorkflowExecutionResponse{
+				WorkflowExecutionInfo: &types.WorkflowExecutionInfo{Type: &types.WorkflowType{Name: "other-type"}},
+			},
+			expectedError: "is not a batch job",
+		},
+		{
+			name:          "missing job ID",
+			req:           &types.DescribeBatchTerminateRequest{},
+			expectedError: "JobID is not set on request.",
+		},
+		{
+			name:          "describe error",
+			req:           &types.DescribeBatchTerminateRequest{JobID: "test-job-id"},
+			describeErr:   &types.EntityNotExistsError{Message: "job not found"},
+			expectedError: "job not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFrontendClient := frontend.NewMockClient(ctrl)
+			if tc.describeResp != nil || tc.describeErr != nil {
+				mockFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &types.DescribeWorkflowExecutionRequest{
+					Domain:    common.BatcherLocalDomainName,
+					Execution: &types.WorkflowExecution{WorkflowID: tc.req.JobID},
+				}).Return(tc.describeResp, tc.describeErr)
+			}
+			adh := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:         testlogger.New(t),
+					MetricsClient:  metrics.NewNoopMetricsClient(),
+					FrontendClient: mockFrontendClient,
+				},
+			}
+
+			resp, err := adh.DescribeBatchTerminate(context.Background(), tc.req)
+
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, resp)
+			}
+		})
+	}
+}
+
+func TestRemoveTaskListTask(t *testing.T) {
+	taskList := &types.TaskList{Name: "test-task-list", Kind: types.TaskListKindNormal.Ptr()}
+	validRequest := &types.RemoveTaskListTaskRequest{
+		Domain:   "test-domain",
+		TaskList: taskList,
+		TaskID:   10,
+		Operator: "operator",
+		Reason:   "wedged task",
+	}
+
+	testCases := []struct {
+		name          string
+		req           *types.RemoveTaskListTaskRequest
+		setupMocks    func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache)
+		expected      *types.RemoveTaskListTaskResponse
+		expectedError string
+	}{
+		{
+			name: "success",
+			req:  validRequest,
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				mockMatchingClient.EXPECT().RemoveTaskListTask(gomock.Any(), &types.MatchingRemoveTaskListTaskRequest{
+					DomainUUID: "test-domain-id",
+					TaskList:   taskList,
+					TaskID:     10,
+					Operator:   "operator",
+					Reason:     "wedged task",
+				}).Return(&types.MatchingRemoveTaskListTaskResponse{TaskID: 10}, nil)
+			},
+			expected: &types.RemoveTaskListTaskResponse{TaskID: 10},
+		},
+		{
+			name:          "nil request",
+			req:           nil,
+			setupMocks:    func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectedError: validate.ErrRequestNotSet.Error(),
+		},
+		{
+			name:          "domain not set",
+			req:           &types.RemoveTaskListTaskRequest{TaskList: taskList},
+			setupMocks:    func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectedError: validate.ErrDomainNotSet.Error(),
+		},
+		{
+			name:          "task list not set",
+			req:           &types.RemoveTaskListTaskRequest{Domain: "test-domain"},
+			setupMocks:    func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectedError: validate.ErrTaskListNotSet.Error(),
+		},
+		{
+			name: "domain cache error",
+			req:  validRequest,
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID("test-domain").Return("", errors.New("domain cache error"))
+			},
+			expectedError: "domain cache error",
+		},
+		{
+			name: "matching client error",
+			req:  validRequest,
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				mockMatchingClient.EXPECT().RemoveTaskListTask(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{Message: "task not found"})
+			},
+			expectedError: "task not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+			mockMatchingClient := matching.NewMockClient(ctrl)
+			tc.setupMocks(mockMatchingClient, mockDomainCache)
+			adh := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:         testlogger.New(t),
+					MetricsClient:  metrics.NewNoopMetricsClient(),
+					DomainCache:    mockDomainCache,
+					MatchingClient: mockMatchingClient,
+				},
+			}
+
+			resp, err := adh.RemoveTaskListTask(context.Background(), tc.req)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, resp)
+			}
+		})
+	}
+}
+
+func TestReplayActivityCompletions(t *testing.T) {
+	validRequest := &types.ReplayActivityCompletionsRequest{
+		Domain: "test-domain",
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "test-workflow-id",
+			RunID:      "f1a3fe20-2f5c-4e1b-9b5c-3c4f0f2b6a71",
+		},
+		BaseRunID:         "0d5fc4f1-6b8e-4c1a-8c1f-2f7a5b3e9d10",
+		ScheduledEventIDs: []int64{5},
+		Identity:          "operator",
+	}
+
+	testCases := []struct {
+		name          string
+		req           *types.ReplayActivityCompletionsRequest
+		setupMocks    func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache)
+		expected      *types.ReplayActivityCompletionsResponse
+		expectedError string
+	}{
+		{
+			name: "success",
+			req:  validRequest,
+			setupMocks: func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				mockHistoryClient.EXPECT().ReplayActivityCompletions(gomock.Any(), &types.HistoryReplayActivityCompletionsRequest{
+					DomainUUID: "test-domain-id",
+					Request:    validRequest,
+				}).Return(&types.ReplayActivityCompletionsResponse{ScheduledEventIDs: map[int64]int64{5: 7}}, nil)
+			},
+			expected: &types.ReplayActivityCompletionsResponse{ScheduledEventIDs: map[int64]int64{5: 7}},
+		},
+		{
+			name:          "nil request",
+			req:           nil,
+			setupMocks:    func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectedError: validate.ErrRequestNotSet.Error(),
+		},
+		{
+			name:          "domain not set",
+			req:           &types.ReplayActivityCompletionsRequest{WorkflowExecution: validRequest.WorkflowExecution},
+			setupMocks:    func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectedError: validate.ErrDomainNotSet.Error(),
+		},
+		{
+			name:          "execution not set",
+			req:           &types.ReplayActivityCompletionsRequest{Domain: "test-domain"},
+			setupMocks:    func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectedError: validate.ErrExecutionNotSet.Error(),
+		},
+		{
+			name: "domain cache error",
+			req:  validRequest,
+			setupMocks: func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID("test-domain").Return("", errors.New("domain cache error"))
+			},
+			expectedError: "domain cache error",
+		},
+		{
+			name: "history client error",
+			req:  validRequest,
+			setupMocks: func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				mockHistoryClient.EXPECT().ReplayActivityCompletions(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{Message: "diverged"})
+			},
+			expectedError: "diverged",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+			mockHistoryClient := history.NewMockClient(ctrl)
+			tc.setupMocks(mockHistoryClient, mockDomainCache)
+			adh := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:        testlogger.New(t),
+					MetricsClient: metrics.NewNoopMetricsClient(),
+					DomainCache:   mockDomainCache,
+					HistoryClient: mockHistoryClient,
+				},
+			}
+
+			resp, err := adh.ReplayActivityCompletions(context.Background(), tc.req)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, resp)
+			}
+		})
+	}
+}
+
+func TestExpireActivityHeartbeat(t *testing.T) {
+	validRequest := &types.ExpireActivityHeartbeatRequest{
+		Domain: "test-domain",
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "test-workflow-id",
+			RunID:      "f1a3fe20-2f5c-4e1b-9b5c-3c4f0f2b6a71",
+		},
+		ActivityID: "test-activity-id",
+	}
+
+	testCases := []struct {
+		name          string
+		req           *types.ExpireActivityHeartbeatRequest
+		setupMocks    func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache)
+		expectedError string
+	}{
+		{
+			name: "success",
+			req:  validRequest,
+			setupMocks: func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				mockHistoryClient.EXPECT().ExpireActivityHeartbeat(gomock.Any(), &types.HistoryExpireActivityHeartbeatRequest{
+					DomainUUID: "test-domain-id",
+					Request:    validRequest,
+				}).Return(nil)
+			},
+		},
+		{
+			name:          "nil request",
+			req:           nil,
+			setupMocks:    func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectedError: validate.ErrRequestNotSet.Error(),
+		},
+		{
+			name:          "domain not set",
+			req:           &types.ExpireActivityHeartbeatRequest{WorkflowExecution: validRequest.WorkflowExecution},
+			setupMocks:    func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectedError: validate.ErrDomainNotSet.Error(),
+		},
+		{
+			name:          "execution not set",
+			req:           &types.ExpireActivityHeartbeatRequest{Domain: "test-domain"},
+			setupMocks:    func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectedError: validate.ErrExecutionNotSet.Error(),
+		},
+		{
+			name:          "activity ID not set",
+			req:           &types.ExpireActivityHeartbeatRequest{Domain: "test-domain", WorkflowExecution: validRequest.WorkflowExecution},
+			setupMocks:    func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectedError: validate.ErrActivityIDNotSet.Error(),
+		},
+		{
+			name: "domain cache error",
+			req:  validRequest,
+			setupMocks: func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID("test-domain").Return("", errors.New("domain cache error"))
+			},
+			expectedError: "domain cache error",
+		},
+		{
+			name: "history client error",
+			req:  validRequest,
+			setupMocks: func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				mockHistoryClient.EXPECT().ExpireActivityHeartbeat(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "not enabled"})
+			},
+			expectedError: "not enabled",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+			mockHistoryClient := history.NewMockClient(ctrl)
+			tc.setupMocks(mockHistoryClient, mockDomainCache)
+			adh := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:        testlogger.New(t),
+					MetricsClient: metrics.NewNoopMetricsClient(),
+					DomainCache:   mockDomainCache,
+					HistoryClient: mockHistoryClient,
+				},
+			}
+
+			err := adh.ExpireActivityHeartbeat(context.Background(), tc.req)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRedriveTimedOutActivities(t *testing.T) {
+	validRequest := &types.RedriveTimedOutActivitiesRequest{
+		Domain: "test-domain",
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "test-workflow-id",
+			RunID:      "f1a3fe20-2f5c-4e1b-9b5c-3c4f0f2b6a71",
+		},
+		Identity: "test-identity",
+	}
+
+	testCases := []struct {
+		name          string
+		req           *types.RedriveTimedOutActivitiesRequest
+		setupMocks    func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache)
+		expectedError string
+	}{
+		{
+			name: "success",
+			req:  validRequest,
+			setupMocks: func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				mockHistoryClient.EXPECT().RedriveTimedOutActivities(gomock.Any(), &types.HistoryRedriveTimedOutActivitiesRequest{
+					DomainUUID: "test-domain-id",
+					Request:    validRequest,
+				}).Return(nil)
+			},
+		},
+		{
+			name:          "nil request",
+			req:           nil,
+			setupMocks:    func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectedError: validate.ErrRequestNotSet.Error(),
+		},
+		{
+			name:          "domain not set",
+			req:           &types.RedriveTimedOutActivitiesRequest{WorkflowExecution: validRequest.WorkflowExecution},
+			setupMocks:    func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectedError: validate.ErrDomainNotSet.Error(),
+		},
+		{
+			name:          "execution not set",
+			req:           &types.RedriveTimedOutActivitiesRequest{Domain: "test-domain"},
+			setupMocks:    func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectedError: validate.ErrExecutionNotSet.Error(),
+		},
+		{
+			name: "domain cache error",
+			req:  validRequest,
+			setupMocks: func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID("test-domain").Return("", errors.New("domain cache error"))
+			},
+			expectedError: "domain cache error",
+		},
+		{
+			name: "history client error",
+			req:  validRequest,
+			setupMocks: func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				mockHistoryClient.EXPECT().RedriveTimedOutActivities(gomock.Any(), gomock.Any()).Return(&types.EntityNotExistsError{Message: "workflow not found"})
+			},
+			expectedError: "workflow not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+			mockHistoryClient := history.NewMockClient(ctrl)
+			tc.setupMocks(mockHistoryClient, mockDomainCache)
+			adh := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:        testlogger.New(t),
+					MetricsClient: metrics.NewNoopMetricsClient(),
+					DomainCache:   mockDomainCache,
+					HistoryClient: mockHistoryClient,
+				},
+			}
+
+			err := adh.RedriveTimedOutActivities(context.Background(), tc.req)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCancelPendingActivities(t *testing.T) {
+	validRequest := &types.CancelPendingActivitiesRequest{
+		Domain: "test-domain",
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "test-workflow-id",
+			RunID:      "f1a3fe20-2f5c-4e1b-9b5c-3c4f0f2b6a71",
+		},
+		Details:  []byte("test-details"),
+		Identity: "test-identity",
+	}
+
+	testCases := []struct {
+		name          string
+		req           *types.CancelPendingActivitiesRequest
+		setupMocks    func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache)
+		expectedError string
+	}{
+		{
+			name: "success",
+			req:  validRequest,
+			setupMocks: func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				mockHistoryClient.EXPECT().CancelPendingActivities(gomock.Any(), &types.HistoryCancelPendingActivitiesRequest{
+					DomainUUID: "test-domain-id",
+					Request:    validRequest,
+				}).Return(nil)
+			},
+		},
+		{
+			name:          "nil request",
+			req:           nil,
+			setupMocks:    func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectedError: validate.ErrRequestNotSet.Error(),
+		},
+		{
+			name:          "domain not set",
+			req:           &types.CancelPendingActivitiesRequest{WorkflowExecution: validRequest.WorkflowExecution},
+			setupMocks:    func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectedError: validate.ErrDomainNotSet.Error(),
+		},
+		{
+			name:          "execution not set",
+			req:           &types.CancelPendingActivitiesRequest{Domain: "test-domain"},
+			setupMocks:    func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectedError: validate.ErrExecutionNotSet.Error(),
+		},
+		{
+			name: "domain cache error",
+			req:  validRequest,
+			setupMocks: func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID("test-domain").Return("", errors.New("domain cache error"))
+			},
+			expectedError: "domain cache error",
+		},
+		{
+			name: "history client error",
+			req:  validRequest,
+			setupMocks: func(mockHistoryClient *history.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				mockHistoryClient.EXPECT().CancelPendingActivities(gomock.Any(), gomock.Any()).Return(&types.EntityNotExistsError{Message: "workflow not found"})
+			},
+			expectedError: "workflow not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+			mockHistoryClient := history.NewMockClient(ctrl)
+			tc.setupMocks(mockHistoryClient, mockDomainCache)
+			adh := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:        testlogger.New(t),
+					MetricsClient: metrics.NewNoopMetricsClient(),
+					DomainCache:   mockDomainCache,
+					HistoryClient: mockHistoryClient,
+				},
+			}
+
+			err := adh.CancelPendingActivities(context.Background(), tc.req)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -20,7 +20,7 @@
 
 //go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination interface_mock.go -self_package github.com/uber/cadence/service/frontend/admin
 //go:generate gowrap gen -g -p . -i Handler -t ../templates/accesscontrolled.tmpl -o ../wrappers/accesscontrolled/admin_generated.go -v handler=Admin
-//go:generate gowrap gen -g -p . -i Handler -t ../../templates/grpc.tmpl -o ../wrappers/grpc/admin_generated.go -v handler=Admin -v package=adminv1 -v path=github.com/uber/cadence-idl/go/proto/admin/v1 -v prefix=Admin
+//go:generate gowrap gen -g -p . -i Handler -t ../../templates/grpc.tmpl -o ../wrappers/grpc/admin_generated.go -v handler=Admin -v package=adminv1 -v path=github.com/uber/cadence-idl/go/proto/admin/v1 -v prefix=Admin -v exclude=RemoveTaskListTask|ReplayActivityCompletions|ExpireActivityHeartbeat|RedriveTimedOutActivities|CancelPendingActivities|DecodeTaskToken|BatchTerminate|DescribeBatchTerminate
 //go:generate gowrap gen -g -p ../../../.gen/go/admin/adminserviceserver -i Interface -t ../../templates/thrift.tmpl -o ../wrappers/thrift/admin_generated.go -v handler=Admin -v prefix=Admin
 
 package admin
@@ -71,4 +71,12 @@ type Handler interface {
 	GetDomainAsyncWorkflowConfiguraton(context.Context, *types.GetDomainAsyncWorkflowConfiguratonRequest) (*types.GetDomainAsyncWorkflowConfiguratonResponse, error)
 	UpdateDomainAsyncWorkflowConfiguraton(context.Context, *types.UpdateDomainAsyncWorkflowConfiguratonRequest) (*types.UpdateDomainAsyncWorkflowConfiguratonResponse, error)
 	UpdateTaskListPartitionConfig(context.Context, *types.UpdateTaskListPartitionConfigRequest) (*types.UpdateTaskListPartitionConfigResponse, error)
+	DecodeTaskToken(context.Context, *types.DecodeTaskTokenRequest) (*types.DecodeTaskTokenResponse, error)
+	BatchTerminate(context.Context, *types.BatchTerminateRequest) (*types.BatchTerminateResponse, error)
+	DescribeBatchTerminate(context.Context, *types.DescribeBatchTerminateRequest) (*types.DescribeBatchTerminateResponse, error)
+	RemoveTaskListTask(context.Context, *types.RemoveTaskListTaskRequest) (*types.RemoveTaskListTaskResponse, error)
+	ReplayActivityCompletions(context.Context, *types.ReplayActivityCompletionsRequest) (*types.ReplayActivityCompletionsResponse, error)
+	ExpireActivityHeartbeat(context.Context, *types.ExpireActivityHeartbeatRequest) error
+	RedriveTimedOutActivities(context.Context, *types.RedriveTimedOutActivitiesRequest) error
+	CancelPendingActivities(context.Context, *types.CancelPendingActivitiesRequest) error
 }
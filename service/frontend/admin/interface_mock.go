@@ -78,6 +78,35 @@ func (mr *MockHandlerMockRecorder) AddSearchAttribute(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttribute", reflect.TypeOf((*MockHandler)(nil).AddSearchAttribute), arg0, arg1)
 }
 
+// BatchTerminate mocks base method.
+func (m *MockHandler) BatchTerminate(arg0 context.Context, arg1 *types.BatchTerminateRequest) (*types.BatchTerminateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchTerminate", arg0, arg1)
+	ret0, _ := ret[0].(*types.BatchTerminateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchTerminate indicates an expected call of BatchTerminate.
+func (mr *MockHandlerMockRecorder) BatchTerminate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchTerminate", reflect.TypeOf((*MockHandler)(nil).BatchTerminate), arg0, arg1)
+}
+
+// CancelPendingActivities mocks base method.
+func (m *MockHandler) CancelPendingActivities(arg0 context.Context, arg1 *types.CancelPendingActivitiesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPendingActivities", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelPendingActivities indicates an expected call of CancelPendingActivities.
+func (mr *MockHandlerMockRecorder) CancelPendingActivities(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPendingActivities", reflect.TypeOf((*MockHandler)(nil).CancelPendingActivities), arg0, arg1)
+}
+
 // CloseShard mocks base method.
 func (m *MockHandler) CloseShard(arg0 context.Context, arg1 *types.CloseShardRequest) error {
 	m.ctrl.T.Helper()
@@ -107,6 +136,21 @@ func (mr *MockHandlerMockRecorder) CountDLQMessages(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDLQMessages", reflect.TypeOf((*MockHandler)(nil).CountDLQMessages), arg0, arg1)
 }
 
+// DecodeTaskToken mocks base method.
+func (m *MockHandler) DecodeTaskToken(arg0 context.Context, arg1 *types.DecodeTaskTokenRequest) (*types.DecodeTaskTokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecodeTaskToken", arg0, arg1)
+	ret0, _ := ret[0].(*types.DecodeTaskTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecodeTaskToken indicates an expected call of DecodeTaskToken.
+func (mr *MockHandlerMockRecorder) DecodeTaskToken(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecodeTaskToken", reflect.TypeOf((*MockHandler)(nil).DecodeTaskToken), arg0, arg1)
+}
+
 // DeleteWorkflow mocks base method.
 func (m *MockHandler) DeleteWorkflow(arg0 context.Context, arg1 *types.AdminDeleteWorkflowRequest) (*types.AdminDeleteWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +166,21 @@ func (mr *MockHandlerMockRecorder) DeleteWorkflow(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflow", reflect.TypeOf((*MockHandler)(nil).DeleteWorkflow), arg0, arg1)
 }
 
+// DescribeBatchTerminate mocks base method.
+func (m *MockHandler) DescribeBatchTerminate(arg0 context.Context, arg1 *types.DescribeBatchTerminateRequest) (*types.DescribeBatchTerminateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeBatchTerminate", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeBatchTerminateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeBatchTerminate indicates an expected call of DescribeBatchTerminate.
+func (mr *MockHandlerMockRecorder) DescribeBatchTerminate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeBatchTerminate", reflect.TypeOf((*MockHandler)(nil).DescribeBatchTerminate), arg0, arg1)
+}
+
 // DescribeCluster mocks base method.
 func (m *MockHandler) DescribeCluster(arg0 context.Context) (*types.DescribeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -197,6 +256,20 @@ func (mr *MockHandlerMockRecorder) DescribeWorkflowExecution(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).DescribeWorkflowExecution), arg0, arg1)
 }
 
+// ExpireActivityHeartbeat mocks base method.
+func (m *MockHandler) ExpireActivityHeartbeat(arg0 context.Context, arg1 *types.ExpireActivityHeartbeatRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireActivityHeartbeat", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireActivityHeartbeat indicates an expected call of ExpireActivityHeartbeat.
+func (mr *MockHandlerMockRecorder) ExpireActivityHeartbeat(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireActivityHeartbeat", reflect.TypeOf((*MockHandler)(nil).ExpireActivityHeartbeat), arg0, arg1)
+}
+
 // GetCrossClusterTasks mocks base method.
 func (m *MockHandler) GetCrossClusterTasks(arg0 context.Context, arg1 *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -420,6 +493,20 @@ func (mr *MockHandlerMockRecorder) ReapplyEvents(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockHandler)(nil).ReapplyEvents), arg0, arg1)
 }
 
+// RedriveTimedOutActivities mocks base method.
+func (m *MockHandler) RedriveTimedOutActivities(arg0 context.Context, arg1 *types.RedriveTimedOutActivitiesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedriveTimedOutActivities", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedriveTimedOutActivities indicates an expected call of RedriveTimedOutActivities.
+func (mr *MockHandlerMockRecorder) RedriveTimedOutActivities(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedriveTimedOutActivities", reflect.TypeOf((*MockHandler)(nil).RedriveTimedOutActivities), arg0, arg1)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockHandler) RefreshWorkflowTasks(arg0 context.Context, arg1 *types.RefreshWorkflowTasksRequest) error {
 	m.ctrl.T.Helper()
@@ -448,6 +535,36 @@ func (mr *MockHandlerMockRecorder) RemoveTask(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockHandler)(nil).RemoveTask), arg0, arg1)
 }
 
+// RemoveTaskListTask mocks base method.
+func (m *MockHandler) RemoveTaskListTask(arg0 context.Context, arg1 *types.RemoveTaskListTaskRequest) (*types.RemoveTaskListTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTaskListTask", arg0, arg1)
+	ret0, _ := ret[0].(*types.RemoveTaskListTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTaskListTask indicates an expected call of RemoveTaskListTask.
+func (mr *MockHandlerMockRecorder) RemoveTaskListTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTaskListTask", reflect.TypeOf((*MockHandler)(nil).RemoveTaskListTask), arg0, arg1)
+}
+
+// ReplayActivityCompletions mocks base method.
+func (m *MockHandler) ReplayActivityCompletions(arg0 context.Context, arg1 *types.ReplayActivityCompletionsRequest) (*types.ReplayActivityCompletionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayActivityCompletions", arg0, arg1)
+	ret0, _ := ret[0].(*types.ReplayActivityCompletionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayActivityCompletions indicates an expected call of ReplayActivityCompletions.
+func (mr *MockHandlerMockRecorder) ReplayActivityCompletions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayActivityCompletions", reflect.TypeOf((*MockHandler)(nil).ReplayActivityCompletions), arg0, arg1)
+}
+
 // ResendReplicationTasks mocks base method.
 func (m *MockHandler) ResendReplicationTasks(arg0 context.Context, arg1 *types.ResendReplicationTasksRequest) error {
 	m.ctrl.T.Helper()
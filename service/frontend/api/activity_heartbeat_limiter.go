@@ -0,0 +1,65 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+// allowActivityHeartbeat admits a heartbeat of the domain under the ActivityHeartbeatRPS limit of the domain, so that
+// a burst of heartbeats can't starve the other requests. A heartbeat above the limit waits for a token up to the
+// queue timeout, and then gets a ServiceBusyError with the ActivityHeartbeatRetryAfter hint.
+func (wh *WorkflowHandler) allowActivityHeartbeat(
+	ctx context.Context,
+	domainName string,
+	scope metrics.Scope,
+) error {
+
+	if wh.config.ActivityHeartbeatRPS(domainName) <= 0 {
+		return nil
+	}
+	limiter := wh.activityHeartbeatLimiters.For(domainName)
+	admitted := limiter.Allow()
+	if queueTimeout := wh.config.ActivityHeartbeatQueueTimeout(domainName); !admitted && queueTimeout > 0 {
+		startTime := wh.GetTimeSource().Now()
+		waitCtx, cancel := context.WithTimeout(ctx, queueTimeout)
+		admitted = limiter.Wait(waitCtx) == nil
+		cancel()
+		scope.RecordTimer(metrics.ActivityHeartbeatQueueLatency, wh.GetTimeSource().Now().Sub(startTime))
+	}
+	if !admitted {
+		scope.IncCounter(metrics.ActivityHeartbeatThrottledCounter)
+		retryAfter := wh.config.ActivityHeartbeatRetryAfter(domainName)
+		return &types.ServiceBusyError{
+			Message:      fmt.Sprintf("Too many activity heartbeats of the domain, retry after %v", retryAfter),
+			Reason:       common.ActivityHeartbeatThrottledReason,
+			RetryAfterMs: retryAfter.Milliseconds(),
+		}
+	}
+	return nil
+}
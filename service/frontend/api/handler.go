@@ -34,12 +34,15 @@ import (
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/.gen/go/sqlblobs"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activityencryption"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/elasticsearch/validator"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -47,6 +50,7 @@ import (
 	"github.com/uber/cadence/common/partition"
 	"github.com/uber/cadence/common/persistence"
 	persistenceutils "github.com/uber/cadence/common/persistence/persistence-utils"
+	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
@@ -84,6 +88,8 @@ type (
 		producerManager           ProducerManager
 		thriftrwEncoder           codec.BinaryEncoder
 		requestValidator          RequestValidator
+		activityPayloadEncryptor  activityencryption.Encryptor
+		activityHeartbeatLimiters quotas.ICollection
 	}
 
 	getHistoryContinuationToken struct {
@@ -115,6 +121,7 @@ func NewWorkflowHandler(
 	config *config.Config,
 	versionChecker client.VersionChecker,
 	domainHandler domain.Handler,
+	activityPayloadEncryptor activityencryption.Encryptor,
 ) *WorkflowHandler {
 	return &WorkflowHandler{
 		Resource:        resource,
@@ -145,8 +152,12 @@ func NewWorkflowHandler(
 			resource.GetLogger(),
 			resource.GetMetricsClient(),
 		),
-		thriftrwEncoder:  codec.NewThriftRWEncoder(),
-		requestValidator: NewRequestValidator(resource.GetLogger(), resource.GetMetricsClient(), config),
+		thriftrwEncoder:          codec.NewThriftRWEncoder(),
+		requestValidator:         NewRequestValidator(resource.GetLogger(), resource.GetMetricsClient(), config),
+		activityPayloadEncryptor: activityPayloadEncryptor,
+		activityHeartbeatLimiters: quotas.NewCollection(quotas.NewSimpleDynamicRateLimiterFactory(
+			config.ActivityHeartbeatRPS,
+		)),
 	}
 }
 
@@ -345,6 +356,7 @@ func (wh *WorkflowHandler) PollForActivityTask(
 			PollerID:       pollerID,
 			PollRequest:    pollRequest,
 			IsolationGroup: isolationGroup,
+			SourceCluster:  partition.SourceClusterFromContext(ctx),
 		})
 		return err
 	}
@@ -369,6 +381,34 @@ func (wh *WorkflowHandler) PollForActivityTask(
 		return nil, nil
 	}
 
+	resp = toPollForActivityTaskResponse(matchingResp)
+	wh.setActivityLogLevel(domainName, resp)
+	return resp, nil
+}
+
+// setActivityLogLevel sets the log level hint configured for the activity type of the polled and prefetched tasks
+func (wh *WorkflowHandler) setActivityLogLevel(domainName string, resp *types.PollForActivityTaskResponse) {
+	logLevels := wh.config.ActivityLogLevel(dynamicconfig.DomainFilter(domainName))
+	if len(logLevels) == 0 {
+		return
+	}
+	for _, task := range append([]*types.PollForActivityTaskResponse{resp}, resp.PrefetchedTasks...) {
+		if len(task.TaskToken) == 0 {
+			continue
+		}
+		logLevel, ok := logLevels[task.ActivityType.GetName()].(string)
+		if !ok {
+			logLevel, _ = logLevels["*"].(string)
+		}
+		task.LogLevel = logLevel
+	}
+}
+
+func toPollForActivityTaskResponse(matchingResp *types.MatchingPollForActivityTaskResponse) *types.PollForActivityTaskResponse {
+	var prefetchedTasks []*types.PollForActivityTaskResponse
+	for _, prefetched := range matchingResp.PrefetchedTasks {
+		prefetchedTasks = append(prefetchedTasks, toPollForActivityTaskResponse(prefetched))
+	}
 	return &types.PollForActivityTaskResponse{
 		TaskToken:                       matchingResp.TaskToken,
 		WorkflowExecution:               matchingResp.WorkflowExecution,
@@ -387,7 +427,10 @@ func (wh *WorkflowHandler) PollForActivityTask(
 		WorkflowDomain:                  matchingResp.WorkflowDomain,
 		Header:                          matchingResp.Header,
 		AutoConfigHint:                  matchingResp.AutoConfigHint,
-	}, nil
+		WasCancelBeforeStart:            matchingResp.WasCancelBeforeStart,
+		ExpectedResultSizeBytes:         matchingResp.ExpectedResultSizeBytes,
+		PrefetchedTasks:                 prefetchedTasks,
+	}
 }
 
 // PollForDecisionTask - Poll for a decision task.
@@ -604,6 +647,24 @@ func (wh *WorkflowHandler) cancelOutstandingPoll(ctx context.Context, err error,
 	return err
 }
 
+// recordBackpressureHint forwards the backpressure hint reported by an activity worker to the matching host
+// owning its task list, which aggregates the hints and lowers the dispatch rate of the task list accordingly
+func (wh *WorkflowHandler) recordBackpressureHint(ctx context.Context, taskToken *common.TaskToken, hint *float64) {
+	if hint == nil || taskToken.TaskList == "" {
+		return
+	}
+	err := wh.GetMatchingClient().RecordBackpressureHint(ctx, &types.MatchingRecordBackpressureHintRequest{
+		DomainUUID: taskToken.DomainID,
+		TaskList:   &types.TaskList{Name: taskToken.TaskList, Kind: types.TaskListKindNormal.Ptr()},
+		Hint:       *hint,
+	})
+	// the hint is advisory, failing to forward it must not fail the response of the worker
+	if err != nil {
+		wh.GetLogger().Warn("Failed to record backpressure hint.",
+			tag.WorkflowTaskListName(taskToken.TaskList), tag.Error(err))
+	}
+}
+
 // RecordActivityTaskHeartbeat - Record Activity Task Heart beat.
 func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(
 	ctx context.Context,
@@ -638,6 +699,9 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(
 		domain: domainName,
 	}
 	scope := getMetricsScopeWithDomain(metrics.FrontendRecordActivityTaskHeartbeatScope, dw, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	if err := wh.allowActivityHeartbeat(ctx, domainName, scope); err != nil {
+		return nil, err
+	}
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 
@@ -731,6 +795,9 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeatByID(
 	}
 
 	scope := getMetricsScopeWithDomain(metrics.FrontendRecordActivityTaskHeartbeatByIDScope, heartbeatRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	if err := wh.allowActivityHeartbeat(ctx, domainName, scope); err != nil {
+		return nil, err
+	}
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 
@@ -763,9 +830,14 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeatByID(
 		resp = &types.RecordActivityTaskHeartbeatResponse{CancelRequested: true}
 	} else {
 		req := &types.RecordActivityTaskHeartbeatRequest{
-			TaskToken: token,
-			Details:   heartbeatRequest.Details,
-			Identity:  heartbeatRequest.Identity,
+			TaskToken:                           token,
+			Details:                             heartbeatRequest.Details,
+			Identity:                            heartbeatRequest.Identity,
+			RequestedStartToCloseTimeoutSeconds: heartbeatRequest.RequestedStartToCloseTimeoutSeconds,
+			HeartbeatSequence:                   heartbeatRequest.HeartbeatSequence,
+			ProgressPercent:                     heartbeatRequest.ProgressPercent,
+			CompressDetails:                     heartbeatRequest.CompressDetails,
+			WorkerHealth:                        heartbeatRequest.WorkerHealth,
 		}
 
 		resp, err = wh.GetHistoryClient().RecordActivityTaskHeartbeat(ctx, &types.HistoryRecordActivityTaskHeartbeatRequest{
@@ -803,6 +875,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 	if taskToken.DomainID == "" {
 		return validate.ErrDomainNotSet
 	}
+	wh.recordBackpressureHint(ctx, taskToken, completeRequest.BackpressureHint)
 
 	domainName, err := wh.GetDomainCache().GetDomainName(taskToken.DomainID)
 	if err != nil {
@@ -958,9 +1031,11 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedByID(
 		}
 	} else {
 		req := &types.RespondActivityTaskCompletedRequest{
-			TaskToken: token,
-			Result:    completeRequest.Result,
-			Identity:  completeRequest.Identity,
+			TaskToken:           token,
+			Result:              completeRequest.Result,
+			Identity:            completeRequest.Identity,
+			ResultSchemaVersion: completeRequest.ResultSchemaVersion,
+			IncrementCounter:    completeRequest.IncrementCounter,
 		}
 
 		err = wh.GetHistoryClient().RespondActivityTaskCompleted(ctx, &types.HistoryRespondActivityTaskCompletedRequest{
@@ -998,6 +1073,7 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 	if taskToken.DomainID == "" {
 		return validate.ErrDomainNotSet
 	}
+	wh.recordBackpressureHint(ctx, taskToken, failedRequest.BackpressureHint)
 
 	domainName, err := wh.GetDomainCache().GetDomainName(taskToken.DomainID)
 	if err != nil {
@@ -1132,10 +1208,11 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedByID(
 	}
 
 	req := &types.RespondActivityTaskFailedRequest{
-		TaskToken: token,
-		Reason:    failedRequest.Reason,
-		Details:   failedRequest.Details,
-		Identity:  failedRequest.Identity,
+		TaskToken:       token,
+		Reason:          failedRequest.Reason,
+		Details:         failedRequest.Details,
+		Identity:        failedRequest.Identity,
+		FailureCategory: failedRequest.FailureCategory,
 	}
 
 	err = wh.GetHistoryClient().RespondActivityTaskFailed(ctx, &types.HistoryRespondActivityTaskFailedRequest{
@@ -1345,6 +1422,64 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledByID(
 	return nil
 }
 
+// RespondActivityTaskNack - called by a worker which cannot process an activity task it polled, the task is
+// returned to its task list and the attempt is not counted
+func (wh *WorkflowHandler) RespondActivityTaskNack(
+	ctx context.Context,
+	nackRequest *types.RespondActivityTaskNackRequest,
+) (retError error) {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+
+	if nackRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	if nackRequest.TaskToken == nil {
+		return validate.ErrTaskTokenNotSet
+	}
+
+	taskToken, err := wh.tokenSerializer.Deserialize(nackRequest.TaskToken)
+	if err != nil {
+		return err
+	}
+
+	if taskToken.DomainID == "" {
+		return validate.ErrDomainNotSet
+	}
+
+	domainName, err := wh.GetDomainCache().GetDomainName(taskToken.DomainID)
+	if err != nil {
+		return err
+	}
+
+	dw := domainWrapper{
+		domain: domainName,
+	}
+	scope := getMetricsScopeWithDomain(metrics.FrontendRespondActivityTaskNackScope, dw, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	if !common.IsValidIDLength(
+		nackRequest.GetIdentity(),
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.IdentityMaxLength(domainName),
+		metrics.CadenceErrIdentityExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeIdentity) {
+		return validate.ErrIdentityTooLong
+	}
+
+	err = wh.GetHistoryClient().RespondActivityTaskNack(ctx, &types.HistoryRespondActivityTaskNackRequest{
+		DomainUUID:  taskToken.DomainID,
+		NackRequest: nackRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+	return nil
+}
+
 // RespondDecisionTaskCompleted - response to a decision task
 func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 	ctx context.Context,
@@ -1407,6 +1542,7 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 
 	completedResp := &types.RespondDecisionTaskCompletedResponse{}
 	completedResp.ActivitiesToDispatchLocally = histResp.ActivitiesToDispatchLocally
+	completedResp.GeneratedActivityIDs = histResp.GeneratedActivityIDs
 	if completeRequest.GetReturnNewDecisionTask() && histResp != nil && histResp.StartedResponse != nil {
 		taskToken := &common.TaskToken{
 			DomainID:        taskToken.DomainID,
@@ -1748,6 +1884,9 @@ func (wh *WorkflowHandler) validateStartWorkflowExecutionRequest(ctx context.Con
 	if startRequest.GetJitterStartSeconds() < 0 {
 		return validate.ErrInvalidJitterStartSeconds
 	}
+	if startRequest.GetPriority() < 0 {
+		return validate.ErrInvalidPriority
+	}
 	jitter := startRequest.GetJitterStartSeconds()
 	cron := startRequest.GetCronSchedule()
 	if cron != "" {
@@ -1816,6 +1955,15 @@ func (wh *WorkflowHandler) validateStartWorkflowExecutionRequest(ctx context.Con
 }
 
 // GetWorkflowExecutionHistory - retrieves the history of workflow execution
+// ActivityTaskCompleted events whose result was deduplicated against an earlier activity of the run are returned
+// with the result filled in, the ResultReferenceScheduledEventID is only visible in the raw or archived history.
+// So are the results written to the blobstore as they were above the archival threshold, see ResultBlobKey.
+//
+// With IncludePendingActivityHeartbeats, the ActivityTaskStarted events of the activities still pending in a running
+// workflow are annotated with their latest heartbeat details and timestamp, read from the mutable state when the page
+// is returned. The response then reflects the live state of these activities rather than the recorded history, and
+// the same page read again may differ. Raw history is not returned, since the events have to be rewritten. The
+// started event of an activity with a retry policy is only recorded once the activity closes, so it isn't annotated.
 func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 	ctx context.Context,
 	getRequest *types.GetWorkflowExecutionHistoryRequest,
@@ -1987,7 +2135,13 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 	clientFeatureVersion := call.Header(common.FeatureVersionHeaderName)
 	clientImpl := call.Header(common.ClientImplHeaderName)
 	supportsRawHistoryQuery := wh.versionChecker.SupportsRawHistoryQuery(clientImpl, clientFeatureVersion) == nil
-	isRawHistoryEnabled := wh.config.SendRawWorkflowHistory(domainName) && supportsRawHistoryQuery
+	// activity results are only dropped from the history of closed workflows, as workers of running
+	// workflows page through it, raw history is not returned since the results have to be rewritten
+	activityResultRetention := wh.config.ActivityResultRetention(domainName)
+	purgeActivityResults := activityResultRetention > 0 && !isWorkflowRunning
+	includePendingActivityHeartbeats := getRequest.GetIncludePendingActivityHeartbeats() && isWorkflowRunning
+	isRawHistoryEnabled := wh.config.SendRawWorkflowHistory(domainName) && supportsRawHistoryQuery && !purgeActivityResults &&
+		!includePendingActivityHeartbeats
 
 	history := &types.History{}
 	history.Events = []*types.HistoryEvent{}
@@ -2027,6 +2181,12 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		if err != nil {
 			return err
 		}
+		if purgeActivityResults {
+			purgeExpiredActivityResults(history.Events, activityResultRetention, wh.GetTimeSource().Now())
+		}
+		if includePendingActivityHeartbeats {
+			return wh.annotatePendingActivityHeartbeats(ctx, domainID, domainName, execution, history.Events)
+		}
 		return nil
 	}
 
@@ -2082,6 +2242,367 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 	}, nil
 }
 
+// SignalActivity is used to send a signal to a pending activity. The signal is buffered on the activity and
+// delivered to the worker with the response of its next heartbeat, no history event is recorded.
+func (wh *WorkflowHandler) SignalActivity(
+	ctx context.Context,
+	signalRequest *types.SignalActivityRequest,
+) (retError error) {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+
+	if signalRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	domainName := signalRequest.GetDomain()
+	if domainName == "" {
+		return validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(signalRequest.GetWorkflowExecution()); err != nil {
+		return err
+	}
+	if signalRequest.GetActivityID() == "" {
+		return validate.ErrActivityIDNotSet
+	}
+	if signalRequest.GetSignalName() == "" {
+		return validate.ErrSignalNameNotSet
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return err
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendSignalActivityScope, signalRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	if err := common.CheckEventBlobSizeLimit(
+		len(signalRequest.Input),
+		wh.config.BlobSizeLimitWarn(domainName),
+		wh.config.BlobSizeLimitError(domainName),
+		domainID,
+		domainName,
+		signalRequest.GetWorkflowExecution().GetWorkflowID(),
+		signalRequest.GetWorkflowExecution().GetRunID(),
+		scope,
+		wh.GetLogger(),
+		tag.BlobSizeViolationOperation("SignalActivity"),
+	); err != nil {
+		return err
+	}
+
+	err = wh.GetHistoryClient().SignalActivity(ctx, &types.HistorySignalActivityRequest{
+		DomainUUID:    domainID,
+		SignalRequest: signalRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+
+	return nil
+}
+
+// GetActivityEffectiveConfig returns the timeouts and retry policy which apply to a pending activity,
+// after the server side defaults and caps have been applied.
+func (wh *WorkflowHandler) GetActivityEffectiveConfig(
+	ctx context.Context,
+	request *types.GetActivityEffectiveConfigRequest,
+) (resp *types.GetActivityEffectiveConfigResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	if request.GetDomain() == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(request.GetWorkflowExecution()); err != nil {
+		return nil, err
+	}
+	if request.GetActivityID() == "" {
+		return nil, validate.ErrActivityIDNotSet
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = wh.GetHistoryClient().GetActivityEffectiveConfig(ctx, &types.HistoryGetActivityEffectiveConfigRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, err)
+	}
+	return resp, nil
+}
+
+// GetActivityNextRetryTime returns when a pending activity which is backing off will be retried,
+// together with the attempt which will be dispatched and the failure reason of the previous attempt.
+func (wh *WorkflowHandler) GetActivityNextRetryTime(
+	ctx context.Context,
+	request *types.GetActivityNextRetryTimeRequest,
+) (resp *types.GetActivityNextRetryTimeResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	if request.GetDomain() == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(request.GetWorkflowExecution()); err != nil {
+		return nil, err
+	}
+	if request.GetActivityID() == "" {
+		return nil, validate.ErrActivityIDNotSet
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = wh.GetHistoryClient().GetActivityNextRetryTime(ctx, &types.HistoryGetActivityNextRetryTimeRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, err)
+	}
+	return resp, nil
+}
+
+// GetActivityRescheduleReasons returns why each previous attempt of an activity ended and caused it to be scheduled again,
+// ordered from the oldest reschedule to the latest one.
+func (wh *WorkflowHandler) GetActivityRescheduleReasons(
+	ctx context.Context,
+	request *types.GetActivityRescheduleReasonsRequest,
+) (resp *types.GetActivityRescheduleReasonsResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	if request.GetDomain() == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(request.GetWorkflowExecution()); err != nil {
+		return nil, err
+	}
+	if request.GetActivityID() == "" {
+		return nil, validate.ErrActivityIDNotSet
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = wh.GetHistoryClient().GetActivityRescheduleReasons(ctx, &types.HistoryGetActivityRescheduleReasonsRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, err)
+	}
+	return resp, nil
+}
+
+// GetActivityWorkerHistory returns the worker each attempt of an activity ran on and how the attempt ended,
+// ordered from the oldest attempt to the latest one.
+func (wh *WorkflowHandler) GetActivityWorkerHistory(
+	ctx context.Context,
+	request *types.GetActivityWorkerHistoryRequest,
+) (resp *types.GetActivityWorkerHistoryResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	if request.GetDomain() == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(request.GetWorkflowExecution()); err != nil {
+		return nil, err
+	}
+	if request.GetActivityID() == "" {
+		return nil, validate.ErrActivityIDNotSet
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = wh.GetHistoryClient().GetActivityWorkerHistory(ctx, &types.HistoryGetActivityWorkerHistoryRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, err)
+	}
+	return resp, nil
+}
+
+// GetActivityRetryStats returns how many activity attempts of a domain closed within a time range and how many of
+// them were retries, summed over all the history hosts.
+func (wh *WorkflowHandler) GetActivityRetryStats(
+	ctx context.Context,
+	request *types.GetActivityRetryStatsRequest,
+) (resp *types.GetActivityRetryStatsResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	if request.GetDomain() == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = wh.GetHistoryClient().GetActivityRetryStats(ctx, &types.HistoryGetActivityRetryStatsRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, err)
+	}
+	return resp, nil
+}
+
+// GetActivityTokenValidationFailures returns the latest task tokens of the activities of a domain rejected by
+// history, oldest first, to diagnose workers holding on to stale tokens.
+func (wh *WorkflowHandler) GetActivityTokenValidationFailures(
+	ctx context.Context,
+	request *types.GetActivityTokenValidationFailuresRequest,
+) (resp *types.GetActivityTokenValidationFailuresResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	if request.GetDomain() == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = wh.GetHistoryClient().GetActivityTokenValidationFailures(ctx, &types.HistoryGetActivityTokenValidationFailuresRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, err)
+	}
+	return resp, nil
+}
+
+// GetActivityAttemptTimings returns when each attempt of an activity started and ended and how it ended,
+// ordered from the oldest attempt to the latest one.
+func (wh *WorkflowHandler) GetActivityAttemptTimings(
+	ctx context.Context,
+	request *types.GetActivityAttemptTimingsRequest,
+) (resp *types.GetActivityAttemptTimingsResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	if request.GetDomain() == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(request.GetWorkflowExecution()); err != nil {
+		return nil, err
+	}
+	if request.GetActivityID() == "" {
+		return nil, validate.ErrActivityIDNotSet
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = wh.GetHistoryClient().GetActivityAttemptTimings(ctx, &types.HistoryGetActivityAttemptTimingsRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, err)
+	}
+	return resp, nil
+}
+
+// ResetActivityAttempts resets the retry attempts of an activity, so that an activity which kept failing because
+// of an already fixed dependency gets its full retry budget back without resetting the whole workflow. An activity
+// which ran out of retries is scheduled again. The reset is recorded in history with a marker.
+func (wh *WorkflowHandler) ResetActivityAttempts(
+	ctx context.Context,
+	resetRequest *types.ResetActivityAttemptsRequest,
+) (retError error) {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+
+	if resetRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	if resetRequest.GetDomain() == "" {
+		return validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(resetRequest.GetWorkflowExecution()); err != nil {
+		return err
+	}
+	if resetRequest.GetActivityID() == "" {
+		return validate.ErrActivityIDNotSet
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(resetRequest.GetDomain())
+	if err != nil {
+		return err
+	}
+
+	err = wh.GetHistoryClient().ResetActivityAttempts(ctx, &types.HistoryResetActivityAttemptsRequest{
+		DomainUUID: domainID,
+		Request:    resetRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+	return nil
+}
+
 // SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
 // WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.
 func (wh *WorkflowHandler) SignalWorkflowExecution(
@@ -2722,6 +3243,56 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(
 	return response, nil
 }
 
+// BatchDescribeWorkflowExecutions returns information about several workflow executions of a domain. A failure
+// to describe one of them is reported on its result and doesn't fail the call.
+func (wh *WorkflowHandler) BatchDescribeWorkflowExecutions(
+	ctx context.Context,
+	request *types.BatchDescribeWorkflowExecutionsRequest,
+) (resp *types.BatchDescribeWorkflowExecutionsResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	domainName := request.GetDomain()
+	if domainName == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+
+	executions := request.GetExecutions()
+	if len(executions) == 0 {
+		return nil, validate.ErrExecutionNotSet
+	}
+	if maxSize := wh.config.MaxBatchDescribeWorkflowExecutionsSize(); len(executions) > maxSize {
+		return nil, &types.BadRequestError{
+			Message: fmt.Sprintf("Too many executions in request: %d, max allowed: %d.", len(executions), maxSize),
+		}
+	}
+	for _, execution := range executions {
+		if err := validate.CheckExecution(execution); err != nil {
+			return nil, err
+		}
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := wh.GetHistoryClient().BatchDescribeWorkflowExecutions(ctx, &types.HistoryBatchDescribeWorkflowExecutionsRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, err)
+	}
+
+	return response, nil
+}
+
 func (wh *WorkflowHandler) getRawHistory(
 	ctx context.Context,
 	scope metrics.Scope,
@@ -2841,6 +3412,18 @@ func (wh *WorkflowHandler) getHistory(
 		return nil, nil, err
 	}
 
+	if err := wh.resolveActivityResultReferences(ctx, domainName, shardID, branchToken, historyEvents); err != nil {
+		return nil, nil, err
+	}
+
+	if err := wh.resolveArchivedActivityResults(ctx, historyEvents); err != nil {
+		return nil, nil, err
+	}
+
+	if err := activityencryption.DecryptEvents(ctx, wh.activityPayloadEncryptor, domainName, historyEvents); err != nil {
+		return nil, nil, &types.InternalServiceError{Message: "Unable to decrypt activity payloads: " + err.Error()}
+	}
+
 	if len(nextPageToken) == 0 && transientDecision != nil {
 		if err := wh.validateTransientDecisionEvents(nextEventID, transientDecision); err != nil {
 			scope.IncCounter(metrics.CadenceErrIncompleteHistoryCounter)
@@ -2859,6 +3442,173 @@ func (wh *WorkflowHandler) getHistory(
 	return executionHistory, nextPageToken, nil
 }
 
+// resolveActivityResultReferences fills in the result of the ActivityTaskCompleted events recorded with a
+// ResultReferenceScheduledEventID from the completed event of the referenced activity. References to activities
+// completed before the first of the events are resolved by reading the history from its beginning.
+func (wh *WorkflowHandler) resolveActivityResultReferences(
+	ctx context.Context,
+	domainName string,
+	shardID int,
+	branchToken []byte,
+	events []*types.HistoryEvent,
+) error {
+
+	completed := make(map[int64]*types.ActivityTaskCompletedEventAttributes)
+	var unresolved []*types.ActivityTaskCompletedEventAttributes
+	for _, event := range events {
+		attributes := event.ActivityTaskCompletedEventAttributes
+		if event.GetEventType() != types.EventTypeActivityTaskCompleted || attributes == nil {
+			continue
+		}
+		if attributes.ResultReferenceScheduledEventID == 0 {
+			completed[attributes.ScheduledEventID] = attributes
+			continue
+		}
+		if referenced, ok := completed[attributes.ResultReferenceScheduledEventID]; ok {
+			resolveActivityResultReference(attributes, referenced)
+			continue
+		}
+		unresolved = append(unresolved, attributes)
+	}
+	if len(unresolved) == 0 {
+		return nil
+	}
+
+	var nextPageToken []byte
+	for {
+		var earlierEvents []*types.HistoryEvent
+		var err error
+		earlierEvents, _, nextPageToken, err = persistenceutils.ReadFullPageV2Events(ctx, wh.GetHistoryManager(), &persistence.ReadHistoryBranchRequest{
+			BranchToken:   branchToken,
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    events[0].ID,
+			PageSize:      common.GetHistoryMaxPageSize,
+			NextPageToken: nextPageToken,
+			ShardID:       common.IntPtr(shardID),
+			DomainName:    domainName,
+		})
+		if err != nil {
+			return err
+		}
+		for _, event := range earlierEvents {
+			attributes := event.ActivityTaskCompletedEventAttributes
+			if event.GetEventType() == types.EventTypeActivityTaskCompleted && attributes != nil && attributes.ResultReferenceScheduledEventID == 0 {
+				completed[attributes.ScheduledEventID] = attributes
+			}
+		}
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	for _, attributes := range unresolved {
+		referenced, ok := completed[attributes.ResultReferenceScheduledEventID]
+		if !ok {
+			return &types.InternalServiceError{Message: fmt.Sprintf(
+				"Unable to resolve activity result, activity scheduled by event %v not completed.", attributes.ResultReferenceScheduledEventID)}
+		}
+		resolveActivityResultReference(attributes, referenced)
+	}
+	return nil
+}
+
+func resolveActivityResultReference(
+	attributes *types.ActivityTaskCompletedEventAttributes,
+	referenced *types.ActivityTaskCompletedEventAttributes,
+) {
+	attributes.Result = referenced.Result
+	attributes.ResultBlobKey = referenced.ResultBlobKey
+	attributes.EncryptionKeyID = referenced.EncryptionKeyID
+	attributes.ResultReferenceScheduledEventID = 0
+}
+
+// resolveArchivedActivityResults fills in the result of the ActivityTaskCompleted events recorded with a
+// ResultBlobKey from the blobstore the result was written to
+func (wh *WorkflowHandler) resolveArchivedActivityResults(
+	ctx context.Context,
+	events []*types.HistoryEvent,
+) error {
+
+	for _, event := range events {
+		attributes := event.ActivityTaskCompletedEventAttributes
+		if event.GetEventType() != types.EventTypeActivityTaskCompleted || attributes.GetResultBlobKey() == "" {
+			continue
+		}
+		blobstoreClient := wh.GetBlobstoreClient()
+		if blobstoreClient == nil {
+			return &types.InternalServiceError{Message: "Unable to read archived activity result, no blobstore is configured."}
+		}
+		resp, err := blobstoreClient.Get(ctx, &blobstore.GetRequest{Key: attributes.ResultBlobKey})
+		if err != nil {
+			return &types.InternalServiceError{Message: "Unable to read archived activity result: " + err.Error()}
+		}
+		attributes.Result = resp.Blob.Body
+		attributes.ResultBlobKey = ""
+	}
+	return nil
+}
+
+// purgeExpiredActivityResults replaces the result of ActivityTaskCompleted events older than the retention with
+// common.ActivityResultTombstone. The events are kept so that the history stays complete, but replaying it is no
+// longer possible as the workflow code would observe the tombstone instead of the activity result. The results are
+// only dropped from the response, they stay in the history storage until the workflow retention removes it.
+func purgeExpiredActivityResults(
+	events []*types.HistoryEvent,
+	retention time.Duration,
+	now time.Time,
+) {
+	for _, event := range events {
+		if event.GetEventType() != types.EventTypeActivityTaskCompleted {
+			continue
+		}
+		if now.Sub(time.Unix(0, event.GetTimestamp())) < retention {
+			continue
+		}
+		if attributes := event.ActivityTaskCompletedEventAttributes; attributes != nil && attributes.Result != nil {
+			attributes.Result = []byte(common.ActivityResultTombstone)
+		}
+	}
+}
+
+// annotatePendingActivityHeartbeats sets the latest heartbeat of the activities still pending on their
+// ActivityTaskStarted events. The mutable state is only read if any started event is among the events.
+func (wh *WorkflowHandler) annotatePendingActivityHeartbeats(
+	ctx context.Context,
+	domainID string,
+	domainName string,
+	execution *types.WorkflowExecution,
+	events []*types.HistoryEvent,
+) error {
+
+	started := make(map[int64]*types.ActivityTaskStartedEventAttributes)
+	for _, event := range events {
+		if attributes := event.ActivityTaskStartedEventAttributes; event.GetEventType() == types.EventTypeActivityTaskStarted && attributes != nil {
+			started[attributes.ScheduledEventID] = attributes
+		}
+	}
+	if len(started) == 0 {
+		return nil
+	}
+
+	response, err := wh.GetHistoryClient().DescribeWorkflowExecution(ctx, &types.HistoryDescribeWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		Request: &types.DescribeWorkflowExecutionRequest{
+			Domain:    domainName,
+			Execution: execution,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	for _, pendingActivity := range response.PendingActivities {
+		if attributes, ok := started[pendingActivity.ScheduleID]; ok && pendingActivity.LastHeartbeatTimestamp != nil {
+			attributes.HeartbeatDetails = pendingActivity.HeartbeatDetails
+			attributes.LastHeartbeatTimestamp = pendingActivity.LastHeartbeatTimestamp
+		}
+	}
+	return nil
+}
+
 func (wh *WorkflowHandler) validateTransientDecisionEvents(
 	expectedNextEventID int64,
 	decision *types.TransientDecisionInfo,
@@ -2992,6 +3742,7 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 		NextEventID:               matchingResp.NextEventID,
 		TotalHistoryBytes:         matchingResp.TotalHistoryBytes,
 		AutoConfigHint:            matchingResp.AutoConfigHint,
+		ActivityBatchCompleted:    matchingResp.ActivityBatchCompleted,
 	}
 
 	return resp, nil
@@ -41,8 +41,10 @@ import (
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activityencryption"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/cluster"
@@ -53,6 +55,7 @@ import (
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/partition"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
@@ -162,7 +165,7 @@ func (s *workflowHandlerSuite) TearDownTest() {
 }
 
 func (s *workflowHandlerSuite) getWorkflowHandler(config *frontendcfg.Config) *WorkflowHandler {
-	return NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, s.domainHandler)
+	return NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, s.domainHandler, activityencryption.NewNopEncryptor())
 }
 
 func (s *workflowHandlerSuite) TestDisableListVisibilityByFilter() {
@@ -442,6 +445,31 @@ func (s *workflowHandlerSuite) TestPollForActivityTask() {
 	}
 }
 
+func (s *workflowHandlerSuite) TestPollForActivityTask_LogLevel() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.ActivityLogLevel = dc.GetMapPropertyFn(map[string]interface{}{"debugged-activity": "debug", "*": "info"})
+	wh := s.getWorkflowHandler(config)
+
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+	s.mockMatchingClient.EXPECT().PollForActivityTask(gomock.Any(), gomock.Any()).Return(&types.MatchingPollForActivityTaskResponse{
+		TaskToken:    []byte("token"),
+		ActivityType: &types.ActivityType{Name: "debugged-activity"},
+		PrefetchedTasks: []*types.MatchingPollForActivityTaskResponse{
+			{TaskToken: []byte("prefetched-token"), ActivityType: &types.ActivityType{Name: "other-activity"}},
+		},
+	}, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	resp, err := wh.PollForActivityTask(ctx, &types.PollForActivityTaskRequest{
+		Domain:   s.testDomain,
+		TaskList: &types.TaskList{Name: "task-list"},
+	})
+	s.NoError(err)
+	s.Equal("debug", resp.LogLevel)
+	s.Len(resp.PrefetchedTasks, 1)
+	s.Equal("info", resp.PrefetchedTasks[0].LogLevel)
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_RequestIdNotSet() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.UserRPS = dc.GetIntPropertyFn(10)
@@ -507,6 +535,37 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_BadDelayStartSe
 	s.Equal(validate.ErrInvalidDelayStartSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_BadPriority() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	startWorkflowExecutionRequest := &types.StartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: "workflow-id",
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RetryPolicy: &types.RetryPolicy{
+			InitialIntervalInSeconds:    1,
+			BackoffCoefficient:          2,
+			MaximumIntervalInSeconds:    2,
+			MaximumAttempts:             1,
+			ExpirationIntervalInSeconds: 1,
+		},
+		RequestID: uuid.New(),
+		Priority:  common.Int32Ptr(-1),
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.Error(err)
+	s.Equal(validate.ErrInvalidPriority, err)
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_StartRequestNotSet() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.UserRPS = dc.GetIntPropertyFn(10)
@@ -852,6 +911,134 @@ func (s *workflowHandlerSuite) TestRecordActivityTaskHeartbeat_Success() {
 	s.Equal(resp, result)
 }
 
+func (s *workflowHandlerSuite) TestRenewActivityLease_Success() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+	taskTokenBytes, err := wh.tokenSerializer.Serialize(&common.TaskToken{
+		DomainID:   s.testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		ActivityID: "1",
+	})
+	s.NoError(err)
+
+	s.mockDomainCache.EXPECT().GetDomainName(s.testDomainID).Return(s.testDomain, nil)
+	s.mockHistoryClient.EXPECT().RecordActivityTaskHeartbeat(gomock.Any(),
+		&types.HistoryRecordActivityTaskHeartbeatRequest{
+			DomainUUID: s.testDomainID,
+			HeartbeatRequest: &types.RecordActivityTaskHeartbeatRequest{
+				TaskToken: taskTokenBytes,
+				Identity:  "worker",
+			},
+			RenewLeaseOnly: true,
+		}).Return(&types.RecordActivityTaskHeartbeatResponse{CancelRequested: true}, nil)
+
+	result, err := wh.RenewActivityLease(context.Background(), &types.RenewActivityLeaseRequest{
+		TaskToken: taskTokenBytes,
+		Identity:  "worker",
+	})
+	s.NoError(err)
+	s.Equal(&types.RenewActivityLeaseResponse{CancelRequested: true}, result)
+}
+
+func (s *workflowHandlerSuite) TestRenewActivityLease_TaskTokenNotSet() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+	result, err := wh.RenewActivityLease(context.Background(), &types.RenewActivityLeaseRequest{})
+
+	s.Equal(validate.ErrTaskTokenNotSet, err)
+	s.Nil(result)
+}
+
+func (s *workflowHandlerSuite) TestListPendingActivityScheduleAttributes_Success() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+	execution := &types.WorkflowExecution{WorkflowID: testWorkflowID, RunID: testRunID}
+	scheduledEventAttributes := &types.ActivityTaskScheduledEventAttributes{
+		ActivityID:   "1",
+		ActivityType: &types.ActivityType{Name: "activity-type"},
+		Input:        []byte("input"),
+		Header:       &types.Header{Fields: map[string][]byte{"key": []byte("value")}},
+		RetryPolicy:  &types.RetryPolicy{MaximumAttempts: 3},
+	}
+
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(),
+		&types.HistoryDescribeWorkflowExecutionRequest{
+			DomainUUID: s.testDomainID,
+			Request: &types.DescribeWorkflowExecutionRequest{
+				Domain:    s.testDomain,
+				Execution: execution,
+			},
+			IncludeScheduledEventAttributes: true,
+		}).Return(&types.DescribeWorkflowExecutionResponse{
+		PendingActivities: []*types.PendingActivityInfo{
+			{ActivityID: "1", ScheduleID: 5, ScheduledEventAttributes: scheduledEventAttributes},
+		},
+	}, nil)
+
+	result, err := wh.ListPendingActivityScheduleAttributes(context.Background(), &types.ListPendingActivityScheduleAttributesRequest{
+		Domain:    s.testDomain,
+		Execution: execution,
+	})
+	s.NoError(err)
+	s.Equal(&types.ListPendingActivityScheduleAttributesResponse{
+		PendingActivities: []*types.PendingActivityScheduleAttributes{
+			{ActivityID: "1", ScheduleID: 5, ScheduledEventAttributes: scheduledEventAttributes},
+		},
+	}, result)
+}
+
+func (s *workflowHandlerSuite) TestAnnotatePendingActivityHeartbeats() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+	execution := &types.WorkflowExecution{WorkflowID: testWorkflowID, RunID: testRunID}
+	newStartedEvent := func(id, scheduledEventID int64) *types.HistoryEvent {
+		return &types.HistoryEvent{
+			ID:        id,
+			EventType: types.EventTypeActivityTaskStarted.Ptr(),
+			ActivityTaskStartedEventAttributes: &types.ActivityTaskStartedEventAttributes{
+				ScheduledEventID: scheduledEventID,
+			},
+		}
+	}
+	heartbeating := newStartedEvent(6, 5)
+	notHeartbeating := newStartedEvent(8, 7)
+	closed := newStartedEvent(10, 9)
+
+	// no started event, the mutable state is not read
+	s.NoError(wh.annotatePendingActivityHeartbeats(context.Background(), s.testDomainID, s.testDomain, execution, []*types.HistoryEvent{
+		{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
+	}))
+
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(),
+		&types.HistoryDescribeWorkflowExecutionRequest{
+			DomainUUID: s.testDomainID,
+			Request: &types.DescribeWorkflowExecutionRequest{
+				Domain:    s.testDomain,
+				Execution: execution,
+			},
+		}).Return(&types.DescribeWorkflowExecutionResponse{
+		PendingActivities: []*types.PendingActivityInfo{
+			{ActivityID: "5", ScheduleID: 5, HeartbeatDetails: []byte("progress"), LastHeartbeatTimestamp: common.Int64Ptr(123)},
+			{ActivityID: "7", ScheduleID: 7},
+		},
+	}, nil)
+	s.NoError(wh.annotatePendingActivityHeartbeats(context.Background(), s.testDomainID, s.testDomain, execution,
+		[]*types.HistoryEvent{heartbeating, notHeartbeating, closed}))
+
+	s.Equal([]byte("progress"), heartbeating.ActivityTaskStartedEventAttributes.HeartbeatDetails)
+	s.Equal(int64(123), heartbeating.ActivityTaskStartedEventAttributes.GetLastHeartbeatTimestamp())
+	s.Nil(notHeartbeating.ActivityTaskStartedEventAttributes.LastHeartbeatTimestamp)
+	s.Nil(closed.ActivityTaskStartedEventAttributes.LastHeartbeatTimestamp)
+}
+
+func (s *workflowHandlerSuite) TestListPendingActivityScheduleAttributes_DomainNotSet() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+	result, err := wh.ListPendingActivityScheduleAttributes(context.Background(), &types.ListPendingActivityScheduleAttributesRequest{
+		Execution: &types.WorkflowExecution{WorkflowID: testWorkflowID, RunID: testRunID},
+	})
+
+	s.Equal(validate.ErrDomainNotSet, err)
+	s.Nil(result)
+}
+
 func (s *workflowHandlerSuite) TestRecordActivityTaskHeartbeat_RequestNotSet() {
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 	result, err := wh.RecordActivityTaskHeartbeat(context.Background(), nil /*request is not set*/)
@@ -892,6 +1079,45 @@ func (s *workflowHandlerSuite) TestRecordActivityTaskHeartbeatByID_Success() {
 	s.Equal(resp, result)
 }
 
+func (s *workflowHandlerSuite) TestRecordActivityTaskHeartbeatByID_Throttled() {
+	dynamicClient := dc.NewInMemoryClient()
+	s.NoError(dynamicClient.UpdateValue(dc.FrontendActivityHeartbeatRPS, 1))
+	s.NoError(dynamicClient.UpdateValue(dc.FrontendActivityHeartbeatQueueTimeout, time.Millisecond))
+	s.NoError(dynamicClient.UpdateValue(dc.FrontendActivityHeartbeatRetryAfter, 2*time.Second))
+	wh := s.getWorkflowHandler(s.newConfig(dynamicClient))
+	mockLimiter := quotas.NewMockLimiter(s.controller)
+	mockLimiters := quotas.NewMockICollection(s.controller)
+	mockLimiters.EXPECT().For(s.testDomain).Return(mockLimiter).Times(2)
+	wh.activityHeartbeatLimiters = mockLimiters
+	req := &types.RecordActivityTaskHeartbeatByIDRequest{
+		Domain:     s.testDomain,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		ActivityID: "1",
+	}
+	resp := &types.RecordActivityTaskHeartbeatResponse{CancelRequested: false}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(2)
+
+	// no token is available, even after waiting for the queue timeout
+	mockLimiter.EXPECT().Allow().Return(false)
+	mockLimiter.EXPECT().Wait(gomock.Any()).Return(context.DeadlineExceeded)
+	result, err := wh.RecordActivityTaskHeartbeatByID(context.Background(), req)
+	s.Equal(&types.ServiceBusyError{
+		Message:      "Too many activity heartbeats of the domain, retry after 2s",
+		Reason:       common.ActivityHeartbeatThrottledReason,
+		RetryAfterMs: 2000,
+	}, err)
+	s.Nil(result)
+
+	// a token is available after waiting
+	mockLimiter.EXPECT().Allow().Return(false)
+	mockLimiter.EXPECT().Wait(gomock.Any()).Return(nil)
+	s.mockHistoryClient.EXPECT().RecordActivityTaskHeartbeat(gomock.Any(), gomock.Any()).Return(resp, nil)
+	result, err = wh.RecordActivityTaskHeartbeatByID(context.Background(), req)
+	s.NoError(err)
+	s.Equal(resp, result)
+}
+
 func (s *workflowHandlerSuite) TestRecordActivityTaskHeartbeatByID_RequestNotSet() {
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 	result, err := wh.RecordActivityTaskHeartbeatByID(context.Background(), nil /*request is not set*/)
@@ -940,6 +1166,39 @@ func (s *workflowHandlerSuite) TestRespondActivityTaskCompleted_Success() {
 	s.NoError(err)
 }
 
+func (s *workflowHandlerSuite) TestRespondActivityTaskCompleted_BackpressureHint() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+	taskToken := common.TaskToken{
+		DomainID:   s.testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		ActivityID: "1",
+		TaskList:   "task-list",
+	}
+	taskTokenBytes, err := wh.tokenSerializer.Serialize(&taskToken)
+	s.NoError(err)
+	req := &types.RespondActivityTaskCompletedRequest{
+		TaskToken:        taskTokenBytes,
+		BackpressureHint: common.Float64Ptr(0.8),
+	}
+
+	s.mockMatchingClient.EXPECT().RecordBackpressureHint(gomock.Any(), &types.MatchingRecordBackpressureHintRequest{
+		DomainUUID: s.testDomainID,
+		TaskList:   &types.TaskList{Name: "task-list", Kind: types.TaskListKindNormal.Ptr()},
+		Hint:       0.8,
+	}).Return(errors.New("matching unavailable"))
+	s.mockDomainCache.EXPECT().GetDomainName(s.testDomainID).Return(s.testDomain, nil)
+	s.mockHistoryClient.EXPECT().RespondActivityTaskCompleted(gomock.Any(),
+		&types.HistoryRespondActivityTaskCompletedRequest{
+			DomainUUID:      taskToken.DomainID,
+			CompleteRequest: req,
+		}).Return(nil)
+
+	// failing to forward the hint does not fail the completion
+	err = wh.RespondActivityTaskCompleted(context.Background(), req)
+	s.NoError(err)
+}
+
 func (s *workflowHandlerSuite) TestRespondActivityTaskCompletedByID_Success() {
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 	req := &types.RespondActivityTaskCompletedByIDRequest{
@@ -1050,7 +1309,7 @@ func TestRespondActivityTaskFailed(t *testing.T) {
 				"hostname",
 			)
 
-			wh := NewWorkflowHandler(mockResource, config, mockVersionChecker, nil)
+			wh := NewWorkflowHandler(mockResource, config, mockVersionChecker, nil, activityencryption.NewNopEncryptor())
 			wh.shuttingDown = tt.fields.shuttingDown
 			wh.producerManager = mockProducerManager
 
@@ -1758,6 +2017,123 @@ func (s *workflowHandlerSuite) TestGetHistory() {
 	s.Equal([]byte{}, token)
 }
 
+func (s *workflowHandlerSuite) TestGetHistory_ActivityResultReferences() {
+	domainID := uuid.New()
+	domainName := uuid.New()
+	firstEventID := int64(100)
+	nextEventID := int64(103)
+	branchToken := []byte{1}
+	we := types.WorkflowExecution{
+		WorkflowID: "wid",
+		RunID:      "rid",
+	}
+	shardID := common.WorkflowIDToHistoryShard(we.WorkflowID, numHistoryShards)
+	completedEvent := func(eventID, scheduledEventID, referenceID int64, result []byte) *types.HistoryEvent {
+		return &types.HistoryEvent{
+			ID:        eventID,
+			EventType: types.EventTypeActivityTaskCompleted.Ptr(),
+			ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+				ScheduledEventID:                scheduledEventID,
+				ResultReferenceScheduledEventID: referenceID,
+				Result:                          result,
+			},
+		}
+	}
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, &persistence.ReadHistoryBranchRequest{
+		BranchToken:   branchToken,
+		MinEventID:    firstEventID,
+		MaxEventID:    nextEventID,
+		PageSize:      0,
+		NextPageToken: []byte{},
+		ShardID:       common.IntPtr(shardID),
+		DomainName:    domainName,
+	}).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{
+			completedEvent(100, 20, 0, []byte("result")),
+			completedEvent(101, 30, 20, nil),
+			completedEvent(102, 40, 5, nil),
+		},
+		NextPageToken: []byte{},
+	}, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  firstEventID,
+		PageSize:    common.GetHistoryMaxPageSize,
+		ShardID:     common.IntPtr(shardID),
+		DomainName:  domainName,
+	}).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{
+			completedEvent(7, 5, 0, []byte("earlier result")),
+		},
+	}, nil).Once()
+
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+
+	scope := metrics.NoopScope(metrics.Frontend)
+	history, _, err := wh.getHistory(context.Background(), scope, domainID, domainName, we, firstEventID, nextEventID, 0, []byte{}, nil, branchToken)
+	s.NoError(err)
+	s.Len(history.Events, 3)
+	s.Equal([]byte("result"), history.Events[1].ActivityTaskCompletedEventAttributes.Result)
+	s.Equal([]byte("earlier result"), history.Events[2].ActivityTaskCompletedEventAttributes.Result)
+	s.Zero(history.Events[2].ActivityTaskCompletedEventAttributes.ResultReferenceScheduledEventID)
+}
+
+func (s *workflowHandlerSuite) TestGetHistory_ArchivedActivityResults() {
+	domainID := uuid.New()
+	domainName := uuid.New()
+	firstEventID := int64(100)
+	nextEventID := int64(102)
+	branchToken := []byte{1}
+	we := types.WorkflowExecution{
+		WorkflowID: "wid",
+		RunID:      "rid",
+	}
+	shardID := common.WorkflowIDToHistoryShard(we.WorkflowID, numHistoryShards)
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, &persistence.ReadHistoryBranchRequest{
+		BranchToken:   branchToken,
+		MinEventID:    firstEventID,
+		MaxEventID:    nextEventID,
+		PageSize:      0,
+		NextPageToken: []byte{},
+		ShardID:       common.IntPtr(shardID),
+		DomainName:    domainName,
+	}).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{
+			{
+				ID:        100,
+				EventType: types.EventTypeActivityTaskCompleted.Ptr(),
+				ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+					ScheduledEventID: 20,
+					ResultBlobKey:    "activity-results/key",
+				},
+			},
+			{
+				ID:        101,
+				EventType: types.EventTypeActivityTaskCompleted.Ptr(),
+				ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+					ScheduledEventID:                30,
+					ResultReferenceScheduledEventID: 20,
+				},
+			},
+		},
+		NextPageToken: []byte{},
+	}, nil).Once()
+	s.mockResource.BlobstoreClient.On("Get", mock.Anything, &blobstore.GetRequest{Key: "activity-results/key"}).
+		Return(&blobstore.GetResponse{Blob: blobstore.Blob{Body: []byte("archived result")}}, nil).Twice()
+
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+
+	scope := metrics.NoopScope(metrics.Frontend)
+	history, _, err := wh.getHistory(context.Background(), scope, domainID, domainName, we, firstEventID, nextEventID, 0, []byte{}, nil, branchToken)
+	s.NoError(err)
+	s.Len(history.Events, 2)
+	for _, event := range history.Events {
+		s.Equal([]byte("archived result"), event.ActivityTaskCompletedEventAttributes.Result)
+		s.Empty(event.ActivityTaskCompletedEventAttributes.ResultBlobKey)
+	}
+}
+
 func (s *workflowHandlerSuite) TestListArchivedVisibility_Failure_InvalidRequest() {
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
@@ -2229,7 +2605,7 @@ func (s *workflowHandlerSuite) newConfig(dynamicClient dc.Client) *frontendcfg.C
 func (s *workflowHandlerSuite) TestRespondActivityTaskFailedByID() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.EnableClientVersionCheck = dc.GetBoolPropertyFn(true)
-	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil, activityencryption.NewNopEncryptor())
 	wh.tokenSerializer = s.mockTokenSerializer
 
 	validRequest := &types.RespondActivityTaskFailedByIDRequest{
@@ -2362,7 +2738,7 @@ func (s *workflowHandlerSuite) TestRespondActivityTaskFailedByID() {
 func (s *workflowHandlerSuite) TestRespondActivityTaskCanceled() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.EnableClientVersionCheck = dc.GetBoolPropertyFn(true)
-	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil, activityencryption.NewNopEncryptor())
 	wh.tokenSerializer = s.mockTokenSerializer
 
 	validInput := &types.RespondActivityTaskCanceledRequest{
@@ -2479,68 +2855,137 @@ func (s *workflowHandlerSuite) TestRespondActivityTaskCanceled() {
 	}
 }
 
-func (s *workflowHandlerSuite) TestRespondActivityTaskCanceledByID() {
+func (s *workflowHandlerSuite) TestRespondActivityTaskNack() {
 	config := s.newConfig(dc.NewInMemoryClient())
-	config.EnableClientVersionCheck = dc.GetBoolPropertyFn(true)
-	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil, activityencryption.NewNopEncryptor())
 	wh.tokenSerializer = s.mockTokenSerializer
 
-	validInput := &types.RespondActivityTaskCanceledByIDRequest{
-		Domain:     s.testDomain,
-		WorkflowID: testWorkflowID,
-		RunID:      testRunID,
-		ActivityID: "activityID",
-		Identity:   "identity",
-		Details:    make([]byte, 1000),
+	validInput := &types.RespondActivityTaskNackRequest{
+		TaskToken: []byte("token"),
+		Reason:    "reason",
+		Identity:  "identity",
 	}
 
 	testInput := map[string]struct {
-		request     *types.RespondActivityTaskCanceledByIDRequest
-		expectError bool
+		input       *types.RespondActivityTaskNackRequest
 		mockFn      func()
+		expectError bool
 	}{
-		"shutting down": {
-			request: validInput,
-			mockFn: func() {
-				wh.shuttingDown = int32(1)
-			},
-			expectError: true,
-		},
 		"nil request": {
-			request:     nil,
+			input:       nil,
 			mockFn:      func() {},
 			expectError: true,
 		},
-		"empty domain name": {
-			request: &types.RespondActivityTaskCanceledByIDRequest{
-				Domain: "",
-			},
+		"empty task token": {
+			input:       &types.RespondActivityTaskNackRequest{},
 			mockFn:      func() {},
 			expectError: true,
 		},
-		"cannot get domain ID": {
-			request: validInput,
+		"empty domain ID": {
+			input: validInput,
 			mockFn: func() {
-				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return("", errors.New("error getting domain ID"))
+				s.mockTokenSerializer.EXPECT().Deserialize(gomock.Any()).Return(&common.TaskToken{DomainID: ""}, nil)
 			},
 			expectError: true,
 		},
-		"empty domain ID": {
-			request: &types.RespondActivityTaskCanceledByIDRequest{
-				Domain: s.testDomain,
-			},
+		"history client returns error": {
+			input: validInput,
 			mockFn: func() {
-				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return("", nil)
+				s.mockTokenSerializer.EXPECT().Deserialize(gomock.Any()).Return(&common.TaskToken{DomainID: s.testDomainID}, nil)
+				s.mockDomainCache.EXPECT().GetDomainName(s.testDomainID).Return(s.testDomain, nil)
+				s.mockHistoryClient.EXPECT().RespondActivityTaskNack(gomock.Any(), gomock.Any()).Return(errors.New("error"))
 			},
 			expectError: true,
 		},
-		"empty workflow ID": {
-			request: &types.RespondActivityTaskCanceledByIDRequest{
-				Domain:     s.testDomain,
-				WorkflowID: "",
-			},
+		"no error": {
+			input: validInput,
 			mockFn: func() {
-				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockTokenSerializer.EXPECT().Deserialize(gomock.Any()).Return(&common.TaskToken{DomainID: s.testDomainID}, nil)
+				s.mockDomainCache.EXPECT().GetDomainName(s.testDomainID).Return(s.testDomain, nil)
+				s.mockHistoryClient.EXPECT().RespondActivityTaskNack(gomock.Any(), &types.HistoryRespondActivityTaskNackRequest{
+					DomainUUID:  s.testDomainID,
+					NackRequest: validInput,
+				}).Return(nil)
+			},
+			expectError: false,
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			input.mockFn()
+			err := wh.RespondActivityTaskNack(context.Background(), input.input)
+			if input.expectError {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *workflowHandlerSuite) TestRespondActivityTaskCanceledByID() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.EnableClientVersionCheck = dc.GetBoolPropertyFn(true)
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil, activityencryption.NewNopEncryptor())
+	wh.tokenSerializer = s.mockTokenSerializer
+
+	validInput := &types.RespondActivityTaskCanceledByIDRequest{
+		Domain:     s.testDomain,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		ActivityID: "activityID",
+		Identity:   "identity",
+		Details:    make([]byte, 1000),
+	}
+
+	testInput := map[string]struct {
+		request     *types.RespondActivityTaskCanceledByIDRequest
+		expectError bool
+		mockFn      func()
+	}{
+		"shutting down": {
+			request: validInput,
+			mockFn: func() {
+				wh.shuttingDown = int32(1)
+			},
+			expectError: true,
+		},
+		"nil request": {
+			request:     nil,
+			mockFn:      func() {},
+			expectError: true,
+		},
+		"empty domain name": {
+			request: &types.RespondActivityTaskCanceledByIDRequest{
+				Domain: "",
+			},
+			mockFn:      func() {},
+			expectError: true,
+		},
+		"cannot get domain ID": {
+			request: validInput,
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return("", errors.New("error getting domain ID"))
+			},
+			expectError: true,
+		},
+		"empty domain ID": {
+			request: &types.RespondActivityTaskCanceledByIDRequest{
+				Domain: s.testDomain,
+			},
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return("", nil)
+			},
+			expectError: true,
+		},
+		"empty workflow ID": {
+			request: &types.RespondActivityTaskCanceledByIDRequest{
+				Domain:     s.testDomain,
+				WorkflowID: "",
+			},
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
 			},
 			expectError: true,
 		},
@@ -2637,7 +3082,7 @@ func (s *workflowHandlerSuite) TestRespondDecisionTaskCompleted() {
 	}
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.EnableClientVersionCheck = dc.GetBoolPropertyFn(true)
-	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil, activityencryption.NewNopEncryptor())
 	wh.tokenSerializer = s.mockTokenSerializer
 
 	testInput := map[string]struct {
@@ -2780,7 +3225,7 @@ func (s *workflowHandlerSuite) TestRespondDecisionTaskFailed() {
 	}
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.EnableClientVersionCheck = dc.GetBoolPropertyFn(true)
-	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil, activityencryption.NewNopEncryptor())
 	wh.tokenSerializer = s.mockTokenSerializer
 
 	testInput := map[string]struct {
@@ -2891,7 +3336,7 @@ func (s *workflowHandlerSuite) TestRespondDecisionTaskFailed() {
 func (s *workflowHandlerSuite) TestRespondQueryTaskCompleted() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.EnableClientVersionCheck = dc.GetBoolPropertyFn(true)
-	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil, activityencryption.NewNopEncryptor())
 	wh.tokenSerializer = s.mockTokenSerializer
 
 	validInput := &types.RespondQueryTaskCompletedRequest{
@@ -3003,7 +3448,7 @@ func (s *workflowHandlerSuite) TestRespondQueryTaskCompleted() {
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Remaining() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.EnableClientVersionCheck = dc.GetBoolPropertyFn(true)
-	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil, activityencryption.NewNopEncryptor())
 	wh.tokenSerializer = s.mockTokenSerializer
 
 	validRequest := &types.StartWorkflowExecutionRequest{
@@ -3082,7 +3527,7 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Remaining() {
 func (s *workflowHandlerSuite) TestSignalWorkflowExecution() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.EnableClientVersionCheck = dc.GetBoolPropertyFn(true)
-	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil, activityencryption.NewNopEncryptor())
 	wh.tokenSerializer = s.mockTokenSerializer
 
 	validRequest := &types.SignalWorkflowExecutionRequest{
@@ -3501,7 +3946,7 @@ func TestStartWorkflowExecutionAsync(t *testing.T) {
 				false,
 				"hostname",
 			)
-			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil)
+			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil, activityencryption.NewNopEncryptor())
 			wh.producerManager = mockProducerManager
 
 			tc.setupMocks(mockProducerManager)
@@ -3621,7 +4066,7 @@ func TestSignalWithStartWorkflowExecutionAsync(t *testing.T) {
 				false,
 				"hostname",
 			)
-			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil)
+			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil, activityencryption.NewNopEncryptor())
 			wh.producerManager = mockProducerManager
 
 			tc.setupMocks(mockProducerManager)
@@ -3733,7 +4178,7 @@ func TestRequestCancelWorkflowExecution(t *testing.T) {
 				false,
 				"hostname",
 			)
-			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil)
+			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil, activityencryption.NewNopEncryptor())
 			wh.shuttingDown = tc.shuttingDown
 
 			tc.setupMocks(mockVersionChecker, mockResource)
@@ -3750,171 +4195,67 @@ func TestRequestCancelWorkflowExecution(t *testing.T) {
 	}
 }
 
-func TestQueryWorkflow(t *testing.T) {
+func TestSignalActivity(t *testing.T) {
+	validRequest := &types.SignalActivityRequest{
+		Domain: "test-domain",
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "test-workflow-id",
+			RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		},
+		ActivityID: "test-activity-id",
+		SignalName: "test-signal",
+		Input:      []byte("test-input"),
+	}
 	testCases := []struct {
-		name           string
-		setupMocks     func(*client.MockVersionChecker, *resource.Test)
-		queryRequest   *types.QueryWorkflowRequest
-		inMemoryClient dc.Client
-		isShuttingDown int32
-		err            error
+		name          string
+		setupMocks    func(mockResource *resource.Test)
+		signalRequest *types.SignalActivityRequest
+		err           error
 	}{
 		{
 			name: "Success case",
-			setupMocks: func(mockVersionChecker *client.MockVersionChecker, resourceMock *resource.Test) {
-				resourceMock.DomainCache.EXPECT().GetDomainID(gomock.Any()).Return("test-domain-id", nil).Times(1)
-				resourceMock.HistoryClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(
-					&types.HistoryQueryWorkflowResponse{
-						Response: &types.QueryWorkflowResponse{
-							QueryResult: []byte("test-result"),
-						},
-					}, nil).Times(1)
-			},
-			inMemoryClient: dc.NewInMemoryClient(),
-			queryRequest: &types.QueryWorkflowRequest{
-				Domain: "test-domain",
-				Execution: &types.WorkflowExecution{
-					WorkflowID: "test-workflow-id",
-					RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
-				},
-				Query: &types.WorkflowQuery{
-					QueryType: "test-query-type",
-				},
-			},
-		},
-		{
-			name:           "Error case - is shutting down",
-			setupMocks:     func(_ *client.MockVersionChecker, _ *resource.Test) {},
-			isShuttingDown: 1,
-			err:            validate.ErrShuttingDown,
-		},
-		{
-			name: "Error case - query request not set",
-			setupMocks: func(mockVersionChecker *client.MockVersionChecker, _ *resource.Test) {
-			},
-			inMemoryClient: dc.NewInMemoryClient(),
-			err:            validate.ErrRequestNotSet,
-		},
-		{
-			name: "Error case - domain not set",
-			setupMocks: func(mockVersionChecker *client.MockVersionChecker, _ *resource.Test) {
-			},
-			inMemoryClient: dc.NewInMemoryClient(),
-			queryRequest:   &types.QueryWorkflowRequest{},
-			err:            validate.ErrDomainNotSet,
-		},
-		{
-			name: "Error case - check execution error",
-			setupMocks: func(mockVersionChecker *client.MockVersionChecker, _ *resource.Test) {
-			},
-			inMemoryClient: dc.NewInMemoryClient(),
-			queryRequest: &types.QueryWorkflowRequest{
-				Domain: "test-domain",
-			},
-			err: validate.ErrExecutionNotSet,
-		},
-		{
-			name: "Error case - query disallowed for domain",
-			setupMocks: func(mockVersionChecker *client.MockVersionChecker, resourceMock *resource.Test) {
-			},
-			inMemoryClient: func() dc.Client {
-				inMemoryClient := dc.NewInMemoryClient()
-				inMemoryClient.UpdateValue(dc.DisallowQuery, true)
-				return inMemoryClient
-			}(),
-			queryRequest: &types.QueryWorkflowRequest{
-				Domain: "test-domain",
-				Execution: &types.WorkflowExecution{
-					WorkflowID: "test-workflow-id",
-					RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
-				},
-			},
-			err: validate.ErrQueryDisallowedForDomain,
-		},
-		{
-			name: "Error case - query not set",
-			setupMocks: func(mockVersionChecker *client.MockVersionChecker, resourceMock *resource.Test) {
-			},
-			inMemoryClient: dc.NewInMemoryClient(),
-			queryRequest: &types.QueryWorkflowRequest{
-				Domain: "test-domain",
-				Execution: &types.WorkflowExecution{
-					WorkflowID: "test-workflow-id",
-					RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
-				},
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().SignalActivity(gomock.Any(), &types.HistorySignalActivityRequest{
+					DomainUUID:    "test-domain-id",
+					SignalRequest: validRequest,
+				}).Return(nil).Times(1)
 			},
-			err: validate.ErrQueryNotSet,
+			signalRequest: validRequest,
 		},
 		{
-			name: "Error case - query type not set",
-			setupMocks: func(mockVersionChecker *client.MockVersionChecker, resourceMock *resource.Test) {
-			},
-			inMemoryClient: dc.NewInMemoryClient(),
-			queryRequest: &types.QueryWorkflowRequest{
-				Domain: "test-domain",
-				Execution: &types.WorkflowExecution{
-					WorkflowID: "test-workflow-id",
-					RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
-				},
-				Query: &types.WorkflowQuery{},
-			},
-			err: validate.ErrQueryTypeNotSet,
+			name:       "Error case - request not set",
+			setupMocks: func(_ *resource.Test) {},
+			err:        validate.ErrRequestNotSet,
 		},
 		{
-			name: "Error case - get domain ID error",
-			setupMocks: func(mockVersionChecker *client.MockVersionChecker, resourceMock *resource.Test) {
-				resourceMock.DomainCache.EXPECT().GetDomainID(gomock.Any()).Return("", errors.New("get-domain-id-error")).Times(1)
-			},
-			inMemoryClient: dc.NewInMemoryClient(),
-			queryRequest: &types.QueryWorkflowRequest{
-				Domain: "test-domain",
-				Execution: &types.WorkflowExecution{
-					WorkflowID: "test-workflow-id",
-					RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
-				},
-				Query: &types.WorkflowQuery{
-					QueryType: "test-query-type",
-				},
+			name:       "Error case - activity ID not set",
+			setupMocks: func(_ *resource.Test) {},
+			signalRequest: &types.SignalActivityRequest{
+				Domain:            "test-domain",
+				WorkflowExecution: validRequest.WorkflowExecution,
+				SignalName:        "test-signal",
 			},
-			err: errors.New("get-domain-id-error"),
+			err: validate.ErrActivityIDNotSet,
 		},
 		{
-			name: "Error case - CheckEventBlobSizeLimit error",
-			setupMocks: func(mockVersionChecker *client.MockVersionChecker, resourceMock *resource.Test) {
-				resourceMock.DomainCache.EXPECT().GetDomainID(gomock.Any()).Return("test-domain-id", nil).Times(1)
-			},
-			inMemoryClient: dc.NewInMemoryClient(),
-			queryRequest: &types.QueryWorkflowRequest{
-				Domain: "test-domain",
-				Execution: &types.WorkflowExecution{
-					WorkflowID: "test-workflow-id",
-					RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
-				},
-				Query: &types.WorkflowQuery{
-					QueryType: "test-query-type",
-					QueryArgs: []byte("test-query-args"),
-				},
+			name:       "Error case - signal name not set",
+			setupMocks: func(_ *resource.Test) {},
+			signalRequest: &types.SignalActivityRequest{
+				Domain:            "test-domain",
+				WorkflowExecution: validRequest.WorkflowExecution,
+				ActivityID:        "test-activity-id",
 			},
-			err: common.ErrBlobSizeExceedsLimit,
+			err: validate.ErrSignalNameNotSet,
 		},
 		{
-			name: "Error case - QueryWorkflow error",
-			setupMocks: func(mockVersionChecker *client.MockVersionChecker, resourceMock *resource.Test) {
-				resourceMock.DomainCache.EXPECT().GetDomainID(gomock.Any()).Return("test-domain-id", nil).Times(1)
-				resourceMock.HistoryClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(nil, errors.New("query-workflow-error")).Times(1)
-			},
-			inMemoryClient: dc.NewInMemoryClient(),
-			queryRequest: &types.QueryWorkflowRequest{
-				Domain: "test-domain",
-				Execution: &types.WorkflowExecution{
-					WorkflowID: "test-workflow-id",
-					RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
-				},
-				Query: &types.WorkflowQuery{
-					QueryType: "test-query-type",
-				},
+			name: "Error case - SignalActivity error",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().SignalActivity(gomock.Any(), gomock.Any()).Return(&types.EntityNotExistsError{}).Times(1)
 			},
-			err: errors.New("query-workflow-error"),
+			signalRequest: validRequest,
+			err:           &types.EntityNotExistsError{},
 		},
 	}
 
@@ -3926,49 +4267,991 @@ func TestQueryWorkflow(t *testing.T) {
 
 			cfg := frontendcfg.NewConfig(
 				dc.NewCollection(
-					tc.inMemoryClient,
+					dc.NewInMemoryClient(),
 					mockResource.GetLogger(),
 				),
 				numHistoryShards,
 				false,
 				"hostname",
 			)
-			cfg.BlobSizeLimitError = func(domain string) int { return 10 }
-			cfg.BlobSizeLimitWarn = func(domain string) int { return 9 }
+			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil, activityencryption.NewNopEncryptor())
 
-			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil)
-			wh.shuttingDown = tc.isShuttingDown
-
-			tc.setupMocks(mockVersionChecker, mockResource)
+			tc.setupMocks(mockResource)
 
-			queryResult, err := wh.QueryWorkflow(context.Background(), tc.queryRequest)
+			err := wh.SignalActivity(context.Background(), tc.signalRequest)
 
 			if tc.err != nil {
-				assert.Error(t, err)
 				assert.Equal(t, tc.err, err)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, []byte("test-result"), queryResult.QueryResult)
 			}
 		})
 	}
 }
 
-func TestDescribeWorkflowExecution(t *testing.T) {
-	resp := &types.DescribeWorkflowExecutionResponse{
-		ExecutionConfiguration: &types.WorkflowExecutionConfiguration{
-			TaskList: &types.TaskList{
-				Name: "test-task-list",
-			},
+func TestGetActivityEffectiveConfig(t *testing.T) {
+	validRequest := &types.GetActivityEffectiveConfigRequest{
+		Domain: "test-domain",
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "test-workflow-id",
+			RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
 		},
+		ActivityID: "test-activity-id",
 	}
-
 	testCases := []struct {
-		name            string
-		setupMocks      func(mockVersionChecker *client.MockVersionChecker, mockResource *resource.Test)
-		describeRequest *types.DescribeWorkflowExecutionRequest
-		isShuttingDown  int32
-		err             error
+		name       string
+		setupMocks func(mockResource *resource.Test)
+		request    *types.GetActivityEffectiveConfigRequest
+		response   *types.GetActivityEffectiveConfigResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().GetActivityEffectiveConfig(gomock.Any(), &types.HistoryGetActivityEffectiveConfigRequest{
+					DomainUUID: "test-domain-id",
+					Request:    validRequest,
+				}).Return(&types.GetActivityEffectiveConfigResponse{ActivityID: "test-activity-id"}, nil).Times(1)
+			},
+			request:  validRequest,
+			response: &types.GetActivityEffectiveConfigResponse{ActivityID: "test-activity-id"},
+		},
+		{
+			name:       "Error case - request not set",
+			setupMocks: func(_ *resource.Test) {},
+			err:        validate.ErrRequestNotSet,
+		},
+		{
+			name:       "Error case - activity ID not set",
+			setupMocks: func(_ *resource.Test) {},
+			request: &types.GetActivityEffectiveConfigRequest{
+				Domain:            "test-domain",
+				WorkflowExecution: validRequest.WorkflowExecution,
+			},
+			err: validate.ErrActivityIDNotSet,
+		},
+		{
+			name: "Error case - GetActivityEffectiveConfig error",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().GetActivityEffectiveConfig(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{}).Times(1)
+			},
+			request: validRequest,
+			err:     &types.EntityNotExistsError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockResource := resource.NewTest(t, mockCtrl, metrics.Frontend)
+			mockVersionChecker := client.NewMockVersionChecker(mockCtrl)
+
+			cfg := frontendcfg.NewConfig(
+				dc.NewCollection(
+					dc.NewInMemoryClient(),
+					mockResource.GetLogger(),
+				),
+				numHistoryShards,
+				false,
+				"hostname",
+			)
+			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil, activityencryption.NewNopEncryptor())
+
+			tc.setupMocks(mockResource)
+
+			resp, err := wh.GetActivityEffectiveConfig(context.Background(), tc.request)
+
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.response, resp)
+			}
+		})
+	}
+}
+
+func TestGetActivityNextRetryTime(t *testing.T) {
+	validRequest := &types.GetActivityNextRetryTimeRequest{
+		Domain: "test-domain",
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "test-workflow-id",
+			RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		},
+		ActivityID: "test-activity-id",
+	}
+	testCases := []struct {
+		name       string
+		setupMocks func(mockResource *resource.Test)
+		request    *types.GetActivityNextRetryTimeRequest
+		response   *types.GetActivityNextRetryTimeResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().GetActivityNextRetryTime(gomock.Any(), &types.HistoryGetActivityNextRetryTimeRequest{
+					DomainUUID: "test-domain-id",
+					Request:    validRequest,
+				}).Return(&types.GetActivityNextRetryTimeResponse{ActivityID: "test-activity-id"}, nil).Times(1)
+			},
+			request:  validRequest,
+			response: &types.GetActivityNextRetryTimeResponse{ActivityID: "test-activity-id"},
+		},
+		{
+			name:       "Error case - request not set",
+			setupMocks: func(_ *resource.Test) {},
+			err:        validate.ErrRequestNotSet,
+		},
+		{
+			name:       "Error case - activity ID not set",
+			setupMocks: func(_ *resource.Test) {},
+			request: &types.GetActivityNextRetryTimeRequest{
+				Domain:            "test-domain",
+				WorkflowExecution: validRequest.WorkflowExecution,
+			},
+			err: validate.ErrActivityIDNotSet,
+		},
+		{
+			name: "Error case - GetActivityNextRetryTime error",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().GetActivityNextRetryTime(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{}).Times(1)
+			},
+			request: validRequest,
+			err:     &types.EntityNotExistsError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockResource := resource.NewTest(t, mockCtrl, metrics.Frontend)
+			mockVersionChecker := client.NewMockVersionChecker(mockCtrl)
+
+			cfg := frontendcfg.NewConfig(
+				dc.NewCollection(
+					dc.NewInMemoryClient(),
+					mockResource.GetLogger(),
+				),
+				numHistoryShards,
+				false,
+				"hostname",
+			)
+			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil, activityencryption.NewNopEncryptor())
+
+			tc.setupMocks(mockResource)
+
+			resp, err := wh.GetActivityNextRetryTime(context.Background(), tc.request)
+
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.response, resp)
+			}
+		})
+	}
+}
+
+func TestGetActivityRescheduleReasons(t *testing.T) {
+	validRequest := &types.GetActivityRescheduleReasonsRequest{
+		Domain: "test-domain",
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "test-workflow-id",
+			RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		},
+		ActivityID: "test-activity-id",
+	}
+	testCases := []struct {
+		name       string
+		setupMocks func(mockResource *resource.Test)
+		request    *types.GetActivityRescheduleReasonsRequest
+		response   *types.GetActivityRescheduleReasonsResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().GetActivityRescheduleReasons(gomock.Any(), &types.HistoryGetActivityRescheduleReasonsRequest{
+					DomainUUID: "test-domain-id",
+					Request:    validRequest,
+				}).Return(&types.GetActivityRescheduleReasonsResponse{ActivityID: "test-activity-id"}, nil).Times(1)
+			},
+			request:  validRequest,
+			response: &types.GetActivityRescheduleReasonsResponse{ActivityID: "test-activity-id"},
+		},
+		{
+			name:       "Error case - request not set",
+			setupMocks: func(_ *resource.Test) {},
+			err:        validate.ErrRequestNotSet,
+		},
+		{
+			name:       "Error case - activity ID not set",
+			setupMocks: func(_ *resource.Test) {},
+			request: &types.GetActivityRescheduleReasonsRequest{
+				Domain:            "test-domain",
+				WorkflowExecution: validRequest.WorkflowExecution,
+			},
+			err: validate.ErrActivityIDNotSet,
+		},
+		{
+			name: "Error case - GetActivityRescheduleReasons error",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().GetActivityRescheduleReasons(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{}).Times(1)
+			},
+			request: validRequest,
+			err:     &types.EntityNotExistsError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockResource := resource.NewTest(t, mockCtrl, metrics.Frontend)
+			mockVersionChecker := client.NewMockVersionChecker(mockCtrl)
+
+			cfg := frontendcfg.NewConfig(
+				dc.NewCollection(
+					dc.NewInMemoryClient(),
+					mockResource.GetLogger(),
+				),
+				numHistoryShards,
+				false,
+				"hostname",
+			)
+			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil, activityencryption.NewNopEncryptor())
+
+			tc.setupMocks(mockResource)
+
+			resp, err := wh.GetActivityRescheduleReasons(context.Background(), tc.request)
+
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.response, resp)
+			}
+		})
+	}
+}
+
+func TestGetActivityRetryStats(t *testing.T) {
+	validRequest := &types.GetActivityRetryStatsRequest{
+		Domain: "test-domain",
+	}
+	testCases := []struct {
+		name       string
+		setupMocks func(mockResource *resource.Test)
+		request    *types.GetActivityRetryStatsRequest
+		response   *types.GetActivityRetryStatsResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().GetActivityRetryStats(gomock.Any(), &types.HistoryGetActivityRetryStatsRequest{
+					DomainUUID: "test-domain-id",
+					Request:    validRequest,
+				}).Return(&types.GetActivityRetryStatsResponse{TotalAttempts: 3, Successes: 2}, nil).Times(1)
+			},
+			request:  validRequest,
+			response: &types.GetActivityRetryStatsResponse{TotalAttempts: 3, Successes: 2},
+		},
+		{
+			name:       "Error case - request not set",
+			setupMocks: func(_ *resource.Test) {},
+			err:        validate.ErrRequestNotSet,
+		},
+		{
+			name:       "Error case - domain not set",
+			setupMocks: func(_ *resource.Test) {},
+			request:    &types.GetActivityRetryStatsRequest{},
+			err:        validate.ErrDomainNotSet,
+		},
+		{
+			name: "Error case - GetActivityRetryStats error",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().GetActivityRetryStats(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{}).Times(1)
+			},
+			request: validRequest,
+			err:     &types.BadRequestError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockResource := resource.NewTest(t, mockCtrl, metrics.Frontend)
+			mockVersionChecker := client.NewMockVersionChecker(mockCtrl)
+
+			cfg := frontendcfg.NewConfig(
+				dc.NewCollection(
+					dc.NewInMemoryClient(),
+					mockResource.GetLogger(),
+				),
+				numHistoryShards,
+				false,
+				"hostname",
+			)
+			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil, activityencryption.NewNopEncryptor())
+
+			tc.setupMocks(mockResource)
+
+			resp, err := wh.GetActivityRetryStats(context.Background(), tc.request)
+
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.response, resp)
+			}
+		})
+	}
+}
+
+func TestGetActivityTokenValidationFailures(t *testing.T) {
+	validRequest := &types.GetActivityTokenValidationFailuresRequest{
+		Domain: "test-domain",
+	}
+	testCases := []struct {
+		name       string
+		setupMocks func(mockResource *resource.Test)
+		request    *types.GetActivityTokenValidationFailuresRequest
+		response   *types.GetActivityTokenValidationFailuresResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().GetActivityTokenValidationFailures(gomock.Any(), &types.HistoryGetActivityTokenValidationFailuresRequest{
+					DomainUUID: "test-domain-id",
+					Request:    validRequest,
+				}).Return(&types.GetActivityTokenValidationFailuresResponse{Failures: []*types.ActivityTokenValidationFailure{{ActivityID: "test-activity-id"}}}, nil).Times(1)
+			},
+			request:  validRequest,
+			response: &types.GetActivityTokenValidationFailuresResponse{Failures: []*types.ActivityTokenValidationFailure{{ActivityID: "test-activity-id"}}},
+		},
+		{
+			name:       "Error case - request not set",
+			setupMocks: func(_ *resource.Test) {},
+			err:        validate.ErrRequestNotSet,
+		},
+		{
+			name:       "Error case - domain not set",
+			setupMocks: func(_ *resource.Test) {},
+			request:    &types.GetActivityTokenValidationFailuresRequest{},
+			err:        validate.ErrDomainNotSet,
+		},
+		{
+			name: "Error case - GetActivityTokenValidationFailures error",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().GetActivityTokenValidationFailures(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{}).Times(1)
+			},
+			request: validRequest,
+			err:     &types.BadRequestError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockResource := resource.NewTest(t, mockCtrl, metrics.Frontend)
+			mockVersionChecker := client.NewMockVersionChecker(mockCtrl)
+
+			cfg := frontendcfg.NewConfig(
+				dc.NewCollection(
+					dc.NewInMemoryClient(),
+					mockResource.GetLogger(),
+				),
+				numHistoryShards,
+				false,
+				"hostname",
+			)
+			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil, activityencryption.NewNopEncryptor())
+
+			tc.setupMocks(mockResource)
+
+			resp, err := wh.GetActivityTokenValidationFailures(context.Background(), tc.request)
+
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.response, resp)
+			}
+		})
+	}
+}
+
+func TestGetActivityWorkerHistory(t *testing.T) {
+	validRequest := &types.GetActivityWorkerHistoryRequest{
+		Domain: "test-domain",
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "test-workflow-id",
+			RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		},
+		ActivityID: "test-activity-id",
+	}
+	testCases := []struct {
+		name       string
+		setupMocks func(mockResource *resource.Test)
+		request    *types.GetActivityWorkerHistoryRequest
+		response   *types.GetActivityWorkerHistoryResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().GetActivityWorkerHistory(gomock.Any(), &types.HistoryGetActivityWorkerHistoryRequest{
+					DomainUUID: "test-domain-id",
+					Request:    validRequest,
+				}).Return(&types.GetActivityWorkerHistoryResponse{ActivityID: "test-activity-id"}, nil).Times(1)
+			},
+			request:  validRequest,
+			response: &types.GetActivityWorkerHistoryResponse{ActivityID: "test-activity-id"},
+		},
+		{
+			name:       "Error case - request not set",
+			setupMocks: func(_ *resource.Test) {},
+			err:        validate.ErrRequestNotSet,
+		},
+		{
+			name:       "Error case - activity ID not set",
+			setupMocks: func(_ *resource.Test) {},
+			request: &types.GetActivityWorkerHistoryRequest{
+				Domain:            "test-domain",
+				WorkflowExecution: validRequest.WorkflowExecution,
+			},
+			err: validate.ErrActivityIDNotSet,
+		},
+		{
+			name: "Error case - GetActivityWorkerHistory error",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().GetActivityWorkerHistory(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{}).Times(1)
+			},
+			request: validRequest,
+			err:     &types.EntityNotExistsError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockResource := resource.NewTest(t, mockCtrl, metrics.Frontend)
+			mockVersionChecker := client.NewMockVersionChecker(mockCtrl)
+
+			cfg := frontendcfg.NewConfig(
+				dc.NewCollection(
+					dc.NewInMemoryClient(),
+					mockResource.GetLogger(),
+				),
+				numHistoryShards,
+				false,
+				"hostname",
+			)
+			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil, activityencryption.NewNopEncryptor())
+
+			tc.setupMocks(mockResource)
+
+			resp, err := wh.GetActivityWorkerHistory(context.Background(), tc.request)
+
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.response, resp)
+			}
+		})
+	}
+}
+
+func TestGetActivityAttemptTimings(t *testing.T) {
+	validRequest := &types.GetActivityAttemptTimingsRequest{
+		Domain: "test-domain",
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "test-workflow-id",
+			RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		},
+		ActivityID: "test-activity-id",
+	}
+	testCases := []struct {
+		name       string
+		setupMocks func(mockResource *resource.Test)
+		request    *types.GetActivityAttemptTimingsRequest
+		response   *types.GetActivityAttemptTimingsResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().GetActivityAttemptTimings(gomock.Any(), &types.HistoryGetActivityAttemptTimingsRequest{
+					DomainUUID: "test-domain-id",
+					Request:    validRequest,
+				}).Return(&types.GetActivityAttemptTimingsResponse{ActivityID: "test-activity-id"}, nil).Times(1)
+			},
+			request:  validRequest,
+			response: &types.GetActivityAttemptTimingsResponse{ActivityID: "test-activity-id"},
+		},
+		{
+			name:       "Error case - request not set",
+			setupMocks: func(_ *resource.Test) {},
+			err:        validate.ErrRequestNotSet,
+		},
+		{
+			name:       "Error case - activity ID not set",
+			setupMocks: func(_ *resource.Test) {},
+			request: &types.GetActivityAttemptTimingsRequest{
+				Domain:            "test-domain",
+				WorkflowExecution: validRequest.WorkflowExecution,
+			},
+			err: validate.ErrActivityIDNotSet,
+		},
+		{
+			name: "Error case - GetActivityAttemptTimings error",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().GetActivityAttemptTimings(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{}).Times(1)
+			},
+			request: validRequest,
+			err:     &types.EntityNotExistsError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockResource := resource.NewTest(t, mockCtrl, metrics.Frontend)
+			mockVersionChecker := client.NewMockVersionChecker(mockCtrl)
+
+			cfg := frontendcfg.NewConfig(
+				dc.NewCollection(
+					dc.NewInMemoryClient(),
+					mockResource.GetLogger(),
+				),
+				numHistoryShards,
+				false,
+				"hostname",
+			)
+			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil, activityencryption.NewNopEncryptor())
+
+			tc.setupMocks(mockResource)
+
+			resp, err := wh.GetActivityAttemptTimings(context.Background(), tc.request)
+
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.response, resp)
+			}
+		})
+	}
+}
+
+func TestResetActivityAttempts(t *testing.T) {
+	validRequest := &types.ResetActivityAttemptsRequest{
+		Domain: "test-domain",
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "test-workflow-id",
+			RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		},
+		ActivityID: "test-activity-id",
+		Identity:   "operator",
+	}
+	testCases := []struct {
+		name       string
+		setupMocks func(mockResource *resource.Test)
+		request    *types.ResetActivityAttemptsRequest
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().ResetActivityAttempts(gomock.Any(), &types.HistoryResetActivityAttemptsRequest{
+					DomainUUID: "test-domain-id",
+					Request:    validRequest,
+				}).Return(nil).Times(1)
+			},
+			request: validRequest,
+		},
+		{
+			name:       "Error case - request not set",
+			setupMocks: func(_ *resource.Test) {},
+			err:        validate.ErrRequestNotSet,
+		},
+		{
+			name:       "Error case - activity ID not set",
+			setupMocks: func(_ *resource.Test) {},
+			request: &types.ResetActivityAttemptsRequest{
+				Domain:            "test-domain",
+				WorkflowExecution: validRequest.WorkflowExecution,
+			},
+			err: validate.ErrActivityIDNotSet,
+		},
+		{
+			name: "Error case - ResetActivityAttempts error",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().ResetActivityAttempts(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "Activity has already completed."}).Times(1)
+			},
+			request: validRequest,
+			err:     &types.BadRequestError{Message: "Activity has already completed."},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockResource := resource.NewTest(t, mockCtrl, metrics.Frontend)
+			mockVersionChecker := client.NewMockVersionChecker(mockCtrl)
+
+			cfg := frontendcfg.NewConfig(
+				dc.NewCollection(
+					dc.NewInMemoryClient(),
+					mockResource.GetLogger(),
+				),
+				numHistoryShards,
+				false,
+				"hostname",
+			)
+			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil, activityencryption.NewNopEncryptor())
+
+			tc.setupMocks(mockResource)
+
+			err := wh.ResetActivityAttempts(context.Background(), tc.request)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestBatchDescribeWorkflowExecutions(t *testing.T) {
+	execution := &types.WorkflowExecution{
+		WorkflowID: "test-workflow-id",
+		RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+	}
+	validRequest := &types.BatchDescribeWorkflowExecutionsRequest{
+		Domain:     "test-domain",
+		Executions: []*types.WorkflowExecution{execution},
+	}
+	response := &types.BatchDescribeWorkflowExecutionsResponse{
+		Results: []*types.DescribeWorkflowExecutionResult{
+			{Execution: execution, Response: &types.DescribeWorkflowExecutionResponse{}},
+		},
+	}
+	testCases := []struct {
+		name       string
+		setupMocks func(mockResource *resource.Test)
+		request    *types.BatchDescribeWorkflowExecutionsRequest
+		response   *types.BatchDescribeWorkflowExecutionsResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().BatchDescribeWorkflowExecutions(gomock.Any(), &types.HistoryBatchDescribeWorkflowExecutionsRequest{
+					DomainUUID: "test-domain-id",
+					Request:    validRequest,
+				}).Return(response, nil).Times(1)
+			},
+			request:  validRequest,
+			response: response,
+		},
+		{
+			name:       "Error case - request not set",
+			setupMocks: func(_ *resource.Test) {},
+			err:        validate.ErrRequestNotSet,
+		},
+		{
+			name:       "Error case - executions not set",
+			setupMocks: func(_ *resource.Test) {},
+			request:    &types.BatchDescribeWorkflowExecutionsRequest{Domain: "test-domain"},
+			err:        validate.ErrExecutionNotSet,
+		},
+		{
+			name:       "Error case - invalid execution",
+			setupMocks: func(_ *resource.Test) {},
+			request: &types.BatchDescribeWorkflowExecutionsRequest{
+				Domain:     "test-domain",
+				Executions: []*types.WorkflowExecution{execution, {}},
+			},
+			err: validate.ErrWorkflowIDNotSet,
+		},
+		{
+			name: "Error case - BatchDescribeWorkflowExecutions error",
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				mockResource.HistoryClient.EXPECT().BatchDescribeWorkflowExecutions(gomock.Any(), gomock.Any()).Return(nil, &types.InternalServiceError{}).Times(1)
+			},
+			request: validRequest,
+			err:     &types.InternalServiceError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockResource := resource.NewTest(t, mockCtrl, metrics.Frontend)
+			mockVersionChecker := client.NewMockVersionChecker(mockCtrl)
+
+			cfg := frontendcfg.NewConfig(
+				dc.NewCollection(
+					dc.NewInMemoryClient(),
+					mockResource.GetLogger(),
+				),
+				numHistoryShards,
+				false,
+				"hostname",
+			)
+			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil, activityencryption.NewNopEncryptor())
+
+			tc.setupMocks(mockResource)
+
+			resp, err := wh.BatchDescribeWorkflowExecutions(context.Background(), tc.request)
+
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.response, resp)
+			}
+		})
+	}
+}
+
+func TestQueryWorkflow(t *testing.T) {
+	testCases := []struct {
+		name           string
+		setupMocks     func(*client.MockVersionChecker, *resource.Test)
+		queryRequest   *types.QueryWorkflowRequest
+		inMemoryClient dc.Client
+		isShuttingDown int32
+		err            error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func(mockVersionChecker *client.MockVersionChecker, resourceMock *resource.Test) {
+				resourceMock.DomainCache.EXPECT().GetDomainID(gomock.Any()).Return("test-domain-id", nil).Times(1)
+				resourceMock.HistoryClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(
+					&types.HistoryQueryWorkflowResponse{
+						Response: &types.QueryWorkflowResponse{
+							QueryResult: []byte("test-result"),
+						},
+					}, nil).Times(1)
+			},
+			inMemoryClient: dc.NewInMemoryClient(),
+			queryRequest: &types.QueryWorkflowRequest{
+				Domain: "test-domain",
+				Execution: &types.WorkflowExecution{
+					WorkflowID: "test-workflow-id",
+					RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+				},
+				Query: &types.WorkflowQuery{
+					QueryType: "test-query-type",
+				},
+			},
+		},
+		{
+			name:           "Error case - is shutting down",
+			setupMocks:     func(_ *client.MockVersionChecker, _ *resource.Test) {},
+			isShuttingDown: 1,
+			err:            validate.ErrShuttingDown,
+		},
+		{
+			name: "Error case - query request not set",
+			setupMocks: func(mockVersionChecker *client.MockVersionChecker, _ *resource.Test) {
+			},
+			inMemoryClient: dc.NewInMemoryClient(),
+			err:            validate.ErrRequestNotSet,
+		},
+		{
+			name: "Error case - domain not set",
+			setupMocks: func(mockVersionChecker *client.MockVersionChecker, _ *resource.Test) {
+			},
+			inMemoryClient: dc.NewInMemoryClient(),
+			queryRequest:   &types.QueryWorkflowRequest{},
+			err:            validate.ErrDomainNotSet,
+		},
+		{
+			name: "Error case - check execution error",
+			setupMocks: func(mockVersionChecker *client.MockVersionChecker, _ *resource.Test) {
+			},
+			inMemoryClient: dc.NewInMemoryClient(),
+			queryRequest: &types.QueryWorkflowRequest{
+				Domain: "test-domain",
+			},
+			err: validate.ErrExecutionNotSet,
+		},
+		{
+			name: "Error case - query disallowed for domain",
+			setupMocks: func(mockVersionChecker *client.MockVersionChecker, resourceMock *resource.Test) {
+			},
+			inMemoryClient: func() dc.Client {
+				inMemoryClient := dc.NewInMemoryClient()
+				inMemoryClient.UpdateValue(dc.DisallowQuery, true)
+				return inMemoryClient
+			}(),
+			queryRequest: &types.QueryWorkflowRequest{
+				Domain: "test-domain",
+				Execution: &types.WorkflowExecution{
+					WorkflowID: "test-workflow-id",
+					RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+				},
+			},
+			err: validate.ErrQueryDisallowedForDomain,
+		},
+		{
+			name: "Error case - query not set",
+			setupMocks: func(mockVersionChecker *client.MockVersionChecker, resourceMock *resource.Test) {
+			},
+			inMemoryClient: dc.NewInMemoryClient(),
+			queryRequest: &types.QueryWorkflowRequest{
+				Domain: "test-domain",
+				Execution: &types.WorkflowExecution{
+					WorkflowID: "test-workflow-id",
+					RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+				},
+			},
+			err: validate.ErrQueryNotSet,
+		},
+		{
+			name: "Error case - query type not set",
+			setupMocks: func(mockVersionChecker *client.MockVersionChecker, resourceMock *resource.Test) {
+			},
+			inMemoryClient: dc.NewInMemoryClient(),
+			queryRequest: &types.QueryWorkflowRequest{
+				Domain: "test-domain",
+				Execution: &types.WorkflowExecution{
+					WorkflowID: "test-workflow-id",
+					RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+				},
+				Query: &types.WorkflowQuery{},
+			},
+			err: validate.ErrQueryTypeNotSet,
+		},
+		{
+			name: "Error case - get domain ID error",
+			setupMocks: func(mockVersionChecker *client.MockVersionChecker, resourceMock *resource.Test) {
+				resourceMock.DomainCache.EXPECT().GetDomainID(gomock.Any()).Return("", errors.New("get-domain-id-error")).Times(1)
+			},
+			inMemoryClient: dc.NewInMemoryClient(),
+			queryRequest: &types.QueryWorkflowRequest{
+				Domain: "test-domain",
+				Execution: &types.WorkflowExecution{
+					WorkflowID: "test-workflow-id",
+					RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+				},
+				Query: &types.WorkflowQuery{
+					QueryType: "test-query-type",
+				},
+			},
+			err: errors.New("get-domain-id-error"),
+		},
+		{
+			name: "Error case - CheckEventBlobSizeLimit error",
+			setupMocks: func(mockVersionChecker *client.MockVersionChecker, resourceMock *resource.Test) {
+				resourceMock.DomainCache.EXPECT().GetDomainID(gomock.Any()).Return("test-domain-id", nil).Times(1)
+			},
+			inMemoryClient: dc.NewInMemoryClient(),
+			queryRequest: &types.QueryWorkflowRequest{
+				Domain: "test-domain",
+				Execution: &types.WorkflowExecution{
+					WorkflowID: "test-workflow-id",
+					RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+				},
+				Query: &types.WorkflowQuery{
+					QueryType: "test-query-type",
+					QueryArgs: []byte("test-query-args"),
+				},
+			},
+			err: common.ErrBlobSizeExceedsLimit,
+		},
+		{
+			name: "Error case - QueryWorkflow error",
+			setupMocks: func(mockVersionChecker *client.MockVersionChecker, resourceMock *resource.Test) {
+				resourceMock.DomainCache.EXPECT().GetDomainID(gomock.Any()).Return("test-domain-id", nil).Times(1)
+				resourceMock.HistoryClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(nil, errors.New("query-workflow-error")).Times(1)
+			},
+			inMemoryClient: dc.NewInMemoryClient(),
+			queryRequest: &types.QueryWorkflowRequest{
+				Domain: "test-domain",
+				Execution: &types.WorkflowExecution{
+					WorkflowID: "test-workflow-id",
+					RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+				},
+				Query: &types.WorkflowQuery{
+					QueryType: "test-query-type",
+				},
+			},
+			err: errors.New("query-workflow-error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockResource := resource.NewTest(t, mockCtrl, metrics.Frontend)
+			mockVersionChecker := client.NewMockVersionChecker(mockCtrl)
+
+			cfg := frontendcfg.NewConfig(
+				dc.NewCollection(
+					tc.inMemoryClient,
+					mockResource.GetLogger(),
+				),
+				numHistoryShards,
+				false,
+				"hostname",
+			)
+			cfg.BlobSizeLimitError = func(domain string) int { return 10 }
+			cfg.BlobSizeLimitWarn = func(domain string) int { return 9 }
+
+			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil, activityencryption.NewNopEncryptor())
+			wh.shuttingDown = tc.isShuttingDown
+
+			tc.setupMocks(mockVersionChecker, mockResource)
+
+			queryResult, err := wh.QueryWorkflow(context.Background(), tc.queryRequest)
+
+			if tc.err != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []byte("test-result"), queryResult.QueryResult)
+			}
+		})
+	}
+}
+
+func TestDescribeWorkflowExecution(t *testing.T) {
+	resp := &types.DescribeWorkflowExecutionResponse{
+		ExecutionConfiguration: &types.WorkflowExecutionConfiguration{
+			TaskList: &types.TaskList{
+				Name: "test-task-list",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		setupMocks      func(mockVersionChecker *client.MockVersionChecker, mockResource *resource.Test)
+		describeRequest *types.DescribeWorkflowExecutionRequest
+		isShuttingDown  int32
+		err             error
 	}{
 		{
 			name: "Success case",
@@ -4059,7 +5342,7 @@ func TestDescribeWorkflowExecution(t *testing.T) {
 				"hostname",
 			)
 
-			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil)
+			wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil, activityencryption.NewNopEncryptor())
 			wh.shuttingDown = tc.isShuttingDown
 
 			tc.setupMocks(mockVersionChecker, mockResource)
@@ -4080,7 +5363,7 @@ func TestDescribeWorkflowExecution(t *testing.T) {
 func (s *workflowHandlerSuite) TestSignalWithStartWorkflowExecution() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.EnableClientVersionCheck = dc.GetBoolPropertyFn(true)
-	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil, activityencryption.NewNopEncryptor())
 	wh.tokenSerializer = s.mockTokenSerializer
 
 	validRequest := &types.SignalWithStartWorkflowExecutionRequest{
@@ -4179,7 +5462,7 @@ func (s *workflowHandlerSuite) TestSignalWithStartWorkflowExecution() {
 func (s *workflowHandlerSuite) TestResetWorkflowExecution() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.EnableClientVersionCheck = dc.GetBoolPropertyFn(true)
-	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil, activityencryption.NewNopEncryptor())
 	wh.tokenSerializer = s.mockTokenSerializer
 
 	validRequest := &types.ResetWorkflowExecutionRequest{
@@ -4279,7 +5562,7 @@ func (s *workflowHandlerSuite) TestResetWorkflowExecution() {
 func (s *workflowHandlerSuite) TestTerminateWorkflowExecution() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.EnableClientVersionCheck = dc.GetBoolPropertyFn(true)
-	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil, activityencryption.NewNopEncryptor())
 	wh.tokenSerializer = s.mockTokenSerializer
 
 	validRequest := &types.TerminateWorkflowExecutionRequest{
@@ -4504,6 +5787,36 @@ func TestWorkflowDescribeEmitStatusMetrics(t *testing.T) {
 	}
 }
 
+func TestPurgeExpiredActivityResults(t *testing.T) {
+	now := time.Now()
+	newCompletedEvent := func(id int64, timestamp time.Time) *types.HistoryEvent {
+		return &types.HistoryEvent{
+			ID:        id,
+			Timestamp: common.Int64Ptr(timestamp.UnixNano()),
+			EventType: types.EventTypeActivityTaskCompleted.Ptr(),
+			ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+				Result: []byte("result"),
+			},
+		}
+	}
+	expired := newCompletedEvent(1, now.Add(-2*time.Hour))
+	recent := newCompletedEvent(2, now.Add(-time.Minute))
+	signaled := &types.HistoryEvent{
+		ID:        3,
+		Timestamp: common.Int64Ptr(now.Add(-2 * time.Hour).UnixNano()),
+		EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+			Input: []byte("input"),
+		},
+	}
+
+	purgeExpiredActivityResults([]*types.HistoryEvent{expired, recent, signaled}, time.Hour, now)
+
+	assert.Equal(t, []byte(common.ActivityResultTombstone), expired.ActivityTaskCompletedEventAttributes.Result)
+	assert.Equal(t, []byte("result"), recent.ActivityTaskCompletedEventAttributes.Result)
+	assert.Equal(t, []byte("input"), signaled.WorkflowExecutionSignaledEventAttributes.Input)
+}
+
 type counterSnapshotMock struct {
 	name  string
 	tags  map[string]string
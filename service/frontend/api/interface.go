@@ -24,7 +24,7 @@
 //go:generate gowrap gen -g -p . -i Handler -t ../templates/versioncheck.tmpl -o ../wrappers/versioncheck/api_generated.go
 //go:generate gowrap gen -g -p . -i Handler -t ../templates/metered.tmpl -o ../wrappers/metered/api_generated.go -v handler=API
 //go:generate gowrap gen -g -p . -i Handler -t ../templates/ratelimited.tmpl -o ../wrappers/ratelimited/api_generated.go -v handler=API
-//go:generate gowrap gen -g -p . -i Handler -t ../../templates/grpc.tmpl -o ../wrappers/grpc/api_generated.go -v handler=API -v package=apiv1 -v path=github.com/uber/cadence-idl/go/proto/api/v1 -v prefix=
+//go:generate gowrap gen -g -p . -i Handler -t ../../templates/grpc.tmpl -o ../wrappers/grpc/api_generated.go -v handler=API -v package=apiv1 -v path=github.com/uber/cadence-idl/go/proto/api/v1 -v prefix= -v exclude=BatchDescribeWorkflowExecutions|SignalActivity|GetActivityEffectiveConfig|GetActivityNextRetryTime|WaitForActivityCompletion|GetActivityRescheduleReasons|RenewActivityLease|GetActivityWorkerHistory|RespondActivityTaskNack|GetActivityRetryStats|ListPendingActivityScheduleAttributes|GetActivityTokenValidationFailures|GetActivityAttemptTimings|ListActivityTypes|ResetActivityAttempts
 //go:generate gowrap gen -g -p ../../../.gen/go/cadence/workflowserviceserver -i Interface -t ../../templates/thrift.tmpl -o ../wrappers/thrift/api_generated.go -v handler=API -v prefix=
 
 package api
@@ -39,19 +39,29 @@ type (
 	// Handler is interface wrapping frontend handler
 	Handler interface {
 		Health(context.Context) (*types.HealthStatus, error)
+		BatchDescribeWorkflowExecutions(context.Context, *types.BatchDescribeWorkflowExecutionsRequest) (*types.BatchDescribeWorkflowExecutionsResponse, error)
 		CountWorkflowExecutions(context.Context, *types.CountWorkflowExecutionsRequest) (*types.CountWorkflowExecutionsResponse, error)
 		DeprecateDomain(context.Context, *types.DeprecateDomainRequest) error
 		DescribeDomain(context.Context, *types.DescribeDomainRequest) (*types.DescribeDomainResponse, error)
 		DescribeTaskList(context.Context, *types.DescribeTaskListRequest) (*types.DescribeTaskListResponse, error)
 		DescribeWorkflowExecution(context.Context, *types.DescribeWorkflowExecutionRequest) (*types.DescribeWorkflowExecutionResponse, error)
 		DiagnoseWorkflowExecution(context.Context, *types.DiagnoseWorkflowExecutionRequest) (*types.DiagnoseWorkflowExecutionResponse, error)
+		GetActivityAttemptTimings(context.Context, *types.GetActivityAttemptTimingsRequest) (*types.GetActivityAttemptTimingsResponse, error)
+		GetActivityEffectiveConfig(context.Context, *types.GetActivityEffectiveConfigRequest) (*types.GetActivityEffectiveConfigResponse, error)
+		GetActivityNextRetryTime(context.Context, *types.GetActivityNextRetryTimeRequest) (*types.GetActivityNextRetryTimeResponse, error)
+		GetActivityRescheduleReasons(context.Context, *types.GetActivityRescheduleReasonsRequest) (*types.GetActivityRescheduleReasonsResponse, error)
+		GetActivityRetryStats(context.Context, *types.GetActivityRetryStatsRequest) (*types.GetActivityRetryStatsResponse, error)
+		GetActivityTokenValidationFailures(context.Context, *types.GetActivityTokenValidationFailuresRequest) (*types.GetActivityTokenValidationFailuresResponse, error)
+		GetActivityWorkerHistory(context.Context, *types.GetActivityWorkerHistoryRequest) (*types.GetActivityWorkerHistoryResponse, error)
 		GetClusterInfo(context.Context) (*types.ClusterInfo, error)
 		GetSearchAttributes(context.Context) (*types.GetSearchAttributesResponse, error)
 		GetWorkflowExecutionHistory(context.Context, *types.GetWorkflowExecutionHistoryRequest) (*types.GetWorkflowExecutionHistoryResponse, error)
+		ListActivityTypes(context.Context, *types.ListActivityTypesRequest) (*types.ListActivityTypesResponse, error)
 		ListArchivedWorkflowExecutions(context.Context, *types.ListArchivedWorkflowExecutionsRequest) (*types.ListArchivedWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutions(context.Context, *types.ListClosedWorkflowExecutionsRequest) (*types.ListClosedWorkflowExecutionsResponse, error)
 		ListDomains(context.Context, *types.ListDomainsRequest) (*types.ListDomainsResponse, error)
 		ListOpenWorkflowExecutions(context.Context, *types.ListOpenWorkflowExecutionsRequest) (*types.ListOpenWorkflowExecutionsResponse, error)
+		ListPendingActivityScheduleAttributes(context.Context, *types.ListPendingActivityScheduleAttributesRequest) (*types.ListPendingActivityScheduleAttributesResponse, error)
 		ListTaskListPartitions(context.Context, *types.ListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error)
 		GetTaskListsByDomain(context.Context, *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
 		RefreshWorkflowTasks(context.Context, *types.RefreshWorkflowTasksRequest) error
@@ -62,7 +72,9 @@ type (
 		RecordActivityTaskHeartbeat(context.Context, *types.RecordActivityTaskHeartbeatRequest) (*types.RecordActivityTaskHeartbeatResponse, error)
 		RecordActivityTaskHeartbeatByID(context.Context, *types.RecordActivityTaskHeartbeatByIDRequest) (*types.RecordActivityTaskHeartbeatResponse, error)
 		RegisterDomain(context.Context, *types.RegisterDomainRequest) error
+		RenewActivityLease(context.Context, *types.RenewActivityLeaseRequest) (*types.RenewActivityLeaseResponse, error)
 		RequestCancelWorkflowExecution(context.Context, *types.RequestCancelWorkflowExecutionRequest) error
+		ResetActivityAttempts(context.Context, *types.ResetActivityAttemptsRequest) error
 		ResetStickyTaskList(context.Context, *types.ResetStickyTaskListRequest) (*types.ResetStickyTaskListResponse, error)
 		ResetWorkflowExecution(context.Context, *types.ResetWorkflowExecutionRequest) (*types.ResetWorkflowExecutionResponse, error)
 		RespondActivityTaskCanceled(context.Context, *types.RespondActivityTaskCanceledRequest) error
@@ -71,11 +83,13 @@ type (
 		RespondActivityTaskCompletedByID(context.Context, *types.RespondActivityTaskCompletedByIDRequest) error
 		RespondActivityTaskFailed(context.Context, *types.RespondActivityTaskFailedRequest) error
 		RespondActivityTaskFailedByID(context.Context, *types.RespondActivityTaskFailedByIDRequest) error
+		RespondActivityTaskNack(context.Context, *types.RespondActivityTaskNackRequest) error
 		RespondDecisionTaskCompleted(context.Context, *types.RespondDecisionTaskCompletedRequest) (*types.RespondDecisionTaskCompletedResponse, error)
 		RespondDecisionTaskFailed(context.Context, *types.RespondDecisionTaskFailedRequest) error
 		RespondQueryTaskCompleted(context.Context, *types.RespondQueryTaskCompletedRequest) error
 		RestartWorkflowExecution(context.Context, *types.RestartWorkflowExecutionRequest) (*types.RestartWorkflowExecutionResponse, error)
 		ScanWorkflowExecutions(context.Context, *types.ListWorkflowExecutionsRequest) (*types.ListWorkflowExecutionsResponse, error)
+		SignalActivity(context.Context, *types.SignalActivityRequest) error
 		SignalWithStartWorkflowExecution(context.Context, *types.SignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
 		SignalWithStartWorkflowExecutionAsync(context.Context, *types.SignalWithStartWorkflowExecutionAsyncRequest) (*types.SignalWithStartWorkflowExecutionAsyncResponse, error)
 		SignalWorkflowExecution(context.Context, *types.SignalWorkflowExecutionRequest) error
@@ -83,5 +97,6 @@ type (
 		StartWorkflowExecutionAsync(context.Context, *types.StartWorkflowExecutionAsyncRequest) (*types.StartWorkflowExecutionAsyncResponse, error)
 		TerminateWorkflowExecution(context.Context, *types.TerminateWorkflowExecutionRequest) error
 		UpdateDomain(context.Context, *types.UpdateDomainRequest) (*types.UpdateDomainResponse, error)
+		WaitForActivityCompletion(context.Context, *types.WaitForActivityCompletionRequest) (*types.WaitForActivityCompletionResponse, error)
 	}
 )
@@ -64,6 +64,21 @@ func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
 	return m.recorder
 }
 
+// BatchDescribeWorkflowExecutions mocks base method.
+func (m *MockHandler) BatchDescribeWorkflowExecutions(arg0 context.Context, arg1 *types.BatchDescribeWorkflowExecutionsRequest) (*types.BatchDescribeWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDescribeWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*types.BatchDescribeWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchDescribeWorkflowExecutions indicates an expected call of BatchDescribeWorkflowExecutions.
+func (mr *MockHandlerMockRecorder) BatchDescribeWorkflowExecutions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDescribeWorkflowExecutions", reflect.TypeOf((*MockHandler)(nil).BatchDescribeWorkflowExecutions), arg0, arg1)
+}
+
 // CountWorkflowExecutions mocks base method.
 func (m *MockHandler) CountWorkflowExecutions(arg0 context.Context, arg1 *types.CountWorkflowExecutionsRequest) (*types.CountWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -153,6 +168,111 @@ func (mr *MockHandlerMockRecorder) DiagnoseWorkflowExecution(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiagnoseWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).DiagnoseWorkflowExecution), arg0, arg1)
 }
 
+// GetActivityAttemptTimings mocks base method.
+func (m *MockHandler) GetActivityAttemptTimings(arg0 context.Context, arg1 *types.GetActivityAttemptTimingsRequest) (*types.GetActivityAttemptTimingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityAttemptTimings", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetActivityAttemptTimingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityAttemptTimings indicates an expected call of GetActivityAttemptTimings.
+func (mr *MockHandlerMockRecorder) GetActivityAttemptTimings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityAttemptTimings", reflect.TypeOf((*MockHandler)(nil).GetActivityAttemptTimings), arg0, arg1)
+}
+
+// GetActivityEffectiveConfig mocks base method.
+func (m *MockHandler) GetActivityEffectiveConfig(arg0 context.Context, arg1 *types.GetActivityEffectiveConfigRequest) (*types.GetActivityEffectiveConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityEffectiveConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetActivityEffectiveConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityEffectiveConfig indicates an expected call of GetActivityEffectiveConfig.
+func (mr *MockHandlerMockRecorder) GetActivityEffectiveConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityEffectiveConfig", reflect.TypeOf((*MockHandler)(nil).GetActivityEffectiveConfig), arg0, arg1)
+}
+
+// GetActivityNextRetryTime mocks base method.
+func (m *MockHandler) GetActivityNextRetryTime(arg0 context.Context, arg1 *types.GetActivityNextRetryTimeRequest) (*types.GetActivityNextRetryTimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityNextRetryTime", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetActivityNextRetryTimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityNextRetryTime indicates an expected call of GetActivityNextRetryTime.
+func (mr *MockHandlerMockRecorder) GetActivityNextRetryTime(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityNextRetryTime", reflect.TypeOf((*MockHandler)(nil).GetActivityNextRetryTime), arg0, arg1)
+}
+
+// GetActivityRescheduleReasons mocks base method.
+func (m *MockHandler) GetActivityRescheduleReasons(arg0 context.Context, arg1 *types.GetActivityRescheduleReasonsRequest) (*types.GetActivityRescheduleReasonsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityRescheduleReasons", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetActivityRescheduleReasonsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityRescheduleReasons indicates an expected call of GetActivityRescheduleReasons.
+func (mr *MockHandlerMockRecorder) GetActivityRescheduleReasons(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityRescheduleReasons", reflect.TypeOf((*MockHandler)(nil).GetActivityRescheduleReasons), arg0, arg1)
+}
+
+// GetActivityRetryStats mocks base method.
+func (m *MockHandler) GetActivityRetryStats(arg0 context.Context, arg1 *types.GetActivityRetryStatsRequest) (*types.GetActivityRetryStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityRetryStats", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetActivityRetryStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityRetryStats indicates an expected call of GetActivityRetryStats.
+func (mr *MockHandlerMockRecorder) GetActivityRetryStats(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityRetryStats", reflect.TypeOf((*MockHandler)(nil).GetActivityRetryStats), arg0, arg1)
+}
+
+// GetActivityTokenValidationFailures mocks base method.
+func (m *MockHandler) GetActivityTokenValidationFailures(arg0 context.Context, arg1 *types.GetActivityTokenValidationFailuresRequest) (*types.GetActivityTokenValidationFailuresResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityTokenValidationFailures", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetActivityTokenValidationFailuresResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityTokenValidationFailures indicates an expected call of GetActivityTokenValidationFailures.
+func (mr *MockHandlerMockRecorder) GetActivityTokenValidationFailures(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityTokenValidationFailures", reflect.TypeOf((*MockHandler)(nil).GetActivityTokenValidationFailures), arg0, arg1)
+}
+
+// GetActivityWorkerHistory mocks base method.
+func (m *MockHandler) GetActivityWorkerHistory(arg0 context.Context, arg1 *types.GetActivityWorkerHistoryRequest) (*types.GetActivityWorkerHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityWorkerHistory", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetActivityWorkerHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityWorkerHistory indicates an expected call of GetActivityWorkerHistory.
+func (mr *MockHandlerMockRecorder) GetActivityWorkerHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityWorkerHistory", reflect.TypeOf((*MockHandler)(nil).GetActivityWorkerHistory), arg0, arg1)
+}
+
 // GetClusterInfo mocks base method.
 func (m *MockHandler) GetClusterInfo(arg0 context.Context) (*types.ClusterInfo, error) {
 	m.ctrl.T.Helper()
@@ -228,6 +348,21 @@ func (mr *MockHandlerMockRecorder) Health(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockHandler)(nil).Health), arg0)
 }
 
+// ListActivityTypes mocks base method.
+func (m *MockHandler) ListActivityTypes(arg0 context.Context, arg1 *types.ListActivityTypesRequest) (*types.ListActivityTypesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActivityTypes", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListActivityTypesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActivityTypes indicates an expected call of ListActivityTypes.
+func (mr *MockHandlerMockRecorder) ListActivityTypes(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActivityTypes", reflect.TypeOf((*MockHandler)(nil).ListActivityTypes), arg0, arg1)
+}
+
 // ListArchivedWorkflowExecutions mocks base method.
 func (m *MockHandler) ListArchivedWorkflowExecutions(arg0 context.Context, arg1 *types.ListArchivedWorkflowExecutionsRequest) (*types.ListArchivedWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -288,6 +423,21 @@ func (mr *MockHandlerMockRecorder) ListOpenWorkflowExecutions(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenWorkflowExecutions", reflect.TypeOf((*MockHandler)(nil).ListOpenWorkflowExecutions), arg0, arg1)
 }
 
+// ListPendingActivityScheduleAttributes mocks base method.
+func (m *MockHandler) ListPendingActivityScheduleAttributes(arg0 context.Context, arg1 *types.ListPendingActivityScheduleAttributesRequest) (*types.ListPendingActivityScheduleAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingActivityScheduleAttributes", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListPendingActivityScheduleAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingActivityScheduleAttributes indicates an expected call of ListPendingActivityScheduleAttributes.
+func (mr *MockHandlerMockRecorder) ListPendingActivityScheduleAttributes(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingActivityScheduleAttributes", reflect.TypeOf((*MockHandler)(nil).ListPendingActivityScheduleAttributes), arg0, arg1)
+}
+
 // ListTaskListPartitions mocks base method.
 func (m *MockHandler) ListTaskListPartitions(arg0 context.Context, arg1 *types.ListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -421,6 +571,21 @@ func (mr *MockHandlerMockRecorder) RegisterDomain(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDomain", reflect.TypeOf((*MockHandler)(nil).RegisterDomain), arg0, arg1)
 }
 
+// RenewActivityLease mocks base method.
+func (m *MockHandler) RenewActivityLease(arg0 context.Context, arg1 *types.RenewActivityLeaseRequest) (*types.RenewActivityLeaseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewActivityLease", arg0, arg1)
+	ret0, _ := ret[0].(*types.RenewActivityLeaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenewActivityLease indicates an expected call of RenewActivityLease.
+func (mr *MockHandlerMockRecorder) RenewActivityLease(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewActivityLease", reflect.TypeOf((*MockHandler)(nil).RenewActivityLease), arg0, arg1)
+}
+
 // RequestCancelWorkflowExecution mocks base method.
 func (m *MockHandler) RequestCancelWorkflowExecution(arg0 context.Context, arg1 *types.RequestCancelWorkflowExecutionRequest) error {
 	m.ctrl.T.Helper()
@@ -435,6 +600,20 @@ func (mr *MockHandlerMockRecorder) RequestCancelWorkflowExecution(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).RequestCancelWorkflowExecution), arg0, arg1)
 }
 
+// ResetActivityAttempts mocks base method.
+func (m *MockHandler) ResetActivityAttempts(arg0 context.Context, arg1 *types.ResetActivityAttemptsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivityAttempts", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivityAttempts indicates an expected call of ResetActivityAttempts.
+func (mr *MockHandlerMockRecorder) ResetActivityAttempts(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityAttempts", reflect.TypeOf((*MockHandler)(nil).ResetActivityAttempts), arg0, arg1)
+}
+
 // ResetStickyTaskList mocks base method.
 func (m *MockHandler) ResetStickyTaskList(arg0 context.Context, arg1 *types.ResetStickyTaskListRequest) (*types.ResetStickyTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -549,6 +728,20 @@ func (mr *MockHandlerMockRecorder) RespondActivityTaskFailedByID(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondActivityTaskFailedByID", reflect.TypeOf((*MockHandler)(nil).RespondActivityTaskFailedByID), arg0, arg1)
 }
 
+// RespondActivityTaskNack mocks base method.
+func (m *MockHandler) RespondActivityTaskNack(arg0 context.Context, arg1 *types.RespondActivityTaskNackRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondActivityTaskNack", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RespondActivityTaskNack indicates an expected call of RespondActivityTaskNack.
+func (mr *MockHandlerMockRecorder) RespondActivityTaskNack(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondActivityTaskNack", reflect.TypeOf((*MockHandler)(nil).RespondActivityTaskNack), arg0, arg1)
+}
+
 // RespondDecisionTaskCompleted mocks base method.
 func (m *MockHandler) RespondDecisionTaskCompleted(arg0 context.Context, arg1 *types.RespondDecisionTaskCompletedRequest) (*types.RespondDecisionTaskCompletedResponse, error) {
 	m.ctrl.T.Helper()
@@ -622,6 +815,20 @@ func (mr *MockHandlerMockRecorder) ScanWorkflowExecutions(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanWorkflowExecutions", reflect.TypeOf((*MockHandler)(nil).ScanWorkflowExecutions), arg0, arg1)
 }
 
+// SignalActivity mocks base method.
+func (m *MockHandler) SignalActivity(arg0 context.Context, arg1 *types.SignalActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignalActivity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignalActivity indicates an expected call of SignalActivity.
+func (mr *MockHandlerMockRecorder) SignalActivity(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalActivity", reflect.TypeOf((*MockHandler)(nil).SignalActivity), arg0, arg1)
+}
+
 // SignalWithStartWorkflowExecution mocks base method.
 func (m *MockHandler) SignalWithStartWorkflowExecution(arg0 context.Context, arg1 *types.SignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -724,3 +931,18 @@ func (mr *MockHandlerMockRecorder) UpdateDomain(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomain", reflect.TypeOf((*MockHandler)(nil).UpdateDomain), arg0, arg1)
 }
+
+// WaitForActivityCompletion mocks base method.
+func (m *MockHandler) WaitForActivityCompletion(arg0 context.Context, arg1 *types.WaitForActivityCompletionRequest) (*types.WaitForActivityCompletionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForActivityCompletion", arg0, arg1)
+	ret0, _ := ret[0].(*types.WaitForActivityCompletionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForActivityCompletion indicates an expected call of WaitForActivityCompletion.
+func (mr *MockHandlerMockRecorder) WaitForActivityCompletion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForActivityCompletion", reflect.TypeOf((*MockHandler)(nil).WaitForActivityCompletion), arg0, arg1)
+}
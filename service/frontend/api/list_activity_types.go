@@ -0,0 +1,184 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"sort"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+// ListActivityTypes returns the activity types scheduled in the domain within the time range, with the number of
+// times each of them was scheduled.
+//
+// Visibility does not know about activities, so the runs which may have scheduled activities in the time range are
+// listed from it: the open runs started before the end of the range and the runs closed since its beginning. The
+// histories of these runs are then read, and their ActivityTaskScheduled events in the range counted. As this is
+// expensive, at most ListActivityTypesMaxWorkflows runs are read, the response is marked as truncated beyond it.
+func (wh *WorkflowHandler) ListActivityTypes(
+	ctx context.Context,
+	request *types.ListActivityTypesRequest,
+) (*types.ListActivityTypesResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	if request.GetDomain() == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if request.EarliestTime == nil {
+		return nil, &types.BadRequestError{Message: "EarliestTime is required"}
+	}
+	now := wh.GetTimeSource().Now().UnixNano()
+	earliestTime := request.GetEarliestTime()
+	latestTime := now
+	if request.LatestTime != nil {
+		latestTime = request.GetLatestTime()
+	}
+	if earliestTime > latestTime {
+		return nil, &types.BadRequestError{Message: "EarliestTime should not be larger than LatestTime"}
+	}
+
+	domainName := request.GetDomain()
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+	scope := getMetricsScopeWithDomain(metrics.FrontendListActivityTypesScope, request, wh.GetMetricsClient())
+
+	listRequests := []struct {
+		list         func(context.Context, *persistence.ListWorkflowExecutionsRequest) (*persistence.ListWorkflowExecutionsResponse, error)
+		earliestTime int64
+		latestTime   int64
+	}{
+		{list: wh.GetVisibilityManager().ListOpenWorkflowExecutions, earliestTime: 0, latestTime: latestTime},
+		{list: wh.GetVisibilityManager().ListClosedWorkflowExecutions, earliestTime: earliestTime, latestTime: now},
+	}
+
+	maxWorkflows := wh.config.ListActivityTypesMaxWorkflows(domainName)
+	counts := make(map[string]int64)
+	workflows := 0
+	truncated := false
+scan:
+	for _, listRequest := range listRequests {
+		var nextPageToken []byte
+		for {
+			listResponse, err := listRequest.list(ctx, &persistence.ListWorkflowExecutionsRequest{
+				DomainUUID:    domainID,
+				Domain:        domainName,
+				PageSize:      wh.config.VisibilityMaxPageSize(domainName),
+				NextPageToken: nextPageToken,
+				EarliestTime:  listRequest.earliestTime,
+				LatestTime:    listRequest.latestTime,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, info := range listResponse.Executions {
+				if workflows >= maxWorkflows {
+					truncated = true
+					break scan
+				}
+				workflows++
+				if err := wh.countScheduledActivityTypes(
+					ctx, scope, domainID, domainName, *info.GetExecution(), earliestTime, latestTime, counts); err != nil {
+					return nil, err
+				}
+			}
+			if len(listResponse.NextPageToken) == 0 {
+				break
+			}
+			nextPageToken = listResponse.NextPageToken
+		}
+	}
+
+	activityTypes := make([]*types.ActivityTypeCount, 0, len(counts))
+	for name, count := range counts {
+		activityTypes = append(activityTypes, &types.ActivityTypeCount{
+			ActivityType: &types.ActivityType{Name: name},
+			Count:        count,
+		})
+	}
+	sort.Slice(activityTypes, func(i, j int) bool {
+		return activityTypes[i].ActivityType.GetName() < activityTypes[j].ActivityType.GetName()
+	})
+	return &types.ListActivityTypesResponse{
+		ActivityTypes: activityTypes,
+		Truncated:     truncated,
+	}, nil
+}
+
+// countScheduledActivityTypes adds the activity types of the ActivityTaskScheduled events of the run within the
+// time range to the counts. A run deleted since it was listed is skipped.
+func (wh *WorkflowHandler) countScheduledActivityTypes(
+	ctx context.Context,
+	scope metrics.Scope,
+	domainID string,
+	domainName string,
+	execution types.WorkflowExecution,
+	earliestTime int64,
+	latestTime int64,
+	counts map[string]int64,
+) error {
+
+	mutableState, err := wh.GetHistoryClient().GetMutableState(ctx, &types.GetMutableStateRequest{
+		DomainUUID: domainID,
+		Execution:  &execution,
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return nil
+		}
+		return err
+	}
+
+	var nextPageToken []byte
+	for {
+		history, token, err := wh.getHistory(
+			ctx, scope, domainID, domainName, execution, common.FirstEventID, mutableState.GetNextEventID(),
+			common.GetHistoryMaxPageSize, nextPageToken, nil, mutableState.CurrentBranchToken)
+		if err != nil {
+			return err
+		}
+		for _, event := range history.Events {
+			if event.GetEventType() != types.EventTypeActivityTaskScheduled {
+				continue
+			}
+			if event.GetTimestamp() < earliestTime || event.GetTimestamp() > latestTime {
+				continue
+			}
+			counts[event.ActivityTaskScheduledEventAttributes.GetActivityType().GetName()]++
+		}
+		if len(token) == 0 {
+			return nil
+		}
+		nextPageToken = token
+	}
+}
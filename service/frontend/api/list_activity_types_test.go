@@ -0,0 +1,168 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestListActivityTypes(t *testing.T) {
+	openRun := &types.WorkflowExecution{WorkflowID: "wf1", RunID: "run1"}
+	closedRun := &types.WorkflowExecution{WorkflowID: "wf2", RunID: "run2"}
+	newRequest := func() *types.ListActivityTypesRequest {
+		return &types.ListActivityTypesRequest{
+			Domain:       "domain",
+			EarliestTime: common.Int64Ptr(10),
+			LatestTime:   common.Int64Ptr(100),
+		}
+	}
+	// history of a run with an activity scheduled by event 2 and another one by event 3
+	historyEvents := func(activityTypes [2]string, timestamps [2]int64) []*types.HistoryEvent {
+		events := []*types.HistoryEvent{{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()}}
+		for i := range activityTypes {
+			events = append(events, &types.HistoryEvent{
+				ID:        int64(i + 2),
+				Timestamp: common.Int64Ptr(timestamps[i]),
+				EventType: types.EventTypeActivityTaskScheduled.Ptr(),
+				ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+					ActivityType: &types.ActivityType{Name: activityTypes[i]},
+				},
+			})
+		}
+		return events
+	}
+	listRuns := func(deps *mockDeps) {
+		deps.mockVisibilityMgr.On("ListOpenWorkflowExecutions", mock.Anything, mock.Anything).
+			Return(&persistence.ListWorkflowExecutionsResponse{
+				Executions: []*types.WorkflowExecutionInfo{{Execution: openRun}},
+			}, nil).Once()
+		deps.mockVisibilityMgr.On("ListClosedWorkflowExecutions", mock.Anything, mock.Anything).
+			Return(&persistence.ListWorkflowExecutionsResponse{
+				Executions: []*types.WorkflowExecutionInfo{{Execution: closedRun}},
+			}, nil).Once()
+	}
+	mutableState := &types.GetMutableStateResponse{NextEventID: 4, CurrentBranchToken: []byte{1}}
+
+	testCases := []struct {
+		name              string
+		req               *types.ListActivityTypesRequest
+		setupMocks        func(*mockDeps)
+		expectedTypes     []*types.ActivityTypeCount
+		expectedTruncated bool
+		expectedError     error
+	}{
+		{
+			name:          "earliest time not set",
+			req:           &types.ListActivityTypesRequest{Domain: "domain"},
+			setupMocks:    func(deps *mockDeps) {},
+			expectedError: &types.BadRequestError{Message: "EarliestTime is required"},
+		},
+		{
+			name: "activity types counted",
+			req:  newRequest(),
+			setupMocks: func(deps *mockDeps) {
+				listRuns(deps)
+				deps.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(mutableState, nil).Times(2)
+				deps.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).
+					Return(&persistence.ReadHistoryBranchResponse{
+						HistoryEvents: historyEvents([2]string{"typeA", "typeB"}, [2]int64{10, 20}),
+					}, nil).Once()
+				deps.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).
+					Return(&persistence.ReadHistoryBranchResponse{
+						HistoryEvents: historyEvents([2]string{"typeA", "typeC"}, [2]int64{100, 101}),
+					}, nil).Once()
+			},
+			expectedTypes: []*types.ActivityTypeCount{
+				{ActivityType: &types.ActivityType{Name: "typeA"}, Count: 2},
+				{ActivityType: &types.ActivityType{Name: "typeB"}, Count: 1},
+			},
+		},
+		{
+			name: "deleted run skipped",
+			req:  newRequest(),
+			setupMocks: func(deps *mockDeps) {
+				listRuns(deps)
+				gomock.InOrder(
+					deps.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).
+						Return(nil, &types.EntityNotExistsError{Message: "workflow not found"}),
+					deps.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(mutableState, nil),
+				)
+				deps.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).
+					Return(&persistence.ReadHistoryBranchResponse{
+						HistoryEvents: historyEvents([2]string{"typeB", "typeB"}, [2]int64{50, 60}),
+					}, nil).Once()
+			},
+			expectedTypes: []*types.ActivityTypeCount{
+				{ActivityType: &types.ActivityType{Name: "typeB"}, Count: 2},
+			},
+		},
+		{
+			name: "truncated",
+			req:  newRequest(),
+			setupMocks: func(deps *mockDeps) {
+				assert.NoError(t, deps.dynamicClient.UpdateValue(dynamicconfig.FrontendListActivityTypesMaxWorkflows, 1))
+				deps.mockVisibilityMgr.On("ListOpenWorkflowExecutions", mock.Anything, mock.Anything).
+					Return(&persistence.ListWorkflowExecutionsResponse{
+						Executions: []*types.WorkflowExecutionInfo{{Execution: openRun}, {Execution: closedRun}},
+					}, nil).Once()
+				deps.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(mutableState, nil)
+				deps.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).
+					Return(&persistence.ReadHistoryBranchResponse{
+						HistoryEvents: historyEvents([2]string{"typeA", "typeB"}, [2]int64{10, 20}),
+					}, nil).Once()
+			},
+			expectedTypes: []*types.ActivityTypeCount{
+				{ActivityType: &types.ActivityType{Name: "typeA"}, Count: 1},
+				{ActivityType: &types.ActivityType{Name: "typeB"}, Count: 1},
+			},
+			expectedTruncated: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, deps := setupMocksForWorkflowHandler(t)
+			deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil).AnyTimes()
+			tc.setupMocks(deps)
+
+			resp, err := wh.ListActivityTypes(context.Background(), tc.req)
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedTypes, resp.GetActivityTypes())
+			assert.Equal(t, tc.expectedTruncated, resp.GetTruncated())
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package api
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+// ListPendingActivityScheduleAttributes returns the attributes of the ActivityTaskScheduled events of the pending
+// activities of a workflow execution, i.e. their input, header, timeouts and retry policy as recorded when they
+// were scheduled. It reads them through mutable state, so callers do not need to scan the history of the workflow.
+func (wh *WorkflowHandler) ListPendingActivityScheduleAttributes(
+	ctx context.Context,
+	request *types.ListPendingActivityScheduleAttributesRequest,
+) (*types.ListPendingActivityScheduleAttributesResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	if request.GetDomain() == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+
+	if err := validate.CheckExecution(request.GetExecution()); err != nil {
+		return nil, err
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+
+	describeResp, err := wh.GetHistoryClient().DescribeWorkflowExecution(ctx, &types.HistoryDescribeWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		Request: &types.DescribeWorkflowExecutionRequest{
+			Domain:    request.GetDomain(),
+			Execution: request.GetExecution(),
+		},
+		IncludeScheduledEventAttributes: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.ListPendingActivityScheduleAttributesResponse{}
+	for _, pendingActivity := range describeResp.GetPendingActivities() {
+		resp.PendingActivities = append(resp.PendingActivities, &types.PendingActivityScheduleAttributes{
+			ActivityID:               pendingActivity.GetActivityID(),
+			ScheduleID:               pendingActivity.GetScheduleID(),
+			ScheduledEventAttributes: pendingActivity.GetScheduledEventAttributes(),
+		})
+	}
+	return resp, nil
+}
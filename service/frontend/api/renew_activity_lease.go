@@ -0,0 +1,97 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package api
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+// RenewActivityLease resets the heartbeat timer of a started activity, like a heartbeat without details would,
+// but keeps the heartbeat details stored for the activity. Activities which only heartbeat to report liveness
+// can use it instead of sending an empty Details blob with every heartbeat.
+func (wh *WorkflowHandler) RenewActivityLease(
+	ctx context.Context,
+	renewRequest *types.RenewActivityLeaseRequest,
+) (*types.RenewActivityLeaseResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+
+	if renewRequest == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	if renewRequest.TaskToken == nil {
+		return nil, validate.ErrTaskTokenNotSet
+	}
+	taskToken, err := wh.tokenSerializer.Deserialize(renewRequest.TaskToken)
+	if err != nil {
+		return nil, err
+	}
+	if taskToken.DomainID == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+
+	domainName, err := wh.GetDomainCache().GetDomainName(taskToken.DomainID)
+	if err != nil {
+		return nil, err
+	}
+
+	dw := domainWrapper{
+		domain: domainName,
+	}
+	scope := getMetricsScopeWithDomain(metrics.FrontendRenewActivityLeaseScope, dw, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	if !common.IsValidIDLength(
+		renewRequest.GetIdentity(),
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.IdentityMaxLength(domainName),
+		metrics.CadenceErrIdentityExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeIdentity) {
+		return nil, validate.ErrIdentityTooLong
+	}
+
+	resp, err := wh.GetHistoryClient().RecordActivityTaskHeartbeat(ctx, &types.HistoryRecordActivityTaskHeartbeatRequest{
+		DomainUUID: taskToken.DomainID,
+		HeartbeatRequest: &types.RecordActivityTaskHeartbeatRequest{
+			TaskToken: renewRequest.TaskToken,
+			Identity:  renewRequest.Identity,
+		},
+		RenewLeaseOnly: true,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, err)
+	}
+	return &types.RenewActivityLeaseResponse{
+		CancelRequested:     resp.CancelRequested,
+		WorkflowCloseStatus: resp.WorkflowCloseStatus,
+	}, nil
+}
@@ -0,0 +1,199 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+// WaitForActivityCompletion blocks until the activity with the given ID reaches a terminal state, and returns the
+// ActivityTaskCompleted, ActivityTaskFailed, ActivityTaskTimedOut or ActivityTaskCanceled event closing it.
+//
+// The activity is looked up in the pending activities of the run, as DescribeWorkflowExecution reports them. While
+// it is pending, the call long polls the history service for new events of the run and looks again. Once it is no
+// longer pending, its closing event is read from the history. If the activity ID was reused, the last activity
+// scheduled with it is the one waited for. An activity which was never scheduled returns EntityNotExistsError, and
+// one still pending when the run closes returns WorkflowExecutionAlreadyCompletedError as it will never complete.
+//
+// The wait is bounded by TimeoutSeconds and by the deadline of the call. When either expires before the activity
+// completes, an empty response is returned, without an error, and the caller may wait again.
+func (wh *WorkflowHandler) WaitForActivityCompletion(
+	ctx context.Context,
+	request *types.WaitForActivityCompletionRequest,
+) (*types.WaitForActivityCompletionResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	if request.GetDomain() == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(request.GetExecution()); err != nil {
+		return nil, err
+	}
+	if request.GetActivityID() == "" {
+		return nil, validate.ErrActivityIDNotSet
+	}
+	if request.GetTimeoutSeconds() <= 0 {
+		return nil, &types.BadRequestError{Message: "A valid TimeoutSeconds is not set on request."}
+	}
+
+	domainName := request.GetDomain()
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+	scope := getMetricsScopeWithDomain(metrics.FrontendWaitForActivityCompletionScope, request, wh.GetMetricsClient())
+
+	waitCtx, cancel := context.WithTimeout(ctx, time.Duration(request.GetTimeoutSeconds())*time.Second)
+	defer cancel()
+
+	execution := *request.GetExecution()
+	expectedNextEventID := int64(0) // the first poll returns without waiting
+	for {
+		pollResponse, err := wh.GetHistoryClient().PollMutableState(waitCtx, &types.PollMutableStateRequest{
+			DomainUUID:          domainID,
+			Execution:           &execution,
+			ExpectedNextEventID: expectedNextEventID,
+		})
+		if err != nil {
+			if waitCtx.Err() != nil {
+				return &types.WaitForActivityCompletionResponse{}, nil
+			}
+			return nil, err
+		}
+		// keep waiting on the same run even if it is continued as new
+		execution.RunID = pollResponse.Execution.GetRunID()
+		expectedNextEventID = pollResponse.GetNextEventID()
+		isWorkflowRunning := pollResponse.GetWorkflowCloseState() == persistence.WorkflowCloseStatusNone
+
+		describeResponse, err := wh.GetHistoryClient().DescribeWorkflowExecution(waitCtx, &types.HistoryDescribeWorkflowExecutionRequest{
+			DomainUUID: domainID,
+			Request: &types.DescribeWorkflowExecutionRequest{
+				Domain:    domainName,
+				Execution: &execution,
+			},
+		})
+		if err != nil {
+			if waitCtx.Err() != nil {
+				return &types.WaitForActivityCompletionResponse{}, nil
+			}
+			return nil, err
+		}
+
+		isPending := false
+		for _, activity := range describeResponse.GetPendingActivities() {
+			if activity.GetActivityID() == request.GetActivityID() {
+				isPending = true
+				break
+			}
+		}
+
+		if !isPending {
+			closeEvent, isScheduled, err := wh.getActivityCloseEvent(
+				waitCtx, scope, domainID, domainName, execution, request.GetActivityID(), expectedNextEventID, pollResponse.CurrentBranchToken)
+			if err != nil {
+				if waitCtx.Err() != nil {
+					return &types.WaitForActivityCompletionResponse{}, nil
+				}
+				return nil, err
+			}
+			if closeEvent != nil {
+				return &types.WaitForActivityCompletionResponse{CloseEvent: closeEvent}, nil
+			}
+			if !isScheduled {
+				return nil, &types.EntityNotExistsError{Message: "Activity not found."}
+			}
+			// the closing event is buffered until the decision in flight completes
+		}
+
+		if !isWorkflowRunning {
+			return nil, &types.WorkflowExecutionAlreadyCompletedError{Message: "Workflow execution closed before the activity completed."}
+		}
+	}
+}
+
+// getActivityCloseEvent returns the event closing the last activity scheduled with the activity ID in the history
+// of the run, and whether such an activity was scheduled at all
+func (wh *WorkflowHandler) getActivityCloseEvent(
+	ctx context.Context,
+	scope metrics.Scope,
+	domainID string,
+	domainName string,
+	execution types.WorkflowExecution,
+	activityID string,
+	nextEventID int64,
+	branchToken []byte,
+) (*types.HistoryEvent, bool, error) {
+
+	scheduledEventID := common.EmptyEventID
+	var closeEvent *types.HistoryEvent
+	var nextPageToken []byte
+	for {
+		history, token, err := wh.getHistory(
+			ctx, scope, domainID, domainName, execution, common.FirstEventID, nextEventID,
+			common.GetHistoryMaxPageSize, nextPageToken, nil, branchToken)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, event := range history.Events {
+			switch event.GetEventType() {
+			case types.EventTypeActivityTaskScheduled:
+				if event.ActivityTaskScheduledEventAttributes.GetActivityID() == activityID {
+					scheduledEventID = event.ID
+					closeEvent = nil
+				}
+			case types.EventTypeActivityTaskCompleted:
+				if event.ActivityTaskCompletedEventAttributes.GetScheduledEventID() == scheduledEventID {
+					closeEvent = event
+				}
+			case types.EventTypeActivityTaskFailed:
+				if event.ActivityTaskFailedEventAttributes.GetScheduledEventID() == scheduledEventID {
+					closeEvent = event
+				}
+			case types.EventTypeActivityTaskTimedOut:
+				if event.ActivityTaskTimedOutEventAttributes.GetScheduledEventID() == scheduledEventID {
+					closeEvent = event
+				}
+			case types.EventTypeActivityTaskCanceled:
+				if event.ActivityTaskCanceledEventAttributes.GetScheduledEventID() == scheduledEventID {
+					closeEvent = event
+				}
+			}
+		}
+		if len(token) == 0 {
+			break
+		}
+		nextPageToken = token
+	}
+	return closeEvent, scheduledEventID != common.EmptyEventID, nil
+}
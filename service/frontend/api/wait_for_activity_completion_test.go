@@ -0,0 +1,212 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+func TestWaitForActivityCompletion(t *testing.T) {
+	execution := &types.WorkflowExecution{WorkflowID: "wf", RunID: "run"}
+	newRequest := func() *types.WaitForActivityCompletionRequest {
+		return &types.WaitForActivityCompletionRequest{
+			Domain:         "domain",
+			Execution:      execution,
+			ActivityID:     "activity",
+			TimeoutSeconds: 10,
+		}
+	}
+	// history of the run up to event 7: the activity is scheduled by event 5 and completed by event 7
+	historyEvents := func(completed bool) []*types.HistoryEvent {
+		var events []*types.HistoryEvent
+		for eventID := int64(1); eventID <= 7; eventID++ {
+			event := &types.HistoryEvent{ID: eventID, EventType: types.EventTypeMarkerRecorded.Ptr()}
+			switch {
+			case eventID == 5:
+				event.EventType = types.EventTypeActivityTaskScheduled.Ptr()
+				event.ActivityTaskScheduledEventAttributes = &types.ActivityTaskScheduledEventAttributes{ActivityID: "activity"}
+			case eventID == 7 && completed:
+				event.EventType = types.EventTypeActivityTaskCompleted.Ptr()
+				event.ActivityTaskCompletedEventAttributes = &types.ActivityTaskCompletedEventAttributes{
+					ScheduledEventID: 5,
+					Result:           []byte("result"),
+				}
+			}
+			events = append(events, event)
+		}
+		return events
+	}
+	pollResponse := func(closeState int32) *types.PollMutableStateResponse {
+		return &types.PollMutableStateResponse{
+			Execution:          execution,
+			NextEventID:        8,
+			CurrentBranchToken: []byte{1},
+			WorkflowCloseState: common.Int32Ptr(closeState),
+		}
+	}
+	describeResponse := func(pendingActivityIDs ...string) *types.DescribeWorkflowExecutionResponse {
+		response := &types.DescribeWorkflowExecutionResponse{}
+		for _, activityID := range pendingActivityIDs {
+			response.PendingActivities = append(response.PendingActivities, &types.PendingActivityInfo{ActivityID: activityID})
+		}
+		return response
+	}
+
+	testCases := []struct {
+		name          string
+		req           *types.WaitForActivityCompletionRequest
+		setupMocks    func(*mockDeps)
+		expectedEvent int64
+		expectedError error
+	}{
+		{
+			name: "activity ID not set",
+			req: &types.WaitForActivityCompletionRequest{
+				Domain:         "domain",
+				Execution:      execution,
+				TimeoutSeconds: 10,
+			},
+			setupMocks:    func(deps *mockDeps) {},
+			expectedError: validate.ErrActivityIDNotSet,
+		},
+		{
+			name: "activity completed",
+			req:  newRequest(),
+			setupMocks: func(deps *mockDeps) {
+				deps.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), gomock.Any()).
+					Return(pollResponse(persistence.WorkflowCloseStatusNone), nil)
+				deps.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(describeResponse("other activity"), nil)
+				deps.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).
+					Return(&persistence.ReadHistoryBranchResponse{HistoryEvents: historyEvents(true)}, nil).Once()
+			},
+			expectedEvent: 7,
+		},
+		{
+			name: "activity completed after a wait",
+			req:  newRequest(),
+			setupMocks: func(deps *mockDeps) {
+				gomock.InOrder(
+					deps.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), gomock.Any()).
+						Return(pollResponse(persistence.WorkflowCloseStatusNone), nil),
+					deps.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), &types.PollMutableStateRequest{
+						DomainUUID:          "domain-id",
+						Execution:           execution,
+						ExpectedNextEventID: 8,
+					}).Return(pollResponse(persistence.WorkflowCloseStatusNone), nil),
+				)
+				gomock.InOrder(
+					deps.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+						Return(describeResponse("activity"), nil),
+					deps.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+						Return(describeResponse(), nil),
+				)
+				deps.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).
+					Return(&persistence.ReadHistoryBranchResponse{HistoryEvents: historyEvents(true)}, nil).Once()
+			},
+			expectedEvent: 7,
+		},
+		{
+			name: "activity not scheduled",
+			req: &types.WaitForActivityCompletionRequest{
+				Domain:         "domain",
+				Execution:      execution,
+				ActivityID:     "unknown activity",
+				TimeoutSeconds: 10,
+			},
+			setupMocks: func(deps *mockDeps) {
+				deps.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), gomock.Any()).
+					Return(pollResponse(persistence.WorkflowCloseStatusNone), nil)
+				deps.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(describeResponse(), nil)
+				deps.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).
+					Return(&persistence.ReadHistoryBranchResponse{HistoryEvents: historyEvents(true)}, nil).Once()
+			},
+			expectedError: &types.EntityNotExistsError{Message: "Activity not found."},
+		},
+		{
+			name: "workflow closed with the activity pending",
+			req:  newRequest(),
+			setupMocks: func(deps *mockDeps) {
+				deps.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), gomock.Any()).
+					Return(pollResponse(persistence.WorkflowCloseStatusTerminated), nil)
+				deps.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(describeResponse("activity"), nil)
+			},
+			expectedError: &types.WorkflowExecutionAlreadyCompletedError{Message: "Workflow execution closed before the activity completed."},
+		},
+		{
+			name: "wait timed out",
+			req: &types.WaitForActivityCompletionRequest{
+				Domain:         "domain",
+				Execution:      execution,
+				ActivityID:     "activity",
+				TimeoutSeconds: 1,
+			},
+			setupMocks: func(deps *mockDeps) {
+				gomock.InOrder(
+					deps.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), gomock.Any()).
+						Return(pollResponse(persistence.WorkflowCloseStatusNone), nil),
+					deps.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, _ *types.PollMutableStateRequest, _ ...yarpc.CallOption) (*types.PollMutableStateResponse, error) {
+							<-ctx.Done()
+							return nil, ctx.Err()
+						}),
+				)
+				deps.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(describeResponse("activity"), nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, deps := setupMocksForWorkflowHandler(t)
+			deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil).AnyTimes()
+			tc.setupMocks(deps)
+
+			resp, err := wh.WaitForActivityCompletion(context.Background(), tc.req)
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, err)
+				return
+			}
+			assert.NoError(t, err)
+			if tc.expectedEvent == 0 {
+				assert.Nil(t, resp.GetCloseEvent())
+				return
+			}
+			assert.Equal(t, tc.expectedEvent, resp.GetCloseEvent().ID)
+		})
+	}
+}
@@ -106,9 +106,27 @@ type Config struct {
 
 	SendRawWorkflowHistory dynamicconfig.BoolPropertyFnWithDomainFilter
 
+	// age after which GetWorkflowExecutionHistory no longer returns activity results (no limit by default)
+	ActivityResultRetention dynamicconfig.DurationPropertyFnWithDomainFilter
+
 	// max number of decisions per RespondDecisionTaskCompleted request (unlimited by default)
 	DecisionResultCountLimit dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// rate limit of RecordActivityTaskHeartbeat per domain (unlimited by default), and how long a heartbeat
+	// above it waits before it is rejected with a retry-after hint
+	ActivityHeartbeatRPS          dynamicconfig.IntPropertyFnWithDomainFilter
+	ActivityHeartbeatQueueTimeout dynamicconfig.DurationPropertyFnWithDomainFilter
+	ActivityHeartbeatRetryAfter   dynamicconfig.DurationPropertyFnWithDomainFilter
+
+	// max number of workflow runs ListActivityTypes reads the history of
+	ListActivityTypesMaxWorkflows dynamicconfig.IntPropertyFnWithDomainFilter
+
+	// max number of executions described by a single BatchDescribeWorkflowExecutions call
+	MaxBatchDescribeWorkflowExecutionsSize dynamicconfig.IntPropertyFn
+
+	// log level hinted to activity workers by activity type, see types.PollForActivityTaskResponse.LogLevel
+	ActivityLogLevel dynamicconfig.MapPropertyFn
+
 	// Debugging
 
 	// Emit signal related metrics with signal name tag. Be aware of cardinality.
@@ -176,7 +194,14 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		VisibilityArchivalQueryMaxPageSize:          dc.GetIntProperty(dynamicconfig.VisibilityArchivalQueryMaxPageSize),
 		DisallowQuery:                               dc.GetBoolPropertyFilteredByDomain(dynamicconfig.DisallowQuery),
 		SendRawWorkflowHistory:                      dc.GetBoolPropertyFilteredByDomain(dynamicconfig.SendRawWorkflowHistory),
+		ActivityResultRetention:                     dc.GetDurationPropertyFilteredByDomain(dynamicconfig.FrontendActivityResultRetention),
 		DecisionResultCountLimit:                    dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendDecisionResultCountLimit),
+		ActivityHeartbeatRPS:                        dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendActivityHeartbeatRPS),
+		ActivityHeartbeatQueueTimeout:               dc.GetDurationPropertyFilteredByDomain(dynamicconfig.FrontendActivityHeartbeatQueueTimeout),
+		ActivityHeartbeatRetryAfter:                 dc.GetDurationPropertyFilteredByDomain(dynamicconfig.FrontendActivityHeartbeatRetryAfter),
+		ListActivityTypesMaxWorkflows:               dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendListActivityTypesMaxWorkflows),
+		MaxBatchDescribeWorkflowExecutionsSize:      dc.GetIntProperty(dynamicconfig.MaxBatchDescribeWorkflowExecutionsSize),
+		ActivityLogLevel:                            dc.GetMapProperty(dynamicconfig.FrontendActivityLogLevel),
 		EmitSignalNameMetricsTag:                    dc.GetBoolPropertyFilteredByDomain(dynamicconfig.FrontendEmitSignalNameMetricsTag),
 		Lockdown:                                    dc.GetBoolPropertyFilteredByDomain(dynamicconfig.Lockdown),
 		EnableTasklistIsolation:                     dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableTasklistIsolation),
@@ -98,7 +98,14 @@ func TestNewConfig(t *testing.T) {
 		"VisibilityArchivalQueryMaxPageSize":          {dynamicconfig.VisibilityArchivalQueryMaxPageSize, 38},
 		"DisallowQuery":                               {dynamicconfig.DisallowQuery, true},
 		"SendRawWorkflowHistory":                      {dynamicconfig.SendRawWorkflowHistory, false},
+		"ActivityResultRetention":                     {dynamicconfig.FrontendActivityResultRetention, time.Duration(44)},
 		"DecisionResultCountLimit":                    {dynamicconfig.FrontendDecisionResultCountLimit, 39},
+		"ActivityHeartbeatRPS":                        {dynamicconfig.FrontendActivityHeartbeatRPS, 45},
+		"ActivityHeartbeatQueueTimeout":               {dynamicconfig.FrontendActivityHeartbeatQueueTimeout, time.Duration(45)},
+		"ActivityHeartbeatRetryAfter":                 {dynamicconfig.FrontendActivityHeartbeatRetryAfter, time.Duration(46)},
+		"ListActivityTypesMaxWorkflows":               {dynamicconfig.FrontendListActivityTypesMaxWorkflows, 47},
+		"MaxBatchDescribeWorkflowExecutionsSize":      {dynamicconfig.MaxBatchDescribeWorkflowExecutionsSize, 48},
+		"ActivityLogLevel":                            {dynamicconfig.FrontendActivityLogLevel, map[string]interface{}{"activity": "debug"}},
 		"EmitSignalNameMetricsTag":                    {dynamicconfig.FrontendEmitSignalNameMetricsTag, true},
 		"Lockdown":                                    {dynamicconfig.Lockdown, false},
 		"EnableTasklistIsolation":                     {dynamicconfig.EnableTasklistIsolation, true},
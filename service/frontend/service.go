@@ -29,6 +29,7 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activityencryption"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
@@ -139,7 +140,11 @@ func (s *Service) Start() {
 	)
 
 	// Base handler
-	s.handler = api.NewWorkflowHandler(s, s.config, client.NewVersionChecker(), dh)
+	activityPayloadEncryptor := s.params.ActivityPayloadEncryptor
+	if activityPayloadEncryptor == nil {
+		activityPayloadEncryptor = activityencryption.NewNopEncryptor()
+	}
+	s.handler = api.NewWorkflowHandler(s, s.config, client.NewVersionChecker(), dh, activityPayloadEncryptor)
 
 	collections, err := s.createGlobalQuotaCollections()
 	if err != nil {
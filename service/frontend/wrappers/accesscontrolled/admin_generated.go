@@ -76,6 +76,38 @@ func (a *adminHandler) AddSearchAttribute(ctx context.Context, ap1 *types.AddSea
 	return a.handler.AddSearchAttribute(ctx, ap1)
 }
 
+func (a *adminHandler) BatchTerminate(ctx context.Context, bp1 *types.BatchTerminateRequest) (bp2 *types.BatchTerminateResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "BatchTerminate",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(bp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.BatchTerminate(ctx, bp1)
+}
+
+func (a *adminHandler) CancelPendingActivities(ctx context.Context, cp1 *types.CancelPendingActivitiesRequest) (err error) {
+	attr := &authorization.Attributes{
+		APIName:     "CancelPendingActivities",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(cp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.CancelPendingActivities(ctx, cp1)
+}
+
 func (a *adminHandler) CloseShard(ctx context.Context, cp1 *types.CloseShardRequest) (err error) {
 	attr := &authorization.Attributes{
 		APIName:     "CloseShard",
@@ -108,6 +140,22 @@ func (a *adminHandler) CountDLQMessages(ctx context.Context, cp1 *types.CountDLQ
 	return a.handler.CountDLQMessages(ctx, cp1)
 }
 
+func (a *adminHandler) DecodeTaskToken(ctx context.Context, dp1 *types.DecodeTaskTokenRequest) (dp2 *types.DecodeTaskTokenResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "DecodeTaskToken",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.DecodeTaskToken(ctx, dp1)
+}
+
 func (a *adminHandler) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDeleteWorkflowRequest) (ap2 *types.AdminDeleteWorkflowResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "DeleteWorkflow",
@@ -139,6 +187,22 @@ func (a *adminHandler) DescribeCluster(ctx context.Context) (dp1 *types.Describe
 	return a.handler.DescribeCluster(ctx)
 }
 
+func (a *adminHandler) DescribeBatchTerminate(ctx context.Context, dp1 *types.DescribeBatchTerminateRequest) (dp2 *types.DescribeBatchTerminateResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "DescribeBatchTerminate",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.DescribeBatchTerminate(ctx, dp1)
+}
+
 func (a *adminHandler) DescribeHistoryHost(ctx context.Context, dp1 *types.DescribeHistoryHostRequest) (dp2 *types.DescribeHistoryHostResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "DescribeHistoryHost",
@@ -203,6 +267,22 @@ func (a *adminHandler) DescribeWorkflowExecution(ctx context.Context, ap1 *types
 	return a.handler.DescribeWorkflowExecution(ctx, ap1)
 }
 
+func (a *adminHandler) ExpireActivityHeartbeat(ctx context.Context, ep1 *types.ExpireActivityHeartbeatRequest) (err error) {
+	attr := &authorization.Attributes{
+		APIName:     "ExpireActivityHeartbeat",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(ep1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.ExpireActivityHeartbeat(ctx, ep1)
+}
+
 func (a *adminHandler) GetCrossClusterTasks(ctx context.Context, gp1 *types.GetCrossClusterTasksRequest) (gp2 *types.GetCrossClusterTasksResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "GetCrossClusterTasks",
@@ -443,6 +523,22 @@ func (a *adminHandler) ReapplyEvents(ctx context.Context, rp1 *types.ReapplyEven
 	return a.handler.ReapplyEvents(ctx, rp1)
 }
 
+func (a *adminHandler) RedriveTimedOutActivities(ctx context.Context, rp1 *types.RedriveTimedOutActivitiesRequest) (err error) {
+	attr := &authorization.Attributes{
+		APIName:     "RedriveTimedOutActivities",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(rp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.RedriveTimedOutActivities(ctx, rp1)
+}
+
 func (a *adminHandler) RefreshWorkflowTasks(ctx context.Context, rp1 *types.RefreshWorkflowTasksRequest) (err error) {
 	attr := &authorization.Attributes{
 		APIName:     "RefreshWorkflowTasks",
@@ -475,6 +571,38 @@ func (a *adminHandler) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskRequ
 	return a.handler.RemoveTask(ctx, rp1)
 }
 
+func (a *adminHandler) RemoveTaskListTask(ctx context.Context, rp1 *types.RemoveTaskListTaskRequest) (rp2 *types.RemoveTaskListTaskResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "RemoveTaskListTask",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(rp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.RemoveTaskListTask(ctx, rp1)
+}
+
+func (a *adminHandler) ReplayActivityCompletions(ctx context.Context, rp1 *types.ReplayActivityCompletionsRequest) (rp2 *types.ReplayActivityCompletionsResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "ReplayActivityCompletions",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(rp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.ReplayActivityCompletions(ctx, rp1)
+}
+
 func (a *adminHandler) ResendReplicationTasks(ctx context.Context, rp1 *types.ResendReplicationTasksRequest) (err error) {
 	attr := &authorization.Attributes{
 		APIName:     "ResendReplicationTasks",
@@ -61,6 +61,24 @@ func NewAPIHandler(handler api.Handler, resource resource.Resource, authorizer a
 	}
 }
 
+func (a *apiHandler) BatchDescribeWorkflowExecutions(ctx context.Context, bp1 *types.BatchDescribeWorkflowExecutionsRequest) (bp2 *types.BatchDescribeWorkflowExecutionsResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendBatchDescribeWorkflowExecutionsScope, bp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "BatchDescribeWorkflowExecutions",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(bp1),
+		DomainName:  bp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.BatchDescribeWorkflowExecutions(ctx, bp1)
+}
+
 func (a *apiHandler) CountWorkflowExecutions(ctx context.Context, cp1 *types.CountWorkflowExecutionsRequest) (cp2 *types.CountWorkflowExecutionsResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendCountWorkflowExecutionsScope, cp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -154,6 +172,132 @@ func (a *apiHandler) DiagnoseWorkflowExecution(ctx context.Context, dp1 *types.D
 	return a.handler.DiagnoseWorkflowExecution(ctx, dp1)
 }
 
+func (a *apiHandler) GetActivityAttemptTimings(ctx context.Context, gp1 *types.GetActivityAttemptTimingsRequest) (gp2 *types.GetActivityAttemptTimingsResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendGetActivityAttemptTimingsScope, gp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "GetActivityAttemptTimings",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(gp1),
+		DomainName:  gp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.GetActivityAttemptTimings(ctx, gp1)
+}
+
+func (a *apiHandler) GetActivityEffectiveConfig(ctx context.Context, gp1 *types.GetActivityEffectiveConfigRequest) (gp2 *types.GetActivityEffectiveConfigResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendGetActivityEffectiveConfigScope, gp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "GetActivityEffectiveConfig",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(gp1),
+		DomainName:  gp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.GetActivityEffectiveConfig(ctx, gp1)
+}
+
+func (a *apiHandler) GetActivityNextRetryTime(ctx context.Context, gp1 *types.GetActivityNextRetryTimeRequest) (gp2 *types.GetActivityNextRetryTimeResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendGetActivityNextRetryTimeScope, gp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "GetActivityNextRetryTime",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(gp1),
+		DomainName:  gp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.GetActivityNextRetryTime(ctx, gp1)
+}
+
+func (a *apiHandler) GetActivityRescheduleReasons(ctx context.Context, gp1 *types.GetActivityRescheduleReasonsRequest) (gp2 *types.GetActivityRescheduleReasonsResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendGetActivityRescheduleReasonsScope, gp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "GetActivityRescheduleReasons",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(gp1),
+		DomainName:  gp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.GetActivityRescheduleReasons(ctx, gp1)
+}
+
+func (a *apiHandler) GetActivityRetryStats(ctx context.Context, gp1 *types.GetActivityRetryStatsRequest) (gp2 *types.GetActivityRetryStatsResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendGetActivityRetryStatsScope, gp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "GetActivityRetryStats",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(gp1),
+		DomainName:  gp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.GetActivityRetryStats(ctx, gp1)
+}
+
+func (a *apiHandler) GetActivityTokenValidationFailures(ctx context.Context, gp1 *types.GetActivityTokenValidationFailuresRequest) (gp2 *types.GetActivityTokenValidationFailuresResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendGetActivityTokenValidationFailuresScope, gp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "GetActivityTokenValidationFailures",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(gp1),
+		DomainName:  gp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.GetActivityTokenValidationFailures(ctx, gp1)
+}
+
+func (a *apiHandler) GetActivityWorkerHistory(ctx context.Context, gp1 *types.GetActivityWorkerHistoryRequest) (gp2 *types.GetActivityWorkerHistoryResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendGetActivityWorkerHistoryScope, gp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "GetActivityWorkerHistory",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(gp1),
+		DomainName:  gp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.GetActivityWorkerHistory(ctx, gp1)
+}
+
 func (a *apiHandler) GetClusterInfo(ctx context.Context) (cp1 *types.ClusterInfo, err error) {
 	return a.handler.GetClusterInfo(ctx)
 }
@@ -202,6 +346,24 @@ func (a *apiHandler) Health(ctx context.Context) (hp1 *types.HealthStatus, err e
 	return a.handler.Health(ctx)
 }
 
+func (a *apiHandler) ListActivityTypes(ctx context.Context, lp1 *types.ListActivityTypesRequest) (lp2 *types.ListActivityTypesResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendListActivityTypesScope, lp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "ListActivityTypes",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(lp1),
+		DomainName:  lp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.ListActivityTypes(ctx, lp1)
+}
+
 func (a *apiHandler) ListArchivedWorkflowExecutions(ctx context.Context, lp1 *types.ListArchivedWorkflowExecutionsRequest) (lp2 *types.ListArchivedWorkflowExecutionsResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendListArchivedWorkflowExecutionsScope, lp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -273,6 +435,24 @@ func (a *apiHandler) ListOpenWorkflowExecutions(ctx context.Context, lp1 *types.
 	return a.handler.ListOpenWorkflowExecutions(ctx, lp1)
 }
 
+func (a *apiHandler) ListPendingActivityScheduleAttributes(ctx context.Context, lp1 *types.ListPendingActivityScheduleAttributesRequest) (lp2 *types.ListPendingActivityScheduleAttributesResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendListPendingActivityScheduleAttributesScope, lp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "ListPendingActivityScheduleAttributes",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(lp1),
+		DomainName:  lp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.ListPendingActivityScheduleAttributes(ctx, lp1)
+}
+
 func (a *apiHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendListTaskListPartitionsScope, lp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -408,6 +588,10 @@ func (a *apiHandler) RegisterDomain(ctx context.Context, rp1 *types.RegisterDoma
 	return a.handler.RegisterDomain(ctx, rp1)
 }
 
+func (a *apiHandler) RenewActivityLease(ctx context.Context, rp1 *types.RenewActivityLeaseRequest) (rp2 *types.RenewActivityLeaseResponse, err error) {
+	return a.handler.RenewActivityLease(ctx, rp1)
+}
+
 func (a *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *types.RequestCancelWorkflowExecutionRequest) (err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendRequestCancelWorkflowExecutionScope, rp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -426,6 +610,24 @@ func (a *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *ty
 	return a.handler.RequestCancelWorkflowExecution(ctx, rp1)
 }
 
+func (a *apiHandler) ResetActivityAttempts(ctx context.Context, rp1 *types.ResetActivityAttemptsRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendResetActivityAttemptsScope, rp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "ResetActivityAttempts",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(rp1),
+		DomainName:  rp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.ResetActivityAttempts(ctx, rp1)
+}
+
 func (a *apiHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendResetStickyTaskListScope, rp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -486,6 +688,10 @@ func (a *apiHandler) RespondActivityTaskFailedByID(ctx context.Context, rp1 *typ
 	return a.handler.RespondActivityTaskFailedByID(ctx, rp1)
 }
 
+func (a *apiHandler) RespondActivityTaskNack(ctx context.Context, rp1 *types.RespondActivityTaskNackRequest) (err error) {
+	return a.handler.RespondActivityTaskNack(ctx, rp1)
+}
+
 func (a *apiHandler) RespondDecisionTaskCompleted(ctx context.Context, rp1 *types.RespondDecisionTaskCompletedRequest) (rp2 *types.RespondDecisionTaskCompletedResponse, err error) {
 	return a.handler.RespondDecisionTaskCompleted(ctx, rp1)
 }
@@ -534,6 +740,24 @@ func (a *apiHandler) ScanWorkflowExecutions(ctx context.Context, lp1 *types.List
 	return a.handler.ScanWorkflowExecutions(ctx, lp1)
 }
 
+func (a *apiHandler) SignalActivity(ctx context.Context, sp1 *types.SignalActivityRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendSignalActivityScope, sp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "SignalActivity",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(sp1),
+		DomainName:  sp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.SignalActivity(ctx, sp1)
+}
+
 func (a *apiHandler) SignalWithStartWorkflowExecution(ctx context.Context, sp1 *types.SignalWithStartWorkflowExecutionRequest) (sp2 *types.StartWorkflowExecutionResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendSignalWithStartWorkflowExecutionScope, sp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -660,3 +884,21 @@ func (a *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRe
 	}
 	return a.handler.UpdateDomain(ctx, up1)
 }
+
+func (a *apiHandler) WaitForActivityCompletion(ctx context.Context, wp1 *types.WaitForActivityCompletionRequest) (wp2 *types.WaitForActivityCompletionResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendWaitForActivityCompletionScope, wp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "WaitForActivityCompletion",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(wp1),
+		DomainName:  wp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.WaitForActivityCompletion(ctx, wp1)
+}
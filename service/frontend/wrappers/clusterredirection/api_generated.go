@@ -77,6 +77,10 @@ func NewAPIHandler(
 	}
 }
 
+func (handler *clusterRedirectionHandler) BatchDescribeWorkflowExecutions(ctx context.Context, bp1 *types.BatchDescribeWorkflowExecutionsRequest) (bp2 *types.BatchDescribeWorkflowExecutionsResponse, err error) {
+	return handler.frontendHandler.BatchDescribeWorkflowExecutions(ctx, bp1)
+}
+
 func (handler *clusterRedirectionHandler) CountWorkflowExecutions(ctx context.Context, cp1 *types.CountWorkflowExecutionsRequest) (cp2 *types.CountWorkflowExecutionsResponse, err error) {
 	var apiName = "CountWorkflowExecutions"
 	var cluster string
@@ -161,6 +165,34 @@ func (handler *clusterRedirectionHandler) DiagnoseWorkflowExecution(ctx context.
 	return handler.frontendHandler.DiagnoseWorkflowExecution(ctx, dp1)
 }
 
+func (handler *clusterRedirectionHandler) GetActivityAttemptTimings(ctx context.Context, gp1 *types.GetActivityAttemptTimingsRequest) (gp2 *types.GetActivityAttemptTimingsResponse, err error) {
+	return handler.frontendHandler.GetActivityAttemptTimings(ctx, gp1)
+}
+
+func (handler *clusterRedirectionHandler) GetActivityEffectiveConfig(ctx context.Context, gp1 *types.GetActivityEffectiveConfigRequest) (gp2 *types.GetActivityEffectiveConfigResponse, err error) {
+	return handler.frontendHandler.GetActivityEffectiveConfig(ctx, gp1)
+}
+
+func (handler *clusterRedirectionHandler) GetActivityNextRetryTime(ctx context.Context, gp1 *types.GetActivityNextRetryTimeRequest) (gp2 *types.GetActivityNextRetryTimeResponse, err error) {
+	return handler.frontendHandler.GetActivityNextRetryTime(ctx, gp1)
+}
+
+func (handler *clusterRedirectionHandler) GetActivityRescheduleReasons(ctx context.Context, gp1 *types.GetActivityRescheduleReasonsRequest) (gp2 *types.GetActivityRescheduleReasonsResponse, err error) {
+	return handler.frontendHandler.GetActivityRescheduleReasons(ctx, gp1)
+}
+
+func (handler *clusterRedirectionHandler) GetActivityRetryStats(ctx context.Context, gp1 *types.GetActivityRetryStatsRequest) (gp2 *types.GetActivityRetryStatsResponse, err error) {
+	return handler.frontendHandler.GetActivityRetryStats(ctx, gp1)
+}
+
+func (handler *clusterRedirectionHandler) GetActivityTokenValidationFailures(ctx context.Context, gp1 *types.GetActivityTokenValidationFailuresRequest) (gp2 *types.GetActivityTokenValidationFailuresResponse, err error) {
+	return handler.frontendHandler.GetActivityTokenValidationFailures(ctx, gp1)
+}
+
+func (handler *clusterRedirectionHandler) GetActivityWorkerHistory(ctx context.Context, gp1 *types.GetActivityWorkerHistoryRequest) (gp2 *types.GetActivityWorkerHistoryResponse, err error) {
+	return handler.frontendHandler.GetActivityWorkerHistory(ctx, gp1)
+}
+
 func (handler *clusterRedirectionHandler) GetClusterInfo(ctx context.Context) (cp1 *types.ClusterInfo, err error) {
 	return handler.frontendHandler.GetClusterInfo(ctx)
 }
@@ -221,6 +253,10 @@ func (handler *clusterRedirectionHandler) Health(ctx context.Context) (hp1 *type
 	return handler.frontendHandler.Health(ctx)
 }
 
+func (handler *clusterRedirectionHandler) ListActivityTypes(ctx context.Context, lp1 *types.ListActivityTypesRequest) (lp2 *types.ListActivityTypesResponse, err error) {
+	return handler.frontendHandler.ListActivityTypes(ctx, lp1)
+}
+
 func (handler *clusterRedirectionHandler) ListArchivedWorkflowExecutions(ctx context.Context, lp1 *types.ListArchivedWorkflowExecutionsRequest) (lp2 *types.ListArchivedWorkflowExecutionsResponse, err error) {
 	var apiName = "ListArchivedWorkflowExecutions"
 	var cluster string
@@ -297,6 +333,10 @@ func (handler *clusterRedirectionHandler) ListOpenWorkflowExecutions(ctx context
 	return lp2, err
 }
 
+func (handler *clusterRedirectionHandler) ListPendingActivityScheduleAttributes(ctx context.Context, lp1 *types.ListPendingActivityScheduleAttributesRequest) (lp2 *types.ListPendingActivityScheduleAttributesResponse, err error) {
+	return handler.frontendHandler.ListPendingActivityScheduleAttributes(ctx, lp1)
+}
+
 func (handler *clusterRedirectionHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	var apiName = "ListTaskListPartitions"
 	var cluster string
@@ -475,6 +515,10 @@ func (handler *clusterRedirectionHandler) RegisterDomain(ctx context.Context, rp
 	return handler.frontendHandler.RegisterDomain(ctx, rp1)
 }
 
+func (handler *clusterRedirectionHandler) RenewActivityLease(ctx context.Context, rp1 *types.RenewActivityLeaseRequest) (rp2 *types.RenewActivityLeaseResponse, err error) {
+	return handler.frontendHandler.RenewActivityLease(ctx, rp1)
+}
+
 func (handler *clusterRedirectionHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *types.RequestCancelWorkflowExecutionRequest) (err error) {
 	var apiName = "RequestCancelWorkflowExecution"
 	var cluster string
@@ -499,6 +543,10 @@ func (handler *clusterRedirectionHandler) RequestCancelWorkflowExecution(ctx con
 	return err
 }
 
+func (handler *clusterRedirectionHandler) ResetActivityAttempts(ctx context.Context, rp1 *types.ResetActivityAttemptsRequest) (err error) {
+	return handler.frontendHandler.ResetActivityAttempts(ctx, rp1)
+}
+
 func (handler *clusterRedirectionHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	var apiName = "ResetStickyTaskList"
 	var cluster string
@@ -709,6 +757,10 @@ func (handler *clusterRedirectionHandler) RespondActivityTaskFailedByID(ctx cont
 	return err
 }
 
+func (handler *clusterRedirectionHandler) RespondActivityTaskNack(ctx context.Context, rp1 *types.RespondActivityTaskNackRequest) (err error) {
+	return handler.frontendHandler.RespondActivityTaskNack(ctx, rp1)
+}
+
 func (handler *clusterRedirectionHandler) RespondDecisionTaskCompleted(ctx context.Context, rp1 *types.RespondDecisionTaskCompletedRequest) (rp2 *types.RespondDecisionTaskCompletedResponse, err error) {
 	var apiName = "RespondDecisionTaskCompleted"
 	var cluster string
@@ -847,6 +899,10 @@ func (handler *clusterRedirectionHandler) ScanWorkflowExecutions(ctx context.Con
 	return lp2, err
 }
 
+func (handler *clusterRedirectionHandler) SignalActivity(ctx context.Context, sp1 *types.SignalActivityRequest) (err error) {
+	return handler.frontendHandler.SignalActivity(ctx, sp1)
+}
+
 func (handler *clusterRedirectionHandler) SignalWithStartWorkflowExecution(ctx context.Context, sp1 *types.SignalWithStartWorkflowExecutionRequest) (sp2 *types.StartWorkflowExecutionResponse, err error) {
 	var apiName = "SignalWithStartWorkflowExecution"
 	var cluster string
@@ -995,6 +1051,10 @@ func (handler *clusterRedirectionHandler) UpdateDomain(ctx context.Context, up1
 	return handler.frontendHandler.UpdateDomain(ctx, up1)
 }
 
+func (handler *clusterRedirectionHandler) WaitForActivityCompletion(ctx context.Context, wp1 *types.WaitForActivityCompletionRequest) (wp2 *types.WaitForActivityCompletionResponse, err error) {
+	return handler.frontendHandler.WaitForActivityCompletion(ctx, wp1)
+}
+
 func (handler *clusterRedirectionHandler) QueryWorkflow(
 	ctx context.Context,
 	request *types.QueryWorkflowRequest,
@@ -31,6 +31,7 @@ import (
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activityencryption"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
@@ -102,7 +103,7 @@ func (s *clusterRedirectionHandlerSuite) SetupTest() {
 		"hostname",
 	)
 	dh := domain.NewMockHandler(s.controller)
-	frontendHandler := api.NewWorkflowHandler(s.mockResource, s.config, client.NewVersionChecker(), dh)
+	frontendHandler := api.NewWorkflowHandler(s.mockResource, s.config, client.NewVersionChecker(), dh, activityencryption.NewNopEncryptor())
 
 	s.mockFrontendHandler = api.NewMockHandler(s.controller)
 	s.handler = NewAPIHandler(frontendHandler, s.mockResource, s.config, config.ClusterRedirectionPolicy{}).(*clusterRedirectionHandler)
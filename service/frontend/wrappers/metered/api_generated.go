@@ -61,6 +61,23 @@ func NewAPIHandler(handler api.Handler, logger log.Logger, metricsClient metrics
 	}
 }
 
+func (h *apiHandler) BatchDescribeWorkflowExecutions(ctx context.Context, bp1 *types.BatchDescribeWorkflowExecutionsRequest) (bp2 *types.BatchDescribeWorkflowExecutionsResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("BatchDescribeWorkflowExecutions")}
+	tags = append(tags, toBatchDescribeWorkflowExecutionsRequestTags(bp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendBatchDescribeWorkflowExecutionsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(bp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	bp2, err = h.handler.BatchDescribeWorkflowExecutions(ctx, bp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return bp2, err
+}
+
 func (h *apiHandler) CountWorkflowExecutions(ctx context.Context, cp1 *types.CountWorkflowExecutionsRequest) (cp2 *types.CountWorkflowExecutionsResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("CountWorkflowExecutions")}
@@ -155,6 +172,118 @@ func (h *apiHandler) DiagnoseWorkflowExecution(ctx context.Context, dp1 *types.D
 	}
 	return dp2, err
 }
+func (h *apiHandler) GetActivityAttemptTimings(ctx context.Context, gp1 *types.GetActivityAttemptTimingsRequest) (gp2 *types.GetActivityAttemptTimingsResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("GetActivityAttemptTimings")}
+	tags = append(tags, toGetActivityAttemptTimingsRequestTags(gp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendGetActivityAttemptTimingsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(gp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	gp2, err = h.handler.GetActivityAttemptTimings(ctx, gp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return gp2, err
+}
+func (h *apiHandler) GetActivityEffectiveConfig(ctx context.Context, gp1 *types.GetActivityEffectiveConfigRequest) (gp2 *types.GetActivityEffectiveConfigResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("GetActivityEffectiveConfig")}
+	tags = append(tags, toGetActivityEffectiveConfigRequestTags(gp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendGetActivityEffectiveConfigScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(gp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	gp2, err = h.handler.GetActivityEffectiveConfig(ctx, gp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return gp2, err
+}
+func (h *apiHandler) GetActivityNextRetryTime(ctx context.Context, gp1 *types.GetActivityNextRetryTimeRequest) (gp2 *types.GetActivityNextRetryTimeResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("GetActivityNextRetryTime")}
+	tags = append(tags, toGetActivityNextRetryTimeRequestTags(gp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendGetActivityNextRetryTimeScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(gp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	gp2, err = h.handler.GetActivityNextRetryTime(ctx, gp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return gp2, err
+}
+func (h *apiHandler) GetActivityRescheduleReasons(ctx context.Context, gp1 *types.GetActivityRescheduleReasonsRequest) (gp2 *types.GetActivityRescheduleReasonsResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("GetActivityRescheduleReasons")}
+	tags = append(tags, toGetActivityRescheduleReasonsRequestTags(gp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendGetActivityRescheduleReasonsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(gp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	gp2, err = h.handler.GetActivityRescheduleReasons(ctx, gp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return gp2, err
+}
+func (h *apiHandler) GetActivityRetryStats(ctx context.Context, gp1 *types.GetActivityRetryStatsRequest) (gp2 *types.GetActivityRetryStatsResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("GetActivityRetryStats")}
+	tags = append(tags, toGetActivityRetryStatsRequestTags(gp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendGetActivityRetryStatsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(gp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	gp2, err = h.handler.GetActivityRetryStats(ctx, gp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return gp2, err
+}
+func (h *apiHandler) GetActivityTokenValidationFailures(ctx context.Context, gp1 *types.GetActivityTokenValidationFailuresRequest) (gp2 *types.GetActivityTokenValidationFailuresResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("GetActivityTokenValidationFailures")}
+	tags = append(tags, toGetActivityTokenValidationFailuresRequestTags(gp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendGetActivityTokenValidationFailuresScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(gp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	gp2, err = h.handler.GetActivityTokenValidationFailures(ctx, gp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return gp2, err
+}
+func (h *apiHandler) GetActivityWorkerHistory(ctx context.Context, gp1 *types.GetActivityWorkerHistoryRequest) (gp2 *types.GetActivityWorkerHistoryResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("GetActivityWorkerHistory")}
+	tags = append(tags, toGetActivityWorkerHistoryRequestTags(gp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendGetActivityWorkerHistoryScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(gp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	gp2, err = h.handler.GetActivityWorkerHistory(ctx, gp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return gp2, err
+}
 func (h *apiHandler) GetClusterInfo(ctx context.Context) (cp1 *types.ClusterInfo, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("GetClusterInfo")}
@@ -220,6 +349,22 @@ func (h *apiHandler) GetWorkflowExecutionHistory(ctx context.Context, gp1 *types
 func (h *apiHandler) Health(ctx context.Context) (hp1 *types.HealthStatus, err error) {
 	return h.handler.Health(ctx)
 }
+func (h *apiHandler) ListActivityTypes(ctx context.Context, lp1 *types.ListActivityTypesRequest) (lp2 *types.ListActivityTypesResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("ListActivityTypes")}
+	tags = append(tags, toListActivityTypesRequestTags(lp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendListActivityTypesScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(lp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	lp2, err = h.handler.ListActivityTypes(ctx, lp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return lp2, err
+}
 func (h *apiHandler) ListArchivedWorkflowExecutions(ctx context.Context, lp1 *types.ListArchivedWorkflowExecutionsRequest) (lp2 *types.ListArchivedWorkflowExecutionsResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ListArchivedWorkflowExecutions")}
@@ -283,6 +428,22 @@ func (h *apiHandler) ListOpenWorkflowExecutions(ctx context.Context, lp1 *types.
 	}
 	return lp2, err
 }
+func (h *apiHandler) ListPendingActivityScheduleAttributes(ctx context.Context, lp1 *types.ListPendingActivityScheduleAttributesRequest) (lp2 *types.ListPendingActivityScheduleAttributesResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("ListPendingActivityScheduleAttributes")}
+	tags = append(tags, toListPendingActivityScheduleAttributesRequestTags(lp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendListPendingActivityScheduleAttributesScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(lp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	lp2, err = h.handler.ListPendingActivityScheduleAttributes(ctx, lp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return lp2, err
+}
 func (h *apiHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ListTaskListPartitions")}
@@ -438,6 +599,31 @@ func (h *apiHandler) RegisterDomain(ctx context.Context, rp1 *types.RegisterDoma
 	}
 	return err
 }
+func (h *apiHandler) RenewActivityLease(ctx context.Context, rp1 *types.RenewActivityLeaseRequest) (rp2 *types.RenewActivityLeaseResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("RenewActivityLease")}
+	token, err := h.tokenSerializer.Deserialize(rp1.TaskToken)
+	if err != nil {
+		return
+	}
+	domainName, err := h.domainCache.GetDomainName(token.DomainID)
+	if err != nil {
+		return
+	}
+	tags = append(tags, tag.WorkflowDomainName(domainName), tag.WorkflowID(token.WorkflowID), tag.WorkflowRunID(token.RunID))
+	scope := h.metricsClient.Scope(metrics.FrontendRenewActivityLeaseScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(domainName))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	rp2, err = h.handler.RenewActivityLease(ctx, rp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return rp2, err
+}
+
 func (h *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *types.RequestCancelWorkflowExecutionRequest) (err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("RequestCancelWorkflowExecution")}
@@ -454,6 +640,22 @@ func (h *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *ty
 	}
 	return err
 }
+func (h *apiHandler) ResetActivityAttempts(ctx context.Context, rp1 *types.ResetActivityAttemptsRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("ResetActivityAttempts")}
+	tags = append(tags, toResetActivityAttemptsRequestTags(rp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendResetActivityAttemptsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(rp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.ResetActivityAttempts(ctx, rp1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
 func (h *apiHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ResetStickyTaskList")}
@@ -606,6 +808,30 @@ func (h *apiHandler) RespondActivityTaskFailedByID(ctx context.Context, rp1 *typ
 	}
 	return err
 }
+func (h *apiHandler) RespondActivityTaskNack(ctx context.Context, rp1 *types.RespondActivityTaskNackRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("RespondActivityTaskNack")}
+	token, err := h.tokenSerializer.Deserialize(rp1.TaskToken)
+	if err != nil {
+		return
+	}
+	domainName, err := h.domainCache.GetDomainName(token.DomainID)
+	if err != nil {
+		return
+	}
+	tags = append(tags, tag.WorkflowDomainName(domainName), tag.WorkflowID(token.WorkflowID), tag.WorkflowRunID(token.RunID))
+	scope := h.metricsClient.Scope(metrics.FrontendRespondActivityTaskNackScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(domainName))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.RespondActivityTaskNack(ctx, rp1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
 func (h *apiHandler) RespondDecisionTaskCompleted(ctx context.Context, rp1 *types.RespondDecisionTaskCompletedRequest) (rp2 *types.RespondDecisionTaskCompletedResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("RespondDecisionTaskCompleted")}
@@ -710,6 +936,22 @@ func (h *apiHandler) ScanWorkflowExecutions(ctx context.Context, lp1 *types.List
 	}
 	return lp2, err
 }
+func (h *apiHandler) SignalActivity(ctx context.Context, sp1 *types.SignalActivityRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("SignalActivity")}
+	tags = append(tags, toSignalActivityRequestTags(sp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendSignalActivityScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(sp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.SignalActivity(ctx, sp1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
 func (h *apiHandler) SignalWithStartWorkflowExecution(ctx context.Context, sp1 *types.SignalWithStartWorkflowExecutionRequest) (sp2 *types.StartWorkflowExecutionResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("SignalWithStartWorkflowExecution")}
@@ -822,3 +1064,20 @@ func (h *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRe
 	}
 	return up2, err
 }
+
+func (h *apiHandler) WaitForActivityCompletion(ctx context.Context, wp1 *types.WaitForActivityCompletionRequest) (wp2 *types.WaitForActivityCompletionResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("WaitForActivityCompletion")}
+	tags = append(tags, toWaitForActivityCompletionRequestTags(wp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendWaitForActivityCompletionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(wp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	wp2, err = h.handler.WaitForActivityCompletion(ctx, wp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return wp2, err
+}
@@ -108,6 +108,12 @@ func frontendInternalServiceError(fmtStr string, args ...interface{}) error {
 	return fmt.Errorf(fmtStr, args...)
 }
 
+func toBatchDescribeWorkflowExecutionsRequestTags(req *types.BatchDescribeWorkflowExecutionsRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
+
 func toCountWorkflowExecutionsRequestTags(req *types.CountWorkflowExecutionsRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
@@ -139,6 +145,58 @@ func toDiagnoseWorkflowExecutionRequestTags(req *types.DiagnoseWorkflowExecution
 	}
 }
 
+func toGetActivityAttemptTimingsRequestTags(req *types.GetActivityAttemptTimingsRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+	}
+}
+
+func toGetActivityEffectiveConfigRequestTags(req *types.GetActivityEffectiveConfigRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+	}
+}
+
+func toGetActivityNextRetryTimeRequestTags(req *types.GetActivityNextRetryTimeRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+	}
+}
+
+func toGetActivityRescheduleReasonsRequestTags(req *types.GetActivityRescheduleReasonsRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+	}
+}
+
+func toGetActivityRetryStatsRequestTags(req *types.GetActivityRetryStatsRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
+
+func toGetActivityTokenValidationFailuresRequestTags(req *types.GetActivityTokenValidationFailuresRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
+
+func toGetActivityWorkerHistoryRequestTags(req *types.GetActivityWorkerHistoryRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+	}
+}
+
 func toGetTaskListsByDomainRequestTags(req *types.GetTaskListsByDomainRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
@@ -153,6 +211,12 @@ func toGetWorkflowExecutionHistoryRequestTags(req *types.GetWorkflowExecutionHis
 	}
 }
 
+func toListActivityTypesRequestTags(req *types.ListActivityTypesRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
+
 func toListArchivedWorkflowExecutionsRequestTags(req *types.ListArchivedWorkflowExecutionsRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
@@ -171,6 +235,14 @@ func toListOpenWorkflowExecutionsRequestTags(req *types.ListOpenWorkflowExecutio
 	}
 }
 
+func toListPendingActivityScheduleAttributesRequestTags(req *types.ListPendingActivityScheduleAttributesRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetExecution().GetRunID()),
+	}
+}
+
 func toListTaskListPartitionsRequestTags(req *types.ListTaskListPartitionsRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
@@ -233,6 +305,14 @@ func toRequestCancelWorkflowExecutionRequestTags(req *types.RequestCancelWorkflo
 	}
 }
 
+func toResetActivityAttemptsRequestTags(req *types.ResetActivityAttemptsRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+	}
+}
+
 func toResetStickyTaskListRequestTags(req *types.ResetStickyTaskListRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
@@ -281,6 +361,15 @@ func toRestartWorkflowExecutionRequestTags(req *types.RestartWorkflowExecutionRe
 	}
 }
 
+func toSignalActivityRequestTags(req *types.SignalActivityRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+		tag.WorkflowSignalName(req.GetSignalName()),
+	}
+}
+
 func toSignalWithStartWorkflowExecutionRequestTags(req *types.SignalWithStartWorkflowExecutionRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
@@ -334,6 +423,15 @@ func toTerminateWorkflowExecutionRequestTags(req *types.TerminateWorkflowExecuti
 	}
 }
 
+func toWaitForActivityCompletionRequestTags(req *types.WaitForActivityCompletionRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetExecution().GetRunID()),
+		tag.WorkflowActivityID(req.GetActivityID()),
+	}
+}
+
 func toScanWorkflowExecutionsRequestTags(req *types.ListWorkflowExecutionsRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
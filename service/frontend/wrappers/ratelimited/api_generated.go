@@ -68,6 +68,22 @@ func NewAPIHandler(
 	}
 }
 
+func (h *apiHandler) BatchDescribeWorkflowExecutions(ctx context.Context, bp1 *types.BatchDescribeWorkflowExecutionsRequest) (bp2 *types.BatchDescribeWorkflowExecutionsResponse, err error) {
+	if bp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if bp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, bp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.BatchDescribeWorkflowExecutions(ctx, bp1)
+}
+
 func (h *apiHandler) CountWorkflowExecutions(ctx context.Context, cp1 *types.CountWorkflowExecutionsRequest) (cp2 *types.CountWorkflowExecutionsResponse, err error) {
 	if cp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -140,6 +156,118 @@ func (h *apiHandler) DiagnoseWorkflowExecution(ctx context.Context, dp1 *types.D
 	return h.wrapped.DiagnoseWorkflowExecution(ctx, dp1)
 }
 
+func (h *apiHandler) GetActivityAttemptTimings(ctx context.Context, gp1 *types.GetActivityAttemptTimingsRequest) (gp2 *types.GetActivityAttemptTimingsResponse, err error) {
+	if gp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if gp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, gp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.GetActivityAttemptTimings(ctx, gp1)
+}
+
+func (h *apiHandler) GetActivityEffectiveConfig(ctx context.Context, gp1 *types.GetActivityEffectiveConfigRequest) (gp2 *types.GetActivityEffectiveConfigResponse, err error) {
+	if gp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if gp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, gp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.GetActivityEffectiveConfig(ctx, gp1)
+}
+
+func (h *apiHandler) GetActivityNextRetryTime(ctx context.Context, gp1 *types.GetActivityNextRetryTimeRequest) (gp2 *types.GetActivityNextRetryTimeResponse, err error) {
+	if gp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if gp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, gp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.GetActivityNextRetryTime(ctx, gp1)
+}
+
+func (h *apiHandler) GetActivityRescheduleReasons(ctx context.Context, gp1 *types.GetActivityRescheduleReasonsRequest) (gp2 *types.GetActivityRescheduleReasonsResponse, err error) {
+	if gp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if gp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, gp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.GetActivityRescheduleReasons(ctx, gp1)
+}
+
+func (h *apiHandler) GetActivityRetryStats(ctx context.Context, gp1 *types.GetActivityRetryStatsRequest) (gp2 *types.GetActivityRetryStatsResponse, err error) {
+	if gp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if gp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, gp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.GetActivityRetryStats(ctx, gp1)
+}
+
+func (h *apiHandler) GetActivityTokenValidationFailures(ctx context.Context, gp1 *types.GetActivityTokenValidationFailuresRequest) (gp2 *types.GetActivityTokenValidationFailuresResponse, err error) {
+	if gp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if gp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, gp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.GetActivityTokenValidationFailures(ctx, gp1)
+}
+
+func (h *apiHandler) GetActivityWorkerHistory(ctx context.Context, gp1 *types.GetActivityWorkerHistoryRequest) (gp2 *types.GetActivityWorkerHistoryResponse, err error) {
+	if gp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if gp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, gp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.GetActivityWorkerHistory(ctx, gp1)
+}
+
 func (h *apiHandler) GetClusterInfo(ctx context.Context) (cp1 *types.ClusterInfo, err error) {
 	return h.wrapped.GetClusterInfo(ctx)
 }
@@ -184,6 +312,22 @@ func (h *apiHandler) Health(ctx context.Context) (hp1 *types.HealthStatus, err e
 	return h.wrapped.Health(ctx)
 }
 
+func (h *apiHandler) ListActivityTypes(ctx context.Context, lp1 *types.ListActivityTypesRequest) (lp2 *types.ListActivityTypesResponse, err error) {
+	if lp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if lp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeVisibility, lp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.ListActivityTypes(ctx, lp1)
+}
+
 func (h *apiHandler) ListArchivedWorkflowExecutions(ctx context.Context, lp1 *types.ListArchivedWorkflowExecutionsRequest) (lp2 *types.ListArchivedWorkflowExecutionsResponse, err error) {
 	if lp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -236,6 +380,22 @@ func (h *apiHandler) ListOpenWorkflowExecutions(ctx context.Context, lp1 *types.
 	return h.wrapped.ListOpenWorkflowExecutions(ctx, lp1)
 }
 
+func (h *apiHandler) ListPendingActivityScheduleAttributes(ctx context.Context, lp1 *types.ListPendingActivityScheduleAttributesRequest) (lp2 *types.ListPendingActivityScheduleAttributesResponse, err error) {
+	if lp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if lp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, lp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.ListPendingActivityScheduleAttributes(ctx, lp1)
+}
+
 func (h *apiHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	if lp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -378,6 +538,33 @@ func (h *apiHandler) RegisterDomain(ctx context.Context, rp1 *types.RegisterDoma
 	return h.wrapped.RegisterDomain(ctx, rp1)
 }
 
+func (h *apiHandler) RenewActivityLease(ctx context.Context, rp1 *types.RenewActivityLeaseRequest) (rp2 *types.RenewActivityLeaseResponse, err error) {
+	if rp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if rp1.TaskToken == nil {
+		err = validate.ErrTaskTokenNotSet
+		return
+	}
+	token, err := h.tokenSerializer.Deserialize(rp1.TaskToken)
+	if err != nil {
+		return
+	}
+	if token.DomainID == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	domainName, err := h.domainCache.GetDomainName(token.DomainID)
+	if err != nil {
+		return
+	}
+	// Count the request in the host RPS,
+	// but we still accept it even if RPS is exceeded
+	h.allowDomain(ratelimitTypeWorker, domainName)
+	return h.wrapped.RenewActivityLease(ctx, rp1)
+}
+
 func (h *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *types.RequestCancelWorkflowExecutionRequest) (err error) {
 	if rp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -394,6 +581,22 @@ func (h *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *ty
 	return h.wrapped.RequestCancelWorkflowExecution(ctx, rp1)
 }
 
+func (h *apiHandler) ResetActivityAttempts(ctx context.Context, rp1 *types.ResetActivityAttemptsRequest) (err error) {
+	if rp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if rp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, rp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.ResetActivityAttempts(ctx, rp1)
+}
+
 func (h *apiHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	if rp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -551,6 +754,33 @@ func (h *apiHandler) RespondActivityTaskFailedByID(ctx context.Context, rp1 *typ
 	return h.wrapped.RespondActivityTaskFailedByID(ctx, rp1)
 }
 
+func (h *apiHandler) RespondActivityTaskNack(ctx context.Context, rp1 *types.RespondActivityTaskNackRequest) (err error) {
+	if rp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if rp1.TaskToken == nil {
+		err = validate.ErrTaskTokenNotSet
+		return
+	}
+	token, err := h.tokenSerializer.Deserialize(rp1.TaskToken)
+	if err != nil {
+		return
+	}
+	if token.DomainID == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	domainName, err := h.domainCache.GetDomainName(token.DomainID)
+	if err != nil {
+		return
+	}
+	// Count the request in the host RPS,
+	// but we still accept it even if RPS is exceeded
+	h.allowDomain(ratelimitTypeWorker, domainName)
+	return h.wrapped.RespondActivityTaskNack(ctx, rp1)
+}
+
 func (h *apiHandler) RespondDecisionTaskCompleted(ctx context.Context, rp1 *types.RespondDecisionTaskCompletedRequest) (rp2 *types.RespondDecisionTaskCompletedResponse, err error) {
 	if rp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -664,6 +894,22 @@ func (h *apiHandler) ScanWorkflowExecutions(ctx context.Context, lp1 *types.List
 	return h.wrapped.ScanWorkflowExecutions(ctx, lp1)
 }
 
+func (h *apiHandler) SignalActivity(ctx context.Context, sp1 *types.SignalActivityRequest) (err error) {
+	if sp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if sp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, sp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.SignalActivity(ctx, sp1)
+}
+
 func (h *apiHandler) SignalWithStartWorkflowExecution(ctx context.Context, sp1 *types.SignalWithStartWorkflowExecutionRequest) (sp2 *types.StartWorkflowExecutionResponse, err error) {
 	if sp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -763,3 +1009,19 @@ func (h *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.
 func (h *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	return h.wrapped.UpdateDomain(ctx, up1)
 }
+
+func (h *apiHandler) WaitForActivityCompletion(ctx context.Context, wp1 *types.WaitForActivityCompletionRequest) (wp2 *types.WaitForActivityCompletionResponse, err error) {
+	if wp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if wp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, wp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.WaitForActivityCompletion(ctx, wp1)
+}
@@ -40,16 +40,36 @@ func (g AdminHandler) AddSearchAttribute(ctx context.Context, Request *admin.Add
 	return thrift.FromError(err)
 }
 
+func (g AdminHandler) BatchTerminate(ctx context.Context, Request *admin.BatchTerminateRequest) (bp1 *admin.BatchTerminateResponse, err error) {
+	response, err := g.h.BatchTerminate(ctx, thrift.ToAdminBatchTerminateRequest(Request))
+	return thrift.FromAdminBatchTerminateResponse(response), thrift.FromError(err)
+}
+
+func (g AdminHandler) CancelPendingActivities(ctx context.Context, Request *shared.CancelPendingActivitiesRequest) (err error) {
+	err = g.h.CancelPendingActivities(ctx, thrift.ToAdminCancelPendingActivitiesRequest(Request))
+	return thrift.FromError(err)
+}
+
 func (g AdminHandler) CloseShard(ctx context.Context, Request *shared.CloseShardRequest) (err error) {
 	err = g.h.CloseShard(ctx, thrift.ToAdminCloseShardRequest(Request))
 	return thrift.FromError(err)
 }
 
+func (g AdminHandler) DecodeTaskToken(ctx context.Context, Request *admin.DecodeTaskTokenRequest) (dp1 *admin.DecodeTaskTokenResponse, err error) {
+	response, err := g.h.DecodeTaskToken(ctx, thrift.ToAdminDecodeTaskTokenRequest(Request))
+	return thrift.FromAdminDecodeTaskTokenResponse(response), thrift.FromError(err)
+}
+
 func (g AdminHandler) DeleteWorkflow(ctx context.Context, Request *admin.AdminDeleteWorkflowRequest) (ap1 *admin.AdminDeleteWorkflowResponse, err error) {
 	response, err := g.h.DeleteWorkflow(ctx, thrift.ToAdminDeleteWorkflowRequest(Request))
 	return thrift.FromAdminDeleteWorkflowResponse(response), thrift.FromError(err)
 }
 
+func (g AdminHandler) DescribeBatchTerminate(ctx context.Context, Request *admin.DescribeBatchTerminateRequest) (dp1 *admin.DescribeBatchTerminateResponse, err error) {
+	response, err := g.h.DescribeBatchTerminate(ctx, thrift.ToAdminDescribeBatchTerminateRequest(Request))
+	return thrift.FromAdminDescribeBatchTerminateResponse(response), thrift.FromError(err)
+}
+
 func (g AdminHandler) DescribeCluster(ctx context.Context) (dp1 *admin.DescribeClusterResponse, err error) {
 	response, err := g.h.DescribeCluster(ctx)
 	return thrift.FromAdminDescribeClusterResponse(response), thrift.FromError(err)
@@ -75,6 +95,11 @@ func (g AdminHandler) DescribeWorkflowExecution(ctx context.Context, Request *ad
 	return thrift.FromAdminDescribeWorkflowExecutionResponse(response), thrift.FromError(err)
 }
 
+func (g AdminHandler) ExpireActivityHeartbeat(ctx context.Context, Request *shared.ExpireActivityHeartbeatRequest) (err error) {
+	err = g.h.ExpireActivityHeartbeat(ctx, thrift.ToAdminExpireActivityHeartbeatRequest(Request))
+	return thrift.FromError(err)
+}
+
 func (g AdminHandler) GetCrossClusterTasks(ctx context.Context, Request *shared.GetCrossClusterTasksRequest) (gp1 *shared.GetCrossClusterTasksResponse, err error) {
 	response, err := g.h.GetCrossClusterTasks(ctx, thrift.ToAdminGetCrossClusterTasksRequest(Request))
 	return thrift.FromAdminGetCrossClusterTasksResponse(response), thrift.FromError(err)
@@ -150,6 +175,11 @@ func (g AdminHandler) ReapplyEvents(ctx context.Context, ReapplyEventsRequest *s
 	return thrift.FromError(err)
 }
 
+func (g AdminHandler) RedriveTimedOutActivities(ctx context.Context, Request *shared.RedriveTimedOutActivitiesRequest) (err error) {
+	err = g.h.RedriveTimedOutActivities(ctx, thrift.ToAdminRedriveTimedOutActivitiesRequest(Request))
+	return thrift.FromError(err)
+}
+
 func (g AdminHandler) RefreshWorkflowTasks(ctx context.Context, Request *shared.RefreshWorkflowTasksRequest) (err error) {
 	err = g.h.RefreshWorkflowTasks(ctx, thrift.ToAdminRefreshWorkflowTasksRequest(Request))
 	return thrift.FromError(err)
@@ -160,6 +190,16 @@ func (g AdminHandler) RemoveTask(ctx context.Context, Request *shared.RemoveTask
 	return thrift.FromError(err)
 }
 
+func (g AdminHandler) RemoveTaskListTask(ctx context.Context, Request *admin.RemoveTaskListTaskRequest) (rp1 *admin.RemoveTaskListTaskResponse, err error) {
+	response, err := g.h.RemoveTaskListTask(ctx, thrift.ToAdminRemoveTaskListTaskRequest(Request))
+	return thrift.FromAdminRemoveTaskListTaskResponse(response), thrift.FromError(err)
+}
+
+func (g AdminHandler) ReplayActivityCompletions(ctx context.Context, Request *shared.ReplayActivityCompletionsRequest) (rp1 *shared.ReplayActivityCompletionsResponse, err error) {
+	response, err := g.h.ReplayActivityCompletions(ctx, thrift.ToAdminReplayActivityCompletionsRequest(Request))
+	return thrift.FromAdminReplayActivityCompletionsResponse(response), thrift.FromError(err)
+}
+
 func (g AdminHandler) ResendReplicationTasks(ctx context.Context, Request *admin.ResendReplicationTasksRequest) (err error) {
 	err = g.h.ResendReplicationTasks(ctx, thrift.ToAdminResendReplicationTasksRequest(Request))
 	return thrift.FromError(err)
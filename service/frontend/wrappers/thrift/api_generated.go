@@ -33,6 +33,11 @@ import (
 	"github.com/uber/cadence/common/types/mapper/thrift"
 )
 
+func (g APIHandler) BatchDescribeWorkflowExecutions(ctx context.Context, DescribeRequest *shared.BatchDescribeWorkflowExecutionsRequest) (bp1 *shared.BatchDescribeWorkflowExecutionsResponse, err error) {
+	response, err := g.h.BatchDescribeWorkflowExecutions(ctx, thrift.ToBatchDescribeWorkflowExecutionsRequest(DescribeRequest))
+	return thrift.FromBatchDescribeWorkflowExecutionsResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) CountWorkflowExecutions(ctx context.Context, CountRequest *shared.CountWorkflowExecutionsRequest) (cp1 *shared.CountWorkflowExecutionsResponse, err error) {
 	response, err := g.h.CountWorkflowExecutions(ctx, thrift.ToCountWorkflowExecutionsRequest(CountRequest))
 	return thrift.FromCountWorkflowExecutionsResponse(response), thrift.FromError(err)
@@ -63,6 +68,41 @@ func (g APIHandler) DiagnoseWorkflowExecution(ctx context.Context, DiagnoseReque
 	return thrift.FromDiagnoseWorkflowExecutionResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) GetActivityAttemptTimings(ctx context.Context, Request *shared.GetActivityAttemptTimingsRequest) (gp1 *shared.GetActivityAttemptTimingsResponse, err error) {
+	response, err := g.h.GetActivityAttemptTimings(ctx, thrift.ToGetActivityAttemptTimingsRequest(Request))
+	return thrift.FromGetActivityAttemptTimingsResponse(response), thrift.FromError(err)
+}
+
+func (g APIHandler) GetActivityEffectiveConfig(ctx context.Context, Request *shared.GetActivityEffectiveConfigRequest) (gp1 *shared.GetActivityEffectiveConfigResponse, err error) {
+	response, err := g.h.GetActivityEffectiveConfig(ctx, thrift.ToGetActivityEffectiveConfigRequest(Request))
+	return thrift.FromGetActivityEffectiveConfigResponse(response), thrift.FromError(err)
+}
+
+func (g APIHandler) GetActivityNextRetryTime(ctx context.Context, Request *shared.GetActivityNextRetryTimeRequest) (gp1 *shared.GetActivityNextRetryTimeResponse, err error) {
+	response, err := g.h.GetActivityNextRetryTime(ctx, thrift.ToGetActivityNextRetryTimeRequest(Request))
+	return thrift.FromGetActivityNextRetryTimeResponse(response), thrift.FromError(err)
+}
+
+func (g APIHandler) GetActivityRescheduleReasons(ctx context.Context, Request *shared.GetActivityRescheduleReasonsRequest) (gp1 *shared.GetActivityRescheduleReasonsResponse, err error) {
+	response, err := g.h.GetActivityRescheduleReasons(ctx, thrift.ToGetActivityRescheduleReasonsRequest(Request))
+	return thrift.FromGetActivityRescheduleReasonsResponse(response), thrift.FromError(err)
+}
+
+func (g APIHandler) GetActivityRetryStats(ctx context.Context, Request *shared.GetActivityRetryStatsRequest) (gp1 *shared.GetActivityRetryStatsResponse, err error) {
+	response, err := g.h.GetActivityRetryStats(ctx, thrift.ToGetActivityRetryStatsRequest(Request))
+	return thrift.FromGetActivityRetryStatsResponse(response), thrift.FromError(err)
+}
+
+func (g APIHandler) GetActivityTokenValidationFailures(ctx context.Context, Request *shared.GetActivityTokenValidationFailuresRequest) (gp1 *shared.GetActivityTokenValidationFailuresResponse, err error) {
+	response, err := g.h.GetActivityTokenValidationFailures(ctx, thrift.ToGetActivityTokenValidationFailuresRequest(Request))
+	return thrift.FromGetActivityTokenValidationFailuresResponse(response), thrift.FromError(err)
+}
+
+func (g APIHandler) GetActivityWorkerHistory(ctx context.Context, Request *shared.GetActivityWorkerHistoryRequest) (gp1 *shared.GetActivityWorkerHistoryResponse, err error) {
+	response, err := g.h.GetActivityWorkerHistory(ctx, thrift.ToGetActivityWorkerHistoryRequest(Request))
+	return thrift.FromGetActivityWorkerHistoryResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) GetClusterInfo(ctx context.Context) (cp1 *shared.ClusterInfo, err error) {
 	response, err := g.h.GetClusterInfo(ctx)
 	return thrift.FromGetClusterInfoResponse(response), thrift.FromError(err)
@@ -83,6 +123,11 @@ func (g APIHandler) GetWorkflowExecutionHistory(ctx context.Context, GetRequest
 	return thrift.FromGetWorkflowExecutionHistoryResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) ListActivityTypes(ctx context.Context, Request *shared.ListActivityTypesRequest) (lp1 *shared.ListActivityTypesResponse, err error) {
+	response, err := g.h.ListActivityTypes(ctx, thrift.ToListActivityTypesRequest(Request))
+	return thrift.FromListActivityTypesResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) ListArchivedWorkflowExecutions(ctx context.Context, ListRequest *shared.ListArchivedWorkflowExecutionsRequest) (lp1 *shared.ListArchivedWorkflowExecutionsResponse, err error) {
 	response, err := g.h.ListArchivedWorkflowExecutions(ctx, thrift.ToListArchivedWorkflowExecutionsRequest(ListRequest))
 	return thrift.FromListArchivedWorkflowExecutionsResponse(response), thrift.FromError(err)
@@ -103,6 +148,11 @@ func (g APIHandler) ListOpenWorkflowExecutions(ctx context.Context, ListRequest
 	return thrift.FromListOpenWorkflowExecutionsResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) ListPendingActivityScheduleAttributes(ctx context.Context, Request *shared.ListPendingActivityScheduleAttributesRequest) (lp1 *shared.ListPendingActivityScheduleAttributesResponse, err error) {
+	response, err := g.h.ListPendingActivityScheduleAttributes(ctx, thrift.ToListPendingActivityScheduleAttributesRequest(Request))
+	return thrift.FromListPendingActivityScheduleAttributesResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) ListTaskListPartitions(ctx context.Context, Request *shared.ListTaskListPartitionsRequest) (lp1 *shared.ListTaskListPartitionsResponse, err error) {
 	response, err := g.h.ListTaskListPartitions(ctx, thrift.ToListTaskListPartitionsRequest(Request))
 	return thrift.FromListTaskListPartitionsResponse(response), thrift.FromError(err)
@@ -148,11 +198,21 @@ func (g APIHandler) RegisterDomain(ctx context.Context, RegisterRequest *shared.
 	return thrift.FromError(err)
 }
 
+func (g APIHandler) RenewActivityLease(ctx context.Context, Request *shared.RenewActivityLeaseRequest) (rp1 *shared.RenewActivityLeaseResponse, err error) {
+	response, err := g.h.RenewActivityLease(ctx, thrift.ToRenewActivityLeaseRequest(Request))
+	return thrift.FromRenewActivityLeaseResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) RequestCancelWorkflowExecution(ctx context.Context, CancelRequest *shared.RequestCancelWorkflowExecutionRequest) (err error) {
 	err = g.h.RequestCancelWorkflowExecution(ctx, thrift.ToRequestCancelWorkflowExecutionRequest(CancelRequest))
 	return thrift.FromError(err)
 }
 
+func (g APIHandler) ResetActivityAttempts(ctx context.Context, Request *shared.ResetActivityAttemptsRequest) (err error) {
+	err = g.h.ResetActivityAttempts(ctx, thrift.ToResetActivityAttemptsRequest(Request))
+	return thrift.FromError(err)
+}
+
 func (g APIHandler) ResetStickyTaskList(ctx context.Context, ResetRequest *shared.ResetStickyTaskListRequest) (rp1 *shared.ResetStickyTaskListResponse, err error) {
 	response, err := g.h.ResetStickyTaskList(ctx, thrift.ToResetStickyTaskListRequest(ResetRequest))
 	return thrift.FromResetStickyTaskListResponse(response), thrift.FromError(err)
@@ -193,6 +253,11 @@ func (g APIHandler) RespondActivityTaskFailedByID(ctx context.Context, FailReque
 	return thrift.FromError(err)
 }
 
+func (g APIHandler) RespondActivityTaskNack(ctx context.Context, Request *shared.RespondActivityTaskNackRequest) (err error) {
+	err = g.h.RespondActivityTaskNack(ctx, thrift.ToRespondActivityTaskNackRequest(Request))
+	return thrift.FromError(err)
+}
+
 func (g APIHandler) RespondDecisionTaskCompleted(ctx context.Context, CompleteRequest *shared.RespondDecisionTaskCompletedRequest) (rp1 *shared.RespondDecisionTaskCompletedResponse, err error) {
 	response, err := g.h.RespondDecisionTaskCompleted(ctx, thrift.ToRespondDecisionTaskCompletedRequest(CompleteRequest))
 	return thrift.FromRespondDecisionTaskCompletedResponse(response), thrift.FromError(err)
@@ -218,6 +283,11 @@ func (g APIHandler) ScanWorkflowExecutions(ctx context.Context, ListRequest *sha
 	return thrift.FromScanWorkflowExecutionsResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) SignalActivity(ctx context.Context, SignalRequest *shared.SignalActivityRequest) (err error) {
+	err = g.h.SignalActivity(ctx, thrift.ToSignalActivityRequest(SignalRequest))
+	return thrift.FromError(err)
+}
+
 func (g APIHandler) SignalWithStartWorkflowExecution(ctx context.Context, SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest) (sp1 *shared.StartWorkflowExecutionResponse, err error) {
 	response, err := g.h.SignalWithStartWorkflowExecution(ctx, thrift.ToSignalWithStartWorkflowExecutionRequest(SignalWithStartRequest))
 	return thrift.FromSignalWithStartWorkflowExecutionResponse(response), thrift.FromError(err)
@@ -252,3 +322,8 @@ func (g APIHandler) UpdateDomain(ctx context.Context, UpdateRequest *shared.Upda
 	response, err := g.h.UpdateDomain(ctx, thrift.ToUpdateDomainRequest(UpdateRequest))
 	return thrift.FromUpdateDomainResponse(response), thrift.FromError(err)
 }
+
+func (g APIHandler) WaitForActivityCompletion(ctx context.Context, Request *shared.WaitForActivityCompletionRequest) (wp1 *shared.WaitForActivityCompletionResponse, err error) {
+	response, err := g.h.WaitForActivityCompletion(ctx, thrift.ToWaitForActivityCompletionRequest(Request))
+	return thrift.FromWaitForActivityCompletionResponse(response), thrift.FromError(err)
+}
@@ -55,6 +55,14 @@ func NewAPIHandler(
 	}
 }
 
+func (h *versionCheckHandler) BatchDescribeWorkflowExecutions(ctx context.Context, bp1 *types.BatchDescribeWorkflowExecutionsRequest) (bp2 *types.BatchDescribeWorkflowExecutionsResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.BatchDescribeWorkflowExecutions(ctx, bp1)
+}
+
 func (h *versionCheckHandler) CountWorkflowExecutions(ctx context.Context, cp1 *types.CountWorkflowExecutionsRequest) (cp2 *types.CountWorkflowExecutionsResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -103,6 +111,62 @@ func (h *versionCheckHandler) DiagnoseWorkflowExecution(ctx context.Context, dp1
 	return h.frontendHandler.DiagnoseWorkflowExecution(ctx, dp1)
 }
 
+func (h *versionCheckHandler) GetActivityAttemptTimings(ctx context.Context, gp1 *types.GetActivityAttemptTimingsRequest) (gp2 *types.GetActivityAttemptTimingsResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.GetActivityAttemptTimings(ctx, gp1)
+}
+
+func (h *versionCheckHandler) GetActivityEffectiveConfig(ctx context.Context, gp1 *types.GetActivityEffectiveConfigRequest) (gp2 *types.GetActivityEffectiveConfigResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.GetActivityEffectiveConfig(ctx, gp1)
+}
+
+func (h *versionCheckHandler) GetActivityNextRetryTime(ctx context.Context, gp1 *types.GetActivityNextRetryTimeRequest) (gp2 *types.GetActivityNextRetryTimeResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.GetActivityNextRetryTime(ctx, gp1)
+}
+
+func (h *versionCheckHandler) GetActivityRescheduleReasons(ctx context.Context, gp1 *types.GetActivityRescheduleReasonsRequest) (gp2 *types.GetActivityRescheduleReasonsResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.GetActivityRescheduleReasons(ctx, gp1)
+}
+
+func (h *versionCheckHandler) GetActivityRetryStats(ctx context.Context, gp1 *types.GetActivityRetryStatsRequest) (gp2 *types.GetActivityRetryStatsResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.GetActivityRetryStats(ctx, gp1)
+}
+
+func (h *versionCheckHandler) GetActivityTokenValidationFailures(ctx context.Context, gp1 *types.GetActivityTokenValidationFailuresRequest) (gp2 *types.GetActivityTokenValidationFailuresResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.GetActivityTokenValidationFailures(ctx, gp1)
+}
+
+func (h *versionCheckHandler) GetActivityWorkerHistory(ctx context.Context, gp1 *types.GetActivityWorkerHistoryRequest) (gp2 *types.GetActivityWorkerHistoryResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.GetActivityWorkerHistory(ctx, gp1)
+}
+
 func (h *versionCheckHandler) GetClusterInfo(ctx context.Context) (cp1 *types.ClusterInfo, err error) {
 	return h.frontendHandler.GetClusterInfo(ctx)
 }
@@ -131,6 +195,14 @@ func (h *versionCheckHandler) Health(ctx context.Context) (hp1 *types.HealthStat
 	return h.frontendHandler.Health(ctx)
 }
 
+func (h *versionCheckHandler) ListActivityTypes(ctx context.Context, lp1 *types.ListActivityTypesRequest) (lp2 *types.ListActivityTypesResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.ListActivityTypes(ctx, lp1)
+}
+
 func (h *versionCheckHandler) ListArchivedWorkflowExecutions(ctx context.Context, lp1 *types.ListArchivedWorkflowExecutionsRequest) (lp2 *types.ListArchivedWorkflowExecutionsResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -163,6 +235,14 @@ func (h *versionCheckHandler) ListOpenWorkflowExecutions(ctx context.Context, lp
 	return h.frontendHandler.ListOpenWorkflowExecutions(ctx, lp1)
 }
 
+func (h *versionCheckHandler) ListPendingActivityScheduleAttributes(ctx context.Context, lp1 *types.ListPendingActivityScheduleAttributesRequest) (lp2 *types.ListPendingActivityScheduleAttributesResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.ListPendingActivityScheduleAttributes(ctx, lp1)
+}
+
 func (h *versionCheckHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	return h.frontendHandler.ListTaskListPartitions(ctx, lp1)
 }
@@ -227,6 +307,14 @@ func (h *versionCheckHandler) RegisterDomain(ctx context.Context, rp1 *types.Reg
 	return h.frontendHandler.RegisterDomain(ctx, rp1)
 }
 
+func (h *versionCheckHandler) RenewActivityLease(ctx context.Context, rp1 *types.RenewActivityLeaseRequest) (rp2 *types.RenewActivityLeaseResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.RenewActivityLease(ctx, rp1)
+}
+
 func (h *versionCheckHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *types.RequestCancelWorkflowExecutionRequest) (err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -235,6 +323,14 @@ func (h *versionCheckHandler) RequestCancelWorkflowExecution(ctx context.Context
 	return h.frontendHandler.RequestCancelWorkflowExecution(ctx, rp1)
 }
 
+func (h *versionCheckHandler) ResetActivityAttempts(ctx context.Context, rp1 *types.ResetActivityAttemptsRequest) (err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.ResetActivityAttempts(ctx, rp1)
+}
+
 func (h *versionCheckHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -299,6 +395,14 @@ func (h *versionCheckHandler) RespondActivityTaskFailedByID(ctx context.Context,
 	return h.frontendHandler.RespondActivityTaskFailedByID(ctx, rp1)
 }
 
+func (h *versionCheckHandler) RespondActivityTaskNack(ctx context.Context, rp1 *types.RespondActivityTaskNackRequest) (err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.RespondActivityTaskNack(ctx, rp1)
+}
+
 func (h *versionCheckHandler) RespondDecisionTaskCompleted(ctx context.Context, rp1 *types.RespondDecisionTaskCompletedRequest) (rp2 *types.RespondDecisionTaskCompletedResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -339,6 +443,14 @@ func (h *versionCheckHandler) ScanWorkflowExecutions(ctx context.Context, lp1 *t
 	return h.frontendHandler.ScanWorkflowExecutions(ctx, lp1)
 }
 
+func (h *versionCheckHandler) SignalActivity(ctx context.Context, sp1 *types.SignalActivityRequest) (err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.SignalActivity(ctx, sp1)
+}
+
 func (h *versionCheckHandler) SignalWithStartWorkflowExecution(ctx context.Context, sp1 *types.SignalWithStartWorkflowExecutionRequest) (sp2 *types.StartWorkflowExecutionResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -394,3 +506,11 @@ func (h *versionCheckHandler) UpdateDomain(ctx context.Context, up1 *types.Updat
 	}
 	return h.frontendHandler.UpdateDomain(ctx, up1)
 }
+
+func (h *versionCheckHandler) WaitForActivityCompletion(ctx context.Context, wp1 *types.WaitForActivityCompletionRequest) (wp2 *types.WaitForActivityCompletionResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.WaitForActivityCompletion(ctx, wp1)
+}
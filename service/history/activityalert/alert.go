@@ -0,0 +1,71 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination alert_mock.go -self_package github.com/uber/cadence/service/history/activityalert
+
+// Package activityalert contains the sinks alerted when an activity of the history service fails for good.
+//
+// An activity is scheduled with an AlertOnFailure tag to be alerted on, and the history.activityFailureAlertSinks
+// dynamic config of its domain maps the tag to the name of a sink. Once the activity fails or times out without
+// being retried any longer, the history service sends the failure to the sink. Sinks are registered by name with
+// RegisterSink when the service starts, and the "noop" sink, which drops every alert, is always registered.
+//
+// Alerts are sent once the workflow update failing the activity is closed, on a best effort basis: an alert the
+// sink fails to take is logged and dropped without failing the update.
+package activityalert
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// SinkNoop is the name of the sink dropping every alert
+	SinkNoop = "noop"
+)
+
+type (
+	// Alert is the failure of an activity which failed or timed out without being retried any longer
+	Alert struct {
+		DomainID     string `json:"domainID"`
+		DomainName   string `json:"domainName"`
+		WorkflowID   string `json:"workflowID"`
+		RunID        string `json:"runID"`
+		ActivityID   string `json:"activityID"`
+		ActivityType string `json:"activityType"`
+		ScheduleID   int64  `json:"scheduleID"`
+		Attempt      int32  `json:"attempt"`
+		// Tag is the AlertOnFailure tag the activity was scheduled with
+		Tag string `json:"tag"`
+		// Reason is the failure reason of a failed activity, or the timeout type of a timed out one
+		Reason string `json:"reason,omitempty"`
+		// Details are the failure details of a failed activity, or the last heartbeat details of a timed out one
+		Details   []byte    `json:"details,omitempty"`
+		Timestamp time.Time `json:"timestamp"`
+	}
+
+	// Sink takes the alerts of the activities which failed for good
+	Sink interface {
+		// Send sends the alert, the sink should not retry it past the deadline of the context
+		Send(ctx context.Context, alert *Alert) error
+	}
+)
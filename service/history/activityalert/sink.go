@@ -0,0 +1,67 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package activityalert
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+type noopSink struct{}
+
+var registeredSinks = map[string]Sink{
+	SinkNoop: noopSink{},
+}
+
+// RegisterSink registers the sink under the name, so the domains can map AlertOnFailure tags to it. Sinks are
+// registered when the service starts, before any alert is sent.
+func RegisterSink(sinkName string, sink Sink) {
+	if _, ok := registeredSinks[sinkName]; ok {
+		panic("activity alert sink " + sinkName + " already registered")
+	}
+	registeredSinks[sinkName] = sink
+}
+
+// GetRegisteredSinkNames returns the names of the registered sinks
+func GetRegisteredSinkNames() []string {
+	var sinkNames []string
+	for sinkName := range registeredSinks {
+		sinkNames = append(sinkNames, sinkName)
+	}
+	sort.Strings(sinkNames)
+	return sinkNames
+}
+
+// Send sends the alert to the sink registered under the name
+func Send(ctx context.Context, sinkName string, alert *Alert) error {
+	sink, ok := registeredSinks[sinkName]
+	if !ok {
+		return fmt.Errorf("activity alert sink %q is not registered, registered sinks: %v", sinkName, GetRegisteredSinkNames())
+	}
+	return sink.Send(ctx, alert)
+}
+
+func (noopSink) Send(context.Context, *Alert) error {
+	return nil
+}
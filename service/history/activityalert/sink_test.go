@@ -0,0 +1,58 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package activityalert
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSend(t *testing.T) {
+	alert := &Alert{
+		DomainName: "domain",
+		WorkflowID: "wid",
+		RunID:      "rid",
+		ActivityID: "aid",
+		ScheduleID: 5,
+		Tag:        "payments",
+		Reason:     "some reason",
+	}
+
+	// the noop sink is always registered
+	assert.NoError(t, Send(context.Background(), SinkNoop, alert))
+	assert.Error(t, Send(context.Background(), "test-send-sink", alert))
+
+	sink := NewMockSink(gomock.NewController(t))
+	RegisterSink("test-send-sink", sink)
+	assert.Equal(t, []string{SinkNoop, "test-send-sink"}, GetRegisteredSinkNames())
+	assert.Panics(t, func() { RegisterSink("test-send-sink", sink) })
+
+	sink.EXPECT().Send(gomock.Any(), alert).Return(nil)
+	assert.NoError(t, Send(context.Background(), "test-send-sink", alert))
+	sink.EXPECT().Send(gomock.Any(), alert).Return(errors.New("some error"))
+	assert.Error(t, Send(context.Background(), "test-send-sink", alert))
+}
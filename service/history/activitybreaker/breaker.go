@@ -0,0 +1,242 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package activitybreaker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/service/history/config"
+)
+
+// numWindowBuckets is the number of buckets the failure-rate window is split into, the window slides by
+// one bucket at a time
+const numWindowBuckets = 10
+
+type (
+	breakerImpl struct {
+		sync.Mutex
+
+		config        *config.Config
+		timeSource    clock.TimeSource
+		metricsClient metrics.Client
+		breakers      map[breakerKey]*typeBreaker
+	}
+
+	breakerKey struct {
+		domainID     string
+		activityType string
+	}
+
+	typeBreaker struct {
+		state State
+		// buckets of the failure-rate window, oldest first
+		buckets  []windowBucket
+		openedAt time.Time
+		// time the probe activity of a half-open breaker was let through, zero if it was not yet
+		probedAt time.Time
+	}
+
+	windowBucket struct {
+		start     time.Time
+		successes int
+		failures  int
+	}
+)
+
+// New creates the circuit breakers of the activity types of a history host
+func New(
+	config *config.Config,
+	timeSource clock.TimeSource,
+	metricsClient metrics.Client,
+) Breaker {
+	return &breakerImpl{
+		config:        config,
+		timeSource:    timeSource,
+		metricsClient: metricsClient,
+		breakers:      make(map[breakerKey]*typeBreaker),
+	}
+}
+
+func (b *breakerImpl) Allow(domainName string, domainID string, activityType string) bool {
+	if !b.config.EnableActivityTypeCircuitBreaker(domainName) {
+		return true
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	key := breakerKey{domainID: domainID, activityType: activityType}
+	breaker, ok := b.breakers[key]
+	if !ok {
+		return true
+	}
+
+	now := b.timeSource.Now()
+	openDuration := b.config.ActivityTypeCircuitBreakerOpenDuration(domainName)
+	switch breaker.state {
+	case StateOpen:
+		if now.Sub(breaker.openedAt) < openDuration {
+			break
+		}
+		breaker.state = StateHalfOpen
+		breaker.probedAt = now
+		b.emitState(domainName, activityType, breaker)
+		return true
+	case StateHalfOpen:
+		// another probe is let through if the outcome of the previous one was never recorded, e.g. because
+		// the activity was canceled
+		if breaker.probedAt.IsZero() || now.Sub(breaker.probedAt) >= openDuration {
+			breaker.probedAt = now
+			return true
+		}
+	default:
+		// forget the activity types which have no outcomes left in the window
+		breaker.slide(now, b.config.ActivityTypeCircuitBreakerWindow(domainName))
+		if len(breaker.buckets) == 0 {
+			delete(b.breakers, key)
+		}
+		return true
+	}
+
+	b.scope(domainName, activityType).IncCounter(metrics.ActivityTypeCircuitBreakerHeldCounter)
+	return false
+}
+
+func (b *breakerImpl) RecordSuccess(domainName string, domainID string, activityType string) {
+	b.record(domainName, domainID, activityType, true)
+}
+
+func (b *breakerImpl) RecordFailure(domainName string, domainID string, activityType string) {
+	b.record(domainName, domainID, activityType, false)
+}
+
+func (b *breakerImpl) State(domainID string, activityType string) State {
+	b.Lock()
+	defer b.Unlock()
+
+	if breaker, ok := b.breakers[breakerKey{domainID: domainID, activityType: activityType}]; ok {
+		return breaker.state
+	}
+	return StateClosed
+}
+
+func (b *breakerImpl) record(domainName string, domainID string, activityType string, success bool) {
+	if !b.config.EnableActivityTypeCircuitBreaker(domainName) {
+		return
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	key := breakerKey{domainID: domainID, activityType: activityType}
+	breaker, ok := b.breakers[key]
+	if !ok {
+		breaker = &typeBreaker{state: StateClosed}
+		b.breakers[key] = breaker
+	}
+
+	now := b.timeSource.Now()
+	switch breaker.state {
+	case StateOpen:
+		// outcomes of the activities dispatched before the breaker opened don't change its state
+		return
+	case StateHalfOpen:
+		if success {
+			breaker.state = StateClosed
+			breaker.buckets = nil
+		} else {
+			b.open(domainName, activityType, breaker, now)
+		}
+		b.emitState(domainName, activityType, breaker)
+		return
+	}
+
+	window := b.config.ActivityTypeCircuitBreakerWindow(domainName)
+	breaker.slide(now, window)
+	breaker.add(now, window, success)
+
+	successes, failures := breaker.counts()
+	failureRate := float64(failures) / float64(successes+failures)
+	b.scope(domainName, activityType).UpdateGauge(metrics.ActivityTypeCircuitBreakerFailureRateGauge, failureRate)
+	if successes+failures < b.config.ActivityTypeCircuitBreakerMinRequests(domainName) ||
+		failureRate < b.config.ActivityTypeCircuitBreakerFailureRate(dynamicconfig.DomainFilter(domainName)) {
+		return
+	}
+	b.open(domainName, activityType, breaker, now)
+	b.emitState(domainName, activityType, breaker)
+}
+
+func (b *breakerImpl) open(domainName string, activityType string, breaker *typeBreaker, now time.Time) {
+	breaker.state = StateOpen
+	breaker.openedAt = now
+	breaker.probedAt = time.Time{}
+	breaker.buckets = nil
+	b.scope(domainName, activityType).IncCounter(metrics.ActivityTypeCircuitBreakerOpenedCounter)
+}
+
+func (b *breakerImpl) emitState(domainName string, activityType string, breaker *typeBreaker) {
+	b.scope(domainName, activityType).UpdateGauge(metrics.ActivityTypeCircuitBreakerStateGauge, float64(breaker.state))
+}
+
+func (b *breakerImpl) scope(domainName string, activityType string) metrics.Scope {
+	return b.metricsClient.Scope(
+		metrics.ActivityTypeCircuitBreakerScope,
+		metrics.DomainTag(domainName),
+		metrics.ActivityTypeTag(activityType),
+	)
+}
+
+// slide drops the buckets which are entirely out of the window
+func (t *typeBreaker) slide(now time.Time, window time.Duration) {
+	bucketSize := window / numWindowBuckets
+	i := 0
+	for i < len(t.buckets) && now.Sub(t.buckets[i].start) >= window+bucketSize {
+		i++
+	}
+	t.buckets = t.buckets[i:]
+}
+
+func (t *typeBreaker) add(now time.Time, window time.Duration, success bool) {
+	bucketSize := window / numWindowBuckets
+	if len(t.buckets) == 0 || now.Sub(t.buckets[len(t.buckets)-1].start) >= bucketSize {
+		t.buckets = append(t.buckets, windowBucket{start: now})
+	}
+	bucket := &t.buckets[len(t.buckets)-1]
+	if success {
+		bucket.successes++
+	} else {
+		bucket.failures++
+	}
+}
+
+func (t *typeBreaker) counts() (successes int, failures int) {
+	for _, bucket := range t.buckets {
+		successes += bucket.successes
+		failures += bucket.failures
+	}
+	return successes, failures
+}
@@ -0,0 +1,157 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package activitybreaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/service/history/config"
+)
+
+const (
+	testDomainName   = "test-domain"
+	testDomainID     = "test-domain-id"
+	testActivityType = "failing-activity"
+	otherActivity    = "healthy-activity"
+)
+
+func newTestBreaker(enabled bool) (Breaker, clock.MockedTimeSource) {
+	cfg := config.NewForTest()
+	cfg.EnableActivityTypeCircuitBreaker = dynamicconfig.GetBoolPropertyFnFilteredByDomain(enabled)
+	cfg.ActivityTypeCircuitBreakerFailureRate = dynamicconfig.GetFloatPropertyFn(0.5)
+	cfg.ActivityTypeCircuitBreakerMinRequests = dynamicconfig.GetIntPropertyFilteredByDomain(4)
+	cfg.ActivityTypeCircuitBreakerWindow = dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Minute)
+	cfg.ActivityTypeCircuitBreakerOpenDuration = dynamicconfig.GetDurationPropertyFnFilteredByDomain(10 * time.Second)
+	timeSource := clock.NewMockedTimeSource()
+	return New(cfg, timeSource, metrics.NewNoopMetricsClient()), timeSource
+}
+
+func recordFailures(breaker Breaker, activityType string, count int) {
+	for i := 0; i < count; i++ {
+		breaker.RecordFailure(testDomainName, testDomainID, activityType)
+	}
+}
+
+func TestBreaker_Disabled(t *testing.T) {
+	breaker, _ := newTestBreaker(false)
+
+	recordFailures(breaker, testActivityType, 10)
+	assert.Equal(t, StateClosed, breaker.State(testDomainID, testActivityType))
+	assert.True(t, breaker.Allow(testDomainName, testDomainID, testActivityType))
+}
+
+func TestBreaker_OpensPerActivityType(t *testing.T) {
+	breaker, _ := newTestBreaker(true)
+
+	// not enough outcomes in the window yet
+	recordFailures(breaker, testActivityType, 3)
+	assert.Equal(t, StateClosed, breaker.State(testDomainID, testActivityType))
+	assert.True(t, breaker.Allow(testDomainName, testDomainID, testActivityType))
+
+	recordFailures(breaker, testActivityType, 1)
+	assert.Equal(t, StateOpen, breaker.State(testDomainID, testActivityType))
+	assert.False(t, breaker.Allow(testDomainName, testDomainID, testActivityType))
+
+	// the other activity types and the same activity type of other domains are not affected
+	assert.True(t, breaker.Allow(testDomainName, testDomainID, otherActivity))
+	assert.True(t, breaker.Allow("other-domain", "other-domain-id", testActivityType))
+}
+
+func TestBreaker_FailureRateBelowThreshold(t *testing.T) {
+	breaker, _ := newTestBreaker(true)
+
+	for i := 0; i < 10; i++ {
+		breaker.RecordSuccess(testDomainName, testDomainID, testActivityType)
+		breaker.RecordSuccess(testDomainName, testDomainID, testActivityType)
+		breaker.RecordFailure(testDomainName, testDomainID, testActivityType)
+	}
+	assert.Equal(t, StateClosed, breaker.State(testDomainID, testActivityType))
+	assert.True(t, breaker.Allow(testDomainName, testDomainID, testActivityType))
+}
+
+func TestBreaker_WindowSlides(t *testing.T) {
+	breaker, timeSource := newTestBreaker(true)
+
+	recordFailures(breaker, testActivityType, 3)
+	// the failures fall out of the window before the next one is recorded
+	timeSource.Advance(2 * time.Minute)
+	recordFailures(breaker, testActivityType, 1)
+	assert.Equal(t, StateClosed, breaker.State(testDomainID, testActivityType))
+}
+
+func TestBreaker_HalfOpen(t *testing.T) {
+	tests := map[string]struct {
+		probeSucceeds bool
+		expectedState State
+	}{
+		"probe succeeds": {
+			probeSucceeds: true,
+			expectedState: StateClosed,
+		},
+		"probe fails": {
+			probeSucceeds: false,
+			expectedState: StateOpen,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			breaker, timeSource := newTestBreaker(true)
+			recordFailures(breaker, testActivityType, 4)
+			assert.False(t, breaker.Allow(testDomainName, testDomainID, testActivityType))
+
+			timeSource.Advance(10 * time.Second)
+			// a single probe is let through
+			assert.True(t, breaker.Allow(testDomainName, testDomainID, testActivityType))
+			assert.Equal(t, StateHalfOpen, breaker.State(testDomainID, testActivityType))
+			assert.False(t, breaker.Allow(testDomainName, testDomainID, testActivityType))
+
+			if tc.probeSucceeds {
+				breaker.RecordSuccess(testDomainName, testDomainID, testActivityType)
+			} else {
+				breaker.RecordFailure(testDomainName, testDomainID, testActivityType)
+			}
+			assert.Equal(t, tc.expectedState, breaker.State(testDomainID, testActivityType))
+			assert.Equal(t, tc.probeSucceeds, breaker.Allow(testDomainName, testDomainID, testActivityType))
+		})
+	}
+}
+
+func TestBreaker_HalfOpenProbeLost(t *testing.T) {
+	breaker, timeSource := newTestBreaker(true)
+	recordFailures(breaker, testActivityType, 4)
+
+	timeSource.Advance(10 * time.Second)
+	assert.True(t, breaker.Allow(testDomainName, testDomainID, testActivityType))
+
+	// the outcome of the probe is never recorded, another probe is let through after the open duration
+	timeSource.Advance(10 * time.Second)
+	assert.True(t, breaker.Allow(testDomainName, testDomainID, testActivityType))
+	assert.False(t, breaker.Allow(testDomainName, testDomainID, testActivityType))
+}
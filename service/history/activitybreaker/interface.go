@@ -0,0 +1,77 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination interface_mock.go -self_package github.com/uber/cadence/service/history/activitybreaker
+
+// Package activitybreaker contains the circuit breakers of the activity types of the history service.
+//
+// The circuit breaker of an activity type tracks the outcome of the attempts of the activities of the type,
+// across all the workflows of a domain, over a sliding failure-rate window. It opens once the share of the
+// attempts that failed or timed out within the window reaches the configured failure rate, and the dispatch
+// of the newly scheduled activities of the type is then held back, without failing them, while the activities
+// of the other types are dispatched as usual. Once it was open for the configured duration, the breaker
+// half-opens and lets a single probe activity through: the breaker closes if the probe succeeds and opens
+// again if it fails or times out.
+//
+// The breakers are kept in memory by each history host and are not persisted, so the state of a breaker is
+// local to the host and is lost when the host restarts.
+package activitybreaker
+
+const (
+	// StateClosed is the state of a breaker letting every activity through
+	StateClosed State = iota
+	// StateHalfOpen is the state of a breaker letting a single probe activity through
+	StateHalfOpen
+	// StateOpen is the state of a breaker holding every activity back
+	StateOpen
+)
+
+type (
+	// State is the state of the circuit breaker of an activity type
+	State int
+
+	// Breaker is the set of the circuit breakers of the activity types of a history host
+	Breaker interface {
+		// Allow returns whether a newly scheduled activity of the type can be dispatched now
+		Allow(domainName string, domainID string, activityType string) bool
+		// RecordSuccess records the completion of an attempt of an activity of the type
+		RecordSuccess(domainName string, domainID string, activityType string)
+		// RecordFailure records the failure or timeout of an attempt of an activity of the type
+		RecordFailure(domainName string, domainID string, activityType string)
+		// State returns the current state of the breaker of the activity type
+		State(domainID string, activityType string) State
+	}
+)
+
+// String returns the name of the state
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half-open"
+	case StateOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
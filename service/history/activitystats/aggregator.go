@@ -0,0 +1,125 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package activitystats
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/service/history/config"
+)
+
+// bucketSize is the granularity the outcome of the attempts is aggregated with
+const bucketSize = time.Minute
+
+type (
+	aggregatorImpl struct {
+		sync.Mutex
+
+		config     *config.Config
+		timeSource clock.TimeSource
+		// buckets of each domain, oldest first
+		domains map[string][]*bucket
+	}
+
+	bucket struct {
+		start time.Time
+		stats Stats
+	}
+)
+
+// New creates the aggregator of the outcome of the activity attempts of a history host
+func New(
+	config *config.Config,
+	timeSource clock.TimeSource,
+) Aggregator {
+	return &aggregatorImpl{
+		config:     config,
+		timeSource: timeSource,
+		domains:    make(map[string][]*bucket),
+	}
+}
+
+func (a *aggregatorImpl) RecordAttempt(domainName string, domainID string, attempt int32, success bool) {
+	if !a.config.EnableActivityRetryStats(domainName) {
+		return
+	}
+
+	a.Lock()
+	defer a.Unlock()
+
+	now := a.timeSource.Now()
+	buckets := a.expire(domainName, domainID, now)
+	start := now.Truncate(bucketSize)
+	if len(buckets) == 0 || buckets[len(buckets)-1].start.Before(start) {
+		buckets = append(buckets, &bucket{start: start})
+	}
+	stats := &buckets[len(buckets)-1].stats
+	stats.TotalAttempts++
+	if attempt > 0 {
+		stats.RetriedAttempts++
+	}
+	if success {
+		stats.Successes++
+		if attempt == 0 {
+			stats.FirstAttemptSuccesses++
+		}
+	}
+	a.domains[domainID] = buckets
+}
+
+func (a *aggregatorImpl) GetStats(domainName string, domainID string, startTime time.Time, endTime time.Time) Stats {
+	a.Lock()
+	defer a.Unlock()
+
+	var stats Stats
+	startTime = startTime.Truncate(bucketSize)
+	for _, b := range a.expire(domainName, domainID, a.timeSource.Now()) {
+		if b.start.Before(startTime) || !b.start.Before(endTime) {
+			continue
+		}
+		stats.TotalAttempts += b.stats.TotalAttempts
+		stats.RetriedAttempts += b.stats.RetriedAttempts
+		stats.Successes += b.stats.Successes
+		stats.FirstAttemptSuccesses += b.stats.FirstAttemptSuccesses
+	}
+	return stats
+}
+
+// expire drops the buckets of the domain older than the retention and returns the remaining ones
+func (a *aggregatorImpl) expire(domainName string, domainID string, now time.Time) []*bucket {
+	buckets := a.domains[domainID]
+	cutoff := now.Add(-a.config.ActivityRetryStatsRetention(domainName)).Truncate(bucketSize)
+	i := 0
+	for i < len(buckets) && buckets[i].start.Before(cutoff) {
+		i++
+	}
+	buckets = buckets[i:]
+	if len(buckets) == 0 {
+		delete(a.domains, domainID)
+	} else {
+		a.domains[domainID] = buckets
+	}
+	return buckets
+}
@@ -0,0 +1,92 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package activitystats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/service/history/config"
+)
+
+const (
+	testDomainName = "test-domain"
+	testDomainID   = "test-domain-id"
+	otherDomainID  = "other-domain-id"
+)
+
+func newTestAggregator(enabled bool) (Aggregator, clock.MockedTimeSource) {
+	cfg := config.NewForTest()
+	cfg.EnableActivityRetryStats = dynamicconfig.GetBoolPropertyFnFilteredByDomain(enabled)
+	cfg.ActivityRetryStatsRetention = dynamicconfig.GetDurationPropertyFnFilteredByDomain(10 * time.Minute)
+	timeSource := clock.NewMockedTimeSourceAt(time.Unix(0, 0))
+	return New(cfg, timeSource), timeSource
+}
+
+func TestAggregator_Disabled(t *testing.T) {
+	aggregator, timeSource := newTestAggregator(false)
+
+	aggregator.RecordAttempt(testDomainName, testDomainID, 0, true)
+	assert.Equal(t, Stats{}, aggregator.GetStats(testDomainName, testDomainID, time.Time{}, timeSource.Now().Add(time.Minute)))
+}
+
+func TestAggregator_GetStats(t *testing.T) {
+	aggregator, timeSource := newTestAggregator(true)
+	start := timeSource.Now()
+
+	// an activity failing twice before completing, and one completing right away
+	aggregator.RecordAttempt(testDomainName, testDomainID, 0, false)
+	aggregator.RecordAttempt(testDomainName, testDomainID, 0, true)
+	timeSource.Advance(time.Minute)
+	aggregator.RecordAttempt(testDomainName, testDomainID, 1, false)
+	aggregator.RecordAttempt(testDomainName, testDomainID, 2, true)
+	aggregator.RecordAttempt(testDomainName, otherDomainID, 0, true)
+
+	assert.Equal(t, Stats{
+		TotalAttempts:         4,
+		RetriedAttempts:       2,
+		Successes:             2,
+		FirstAttemptSuccesses: 1,
+	}, aggregator.GetStats(testDomainName, testDomainID, start, timeSource.Now().Add(time.Second)))
+
+	// only the attempts closed in the second minute
+	assert.Equal(t, Stats{
+		TotalAttempts:   2,
+		RetriedAttempts: 2,
+		Successes:       1,
+	}, aggregator.GetStats(testDomainName, testDomainID, start.Add(90*time.Second), timeSource.Now().Add(time.Second)))
+
+	// buckets older than the retention are dropped
+	timeSource.Advance(10 * time.Minute)
+	assert.Equal(t, Stats{
+		TotalAttempts:   2,
+		RetriedAttempts: 2,
+		Successes:       1,
+	}, aggregator.GetStats(testDomainName, testDomainID, start, timeSource.Now()))
+	timeSource.Advance(time.Minute)
+	assert.Equal(t, Stats{}, aggregator.GetStats(testDomainName, testDomainID, start, timeSource.Now()))
+}
@@ -0,0 +1,60 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination interface_mock.go -self_package github.com/uber/cadence/service/history/activitystats
+
+// Package activitystats aggregates the outcome of the activity attempts of the domains of the history service,
+// to report the retry rate of the activities of a domain without inspecting its workflows one by one.
+//
+// Every attempt which completes, fails or times out is counted in the bucket of the minute it closed in,
+// whether or not it is retried afterwards, and buckets older than the configured retention are dropped.
+// The stats are kept in memory by each history host and are not persisted, so they only cover the attempts
+// closed on the host since it started: the stats of a domain are the sum of the stats of all the hosts.
+package activitystats
+
+import (
+	"time"
+)
+
+type (
+	// Stats is the aggregated outcome of the activity attempts of a domain
+	Stats struct {
+		// TotalAttempts is the number of attempts which completed, failed or timed out
+		TotalAttempts int64
+		// RetriedAttempts is the number of those attempts which were not the first attempt of their activity
+		RetriedAttempts int64
+		// Successes is the number of those attempts which completed
+		Successes int64
+		// FirstAttemptSuccesses is the number of those attempts which completed on the first attempt of their activity
+		FirstAttemptSuccesses int64
+	}
+
+	// Aggregator aggregates the outcome of the activity attempts of the domains of a history host
+	Aggregator interface {
+		// RecordAttempt records the outcome of an attempt of an activity of the domain, attempts are counted from 0
+		RecordAttempt(domainName string, domainID string, attempt int32, success bool)
+		// GetStats returns the outcome of the attempts of the domain closed within [startTime, endTime), the
+		// stats are aggregated per minute so that attempts closed in the minutes the range starts or ends in
+		// are included
+		GetStats(domainName string, domainID string, startTime time.Time, endTime time.Time) Stats
+	}
+)
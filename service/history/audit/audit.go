@@ -0,0 +1,85 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination audit_mock.go -self_package github.com/uber/cadence/service/history/audit
+
+package audit
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// ActivityAuditAppName is used to find the kafka topic of the activity audit log
+	ActivityAuditAppName = "activity-audit"
+
+	// SinkKafka is the name of the sink publishing the audit log to kafka
+	SinkKafka = "kafka"
+	// SinkFile is the name of the sink appending the audit log to a file
+	SinkFile = "file"
+)
+
+const (
+	// ActivityTransitionStarted is recorded when an attempt of the activity is started by a worker
+	ActivityTransitionStarted ActivityTransition = "started"
+	// ActivityTransitionCompleted is recorded when the activity completes
+	ActivityTransitionCompleted ActivityTransition = "completed"
+	// ActivityTransitionFailed is recorded when an attempt of the activity fails
+	ActivityTransitionFailed ActivityTransition = "failed"
+	// ActivityTransitionTimedOut is recorded when an attempt of the activity times out
+	ActivityTransitionTimedOut ActivityTransition = "timed-out"
+	// ActivityTransitionCanceled is recorded when the activity is canceled
+	ActivityTransitionCanceled ActivityTransition = "canceled"
+)
+
+type (
+	// ActivityTransition is a lifecycle transition of an activity recorded in the audit log
+	ActivityTransition string
+
+	// ActivityRecord is the audit record of a lifecycle transition of an activity
+	ActivityRecord struct {
+		DomainID   string             `json:"domainID"`
+		DomainName string             `json:"domainName"`
+		WorkflowID string             `json:"workflowID"`
+		RunID      string             `json:"runID"`
+		ActivityID string             `json:"activityID"`
+		ScheduleID int64              `json:"scheduleID"`
+		Attempt    int32              `json:"attempt"`
+		Transition ActivityTransition `json:"transition"`
+		// Identity is the identity of the worker which started, completed, failed or canceled the attempt
+		Identity  string    `json:"identity,omitempty"`
+		Timestamp time.Time `json:"timestamp"`
+		// Reason is the failure reason or timeout type of a failed or timed out attempt
+		Reason string `json:"reason,omitempty"`
+		// WillRetry is set when a failed or timed out attempt is going to be retried
+		WillRetry bool `json:"willRetry,omitempty"`
+	}
+
+	// Sink writes the records of the activity audit log
+	Sink interface {
+		// Write writes the records in order, and returns once they are all durably written
+		Write(ctx context.Context, records []*ActivityRecord) error
+		// Close releases the resources held by the sink
+		Close() error
+	}
+)
@@ -0,0 +1,55 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+/*
+Package audit contains the sinks of the activity audit log.
+
+# Overview
+
+The activity audit log is an append-only record of the lifecycle transitions of the activities of a domain:
+every start, completion, failure, timeout and cancellation of an activity attempt, with the identity of the
+worker involved and the time of the transition. It is enabled per domain through the
+history.activityAuditLogSink dynamic config, which selects the sink the records of the domain are written to:
+
+  - "kafka" publishes every record as a JSON message to the topic of the activity-audit kafka application,
+    keyed by workflow ID
+  - "file" appends every record as a JSON line to the file set by history.activityAuditLogFilePath
+
+The audit log is separate from the metrics emitted by the history service. Metrics are sampled and
+aggregated, while an audit record is written for every persisted transition of an audited domain and
+identifies the individual activity attempt.
+
+# Delivery
+
+The records of a workflow update are written to the sink in the background once the update is persisted, so
+a slow or unavailable sink never holds or fails the workflow. Only persisted transitions are recorded, but
+delivery is best effort: records which cannot be written, or which are pending when the history host stops,
+are logged and dropped.
+
+# Ordering
+
+The records of a workflow update are written in the order of its transitions. The records of successive
+updates are written independently and may reach the sink out of order, so consumers should order the records
+of an activity by their timestamp. The kafka sink keys messages by workflow ID, so the records of a workflow
+land in the same partition.
+*/
+package audit
@@ -0,0 +1,95 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/messaging"
+)
+
+type kafkaSink struct {
+	messagingClient messaging.Client
+
+	sync.Mutex
+	producer messaging.Producer
+}
+
+// NewKafkaSink creates a sink publishing records to the topic of the activity-audit kafka application.
+// The producer is created on the first write, so hosts without the topic configured only fail once a
+// domain is audited to kafka.
+func NewKafkaSink(messagingClient messaging.Client) Sink {
+	return &kafkaSink{
+		messagingClient: messagingClient,
+	}
+}
+
+func (s *kafkaSink) Write(ctx context.Context, records []*ActivityRecord) error {
+	producer, err := s.getProducer()
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		payload, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		// messages are keyed by workflow ID to keep the records of a workflow in order
+		if err := producer.Publish(ctx, &indexer.ActivityAuditMessage{
+			WorkflowID: common.StringPtr(record.WorkflowID),
+			Payload:    payload,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *kafkaSink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if producer, ok := s.producer.(messaging.CloseableProducer); ok {
+		return producer.Close()
+	}
+	return nil
+}
+
+func (s *kafkaSink) getProducer() (messaging.Producer, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.producer == nil {
+		producer, err := s.messagingClient.NewProducer(ActivityAuditAppName)
+		if err != nil {
+			return nil, err
+		}
+		s.producer = producer
+	}
+	return s.producer, nil
+}
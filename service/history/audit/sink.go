@@ -0,0 +1,86 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/multierr"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/messaging"
+)
+
+type domainSink struct {
+	sinkName dynamicconfig.StringPropertyFnWithDomainFilter
+	sinks    map[string]Sink
+}
+
+// NewSink creates the sink of the activity audit log of the history service, which writes the records of
+// each domain to the sink selected by sinkName for the domain
+func NewSink(
+	sinkName dynamicconfig.StringPropertyFnWithDomainFilter,
+	filePath dynamicconfig.StringPropertyFn,
+	messagingClient messaging.Client,
+) Sink {
+	sinks := map[string]Sink{
+		SinkFile: NewFileSink(filePath),
+	}
+	// the messaging client is optional, kafka is not available as a sink without it
+	if messagingClient != nil {
+		sinks[SinkKafka] = NewKafkaSink(messagingClient)
+	}
+	return &domainSink{
+		sinkName: sinkName,
+		sinks:    sinks,
+	}
+}
+
+func (s *domainSink) Write(ctx context.Context, records []*ActivityRecord) error {
+	for len(records) > 0 {
+		// write the consecutive records going to the same sink in one batch
+		name := s.sinkName(records[0].DomainName)
+		end := 1
+		for end < len(records) && s.sinkName(records[end].DomainName) == name {
+			end++
+		}
+		sink, ok := s.sinks[name]
+		if !ok {
+			return fmt.Errorf("activity audit log sink %q is not available", name)
+		}
+		if err := sink.Write(ctx, records[:end]); err != nil {
+			return err
+		}
+		records = records[end:]
+	}
+	return nil
+}
+
+func (s *domainSink) Close() error {
+	var err error
+	for _, sink := range s.sinks {
+		err = multierr.Append(err, sink.Close())
+	}
+	return err
+}
@@ -0,0 +1,151 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/messaging"
+)
+
+func testRecords(domainNames ...string) []*ActivityRecord {
+	records := make([]*ActivityRecord, 0, len(domainNames))
+	for i, domainName := range domainNames {
+		records = append(records, &ActivityRecord{
+			DomainID:   domainName + "-id",
+			DomainName: domainName,
+			WorkflowID: "wid",
+			RunID:      "rid",
+			ActivityID: "aid",
+			ScheduleID: 5,
+			Attempt:    int32(i),
+			Transition: ActivityTransitionFailed,
+			Identity:   "worker",
+			Timestamp:  time.Unix(0, int64(i)).UTC(),
+			Reason:     "some reason",
+			WillRetry:  true,
+		})
+	}
+	return records
+}
+
+func TestDomainSink_Write(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kafka := NewMockSink(ctrl)
+	file := NewMockSink(ctrl)
+	sink := &domainSink{
+		sinkName: func(domain string) string {
+			return map[string]string{"kafka-domain": SinkKafka, "file-domain": SinkFile, "unknown-domain": "unknown"}[domain]
+		},
+		sinks: map[string]Sink{SinkKafka: kafka, SinkFile: file},
+	}
+	records := testRecords("kafka-domain", "kafka-domain", "file-domain", "kafka-domain")
+
+	gomock.InOrder(
+		kafka.EXPECT().Write(gomock.Any(), records[:2]).Return(nil),
+		file.EXPECT().Write(gomock.Any(), records[2:3]).Return(nil),
+		kafka.EXPECT().Write(gomock.Any(), records[3:]).Return(nil),
+	)
+	assert.NoError(t, sink.Write(context.Background(), records))
+
+	kafka.EXPECT().Write(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+	assert.Error(t, sink.Write(context.Background(), records))
+
+	assert.EqualError(t, sink.Write(context.Background(), testRecords("unknown-domain")), `activity audit log sink "unknown" is not available`)
+}
+
+func TestNewSink_WithoutMessagingClient(t *testing.T) {
+	sink := NewSink(
+		func(string) string { return SinkKafka },
+		dynamicconfig.GetStringPropertyFn(""),
+		nil,
+	)
+	assert.Error(t, sink.Write(context.Background(), testRecords("domain")))
+	assert.NoError(t, sink.Close())
+}
+
+func TestKafkaSink(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := messaging.NewMockClient(ctrl)
+	producer := messaging.NewMockProducer(ctrl)
+	sink := NewKafkaSink(client)
+	records := testRecords("domain", "domain")
+
+	client.EXPECT().NewProducer(ActivityAuditAppName).Return(nil, errors.New("no topic"))
+	assert.Error(t, sink.Write(context.Background(), records))
+
+	// the producer is created once, and records are published in order keyed by workflow ID
+	client.EXPECT().NewProducer(ActivityAuditAppName).Return(producer, nil).Times(1)
+	for _, record := range records {
+		payload, err := json.Marshal(record)
+		require.NoError(t, err)
+		producer.EXPECT().Publish(gomock.Any(), &indexer.ActivityAuditMessage{
+			WorkflowID: &record.WorkflowID,
+			Payload:    payload,
+		}).Return(nil)
+	}
+	assert.NoError(t, sink.Write(context.Background(), records[:1]))
+	assert.NoError(t, sink.Write(context.Background(), records[1:]))
+	assert.NoError(t, sink.Close())
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink := NewFileSink(dynamicconfig.GetStringPropertyFn(path))
+	records := testRecords("domain", "domain", "domain")
+
+	require.NoError(t, sink.Write(context.Background(), records[:2]))
+	require.NoError(t, sink.Close())
+	// records are appended to the existing file once it is reopened
+	require.NoError(t, sink.Write(context.Background(), records[2:]))
+	require.NoError(t, sink.Close())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, len(records))
+	for i, line := range lines {
+		var record ActivityRecord
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		assert.Equal(t, records[i], &record)
+	}
+}
+
+func TestFileSink_PathNotConfigured(t *testing.T) {
+	sink := NewFileSink(dynamicconfig.GetStringPropertyFn(""))
+	assert.Error(t, sink.Write(context.Background(), testRecords("domain")))
+	assert.NoError(t, sink.Close())
+}
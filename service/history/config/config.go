@@ -60,6 +60,8 @@ type Config struct {
 	WorkflowDeletionJitterRange      dynamicconfig.IntPropertyFnWithDomainFilter
 	DeleteHistoryEventContextTimeout dynamicconfig.IntPropertyFn
 	MaxResponseSize                  int
+	// Max # of executions described by a single BatchDescribeWorkflowExecutions call
+	MaxBatchDescribeWorkflowExecutionsSize dynamicconfig.IntPropertyFn
 
 	// HistoryCache settings
 	// Change of these configs require shard restart
@@ -122,6 +124,8 @@ type Config struct {
 
 	// TimerQueueProcessor settings
 	TimerTaskBatchSize                                dynamicconfig.IntPropertyFn
+	TimerProcessorBatchSize                           dynamicconfig.IntPropertyFnWithShardIDFilter
+	TimerProcessorMaxPendingTasks                     dynamicconfig.IntPropertyFnWithShardIDFilter
 	TimerTaskDeleteBatchSize                          dynamicconfig.IntPropertyFn
 	TimerProcessorGetFailureRetryCount                dynamicconfig.IntPropertyFn
 	TimerProcessorCompleteTimerFailureRetryCount      dynamicconfig.IntPropertyFn
@@ -291,6 +295,73 @@ type Config struct {
 	MaxActivityCountDispatchByDomain dynamicconfig.IntPropertyFnWithDomainFilter
 
 	ActivityMaxScheduleToStartTimeoutForRetry dynamicconfig.DurationPropertyFnWithDomainFilter
+	// Max # of signals buffered for a pending activity until they are delivered through a heartbeat response
+	MaximumPendingSignalsPerActivity dynamicconfig.IntPropertyFnWithDomainFilter
+	// How long a completed activity is remembered to acknowledge a retried completion with the same task token
+	ActivityCompletionDedupWindow dynamicconfig.DurationPropertyFnWithDomainFilter
+	// Whether an activity result identical to an earlier one of the same run is stored as a reference to it
+	EnableActivityResultDedup dynamicconfig.BoolPropertyFnWithDomainFilter
+	// Whether the heartbeat of an activity of a closed workflow run reports the close status instead of failing
+	EnableActivityHeartbeatWorkflowCloseStatus dynamicconfig.BoolPropertyFnWithDomainFilter
+	// Sink the audit log of activity lifecycle transitions is written to, the audit log is disabled when empty
+	ActivityAuditLogSink dynamicconfig.StringPropertyFnWithDomainFilter
+	// Path of the file the file sink of the activity audit log appends records to
+	ActivityAuditLogFilePath dynamicconfig.StringPropertyFn
+	// How long the last heartbeat details of a closed activity stay visible through DescribeWorkflowExecution
+	ClosedActivityHeartbeatDetailsRetention dynamicconfig.DurationPropertyFnWithDomainFilter
+	ActivityMaxStartToCloseTimeout          dynamicconfig.DurationPropertyFnWithDomainFilter
+	DefaultActivityHeartbeatTimeout         dynamicconfig.DurationPropertyFnWithDomainFilter
+	ActivityCancellationAckTimeout          dynamicconfig.DurationPropertyFnWithDomainFilter
+	ActivityCancellationForceTimeout        dynamicconfig.DurationPropertyFnWithDomainFilter
+	ActivityMaxCancellationCheckpointGrace  dynamicconfig.DurationPropertyFnWithDomainFilter
+	ActivityCancellationGracePeriod         dynamicconfig.DurationPropertyFnWithDomainFilter
+	// Largest timeouts an activity can be scheduled with, 0 disables a cap
+	ActivityScheduleToCloseTimeoutCap dynamicconfig.DurationPropertyFnWithDomainFilter
+	ActivityScheduleToStartTimeoutCap dynamicconfig.DurationPropertyFnWithDomainFilter
+	ActivityStartToCloseTimeoutCap    dynamicconfig.DurationPropertyFnWithDomainFilter
+	ActivityHeartbeatTimeoutCap       dynamicconfig.DurationPropertyFnWithDomainFilter
+	// Fail the decisions scheduling an activity with a timeout over its cap instead of clamping the timeout
+	RejectActivityTimeoutsOverCap dynamicconfig.BoolPropertyFnWithDomainFilter
+	// Interval between the sweeps checking the heartbeat timeouts of the activities of a workflow, 0 disables coalescing
+	ActivityHeartbeatTimerCoalesceInterval dynamicconfig.DurationPropertyFnWithDomainFilter
+	// Treat every activity failure and timeout as final regardless of the retry policy
+	DisableActivityRetries dynamicconfig.BoolPropertyFnWithDomainFilter
+	// Consecutive ScheduleToStart timeouts after which an activity moves to its fallback task list
+	ActivityFallbackTaskListScheduleToStartTimeouts dynamicconfig.IntPropertyFnWithDomainFilter
+	// Consecutive heartbeats not advancing the reported progress after which an activity is flagged as stalled
+	ActivityStalledHeartbeatThreshold dynamicconfig.IntPropertyFnWithDomainFilter
+	// Retry policy given to activities scheduled as Idempotent without a RetryPolicy
+	IdempotentActivityRetryInitialInterval dynamicconfig.DurationPropertyFnWithDomainFilter
+	IdempotentActivityRetryMaximumAttempts dynamicconfig.IntPropertyFnWithDomainFilter
+	// Canned activity outcomes keyed by activity type, recorded without dispatching to a worker (testing only)
+	ActivityStubOutcomes dynamicconfig.MapPropertyFn
+	// Activity types, or "*" for all, whose completion results are passed to the activity result validator
+	ActivityResultValidation dynamicconfig.MapPropertyFn
+	// ActivityMaintenanceWindows maps activity type names to the maintenance windows suspending their heartbeat and StartToClose timeouts
+	ActivityMaintenanceWindows dynamicconfig.MapPropertyFn
+	// Kill switch tags set to true, the dispatch of the activities scheduled with such a tag is held
+	DisabledActivityKillSwitchTags dynamicconfig.MapPropertyFn
+	// AlertOnFailure tags mapped to the name of the sink alerted when an activity with the tag fails for good
+	ActivityFailureAlertSinks dynamicconfig.MapPropertyFn
+	// Header fields mapped to the values added to the header of every activity of the domain, unless set by the decider
+	DefaultActivityHeader dynamicconfig.MapPropertyFn
+	// Circuit breaking of the dispatch of activity types whose attempts keep failing or timing out
+	EnableActivityTypeCircuitBreaker       dynamicconfig.BoolPropertyFnWithDomainFilter
+	ActivityTypeCircuitBreakerFailureRate  dynamicconfig.FloatPropertyFn
+	ActivityTypeCircuitBreakerMinRequests  dynamicconfig.IntPropertyFnWithDomainFilter
+	ActivityTypeCircuitBreakerWindow       dynamicconfig.DurationPropertyFnWithDomainFilter
+	ActivityTypeCircuitBreakerOpenDuration dynamicconfig.DurationPropertyFnWithDomainFilter
+	// In memory aggregation of the outcome of the activity attempts of a domain, served by GetActivityRetryStats
+	EnableActivityRetryStats    dynamicconfig.BoolPropertyFnWithDomainFilter
+	ActivityRetryStatsRetention dynamicconfig.DurationPropertyFnWithDomainFilter
+	// Maximum number of named counters incremented by activity completions a workflow can hold
+	WorkflowCounterLimit dynamicconfig.IntPropertyFnWithDomainFilter
+	// Number of times an activity attempt can be nacked back to its task list before a nack fails it
+	ActivityMaxNacks dynamicconfig.IntPropertyFnWithDomainFilter
+	// Size in bytes above which the result of a completed activity is written to the blobstore instead of history
+	ActivityResultArchivalThreshold dynamicconfig.IntPropertyFnWithDomainFilter
+	// ExpireActivityHeartbeat is a testing tool and is disabled by default
+	EnableExpireActivityHeartbeat dynamicconfig.BoolPropertyFnWithDomainFilter
 
 	// Debugging configurations
 	EnableDebugMode               bool // note that this value is initialized once on service start
@@ -366,6 +437,8 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		DeleteHistoryEventContextTimeout:     dc.GetIntProperty(dynamicconfig.DeleteHistoryEventContextTimeout),
 		MaxResponseSize:                      maxMessageSize,
 
+		MaxBatchDescribeWorkflowExecutionsSize: dc.GetIntProperty(dynamicconfig.MaxBatchDescribeWorkflowExecutionsSize),
+
 		TaskProcessRPS:                           dc.GetIntPropertyFilteredByDomain(dynamicconfig.TaskProcessRPS),
 		TaskSchedulerType:                        dc.GetIntProperty(dynamicconfig.TaskSchedulerType),
 		TaskSchedulerWorkerCount:                 dc.GetIntProperty(dynamicconfig.TaskSchedulerWorkerCount),
@@ -398,6 +471,8 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		QueueProcessorEnableGracefulSyncShutdown:           dc.GetBoolProperty(dynamicconfig.QueueProcessorEnableGracefulSyncShutdown),
 
 		TimerTaskBatchSize:                                dc.GetIntProperty(dynamicconfig.TimerTaskBatchSize),
+		TimerProcessorBatchSize:                           dc.GetIntPropertyFilteredByShardID(dynamicconfig.TimerProcessorBatchSize),
+		TimerProcessorMaxPendingTasks:                     dc.GetIntPropertyFilteredByShardID(dynamicconfig.TimerProcessorMaxPendingTasks),
 		TimerTaskDeleteBatchSize:                          dc.GetIntProperty(dynamicconfig.TimerTaskDeleteBatchSize),
 		TimerProcessorGetFailureRetryCount:                dc.GetIntProperty(dynamicconfig.TimerProcessorGetFailureRetryCount),
 		TimerProcessorCompleteTimerFailureRetryCount:      dc.GetIntProperty(dynamicconfig.TimerProcessorCompleteTimerFailureRetryCount),
@@ -531,7 +606,48 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		EnableActivityLocalDispatchByDomain: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableActivityLocalDispatchByDomain),
 		MaxActivityCountDispatchByDomain:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaxActivityCountDispatchByDomain),
 
-		ActivityMaxScheduleToStartTimeoutForRetry: dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityMaxScheduleToStartTimeoutForRetry),
+		ActivityMaxScheduleToStartTimeoutForRetry:       dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityMaxScheduleToStartTimeoutForRetry),
+		MaximumPendingSignalsPerActivity:                dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaximumPendingSignalsPerActivity),
+		ActivityCompletionDedupWindow:                   dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityCompletionDedupWindow),
+		EnableActivityResultDedup:                       dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableActivityResultDedup),
+		EnableActivityHeartbeatWorkflowCloseStatus:      dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableActivityHeartbeatWorkflowCloseStatus),
+		ActivityAuditLogSink:                            dc.GetStringPropertyFilteredByDomain(dynamicconfig.ActivityAuditLogSink),
+		ActivityAuditLogFilePath:                        dc.GetStringProperty(dynamicconfig.ActivityAuditLogFilePath),
+		ClosedActivityHeartbeatDetailsRetention:         dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ClosedActivityHeartbeatDetailsRetention),
+		ActivityMaxStartToCloseTimeout:                  dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityMaxStartToCloseTimeout),
+		DefaultActivityHeartbeatTimeout:                 dc.GetDurationPropertyFilteredByDomain(dynamicconfig.DefaultActivityHeartbeatTimeout),
+		ActivityHeartbeatTimerCoalesceInterval:          dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityHeartbeatTimerCoalesceInterval),
+		ActivityCancellationAckTimeout:                  dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityCancellationAckTimeout),
+		ActivityCancellationForceTimeout:                dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityCancellationForceTimeout),
+		ActivityMaxCancellationCheckpointGrace:          dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityMaxCancellationCheckpointGrace),
+		ActivityCancellationGracePeriod:                 dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityCancellationGracePeriod),
+		ActivityScheduleToCloseTimeoutCap:               dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityScheduleToCloseTimeoutCap),
+		ActivityScheduleToStartTimeoutCap:               dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityScheduleToStartTimeoutCap),
+		ActivityStartToCloseTimeoutCap:                  dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityStartToCloseTimeoutCap),
+		ActivityHeartbeatTimeoutCap:                     dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityHeartbeatTimeoutCap),
+		RejectActivityTimeoutsOverCap:                   dc.GetBoolPropertyFilteredByDomain(dynamicconfig.RejectActivityTimeoutsOverCap),
+		DisableActivityRetries:                          dc.GetBoolPropertyFilteredByDomain(dynamicconfig.DisableActivityRetries),
+		ActivityFallbackTaskListScheduleToStartTimeouts: dc.GetIntPropertyFilteredByDomain(dynamicconfig.ActivityFallbackTaskListScheduleToStartTimeouts),
+		ActivityStalledHeartbeatThreshold:               dc.GetIntPropertyFilteredByDomain(dynamicconfig.ActivityStalledHeartbeatThreshold),
+		IdempotentActivityRetryInitialInterval:          dc.GetDurationPropertyFilteredByDomain(dynamicconfig.IdempotentActivityRetryInitialInterval),
+		IdempotentActivityRetryMaximumAttempts:          dc.GetIntPropertyFilteredByDomain(dynamicconfig.IdempotentActivityRetryMaximumAttempts),
+		ActivityStubOutcomes:                            dc.GetMapProperty(dynamicconfig.ActivityStubOutcomes),
+		ActivityResultValidation:                        dc.GetMapProperty(dynamicconfig.ActivityResultValidation),
+		ActivityMaintenanceWindows:                      dc.GetMapProperty(dynamicconfig.ActivityMaintenanceWindows),
+		DisabledActivityKillSwitchTags:                  dc.GetMapProperty(dynamicconfig.DisabledActivityKillSwitchTags),
+		ActivityFailureAlertSinks:                       dc.GetMapProperty(dynamicconfig.ActivityFailureAlertSinks),
+		DefaultActivityHeader:                           dc.GetMapProperty(dynamicconfig.DefaultActivityHeader),
+		EnableActivityTypeCircuitBreaker:                dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableActivityTypeCircuitBreaker),
+		ActivityTypeCircuitBreakerFailureRate:           dc.GetFloat64Property(dynamicconfig.ActivityTypeCircuitBreakerFailureRate),
+		ActivityTypeCircuitBreakerMinRequests:           dc.GetIntPropertyFilteredByDomain(dynamicconfig.ActivityTypeCircuitBreakerMinRequests),
+		ActivityTypeCircuitBreakerWindow:                dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityTypeCircuitBreakerWindow),
+		ActivityTypeCircuitBreakerOpenDuration:          dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityTypeCircuitBreakerOpenDuration),
+		EnableActivityRetryStats:                        dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableActivityRetryStats),
+		ActivityRetryStatsRetention:                     dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityRetryStatsRetention),
+		WorkflowCounterLimit:                            dc.GetIntPropertyFilteredByDomain(dynamicconfig.WorkflowCounterLimit),
+		ActivityMaxNacks:                                dc.GetIntPropertyFilteredByDomain(dynamicconfig.ActivityMaxNacks),
+		ActivityResultArchivalThreshold:                 dc.GetIntPropertyFilteredByDomain(dynamicconfig.ActivityResultArchivalThreshold),
+		EnableExpireActivityHeartbeat:                   dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableExpireActivityHeartbeat),
 
 		EnableDebugMode:               dc.GetBoolProperty(dynamicconfig.EnableDebugMode)(),
 		EnableTaskInfoLogByDomainID:   dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.HistoryEnableTaskInfoLogByDomainID),
@@ -75,6 +75,7 @@ func TestNewConfig(t *testing.T) {
 		"WorkflowDeletionJitterRange":                          {dynamicconfig.WorkflowDeletionJitterRange, 20},
 		"DeleteHistoryEventContextTimeout":                     {dynamicconfig.DeleteHistoryEventContextTimeout, 21},
 		"MaxResponseSize":                                      {nil, maxMessageSize},
+		"MaxBatchDescribeWorkflowExecutionsSize":               {dynamicconfig.MaxBatchDescribeWorkflowExecutionsSize, 99},
 		"HistoryCacheInitialSize":                              {dynamicconfig.HistoryCacheInitialSize, 22},
 		"HistoryCacheMaxSize":                                  {dynamicconfig.HistoryCacheMaxSize, 23},
 		"HistoryCacheTTL":                                      {dynamicconfig.HistoryCacheTTL, time.Second},
@@ -118,6 +119,8 @@ func TestNewConfig(t *testing.T) {
 		"QueueProcessorEnableGracefulSyncShutdown":             {dynamicconfig.QueueProcessorEnableGracefulSyncShutdown, true},
 		"QueueProcessorEnablePersistQueueStates":               {dynamicconfig.QueueProcessorEnablePersistQueueStates, true},
 		"TimerTaskBatchSize":                                   {dynamicconfig.TimerTaskBatchSize, 39},
+		"TimerProcessorBatchSize":                              {dynamicconfig.TimerProcessorBatchSize, 100},
+		"TimerProcessorMaxPendingTasks":                        {dynamicconfig.TimerProcessorMaxPendingTasks, 101},
 		"TimerTaskDeleteBatchSize":                             {dynamicconfig.TimerTaskDeleteBatchSize, 40},
 		"TimerProcessorGetFailureRetryCount":                   {dynamicconfig.TimerProcessorGetFailureRetryCount, 41},
 		"TimerProcessorCompleteTimerFailureRetryCount":         {dynamicconfig.TimerProcessorCompleteTimerFailureRetryCount, 42},
@@ -237,6 +240,47 @@ func TestNewConfig(t *testing.T) {
 		"EnableActivityLocalDispatchByDomain":                  {dynamicconfig.EnableActivityLocalDispatchByDomain, true},
 		"MaxActivityCountDispatchByDomain":                     {dynamicconfig.MaxActivityCountDispatchByDomain, 92},
 		"ActivityMaxScheduleToStartTimeoutForRetry":            {dynamicconfig.ActivityMaxScheduleToStartTimeoutForRetry, time.Second},
+		"MaximumPendingSignalsPerActivity":                     {dynamicconfig.MaximumPendingSignalsPerActivity, 98},
+		"ActivityCompletionDedupWindow":                        {dynamicconfig.ActivityCompletionDedupWindow, time.Second},
+		"EnableActivityResultDedup":                            {dynamicconfig.EnableActivityResultDedup, true},
+		"EnableActivityHeartbeatWorkflowCloseStatus":           {dynamicconfig.EnableActivityHeartbeatWorkflowCloseStatus, true},
+		"ActivityAuditLogSink":                                 {dynamicconfig.ActivityAuditLogSink, "kafka"},
+		"ActivityAuditLogFilePath":                             {dynamicconfig.ActivityAuditLogFilePath, "/var/log/cadence/activity-audit.log"},
+		"ClosedActivityHeartbeatDetailsRetention":              {dynamicconfig.ClosedActivityHeartbeatDetailsRetention, time.Second},
+		"ActivityMaxStartToCloseTimeout":                       {dynamicconfig.ActivityMaxStartToCloseTimeout, time.Minute},
+		"DefaultActivityHeartbeatTimeout":                      {dynamicconfig.DefaultActivityHeartbeatTimeout, time.Minute},
+		"ActivityHeartbeatTimerCoalesceInterval":               {dynamicconfig.ActivityHeartbeatTimerCoalesceInterval, 5 * time.Second},
+		"ActivityCancellationAckTimeout":                       {dynamicconfig.ActivityCancellationAckTimeout, time.Minute},
+		"ActivityCancellationForceTimeout":                     {dynamicconfig.ActivityCancellationForceTimeout, time.Minute},
+		"ActivityMaxCancellationCheckpointGrace":               {dynamicconfig.ActivityMaxCancellationCheckpointGrace, 30 * time.Second},
+		"ActivityCancellationGracePeriod":                      {dynamicconfig.ActivityCancellationGracePeriod, 15 * time.Second},
+		"ActivityScheduleToCloseTimeoutCap":                    {dynamicconfig.ActivityScheduleToCloseTimeoutCap, 24 * time.Hour},
+		"ActivityScheduleToStartTimeoutCap":                    {dynamicconfig.ActivityScheduleToStartTimeoutCap, 2 * time.Hour},
+		"ActivityStartToCloseTimeoutCap":                       {dynamicconfig.ActivityStartToCloseTimeoutCap, 3 * time.Hour},
+		"ActivityHeartbeatTimeoutCap":                          {dynamicconfig.ActivityHeartbeatTimeoutCap, 10 * time.Minute},
+		"RejectActivityTimeoutsOverCap":                        {dynamicconfig.RejectActivityTimeoutsOverCap, true},
+		"DisableActivityRetries":                               {dynamicconfig.DisableActivityRetries, true},
+		"ActivityFallbackTaskListScheduleToStartTimeouts":      {dynamicconfig.ActivityFallbackTaskListScheduleToStartTimeouts, 33},
+		"ActivityStalledHeartbeatThreshold":                    {dynamicconfig.ActivityStalledHeartbeatThreshold, 36},
+		"IdempotentActivityRetryInitialInterval":               {dynamicconfig.IdempotentActivityRetryInitialInterval, 2 * time.Second},
+		"IdempotentActivityRetryMaximumAttempts":               {dynamicconfig.IdempotentActivityRetryMaximumAttempts, 102},
+		"ActivityStubOutcomes":                                 {dynamicconfig.ActivityStubOutcomes, map[string]interface{}{"stub": 1}},
+		"ActivityResultValidation":                             {dynamicconfig.ActivityResultValidation, map[string]interface{}{"validated": true}},
+		"ActivityMaintenanceWindows":                           {dynamicconfig.ActivityMaintenanceWindows, map[string]interface{}{"*": map[string]interface{}{"duration": "1h"}}},
+		"DisabledActivityKillSwitchTags":                       {dynamicconfig.DisabledActivityKillSwitchTags, map[string]interface{}{"payments": true}},
+		"ActivityFailureAlertSinks":                            {dynamicconfig.ActivityFailureAlertSinks, map[string]interface{}{"payments": "pager"}},
+		"DefaultActivityHeader":                                {dynamicconfig.DefaultActivityHeader, map[string]interface{}{"tenant": "payments"}},
+		"EnableActivityTypeCircuitBreaker":                     {dynamicconfig.EnableActivityTypeCircuitBreaker, true},
+		"ActivityTypeCircuitBreakerFailureRate":                {dynamicconfig.ActivityTypeCircuitBreakerFailureRate, 18.0},
+		"ActivityTypeCircuitBreakerMinRequests":                {dynamicconfig.ActivityTypeCircuitBreakerMinRequests, 103},
+		"ActivityTypeCircuitBreakerWindow":                     {dynamicconfig.ActivityTypeCircuitBreakerWindow, time.Minute},
+		"ActivityTypeCircuitBreakerOpenDuration":               {dynamicconfig.ActivityTypeCircuitBreakerOpenDuration, 3 * time.Second},
+		"EnableActivityRetryStats":                             {dynamicconfig.EnableActivityRetryStats, true},
+		"ActivityRetryStatsRetention":                          {dynamicconfig.ActivityRetryStatsRetention, 2 * time.Hour},
+		"WorkflowCounterLimit":                                 {dynamicconfig.WorkflowCounterLimit, 104},
+		"ActivityMaxNacks":                                     {dynamicconfig.ActivityMaxNacks, 105},
+		"ActivityResultArchivalThreshold":                      {dynamicconfig.ActivityResultArchivalThreshold, 106},
+		"EnableExpireActivityHeartbeat":                        {dynamicconfig.EnableExpireActivityHeartbeat, true},
 		"EnableDebugMode":                                      {dynamicconfig.EnableDebugMode, true},
 		"EnableTaskInfoLogByDomainID":                          {dynamicconfig.HistoryEnableTaskInfoLogByDomainID, true},
 		"EnableTimerDebugLogByDomainID":                        {dynamicconfig.EnableTimerDebugLogByDomainID, true},
@@ -30,7 +30,10 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/elasticsearch/validator"
+	"github.com/uber/cadence/common/jsonpath"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -200,6 +203,86 @@ func (c *workflowSizeChecker) failWorkflowSizeExceedsLimit() (bool, error) {
 	return false, nil
 }
 
+func (v *attrValidator) validateActivityScheduleBatchAttributes(
+	attributes *types.ScheduleActivityTasksBatchDecisionAttributes,
+) error {
+
+	if attributes == nil {
+		return &types.BadRequestError{Message: "ScheduleActivityTasksBatchDecisionAttributes is not set on decision."}
+	}
+
+	if len(attributes.Activities) == 0 {
+		return &types.BadRequestError{Message: "Activities is not set on decision."}
+	}
+
+	for _, activity := range attributes.Activities {
+		if activity == nil {
+			return &types.BadRequestError{Message: "Activities contains an empty activity."}
+		}
+	}
+
+	// attributes of the individual activities are validated when the batch is expanded
+	return nil
+}
+
+func (v *attrValidator) validateActivityScheduleWeightedAttributes(
+	attributes *types.ScheduleWeightedActivityDecisionAttributes,
+) error {
+
+	if attributes == nil {
+		return &types.BadRequestError{Message: "ScheduleWeightedActivityDecisionAttributes is not set on decision."}
+	}
+	if attributes.ScheduleAttributes == nil {
+		return &types.BadRequestError{Message: "ScheduleAttributes is not set on decision."}
+	}
+	if attributes.ScheduleAttributes.ActivityType != nil {
+		return &types.BadRequestError{Message: "ScheduleAttributes.ActivityType must not be set, it is selected among Candidates."}
+	}
+	if len(attributes.Candidates) == 0 {
+		return &types.BadRequestError{Message: "Candidates are not set on decision."}
+	}
+	for _, candidate := range attributes.Candidates {
+		if candidate.GetActivityType().GetName() == "" {
+			return &types.BadRequestError{Message: "ActivityType is not set on candidate."}
+		}
+		if candidate.GetWeight() <= 0 {
+			return &types.BadRequestError{Message: fmt.Sprintf("Weight of candidate %v must be positive.", candidate.GetActivityType().GetName())}
+		}
+	}
+
+	// the selected activity is validated when it is scheduled
+	return nil
+}
+
+func (v *attrValidator) validateActivityReplaceAttributes(
+	attributes *types.ReplaceActivityTaskDecisionAttributes,
+	mutableState execution.MutableState,
+) error {
+
+	if attributes == nil {
+		return &types.BadRequestError{Message: "ReplaceActivityTaskDecisionAttributes is not set on decision."}
+	}
+	if attributes.GetActivityID() == "" {
+		return &types.BadRequestError{Message: "ActivityID is not set on decision."}
+	}
+	if attributes.NewScheduleAttributes == nil {
+		return &types.BadRequestError{Message: "NewScheduleAttributes is not set on decision."}
+	}
+	if attributes.NewScheduleAttributes.GetActivityID() == attributes.GetActivityID() {
+		return &types.BadRequestError{Message: "NewScheduleAttributes.ActivityID must differ from the replaced ActivityID."}
+	}
+	ai, ok := mutableState.GetActivityByActivityID(attributes.GetActivityID())
+	if !ok {
+		return &types.BadRequestError{Message: fmt.Sprintf("Activity %v is not pending.", attributes.GetActivityID())}
+	}
+	if ai.CancelRequested {
+		return &types.BadRequestError{Message: fmt.Sprintf("Activity %v is already cancel requested.", attributes.GetActivityID())}
+	}
+
+	// the new activity is validated when it is scheduled
+	return nil
+}
+
 func (v *attrValidator) validateActivityScheduleAttributes(
 	domainID string,
 	targetDomainID string,
@@ -219,11 +302,24 @@ func (v *attrValidator) validateActivityScheduleAttributes(
 		return &types.BadRequestError{Message: "ScheduleActivityTaskDecisionAttributes is not set on decision."}
 	}
 
+	// the domain of activities scheduled in the domain of the workflow is not set on the attributes,
+	// so the per-domain config is looked up with the name of the domain of the workflow
+	domainName, err := v.domainCache.GetDomainName(domainID)
+	if err != nil {
+		return err
+	}
+
 	defaultTaskListName := ""
 	if _, err := v.validatedTaskList(attributes.TaskList, defaultTaskListName, metricsScope, attributes.GetDomain()); err != nil {
 		return err
 	}
 
+	if attributes.FallbackTaskList != nil {
+		if _, err := v.validatedTaskList(attributes.FallbackTaskList, defaultTaskListName, metricsScope, attributes.GetDomain()); err != nil {
+			return err
+		}
+	}
+
 	if attributes.GetActivityID() == "" {
 		return &types.BadRequestError{Message: "ActivityId is not set on decision."}
 	}
@@ -236,6 +332,16 @@ func (v *attrValidator) validateActivityScheduleAttributes(
 		return err
 	}
 
+	if attributes.ParentInitiatedChildID != nil && attributes.GetParentInitiatedChildID() <= 0 {
+		return &types.BadRequestError{Message: "ParentInitiatedChildID is not a valid event ID."}
+	}
+
+	for _, capability := range attributes.GetRequiredCapabilities() {
+		if capability == "" {
+			return &types.BadRequestError{Message: "RequiredCapabilities contains an empty capability."}
+		}
+	}
+
 	idLengthWarnLimit := v.config.MaxIDLengthWarnLimit()
 	if !common.IsValidIDLength(
 		attributes.GetActivityID(),
@@ -273,12 +379,116 @@ func (v *attrValidator) validateActivityScheduleAttributes(
 		return &types.BadRequestError{Message: "Domain exceeds length limit."}
 	}
 
+	if attributes.SearchAttributes != nil {
+		if err := v.searchAttributesValidator.ValidateSearchAttributes(attributes.SearchAttributes, domainName); err != nil {
+			return err
+		}
+	}
+
+	if attributes.OrderedDispatch && attributes.RequestLocalDispatch {
+		return &types.BadRequestError{Message: "An activity with OrderedDispatch cannot request local dispatch."}
+	}
+
+	if attributes.AtMostOnce && attributes.Idempotent {
+		return &types.BadRequestError{Message: "An activity with AtMostOnce cannot be Idempotent."}
+	}
+
+	if attributes.DependsOnActivityID != "" && attributes.RequestLocalDispatch {
+		return &types.BadRequestError{Message: "An activity with DependsOnActivityID cannot request local dispatch."}
+	}
+
+	if window := attributes.DispatchWindow; window != nil {
+		if err := common.ValidateActivityDispatchWindow(window); err != nil {
+			return err
+		}
+		if attributes.RequestLocalDispatch {
+			return &types.BadRequestError{Message: "An activity with a DispatchWindow cannot request local dispatch."}
+		}
+		if window.Timezone == "" {
+			window.Timezone = time.UTC.String()
+		}
+	}
+
+	if grace := attributes.GetCancellationCheckpointGraceSeconds(); grace != 0 {
+		if grace < 0 {
+			return &types.BadRequestError{Message: "CancellationCheckpointGraceSeconds may not be negative."}
+		}
+		if maxGrace := int32(v.config.ActivityMaxCancellationCheckpointGrace(domainName).Seconds()); grace > maxGrace {
+			return &types.BadRequestError{Message: fmt.Sprintf(
+				"CancellationCheckpointGraceSeconds of the activity exceeds the limit of %v seconds of the domain.", maxGrace)}
+		}
+	}
+
+	if attributes.GetPriority() < 0 {
+		return &types.BadRequestError{Message: "Priority may not be negative."}
+	}
+
+	if attributes.GetExpectedResultSizeBytes() < 0 {
+		return &types.BadRequestError{Message: "ExpectedResultSizeBytes may not be negative."}
+	}
+
+	if err := v.validateActivityResultSearchAttributes(attributes); err != nil {
+		return err
+	}
+
+	if next := attributes.NextActivity; next != nil {
+		if len(next.Input) > 0 {
+			return &types.BadRequestError{Message: "Input of NextActivity is forwarded from the result of the activity and cannot be set on decision."}
+		}
+		if next.GetDomain() != attributes.GetDomain() {
+			return &types.BadRequestError{Message: "NextActivity must be scheduled in the domain of the activity."}
+		}
+		if next.TaskList == nil {
+			next.TaskList = &types.TaskList{Name: attributes.TaskList.GetName(), Kind: attributes.TaskList.Kind}
+		} else if next.TaskList.GetName() != attributes.TaskList.GetName() {
+			return &types.BadRequestError{Message: "NextActivity must be scheduled on the task list of the activity."}
+		}
+		if next.RequestLocalDispatch {
+			return &types.BadRequestError{Message: "NextActivity cannot request local dispatch."}
+		}
+		// the result forwarded to the next activity is encrypted with the key of the activity
+		if next.EncryptionKeyID == "" {
+			next.EncryptionKeyID = attributes.EncryptionKeyID
+		} else if next.EncryptionKeyID != attributes.EncryptionKeyID {
+			return &types.BadRequestError{Message: "NextActivity must be scheduled with the encryption key of the activity."}
+		}
+		if err := v.validateActivityScheduleAttributes(domainID, targetDomainID, next, wfTimeout, metricsScope); err != nil {
+			return err
+		}
+	}
+
 	// Only attempt to deduce and fill in unspecified timeouts only when all timeouts are non-negative.
 	if attributes.GetScheduleToCloseTimeoutSeconds() < 0 || attributes.GetScheduleToStartTimeoutSeconds() < 0 ||
-		attributes.GetStartToCloseTimeoutSeconds() < 0 || attributes.GetHeartbeatTimeoutSeconds() < 0 {
+		attributes.GetStartToCloseTimeoutSeconds() < 0 || attributes.GetHeartbeatTimeoutSeconds() < 0 ||
+		attributes.GetFirstAttemptStartToCloseSeconds() < 0 {
 		return &types.BadRequestError{Message: "A valid timeout may not be negative."}
 	}
 
+	// an unset HeartbeatTimeout gets the default of the domain, while an explicit 0 keeps heartbeat timeouts
+	// disabled for the activity. The effective value is recorded on the scheduled event
+	if attributes.HeartbeatTimeoutSeconds == nil {
+		if defaultTimeout := v.config.DefaultActivityHeartbeatTimeout(domainName); defaultTimeout > 0 {
+			attributes.HeartbeatTimeoutSeconds = common.Int32Ptr(int32(defaultTimeout.Seconds()))
+		}
+	}
+
+	// an idempotent activity is safe to retry, without a retry policy it gets the default one for such activities.
+	// It is recorded on the scheduled event like an explicit one
+	if attributes.Idempotent && attributes.RetryPolicy == nil {
+		attributes.RetryPolicy = v.idempotentActivityRetryPolicy(domainName)
+	}
+
+	if err := v.validateActivityTaskListEscalation(attributes, metricsScope); err != nil {
+		return err
+	}
+
+	// the default header fields of the domain are added to the header of the activity, unless the decider set them.
+	// The merged header is recorded on the scheduled event
+	attributes.Header = mergeDefaultActivityHeader(
+		attributes.Header,
+		v.config.DefaultActivityHeader(dynamicconfig.DomainFilter(domainName)),
+	)
+
 	// ensure activity timeout never larger than workflow timeout
 	if attributes.GetScheduleToCloseTimeoutSeconds() > wfTimeout {
 		attributes.ScheduleToCloseTimeoutSeconds = common.Int32Ptr(wfTimeout)
@@ -292,6 +502,9 @@ func (v *attrValidator) validateActivityScheduleAttributes(
 	if attributes.GetHeartbeatTimeoutSeconds() > wfTimeout {
 		attributes.HeartbeatTimeoutSeconds = common.Int32Ptr(wfTimeout)
 	}
+	if attributes.GetFirstAttemptStartToCloseSeconds() > wfTimeout {
+		attributes.FirstAttemptStartToCloseSeconds = common.Int32Ptr(wfTimeout)
+	}
 
 	validScheduleToClose := attributes.GetScheduleToCloseTimeoutSeconds() > 0
 	validScheduleToStart := attributes.GetScheduleToStartTimeoutSeconds() > 0
@@ -332,7 +545,8 @@ func (v *attrValidator) validateActivityScheduleAttributes(
 			expiration = wfTimeout
 		}
 
-		if isScheduleToStartRetryable {
+		// an activity with a TaskListEscalation is scheduled again on another tasklist, which is not a no-op
+		if isScheduleToStartRetryable && len(attributes.TaskListEscalation) == 0 {
 			// If schedule to start timeout is retryable, we don't need to fail the activity and schedule
 			// it again on the same tasklist, as it's a no-op). Extending schedule to start timeout to achieve
 			// the same thing.
@@ -343,7 +557,6 @@ func (v *attrValidator) validateActivityScheduleAttributes(
 			// to a maximum value, so that when the activity task got lost, timeout can happen sooner and schedule
 			// the activity again.
 
-			domainName, _ := v.domainCache.GetDomainName(domainID) // if this call returns an error, we will just used the default value for max timeout
 			maximumScheduleToStartTimeoutForRetryInSeconds := int32(v.config.ActivityMaxScheduleToStartTimeoutForRetry(domainName).Seconds())
 			scheduleToStartExpiration := common.MinInt32(expiration, maximumScheduleToStartTimeoutForRetryInSeconds)
 			if attributes.GetScheduleToStartTimeoutSeconds() < scheduleToStartExpiration {
@@ -363,9 +576,154 @@ func (v *attrValidator) validateActivityScheduleAttributes(
 			attributes.ScheduleToCloseTimeoutSeconds = common.Int32Ptr(expiration)
 		}
 	}
+	return v.capActivityTimeouts(attributes, domainName, metricsScope)
+}
+
+// validateActivityTaskListEscalation checks the task lists of the retries of the activity. The escalation only
+// picks the task list of each retry, so it needs a retry policy, and task lists past the last retry the
+// policy allows would never be used.
+func (v *attrValidator) validateActivityTaskListEscalation(
+	attributes *types.ScheduleActivityTaskDecisionAttributes,
+	metricsScope int,
+) error {
+
+	escalation := attributes.GetTaskListEscalation()
+	if len(escalation) == 0 {
+		return nil
+	}
+	if attributes.RetryPolicy == nil {
+		return &types.BadRequestError{Message: "An activity with a TaskListEscalation requires a RetryPolicy."}
+	}
+	if attributes.FallbackTaskList != nil {
+		return &types.BadRequestError{Message: "An activity with a TaskListEscalation cannot have a FallbackTaskList."}
+	}
+	if maximumAttempts := attributes.RetryPolicy.GetMaximumAttempts(); maximumAttempts > 0 && int32(len(escalation)) > maximumAttempts-1 {
+		return &types.BadRequestError{Message: fmt.Sprintf(
+			"TaskListEscalation has %v task lists while the RetryPolicy allows %v retries.", len(escalation), maximumAttempts-1)}
+	}
+	for _, taskList := range escalation {
+		if _, err := v.validatedTaskList(taskList, "", metricsScope, attributes.GetDomain()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateActivityResultSearchAttributes checks the search attributes extracted from the result of the activity are
+// registered, and that their paths parse. Whether a path matches the result is only known once the activity completes.
+func (v *attrValidator) validateActivityResultSearchAttributes(
+	attributes *types.ScheduleActivityTaskDecisionAttributes,
+) error {
+
+	extractors := attributes.GetResultSearchAttributes()
+	if len(extractors) == 0 {
+		return nil
+	}
+	if attributes.EncryptionKeyID != "" {
+		return &types.BadRequestError{Message: "ResultSearchAttributes cannot be extracted from an encrypted result."}
+	}
+	validateKeys := v.config.EnableQueryAttributeValidation == nil || v.config.EnableQueryAttributeValidation()
+	validSearchAttributes := v.config.ValidSearchAttributes()
+	keys := make(map[string]struct{}, len(extractors))
+	for _, extractor := range extractors {
+		key := extractor.GetSearchAttributeKey()
+		if key == "" {
+			return &types.BadRequestError{Message: "SearchAttributeKey of ResultSearchAttributes is not set."}
+		}
+		if _, ok := keys[key]; ok {
+			return &types.BadRequestError{Message: fmt.Sprintf("ResultSearchAttributes extracts %v more than once.", key)}
+		}
+		keys[key] = struct{}{}
+		if definition.IsSystemIndexedKey(key) {
+			return &types.BadRequestError{Message: fmt.Sprintf("%v is read-only Cadence reservered attribute", key)}
+		}
+		if _, ok := validSearchAttributes[key]; !ok && validateKeys {
+			return &types.BadRequestError{Message: fmt.Sprintf("%v is not a valid search attribute key", key)}
+		}
+		if _, err := jsonpath.Parse(extractor.GetJSONPath()); err != nil {
+			return &types.BadRequestError{Message: fmt.Sprintf("Invalid JSONPath of ResultSearchAttributes: %v.", err)}
+		}
+	}
 	return nil
 }
 
+// capActivityTimeouts clamps the timeouts of an activity to the caps of the domain, or rejects the activity if the
+// domain rejects timeouts over their cap. It runs once the timeouts are deduced and extended for the retry policy,
+// so the clamped values are the effective ones recorded on the scheduled event
+func (v *attrValidator) capActivityTimeouts(
+	attributes *types.ScheduleActivityTaskDecisionAttributes,
+	domainName string,
+	metricsScope int,
+) error {
+
+	timeouts := []struct {
+		name    string
+		seconds **int32
+		cap     time.Duration
+	}{
+		{"ScheduleToCloseTimeout", &attributes.ScheduleToCloseTimeoutSeconds, v.config.ActivityScheduleToCloseTimeoutCap(domainName)},
+		{"ScheduleToStartTimeout", &attributes.ScheduleToStartTimeoutSeconds, v.config.ActivityScheduleToStartTimeoutCap(domainName)},
+		{"StartToCloseTimeout", &attributes.StartToCloseTimeoutSeconds, v.config.ActivityStartToCloseTimeoutCap(domainName)},
+		{"FirstAttemptStartToCloseTimeout", &attributes.FirstAttemptStartToCloseSeconds, v.config.ActivityStartToCloseTimeoutCap(domainName)},
+		{"HeartbeatTimeout", &attributes.HeartbeatTimeoutSeconds, v.config.ActivityHeartbeatTimeoutCap(domainName)},
+	}
+	for _, timeout := range timeouts {
+		capSeconds := int32(timeout.cap.Seconds())
+		if capSeconds <= 0 || common.Int32Default(*timeout.seconds) <= capSeconds {
+			continue
+		}
+		if v.config.RejectActivityTimeoutsOverCap(domainName) {
+			return &types.BadRequestError{Message: fmt.Sprintf(
+				"%v of the activity exceeds the limit of %v seconds of the domain.", timeout.name, capSeconds)}
+		}
+		*timeout.seconds = common.Int32Ptr(capSeconds)
+		v.metricsClient.Scope(metricsScope, metrics.DomainTag(domainName)).IncCounter(metrics.ActivityTimeoutClampedCounter)
+	}
+	return nil
+}
+
+// mergeDefaultActivityHeader adds the default fields with a string value to the header, fields already in the
+// header are kept as is
+func mergeDefaultActivityHeader(
+	header *types.Header,
+	defaultFields map[string]interface{},
+) *types.Header {
+
+	if len(defaultFields) == 0 {
+		return header
+	}
+	fields := make(map[string][]byte, len(defaultFields)+len(header.GetFields()))
+	for key, value := range defaultFields {
+		if value, ok := value.(string); ok {
+			fields[key] = []byte(value)
+		}
+	}
+	for key, value := range header.GetFields() {
+		fields[key] = value
+	}
+	if len(fields) == 0 {
+		return header
+	}
+	return &types.Header{Fields: fields}
+}
+
+func (v *attrValidator) idempotentActivityRetryPolicy(domainName string) *types.RetryPolicy {
+	maximumAttempts := int32(v.config.IdempotentActivityRetryMaximumAttempts(domainName))
+	if maximumAttempts <= 0 {
+		return nil
+	}
+	initialInterval := int32(v.config.IdempotentActivityRetryInitialInterval(domainName).Seconds())
+	if initialInterval < 1 {
+		initialInterval = 1
+	}
+	return &types.RetryPolicy{
+		InitialIntervalInSeconds: initialInterval,
+		BackoffCoefficient:       2,
+		MaximumIntervalInSeconds: initialInterval * 100,
+		MaximumAttempts:          maximumAttempts,
+	}
+}
+
 func (v *attrValidator) validateTimerScheduleAttributes(
 	attributes *types.StartTimerDecisionAttributes,
 	metricsScope int,
@@ -473,10 +831,30 @@ func (v *attrValidator) validateRecordMarkerAttributes(
 		tag.IDTypeMarkerName) {
 		return &types.BadRequestError{Message: "MarkerName exceeds length limit."}
 	}
+	if attributes.GetMarkerName() == common.ActivityAttemptsResetMarkerName {
+		return &types.BadRequestError{Message: "MarkerName is reserved."}
+	}
 
 	return nil
 }
 
+func (v *attrValidator) validateRecordActivityHeartbeatSnapshotAttributes(
+	attributes *types.RecordActivityHeartbeatSnapshotDecisionAttributes,
+	mutableState execution.MutableState,
+) error {
+
+	if attributes == nil {
+		return &types.BadRequestError{Message: "RecordActivityHeartbeatSnapshotDecisionAttributes is not set on decision."}
+	}
+	if attributes.GetActivityID() == "" {
+		return &types.BadRequestError{Message: "ActivityID is not set on decision."}
+	}
+	if _, ok := mutableState.GetActivityByActivityID(attributes.GetActivityID()); !ok {
+		return &types.BadRequestError{Message: fmt.Sprintf("Activity %v is not pending.", attributes.GetActivityID())}
+	}
+	return nil
+}
+
 func (v *attrValidator) validateCompleteWorkflowExecutionAttributes(
 	attributes *types.CompleteWorkflowExecutionDecisionAttributes,
 ) error {
@@ -61,6 +61,7 @@ type (
 		validator *attrValidator
 
 		testDomainID       string
+		testDomainName     string
 		testTargetDomainID string
 
 		testActivityMaxScheduleToStartTimeoutForRetryInSeconds int32
@@ -74,6 +75,7 @@ func TestAttrValidatorSuite(t *testing.T) {
 
 func (s *attrValidatorSuite) SetupSuite() {
 	s.testDomainID = "test domain ID"
+	s.testDomainName = "test domain name"
 	s.testTargetDomainID = "test target domain ID"
 	s.testActivityMaxScheduleToStartTimeoutForRetryInSeconds = 1800
 }
@@ -86,6 +88,7 @@ func (s *attrValidatorSuite) SetupTest() {
 
 	s.controller = gomock.NewController(s.T())
 	s.mockDomainCache = cache.NewMockDomainCache(s.controller)
+	s.mockDomainCache.EXPECT().GetDomainName(s.testDomainID).Return(s.testDomainName, nil).AnyTimes()
 	config := &config.Config{
 		MaxIDLengthWarnLimit:              dynamicconfig.GetIntPropertyFn(128),
 		DomainNameMaxLength:               dynamicconfig.GetIntPropertyFilteredByDomain(1000),
@@ -107,7 +110,15 @@ func (s *attrValidatorSuite) SetupTest() {
 		ActivityMaxScheduleToStartTimeoutForRetry: dynamicconfig.GetDurationPropertyFnFilteredByDomain(
 			time.Duration(s.testActivityMaxScheduleToStartTimeoutForRetryInSeconds) * time.Second,
 		),
-		EnableCrossClusterOperationsForDomain: dynamicconfig.GetBoolPropertyFnFilteredByDomain(false),
+		EnableCrossClusterOperationsForDomain:  dynamicconfig.GetBoolPropertyFnFilteredByDomain(false),
+		DefaultActivityHeartbeatTimeout:        dynamicconfig.GetDurationPropertyFnFilteredByDomain(0),
+		ActivityScheduleToCloseTimeoutCap:      dynamicconfig.GetDurationPropertyFnFilteredByDomain(0),
+		ActivityScheduleToStartTimeoutCap:      dynamicconfig.GetDurationPropertyFnFilteredByDomain(0),
+		ActivityStartToCloseTimeoutCap:         dynamicconfig.GetDurationPropertyFnFilteredByDomain(0),
+		ActivityHeartbeatTimeoutCap:            dynamicconfig.GetDurationPropertyFnFilteredByDomain(0),
+		RejectActivityTimeoutsOverCap:          dynamicconfig.GetBoolPropertyFnFilteredByDomain(false),
+		ActivityMaxCancellationCheckpointGrace: dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Minute),
+		DefaultActivityHeader:                  dynamicconfig.GetMapPropertyFn(nil),
 	}
 	s.validator = newAttrValidator(
 		s.mockDomainCache,
@@ -184,6 +195,18 @@ func (s *attrValidatorSuite) TestValidateUpsertWorkflowSearchAttributes() {
 	s.Nil(err)
 }
 
+func (s *attrValidatorSuite) TestValidateRecordMarkerAttributes() {
+	domainName := "testDomain"
+	attributes := &types.RecordMarkerDecisionAttributes{MarkerName: "marker"}
+
+	err := s.validator.validateRecordMarkerAttributes(attributes, metrics.HistoryRespondDecisionTaskCompletedScope, domainName)
+	s.Nil(err)
+
+	attributes.MarkerName = common.ActivityAttemptsResetMarkerName
+	err = s.validator.validateRecordMarkerAttributes(attributes, metrics.HistoryRespondDecisionTaskCompletedScope, domainName)
+	s.EqualError(err, "MarkerName is reserved.")
+}
+
 func (s *attrValidatorSuite) TestValidateCrossDomainCall_LocalToLocal() {
 	domainEntry := cache.NewLocalDomainCacheEntryForTest(
 		&persistence.DomainInfo{Name: s.testDomainID},
@@ -642,9 +665,470 @@ func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_NoRetryPolic
 	s.Equal(expectedAttributesAfterValidation, attributes)
 }
 
-func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_WithRetryPolicy_ScheduleToStartRetryable() {
-	s.mockDomainCache.EXPECT().GetDomainName(s.testDomainID).Return("some random domain name", nil).Times(1)
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_DefaultHeartbeatTimeout() {
+	s.validator.config.DefaultActivityHeartbeatTimeout = func(domain string) time.Duration {
+		if domain == s.testDomainName {
+			return 3 * time.Second
+		}
+		return 0
+	}
+	wfTimeout := int32(5)
+	newAttributes := func(heartbeatTimeout *int32) *types.ScheduleActivityTaskDecisionAttributes {
+		return &types.ScheduleActivityTaskDecisionAttributes{
+			ActivityID: "some random activityID",
+			ActivityType: &types.ActivityType{
+				Name: "some random activity type",
+			},
+			Domain: s.testDomainID,
+			TaskList: &types.TaskList{
+				Name: "some random task list",
+			},
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(wfTimeout),
+			HeartbeatTimeoutSeconds:       heartbeatTimeout,
+		}
+	}
+
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomainID},
+		nil,
+		cluster.TestCurrentClusterName,
+	)
+	targetDomainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testTargetDomainID},
+		nil,
+		cluster.TestCurrentClusterName,
+	)
+	s.mockDomainCache.EXPECT().GetDomainByID(s.testDomainID).Return(domainEntry, nil).Times(3)
+	s.mockDomainCache.EXPECT().GetDomainByID(s.testTargetDomainID).Return(targetDomainEntry, nil).Times(3)
+
+	for _, tc := range []struct {
+		heartbeatTimeout *int32
+		expected         int32
+	}{
+		{heartbeatTimeout: nil, expected: 3},                // unset, the domain default applies
+		{heartbeatTimeout: common.Int32Ptr(0), expected: 0}, // explicit 0 keeps heartbeat timeouts disabled
+		{heartbeatTimeout: common.Int32Ptr(2), expected: 2}, // explicit value wins over the default
+	} {
+		attributes := newAttributes(tc.heartbeatTimeout)
+		err := s.validator.validateActivityScheduleAttributes(
+			s.testDomainID,
+			s.testTargetDomainID,
+			attributes,
+			wfTimeout,
+			metrics.HistoryRespondDecisionTaskCompletedScope,
+		)
+		s.Nil(err)
+		s.NotNil(attributes.HeartbeatTimeoutSeconds)
+		s.Equal(tc.expected, attributes.GetHeartbeatTimeoutSeconds())
+	}
+}
+
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_OrderedDispatch() {
+	wfTimeout := int32(5)
+	attributes := &types.ScheduleActivityTaskDecisionAttributes{
+		ActivityID:                    "some random activityID",
+		ActivityType:                  &types.ActivityType{Name: "some random activity type"},
+		TaskList:                      &types.TaskList{Name: "some random task list"},
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(wfTimeout),
+		OrderedDispatch:               true,
+	}
+	err := s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.Nil(err)
+
+	attributes.RequestLocalDispatch = true
+	err = s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_TimeoutCaps() {
+	// the caps are set for the domain of the workflow, which the attributes leave unset
+	domainCap := func(cap time.Duration) dynamicconfig.DurationPropertyFnWithDomainFilter {
+		return func(domain string) time.Duration {
+			if domain == s.testDomainName {
+				return cap
+			}
+			return 0
+		}
+	}
+	s.validator.config.ActivityScheduleToCloseTimeoutCap = domainCap(100 * time.Second)
+	s.validator.config.ActivityStartToCloseTimeoutCap = domainCap(30 * time.Second)
+	s.validator.config.ActivityHeartbeatTimeoutCap = domainCap(10 * time.Second)
+	wfTimeout := int32(1000)
+	newAttributes := func() *types.ScheduleActivityTaskDecisionAttributes {
+		return &types.ScheduleActivityTaskDecisionAttributes{
+			ActivityID:                    "some random activityID",
+			ActivityType:                  &types.ActivityType{Name: "some random activity type"},
+			TaskList:                      &types.TaskList{Name: "some random task list"},
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(500),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(50),
+			StartToCloseTimeoutSeconds:    common.Int32Ptr(20),
+			HeartbeatTimeoutSeconds:       common.Int32Ptr(60),
+		}
+	}
+
+	attributes := newAttributes()
+	err := s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.Nil(err)
+	s.Equal(int32(100), attributes.GetScheduleToCloseTimeoutSeconds())
+	s.Equal(int32(50), attributes.GetScheduleToStartTimeoutSeconds())
+	s.Equal(int32(20), attributes.GetStartToCloseTimeoutSeconds())
+	s.Equal(int32(10), attributes.GetHeartbeatTimeoutSeconds())
+
+	s.validator.config.RejectActivityTimeoutsOverCap = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	err = s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, newAttributes(), wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.Equal(&types.BadRequestError{Message: "ScheduleToCloseTimeout of the activity exceeds the limit of 100 seconds of the domain."}, err)
+}
+
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_DispatchWindow() {
+	wfTimeout := int32(5)
+	attributes := &types.ScheduleActivityTaskDecisionAttributes{
+		ActivityID:                    "some random activityID",
+		ActivityType:                  &types.ActivityType{Name: "some random activity type"},
+		TaskList:                      &types.TaskList{Name: "some random task list"},
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(wfTimeout),
+		DispatchWindow:                &types.ActivityDispatchWindow{StartTimeOfDay: "09:00", EndTimeOfDay: "17:00"},
+	}
+	err := s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.Nil(err)
+	s.Equal("UTC", attributes.DispatchWindow.Timezone)
+
+	attributes.RequestLocalDispatch = true
+	err = s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.IsType(&types.BadRequestError{}, err)
+
+	attributes.RequestLocalDispatch = false
+	attributes.DispatchWindow.EndTimeOfDay = "5pm"
+	err = s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_CancellationCheckpointGrace() {
+	s.validator.config.ActivityMaxCancellationCheckpointGrace = func(domain string) time.Duration {
+		if domain == s.testDomainName {
+			return 10 * time.Second
+		}
+		return 0
+	}
+	wfTimeout := int32(5)
+	attributes := &types.ScheduleActivityTaskDecisionAttributes{
+		ActivityID:                         "some random activityID",
+		ActivityType:                       &types.ActivityType{Name: "some random activity type"},
+		TaskList:                           &types.TaskList{Name: "some random task list"},
+		ScheduleToCloseTimeoutSeconds:      common.Int32Ptr(wfTimeout),
+		CancellationCheckpointGraceSeconds: common.Int32Ptr(10),
+	}
+	err := s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.Nil(err)
+
+	attributes.CancellationCheckpointGraceSeconds = common.Int32Ptr(11)
+	err = s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.IsType(&types.BadRequestError{}, err)
+
+	attributes.CancellationCheckpointGraceSeconds = common.Int32Ptr(-1)
+	err = s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_ExpectedResultSize() {
+	wfTimeout := int32(5)
+	attributes := &types.ScheduleActivityTaskDecisionAttributes{
+		ActivityID:                    "some random activityID",
+		ActivityType:                  &types.ActivityType{Name: "some random activity type"},
+		TaskList:                      &types.TaskList{Name: "some random task list"},
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(wfTimeout),
+		ExpectedResultSizeBytes:       common.Int64Ptr(1024),
+	}
+	err := s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.Nil(err)
+
+	attributes.ExpectedResultSizeBytes = common.Int64Ptr(-1)
+	err = s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_Priority() {
+	wfTimeout := int32(5)
+	attributes := &types.ScheduleActivityTaskDecisionAttributes{
+		ActivityID:                    "some random activityID",
+		ActivityType:                  &types.ActivityType{Name: "some random activity type"},
+		TaskList:                      &types.TaskList{Name: "some random task list"},
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(wfTimeout),
+		Priority:                      common.Int32Ptr(5),
+	}
+	err := s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.Nil(err)
+
+	attributes.Priority = common.Int32Ptr(-1)
+	err = s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_ResultSearchAttributes() {
+	wfTimeout := int32(5)
+	newAttributes := func(extractors ...*types.ActivityResultSearchAttribute) *types.ScheduleActivityTaskDecisionAttributes {
+		return &types.ScheduleActivityTaskDecisionAttributes{
+			ActivityID:                    "some random activityID",
+			ActivityType:                  &types.ActivityType{Name: "some random activity type"},
+			TaskList:                      &types.TaskList{Name: "some random task list"},
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(wfTimeout),
+			ResultSearchAttributes:        extractors,
+		}
+	}
+
+	attributes := newAttributes(
+		&types.ActivityResultSearchAttribute{JSONPath: "$.order.status", SearchAttributeKey: definition.CustomKeywordField},
+		&types.ActivityResultSearchAttribute{JSONPath: "$.order.items[0].quantity", SearchAttributeKey: definition.CustomIntField},
+	)
+	err := s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.Nil(err)
+
+	attributes.EncryptionKeyID = "some key"
+	err = s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.IsType(&types.BadRequestError{}, err)
+
+	for _, extractors := range [][]*types.ActivityResultSearchAttribute{
+		{{JSONPath: "$.status"}},
+		{{JSONPath: "status", SearchAttributeKey: definition.CustomKeywordField}},
+		{{JSONPath: "$.status", SearchAttributeKey: "UnknownField"}},
+		{{JSONPath: "$.status", SearchAttributeKey: definition.WorkflowType}},
+		{
+			{JSONPath: "$.status", SearchAttributeKey: definition.CustomKeywordField},
+			{JSONPath: "$.state", SearchAttributeKey: definition.CustomKeywordField},
+		},
+	} {
+		err = s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, newAttributes(extractors...), wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+		s.IsType(&types.BadRequestError{}, err)
+	}
+}
+
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_TaskListEscalation() {
+	wfTimeout := int32(5)
+	newAttributes := func(retryPolicy *types.RetryPolicy, escalation ...*types.TaskList) *types.ScheduleActivityTaskDecisionAttributes {
+		return &types.ScheduleActivityTaskDecisionAttributes{
+			ActivityID:                    "some random activityID",
+			ActivityType:                  &types.ActivityType{Name: "some random activity type"},
+			TaskList:                      &types.TaskList{Name: "fast task list"},
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(wfTimeout),
+			RetryPolicy:                   retryPolicy,
+			TaskListEscalation:            escalation,
+		}
+	}
+	retryPolicy := &types.RetryPolicy{
+		InitialIntervalInSeconds: 1,
+		BackoffCoefficient:       1,
+		MaximumAttempts:          3,
+	}
+	fallback := &types.TaskList{Name: "fallback task list"}
+	dlq := &types.TaskList{Name: "dlq task list"}
+
+	attributes := newAttributes(retryPolicy, fallback, dlq)
+	err := s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.Nil(err)
+
+	for _, attributes := range []*types.ScheduleActivityTaskDecisionAttributes{
+		// no retry policy
+		newAttributes(nil, fallback),
+		// more task lists than retries
+		newAttributes(retryPolicy, fallback, dlq, dlq),
+		// unset task list name
+		newAttributes(retryPolicy, &types.TaskList{}),
+	} {
+		err = s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+		s.IsType(&types.BadRequestError{}, err)
+	}
+
+	attributes = newAttributes(retryPolicy, fallback)
+	attributes.FallbackTaskList = dlq
+	err = s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_Idempotent() {
+	s.validator.config.IdempotentActivityRetryInitialInterval = dynamicconfig.GetDurationPropertyFnFilteredByDomain(2 * time.Second)
+	s.validator.config.IdempotentActivityRetryMaximumAttempts = func(domain string) int {
+		if domain == s.testDomainName {
+			return 8
+		}
+		return 0
+	}
+	wfTimeout := int32(5)
+	newAttributes := func(retryPolicy *types.RetryPolicy) *types.ScheduleActivityTaskDecisionAttributes {
+		return &types.ScheduleActivityTaskDecisionAttributes{
+			ActivityID:                    "some random activityID",
+			ActivityType:                  &types.ActivityType{Name: "some random activity type"},
+			TaskList:                      &types.TaskList{Name: "some random task list"},
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(wfTimeout),
+			Idempotent:                    true,
+			RetryPolicy:                   retryPolicy,
+		}
+	}
+
+	attributes := newAttributes(nil)
+	err := s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.Nil(err)
+	s.Equal(&types.RetryPolicy{
+		InitialIntervalInSeconds: 2,
+		BackoffCoefficient:       2,
+		MaximumIntervalInSeconds: 200,
+		MaximumAttempts:          8,
+	}, attributes.RetryPolicy)
+
+	// an explicit retry policy is kept
+	retryPolicy := &types.RetryPolicy{
+		InitialIntervalInSeconds: 1,
+		BackoffCoefficient:       1,
+		MaximumAttempts:          2,
+	}
+	attributes = newAttributes(retryPolicy)
+	err = s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.Nil(err)
+	s.Equal(retryPolicy, attributes.RetryPolicy)
+
+	// without a default number of attempts, the activity is not retried
+	s.validator.config.IdempotentActivityRetryMaximumAttempts = dynamicconfig.GetIntPropertyFilteredByDomain(0)
+	attributes = newAttributes(nil)
+	err = s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.Nil(err)
+	s.Nil(attributes.RetryPolicy)
+}
+
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_DefaultHeader() {
+	s.validator.config.DefaultActivityHeader = func(opts ...dynamicconfig.FilterOption) map[string]interface{} {
+		filters := make(map[dynamicconfig.Filter]interface{})
+		for _, opt := range opts {
+			opt(filters)
+		}
+		if filters[dynamicconfig.DomainName] != s.testDomainName {
+			return nil
+		}
+		return map[string]interface{}{
+			"tenant":  "payments",
+			"baggage": "default",
+			"ignored": 1,
+		}
+	}
+	wfTimeout := int32(5)
+	newAttributes := func(header *types.Header) *types.ScheduleActivityTaskDecisionAttributes {
+		return &types.ScheduleActivityTaskDecisionAttributes{
+			ActivityID:                    "some random activityID",
+			ActivityType:                  &types.ActivityType{Name: "some random activity type"},
+			TaskList:                      &types.TaskList{Name: "some random task list"},
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(wfTimeout),
+			Header:                        header,
+		}
+	}
+
+	attributes := newAttributes(nil)
+	err := s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.Nil(err)
+	s.Equal(&types.Header{Fields: map[string][]byte{
+		"tenant":  []byte("payments"),
+		"baggage": []byte("default"),
+	}}, attributes.Header)
+
+	// fields set by the decider take precedence
+	attributes = newAttributes(&types.Header{Fields: map[string][]byte{
+		"baggage": []byte("decider"),
+		"other":   []byte("value"),
+	}})
+	err = s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.Nil(err)
+	s.Equal(&types.Header{Fields: map[string][]byte{
+		"tenant":  []byte("payments"),
+		"baggage": []byte("decider"),
+		"other":   []byte("value"),
+	}}, attributes.Header)
+
+	// without defaults the header is left as is
+	s.validator.config.DefaultActivityHeader = dynamicconfig.GetMapPropertyFn(nil)
+	attributes = newAttributes(nil)
+	err = s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.Nil(err)
+	s.Nil(attributes.Header)
+}
+
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_AtMostOnceIdempotent() {
+	wfTimeout := int32(5)
+	attributes := &types.ScheduleActivityTaskDecisionAttributes{
+		ActivityID:                    "some random activityID",
+		ActivityType:                  &types.ActivityType{Name: "some random activity type"},
+		TaskList:                      &types.TaskList{Name: "some random task list"},
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(wfTimeout),
+		AtMostOnce:                    true,
+		Idempotent:                    true,
+	}
+
+	err := s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.IsType(&types.BadRequestError{}, err)
+
+	attributes.Idempotent = false
+	err = s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.Nil(err)
+}
+
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_DependsOnActivityLocalDispatch() {
+	wfTimeout := int32(5)
+	attributes := &types.ScheduleActivityTaskDecisionAttributes{
+		ActivityID:                    "some random activityID",
+		ActivityType:                  &types.ActivityType{Name: "some random activity type"},
+		TaskList:                      &types.TaskList{Name: "some random task list"},
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(wfTimeout),
+		DependsOnActivityID:           "some other activityID",
+		RequestLocalDispatch:          true,
+	}
+
+	err := s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.IsType(&types.BadRequestError{}, err)
+
+	attributes.RequestLocalDispatch = false
+	err = s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.Nil(err)
+}
+
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_NextActivity() {
+	wfTimeout := int32(5)
+	newAttributes := func(next *types.ScheduleActivityTaskDecisionAttributes) *types.ScheduleActivityTaskDecisionAttributes {
+		return &types.ScheduleActivityTaskDecisionAttributes{
+			ActivityID:                    "some random activityID",
+			ActivityType:                  &types.ActivityType{Name: "some random activity type"},
+			TaskList:                      &types.TaskList{Name: "some random task list"},
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(wfTimeout),
+			NextActivity:                  next,
+		}
+	}
+	newNext := func() *types.ScheduleActivityTaskDecisionAttributes {
+		return &types.ScheduleActivityTaskDecisionAttributes{
+			ActivityID:                    "next activityID",
+			ActivityType:                  &types.ActivityType{Name: "next activity type"},
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(10),
+		}
+	}
 
+	// the next activity inherits the task list of the activity and is validated as any activity
+	attributes := newAttributes(newNext())
+	err := s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, attributes, wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.Nil(err)
+	s.Equal("some random task list", attributes.NextActivity.TaskList.GetName())
+	s.Equal(wfTimeout, attributes.NextActivity.GetScheduleToCloseTimeoutSeconds())
+
+	for name, update := range map[string]func(next *types.ScheduleActivityTaskDecisionAttributes){
+		"input":        func(next *types.ScheduleActivityTaskDecisionAttributes) { next.Input = []byte{1} },
+		"other domain": func(next *types.ScheduleActivityTaskDecisionAttributes) { next.Domain = "other domain" },
+		"other task list": func(next *types.ScheduleActivityTaskDecisionAttributes) {
+			next.TaskList = &types.TaskList{Name: "other"}
+		},
+		"local dispatch": func(next *types.ScheduleActivityTaskDecisionAttributes) { next.RequestLocalDispatch = true },
+		"other key":      func(next *types.ScheduleActivityTaskDecisionAttributes) { next.EncryptionKeyID = "other key" },
+		"invalid":        func(next *types.ScheduleActivityTaskDecisionAttributes) { next.ActivityType = nil },
+	} {
+		next := newNext()
+		update(next)
+		err := s.validator.validateActivityScheduleAttributes(s.testDomainID, s.testDomainID, newAttributes(next), wfTimeout, metrics.HistoryRespondDecisionTaskCompletedScope)
+		s.IsType(&types.BadRequestError{}, err, name)
+	}
+}
+
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_WithRetryPolicy_ScheduleToStartRetryable() {
 	wfTimeout := int32(3000)
 	attributes := &types.ScheduleActivityTaskDecisionAttributes{
 		ActivityID: "some random activityID",
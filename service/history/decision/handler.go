@@ -614,6 +614,12 @@ Update_History_Loop:
 			if dr.activityDispatchInfo != nil {
 				activitiesToDispatchLocally[dr.activityDispatchInfo.ActivityID] = dr.activityDispatchInfo
 			}
+			if dr.generatedActivityID != "" {
+				if resp.GeneratedActivityIDs == nil {
+					resp.GeneratedActivityIDs = make(map[int32]string)
+				}
+				resp.GeneratedActivityIDs[dr.decisionIndex] = dr.generatedActivityID
+			}
 		}
 		logger.Debugf("%d activities will be dispatched locally on the client side")
 		resp.ActivitiesToDispatchLocally = activitiesToDispatchLocally
@@ -670,6 +676,7 @@ func (handler *handlerImpl) createRecordDecisionTaskStartedResponse(
 	}
 	response.ScheduledTimestamp = common.Int64Ptr(decision.ScheduledTimestamp)
 	response.StartedTimestamp = common.Int64Ptr(decision.StartedTimestamp)
+	response.ActivityBatchCompleted = executionInfo.ActivityBatchCompleted
 
 	if decision.Attempt > 0 {
 		// This decision is retried from mutable state
@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/dgryski/go-farm"
 	"github.com/pborman/uuid"
 
 	"github.com/uber/cadence/common"
@@ -32,6 +33,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
@@ -41,6 +43,8 @@ import (
 const (
 	activityCancellationMsgActivityIDUnknown  = "ACTIVITY_ID_UNKNOWN"
 	activityCancellationMsgActivityNotStarted = "ACTIVITY_ID_NOT_STARTED"
+
+	generatedActivityIDPrefix = "auto"
 )
 
 type (
@@ -77,6 +81,9 @@ type (
 
 	decisionResult struct {
 		activityDispatchInfo *types.ActivityLocalDispatchInfo
+		// set for the decisions whose activity ID was generated by history
+		decisionIndex       int32
+		generatedActivityID string
 	}
 )
 
@@ -137,7 +144,23 @@ func (handler *taskHandlerImpl) handleDecisions(
 	}
 
 	var results []*decisionResult
-	for _, decision := range decisions {
+	for index, decision := range decisions {
+		generatedResult, err := handler.generateActivityID(int32(index), decision)
+		if err != nil || handler.stopProcessing {
+			return nil, err
+		} else if generatedResult != nil {
+			results = append(results, generatedResult)
+		}
+
+		if decision.GetDecisionType() == types.DecisionTypeScheduleActivityTasksBatch {
+			batchResults, err := handler.handleDecisionScheduleActivitiesBatch(ctx, decision.ScheduleActivityTasksBatchDecisionAttributes)
+			if err != nil || handler.stopProcessing {
+				return nil, err
+			}
+			results = append(results, batchResults...)
+			continue
+		}
+
 		result, err := handler.handleDecisionWithResult(ctx, decision)
 		if err != nil || handler.stopProcessing {
 			return nil, err
@@ -150,13 +173,57 @@ func (handler *taskHandlerImpl) handleDecisions(
 	return results, nil
 }
 
+// generateActivityID assigns the activity ID of a decision scheduling an activity with AutoGenerateActivityID. The
+// ID is derived from the decision task completed event and the index of the decision, so it is unique within the
+// workflow run, and it is recorded in the scheduled event like any other activity ID.
+func (handler *taskHandlerImpl) generateActivityID(
+	index int32,
+	decision *types.Decision,
+) (*decisionResult, error) {
+
+	var attr *types.ScheduleActivityTaskDecisionAttributes
+	switch decision.GetDecisionType() {
+	case types.DecisionTypeScheduleActivityTask:
+		attr = decision.ScheduleActivityTaskDecisionAttributes
+	case types.DecisionTypeReplaceActivityTask:
+		attr = decision.ReplaceActivityTaskDecisionAttributes.GetNewScheduleAttributes()
+	case types.DecisionTypeScheduleWeightedActivity:
+		attr = decision.ScheduleWeightedActivityDecisionAttributes.GetScheduleAttributes()
+	}
+	if !attr.GetAutoGenerateActivityID() {
+		return nil, nil
+	}
+
+	if err := handler.validateDecisionAttr(
+		func() error {
+			if attr.ActivityID != "" {
+				return &types.BadRequestError{Message: "ActivityID cannot be set with AutoGenerateActivityID."}
+			}
+			return nil
+		},
+		types.DecisionTaskFailedCauseBadScheduleActivityAttributes,
+	); err != nil || handler.stopProcessing {
+		return nil, err
+	}
+
+	attr.ActivityID = fmt.Sprintf("%v-%v-%v", generatedActivityIDPrefix, handler.decisionTaskCompletedID, index)
+	return &decisionResult{
+		decisionIndex:       index,
+		generatedActivityID: attr.ActivityID,
+	}, nil
+}
+
 func (handler *taskHandlerImpl) handleDecisionWithResult(
 	ctx context.Context,
 	decision *types.Decision,
 ) (*decisionResult, error) {
 	switch decision.GetDecisionType() {
 	case types.DecisionTypeScheduleActivityTask:
-		return handler.handleDecisionScheduleActivity(ctx, decision.ScheduleActivityTaskDecisionAttributes)
+		return handler.handleDecisionScheduleActivity(ctx, decision.ScheduleActivityTaskDecisionAttributes, nil)
+	case types.DecisionTypeReplaceActivityTask:
+		return handler.handleDecisionReplaceActivity(ctx, decision.ReplaceActivityTaskDecisionAttributes)
+	case types.DecisionTypeScheduleWeightedActivity:
+		return handler.handleDecisionScheduleWeightedActivity(ctx, decision.ScheduleWeightedActivityDecisionAttributes)
 	default:
 		return nil, handler.handleDecision(ctx, decision)
 	}
@@ -204,6 +271,9 @@ func (handler *taskHandlerImpl) handleDecision(
 	case types.DecisionTypeUpsertWorkflowSearchAttributes:
 		return handler.handleDecisionUpsertWorkflowSearchAttributes(ctx, decision.UpsertWorkflowSearchAttributesDecisionAttributes)
 
+	case types.DecisionTypeRecordActivityHeartbeatSnapshot:
+		return handler.handleDecisionRecordActivityHeartbeatSnapshot(ctx, decision.RecordActivityHeartbeatSnapshotDecisionAttributes)
+
 	default:
 		return &types.BadRequestError{Message: fmt.Sprintf("Unknown decision type: %v", decision.GetDecisionType())}
 	}
@@ -212,6 +282,7 @@ func (handler *taskHandlerImpl) handleDecision(
 func (handler *taskHandlerImpl) handleDecisionScheduleActivity(
 	ctx context.Context,
 	attr *types.ScheduleActivityTaskDecisionAttributes,
+	weightedSelection *types.WeightedActivitySelection,
 ) (*decisionResult, error) {
 	handler.metricsClient.IncCounter(
 		metrics.HistoryRespondDecisionTaskCompletedScope,
@@ -232,13 +303,27 @@ func (handler *taskHandlerImpl) handleDecisionScheduleActivity(
 
 	if err := handler.validateDecisionAttr(
 		func() error {
-			return handler.attrValidator.validateActivityScheduleAttributes(
+			if err := handler.attrValidator.validateActivityScheduleAttributes(
 				domainID,
 				targetDomainID,
 				attr,
 				executionInfo.WorkflowTimeout,
 				metrics.HistoryRespondDecisionTaskCompletedScope,
-			)
+			); err != nil {
+				return err
+			}
+			// the child workflow must have been initiated by an earlier decision of this workflow,
+			// it does not need to be pending so that activities can handle the result of closed children
+			if attr.ParentInitiatedChildID != nil && attr.GetParentInitiatedChildID() >= handler.decisionTaskCompletedID {
+				return &types.BadRequestError{Message: "ParentInitiatedChildID does not refer to an earlier event."}
+			}
+			// the outcome of closed activities is not kept, so only a pending activity can be depended on
+			if attr.DependsOnActivityID != "" {
+				if _, ok := handler.mutableState.GetActivityByActivityID(attr.DependsOnActivityID); !ok {
+					return &types.BadRequestError{Message: "DependsOnActivityID does not refer to a pending activity."}
+				}
+			}
+			return nil
 		},
 		types.DecisionTaskFailedCauseBadScheduleActivityAttributes,
 	); err != nil || handler.stopProcessing {
@@ -254,9 +339,31 @@ func (handler *taskHandlerImpl) handleDecisionScheduleActivity(
 		handler.stopProcessing = true
 		return nil, err
 	}
+	if len(attr.OnTimeoutDefaultResult) > 0 {
+		failWorkflow, err = handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
+			metrics.DecisionTypeTag(types.DecisionTypeScheduleActivityTask.String()),
+			attr.OnTimeoutDefaultResult,
+			"ScheduleActivityTaskDecisionAttributes.OnTimeoutDefaultResult exceeds size limit.",
+		)
+		if err != nil || failWorkflow {
+			handler.stopProcessing = true
+			return nil, err
+		}
+	}
 
-	event, ai, activityDispatchInfo, dispatched, started, err := handler.mutableState.AddActivityTaskScheduledEvent(
-		ctx, handler.decisionTaskCompletedID, attr, handler.activityCountToDispatch > 0)
+	var (
+		event                *types.HistoryEvent
+		ai                   *persistence.ActivityInfo
+		activityDispatchInfo *types.ActivityLocalDispatchInfo
+		dispatched, started  bool
+	)
+	if weightedSelection != nil {
+		event, ai, activityDispatchInfo, dispatched, started, err = handler.mutableState.AddWeightedActivityTaskScheduledEvent(
+			ctx, handler.decisionTaskCompletedID, attr, weightedSelection, handler.activityCountToDispatch > 0)
+	} else {
+		event, ai, activityDispatchInfo, dispatched, started, err = handler.mutableState.AddActivityTaskScheduledEvent(
+			ctx, handler.decisionTaskCompletedID, attr, handler.activityCountToDispatch > 0)
+	}
 	if dispatched {
 		handler.activityCountToDispatch--
 	}
@@ -278,6 +385,7 @@ func (handler *taskHandlerImpl) handleDecisionScheduleActivity(
 				ScheduleAttempt: 0,
 				ActivityID:      ai.ActivityID,
 				ActivityType:    attr.ActivityType.GetName(),
+				TaskList:        ai.TaskList,
 			}
 			activityDispatchInfo.TaskToken, err = handler.tokenSerializer.Serialize(token)
 			if err != nil {
@@ -298,6 +406,170 @@ func (handler *taskHandlerImpl) handleDecisionScheduleActivity(
 	}
 }
 
+// handleDecisionReplaceActivity requests the cancellation of a pending activity and schedules its replacement,
+// as part of the same decision task completion
+func (handler *taskHandlerImpl) handleDecisionReplaceActivity(
+	ctx context.Context,
+	attr *types.ReplaceActivityTaskDecisionAttributes,
+) (*decisionResult, error) {
+
+	if err := handler.validateDecisionAttr(
+		func() error {
+			return handler.attrValidator.validateActivityReplaceAttributes(attr, handler.mutableState)
+		},
+		types.DecisionTaskFailedCauseBadRequestCancelActivityAttributes,
+	); err != nil || handler.stopProcessing {
+		return nil, err
+	}
+
+	actCancelReqEvent, ai, err := handler.mutableState.AddActivityTaskCancelRequestedEvent(
+		handler.decisionTaskCompletedID,
+		attr.GetActivityID(),
+		handler.identity,
+	)
+	if err != nil {
+		return nil, err
+	}
+	actCancelReqEvent.ActivityTaskCancelRequestedEventAttributes.ReplacementActivityID = attr.NewScheduleAttributes.GetActivityID()
+	if ai.StartedID == common.EmptyEventID {
+		if _, err := handler.mutableState.AddActivityTaskCanceledEvent(
+			ai.ScheduleID,
+			ai.StartedID,
+			actCancelReqEvent.ID,
+			[]byte(activityCancellationMsgActivityNotStarted),
+			handler.identity,
+		); err != nil {
+			return nil, err
+		}
+		handler.activityNotStartedCancelled = true
+	} else {
+		handler.setActivityCancellationEscalation(ai)
+	}
+
+	return handler.handleDecisionScheduleActivity(ctx, attr.NewScheduleAttributes, nil)
+}
+
+// handleDecisionScheduleWeightedActivity schedules an activity of the activity type selected among the candidates
+// of the decision, and records the selection on the scheduled event
+func (handler *taskHandlerImpl) handleDecisionScheduleWeightedActivity(
+	ctx context.Context,
+	attr *types.ScheduleWeightedActivityDecisionAttributes,
+) (*decisionResult, error) {
+
+	if err := handler.validateDecisionAttr(
+		func() error {
+			return handler.attrValidator.validateActivityScheduleWeightedAttributes(attr)
+		},
+		types.DecisionTaskFailedCauseBadScheduleActivityAttributes,
+	); err != nil || handler.stopProcessing {
+		return nil, err
+	}
+
+	seed := attr.GetSeed()
+	if attr.Seed == nil {
+		seed = weightedActivitySeed(handler.mutableState.GetExecutionInfo().WorkflowID, attr.ScheduleAttributes.GetActivityID())
+	}
+	// the attributes are copied so that the decision keeps its ActivityType unset
+	scheduleAttr := *attr.ScheduleAttributes
+	scheduleAttr.ActivityType = selectWeightedActivityType(attr.Candidates, seed)
+	return handler.handleDecisionScheduleActivity(ctx, &scheduleAttr, &types.WeightedActivitySelection{
+		Candidates: attr.Candidates,
+		Seed:       seed,
+	})
+}
+
+func (handler *taskHandlerImpl) handleDecisionScheduleActivitiesBatch(
+	ctx context.Context,
+	attr *types.ScheduleActivityTasksBatchDecisionAttributes,
+) ([]*decisionResult, error) {
+
+	if err := handler.validateDecisionAttr(
+		func() error {
+			return handler.attrValidator.validateActivityScheduleBatchAttributes(attr)
+		},
+		types.DecisionTaskFailedCauseBadScheduleActivityAttributes,
+	); err != nil || handler.stopProcessing {
+		return nil, err
+	}
+
+	var results []*decisionResult
+	for _, activity := range attr.Activities {
+		result, err := handler.handleDecisionScheduleActivity(ctx, scheduleActivityAttributesFromBatch(attr, activity), nil)
+		if err != nil || handler.stopProcessing {
+			return nil, err
+		}
+		if result != nil {
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// scheduleActivityAttributesFromBatch builds the attributes of a single activity of a batch,
+// the task list is copied as validation may fill it in place
+func scheduleActivityAttributesFromBatch(
+	attr *types.ScheduleActivityTasksBatchDecisionAttributes,
+	activity *types.ScheduleActivityTaskBatchEntry,
+) *types.ScheduleActivityTaskDecisionAttributes {
+
+	var taskList *types.TaskList
+	if attr.TaskList != nil {
+		taskListCopy := *attr.TaskList
+		taskList = &taskListCopy
+	}
+	return &types.ScheduleActivityTaskDecisionAttributes{
+		ActivityID:                    activity.GetActivityID(),
+		ActivityType:                  attr.ActivityType,
+		Domain:                        attr.Domain,
+		TaskList:                      taskList,
+		Input:                         activity.GetInput(),
+		ScheduleToCloseTimeoutSeconds: attr.ScheduleToCloseTimeoutSeconds,
+		ScheduleToStartTimeoutSeconds: attr.ScheduleToStartTimeoutSeconds,
+		StartToCloseTimeoutSeconds:    attr.StartToCloseTimeoutSeconds,
+		HeartbeatTimeoutSeconds:       attr.HeartbeatTimeoutSeconds,
+		RetryPolicy:                   attr.RetryPolicy,
+		Header:                        attr.Header,
+		RequestLocalDispatch:          attr.RequestLocalDispatch,
+	}
+}
+
+// weightedActivitySeed derives the seed of a weighted activity selection from the workflow ID and the activity ID,
+// so that it is the same for every run of the workflow ID
+func weightedActivitySeed(workflowID string, activityID string) int64 {
+	return int64(farm.Fingerprint64([]byte(workflowID + "/" + activityID)))
+}
+
+// selectWeightedActivityType selects a candidate with a probability proportional to its weight. The selection
+// only depends on the candidates, in order, and on the seed: it must not change across releases, otherwise the
+// runs of a workflow ID scheduled before and after an upgrade could select different activity types.
+func selectWeightedActivityType(
+	candidates []*types.WeightedActivityType,
+	seed int64,
+) *types.ActivityType {
+
+	var totalWeight uint64
+	for _, candidate := range candidates {
+		totalWeight += uint64(candidate.GetWeight())
+	}
+
+	// spread the seed with the splitmix64 finalizer, so that close seeds don't select the same candidate
+	point := uint64(seed)
+	point = (point ^ (point >> 30)) * 0xbf58476d1ce4e5b9
+	point = (point ^ (point >> 27)) * 0x94d049bb133111eb
+	point = (point ^ (point >> 31)) % totalWeight
+
+	for _, candidate := range candidates {
+		weight := uint64(candidate.GetWeight())
+		if point < weight {
+			activityType := *candidate.ActivityType
+			return &activityType
+		}
+		point -= weight
+	}
+	// unreachable as the weights are validated to be positive
+	return candidates[len(candidates)-1].ActivityType
+}
+
 func (handler *taskHandlerImpl) handleDecisionRequestCancelActivity(
 	ctx context.Context,
 	attr *types.RequestCancelActivityTaskDecisionAttributes,
@@ -342,6 +614,8 @@ func (handler *taskHandlerImpl) handleDecisionRequestCancelActivity(
 				return err
 			}
 			handler.activityNotStartedCancelled = true
+		} else {
+			handler.setActivityCancellationEscalation(ai)
 		}
 		return nil
 	case *types.BadRequestError:
@@ -356,6 +630,16 @@ func (handler *taskHandlerImpl) handleDecisionRequestCancelActivity(
 	}
 }
 
+// setActivityCancellationEscalation records the escalation timeline of the domain on a started activity whose
+// cancellation was just requested, the timeline applies if the worker does not close the activity in time
+func (handler *taskHandlerImpl) setActivityCancellationEscalation(
+	ai *persistence.ActivityInfo,
+) {
+	domainName := handler.domainEntry.GetInfo().Name
+	ai.CancelAckTimeout = int32(handler.config.ActivityCancellationAckTimeout(domainName).Seconds())
+	ai.CancelForceTimeout = int32(handler.config.ActivityCancellationForceTimeout(domainName).Seconds())
+}
+
 func (handler *taskHandlerImpl) handleDecisionStartTimer(
 	ctx context.Context,
 	attr *types.StartTimerDecisionAttributes,
@@ -746,6 +1030,34 @@ func (handler *taskHandlerImpl) handleDecisionRecordMarker(
 	return err
 }
 
+// handleDecisionRecordActivityHeartbeatSnapshot records the current heartbeat details of a pending activity
+// as a marker, going through the same validation and size limits as a RecordMarker decision
+func (handler *taskHandlerImpl) handleDecisionRecordActivityHeartbeatSnapshot(
+	ctx context.Context,
+	attr *types.RecordActivityHeartbeatSnapshotDecisionAttributes,
+) error {
+
+	if err := handler.validateDecisionAttr(
+		func() error {
+			return handler.attrValidator.validateRecordActivityHeartbeatSnapshotAttributes(attr, handler.mutableState)
+		},
+		types.DecisionTaskFailedCauseBadRecordMarkerAttributes,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
+	ai, _ := handler.mutableState.GetActivityByActivityID(attr.GetActivityID())
+	return handler.handleDecisionRecordMarker(ctx, &types.RecordMarkerDecisionAttributes{
+		MarkerName: common.ActivityHeartbeatSnapshotMarkerName,
+		Details:    execution.GetActivityHeartbeatDetails(ai, false),
+		Header: &types.Header{
+			Fields: map[string][]byte{
+				common.ActivityHeartbeatSnapshotActivityIDHeader: []byte(ai.ActivityID),
+			},
+		},
+	})
+}
+
 func (handler *taskHandlerImpl) handleDecisionContinueAsNewWorkflow(
 	ctx context.Context,
 	attr *types.ContinueAsNewWorkflowExecutionDecisionAttributes,
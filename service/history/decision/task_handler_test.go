@@ -136,6 +136,7 @@ func TestHandleDecisionRequestCancelExternalWorkflow(t *testing.T) {
 }
 
 func TestHandleDecisionRequestCancelActivity(t *testing.T) {
+	startedActivityInfo := &persistence.ActivityInfo{StartedID: 5}
 	tests := []struct {
 		name            string
 		expectMockCalls func(taskHandler *taskHandlerImpl)
@@ -161,6 +162,26 @@ func TestHandleDecisionRequestCancelActivity(t *testing.T) {
 
 			},
 		},
+		{
+			name:       "success - started activity",
+			attributes: &types.RequestCancelActivityTaskDecisionAttributes{ActivityID: testdata.ActivityID},
+			expectMockCalls: func(taskHandler *taskHandlerImpl) {
+				taskHandler.config.ActivityCancellationAckTimeout = func(domain string) time.Duration { return time.Minute }
+				taskHandler.config.ActivityCancellationForceTimeout = func(domain string) time.Duration { return time.Hour }
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddActivityTaskCancelRequestedEvent(
+					testTaskCompletedID,
+					testdata.ActivityID,
+					testdata.Identity,
+				).Times(1).Return(&types.HistoryEvent{}, startedActivityInfo, nil)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, err error) {
+				assert.False(t, taskHandler.failDecision)
+				assert.False(t, taskHandler.activityNotStartedCancelled)
+				assert.Equal(t, nil, err)
+				assert.Equal(t, int32(60), startedActivityInfo.CancelAckTimeout)
+				assert.Equal(t, int32(3600), startedActivityInfo.CancelForceTimeout)
+			},
+		},
 		{
 			name:       "AddActivityTaskCanceledEvent failure",
 			attributes: &types.RequestCancelActivityTaskDecisionAttributes{ActivityID: testdata.ActivityID},
@@ -1230,6 +1251,70 @@ func TestHandleDecisionRecordMarker(t *testing.T) {
 	}
 }
 
+func TestHandleDecisionRecordActivityHeartbeatSnapshot(t *testing.T) {
+	tests := []struct {
+		name            string
+		expectMockCalls func(taskHandler *taskHandlerImpl, attr *types.RecordActivityHeartbeatSnapshotDecisionAttributes)
+		attributes      *types.RecordActivityHeartbeatSnapshotDecisionAttributes
+		asserts         func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.RecordActivityHeartbeatSnapshotDecisionAttributes, err error)
+	}{
+		{
+			name: "attributes validation failure",
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.RecordActivityHeartbeatSnapshotDecisionAttributes, err error) {
+				assert.Equal(t, types.DecisionTaskFailedCauseBadRecordMarkerAttributes, *taskHandler.failDecisionCause)
+				assert.Nil(t, err)
+				assert.True(t, taskHandler.stopProcessing)
+			},
+		},
+		{
+			name:       "activity is not pending",
+			attributes: &types.RecordActivityHeartbeatSnapshotDecisionAttributes{ActivityID: "some-activity-id"},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, attr *types.RecordActivityHeartbeatSnapshotDecisionAttributes) {
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetActivityByActivityID(attr.ActivityID).Return(nil, false)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.RecordActivityHeartbeatSnapshotDecisionAttributes, err error) {
+				assert.Equal(t, types.DecisionTaskFailedCauseBadRecordMarkerAttributes, *taskHandler.failDecisionCause)
+				assert.Nil(t, err)
+				assert.True(t, taskHandler.stopProcessing)
+			},
+		},
+		{
+			name:       "success",
+			attributes: &types.RecordActivityHeartbeatSnapshotDecisionAttributes{ActivityID: "some-activity-id"},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, attr *types.RecordActivityHeartbeatSnapshotDecisionAttributes) {
+				ai := &persistence.ActivityInfo{ActivityID: attr.ActivityID, Details: []byte("some-details")}
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetActivityByActivityID(attr.ActivityID).Return(ai, true).Times(2)
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{})
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddRecordMarkerEvent(taskHandler.decisionTaskCompletedID, &types.RecordMarkerDecisionAttributes{
+					MarkerName: common.ActivityHeartbeatSnapshotMarkerName,
+					Details:    []byte("some-details"),
+					Header: &types.Header{
+						Fields: map[string][]byte{common.ActivityHeartbeatSnapshotActivityIDHeader: []byte(attr.ActivityID)},
+					},
+				})
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.RecordActivityHeartbeatSnapshotDecisionAttributes, err error) {
+				assert.Nil(t, err)
+				assert.False(t, taskHandler.stopProcessing)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			taskHandler := newTaskHandlerForTest(t)
+			if test.expectMockCalls != nil {
+				test.expectMockCalls(taskHandler, test.attributes)
+			}
+			decision := &types.Decision{
+				DecisionType: common.Ptr(types.DecisionTypeRecordActivityHeartbeatSnapshot),
+				RecordActivityHeartbeatSnapshotDecisionAttributes: test.attributes,
+			}
+			err := taskHandler.handleDecision(context.Background(), decision)
+			test.asserts(t, taskHandler, test.attributes, err)
+		})
+	}
+}
+
 func TestHandleDecisionScheduleActivity(t *testing.T) {
 	domainEntry := cache.NewLocalDomainCacheEntryForTest(
 		&persistence.DomainInfo{ID: testdata.DomainID, Name: testdata.DomainName},
@@ -1286,6 +1371,24 @@ func TestHandleDecisionScheduleActivity(t *testing.T) {
 				assert.True(t, taskHandler.stopProcessing)
 			},
 		},
+		{
+			name: "parent initiated child id validation failure",
+			attributes: func() *types.ScheduleActivityTaskDecisionAttributes {
+				attr := *validAttr
+				attr.ParentInitiatedChildID = common.Int64Ptr(testTaskCompletedID)
+				return &attr
+			}(),
+			expectMockCalls: func(taskHandler *taskHandlerImpl, attr *types.ScheduleActivityTaskDecisionAttributes) {
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Return(executionInfo)
+				taskHandler.domainCache.(*cache.MockDomainCache).EXPECT().GetDomain(attr.GetDomain()).Return(domainEntry, nil)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.ScheduleActivityTaskDecisionAttributes, res *decisionResult, err error) {
+				assert.Nil(t, err)
+				assert.Nil(t, res)
+				assert.True(t, taskHandler.stopProcessing)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadScheduleActivityAttributes, *taskHandler.failDecisionCause)
+			},
+		},
 		{
 			name:       "blob size limit check failure",
 			attributes: validAttr,
@@ -1427,6 +1530,7 @@ func TestHandleDecisionScheduleActivity(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			taskHandler := newTaskHandlerForTest(t)
+			taskHandler.domainCache.(*cache.MockDomainCache).EXPECT().GetDomainName(gomock.Any()).Return(testdata.DomainName, nil).AnyTimes()
 			if test.expectMockCalls != nil {
 				test.expectMockCalls(taskHandler, test.attributes)
 			}
@@ -1444,6 +1548,403 @@ func TestHandleDecisionScheduleActivity(t *testing.T) {
 	}
 }
 
+func TestHandleDecisionScheduleActivitiesBatch(t *testing.T) {
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: testdata.DomainID, Name: testdata.DomainName},
+		&persistence.DomainConfig{Retention: 1},
+		cluster.TestCurrentClusterName)
+	executionInfo := &persistence.WorkflowExecutionInfo{
+		DomainID:        testdata.DomainID,
+		WorkflowID:      testdata.WorkflowID,
+		WorkflowTimeout: 100,
+	}
+	validAttr := &types.ScheduleActivityTasksBatchDecisionAttributes{
+		Domain:                        testdata.DomainName,
+		TaskList:                      &types.TaskList{Name: testdata.TaskListName},
+		ActivityType:                  &types.ActivityType{Name: testdata.ActivityTypeName},
+		ScheduleToCloseTimeoutSeconds: func(i int32) *int32 { return &i }(100),
+		ScheduleToStartTimeoutSeconds: func(i int32) *int32 { return &i }(20),
+		StartToCloseTimeoutSeconds:    func(i int32) *int32 { return &i }(80),
+		Activities: []*types.ScheduleActivityTaskBatchEntry{
+			{ActivityID: "activity-1", Input: []byte("input-1")},
+			{ActivityID: "activity-2", Input: []byte("input-2")},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		expectMockCalls func(taskHandler *taskHandlerImpl, scheduled *[]*types.ScheduleActivityTaskDecisionAttributes)
+		attributes      *types.ScheduleActivityTasksBatchDecisionAttributes
+		asserts         func(t *testing.T, taskHandler *taskHandlerImpl, scheduled []*types.ScheduleActivityTaskDecisionAttributes, res []*decisionResult, err error)
+	}{
+		{
+			name:       "attributes not set",
+			attributes: nil,
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, scheduled []*types.ScheduleActivityTaskDecisionAttributes, res []*decisionResult, err error) {
+				assert.Nil(t, err)
+				assert.Nil(t, res)
+				assert.True(t, taskHandler.stopProcessing)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadScheduleActivityAttributes, *taskHandler.failDecisionCause)
+			},
+		},
+		{
+			name:       "no activities",
+			attributes: &types.ScheduleActivityTasksBatchDecisionAttributes{ActivityType: &types.ActivityType{Name: testdata.ActivityTypeName}},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, scheduled []*types.ScheduleActivityTaskDecisionAttributes, res []*decisionResult, err error) {
+				assert.Nil(t, err)
+				assert.Nil(t, res)
+				assert.True(t, taskHandler.stopProcessing)
+			},
+		},
+		{
+			name:       "success - one scheduled event per activity",
+			attributes: validAttr,
+			expectMockCalls: func(taskHandler *taskHandlerImpl, scheduled *[]*types.ScheduleActivityTaskDecisionAttributes) {
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Return(executionInfo).AnyTimes()
+				taskHandler.domainCache.(*cache.MockDomainCache).EXPECT().GetDomain(testdata.DomainName).Return(domainEntry, nil).Times(2)
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddActivityTaskScheduledEvent(context.Background(), taskHandler.decisionTaskCompletedID, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int64, attr *types.ScheduleActivityTaskDecisionAttributes, _ bool) (*types.HistoryEvent, *persistence.ActivityInfo, *types.ActivityLocalDispatchInfo, bool, bool, error) {
+						*scheduled = append(*scheduled, attr)
+						return &types.HistoryEvent{}, &persistence.ActivityInfo{}, nil, false, false, nil
+					}).Times(2)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, scheduled []*types.ScheduleActivityTaskDecisionAttributes, res []*decisionResult, err error) {
+				assert.Nil(t, err)
+				assert.Empty(t, res)
+				assert.False(t, taskHandler.stopProcessing)
+				assert.Len(t, scheduled, 2)
+				for i, attr := range scheduled {
+					assert.Equal(t, validAttr.Activities[i].ActivityID, attr.ActivityID)
+					assert.Equal(t, validAttr.Activities[i].Input, attr.Input)
+					assert.Equal(t, validAttr.ActivityType, attr.ActivityType)
+					assert.Equal(t, validAttr.TaskList.Name, attr.TaskList.Name)
+					assert.NotSame(t, validAttr.TaskList, attr.TaskList)
+					assert.Equal(t, validAttr.StartToCloseTimeoutSeconds, attr.StartToCloseTimeoutSeconds)
+				}
+			},
+		},
+		{
+			name: "invalid activity stops processing",
+			attributes: &types.ScheduleActivityTasksBatchDecisionAttributes{
+				Domain:       testdata.DomainName,
+				TaskList:     &types.TaskList{Name: testdata.TaskListName},
+				ActivityType: &types.ActivityType{Name: testdata.ActivityTypeName},
+				Activities:   []*types.ScheduleActivityTaskBatchEntry{{ActivityID: ""}},
+			},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, scheduled *[]*types.ScheduleActivityTaskDecisionAttributes) {
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Return(executionInfo).AnyTimes()
+				taskHandler.domainCache.(*cache.MockDomainCache).EXPECT().GetDomain(testdata.DomainName).Return(domainEntry, nil).Times(1)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, scheduled []*types.ScheduleActivityTaskDecisionAttributes, res []*decisionResult, err error) {
+				assert.Nil(t, err)
+				assert.Nil(t, res)
+				assert.Empty(t, scheduled)
+				assert.True(t, taskHandler.stopProcessing)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			taskHandler := newTaskHandlerForTest(t)
+			taskHandler.domainCache.(*cache.MockDomainCache).EXPECT().GetDomainName(gomock.Any()).Return(testdata.DomainName, nil).AnyTimes()
+			var scheduled []*types.ScheduleActivityTaskDecisionAttributes
+			if test.expectMockCalls != nil {
+				test.expectMockCalls(taskHandler, &scheduled)
+			}
+			res, err := taskHandler.handleDecisionScheduleActivitiesBatch(context.Background(), test.attributes)
+			test.asserts(t, taskHandler, scheduled, res, err)
+		})
+	}
+}
+
+func TestHandleDecisionReplaceActivity(t *testing.T) {
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: testdata.DomainID, Name: testdata.DomainName},
+		&persistence.DomainConfig{Retention: 1},
+		cluster.TestCurrentClusterName)
+	executionInfo := &persistence.WorkflowExecutionInfo{
+		DomainID:        testdata.DomainID,
+		WorkflowID:      testdata.WorkflowID,
+		WorkflowTimeout: 100,
+	}
+	newScheduleAttr := &types.ScheduleActivityTaskDecisionAttributes{
+		Domain:                        testdata.DomainName,
+		TaskList:                      &types.TaskList{Name: testdata.TaskListName},
+		ActivityID:                    "new-activity-id",
+		ActivityType:                  &types.ActivityType{Name: testdata.ActivityTypeName},
+		ScheduleToCloseTimeoutSeconds: func(i int32) *int32 { return &i }(100),
+		ScheduleToStartTimeoutSeconds: func(i int32) *int32 { return &i }(20),
+		StartToCloseTimeoutSeconds:    func(i int32) *int32 { return &i }(80),
+	}
+
+	tests := []struct {
+		name            string
+		expectMockCalls func(taskHandler *taskHandlerImpl, cancelRequestedEvent *types.HistoryEvent)
+		attributes      *types.ReplaceActivityTaskDecisionAttributes
+		asserts         func(t *testing.T, taskHandler *taskHandlerImpl, cancelRequestedEvent *types.HistoryEvent, err error)
+	}{
+		{
+			name:       "replacement reuses the activity ID",
+			attributes: &types.ReplaceActivityTaskDecisionAttributes{ActivityID: "new-activity-id", NewScheduleAttributes: newScheduleAttr},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, cancelRequestedEvent *types.HistoryEvent, err error) {
+				assert.Nil(t, err)
+				assert.True(t, taskHandler.stopProcessing)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadRequestCancelActivityAttributes, *taskHandler.failDecisionCause)
+			},
+		},
+		{
+			name:       "activity is not pending",
+			attributes: &types.ReplaceActivityTaskDecisionAttributes{ActivityID: "old-activity-id", NewScheduleAttributes: newScheduleAttr},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, cancelRequestedEvent *types.HistoryEvent) {
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetActivityByActivityID("old-activity-id").Return(nil, false)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, cancelRequestedEvent *types.HistoryEvent, err error) {
+				assert.Nil(t, err)
+				assert.True(t, taskHandler.stopProcessing)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadRequestCancelActivityAttributes, *taskHandler.failDecisionCause)
+			},
+		},
+		{
+			name:       "success - activity not started is cancelled and replaced",
+			attributes: &types.ReplaceActivityTaskDecisionAttributes{ActivityID: "old-activity-id", NewScheduleAttributes: newScheduleAttr},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, cancelRequestedEvent *types.HistoryEvent) {
+				ai := &persistence.ActivityInfo{ActivityID: "old-activity-id", ScheduleID: 5, StartedID: common.EmptyEventID}
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetActivityByActivityID("old-activity-id").Return(ai, true)
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddActivityTaskCancelRequestedEvent(taskHandler.decisionTaskCompletedID, "old-activity-id", taskHandler.identity).
+					Return(cancelRequestedEvent, ai, nil)
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddActivityTaskCanceledEvent(
+					ai.ScheduleID, ai.StartedID, cancelRequestedEvent.ID, []byte(activityCancellationMsgActivityNotStarted), taskHandler.identity)
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Return(executionInfo).AnyTimes()
+				taskHandler.domainCache.(*cache.MockDomainCache).EXPECT().GetDomain(testdata.DomainName).Return(domainEntry, nil)
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddActivityTaskScheduledEvent(context.Background(), taskHandler.decisionTaskCompletedID, newScheduleAttr, gomock.Any()).
+					Return(&types.HistoryEvent{}, &persistence.ActivityInfo{}, nil, false, false, nil)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, cancelRequestedEvent *types.HistoryEvent, err error) {
+				assert.Nil(t, err)
+				assert.False(t, taskHandler.stopProcessing)
+				assert.True(t, taskHandler.activityNotStartedCancelled)
+				assert.Equal(t, "new-activity-id", cancelRequestedEvent.ActivityTaskCancelRequestedEventAttributes.GetReplacementActivityID())
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			taskHandler := newTaskHandlerForTest(t)
+			taskHandler.domainCache.(*cache.MockDomainCache).EXPECT().GetDomainName(gomock.Any()).Return(testdata.DomainName, nil).AnyTimes()
+			cancelRequestedEvent := &types.HistoryEvent{
+				ID: 6,
+				ActivityTaskCancelRequestedEventAttributes: &types.ActivityTaskCancelRequestedEventAttributes{ActivityID: "old-activity-id"},
+			}
+			if test.expectMockCalls != nil {
+				test.expectMockCalls(taskHandler, cancelRequestedEvent)
+			}
+			decision := &types.Decision{
+				DecisionType:                          common.Ptr(types.DecisionTypeReplaceActivityTask),
+				ReplaceActivityTaskDecisionAttributes: test.attributes,
+			}
+			_, err := taskHandler.handleDecisionWithResult(context.Background(), decision)
+			test.asserts(t, taskHandler, cancelRequestedEvent, err)
+		})
+	}
+}
+
+func TestHandleDecisionScheduleWeightedActivity(t *testing.T) {
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: testdata.DomainID, Name: testdata.DomainName},
+		&persistence.DomainConfig{Retention: 1},
+		cluster.TestCurrentClusterName)
+	executionInfo := &persistence.WorkflowExecutionInfo{
+		DomainID:        testdata.DomainID,
+		WorkflowID:      testdata.WorkflowID,
+		WorkflowTimeout: 100,
+	}
+	scheduleAttr := &types.ScheduleActivityTaskDecisionAttributes{
+		Domain:                        testdata.DomainName,
+		TaskList:                      &types.TaskList{Name: testdata.TaskListName},
+		ActivityID:                    "activity-1",
+		ScheduleToCloseTimeoutSeconds: func(i int32) *int32 { return &i }(100),
+		ScheduleToStartTimeoutSeconds: func(i int32) *int32 { return &i }(20),
+		StartToCloseTimeoutSeconds:    func(i int32) *int32 { return &i }(80),
+	}
+	candidates := []*types.WeightedActivityType{
+		{ActivityType: &types.ActivityType{Name: "variant-a"}, Weight: 1},
+		{ActivityType: &types.ActivityType{Name: "variant-b"}, Weight: 3},
+	}
+
+	tests := []struct {
+		name            string
+		expectMockCalls func(taskHandler *taskHandlerImpl, scheduledEvent *types.HistoryEvent)
+		attributes      *types.ScheduleWeightedActivityDecisionAttributes
+		asserts         func(t *testing.T, taskHandler *taskHandlerImpl, scheduledEvent *types.HistoryEvent, err error)
+	}{
+		{
+			name:       "attributes not set",
+			attributes: nil,
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, scheduledEvent *types.HistoryEvent, err error) {
+				assert.Nil(t, err)
+				assert.True(t, taskHandler.stopProcessing)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadScheduleActivityAttributes, *taskHandler.failDecisionCause)
+			},
+		},
+		{
+			name: "activity type set on the schedule attributes",
+			attributes: &types.ScheduleWeightedActivityDecisionAttributes{
+				Candidates:         candidates,
+				ScheduleAttributes: &types.ScheduleActivityTaskDecisionAttributes{ActivityType: &types.ActivityType{Name: "variant-a"}},
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, scheduledEvent *types.HistoryEvent, err error) {
+				assert.Nil(t, err)
+				assert.True(t, taskHandler.stopProcessing)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadScheduleActivityAttributes, *taskHandler.failDecisionCause)
+			},
+		},
+		{
+			name: "candidate without weight",
+			attributes: &types.ScheduleWeightedActivityDecisionAttributes{
+				Candidates:         []*types.WeightedActivityType{{ActivityType: &types.ActivityType{Name: "variant-a"}}},
+				ScheduleAttributes: scheduleAttr,
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, scheduledEvent *types.HistoryEvent, err error) {
+				assert.Nil(t, err)
+				assert.True(t, taskHandler.stopProcessing)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadScheduleActivityAttributes, *taskHandler.failDecisionCause)
+			},
+		},
+		{
+			name: "success - selection is recorded on the scheduled event",
+			attributes: &types.ScheduleWeightedActivityDecisionAttributes{
+				Candidates:         candidates,
+				Seed:               common.Int64Ptr(42),
+				ScheduleAttributes: scheduleAttr,
+			},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, scheduledEvent *types.HistoryEvent) {
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Return(executionInfo).AnyTimes()
+				taskHandler.domainCache.(*cache.MockDomainCache).EXPECT().GetDomain(testdata.DomainName).Return(domainEntry, nil)
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddWeightedActivityTaskScheduledEvent(context.Background(), taskHandler.decisionTaskCompletedID, gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int64, attr *types.ScheduleActivityTaskDecisionAttributes, weightedSelection *types.WeightedActivitySelection, _ bool) (*types.HistoryEvent, *persistence.ActivityInfo, *types.ActivityLocalDispatchInfo, bool, bool, error) {
+						scheduledEvent.ActivityTaskScheduledEventAttributes.ActivityType = attr.ActivityType
+						scheduledEvent.ActivityTaskScheduledEventAttributes.WeightedSelection = weightedSelection
+						return scheduledEvent, &persistence.ActivityInfo{}, nil, false, false, nil
+					})
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, scheduledEvent *types.HistoryEvent, err error) {
+				assert.Nil(t, err)
+				assert.False(t, taskHandler.stopProcessing)
+				attributes := scheduledEvent.ActivityTaskScheduledEventAttributes
+				assert.Equal(t, selectWeightedActivityType(candidates, 42), attributes.ActivityType)
+				assert.Equal(t, &types.WeightedActivitySelection{Candidates: candidates, Seed: 42}, attributes.WeightedSelection)
+				assert.Nil(t, scheduleAttr.ActivityType)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			taskHandler := newTaskHandlerForTest(t)
+			taskHandler.domainCache.(*cache.MockDomainCache).EXPECT().GetDomainName(gomock.Any()).Return(testdata.DomainName, nil).AnyTimes()
+			scheduledEvent := &types.HistoryEvent{
+				ID:                                   5,
+				ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{ActivityID: "activity-1"},
+			}
+			if test.expectMockCalls != nil {
+				test.expectMockCalls(taskHandler, scheduledEvent)
+			}
+			decision := &types.Decision{
+				DecisionType: common.Ptr(types.DecisionTypeScheduleWeightedActivity),
+				ScheduleWeightedActivityDecisionAttributes: test.attributes,
+			}
+			_, err := taskHandler.handleDecisionWithResult(context.Background(), decision)
+			test.asserts(t, taskHandler, scheduledEvent, err)
+		})
+	}
+}
+
+func TestGenerateActivityID(t *testing.T) {
+	t.Run("not requested", func(t *testing.T) {
+		taskHandler := newTaskHandlerForTest(t)
+		attr := &types.ScheduleActivityTaskDecisionAttributes{ActivityID: "activity-1"}
+		result, err := taskHandler.generateActivityID(0, &types.Decision{
+			DecisionType:                           types.DecisionTypeScheduleActivityTask.Ptr(),
+			ScheduleActivityTaskDecisionAttributes: attr,
+		})
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+		assert.Equal(t, "activity-1", attr.ActivityID)
+	})
+	t.Run("activity ID set", func(t *testing.T) {
+		taskHandler := newTaskHandlerForTest(t)
+		result, err := taskHandler.generateActivityID(0, &types.Decision{
+			DecisionType: types.DecisionTypeScheduleActivityTask.Ptr(),
+			ScheduleActivityTaskDecisionAttributes: &types.ScheduleActivityTaskDecisionAttributes{
+				ActivityID:             "activity-1",
+				AutoGenerateActivityID: true,
+			},
+		})
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+		assert.True(t, taskHandler.failDecision)
+		assert.Equal(t, types.DecisionTaskFailedCauseBadScheduleActivityAttributes, *taskHandler.failDecisionCause)
+	})
+	t.Run("success", func(t *testing.T) {
+		taskHandler := newTaskHandlerForTest(t)
+		scheduleAttr := &types.ScheduleActivityTaskDecisionAttributes{AutoGenerateActivityID: true}
+		replaceAttr := &types.ScheduleActivityTaskDecisionAttributes{AutoGenerateActivityID: true}
+		weightedAttr := &types.ScheduleActivityTaskDecisionAttributes{AutoGenerateActivityID: true}
+		decisions := []*types.Decision{
+			{
+				DecisionType:                           types.DecisionTypeScheduleActivityTask.Ptr(),
+				ScheduleActivityTaskDecisionAttributes: scheduleAttr,
+			},
+			{
+				DecisionType: types.DecisionTypeReplaceActivityTask.Ptr(),
+				ReplaceActivityTaskDecisionAttributes: &types.ReplaceActivityTaskDecisionAttributes{
+					ActivityID:            "activity-1",
+					NewScheduleAttributes: replaceAttr,
+				},
+			},
+			{
+				DecisionType: types.DecisionTypeScheduleWeightedActivity.Ptr(),
+				ScheduleWeightedActivityDecisionAttributes: &types.ScheduleWeightedActivityDecisionAttributes{
+					ScheduleAttributes: weightedAttr,
+				},
+			},
+		}
+
+		// the ID depends on the decision task completed event and the position of the decision only
+		for index, decision := range decisions {
+			result, err := taskHandler.generateActivityID(int32(index)+1, decision)
+			assert.NoError(t, err)
+			assert.Equal(t, &decisionResult{
+				decisionIndex:       int32(index) + 1,
+				generatedActivityID: fmt.Sprintf("auto-%v-%v", testTaskCompletedID, index+1),
+			}, result)
+		}
+		assert.Equal(t, fmt.Sprintf("auto-%v-1", testTaskCompletedID), scheduleAttr.ActivityID)
+		assert.Equal(t, fmt.Sprintf("auto-%v-2", testTaskCompletedID), replaceAttr.ActivityID)
+		assert.Equal(t, fmt.Sprintf("auto-%v-3", testTaskCompletedID), weightedAttr.ActivityID)
+		assert.False(t, taskHandler.failDecision)
+	})
+}
+
+func TestSelectWeightedActivityType(t *testing.T) {
+	candidates := []*types.WeightedActivityType{
+		{ActivityType: &types.ActivityType{Name: "variant-a"}, Weight: 1},
+		{ActivityType: &types.ActivityType{Name: "variant-b"}, Weight: 3},
+	}
+
+	// the same seed always selects the same activity type
+	seed := weightedActivitySeed(testdata.WorkflowID, "activity-1")
+	assert.Equal(t, seed, weightedActivitySeed(testdata.WorkflowID, "activity-1"))
+	assert.Equal(t, selectWeightedActivityType(candidates, seed), selectWeightedActivityType(candidates, seed))
+
+	// the activity types are selected in proportion to their weights
+	selected := make(map[string]int)
+	for i := 0; i < 4000; i++ {
+		selected[selectWeightedActivityType(candidates, weightedActivitySeed(fmt.Sprintf("workflow-%v", i), "activity-1")).GetName()]++
+	}
+	assert.InDelta(t, 1000, selected["variant-a"], 150)
+	assert.InDelta(t, 3000, selected["variant-b"], 150)
+}
+
 func TestHandleDecisionContinueAsNewWorkflow(t *testing.T) {
 	executionInfo := &persistence.WorkflowExecutionInfo{
 		DomainID:        testdata.DomainID,
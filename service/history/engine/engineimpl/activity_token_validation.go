@@ -0,0 +1,141 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	activityTokenValidationFailuresMaxCount = 1000
+)
+
+type (
+	activityTokenValidationFailure struct {
+		domainID string
+		failure  *types.ActivityTokenValidationFailure
+	}
+
+	// activityTokenValidationFailures keeps the latest task tokens of activities rejected by the engine
+	activityTokenValidationFailures struct {
+		sync.Mutex
+		failures []activityTokenValidationFailure
+	}
+)
+
+// GetActivityTokenValidationFailures returns the latest task tokens of the activities of a domain rejected by
+// RespondActivityTaskCompleted, RespondActivityTaskFailed, RespondActivityTaskCanceled and RecordActivityTaskHeartbeat,
+// oldest first, to diagnose workers holding on to stale tokens. The failures are kept in memory by the engine of the
+// shard, up to a fixed number across all the domains, so they don't survive the shard moving to another host.
+func (e *historyEngineImpl) GetActivityTokenValidationFailures(
+	ctx context.Context,
+	request *types.HistoryGetActivityTokenValidationFailuresRequest,
+) (*types.GetActivityTokenValidationFailuresResponse, error) {
+
+	if err := common.ValidateDomainUUID(request.DomainUUID); err != nil {
+		return nil, err
+	}
+
+	e.activityTokenFailures.Lock()
+	defer e.activityTokenFailures.Unlock()
+
+	var failures []*types.ActivityTokenValidationFailure
+	for _, failure := range e.activityTokenFailures.failures {
+		if failure.domainID == request.DomainUUID {
+			failures = append(failures, failure.failure)
+		}
+	}
+	return &types.GetActivityTokenValidationFailuresResponse{
+		Failures: failures,
+	}, nil
+}
+
+// getActivityTokenFailureCause returns why the token is not the one of the started attempt of a pending activity
+func getActivityTokenFailureCause(
+	isRunning bool,
+) *types.ActivityTokenValidationFailureCause {
+
+	if !isRunning {
+		return types.ActivityTokenValidationFailureCauseAlreadyCompleted.Ptr()
+	}
+	return types.ActivityTokenValidationFailureCauseExpired.Ptr()
+}
+
+// recordActivityTokenValidationFailure emits the metric of a task token rejected by the operation, and keeps it for
+// GetActivityTokenValidationFailures. The cause is the one found while validating the token against the mutable
+// state, if the operation failed before the mutable state was loaded the token is checked for a run which does
+// not exist. Nothing is recorded if the token was not rejected.
+func (e *historyEngineImpl) recordActivityTokenValidationFailure(
+	scope int,
+	operation string,
+	domainID string,
+	domainName string,
+	token *common.TaskToken,
+	cause *types.ActivityTokenValidationFailureCause,
+	err error,
+) {
+
+	if cause == nil {
+		if _, ok := err.(*types.EntityNotExistsError); !ok {
+			return
+		}
+		cause = types.ActivityTokenValidationFailureCauseWrongRun.Ptr()
+	}
+	if token == nil {
+		token = &common.TaskToken{}
+	}
+
+	e.metricsClient.Scope(scope).
+		Tagged(
+			metrics.DomainTag(domainName),
+			metrics.ActivityTypeTag(token.ActivityType),
+			metrics.ActivityTokenFailureCauseTag(cause.String()),
+		).
+		IncCounter(metrics.ActivityTokenValidationFailureCounter)
+
+	e.activityTokenFailures.Lock()
+	defer e.activityTokenFailures.Unlock()
+
+	e.activityTokenFailures.failures = append(e.activityTokenFailures.failures, activityTokenValidationFailure{
+		domainID: domainID,
+		failure: &types.ActivityTokenValidationFailure{
+			Operation:       operation,
+			Cause:           cause,
+			WorkflowID:      token.WorkflowID,
+			RunID:           token.RunID,
+			ActivityID:      token.ActivityID,
+			ActivityType:    token.ActivityType,
+			ScheduleID:      token.ScheduleID,
+			ScheduleAttempt: token.ScheduleAttempt,
+			Timestamp:       common.Int64Ptr(e.timeSource.Now().UnixNano()),
+		},
+	})
+	if overflow := len(e.activityTokenFailures.failures) - activityTokenValidationFailuresMaxCount; overflow > 0 {
+		e.activityTokenFailures.failures = e.activityTokenFailures.failures[overflow:]
+	}
+}
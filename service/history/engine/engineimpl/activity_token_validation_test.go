@@ -0,0 +1,108 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+)
+
+func TestActivityTokenValidationFailures(t *testing.T) {
+	otherDomainID := "b1c2d3e4-0123-4567-890a-bcdef0123456"
+	timeSource := clock.NewMockedTimeSource()
+	e := &historyEngineImpl{
+		metricsClient: metrics.NewNoopMetricsClient(),
+		timeSource:    timeSource,
+	}
+	getFailures := func(domainID string) []*types.ActivityTokenValidationFailure {
+		resp, err := e.GetActivityTokenValidationFailures(context.Background(), &types.HistoryGetActivityTokenValidationFailuresRequest{
+			DomainUUID: domainID,
+			Request:    &types.GetActivityTokenValidationFailuresRequest{},
+		})
+		require.NoError(t, err)
+		return resp.GetFailures()
+	}
+	token := &common.TaskToken{
+		WorkflowID:      "wid",
+		RunID:           "rid",
+		ScheduleID:      5,
+		ScheduleAttempt: 1,
+		ActivityID:      "aid",
+		ActivityType:    "charge",
+	}
+
+	e.recordActivityTokenValidationFailure(metrics.HistoryRespondActivityTaskCompletedScope, "RespondActivityTaskCompleted",
+		constants.TestDomainID, constants.TestDomainName, nil, types.ActivityTokenValidationFailureCauseMalformed.Ptr(), nil)
+	e.recordActivityTokenValidationFailure(metrics.HistoryRecordActivityTaskHeartbeatScope, "RecordActivityTaskHeartbeat",
+		constants.TestDomainID, constants.TestDomainName, token, getActivityTokenFailureCause(true), &types.EntityNotExistsError{})
+	// a run which does not exist is only found out by the error
+	e.recordActivityTokenValidationFailure(metrics.HistoryRespondActivityTaskFailedScope, "RespondActivityTaskFailed",
+		otherDomainID, "other domain", token, nil, &types.EntityNotExistsError{})
+	// any other error is not a token validation failure
+	e.recordActivityTokenValidationFailure(metrics.HistoryRespondActivityTaskFailedScope, "RespondActivityTaskFailed",
+		constants.TestDomainID, constants.TestDomainName, token, nil, &types.InternalServiceError{})
+	e.recordActivityTokenValidationFailure(metrics.HistoryRespondActivityTaskFailedScope, "RespondActivityTaskFailed",
+		constants.TestDomainID, constants.TestDomainName, token, nil, nil)
+
+	now := common.Int64Ptr(timeSource.Now().UnixNano())
+	assert.Equal(t, []*types.ActivityTokenValidationFailure{
+		{
+			Operation: "RespondActivityTaskCompleted",
+			Cause:     types.ActivityTokenValidationFailureCauseMalformed.Ptr(),
+			Timestamp: now,
+		},
+		{
+			Operation:       "RecordActivityTaskHeartbeat",
+			Cause:           types.ActivityTokenValidationFailureCauseExpired.Ptr(),
+			WorkflowID:      "wid",
+			RunID:           "rid",
+			ActivityID:      "aid",
+			ActivityType:    "charge",
+			ScheduleID:      5,
+			ScheduleAttempt: 1,
+			Timestamp:       now,
+		},
+	}, getFailures(constants.TestDomainID))
+	failures := getFailures(otherDomainID)
+	require.Len(t, failures, 1)
+	assert.Equal(t, types.ActivityTokenValidationFailureCauseWrongRun, failures[0].GetCause())
+
+	// the oldest failures are dropped first
+	for i := 0; i < activityTokenValidationFailuresMaxCount; i++ {
+		e.recordActivityTokenValidationFailure(metrics.HistoryRespondActivityTaskCanceledScope, "RespondActivityTaskCanceled",
+			otherDomainID, "other domain", token, getActivityTokenFailureCause(false), nil)
+	}
+	assert.Empty(t, getFailures(constants.TestDomainID))
+	failures = getFailures(otherDomainID)
+	assert.Len(t, failures, activityTokenValidationFailuresMaxCount)
+	assert.Equal(t, types.ActivityTokenValidationFailureCauseAlreadyCompleted, failures[len(failures)-1].GetCause())
+}
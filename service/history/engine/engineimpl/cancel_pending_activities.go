@@ -0,0 +1,114 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// CancelPendingActivities requests the cancellation of the pending activities of a workflow, so that workers learn
+// about it from their next heartbeat, e.g. to let them shut down gracefully before the workflow is terminated. The
+// activities which are not started yet are canceled right away and a decision task is scheduled.
+func (e *historyEngineImpl) CancelPendingActivities(
+	ctx context.Context,
+	request *types.HistoryCancelPendingActivitiesRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainByID(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
+		return errDomainDeprecated
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	cancelRequest := request.Request
+	if cancelRequest.WorkflowExecution == nil {
+		return &types.BadRequestError{Message: "WorkflowExecution is not set on request."}
+	}
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: cancelRequest.WorkflowExecution.GetWorkflowID(),
+		RunID:      cancelRequest.WorkflowExecution.GetRunID(),
+	}
+
+	return workflow.UpdateWithActionFunc(ctx, e.executionCache, domainID, workflowExecution, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+			// the cancel requested events cannot be buffered, so they wait for the decision in flight to complete
+			if mutableState.HasInFlightDecision() {
+				return nil, &types.ServiceBusyError{Message: "Workflow is processing a decision, retry later."}
+			}
+
+			canceled, err := cancelPendingActivities(mutableState, cancelRequest.GetDetails(), cancelRequest.GetIdentity())
+			if err != nil {
+				return nil, err
+			}
+			if canceled == 0 {
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+
+			e.logger.Info("Canceling pending activities",
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(workflowExecution.GetWorkflowID()),
+				tag.WorkflowRunID(workflowExecution.GetRunID()),
+				tag.Counter(canceled),
+			)
+			return &workflow.UpdateAction{CreateDecision: true}, nil
+		})
+}
+
+// cancelPendingActivities requests the cancellation of the pending activities, so that workers learn about it
+// from their next heartbeat. The activities which are not started yet are canceled right away.
+func cancelPendingActivities(
+	mutableState execution.MutableState,
+	details []byte,
+	identity string,
+) (int, error) {
+	canceled := 0
+	for _, ai := range mutableState.GetPendingActivityInfos() {
+		if ai.CancelRequested {
+			continue
+		}
+		cancelRequestedEvent, _, err := mutableState.AddActivityTaskCancelRequestedEvent(common.EmptyEventID, ai.ActivityID, identity)
+		if err != nil {
+			return 0, err
+		}
+		canceled++
+		if ai.StartedID != common.EmptyEventID {
+			continue
+		}
+		if _, err := mutableState.AddActivityTaskCanceledEvent(ai.ScheduleID, ai.StartedID, cancelRequestedEvent.ID, details, identity); err != nil {
+			return 0, err
+		}
+	}
+	return canceled, nil
+}
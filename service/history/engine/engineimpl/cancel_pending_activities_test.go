@@ -0,0 +1,71 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package engineimpl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+)
+
+func TestCancelPendingActivities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mutableState := execution.NewMockMutableState(ctrl)
+
+	scheduled := &persistence.ActivityInfo{
+		ScheduleID: 5,
+		StartedID:  common.EmptyEventID,
+		ActivityID: "scheduled",
+	}
+	started := &persistence.ActivityInfo{
+		ScheduleID: 6,
+		StartedID:  8,
+		ActivityID: "started",
+	}
+	cancelRequested := &persistence.ActivityInfo{
+		ScheduleID:      7,
+		StartedID:       9,
+		ActivityID:      "cancel-requested",
+		CancelRequested: true,
+	}
+	mutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistence.ActivityInfo{
+		scheduled.ScheduleID:       scheduled,
+		started.ScheduleID:         started,
+		cancelRequested.ScheduleID: cancelRequested,
+	})
+	mutableState.EXPECT().AddActivityTaskCancelRequestedEvent(common.EmptyEventID, "scheduled", "identity").
+		Return(&types.HistoryEvent{ID: 10}, scheduled, nil).Times(1)
+	mutableState.EXPECT().AddActivityTaskCanceledEvent(int64(5), common.EmptyEventID, int64(10), []byte("reason"), "identity").
+		Return(&types.HistoryEvent{}, nil).Times(1)
+	mutableState.EXPECT().AddActivityTaskCancelRequestedEvent(common.EmptyEventID, "started", "identity").
+		Return(&types.HistoryEvent{ID: 11}, started, nil).Times(1)
+
+	canceled, err := cancelPendingActivities(mutableState, []byte("reason"), "identity")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, canceled)
+}
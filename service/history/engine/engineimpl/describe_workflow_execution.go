@@ -135,7 +135,14 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			lastHeartbeatUnixNano := ai.LastHeartBeatUpdatedTime.UnixNano()
 			if lastHeartbeatUnixNano > 0 {
 				p.LastHeartbeatTimestamp = common.Int64Ptr(lastHeartbeatUnixNano)
-				p.HeartbeatDetails = ai.Details
+				p.HeartbeatDetails = execution.GetActivityHeartbeatDetails(ai, false)
+			}
+			p.MaxHeartbeatGapMillis = ai.MaxHeartbeatGap.Milliseconds()
+			p.Stalled = execution.IsActivityStalled(ai, e.config.ActivityStalledHeartbeatThreshold(mutableState.GetDomainEntry().GetInfo().Name))
+			p.NackCount = ai.NackCount
+			p.LastNackReason = ai.LastNackReason
+			if remaining, ok := execution.GetActivityRemainingExecutionBudget(ai, e.timeSource.Now()); ok {
+				p.RemainingExecutionBudgetSeconds = common.Int32Ptr(int32(remaining.Seconds()))
 			}
 			// TODO: move to mutable state instead of loading it from event
 			scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, ai.ScheduleID)
@@ -143,6 +150,10 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 				return nil, err
 			}
 			p.ActivityType = scheduledEvent.ActivityTaskScheduledEventAttributes.ActivityType
+			p.ParentInitiatedChildID = scheduledEvent.ActivityTaskScheduledEventAttributes.ParentInitiatedChildID
+			if request.IncludeScheduledEventAttributes {
+				p.ScheduledEventAttributes = scheduledEvent.ActivityTaskScheduledEventAttributes
+			}
 			if state == types.PendingActivityStateScheduled {
 				p.ScheduledTimestamp = common.Int64Ptr(ai.ScheduledTime.UnixNano())
 			} else {
@@ -169,6 +180,15 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 		}
 	}
 
+	result.RecentlyClosedActivities = mutableState.GetRecentlyClosedActivities()
+
+	if len(executionInfo.Counters) > 0 {
+		result.WorkflowCounters = make(map[string]int64, len(executionInfo.Counters))
+		for name, value := range executionInfo.Counters {
+			result.WorkflowCounters[name] = value
+		}
+	}
+
 	if len(mutableState.GetPendingChildExecutionInfos()) > 0 {
 		for _, ch := range mutableState.GetPendingChildExecutionInfos() {
 			childDomainName, err := execution.GetChildExecutionDomainName(
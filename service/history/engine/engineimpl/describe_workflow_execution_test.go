@@ -105,6 +105,7 @@ func TestDescribeWorkflowExecution(t *testing.T) {
 		LastWorkerIdentity:     "LastWorkerIdentity",
 		LastFailureDetails:     []byte("failure details"),
 		ScheduleID:             1,
+		MaxHeartbeatGapMillis:  2005,
 	}
 	child1 := &types.PendingChildExecutionInfo{
 		Domain:            childDomainID,
@@ -140,6 +141,7 @@ func TestDescribeWorkflowExecution(t *testing.T) {
 					LastFailureReason:        *activity1.LastFailureReason,
 					LastWorkerIdentity:       activity1.LastWorkerIdentity,
 					LastFailureDetails:       activity1.LastFailureDetails,
+					MaxHeartbeatGap:          time.Duration(activity1.MaxHeartbeatGapMillis) * time.Millisecond,
 				},
 			},
 			ChildExecutionInfos: map[int64]*persistence.ChildExecutionInfo{
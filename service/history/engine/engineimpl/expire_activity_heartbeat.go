@@ -0,0 +1,94 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// ExpireActivityHeartbeat fires the heartbeat timeout of a started activity right away, as if its heartbeat
+// timeout elapsed, so that tests exercise the heartbeat timeout handling, retries included, without waiting
+// for it. It is a testing tool which has to be enabled for the domain with EnableExpireActivityHeartbeat.
+func (e *historyEngineImpl) ExpireActivityHeartbeat(
+	ctx context.Context,
+	request *types.HistoryExpireActivityHeartbeatRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainByID(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
+		return errDomainDeprecated
+	}
+	domainID := domainEntry.GetInfo().ID
+	if !e.config.EnableExpireActivityHeartbeat(domainEntry.GetInfo().Name) {
+		return &types.BadRequestError{Message: "ExpireActivityHeartbeat is not enabled for the domain."}
+	}
+
+	expireRequest := request.Request
+	if expireRequest.GetActivityID() == "" {
+		return &types.BadRequestError{Message: "ActivityID is not set on request."}
+	}
+	if expireRequest.WorkflowExecution == nil {
+		return &types.BadRequestError{Message: "WorkflowExecution is not set on request."}
+	}
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: expireRequest.WorkflowExecution.GetWorkflowID(),
+		RunID:      expireRequest.WorkflowExecution.GetRunID(),
+	}
+
+	return workflow.UpdateWithAction(ctx, e.executionCache, domainID, workflowExecution, false, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) error {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return workflow.ErrAlreadyCompleted
+			}
+
+			ai, ok := mutableState.GetActivityByActivityID(expireRequest.GetActivityID())
+			if !ok {
+				return &types.BadRequestError{Message: "Activity is not pending, it has either completed or failed."}
+			}
+			if ai.StartedID == common.EmptyEventID {
+				return &types.BadRequestError{Message: "Activity is not started, only started activities have a heartbeat timer."}
+			}
+			if ai.HeartbeatTimeout <= 0 {
+				return &types.BadRequestError{Message: "Activity has no heartbeat timeout."}
+			}
+
+			e.logger.Info("Expiring activity heartbeat",
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(workflowExecution.GetWorkflowID()),
+				tag.WorkflowRunID(workflowExecution.GetRunID()),
+				tag.WorkflowScheduleID(ai.ScheduleID),
+				tag.Attempt(ai.Attempt),
+			)
+			return mutableState.ExpireActivityHeartbeat(ai)
+		})
+}
@@ -0,0 +1,182 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	ctx "context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine/testdata"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+func TestExpireActivityHeartbeat(t *testing.T) {
+	workflowExecution := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	newRequest := func(activityID string) *types.HistoryExpireActivityHeartbeatRequest {
+		return &types.HistoryExpireActivityHeartbeatRequest{
+			DomainUUID: constants.TestDomainID,
+			Request: &types.ExpireActivityHeartbeatRequest{
+				Domain:            constants.TestDomainName,
+				WorkflowExecution: workflowExecution,
+				ActivityID:        activityID,
+			},
+		}
+	}
+	mutableState := func(state int, startedID int64, heartbeatTimeout int32) *persistence.WorkflowMutableState {
+		return &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				DomainID:    constants.TestDomainID,
+				WorkflowID:  workflowExecution.WorkflowID,
+				RunID:       workflowExecution.RunID,
+				State:       state,
+				NextEventID: 10,
+			},
+			ActivityInfos: map[int64]*persistence.ActivityInfo{
+				5: {
+					ScheduleID:       5,
+					StartedID:        startedID,
+					StartedTime:      time.Now().Add(-time.Second),
+					ActivityID:       "activity",
+					DomainID:         constants.TestDomainID,
+					HeartbeatTimeout: heartbeatTimeout,
+					TimerTaskStatus:  execution.TimerTaskStatusCreatedHeartbeat,
+				},
+			},
+			ExecutionStats: &persistence.ExecutionStats{},
+			Checksum:       checksum.Checksum{},
+		}
+	}
+	enable := func(engine *testdata.EngineForTest) {
+		engine.ShardCtx.GetConfig().EnableExpireActivityHeartbeat = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	}
+	cases := []struct {
+		name        string
+		request     *types.HistoryExpireActivityHeartbeatRequest
+		init        func(engine *testdata.EngineForTest)
+		expectedErr error
+	}{
+		{
+			name:        "Not Enabled",
+			request:     newRequest("activity"),
+			expectedErr: &types.BadRequestError{Message: "ExpireActivityHeartbeat is not enabled for the domain."},
+		},
+		{
+			name:        "Missing ActivityID",
+			request:     newRequest(""),
+			init:        enable,
+			expectedErr: &types.BadRequestError{Message: "ActivityID is not set on request."},
+		},
+		{
+			name:    "Completed Workflow",
+			request: newRequest("activity"),
+			init: func(engine *testdata.EngineForTest) {
+				enable(engine)
+				engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&persistence.GetWorkflowExecutionResponse{State: mutableState(persistence.WorkflowStateCompleted, 6, 10)}, nil)
+			},
+			expectedErr: workflow.ErrAlreadyCompleted,
+		},
+		{
+			name:    "Activity Not Pending",
+			request: newRequest("completed-activity"),
+			init: func(engine *testdata.EngineForTest) {
+				enable(engine)
+				engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&persistence.GetWorkflowExecutionResponse{State: mutableState(persistence.WorkflowStateRunning, 6, 10)}, nil)
+			},
+			expectedErr: &types.BadRequestError{Message: "Activity is not pending, it has either completed or failed."},
+		},
+		{
+			name:    "Activity Not Started",
+			request: newRequest("activity"),
+			init: func(engine *testdata.EngineForTest) {
+				enable(engine)
+				engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&persistence.GetWorkflowExecutionResponse{State: mutableState(persistence.WorkflowStateRunning, common.EmptyEventID, 10)}, nil)
+			},
+			expectedErr: &types.BadRequestError{Message: "Activity is not started, only started activities have a heartbeat timer."},
+		},
+		{
+			name:    "No Heartbeat Timeout",
+			request: newRequest("activity"),
+			init: func(engine *testdata.EngineForTest) {
+				enable(engine)
+				engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&persistence.GetWorkflowExecutionResponse{State: mutableState(persistence.WorkflowStateRunning, 6, 0)}, nil)
+			},
+			expectedErr: &types.BadRequestError{Message: "Activity has no heartbeat timeout."},
+		},
+		{
+			name:    "Success",
+			request: newRequest("activity"),
+			init: func(engine *testdata.EngineForTest) {
+				enable(engine)
+				engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&persistence.GetWorkflowExecutionResponse{State: mutableState(persistence.WorkflowStateRunning, 6, 10)}, nil)
+				engine.ShardCtx.Resource.ExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.UpdateWorkflowExecutionRequest) bool {
+					timerTasks := req.UpdateWorkflowMutation.TasksByCategory[persistence.HistoryTaskCategoryTimer]
+					for _, task := range timerTasks {
+						// the heartbeat timer fires right away rather than 10 seconds after the start of the attempt
+						if timeoutTask, ok := task.(*persistence.ActivityTimeoutTask); ok &&
+							timeoutTask.TimeoutType == int(types.TimeoutTypeHeartbeat) &&
+							timeoutTask.VisibilityTimestamp.Before(time.Now().Add(5*time.Second)) {
+							return true
+						}
+					}
+					return false
+				})).Return(&persistence.UpdateWorkflowExecutionResponse{
+					MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{},
+				}, nil)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+
+			if testCase.init != nil {
+				testCase.init(eft)
+			}
+			eft.Engine.Start()
+			err := eft.Engine.ExpireActivityHeartbeat(ctx.Background(), testCase.request)
+			eft.Engine.Stop()
+
+			assert.Equal(t, testCase.expectedErr, err)
+		})
+	}
+}
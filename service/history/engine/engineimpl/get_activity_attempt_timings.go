@@ -0,0 +1,67 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// GetActivityAttemptTimings returns when each attempt of an activity started and ended and how it ended, oldest
+// first, for every time the activity ID was scheduled in the run. The attempts are the ones of
+// GetActivityWorkerHistory: the start and end of the final attempt of each schedule come from its started and
+// closing events, the ones of the attempts retried before it from the reschedule reasons of the activity.
+func (e *historyEngineImpl) GetActivityAttemptTimings(
+	ctx context.Context,
+	request *types.HistoryGetActivityAttemptTimingsRequest,
+) (*types.GetActivityAttemptTimingsResponse, error) {
+
+	historyRequest := request.Request
+	workerHistory, err := e.GetActivityWorkerHistory(ctx, &types.HistoryGetActivityWorkerHistoryRequest{
+		DomainUUID: request.DomainUUID,
+		Request: &types.GetActivityWorkerHistoryRequest{
+			Domain:            historyRequest.GetDomain(),
+			WorkflowExecution: historyRequest.GetWorkflowExecution(),
+			ActivityID:        historyRequest.GetActivityID(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := make([]*types.ActivityAttemptTiming, 0, len(workerHistory.GetAttempts()))
+	for _, attempt := range workerHistory.GetAttempts() {
+		attempts = append(attempts, &types.ActivityAttemptTiming{
+			ScheduledEventID: attempt.ScheduledEventID,
+			Attempt:          attempt.Attempt,
+			StartedTimestamp: attempt.StartedTimestamp,
+			EndTimestamp:     attempt.EndTimestamp,
+			Outcome:          attempt.Outcome,
+		})
+	}
+	return &types.GetActivityAttemptTimingsResponse{
+		ActivityID: workerHistory.ActivityID,
+		Attempts:   attempts,
+	}, nil
+}
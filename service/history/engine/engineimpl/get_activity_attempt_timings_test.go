@@ -0,0 +1,172 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package engineimpl
+
+import (
+	ctx "context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine/testdata"
+)
+
+func TestGetActivityAttemptTimings(t *testing.T) {
+	workflowExecution := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	newRequest := func(activityID string) *types.HistoryGetActivityAttemptTimingsRequest {
+		return &types.HistoryGetActivityAttemptTimingsRequest{
+			DomainUUID: constants.TestDomainID,
+			Request: &types.GetActivityAttemptTimingsRequest{
+				Domain:            constants.TestDomainName,
+				WorkflowExecution: workflowExecution,
+				ActivityID:        activityID,
+			},
+		}
+	}
+	startTime := time.Unix(1700000000, 0)
+	at := func(seconds int) *int64 {
+		return common.Int64Ptr(startTime.Add(time.Duration(seconds) * time.Second).UnixNano())
+	}
+	mutableState := &persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:    constants.TestDomainID,
+			WorkflowID:  workflowExecution.WorkflowID,
+			RunID:       workflowExecution.RunID,
+			State:       persistence.WorkflowStateRunning,
+			NextEventID: 9,
+			BranchToken: []byte("branch-token"),
+		},
+		ActivityInfos: map[int64]*persistence.ActivityInfo{
+			8: {
+				ScheduleID:      8,
+				StartedID:       common.TransientEventID,
+				StartedTime:     startTime.Add(40 * time.Second),
+				ActivityID:      "flaky",
+				DomainID:        constants.TestDomainID,
+				Attempt:         1,
+				StartedIdentity: "worker-b",
+				RescheduleReasons: []*types.ActivityRescheduleReason{
+					{Attempt: 0, Cause: types.ActivityRescheduleCauseStartToCloseTimeout.Ptr(), Identity: "worker-a", StartedTimestamp: at(20), Timestamp: at(30)},
+				},
+			},
+		},
+		ExecutionStats: &persistence.ExecutionStats{},
+		Checksum:       checksum.Checksum{},
+	}
+	historyEvents := []*types.HistoryEvent{
+		{
+			ID:        5,
+			EventType: types.EventTypeActivityTaskScheduled.Ptr(),
+			ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+				ActivityID: "flaky",
+			},
+		},
+		{
+			ID:        6,
+			Timestamp: at(5),
+			EventType: types.EventTypeActivityTaskStarted.Ptr(),
+			ActivityTaskStartedEventAttributes: &types.ActivityTaskStartedEventAttributes{
+				ScheduledEventID: 5,
+				Attempt:          1,
+				Identity:         "worker-b",
+				RetriedAttempts: []*types.ActivityWorkerAttempt{
+					{ScheduledEventID: 5, Attempt: 0, Identity: "worker-a", Outcome: types.EventTypeActivityTaskFailed.Ptr(), StartedTimestamp: at(1), EndTimestamp: at(2)},
+				},
+			},
+		},
+		{
+			ID:        7,
+			Timestamp: at(9),
+			EventType: types.EventTypeActivityTaskFailed.Ptr(),
+			ActivityTaskFailedEventAttributes: &types.ActivityTaskFailedEventAttributes{
+				ScheduledEventID: 5,
+				StartedEventID:   6,
+			},
+		},
+		{
+			ID:        8,
+			EventType: types.EventTypeActivityTaskScheduled.Ptr(),
+			ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+				ActivityID: "flaky",
+			},
+		},
+	}
+	initWorkflow := func(engine *testdata.EngineForTest) {
+		engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+			Return(&persistence.GetWorkflowExecutionResponse{State: mutableState}, nil)
+		engine.ShardCtx.Resource.HistoryMgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).
+			Return(&persistence.ReadHistoryBranchResponse{HistoryEvents: historyEvents}, nil).Once()
+	}
+	cases := []struct {
+		name             string
+		request          *types.HistoryGetActivityAttemptTimingsRequest
+		init             func(engine *testdata.EngineForTest)
+		expectedResponse *types.GetActivityAttemptTimingsResponse
+		expectedErr      error
+	}{
+		{
+			name:        "Missing ActivityID",
+			request:     newRequest(""),
+			expectedErr: &types.BadRequestError{Message: "ActivityID is not set on request."},
+		},
+		{
+			name:    "Closed And Pending Attempts",
+			request: newRequest("flaky"),
+			init:    initWorkflow,
+			expectedResponse: &types.GetActivityAttemptTimingsResponse{
+				ActivityID: "flaky",
+				Attempts: []*types.ActivityAttemptTiming{
+					{ScheduledEventID: 5, Attempt: 0, StartedTimestamp: at(1), EndTimestamp: at(2), Outcome: types.EventTypeActivityTaskFailed.Ptr()},
+					{ScheduledEventID: 5, Attempt: 1, StartedTimestamp: at(5), EndTimestamp: at(9), Outcome: types.EventTypeActivityTaskFailed.Ptr()},
+					{ScheduledEventID: 8, Attempt: 0, StartedTimestamp: at(20), EndTimestamp: at(30), Outcome: types.EventTypeActivityTaskTimedOut.Ptr()},
+					{ScheduledEventID: 8, Attempt: 1, StartedTimestamp: at(40)},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+
+			if testCase.init != nil {
+				testCase.init(eft)
+			}
+			eft.Engine.Start()
+			response, err := eft.Engine.GetActivityAttemptTimings(ctx.Background(), testCase.request)
+			eft.Engine.Stop()
+
+			assert.Equal(t, testCase.expectedErr, err)
+			assert.Equal(t, testCase.expectedResponse, response)
+		})
+	}
+}
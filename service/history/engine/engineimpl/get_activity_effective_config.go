@@ -0,0 +1,84 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// GetActivityEffectiveConfig returns the timeouts and retry policy which apply to a pending activity. The values are
+// read from the scheduled event, so they reflect what history resolved when the activity was scheduled, after
+// defaults, workflow timeout caps and retry policy adjustments were applied to the decision attributes.
+func (e *historyEngineImpl) GetActivityEffectiveConfig(
+	ctx context.Context,
+	request *types.HistoryGetActivityEffectiveConfigRequest,
+) (retResp *types.GetActivityEffectiveConfigResponse, retError error) {
+
+	if err := common.ValidateDomainUUID(request.DomainUUID); err != nil {
+		return nil, err
+	}
+	configRequest := request.Request
+	if configRequest.GetActivityID() == "" {
+		return nil, &types.BadRequestError{Message: "ActivityID is not set on request."}
+	}
+	if configRequest.WorkflowExecution == nil {
+		return nil, &types.BadRequestError{Message: "WorkflowExecution is not set on request."}
+	}
+
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, request.DomainUUID, *configRequest.WorkflowExecution)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ai, ok := mutableState.GetActivityByActivityID(configRequest.GetActivityID())
+	if !ok {
+		return nil, workflow.ErrActivityTaskNotFound
+	}
+	scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, ai.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+	attributes := scheduledEvent.ActivityTaskScheduledEventAttributes
+
+	return &types.GetActivityEffectiveConfigResponse{
+		ActivityID:                               attributes.ActivityID,
+		TaskList:                                 attributes.TaskList,
+		ScheduleToStartTimeoutSeconds:            attributes.GetScheduleToStartTimeoutSeconds(),
+		StartToCloseTimeoutSeconds:               attributes.GetStartToCloseTimeoutSeconds(),
+		FirstAttemptStartToCloseSeconds:          attributes.GetFirstAttemptStartToCloseSeconds(),
+		ScheduleToCloseTimeoutSeconds:            attributes.GetScheduleToCloseTimeoutSeconds(),
+		HeartbeatTimeoutSeconds:                  attributes.GetHeartbeatTimeoutSeconds(),
+		RetryPolicy:                              attributes.RetryPolicy,
+		Attempt:                                  ai.Attempt,
+		CurrentAttemptStartToCloseTimeoutSeconds: attributes.GetStartToCloseTimeoutSecondsForAttempt(int64(ai.Attempt)),
+	}, nil
+}
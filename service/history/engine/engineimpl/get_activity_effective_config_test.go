@@ -0,0 +1,150 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package engineimpl
+
+import (
+	ctx "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine/testdata"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+func TestGetActivityEffectiveConfig(t *testing.T) {
+	execution := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	retryPolicy := &types.RetryPolicy{
+		InitialIntervalInSeconds: 1,
+		BackoffCoefficient:       2,
+		MaximumAttempts:          5,
+	}
+	newRequest := func(activityID string) *types.HistoryGetActivityEffectiveConfigRequest {
+		return &types.HistoryGetActivityEffectiveConfigRequest{
+			DomainUUID: constants.TestDomainID,
+			Request: &types.GetActivityEffectiveConfigRequest{
+				Domain:            constants.TestDomainName,
+				WorkflowExecution: execution,
+				ActivityID:        activityID,
+			},
+		}
+	}
+	mutableState := &persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:   constants.TestDomainID,
+			WorkflowID: execution.WorkflowID,
+			RunID:      execution.RunID,
+			State:      persistence.WorkflowStateRunning,
+		},
+		ActivityInfos: map[int64]*persistence.ActivityInfo{
+			5: {
+				ScheduleID: 5,
+				StartedID:  common.EmptyEventID,
+				ActivityID: "activity",
+				DomainID:   constants.TestDomainID,
+				Attempt:    0,
+				ScheduledEvent: &types.HistoryEvent{
+					ID: 5,
+					ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+						ActivityID:                      "activity",
+						TaskList:                        &types.TaskList{Name: "tasklist"},
+						ScheduleToStartTimeoutSeconds:   common.Int32Ptr(10),
+						StartToCloseTimeoutSeconds:      common.Int32Ptr(60),
+						FirstAttemptStartToCloseSeconds: common.Int32Ptr(5),
+						ScheduleToCloseTimeoutSeconds:   common.Int32Ptr(300),
+						HeartbeatTimeoutSeconds:         common.Int32Ptr(15),
+						RetryPolicy:                     retryPolicy,
+					},
+				},
+			},
+		},
+		ExecutionStats: &persistence.ExecutionStats{},
+		Checksum:       checksum.Checksum{},
+	}
+	cases := []struct {
+		name             string
+		request          *types.HistoryGetActivityEffectiveConfigRequest
+		init             func(engine *testdata.EngineForTest)
+		expectedResponse *types.GetActivityEffectiveConfigResponse
+		expectedErr      error
+	}{
+		{
+			name:        "Missing ActivityID",
+			request:     newRequest(""),
+			expectedErr: &types.BadRequestError{Message: "ActivityID is not set on request."},
+		},
+		{
+			name:    "Activity Not Found",
+			request: newRequest("unknown"),
+			init: func(engine *testdata.EngineForTest) {
+				engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&persistence.GetWorkflowExecutionResponse{State: mutableState}, nil)
+			},
+			expectedErr: workflow.ErrActivityTaskNotFound,
+		},
+		{
+			name:    "Success",
+			request: newRequest("activity"),
+			init: func(engine *testdata.EngineForTest) {
+				engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&persistence.GetWorkflowExecutionResponse{State: mutableState}, nil)
+			},
+			expectedResponse: &types.GetActivityEffectiveConfigResponse{
+				ActivityID:                               "activity",
+				TaskList:                                 &types.TaskList{Name: "tasklist"},
+				ScheduleToStartTimeoutSeconds:            10,
+				StartToCloseTimeoutSeconds:               60,
+				FirstAttemptStartToCloseSeconds:          5,
+				ScheduleToCloseTimeoutSeconds:            300,
+				HeartbeatTimeoutSeconds:                  15,
+				RetryPolicy:                              retryPolicy,
+				Attempt:                                  0,
+				CurrentAttemptStartToCloseTimeoutSeconds: 5,
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+
+			if testCase.init != nil {
+				testCase.init(eft)
+			}
+			eft.Engine.Start()
+			response, err := eft.Engine.GetActivityEffectiveConfig(ctx.Background(), testCase.request)
+			eft.Engine.Stop()
+
+			assert.Equal(t, testCase.expectedErr, err)
+			assert.Equal(t, testCase.expectedResponse, response)
+		})
+	}
+}
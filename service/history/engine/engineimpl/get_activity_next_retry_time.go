@@ -0,0 +1,79 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// GetActivityNextRetryTime returns when a pending activity that failed or timed out will be dispatched again. The
+// timestamp is the one the retry timer was created with when the previous attempt closed, so it is only available
+// while the activity waits for that timer; ErrActivityNotWaitingForRetry is returned otherwise.
+func (e *historyEngineImpl) GetActivityNextRetryTime(
+	ctx context.Context,
+	request *types.HistoryGetActivityNextRetryTimeRequest,
+) (retResp *types.GetActivityNextRetryTimeResponse, retError error) {
+
+	if err := common.ValidateDomainUUID(request.DomainUUID); err != nil {
+		return nil, err
+	}
+	retryRequest := request.Request
+	if retryRequest.GetActivityID() == "" {
+		return nil, &types.BadRequestError{Message: "ActivityID is not set on request."}
+	}
+	if retryRequest.WorkflowExecution == nil {
+		return nil, &types.BadRequestError{Message: "WorkflowExecution is not set on request."}
+	}
+
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, request.DomainUUID, *retryRequest.WorkflowExecution)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ai, ok := mutableState.GetActivityByActivityID(retryRequest.GetActivityID())
+	if !ok {
+		return nil, workflow.ErrActivityTaskNotFound
+	}
+	if !ai.HasRetryPolicy || ai.Attempt == 0 || ai.StartedID != common.EmptyEventID || !ai.ScheduledTime.After(e.timeSource.Now()) {
+		return nil, workflow.ErrActivityNotWaitingForRetry
+	}
+
+	response := &types.GetActivityNextRetryTimeResponse{
+		ActivityID:         ai.ActivityID,
+		Attempt:            ai.Attempt,
+		NextRetryTimestamp: common.Int64Ptr(ai.ScheduledTime.UnixNano()),
+	}
+	if ai.LastFailureReason != "" {
+		response.LastFailureReason = common.StringPtr(ai.LastFailureReason)
+	}
+	return response, nil
+}
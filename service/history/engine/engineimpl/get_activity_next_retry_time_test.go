@@ -0,0 +1,144 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package engineimpl
+
+import (
+	ctx "context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine/testdata"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+func TestGetActivityNextRetryTime(t *testing.T) {
+	execution := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	nextRetryTime := time.Now().Add(time.Hour)
+	newRequest := func(activityID string) *types.HistoryGetActivityNextRetryTimeRequest {
+		return &types.HistoryGetActivityNextRetryTimeRequest{
+			DomainUUID: constants.TestDomainID,
+			Request: &types.GetActivityNextRetryTimeRequest{
+				Domain:            constants.TestDomainName,
+				WorkflowExecution: execution,
+				ActivityID:        activityID,
+			},
+		}
+	}
+	mutableState := &persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:   constants.TestDomainID,
+			WorkflowID: execution.WorkflowID,
+			RunID:      execution.RunID,
+			State:      persistence.WorkflowStateRunning,
+		},
+		ActivityInfos: map[int64]*persistence.ActivityInfo{
+			5: {
+				ScheduleID:        5,
+				StartedID:         common.EmptyEventID,
+				ActivityID:        "retrying",
+				DomainID:          constants.TestDomainID,
+				HasRetryPolicy:    true,
+				Attempt:           2,
+				ScheduledTime:     nextRetryTime,
+				LastFailureReason: "some reason",
+			},
+			6: {
+				ScheduleID:     6,
+				StartedID:      7,
+				ActivityID:     "running",
+				DomainID:       constants.TestDomainID,
+				HasRetryPolicy: true,
+				Attempt:        2,
+				ScheduledTime:  time.Now().Add(-time.Minute),
+			},
+		},
+		ExecutionStats: &persistence.ExecutionStats{},
+		Checksum:       checksum.Checksum{},
+	}
+	initMutableState := func(engine *testdata.EngineForTest) {
+		engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+			Return(&persistence.GetWorkflowExecutionResponse{State: mutableState}, nil)
+	}
+	cases := []struct {
+		name             string
+		request          *types.HistoryGetActivityNextRetryTimeRequest
+		init             func(engine *testdata.EngineForTest)
+		expectedResponse *types.GetActivityNextRetryTimeResponse
+		expectedErr      error
+	}{
+		{
+			name:        "Missing ActivityID",
+			request:     newRequest(""),
+			expectedErr: &types.BadRequestError{Message: "ActivityID is not set on request."},
+		},
+		{
+			name:        "Activity Not Found",
+			request:     newRequest("unknown"),
+			init:        initMutableState,
+			expectedErr: workflow.ErrActivityTaskNotFound,
+		},
+		{
+			name:        "Activity Not Waiting For Retry",
+			request:     newRequest("running"),
+			init:        initMutableState,
+			expectedErr: workflow.ErrActivityNotWaitingForRetry,
+		},
+		{
+			name:    "Success",
+			request: newRequest("retrying"),
+			init:    initMutableState,
+			expectedResponse: &types.GetActivityNextRetryTimeResponse{
+				ActivityID:         "retrying",
+				Attempt:            2,
+				NextRetryTimestamp: common.Int64Ptr(nextRetryTime.UnixNano()),
+				LastFailureReason:  common.StringPtr("some reason"),
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+
+			if testCase.init != nil {
+				testCase.init(eft)
+			}
+			eft.Engine.Start()
+			response, err := eft.Engine.GetActivityNextRetryTime(ctx.Background(), testCase.request)
+			eft.Engine.Stop()
+
+			assert.Equal(t, testCase.expectedErr, err)
+			assert.Equal(t, testCase.expectedResponse, response)
+		})
+	}
+}
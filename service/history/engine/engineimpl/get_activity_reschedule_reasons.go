@@ -0,0 +1,90 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// GetActivityRescheduleReasons returns why a pending activity was scheduled again, oldest first. The reasons are
+// persisted with the activity info; when the latest reschedule was not recorded, it is derived from the last
+// failure reason of the activity, without a timestamp.
+func (e *historyEngineImpl) GetActivityRescheduleReasons(
+	ctx context.Context,
+	request *types.HistoryGetActivityRescheduleReasonsRequest,
+) (retResp *types.GetActivityRescheduleReasonsResponse, retError error) {
+
+	if err := common.ValidateDomainUUID(request.DomainUUID); err != nil {
+		return nil, err
+	}
+	reasonsRequest := request.Request
+	if reasonsRequest.GetActivityID() == "" {
+		return nil, &types.BadRequestError{Message: "ActivityID is not set on request."}
+	}
+	if reasonsRequest.WorkflowExecution == nil {
+		return nil, &types.BadRequestError{Message: "WorkflowExecution is not set on request."}
+	}
+
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, request.DomainUUID, *reasonsRequest.WorkflowExecution)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ai, ok := mutableState.GetActivityByActivityID(reasonsRequest.GetActivityID())
+	if !ok {
+		return nil, workflow.ErrActivityTaskNotFound
+	}
+
+	reasons := make([]*types.ActivityRescheduleReason, 0, len(ai.RescheduleReasons)+1)
+	for _, reason := range ai.RescheduleReasons {
+		reasons = append(reasons, &types.ActivityRescheduleReason{
+			Attempt:   reason.Attempt,
+			Cause:     reason.Cause,
+			Timestamp: reason.Timestamp,
+			Identity:  reason.Identity,
+		})
+	}
+	lastAttempt := ai.Attempt - 1
+	if lastAttempt >= 0 && ai.LastFailureReason != "" && (len(reasons) == 0 || reasons[len(reasons)-1].Attempt < lastAttempt) {
+		reasons = append(reasons, &types.ActivityRescheduleReason{
+			Attempt:  lastAttempt,
+			Cause:    execution.FailureReasonToActivityRescheduleCause(ai.LastFailureReason).Ptr(),
+			Identity: ai.LastWorkerIdentity,
+		})
+	}
+
+	return &types.GetActivityRescheduleReasonsResponse{
+		ActivityID: ai.ActivityID,
+		Reasons:    reasons,
+	}, nil
+}
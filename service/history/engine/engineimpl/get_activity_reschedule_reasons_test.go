@@ -0,0 +1,174 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package engineimpl
+
+import (
+	ctx "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine/testdata"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+func TestGetActivityRescheduleReasons(t *testing.T) {
+	workflowExecution := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	newRequest := func(activityID string) *types.HistoryGetActivityRescheduleReasonsRequest {
+		return &types.HistoryGetActivityRescheduleReasonsRequest{
+			DomainUUID: constants.TestDomainID,
+			Request: &types.GetActivityRescheduleReasonsRequest{
+				Domain:            constants.TestDomainName,
+				WorkflowExecution: workflowExecution,
+				ActivityID:        activityID,
+			},
+		}
+	}
+	recordedReasons := []*types.ActivityRescheduleReason{
+		{
+			Attempt:   0,
+			Cause:     types.ActivityRescheduleCauseScheduleToStartTimeout.Ptr(),
+			Timestamp: common.Int64Ptr(1000),
+		},
+		{
+			Attempt:   1,
+			Cause:     types.ActivityRescheduleCauseFailure.Ptr(),
+			Timestamp: common.Int64Ptr(2000),
+		},
+	}
+	mutableState := &persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:   constants.TestDomainID,
+			WorkflowID: workflowExecution.WorkflowID,
+			RunID:      workflowExecution.RunID,
+			State:      persistence.WorkflowStateRunning,
+		},
+		ActivityInfos: map[int64]*persistence.ActivityInfo{
+			5: {
+				ScheduleID:        5,
+				StartedID:         common.EmptyEventID,
+				ActivityID:        "recorded",
+				DomainID:          constants.TestDomainID,
+				Attempt:           2,
+				LastFailureReason: "some reason",
+				RescheduleReasons: recordedReasons,
+			},
+			6: {
+				ScheduleID:        6,
+				StartedID:         common.EmptyEventID,
+				ActivityID:        "reloaded",
+				DomainID:          constants.TestDomainID,
+				Attempt:           3,
+				LastFailureReason: execution.TimerTypeToReason(execution.TimerTypeHeartbeat),
+			},
+			7: {
+				ScheduleID: 7,
+				StartedID:  common.EmptyEventID,
+				ActivityID: "first-attempt",
+				DomainID:   constants.TestDomainID,
+			},
+		},
+		ExecutionStats: &persistence.ExecutionStats{},
+		Checksum:       checksum.Checksum{},
+	}
+	initMutableState := func(engine *testdata.EngineForTest) {
+		engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+			Return(&persistence.GetWorkflowExecutionResponse{State: mutableState}, nil)
+	}
+	cases := []struct {
+		name             string
+		request          *types.HistoryGetActivityRescheduleReasonsRequest
+		init             func(engine *testdata.EngineForTest)
+		expectedResponse *types.GetActivityRescheduleReasonsResponse
+		expectedErr      error
+	}{
+		{
+			name:        "Missing ActivityID",
+			request:     newRequest(""),
+			expectedErr: &types.BadRequestError{Message: "ActivityID is not set on request."},
+		},
+		{
+			name:        "Activity Not Found",
+			request:     newRequest("unknown"),
+			init:        initMutableState,
+			expectedErr: workflow.ErrActivityTaskNotFound,
+		},
+		{
+			name:    "Recorded Reasons",
+			request: newRequest("recorded"),
+			init:    initMutableState,
+			expectedResponse: &types.GetActivityRescheduleReasonsResponse{
+				ActivityID: "recorded",
+				Reasons:    recordedReasons,
+			},
+		},
+		{
+			name:    "Reasons Lost On Reload",
+			request: newRequest("reloaded"),
+			init:    initMutableState,
+			expectedResponse: &types.GetActivityRescheduleReasonsResponse{
+				ActivityID: "reloaded",
+				Reasons: []*types.ActivityRescheduleReason{
+					{
+						Attempt: 2,
+						Cause:   types.ActivityRescheduleCauseHeartbeatTimeout.Ptr(),
+					},
+				},
+			},
+		},
+		{
+			name:    "Never Rescheduled",
+			request: newRequest("first-attempt"),
+			init:    initMutableState,
+			expectedResponse: &types.GetActivityRescheduleReasonsResponse{
+				ActivityID: "first-attempt",
+				Reasons:    []*types.ActivityRescheduleReason{},
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+
+			if testCase.init != nil {
+				testCase.init(eft)
+			}
+			eft.Engine.Start()
+			response, err := eft.Engine.GetActivityRescheduleReasons(ctx.Background(), testCase.request)
+			eft.Engine.Stop()
+
+			assert.Equal(t, testCase.expectedErr, err)
+			assert.Equal(t, testCase.expectedResponse, response)
+		})
+	}
+}
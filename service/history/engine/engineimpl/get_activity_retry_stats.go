@@ -0,0 +1,72 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+// GetActivityRetryStats returns the outcome of the activity attempts of a domain closed within a time range, to
+// tell how often the activities of the domain need to be retried. The stats are aggregated in memory by the history
+// host with EnableActivityRetryStats, so they only cover the attempts closed on this host since it started and
+// within ActivityRetryStatsRetention: the stats of the domain are the sum of the responses of all the hosts.
+func (e *historyEngineImpl) GetActivityRetryStats(
+	ctx context.Context,
+	request *types.HistoryGetActivityRetryStatsRequest,
+) (*types.GetActivityRetryStatsResponse, error) {
+
+	if err := common.ValidateDomainUUID(request.DomainUUID); err != nil {
+		return nil, err
+	}
+	domainName, err := e.shard.GetDomainCache().GetDomainName(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	statsRequest := request.Request
+	endTime := e.timeSource.Now()
+	if statsRequest.EndTime != nil {
+		endTime = time.Unix(0, statsRequest.GetEndTime())
+	}
+	startTime := endTime.Add(-e.config.ActivityRetryStatsRetention(domainName))
+	if statsRequest.StartTime != nil {
+		startTime = time.Unix(0, statsRequest.GetStartTime())
+	}
+	if !startTime.Before(endTime) {
+		return nil, &types.BadRequestError{Message: "StartTime must be before EndTime."}
+	}
+
+	stats := e.shard.GetService().GetActivityRetryStats().GetStats(domainName, request.DomainUUID, startTime, endTime)
+	return &types.GetActivityRetryStatsResponse{
+		StartTime:             startTime.UnixNano(),
+		EndTime:               endTime.UnixNano(),
+		TotalAttempts:         stats.TotalAttempts,
+		RetriedAttempts:       stats.RetriedAttempts,
+		Successes:             stats.Successes,
+		FirstAttemptSuccesses: stats.FirstAttemptSuccesses,
+	}, nil
+}
@@ -0,0 +1,103 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	ctx "context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/activitystats"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine/testdata"
+)
+
+func TestGetActivityRetryStats(t *testing.T) {
+	startTime := time.Unix(0, 0)
+	endTime := startTime.Add(time.Hour)
+	newRequest := func(startTime, endTime time.Time) *types.HistoryGetActivityRetryStatsRequest {
+		return &types.HistoryGetActivityRetryStatsRequest{
+			DomainUUID: constants.TestDomainID,
+			Request: &types.GetActivityRetryStatsRequest{
+				Domain:    constants.TestDomainName,
+				StartTime: common.Int64Ptr(startTime.UnixNano()),
+				EndTime:   common.Int64Ptr(endTime.UnixNano()),
+			},
+		}
+	}
+	cases := []struct {
+		name             string
+		request          *types.HistoryGetActivityRetryStatsRequest
+		init             func(engine *testdata.EngineForTest)
+		expectedResponse *types.GetActivityRetryStatsResponse
+		expectedErr      error
+	}{
+		{
+			name:        "Empty Range",
+			request:     newRequest(endTime, startTime),
+			expectedErr: &types.BadRequestError{Message: "StartTime must be before EndTime."},
+		},
+		{
+			name:    "Success",
+			request: newRequest(startTime, endTime),
+			init: func(engine *testdata.EngineForTest) {
+				engine.ShardCtx.Resource.ActivityRetryStats.EXPECT().
+					GetStats(constants.TestDomainName, constants.TestDomainID, startTime, endTime).
+					Return(activitystats.Stats{
+						TotalAttempts:         10,
+						RetriedAttempts:       4,
+						Successes:             6,
+						FirstAttemptSuccesses: 5,
+					})
+			},
+			expectedResponse: &types.GetActivityRetryStatsResponse{
+				StartTime:             startTime.UnixNano(),
+				EndTime:               endTime.UnixNano(),
+				TotalAttempts:         10,
+				RetriedAttempts:       4,
+				Successes:             6,
+				FirstAttemptSuccesses: 5,
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+
+			if testCase.init != nil {
+				testCase.init(eft)
+			}
+			eft.Engine.Start()
+			response, err := eft.Engine.GetActivityRetryStats(ctx.Background(), testCase.request)
+			eft.Engine.Stop()
+
+			assert.Equal(t, testCase.expectedErr, err)
+			assert.Equal(t, testCase.expectedResponse, response)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/collection"
+	persistenceutils "github.com/uber/cadence/common/persistence/persistence-utils"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+)
+
+// GetActivityWorkerHistory returns the worker each attempt of an activity ran on and how the attempt ended, oldest
+// first, for every time the activity ID was scheduled in the run. Closed activities are assembled from their history
+// events, the attempt pending in the mutable state is appended last.
+//
+// Only the final attempt of an activity with a retry policy is recorded as a started event, the attempts retried
+// before it are recorded on that event from the reschedule reasons of the activity, see
+// execution.GetActivityRetriedAttempts.
+func (e *historyEngineImpl) GetActivityWorkerHistory(
+	ctx context.Context,
+	request *types.HistoryGetActivityWorkerHistoryRequest,
+) (*types.GetActivityWorkerHistoryResponse, error) {
+
+	if err := common.ValidateDomainUUID(request.DomainUUID); err != nil {
+		return nil, err
+	}
+	historyRequest := request.Request
+	if historyRequest.GetActivityID() == "" {
+		return nil, &types.BadRequestError{Message: "ActivityID is not set on request."}
+	}
+	if historyRequest.WorkflowExecution == nil {
+		return nil, &types.BadRequestError{Message: "WorkflowExecution is not set on request."}
+	}
+
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, request.DomainUUID, *historyRequest.WorkflowExecution)
+	if err != nil {
+		return nil, err
+	}
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		release(err)
+		return nil, err
+	}
+	branchToken, err := mutableState.GetCurrentBranchToken()
+	if err != nil {
+		release(err)
+		return nil, err
+	}
+	nextEventID := mutableState.GetNextEventID()
+	// the started event of the pending attempt of an activity with a retry policy is only recorded once it closes
+	var pendingAttempts []*types.ActivityWorkerAttempt
+	if ai, ok := mutableState.GetActivityByActivityID(historyRequest.GetActivityID()); ok && (ai.StartedID == common.EmptyEventID || ai.StartedID == common.TransientEventID) {
+		pendingAttempts = execution.GetActivityRetriedAttempts(ai)
+		if ai.StartedID == common.TransientEventID {
+			pendingAttempt := &types.ActivityWorkerAttempt{
+				ScheduledEventID: ai.ScheduleID,
+				Attempt:          ai.Attempt,
+				Identity:         ai.StartedIdentity,
+			}
+			if !ai.StartedTime.IsZero() {
+				pendingAttempt.StartedTimestamp = common.Int64Ptr(ai.StartedTime.UnixNano())
+			}
+			pendingAttempts = append(pendingAttempts, pendingAttempt)
+		}
+	}
+	// history is read without holding the lock of the workflow
+	release(nil)
+
+	iter := collection.NewPagingIterator(func(paginationToken []byte) ([]interface{}, []byte, error) {
+		events, _, token, _, err := persistenceutils.PaginateHistory(
+			ctx,
+			e.historyV2Mgr,
+			false,
+			branchToken,
+			common.FirstEventID,
+			nextEventID,
+			paginationToken,
+			execution.NDCDefaultPageSize,
+			common.IntPtr(e.shard.GetShardID()),
+			request.DomainUUID,
+			e.shard.GetDomainCache(),
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		items := make([]interface{}, 0, len(events))
+		for _, event := range events {
+			items = append(items, event)
+		}
+		return items, token, nil
+	})
+
+	attempts := []*types.ActivityWorkerAttempt{}
+	// the started attempt of each schedule of the activity ID, nil until it is started
+	startedAttempts := make(map[int64]*types.ActivityWorkerAttempt)
+	closeAttempt := func(scheduledEventID int64, event *types.HistoryEvent) {
+		started, ok := startedAttempts[scheduledEventID]
+		if !ok {
+			return
+		}
+		if started == nil {
+			// the activity was canceled or timed out before any attempt started
+			started = &types.ActivityWorkerAttempt{ScheduledEventID: scheduledEventID}
+			attempts = append(attempts, started)
+		}
+		started.Outcome = event.EventType
+		started.EndTimestamp = event.Timestamp
+		delete(startedAttempts, scheduledEventID)
+	}
+	for iter.HasNext() {
+		item, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		event := item.(*types.HistoryEvent)
+		switch event.GetEventType() {
+		case types.EventTypeActivityTaskScheduled:
+			if event.ActivityTaskScheduledEventAttributes.GetActivityID() == historyRequest.GetActivityID() {
+				startedAttempts[event.ID] = nil
+			}
+		case types.EventTypeActivityTaskStarted:
+			attributes := event.ActivityTaskStartedEventAttributes
+			if _, ok := startedAttempts[attributes.GetScheduledEventID()]; !ok {
+				continue
+			}
+			started := &types.ActivityWorkerAttempt{
+				ScheduledEventID: attributes.GetScheduledEventID(),
+				Attempt:          attributes.Attempt,
+				Identity:         attributes.Identity,
+				StartedTimestamp: event.Timestamp,
+			}
+			attempts = append(attempts, attributes.GetRetriedAttempts()...)
+			attempts = append(attempts, started)
+			startedAttempts[attributes.GetScheduledEventID()] = started
+		case types.EventTypeActivityTaskCompleted:
+			closeAttempt(event.ActivityTaskCompletedEventAttributes.GetScheduledEventID(), event)
+		case types.EventTypeActivityTaskFailed:
+			closeAttempt(event.ActivityTaskFailedEventAttributes.GetScheduledEventID(), event)
+		case types.EventTypeActivityTaskTimedOut:
+			closeAttempt(event.ActivityTaskTimedOutEventAttributes.GetScheduledEventID(), event)
+		case types.EventTypeActivityTaskCanceled:
+			closeAttempt(event.ActivityTaskCanceledEventAttributes.GetScheduledEventID(), event)
+		}
+	}
+
+	return &types.GetActivityWorkerHistoryResponse{
+		ActivityID: historyRequest.GetActivityID(),
+		Attempts:   append(attempts, pendingAttempts...),
+	}, nil
+}
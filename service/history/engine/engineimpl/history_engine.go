@@ -31,6 +31,8 @@ import (
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/client/wrappers/retryable"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activityencryption"
+	"github.com/uber/cadence/common/activityvalidator"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
@@ -69,6 +71,8 @@ const (
 	queryFirstDecisionTaskCheckInterval   = 200 * time.Millisecond
 	contextLockTimeout                    = 500 * time.Millisecond
 	longPollCompletionBuffer              = 50 * time.Millisecond
+	completedActivityCacheMaxCount        = 10000
+	activityResultCacheMaxCount           = 10000
 
 	// TerminateIfRunningReason reason for terminateIfRunning
 	TerminateIfRunningReason = "TerminateIfRunning Policy"
@@ -116,6 +120,11 @@ type historyEngineImpl struct {
 	replicationDLQHandler     replication.DLQHandler
 	failoverMarkerNotifier    failover.MarkerNotifier
 	wfIDCache                 workflowcache.WFCache
+	completedActivityCache    cache.Cache
+	activityResultCache       cache.Cache
+	activityResultValidator   activityvalidator.Validator
+	activityPayloadEncryptor  activityencryption.Encryptor
+	activityTokenFailures     activityTokenValidationFailures
 
 	updateWithActionFn func(context.Context, execution.Cache, string, types.WorkflowExecution, bool, time.Time, func(wfContext execution.Context, mutableState execution.MutableState) error) error
 }
@@ -145,6 +154,8 @@ func NewEngineWithShardContext(
 	failoverCoordinator failover.Coordinator,
 	wfIDCache workflowcache.WFCache,
 	queueProcessorFactory queue.ProcessorFactory,
+	activityResultValidator activityvalidator.Validator,
+	activityPayloadEncryptor activityencryption.Encryptor,
 ) engine.Engine {
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 
@@ -228,8 +239,18 @@ func NewEngineWithShardContext(
 		replicationTaskStore: replicationTaskStore,
 		replicationMetricsEmitter: replication.NewMetricsEmitter(
 			shard.GetShardID(), shard, replicationReader, shard.GetMetricsClient()),
-		wfIDCache:          wfIDCache,
-		updateWithActionFn: workflow.UpdateWithAction,
+		wfIDCache: wfIDCache,
+		completedActivityCache: cache.New(&cache.Options{
+			InitialCapacity: 100,
+			MaxCount:        completedActivityCacheMaxCount,
+		}),
+		activityResultCache: cache.New(&cache.Options{
+			InitialCapacity: 100,
+			MaxCount:        activityResultCacheMaxCount,
+		}),
+		activityResultValidator:  activityResultValidator,
+		activityPayloadEncryptor: activityPayloadEncryptor,
+		updateWithActionFn:       workflow.UpdateWithAction,
 	}
 	historyEngImpl.decisionHandler = decision.NewHandler(
 		shard,
@@ -755,6 +755,98 @@ func (s *engine2Suite) TestRecordActivityTaskStartedSuccess() {
 	s.Nil(err)
 	s.NotNil(response)
 	s.Equal(scheduledEvent, response.ScheduledEvent)
+	s.False(response.WasCancelBeforeStart)
+}
+
+func (s *engine2Suite) TestRecordActivityTaskStartedCancelRequested() {
+	domainID := constants.TestDomainID
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, true)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, int64(2), int64(3), nil, identity)
+	scheduledEvent, ai := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, "activity1_id",
+		"activity_type1", tl, []byte("input1"), 100, 10, 1, 5)
+	// cancellation was requested while the task was still queued in matching
+	ai.CancelRequested = true
+
+	ms1 := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse1 := &p.GetWorkflowExecutionResponse{State: ms1}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse1, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+
+	s.mockEventsCache.EXPECT().GetEvent(
+		gomock.Any(), gomock.Any(), domainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID(),
+		decisionCompletedEvent.ID, scheduledEvent.ID, gomock.Any(),
+	).Return(scheduledEvent, nil)
+	response, err := s.historyEngine.RecordActivityTaskStarted(context.Background(), &types.RecordActivityTaskStartedRequest{
+		DomainUUID:        domainID,
+		WorkflowExecution: &workflowExecution,
+		ScheduleID:        scheduledEvent.ID,
+		TaskID:            100,
+		RequestID:         "reqId",
+		PollRequest: &types.PollForActivityTaskRequest{
+			TaskList: &types.TaskList{
+				Name: tl,
+			},
+			Identity: identity,
+		},
+	})
+	s.Nil(err)
+	s.NotNil(response)
+	s.True(response.WasCancelBeforeStart)
+}
+
+func (s *engine2Suite) TestRecordActivityTaskStartedCapabilityMismatch() {
+	domainID := constants.TestDomainID
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, true)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, int64(2), int64(3), nil, identity)
+	scheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, "activity1_id",
+		"activity_type1", tl, []byte("input1"), 100, 10, 1, 5)
+	scheduledEvent.ActivityTaskScheduledEventAttributes.RequiredCapabilities = []string{"gpu"}
+
+	ms1 := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse1 := &p.GetWorkflowExecutionResponse{State: ms1}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse1, nil).Once()
+	s.mockEventsCache.EXPECT().GetEvent(
+		gomock.Any(), gomock.Any(), domainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID(),
+		decisionCompletedEvent.ID, scheduledEvent.ID, gomock.Any(),
+	).Return(scheduledEvent, nil)
+	response, err := s.historyEngine.RecordActivityTaskStarted(context.Background(), &types.RecordActivityTaskStartedRequest{
+		DomainUUID:        domainID,
+		WorkflowExecution: &workflowExecution,
+		ScheduleID:        scheduledEvent.ID,
+		TaskID:            100,
+		RequestID:         "reqId",
+		PollRequest: &types.PollForActivityTaskRequest{
+			TaskList: &types.TaskList{
+				Name: tl,
+			},
+			Identity:     identity,
+			Capabilities: map[string]string{"memory": "64g"},
+		},
+	})
+	s.Nil(response)
+	s.Equal(workflow.ErrActivityCapabilityMismatch, err)
+	s.True(common.IsActivityCapabilityMismatchError(err))
 }
 
 func (s *engine2Suite) TestRecordActivityTaskStartedResurrected() {
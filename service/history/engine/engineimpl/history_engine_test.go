@@ -23,6 +23,7 @@ package engineimpl
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"sync"
@@ -42,6 +43,9 @@ import (
 	hclient "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activityencryption"
+	"github.com/uber/cadence/common/activityvalidator"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cache"
 	cc "github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
@@ -171,6 +175,14 @@ func (s *engineSuite) SetupTest() {
 		clientChecker:        cc.NewVersionChecker(),
 		eventsReapplier:      s.mockEventsReapplier,
 		workflowResetter:     s.mockWorkflowResetter,
+		completedActivityCache: cache.New(&cache.Options{
+			InitialCapacity: 100,
+			MaxCount:        completedActivityCacheMaxCount,
+		}),
+		activityResultCache: cache.New(&cache.Options{
+			InitialCapacity: 100,
+			MaxCount:        activityResultCacheMaxCount,
+		}),
 	}
 	s.mockShard.SetEngine(h)
 	h.decisionHandler = decision.NewHandler(s.mockShard, h.executionCache, h.tokenSerializer)
@@ -669,6 +681,57 @@ func (s *engineSuite) TestQueryWorkflow_FirstDecisionNotCompleted() {
 	s.Nil(resp)
 }
 
+func (s *engineSuite) TestQueryWorkflow_PendingActivities() {
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "TestQueryWorkflow_PendingActivities",
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		workflowExecution.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	completedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.ID, nil, identity)
+	activity1, _ := test.AddActivityTaskScheduledEvent(msBuilder, completedEvent.ID, "activity1", "activity_type1", tasklist, nil, 100, 10, 1, 0)
+	test.AddActivityTaskStartedEvent(msBuilder, activity1.ID, identity)
+	test.AddActivityTaskScheduledEvent(msBuilder, completedEvent.ID, "activity2", "activity_type2", tasklist, nil, 100, 10, 1, 0)
+
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gweResponse, nil).Once()
+
+	// answered by history, nothing is dispatched to matching
+	request := &types.HistoryQueryWorkflowRequest{
+		DomainUUID: constants.TestDomainID,
+		Request: &types.QueryWorkflowRequest{
+			Execution: &workflowExecution,
+			Query:     &types.WorkflowQuery{QueryType: common.PendingActivitiesQueryType},
+		},
+	}
+	resp, err := s.mockHistoryEngine.QueryWorkflow(context.Background(), request)
+	s.NoError(err)
+
+	var snapshots []*pendingActivitySnapshot
+	s.NoError(json.Unmarshal(resp.GetResponse().GetQueryResult(), &snapshots))
+	s.Len(snapshots, 2)
+	s.Equal("activity1", snapshots[0].ActivityID)
+	s.Equal("activity_type1", snapshots[0].ActivityType)
+	s.Equal(types.PendingActivityStateStarted.String(), snapshots[0].State)
+	s.Equal(identity, snapshots[0].StartedIdentity)
+	s.NotNil(snapshots[0].StartedTime)
+	s.Equal("activity2", snapshots[1].ActivityID)
+	s.Equal(types.PendingActivityStateScheduled.String(), snapshots[1].State)
+	s.Nil(snapshots[1].StartedTime)
+	s.Equal(int32(100), snapshots[1].ScheduleToCloseTimeoutSeconds)
+}
+
 func (s *engineSuite) TestQueryWorkflow_DirectlyThroughMatching() {
 	workflowExecution := types.WorkflowExecution{
 		WorkflowID: "TestQueryWorkflow_DirectlyThroughMatching",
@@ -2933,24 +2996,29 @@ func (s *engineSuite) TestRespondActivityTaskCompletedSuccess() {
 	s.Equal(common.EmptyEventID, di.StartedID)
 }
 
-func (s *engineSuite) TestRespondActivityTaskCompletedByIdSuccess() {
+func (s *engineSuite) TestRespondActivityTaskCompletedDuplicate() {
 
 	we := types.WorkflowExecution{
 		WorkflowID: "wId",
 		RunID:      constants.TestRunID,
 	}
 	tl := "testTaskList"
-
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+		ScheduleID: 5,
+	})
+	staleTaskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID:      we.WorkflowID,
+		RunID:           we.RunID,
+		ScheduleID:      5,
+		ScheduleAttempt: 1,
+	})
 	identity := "testIdentity"
 	activityID := "activity1_id"
 	activityType := "activity_type1"
 	activityInput := []byte("input1")
 	activityResult := []byte("activity result")
-	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: we.WorkflowID,
-		ScheduleID: common.EmptyEventID,
-		ActivityID: activityID,
-	})
 
 	msBuilder := execution.NewMutableStateBuilderWithEventV2(
 		s.mockHistoryEngine.shard,
@@ -2959,9 +3027,9 @@ func (s *engineSuite) TestRespondActivityTaskCompletedByIdSuccess() {
 		constants.TestLocalDomainEntry,
 	)
 	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
-	decisionScheduledEvent := test.AddDecisionTaskScheduledEvent(msBuilder)
-	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.ScheduleID, tl, identity)
-	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.ScheduleID,
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
 		decisionStartedEvent.ID, nil, identity)
 	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
 		activityType, tl, activityInput, 100, 10, 1, 5)
@@ -2969,197 +3037,137 @@ func (s *engineSuite) TestRespondActivityTaskCompletedByIdSuccess() {
 
 	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
-	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: we.RunID}
 
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), &types.HistoryRespondActivityTaskCompletedRequest{
-		DomainUUID: constants.TestDomainID,
-		CompleteRequest: &types.RespondActivityTaskCompletedRequest{
-			TaskToken: taskToken,
-			Result:    activityResult,
-			Identity:  identity,
-		},
-	})
-	s.Nil(err, s.printHistory(msBuilder))
-	executionBuilder := s.getBuilder(constants.TestDomainID, we)
-	s.Equal(int64(9), executionBuilder.GetExecutionInfo().NextEventID)
-	s.Equal(int64(3), executionBuilder.GetExecutionInfo().LastProcessedEvent)
-	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
-
-	s.True(executionBuilder.HasPendingDecision())
-	di, ok := executionBuilder.GetDecisionInfo(int64(8))
-	s.True(ok)
-	s.Equal(int32(100), di.DecisionTimeout)
-	s.Equal(int64(8), di.ScheduleID)
-	s.Equal(common.EmptyEventID, di.StartedID)
-}
+	completeRequest := func(token []byte) *types.HistoryRespondActivityTaskCompletedRequest {
+		return &types.HistoryRespondActivityTaskCompletedRequest{
+			DomainUUID: constants.TestDomainID,
+			CompleteRequest: &types.RespondActivityTaskCompletedRequest{
+				TaskToken: token,
+				Result:    activityResult,
+				Identity:  identity,
+			},
+		}
+	}
 
-func (s *engineSuite) TestRespondActivityTaskFailedInvalidToken() {
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), completeRequest(taskToken))
+	s.Nil(err, s.printHistory(msBuilder))
 
-	invalidToken, _ := json.Marshal("bad token")
-	identity := "testIdentity"
+	// retrying with the same token is acknowledged without writing to the workflow again
+	err = s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), completeRequest(taskToken))
+	s.Nil(err)
 
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
-		DomainUUID: constants.TestDomainID,
-		FailedRequest: &types.RespondActivityTaskFailedRequest{
-			TaskToken: invalidToken,
-			Identity:  identity,
-		},
-	})
+	// a token of another attempt is stale
+	err = s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), completeRequest(staleTaskToken))
+	s.Equal(workflow.ErrActivityTaskNotFound, err)
 
-	s.NotNil(err)
-	s.IsType(&types.BadRequestError{}, err)
+	executionBuilder := s.getBuilder(constants.TestDomainID, we)
+	s.Equal(int64(9), executionBuilder.GetExecutionInfo().NextEventID)
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedIfNoExecution() {
-
-	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: "wId",
-		RunID:      constants.TestRunID,
-		ScheduleID: 2,
-	})
-	identity := "testIdentity"
-
-	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(nil,
-		&types.EntityNotExistsError{}).Once()
-
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
-		DomainUUID: constants.TestDomainID,
-		FailedRequest: &types.RespondActivityTaskFailedRequest{
-			TaskToken: taskToken,
-			Identity:  identity,
-		},
-	})
-	s.NotNil(err)
-	s.IsType(&types.EntityNotExistsError{}, err)
+type rejectingActivityResultValidator struct {
+	requests []*activityvalidator.Request
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedIfNoRunID() {
-
-	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: "wId",
-		ScheduleID: 2,
-	})
-	identity := "testIdentity"
-
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(nil,
-		&types.EntityNotExistsError{}).Once()
-
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
-		DomainUUID: constants.TestDomainID,
-		FailedRequest: &types.RespondActivityTaskFailedRequest{
-			TaskToken: taskToken,
-			Identity:  identity,
-		},
-	})
-	s.NotNil(err)
-	s.IsType(&types.EntityNotExistsError{}, err)
+func (v *rejectingActivityResultValidator) Validate(ctx context.Context, request *activityvalidator.Request) error {
+	v.requests = append(v.requests, request)
+	return errors.New("malformed result")
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedIfGetExecutionFailed() {
+func (s *engineSuite) TestRespondActivityTaskCompletedResultRejected() {
 
-	taskToken, _ := json.Marshal(&common.TaskToken{
+	we := types.WorkflowExecution{
 		WorkflowID: "wId",
 		RunID:      constants.TestRunID,
-		ScheduleID: 2,
-	})
-	identity := "testIdentity"
-
-	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(nil,
-		errors.New("FAILED")).Once()
-
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
-		DomainUUID: constants.TestDomainID,
-		FailedRequest: &types.RespondActivityTaskFailedRequest{
-			TaskToken: taskToken,
-			Identity:  identity,
-		},
-	})
-	s.EqualError(err, "FAILED")
-}
-
-func (s *engineSuite) TestRespondActivityTaskFailededIfNoAIdProvided() {
-
+	}
+	tl := "testTaskList"
 	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: "wId",
-		ScheduleID: common.EmptyEventID,
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+		ScheduleID: 5,
 	})
-	workflowExecution := types.WorkflowExecution{
-		WorkflowID: "wId",
-		RunID:      constants.TestRunID,
-	}
-	tasklist := "testTaskList"
 	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+	activityResult := []byte("activity result")
+
+	validator := &rejectingActivityResultValidator{}
+	s.mockHistoryEngine.activityResultValidator = validator
+	s.mockHistoryEngine.config.ActivityResultValidation = dynamicconfig.GetMapPropertyFn(map[string]interface{}{activityType: true})
 
 	msBuilder := execution.NewMutableStateBuilderWithEventV2(
 		s.mockHistoryEngine.shard,
 		testlogger.New(s.Suite.T()),
-		constants.TestRunID,
+		we.GetRunID(),
 		constants.TestLocalDomainEntry,
 	)
-	test.AddWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", tasklist, []byte("input"), 100, 200, identity)
-	test.AddDecisionTaskScheduledEvent(msBuilder)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
+		activityType, tl, activityInput, 100, 10, 1, 5)
+	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
+
 	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
-	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: constants.TestRunID}
 
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	var appendedEvents []*types.HistoryEvent
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Run(func(arguments mock.Arguments) {
+		appendedEvents = arguments.Get(1).(*persistence.AppendHistoryNodesRequest).Events
+	}).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), &types.HistoryRespondActivityTaskCompletedRequest{
 		DomainUUID: constants.TestDomainID,
-		FailedRequest: &types.RespondActivityTaskFailedRequest{
+		CompleteRequest: &types.RespondActivityTaskCompletedRequest{
 			TaskToken: taskToken,
+			Result:    activityResult,
 			Identity:  identity,
 		},
 	})
-	s.EqualError(err, "Neither ActivityID nor ScheduleID is provided")
-}
+	s.IsType(&types.BadRequestError{}, err)
+	s.Len(validator.requests, 1)
+	s.Equal(activityType, validator.requests[0].ActivityType)
+	s.Equal(activityResult, validator.requests[0].Result)
 
-func (s *engineSuite) TestRespondActivityTaskFailededIfNotFound() {
+	executionBuilder := s.getBuilder(constants.TestDomainID, we)
+	s.Equal(int64(9), executionBuilder.GetExecutionInfo().NextEventID)
+	_, isRunning := executionBuilder.GetActivityInfo(activityScheduledEvent.ID)
+	s.False(isRunning)
+	s.True(executionBuilder.HasPendingDecision())
+	s.Len(appendedEvents, 2)
+	failedEvent := appendedEvents[0]
+	s.Equal(types.EventTypeActivityTaskFailed, failedEvent.GetEventType())
+	s.Equal(common.FailureReasonActivityResultRejected, *failedEvent.ActivityTaskFailedEventAttributes.Reason)
+	s.Equal([]byte("malformed result"), failedEvent.ActivityTaskFailedEventAttributes.Details)
+}
 
-	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: "wId",
-		ScheduleID: common.EmptyEventID,
-		ActivityID: "aid",
-	})
-	workflowExecution := types.WorkflowExecution{
-		WorkflowID: "wId",
-		RunID:      constants.TestRunID,
-	}
-	tasklist := "testTaskList"
-	identity := "testIdentity"
+type reversingActivityPayloadEncryptor struct{}
 
-	msBuilder := execution.NewMutableStateBuilderWithEventV2(
-		s.mockHistoryEngine.shard,
-		testlogger.New(s.Suite.T()),
-		constants.TestRunID,
-		constants.TestLocalDomainEntry,
-	)
-	test.AddWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", tasklist, []byte("input"), 100, 200, identity)
-	test.AddDecisionTaskScheduledEvent(msBuilder)
-	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
-	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
-	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: constants.TestRunID}
+func (e *reversingActivityPayloadEncryptor) Encrypt(ctx context.Context, request *activityencryption.Request) ([]byte, error) {
+	return reversePayload(request.KeyID, request.Payload), nil
+}
 
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
-	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+func (e *reversingActivityPayloadEncryptor) Decrypt(ctx context.Context, request *activityencryption.Request) ([]byte, error) {
+	return reversePayload(request.KeyID, request.Payload), nil
+}
 
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
-		DomainUUID: constants.TestDomainID,
-		FailedRequest: &types.RespondActivityTaskFailedRequest{
-			TaskToken: taskToken,
-			Identity:  identity,
-		},
-	})
-	s.Error(err)
+func reversePayload(keyID string, payload []byte) []byte {
+	reversed := make([]byte, 0, len(payload))
+	for i := len(payload) - 1; i >= 0; i-- {
+		reversed = append(reversed, payload[i]^keyID[0])
+	}
+	return reversed
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedUpdateExecutionFailed() {
+func (s *engineSuite) TestRespondActivityTaskCompletedResultEncrypted() {
 
 	we := types.WorkflowExecution{
 		WorkflowID: "wId",
@@ -3172,9 +3180,10 @@ func (s *engineSuite) TestRespondActivityTaskFailedUpdateExecutionFailed() {
 		ScheduleID: 5,
 	})
 	identity := "testIdentity"
-	activityID := "activity1_id"
-	activityType := "activity_type1"
-	activityInput := []byte("input1")
+	keyID := "tenant-key"
+	activityResult := []byte("activity result")
+
+	s.mockHistoryEngine.activityPayloadEncryptor = &reversingActivityPayloadEncryptor{}
 
 	msBuilder := execution.NewMutableStateBuilderWithEventV2(
 		s.mockHistoryEngine.shard,
@@ -3187,29 +3196,958 @@ func (s *engineSuite) TestRespondActivityTaskFailedUpdateExecutionFailed() {
 	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
 	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
 		decisionStartedEvent.ID, nil, identity)
-	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
-		activityType, tl, activityInput, 100, 10, 1, 5)
+	activityScheduledEvent, _, _, _, _, err := msBuilder.AddActivityTaskScheduledEvent(nil, decisionCompletedEvent.ID, &types.ScheduleActivityTaskDecisionAttributes{
+		ActivityID:                    "activity1_id",
+		ActivityType:                  &types.ActivityType{Name: "activity_type1"},
+		TaskList:                      &types.TaskList{Name: tl},
+		Input:                         []byte("input1"),
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(10),
+		StartToCloseTimeoutSeconds:    common.Int32Ptr(1),
+		HeartbeatTimeoutSeconds:       common.Int32Ptr(5),
+		EncryptionKeyID:               keyID,
+	}, false)
+	s.NoError(err)
 	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
 
 	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, errors.New("FAILED")).Once()
-	s.mockShardManager.On("UpdateShard", mock.Anything, mock.Anything).Return(nil).Once()
+	var appendedEvents []*types.HistoryEvent
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Run(func(arguments mock.Arguments) {
+		appendedEvents = arguments.Get(1).(*persistence.AppendHistoryNodesRequest).Events
+	}).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+	err = s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), &types.HistoryRespondActivityTaskCompletedRequest{
+		DomainUUID: constants.TestDomainID,
+		CompleteRequest: &types.RespondActivityTaskCompletedRequest{
+			TaskToken: taskToken,
+			Result:    activityResult,
+			Identity:  identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	s.Len(appendedEvents, 2)
+	completedEvent := appendedEvents[0]
+	s.Equal(types.EventTypeActivityTaskCompleted, completedEvent.GetEventType())
+	s.Equal(keyID, completedEvent.ActivityTaskCompletedEventAttributes.EncryptionKeyID)
+	s.Equal(reversePayload(keyID, activityResult), completedEvent.ActivityTaskCompletedEventAttributes.Result)
+}
+
+func (s *engineSuite) TestRespondActivityTaskCompletedResultDeduplicated() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityResult := []byte("activity result")
+
+	s.mockHistoryEngine.config.EnableActivityResultDedup = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	// the same result was recorded by the activity scheduled by event 3
+	s.mockHistoryEngine.activityResultCache.Put(activityResultKey{
+		domainID:   constants.TestDomainID,
+		workflowID: we.WorkflowID,
+		runID:      we.RunID,
+		resultHash: sha256.Sum256(append([]byte("\x00"), activityResult...)),
+	}, int64(3))
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, _, _, _, _, err := msBuilder.AddActivityTaskScheduledEvent(nil, decisionCompletedEvent.ID, &types.ScheduleActivityTaskDecisionAttributes{
+		ActivityID:                    "activity1_id",
+		ActivityType:                  &types.ActivityType{Name: "activity_type1"},
+		TaskList:                      &types.TaskList{Name: tl},
+		Input:                         []byte("input1"),
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(10),
+		StartToCloseTimeoutSeconds:    common.Int32Ptr(1),
+		HeartbeatTimeoutSeconds:       common.Int32Ptr(5),
+	}, false)
+	s.NoError(err)
+	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
+
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	var appendedEvents []*types.HistoryEvent
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Run(func(arguments mock.Arguments) {
+		appendedEvents = arguments.Get(1).(*persistence.AppendHistoryNodesRequest).Events
+	}).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err = s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), &types.HistoryRespondActivityTaskCompletedRequest{
+		DomainUUID: constants.TestDomainID,
+		CompleteRequest: &types.RespondActivityTaskCompletedRequest{
+			TaskToken: taskToken,
+			Result:    activityResult,
+			Identity:  identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	s.Len(appendedEvents, 2)
+	completedEvent := appendedEvents[0]
+	s.Equal(types.EventTypeActivityTaskCompleted, completedEvent.GetEventType())
+	s.Empty(completedEvent.ActivityTaskCompletedEventAttributes.Result)
+	s.Equal(int64(3), completedEvent.ActivityTaskCompletedEventAttributes.ResultReferenceScheduledEventID)
+}
+
+func (s *engineSuite) TestRespondActivityTaskCompletedResultArchived() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityResult := []byte("activity result")
+
+	s.mockHistoryEngine.config.ActivityResultArchivalThreshold = dynamicconfig.GetIntPropertyFilteredByDomain(len(activityResult) - 1)
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, "activity1_id",
+		"activity_type1", tl, []byte("input1"), 100, 10, 1, 5)
+	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
+
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	blobKey := "activity-results/" + constants.TestDomainID + "/" + we.WorkflowID + "/" + we.RunID + "/5"
+	s.mockShard.Resource.BlobstoreClient.On("Put", mock.Anything, &blobstore.PutRequest{
+		Key:  blobKey,
+		Blob: blobstore.Blob{Body: activityResult},
+	}).Return(&blobstore.PutResponse{}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	var appendedEvents []*types.HistoryEvent
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Run(func(arguments mock.Arguments) {
+		appendedEvents = arguments.Get(1).(*persistence.AppendHistoryNodesRequest).Events
+	}).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), &types.HistoryRespondActivityTaskCompletedRequest{
+		DomainUUID: constants.TestDomainID,
+		CompleteRequest: &types.RespondActivityTaskCompletedRequest{
+			TaskToken: taskToken,
+			Result:    activityResult,
+			Identity:  identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	s.Len(appendedEvents, 2)
+	completedEvent := appendedEvents[0]
+	s.Equal(types.EventTypeActivityTaskCompleted, completedEvent.GetEventType())
+	s.Empty(completedEvent.ActivityTaskCompletedEventAttributes.Result)
+	s.Equal(blobKey, completedEvent.ActivityTaskCompletedEventAttributes.ResultBlobKey)
+}
+
+func (s *engineSuite) TestRespondActivityTaskCompletedByIdSuccess() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+	activityResult := []byte("activity result")
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		ScheduleID: common.EmptyEventID,
+		ActivityID: activityID,
+	})
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	decisionScheduledEvent := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
+		activityType, tl, activityInput, 100, 10, 1, 5)
+	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
+
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: we.RunID}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), &types.HistoryRespondActivityTaskCompletedRequest{
+		DomainUUID: constants.TestDomainID,
+		CompleteRequest: &types.RespondActivityTaskCompletedRequest{
+			TaskToken: taskToken,
+			Result:    activityResult,
+			Identity:  identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(constants.TestDomainID, we)
+	s.Equal(int64(9), executionBuilder.GetExecutionInfo().NextEventID)
+	s.Equal(int64(3), executionBuilder.GetExecutionInfo().LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
+
+	s.True(executionBuilder.HasPendingDecision())
+	di, ok := executionBuilder.GetDecisionInfo(int64(8))
+	s.True(ok)
+	s.Equal(int32(100), di.DecisionTimeout)
+	s.Equal(int64(8), di.ScheduleID)
+	s.Equal(common.EmptyEventID, di.StartedID)
+}
+
+func (s *engineSuite) TestRespondActivityTaskCompletedByIdDuplicate() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+	activityResult := []byte("activity result")
+	byIDToken := func(activityID string) []byte {
+		taskToken, _ := json.Marshal(&common.TaskToken{
+			WorkflowID: we.WorkflowID,
+			ScheduleID: common.EmptyEventID,
+			ActivityID: activityID,
+		})
+		return taskToken
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	decisionScheduledEvent := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
+		activityType, tl, activityInput, 100, 10, 1, 5)
+	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
+
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: we.RunID}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Times(3)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	completeRequest := func(token []byte) *types.HistoryRespondActivityTaskCompletedRequest {
+		return &types.HistoryRespondActivityTaskCompletedRequest{
+			DomainUUID: constants.TestDomainID,
+			CompleteRequest: &types.RespondActivityTaskCompletedRequest{
+				TaskToken: token,
+				Result:    activityResult,
+				Identity:  identity,
+			},
+		}
+	}
+
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), completeRequest(byIDToken(activityID)))
+	s.Nil(err, s.printHistory(msBuilder))
+
+	// retrying the completion by ID is resolved to the completed activity of the run, and acknowledged
+	err = s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), completeRequest(byIDToken(activityID)))
+	s.Nil(err)
+
+	// a completion by ID of another activity is not mistaken for a duplicate
+	err = s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), completeRequest(byIDToken("activity2_id")))
+	s.IsType(&types.BadRequestError{}, err)
+
+	executionBuilder := s.getBuilder(constants.TestDomainID, we)
+	s.Equal(int64(9), executionBuilder.GetExecutionInfo().NextEventID)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedInvalidToken() {
+
+	invalidToken, _ := json.Marshal("bad token")
+	identity := "testIdentity"
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+		DomainUUID: constants.TestDomainID,
+		FailedRequest: &types.RespondActivityTaskFailedRequest{
+			TaskToken: invalidToken,
+			Identity:  identity,
+		},
+	})
+
+	s.NotNil(err)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedIfNoExecution() {
+
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(nil,
+		&types.EntityNotExistsError{}).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+		DomainUUID: constants.TestDomainID,
+		FailedRequest: &types.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Identity:  identity,
+		},
+	})
+	s.NotNil(err)
+	s.IsType(&types.EntityNotExistsError{}, err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedIfNoRunID() {
+
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(nil,
+		&types.EntityNotExistsError{}).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+		DomainUUID: constants.TestDomainID,
+		FailedRequest: &types.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Identity:  identity,
+		},
+	})
+	s.NotNil(err)
+	s.IsType(&types.EntityNotExistsError{}, err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedIfGetExecutionFailed() {
+
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(nil,
+		errors.New("FAILED")).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+		DomainUUID: constants.TestDomainID,
+		FailedRequest: &types.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Identity:  identity,
+		},
+	})
+	s.EqualError(err, "FAILED")
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailededIfNoAIdProvided() {
+
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		ScheduleID: common.EmptyEventID,
+	})
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		constants.TestRunID,
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: constants.TestRunID}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+		DomainUUID: constants.TestDomainID,
+		FailedRequest: &types.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Identity:  identity,
+		},
+	})
+	s.EqualError(err, "Neither ActivityID nor ScheduleID is provided")
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailededIfNotFound() {
+
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		ScheduleID: common.EmptyEventID,
+		ActivityID: "aid",
+	})
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		constants.TestRunID,
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: constants.TestRunID}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+		DomainUUID: constants.TestDomainID,
+		FailedRequest: &types.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Identity:  identity,
+		},
+	})
+	s.Error(err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedUpdateExecutionFailed() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
+		activityType, tl, activityInput, 100, 10, 1, 5)
+	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
+
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, errors.New("FAILED")).Once()
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+		DomainUUID: constants.TestDomainID,
+		FailedRequest: &types.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Identity:  identity,
+		},
+	})
+	s.EqualError(err, "FAILED")
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedIfTaskCompleted() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+	failReason := "fail reason"
+	details := []byte("fail details")
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
+		activityType, tl, activityInput, 100, 10, 1, 5)
+	activityStartedEvent := test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
+	test.AddActivityTaskFailedEvent(msBuilder, activityScheduledEvent.ID, activityStartedEvent.ID,
+		failReason, details, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+		DomainUUID: constants.TestDomainID,
+		FailedRequest: &types.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Reason:    &failReason,
+			Details:   details,
+			Identity:  identity,
+		},
+	})
+	s.NotNil(err)
+	s.IsType(&types.EntityNotExistsError{}, err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedIfTaskNotStarted() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
+		activityType, tl, activityInput, 100, 10, 1, 5)
+
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+		DomainUUID: constants.TestDomainID,
+		FailedRequest: &types.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Identity:  identity,
+		},
+	})
+	s.NotNil(err)
+	s.IsType(&types.EntityNotExistsError{}, err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedConflictOnUpdate() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activity1ID := "activity1"
+	activity1Type := "activity_type1"
+	activity1Input := []byte("input1")
+	failReason := "fail reason"
+	details := []byte("fail details.")
+	activity2ID := "activity2"
+	activity2Type := "activity_type2"
+	activity2Input := []byte("input2")
+	activity2Result := []byte("activity2_result")
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 25, 25, identity)
+	di1 := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent1 := test.AddDecisionTaskStartedEvent(msBuilder, di1.ScheduleID, tl, identity)
+	decisionCompletedEvent1 := test.AddDecisionTaskCompletedEvent(msBuilder, di1.ScheduleID,
+		decisionStartedEvent1.ID, nil, identity)
+	activity1ScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent1.ID, activity1ID,
+		activity1Type, tl, activity1Input, 100, 10, 1, 5)
+	activity2ScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent1.ID, activity2ID,
+		activity2Type, tl, activity2Input, 100, 10, 1, 5)
+	test.AddActivityTaskStartedEvent(msBuilder, activity1ScheduledEvent.ID, identity)
+	activity2StartedEvent := test.AddActivityTaskStartedEvent(msBuilder, activity2ScheduledEvent.ID, identity)
+
+	ms1 := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse1 := &persistence.GetWorkflowExecutionResponse{State: ms1}
+
+	test.AddActivityTaskCompletedEvent(msBuilder, activity2ScheduledEvent.ID,
+		activity2StartedEvent.ID, activity2Result, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+
+	ms2 := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse2 := &persistence.GetWorkflowExecutionResponse{State: ms2}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse1, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, &persistence.ConditionFailedError{}).Once()
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse2, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+		DomainUUID: constants.TestDomainID,
+		FailedRequest: &types.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Reason:    &failReason,
+			Details:   details,
+			Identity:  identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(constants.TestDomainID, we)
+	s.Equal(int64(12), executionBuilder.GetExecutionInfo().NextEventID)
+	s.Equal(int64(3), executionBuilder.GetExecutionInfo().LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
+
+	s.True(executionBuilder.HasPendingDecision())
+	di, ok := executionBuilder.GetDecisionInfo(int64(10))
+	s.True(ok)
+	s.Equal(int32(25), di.DecisionTimeout)
+	s.Equal(int64(10), di.ScheduleID)
+	s.Equal(common.EmptyEventID, di.StartedID)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedMaxAttemptsExceeded() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
+		activityType, tl, activityInput, 100, 10, 1, 5)
+	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
+
+	for i := 0; i < workflow.ConditionalRetryCount; i++ {
+		ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+		gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+		s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+		s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+		s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, &persistence.ConditionFailedError{}).Once()
+	}
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+		DomainUUID: constants.TestDomainID,
+		FailedRequest: &types.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Identity:  identity,
+		},
+	})
+	s.Equal(workflow.ErrMaxAttemptsExceeded, err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedSuccess() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+	failReason := "failed"
+	failDetails := []byte("fail details.")
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
+		activityType, tl, activityInput, 100, 10, 1, 5)
+	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
+
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
 		DomainUUID: constants.TestDomainID,
 		FailedRequest: &types.RespondActivityTaskFailedRequest{
 			TaskToken: taskToken,
+			Reason:    &failReason,
+			Details:   failDetails,
 			Identity:  identity,
 		},
 	})
-	s.EqualError(err, "FAILED")
+	s.Nil(err)
+	executionBuilder := s.getBuilder(constants.TestDomainID, we)
+	s.Equal(int64(9), executionBuilder.GetExecutionInfo().NextEventID)
+	s.Equal(int64(3), executionBuilder.GetExecutionInfo().LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
+
+	s.True(executionBuilder.HasPendingDecision())
+	di, ok := executionBuilder.GetDecisionInfo(int64(8))
+	s.True(ok)
+	s.Equal(int32(100), di.DecisionTimeout)
+	s.Equal(int64(8), di.ScheduleID)
+	s.Equal(common.EmptyEventID, di.StartedID)
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedIfTaskCompleted() {
+func (s *engineSuite) TestRespondActivityTaskFailedByIDSuccess() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+	failReason := "failed"
+	failDetails := []byte("fail details.")
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		ScheduleID: common.EmptyEventID,
+		ActivityID: activityID,
+	})
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	decisionScheduledEvent := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
+		activityType, tl, activityInput, 100, 10, 1, 5)
+	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
+
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: we.RunID}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+		DomainUUID: constants.TestDomainID,
+		FailedRequest: &types.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Reason:    &failReason,
+			Details:   failDetails,
+			Identity:  identity,
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(constants.TestDomainID, we)
+	s.Equal(int64(9), executionBuilder.GetExecutionInfo().NextEventID)
+	s.Equal(int64(3), executionBuilder.GetExecutionInfo().LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
+
+	s.True(executionBuilder.HasPendingDecision())
+	di, ok := executionBuilder.GetDecisionInfo(int64(8))
+	s.True(ok)
+	s.Equal(int32(100), di.DecisionTimeout)
+	s.Equal(int64(8), di.ScheduleID)
+	s.Equal(common.EmptyEventID, di.StartedID)
+}
+
+func (s *engineSuite) TestRespondActivityTaskNackInvalidToken() {
+
+	invalidToken, _ := json.Marshal("bad token")
+
+	err := s.mockHistoryEngine.RespondActivityTaskNack(context.Background(), &types.HistoryRespondActivityTaskNackRequest{
+		DomainUUID: constants.TestDomainID,
+		NackRequest: &types.RespondActivityTaskNackRequest{
+			TaskToken: invalidToken,
+			Reason:    "overloaded",
+		},
+	})
+
+	s.NotNil(err)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskNackWithoutRetryPolicy() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, "activity1_id",
+		"activity_type1", tl, []byte("input1"), 100, 10, 1, 5)
+	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
+
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskNack(context.Background(), &types.HistoryRespondActivityTaskNackRequest{
+		DomainUUID: constants.TestDomainID,
+		NackRequest: &types.RespondActivityTaskNackRequest{
+			TaskToken: taskToken,
+			Reason:    "overloaded",
+			Identity:  identity,
+		},
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_NoTimer() {
 
 	we := types.WorkflowExecution{
 		WorkflowID: "wId",
@@ -3225,8 +4163,6 @@ func (s *engineSuite) TestRespondActivityTaskFailedIfTaskCompleted() {
 	activityID := "activity1_id"
 	activityType := "activity_type1"
 	activityInput := []byte("input1")
-	failReason := "fail reason"
-	details := []byte("fail details")
 
 	msBuilder := execution.NewMutableStateBuilderWithEventV2(
 		s.mockHistoryEngine.shard,
@@ -3240,31 +4176,29 @@ func (s *engineSuite) TestRespondActivityTaskFailedIfTaskCompleted() {
 	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
 		decisionStartedEvent.ID, nil, identity)
 	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
-		activityType, tl, activityInput, 100, 10, 1, 5)
-	activityStartedEvent := test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
-	test.AddActivityTaskFailedEvent(msBuilder, activityScheduledEvent.ID, activityStartedEvent.ID,
-		failReason, details, identity)
-	test.AddDecisionTaskScheduledEvent(msBuilder)
+		activityType, tl, activityInput, 100, 10, 1, 0)
+	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
 
+	// No HeartBeat timer running.
 	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
-
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+	detais := []byte("details")
+
+	_, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), &types.HistoryRecordActivityTaskHeartbeatRequest{
 		DomainUUID: constants.TestDomainID,
-		FailedRequest: &types.RespondActivityTaskFailedRequest{
+		HeartbeatRequest: &types.RecordActivityTaskHeartbeatRequest{
 			TaskToken: taskToken,
-			Reason:    &failReason,
-			Details:   details,
 			Identity:  identity,
+			Details:   detais,
 		},
 	})
-	s.NotNil(err)
-	s.IsType(&types.EntityNotExistsError{}, err)
+	s.Nil(err)
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedIfTaskNotStarted() {
+func (s *engineSuite) TestRecordActivityTaskHeartBeat_WorkflowClosed() {
 
 	we := types.WorkflowExecution{
 		WorkflowID: "wId",
@@ -3292,26 +4226,31 @@ func (s *engineSuite) TestRespondActivityTaskFailedIfTaskNotStarted() {
 	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
 	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
 		decisionStartedEvent.ID, nil, identity)
-	test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
-		activityType, tl, activityInput, 100, 10, 1, 5)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
+		activityType, tl, activityInput, 100, 10, 1, 0)
+	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
 
 	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	ms.ExecutionInfo.CloseStatus = persistence.WorkflowCloseStatusTerminated
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
-
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryEngine.config.EnableActivityHeartbeatWorkflowCloseStatus = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
 
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+	response, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), &types.HistoryRecordActivityTaskHeartbeatRequest{
 		DomainUUID: constants.TestDomainID,
-		FailedRequest: &types.RespondActivityTaskFailedRequest{
+		HeartbeatRequest: &types.RecordActivityTaskHeartbeatRequest{
 			TaskToken: taskToken,
 			Identity:  identity,
+			Details:   []byte("details"),
 		},
 	})
-	s.NotNil(err)
-	s.IsType(&types.EntityNotExistsError{}, err)
+	s.Nil(err)
+	s.True(response.CancelRequested)
+	s.Equal(types.WorkflowExecutionCloseStatusTerminated.Ptr(), response.WorkflowCloseStatus)
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedConflictOnUpdate() {
+func (s *engineSuite) TestRecordActivityTaskHeartBeat_WorkflowClosed_Disabled() {
 
 	we := types.WorkflowExecution{
 		WorkflowID: "wId",
@@ -3324,15 +4263,9 @@ func (s *engineSuite) TestRespondActivityTaskFailedConflictOnUpdate() {
 		ScheduleID: 5,
 	})
 	identity := "testIdentity"
-	activity1ID := "activity1"
-	activity1Type := "activity_type1"
-	activity1Input := []byte("input1")
-	failReason := "fail reason"
-	details := []byte("fail details.")
-	activity2ID := "activity2"
-	activity2Type := "activity_type2"
-	activity2Input := []byte("input2")
-	activity2Result := []byte("activity2_result")
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
 
 	msBuilder := execution.NewMutableStateBuilderWithEventV2(
 		s.mockHistoryEngine.shard,
@@ -3340,75 +4273,109 @@ func (s *engineSuite) TestRespondActivityTaskFailedConflictOnUpdate() {
 		we.GetRunID(),
 		constants.TestLocalDomainEntry,
 	)
-	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 25, 25, identity)
-	di1 := test.AddDecisionTaskScheduledEvent(msBuilder)
-	decisionStartedEvent1 := test.AddDecisionTaskStartedEvent(msBuilder, di1.ScheduleID, tl, identity)
-	decisionCompletedEvent1 := test.AddDecisionTaskCompletedEvent(msBuilder, di1.ScheduleID,
-		decisionStartedEvent1.ID, nil, identity)
-	activity1ScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent1.ID, activity1ID,
-		activity1Type, tl, activity1Input, 100, 10, 1, 5)
-	activity2ScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent1.ID, activity2ID,
-		activity2Type, tl, activity2Input, 100, 10, 1, 5)
-	test.AddActivityTaskStartedEvent(msBuilder, activity1ScheduledEvent.ID, identity)
-	activity2StartedEvent := test.AddActivityTaskStartedEvent(msBuilder, activity2ScheduledEvent.ID, identity)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
+		activityType, tl, activityInput, 100, 10, 1, 0)
+	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
 
-	ms1 := execution.CreatePersistenceMutableState(s.T(), msBuilder)
-	gwmsResponse1 := &persistence.GetWorkflowExecutionResponse{State: ms1}
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	ms.ExecutionInfo.CloseStatus = persistence.WorkflowCloseStatusTerminated
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
 
-	test.AddActivityTaskCompletedEvent(msBuilder, activity2ScheduledEvent.ID,
-		activity2StartedEvent.ID, activity2Result, identity)
-	test.AddDecisionTaskScheduledEvent(msBuilder)
+	_, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), &types.HistoryRecordActivityTaskHeartbeatRequest{
+		DomainUUID: constants.TestDomainID,
+		HeartbeatRequest: &types.RecordActivityTaskHeartbeatRequest{
+			TaskToken: taskToken,
+			Identity:  identity,
+			Details:   []byte("details"),
+		},
+	})
+	s.IsType(&types.WorkflowExecutionAlreadyCompletedError{}, err)
+	failures := s.mockHistoryEngine.activityTokenFailures.failures
+	s.Require().NotEmpty(failures)
+	s.Equal(types.ActivityTokenValidationFailureCauseAlreadyCompleted, failures[len(failures)-1].failure.GetCause())
+}
 
-	ms2 := execution.CreatePersistenceMutableState(s.T(), msBuilder)
-	gwmsResponse2 := &persistence.GetWorkflowExecutionResponse{State: ms2}
+func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_TimerRunning() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
+		activityType, tl, activityInput, 100, 10, 1, 1)
+	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse1, nil).Once()
-	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, &persistence.ConditionFailedError{}).Once()
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse2, nil).Once()
-	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	// HeartBeat timer running.
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+	detais := []byte("details")
+
+	_, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), &types.HistoryRecordActivityTaskHeartbeatRequest{
 		DomainUUID: constants.TestDomainID,
-		FailedRequest: &types.RespondActivityTaskFailedRequest{
+		HeartbeatRequest: &types.RecordActivityTaskHeartbeatRequest{
 			TaskToken: taskToken,
-			Reason:    &failReason,
-			Details:   details,
 			Identity:  identity,
+			Details:   detais,
 		},
 	})
-	s.Nil(err, s.printHistory(msBuilder))
+	s.Nil(err)
 	executionBuilder := s.getBuilder(constants.TestDomainID, we)
-	s.Equal(int64(12), executionBuilder.GetExecutionInfo().NextEventID)
+	s.Equal(int64(7), executionBuilder.GetExecutionInfo().NextEventID)
 	s.Equal(int64(3), executionBuilder.GetExecutionInfo().LastProcessedEvent)
 	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
-
-	s.True(executionBuilder.HasPendingDecision())
-	di, ok := executionBuilder.GetDecisionInfo(int64(10))
-	s.True(ok)
-	s.Equal(int32(25), di.DecisionTimeout)
-	s.Equal(int64(10), di.ScheduleID)
-	s.Equal(common.EmptyEventID, di.StartedID)
+	s.False(executionBuilder.HasPendingDecision())
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedMaxAttemptsExceeded() {
+func (s *engineSuite) TestRecordActivityTaskHeartBeatByIDSuccess() {
 
 	we := types.WorkflowExecution{
 		WorkflowID: "wId",
 		RunID:      constants.TestRunID,
 	}
 	tl := "testTaskList"
-	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: we.WorkflowID,
-		RunID:      we.RunID,
-		ScheduleID: 5,
-	})
 	identity := "testIdentity"
 	activityID := "activity1_id"
 	activityType := "activity_type1"
 	activityInput := []byte("input1")
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+		ScheduleID: common.EmptyEventID,
+		ActivityID: activityID,
+	})
 
 	msBuilder := execution.NewMutableStateBuilderWithEventV2(
 		s.mockHistoryEngine.shard,
@@ -3422,46 +4389,44 @@ func (s *engineSuite) TestRespondActivityTaskFailedMaxAttemptsExceeded() {
 	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
 		decisionStartedEvent.ID, nil, identity)
 	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
-		activityType, tl, activityInput, 100, 10, 1, 5)
+		activityType, tl, activityInput, 100, 10, 1, 0)
 	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
 
-	for i := 0; i < workflow.ConditionalRetryCount; i++ {
-		ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
-		gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	// No HeartBeat timer running.
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
 
-		s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
-		s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
-		s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, &persistence.ConditionFailedError{}).Once()
-	}
+	detais := []byte("details")
 
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+	_, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), &types.HistoryRecordActivityTaskHeartbeatRequest{
 		DomainUUID: constants.TestDomainID,
-		FailedRequest: &types.RespondActivityTaskFailedRequest{
+		HeartbeatRequest: &types.RecordActivityTaskHeartbeatRequest{
 			TaskToken: taskToken,
 			Identity:  identity,
+			Details:   detais,
 		},
 	})
-	s.Equal(workflow.ErrMaxAttemptsExceeded, err)
+	s.Nil(err)
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedSuccess() {
+func (s *engineSuite) TestRecordActivityTaskHeartBeat_ExtendStartToCloseTimeout() {
 
 	we := types.WorkflowExecution{
 		WorkflowID: "wId",
 		RunID:      constants.TestRunID,
 	}
 	tl := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
 	taskToken, _ := json.Marshal(&common.TaskToken{
 		WorkflowID: we.WorkflowID,
 		RunID:      we.RunID,
 		ScheduleID: 5,
 	})
-	identity := "testIdentity"
-	activityID := "activity1_id"
-	activityType := "activity_type1"
-	activityInput := []byte("input1")
-	failReason := "failed"
-	failDetails := []byte("fail details.")
 
 	msBuilder := execution.NewMutableStateBuilderWithEventV2(
 		s.mockHistoryEngine.shard,
@@ -3475,57 +4440,56 @@ func (s *engineSuite) TestRespondActivityTaskFailedSuccess() {
 	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
 		decisionStartedEvent.ID, nil, identity)
 	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
-		activityType, tl, activityInput, 100, 10, 1, 5)
+		activityType, tl, activityInput, 100, 10, 1, 0)
 	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
 
 	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
-
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockHistoryEngine.config.ActivityMaxStartToCloseTimeout = dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Minute)
 
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+	_, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), &types.HistoryRecordActivityTaskHeartbeatRequest{
 		DomainUUID: constants.TestDomainID,
-		FailedRequest: &types.RespondActivityTaskFailedRequest{
-			TaskToken: taskToken,
-			Reason:    &failReason,
-			Details:   failDetails,
-			Identity:  identity,
+		HeartbeatRequest: &types.RecordActivityTaskHeartbeatRequest{
+			TaskToken:                           taskToken,
+			Identity:                            identity,
+			RequestedStartToCloseTimeoutSeconds: common.Int32Ptr(120),
+		},
+	})
+	s.Equal(workflow.ErrActivityStartToCloseLimitExceeded, err)
+
+	response, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), &types.HistoryRecordActivityTaskHeartbeatRequest{
+		DomainUUID: constants.TestDomainID,
+		HeartbeatRequest: &types.RecordActivityTaskHeartbeatRequest{
+			TaskToken:                           taskToken,
+			Identity:                            identity,
+			RequestedStartToCloseTimeoutSeconds: common.Int32Ptr(50),
 		},
 	})
 	s.Nil(err)
-	executionBuilder := s.getBuilder(constants.TestDomainID, we)
-	s.Equal(int64(9), executionBuilder.GetExecutionInfo().NextEventID)
-	s.Equal(int64(3), executionBuilder.GetExecutionInfo().LastProcessedEvent)
-	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
 
-	s.True(executionBuilder.HasPendingDecision())
-	di, ok := executionBuilder.GetDecisionInfo(int64(8))
+	ai, ok := s.getBuilder(constants.TestDomainID, we).GetActivityInfo(activityScheduledEvent.ID)
 	s.True(ok)
-	s.Equal(int32(100), di.DecisionTimeout)
-	s.Equal(int64(8), di.ScheduleID)
-	s.Equal(common.EmptyEventID, di.StartedID)
+	s.Equal(int32(50), ai.GrantedStartToCloseTimeout)
+	s.Equal(ai.StartedTime.Add(50*time.Second).UnixNano(), response.GetStartToCloseDeadline())
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedByIDSuccess() {
+func (s *engineSuite) TestRecordActivityTaskHeartBeat_PersistedSequence() {
 
 	we := types.WorkflowExecution{
 		WorkflowID: "wId",
 		RunID:      constants.TestRunID,
 	}
 	tl := "testTaskList"
-
 	identity := "testIdentity"
 	activityID := "activity1_id"
 	activityType := "activity_type1"
 	activityInput := []byte("input1")
-	failReason := "failed"
-	failDetails := []byte("fail details.")
 	taskToken, _ := json.Marshal(&common.TaskToken{
 		WorkflowID: we.WorkflowID,
-		ScheduleID: common.EmptyEventID,
-		ActivityID: activityID,
+		RunID:      we.RunID,
+		ScheduleID: 5,
 	})
 
 	msBuilder := execution.NewMutableStateBuilderWithEventV2(
@@ -3535,62 +4499,65 @@ func (s *engineSuite) TestRespondActivityTaskFailedByIDSuccess() {
 		constants.TestLocalDomainEntry,
 	)
 	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
-	decisionScheduledEvent := test.AddDecisionTaskScheduledEvent(msBuilder)
-	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.ScheduleID, tl, identity)
-	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.ScheduleID,
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
 		decisionStartedEvent.ID, nil, identity)
 	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
-		activityType, tl, activityInput, 100, 10, 1, 5)
+		activityType, tl, activityInput, 100, 10, 1, 0)
 	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
 
 	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
-	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: we.RunID}
-
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Times(2)
 
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+	response, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), &types.HistoryRecordActivityTaskHeartbeatRequest{
 		DomainUUID: constants.TestDomainID,
-		FailedRequest: &types.RespondActivityTaskFailedRequest{
-			TaskToken: taskToken,
-			Reason:    &failReason,
-			Details:   failDetails,
-			Identity:  identity,
+		HeartbeatRequest: &types.RecordActivityTaskHeartbeatRequest{
+			TaskToken:         taskToken,
+			Details:           []byte("details2"),
+			Identity:          identity,
+			HeartbeatSequence: common.Int64Ptr(2),
 		},
 	})
 	s.Nil(err)
-	executionBuilder := s.getBuilder(constants.TestDomainID, we)
-	s.Equal(int64(9), executionBuilder.GetExecutionInfo().NextEventID)
-	s.Equal(int64(3), executionBuilder.GetExecutionInfo().LastProcessedEvent)
-	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
+	s.Equal(int64(2), response.GetPersistedSequence())
 
-	s.True(executionBuilder.HasPendingDecision())
-	di, ok := executionBuilder.GetDecisionInfo(int64(8))
+	// an out of order heartbeat does not overwrite the newer details
+	response, err = s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), &types.HistoryRecordActivityTaskHeartbeatRequest{
+		DomainUUID: constants.TestDomainID,
+		HeartbeatRequest: &types.RecordActivityTaskHeartbeatRequest{
+			TaskToken:         taskToken,
+			Details:           []byte("details1"),
+			Identity:          identity,
+			HeartbeatSequence: common.Int64Ptr(1),
+		},
+	})
+	s.Nil(err)
+	s.Equal(int64(2), response.GetPersistedSequence())
+
+	ai, ok := s.getBuilder(constants.TestDomainID, we).GetActivityInfo(activityScheduledEvent.ID)
 	s.True(ok)
-	s.Equal(int32(100), di.DecisionTimeout)
-	s.Equal(int64(8), di.ScheduleID)
-	s.Equal(common.EmptyEventID, di.StartedID)
+	s.Equal([]byte("details2"), ai.Details)
 }
 
-func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_NoTimer() {
+func (s *engineSuite) TestRecordActivityTaskHeartBeat_RenewLeaseOnly() {
 
 	we := types.WorkflowExecution{
 		WorkflowID: "wId",
 		RunID:      constants.TestRunID,
 	}
 	tl := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
 	taskToken, _ := json.Marshal(&common.TaskToken{
 		WorkflowID: we.WorkflowID,
 		RunID:      we.RunID,
 		ScheduleID: 5,
 	})
-	identity := "testIdentity"
-	activityID := "activity1_id"
-	activityType := "activity_type1"
-	activityInput := []byte("input1")
 
 	msBuilder := execution.NewMutableStateBuilderWithEventV2(
 		s.mockHistoryEngine.shard,
@@ -3607,41 +4574,121 @@ func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_NoTimer() {
 		activityType, tl, activityInput, 100, 10, 1, 0)
 	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
 
-	// No HeartBeat timer running.
 	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
-
-	detais := []byte("details")
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Times(2)
 
 	_, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), &types.HistoryRecordActivityTaskHeartbeatRequest{
+		DomainUUID: constants.TestDomainID,
+		HeartbeatRequest: &types.RecordActivityTaskHeartbeatRequest{
+			TaskToken: taskToken,
+			Details:   []byte("details"),
+			Identity:  identity,
+		},
+	})
+	s.Nil(err)
+	ai, ok := s.getBuilder(constants.TestDomainID, we).GetActivityInfo(activityScheduledEvent.ID)
+	s.True(ok)
+	lastHeartbeatTime := ai.LastHeartBeatUpdatedTime.Add(-time.Minute)
+	ai.LastHeartBeatUpdatedTime = lastHeartbeatTime
+
+	// a lease renewal resets the heartbeat timer but keeps the stored details
+	_, err = s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), &types.HistoryRecordActivityTaskHeartbeatRequest{
 		DomainUUID: constants.TestDomainID,
 		HeartbeatRequest: &types.RecordActivityTaskHeartbeatRequest{
 			TaskToken: taskToken,
 			Identity:  identity,
-			Details:   detais,
 		},
+		RenewLeaseOnly: true,
 	})
 	s.Nil(err)
+
+	ai, ok = s.getBuilder(constants.TestDomainID, we).GetActivityInfo(activityScheduledEvent.ID)
+	s.True(ok)
+	s.Equal([]byte("details"), ai.Details)
+	s.True(ai.LastHeartBeatUpdatedTime.After(lastHeartbeatTime))
 }
 
-func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_TimerRunning() {
+func (s *engineSuite) TestRecordActivityTaskHeartBeat_YieldRequested() {
 
 	we := types.WorkflowExecution{
 		WorkflowID: "wId",
 		RunID:      constants.TestRunID,
 	}
 	tl := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
 	taskToken, _ := json.Marshal(&common.TaskToken{
 		WorkflowID: we.WorkflowID,
 		RunID:      we.RunID,
 		ScheduleID: 5,
 	})
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
+		activityType, tl, activityInput, 100, 10, 1, 0)
+	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
+
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Times(2)
+
+	heartbeatRequest := &types.HistoryRecordActivityTaskHeartbeatRequest{
+		DomainUUID: constants.TestDomainID,
+		HeartbeatRequest: &types.RecordActivityTaskHeartbeatRequest{
+			TaskToken:    taskToken,
+			Identity:     identity,
+			WorkerHealth: types.WorkerHealthDegraded.Ptr(),
+		},
+	}
+
+	// yielding an activity without retry policy would fail it for good
+	response, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), heartbeatRequest)
+	s.Nil(err)
+	s.False(response.GetYieldRequested())
+
+	ai, ok := s.getBuilder(constants.TestDomainID, we).GetActivityInfo(activityScheduledEvent.ID)
+	s.True(ok)
+	ai.HasRetryPolicy = true
+	ai.InitialInterval = 1
+	ai.BackoffCoefficient = 1
+	ai.MaximumAttempts = 3
+
+	response, err = s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), heartbeatRequest)
+	s.Nil(err)
+	s.True(response.GetYieldRequested())
+}
+
+func (s *engineSuite) TestRecordActivityTaskHeartBeat_CancelDeadline() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
 	identity := "testIdentity"
 	activityID := "activity1_id"
 	activityType := "activity_type1"
 	activityInput := []byte("input1")
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+		ScheduleID: 5,
+	})
 
 	msBuilder := execution.NewMutableStateBuilderWithEventV2(
 		s.mockHistoryEngine.shard,
@@ -3655,35 +4702,47 @@ func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_TimerRunning() {
 	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
 		decisionStartedEvent.ID, nil, identity)
 	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
-		activityType, tl, activityInput, 100, 10, 1, 1)
+		activityType, tl, activityInput, 100, 10, 1, 0)
 	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
 
 	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
-
-	// HeartBeat timer running.
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Times(3)
+	s.mockHistoryEngine.config.ActivityCancellationGracePeriod = dynamicconfig.GetDurationPropertyFnFilteredByDomain(30 * time.Second)
 
-	detais := []byte("details")
-
-	_, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), &types.HistoryRecordActivityTaskHeartbeatRequest{
+	heartbeatRequest := &types.HistoryRecordActivityTaskHeartbeatRequest{
 		DomainUUID: constants.TestDomainID,
 		HeartbeatRequest: &types.RecordActivityTaskHeartbeatRequest{
 			TaskToken: taskToken,
 			Identity:  identity,
-			Details:   detais,
 		},
-	})
+	}
+
+	response, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), heartbeatRequest)
 	s.Nil(err)
-	executionBuilder := s.getBuilder(constants.TestDomainID, we)
-	s.Equal(int64(7), executionBuilder.GetExecutionInfo().NextEventID)
-	s.Equal(int64(3), executionBuilder.GetExecutionInfo().LastProcessedEvent)
-	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
-	s.False(executionBuilder.HasPendingDecision())
+	s.False(response.CancelRequested)
+	s.Nil(response.CancelDeadline)
+
+	requestedTime := time.Unix(0, 0).Add(time.Hour)
+	ai, ok := s.getBuilder(constants.TestDomainID, we).GetActivityInfo(activityScheduledEvent.ID)
+	s.True(ok)
+	ai.CancelRequested = true
+	ai.CancelRequestedTime = requestedTime
+
+	response, err = s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), heartbeatRequest)
+	s.Nil(err)
+	s.True(response.CancelRequested)
+	s.Equal(requestedTime.Add(30*time.Second).UnixNano(), response.GetCancelDeadline())
+
+	// a forced cancellation ahead of the grace period caps the deadline
+	ai.CancelForceTimeout = 10
+	response, err = s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), heartbeatRequest)
+	s.Nil(err)
+	s.Equal(requestedTime.Add(10*time.Second).UnixNano(), response.GetCancelDeadline())
 }
 
-func (s *engineSuite) TestRecordActivityTaskHeartBeatByIDSuccess() {
+func (s *engineSuite) TestRecordActivityTaskHeartBeat_AcknowledgePrefetchedActivity() {
 
 	we := types.WorkflowExecution{
 		WorkflowID: "wId",
@@ -3697,8 +4756,7 @@ func (s *engineSuite) TestRecordActivityTaskHeartBeatByIDSuccess() {
 	taskToken, _ := json.Marshal(&common.TaskToken{
 		WorkflowID: we.WorkflowID,
 		RunID:      we.RunID,
-		ScheduleID: common.EmptyEventID,
-		ActivityID: activityID,
+		ScheduleID: 5,
 	})
 
 	msBuilder := execution.NewMutableStateBuilderWithEventV2(
@@ -3713,26 +4771,32 @@ func (s *engineSuite) TestRecordActivityTaskHeartBeatByIDSuccess() {
 	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
 		decisionStartedEvent.ID, nil, identity)
 	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
-		activityType, tl, activityInput, 100, 10, 1, 0)
+		activityType, tl, activityInput, 100, 10, 10, 1)
 	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
 
-	// No HeartBeat timer running.
 	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
 
-	detais := []byte("details")
+	leasedActivity := ms.ActivityInfos[activityScheduledEvent.ID]
+	leasedActivity.PrefetchLeased = true
+	leasedTime := leasedActivity.StartedTime
 
 	_, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), &types.HistoryRecordActivityTaskHeartbeatRequest{
 		DomainUUID: constants.TestDomainID,
 		HeartbeatRequest: &types.RecordActivityTaskHeartbeatRequest{
 			TaskToken: taskToken,
 			Identity:  identity,
-			Details:   detais,
 		},
 	})
 	s.Nil(err)
+
+	ai, ok := s.getBuilder(constants.TestDomainID, we).GetActivityInfo(activityScheduledEvent.ID)
+	s.True(ok)
+	s.False(ai.PrefetchLeased)
+	s.False(ai.StartedTime.Before(leasedTime))
+	s.NotZero(ai.TimerTaskStatus & execution.TimerTaskStatusCreatedHeartbeat)
 }
 
 func (s *engineSuite) TestRespondActivityTaskCanceled_Scheduled() {
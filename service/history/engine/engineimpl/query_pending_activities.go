@@ -0,0 +1,162 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+)
+
+type (
+	// pendingActivitySnapshot is the JSON representation of a pending activity in the result of the
+	// common.PendingActivitiesQueryType query
+	pendingActivitySnapshot struct {
+		ActivityID                    string             `json:"activityID"`
+		ActivityType                  string             `json:"activityType"`
+		TaskList                      string             `json:"taskList"`
+		State                         string             `json:"state"`
+		ScheduleID                    int64              `json:"scheduleID"`
+		StartedID                     int64              `json:"startedID"`
+		Attempt                       int32              `json:"attempt"`
+		ScheduledTime                 time.Time          `json:"scheduledTime"`
+		StartedTime                   *time.Time         `json:"startedTime,omitempty"`
+		StartedIdentity               string             `json:"startedIdentity,omitempty"`
+		ScheduleToStartTimeoutSeconds int32              `json:"scheduleToStartTimeoutSeconds"`
+		ScheduleToCloseTimeoutSeconds int32              `json:"scheduleToCloseTimeoutSeconds"`
+		StartToCloseTimeoutSeconds    int32              `json:"startToCloseTimeoutSeconds"`
+		HeartbeatTimeoutSeconds       int32              `json:"heartbeatTimeoutSeconds"`
+		RetryPolicy                   *types.RetryPolicy `json:"retryPolicy,omitempty"`
+		ExpirationTime                *time.Time         `json:"expirationTime,omitempty"`
+		CancelRequested               bool               `json:"cancelRequested"`
+		LastHeartbeatTime             *time.Time         `json:"lastHeartbeatTime,omitempty"`
+		HeartbeatDetails              []byte             `json:"heartbeatDetails,omitempty"`
+		ProgressPercent               *int32             `json:"progressPercent,omitempty"`
+		LastFailureReason             string             `json:"lastFailureReason,omitempty"`
+		LastFailureDetails            []byte             `json:"lastFailureDetails,omitempty"`
+		LastWorkerIdentity            string             `json:"lastWorkerIdentity,omitempty"`
+	}
+)
+
+// queryPendingActivities answers the common.PendingActivitiesQueryType query from mutable state, without
+// involving the decider, with a JSON array of the pending activities ordered by schedule ID
+func (e *historyEngineImpl) queryPendingActivities(
+	ctx context.Context,
+	domainID string,
+	workflowExecution types.WorkflowExecution,
+) (retResp *types.HistoryQueryWorkflowResponse, retErr error) {
+
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, domainID, workflowExecution)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retErr) }()
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*pendingActivitySnapshot, 0, len(mutableState.GetPendingActivityInfos()))
+	for _, ai := range mutableState.GetPendingActivityInfos() {
+		scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, ai.ScheduleID)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, newPendingActivitySnapshot(ai, scheduledEvent.ActivityTaskScheduledEventAttributes))
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].ScheduleID < snapshots[j].ScheduleID
+	})
+
+	result, err := json.Marshal(snapshots)
+	if err != nil {
+		return nil, err
+	}
+	return &types.HistoryQueryWorkflowResponse{
+		Response: &types.QueryWorkflowResponse{
+			QueryResult: result,
+		},
+	}, nil
+}
+
+func newPendingActivitySnapshot(
+	ai *persistence.ActivityInfo,
+	attributes *types.ActivityTaskScheduledEventAttributes,
+) *pendingActivitySnapshot {
+
+	state := types.PendingActivityStateScheduled
+	if ai.CancelRequested {
+		state = types.PendingActivityStateCancelRequested
+	} else if ai.StartedID != common.EmptyEventID {
+		state = types.PendingActivityStateStarted
+	}
+
+	snapshot := &pendingActivitySnapshot{
+		ActivityID:                    ai.ActivityID,
+		ActivityType:                  attributes.GetActivityType().GetName(),
+		TaskList:                      ai.TaskList,
+		State:                         state.String(),
+		ScheduleID:                    ai.ScheduleID,
+		StartedID:                     ai.StartedID,
+		Attempt:                       ai.Attempt,
+		ScheduledTime:                 ai.ScheduledTime,
+		StartedIdentity:               ai.StartedIdentity,
+		ScheduleToStartTimeoutSeconds: ai.ScheduleToStartTimeout,
+		ScheduleToCloseTimeoutSeconds: ai.ScheduleToCloseTimeout,
+		StartToCloseTimeoutSeconds:    execution.GetActivityStartToCloseTimeout(ai),
+		HeartbeatTimeoutSeconds:       ai.HeartbeatTimeout,
+		CancelRequested:               ai.CancelRequested,
+		ProgressPercent:               ai.ProgressPercent,
+		LastFailureReason:             ai.LastFailureReason,
+		LastFailureDetails:            ai.LastFailureDetails,
+		LastWorkerIdentity:            ai.LastWorkerIdentity,
+	}
+	if !ai.StartedTime.IsZero() {
+		snapshot.StartedTime = common.TimePtr(ai.StartedTime)
+	}
+	if ai.LastHeartBeatUpdatedTime.UnixNano() > 0 {
+		snapshot.LastHeartbeatTime = common.TimePtr(ai.LastHeartBeatUpdatedTime)
+		snapshot.HeartbeatDetails = execution.GetActivityHeartbeatDetails(ai, false)
+	}
+	if ai.HasRetryPolicy {
+		snapshot.RetryPolicy = &types.RetryPolicy{
+			InitialIntervalInSeconds: ai.InitialInterval,
+			BackoffCoefficient:       ai.BackoffCoefficient,
+			MaximumIntervalInSeconds: ai.MaximumInterval,
+			MinimumIntervalInSeconds: ai.MinimumInterval,
+			MaximumAttempts:          ai.MaximumAttempts,
+			NonRetriableErrorReasons: ai.NonRetriableErrors,
+			MaxTotalExecutionSeconds: ai.MaxTotalExecutionSeconds,
+		}
+		if !ai.ExpirationTime.IsZero() {
+			snapshot.ExpirationTime = common.TimePtr(ai.ExpirationTime)
+		}
+	}
+	return snapshot
+}
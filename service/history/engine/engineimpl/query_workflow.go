@@ -75,6 +75,10 @@ func (e *historyEngineImpl) QueryWorkflow(
 		}
 	}
 
+	if req.GetQuery().GetQueryType() == common.PendingActivitiesQueryType {
+		return e.queryPendingActivities(ctx, request.GetDomainUUID(), execution)
+	}
+
 	// query cannot be processed unless at least one decision task has finished
 	// if first decision task has not finished wait for up to a second for it to complete
 	queryFirstDecisionTaskWaitTime := defaultQueryFirstDecisionTaskWaitTime
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activityencryption"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
@@ -55,6 +56,9 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 	}
 
 	var resurrectError error
+	var queueLatency time.Duration
+	var taskList string
+	var attempt int32
 	response := &types.RecordActivityTaskStartedResponse{}
 	err = workflow.UpdateWithAction(ctx, e.executionCache, domainID, workflowExecution, false, e.timeSource.Now(),
 		func(wfContext execution.Context, mutableState execution.MutableState) error {
@@ -125,11 +129,17 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 			if err != nil {
 				return err
 			}
+			if !request.PollRequest.HasCapabilities(scheduledEvent.ActivityTaskScheduledEventAttributes.GetRequiredCapabilities()) {
+				return workflow.ErrActivityCapabilityMismatch
+			}
 			response.ScheduledEvent = scheduledEvent
 			response.ScheduledTimestampOfThisAttempt = common.Int64Ptr(ai.ScheduledTime.UnixNano())
 
 			response.Attempt = int64(ai.Attempt)
-			response.HeartbeatDetails = ai.Details
+			response.HeartbeatDetails = execution.GetActivityHeartbeatDetails(ai, request.PollRequest.GetAcceptCompressedHeartbeatDetails())
+			// a cancellation may have been requested while the task was waiting in matching,
+			// let the worker know so it can skip executing the activity
+			response.WasCancelBeforeStart = ai.CancelRequested
 
 			response.WorkflowType = mutableState.GetWorkflowType()
 			response.WorkflowDomain = domainName
@@ -147,6 +157,14 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return &types.EventAlreadyStartedError{Message: "Activity task already started."}
 			}
 
+			// only activities with a retry policy can be redispatched, see RedispatchActivity
+			if ai.HasRetryPolicy {
+				ai.VisibilityTimeout = request.GetVisibilityTimeoutSeconds()
+			}
+			// only idempotent activities with a heartbeat timeout can be stolen, see StealActivity
+			ai.StealTimeout = execution.GetActivityStealTimeout(ai, request.GetStealThreshold())
+			// a prefetched task is leased until its first heartbeat, the heartbeat timer is not started before that
+			ai.PrefetchLeased = request.GetPrefetched()
 			if _, err := mutableState.AddActivityTaskStartedEvent(
 				ai, scheduleID, requestID, request.PollRequest.GetIdentity(),
 			); err != nil {
@@ -154,6 +172,9 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 			}
 
 			response.StartedTimestamp = common.Int64Ptr(ai.StartedTime.UnixNano())
+			queueLatency = ai.StartedTime.Sub(ai.ScheduledTime)
+			taskList = ai.TaskList
+			attempt = ai.Attempt
 
 			return nil
 		})
@@ -164,6 +185,26 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 	if resurrectError != nil {
 		return nil, resurrectError
 	}
+	if queueLatency > 0 {
+		// the scheduled time of a retry is the time its backoff expired, so retries only measure the dispatch latency too
+		e.metricsClient.Scope(metrics.HistoryRecordActivityTaskStartedScope).
+			Tagged(
+				metrics.DomainTag(domainName),
+				metrics.TaskListTag(taskList),
+				metrics.ActivityRetryTag(attempt > 0),
+			).
+			RecordHistogramDuration(metrics.ActivityQueueLatencyHistogram, queueLatency)
+	}
+	if scheduledEvent := response.ScheduledEvent; scheduledEvent.GetActivityTaskScheduledEventAttributes().GetEncryptionKeyID() != "" {
+		// the scheduled event is shared with the events cache, the input is decrypted on a copy
+		event := *scheduledEvent
+		attributes := *scheduledEvent.ActivityTaskScheduledEventAttributes
+		event.ActivityTaskScheduledEventAttributes = &attributes
+		if err := activityencryption.DecryptEvents(ctx, e.activityPayloadEncryptor, domainName, []*types.HistoryEvent{&event}); err != nil {
+			return nil, &types.InternalServiceError{Message: "Unable to decrypt activity input: " + err.Error()}
+		}
+		response.ScheduledEvent = &event
+	}
 
 	return response, err
 }
@@ -0,0 +1,112 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package engineimpl
+
+import (
+	"context"
+	"strings"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// RedriveTimedOutActivities resets the attempts of the pending activities of a workflow which timed out and are
+// waiting for their next retry, so they are rescheduled right away instead of waiting out the backoff. Each reset
+// is recorded in history with a marker named common.ActivityAttemptsResetMarkerName, workflows without such
+// activities are left untouched.
+func (e *historyEngineImpl) RedriveTimedOutActivities(
+	ctx context.Context,
+	request *types.HistoryRedriveTimedOutActivitiesRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainByID(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
+		return errDomainDeprecated
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	redriveRequest := request.Request
+	if redriveRequest.WorkflowExecution == nil {
+		return &types.BadRequestError{Message: "WorkflowExecution is not set on request."}
+	}
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: redriveRequest.WorkflowExecution.GetWorkflowID(),
+		RunID:      redriveRequest.WorkflowExecution.GetRunID(),
+	}
+
+	return workflow.UpdateWithActionFunc(ctx, e.executionCache, domainID, workflowExecution, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			redriven, err := redriveTimedOutActivities(ctx, mutableState, redriveRequest.GetIdentity())
+			if err != nil {
+				return nil, err
+			}
+			if redriven == 0 {
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+
+			e.logger.Info("Redriving timed out activities",
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(workflowExecution.GetWorkflowID()),
+				tag.WorkflowRunID(workflowExecution.GetRunID()),
+				tag.Counter(redriven),
+			)
+			return &workflow.UpdateAction{}, nil
+		})
+}
+
+// redriveTimedOutActivities records an attempts reset marker for each pending activity which timed out and is
+// waiting for its next retry, and returns the number of activities rescheduled
+func redriveTimedOutActivities(
+	ctx context.Context,
+	mutableState execution.MutableState,
+	identity string,
+) (int, error) {
+	redriven := 0
+	for _, ai := range mutableState.GetPendingActivityInfos() {
+		if ai.StartedID != common.EmptyEventID || !strings.HasPrefix(ai.LastFailureReason, common.FailureReasonTimeoutPrefix) {
+			continue
+		}
+		if _, err := mutableState.AddActivityAttemptsResetMarkerEvent(
+			ctx,
+			ai.ScheduleID,
+			ai.ScheduledEventBatchID,
+			ai.ActivityID,
+			ai.Attempt,
+			identity,
+		); err != nil {
+			return 0, &types.InternalServiceError{Message: "Unable to add activity attempts reset marker to history."}
+		}
+		redriven++
+	}
+	return redriven, nil
+}
@@ -0,0 +1,72 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package engineimpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+)
+
+func TestRedriveTimedOutActivities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mutableState := execution.NewMockMutableState(ctrl)
+
+	timedOut := &persistence.ActivityInfo{
+		ScheduleID:            5,
+		ScheduledEventBatchID: 4,
+		ActivityID:            "timed-out",
+		StartedID:             common.EmptyEventID,
+		Attempt:               2,
+		LastFailureReason:     execution.TimerTypeToReason(execution.TimerTypeStartToClose),
+	}
+	running := &persistence.ActivityInfo{
+		ScheduleID:        6,
+		StartedID:         common.TransientEventID,
+		Attempt:           1,
+		LastFailureReason: execution.TimerTypeToReason(execution.TimerTypeHeartbeat),
+	}
+	failed := &persistence.ActivityInfo{
+		ScheduleID:        7,
+		StartedID:         common.EmptyEventID,
+		Attempt:           1,
+		LastFailureReason: "some-error",
+	}
+	mutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistence.ActivityInfo{
+		timedOut.ScheduleID: timedOut,
+		running.ScheduleID:  running,
+		failed.ScheduleID:   failed,
+	})
+	mutableState.EXPECT().AddActivityAttemptsResetMarkerEvent(gomock.Any(), int64(5), int64(4), "timed-out", int32(2), "identity").
+		Return(&types.HistoryEvent{}, nil).Times(1)
+
+	redriven, err := redriveTimedOutActivities(context.Background(), mutableState, "identity")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, redriven)
+}
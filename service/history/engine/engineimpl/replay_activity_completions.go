@@ -0,0 +1,311 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activityencryption"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/collection"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	persistenceutils "github.com/uber/cadence/common/persistence/persistence-utils"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+type activityCompletion struct {
+	baseScheduledEventID int64
+	scheduledAttributes  *types.ActivityTaskScheduledEventAttributes
+	completed            bool
+	result               []byte
+}
+
+// ReplayActivityCompletions replays the results of activities which completed in the base run of a reset into
+// the run created by the reset, so that expensive activities are not executed again after the reset. Each
+// replayed activity is recorded as started and completed in the new run with the result of the base run.
+//
+// The replay relies on the decider being deterministic: every replayed activity must have been scheduled again
+// by the new run with the same activity ID, type and input as in the base run, and must not have been started
+// yet. The request is rejected as a whole if the scheduling of any activity diverged from the base run, or if
+// the activity did not complete in the base run. Activities scheduled with an EncryptionKeyID must use the same
+// key in both runs, their inputs are compared decrypted and their results are replayed as they are.
+func (e *historyEngineImpl) ReplayActivityCompletions(
+	ctx context.Context,
+	request *types.HistoryReplayActivityCompletionsRequest,
+) (*types.ReplayActivityCompletionsResponse, error) {
+
+	domainEntry, err := e.getActiveDomainByID(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
+		return nil, errDomainDeprecated
+	}
+	domainID := domainEntry.GetInfo().ID
+	domainName := domainEntry.GetInfo().Name
+
+	replayRequest := request.Request
+	if replayRequest.GetWorkflowExecution().GetRunID() == "" {
+		return nil, &types.BadRequestError{Message: "RunID of the reset run is not set on request."}
+	}
+	if replayRequest.GetBaseRunID() == "" || replayRequest.GetBaseRunID() == replayRequest.GetWorkflowExecution().GetRunID() {
+		return nil, &types.BadRequestError{Message: "BaseRunID must be set to the run which was reset."}
+	}
+	if len(replayRequest.GetScheduledEventIDs()) == 0 {
+		return nil, &types.BadRequestError{Message: "ScheduledEventIDs is not set on request."}
+	}
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: replayRequest.GetWorkflowExecution().GetWorkflowID(),
+		RunID:      replayRequest.GetWorkflowExecution().GetRunID(),
+	}
+
+	completions, err := e.getActivityCompletions(
+		ctx,
+		domainID,
+		types.WorkflowExecution{
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      replayRequest.GetBaseRunID(),
+		},
+		replayRequest.GetScheduledEventIDs(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &types.ReplayActivityCompletionsResponse{
+		ScheduledEventIDs: make(map[int64]int64, len(completions)),
+	}
+	err = workflow.UpdateWithAction(ctx, e.executionCache, domainID, workflowExecution, true, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) error {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return workflow.ErrAlreadyCompleted
+			}
+
+			// all the activities are matched before recording any completion, so that the request is applied as a whole
+			targets := make([]*persistence.ActivityInfo, 0, len(completions))
+			matched := make(map[int64]struct{}, len(completions))
+			for _, completion := range completions {
+				activityID := completion.scheduledAttributes.GetActivityID()
+				ai, ok := mutableState.GetActivityByActivityID(activityID)
+				if !ok {
+					return &types.BadRequestError{Message: fmt.Sprintf(
+						"Activity %v is not pending in the reset run, the decider scheduling diverged from the base run.", activityID)}
+				}
+				if _, ok := matched[ai.ScheduleID]; ok {
+					return &types.BadRequestError{Message: fmt.Sprintf(
+						"Several completions of the base run are replayed into activity %v.", activityID)}
+				}
+				if ai.StartedID != common.EmptyEventID {
+					return &types.BadRequestError{Message: fmt.Sprintf(
+						"Activity %v already started in the reset run.", activityID)}
+				}
+				scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, ai.ScheduleID)
+				if err != nil {
+					return err
+				}
+				attributes := scheduledEvent.ActivityTaskScheduledEventAttributes
+				sameInput, err := e.sameActivityInput(ctx, domainName, attributes, completion.scheduledAttributes)
+				if err != nil {
+					return err
+				}
+				if attributes.GetActivityType().GetName() != completion.scheduledAttributes.GetActivityType().GetName() || !sameInput {
+					return &types.BadRequestError{Message: fmt.Sprintf(
+						"Activity %v was scheduled with a different type or input in the reset run, the decider scheduling diverged from the base run.", activityID)}
+				}
+				matched[ai.ScheduleID] = struct{}{}
+				targets = append(targets, ai)
+			}
+
+			for i, completion := range completions {
+				ai := targets[i]
+				if _, err := mutableState.AddActivityTaskStartedEvent(ai, ai.ScheduleID, uuid.New(), replayRequest.GetIdentity()); err != nil {
+					return &types.InternalServiceError{Message: "Unable to add ActivityTaskStarted event to history."}
+				}
+				if _, err := mutableState.AddActivityTaskCompletedEvent(ai.ScheduleID, ai.StartedID, &types.RespondActivityTaskCompletedRequest{
+					Result:   completion.result,
+					Identity: replayRequest.GetIdentity(),
+				}, nil); err != nil {
+					return &types.InternalServiceError{Message: "Unable to add ActivityTaskCompleted event to history."}
+				}
+				response.ScheduledEventIDs[completion.baseScheduledEventID] = ai.ScheduleID
+			}
+
+			e.logger.Info("Replayed activity completions into reset run",
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(workflowExecution.GetWorkflowID()),
+				tag.WorkflowRunID(workflowExecution.GetRunID()),
+				tag.Counter(len(completions)),
+			)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// getActivityCompletions reads the scheduled attributes and the results of the given activities from the
+// history of the base run, in the order of the request
+func (e *historyEngineImpl) getActivityCompletions(
+	ctx context.Context,
+	domainID string,
+	baseExecution types.WorkflowExecution,
+	scheduledEventIDs []int64,
+) ([]*activityCompletion, error) {
+
+	completions := make(map[int64]*activityCompletion, len(scheduledEventIDs))
+	for _, scheduledEventID := range scheduledEventIDs {
+		if _, ok := completions[scheduledEventID]; ok {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("Scheduled event %v is replayed more than once.", scheduledEventID)}
+		}
+		completions[scheduledEventID] = &activityCompletion{baseScheduledEventID: scheduledEventID}
+	}
+
+	mutableStateResp, err := e.getMutableState(ctx, domainID, baseExecution)
+	if err != nil {
+		return nil, err
+	}
+
+	iter := collection.NewPagingIterator(func(paginationToken []byte) ([]interface{}, []byte, error) {
+		events, _, token, _, err := persistenceutils.PaginateHistory(
+			ctx,
+			e.historyV2Mgr,
+			false,
+			mutableStateResp.CurrentBranchToken,
+			common.FirstEventID,
+			mutableStateResp.GetNextEventID(),
+			paginationToken,
+			execution.NDCDefaultPageSize,
+			common.IntPtr(e.shard.GetShardID()),
+			domainID,
+			e.shard.GetDomainCache(),
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		items := make([]interface{}, 0, len(events))
+		for _, event := range events {
+			items = append(items, event)
+		}
+		return items, token, nil
+	})
+	// completed activities, to resolve the results deduplicated against them
+	completed := make(map[int64]*types.ActivityTaskCompletedEventAttributes)
+	for iter.HasNext() {
+		item, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		event := item.(*types.HistoryEvent)
+		switch event.GetEventType() {
+		case types.EventTypeActivityTaskScheduled:
+			if completion, ok := completions[event.ID]; ok {
+				completion.scheduledAttributes = event.ActivityTaskScheduledEventAttributes
+			}
+		case types.EventTypeActivityTaskCompleted:
+			attributes := event.ActivityTaskCompletedEventAttributes
+			resultAttributes := attributes
+			if referenceID := attributes.GetResultReferenceScheduledEventID(); referenceID != 0 {
+				resultAttributes = completed[referenceID]
+			} else {
+				completed[attributes.GetScheduledEventID()] = attributes
+			}
+			if completion, ok := completions[attributes.GetScheduledEventID()]; ok {
+				result, err := e.getArchivedActivityResult(ctx, resultAttributes)
+				if err != nil {
+					return nil, err
+				}
+				completion.completed = true
+				completion.result = result
+			}
+		}
+	}
+
+	result := make([]*activityCompletion, 0, len(scheduledEventIDs))
+	for _, scheduledEventID := range scheduledEventIDs {
+		completion := completions[scheduledEventID]
+		if completion.scheduledAttributes == nil || !completion.completed {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("Activity scheduled by event %v did not complete in the base run.", scheduledEventID)}
+		}
+		result = append(result, completion)
+	}
+	return result, nil
+}
+
+// sameActivityInput compares the inputs of the activities, the inputs of activities scheduled with the same
+// EncryptionKeyID are compared decrypted
+func (e *historyEngineImpl) sameActivityInput(
+	ctx context.Context,
+	domainName string,
+	attributes *types.ActivityTaskScheduledEventAttributes,
+	baseAttributes *types.ActivityTaskScheduledEventAttributes,
+) (bool, error) {
+
+	if attributes.GetEncryptionKeyID() != baseAttributes.GetEncryptionKeyID() {
+		return false, nil
+	}
+	if attributes.GetEncryptionKeyID() == "" {
+		return bytes.Equal(attributes.Input, baseAttributes.Input), nil
+	}
+	inputs := make([][]byte, 0, 2)
+	for _, scheduledAttributes := range []*types.ActivityTaskScheduledEventAttributes{attributes, baseAttributes} {
+		input, err := activityencryption.DecryptPayload(ctx, e.activityPayloadEncryptor, domainName, scheduledAttributes.EncryptionKeyID, scheduledAttributes.Input)
+		if err != nil {
+			return false, &types.InternalServiceError{Message: "Unable to decrypt activity input: " + err.Error()}
+		}
+		inputs = append(inputs, input)
+	}
+	return bytes.Equal(inputs[0], inputs[1]), nil
+}
+
+// getArchivedActivityResult returns the result of the completed activity, reading it from the blobstore if it was
+// above the archival threshold when the activity completed
+func (e *historyEngineImpl) getArchivedActivityResult(
+	ctx context.Context,
+	attributes *types.ActivityTaskCompletedEventAttributes,
+) ([]byte, error) {
+
+	if attributes == nil {
+		return nil, nil
+	}
+	if attributes.ResultBlobKey == "" {
+		return attributes.Result, nil
+	}
+	blobstoreClient := e.shard.GetService().GetBlobstoreClient()
+	if blobstoreClient == nil {
+		return nil, &types.InternalServiceError{Message: "Unable to read archived activity result, no blobstore is configured."}
+	}
+	resp, err := blobstoreClient.Get(ctx, &blobstore.GetRequest{Key: attributes.ResultBlobKey})
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: "Unable to read archived activity result: " + err.Error()}
+	}
+	return resp.Blob.Body, nil
+}
@@ -0,0 +1,156 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package engineimpl
+
+import (
+	ctx "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine/testdata"
+)
+
+func TestReplayActivityCompletions(t *testing.T) {
+	const baseRunID = "base-run-id"
+	execution := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	newRequest := func(baseRunID string, scheduledEventIDs ...int64) *types.HistoryReplayActivityCompletionsRequest {
+		return &types.HistoryReplayActivityCompletionsRequest{
+			DomainUUID: constants.TestDomainID,
+			Request: &types.ReplayActivityCompletionsRequest{
+				Domain:            constants.TestDomainName,
+				WorkflowExecution: execution,
+				BaseRunID:         baseRunID,
+				ScheduledEventIDs: scheduledEventIDs,
+				Identity:          "operator",
+			},
+		}
+	}
+	mutableState := func(runID string) *persistence.WorkflowMutableState {
+		return &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				DomainID:    constants.TestDomainID,
+				WorkflowID:  execution.WorkflowID,
+				RunID:       runID,
+				State:       persistence.WorkflowStateRunning,
+				NextEventID: 10,
+				BranchToken: []byte(runID),
+			},
+			ActivityInfos:  map[int64]*persistence.ActivityInfo{},
+			ExecutionStats: &persistence.ExecutionStats{},
+			Checksum:       checksum.Checksum{},
+		}
+	}
+	scheduledEvent := &types.HistoryEvent{
+		ID:        5,
+		EventType: types.EventTypeActivityTaskScheduled.Ptr(),
+		ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+			ActivityID:   "activity",
+			ActivityType: &types.ActivityType{Name: "activity-type"},
+		},
+	}
+	completedEvent := &types.HistoryEvent{
+		ID:        7,
+		EventType: types.EventTypeActivityTaskCompleted.Ptr(),
+		ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+			ScheduledEventID: 5,
+			StartedEventID:   6,
+			Result:           []byte("result"),
+		},
+	}
+	mockRuns := func(engine *testdata.EngineForTest, baseEvents ...*types.HistoryEvent) {
+		for _, runID := range []string{baseRunID, execution.RunID} {
+			runID := runID
+			engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.GetWorkflowExecutionRequest) bool {
+				return req.Execution.RunID == runID
+			})).Return(&persistence.GetWorkflowExecutionResponse{State: mutableState(runID)}, nil)
+		}
+		engine.ShardCtx.Resource.HistoryMgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).
+			Return(&persistence.ReadHistoryBranchResponse{HistoryEvents: baseEvents}, nil).Once()
+	}
+	cases := []struct {
+		name        string
+		request     *types.HistoryReplayActivityCompletionsRequest
+		init        func(engine *testdata.EngineForTest)
+		expectedErr error
+	}{
+		{
+			name:        "Missing BaseRunID",
+			request:     newRequest("", 5),
+			expectedErr: &types.BadRequestError{Message: "BaseRunID must be set to the run which was reset."},
+		},
+		{
+			name:        "BaseRunID Of Reset Run",
+			request:     newRequest(execution.RunID, 5),
+			expectedErr: &types.BadRequestError{Message: "BaseRunID must be set to the run which was reset."},
+		},
+		{
+			name:        "Missing ScheduledEventIDs",
+			request:     newRequest(baseRunID),
+			expectedErr: &types.BadRequestError{Message: "ScheduledEventIDs is not set on request."},
+		},
+		{
+			name:        "Duplicate ScheduledEventIDs",
+			request:     newRequest(baseRunID, 5, 5),
+			expectedErr: &types.BadRequestError{Message: "Scheduled event 5 is replayed more than once."},
+		},
+		{
+			name:    "Activity Not Completed In Base Run",
+			request: newRequest(baseRunID, 5),
+			init: func(engine *testdata.EngineForTest) {
+				mockRuns(engine, scheduledEvent)
+			},
+			expectedErr: &types.BadRequestError{Message: "Activity scheduled by event 5 did not complete in the base run."},
+		},
+		{
+			name:    "Activity Not Scheduled In Reset Run",
+			request: newRequest(baseRunID, 5),
+			init: func(engine *testdata.EngineForTest) {
+				mockRuns(engine, scheduledEvent, completedEvent)
+			},
+			expectedErr: &types.BadRequestError{Message: "Activity activity is not pending in the reset run, the decider scheduling diverged from the base run."},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+
+			if testCase.init != nil {
+				testCase.init(eft)
+			}
+			eft.Engine.Start()
+			_, err := eft.Engine.ReplayActivityCompletions(ctx.Background(), testCase.request)
+			eft.Engine.Stop()
+
+			assert.Equal(t, testCase.expectedErr, err)
+		})
+	}
+}
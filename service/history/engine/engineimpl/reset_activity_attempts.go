@@ -0,0 +1,235 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/collection"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	persistenceutils "github.com/uber/cadence/common/persistence/persistence-utils"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// exhaustedActivity is an activity which is no longer pending because it ran out of retries
+type exhaustedActivity struct {
+	scheduleID            int64
+	scheduledEventBatchID int64
+	attempt               int32
+}
+
+// ResetActivityAttempts resets the retry attempts of an activity, so that an activity which kept failing
+// because of an already fixed dependency gets its full retry budget back without resetting the whole workflow.
+// The reset is recorded in history with a marker named common.ActivityAttemptsResetMarkerName.
+//
+// An activity which is pending between two attempts is rescheduled right away instead of waiting out its retry
+// backoff, and an activity which ran out of retries and failed or timed out is scheduled again. An attempt which
+// is currently running is rejected, since the worker holds a task token for that attempt, and so are activities
+// which completed or were canceled.
+func (e *historyEngineImpl) ResetActivityAttempts(
+	ctx context.Context,
+	request *types.HistoryResetActivityAttemptsRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainByID(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
+		return errDomainDeprecated
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	resetRequest := request.Request
+	if resetRequest.GetActivityID() == "" {
+		return &types.BadRequestError{Message: "ActivityID is not set on request."}
+	}
+	if resetRequest.WorkflowExecution == nil {
+		return &types.BadRequestError{Message: "WorkflowExecution is not set on request."}
+	}
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: resetRequest.WorkflowExecution.GetWorkflowID(),
+		RunID:      resetRequest.WorkflowExecution.GetRunID(),
+	}
+
+	return workflow.UpdateWithAction(ctx, e.executionCache, domainID, workflowExecution, false, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) error {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return workflow.ErrAlreadyCompleted
+			}
+
+			var activity *exhaustedActivity
+			if ai, ok := mutableState.GetActivityByActivityID(resetRequest.GetActivityID()); ok {
+				if ai.StartedID != common.EmptyEventID {
+					return &types.BadRequestError{Message: "Activity attempt is running, attempts can only be reset between attempts."}
+				}
+				if ai.Attempt == 0 {
+					return &types.BadRequestError{Message: "Activity has not been retried, there are no attempts to reset."}
+				}
+				activity = &exhaustedActivity{
+					scheduleID:            ai.ScheduleID,
+					scheduledEventBatchID: ai.ScheduledEventBatchID,
+					attempt:               ai.Attempt,
+				}
+			} else {
+				exhausted, err := e.getExhaustedActivity(ctx, domainID, mutableState, resetRequest.GetActivityID())
+				if err != nil {
+					return err
+				}
+				activity = exhausted
+			}
+
+			if _, err := mutableState.AddActivityAttemptsResetMarkerEvent(
+				ctx,
+				activity.scheduleID,
+				activity.scheduledEventBatchID,
+				resetRequest.GetActivityID(),
+				activity.attempt,
+				resetRequest.GetIdentity(),
+			); err != nil {
+				return &types.InternalServiceError{Message: "Unable to add activity attempts reset marker to history."}
+			}
+
+			e.logger.Info("Resetting activity attempts",
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(workflowExecution.GetWorkflowID()),
+				tag.WorkflowRunID(workflowExecution.GetRunID()),
+				tag.WorkflowScheduleID(activity.scheduleID),
+				tag.Attempt(activity.attempt),
+			)
+			return nil
+		})
+}
+
+// getExhaustedActivity looks up in history the last activity scheduled with the activity ID, which is expected to
+// have run out of retries since it is no longer pending
+func (e *historyEngineImpl) getExhaustedActivity(
+	ctx context.Context,
+	domainID string,
+	mutableState execution.MutableState,
+	activityID string,
+) (*exhaustedActivity, error) {
+
+	branchToken, err := mutableState.GetCurrentBranchToken()
+	if err != nil {
+		return nil, err
+	}
+	iter := collection.NewPagingIterator(func(paginationToken []byte) ([]interface{}, []byte, error) {
+		_, batches, token, _, err := persistenceutils.PaginateHistory(
+			ctx,
+			e.historyV2Mgr,
+			true,
+			branchToken,
+			common.FirstEventID,
+			mutableState.GetNextEventID(),
+			paginationToken,
+			execution.NDCDefaultPageSize,
+			common.IntPtr(e.shard.GetShardID()),
+			domainID,
+			e.shard.GetDomainCache(),
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		items := make([]interface{}, 0, len(batches))
+		for _, batch := range batches {
+			items = append(items, batch)
+		}
+		return items, token, nil
+	})
+
+	var activity *exhaustedActivity
+	var closeEventType *types.EventType
+	for iter.HasNext() {
+		item, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		batch := item.(*types.History)
+		for _, event := range batch.Events {
+			if event.GetEventType() == types.EventTypeActivityTaskScheduled {
+				if event.ActivityTaskScheduledEventAttributes.GetActivityID() == activityID {
+					activity = &exhaustedActivity{scheduleID: event.ID, scheduledEventBatchID: batch.Events[0].ID}
+					closeEventType = nil
+				}
+				continue
+			}
+			if activity == nil {
+				continue
+			}
+			resetDetails, err := execution.GetActivityAttemptsResetDetails(event)
+			if err != nil {
+				return nil, err
+			}
+			if resetDetails != nil {
+				if resetDetails.ScheduledEventID == activity.scheduleID {
+					closeEventType = nil
+				}
+				continue
+			}
+			if getActivityScheduledEventID(event) != activity.scheduleID {
+				continue
+			}
+			if event.GetEventType() == types.EventTypeActivityTaskStarted {
+				activity.attempt = event.ActivityTaskStartedEventAttributes.Attempt
+			} else {
+				closeEventType = event.EventType
+			}
+		}
+	}
+
+	if activity == nil || closeEventType == nil {
+		return nil, &types.BadRequestError{Message: "Activity is not found."}
+	}
+	switch *closeEventType {
+	case types.EventTypeActivityTaskCompleted:
+		return nil, &types.BadRequestError{Message: "Activity has already completed."}
+	case types.EventTypeActivityTaskCanceled:
+		return nil, &types.BadRequestError{Message: "Activity was canceled."}
+	}
+	return activity, nil
+}
+
+// getActivityScheduledEventID returns the ID of the scheduled event of the activity an activity event is about,
+// or common.EmptyEventID for other events
+func getActivityScheduledEventID(
+	event *types.HistoryEvent,
+) int64 {
+	switch event.GetEventType() {
+	case types.EventTypeActivityTaskStarted:
+		return event.ActivityTaskStartedEventAttributes.GetScheduledEventID()
+	case types.EventTypeActivityTaskCompleted:
+		return event.ActivityTaskCompletedEventAttributes.GetScheduledEventID()
+	case types.EventTypeActivityTaskFailed:
+		return event.ActivityTaskFailedEventAttributes.GetScheduledEventID()
+	case types.EventTypeActivityTaskTimedOut:
+		return event.ActivityTaskTimedOutEventAttributes.GetScheduledEventID()
+	case types.EventTypeActivityTaskCanceled:
+		return event.ActivityTaskCanceledEventAttributes.GetScheduledEventID()
+	default:
+		return common.EmptyEventID
+	}
+}
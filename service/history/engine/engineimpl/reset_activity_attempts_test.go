@@ -0,0 +1,269 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package engineimpl
+
+import (
+	ctx "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine/testdata"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+func TestResetActivityAttempts(t *testing.T) {
+	execution := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	newRequest := func(activityID string) *types.HistoryResetActivityAttemptsRequest {
+		return &types.HistoryResetActivityAttemptsRequest{
+			DomainUUID: constants.TestDomainID,
+			Request: &types.ResetActivityAttemptsRequest{
+				Domain:            constants.TestDomainName,
+				WorkflowExecution: execution,
+				ActivityID:        activityID,
+				Identity:          "operator",
+			},
+		}
+	}
+	mutableState := func(state int, startedID int64) *persistence.WorkflowMutableState {
+		return &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				DomainID:    constants.TestDomainID,
+				WorkflowID:  execution.WorkflowID,
+				RunID:       execution.RunID,
+				State:       state,
+				NextEventID: 10,
+			},
+			ActivityInfos: map[int64]*persistence.ActivityInfo{
+				5: {
+					ScheduleID:     5,
+					StartedID:      startedID,
+					ActivityID:     "activity",
+					DomainID:       constants.TestDomainID,
+					Attempt:        3,
+					HasRetryPolicy: true,
+				},
+			},
+			ExecutionStats: &persistence.ExecutionStats{},
+			Checksum:       checksum.Checksum{},
+		}
+	}
+	history := []*types.History{
+		{Events: []*types.HistoryEvent{
+			{
+				ID:        2,
+				EventType: types.EventTypeActivityTaskScheduled.Ptr(),
+				ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+					ActivityID: "completed-activity",
+					TaskList:   &types.TaskList{Name: "tasklist"},
+				},
+			},
+			{
+				ID:        3,
+				EventType: types.EventTypeActivityTaskScheduled.Ptr(),
+				ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+					ActivityID:  "exhausted-activity",
+					TaskList:    &types.TaskList{Name: "tasklist"},
+					RetryPolicy: &types.RetryPolicy{MaximumAttempts: 3},
+				},
+			},
+		}},
+		{Events: []*types.HistoryEvent{
+			{
+				ID:        6,
+				EventType: types.EventTypeActivityTaskStarted.Ptr(),
+				ActivityTaskStartedEventAttributes: &types.ActivityTaskStartedEventAttributes{
+					ScheduledEventID: 2,
+				},
+			},
+			{
+				ID:        7,
+				EventType: types.EventTypeActivityTaskCompleted.Ptr(),
+				ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+					ScheduledEventID: 2,
+					StartedEventID:   6,
+				},
+			},
+		}},
+		{Events: []*types.HistoryEvent{
+			{
+				ID:        8,
+				EventType: types.EventTypeActivityTaskStarted.Ptr(),
+				ActivityTaskStartedEventAttributes: &types.ActivityTaskStartedEventAttributes{
+					ScheduledEventID: 3,
+					Attempt:          2,
+				},
+			},
+			{
+				ID:        9,
+				EventType: types.EventTypeActivityTaskFailed.Ptr(),
+				ActivityTaskFailedEventAttributes: &types.ActivityTaskFailedEventAttributes{
+					ScheduledEventID: 3,
+					StartedEventID:   8,
+				},
+			},
+		}},
+	}
+	cases := []struct {
+		name        string
+		request     *types.HistoryResetActivityAttemptsRequest
+		init        func(engine *testdata.EngineForTest)
+		expectedErr error
+	}{
+		{
+			name:        "Missing ActivityID",
+			request:     newRequest(""),
+			expectedErr: &types.BadRequestError{Message: "ActivityID is not set on request."},
+		},
+		{
+			name:    "Completed Workflow",
+			request: newRequest("activity"),
+			init: func(engine *testdata.EngineForTest) {
+				engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&persistence.GetWorkflowExecutionResponse{State: mutableState(persistence.WorkflowStateCompleted, common.EmptyEventID)}, nil)
+			},
+			expectedErr: workflow.ErrAlreadyCompleted,
+		},
+		{
+			name:    "Activity Not Found",
+			request: newRequest("unknown-activity"),
+			init: func(engine *testdata.EngineForTest) {
+				engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&persistence.GetWorkflowExecutionResponse{State: mutableState(persistence.WorkflowStateRunning, common.EmptyEventID)}, nil)
+				engine.ShardCtx.Resource.HistoryMgr.On("ReadHistoryBranchByBatch", mock.Anything, mock.Anything).
+					Return(&persistence.ReadHistoryBranchByBatchResponse{History: history}, nil).Once()
+			},
+			expectedErr: &types.BadRequestError{Message: "Activity is not found."},
+		},
+		{
+			name:    "Activity Completed",
+			request: newRequest("completed-activity"),
+			init: func(engine *testdata.EngineForTest) {
+				engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&persistence.GetWorkflowExecutionResponse{State: mutableState(persistence.WorkflowStateRunning, common.EmptyEventID)}, nil)
+				engine.ShardCtx.Resource.HistoryMgr.On("ReadHistoryBranchByBatch", mock.Anything, mock.Anything).
+					Return(&persistence.ReadHistoryBranchByBatchResponse{History: history}, nil).Once()
+			},
+			expectedErr: &types.BadRequestError{Message: "Activity has already completed."},
+		},
+		{
+			name:    "Not Retried",
+			request: newRequest("activity"),
+			init: func(engine *testdata.EngineForTest) {
+				state := mutableState(persistence.WorkflowStateRunning, common.EmptyEventID)
+				state.ActivityInfos[5].Attempt = 0
+				engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&persistence.GetWorkflowExecutionResponse{State: state}, nil)
+			},
+			expectedErr: &types.BadRequestError{Message: "Activity has not been retried, there are no attempts to reset."},
+		},
+		{
+			name:    "Attempt Running",
+			request: newRequest("activity"),
+			init: func(engine *testdata.EngineForTest) {
+				engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&persistence.GetWorkflowExecutionResponse{State: mutableState(persistence.WorkflowStateRunning, common.TransientEventID)}, nil)
+			},
+			expectedErr: &types.BadRequestError{Message: "Activity attempt is running, attempts can only be reset between attempts."},
+		},
+		{
+			name:    "Success",
+			request: newRequest("activity"),
+			init: func(engine *testdata.EngineForTest) {
+				engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&persistence.GetWorkflowExecutionResponse{State: mutableState(persistence.WorkflowStateRunning, common.EmptyEventID)}, nil)
+				engine.ShardCtx.Resource.HistoryMgr.On("AppendHistoryNodes", mock.Anything, mock.AnythingOfType("*persistence.AppendHistoryNodesRequest")).
+					Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+				engine.ShardCtx.Resource.ExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.UpdateWorkflowExecutionRequest) bool {
+					activityInfos := req.UpdateWorkflowMutation.UpsertActivityInfos
+					timerTasks := req.UpdateWorkflowMutation.TasksByCategory[persistence.HistoryTaskCategoryTimer]
+					retryScheduled := false
+					for _, task := range timerTasks {
+						if _, ok := task.(*persistence.ActivityRetryTimerTask); ok {
+							retryScheduled = true
+						}
+					}
+					return len(activityInfos) == 1 &&
+						activityInfos[0].Attempt == 0 &&
+						retryScheduled
+				})).Return(&persistence.UpdateWorkflowExecutionResponse{
+					MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{},
+				}, nil)
+			},
+		},
+		{
+			name:    "Exhausted Activity Reopened",
+			request: newRequest("exhausted-activity"),
+			init: func(engine *testdata.EngineForTest) {
+				engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&persistence.GetWorkflowExecutionResponse{State: mutableState(persistence.WorkflowStateRunning, common.EmptyEventID)}, nil)
+				engine.ShardCtx.Resource.HistoryMgr.On("ReadHistoryBranchByBatch", mock.Anything, mock.Anything).
+					Return(&persistence.ReadHistoryBranchByBatchResponse{History: history}, nil).Once()
+				engine.ShardCtx.Resource.HistoryMgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).
+					Return(&persistence.ReadHistoryBranchResponse{HistoryEvents: history[0].Events}, nil).Once()
+				engine.ShardCtx.Resource.HistoryMgr.On("AppendHistoryNodes", mock.Anything, mock.AnythingOfType("*persistence.AppendHistoryNodesRequest")).
+					Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+				engine.ShardCtx.Resource.ExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.UpdateWorkflowExecutionRequest) bool {
+					activityInfos := req.UpdateWorkflowMutation.UpsertActivityInfos
+					transferTasks := req.UpdateWorkflowMutation.TasksByCategory[persistence.HistoryTaskCategoryTransfer]
+					activityScheduled := false
+					for _, task := range transferTasks {
+						if activityTask, ok := task.(*persistence.ActivityTask); ok && activityTask.ScheduleID == 3 {
+							activityScheduled = true
+						}
+					}
+					return len(activityInfos) == 1 &&
+						activityInfos[0].ScheduleID == 3 &&
+						activityInfos[0].ScheduledEventBatchID == 2 &&
+						activityInfos[0].Attempt == 0 &&
+						activityScheduled
+				})).Return(&persistence.UpdateWorkflowExecutionResponse{
+					MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{},
+				}, nil)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+
+			if testCase.init != nil {
+				testCase.init(eft)
+			}
+			eft.Engine.Start()
+			err := eft.Engine.ResetActivityAttempts(ctx.Background(), testCase.request)
+			eft.Engine.Stop()
+
+			assert.Equal(t, testCase.expectedErr, err)
+		})
+	}
+}
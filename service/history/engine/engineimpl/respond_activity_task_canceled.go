@@ -49,6 +49,8 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 	request := req.CancelRequest
 	token, err0 := e.tokenSerializer.Deserialize(request.TaskToken)
 	if err0 != nil {
+		e.recordActivityTokenValidationFailure(metrics.HistoryRespondActivityTaskCanceledScope, "RespondActivityTaskCanceled", domainID, domainName, nil,
+			types.ActivityTokenValidationFailureCauseMalformed.Ptr(), nil)
 		return workflow.ErrDeserializingToken
 	}
 
@@ -59,9 +61,11 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 
 	var activityStartedTime time.Time
 	var taskList string
+	var tokenFailureCause *types.ActivityTokenValidationFailureCause
 	err = workflow.UpdateWithAction(ctx, e.executionCache, domainID, workflowExecution, true, e.timeSource.Now(),
 		func(wfContext execution.Context, mutableState execution.MutableState) error {
 			if !mutableState.IsWorkflowExecutionRunning() {
+				tokenFailureCause = types.ActivityTokenValidationFailureCauseWrongRun.Ptr()
 				return workflow.ErrAlreadyCompleted
 			}
 
@@ -69,6 +73,9 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 			if scheduleID == common.EmptyEventID { // client call CompleteActivityById, so get scheduleID by activityID
 				scheduleID, err0 = getScheduleID(token.ActivityID, mutableState)
 				if err0 != nil {
+					if token.ActivityID != "" {
+						tokenFailureCause = types.ActivityTokenValidationFailureCauseAlreadyCompleted.Ptr()
+					}
 					return err0
 				}
 			}
@@ -90,6 +97,7 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 
 			if !isRunning || ai.StartedID == common.EmptyEventID ||
 				(token.ScheduleID != common.EmptyEventID && token.ScheduleAttempt != int64(ai.Attempt)) {
+				tokenFailureCause = getActivityTokenFailureCause(isRunning)
 				return workflow.ErrActivityTaskNotFound
 			}
 
@@ -107,6 +115,7 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 			taskList = ai.TaskList
 			return nil
 		})
+	e.recordActivityTokenValidationFailure(metrics.HistoryRespondActivityTaskCanceledScope, "RespondActivityTaskCanceled", domainID, domainName, token, tokenFailureCause, err)
 	if err == nil && !activityStartedTime.IsZero() {
 		scope := e.metricsClient.Scope(metrics.HistoryClientRespondActivityTaskCanceledScope).
 			Tagged(
@@ -23,18 +23,69 @@ package engineimpl
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activityencryption"
+	"github.com/uber/cadence/common/activityvalidator"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/workflow"
 )
 
+// activityResultBlobKeyPrefix prefixes the blobstore keys of the activity results above the archival threshold
+const activityResultBlobKeyPrefix = "activity-results"
+
+// completedActivityKey identifies a completed activity by the schedule ID and attempt of the completion, or by its
+// activity ID, with the schedule ID left to common.EmptyEventID, for the completions by ID
+type completedActivityKey struct {
+	domainID        string
+	workflowID      string
+	runID           string
+	activityID      string
+	scheduleID      int64
+	scheduleAttempt int64
+}
+
+type activityResultKey struct {
+	domainID   string
+	workflowID string
+	runID      string
+	resultHash [sha256.Size]byte
+}
+
 // RespondActivityTaskCompleted completes an activity task.
+//
+// A worker retrying a completion whose response was lost would otherwise get ErrActivityTaskNotFound,
+// as the activity is gone once completed. Completions are remembered for ActivityCompletionDedupWindow,
+// and a completion of an activity which is no longer pending is checked against them once its token is
+// resolved against the workflow: by the run, schedule ID and attempt of the token, or by the run and
+// activity ID for completions by ID. A match is treated as a duplicate and acknowledged without touching
+// the workflow. Tokens of any other attempt, or arriving after the window, are stale and still fail. The
+// record is kept in memory, so it doesn't survive a shard moving to another host.
+//
+// For the activity types listed in ActivityResultValidation, the result is first passed to the activity
+// result validator. A rejected result is recorded as a failed attempt with FailureReasonActivityResultRejected,
+// retried as any other failure, and the worker gets a BadRequestError.
+//
+// The result of an activity scheduled with an EncryptionKeyID is encrypted with the same key before it is recorded.
+//
+// With EnableActivityResultDedup, a result identical to the one of an activity completed earlier in the same run,
+// and encrypted with the same key, is not stored again. The completed event is recorded with an empty Result and
+// ResultReferenceScheduledEventID pointing at the earlier activity, which GetWorkflowExecutionHistory resolves.
+// Earlier results are remembered in memory by their hash, so a result is only deduplicated against activities
+// completed on this host since the shard was loaded.
+//
+// A result, as recorded, larger than ActivityResultArchivalThreshold is written to the blobstore before the
+// completion is recorded, and the completed event only keeps its ResultBlobKey, which GetWorkflowExecutionHistory
+// resolves. The blob is not deleted together with the workflow, its lifetime is up to the blobstore.
 func (e *historyEngineImpl) RespondActivityTaskCompleted(
 	ctx context.Context,
 	req *types.HistoryRespondActivityTaskCompletedRequest,
@@ -50,6 +101,8 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 	request := req.CompleteRequest
 	token, err0 := e.tokenSerializer.Deserialize(request.TaskToken)
 	if err0 != nil {
+		e.recordActivityTokenValidationFailure(metrics.HistoryRespondActivityTaskCompletedScope, "RespondActivityTaskCompleted", domainID, domainName, nil,
+			types.ActivityTokenValidationFailureCauseMalformed.Ptr(), nil)
 		return workflow.ErrDeserializingToken
 	}
 
@@ -58,19 +111,66 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 		RunID:      token.RunID,
 	}
 
+	dedupWindow := e.config.ActivityCompletionDedupWindow(domainName)
+
 	var activityStartedTime time.Time
 	var taskList string
-	err = workflow.UpdateWithAction(ctx, e.executionCache, domainID, workflowExecution, true, e.timeSource.Now(),
-		func(wfContext execution.Context, mutableState execution.MutableState) error {
+	var expectedResultSize int64
+	var activityAttempt int32
+	var rejectErr error
+	var resultKey *activityResultKey
+	var resultScheduleID int64
+	var tokenFailureCause *types.ActivityTokenValidationFailureCause
+	var completedKeys []completedActivityKey
+	err = workflow.UpdateWithActionFunc(ctx, e.executionCache, domainID, workflowExecution, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			resultKey = nil
+			completedKeys = nil
+			executionInfo := mutableState.GetExecutionInfo()
+			tokenKey := completedActivityKey{
+				domainID:        domainID,
+				workflowID:      executionInfo.WorkflowID,
+				runID:           executionInfo.RunID,
+				scheduleID:      token.ScheduleID,
+				scheduleAttempt: token.ScheduleAttempt,
+			}
+			if token.ScheduleID == common.EmptyEventID {
+				tokenKey.activityID = token.ActivityID
+				tokenKey.scheduleAttempt = 0
+			}
+			// acknowledges the completion if the activity it resolves to was completed within the dedup window
+			duplicateAction := func() (*workflow.UpdateAction, bool) {
+				if !e.isDuplicateActivityCompletion(tokenKey, dedupWindow) {
+					return nil, false
+				}
+				e.logger.Info("Acknowledging duplicate activity completion",
+					tag.WorkflowDomainName(domainName),
+					tag.WorkflowID(executionInfo.WorkflowID),
+					tag.WorkflowRunID(executionInfo.RunID),
+					tag.WorkflowScheduleID(token.ScheduleID),
+				)
+				return &workflow.UpdateAction{Noop: true}, true
+			}
+
 			if !mutableState.IsWorkflowExecutionRunning() {
-				return workflow.ErrAlreadyCompleted
+				if action, ok := duplicateAction(); ok {
+					return action, nil
+				}
+				tokenFailureCause = types.ActivityTokenValidationFailureCauseWrongRun.Ptr()
+				return nil, workflow.ErrAlreadyCompleted
 			}
 
 			scheduleID := token.ScheduleID
 			if scheduleID == common.EmptyEventID { // client call CompleteActivityById, so get scheduleID by activityID
 				scheduleID, err0 = getScheduleID(token.ActivityID, mutableState)
 				if err0 != nil {
-					return err0
+					if action, ok := duplicateAction(); ok {
+						return action, nil
+					}
+					if token.ActivityID != "" {
+						tokenFailureCause = types.ActivityTokenValidationFailureCauseAlreadyCompleted.Ptr()
+					}
+					return nil, err0
 				}
 			}
 			ai, isRunning := mutableState.GetActivityInfo(scheduleID)
@@ -86,11 +186,14 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 					tag.WorkflowScheduleID(scheduleID),
 					tag.WorkflowNextEventID(mutableState.GetNextEventID()),
 				)
-				return workflow.ErrStaleState
+				return nil, workflow.ErrStaleState
 			}
 
 			if !isRunning || ai.StartedID == common.EmptyEventID ||
 				(token.ScheduleID != common.EmptyEventID && token.ScheduleAttempt != int64(ai.Attempt)) {
+				if action, ok := duplicateAction(); ok {
+					return action, nil
+				}
 				e.logger.Warn(fmt.Sprintf(
 					"Encounter non existing activity in RecordActivityTaskCompleted: isRunning: %t, ai: %#v, token: %#v.",
 					isRunning, ai, token),
@@ -100,17 +203,96 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 					tag.WorkflowScheduleID(scheduleID),
 					tag.WorkflowNextEventID(mutableState.GetNextEventID()),
 				)
-				return workflow.ErrActivityTaskNotFound
+				tokenFailureCause = getActivityTokenFailureCause(isRunning)
+				return nil, workflow.ErrActivityTaskNotFound
 			}
 
-			if _, err := mutableState.AddActivityTaskCompletedEvent(scheduleID, ai.StartedID, request); err != nil {
+			rejectErr, err0 = e.validateActivityResult(ctx, mutableState, domainName, scheduleID, request.Result)
+			if err0 != nil {
+				return nil, err0
+			}
+			if rejectErr != nil {
+				failedRequest := &types.RespondActivityTaskFailedRequest{
+					Reason:   common.StringPtr(common.FailureReasonActivityResultRejected),
+					Details:  []byte(rejectErr.Error()),
+					Identity: request.Identity,
+				}
+				postActions := &workflow.UpdateAction{}
+				ok, err := mutableState.RetryActivity(ai, failedRequest.GetReason(), failedRequest.GetDetails(), nil)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					if _, err := mutableState.AddActivityTaskFailedEvent(scheduleID, ai.StartedID, failedRequest); err != nil {
+						// Unable to add ActivityTaskFailed event to history
+						return nil, &types.InternalServiceError{Message: "Unable to add ActivityTaskFailed event to history."}
+					}
+					postActions.CreateDecision = true
+				}
+				return postActions, nil
+			}
+
+			keyID, completedRequest, err := e.encryptActivityResult(ctx, ai, domainName, request)
+			if err != nil {
+				return nil, err
+			}
+			completedOptions := &execution.ActivityTaskCompletedOptions{}
+			if e.config.EnableActivityResultDedup(domainName) && len(request.Result) > 0 {
+				resultKey = &activityResultKey{
+					domainID:   domainID,
+					workflowID: mutableState.GetExecutionInfo().WorkflowID,
+					runID:      mutableState.GetExecutionInfo().RunID,
+					resultHash: sha256.Sum256(append([]byte(keyID+"\x00"), request.Result...)),
+				}
+				resultScheduleID = scheduleID
+				if referenceID, ok := e.activityResultCache.Get(*resultKey).(int64); ok {
+					completedOptions.ResultReferenceScheduledEventID = referenceID
+					resultKey = nil
+				}
+			}
+			if completedOptions.ResultReferenceScheduledEventID == 0 {
+				completedOptions.ResultBlobKey, err = e.archiveActivityResult(ctx, domainID, domainName, mutableState, scheduleID, completedRequest.Result)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if _, err := mutableState.AddActivityTaskCompletedEvent(scheduleID, ai.StartedID, completedRequest, completedOptions); err != nil {
 				// Unable to add ActivityTaskCompleted event to history
-				return &types.InternalServiceError{Message: "Unable to add ActivityTaskCompleted event to history."}
+				return nil, &types.InternalServiceError{Message: "Unable to add ActivityTaskCompleted event to history."}
 			}
 			activityStartedTime = ai.StartedTime
+			expectedResultSize = ai.ExpectedResultSizeBytes
 			taskList = ai.TaskList
-			return nil
+			activityAttempt = ai.Attempt
+			completedKeys = []completedActivityKey{
+				{
+					domainID:        domainID,
+					workflowID:      executionInfo.WorkflowID,
+					runID:           executionInfo.RunID,
+					scheduleID:      scheduleID,
+					scheduleAttempt: int64(ai.Attempt),
+				},
+				{
+					domainID:   domainID,
+					workflowID: executionInfo.WorkflowID,
+					runID:      executionInfo.RunID,
+					activityID: ai.ActivityID,
+					scheduleID: common.EmptyEventID,
+				},
+			}
+			return &workflow.UpdateAction{CreateDecision: true}, nil
 		})
+	e.recordActivityTokenValidationFailure(metrics.HistoryRespondActivityTaskCompletedScope, "RespondActivityTaskCompleted", domainID, domainName, token, tokenFailureCause, err)
+	if err == nil && rejectErr != nil {
+		e.metricsClient.Scope(metrics.HistoryRespondActivityTaskCompletedScope, metrics.DomainTag(domainName)).
+			IncCounter(metrics.ActivityResultRejectedCounter)
+		return &types.BadRequestError{Message: fmt.Sprintf("Activity result rejected: %v", rejectErr)}
+	}
+	if err == nil && expectedResultSize > 0 && int64(len(request.Result)) > expectedResultSize {
+		// the hint is advisory, the result was handled on its actual size
+		e.metricsClient.Scope(metrics.HistoryRespondActivityTaskCompletedScope, metrics.DomainTag(domainName)).
+			IncCounter(metrics.ActivityResultSizeHintExceededCounter)
+	}
 	if err == nil && !activityStartedTime.IsZero() {
 		scope := e.metricsClient.Scope(metrics.HistoryRespondActivityTaskCompletedScope).
 			Tagged(
@@ -119,7 +301,115 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 				metrics.ActivityTypeTag(token.ActivityType),
 				metrics.TaskListTag(taskList),
 			)
-		scope.RecordTimer(metrics.ActivityE2ELatency, time.Since(activityStartedTime))
+		latency := time.Since(activityStartedTime)
+		scope.RecordTimer(metrics.ActivityE2ELatency, latency)
+		scope.Tagged(metrics.ActivityRetryTag(activityAttempt > 0)).
+			RecordHistogramDuration(metrics.ActivityExecutionLatencyHistogram, latency)
+	}
+	if err == nil && dedupWindow > 0 {
+		for _, key := range completedKeys {
+			e.completedActivityCache.Put(key, e.timeSource.Now())
+		}
+	}
+	if err == nil && resultKey != nil {
+		e.activityResultCache.Put(*resultKey, resultScheduleID)
 	}
 	return err
 }
+
+// isDuplicateActivityCompletion returns whether the activity identified by the key was completed within the dedup window
+func (e *historyEngineImpl) isDuplicateActivityCompletion(key completedActivityKey, dedupWindow time.Duration) bool {
+	if dedupWindow <= 0 {
+		return false
+	}
+	completedTime, ok := e.completedActivityCache.Get(key).(time.Time)
+	return ok && e.timeSource.Now().Sub(completedTime) < dedupWindow
+}
+
+// validateActivityResult returns the validator's rejection of the result, if the activity type is
+// configured for validation and the validator rejects it
+func (e *historyEngineImpl) validateActivityResult(
+	ctx context.Context,
+	mutableState execution.MutableState,
+	domainName string,
+	scheduleID int64,
+	result []byte,
+) (rejection error, err error) {
+
+	validatedTypes := e.config.ActivityResultValidation(dynamicconfig.DomainFilter(domainName))
+	if len(validatedTypes) == 0 {
+		return nil, nil
+	}
+	scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	activityType := scheduledEvent.ActivityTaskScheduledEventAttributes.ActivityType.GetName()
+	validate, _ := validatedTypes[activityType].(bool)
+	if !validate {
+		validate, _ = validatedTypes["*"].(bool)
+	}
+	if !validate {
+		return nil, nil
+	}
+	return e.activityResultValidator.Validate(ctx, &activityvalidator.Request{
+		DomainName:   domainName,
+		WorkflowType: mutableState.GetExecutionInfo().WorkflowTypeName,
+		ActivityType: activityType,
+		Result:       result,
+	}), nil
+}
+
+// encryptActivityResult returns the key the activity was scheduled with, as recorded on its activity info, and the
+// request with its result encrypted with that key
+func (e *historyEngineImpl) encryptActivityResult(
+	ctx context.Context,
+	ai *persistence.ActivityInfo,
+	domainName string,
+	request *types.RespondActivityTaskCompletedRequest,
+) (string, *types.RespondActivityTaskCompletedRequest, error) {
+
+	keyID := ai.EncryptionKeyID
+	if keyID == "" || len(request.Result) == 0 {
+		return keyID, request, nil
+	}
+	result, err := e.activityPayloadEncryptor.Encrypt(ctx, &activityencryption.Request{
+		DomainName: domainName,
+		KeyID:      keyID,
+		Payload:    request.Result,
+	})
+	if err != nil {
+		return "", nil, &types.InternalServiceError{Message: "Unable to encrypt activity result: " + err.Error()}
+	}
+	encryptedRequest := *request
+	encryptedRequest.Result = result
+	return keyID, &encryptedRequest, nil
+}
+
+// archiveActivityResult writes the result of a completed activity to the blobstore if it is larger than the archival
+// threshold of the domain, and returns the key of the blob for the completed event to record instead of the result.
+// The key is derived from the activity, so writing it again when the update of the workflow is retried is harmless.
+func (e *historyEngineImpl) archiveActivityResult(
+	ctx context.Context,
+	domainID string,
+	domainName string,
+	mutableState execution.MutableState,
+	scheduleID int64,
+	result []byte,
+) (string, error) {
+
+	threshold := e.config.ActivityResultArchivalThreshold(domainName)
+	blobstoreClient := e.shard.GetService().GetBlobstoreClient()
+	if threshold <= 0 || len(result) <= threshold || blobstoreClient == nil {
+		return "", nil
+	}
+	executionInfo := mutableState.GetExecutionInfo()
+	key := fmt.Sprintf("%v/%v/%v/%v/%v", activityResultBlobKeyPrefix, domainID, executionInfo.WorkflowID, executionInfo.RunID, scheduleID)
+	if _, err := blobstoreClient.Put(ctx, &blobstore.PutRequest{
+		Key:  key,
+		Blob: blobstore.Blob{Body: result},
+	}); err != nil {
+		return "", &types.InternalServiceError{Message: "Unable to archive activity result: " + err.Error()}
+	}
+	return key, nil
+}
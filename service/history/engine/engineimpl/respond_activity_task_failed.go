@@ -50,6 +50,8 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 	request := req.FailedRequest
 	token, err0 := e.tokenSerializer.Deserialize(request.TaskToken)
 	if err0 != nil {
+		e.recordActivityTokenValidationFailure(metrics.HistoryRespondActivityTaskFailedScope, "RespondActivityTaskFailed", domainID, domainName, nil,
+			types.ActivityTokenValidationFailureCauseMalformed.Ptr(), nil)
 		return workflow.ErrDeserializingToken
 	}
 
@@ -60,6 +62,7 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 
 	var activityStartedTime time.Time
 	var taskList string
+	var tokenFailureCause *types.ActivityTokenValidationFailureCause
 	err = workflow.UpdateWithActionFunc(
 		ctx,
 		e.executionCache,
@@ -68,6 +71,7 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 		e.timeSource.Now(),
 		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
 			if !mutableState.IsWorkflowExecutionRunning() {
+				tokenFailureCause = types.ActivityTokenValidationFailureCauseWrongRun.Ptr()
 				return nil, workflow.ErrAlreadyCompleted
 			}
 
@@ -75,6 +79,9 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 			if scheduleID == common.EmptyEventID { // client call CompleteActivityById, so get scheduleID by activityID
 				scheduleID, err0 = getScheduleID(token.ActivityID, mutableState)
 				if err0 != nil {
+					if token.ActivityID != "" {
+						tokenFailureCause = types.ActivityTokenValidationFailureCauseAlreadyCompleted.Ptr()
+					}
 					return nil, err0
 				}
 			}
@@ -105,11 +112,12 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 					tag.WorkflowScheduleID(scheduleID),
 					tag.WorkflowNextEventID(mutableState.GetNextEventID()),
 				)
+				tokenFailureCause = getActivityTokenFailureCause(isRunning)
 				return nil, workflow.ErrActivityTaskNotFound
 			}
 
 			postActions := &workflow.UpdateAction{}
-			ok, err := mutableState.RetryActivity(ai, req.FailedRequest.GetReason(), req.FailedRequest.GetDetails())
+			ok, err := mutableState.RetryActivity(ai, req.FailedRequest.GetReason(), req.FailedRequest.GetDetails(), req.FailedRequest.FailureCategory)
 			if err != nil {
 				return nil, err
 			}
@@ -127,6 +135,7 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 			return postActions, nil
 		},
 	)
+	e.recordActivityTokenValidationFailure(metrics.HistoryRespondActivityTaskFailedScope, "RespondActivityTaskFailed", domainID, domainName, token, tokenFailureCause, err)
 	if err == nil && !activityStartedTime.IsZero() {
 		scope := e.metricsClient.Scope(metrics.HistoryRespondActivityTaskFailedScope).
 			Tagged(
@@ -136,6 +145,8 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 				metrics.TaskListTag(taskList),
 			)
 		scope.RecordTimer(metrics.ActivityE2ELatency, time.Since(activityStartedTime))
+		scope.Tagged(metrics.ActivityFailureCategoryTag(req.FailedRequest.GetFailureCategory().String())).
+			IncCounter(metrics.ActivityFailedPerCategoryCounter)
 	}
 	return err
 }
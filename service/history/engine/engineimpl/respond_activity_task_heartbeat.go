@@ -24,10 +24,12 @@ package engineimpl
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/workflow"
@@ -37,6 +39,16 @@ import (
 // This method can be used for two purposes.
 // - For reporting liveness of the activity.
 // - For reporting progress of the activity, this can be done even if the liveness is not configured.
+// A request with RenewLeaseOnly set only reports liveness and keeps the stored details.
+//
+// For an activity scheduled with CancellationCheckpointGraceSeconds, the first heartbeat response reporting
+// CancelRequested starts a wait for one more heartbeat, which carries the final checkpoint of the worker. That
+// heartbeat records ActivityTaskCanceled with its details right away. The wait is bounded by the grace: once it
+// expires, the timer queue records ActivityTaskCanceled with the last details history has instead.
+//
+// A heartbeat of an activity whose workflow run is already closed fails with WorkflowExecutionAlreadyCompletedError.
+// With EnableActivityHeartbeatWorkflowCloseStatus it succeeds without being recorded instead, the response reports
+// the close status of the run and requests the cancellation of the activity, which can no longer be completed.
 func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	req *types.HistoryRecordActivityTaskHeartbeatRequest,
@@ -51,6 +63,8 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 	request := req.HeartbeatRequest
 	token, err0 := e.tokenSerializer.Deserialize(request.TaskToken)
 	if err0 != nil {
+		e.recordActivityTokenValidationFailure(metrics.HistoryRecordActivityTaskHeartbeatScope, "RecordActivityTaskHeartbeat", domainID, domainEntry.GetInfo().Name, nil,
+			types.ActivityTokenValidationFailureCauseMalformed.Ptr(), nil)
 		return nil, workflow.ErrDeserializingToken
 	}
 
@@ -60,18 +74,38 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 	}
 
 	var cancelRequested bool
-	err = workflow.UpdateWithAction(ctx, e.executionCache, domainID, workflowExecution, false, e.timeSource.Now(),
-		func(wfContext execution.Context, mutableState execution.MutableState) error {
+	var signals []*types.ActivitySignal
+	var startToCloseDeadline *int64
+	var cancelDeadline *int64
+	var persistedSequence *int64
+	var yieldRequested bool
+	var heartbeatGap time.Duration
+	var taskList string
+	var workflowCloseStatus *types.WorkflowExecutionCloseStatus
+	var tokenFailureCause *types.ActivityTokenValidationFailureCause
+	err = workflow.UpdateWithActionFunc(ctx, e.executionCache, domainID, workflowExecution, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
 			if !mutableState.IsWorkflowExecutionRunning() {
-				e.logger.Debug("Heartbeat failed")
-				return workflow.ErrAlreadyCompleted
+				if !e.config.EnableActivityHeartbeatWorkflowCloseStatus(domainEntry.GetInfo().Name) {
+					e.logger.Debug("Heartbeat failed")
+					// the token is of the right run, the activity is just not pending anymore as the run is closed
+					tokenFailureCause = types.ActivityTokenValidationFailureCauseAlreadyCompleted.Ptr()
+					return nil, workflow.ErrAlreadyCompleted
+				}
+				e.logger.Debug("Heartbeat of an activity of a closed workflow")
+				workflowCloseStatus = persistence.ToInternalWorkflowExecutionCloseStatus(mutableState.GetExecutionInfo().CloseStatus)
+				cancelRequested = true
+				return &workflow.UpdateAction{Noop: true}, nil
 			}
 
 			scheduleID := token.ScheduleID
 			if scheduleID == common.EmptyEventID { // client call RecordActivityHeartbeatByID, so get scheduleID by activityID
 				scheduleID, err0 = getScheduleID(token.ActivityID, mutableState)
 				if err0 != nil {
-					return err0
+					if token.ActivityID != "" {
+						tokenFailureCause = types.ActivityTokenValidationFailureCauseAlreadyCompleted.Ptr()
+					}
+					return nil, err0
 				}
 			}
 			ai, isRunning := mutableState.GetActivityInfo(scheduleID)
@@ -87,7 +121,7 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 					tag.WorkflowScheduleID(scheduleID),
 					tag.WorkflowNextEventID(mutableState.GetNextEventID()),
 				)
-				return workflow.ErrStaleState
+				return nil, workflow.ErrStaleState
 			}
 
 			if !isRunning || ai.StartedID == common.EmptyEventID ||
@@ -102,23 +136,166 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 					tag.WorkflowNextEventID(mutableState.GetNextEventID()),
 				)
 
-				return workflow.ErrActivityTaskNotFound
+				tokenFailureCause = getActivityTokenFailureCause(isRunning)
+				return nil, workflow.ErrActivityTaskNotFound
 			}
 
 			cancelRequested = ai.CancelRequested
+			if cancelRequested {
+				cancelDeadline = common.Int64Ptr(e.getActivityCancelDeadline(domainEntry.GetInfo().Name, ai).UnixNano())
+			}
 
 			e.logger.Debug(fmt.Sprintf("Activity HeartBeat: scheduleEventID: %v, ActivityInfo: %+v, CancelRequested: %v",
 				scheduleID, ai, cancelRequested))
 
+			if ai.PrefetchLeased {
+				// the first heartbeat of a prefetched activity acknowledges that the worker started executing it,
+				// StartToClose restarts from now and the timers are recreated together with the heartbeat timer
+				ai.PrefetchLeased = false
+				ai.StartedTime = e.timeSource.Now()
+				ai.TimerTaskStatus &^= execution.TimerTaskStatusCreatedStartToClose | execution.TimerTaskStatusCreatedHeartbeat
+			}
+
+			if requestedTimeout := request.GetRequestedStartToCloseTimeoutSeconds(); requestedTimeout > 0 {
+				deadline, err := e.extendActivityStartToCloseTimeout(domainEntry.GetInfo().Name, ai, requestedTimeout)
+				if err != nil {
+					return nil, err
+				}
+				startToCloseDeadline = common.Int64Ptr(deadline.UnixNano())
+			}
+
+			// Hand over buffered activity signals, they are removed from mutable state together with the progress update.
+			signals = ai.PendingSignals
+			ai.PendingSignals = nil
+
+			// A heartbeat carrying an older sequence than the details already stored still reports liveness,
+			// but must not overwrite the newer details. A lease renewal only reports liveness.
+			progress := request
+			if req.GetRenewLeaseOnly() {
+				progress = &types.RecordActivityTaskHeartbeatRequest{Details: ai.Details}
+			} else if request.HeartbeatSequence != nil {
+				if request.GetHeartbeatSequence() < ai.HeartbeatSequence {
+					progress = &types.RecordActivityTaskHeartbeatRequest{Details: ai.Details}
+				} else {
+					ai.HeartbeatSequence = request.GetHeartbeatSequence()
+				}
+				persistedSequence = common.Int64Ptr(ai.HeartbeatSequence)
+			}
+
 			// Save progress and last HB reported time.
-			mutableState.UpdateActivityProgress(ai, request)
+			lastHeartbeatTime := ai.LastHeartBeatUpdatedTime
+			mutableState.UpdateActivityProgress(ai, progress)
+			if !lastHeartbeatTime.IsZero() {
+				heartbeatGap = ai.LastHeartBeatUpdatedTime.Sub(lastHeartbeatTime)
+			}
+			taskList = ai.TaskList
+
+			postActions := &workflow.UpdateAction{}
+			if cancelRequested && ai.CancellationCheckpointGrace > 0 {
+				if ai.CancelDeliveredTime.IsZero() {
+					// this response delivers the cancellation, history now waits for one more heartbeat carrying
+					// the final checkpoint and the heartbeat timer is recreated with the bounded wait
+					ai.CancelDeliveredTime = e.timeSource.Now()
+					ai.TimerTaskStatus &^= execution.TimerTaskStatusCreatedHeartbeat
+				} else if !req.GetRenewLeaseOnly() {
+					// the final checkpoint is in, the activity is canceled on behalf of the worker
+					if _, err := mutableState.AddActivityTaskCanceledEvent(
+						scheduleID,
+						ai.StartedID,
+						ai.CancelRequestID,
+						execution.GetActivityHeartbeatDetails(ai, false),
+						request.Identity,
+					); err != nil {
+						return nil, &types.InternalServiceError{Message: "Unable to add ActivityTaskCanceled event to history."}
+					}
+					postActions.CreateDecision = true
+					return postActions, nil
+				}
+			}
 
-			return nil
+			// a degraded worker is only asked to give up the activity when the failure it reports gets retried,
+			// otherwise yielding would fail the activity for good
+			if request.GetWorkerHealth() == types.WorkerHealthDegraded &&
+				!e.config.DisableActivityRetries(domainEntry.GetInfo().Name) &&
+				execution.IsActivityRetryable(ai, e.timeSource.Now()) {
+				yieldRequested = true
+			}
+
+			return postActions, nil
 		})
+	e.recordActivityTokenValidationFailure(metrics.HistoryRecordActivityTaskHeartbeatScope, "RecordActivityTaskHeartbeat", domainID, domainEntry.GetInfo().Name, token, tokenFailureCause, err)
 
 	if err != nil {
 		return &types.RecordActivityTaskHeartbeatResponse{}, err
 	}
 
-	return &types.RecordActivityTaskHeartbeatResponse{CancelRequested: cancelRequested}, nil
+	if heartbeatGap > 0 {
+		e.metricsClient.Scope(metrics.HistoryRecordActivityTaskHeartbeatScope).
+			Tagged(
+				metrics.DomainTag(domainEntry.GetInfo().Name),
+				metrics.WorkflowTypeTag(token.WorkflowType),
+				metrics.ActivityTypeTag(token.ActivityType),
+				metrics.TaskListTag(taskList),
+			).
+			RecordTimer(metrics.ActivityHeartbeatGap, heartbeatGap)
+	}
+
+	return &types.RecordActivityTaskHeartbeatResponse{
+		CancelRequested:      cancelRequested,
+		CancelDeadline:       cancelDeadline,
+		Signals:              signals,
+		StartToCloseDeadline: startToCloseDeadline,
+		PersistedSequence:    persistedSequence,
+		YieldRequested:       yieldRequested,
+		WorkflowCloseStatus:  workflowCloseStatus,
+	}, nil
+}
+
+// getActivityCancelDeadline returns the time by which the worker is expected to have canceled the activity, the
+// cancellation grace period of the domain after the cancellation was requested. It is never later than the time
+// a forced cancellation records ActivityTaskCanceled. The time of the request is not kept across mutable state
+// reloads, the grace period then starts with the heartbeat.
+func (e *historyEngineImpl) getActivityCancelDeadline(
+	domainName string,
+	ai *persistence.ActivityInfo,
+) time.Time {
+
+	requestedTime := ai.CancelRequestedTime
+	if requestedTime.IsZero() {
+		requestedTime = e.timeSource.Now()
+	}
+	deadline := requestedTime.Add(e.config.ActivityCancellationGracePeriod(domainName))
+	if ai.CancelForceTimeout > 0 {
+		forceTime := requestedTime.Add(time.Duration(ai.CancelForceTimeout) * time.Second)
+		if forceTime.Before(deadline) {
+			deadline = forceTime
+		}
+	}
+	return deadline
+}
+
+// extendActivityStartToCloseTimeout grants the StartToClose timeout requested by a heartbeat to the current attempt
+// of the activity and returns the resulting deadline. The request is capped by the ScheduleToClose timeout of the
+// activity and rejected if it is above the domain limit. A request below the timeout in effect does not shorten it.
+func (e *historyEngineImpl) extendActivityStartToCloseTimeout(
+	domainName string,
+	ai *persistence.ActivityInfo,
+	requestedTimeout int32,
+) (time.Time, error) {
+
+	maxTimeout := e.config.ActivityMaxStartToCloseTimeout(domainName)
+	if time.Duration(requestedTimeout)*time.Second > maxTimeout {
+		return time.Time{}, workflow.ErrActivityStartToCloseLimitExceeded
+	}
+	if ai.ScheduleToCloseTimeout > 0 && requestedTimeout > ai.ScheduleToCloseTimeout {
+		requestedTimeout = ai.ScheduleToCloseTimeout
+	}
+
+	if requestedTimeout > execution.GetActivityStartToCloseTimeout(ai) {
+		ai.GrantedStartToCloseTimeout = requestedTimeout
+		// clear the timer task mask so that the StartToClose timer is recreated with the new deadline,
+		// the timer created for the previous deadline is a no-op once it fires
+		ai.TimerTaskStatus &^= execution.TimerTaskStatusCreatedStartToClose
+	}
+	return ai.StartedTime.Add(time.Duration(execution.GetActivityStartToCloseTimeout(ai)) * time.Second), nil
 }
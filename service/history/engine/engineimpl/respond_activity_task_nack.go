@@ -0,0 +1,150 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package engineimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// RespondActivityTaskNack returns a started activity task to its task list, for a worker which cannot
+// process it right now. The attempt is not counted against the retry policy, the nack reason is recorded
+// on the pending activity instead and shown by DescribeWorkflowExecution.
+//
+// Once the attempt was nacked ActivityMaxNacks times, a further nack fails the attempt with
+// FailureReasonActivityNackLimitExceeded, which is then retried as any other failure. Only activities with
+// a retry policy can be nacked, since the started event of any other activity is already in history.
+func (e *historyEngineImpl) RespondActivityTaskNack(
+	ctx context.Context,
+	req *types.HistoryRespondActivityTaskNackRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainByID(req.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+	domainName := domainEntry.GetInfo().Name
+
+	request := req.NackRequest
+	token, err0 := e.tokenSerializer.Deserialize(request.GetTaskToken())
+	if err0 != nil {
+		return workflow.ErrDeserializingToken
+	}
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: token.WorkflowID,
+		RunID:      token.RunID,
+	}
+
+	return workflow.UpdateWithActionFunc(
+		ctx,
+		e.executionCache,
+		domainID,
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			scheduleID := token.ScheduleID
+			if scheduleID == common.EmptyEventID { // client call NackActivityById, so get scheduleID by activityID
+				scheduleID, err0 = getScheduleID(token.ActivityID, mutableState)
+				if err0 != nil {
+					return nil, err0
+				}
+			}
+			ai, isRunning := mutableState.GetActivityInfo(scheduleID)
+
+			// First check to see if cache needs to be refreshed as we could potentially have stale workflow execution in
+			// some extreme cassandra failure cases.
+			if !isRunning && scheduleID >= mutableState.GetNextEventID() {
+				e.logger.Error("Encounter stale mutable state in RespondActivityTaskNack",
+					tag.WorkflowDomainName(domainName),
+					tag.WorkflowID(workflowExecution.GetWorkflowID()),
+					tag.WorkflowRunID(workflowExecution.GetRunID()),
+					tag.WorkflowScheduleID(scheduleID),
+					tag.WorkflowNextEventID(mutableState.GetNextEventID()),
+				)
+				return nil, workflow.ErrStaleState
+			}
+
+			if !isRunning || ai.StartedID == common.EmptyEventID ||
+				(token.ScheduleID != common.EmptyEventID && token.ScheduleAttempt != int64(ai.Attempt)) {
+				e.logger.Warn(fmt.Sprintf(
+					"Encounter non existing activity in RespondActivityTaskNack: isRunning: %t, ai: %#v, token: %#v.",
+					isRunning, ai, token),
+					tag.WorkflowDomainName(domainName),
+					tag.WorkflowID(workflowExecution.GetWorkflowID()),
+					tag.WorkflowRunID(workflowExecution.GetRunID()),
+					tag.WorkflowScheduleID(scheduleID),
+					tag.WorkflowNextEventID(mutableState.GetNextEventID()),
+				)
+				return nil, workflow.ErrActivityTaskNotFound
+			}
+
+			if !ai.HasRetryPolicy {
+				return nil, &types.BadRequestError{Message: "Only activities with a retry policy can be nacked."}
+			}
+
+			if int(ai.NackCount) < e.config.ActivityMaxNacks(domainName) {
+				if err := mutableState.NackActivity(ai, request.GetReason()); err != nil {
+					return nil, err
+				}
+				return &workflow.UpdateAction{}, nil
+			}
+
+			e.logger.Warn("Activity attempt nacked too many times, failing the attempt",
+				tag.WorkflowDomainName(domainName),
+				tag.WorkflowID(workflowExecution.GetWorkflowID()),
+				tag.WorkflowRunID(workflowExecution.GetRunID()),
+				tag.WorkflowScheduleID(scheduleID),
+				tag.Attempt(ai.Attempt),
+			)
+			failedRequest := &types.RespondActivityTaskFailedRequest{
+				Reason:   common.StringPtr(common.FailureReasonActivityNackLimitExceeded),
+				Details:  []byte(request.GetReason()),
+				Identity: request.GetIdentity(),
+			}
+			postActions := &workflow.UpdateAction{}
+			ok, err := mutableState.RetryActivity(ai, failedRequest.GetReason(), failedRequest.GetDetails(), nil)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				if _, err := mutableState.AddActivityTaskFailedEvent(scheduleID, ai.StartedID, failedRequest); err != nil {
+					// Unable to add ActivityTaskFailed event to history
+					return nil, &types.InternalServiceError{Message: "Unable to add ActivityTaskFailed event to history."}
+				}
+				postActions.CreateDecision = true
+			}
+			return postActions, nil
+		},
+	)
+}
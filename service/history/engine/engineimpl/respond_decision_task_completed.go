@@ -24,10 +24,47 @@ package engineimpl
 import (
 	"context"
 
+	"github.com/uber/cadence/common/activityencryption"
 	"github.com/uber/cadence/common/types"
 )
 
-// RespondDecisionTaskCompleted completes a decision task
+// RespondDecisionTaskCompleted completes a decision task.
+// The input of the activities scheduled with an EncryptionKeyID is encrypted before the decisions are handled.
 func (e *historyEngineImpl) RespondDecisionTaskCompleted(ctx context.Context, req *types.HistoryRespondDecisionTaskCompletedRequest) (*types.HistoryRespondDecisionTaskCompletedResponse, error) {
+	if err := e.encryptActivityInputs(ctx, req); err != nil {
+		return nil, err
+	}
 	return e.decisionHandler.HandleDecisionTaskCompleted(ctx, req)
 }
+
+func (e *historyEngineImpl) encryptActivityInputs(ctx context.Context, req *types.HistoryRespondDecisionTaskCompletedRequest) error {
+	if req.GetCompleteRequest() == nil {
+		return nil
+	}
+	var domainName string
+	for _, decision := range req.CompleteRequest.Decisions {
+		if decision.GetDecisionType() != types.DecisionTypeScheduleActivityTask {
+			continue
+		}
+		attributes := decision.ScheduleActivityTaskDecisionAttributes
+		if attributes.GetEncryptionKeyID() == "" || len(attributes.Input) == 0 {
+			continue
+		}
+		if domainName == "" {
+			var err error
+			if domainName, err = e.shard.GetDomainCache().GetDomainName(req.DomainUUID); err != nil {
+				return err
+			}
+		}
+		input, err := e.activityPayloadEncryptor.Encrypt(ctx, &activityencryption.Request{
+			DomainName: domainName,
+			KeyID:      attributes.EncryptionKeyID,
+			Payload:    attributes.Input,
+		})
+		if err != nil {
+			return &types.InternalServiceError{Message: "Unable to encrypt activity input: " + err.Error()}
+		}
+		attributes.Input = input
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// SignalActivity buffers a signal for a pending activity. Buffered signals are handed to the worker,
+// in the order they were accepted, as part of the next RecordActivityTaskHeartbeat response.
+//
+// Delivery is at most once: signals live in the cached mutable state only, so they are dropped if the
+// activity completes, fails, times out or is retried before heartbeating, and also if the workflow
+// is evicted from the history cache or its shard moves to another host.
+func (e *historyEngineImpl) SignalActivity(
+	ctx context.Context,
+	request *types.HistorySignalActivityRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainByID(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
+		return errDomainDeprecated
+	}
+	domainID := domainEntry.GetInfo().ID
+	domainName := domainEntry.GetInfo().Name
+
+	signalRequest := request.SignalRequest
+	if signalRequest.GetActivityID() == "" {
+		return &types.BadRequestError{Message: "ActivityID is not set on request."}
+	}
+	if signalRequest.GetSignalName() == "" {
+		return &types.BadRequestError{Message: "SignalName is not set on request."}
+	}
+	if signalRequest.WorkflowExecution == nil {
+		return &types.BadRequestError{Message: "WorkflowExecution is not set on request."}
+	}
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: signalRequest.WorkflowExecution.GetWorkflowID(),
+		RunID:      signalRequest.WorkflowExecution.GetRunID(),
+	}
+
+	return workflow.UpdateWithAction(ctx, e.executionCache, domainID, workflowExecution, false, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) error {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return workflow.ErrAlreadyCompleted
+			}
+
+			ai, ok := mutableState.GetActivityByActivityID(signalRequest.GetActivityID())
+			if !ok {
+				return workflow.ErrActivityTaskNotFound
+			}
+
+			maxPendingSignals := e.config.MaximumPendingSignalsPerActivity(domainName)
+			if maxPendingSignals > 0 && len(ai.PendingSignals) >= maxPendingSignals {
+				e.logger.Info("Execution limit reached for maximum pending activity signals",
+					tag.WorkflowDomainName(domainName),
+					tag.WorkflowID(workflowExecution.GetWorkflowID()),
+					tag.WorkflowRunID(workflowExecution.GetRunID()),
+					tag.WorkflowScheduleID(ai.ScheduleID),
+				)
+				return workflow.ErrActivitySignalsLimitExceeded
+			}
+
+			ai.PendingSignals = append(ai.PendingSignals, &types.ActivitySignal{
+				SignalName: signalRequest.GetSignalName(),
+				Input:      signalRequest.GetInput(),
+				Identity:   signalRequest.GetIdentity(),
+				Timestamp:  common.Int64Ptr(e.timeSource.Now().UnixNano()),
+			})
+			return mutableState.UpdateActivity(ai)
+		})
+}
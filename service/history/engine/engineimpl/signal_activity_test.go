@@ -0,0 +1,155 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	ctx "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine/testdata"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+func TestSignalActivity(t *testing.T) {
+	execution := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	newRequest := func(activityID string) *types.HistorySignalActivityRequest {
+		return &types.HistorySignalActivityRequest{
+			DomainUUID: constants.TestDomainID,
+			SignalRequest: &types.SignalActivityRequest{
+				Domain:            constants.TestDomainName,
+				WorkflowExecution: execution,
+				ActivityID:        activityID,
+				SignalName:        "pause",
+				Input:             []byte("input"),
+				Identity:          "operator",
+			},
+		}
+	}
+	mutableState := func(state int, pendingSignals []*types.ActivitySignal) *persistence.WorkflowMutableState {
+		return &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				DomainID:   constants.TestDomainID,
+				WorkflowID: execution.WorkflowID,
+				RunID:      execution.RunID,
+				State:      state,
+			},
+			ActivityInfos: map[int64]*persistence.ActivityInfo{
+				5: {
+					ScheduleID:     5,
+					StartedID:      6,
+					ActivityID:     "activity",
+					DomainID:       constants.TestDomainID,
+					PendingSignals: pendingSignals,
+				},
+			},
+			ExecutionStats: &persistence.ExecutionStats{},
+			Checksum:       checksum.Checksum{},
+		}
+	}
+	cases := []struct {
+		name        string
+		request     *types.HistorySignalActivityRequest
+		init        func(engine *testdata.EngineForTest)
+		expectedErr error
+	}{
+		{
+			name:        "Missing ActivityID",
+			request:     newRequest(""),
+			expectedErr: &types.BadRequestError{Message: "ActivityID is not set on request."},
+		},
+		{
+			name:    "Completed Workflow",
+			request: newRequest("activity"),
+			init: func(engine *testdata.EngineForTest) {
+				engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&persistence.GetWorkflowExecutionResponse{State: mutableState(persistence.WorkflowStateCompleted, nil)}, nil)
+			},
+			expectedErr: workflow.ErrAlreadyCompleted,
+		},
+		{
+			name:    "Activity Not Found",
+			request: newRequest("unknown"),
+			init: func(engine *testdata.EngineForTest) {
+				engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&persistence.GetWorkflowExecutionResponse{State: mutableState(persistence.WorkflowStateRunning, nil)}, nil)
+			},
+			expectedErr: workflow.ErrActivityTaskNotFound,
+		},
+		{
+			name:    "Pending Signals Limit Exceeded",
+			request: newRequest("activity"),
+			init: func(engine *testdata.EngineForTest) {
+				engine.ShardCtx.GetConfig().MaximumPendingSignalsPerActivity = dynamicconfig.GetIntPropertyFilteredByDomain(1)
+				engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&persistence.GetWorkflowExecutionResponse{
+						State: mutableState(persistence.WorkflowStateRunning, []*types.ActivitySignal{{SignalName: "speedup"}}),
+					}, nil)
+			},
+			expectedErr: workflow.ErrActivitySignalsLimitExceeded,
+		},
+		{
+			name:    "Success",
+			request: newRequest("activity"),
+			init: func(engine *testdata.EngineForTest) {
+				engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&persistence.GetWorkflowExecutionResponse{
+						State: mutableState(persistence.WorkflowStateRunning, []*types.ActivitySignal{{SignalName: "speedup"}}),
+					}, nil)
+				engine.ShardCtx.Resource.ExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.UpdateWorkflowExecutionRequest) bool {
+					activityInfos := req.UpdateWorkflowMutation.UpsertActivityInfos
+					return len(activityInfos) == 1 &&
+						len(activityInfos[0].PendingSignals) == 2 &&
+						activityInfos[0].PendingSignals[0].SignalName == "speedup" &&
+						activityInfos[0].PendingSignals[1].SignalName == "pause" &&
+						activityInfos[0].PendingSignals[1].Identity == "operator"
+				})).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+
+			if testCase.init != nil {
+				testCase.init(eft)
+			}
+			eft.Engine.Start()
+			err := eft.Engine.SignalActivity(ctx.Background(), testCase.request)
+			eft.Engine.Stop()
+
+			assert.Equal(t, testCase.expectedErr, err)
+		})
+	}
+}
@@ -48,14 +48,28 @@ type (
 		RespondDecisionTaskFailed(ctx context.Context, request *types.HistoryRespondDecisionTaskFailedRequest) error
 		RespondActivityTaskCompleted(ctx context.Context, request *types.HistoryRespondActivityTaskCompletedRequest) error
 		RespondActivityTaskFailed(ctx context.Context, request *types.HistoryRespondActivityTaskFailedRequest) error
+		RespondActivityTaskNack(ctx context.Context, request *types.HistoryRespondActivityTaskNackRequest) error
 		RespondActivityTaskCanceled(ctx context.Context, request *types.HistoryRespondActivityTaskCanceledRequest) error
 		RecordActivityTaskHeartbeat(ctx context.Context, request *types.HistoryRecordActivityTaskHeartbeatRequest) (*types.RecordActivityTaskHeartbeatResponse, error)
 		RequestCancelWorkflowExecution(ctx context.Context, request *types.HistoryRequestCancelWorkflowExecutionRequest) error
 		SignalWorkflowExecution(ctx context.Context, request *types.HistorySignalWorkflowExecutionRequest) error
+		SignalActivity(ctx context.Context, request *types.HistorySignalActivityRequest) error
+		ResetActivityAttempts(ctx context.Context, request *types.HistoryResetActivityAttemptsRequest) error
+		ExpireActivityHeartbeat(ctx context.Context, request *types.HistoryExpireActivityHeartbeatRequest) error
+		RedriveTimedOutActivities(ctx context.Context, request *types.HistoryRedriveTimedOutActivitiesRequest) error
+		CancelPendingActivities(ctx context.Context, request *types.HistoryCancelPendingActivitiesRequest) error
+		GetActivityEffectiveConfig(ctx context.Context, request *types.HistoryGetActivityEffectiveConfigRequest) (*types.GetActivityEffectiveConfigResponse, error)
+		GetActivityNextRetryTime(ctx context.Context, request *types.HistoryGetActivityNextRetryTimeRequest) (*types.GetActivityNextRetryTimeResponse, error)
+		GetActivityRescheduleReasons(ctx context.Context, request *types.HistoryGetActivityRescheduleReasonsRequest) (*types.GetActivityRescheduleReasonsResponse, error)
+		GetActivityWorkerHistory(ctx context.Context, request *types.HistoryGetActivityWorkerHistoryRequest) (*types.GetActivityWorkerHistoryResponse, error)
+		GetActivityAttemptTimings(ctx context.Context, request *types.HistoryGetActivityAttemptTimingsRequest) (*types.GetActivityAttemptTimingsResponse, error)
+		GetActivityRetryStats(ctx context.Context, request *types.HistoryGetActivityRetryStatsRequest) (*types.GetActivityRetryStatsResponse, error)
+		GetActivityTokenValidationFailures(ctx context.Context, request *types.HistoryGetActivityTokenValidationFailuresRequest) (*types.GetActivityTokenValidationFailuresResponse, error)
 		SignalWithStartWorkflowExecution(ctx context.Context, request *types.HistorySignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
 		RemoveSignalMutableState(ctx context.Context, request *types.RemoveSignalMutableStateRequest) error
 		TerminateWorkflowExecution(ctx context.Context, request *types.HistoryTerminateWorkflowExecutionRequest) error
 		ResetWorkflowExecution(ctx context.Context, request *types.HistoryResetWorkflowExecutionRequest) (*types.ResetWorkflowExecutionResponse, error)
+		ReplayActivityCompletions(ctx context.Context, request *types.HistoryReplayActivityCompletionsRequest) (*types.ReplayActivityCompletionsResponse, error)
 		ScheduleDecisionTask(ctx context.Context, request *types.ScheduleDecisionTaskRequest) error
 		RecordChildExecutionCompleted(ctx context.Context, request *types.RecordChildExecutionCompletedRequest) error
 		ReplicateEventsV2(ctx context.Context, request *types.ReplicateEventsV2Request) error
@@ -66,6 +66,20 @@ func (m *MockEngine) EXPECT() *MockEngineMockRecorder {
 	return m.recorder
 }
 
+// CancelPendingActivities mocks base method.
+func (m *MockEngine) CancelPendingActivities(ctx context.Context, request *types.HistoryCancelPendingActivitiesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPendingActivities", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelPendingActivities indicates an expected call of CancelPendingActivities.
+func (mr *MockEngineMockRecorder) CancelPendingActivities(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPendingActivities", reflect.TypeOf((*MockEngine)(nil).CancelPendingActivities), ctx, request)
+}
+
 // CountDLQMessages mocks base method.
 func (m *MockEngine) CountDLQMessages(ctx context.Context, forceFetch bool) (map[string]int64, error) {
 	m.ctrl.T.Helper()
@@ -141,6 +155,125 @@ func (mr *MockEngineMockRecorder) DescribeWorkflowExecution(ctx, request any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).DescribeWorkflowExecution), ctx, request)
 }
 
+// ExpireActivityHeartbeat mocks base method.
+func (m *MockEngine) ExpireActivityHeartbeat(ctx context.Context, request *types.HistoryExpireActivityHeartbeatRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireActivityHeartbeat", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireActivityHeartbeat indicates an expected call of ExpireActivityHeartbeat.
+func (mr *MockEngineMockRecorder) ExpireActivityHeartbeat(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireActivityHeartbeat", reflect.TypeOf((*MockEngine)(nil).ExpireActivityHeartbeat), ctx, request)
+}
+
+// GetActivityAttemptTimings mocks base method.
+func (m *MockEngine) GetActivityAttemptTimings(ctx context.Context, request *types.HistoryGetActivityAttemptTimingsRequest) (*types.GetActivityAttemptTimingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityAttemptTimings", ctx, request)
+	ret0, _ := ret[0].(*types.GetActivityAttemptTimingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityAttemptTimings indicates an expected call of GetActivityAttemptTimings.
+func (mr *MockEngineMockRecorder) GetActivityAttemptTimings(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityAttemptTimings", reflect.TypeOf((*MockEngine)(nil).GetActivityAttemptTimings), ctx, request)
+}
+
+// GetActivityEffectiveConfig mocks base method.
+func (m *MockEngine) GetActivityEffectiveConfig(ctx context.Context, request *types.HistoryGetActivityEffectiveConfigRequest) (*types.GetActivityEffectiveConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityEffectiveConfig", ctx, request)
+	ret0, _ := ret[0].(*types.GetActivityEffectiveConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityEffectiveConfig indicates an expected call of GetActivityEffectiveConfig.
+func (mr *MockEngineMockRecorder) GetActivityEffectiveConfig(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityEffectiveConfig", reflect.TypeOf((*MockEngine)(nil).GetActivityEffectiveConfig), ctx, request)
+}
+
+// GetActivityNextRetryTime mocks base method.
+func (m *MockEngine) GetActivityNextRetryTime(ctx context.Context, request *types.HistoryGetActivityNextRetryTimeRequest) (*types.GetActivityNextRetryTimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityNextRetryTime", ctx, request)
+	ret0, _ := ret[0].(*types.GetActivityNextRetryTimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityNextRetryTime indicates an expected call of GetActivityNextRetryTime.
+func (mr *MockEngineMockRecorder) GetActivityNextRetryTime(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityNextRetryTime", reflect.TypeOf((*MockEngine)(nil).GetActivityNextRetryTime), ctx, request)
+}
+
+// GetActivityRescheduleReasons mocks base method.
+func (m *MockEngine) GetActivityRescheduleReasons(ctx context.Context, request *types.HistoryGetActivityRescheduleReasonsRequest) (*types.GetActivityRescheduleReasonsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityRescheduleReasons", ctx, request)
+	ret0, _ := ret[0].(*types.GetActivityRescheduleReasonsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityRescheduleReasons indicates an expected call of GetActivityRescheduleReasons.
+func (mr *MockEngineMockRecorder) GetActivityRescheduleReasons(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityRescheduleReasons", reflect.TypeOf((*MockEngine)(nil).GetActivityRescheduleReasons), ctx, request)
+}
+
+// GetActivityRetryStats mocks base method.
+func (m *MockEngine) GetActivityRetryStats(ctx context.Context, request *types.HistoryGetActivityRetryStatsRequest) (*types.GetActivityRetryStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityRetryStats", ctx, request)
+	ret0, _ := ret[0].(*types.GetActivityRetryStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityRetryStats indicates an expected call of GetActivityRetryStats.
+func (mr *MockEngineMockRecorder) GetActivityRetryStats(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityRetryStats", reflect.TypeOf((*MockEngine)(nil).GetActivityRetryStats), ctx, request)
+}
+
+// GetActivityTokenValidationFailures mocks base method.
+func (m *MockEngine) GetActivityTokenValidationFailures(ctx context.Context, request *types.HistoryGetActivityTokenValidationFailuresRequest) (*types.GetActivityTokenValidationFailuresResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityTokenValidationFailures", ctx, request)
+	ret0, _ := ret[0].(*types.GetActivityTokenValidationFailuresResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityTokenValidationFailures indicates an expected call of GetActivityTokenValidationFailures.
+func (mr *MockEngineMockRecorder) GetActivityTokenValidationFailures(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityTokenValidationFailures", reflect.TypeOf((*MockEngine)(nil).GetActivityTokenValidationFailures), ctx, request)
+}
+
+// GetActivityWorkerHistory mocks base method.
+func (m *MockEngine) GetActivityWorkerHistory(ctx context.Context, request *types.HistoryGetActivityWorkerHistoryRequest) (*types.GetActivityWorkerHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityWorkerHistory", ctx, request)
+	ret0, _ := ret[0].(*types.GetActivityWorkerHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityWorkerHistory indicates an expected call of GetActivityWorkerHistory.
+func (mr *MockEngineMockRecorder) GetActivityWorkerHistory(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityWorkerHistory", reflect.TypeOf((*MockEngine)(nil).GetActivityWorkerHistory), ctx, request)
+}
+
 // GetDLQReplicationMessages mocks base method.
 func (m *MockEngine) GetDLQReplicationMessages(ctx context.Context, taskInfos []*types.ReplicationTaskInfo) ([]*types.ReplicationTask, error) {
 	m.ctrl.T.Helper()
@@ -381,6 +514,20 @@ func (mr *MockEngineMockRecorder) RecordDecisionTaskStarted(ctx, request any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDecisionTaskStarted", reflect.TypeOf((*MockEngine)(nil).RecordDecisionTaskStarted), ctx, request)
 }
 
+// RedriveTimedOutActivities mocks base method.
+func (m *MockEngine) RedriveTimedOutActivities(ctx context.Context, request *types.HistoryRedriveTimedOutActivitiesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedriveTimedOutActivities", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedriveTimedOutActivities indicates an expected call of RedriveTimedOutActivities.
+func (mr *MockEngineMockRecorder) RedriveTimedOutActivities(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedriveTimedOutActivities", reflect.TypeOf((*MockEngine)(nil).RedriveTimedOutActivities), ctx, request)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockEngine) RefreshWorkflowTasks(ctx context.Context, domainUUID string, execution types.WorkflowExecution) error {
 	m.ctrl.T.Helper()
@@ -409,6 +556,21 @@ func (mr *MockEngineMockRecorder) RemoveSignalMutableState(ctx, request any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSignalMutableState", reflect.TypeOf((*MockEngine)(nil).RemoveSignalMutableState), ctx, request)
 }
 
+// ReplayActivityCompletions mocks base method.
+func (m *MockEngine) ReplayActivityCompletions(ctx context.Context, request *types.HistoryReplayActivityCompletionsRequest) (*types.ReplayActivityCompletionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayActivityCompletions", ctx, request)
+	ret0, _ := ret[0].(*types.ReplayActivityCompletionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayActivityCompletions indicates an expected call of ReplayActivityCompletions.
+func (mr *MockEngineMockRecorder) ReplayActivityCompletions(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayActivityCompletions", reflect.TypeOf((*MockEngine)(nil).ReplayActivityCompletions), ctx, request)
+}
+
 // ReplicateEventsV2 mocks base method.
 func (m *MockEngine) ReplicateEventsV2(ctx context.Context, request *types.ReplicateEventsV2Request) error {
 	m.ctrl.T.Helper()
@@ -437,6 +599,20 @@ func (mr *MockEngineMockRecorder) RequestCancelWorkflowExecution(ctx, request an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).RequestCancelWorkflowExecution), ctx, request)
 }
 
+// ResetActivityAttempts mocks base method.
+func (m *MockEngine) ResetActivityAttempts(ctx context.Context, request *types.HistoryResetActivityAttemptsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivityAttempts", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivityAttempts indicates an expected call of ResetActivityAttempts.
+func (mr *MockEngineMockRecorder) ResetActivityAttempts(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityAttempts", reflect.TypeOf((*MockEngine)(nil).ResetActivityAttempts), ctx, request)
+}
+
 // ResetStickyTaskList mocks base method.
 func (m *MockEngine) ResetStickyTaskList(ctx context.Context, resetRequest *types.HistoryResetStickyTaskListRequest) (*types.HistoryResetStickyTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -537,6 +713,20 @@ func (mr *MockEngineMockRecorder) RespondActivityTaskFailed(ctx, request any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondActivityTaskFailed", reflect.TypeOf((*MockEngine)(nil).RespondActivityTaskFailed), ctx, request)
 }
 
+// RespondActivityTaskNack mocks base method.
+func (m *MockEngine) RespondActivityTaskNack(ctx context.Context, request *types.HistoryRespondActivityTaskNackRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondActivityTaskNack", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RespondActivityTaskNack indicates an expected call of RespondActivityTaskNack.
+func (mr *MockEngineMockRecorder) RespondActivityTaskNack(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondActivityTaskNack", reflect.TypeOf((*MockEngine)(nil).RespondActivityTaskNack), ctx, request)
+}
+
 // RespondDecisionTaskCompleted mocks base method.
 func (m *MockEngine) RespondDecisionTaskCompleted(ctx context.Context, request *types.HistoryRespondDecisionTaskCompletedRequest) (*types.HistoryRespondDecisionTaskCompletedResponse, error) {
 	m.ctrl.T.Helper()
@@ -580,6 +770,20 @@ func (mr *MockEngineMockRecorder) ScheduleDecisionTask(ctx, request any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleDecisionTask", reflect.TypeOf((*MockEngine)(nil).ScheduleDecisionTask), ctx, request)
 }
 
+// SignalActivity mocks base method.
+func (m *MockEngine) SignalActivity(ctx context.Context, request *types.HistorySignalActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignalActivity", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignalActivity indicates an expected call of SignalActivity.
+func (mr *MockEngineMockRecorder) SignalActivity(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalActivity", reflect.TypeOf((*MockEngine)(nil).SignalActivity), ctx, request)
+}
+
 // SignalWithStartWorkflowExecution mocks base method.
 func (m *MockEngine) SignalWithStartWorkflowExecution(ctx context.Context, request *types.HistorySignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -30,6 +30,8 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common/activityencryption"
+	"github.com/uber/cadence/common/activityvalidator"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
@@ -65,6 +67,8 @@ type NewEngineFn func(
 	failoverCoordinator failover.Coordinator,
 	wfIDCache workflowcache.WFCache,
 	queueProcessorFactory queue.ProcessorFactory,
+	activityResultValidator activityvalidator.Validator,
+	activityPayloadEncryptor activityencryption.Encryptor,
 ) engine.Engine
 
 func NewEngineForTest(t *testing.T, newEngineFn NewEngineFn) *EngineForTest {
@@ -165,6 +169,8 @@ func NewEngineForTest(t *testing.T, newEngineFn NewEngineFn) *EngineForTest {
 		failoverCoordinator,
 		wfIDCache,
 		queueProcessorFactory,
+		activityvalidator.NewNopValidator(),
+		activityencryption.NewNopEncryptor(),
 	)
 
 	shardCtx.SetEngine(engine)
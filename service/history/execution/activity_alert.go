@@ -0,0 +1,117 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package execution
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/history/activityalert"
+)
+
+const (
+	activityFailureAlertSendTimeout = 5 * time.Second
+)
+
+type activityFailureAlert struct {
+	sinkName string
+	alert    *activityalert.Alert
+}
+
+// recordActivityFailureAlert adds the alert of an activity which failed for good to the current transaction, if
+// the activity was scheduled with an AlertOnFailure tag the domain maps to a sink
+func (e *mutableStateBuilder) recordActivityFailureAlert(
+	ai *persistence.ActivityInfo,
+	reason string,
+	details []byte,
+) {
+
+	if ai.AlertOnFailure == "" {
+		return
+	}
+	domainName := e.domainEntry.GetInfo().Name
+	sinkName, _ := e.config.ActivityFailureAlertSinks(dynamicconfig.DomainFilter(domainName))[ai.AlertOnFailure].(string)
+	if sinkName == "" {
+		return
+	}
+
+	// the alert is still worth sending without the activity type if the scheduled event cannot be loaded
+	var activityType string
+	if scheduledEvent, err := e.GetActivityScheduledEvent(context.Background(), ai.ScheduleID); err == nil {
+		activityType = scheduledEvent.ActivityTaskScheduledEventAttributes.GetActivityType().GetName()
+	}
+	e.activityFailureAlerts = append(e.activityFailureAlerts, &activityFailureAlert{
+		sinkName: sinkName,
+		alert: &activityalert.Alert{
+			DomainID:     e.executionInfo.DomainID,
+			DomainName:   domainName,
+			WorkflowID:   e.executionInfo.WorkflowID,
+			RunID:        e.executionInfo.RunID,
+			ActivityID:   ai.ActivityID,
+			ActivityType: activityType,
+			ScheduleID:   ai.ScheduleID,
+			Attempt:      ai.Attempt,
+			Tag:          ai.AlertOnFailure,
+			Reason:       reason,
+			Details:      details,
+			Timestamp:    e.timeSource.Now(),
+		},
+	})
+}
+
+// sendActivityFailureAlerts sends the alerts of the last closed transaction in the background once it is persisted,
+// so a slow sink does not hold the workflow and no alert is sent for a failure which was not recorded
+func (e *mutableStateBuilder) sendActivityFailureAlerts() {
+	alerts := e.closedActivityFailureAlerts
+	e.closedActivityFailureAlerts = nil
+	if len(alerts) == 0 {
+		return
+	}
+
+	go sendActivityFailureAlerts(e.logger, alerts)
+}
+
+// sendActivityFailureAlerts sends the alerts to their sink, an alert which cannot be sent is logged and dropped
+func sendActivityFailureAlerts(
+	logger log.Logger,
+	alerts []*activityFailureAlert,
+) {
+
+	for _, a := range alerts {
+		ctx, cancel := context.WithTimeout(context.Background(), activityFailureAlertSendTimeout)
+		err := activityalert.Send(ctx, a.sinkName, a.alert)
+		cancel()
+		if err != nil {
+			logger.Warn("Failed to send activity failure alert",
+				tag.WorkflowDomainName(a.alert.DomainName),
+				tag.WorkflowID(a.alert.WorkflowID),
+				tag.WorkflowRunID(a.alert.RunID),
+				tag.WorkflowScheduleID(a.alert.ScheduleID),
+				tag.Error(err))
+		}
+	}
+}
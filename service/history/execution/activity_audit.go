@@ -0,0 +1,99 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package execution
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/history/audit"
+)
+
+const (
+	activityAuditWriteTimeout = 5 * time.Second
+)
+
+// recordActivityAudit adds the audit record of a lifecycle transition of an activity to the current transaction,
+// if the activity audit log is enabled for the domain
+func (e *mutableStateBuilder) recordActivityAudit(
+	ai *persistence.ActivityInfo,
+	transition audit.ActivityTransition,
+	identity string,
+	reason string,
+	willRetry bool,
+) {
+
+	domainName := e.domainEntry.GetInfo().Name
+	if e.config.ActivityAuditLogSink(domainName) == "" {
+		return
+	}
+
+	e.activityAuditRecords = append(e.activityAuditRecords, &audit.ActivityRecord{
+		DomainID:   e.executionInfo.DomainID,
+		DomainName: domainName,
+		WorkflowID: e.executionInfo.WorkflowID,
+		RunID:      e.executionInfo.RunID,
+		ActivityID: ai.ActivityID,
+		ScheduleID: ai.ScheduleID,
+		Attempt:    ai.Attempt,
+		Transition: transition,
+		Identity:   identity,
+		Timestamp:  e.timeSource.Now(),
+		Reason:     reason,
+		WillRetry:  willRetry,
+	})
+}
+
+// writeActivityAuditRecords writes the audit records of the last closed transaction in the background once it is
+// persisted, so only persisted transitions are recorded and a slow sink does not hold the workflow
+func (e *mutableStateBuilder) writeActivityAuditRecords() {
+	records := e.closedActivityAuditRecords
+	e.closedActivityAuditRecords = nil
+	if len(records) == 0 {
+		return
+	}
+
+	go writeActivityAuditRecords(e.logger, e.shard.GetService().GetActivityAuditSink(), records)
+}
+
+// writeActivityAuditRecords writes the records to the sink, records which cannot be written are logged and dropped
+func writeActivityAuditRecords(
+	logger log.Logger,
+	sink audit.Sink,
+	records []*audit.ActivityRecord,
+) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), activityAuditWriteTimeout)
+	defer cancel()
+	if err := sink.Write(ctx, records); err != nil {
+		logger.Warn("Failed to write activity audit records",
+			tag.WorkflowDomainName(records[0].DomainName),
+			tag.WorkflowID(records[0].WorkflowID),
+			tag.WorkflowRunID(records[0].RunID),
+			tag.Counter(len(records)),
+			tag.Error(err))
+	}
+}
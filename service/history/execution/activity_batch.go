@@ -0,0 +1,51 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package execution
+
+import (
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// recordActivityBatchCompletion flags the next decision task once the closed activity was the last pending one
+// scheduled by its decision, if the workflow was started with NotifyOnActivityBatchComplete. Activities chained
+// with NextActivity belong to the batch of the activity they were chained from, as they are scheduled on behalf
+// of its decision. Activities scheduled by a later decision form a batch of their own: they never hold back the
+// completion of an earlier batch, and the flag stays set until a decision started after the last batch completed
+// is completed.
+func (e *mutableStateBuilder) recordActivityBatchCompletion(
+	ai *persistence.ActivityInfo,
+) {
+
+	if !e.executionInfo.NotifyOnActivityBatchComplete {
+		return
+	}
+	for _, pending := range e.pendingActivityInfoIDs {
+		if pending.ScheduledEventBatchID == ai.ScheduledEventBatchID {
+			return
+		}
+	}
+	e.executionInfo.ActivityBatchCompleted = true
+	// a decision in flight was started before the batch completed, its completion does not clear the flag
+	e.executionInfo.ActivityBatchNotifiedStartedID = common.EmptyEventID
+}
@@ -0,0 +1,88 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package execution
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/history/activitybreaker"
+)
+
+type activityTypeOutcome struct {
+	activityType string
+	success      bool
+}
+
+// recordActivityTypeOutcome records the outcome of an attempt of the activity for the circuit breaker of its
+// activity type, if circuit breaking is enabled for the domain. Failed and timed out attempts are recorded as
+// failures, whether they are retried or not. The outcomes are only reported to the breaker once the transaction
+// is persisted, so a transaction which is retried is not counted twice.
+func (e *mutableStateBuilder) recordActivityTypeOutcome(
+	ai *persistence.ActivityInfo,
+	success bool,
+) {
+
+	domainName := e.domainEntry.GetInfo().Name
+	if !e.config.EnableActivityTypeCircuitBreaker(domainName) {
+		return
+	}
+
+	// the scheduled event is usually served by the events cache, losing an outcome is better than failing
+	// the transition it is recorded from
+	scheduledEvent, err := e.GetActivityScheduledEvent(context.Background(), ai.ScheduleID)
+	if err != nil {
+		return
+	}
+	e.activityTypeOutcomes = append(e.activityTypeOutcomes, &activityTypeOutcome{
+		activityType: scheduledEvent.ActivityTaskScheduledEventAttributes.GetActivityType().GetName(),
+		success:      success,
+	})
+}
+
+// reportActivityTypeOutcomes reports the outcomes of the last closed transaction to the circuit breakers once it
+// is persisted
+func (e *mutableStateBuilder) reportActivityTypeOutcomes() {
+	outcomes := e.closedActivityTypeOutcomes
+	e.closedActivityTypeOutcomes = nil
+
+	domainName := e.domainEntry.GetInfo().Name
+	breaker := e.shard.GetService().GetActivityTypeBreaker()
+	for _, outcome := range outcomes {
+		if outcome.success {
+			breaker.RecordSuccess(domainName, e.executionInfo.DomainID, outcome.activityType)
+		} else {
+			breaker.RecordFailure(domainName, e.executionInfo.DomainID, outcome.activityType)
+		}
+	}
+}
+
+// isActivityTypeBreakerClosed tells if the circuit breaker of the activity type lets every activity through. Activities
+// of a type whose breaker is open or half-open are left to the transfer queue, which holds them back or lets the probe
+// through.
+func (e *mutableStateBuilder) isActivityTypeBreakerClosed(activityType string) bool {
+	if !e.config.EnableActivityTypeCircuitBreaker(e.domainEntry.GetInfo().Name) {
+		return true
+	}
+	return e.shard.GetService().GetActivityTypeBreaker().State(e.executionInfo.DomainID, activityType) == activitybreaker.StateClosed
+}
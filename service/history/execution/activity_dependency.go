@@ -0,0 +1,82 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package execution
+
+import (
+	"fmt"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	activityDependencyIdentity = "cadence-activity-dependency"
+)
+
+// resolveActivityDependents applies the OnDependencyFailure of the pending activities which depend on the activity
+// of the ID, once it failed for good, timed out or was canceled. The dependents are always waiting for their
+// dispatch, as the transfer queue holds them back while the activity they depend on is pending, and resolving
+// them resolves their own dependents in turn.
+func (e *mutableStateBuilder) resolveActivityDependents(
+	activityID string,
+) error {
+
+	var dependents []*persistence.ActivityInfo
+	for _, ai := range e.pendingActivityInfoIDs {
+		if ai.DependsOnActivityID == activityID && ai.StartedID == common.EmptyEventID {
+			dependents = append(dependents, ai)
+		}
+	}
+
+	details := []byte(fmt.Sprintf("activity %v did not complete", activityID))
+	for _, ai := range dependents {
+		if _, ok := e.pendingActivityInfoIDs[ai.ScheduleID]; !ok {
+			continue
+		}
+		if ai.OnDependencyFailure == types.ActivityDependencyFailurePolicySkip {
+			cancelRequestedEvent, _, err := e.AddActivityTaskCancelRequestedEvent(common.EmptyEventID, ai.ActivityID, activityDependencyIdentity)
+			if err != nil {
+				return err
+			}
+			if _, err := e.AddActivityTaskCanceledEvent(ai.ScheduleID, ai.StartedID, cancelRequestedEvent.ID, details, activityDependencyIdentity); err != nil {
+				return err
+			}
+			continue
+		}
+		// the failure is final, the activity is not retried
+		if _, err := e.AddActivityTaskStartedEvent(ai, ai.ScheduleID, uuid.New(), activityDependencyIdentity); err != nil {
+			return err
+		}
+		if _, err := e.AddActivityTaskFailedEvent(ai.ScheduleID, ai.StartedID, &types.RespondActivityTaskFailedRequest{
+			Reason:   common.StringPtr(common.FailureReasonActivityDependencyFailed),
+			Details:  details,
+			Identity: activityDependencyIdentity,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package execution
+
+import (
+	"errors"
+
+	"github.com/uber/cadence/common/jsonpath"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+var errActivityResultSearchAttributeTooLarge = errors.New("value exceeds the search attribute size of value limit")
+
+// extractActivityResultSearchAttributes evaluates the ResultSearchAttributes of the activity against its result.
+// Extraction is best effort: a path which doesn't match, a result which isn't JSON or a value over the search
+// attribute size limit skips the search attribute, the activity completes regardless. The extracted values are
+// recorded on the completed event, so replicating or replaying it never evaluates the paths again.
+func (e *mutableStateBuilder) extractActivityResultSearchAttributes(
+	ai *persistence.ActivityInfo,
+	result []byte,
+) *types.SearchAttributes {
+
+	if len(ai.ResultSearchAttributes) == 0 {
+		return nil
+	}
+	domainName := e.GetDomainEntry().GetInfo().Name
+	sizeOfValueLimit := e.config.SearchAttributesSizeOfValueLimit(domainName)
+	indexedFields := make(map[string][]byte)
+	for _, extractor := range ai.ResultSearchAttributes {
+		value, err := extractActivityResultSearchAttribute(extractor, result)
+		if err == nil && len(value) > sizeOfValueLimit {
+			err = errActivityResultSearchAttributeTooLarge
+		}
+		if err != nil {
+			e.metricsClient.Scope(metrics.HistoryRespondActivityTaskCompletedScope, metrics.DomainTag(domainName)).
+				IncCounter(metrics.ActivityResultSearchAttributeSkippedCounter)
+			e.logWarn("Activity result search attribute skipped",
+				tag.WorkflowActivityID(ai.ActivityID),
+				tag.ESKey(extractor.GetSearchAttributeKey()),
+				tag.Value(extractor.GetJSONPath()),
+				tag.Error(err))
+			continue
+		}
+		indexedFields[extractor.GetSearchAttributeKey()] = value
+	}
+	if len(indexedFields) == 0 {
+		return nil
+	}
+	return &types.SearchAttributes{IndexedFields: indexedFields}
+}
+
+func extractActivityResultSearchAttribute(
+	extractor *types.ActivityResultSearchAttribute,
+	result []byte,
+) ([]byte, error) {
+
+	path, err := jsonpath.Parse(extractor.GetJSONPath())
+	if err != nil {
+		return nil, err
+	}
+	return path.Lookup(result)
+}
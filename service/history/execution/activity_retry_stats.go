@@ -0,0 +1,41 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package execution
+
+import (
+	"github.com/uber/cadence/common/persistence"
+)
+
+// recordActivityRetryStats records the outcome of an attempt of the activity with the retry stats of the domain,
+// if they are enabled for the domain. Failed and timed out attempts are recorded whether they are retried or not.
+func (e *mutableStateBuilder) recordActivityRetryStats(
+	ai *persistence.ActivityInfo,
+	success bool,
+) {
+
+	domainName := e.domainEntry.GetInfo().Name
+	if !e.config.EnableActivityRetryStats(domainName) {
+		return
+	}
+	e.shard.GetService().GetActivityRetryStats().RecordAttempt(domainName, e.executionInfo.DomainID, ai.Attempt, success)
+}
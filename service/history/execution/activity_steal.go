@@ -0,0 +1,87 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package execution
+
+import (
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// Stealing hands a started attempt to another poller while its original worker may still be running it, the
+// original worker is only stopped from reporting back. It is therefore limited to activities which declared
+// themselves idempotent, which excludes AtMostOnce ones, and which have a heartbeat timeout to tell a slow
+// worker from a stuck one. Their retry policy keeps the started event transient, so the stolen attempt leaves
+// no trace in the history besides the bumped attempt of the next started event.
+
+// IsActivityStealable tells if the current attempt of the activity may be handed to another poller
+// once it went without a heartbeat for its steal timeout
+func IsActivityStealable(
+	ai *persistence.ActivityInfo,
+) bool {
+
+	return ai.Idempotent &&
+		!ai.AtMostOnce &&
+		ai.HasRetryPolicy &&
+		ai.HeartbeatTimeout > 0 &&
+		ai.StartedID == common.TransientEventID &&
+		!ai.CancelRequested
+}
+
+// GetActivityStealTimeout returns the seconds the started activity may go without a heartbeat before it is
+// stolen, given the steal threshold of its task list as a fraction of its heartbeat timeout. 0 if the activity
+// is never stolen.
+func GetActivityStealTimeout(
+	ai *persistence.ActivityInfo,
+	threshold float64,
+) int32 {
+
+	if !ai.Idempotent || ai.AtMostOnce || !ai.HasRetryPolicy || ai.HeartbeatTimeout <= 0 {
+		return 0
+	}
+	if threshold <= 0 || threshold >= 1 {
+		return 0
+	}
+	stealTimeout := int32(float64(ai.HeartbeatTimeout) * threshold)
+	if stealTimeout < 1 {
+		stealTimeout = 1
+	}
+	if stealTimeout >= ai.HeartbeatTimeout {
+		return 0
+	}
+	return stealTimeout
+}
+
+// IsActivityStealTimeoutEffective returns true if the heartbeat timer of the activity
+// is driven by its steal timeout rather than by its heartbeat or visibility timeout
+func IsActivityStealTimeoutEffective(
+	ai *persistence.ActivityInfo,
+) bool {
+
+	if ai.StealTimeout <= 0 || !IsActivityStealable(ai) {
+		return false
+	}
+	if ai.StealTimeout >= ai.HeartbeatTimeout {
+		return false
+	}
+	return !IsActivityVisibilityTimeoutEffective(ai) || ai.StealTimeout < ai.VisibilityTimeout
+}
@@ -0,0 +1,119 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package execution
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	activityAttemptSpanName   = "cadence-activity-attempt"
+	activityExecutionSpanName = "cadence-activity-execution"
+
+	activityOutcomeCompleted = "completed"
+	activityOutcomeFailed    = "failed"
+	activityOutcomeTimedOut  = "timed-out"
+	activityOutcomeCanceled  = "canceled"
+	activityOutcomeRetry     = "retry"
+)
+
+// traceActivityAttempt reports the spans of an activity attempt once its outcome is known: a span covering
+// the attempt from its schedule time, and a child span covering the execution from its start time, if it was
+// started. The spans are children of the trace context the workflow propagated through the activity header,
+// so each retry shows up as a sibling attempt in the same trace. The spans are reported to the global
+// opentracing tracer, which is a no-op unless the host installs one, e.g. an OpenTelemetry bridge.
+func (e *mutableStateBuilder) traceActivityAttempt(
+	ai *persistence.ActivityInfo,
+	outcome string,
+	failureReason string,
+) {
+
+	tracer := opentracing.GlobalTracer()
+	if _, ok := tracer.(opentracing.NoopTracer); ok {
+		return
+	}
+
+	tags := opentracing.Tags{
+		"cadence.domain":      e.domainEntry.GetInfo().Name,
+		"cadence.workflow_id": e.executionInfo.WorkflowID,
+		"cadence.run_id":      e.executionInfo.RunID,
+		"cadence.activity_id": ai.ActivityID,
+		"cadence.attempt":     ai.Attempt,
+		"cadence.outcome":     outcome,
+	}
+	options := []opentracing.StartSpanOption{opentracing.StartTime(ai.ScheduledTime), tags}
+	// the scheduled event is usually served by the events cache, a trace without parent is still
+	// better than failing the transition it is reported from
+	if scheduledEvent, err := e.GetActivityScheduledEvent(context.Background(), ai.ScheduleID); err == nil {
+		attributes := scheduledEvent.ActivityTaskScheduledEventAttributes
+		tags["cadence.activity_type"] = attributes.GetActivityType().GetName()
+		if parent := extractActivitySpanContext(tracer, attributes.Header); parent != nil {
+			options = append(options, opentracing.ChildOf(parent))
+		}
+	}
+
+	now := e.timeSource.Now()
+	span := tracer.StartSpan(activityAttemptSpanName, options...)
+	if !ai.StartedTime.IsZero() {
+		execution := tracer.StartSpan(
+			activityExecutionSpanName,
+			opentracing.ChildOf(span.Context()),
+			opentracing.StartTime(ai.StartedTime),
+			opentracing.Tag{Key: "cadence.worker_identity", Value: ai.StartedIdentity},
+		)
+		execution.FinishWithOptions(opentracing.FinishOptions{FinishTime: now})
+	}
+	if outcome != activityOutcomeCompleted {
+		ext.Error.Set(span, true)
+		span.LogFields(log.String("cadence.failure_reason", failureReason))
+	}
+	span.FinishWithOptions(opentracing.FinishOptions{FinishTime: now})
+}
+
+// extractActivitySpanContext reads the trace context the client tracing propagator injected into the header,
+// each header field holding one entry of the carrier
+func extractActivitySpanContext(
+	tracer opentracing.Tracer,
+	header *types.Header,
+) opentracing.SpanContext {
+
+	if header == nil || len(header.Fields) == 0 {
+		return nil
+	}
+	carrier := opentracing.TextMapCarrier{}
+	for key, value := range header.Fields {
+		carrier[key] = string(value)
+	}
+	spanContext, err := tracer.Extract(opentracing.HTTPHeaders, carrier)
+	if err != nil {
+		return nil
+	}
+	return spanContext
+}
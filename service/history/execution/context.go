@@ -613,6 +613,13 @@ func (c *contextImpl) ConflictResolveWorkflowExecution(
 	c.notifyTasksFromWorkflowSnapshotFn(resetWorkflow, persistedBlobs, false)
 	c.notifyTasksFromWorkflowSnapshotFn(newWorkflow, persistedBlobs, false)
 	c.notifyTasksFromWorkflowMutationFn(currentWorkflow, persistedBlobs, false)
+	resetMutableState.NotifyTransactionPersisted()
+	if newWorkflow != nil {
+		newMutableState.NotifyTransactionPersisted()
+	}
+	if currentWorkflow != nil {
+		currentMutableState.NotifyTransactionPersisted()
+	}
 
 	// finally emit session stats
 	c.emitWorkflowHistoryStatsFn(domain, int(c.stats.HistorySize), int(resetMutableState.GetNextEventID()-1))
@@ -857,6 +864,10 @@ func (c *contextImpl) UpdateWorkflowExecutionWithNew(
 	c.notifyTasksFromWorkflowMutationFn(currentWorkflow, persistedBlobs, false)
 	// notify new workflow tasks
 	c.notifyTasksFromWorkflowSnapshotFn(newWorkflow, persistedBlobs, false)
+	c.mutableState.NotifyTransactionPersisted()
+	if newWorkflow != nil {
+		newMutableState.NotifyTransactionPersisted()
+	}
 
 	// finally emit session stats
 	c.emitWorkflowHistoryStatsFn(domain, int(c.stats.HistorySize), int(c.mutableState.GetNextEventID()-1))
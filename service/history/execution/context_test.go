@@ -1769,6 +1769,8 @@ func TestUpdateWorkflowExecutionWithNew(t *testing.T) {
 				mockMutableState.EXPECT().GetCompletionEvent(gomock.Any()).Return(&types.HistoryEvent{
 					ID: 123,
 				}, nil)
+				mockMutableState.EXPECT().NotifyTransactionPersisted()
+				mockNewMutableState.EXPECT().NotifyTransactionPersisted()
 			},
 			mockPersistNonStartWorkflowBatchEventsFn: func(_ context.Context, history *persistence.WorkflowEvents) (events.PersistedBlob, error) {
 				assert.Equal(t, &persistence.WorkflowEvents{
@@ -2575,6 +2577,9 @@ func TestConflictResolveWorkflowExecution(t *testing.T) {
 				mockResetMutableState.EXPECT().GetCompletionEvent(gomock.Any()).Return(&types.HistoryEvent{
 					ID: 123,
 				}, nil)
+				mockResetMutableState.EXPECT().NotifyTransactionPersisted()
+				mockNewMutableState.EXPECT().NotifyTransactionPersisted()
+				mockMutableState.EXPECT().NotifyTransactionPersisted()
 			},
 			mockPersistNonStartWorkflowBatchEventsFn: func(_ context.Context, history *persistence.WorkflowEvents) (events.PersistedBlob, error) {
 				if history.BranchToken[0] == 1 {
@@ -102,6 +102,8 @@ func (b *HistoryBuilder) AddWorkflowExecutionStartedEvent(startRequest *types.Hi
 		JitterStartSeconds:                  request.JitterStartSeconds,
 		PartitionConfig:                     startRequest.PartitionConfig,
 		RequestID:                           request.RequestID,
+		Priority:                            request.Priority,
+		NotifyOnActivityBatchComplete:       request.NotifyOnActivityBatchComplete,
 	}
 	if parentInfo := startRequest.ParentExecutionInfo; parentInfo != nil {
 		attributes.ParentWorkflowDomainID = &parentInfo.DomainUUID
@@ -204,7 +206,7 @@ func (b *HistoryBuilder) AddDecisionTaskFailedEvent(attr types.DecisionTaskFaile
 
 // AddActivityTaskScheduledEvent adds ActivityTaskScheduled event to history
 func (b *HistoryBuilder) AddActivityTaskScheduledEvent(decisionCompletedEventID int64,
-	attributes *types.ScheduleActivityTaskDecisionAttributes) *types.HistoryEvent {
+	attributes *types.ScheduleActivityTaskDecisionAttributes, weightedSelection *types.WeightedActivitySelection) *types.HistoryEvent {
 
 	var domain *string
 	if attributes.Domain != "" {
@@ -215,18 +217,42 @@ func (b *HistoryBuilder) AddActivityTaskScheduledEvent(decisionCompletedEventID
 
 	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeActivityTaskScheduled)
 	event.ActivityTaskScheduledEventAttributes = &types.ActivityTaskScheduledEventAttributes{
-		ActivityID:                    attributes.ActivityID,
-		ActivityType:                  attributes.ActivityType,
-		TaskList:                      attributes.TaskList,
-		Header:                        attributes.Header,
-		Input:                         attributes.Input,
-		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(common.Int32Default(attributes.ScheduleToCloseTimeoutSeconds)),
-		ScheduleToStartTimeoutSeconds: common.Int32Ptr(common.Int32Default(attributes.ScheduleToStartTimeoutSeconds)),
-		StartToCloseTimeoutSeconds:    common.Int32Ptr(common.Int32Default(attributes.StartToCloseTimeoutSeconds)),
-		HeartbeatTimeoutSeconds:       common.Int32Ptr(common.Int32Default(attributes.HeartbeatTimeoutSeconds)),
-		DecisionTaskCompletedEventID:  decisionCompletedEventID,
-		RetryPolicy:                   attributes.RetryPolicy,
-		Domain:                        domain,
+		ActivityID:                         attributes.ActivityID,
+		ActivityType:                       attributes.ActivityType,
+		TaskList:                           attributes.TaskList,
+		Header:                             attributes.Header,
+		Input:                              attributes.Input,
+		ScheduleToCloseTimeoutSeconds:      common.Int32Ptr(common.Int32Default(attributes.ScheduleToCloseTimeoutSeconds)),
+		ScheduleToStartTimeoutSeconds:      common.Int32Ptr(common.Int32Default(attributes.ScheduleToStartTimeoutSeconds)),
+		StartToCloseTimeoutSeconds:         common.Int32Ptr(common.Int32Default(attributes.StartToCloseTimeoutSeconds)),
+		HeartbeatTimeoutSeconds:            common.Int32Ptr(common.Int32Default(attributes.HeartbeatTimeoutSeconds)),
+		DecisionTaskCompletedEventID:       decisionCompletedEventID,
+		RetryPolicy:                        attributes.RetryPolicy,
+		Domain:                             domain,
+		Priority:                           attributes.Priority,
+		FirstAttemptStartToCloseSeconds:    attributes.FirstAttemptStartToCloseSeconds,
+		SearchAttributes:                   attributes.SearchAttributes,
+		CancellationCheckpointGraceSeconds: attributes.CancellationCheckpointGraceSeconds,
+		ParentInitiatedChildID:             attributes.ParentInitiatedChildID,
+		RequiredCapabilities:               attributes.RequiredCapabilities,
+		FallbackTaskList:                   attributes.FallbackTaskList,
+		NextActivity:                       attributes.NextActivity,
+		EncryptionKeyID:                    attributes.EncryptionKeyID,
+		OrderedDispatch:                    attributes.OrderedDispatch,
+		Idempotent:                         attributes.Idempotent,
+		RegionAffinity:                     attributes.RegionAffinity,
+		OnTimeoutDefaultResult:             attributes.OnTimeoutDefaultResult,
+		DispatchWindow:                     attributes.DispatchWindow,
+		KillSwitchTag:                      attributes.KillSwitchTag,
+		AtMostOnce:                         attributes.AtMostOnce,
+		AlertOnFailure:                     attributes.AlertOnFailure,
+		DependsOnActivityID:                attributes.DependsOnActivityID,
+		OnDependencyFailure:                attributes.OnDependencyFailure,
+		ExpectedResultSizeBytes:            attributes.ExpectedResultSizeBytes,
+		ResultSearchAttributes:             attributes.ResultSearchAttributes,
+		TaskListEscalation:                 attributes.TaskListEscalation,
+		RoutingKey:                         attributes.RoutingKey,
+		WeightedSelection:                  weightedSelection,
 	}
 
 	return b.addEventToHistory(event)
@@ -255,15 +281,9 @@ func (b *HistoryBuilder) AddActivityTaskStartedEvent(
 }
 
 // AddActivityTaskCompletedEvent adds ActivityTaskCompleted event to history
-func (b *HistoryBuilder) AddActivityTaskCompletedEvent(scheduleEventID, startedEventID int64,
-	request *types.RespondActivityTaskCompletedRequest) *types.HistoryEvent {
+func (b *HistoryBuilder) AddActivityTaskCompletedEvent(attributes *types.ActivityTaskCompletedEventAttributes) *types.HistoryEvent {
 	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeActivityTaskCompleted)
-	event.ActivityTaskCompletedEventAttributes = &types.ActivityTaskCompletedEventAttributes{
-		Result:           request.Result,
-		ScheduledEventID: scheduleEventID,
-		StartedEventID:   startedEventID,
-		Identity:         request.Identity,
-	}
+	event.ActivityTaskCompletedEventAttributes = attributes
 
 	return b.addEventToHistory(event)
 }
@@ -278,6 +298,7 @@ func (b *HistoryBuilder) AddActivityTaskFailedEvent(scheduleEventID, StartedEven
 		ScheduledEventID: scheduleEventID,
 		StartedEventID:   StartedEventID,
 		Identity:         request.Identity,
+		FailureCategory:  request.FailureCategory,
 	}
 
 	return b.addEventToHistory(event)
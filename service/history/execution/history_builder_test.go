@@ -318,7 +318,7 @@ func (s *historyBuilderSuite) TestHistoryBuilderDynamicSuccess() {
 
 	activity3Reason := "dynamic-historybuilder-success-activity3-failed"
 	activity3Details := []byte("dynamic-historybuilder-success-activity3-callstack")
-	s.msBuilder.RetryActivity(ai5, activity3Reason, activity3Details)
+	s.msBuilder.RetryActivity(ai5, activity3Reason, activity3Details, nil)
 	ai6, activity3Running2 := s.msBuilder.GetActivityInfo(7)
 	s.Equal(activity3Reason, ai6.LastFailureReason)
 	s.Equal(activity3Details, ai6.LastFailureDetails)
@@ -377,7 +377,7 @@ func (s *historyBuilderSuite) TestHistoryBuilderDynamicSuccess() {
 
 	activity5Reason := "dynamic-historybuilder-success-activity5-failed"
 	activity5Details := []byte("dynamic-historybuilder-success-activity5-callstack")
-	s.msBuilder.RetryActivity(ai5, activity5Reason, activity5Details)
+	s.msBuilder.RetryActivity(ai5, activity5Reason, activity5Details, nil)
 	ai6, activity5Running2 := s.msBuilder.GetActivityInfo(9)
 	s.Equal(activity5Reason, ai6.LastFailureReason)
 	s.Equal(activity5Details, ai6.LastFailureDetails)
@@ -1336,6 +1336,170 @@ func (s *historyBuilderSuite) TestHistoryBuilderActivityTaskTimedOut() {
 	s.validateWorkflowExecutionFailed(failed, 11, 10, failReason, failDetails)
 }
 
+func (s *historyBuilderSuite) TestHistoryBuilderActivityPriorityInheritance() {
+	we := types.WorkflowExecution{
+		WorkflowID: "historybuilder-workflow-id",
+		RunID:      "historybuilder-test-run-id",
+	}
+	tl := "historybuilder-tasklist"
+	identity := "historybuilder-worker"
+
+	// 1
+	started, err := s.msBuilder.AddWorkflowExecutionStartedEvent(
+		we,
+		&types.HistoryStartWorkflowExecutionRequest{
+			DomainUUID: s.domainID,
+			StartRequest: &types.StartWorkflowExecutionRequest{
+				WorkflowID:                          we.WorkflowID,
+				WorkflowType:                        &types.WorkflowType{Name: "historybuilder-type"},
+				TaskList:                            &types.TaskList{Name: tl},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+				Identity:                            identity,
+				Priority:                            common.Int32Ptr(5),
+			},
+		},
+	)
+	s.Nil(err)
+	s.Equal(int32(5), started.WorkflowExecutionStartedEventAttributes.GetPriority())
+	s.Equal(int32(5), *s.msBuilder.GetExecutionInfo().Priority)
+	// 2
+	s.addDecisionTaskScheduledEvent()
+	// 3
+	s.addDecisionTaskStartedEvent(2, tl, identity)
+	// 4
+	s.addDecisionTaskCompletedEvent(2, 3, nil, identity)
+
+	// 5
+	inherited, ai, _ := s.addActivityTaskScheduledEvent(4, "activity1", "activity1-type", "", tl, nil, 60, 20, 10, nil, false)
+	s.Equal(int32(5), inherited.ActivityTaskScheduledEventAttributes.GetPriority())
+	s.Equal(int32(5), *ai.Priority)
+
+	// 6
+	overridden, ai, _, _, _, err := s.msBuilder.AddActivityTaskScheduledEvent(nil, 4,
+		&types.ScheduleActivityTaskDecisionAttributes{
+			ActivityID:                    "activity2",
+			ActivityType:                  &types.ActivityType{Name: "activity2-type"},
+			TaskList:                      &types.TaskList{Name: tl},
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(60),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(20),
+			StartToCloseTimeoutSeconds:    common.Int32Ptr(1),
+			HeartbeatTimeoutSeconds:       common.Int32Ptr(10),
+			Priority:                      common.Int32Ptr(1),
+		}, false,
+	)
+	s.Nil(err)
+	s.Equal(int32(1), overridden.ActivityTaskScheduledEventAttributes.GetPriority())
+	s.Equal(int32(1), *ai.Priority)
+}
+
+func (s *historyBuilderSuite) TestHistoryBuilderWeightedActivity() {
+	we := types.WorkflowExecution{
+		WorkflowID: "historybuilder-workflow-id",
+		RunID:      "historybuilder-test-run-id",
+	}
+	tl := "historybuilder-tasklist"
+	identity := "historybuilder-worker"
+	partitionConfig := map[string]string{
+		"zone": "dca1",
+	}
+
+	// 1
+	s.addWorkflowExecutionStartedEvent(we, "historybuilder-type", tl, nil, 60, 10, identity, partitionConfig)
+	// 2
+	s.addDecisionTaskScheduledEvent()
+	// 3
+	s.addDecisionTaskStartedEvent(2, tl, identity)
+	// 4
+	s.addDecisionTaskCompletedEvent(2, 3, nil, identity)
+
+	// 5
+	weightedSelection := &types.WeightedActivitySelection{
+		Candidates: []*types.WeightedActivityType{
+			{ActivityType: &types.ActivityType{Name: "activity1-type"}, Weight: 1},
+			{ActivityType: &types.ActivityType{Name: "activity2-type"}, Weight: 3},
+		},
+		Seed: 42,
+	}
+	scheduled, _, _, _, _, err := s.msBuilder.AddWeightedActivityTaskScheduledEvent(nil, 4,
+		&types.ScheduleActivityTaskDecisionAttributes{
+			ActivityID:                    "activity1",
+			ActivityType:                  &types.ActivityType{Name: "activity1-type"},
+			TaskList:                      &types.TaskList{Name: tl},
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(60),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(20),
+			StartToCloseTimeoutSeconds:    common.Int32Ptr(10),
+		}, weightedSelection, false,
+	)
+	s.Nil(err)
+	s.Equal(weightedSelection, scheduled.ActivityTaskScheduledEventAttributes.GetWeightedSelection())
+}
+
+func (s *historyBuilderSuite) TestHistoryBuilderActivityNextActivity() {
+	we := types.WorkflowExecution{
+		WorkflowID: "historybuilder-workflow-id",
+		RunID:      "historybuilder-test-run-id",
+	}
+	tl := "historybuilder-tasklist"
+	identity := "historybuilder-worker"
+	partitionConfig := map[string]string{
+		"zone": "dca1",
+	}
+
+	// 1
+	s.addWorkflowExecutionStartedEvent(we, "historybuilder-type", tl, nil, 60, 10, identity, partitionConfig)
+	// 2
+	s.addDecisionTaskScheduledEvent()
+	// 3
+	s.addDecisionTaskStartedEvent(2, tl, identity)
+	// 4
+	s.addDecisionTaskCompletedEvent(2, 3, nil, identity)
+
+	// 5
+	scheduled, _, _, _, _, err := s.msBuilder.AddActivityTaskScheduledEvent(nil, 4,
+		&types.ScheduleActivityTaskDecisionAttributes{
+			ActivityID:                    "activity1",
+			ActivityType:                  &types.ActivityType{Name: "activity1-type"},
+			TaskList:                      &types.TaskList{Name: tl},
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(60),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(20),
+			StartToCloseTimeoutSeconds:    common.Int32Ptr(10),
+			NextActivity: &types.ScheduleActivityTaskDecisionAttributes{
+				ActivityID:                    "activity2",
+				ActivityType:                  &types.ActivityType{Name: "activity2-type"},
+				TaskList:                      &types.TaskList{Name: tl},
+				ScheduleToCloseTimeoutSeconds: common.Int32Ptr(60),
+				ScheduleToStartTimeoutSeconds: common.Int32Ptr(20),
+				StartToCloseTimeoutSeconds:    common.Int32Ptr(10),
+			},
+		}, false,
+	)
+	s.Nil(err)
+	s.Equal("activity2", scheduled.ActivityTaskScheduledEventAttributes.GetNextActivity().GetActivityID())
+	// 6
+	s.addActivityTaskStartedEvent(5, tl, identity)
+	// 7, and 8 for the next activity
+	s.addActivityTaskCompletedEvent(5, 6, []byte("activity1-result"), identity)
+
+	_, ok := s.msBuilder.GetActivityByActivityID("activity1")
+	s.False(ok)
+	ai, ok := s.msBuilder.GetActivityByActivityID("activity2")
+	s.True(ok)
+	s.Equal(tl, ai.TaskList)
+	s.Equal(int64(4), ai.ScheduledEventBatchID)
+
+	var next *types.HistoryEvent
+	for _, event := range s.msBuilder.GetHistoryBuilder().history {
+		if event.GetEventType() == types.EventTypeActivityTaskScheduled && event.ID == ai.ScheduleID {
+			next = event
+		}
+	}
+	s.NotNil(next)
+	s.Equal([]byte("activity1-result"), next.ActivityTaskScheduledEventAttributes.Input)
+	s.Equal(int64(5), next.ActivityTaskScheduledEventAttributes.GetChainedFromScheduledEventID())
+	s.Equal(int64(4), next.ActivityTaskScheduledEventAttributes.DecisionTaskCompletedEventID)
+}
+
 func (s *historyBuilderSuite) TestHistoryBuilderTimeoutWorkflow() {
 	id := "historybuilder-workflow-id"
 	rid := "historybuilder-test-run-id"
@@ -1732,7 +1896,7 @@ func (s *historyBuilderSuite) addActivityTaskCompletedEvent(scheduleID, startedI
 	event, err := s.msBuilder.AddActivityTaskCompletedEvent(scheduleID, startedID, &types.RespondActivityTaskCompletedRequest{
 		Result:   result,
 		Identity: identity,
-	})
+	}, nil)
 	s.Nil(err)
 	return event
 }
@@ -57,9 +57,10 @@ type (
 
 	// MutableState contains the current workflow execution state
 	MutableState interface {
+		AddActivityAttemptsResetMarkerEvent(context.Context, int64, int64, string, int32, string) (*types.HistoryEvent, error)
 		AddActivityTaskCancelRequestedEvent(int64, string, string) (*types.HistoryEvent, *persistence.ActivityInfo, error)
 		AddActivityTaskCanceledEvent(int64, int64, int64, []uint8, string) (*types.HistoryEvent, error)
-		AddActivityTaskCompletedEvent(int64, int64, *types.RespondActivityTaskCompletedRequest) (*types.HistoryEvent, error)
+		AddActivityTaskCompletedEvent(int64, int64, *types.RespondActivityTaskCompletedRequest, *ActivityTaskCompletedOptions) (*types.HistoryEvent, error)
 		AddActivityTaskFailedEvent(int64, int64, *types.RespondActivityTaskFailedRequest) (*types.HistoryEvent, error)
 		AddActivityTaskScheduledEvent(context.Context, int64, *types.ScheduleActivityTaskDecisionAttributes, bool) (*types.HistoryEvent, *persistence.ActivityInfo, *types.ActivityLocalDispatchInfo, bool, bool, error)
 		AddActivityTaskStartedEvent(*persistence.ActivityInfo, int64, string, string) (*types.HistoryEvent, error)
@@ -99,6 +100,7 @@ type (
 		AddTimerFiredEvent(string) (*types.HistoryEvent, error)
 		AddTimerStartedEvent(int64, *types.StartTimerDecisionAttributes) (*types.HistoryEvent, *persistence.TimerInfo, error)
 		AddUpsertWorkflowSearchAttributesEvent(int64, *types.UpsertWorkflowSearchAttributesDecisionAttributes) (*types.HistoryEvent, error)
+		AddWeightedActivityTaskScheduledEvent(context.Context, int64, *types.ScheduleActivityTaskDecisionAttributes, *types.WeightedActivitySelection, bool) (*types.HistoryEvent, *persistence.ActivityInfo, *types.ActivityLocalDispatchInfo, bool, bool, error)
 		AddWorkflowExecutionCancelRequestedEvent(string, *types.HistoryRequestCancelWorkflowExecutionRequest) (*types.HistoryEvent, error)
 		AddWorkflowExecutionCanceledEvent(int64, *types.CancelWorkflowExecutionDecisionAttributes) (*types.HistoryEvent, error)
 		AddWorkflowExecutionSignaled(signalName string, input []byte, identity string, reqeustID string) (*types.HistoryEvent, error)
@@ -107,7 +109,11 @@ type (
 		ClearStickyness()
 		CheckResettable() error
 		CopyToPersistence() *persistence.WorkflowMutableState
-		RetryActivity(ai *persistence.ActivityInfo, failureReason string, failureDetails []byte) (bool, error)
+		RetryActivity(ai *persistence.ActivityInfo, failureReason string, failureDetails []byte, failureCategory *types.ActivityFailureCategory) (bool, error)
+		ExpireActivityHeartbeat(ai *persistence.ActivityInfo) error
+		RedispatchActivity(ai *persistence.ActivityInfo) error
+		StealActivity(ai *persistence.ActivityInfo) error
+		NackActivity(ai *persistence.ActivityInfo, reason string) error
 		CreateNewHistoryEvent(eventType types.EventType) *types.HistoryEvent
 		CreateNewHistoryEventWithTimestamp(eventType types.EventType, timestamp int64) *types.HistoryEvent
 		CreateTransientDecisionEvents(di *DecisionInfo, identity string) (*types.HistoryEvent, *types.HistoryEvent)
@@ -139,6 +145,7 @@ type (
 		GetNextEventID() int64
 		GetPreviousStartedEventID() int64
 		GetPendingActivityInfos() map[int64]*persistence.ActivityInfo
+		GetRecentlyClosedActivities() []*types.RecentlyClosedActivityInfo
 		GetPendingTimerInfos() map[string]*persistence.TimerInfo
 		GetPendingChildExecutionInfos() map[int64]*persistence.ChildExecutionInfo
 		GetPendingRequestCancelExternalInfos() map[int64]*persistence.RequestCancelInfo
@@ -168,6 +175,7 @@ type (
 		IsResourceDuplicated(resourceDedupKey definition.DeduplicationID) bool
 		UpdateDuplicatedResource(resourceDedupKey definition.DeduplicationID)
 		Load(*persistence.WorkflowMutableState) error
+		ReplicateActivityAttemptsResetMarkerEvent(context.Context, *types.HistoryEvent) error
 		ReplicateActivityInfo(*types.SyncActivityRequest, bool) error
 		ReplicateActivityTaskCancelRequestedEvent(*types.HistoryEvent) error
 		ReplicateActivityTaskCanceledEvent(*types.HistoryEvent) error
@@ -233,6 +241,8 @@ type (
 		StartTransaction(entry *cache.DomainCacheEntry, incomingTaskVersion int64) (bool, error)
 		CloseTransactionAsMutation(now time.Time, transactionPolicy TransactionPolicy) (*persistence.WorkflowMutation, []*persistence.WorkflowEvents, error)
 		CloseTransactionAsSnapshot(now time.Time, transactionPolicy TransactionPolicy) (*persistence.WorkflowSnapshot, []*persistence.WorkflowEvents, error)
+		// NotifyTransactionPersisted is called once the last closed transaction is persisted
+		NotifyTransactionPersisted()
 
 		GetHistorySize() int64
 		SetHistorySize(size int64)
@@ -27,6 +27,7 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/dgryski/go-farm"
 	"github.com/pborman/uuid"
 	"golang.org/x/exp/maps"
 
@@ -43,6 +44,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/audit"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/events"
 	"github.com/uber/cadence/service/history/query"
@@ -54,6 +56,13 @@ const (
 	mutableStateInvalidHistoryActionMsgTemplate = mutableStateInvalidHistoryActionMsg + ": %v"
 
 	timerCancellationMsgTimerIDUnknown = "TIMER_ID_UNKNOWN"
+
+	activityVisibilityTimeoutReason = "cadenceInternal:VisibilityTimeout"
+	activityStolenReason            = "cadenceInternal:Stolen"
+
+	throttledActivityFailureBackoffMultiplier = 2
+
+	maxActivityRescheduleReasons = 100
 )
 
 var (
@@ -142,6 +151,20 @@ type (
 		// record if a event has been applied to mutable state
 		// TODO: persist this to db
 		appliedEvents map[string]struct{}
+		// last heartbeat details of recently closed activities, keyed by activity ID
+		closedActivityHeartbeats map[string]*types.RecentlyClosedActivityInfo
+		// audit records of the activity transitions of the current transaction
+		activityAuditRecords []*audit.ActivityRecord
+		// audit records of the last closed transaction, written once it is persisted
+		closedActivityAuditRecords []*audit.ActivityRecord
+		// alerts of the activities which failed for good in the current transaction
+		activityFailureAlerts []*activityFailureAlert
+		// alerts of the last closed transaction, sent once it is persisted
+		closedActivityFailureAlerts []*activityFailureAlert
+		// outcomes of the activity attempts of the current transaction, for the circuit breakers of their types
+		activityTypeOutcomes []*activityTypeOutcome
+		// outcomes of the last closed transaction, reported once it is persisted
+		closedActivityTypeOutcomes []*activityTypeOutcome
 
 		insertTransferTasks    []persistence.Task
 		insertReplicationTasks []persistence.Task
@@ -907,7 +930,6 @@ func (e *mutableStateBuilder) shouldBufferEvent(
 		types.EventTypeTimerCanceled,
 		types.EventTypeCancelTimerFailed,
 		types.EventTypeRequestCancelExternalWorkflowExecutionInitiated,
-		types.EventTypeMarkerRecorded,
 		types.EventTypeStartChildWorkflowExecutionInitiated,
 		types.EventTypeSignalExternalWorkflowExecutionInitiated,
 		types.EventTypeUpsertWorkflowSearchAttributes:
@@ -920,6 +942,10 @@ func (e *mutableStateBuilder) shouldBufferEvent(
 			panic(msg)
 		}
 		return false
+	case // markers are generated from decisions as well, except for the activity attempts reset markers
+		// which are buffered while there is a decision on the fly
+		types.EventTypeMarkerRecorded:
+		return e.HasInFlightDecision()
 	default:
 		return true
 	}
@@ -956,6 +982,7 @@ func (e *mutableStateBuilder) GetRetryBackoffDuration(
 		info.MaximumAttempts,
 		info.InitialInterval,
 		info.MaximumInterval,
+		info.MinimumInterval,
 		info.BackoffCoefficient,
 		errReason,
 		info.NonRetriableErrors,
@@ -1352,9 +1379,40 @@ func (e *mutableStateBuilder) AddTimerTasks(
 	timerTasks ...persistence.Task,
 ) {
 
+	for _, task := range timerTasks {
+		e.coalesceActivityHeartbeatTimer(task)
+	}
 	e.insertTimerTasks = append(e.insertTimerTasks, timerTasks...)
 }
 
+// coalesceActivityHeartbeatTimer delays a heartbeat timeout task to the next heartbeat sweep of the workflow when
+// ActivityHeartbeatTimerCoalesceInterval is set. The sweeps of a workflow are the interval apart, shifted by a phase
+// derived from the workflow ID so the sweeps of different workflows are spread out. As the activity timeout task
+// checks the timers of every pending activity, the heartbeat deadlines of all the activities falling before a sweep
+// are handled by a single timer task. Each activity still times out based on its own last heartbeat, the timeout
+// is only detected up to the interval late.
+func (e *mutableStateBuilder) coalesceActivityHeartbeatTimer(
+	task persistence.Task,
+) {
+
+	timeoutTask, ok := task.(*persistence.ActivityTimeoutTask)
+	if !ok || timeoutTask.TimeoutType != int(TimerTypeHeartbeat) {
+		return
+	}
+	interval := e.config.ActivityHeartbeatTimerCoalesceInterval(e.domainEntry.GetInfo().Name)
+	if interval <= 0 {
+		return
+	}
+
+	phase := time.Duration(farm.Fingerprint64([]byte(e.executionInfo.WorkflowID)) % uint64(interval))
+	deadline := timeoutTask.VisibilityTimestamp
+	sweep := deadline.Add(-phase).Truncate(interval).Add(phase)
+	if sweep.Before(deadline) {
+		sweep = sweep.Add(interval)
+	}
+	timeoutTask.VisibilityTimestamp = sweep
+}
+
 func (e *mutableStateBuilder) GetTransferTasks() []persistence.Task {
 	return e.insertTransferTasks
 }
@@ -1670,9 +1728,22 @@ func (e *mutableStateBuilder) cleanupTransaction() error {
 	e.insertTimerTasks = nil
 
 	e.workflowRequests = make(map[persistence.WorkflowRequest]struct{})
+	e.closedActivityAuditRecords = e.activityAuditRecords
+	e.activityAuditRecords = nil
+	e.closedActivityTypeOutcomes = e.activityTypeOutcomes
+	e.activityTypeOutcomes = nil
+	e.closedActivityFailureAlerts = e.activityFailureAlerts
+	e.activityFailureAlerts = nil
 	return nil
 }
 
+// NotifyTransactionPersisted emits what must only be emitted for the persisted transitions of the workflow
+func (e *mutableStateBuilder) NotifyTransactionPersisted() {
+	e.writeActivityAuditRecords()
+	e.reportActivityTypeOutcomes()
+	e.sendActivityFailureAlerts()
+}
+
 func (e *mutableStateBuilder) prepareEventsAndReplicationTasks(
 	transactionPolicy TransactionPolicy,
 ) ([]*persistence.WorkflowEvents, error) {
@@ -23,16 +23,23 @@
 package execution
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/pborman/uuid"
+
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/codec/compression"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/audit"
 )
 
 // GetActivityInfo gives details about an activity that is currently in progress.
@@ -64,13 +71,94 @@ func (e *mutableStateBuilder) UpdateActivityProgress(
 	ai *persistence.ActivityInfo,
 	request *types.RecordActivityTaskHeartbeatRequest,
 ) {
+	now := e.timeSource.Now()
+	if !ai.LastHeartBeatUpdatedTime.IsZero() {
+		if gap := now.Sub(ai.LastHeartBeatUpdatedTime); gap > ai.MaxHeartbeatGap {
+			ai.MaxHeartbeatGap = gap
+		}
+	}
 	ai.Version = e.GetCurrentVersion()
 	ai.Details = request.Details
-	ai.LastHeartBeatUpdatedTime = e.timeSource.Now()
+	if request.GetCompressDetails() {
+		e.compressActivityDetails(ai)
+	}
+	ai.LastHeartBeatUpdatedTime = now
+	if request.ProgressPercent != nil {
+		e.updateActivityStall(ai, request.GetProgressPercent())
+	}
 	e.updateActivityInfos[ai.ScheduleID] = ai
 	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
 }
 
+// compressActivityDetails stores the heartbeat details compressed, unless compression does not make them smaller
+func (e *mutableStateBuilder) compressActivityDetails(
+	ai *persistence.ActivityInfo,
+) {
+
+	compressed, err := compression.Compress(ai.Details)
+	if err != nil {
+		e.logWarn("Unable to compress activity heartbeat details",
+			tag.WorkflowScheduleID(ai.ScheduleID),
+			tag.Error(err),
+		)
+		return
+	}
+	if len(compressed) < len(ai.Details) {
+		ai.Details = compressed
+	}
+}
+
+// GetActivityHeartbeatDetails returns the heartbeat details of the activity, inflated unless the caller accepts
+// details stored compressed. Details which cannot be inflated are returned as stored.
+func GetActivityHeartbeatDetails(
+	ai *persistence.ActivityInfo,
+	acceptCompressed bool,
+) []byte {
+
+	if acceptCompressed {
+		return ai.Details
+	}
+	details, err := compression.Inflate(ai.Details)
+	if err != nil {
+		return ai.Details
+	}
+	return details
+}
+
+// updateActivityStall counts the consecutive heartbeats of the attempt which did not advance the reported progress,
+// to catch activities which keep heartbeating without making progress and so never trip the heartbeat timeout
+func (e *mutableStateBuilder) updateActivityStall(
+	ai *persistence.ActivityInfo,
+	progressPercent int32,
+) {
+
+	if ai.ProgressPercent != nil && progressPercent <= *ai.ProgressPercent {
+		ai.StalledHeartbeats++
+	} else {
+		ai.StalledHeartbeats = 0
+	}
+	ai.ProgressPercent = common.Int32Ptr(progressPercent)
+
+	domainName := e.domainEntry.GetInfo().Name
+	if threshold := e.config.ActivityStalledHeartbeatThreshold(domainName); threshold > 0 && int(ai.StalledHeartbeats) == threshold {
+		e.metricsClient.Scope(metrics.WorkflowContextScope, metrics.DomainTag(domainName)).IncCounter(metrics.ActivityStalledCounter)
+		e.logWarn("Activity progress stalled",
+			tag.WorkflowDomainName(domainName),
+			tag.WorkflowScheduleID(ai.ScheduleID),
+			tag.Attempt(ai.Attempt),
+			tag.Counter(int(ai.StalledHeartbeats)),
+		)
+	}
+}
+
+// IsActivityStalled returns true if the last heartbeats of the activity did not advance its reported progress
+func IsActivityStalled(
+	ai *persistence.ActivityInfo,
+	stalledHeartbeatThreshold int,
+) bool {
+	return stalledHeartbeatThreshold > 0 && int(ai.StalledHeartbeats) >= stalledHeartbeatThreshold
+}
+
 // ReplicateActivityInfo replicate the necessary activity information
 func (e *mutableStateBuilder) ReplicateActivityInfo(
 	request *types.SyncActivityRequest,
@@ -132,6 +220,10 @@ func (e *mutableStateBuilder) DeleteActivity(
 ) error {
 
 	if activityInfo, ok := e.pendingActivityInfoIDs[scheduleEventID]; ok {
+		e.recordClosedActivityHeartbeat(activityInfo)
+		if err := e.clearActivitySearchAttributes(activityInfo); err != nil {
+			return err
+		}
 		delete(e.pendingActivityInfoIDs, scheduleEventID)
 
 		if _, ok = e.pendingActivityIDToEventID[activityInfo.ActivityID]; ok {
@@ -158,6 +250,60 @@ func (e *mutableStateBuilder) DeleteActivity(
 	return nil
 }
 
+// GetRecentlyClosedActivities returns the last heartbeat details of activities closed within
+// history.closedActivityHeartbeatDetailsRetention, oldest first.
+func (e *mutableStateBuilder) GetRecentlyClosedActivities() []*types.RecentlyClosedActivityInfo {
+	if len(e.closedActivityHeartbeats) == 0 {
+		return nil
+	}
+
+	e.pruneClosedActivityHeartbeats()
+	result := make([]*types.RecentlyClosedActivityInfo, 0, len(e.closedActivityHeartbeats))
+	for _, info := range e.closedActivityHeartbeats {
+		result = append(result, info)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetCloseTimestamp() < result[j].GetCloseTimestamp()
+	})
+	return result
+}
+
+// recordClosedActivityHeartbeat keeps the last heartbeat details of a closed activity, so that post-mortem
+// tooling can still read its final progress through DescribeWorkflowExecution. The details are only kept
+// in the cached mutable state, and are lost once the workflow is evicted from the cache.
+func (e *mutableStateBuilder) recordClosedActivityHeartbeat(
+	ai *persistence.ActivityInfo,
+) {
+
+	if ai.LastHeartBeatUpdatedTime.UnixNano() <= 0 {
+		return
+	}
+	if e.config.ClosedActivityHeartbeatDetailsRetention(e.domainEntry.GetInfo().Name) <= 0 {
+		return
+	}
+
+	e.pruneClosedActivityHeartbeats()
+	if e.closedActivityHeartbeats == nil {
+		e.closedActivityHeartbeats = make(map[string]*types.RecentlyClosedActivityInfo)
+	}
+	e.closedActivityHeartbeats[ai.ActivityID] = &types.RecentlyClosedActivityInfo{
+		ActivityID:             ai.ActivityID,
+		HeartbeatDetails:       GetActivityHeartbeatDetails(ai, false),
+		LastHeartbeatTimestamp: common.Int64Ptr(ai.LastHeartBeatUpdatedTime.UnixNano()),
+		CloseTimestamp:         common.Int64Ptr(e.timeSource.Now().UnixNano()),
+	}
+}
+
+func (e *mutableStateBuilder) pruneClosedActivityHeartbeats() {
+	retention := e.config.ClosedActivityHeartbeatDetailsRetention(e.domainEntry.GetInfo().Name)
+	now := e.timeSource.Now().UnixNano()
+	for activityID, info := range e.closedActivityHeartbeats {
+		if now-info.GetCloseTimestamp() >= retention.Nanoseconds() {
+			delete(e.closedActivityHeartbeats, activityID)
+		}
+	}
+}
+
 func (e *mutableStateBuilder) GetActivityScheduledEvent(
 	ctx context.Context,
 	scheduleEventID int64,
@@ -209,6 +355,30 @@ func (e *mutableStateBuilder) AddActivityTaskScheduledEvent(
 	dispatch bool,
 ) (*types.HistoryEvent, *persistence.ActivityInfo, *types.ActivityLocalDispatchInfo, bool, bool, error) {
 
+	return e.addActivityTaskScheduledEvent(ctx, decisionCompletedEventID, attributes, nil, dispatch)
+}
+
+// AddWeightedActivityTaskScheduledEvent schedules an activity selected by a ScheduleWeightedActivity decision,
+// the selection is recorded on the scheduled event
+func (e *mutableStateBuilder) AddWeightedActivityTaskScheduledEvent(
+	ctx context.Context,
+	decisionCompletedEventID int64,
+	attributes *types.ScheduleActivityTaskDecisionAttributes,
+	weightedSelection *types.WeightedActivitySelection,
+	dispatch bool,
+) (*types.HistoryEvent, *persistence.ActivityInfo, *types.ActivityLocalDispatchInfo, bool, bool, error) {
+
+	return e.addActivityTaskScheduledEvent(ctx, decisionCompletedEventID, attributes, weightedSelection, dispatch)
+}
+
+func (e *mutableStateBuilder) addActivityTaskScheduledEvent(
+	ctx context.Context,
+	decisionCompletedEventID int64,
+	attributes *types.ScheduleActivityTaskDecisionAttributes,
+	weightedSelection *types.WeightedActivitySelection,
+	dispatch bool,
+) (*types.HistoryEvent, *persistence.ActivityInfo, *types.ActivityLocalDispatchInfo, bool, bool, error) {
+
 	opTag := tag.WorkflowActionActivityTaskScheduled
 	if err := e.checkMutability(opTag); err != nil {
 		return nil, nil, nil, false, false, err
@@ -244,7 +414,14 @@ func (e *mutableStateBuilder) AddActivityTaskScheduledEvent(
 		e.pendingActivityWarningSent = true
 	}
 
-	event := e.hBuilder.AddActivityTaskScheduledEvent(decisionCompletedEventID, attributes)
+	// activities without a priority of their own inherit the workflow priority,
+	// the effective value is recorded on the scheduled event
+	if attributes.Priority == nil && e.executionInfo.Priority != nil {
+		attributes.Priority = common.Int32Ptr(*e.executionInfo.Priority)
+	}
+
+	event := e.hBuilder.AddActivityTaskScheduledEvent(decisionCompletedEventID, attributes, weightedSelection)
+	event.ActivityTaskScheduledEventAttributes.AttemptChainID = e.getActivityAttemptChainID(event.ID)
 
 	// Write the event to cache only on active cluster for processing on activity started or retried
 	e.eventsCache.PutEvent(
@@ -260,10 +437,21 @@ func (e *mutableStateBuilder) AddActivityTaskScheduledEvent(
 		return nil, nil, nil, false, false, err
 	}
 	activityStartedScope := e.metricsClient.Scope(metrics.HistoryRecordActivityTaskStartedScope)
-	if e.config.EnableActivityLocalDispatchByDomain(e.domainEntry.GetInfo().Name) && attributes.RequestLocalDispatch {
+	// activities whose kill switch is on are held back by the transfer queue until it is switched off, and so are
+	// activities whose type has its circuit breaker open
+	killSwitchOn := IsActivityKillSwitchOn(e.config, e.domainEntry.GetInfo().Name, attributes.GetKillSwitchTag())
+	breakerClosed := e.isActivityTypeBreakerClosed(attributes.GetActivityType().GetName())
+	// the capabilities of the decision worker are unknown, so activities requiring some are never dispatched to it
+	if e.config.EnableActivityLocalDispatchByDomain(e.domainEntry.GetInfo().Name) && attributes.RequestLocalDispatch &&
+		len(attributes.GetRequiredCapabilities()) == 0 && !killSwitchOn && breakerClosed {
 		activityStartedScope.IncCounter(metrics.CadenceRequests)
 		return event, ai, &types.ActivityLocalDispatchInfo{ActivityID: ai.ActivityID}, false, false, nil
 	}
+	// ordered activities are dispatched by the transfer queue, which holds them back behind the activities scheduled before,
+	// and so are activities with a dispatch window, which the transfer queue holds back until the window opens, and
+	// activities depending on another one, which the transfer queue holds back until that activity completes
+	dispatch = dispatch && !attributes.OrderedDispatch && attributes.DispatchWindow == nil && !killSwitchOn && breakerClosed &&
+		attributes.DependsOnActivityID == ""
 	started := false
 	if dispatch {
 		started = e.tryDispatchActivityTask(ctx, event, ai)
@@ -290,7 +478,7 @@ func (e *mutableStateBuilder) tryDispatchActivityTask(
 		metrics.WorkflowTypeTag(e.GetWorkflowType().Name),
 		metrics.TaskListTag(ai.TaskList))
 	taggedScope.IncCounter(metrics.DecisionTypeScheduleActivityDispatchCounter)
-	_, err := e.shard.GetService().GetMatchingClient().AddActivityTask(ctx, &types.AddActivityTaskRequest{
+	resp, err := e.shard.GetService().GetMatchingClient().AddActivityTask(ctx, &types.AddActivityTaskRequest{
 		DomainUUID:       e.executionInfo.DomainID,
 		SourceDomainUUID: e.domainEntry.GetInfo().ID,
 		Execution: &types.WorkflowExecution{
@@ -306,11 +494,18 @@ func (e *mutableStateBuilder) tryDispatchActivityTask(
 			WorkflowType:                    e.GetWorkflowType(),
 			WorkflowDomain:                  e.GetDomainEntry().GetInfo().Name,
 			ScheduledTimestampOfThisAttempt: common.Int64Ptr(ai.ScheduledTime.UnixNano()),
+			WasCancelBeforeStart:            ai.CancelRequested,
 		},
 		PartitionConfig: e.executionInfo.PartitionConfig,
+		RoutingKey:      ai.RoutingKey,
+		Priority:        ai.Priority,
 	})
 	if err == nil {
 		taggedScope.IncCounter(metrics.DecisionTypeScheduleActivityDispatchSucceedCounter)
+		if ai.HasRetryPolicy {
+			ai.VisibilityTimeout = resp.GetVisibilityTimeoutSeconds()
+		}
+		ai.StealTimeout = GetActivityStealTimeout(ai, resp.GetStealThreshold())
 		return true
 	}
 	return false
@@ -336,32 +531,55 @@ func (e *mutableStateBuilder) ReplicateActivityTaskScheduledEvent(
 	scheduleToCloseTimeout := attributes.GetScheduleToCloseTimeoutSeconds()
 
 	ai := &persistence.ActivityInfo{
-		Version:                  event.Version,
-		ScheduleID:               scheduleEventID,
-		ScheduledEventBatchID:    firstEventID,
-		ScheduledTime:            time.Unix(0, event.GetTimestamp()),
-		StartedID:                common.EmptyEventID,
-		StartedTime:              time.Time{},
-		ActivityID:               attributes.ActivityID,
-		DomainID:                 targetDomainID,
-		ScheduleToStartTimeout:   attributes.GetScheduleToStartTimeoutSeconds(),
-		ScheduleToCloseTimeout:   scheduleToCloseTimeout,
-		StartToCloseTimeout:      attributes.GetStartToCloseTimeoutSeconds(),
-		HeartbeatTimeout:         attributes.GetHeartbeatTimeoutSeconds(),
-		CancelRequested:          false,
-		CancelRequestID:          common.EmptyEventID,
-		LastHeartBeatUpdatedTime: time.Time{},
-		TimerTaskStatus:          TimerTaskStatusNone,
-		TaskList:                 attributes.TaskList.GetName(),
-		HasRetryPolicy:           attributes.RetryPolicy != nil,
+		Version:                         event.Version,
+		ScheduleID:                      scheduleEventID,
+		ScheduledEventBatchID:           firstEventID,
+		ScheduledTime:                   time.Unix(0, event.GetTimestamp()),
+		StartedID:                       common.EmptyEventID,
+		StartedTime:                     time.Time{},
+		ActivityID:                      attributes.ActivityID,
+		DomainID:                        targetDomainID,
+		ScheduleToStartTimeout:          attributes.GetScheduleToStartTimeoutSeconds(),
+		ScheduleToCloseTimeout:          scheduleToCloseTimeout,
+		StartToCloseTimeout:             attributes.GetStartToCloseTimeoutSeconds(),
+		HeartbeatTimeout:                attributes.GetHeartbeatTimeoutSeconds(),
+		CancelRequested:                 false,
+		CancelRequestID:                 common.EmptyEventID,
+		LastHeartBeatUpdatedTime:        time.Time{},
+		TimerTaskStatus:                 TimerTaskStatusNone,
+		TaskList:                        attributes.TaskList.GetName(),
+		HasRetryPolicy:                  attributes.RetryPolicy != nil,
+		Priority:                        attributes.Priority,
+		FirstAttemptStartToCloseTimeout: attributes.GetFirstAttemptStartToCloseSeconds(),
+		SearchAttributes:                attributes.GetSearchAttributes().GetIndexedFields(),
+		FallbackTaskList:                attributes.FallbackTaskList.GetName(),
+		EncryptionKeyID:                 attributes.GetEncryptionKeyID(),
+		NextActivity:                    attributes.NextActivity,
+		CancellationCheckpointGrace:     attributes.GetCancellationCheckpointGraceSeconds(),
+		AtMostOnce:                      attributes.GetAtMostOnce(),
+		AlertOnFailure:                  attributes.GetAlertOnFailure(),
+		DependsOnActivityID:             attributes.GetDependsOnActivityID(),
+		OnDependencyFailure:             attributes.GetOnDependencyFailure(),
+		Idempotent:                      attributes.GetIdempotent(),
+		ExpectedResultSizeBytes:         attributes.GetExpectedResultSizeBytes(),
+		ResultSearchAttributes:          attributes.GetResultSearchAttributes(),
+		RoutingKey:                      attributes.GetRoutingKey(),
+	}
+	if escalation := attributes.GetTaskListEscalation(); len(escalation) != 0 {
+		ai.TaskListEscalation = append(ai.TaskListEscalation, ai.TaskList)
+		for _, taskList := range escalation {
+			ai.TaskListEscalation = append(ai.TaskListEscalation, taskList.GetName())
+		}
 	}
 
 	if ai.HasRetryPolicy {
 		ai.InitialInterval = attributes.RetryPolicy.GetInitialIntervalInSeconds()
 		ai.BackoffCoefficient = attributes.RetryPolicy.GetBackoffCoefficient()
 		ai.MaximumInterval = attributes.RetryPolicy.GetMaximumIntervalInSeconds()
+		ai.MinimumInterval = attributes.RetryPolicy.GetMinimumIntervalInSeconds()
 		ai.MaximumAttempts = attributes.RetryPolicy.GetMaximumAttempts()
 		ai.NonRetriableErrors = attributes.RetryPolicy.NonRetriableErrorReasons
+		ai.MaxTotalExecutionSeconds = attributes.RetryPolicy.GetMaxTotalExecutionSeconds()
 		if attributes.RetryPolicy.GetExpirationIntervalInSeconds() != 0 {
 			ai.ExpirationTime = ai.ScheduledTime.Add(time.Duration(attributes.RetryPolicy.GetExpirationIntervalInSeconds()) * time.Second)
 		}
@@ -378,6 +596,32 @@ func (e *mutableStateBuilder) ReplicateActivityTaskScheduledEvent(
 	return ai, nil
 }
 
+// getActivityMaintenancePause returns how maintenance windows suspended the timeouts of the current attempt
+// of the activity, or nil if none did
+func getActivityMaintenancePause(
+	ai *persistence.ActivityInfo,
+) *types.ActivityMaintenancePause {
+	if ai.MaintenanceTimeoutsDeferred == 0 {
+		return nil
+	}
+	return &types.ActivityMaintenancePause{
+		TimeoutsDeferred:             ai.MaintenanceTimeoutsDeferred,
+		StartToCloseExtensionSeconds: int64(ai.MaintenancePausedTime / time.Second),
+	}
+}
+
+// getActivityAttemptChainID returns the ID recorded on the events of every attempt of the activity scheduled by
+// the given event. It is derived from the run and the schedule event instead of being persisted, so it stays the
+// same across retries, mutable state reloads and replication.
+func (e *mutableStateBuilder) getActivityAttemptChainID(
+	scheduleEventID int64,
+) string {
+	return uuid.NewSHA1(
+		uuid.NameSpace_OID,
+		[]byte(fmt.Sprintf("%v/%v", e.executionInfo.RunID, scheduleEventID)),
+	).String()
+}
+
 func (e *mutableStateBuilder) addTransientActivityStartedEvent(
 	scheduleEventID int64,
 ) error {
@@ -390,6 +634,12 @@ func (e *mutableStateBuilder) addTransientActivityStartedEvent(
 	// activity task was started (as transient event), we need to add it now.
 	event := e.hBuilder.AddActivityTaskStartedEvent(scheduleEventID, ai.Attempt, ai.RequestID, ai.StartedIdentity,
 		ai.LastFailureReason, ai.LastFailureDetails)
+	event.ActivityTaskStartedEventAttributes.AttemptChainID = e.getActivityAttemptChainID(scheduleEventID)
+	event.ActivityTaskStartedEventAttributes.RetriedAttempts = GetActivityRetriedAttempts(ai)
+	if (ai.FallbackTaskList != "" && ai.TaskList == ai.FallbackTaskList) ||
+		(len(ai.TaskListEscalation) != 0 && ai.TaskList != ai.TaskListEscalation[0]) {
+		event.ActivityTaskStartedEventAttributes.TaskList = &types.TaskList{Name: ai.TaskList}
+	}
 	if !ai.StartedTime.IsZero() {
 		// overwrite started event time to the one recorded in ActivityInfo
 		event.Timestamp = common.Int64Ptr(ai.StartedTime.UnixNano())
@@ -408,14 +658,16 @@ func (e *mutableStateBuilder) AddActivityTaskStartedEvent(
 	if err := e.checkMutability(opTag); err != nil {
 		return nil, err
 	}
+	e.recordActivityAudit(ai, audit.ActivityTransitionStarted, identity, "", false)
 
 	if !ai.HasRetryPolicy {
 		event := e.hBuilder.AddActivityTaskStartedEvent(scheduleEventID, ai.Attempt, requestID, identity,
 			ai.LastFailureReason, ai.LastFailureDetails)
+		event.ActivityTaskStartedEventAttributes.AttemptChainID = e.getActivityAttemptChainID(scheduleEventID)
 		if err := e.ReplicateActivityTaskStartedEvent(event); err != nil {
 			return nil, err
 		}
-		return event, nil
+		return event, e.upsertActivitySearchAttributes(ai)
 	}
 
 	// we might need to retry, so do not append started event just yet,
@@ -430,7 +682,37 @@ func (e *mutableStateBuilder) AddActivityTaskStartedEvent(
 		return nil, err
 	}
 	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
-	return nil, nil
+	return nil, e.upsertActivitySearchAttributes(ai)
+}
+
+// upsertActivitySearchAttributes adds the search attributes contributed by a started activity to the
+// workflow search attributes, so visibility queries can find workflows by the activities they are running.
+func (e *mutableStateBuilder) upsertActivitySearchAttributes(
+	ai *persistence.ActivityInfo,
+) error {
+
+	if len(ai.SearchAttributes) == 0 {
+		return nil
+	}
+	e.executionInfo.SearchAttributes = mergeMapOfByteArray(e.executionInfo.SearchAttributes, ai.SearchAttributes)
+	return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
+}
+
+// clearActivitySearchAttributes removes the search attributes contributed by a closed activity. A key is left
+// untouched if its value was changed after the activity started, e.g. by an upsert from the workflow.
+func (e *mutableStateBuilder) clearActivitySearchAttributes(
+	ai *persistence.ActivityInfo,
+) error {
+
+	if len(ai.SearchAttributes) == 0 || ai.StartedID == common.EmptyEventID {
+		return nil
+	}
+	for key, value := range ai.SearchAttributes {
+		if current, ok := e.executionInfo.SearchAttributes[key]; ok && bytes.Equal(current, value) {
+			delete(e.executionInfo.SearchAttributes, key)
+		}
+	}
+	return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
 }
 
 func (e *mutableStateBuilder) ReplicateActivityTaskStartedEvent(
@@ -461,6 +743,7 @@ func (e *mutableStateBuilder) AddActivityTaskCompletedEvent(
 	scheduleEventID int64,
 	startedEventID int64,
 	request *types.RespondActivityTaskCompletedRequest,
+	options *ActivityTaskCompletedOptions,
 ) (*types.HistoryEvent, error) {
 
 	opTag := tag.WorkflowActionActivityTaskCompleted
@@ -468,7 +751,8 @@ func (e *mutableStateBuilder) AddActivityTaskCompletedEvent(
 		return nil, err
 	}
 
-	if ai, ok := e.GetActivityInfo(scheduleEventID); !ok || ai.StartedID != startedEventID {
+	ai, ok := e.GetActivityInfo(scheduleEventID)
+	if !ok || ai.StartedID != startedEventID {
 		e.logger.Warn(
 			mutableStateInvalidHistoryActionMsg,
 			opTag,
@@ -483,14 +767,87 @@ func (e *mutableStateBuilder) AddActivityTaskCompletedEvent(
 	if err := e.addTransientActivityStartedEvent(scheduleEventID); err != nil {
 		return nil, err
 	}
-	event := e.hBuilder.AddActivityTaskCompletedEvent(scheduleEventID, startedEventID, request)
+	if options == nil {
+		options = &ActivityTaskCompletedOptions{}
+	}
+	result := request.Result
+	if options.ResultReferenceScheduledEventID != 0 || options.ResultBlobKey != "" {
+		result = nil
+	}
+	event := e.hBuilder.AddActivityTaskCompletedEvent(&types.ActivityTaskCompletedEventAttributes{
+		Result:                          result,
+		ScheduledEventID:                scheduleEventID,
+		StartedEventID:                  startedEventID,
+		Identity:                        request.Identity,
+		AttemptChainID:                  e.getActivityAttemptChainID(scheduleEventID),
+		EncryptionKeyID:                 ai.EncryptionKeyID,
+		ResultReferenceScheduledEventID: options.ResultReferenceScheduledEventID,
+		Synthesized:                     options.Synthesized,
+		TimeoutType:                     options.TimeoutType,
+		ResultSchemaVersion:             request.ResultSchemaVersion,
+		MaintenancePause:                getActivityMaintenancePause(ai),
+		IncrementedCounter:              e.getIncrementableWorkflowCounter(request.GetIncrementCounter()),
+		ResultBlobKey:                   options.ResultBlobKey,
+		ResultSearchAttributes:          e.extractActivityResultSearchAttributes(ai, request.Result),
+	})
+	e.traceActivityAttempt(ai, activityOutcomeCompleted, "")
+	e.recordActivityTypeOutcome(ai, true)
+	e.recordActivityRetryStats(ai, true)
+	e.recordActivityAudit(ai, audit.ActivityTransitionCompleted, request.GetIdentity(), "", false)
 	if err := e.ReplicateActivityTaskCompletedEvent(event); err != nil {
 		return nil, err
 	}
+	if ai.NextActivity != nil {
+		if err := e.scheduleNextActivity(ai, request.Result); err != nil {
+			return nil, err
+		}
+	}
+	e.recordActivityBatchCompletion(ai)
 
 	return event, nil
 }
 
+// ActivityTaskCompletedOptions are the attributes of an ActivityTaskCompleted event that are not taken from the
+// completion request
+type ActivityTaskCompletedOptions struct {
+	// Synthesized is set when the result is the OnTimeoutDefaultResult of the activity recorded by history on
+	// a timeout of the given TimeoutType
+	Synthesized bool
+	TimeoutType *types.TimeoutType
+	// ResultReferenceScheduledEventID, when set, is the scheduled event ID of an earlier completed activity of the
+	// same run with an identical result, the event records it instead of the result
+	ResultReferenceScheduledEventID int64
+	// ResultBlobKey, when set, is the blobstore key the result was archived to, the event records it instead of
+	// the result
+	ResultBlobKey string
+}
+
+// scheduleNextActivity schedules the next activity declared by a completed activity, with the result of the
+// completed activity as its input. The chain stops without failing the completion if the next activity
+// cannot be scheduled, the decider learns about it as the next activity is missing from the history.
+func (e *mutableStateBuilder) scheduleNextActivity(
+	ai *persistence.ActivityInfo,
+	result []byte,
+) error {
+
+	attributes := *ai.NextActivity
+	attributes.Input = result
+	// the next activity is part of the decision which scheduled the completed activity
+	event, _, _, _, _, err := e.AddActivityTaskScheduledEvent(context.Background(), ai.ScheduledEventBatchID, &attributes, false)
+	if err != nil {
+		if _, ok := err.(*types.BadRequestError); !ok && err != ErrTooManyPendingActivities {
+			return err
+		}
+		e.logWarn("Next activity of completed activity not scheduled",
+			tag.WorkflowScheduleID(ai.ScheduleID),
+			tag.WorkflowActivityID(attributes.GetActivityID()),
+			tag.Error(err))
+		return nil
+	}
+	event.ActivityTaskScheduledEventAttributes.ChainedFromScheduledEventID = common.Int64Ptr(ai.ScheduleID)
+	return nil
+}
+
 func (e *mutableStateBuilder) ReplicateActivityTaskCompletedEvent(
 	event *types.HistoryEvent,
 ) error {
@@ -498,7 +855,46 @@ func (e *mutableStateBuilder) ReplicateActivityTaskCompletedEvent(
 	attributes := event.ActivityTaskCompletedEventAttributes
 	scheduleID := attributes.GetScheduledEventID()
 
-	return e.DeleteActivity(scheduleID)
+	if counter := attributes.GetIncrementedCounter(); counter != "" {
+		if e.executionInfo.Counters == nil {
+			e.executionInfo.Counters = make(map[string]int64)
+		}
+		e.executionInfo.Counters[counter]++
+	}
+	if err := e.DeleteActivity(scheduleID); err != nil {
+		return err
+	}
+	// merged after the activity is deleted, which clears the search attributes it contributed while running
+	if resultSearchAttributes := attributes.GetResultSearchAttributes().GetIndexedFields(); len(resultSearchAttributes) != 0 {
+		e.executionInfo.SearchAttributes = mergeMapOfByteArray(e.executionInfo.SearchAttributes, resultSearchAttributes)
+		return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
+	}
+	return nil
+}
+
+// getIncrementableWorkflowCounter returns the counter an activity completion increments, or an empty
+// string if the counter would be a new one past the counter limit of the workflow
+func (e *mutableStateBuilder) getIncrementableWorkflowCounter(
+	counter string,
+) string {
+	if counter == "" {
+		return ""
+	}
+	if _, ok := e.executionInfo.Counters[counter]; ok {
+		return counter
+	}
+	domainName := e.GetDomainEntry().GetInfo().Name
+	if len(e.executionInfo.Counters) >= e.config.WorkflowCounterLimit(domainName) {
+		e.metricsClient.Scope(metrics.HistoryRespondActivityTaskCompletedScope, metrics.DomainTag(domainName)).
+			IncCounter(metrics.WorkflowCounterLimitExceededCounter)
+		e.logWarn("Workflow counter limit exceeded, counter not incremented",
+			tag.WorkflowDomainName(domainName),
+			tag.WorkflowID(e.executionInfo.WorkflowID),
+			tag.WorkflowRunID(e.executionInfo.RunID),
+			tag.Value(counter))
+		return ""
+	}
+	return counter
 }
 
 func (e *mutableStateBuilder) AddActivityTaskFailedEvent(
@@ -512,7 +908,8 @@ func (e *mutableStateBuilder) AddActivityTaskFailedEvent(
 		return nil, err
 	}
 
-	if ai, ok := e.GetActivityInfo(scheduleEventID); !ok || ai.StartedID != startedEventID {
+	ai, ok := e.GetActivityInfo(scheduleEventID)
+	if !ok || ai.StartedID != startedEventID {
 		e.logger.Warn(mutableStateInvalidHistoryActionMsg, opTag,
 			tag.WorkflowEventID(e.GetNextEventID()),
 			tag.ErrorTypeInvalidHistoryAction,
@@ -526,9 +923,20 @@ func (e *mutableStateBuilder) AddActivityTaskFailedEvent(
 		return nil, err
 	}
 	event := e.hBuilder.AddActivityTaskFailedEvent(scheduleEventID, startedEventID, request)
+	event.ActivityTaskFailedEventAttributes.AttemptChainID = e.getActivityAttemptChainID(scheduleEventID)
+	event.ActivityTaskFailedEventAttributes.MaintenancePause = getActivityMaintenancePause(ai)
+	e.traceActivityAttempt(ai, activityOutcomeFailed, request.GetReason())
+	e.recordActivityTypeOutcome(ai, false)
+	e.recordActivityRetryStats(ai, false)
+	e.recordActivityAudit(ai, audit.ActivityTransitionFailed, request.GetIdentity(), request.GetReason(), false)
+	e.recordActivityFailureAlert(ai, request.GetReason(), request.GetDetails())
 	if err := e.ReplicateActivityTaskFailedEvent(event); err != nil {
 		return nil, err
 	}
+	if err := e.resolveActivityDependents(ai.ActivityID); err != nil {
+		return nil, err
+	}
+	e.recordActivityBatchCompletion(ai)
 
 	return event, nil
 }
@@ -572,9 +980,24 @@ func (e *mutableStateBuilder) AddActivityTaskTimedOutEvent(
 		return nil, err
 	}
 	event := e.hBuilder.AddActivityTaskTimedOutEvent(scheduleEventID, startedEventID, timeoutType, lastHeartBeatDetails, ai.LastFailureReason, ai.LastFailureDetails)
+	event.ActivityTaskTimedOutEventAttributes.AttemptChainID = e.getActivityAttemptChainID(scheduleEventID)
+	event.ActivityTaskTimedOutEventAttributes.MaintenancePause = getActivityMaintenancePause(ai)
+	event.ActivityTaskTimedOutEventAttributes.LastHeartbeatDetails = lastHeartBeatDetails
+	if lastHeartbeatUnixNano := ai.LastHeartBeatUpdatedTime.UnixNano(); lastHeartbeatUnixNano > 0 {
+		event.ActivityTaskTimedOutEventAttributes.LastHeartbeatTimestamp = common.Int64Ptr(lastHeartbeatUnixNano)
+	}
+	e.traceActivityAttempt(ai, activityOutcomeTimedOut, timeoutType.String())
+	e.recordActivityTypeOutcome(ai, false)
+	e.recordActivityRetryStats(ai, false)
+	e.recordActivityAudit(ai, audit.ActivityTransitionTimedOut, ai.StartedIdentity, timeoutType.String(), false)
+	e.recordActivityFailureAlert(ai, timeoutType.String(), lastHeartBeatDetails)
 	if err := e.ReplicateActivityTaskTimedOutEvent(event); err != nil {
 		return nil, err
 	}
+	if err := e.resolveActivityDependents(ai.ActivityID); err != nil {
+		return nil, err
+	}
+	e.recordActivityBatchCompletion(ai)
 
 	return event, nil
 }
@@ -644,6 +1067,7 @@ func (e *mutableStateBuilder) ReplicateActivityTaskCancelRequestedEvent(
 	ai.CancelRequested = true
 
 	ai.CancelRequestID = event.ID
+	ai.CancelRequestedTime = time.Unix(0, event.GetTimestamp())
 	e.updateActivityInfos[ai.ScheduleID] = ai
 	return nil
 }
@@ -700,9 +1124,17 @@ func (e *mutableStateBuilder) AddActivityTaskCanceledEvent(
 	}
 	event := e.hBuilder.AddActivityTaskCanceledEvent(scheduleEventID, startedEventID, latestCancelRequestedEventID,
 		details, identity)
+	event.ActivityTaskCanceledEventAttributes.AttemptChainID = e.getActivityAttemptChainID(scheduleEventID)
+	event.ActivityTaskCanceledEventAttributes.MaintenancePause = getActivityMaintenancePause(ai)
+	e.traceActivityAttempt(ai, activityOutcomeCanceled, "")
+	e.recordActivityAudit(ai, audit.ActivityTransitionCanceled, identity, "", false)
 	if err := e.ReplicateActivityTaskCanceledEvent(event); err != nil {
 		return nil, err
 	}
+	if err := e.resolveActivityDependents(ai.ActivityID); err != nil {
+		return nil, err
+	}
+	e.recordActivityBatchCompletion(ai)
 
 	return event, nil
 }
@@ -721,6 +1153,7 @@ func (e *mutableStateBuilder) RetryActivity(
 	ai *persistence.ActivityInfo,
 	failureReason string,
 	failureDetails []byte,
+	failureCategory *types.ActivityFailureCategory,
 ) (bool, error) {
 
 	opTag := tag.WorkflowActionActivityTaskRetry
@@ -732,8 +1165,31 @@ func (e *mutableStateBuilder) RetryActivity(
 		return false, nil
 	}
 
+	// the worker knows that another attempt cannot succeed
+	if failureCategory != nil && *failureCategory == types.ActivityFailureCategoryPermanent {
+		return false, nil
+	}
+
+	domainName := e.domainEntry.GetInfo().Name
+	if e.config.DisableActivityRetries(domainName) {
+		e.metricsClient.Scope(metrics.WorkflowContextScope, metrics.DomainTag(domainName)).IncCounter(metrics.ActivityRetrySuppressedCounter)
+		e.logInfo("Activity retry suppressed, activity retries are disabled for the domain",
+			tag.WorkflowDomainName(domainName),
+			tag.WorkflowScheduleID(ai.ScheduleID),
+			tag.Attempt(ai.Attempt),
+		)
+		return false, nil
+	}
+
 	now := e.timeSource.Now()
 
+	if !ai.StartedTime.IsZero() {
+		ai.TotalExecutionTime += now.Sub(ai.StartedTime)
+	}
+	if ai.MaxTotalExecutionSeconds > 0 && ai.TotalExecutionTime >= time.Duration(ai.MaxTotalExecutionSeconds)*time.Second {
+		return false, nil
+	}
+
 	backoffInterval := getBackoffInterval(
 		now,
 		ai.ExpirationTime,
@@ -741,6 +1197,7 @@ func (e *mutableStateBuilder) RetryActivity(
 		ai.MaximumAttempts,
 		ai.InitialInterval,
 		ai.MaximumInterval,
+		ai.MinimumInterval,
 		ai.BackoffCoefficient,
 		failureReason,
 		ai.NonRetriableErrors,
@@ -749,9 +1206,29 @@ func (e *mutableStateBuilder) RetryActivity(
 		return false, nil
 	}
 
+	// throttled failures back off for longer to give the overloaded dependency room to recover,
+	// but never past the expiration of the retry policy
+	if failureCategory != nil && *failureCategory == types.ActivityFailureCategoryThrottled {
+		backoffInterval *= throttledActivityFailureBackoffMultiplier
+		if !ai.ExpirationTime.IsZero() && now.Add(backoffInterval).After(ai.ExpirationTime) {
+			backoffInterval = ai.ExpirationTime.Sub(now)
+		}
+	}
+
 	// a retry is needed, update activity info for next retry
+	e.traceActivityAttempt(ai, activityOutcomeRetry, failureReason)
+	e.recordActivityTypeOutcome(ai, false)
+	e.recordActivityRetryStats(ai, false)
+	retryTransition := audit.ActivityTransitionTimedOut
+	if FailureReasonToActivityRescheduleCause(failureReason) == types.ActivityRescheduleCauseFailure {
+		retryTransition = audit.ActivityTransitionFailed
+	}
+	e.recordActivityAudit(ai, retryTransition, ai.StartedIdentity, failureReason, true)
+	recordActivityRescheduleReason(ai, failureReason, now)
+	e.updateActivityFallbackTaskList(ai, failureReason)
 	ai.Version = e.GetCurrentVersion()
 	ai.Attempt++
+	e.updateActivityEscalationTaskList(ai)
 	ai.ScheduledTime = now.Add(backoffInterval) // update to next schedule time
 	ai.StartedID = common.EmptyEventID
 	ai.RequestID = ""
@@ -760,6 +1237,14 @@ func (e *mutableStateBuilder) RetryActivity(
 	ai.LastFailureReason = failureReason
 	ai.LastWorkerIdentity = ai.StartedIdentity
 	ai.LastFailureDetails = failureDetails
+	ai.MaxHeartbeatGap = 0
+	ai.GrantedStartToCloseTimeout = 0
+	ai.MaintenancePausedTime = 0
+	ai.MaintenanceTimeoutsDeferred = 0
+	ai.ProgressPercent = nil
+	ai.StalledHeartbeats = 0
+	ai.NackCount = 0
+	ai.LastNackReason = ""
 
 	if err := e.taskGenerator.GenerateActivityRetryTasks(
 		ai.ScheduleID,
@@ -771,3 +1256,433 @@ func (e *mutableStateBuilder) RetryActivity(
 	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
 	return true, nil
 }
+
+// updateActivityFallbackTaskList counts the consecutive ScheduleToStart timeouts of an activity, and moves
+// the activity to its fallback task list once they reach ActivityFallbackTaskListScheduleToStartTimeouts,
+// as no worker seems to poll the task list it was scheduled on
+func (e *mutableStateBuilder) updateActivityFallbackTaskList(
+	ai *persistence.ActivityInfo,
+	failureReason string,
+) {
+
+	if failureReason != TimerTypeToReason(TimerTypeScheduleToStart) {
+		ai.ScheduleToStartTimeouts = 0
+		return
+	}
+	ai.ScheduleToStartTimeouts++
+
+	if ai.FallbackTaskList == "" || ai.TaskList == ai.FallbackTaskList {
+		return
+	}
+	domainName := e.domainEntry.GetInfo().Name
+	if int(ai.ScheduleToStartTimeouts) < e.config.ActivityFallbackTaskListScheduleToStartTimeouts(domainName) {
+		return
+	}
+
+	e.logInfo("Activity moved to its fallback task list after repeated ScheduleToStart timeouts",
+		tag.WorkflowDomainName(domainName),
+		tag.WorkflowScheduleID(ai.ScheduleID),
+		tag.WorkflowTaskListName(ai.FallbackTaskList),
+		tag.Attempt(ai.Attempt),
+	)
+	ai.TaskList = ai.FallbackTaskList
+}
+
+// updateActivityEscalationTaskList moves an activity with a TaskListEscalation to the task list of its current attempt,
+// the attempts past the end of the escalation stay on its last task list
+func (e *mutableStateBuilder) updateActivityEscalationTaskList(
+	ai *persistence.ActivityInfo,
+) {
+
+	if len(ai.TaskListEscalation) == 0 {
+		return
+	}
+	taskList := ai.TaskListEscalation[common.MinInt(int(ai.Attempt), len(ai.TaskListEscalation)-1)]
+	if taskList == ai.TaskList {
+		return
+	}
+	e.logInfo("Activity attempt escalated to another task list",
+		tag.WorkflowDomainName(e.domainEntry.GetInfo().Name),
+		tag.WorkflowScheduleID(ai.ScheduleID),
+		tag.WorkflowTaskListName(taskList),
+		tag.Attempt(ai.Attempt),
+	)
+	ai.TaskList = taskList
+}
+
+// recordActivityRescheduleReason keeps why the current attempt of the activity is scheduled again,
+// only the latest maxActivityRescheduleReasons are kept
+func recordActivityRescheduleReason(
+	ai *persistence.ActivityInfo,
+	failureReason string,
+	now time.Time,
+) {
+
+	reason := &types.ActivityRescheduleReason{
+		Attempt:   ai.Attempt,
+		Cause:     FailureReasonToActivityRescheduleCause(failureReason).Ptr(),
+		Timestamp: common.Int64Ptr(now.UnixNano()),
+		Identity:  ai.StartedIdentity,
+		TaskList:  ai.TaskList,
+	}
+	if !ai.StartedTime.IsZero() {
+		reason.StartedTimestamp = common.Int64Ptr(ai.StartedTime.UnixNano())
+	}
+	ai.RescheduleReasons = append(ai.RescheduleReasons, reason)
+	if len(ai.RescheduleReasons) > maxActivityRescheduleReasons {
+		ai.RescheduleReasons = ai.RescheduleReasons[len(ai.RescheduleReasons)-maxActivityRescheduleReasons:]
+	}
+}
+
+// GetActivityRetriedAttempts returns the attempts of an activity which were retried, oldest first. They are derived
+// from the reschedule reasons persisted with the activity info; when the latest retried attempt was not recorded,
+// it is derived from the last failure of the activity.
+func GetActivityRetriedAttempts(
+	ai *persistence.ActivityInfo,
+) []*types.ActivityWorkerAttempt {
+
+	var attempts []*types.ActivityWorkerAttempt
+	for _, reason := range ai.RescheduleReasons {
+		attempts = append(attempts, &types.ActivityWorkerAttempt{
+			ScheduledEventID: ai.ScheduleID,
+			Attempt:          reason.Attempt,
+			Identity:         reason.Identity,
+			Outcome:          activityRescheduleCauseToOutcome(reason.GetCause()).Ptr(),
+			StartedTimestamp: reason.StartedTimestamp,
+			EndTimestamp:     reason.Timestamp,
+			TaskList:         reason.TaskList,
+		})
+	}
+	lastAttempt := ai.Attempt - 1
+	if lastAttempt >= 0 && ai.LastFailureReason != "" && (len(attempts) == 0 || attempts[len(attempts)-1].Attempt < lastAttempt) {
+		attempts = append(attempts, &types.ActivityWorkerAttempt{
+			ScheduledEventID: ai.ScheduleID,
+			Attempt:          lastAttempt,
+			Identity:         ai.LastWorkerIdentity,
+			Outcome:          activityRescheduleCauseToOutcome(FailureReasonToActivityRescheduleCause(ai.LastFailureReason)).Ptr(),
+		})
+	}
+	return attempts
+}
+
+func activityRescheduleCauseToOutcome(
+	cause types.ActivityRescheduleCause,
+) types.EventType {
+
+	if cause == types.ActivityRescheduleCauseFailure {
+		return types.EventTypeActivityTaskFailed
+	}
+	return types.EventTypeActivityTaskTimedOut
+}
+
+// FailureReasonToActivityRescheduleCause maps the reason an activity attempt failed or timed out with
+// to the cause of the reschedule of the activity
+func FailureReasonToActivityRescheduleCause(
+	failureReason string,
+) types.ActivityRescheduleCause {
+
+	switch failureReason {
+	case TimerTypeToReason(TimerTypeHeartbeat):
+		return types.ActivityRescheduleCauseHeartbeatTimeout
+	case TimerTypeToReason(TimerTypeStartToClose):
+		return types.ActivityRescheduleCauseStartToCloseTimeout
+	case TimerTypeToReason(TimerTypeScheduleToStart):
+		return types.ActivityRescheduleCauseScheduleToStartTimeout
+	default:
+		return types.ActivityRescheduleCauseFailure
+	}
+}
+
+func (e *mutableStateBuilder) resetActivityAttempts(
+	ai *persistence.ActivityInfo,
+	version int64,
+	now time.Time,
+) error {
+
+	ai.Version = version
+	ai.Attempt = 0
+	e.updateActivityEscalationTaskList(ai)
+	if ai.StartedID == common.EmptyEventID {
+		ai.ScheduledTime = now
+		if err := e.taskGenerator.GenerateActivityRetryTasks(
+			ai.ScheduleID,
+		); err != nil {
+			return err
+		}
+	}
+
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
+
+// ActivityAttemptsResetDetails are the details of the marker recorded when the retry attempts of an activity
+// are reset, see common.ActivityAttemptsResetMarkerName
+type ActivityAttemptsResetDetails struct {
+	ScheduledEventID int64 `json:"scheduledEventId"`
+	// ScheduledEventBatchID is the first event ID of the batch of the scheduled event, to load it when the
+	// activity is reopened
+	ScheduledEventBatchID int64  `json:"scheduledEventBatchId"`
+	ActivityID            string `json:"activityId"`
+	PreviousAttempt       int32  `json:"previousAttempt"`
+	// Reopened is set if the activity had already failed after running out of retries and is scheduled again
+	Reopened bool   `json:"reopened,omitempty"`
+	Identity string `json:"identity,omitempty"`
+}
+
+// GetActivityAttemptsResetDetails returns the details of an activity attempts reset marker, or nil if the event
+// is not such a marker
+func GetActivityAttemptsResetDetails(
+	event *types.HistoryEvent,
+) (*ActivityAttemptsResetDetails, error) {
+
+	if event.GetEventType() != types.EventTypeMarkerRecorded ||
+		event.MarkerRecordedEventAttributes.GetMarkerName() != common.ActivityAttemptsResetMarkerName {
+		return nil, nil
+	}
+	details := &ActivityAttemptsResetDetails{}
+	if err := json.Unmarshal(event.MarkerRecordedEventAttributes.Details, details); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+// AddActivityAttemptsResetMarkerEvent records a reset of the retry attempts of an activity as a marker. An activity
+// which is pending between two attempts is rescheduled right away with its attempt counter reset, and an activity
+// which ran out of retries is scheduled again from its scheduled event.
+func (e *mutableStateBuilder) AddActivityAttemptsResetMarkerEvent(
+	ctx context.Context,
+	scheduleEventID int64,
+	scheduledEventBatchID int64,
+	activityID string,
+	previousAttempt int32,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionActivityTaskAttemptsReset
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	reopened := false
+	if ai, ok := e.GetActivityInfo(scheduleEventID); ok {
+		if ai.StartedID != common.EmptyEventID || ai.ActivityID != activityID {
+			e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+				tag.WorkflowEventID(e.GetNextEventID()),
+				tag.ErrorTypeInvalidHistoryAction,
+				tag.WorkflowScheduleID(scheduleEventID),
+				tag.WorkflowActivityID(ai.ActivityID),
+				tag.WorkflowStartedID(ai.StartedID))
+			return nil, e.createInternalServerError(opTag)
+		}
+	} else {
+		// the activity ID may have been reused by an activity scheduled after the closed one
+		if _, ok := e.GetActivityByActivityID(activityID); ok {
+			e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+				tag.WorkflowEventID(e.GetNextEventID()),
+				tag.ErrorTypeInvalidHistoryAction,
+				tag.WorkflowScheduleID(scheduleEventID),
+				tag.WorkflowActivityID(activityID))
+			return nil, e.createInternalServerError(opTag)
+		}
+		reopened = true
+	}
+
+	details, err := json.Marshal(&ActivityAttemptsResetDetails{
+		ScheduledEventID:      scheduleEventID,
+		ScheduledEventBatchID: scheduledEventBatchID,
+		ActivityID:            activityID,
+		PreviousAttempt:       previousAttempt,
+		Reopened:              reopened,
+		Identity:              identity,
+	})
+	if err != nil {
+		return nil, err
+	}
+	event := e.hBuilder.AddMarkerRecordedEvent(common.EmptyEventID, &types.RecordMarkerDecisionAttributes{
+		MarkerName: common.ActivityAttemptsResetMarkerName,
+		Details:    details,
+	})
+	if err := e.ReplicateActivityAttemptsResetMarkerEvent(ctx, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateActivityAttemptsResetMarkerEvent(
+	ctx context.Context,
+	event *types.HistoryEvent,
+) error {
+
+	details, err := GetActivityAttemptsResetDetails(event)
+	if err != nil || details == nil {
+		return &types.InternalServiceError{Message: "Unable to read activity attempts reset marker."}
+	}
+	if !details.Reopened {
+		ai, ok := e.GetActivityInfo(details.ScheduledEventID)
+		if !ok {
+			return ErrMissingActivityInfo
+		}
+		return e.resetActivityAttempts(ai, event.Version, time.Unix(0, event.GetTimestamp()))
+	}
+
+	currentBranchToken, err := e.GetCurrentBranchToken()
+	if err != nil {
+		return err
+	}
+	scheduledEvent, err := e.eventsCache.GetEvent(
+		ctx,
+		e.shard.GetShardID(),
+		e.executionInfo.DomainID,
+		e.executionInfo.WorkflowID,
+		e.executionInfo.RunID,
+		details.ScheduledEventBatchID,
+		details.ScheduledEventID,
+		currentBranchToken,
+	)
+	if err != nil {
+		if persistence.IsTransientError(err) {
+			return err
+		}
+		return ErrMissingActivityScheduledEvent
+	}
+	// the reopened activity is scheduled from the reset on, so that its timeouts and retry expiration start over
+	reopenedEvent := *scheduledEvent
+	reopenedEvent.Version = event.Version
+	reopenedEvent.Timestamp = event.Timestamp
+	_, err = e.ReplicateActivityTaskScheduledEvent(details.ScheduledEventBatchID, &reopenedEvent, false)
+	return err
+}
+
+// ExpireActivityHeartbeat makes the heartbeat timer of the started attempt of the activity fire right away, as if
+// its heartbeat timeout elapsed. The expiry is persisted with the activity info, and a heartbeat of the attempt
+// supersedes it.
+func (e *mutableStateBuilder) ExpireActivityHeartbeat(
+	ai *persistence.ActivityInfo,
+) error {
+
+	opTag := tag.WorkflowActionActivityHeartbeatExpire
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	ai.HeartbeatExpiredTime = e.timeSource.Now()
+	// the heartbeat timer task already created fires too late, a new one is created on close of the transaction
+	ai.TimerTaskStatus = ai.TimerTaskStatus &^ TimerTaskStatusCreatedHeartbeat
+	return e.UpdateActivity(ai)
+}
+
+// RedispatchActivity puts a started activity back to its task list once its visibility timeout expired,
+// so that another poller can pick it up right away. The attempt is bumped, which makes matching hand out
+// a new task token and history reject any later heartbeat or completion from the original worker.
+// Only activities with a retry policy can be redispatched, as their started event is not yet written.
+func (e *mutableStateBuilder) RedispatchActivity(
+	ai *persistence.ActivityInfo,
+) error {
+
+	return e.redispatchActivity(ai, tag.WorkflowActionActivityTaskRedispatch, activityVisibilityTimeoutReason)
+}
+
+// StealActivity puts a started idempotent activity back to its task list once it went without a heartbeat
+// for the steal threshold of its task list, so that a healthier poller can take it over before the heartbeat
+// timeout. Like RedispatchActivity the attempt is bumped, so the late heartbeat or completion of the original
+// worker is rejected. Only activities passing IsActivityStealable can be stolen.
+func (e *mutableStateBuilder) StealActivity(
+	ai *persistence.ActivityInfo,
+) error {
+
+	if !IsActivityStealable(ai) {
+		return e.createInternalServerError(tag.WorkflowActionActivityTaskSteal)
+	}
+	return e.redispatchActivity(ai, tag.WorkflowActionActivityTaskSteal, activityStolenReason)
+}
+
+func (e *mutableStateBuilder) redispatchActivity(
+	ai *persistence.ActivityInfo,
+	opTag tag.Tag,
+	reason string,
+) error {
+
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	if !ai.HasRetryPolicy || ai.StartedID != common.TransientEventID {
+		return e.createInternalServerError(opTag)
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.Attempt++
+	ai.TotalExecutionTime += e.timeSource.Now().Sub(ai.StartedTime)
+	ai.ScheduledTime = e.timeSource.Now()
+	ai.StartedID = common.EmptyEventID
+	ai.RequestID = ""
+	ai.StartedTime = time.Time{}
+	ai.TimerTaskStatus = TimerTaskStatusNone
+	ai.LastFailureReason = reason
+	ai.LastWorkerIdentity = ai.StartedIdentity
+	ai.LastFailureDetails = nil
+	ai.MaxHeartbeatGap = 0
+	ai.GrantedStartToCloseTimeout = 0
+	ai.MaintenancePausedTime = 0
+	ai.MaintenanceTimeoutsDeferred = 0
+	ai.VisibilityTimeout = 0
+	ai.StealTimeout = 0
+	ai.NackCount = 0
+	ai.LastNackReason = ""
+
+	if err := e.taskGenerator.GenerateActivityRetryTasks(
+		ai.ScheduleID,
+	); err != nil {
+		return err
+	}
+
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
+
+// NackActivity puts a started activity back to its task list because its worker declined to process it.
+// Unlike RedispatchActivity the attempt is left as is, only the nack count and reason are recorded,
+// callers are expected to fail the attempt instead once history.activityMaxNacks is reached.
+// Only activities with a retry policy can be nacked, as their started event is not yet written.
+func (e *mutableStateBuilder) NackActivity(
+	ai *persistence.ActivityInfo,
+	reason string,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskNack
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	if !ai.HasRetryPolicy || ai.StartedID != common.TransientEventID {
+		return e.createInternalServerError(opTag)
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.NackCount++
+	ai.LastNackReason = reason
+	ai.TotalExecutionTime += e.timeSource.Now().Sub(ai.StartedTime)
+	ai.ScheduledTime = e.timeSource.Now()
+	ai.StartedID = common.EmptyEventID
+	ai.RequestID = ""
+	ai.StartedTime = time.Time{}
+	ai.TimerTaskStatus = TimerTaskStatusNone
+	ai.MaxHeartbeatGap = 0
+	ai.GrantedStartToCloseTimeout = 0
+	ai.MaintenancePausedTime = 0
+	ai.MaintenanceTimeoutsDeferred = 0
+	ai.VisibilityTimeout = 0
+	ai.StealTimeout = 0
+
+	if err := e.taskGenerator.GenerateActivityRetryTasks(
+		ai.ScheduleID,
+	); err != nil {
+		return err
+	}
+
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
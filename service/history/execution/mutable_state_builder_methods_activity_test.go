@@ -23,18 +23,27 @@
 package execution
 
 import (
+	"bytes"
 	"context"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/codec/compression"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/activityalert"
+	"github.com/uber/cadence/service/history/audit"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/events"
@@ -85,6 +94,71 @@ func Test__UpdateActivityProgress(t *testing.T) {
 	assert.NotNil(t, mb.syncActivityTasks[ai.ScheduleID])
 }
 
+func Test__UpdateActivityProgress_Stalled(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	mb.config.ActivityStalledHeartbeatThreshold = dynamicconfig.GetIntPropertyFilteredByDomain(2)
+	threshold := mb.config.ActivityStalledHeartbeatThreshold(constants.TestDomainName)
+	ai := &persistence.ActivityInfo{ScheduleID: 1}
+	heartbeat := func(progressPercent int32) {
+		mb.UpdateActivityProgress(ai, &types.RecordActivityTaskHeartbeatRequest{ProgressPercent: common.Int32Ptr(progressPercent)})
+	}
+
+	heartbeat(10)
+	heartbeat(10)
+	assert.False(t, IsActivityStalled(ai, threshold))
+	heartbeat(5)
+	assert.True(t, IsActivityStalled(ai, threshold))
+
+	// a heartbeat without progress does not count
+	mb.UpdateActivityProgress(ai, &types.RecordActivityTaskHeartbeatRequest{Details: []byte{1}})
+	assert.True(t, IsActivityStalled(ai, threshold))
+
+	heartbeat(20)
+	assert.False(t, IsActivityStalled(ai, threshold))
+	assert.False(t, IsActivityStalled(ai, 0))
+}
+
+func Test__UpdateActivityProgress_CompressDetails(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	ai := &persistence.ActivityInfo{ScheduleID: 1}
+	checkpoint := bytes.Repeat([]byte("checkpoint"), 100)
+
+	mb.UpdateActivityProgress(ai, &types.RecordActivityTaskHeartbeatRequest{Details: checkpoint, CompressDetails: true})
+	assert.True(t, compression.IsCompressed(ai.Details))
+	assert.Less(t, len(ai.Details), len(checkpoint))
+	assert.Equal(t, checkpoint, GetActivityHeartbeatDetails(ai, false))
+	assert.Equal(t, ai.Details, GetActivityHeartbeatDetails(ai, true))
+
+	// details which do not shrink are stored as is
+	mb.UpdateActivityProgress(ai, &types.RecordActivityTaskHeartbeatRequest{Details: []byte{1}, CompressDetails: true})
+	assert.Equal(t, []byte{1}, ai.Details)
+	assert.Equal(t, []byte{1}, GetActivityHeartbeatDetails(ai, false))
+}
+
+func Test__UpdateActivityProgress_MaxHeartbeatGap(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	timeSource := clock.NewMockedTimeSource()
+	mb.timeSource = timeSource
+	ai := &persistence.ActivityInfo{
+		ScheduleID:               1,
+		LastHeartBeatUpdatedTime: timeSource.Now(),
+	}
+	request := &types.RecordActivityTaskHeartbeatRequest{Details: []byte{1}}
+
+	timeSource.Advance(3 * time.Second)
+	mb.UpdateActivityProgress(ai, request)
+	assert.Equal(t, 3*time.Second, ai.MaxHeartbeatGap)
+
+	timeSource.Advance(time.Second)
+	mb.UpdateActivityProgress(ai, request)
+	assert.Equal(t, 3*time.Second, ai.MaxHeartbeatGap)
+
+	timeSource.Advance(5 * time.Second)
+	mb.UpdateActivityProgress(ai, request)
+	assert.Equal(t, 5*time.Second, ai.MaxHeartbeatGap)
+	assert.Equal(t, timeSource.Now(), ai.LastHeartBeatUpdatedTime)
+}
+
 func Test__ReplicateActivityInfo(t *testing.T) {
 	mb := testMutableStateBuilder(t)
 	now := time.Now()
@@ -168,19 +242,20 @@ func Test__GetActivityScheduledEvent(t *testing.T) {
 func Test__AddActivityTaskCompletedEvent(t *testing.T) {
 	mb := testMutableStateBuilder(t)
 	request := &types.RespondActivityTaskCompletedRequest{
-		TaskToken: nil,
-		Result:    nil,
-		Identity:  "",
+		TaskToken:           nil,
+		Result:              nil,
+		Identity:            "",
+		ResultSchemaVersion: common.Int32Ptr(2),
 	}
 	t.Run("error workflow finished", func(t *testing.T) {
 		mbCompleted := testMutableStateBuilder(t)
 		mbCompleted.executionInfo.State = persistence.WorkflowStateCompleted
-		_, err := mbCompleted.AddActivityTaskCompletedEvent(1, 1, request)
+		_, err := mbCompleted.AddActivityTaskCompletedEvent(1, 1, request, nil)
 		assert.Error(t, err)
 		assert.Equal(t, ErrWorkflowFinished, err)
 	})
 	t.Run("error getting activity info", func(t *testing.T) {
-		_, err := mb.AddActivityTaskCompletedEvent(1, 1, request)
+		_, err := mb.AddActivityTaskCompletedEvent(1, 1, request, nil)
 		assert.Error(t, err)
 		assert.Equal(t, "add-activitytask-completed-event operation failed", err.Error())
 	})
@@ -195,12 +270,743 @@ func Test__AddActivityTaskCompletedEvent(t *testing.T) {
 		mb.pendingActivityIDToEventID["1"] = 1
 		mb.updateActivityInfos[1] = ai
 		mb.hBuilder = NewHistoryBuilder(mb)
-		event, err := mb.AddActivityTaskCompletedEvent(1, 1, request)
+		event, err := mb.AddActivityTaskCompletedEvent(1, 1, request, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), event.ActivityTaskCompletedEventAttributes.ScheduledEventID)
+		assert.NotEmpty(t, event.ActivityTaskCompletedEventAttributes.AttemptChainID)
+		assert.Equal(t, mb.getActivityAttemptChainID(1), event.ActivityTaskCompletedEventAttributes.AttemptChainID)
+		assert.NotEqual(t, mb.getActivityAttemptChainID(2), event.ActivityTaskCompletedEventAttributes.AttemptChainID)
+		assert.Equal(t, int32(2), event.ActivityTaskCompletedEventAttributes.GetResultSchemaVersion())
+		assert.False(t, event.ActivityTaskCompletedEventAttributes.Synthesized)
+	})
+	t.Run("success with options", func(t *testing.T) {
+		ai := &persistence.ActivityInfo{
+			ScheduleID:     3,
+			ActivityID:     "3",
+			ScheduledEvent: &types.HistoryEvent{},
+			StartedID:      4,
+		}
+		mb.pendingActivityInfoIDs[3] = ai
+		mb.pendingActivityIDToEventID["3"] = 3
+		mb.updateActivityInfos[3] = ai
+		event, err := mb.AddActivityTaskCompletedEvent(3, 4, &types.RespondActivityTaskCompletedRequest{
+			Result: []byte("result"),
+		}, &ActivityTaskCompletedOptions{
+			Synthesized:                     true,
+			TimeoutType:                     types.TimeoutTypeStartToClose.Ptr(),
+			ResultReferenceScheduledEventID: 1,
+		})
+		assert.NoError(t, err)
+		assert.True(t, event.ActivityTaskCompletedEventAttributes.Synthesized)
+		assert.Equal(t, types.TimeoutTypeStartToClose, event.ActivityTaskCompletedEventAttributes.GetTimeoutType())
+		assert.Nil(t, event.ActivityTaskCompletedEventAttributes.Result)
+		assert.Equal(t, int64(1), event.ActivityTaskCompletedEventAttributes.ResultReferenceScheduledEventID)
 	})
 }
 
+func Test__GetRecentlyClosedActivities(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	timeSource := clock.NewMockedTimeSource()
+	mb.timeSource = timeSource
+	mb.config.ClosedActivityHeartbeatDetailsRetention = dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Minute)
+	addActivity := func(scheduleID int64, activityID string, lastHeartbeat time.Time) {
+		ai := &persistence.ActivityInfo{
+			ScheduleID:               scheduleID,
+			ActivityID:               activityID,
+			Details:                  []byte(activityID + "-progress"),
+			LastHeartBeatUpdatedTime: lastHeartbeat,
+		}
+		mb.pendingActivityInfoIDs[scheduleID] = ai
+		mb.pendingActivityIDToEventID[activityID] = scheduleID
+	}
+
+	addActivity(1, "heartbeating", timeSource.Now())
+	addActivity(2, "silent", time.Time{})
+	assert.NoError(t, mb.DeleteActivity(1))
+	assert.NoError(t, mb.DeleteActivity(2))
+
+	closed := mb.GetRecentlyClosedActivities()
+	assert.Len(t, closed, 1)
+	assert.Equal(t, "heartbeating", closed[0].GetActivityID())
+	assert.Equal(t, []byte("heartbeating-progress"), closed[0].GetHeartbeatDetails())
+	assert.Equal(t, timeSource.Now().UnixNano(), closed[0].GetCloseTimestamp())
+
+	timeSource.Advance(time.Minute)
+	assert.Empty(t, mb.GetRecentlyClosedActivities())
+}
+
+func Test__ActivitySearchAttributes(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	ai := &persistence.ActivityInfo{
+		ScheduleID: 1,
+		ActivityID: "1",
+		StartedID:  2,
+		SearchAttributes: map[string][]byte{
+			"CustomKeywordField": []byte(`"activity-type"`),
+			"CustomStringField":  []byte(`"activity"`),
+		},
+	}
+	mb.pendingActivityInfoIDs[1] = ai
+	mb.pendingActivityIDToEventID["1"] = 1
+
+	assert.NoError(t, mb.upsertActivitySearchAttributes(ai))
+	assert.Equal(t, ai.SearchAttributes, mb.executionInfo.SearchAttributes)
+
+	// changed by the workflow while the activity was running
+	mb.executionInfo.SearchAttributes["CustomStringField"] = []byte(`"workflow"`)
+
+	assert.NoError(t, mb.DeleteActivity(1))
+	assert.Equal(t, map[string][]byte{"CustomStringField": []byte(`"workflow"`)}, mb.executionInfo.SearchAttributes)
+}
+
+func Test__ActivityResultSearchAttributes(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	mb.hBuilder = NewHistoryBuilder(mb)
+	ai := &persistence.ActivityInfo{
+		ScheduleID:     1,
+		ActivityID:     "1",
+		ScheduledEvent: &types.HistoryEvent{},
+		StartedID:      2,
+		SearchAttributes: map[string][]byte{
+			"CustomKeywordField": []byte(`"processing"`),
+		},
+		ResultSearchAttributes: []*types.ActivityResultSearchAttribute{
+			{JSONPath: "$.order.status", SearchAttributeKey: "CustomKeywordField"},
+			{JSONPath: "$.order.items[1].quantity", SearchAttributeKey: "CustomIntField"},
+			{JSONPath: "$.order.customer", SearchAttributeKey: "CustomStringField"},
+		},
+	}
+	mb.pendingActivityInfoIDs[1] = ai
+	mb.pendingActivityIDToEventID["1"] = 1
+	mb.updateActivityInfos[1] = ai
+	assert.NoError(t, mb.upsertActivitySearchAttributes(ai))
+
+	event, err := mb.AddActivityTaskCompletedEvent(1, 2, &types.RespondActivityTaskCompletedRequest{
+		Result: []byte(`{"order":{"status":"shipped","items":[{"quantity":1},{"quantity":3}]}}`),
+	}, nil)
+	assert.NoError(t, err)
+	// the customer is not part of the result, its search attribute is skipped
+	expected := map[string][]byte{
+		"CustomKeywordField": []byte(`"shipped"`),
+		"CustomIntField":     []byte(`3`),
+	}
+	assert.Equal(t, expected, event.ActivityTaskCompletedEventAttributes.GetResultSearchAttributes().GetIndexedFields())
+	assert.Equal(t, expected, mb.executionInfo.SearchAttributes)
+
+	// a result which is not JSON completes the activity without extracting anything
+	ai = &persistence.ActivityInfo{
+		ScheduleID:             3,
+		ActivityID:             "3",
+		ScheduledEvent:         &types.HistoryEvent{},
+		StartedID:              4,
+		ResultSearchAttributes: ai.ResultSearchAttributes,
+	}
+	mb.pendingActivityInfoIDs[3] = ai
+	mb.pendingActivityIDToEventID["3"] = 3
+	mb.updateActivityInfos[3] = ai
+	event, err = mb.AddActivityTaskCompletedEvent(3, 4, &types.RespondActivityTaskCompletedRequest{Result: []byte("done")}, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, event.ActivityTaskCompletedEventAttributes.GetResultSearchAttributes())
+	assert.Equal(t, expected, mb.executionInfo.SearchAttributes)
+}
+
+func Test__RedispatchActivity(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	timeSource := clock.NewMockedTimeSource()
+	mb.timeSource = timeSource
+	ai := &persistence.ActivityInfo{
+		ScheduleID:        1,
+		ActivityID:        "1",
+		StartedID:         common.TransientEventID,
+		StartedIdentity:   "worker",
+		StartedTime:       timeSource.Now(),
+		RequestID:         "request-id",
+		Attempt:           1,
+		HasRetryPolicy:    true,
+		VisibilityTimeout: 5,
+	}
+	mb.pendingActivityInfoIDs[1] = ai
+	mb.pendingActivityIDToEventID["1"] = 1
+
+	timeSource.Advance(5 * time.Second)
+	assert.NoError(t, mb.RedispatchActivity(ai))
+	assert.Equal(t, int32(2), ai.Attempt)
+	assert.Equal(t, common.EmptyEventID, ai.StartedID)
+	assert.Equal(t, timeSource.Now(), ai.ScheduledTime)
+	assert.Equal(t, "", ai.RequestID)
+	assert.Equal(t, "worker", ai.LastWorkerIdentity)
+	assert.Equal(t, activityVisibilityTimeoutReason, ai.LastFailureReason)
+	assert.Equal(t, int32(0), ai.VisibilityTimeout)
+	assert.Equal(t, ai, mb.updateActivityInfos[ai.ScheduleID])
+
+	// the activity is not started anymore
+	assert.Error(t, mb.RedispatchActivity(ai))
+}
+
+func Test__StealActivity(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	timeSource := clock.NewMockedTimeSource()
+	mb.timeSource = timeSource
+	ai := &persistence.ActivityInfo{
+		ScheduleID:       1,
+		ActivityID:       "1",
+		StartedID:        common.TransientEventID,
+		StartedIdentity:  "worker",
+		StartedTime:      timeSource.Now(),
+		RequestID:        "request-id",
+		Attempt:          1,
+		HasRetryPolicy:   true,
+		HeartbeatTimeout: 10,
+		StealTimeout:     5,
+	}
+	mb.pendingActivityInfoIDs[1] = ai
+	mb.pendingActivityIDToEventID["1"] = 1
+
+	// only idempotent activities are stolen
+	assert.Error(t, mb.StealActivity(ai))
+	assert.Equal(t, int32(1), ai.Attempt)
+
+	ai.Idempotent = true
+	timeSource.Advance(5 * time.Second)
+	assert.NoError(t, mb.StealActivity(ai))
+	assert.Equal(t, int32(2), ai.Attempt)
+	assert.Equal(t, common.EmptyEventID, ai.StartedID)
+	assert.Equal(t, "worker", ai.LastWorkerIdentity)
+	assert.Equal(t, activityStolenReason, ai.LastFailureReason)
+	assert.Equal(t, int32(0), ai.StealTimeout)
+	assert.Equal(t, ai, mb.updateActivityInfos[ai.ScheduleID])
+
+	// the activity is not started anymore
+	assert.Error(t, mb.StealActivity(ai))
+}
+
+func Test__RecordActivityBatchCompletion(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	closed := &persistence.ActivityInfo{ScheduleID: 5, ScheduledEventBatchID: 4}
+	mb.pendingActivityInfoIDs[6] = &persistence.ActivityInfo{ScheduleID: 6, ScheduledEventBatchID: 4}
+	mb.pendingActivityInfoIDs[11] = &persistence.ActivityInfo{ScheduleID: 11, ScheduledEventBatchID: 10}
+
+	// not enabled for the workflow
+	delete(mb.pendingActivityInfoIDs, 6)
+	mb.recordActivityBatchCompletion(closed)
+	assert.False(t, mb.executionInfo.ActivityBatchCompleted)
+
+	// another activity of the batch is pending
+	mb.executionInfo.NotifyOnActivityBatchComplete = true
+	mb.pendingActivityInfoIDs[6] = &persistence.ActivityInfo{ScheduleID: 6, ScheduledEventBatchID: 4}
+	mb.recordActivityBatchCompletion(closed)
+	assert.False(t, mb.executionInfo.ActivityBatchCompleted)
+
+	// activities scheduled by a later decision do not hold back the batch
+	delete(mb.pendingActivityInfoIDs, 6)
+	mb.executionInfo.ActivityBatchNotifiedStartedID = 3
+	mb.recordActivityBatchCompletion(closed)
+	assert.True(t, mb.executionInfo.ActivityBatchCompleted)
+	assert.Equal(t, common.EmptyEventID, mb.executionInfo.ActivityBatchNotifiedStartedID)
+}
+
+func Test__NackActivity(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	timeSource := clock.NewMockedTimeSource()
+	mb.timeSource = timeSource
+	ai := &persistence.ActivityInfo{
+		ScheduleID:      1,
+		ActivityID:      "1",
+		StartedID:       common.TransientEventID,
+		StartedIdentity: "worker",
+		StartedTime:     timeSource.Now(),
+		RequestID:       "request-id",
+		Attempt:         1,
+		HasRetryPolicy:  true,
+	}
+	mb.pendingActivityInfoIDs[1] = ai
+	mb.pendingActivityIDToEventID["1"] = 1
+
+	timeSource.Advance(time.Second)
+	assert.NoError(t, mb.NackActivity(ai, "overloaded"))
+	assert.Equal(t, int32(1), ai.Attempt)
+	assert.Equal(t, int32(1), ai.NackCount)
+	assert.Equal(t, "overloaded", ai.LastNackReason)
+	assert.Equal(t, common.EmptyEventID, ai.StartedID)
+	assert.Equal(t, timeSource.Now(), ai.ScheduledTime)
+	assert.Equal(t, "", ai.RequestID)
+	assert.Equal(t, ai, mb.updateActivityInfos[ai.ScheduleID])
+
+	// the activity is not started anymore
+	assert.Error(t, mb.NackActivity(ai, "overloaded"))
+
+	// a new attempt starts with no nacks
+	ai.StartedID = common.TransientEventID
+	assert.NoError(t, mb.RedispatchActivity(ai))
+	assert.Equal(t, int32(0), ai.NackCount)
+	assert.Equal(t, "", ai.LastNackReason)
+}
+
+func Test__RetryActivity_FailureCategory(t *testing.T) {
+	newActivityInfo := func() *persistence.ActivityInfo {
+		return &persistence.ActivityInfo{
+			ScheduleID:         1,
+			ActivityID:         "1",
+			StartedID:          common.TransientEventID,
+			HasRetryPolicy:     true,
+			MaximumAttempts:    10,
+			InitialInterval:    1,
+			MaximumInterval:    100,
+			BackoffCoefficient: 2,
+		}
+	}
+	tests := map[string]struct {
+		category        *types.ActivityFailureCategory
+		expectRetry     bool
+		expectedBackoff time.Duration
+	}{
+		"no category": {
+			expectRetry:     true,
+			expectedBackoff: time.Second,
+		},
+		"transient": {
+			category:        types.ActivityFailureCategoryTransient.Ptr(),
+			expectRetry:     true,
+			expectedBackoff: time.Second,
+		},
+		"permanent": {
+			category:    types.ActivityFailureCategoryPermanent.Ptr(),
+			expectRetry: false,
+		},
+		"throttled": {
+			category:        types.ActivityFailureCategoryThrottled.Ptr(),
+			expectRetry:     true,
+			expectedBackoff: 2 * time.Second,
+		},
+	}
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			mb := testMutableStateBuilder(t)
+			timeSource := clock.NewMockedTimeSource()
+			mb.timeSource = timeSource
+			ai := newActivityInfo()
+			mb.pendingActivityInfoIDs[1] = ai
+			mb.pendingActivityIDToEventID["1"] = 1
+
+			retried, err := mb.RetryActivity(ai, "some-reason", nil, td.category)
+			assert.NoError(t, err)
+			assert.Equal(t, td.expectRetry, retried)
+			if td.expectRetry {
+				assert.Equal(t, timeSource.Now().Add(td.expectedBackoff), ai.ScheduledTime)
+			}
+		})
+	}
+}
+
+func Test__RetryActivity_ExecutionBudget(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	timeSource := clock.NewMockedTimeSource()
+	mb.timeSource = timeSource
+	ai := &persistence.ActivityInfo{
+		ScheduleID:               1,
+		ActivityID:               "1",
+		StartedID:                common.TransientEventID,
+		StartedTime:              timeSource.Now().Add(-4 * time.Second),
+		HasRetryPolicy:           true,
+		MaximumAttempts:          10,
+		InitialInterval:          1,
+		MaximumInterval:          100,
+		BackoffCoefficient:       2,
+		MaxTotalExecutionSeconds: 10,
+	}
+	mb.pendingActivityInfoIDs[1] = ai
+	mb.pendingActivityIDToEventID["1"] = 1
+
+	retried, err := mb.RetryActivity(ai, "some-reason", nil, nil)
+	assert.NoError(t, err)
+	assert.True(t, retried)
+	assert.Equal(t, 4*time.Second, ai.TotalExecutionTime)
+	remaining, ok := GetActivityRemainingExecutionBudget(ai, timeSource.Now())
+	assert.True(t, ok)
+	assert.Equal(t, 6*time.Second, remaining)
+
+	// the second attempt runs for the rest of the budget
+	ai.StartedID = common.TransientEventID
+	ai.StartedTime = timeSource.Now()
+	assert.Equal(t, int32(6), GetActivityStartToCloseTimeout(&persistence.ActivityInfo{
+		StartToCloseTimeout:      30,
+		MaxTotalExecutionSeconds: ai.MaxTotalExecutionSeconds,
+		TotalExecutionTime:       ai.TotalExecutionTime,
+	}))
+	timeSource.Advance(6 * time.Second)
+
+	retried, err = mb.RetryActivity(ai, "some-reason", nil, nil)
+	assert.NoError(t, err)
+	assert.False(t, retried)
+}
+
+func Test__RetryActivity_Tracing(t *testing.T) {
+	tracer := mocktracer.New()
+	opentracing.SetGlobalTracer(tracer)
+	defer opentracing.SetGlobalTracer(opentracing.NoopTracer{})
+
+	workflowSpan := tracer.StartSpan("workflow")
+	carrier := opentracing.TextMapCarrier{}
+	assert.NoError(t, tracer.Inject(workflowSpan.Context(), opentracing.HTTPHeaders, carrier))
+	header := &types.Header{Fields: map[string][]byte{}}
+	for key, value := range carrier {
+		header.Fields[key] = []byte(value)
+	}
+
+	mb := testMutableStateBuilder(t)
+	timeSource := clock.NewMockedTimeSource()
+	mb.timeSource = timeSource
+	ai := &persistence.ActivityInfo{
+		ScheduleID:    1,
+		ActivityID:    "1",
+		ScheduledTime: timeSource.Now().Add(-5 * time.Second),
+		ScheduledEvent: &types.HistoryEvent{
+			ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+				ActivityType: &types.ActivityType{Name: "some-activity-type"},
+				Header:       header,
+			},
+		},
+		StartedID:          common.TransientEventID,
+		StartedTime:        timeSource.Now().Add(-4 * time.Second),
+		HasRetryPolicy:     true,
+		MaximumAttempts:    10,
+		InitialInterval:    1,
+		MaximumInterval:    100,
+		BackoffCoefficient: 2,
+	}
+	mb.pendingActivityInfoIDs[1] = ai
+	mb.pendingActivityIDToEventID["1"] = 1
+
+	retried, err := mb.RetryActivity(ai, "some-reason", nil, nil)
+	assert.NoError(t, err)
+	assert.True(t, retried)
+
+	spans := tracer.FinishedSpans()
+	assert.Len(t, spans, 2)
+	execution, attempt := spans[0], spans[1]
+	assert.Equal(t, activityExecutionSpanName, execution.OperationName)
+	assert.Equal(t, attempt.SpanContext.SpanID, execution.ParentID)
+	assert.Equal(t, timeSource.Now().Add(-4*time.Second), execution.StartTime)
+	assert.Equal(t, activityAttemptSpanName, attempt.OperationName)
+	assert.Equal(t, workflowSpan.Context().(mocktracer.MockSpanContext).SpanID, attempt.ParentID)
+	assert.Equal(t, timeSource.Now().Add(-5*time.Second), attempt.StartTime)
+	assert.Equal(t, timeSource.Now(), attempt.FinishTime)
+	assert.Equal(t, "some-activity-type", attempt.Tag("cadence.activity_type"))
+	assert.Equal(t, activityOutcomeRetry, attempt.Tag("cadence.outcome"))
+	assert.Equal(t, int32(0), attempt.Tag("cadence.attempt"))
+	assert.Equal(t, true, attempt.Tag("error"))
+}
+
+func Test__RetryActivity_DisabledForDomain(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	mb.config.DisableActivityRetries = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	ai := &persistence.ActivityInfo{
+		ScheduleID:         1,
+		ActivityID:         "1",
+		StartedID:          common.TransientEventID,
+		HasRetryPolicy:     true,
+		MaximumAttempts:    10,
+		InitialInterval:    1,
+		MaximumInterval:    100,
+		BackoffCoefficient: 2,
+	}
+	mb.pendingActivityInfoIDs[1] = ai
+	mb.pendingActivityIDToEventID["1"] = 1
+
+	retried, err := mb.RetryActivity(ai, "some-reason", nil, nil)
+	assert.NoError(t, err)
+	assert.False(t, retried)
+	assert.Equal(t, int32(0), ai.Attempt)
+}
+
+func Test__RetryActivity_FallbackTaskList(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	mb.config.ActivityFallbackTaskListScheduleToStartTimeouts = dynamicconfig.GetIntPropertyFilteredByDomain(2)
+	ai := &persistence.ActivityInfo{
+		ScheduleID:         1,
+		ActivityID:         "1",
+		StartedID:          common.EmptyEventID,
+		TaskList:           "dedicated-tl",
+		FallbackTaskList:   "fallback-tl",
+		HasRetryPolicy:     true,
+		MaximumAttempts:    10,
+		InitialInterval:    1,
+		MaximumInterval:    100,
+		BackoffCoefficient: 2,
+	}
+	mb.pendingActivityInfoIDs[1] = ai
+	mb.pendingActivityIDToEventID["1"] = 1
+	scheduleToStartReason := TimerTypeToReason(TimerTypeScheduleToStart)
+
+	retried, err := mb.RetryActivity(ai, scheduleToStartReason, nil, nil)
+	assert.NoError(t, err)
+	assert.True(t, retried)
+	assert.Equal(t, "dedicated-tl", ai.TaskList)
+
+	// any other failure breaks the streak of ScheduleToStart timeouts
+	retried, err = mb.RetryActivity(ai, "some-reason", nil, nil)
+	assert.NoError(t, err)
+	assert.True(t, retried)
+	assert.Equal(t, int32(0), ai.ScheduleToStartTimeouts)
+
+	for i := 0; i < 2; i++ {
+		retried, err = mb.RetryActivity(ai, scheduleToStartReason, nil, nil)
+		assert.NoError(t, err)
+		assert.True(t, retried)
+	}
+	assert.Equal(t, "fallback-tl", ai.TaskList)
+}
+
+func Test__RetryActivity_TaskListEscalation(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	ai := &persistence.ActivityInfo{
+		ScheduleID:         1,
+		ActivityID:         "1",
+		StartedID:          common.EmptyEventID,
+		TaskList:           "fast-tl",
+		TaskListEscalation: []string{"fast-tl", "fallback-tl", "dlq-tl"},
+		HasRetryPolicy:     true,
+		MaximumAttempts:    10,
+		InitialInterval:    1,
+		MaximumInterval:    100,
+		BackoffCoefficient: 2,
+	}
+	mb.pendingActivityInfoIDs[1] = ai
+	mb.pendingActivityIDToEventID["1"] = 1
+
+	// the attempts past the end of the escalation stay on its last task list
+	for _, expected := range []string{"fallback-tl", "dlq-tl", "dlq-tl"} {
+		retried, err := mb.RetryActivity(ai, "some-reason", nil, nil)
+		assert.NoError(t, err)
+		assert.True(t, retried)
+		assert.Equal(t, expected, ai.TaskList)
+	}
+
+	// each retried attempt records the task list it was dispatched on
+	var taskLists []string
+	for _, attempt := range GetActivityRetriedAttempts(ai) {
+		taskLists = append(taskLists, attempt.GetTaskList())
+	}
+	assert.Equal(t, []string{"fast-tl", "fallback-tl", "dlq-tl"}, taskLists)
+
+	// resetting the attempts moves the activity back to the task list it was scheduled on
+	assert.NoError(t, mb.resetActivityAttempts(ai, mb.GetCurrentVersion(), mb.timeSource.Now()))
+	assert.Equal(t, "fast-tl", ai.TaskList)
+}
+
+func Test__AddActivityAttemptsResetMarkerEvent(t *testing.T) {
+	t.Run("pending activity", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		mb.hBuilder = NewHistoryBuilder(mb)
+		ai := &persistence.ActivityInfo{
+			ScheduleID:            5,
+			ScheduledEventBatchID: 4,
+			ActivityID:            "1",
+			StartedID:             common.EmptyEventID,
+			Attempt:               3,
+			HasRetryPolicy:        true,
+		}
+		mb.pendingActivityInfoIDs[5] = ai
+		mb.pendingActivityIDToEventID["1"] = 5
+
+		event, err := mb.AddActivityAttemptsResetMarkerEvent(context.Background(), 5, 4, "1", 3, "operator")
+		assert.NoError(t, err)
+		assert.Equal(t, common.ActivityAttemptsResetMarkerName, event.MarkerRecordedEventAttributes.GetMarkerName())
+		details, err := GetActivityAttemptsResetDetails(event)
+		assert.NoError(t, err)
+		assert.Equal(t, &ActivityAttemptsResetDetails{
+			ScheduledEventID:      5,
+			ScheduledEventBatchID: 4,
+			ActivityID:            "1",
+			PreviousAttempt:       3,
+			Identity:              "operator",
+		}, details)
+		assert.Equal(t, int32(0), ai.Attempt)
+		assert.Equal(t, ai, mb.updateActivityInfos[5])
+	})
+	t.Run("started activity", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		mb.hBuilder = NewHistoryBuilder(mb)
+		mb.pendingActivityInfoIDs[5] = &persistence.ActivityInfo{
+			ScheduleID: 5,
+			ActivityID: "1",
+			StartedID:  common.TransientEventID,
+		}
+		mb.pendingActivityIDToEventID["1"] = 5
+
+		_, err := mb.AddActivityAttemptsResetMarkerEvent(context.Background(), 5, 4, "1", 3, "operator")
+		assert.Error(t, err)
+	})
+	t.Run("exhausted activity", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		mb.hBuilder = NewHistoryBuilder(mb)
+		mockEventsCache := mb.shard.GetEventsCache().(*events.MockCache)
+		mockEventsCache.EXPECT().GetEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), int64(4), int64(5), gomock.Any()).
+			Return(&types.HistoryEvent{
+				ID:        5,
+				EventType: types.EventTypeActivityTaskScheduled.Ptr(),
+				ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+					ActivityID: "1",
+					TaskList:   &types.TaskList{Name: "tl"},
+				},
+			}, nil)
+
+		event, err := mb.AddActivityAttemptsResetMarkerEvent(context.Background(), 5, 4, "1", 3, "operator")
+		assert.NoError(t, err)
+		details, err := GetActivityAttemptsResetDetails(event)
+		assert.NoError(t, err)
+		assert.True(t, details.Reopened)
+		ai, ok := mb.GetActivityByActivityID("1")
+		assert.True(t, ok)
+		assert.Equal(t, int64(5), ai.ScheduleID)
+		assert.Equal(t, int64(4), ai.ScheduledEventBatchID)
+		assert.Equal(t, int32(0), ai.Attempt)
+		assert.Equal(t, time.Unix(0, event.GetTimestamp()), ai.ScheduledTime)
+	})
+}
+
+func Test__RetryActivity_RescheduleReasons(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	timeSource := clock.NewMockedTimeSource()
+	mb.timeSource = timeSource
+	ai := &persistence.ActivityInfo{
+		ScheduleID:         1,
+		ActivityID:         "1",
+		StartedID:          common.EmptyEventID,
+		HasRetryPolicy:     true,
+		MaximumAttempts:    10,
+		InitialInterval:    1,
+		MaximumInterval:    100,
+		BackoffCoefficient: 2,
+	}
+	mb.pendingActivityInfoIDs[1] = ai
+	mb.pendingActivityIDToEventID["1"] = 1
+
+	failureReasons := []string{
+		TimerTypeToReason(TimerTypeScheduleToStart),
+		"some-reason",
+		TimerTypeToReason(TimerTypeHeartbeat),
+		TimerTypeToReason(TimerTypeStartToClose),
+	}
+	for _, failureReason := range failureReasons {
+		retried, err := mb.RetryActivity(ai, failureReason, nil, nil)
+		assert.NoError(t, err)
+		assert.True(t, retried)
+	}
+
+	expectedCauses := []types.ActivityRescheduleCause{
+		types.ActivityRescheduleCauseScheduleToStartTimeout,
+		types.ActivityRescheduleCauseFailure,
+		types.ActivityRescheduleCauseHeartbeatTimeout,
+		types.ActivityRescheduleCauseStartToCloseTimeout,
+	}
+	assert.Len(t, ai.RescheduleReasons, len(expectedCauses))
+	for i, reason := range ai.RescheduleReasons {
+		assert.Equal(t, int32(i), reason.GetAttempt())
+		assert.Equal(t, expectedCauses[i], reason.GetCause())
+		assert.Equal(t, timeSource.Now().UnixNano(), reason.GetTimestamp())
+	}
+}
+
+func Test__RetryActivity_AuditRecord(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	timeSource := clock.NewMockedTimeSource()
+	mb.timeSource = timeSource
+	ai := &persistence.ActivityInfo{
+		ScheduleID:         1,
+		ActivityID:         "1",
+		StartedID:          common.TransientEventID,
+		StartedIdentity:    "worker",
+		Attempt:            3,
+		HasRetryPolicy:     true,
+		MaximumAttempts:    10,
+		InitialInterval:    1,
+		MaximumInterval:    100,
+		BackoffCoefficient: 2,
+	}
+	mb.pendingActivityInfoIDs[1] = ai
+	mb.pendingActivityIDToEventID["1"] = 1
+	timeoutReason := TimerTypeToReason(TimerTypeStartToClose)
+
+	// nothing is recorded unless the audit log is enabled for the domain
+	retried, err := mb.RetryActivity(ai, timeoutReason, nil, nil)
+	assert.NoError(t, err)
+	assert.True(t, retried)
+	assert.Empty(t, mb.activityAuditRecords)
+
+	mb.config.ActivityAuditLogSink = dynamicconfig.GetStringPropertyFnFilteredByDomain(audit.SinkKafka)
+	ai.StartedIdentity = "worker"
+	retried, err = mb.RetryActivity(ai, timeoutReason, nil, nil)
+	assert.NoError(t, err)
+	assert.True(t, retried)
+	assert.Equal(t, []*audit.ActivityRecord{
+		{
+			DomainID:   mb.executionInfo.DomainID,
+			DomainName: constants.TestDomainName,
+			WorkflowID: mb.executionInfo.WorkflowID,
+			RunID:      mb.executionInfo.RunID,
+			ActivityID: "1",
+			ScheduleID: 1,
+			Attempt:    4,
+			Transition: audit.ActivityTransitionTimedOut,
+			Identity:   "worker",
+			Timestamp:  timeSource.Now(),
+			Reason:     timeoutReason,
+			WillRetry:  true,
+		},
+	}, mb.activityAuditRecords)
+
+	// the records are only written once the transaction is persisted
+	records := mb.activityAuditRecords
+	assert.NoError(t, mb.cleanupTransaction())
+	assert.Empty(t, mb.activityAuditRecords)
+
+	written := make(chan struct{})
+	sink := mb.shard.(*shard.TestContext).Resource.ActivityAuditSink
+	sink.EXPECT().Write(gomock.Any(), records).DoAndReturn(func(context.Context, []*audit.ActivityRecord) error {
+		close(written)
+		return nil
+	})
+	mb.NotifyTransactionPersisted()
+	<-written
+	assert.Empty(t, mb.closedActivityAuditRecords)
+}
+
+func Test__recordActivityTypeOutcome(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	ai := &persistence.ActivityInfo{
+		ScheduleID: 1,
+		ActivityID: "1",
+		ScheduledEvent: &types.HistoryEvent{
+			ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+				ActivityType: &types.ActivityType{Name: "activity-type"},
+			},
+		},
+	}
+	mb.pendingActivityInfoIDs[1] = ai
+
+	// nothing is recorded unless circuit breaking is enabled for the domain
+	mb.recordActivityTypeOutcome(ai, false)
+	assert.Empty(t, mb.activityTypeOutcomes)
+
+	mb.config.EnableActivityTypeCircuitBreaker = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	mb.recordActivityTypeOutcome(ai, false)
+	mb.recordActivityTypeOutcome(ai, true)
+	assert.Len(t, mb.activityTypeOutcomes, 2)
+
+	// the outcomes are only reported once the transaction is persisted
+	assert.NoError(t, mb.cleanupTransaction())
+	assert.Empty(t, mb.activityTypeOutcomes)
+
+	breaker := mb.shard.(*shard.TestContext).Resource.ActivityTypeBreaker
+	gomock.InOrder(
+		breaker.EXPECT().RecordFailure(constants.TestDomainName, mb.executionInfo.DomainID, "activity-type"),
+		breaker.EXPECT().RecordSuccess(constants.TestDomainName, mb.executionInfo.DomainID, "activity-type"),
+	)
+	mb.NotifyTransactionPersisted()
+	assert.Empty(t, mb.closedActivityTypeOutcomes)
+}
+
 func Test__tryDispatchActivityTask(t *testing.T) {
 	mb := testMutableStateBuilder(t)
 	event := &types.HistoryEvent{}
@@ -312,6 +1118,36 @@ func Test__ReplicateActivityTaskCompletedEvent(t *testing.T) {
 	assert.False(t, ok)
 }
 
+func Test__ReplicateActivityTaskCompletedEvent_IncrementsCounter(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	for i := int64(1); i <= 2; i++ {
+		event := &types.HistoryEvent{
+			EventType: types.EventTypeActivityTaskCompleted.Ptr(),
+			ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+				ScheduledEventID:   i,
+				IncrementedCounter: "processed",
+			},
+		}
+		activityID := strconv.FormatInt(i, 10)
+		mb.pendingActivityInfoIDs[i] = &persistence.ActivityInfo{ScheduleID: i, ActivityID: activityID}
+		mb.pendingActivityIDToEventID[activityID] = i
+		err := mb.ReplicateActivityTaskCompletedEvent(event)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, map[string]int64{"processed": 2}, mb.executionInfo.Counters)
+}
+
+func Test__getIncrementableWorkflowCounter(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	mb.config.WorkflowCounterLimit = func(domain string) int { return 1 }
+	assert.Equal(t, "", mb.getIncrementableWorkflowCounter(""))
+	assert.Equal(t, "first", mb.getIncrementableWorkflowCounter("first"))
+
+	mb.executionInfo.Counters = map[string]int64{"first": 1}
+	assert.Equal(t, "first", mb.getIncrementableWorkflowCounter("first"))
+	assert.Equal(t, "", mb.getIncrementableWorkflowCounter("second"))
+}
+
 func Test__AddActivityTaskCanceledEvent(t *testing.T) {
 	t.Run("error workflow finished", func(t *testing.T) {
 		mbCompleted := testMutableStateBuilder(t)
@@ -429,6 +1265,27 @@ func Test__AddActivityTaskTimedOutEvent(t *testing.T) {
 		event, err := mb.AddActivityTaskTimedOutEvent(1, 1, types.TimeoutTypeHeartbeat, []byte{10})
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), event.ActivityTaskTimedOutEventAttributes.ScheduledEventID)
+		assert.Equal(t, mb.getActivityAttemptChainID(1), event.ActivityTaskTimedOutEventAttributes.AttemptChainID)
+		assert.Equal(t, []byte{10}, event.ActivityTaskTimedOutEventAttributes.LastHeartbeatDetails)
+		assert.Nil(t, event.ActivityTaskTimedOutEventAttributes.LastHeartbeatTimestamp)
+	})
+	t.Run("success with heartbeat", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		lastHeartbeat := time.Unix(0, 2000)
+		ai := &persistence.ActivityInfo{
+			ScheduleID:               1,
+			ActivityID:               "1",
+			ScheduledEvent:           &types.HistoryEvent{},
+			StartedID:                1,
+			LastHeartBeatUpdatedTime: lastHeartbeat,
+		}
+		mb.pendingActivityInfoIDs[1] = ai
+		mb.hBuilder = NewHistoryBuilder(mb)
+		event, err := mb.AddActivityTaskTimedOutEvent(1, 1, types.TimeoutTypeHeartbeat, []byte("5"))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("5"), event.ActivityTaskTimedOutEventAttributes.Details)
+		assert.Equal(t, []byte("5"), event.ActivityTaskTimedOutEventAttributes.LastHeartbeatDetails)
+		assert.Equal(t, lastHeartbeat.UnixNano(), event.ActivityTaskTimedOutEventAttributes.GetLastHeartbeatTimestamp())
 	})
 }
 
@@ -465,3 +1322,119 @@ func Test__AddActivityTaskFailedEvent(t *testing.T) {
 
 	})
 }
+
+func Test__AddActivityTaskFailedEvent_FailureAlert(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	timeSource := clock.NewMockedTimeSource()
+	mb.timeSource = timeSource
+	mb.hBuilder = NewHistoryBuilder(mb)
+	for _, ai := range []*persistence.ActivityInfo{
+		{ScheduleID: 1, ActivityID: "1", StartedID: 1, Attempt: 2, AlertOnFailure: "payments"},
+		{ScheduleID: 2, ActivityID: "2", StartedID: 2, AlertOnFailure: "reports"},
+		{ScheduleID: 3, ActivityID: "3", StartedID: 3},
+	} {
+		ai.ScheduledEvent = &types.HistoryEvent{
+			ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+				ActivityType: &types.ActivityType{Name: "charge"},
+			},
+		}
+		mb.pendingActivityInfoIDs[ai.ScheduleID] = ai
+		mb.pendingActivityIDToEventID[ai.ActivityID] = ai.ScheduleID
+	}
+	mb.config.ActivityFailureAlertSinks = dynamicconfig.GetMapPropertyFn(map[string]interface{}{
+		"payments": activityalert.SinkNoop,
+	})
+
+	// only the activity whose tag the domain maps to a sink is alerted on
+	for scheduleID := int64(1); scheduleID <= 3; scheduleID++ {
+		_, err := mb.AddActivityTaskFailedEvent(scheduleID, scheduleID, &types.RespondActivityTaskFailedRequest{
+			Reason:  common.StringPtr("some reason"),
+			Details: []byte("some details"),
+		})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, []*activityFailureAlert{
+		{
+			sinkName: activityalert.SinkNoop,
+			alert: &activityalert.Alert{
+				DomainID:     mb.executionInfo.DomainID,
+				DomainName:   constants.TestDomainName,
+				WorkflowID:   mb.executionInfo.WorkflowID,
+				RunID:        mb.executionInfo.RunID,
+				ActivityID:   "1",
+				ActivityType: "charge",
+				ScheduleID:   1,
+				Attempt:      2,
+				Tag:          "payments",
+				Reason:       "some reason",
+				Details:      []byte("some details"),
+				Timestamp:    timeSource.Now(),
+			},
+		},
+	}, mb.activityFailureAlerts)
+
+	// alerts which cannot be sent are dropped
+	sendActivityFailureAlerts(mb.logger, append(mb.activityFailureAlerts, &activityFailureAlert{
+		sinkName: "unregistered",
+		alert:    &activityalert.Alert{},
+	}))
+
+	// the alerts of a transaction are only sent once it is persisted
+	assert.NoError(t, mb.cleanupTransaction())
+	assert.Nil(t, mb.activityFailureAlerts)
+	assert.Len(t, mb.closedActivityFailureAlerts, 1)
+	mb.sendActivityFailureAlerts()
+	assert.Nil(t, mb.closedActivityFailureAlerts)
+}
+
+func Test__AddActivityTaskFailedEvent_Dependents(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	mb.hBuilder = NewHistoryBuilder(mb)
+	for _, ai := range []*persistence.ActivityInfo{
+		{ScheduleID: 1, ActivityID: "1", StartedID: 1},
+		{ScheduleID: 2, ActivityID: "2", StartedID: common.EmptyEventID, DependsOnActivityID: "1"},
+		{ScheduleID: 3, ActivityID: "3", StartedID: common.EmptyEventID, DependsOnActivityID: "1", OnDependencyFailure: types.ActivityDependencyFailurePolicySkip},
+		{ScheduleID: 4, ActivityID: "4", StartedID: common.EmptyEventID, DependsOnActivityID: "2", OnDependencyFailure: types.ActivityDependencyFailurePolicySkip},
+		{ScheduleID: 5, ActivityID: "5", StartedID: common.EmptyEventID},
+	} {
+		mb.pendingActivityInfoIDs[ai.ScheduleID] = ai
+		mb.pendingActivityIDToEventID[ai.ActivityID] = ai.ScheduleID
+	}
+
+	_, err := mb.AddActivityTaskFailedEvent(1, 1, &types.RespondActivityTaskFailedRequest{
+		Reason: common.StringPtr("some reason"),
+	})
+	assert.NoError(t, err)
+
+	// 2 fails as 1 failed, which skips 4 in turn, and 3 is skipped
+	_, ok := mb.GetActivityInfo(5)
+	assert.True(t, ok)
+	assert.Len(t, mb.pendingActivityInfoIDs, 1)
+	var failed, canceled []int64
+	for _, event := range mb.hBuilder.history {
+		switch event.GetEventType() {
+		case types.EventTypeActivityTaskFailed:
+			failed = append(failed, event.ActivityTaskFailedEventAttributes.ScheduledEventID)
+			if event.ActivityTaskFailedEventAttributes.ScheduledEventID == 2 {
+				assert.Equal(t, common.FailureReasonActivityDependencyFailed, *event.ActivityTaskFailedEventAttributes.Reason)
+			}
+		case types.EventTypeActivityTaskCanceled:
+			canceled = append(canceled, event.ActivityTaskCanceledEventAttributes.ScheduledEventID)
+		}
+	}
+	assert.Equal(t, []int64{1, 2}, failed)
+	assert.ElementsMatch(t, []int64{3, 4}, canceled)
+}
+
+func TestIsActivityKillSwitchOn(t *testing.T) {
+	cfg := config.NewForTest()
+	cfg.DisabledActivityKillSwitchTags = dynamicconfig.GetMapPropertyFn(map[string]interface{}{
+		"payments": true,
+		"emails":   false,
+	})
+
+	assert.True(t, IsActivityKillSwitchOn(cfg, constants.TestDomainName, "payments"))
+	assert.False(t, IsActivityKillSwitchOn(cfg, constants.TestDomainName, "emails"))
+	assert.False(t, IsActivityKillSwitchOn(cfg, constants.TestDomainName, "reports"))
+	assert.False(t, IsActivityKillSwitchOn(cfg, constants.TestDomainName, ""))
+}
@@ -77,6 +77,8 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 		Memo:                                attributes.Memo,
 		SearchAttributes:                    attributes.SearchAttributes,
 		JitterStartSeconds:                  attributes.JitterStartSeconds,
+		Priority:                            previousExecutionInfo.Priority,
+		NotifyOnActivityBatchComplete:       previousExecutionInfo.NotifyOnActivityBatchComplete,
 	}
 
 	req := &types.HistoryStartWorkflowExecutionRequest{
@@ -250,6 +252,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 		e.executionInfo.InitialInterval = event.RetryPolicy.GetInitialIntervalInSeconds()
 		e.executionInfo.MaximumAttempts = event.RetryPolicy.GetMaximumAttempts()
 		e.executionInfo.MaximumInterval = event.RetryPolicy.GetMaximumIntervalInSeconds()
+		e.executionInfo.MinimumInterval = event.RetryPolicy.GetMinimumIntervalInSeconds()
 		e.executionInfo.NonRetriableErrors = event.RetryPolicy.NonRetriableErrorReasons
 	}
 
@@ -267,6 +270,8 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 		e.executionInfo.SearchAttributes = event.SearchAttributes.GetIndexedFields()
 	}
 	e.executionInfo.PartitionConfig = event.PartitionConfig
+	e.executionInfo.Priority = event.Priority
+	e.executionInfo.NotifyOnActivityBatchComplete = event.NotifyOnActivityBatchComplete
 
 	e.writeEventToCache(startEvent)
 
@@ -275,8 +275,20 @@ OtherEventsLoop:
 
 	// +1 is because DecisionTypeCancelTimer will be mapped
 	// to either types.EventTypeTimerCanceled, or types.EventTypeCancelTimerFailed.
-	s.Equal(len(types.DecisionTypeValues())+1, len(decisionEvents),
+	// -1 is because DecisionTypeScheduleActivityTasksBatch is expanded
+	// into types.EventTypeActivityTaskScheduled events.
+	// -1 is because DecisionTypeRecordActivityHeartbeatSnapshot is recorded
+	// as a types.EventTypeMarkerRecorded event.
+	// -1 is because DecisionTypeReplaceActivityTask is recorded as
+	// types.EventTypeActivityTaskCancelRequested and types.EventTypeActivityTaskScheduled events.
+	// -1 is because DecisionTypeScheduleWeightedActivity is recorded
+	// as a types.EventTypeActivityTaskScheduled event.
+	s.Equal(len(types.DecisionTypeValues())-3, len(decisionEvents),
 		"This assertaion will be broken a new decision is added and no corresponding logic added to shouldBufferEvent()")
+
+	// activity attempts reset markers are recorded while there may be a decision on the fly
+	s.msBuilder.executionInfo.DecisionStartedID = 10
+	s.True(s.msBuilder.shouldBufferEvent(types.EventTypeMarkerRecorded))
 }
 
 func (s *mutableStateSuite) TestReorderEvents() {
@@ -2900,6 +2912,39 @@ func TestMutableStateBuilder_GetTimerTasks(t *testing.T) {
 	assert.IsType(t, &persistence.UserTimerTask{}, tasks[0])
 }
 
+func TestMutableStateBuilder_AddTimerTasks_CoalesceActivityHeartbeatTimers(t *testing.T) {
+	cfg := config.NewForTest()
+	msb := &mutableStateBuilder{
+		config:        cfg,
+		domainEntry:   constants.TestLocalDomainEntry,
+		executionInfo: &persistence.WorkflowExecutionInfo{WorkflowID: constants.TestWorkflowID},
+	}
+	addTimer := func(timerType TimerType, deadline time.Time) time.Time {
+		task := &persistence.ActivityTimeoutTask{
+			TaskData:    persistence.TaskData{VisibilityTimestamp: deadline},
+			TimeoutType: int(timerType),
+		}
+		msb.AddTimerTasks(task)
+		return task.VisibilityTimestamp
+	}
+	now := time.Now()
+
+	// heartbeat timers are not coalesced by default
+	assert.Equal(t, now, addTimer(TimerTypeHeartbeat, now))
+
+	cfg.ActivityHeartbeatTimerCoalesceInterval = dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Minute)
+	sweep := addTimer(TimerTypeHeartbeat, now)
+	assert.False(t, sweep.Before(now))
+	assert.True(t, sweep.Before(now.Add(time.Minute)))
+	// deadlines up to a sweep are delayed to it, later ones to the next sweep
+	assert.Equal(t, sweep, addTimer(TimerTypeHeartbeat, sweep.Add(-time.Nanosecond)))
+	assert.Equal(t, sweep, addTimer(TimerTypeHeartbeat, sweep))
+	assert.Equal(t, sweep.Add(time.Minute), addTimer(TimerTypeHeartbeat, sweep.Add(time.Nanosecond)))
+
+	// other activity timers are not coalesced
+	assert.Equal(t, now, addTimer(TimerTypeStartToClose, now))
+}
+
 func TestMutableStateBuilder_DeleteTransferTasks(t *testing.T) {
 	msb := &mutableStateBuilder{
 		insertTransferTasks: []persistence.Task{
@@ -532,6 +532,9 @@ func (m *mutableStateDecisionTaskManagerImpl) AddDecisionTaskStartedEvent(
 	}
 
 	decision, err := m.ReplicateDecisionTaskStartedEvent(decision, m.msb.GetCurrentVersion(), scheduleID, startedID, requestID, startTime)
+	if err == nil && m.msb.executionInfo.ActivityBatchCompleted {
+		m.msb.executionInfo.ActivityBatchNotifiedStartedID = decision.StartedID
+	}
 	return event, decision, err
 }
 
@@ -553,6 +556,11 @@ func (m *mutableStateDecisionTaskManagerImpl) AddDecisionTaskCompletedEvent(
 		return nil, m.msb.createInternalServerError(opTag)
 	}
 
+	// the completed decision was told about the completed activity batch, unless another batch completed after it started
+	if m.msb.executionInfo.ActivityBatchCompleted && m.msb.executionInfo.ActivityBatchNotifiedStartedID == startedEventID {
+		m.msb.executionInfo.ActivityBatchCompleted = false
+	}
+
 	m.beforeAddDecisionTaskCompletedEvent()
 	if decision.Attempt > 0 {
 		// Create corresponding DecisionTaskSchedule and DecisionTaskStarted events for decisions we have been retrying
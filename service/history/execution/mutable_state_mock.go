@@ -69,6 +69,21 @@ func (m *MockMutableState) EXPECT() *MockMutableStateMockRecorder {
 	return m.recorder
 }
 
+// AddActivityAttemptsResetMarkerEvent mocks base method.
+func (m *MockMutableState) AddActivityAttemptsResetMarkerEvent(arg0 context.Context, arg1, arg2 int64, arg3 string, arg4 int32, arg5 string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddActivityAttemptsResetMarkerEvent", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddActivityAttemptsResetMarkerEvent indicates an expected call of AddActivityAttemptsResetMarkerEvent.
+func (mr *MockMutableStateMockRecorder) AddActivityAttemptsResetMarkerEvent(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityAttemptsResetMarkerEvent", reflect.TypeOf((*MockMutableState)(nil).AddActivityAttemptsResetMarkerEvent), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // AddActivityTaskCancelRequestedEvent mocks base method.
 func (m *MockMutableState) AddActivityTaskCancelRequestedEvent(arg0 int64, arg1, arg2 string) (*types.HistoryEvent, *persistence.ActivityInfo, error) {
 	m.ctrl.T.Helper()
@@ -101,18 +116,18 @@ func (mr *MockMutableStateMockRecorder) AddActivityTaskCanceledEvent(arg0, arg1,
 }
 
 // AddActivityTaskCompletedEvent mocks base method.
-func (m *MockMutableState) AddActivityTaskCompletedEvent(arg0, arg1 int64, arg2 *types.RespondActivityTaskCompletedRequest) (*types.HistoryEvent, error) {
+func (m *MockMutableState) AddActivityTaskCompletedEvent(arg0, arg1 int64, arg2 *types.RespondActivityTaskCompletedRequest, arg3 *ActivityTaskCompletedOptions) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddActivityTaskCompletedEvent", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "AddActivityTaskCompletedEvent", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*types.HistoryEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddActivityTaskCompletedEvent indicates an expected call of AddActivityTaskCompletedEvent.
-func (mr *MockMutableStateMockRecorder) AddActivityTaskCompletedEvent(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockMutableStateMockRecorder) AddActivityTaskCompletedEvent(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityTaskCompletedEvent", reflect.TypeOf((*MockMutableState)(nil).AddActivityTaskCompletedEvent), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityTaskCompletedEvent", reflect.TypeOf((*MockMutableState)(nil).AddActivityTaskCompletedEvent), arg0, arg1, arg2, arg3)
 }
 
 // AddActivityTaskFailedEvent mocks base method.
@@ -738,6 +753,25 @@ func (mr *MockMutableStateMockRecorder) AddUpsertWorkflowSearchAttributesEvent(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUpsertWorkflowSearchAttributesEvent", reflect.TypeOf((*MockMutableState)(nil).AddUpsertWorkflowSearchAttributesEvent), arg0, arg1)
 }
 
+// AddWeightedActivityTaskScheduledEvent mocks base method.
+func (m *MockMutableState) AddWeightedActivityTaskScheduledEvent(arg0 context.Context, arg1 int64, arg2 *types.ScheduleActivityTaskDecisionAttributes, arg3 *types.WeightedActivitySelection, arg4 bool) (*types.HistoryEvent, *persistence.ActivityInfo, *types.ActivityLocalDispatchInfo, bool, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWeightedActivityTaskScheduledEvent", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(*persistence.ActivityInfo)
+	ret2, _ := ret[2].(*types.ActivityLocalDispatchInfo)
+	ret3, _ := ret[3].(bool)
+	ret4, _ := ret[4].(bool)
+	ret5, _ := ret[5].(error)
+	return ret0, ret1, ret2, ret3, ret4, ret5
+}
+
+// AddWeightedActivityTaskScheduledEvent indicates an expected call of AddWeightedActivityTaskScheduledEvent.
+func (mr *MockMutableStateMockRecorder) AddWeightedActivityTaskScheduledEvent(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWeightedActivityTaskScheduledEvent", reflect.TypeOf((*MockMutableState)(nil).AddWeightedActivityTaskScheduledEvent), arg0, arg1, arg2, arg3, arg4)
+}
+
 // AddWorkflowExecutionCancelRequestedEvent mocks base method.
 func (m *MockMutableState) AddWorkflowExecutionCancelRequestedEvent(arg0 string, arg1 *types.HistoryRequestCancelWorkflowExecutionRequest) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -1004,6 +1038,20 @@ func (mr *MockMutableStateMockRecorder) DeleteUserTimer(timerID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserTimer", reflect.TypeOf((*MockMutableState)(nil).DeleteUserTimer), timerID)
 }
 
+// ExpireActivityHeartbeat mocks base method.
+func (m *MockMutableState) ExpireActivityHeartbeat(ai *persistence.ActivityInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireActivityHeartbeat", ai)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireActivityHeartbeat indicates an expected call of ExpireActivityHeartbeat.
+func (mr *MockMutableStateMockRecorder) ExpireActivityHeartbeat(ai any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireActivityHeartbeat", reflect.TypeOf((*MockMutableState)(nil).ExpireActivityHeartbeat), ai)
+}
+
 // FailDecision mocks base method.
 func (m *MockMutableState) FailDecision(arg0 bool) {
 	m.ctrl.T.Helper()
@@ -1420,6 +1468,20 @@ func (mr *MockMutableStateMockRecorder) GetQueryRegistry() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryRegistry", reflect.TypeOf((*MockMutableState)(nil).GetQueryRegistry))
 }
 
+// GetRecentlyClosedActivities mocks base method.
+func (m *MockMutableState) GetRecentlyClosedActivities() []*types.RecentlyClosedActivityInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentlyClosedActivities")
+	ret0, _ := ret[0].([]*types.RecentlyClosedActivityInfo)
+	return ret0
+}
+
+// GetRecentlyClosedActivities indicates an expected call of GetRecentlyClosedActivities.
+func (mr *MockMutableStateMockRecorder) GetRecentlyClosedActivities() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentlyClosedActivities", reflect.TypeOf((*MockMutableState)(nil).GetRecentlyClosedActivities))
+}
+
 // GetRequestCancelInfo mocks base method.
 func (m *MockMutableState) GetRequestCancelInfo(arg0 int64) (*persistence.RequestCancelInfo, bool) {
 	m.ctrl.T.Helper()
@@ -1792,6 +1854,60 @@ func (mr *MockMutableStateMockRecorder) Load(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockMutableState)(nil).Load), arg0)
 }
 
+// NackActivity mocks base method.
+func (m *MockMutableState) NackActivity(ai *persistence.ActivityInfo, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NackActivity", ai, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NackActivity indicates an expected call of NackActivity.
+func (mr *MockMutableStateMockRecorder) NackActivity(ai, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NackActivity", reflect.TypeOf((*MockMutableState)(nil).NackActivity), ai, reason)
+}
+
+// NotifyTransactionPersisted mocks base method.
+func (m *MockMutableState) NotifyTransactionPersisted() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyTransactionPersisted")
+}
+
+// NotifyTransactionPersisted indicates an expected call of NotifyTransactionPersisted.
+func (mr *MockMutableStateMockRecorder) NotifyTransactionPersisted() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyTransactionPersisted", reflect.TypeOf((*MockMutableState)(nil).NotifyTransactionPersisted))
+}
+
+// RedispatchActivity mocks base method.
+func (m *MockMutableState) RedispatchActivity(ai *persistence.ActivityInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedispatchActivity", ai)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedispatchActivity indicates an expected call of RedispatchActivity.
+func (mr *MockMutableStateMockRecorder) RedispatchActivity(ai any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedispatchActivity", reflect.TypeOf((*MockMutableState)(nil).RedispatchActivity), ai)
+}
+
+// ReplicateActivityAttemptsResetMarkerEvent mocks base method.
+func (m *MockMutableState) ReplicateActivityAttemptsResetMarkerEvent(arg0 context.Context, arg1 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateActivityAttemptsResetMarkerEvent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateActivityAttemptsResetMarkerEvent indicates an expected call of ReplicateActivityAttemptsResetMarkerEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateActivityAttemptsResetMarkerEvent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateActivityAttemptsResetMarkerEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateActivityAttemptsResetMarkerEvent), arg0, arg1)
+}
+
 // ReplicateActivityInfo mocks base method.
 func (m *MockMutableState) ReplicateActivityInfo(arg0 *types.SyncActivityRequest, arg1 bool) error {
 	m.ctrl.T.Helper()
@@ -2374,18 +2490,18 @@ func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionTimedoutEvent(
 }
 
 // RetryActivity mocks base method.
-func (m *MockMutableState) RetryActivity(ai *persistence.ActivityInfo, failureReason string, failureDetails []byte) (bool, error) {
+func (m *MockMutableState) RetryActivity(ai *persistence.ActivityInfo, failureReason string, failureDetails []byte, failureCategory *types.ActivityFailureCategory) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RetryActivity", ai, failureReason, failureDetails)
+	ret := m.ctrl.Call(m, "RetryActivity", ai, failureReason, failureDetails, failureCategory)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RetryActivity indicates an expected call of RetryActivity.
-func (mr *MockMutableStateMockRecorder) RetryActivity(ai, failureReason, failureDetails any) *gomock.Call {
+func (mr *MockMutableStateMockRecorder) RetryActivity(ai, failureReason, failureDetails, failureCategory any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryActivity", reflect.TypeOf((*MockMutableState)(nil).RetryActivity), ai, failureReason, failureDetails)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryActivity", reflect.TypeOf((*MockMutableState)(nil).RetryActivity), ai, failureReason, failureDetails, failureCategory)
 }
 
 // SetCurrentBranchToken mocks base method.
@@ -2493,6 +2609,20 @@ func (mr *MockMutableStateMockRecorder) StartTransaction(entry, incomingTaskVers
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTransaction", reflect.TypeOf((*MockMutableState)(nil).StartTransaction), entry, incomingTaskVersion)
 }
 
+// StealActivity mocks base method.
+func (m *MockMutableState) StealActivity(ai *persistence.ActivityInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StealActivity", ai)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StealActivity indicates an expected call of StealActivity.
+func (mr *MockMutableStateMockRecorder) StealActivity(ai any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StealActivity", reflect.TypeOf((*MockMutableState)(nil).StealActivity), ai)
+}
+
 // UpdateActivity mocks base method.
 func (m *MockMutableState) UpdateActivity(arg0 *persistence.ActivityInfo) error {
 	m.ctrl.T.Helper()
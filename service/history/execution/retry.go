@@ -26,8 +26,46 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/persistence"
 )
 
+// IsActivityRetryable tells if a failure of the current attempt of the activity would be retried by its retry
+// policy, assuming the failure reason is retryable
+func IsActivityRetryable(
+	ai *persistence.ActivityInfo,
+	now time.Time,
+) bool {
+
+	if !ai.HasRetryPolicy || ai.CancelRequested {
+		return false
+	}
+	if remaining, ok := GetActivityRemainingExecutionBudget(ai, now); ok && remaining <= 0 {
+		return false
+	}
+	return getBackoffInterval(
+		now,
+		ai.ExpirationTime,
+		ai.Attempt,
+		ai.MaximumAttempts,
+		ai.InitialInterval,
+		ai.MaximumInterval,
+		ai.MinimumInterval,
+		ai.BackoffCoefficient,
+		"",
+		nil,
+	) != backoff.NoBackoff
+}
+
+// IsActivityRedeliverable tells if the current attempt of the activity may be delivered to a worker again when its
+// worker is lost, i.e. retried or redispatched after a StartToClose or Heartbeat timeout. A started attempt of an
+// activity scheduled AtMostOnce is never delivered again
+func IsActivityRedeliverable(
+	ai *persistence.ActivityInfo,
+) bool {
+
+	return !ai.AtMostOnce || ai.StartedID == common.EmptyEventID
+}
+
 func getBackoffInterval(
 	now time.Time,
 	expirationTime time.Time,
@@ -35,6 +73,7 @@ func getBackoffInterval(
 	maxAttempts int32,
 	initInterval int32,
 	maxInterval int32,
+	minInterval int32,
 	backoffCoefficient float64,
 	failureReason string,
 	nonRetriableErrors []string,
@@ -65,6 +104,11 @@ func getBackoffInterval(
 		nextInterval = int64(maxInterval)
 	}
 
+	if nextInterval < int64(minInterval) {
+		// floor next interval to MinInterval, which wins over MaxInterval as validation keeps it no larger
+		nextInterval = int64(minInterval)
+	}
+
 	backoffInterval := time.Duration(nextInterval) * time.Second
 	nextScheduleTime := now.Add(backoffInterval)
 	if !expirationTime.IsZero() && nextScheduleTime.After(expirationTime) {
@@ -26,6 +26,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/persistence"
@@ -60,6 +61,7 @@ func Test_NextRetry2(t *testing.T) {
 		ai.MaximumAttempts,
 		ai.InitialInterval,
 		ai.MaximumInterval,
+		ai.MinimumInterval,
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
@@ -90,6 +92,7 @@ func Test_NextRetry(t *testing.T) {
 		ai.MaximumAttempts,
 		ai.InitialInterval,
 		ai.MaximumInterval,
+		ai.MinimumInterval,
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
@@ -105,6 +108,7 @@ func Test_NextRetry(t *testing.T) {
 		ai.MaximumAttempts,
 		ai.InitialInterval,
 		ai.MaximumInterval,
+		ai.MinimumInterval,
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
@@ -119,6 +123,7 @@ func Test_NextRetry(t *testing.T) {
 		ai.MaximumAttempts,
 		ai.InitialInterval,
 		ai.MaximumInterval,
+		ai.MinimumInterval,
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
@@ -134,6 +139,7 @@ func Test_NextRetry(t *testing.T) {
 		ai.MaximumAttempts,
 		ai.InitialInterval,
 		ai.MaximumInterval,
+		ai.MinimumInterval,
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
@@ -149,6 +155,7 @@ func Test_NextRetry(t *testing.T) {
 		ai.MaximumAttempts,
 		ai.InitialInterval,
 		ai.MaximumInterval,
+		ai.MinimumInterval,
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
@@ -162,6 +169,7 @@ func Test_NextRetry(t *testing.T) {
 		ai.MaximumAttempts,
 		ai.InitialInterval,
 		ai.MaximumInterval,
+		ai.MinimumInterval,
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
@@ -175,6 +183,7 @@ func Test_NextRetry(t *testing.T) {
 		ai.MaximumAttempts,
 		ai.InitialInterval,
 		ai.MaximumInterval,
+		ai.MinimumInterval,
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
@@ -190,6 +199,7 @@ func Test_NextRetry(t *testing.T) {
 		ai.MaximumAttempts,
 		ai.InitialInterval,
 		ai.MaximumInterval,
+		ai.MinimumInterval,
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
@@ -204,6 +214,7 @@ func Test_NextRetry(t *testing.T) {
 		ai.MaximumAttempts,
 		ai.InitialInterval,
 		ai.MaximumInterval,
+		ai.MinimumInterval,
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
@@ -219,6 +230,7 @@ func Test_NextRetry(t *testing.T) {
 		ai.MaximumAttempts,
 		ai.InitialInterval,
 		ai.MaximumInterval,
+		ai.MinimumInterval,
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
@@ -234,6 +246,7 @@ func Test_NextRetry(t *testing.T) {
 		ai.MaximumAttempts,
 		ai.InitialInterval,
 		ai.MaximumInterval,
+		ai.MinimumInterval,
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
@@ -250,6 +263,7 @@ func Test_NextRetry(t *testing.T) {
 		ai.MaximumAttempts,
 		ai.InitialInterval,
 		ai.MaximumInterval,
+		ai.MinimumInterval,
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
@@ -264,6 +278,7 @@ func Test_NextRetry(t *testing.T) {
 		ai.MaximumAttempts,
 		ai.InitialInterval,
 		ai.MaximumInterval,
+		ai.MinimumInterval,
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
@@ -280,9 +295,57 @@ func Test_NextRetry(t *testing.T) {
 		ai.MaximumAttempts,
 		ai.InitialInterval,
 		ai.MaximumInterval,
+		ai.MinimumInterval,
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
 	))
 	ai.Attempt++
+
+	// with a minimum interval, the early attempts are floored to it while the later ones keep growing
+	ai.Attempt = 0
+	ai.InitialInterval = 1
+	ai.BackoffCoefficient = 2
+	ai.MaximumInterval = 10
+	ai.MinimumInterval = 5
+	a.Equal(time.Second*5, getBackoffInterval(
+		now,
+		ai.ExpirationTime,
+		ai.Attempt,
+		ai.MaximumAttempts,
+		ai.InitialInterval,
+		ai.MaximumInterval,
+		ai.MinimumInterval,
+		ai.BackoffCoefficient,
+		reason,
+		ai.NonRetriableErrors,
+	))
+	ai.Attempt = 3
+	a.Equal(time.Second*8, getBackoffInterval(
+		now,
+		ai.ExpirationTime,
+		ai.Attempt,
+		ai.MaximumAttempts,
+		ai.InitialInterval,
+		ai.MaximumInterval,
+		ai.MinimumInterval,
+		ai.BackoffCoefficient,
+		reason,
+		ai.NonRetriableErrors,
+	))
+}
+
+func Test_IsActivityRedeliverable(t *testing.T) {
+	ai := &persistence.ActivityInfo{StartedID: common.EmptyEventID}
+	assert.True(t, IsActivityRedeliverable(ai))
+
+	ai.StartedID = common.TransientEventID
+	assert.True(t, IsActivityRedeliverable(ai))
+
+	ai.AtMostOnce = true
+	assert.False(t, IsActivityRedeliverable(ai))
+
+	// an attempt which was never started may be dispatched again
+	ai.StartedID = common.EmptyEventID
+	assert.True(t, IsActivityRedeliverable(ai))
 }
@@ -23,10 +23,12 @@
 package execution
 
 import (
+	"context"
 	"time"
 
 	"github.com/pborman/uuid"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/errors"
@@ -406,7 +408,14 @@ func (b *stateBuilderImpl) ApplyEvents(
 			}
 
 		case types.EventTypeMarkerRecorded:
-			// No mutable state action is needed
+			if event.MarkerRecordedEventAttributes.GetMarkerName() == common.ActivityAttemptsResetMarkerName {
+				if err := b.mutableState.ReplicateActivityAttemptsResetMarkerEvent(
+					context.Background(),
+					event,
+				); err != nil {
+					return nil, err
+				}
+			}
 
 		case types.EventTypeWorkflowExecutionSignaled:
 			if err := b.mutableState.ReplicateWorkflowExecutionSignaled(
@@ -24,6 +24,7 @@ package execution
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"time"
 
@@ -334,7 +335,7 @@ func (t *timerSequenceImpl) getActivityStartToCloseTimeout(
 	}
 
 	closeTimeout := activityInfo.StartedTime.Add(
-		time.Duration(activityInfo.StartToCloseTimeout) * time.Second,
+		time.Duration(GetActivityStartToCloseTimeout(activityInfo))*time.Second + activityInfo.MaintenancePausedTime,
 	)
 
 	return &TimerSequenceID{
@@ -360,20 +361,52 @@ func (t *timerSequenceImpl) getActivityHeartbeatTimeout(
 		return nil
 	}
 
-	// not heartbeat timeout configured
-	if activityInfo.HeartbeatTimeout <= 0 {
+	// a prefetched activity is not heartbeating before the worker acknowledges it was started
+	if activityInfo.PrefetchLeased {
 		return nil
 	}
 
-	// use the latest time as last heartbeat time
-	lastHeartbeat := activityInfo.StartedTime
-	if activityInfo.LastHeartBeatUpdatedTime.After(lastHeartbeat) {
-		lastHeartbeat = activityInfo.LastHeartBeatUpdatedTime
+	// the visibility and steal timeouts share the heartbeat timer, whichever is shorter fires first
+	timeoutInSeconds := activityInfo.HeartbeatTimeout
+	if IsActivityVisibilityTimeoutEffective(activityInfo) {
+		timeoutInSeconds = activityInfo.VisibilityTimeout
+	}
+	if IsActivityStealTimeoutEffective(activityInfo) {
+		timeoutInSeconds = activityInfo.StealTimeout
 	}
 
-	heartbeatTimeout := lastHeartbeat.Add(
-		time.Duration(activityInfo.HeartbeatTimeout) * time.Second,
-	)
+	var heartbeatTimeout time.Time
+	if timeoutInSeconds > 0 {
+		// use the latest time as last heartbeat time
+		lastHeartbeat := activityInfo.StartedTime
+		if activityInfo.LastHeartBeatUpdatedTime.After(lastHeartbeat) {
+			lastHeartbeat = activityInfo.LastHeartBeatUpdatedTime
+		}
+
+		heartbeatTimeout = lastHeartbeat.Add(
+			time.Duration(timeoutInSeconds) * time.Second,
+		)
+	}
+
+	// the heartbeat timer was expired by ExpireActivityHeartbeat, unless the attempt heartbeated or
+	// was retried since
+	if expiredTime := activityInfo.HeartbeatExpiredTime; expiredTime.After(activityInfo.StartedTime) &&
+		expiredTime.After(activityInfo.LastHeartBeatUpdatedTime) &&
+		(heartbeatTimeout.IsZero() || expiredTime.Before(heartbeatTimeout)) {
+		heartbeatTimeout = expiredTime
+	}
+
+	// the escalation of an unacknowledged cancellation shares the heartbeat timer as well
+	if escalationTime, ok := GetActivityCancellationEscalationTime(activityInfo); ok {
+		if heartbeatTimeout.IsZero() || escalationTime.Before(heartbeatTimeout) {
+			heartbeatTimeout = escalationTime
+		}
+	}
+
+	// not heartbeat timeout configured
+	if heartbeatTimeout.IsZero() {
+		return nil
+	}
 
 	return &TimerSequenceID{
 		EventID:      activityInfo.ScheduleID,
@@ -384,6 +417,94 @@ func (t *timerSequenceImpl) getActivityHeartbeatTimeout(
 	}
 }
 
+// IsActivityVisibilityTimeoutEffective returns true if the heartbeat timer of the activity
+// is driven by its visibility timeout rather than by its heartbeat timeout
+func IsActivityVisibilityTimeoutEffective(
+	activityInfo *persistence.ActivityInfo,
+) bool {
+	if activityInfo.VisibilityTimeout <= 0 {
+		return false
+	}
+	return activityInfo.HeartbeatTimeout <= 0 || activityInfo.VisibilityTimeout < activityInfo.HeartbeatTimeout
+}
+
+// GetActivityCancellationEscalationTime returns the time at which the next escalation step of the cancellation
+// of a started activity is due if the worker does not acknowledge it by closing the activity, the second return
+// value is false if the cancellation of the activity has no escalation step left
+func GetActivityCancellationEscalationTime(
+	activityInfo *persistence.ActivityInfo,
+) (time.Time, bool) {
+	if !activityInfo.CancelRequested || activityInfo.StartedID == common.EmptyEventID || activityInfo.CancelRequestedTime.IsZero() {
+		return time.Time{}, false
+	}
+
+	escalationTime, ok := time.Time{}, false
+	if activityInfo.CancelAckTimeout > 0 && activityInfo.CancelAckTimeoutExceededTime.IsZero() {
+		escalationTime, ok = activityInfo.CancelRequestedTime.Add(time.Duration(activityInfo.CancelAckTimeout)*time.Second), true
+	} else if activityInfo.CancelForceTimeout > 0 {
+		escalationTime, ok = activityInfo.CancelRequestedTime.Add(time.Duration(activityInfo.CancelForceTimeout)*time.Second), true
+	}
+	if deadline, waiting := GetActivityCancellationCheckpointDeadline(activityInfo); waiting && (!ok || deadline.Before(escalationTime)) {
+		return deadline, true
+	}
+	return escalationTime, ok
+}
+
+// GetActivityCancellationCheckpointDeadline returns the time until which history waits for the heartbeat carrying
+// the final checkpoint of an activity scheduled with CancellationCheckpointGraceSeconds, once a heartbeat response
+// delivered its cancellation to the worker. The second return value is false if history is not waiting.
+func GetActivityCancellationCheckpointDeadline(
+	activityInfo *persistence.ActivityInfo,
+) (time.Time, bool) {
+	if activityInfo.CancellationCheckpointGrace <= 0 || activityInfo.CancelDeliveredTime.IsZero() {
+		return time.Time{}, false
+	}
+	return activityInfo.CancelDeliveredTime.Add(time.Duration(activityInfo.CancellationCheckpointGrace) * time.Second), true
+}
+
+// GetActivityStartToCloseTimeout returns the StartToClose timeout in seconds which applies to the current
+// attempt of the activity, taking the first attempt override, any heartbeat granted extension and what is
+// left of the execution budget of the retry policy into account
+func GetActivityStartToCloseTimeout(
+	activityInfo *persistence.ActivityInfo,
+) int32 {
+	timeout := activityInfo.StartToCloseTimeout
+	if activityInfo.GrantedStartToCloseTimeout > 0 {
+		timeout = activityInfo.GrantedStartToCloseTimeout
+	} else if activityInfo.Attempt == 0 && activityInfo.FirstAttemptStartToCloseTimeout > 0 {
+		timeout = activityInfo.FirstAttemptStartToCloseTimeout
+	}
+
+	if activityInfo.MaxTotalExecutionSeconds > 0 {
+		remaining := time.Duration(activityInfo.MaxTotalExecutionSeconds)*time.Second - activityInfo.TotalExecutionTime
+		budget := int32(math.Max(1, math.Ceil(remaining.Seconds())))
+		if budget < timeout {
+			timeout = budget
+		}
+	}
+	return timeout
+}
+
+// GetActivityRemainingExecutionBudget returns what is left of the execution budget of the retry policy of
+// the activity at the given time, the second return value is false if the retry policy has no budget
+func GetActivityRemainingExecutionBudget(
+	activityInfo *persistence.ActivityInfo,
+	now time.Time,
+) (time.Duration, bool) {
+	if activityInfo.MaxTotalExecutionSeconds <= 0 {
+		return 0, false
+	}
+
+	remaining := time.Duration(activityInfo.MaxTotalExecutionSeconds)*time.Second - activityInfo.TotalExecutionTime
+	if !activityInfo.StartedTime.IsZero() {
+		remaining -= now.Sub(activityInfo.StartedTime)
+	}
+	if remaining < 0 {
+		remaining = 0
+	}
+	return remaining, true
+}
+
 // TimerTypeToTimerMask converts TimerType into the TimerTaskStatus flag
 func TimerTypeToTimerMask(
 	TimerType TimerType,
@@ -445,7 +566,7 @@ func TimerTypeFromInternal(
 func TimerTypeToReason(
 	timerType TimerType,
 ) string {
-	return fmt.Sprintf("cadenceInternal:Timeout %v", TimerTypeToInternal(timerType))
+	return fmt.Sprintf("%v %v", common.FailureReasonTimeoutPrefix, TimerTypeToInternal(timerType))
 }
 
 // Len implements sort.Interface
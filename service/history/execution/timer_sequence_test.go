@@ -879,6 +879,32 @@ func (s *timerSequenceSuite) TestGetActivityStartToCloseTimeout_Started() {
 	s.Equal(expectedTimerSequence, timerSequence)
 }
 
+func (s *timerSequenceSuite) TestGetActivityStartToCloseTimeout_FirstAttemptOverride() {
+	now := time.Now()
+	activityInfo := &persistence.ActivityInfo{
+		Version:                         123,
+		ScheduleID:                      234,
+		ScheduledTime:                   now,
+		StartedID:                       345,
+		StartedTime:                     now.Add(200 * time.Millisecond),
+		ActivityID:                      "some random activity ID",
+		ScheduleToStartTimeout:          10,
+		ScheduleToCloseTimeout:          1000,
+		StartToCloseTimeout:             100,
+		FirstAttemptStartToCloseTimeout: 5,
+		HeartbeatTimeout:                0,
+		TimerTaskStatus:                 TimerTaskStatusNone,
+		Attempt:                         0,
+	}
+
+	timerSequence := s.timerSequence.getActivityStartToCloseTimeout(activityInfo)
+	s.Equal(activityInfo.StartedTime.Add(5*time.Second), timerSequence.Timestamp)
+
+	activityInfo.Attempt = 1
+	timerSequence = s.timerSequence.getActivityStartToCloseTimeout(activityInfo)
+	s.Equal(activityInfo.StartedTime.Add(100*time.Second), timerSequence.Timestamp)
+}
+
 func (s *timerSequenceSuite) TestGetActivityHeartbeatTimeout_WithHeartbeat_NotStarted() {
 	now := time.Now()
 	activityInfo := &persistence.ActivityInfo{
@@ -975,6 +1001,49 @@ func (s *timerSequenceSuite) TestGetActivityHeartbeatTimeout_WithHeartbeat_Start
 	s.Equal(expectedTimerSequence, timerSequence)
 }
 
+func (s *timerSequenceSuite) TestGetActivityHeartbeatTimeout_Expired() {
+	now := time.Now()
+	activityInfo := &persistence.ActivityInfo{
+		Version:              123,
+		ScheduleID:           234,
+		ScheduledTime:        now,
+		StartedID:            345,
+		StartedTime:          now.Add(200 * time.Millisecond),
+		ActivityID:           "some random activity ID",
+		StartToCloseTimeout:  100,
+		HeartbeatTimeout:     10,
+		HeartbeatExpiredTime: now.Add(400 * time.Millisecond),
+		Attempt:              12,
+	}
+
+	expectedTimerSequence := &TimerSequenceID{
+		EventID:      activityInfo.ScheduleID,
+		Timestamp:    activityInfo.HeartbeatExpiredTime,
+		TimerType:    TimerTypeHeartbeat,
+		TimerCreated: false,
+		Attempt:      12,
+	}
+	timerSequence := s.timerSequence.getActivityHeartbeatTimeout(activityInfo)
+	s.Equal(expectedTimerSequence, timerSequence)
+
+	// a heartbeat after the expiry supersedes it
+	activityInfo.LastHeartBeatUpdatedTime = now.Add(600 * time.Millisecond)
+	expectedTimerSequence.Timestamp = activityInfo.LastHeartBeatUpdatedTime.Add(
+		time.Duration(activityInfo.HeartbeatTimeout) * time.Second,
+	)
+	timerSequence = s.timerSequence.getActivityHeartbeatTimeout(activityInfo)
+	s.Equal(expectedTimerSequence, timerSequence)
+
+	// so does the start of the next attempt
+	activityInfo.LastHeartBeatUpdatedTime = time.Time{}
+	activityInfo.StartedTime = now.Add(800 * time.Millisecond)
+	expectedTimerSequence.Timestamp = activityInfo.StartedTime.Add(
+		time.Duration(activityInfo.HeartbeatTimeout) * time.Second,
+	)
+	timerSequence = s.timerSequence.getActivityHeartbeatTimeout(activityInfo)
+	s.Equal(expectedTimerSequence, timerSequence)
+}
+
 func (s *timerSequenceSuite) TestGetActivityHeartbeatTimeout_WithoutHeartbeat_NotStarted() {
 	now := time.Now()
 	activityInfo := &persistence.ActivityInfo{
@@ -1049,6 +1118,204 @@ func (s *timerSequenceSuite) TestGetActivityHeartbeatTimeout_WithoutHeartbeat_St
 	s.Empty(timerSequence)
 }
 
+func (s *timerSequenceSuite) TestGetActivityHeartbeatTimeout_WithVisibilityTimeout() {
+	now := time.Now()
+	activityInfo := &persistence.ActivityInfo{
+		Version:                  123,
+		ScheduleID:               234,
+		ScheduledTime:            now,
+		StartedID:                common.TransientEventID,
+		StartedTime:              now.Add(200 * time.Millisecond),
+		ActivityID:               "some random activity ID",
+		ScheduleToStartTimeout:   10,
+		ScheduleToCloseTimeout:   1000,
+		StartToCloseTimeout:      100,
+		HeartbeatTimeout:         0,
+		LastHeartBeatUpdatedTime: now.Add(400 * time.Millisecond),
+		TimerTaskStatus:          TimerTaskStatusNone,
+		Attempt:                  12,
+		HasRetryPolicy:           true,
+		VisibilityTimeout:        5,
+	}
+
+	// visibility timeout applies when no heartbeat timeout is configured
+	s.True(IsActivityVisibilityTimeoutEffective(activityInfo))
+	timerSequence := s.timerSequence.getActivityHeartbeatTimeout(activityInfo)
+	s.Equal(&TimerSequenceID{
+		EventID:      activityInfo.ScheduleID,
+		Timestamp:    activityInfo.LastHeartBeatUpdatedTime.Add(5 * time.Second),
+		TimerType:    TimerTypeHeartbeat,
+		TimerCreated: false,
+		Attempt:      12,
+	}, timerSequence)
+
+	// a shorter heartbeat timeout wins over the visibility timeout
+	activityInfo.HeartbeatTimeout = 2
+	s.False(IsActivityVisibilityTimeoutEffective(activityInfo))
+	timerSequence = s.timerSequence.getActivityHeartbeatTimeout(activityInfo)
+	s.Equal(activityInfo.LastHeartBeatUpdatedTime.Add(2*time.Second), timerSequence.Timestamp)
+}
+
+func (s *timerSequenceSuite) TestGetActivityHeartbeatTimeout_WithStealTimeout() {
+	now := time.Now()
+	activityInfo := &persistence.ActivityInfo{
+		Version:                  123,
+		ScheduleID:               234,
+		ScheduledTime:            now,
+		StartedID:                common.TransientEventID,
+		StartedTime:              now.Add(200 * time.Millisecond),
+		ActivityID:               "some random activity ID",
+		ScheduleToStartTimeout:   10,
+		ScheduleToCloseTimeout:   1000,
+		StartToCloseTimeout:      100,
+		HeartbeatTimeout:         10,
+		LastHeartBeatUpdatedTime: now.Add(400 * time.Millisecond),
+		TimerTaskStatus:          TimerTaskStatusNone,
+		Attempt:                  12,
+		HasRetryPolicy:           true,
+		Idempotent:               true,
+	}
+	activityInfo.StealTimeout = GetActivityStealTimeout(activityInfo, 0.5)
+	s.Equal(int32(5), activityInfo.StealTimeout)
+
+	// steal timeout fires before the heartbeat timeout
+	s.True(IsActivityStealTimeoutEffective(activityInfo))
+	timerSequence := s.timerSequence.getActivityHeartbeatTimeout(activityInfo)
+	s.Equal(&TimerSequenceID{
+		EventID:      activityInfo.ScheduleID,
+		Timestamp:    activityInfo.LastHeartBeatUpdatedTime.Add(5 * time.Second),
+		TimerType:    TimerTypeHeartbeat,
+		TimerCreated: false,
+		Attempt:      12,
+	}, timerSequence)
+
+	// a shorter visibility timeout wins over the steal timeout
+	activityInfo.VisibilityTimeout = 3
+	s.False(IsActivityStealTimeoutEffective(activityInfo))
+	timerSequence = s.timerSequence.getActivityHeartbeatTimeout(activityInfo)
+	s.Equal(activityInfo.LastHeartBeatUpdatedTime.Add(3*time.Second), timerSequence.Timestamp)
+	activityInfo.VisibilityTimeout = 0
+
+	// a requested cancellation is left to the original worker
+	activityInfo.CancelRequested = true
+	s.False(IsActivityStealTimeoutEffective(activityInfo))
+	timerSequence = s.timerSequence.getActivityHeartbeatTimeout(activityInfo)
+	s.Equal(activityInfo.LastHeartBeatUpdatedTime.Add(10*time.Second), timerSequence.Timestamp)
+}
+
+func (s *timerSequenceSuite) TestGetActivityStealTimeout() {
+	activityInfo := &persistence.ActivityInfo{
+		HeartbeatTimeout: 10,
+		HasRetryPolicy:   true,
+		Idempotent:       true,
+	}
+	s.Equal(int32(2), GetActivityStealTimeout(activityInfo, 0.25))
+	s.Equal(int32(1), GetActivityStealTimeout(activityInfo, 0.01))
+	s.Equal(int32(0), GetActivityStealTimeout(activityInfo, 0))
+	s.Equal(int32(0), GetActivityStealTimeout(activityInfo, 1))
+
+	// activities which may have side effects are never stolen
+	activityInfo.Idempotent = false
+	s.Equal(int32(0), GetActivityStealTimeout(activityInfo, 0.5))
+	activityInfo.Idempotent = true
+
+	// nor are activities without heartbeats
+	activityInfo.HeartbeatTimeout = 0
+	s.Equal(int32(0), GetActivityStealTimeout(activityInfo, 0.5))
+	activityInfo.HeartbeatTimeout = 1
+	s.Equal(int32(0), GetActivityStealTimeout(activityInfo, 0.5))
+}
+
+func (s *timerSequenceSuite) TestGetActivityHeartbeatTimeout_PrefetchLeased() {
+	now := time.Now()
+	activityInfo := &persistence.ActivityInfo{
+		Version:                  123,
+		ScheduleID:               234,
+		ScheduledTime:            now,
+		StartedID:                345,
+		StartedTime:              now.Add(200 * time.Millisecond),
+		ActivityID:               "some random activity ID",
+		ScheduleToStartTimeout:   10,
+		ScheduleToCloseTimeout:   1000,
+		StartToCloseTimeout:      100,
+		HeartbeatTimeout:         1,
+		LastHeartBeatUpdatedTime: now.Add(200 * time.Millisecond),
+		TimerTaskStatus:          TimerTaskStatusNone,
+		Attempt:                  12,
+		PrefetchLeased:           true,
+	}
+
+	// the heartbeat timer of a prefetched activity waits for the acknowledging heartbeat
+	s.Nil(s.timerSequence.getActivityHeartbeatTimeout(activityInfo))
+	s.NotNil(s.timerSequence.getActivityStartToCloseTimeout(activityInfo))
+
+	activityInfo.PrefetchLeased = false
+	s.NotNil(s.timerSequence.getActivityHeartbeatTimeout(activityInfo))
+}
+
+func (s *timerSequenceSuite) TestGetActivityHeartbeatTimeout_CancellationEscalation() {
+	now := time.Now()
+	activityInfo := &persistence.ActivityInfo{
+		Version:                  123,
+		ScheduleID:               234,
+		ScheduledTime:            now,
+		StartedID:                345,
+		StartedTime:              now.Add(200 * time.Millisecond),
+		ActivityID:               "some random activity ID",
+		ScheduleToStartTimeout:   10,
+		ScheduleToCloseTimeout:   1000,
+		StartToCloseTimeout:      100,
+		HeartbeatTimeout:         0,
+		LastHeartBeatUpdatedTime: now.Add(400 * time.Millisecond),
+		TimerTaskStatus:          TimerTaskStatusNone,
+		Attempt:                  12,
+		CancelRequested:          true,
+		CancelRequestedTime:      now.Add(time.Second),
+		CancelAckTimeout:         5,
+		CancelForceTimeout:       20,
+	}
+
+	// the escalation applies when no heartbeat timeout is configured
+	timerSequence := s.timerSequence.getActivityHeartbeatTimeout(activityInfo)
+	s.Equal(&TimerSequenceID{
+		EventID:      activityInfo.ScheduleID,
+		Timestamp:    activityInfo.CancelRequestedTime.Add(5 * time.Second),
+		TimerType:    TimerTypeHeartbeat,
+		TimerCreated: false,
+		Attempt:      12,
+	}, timerSequence)
+
+	// once reported, the escalation waits for the forced cancellation
+	activityInfo.CancelAckTimeoutExceededTime = activityInfo.CancelRequestedTime.Add(5 * time.Second)
+	timerSequence = s.timerSequence.getActivityHeartbeatTimeout(activityInfo)
+	s.Equal(activityInfo.CancelRequestedTime.Add(20*time.Second), timerSequence.Timestamp)
+
+	// a shorter heartbeat timeout wins over the escalation
+	activityInfo.HeartbeatTimeout = 2
+	timerSequence = s.timerSequence.getActivityHeartbeatTimeout(activityInfo)
+	s.Equal(activityInfo.LastHeartBeatUpdatedTime.Add(2*time.Second), timerSequence.Timestamp)
+
+	// without forced cancellation there is no escalation step left
+	activityInfo.HeartbeatTimeout = 0
+	activityInfo.CancelForceTimeout = 0
+	_, ok := GetActivityCancellationEscalationTime(activityInfo)
+	s.False(ok)
+	s.Nil(s.timerSequence.getActivityHeartbeatTimeout(activityInfo))
+
+	// once the cancellation was delivered, the wait for the final checkpoint is bounded by the grace
+	activityInfo.CancellationCheckpointGrace = 3
+	_, ok = GetActivityCancellationEscalationTime(activityInfo)
+	s.False(ok)
+	activityInfo.CancelDeliveredTime = activityInfo.CancelRequestedTime.Add(time.Second)
+	timerSequence = s.timerSequence.getActivityHeartbeatTimeout(activityInfo)
+	s.Equal(activityInfo.CancelDeliveredTime.Add(3*time.Second), timerSequence.Timestamp)
+
+	// a forced cancellation due earlier wins over the grace
+	activityInfo.CancelForceTimeout = 2
+	timerSequence = s.timerSequence.getActivityHeartbeatTimeout(activityInfo)
+	s.Equal(activityInfo.CancelRequestedTime.Add(2*time.Second), timerSequence.Timestamp)
+}
+
 func (s *timerSequenceSuite) TestConversion() {
 	s.Equal(types.TimeoutTypeStartToClose, TimerTypeToInternal(TimerTypeStartToClose))
 	s.Equal(types.TimeoutTypeScheduleToStart, TimerTypeToInternal(TimerTypeScheduleToStart))
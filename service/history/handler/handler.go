@@ -24,6 +24,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -34,6 +35,8 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activityencryption"
+	"github.com/uber/cadence/common/activityvalidator"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -69,19 +72,21 @@ type (
 	handlerImpl struct {
 		resource.Resource
 
-		shuttingDown            int32
-		controller              shard.Controller
-		tokenSerializer         common.TaskTokenSerializer
-		startWG                 sync.WaitGroup
-		config                  *config.Config
-		historyEventNotifier    events.Notifier
-		rateLimiter             quotas.Limiter
-		replicationTaskFetchers replication.TaskFetchers
-		queueTaskProcessor      task.Processor
-		failoverCoordinator     failover.Coordinator
-		workflowIDCache         workflowcache.WFCache
-		queueProcessorFactory   queue.ProcessorFactory
-		ratelimitAggregator     algorithm.RequestWeighted
+		shuttingDown             int32
+		controller               shard.Controller
+		tokenSerializer          common.TaskTokenSerializer
+		startWG                  sync.WaitGroup
+		config                   *config.Config
+		historyEventNotifier     events.Notifier
+		rateLimiter              quotas.Limiter
+		replicationTaskFetchers  replication.TaskFetchers
+		queueTaskProcessor       task.Processor
+		failoverCoordinator      failover.Coordinator
+		workflowIDCache          workflowcache.WFCache
+		queueProcessorFactory    queue.ProcessorFactory
+		ratelimitAggregator      algorithm.RequestWeighted
+		activityResultValidator  activityvalidator.Validator
+		activityPayloadEncryptor activityencryption.Encryptor
 	}
 )
 
@@ -93,14 +98,18 @@ func NewHandler(
 	resource resource.Resource,
 	config *config.Config,
 	wfCache workflowcache.WFCache,
+	activityResultValidator activityvalidator.Validator,
+	activityPayloadEncryptor activityencryption.Encryptor,
 ) Handler {
 	handler := &handlerImpl{
-		Resource:            resource,
-		config:              config,
-		tokenSerializer:     common.NewJSONTaskTokenSerializer(),
-		rateLimiter:         quotas.NewDynamicRateLimiter(config.RPS.AsFloat64()),
-		workflowIDCache:     wfCache,
-		ratelimitAggregator: resource.GetRatelimiterAlgorithm(),
+		Resource:                 resource,
+		config:                   config,
+		tokenSerializer:          common.NewJSONTaskTokenSerializer(),
+		rateLimiter:              quotas.NewDynamicRateLimiter(config.RPS.AsFloat64()),
+		workflowIDCache:          wfCache,
+		ratelimitAggregator:      resource.GetRatelimiterAlgorithm(),
+		activityResultValidator:  activityResultValidator,
+		activityPayloadEncryptor: activityPayloadEncryptor,
 	}
 
 	// prevent us from trying to serve requests before shard controller is started and ready
@@ -223,6 +232,8 @@ func (h *handlerImpl) CreateEngine(
 		h.failoverCoordinator,
 		h.workflowIDCache,
 		queue.NewProcessorFactory(),
+		h.activityResultValidator,
+		h.activityPayloadEncryptor,
 	)
 }
 
@@ -529,6 +540,54 @@ func (h *handlerImpl) RespondActivityTaskCanceled(
 	return nil
 }
 
+// RespondActivityTaskNack - returns a started activity task to its task list without counting an attempt
+func (h *handlerImpl) RespondActivityTaskNack(
+	ctx context.Context,
+	wrappedRequest *types.HistoryRespondActivityTaskNackRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRespondActivityTaskNackScope)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	nackRequest := wrappedRequest.NackRequest
+	token, err0 := h.tokenSerializer.Deserialize(nackRequest.TaskToken)
+	if err0 != nil {
+		err0 = &types.BadRequestError{Message: fmt.Sprintf("Error deserializing task token. Error: %v", err0)}
+		return h.error(err0, scope, domainID, "", "")
+	}
+
+	err0 = validateTaskToken(token)
+	if err0 != nil {
+		return h.error(err0, scope, domainID, "", "")
+	}
+	workflowID := token.WorkflowID
+	runID := token.RunID
+
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	err2 := engine.RespondActivityTaskNack(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
 // RespondDecisionTaskCompleted - records completion of a decision task
 func (h *handlerImpl) RespondDecisionTaskCompleted(
 	ctx context.Context,
@@ -966,6 +1025,83 @@ func (h *handlerImpl) DescribeWorkflowExecution(
 	return resp, nil
 }
 
+// BatchDescribeWorkflowExecutions returns information about several workflow executions of a domain.
+// Executions are described in parallel and a failure to describe one of them doesn't fail the call,
+// the error is reported on its result instead. Results are returned in the order of the request.
+func (h *handlerImpl) BatchDescribeWorkflowExecutions(
+	ctx context.Context,
+	request *types.HistoryBatchDescribeWorkflowExecutionsRequest,
+) (resp *types.BatchDescribeWorkflowExecutionsResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryBatchDescribeWorkflowExecutionsScope)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	executions := request.Request.GetExecutions()
+	if maxSize := h.config.MaxBatchDescribeWorkflowExecutionsSize(); len(executions) > maxSize {
+		return nil, h.error(&types.BadRequestError{
+			Message: fmt.Sprintf("Too many executions in request: %d, max allowed: %d.", len(executions), maxSize),
+		}, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	results := make([]*types.DescribeWorkflowExecutionResult, len(executions))
+	var wg sync.WaitGroup
+	wg.Add(len(executions))
+	for i, execution := range executions {
+		go func(i int, execution *types.WorkflowExecution) {
+			defer wg.Done()
+
+			result := &types.DescribeWorkflowExecutionResult{Execution: execution}
+			response, err := h.describeWorkflowExecutionInBatch(ctx, domainID, request.Request.GetDomain(), execution)
+			if err != nil {
+				result.Error = h.error(err, scope, domainID, execution.GetWorkflowID(), execution.GetRunID()).Error()
+			} else {
+				result.Response = response
+			}
+			results[i] = result
+		}(i, execution)
+	}
+	wg.Wait()
+
+	return &types.BatchDescribeWorkflowExecutionsResponse{Results: results}, nil
+}
+
+func (h *handlerImpl) describeWorkflowExecutionInBatch(
+	ctx context.Context,
+	domainID string,
+	domain string,
+	execution *types.WorkflowExecution,
+) (resp *types.DescribeWorkflowExecutionResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+
+	if execution.GetWorkflowID() == "" {
+		return nil, constants.ErrWorkflowIDNotSet
+	}
+	engine, err := h.controller.GetEngine(execution.GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	return engine.DescribeWorkflowExecution(ctx, &types.HistoryDescribeWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		Request: &types.DescribeWorkflowExecutionRequest{
+			Domain:    domain,
+			Execution: execution,
+		},
+	})
+}
+
 // RequestCancelWorkflowExecution - requests cancellation of a workflow
 func (h *handlerImpl) RequestCancelWorkflowExecution(
 	ctx context.Context,
@@ -1055,6 +1191,481 @@ func (h *handlerImpl) SignalWorkflowExecution(
 	return nil
 }
 
+// SignalActivity buffers a signal for a pending activity, it is delivered with the response of the next
+// RecordActivityTaskHeartbeat of the activity
+func (h *handlerImpl) SignalActivity(
+	ctx context.Context,
+	wrappedRequest *types.HistorySignalActivityRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistorySignalActivityScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := wrappedRequest.SignalRequest.GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	if workflowID == "" {
+		return h.error(constants.ErrWorkflowIDNotSet, scope, domainID, workflowID, runID)
+	}
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	if err := engine.SignalActivity(ctx, wrappedRequest); err != nil {
+		return h.error(err, scope, domainID, workflowID, runID)
+	}
+	return nil
+}
+
+// GetActivityEffectiveConfig returns the timeouts and retry policy which apply to a pending activity.
+func (h *handlerImpl) GetActivityEffectiveConfig(
+	ctx context.Context,
+	request *types.HistoryGetActivityEffectiveConfigRequest,
+) (resp *types.GetActivityEffectiveConfigResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetActivityEffectiveConfigScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := request.Request.GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	if workflowID == "" {
+		return nil, h.error(constants.ErrWorkflowIDNotSet, scope, domainID, workflowID, runID)
+	}
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	resp, err = engine.GetActivityEffectiveConfig(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+	return resp, nil
+}
+
+// GetActivityNextRetryTime returns when a pending activity which is backing off will be retried.
+func (h *handlerImpl) GetActivityNextRetryTime(
+	ctx context.Context,
+	request *types.HistoryGetActivityNextRetryTimeRequest,
+) (resp *types.GetActivityNextRetryTimeResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetActivityNextRetryTimeScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := request.Request.GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	if workflowID == "" {
+		return nil, h.error(constants.ErrWorkflowIDNotSet, scope, domainID, workflowID, runID)
+	}
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	resp, err = engine.GetActivityNextRetryTime(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+	return resp, nil
+}
+
+// GetActivityRescheduleReasons returns why each previous attempt of an activity ended and caused it to be scheduled again.
+func (h *handlerImpl) GetActivityRescheduleReasons(
+	ctx context.Context,
+	request *types.HistoryGetActivityRescheduleReasonsRequest,
+) (resp *types.GetActivityRescheduleReasonsResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetActivityRescheduleReasonsScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := request.Request.GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	if workflowID == "" {
+		return nil, h.error(constants.ErrWorkflowIDNotSet, scope, domainID, workflowID, runID)
+	}
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	resp, err = engine.GetActivityRescheduleReasons(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+	return resp, nil
+}
+
+// GetActivityWorkerHistory returns the worker each attempt of an activity ran on and how the attempt ended.
+func (h *handlerImpl) GetActivityWorkerHistory(
+	ctx context.Context,
+	request *types.HistoryGetActivityWorkerHistoryRequest,
+) (resp *types.GetActivityWorkerHistoryResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetActivityWorkerHistoryScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := request.Request.GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	if workflowID == "" {
+		return nil, h.error(constants.ErrWorkflowIDNotSet, scope, domainID, workflowID, runID)
+	}
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	resp, err = engine.GetActivityWorkerHistory(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+	return resp, nil
+}
+
+// GetActivityRetryStats returns the outcome of the activity attempts of a domain closed on the host within a time range.
+// The stats are aggregated per host rather than per shard, so the engine of any shard owned by the host serves them.
+func (h *handlerImpl) GetActivityRetryStats(
+	ctx context.Context,
+	request *types.HistoryGetActivityRetryStatsRequest,
+) (resp *types.GetActivityRetryStatsResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetActivityRetryStatsScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	shardIDs := h.controller.ShardIDs()
+	if len(shardIDs) == 0 {
+		// the host owns no shard, so it has no engine to serve the stats
+		return &types.GetActivityRetryStatsResponse{}, nil
+	}
+	engine, err := h.controller.GetEngineForShard(int(shardIDs[0]))
+	if err != nil {
+		return nil, h.error(err, scope, domainID, "", "")
+	}
+
+	resp, err = engine.GetActivityRetryStats(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, "", "")
+	}
+	return resp, nil
+}
+
+// GetActivityTokenValidationFailures returns the latest task tokens of the activities of a domain rejected by the
+// shards of the host, oldest first.
+func (h *handlerImpl) GetActivityTokenValidationFailures(
+	ctx context.Context,
+	request *types.HistoryGetActivityTokenValidationFailuresRequest,
+) (resp *types.GetActivityTokenValidationFailuresResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetActivityTokenValidationFailuresScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	// the failures are kept by the engine of each shard
+	var failures []*types.ActivityTokenValidationFailure
+	for _, shardID := range h.controller.ShardIDs() {
+		engine, err := h.controller.GetEngineForShard(int(shardID))
+		if err != nil {
+			return nil, h.error(err, scope, domainID, "", "")
+		}
+
+		shardResp, err := engine.GetActivityTokenValidationFailures(ctx, request)
+		if err != nil {
+			return nil, h.error(err, scope, domainID, "", "")
+		}
+		failures = append(failures, shardResp.GetFailures()...)
+	}
+	sort.SliceStable(failures, func(i, j int) bool {
+		return failures[i].GetTimestamp() < failures[j].GetTimestamp()
+	})
+	return &types.GetActivityTokenValidationFailuresResponse{Failures: failures}, nil
+}
+
+// GetActivityAttemptTimings returns when each attempt of an activity started and ended and how it ended.
+func (h *handlerImpl) GetActivityAttemptTimings(
+	ctx context.Context,
+	request *types.HistoryGetActivityAttemptTimingsRequest,
+) (resp *types.GetActivityAttemptTimingsResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetActivityAttemptTimingsScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := request.Request.GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	if workflowID == "" {
+		return nil, h.error(constants.ErrWorkflowIDNotSet, scope, domainID, workflowID, runID)
+	}
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	resp, err = engine.GetActivityAttemptTimings(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+	return resp, nil
+}
+
+// ResetActivityAttempts resets the retry attempts of an activity and schedules it again if it ran out of retries
+func (h *handlerImpl) ResetActivityAttempts(
+	ctx context.Context,
+	request *types.HistoryResetActivityAttemptsRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryResetActivityAttemptsScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := request.Request.GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	if workflowID == "" {
+		return h.error(constants.ErrWorkflowIDNotSet, scope, domainID, workflowID, runID)
+	}
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	if err := engine.ResetActivityAttempts(ctx, request); err != nil {
+		return h.error(err, scope, domainID, workflowID, runID)
+	}
+	return nil
+}
+
+// RedriveTimedOutActivities resets the attempts of the pending activities of a workflow which timed out and wait for
+// their next retry, so that they are rescheduled right away
+func (h *handlerImpl) RedriveTimedOutActivities(
+	ctx context.Context,
+	request *types.HistoryRedriveTimedOutActivitiesRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRedriveTimedOutActivitiesScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := request.Request.GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	if workflowID == "" {
+		return h.error(constants.ErrWorkflowIDNotSet, scope, domainID, workflowID, runID)
+	}
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	if err := engine.RedriveTimedOutActivities(ctx, request); err != nil {
+		return h.error(err, scope, domainID, workflowID, runID)
+	}
+	return nil
+}
+
+// CancelPendingActivities requests the cancellation of the pending activities of a workflow, workers learn about it
+// from their next heartbeat. The activities which are not started yet are canceled right away.
+func (h *handlerImpl) CancelPendingActivities(
+	ctx context.Context,
+	request *types.HistoryCancelPendingActivitiesRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryCancelPendingActivitiesScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := request.Request.GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	if workflowID == "" {
+		return h.error(constants.ErrWorkflowIDNotSet, scope, domainID, workflowID, runID)
+	}
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	if err := engine.CancelPendingActivities(ctx, request); err != nil {
+		return h.error(err, scope, domainID, workflowID, runID)
+	}
+	return nil
+}
+
 // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
 // If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
 // and a decision task being created for the execution.
@@ -1749,6 +2360,84 @@ func (h *handlerImpl) ReapplyEvents(
 	return nil
 }
 
+// ExpireActivityHeartbeat fires the heartbeat timeout of a started activity right away, for tests
+func (h *handlerImpl) ExpireActivityHeartbeat(
+	ctx context.Context,
+	request *types.HistoryExpireActivityHeartbeatRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryExpireActivityHeartbeatScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	workflowExecution := request.Request.GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	if workflowID == "" {
+		return h.error(constants.ErrWorkflowIDNotSet, scope, domainID, workflowID, runID)
+	}
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	if err := engine.ExpireActivityHeartbeat(ctx, request); err != nil {
+		return h.error(err, scope, domainID, workflowID, runID)
+	}
+	return nil
+}
+
+// ReplayActivityCompletions replays the results of activities completed in the base run of a reset into the
+// run created by the reset
+func (h *handlerImpl) ReplayActivityCompletions(
+	ctx context.Context,
+	request *types.HistoryReplayActivityCompletionsRequest,
+) (resp *types.ReplayActivityCompletionsResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryReplayActivityCompletionsScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	workflowExecution := request.Request.GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	if workflowID == "" {
+		return nil, h.error(constants.ErrWorkflowIDNotSet, scope, domainID, workflowID, runID)
+	}
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	resp, err = engine.ReplayActivityCompletions(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+	return resp, nil
+}
+
 func (h *handlerImpl) CountDLQMessages(
 	ctx context.Context,
 	request *types.CountDLQMessagesRequest,
@@ -35,6 +35,8 @@ import (
 	"go.uber.org/yarpc/yarpcerrors"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activityencryption"
+	"github.com/uber/cadence/common/activityvalidator"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/membership"
@@ -98,7 +100,7 @@ func (s *handlerSuite) SetupTest() {
 	s.mockEngine = engine.NewMockEngine(s.controller)
 	s.mockWFCache = workflowcache.NewMockWFCache(s.controller)
 	s.mockFailoverCoordinator = failover.NewMockCoordinator(s.controller)
-	s.handler = NewHandler(s.mockResource, config.NewForTest(), s.mockWFCache).(*handlerImpl)
+	s.handler = NewHandler(s.mockResource, config.NewForTest(), s.mockWFCache, activityvalidator.NewNopValidator(), activityencryption.NewNopEncryptor()).(*handlerImpl)
 	s.handler.controller = s.mockShardController
 	s.mockTokenSerializer = common.NewMockTaskTokenSerializer(s.controller)
 	s.mockRatelimiter = quotas.NewMockLimiter(s.controller)
@@ -904,6 +906,76 @@ func (s *handlerSuite) TestRespondActivityTaskCanceled() {
 	}
 }
 
+func (s *handlerSuite) TestRespondActivityTaskNack() {
+	validInput := &types.HistoryRespondActivityTaskNackRequest{
+		DomainUUID: testDomainID,
+		NackRequest: &types.RespondActivityTaskNackRequest{
+			TaskToken: []byte("task-token"),
+			Reason:    "reason",
+			Identity:  "identity",
+		},
+	}
+	testInput := map[string]struct {
+		input         *types.HistoryRespondActivityTaskNackRequest
+		expectedError bool
+		mockFn        func()
+	}{
+		"valid input": {
+			input:         validInput,
+			expectedError: false,
+			mockFn: func() {
+				s.mockRatelimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockTokenSerializer.EXPECT().Deserialize(gomock.Any()).Return(&common.TaskToken{
+					WorkflowID: testWorkflowID,
+					RunID:      testValidUUID,
+				}, nil).Times(1)
+				s.mockShardController.EXPECT().GetEngine(testWorkflowID).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().RespondActivityTaskNack(gomock.Any(), validInput).Return(nil).Times(1)
+			},
+		},
+		"empty domainID": {
+			input: &types.HistoryRespondActivityTaskNackRequest{
+				DomainUUID: "",
+			},
+			expectedError: true,
+			mockFn:        func() {},
+		},
+		"token deserialization error": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.mockRatelimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockTokenSerializer.EXPECT().Deserialize(gomock.Any()).Return(nil, errors.New("some random error")).Times(1)
+			},
+		},
+		"engine error": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.mockRatelimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockTokenSerializer.EXPECT().Deserialize(gomock.Any()).Return(&common.TaskToken{
+					WorkflowID: testWorkflowID,
+					RunID:      testValidUUID,
+				}, nil).Times(1)
+				s.mockShardController.EXPECT().GetEngine(testWorkflowID).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().RespondActivityTaskNack(gomock.Any(), validInput).Return(errors.New("error")).Times(1)
+			},
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			input.mockFn()
+			err := s.handler.RespondActivityTaskNack(context.Background(), input.input)
+			if input.expectedError {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
 func (s *handlerSuite) TestRespondDecisionTaskCompleted() {
 	validReq := &types.HistoryRespondDecisionTaskCompletedRequest{
 		DomainUUID: testDomainID,
@@ -1677,6 +1749,105 @@ func (s *handlerSuite) TestDescribeWorkflowExecution() {
 	}
 }
 
+func (s *handlerSuite) TestBatchDescribeWorkflowExecutions() {
+	execution1 := &types.WorkflowExecution{WorkflowID: "workflow-1", RunID: testValidUUID}
+	execution2 := &types.WorkflowExecution{WorkflowID: "workflow-2", RunID: testValidUUID}
+	validInput := &types.HistoryBatchDescribeWorkflowExecutionsRequest{
+		DomainUUID: testDomainID,
+		Request: &types.BatchDescribeWorkflowExecutionsRequest{
+			Domain:     "domain",
+			Executions: []*types.WorkflowExecution{execution1, execution2},
+		},
+	}
+	describeRequest := func(execution *types.WorkflowExecution) *types.HistoryDescribeWorkflowExecutionRequest {
+		return &types.HistoryDescribeWorkflowExecutionRequest{
+			DomainUUID: testDomainID,
+			Request: &types.DescribeWorkflowExecutionRequest{
+				Domain:    "domain",
+				Execution: execution,
+			},
+		}
+	}
+	testInput := map[string]struct {
+		input         *types.HistoryBatchDescribeWorkflowExecutionsRequest
+		maxBatchSize  int
+		expected      *types.BatchDescribeWorkflowExecutionsResponse
+		expectedError bool
+		mockFn        func()
+	}{
+		"valid input": {
+			input:        validInput,
+			maxBatchSize: 2,
+			expected: &types.BatchDescribeWorkflowExecutionsResponse{
+				Results: []*types.DescribeWorkflowExecutionResult{
+					{Execution: execution1, Response: &types.DescribeWorkflowExecutionResponse{}},
+					{Execution: execution2, Response: &types.DescribeWorkflowExecutionResponse{}},
+				},
+			},
+			mockFn: func() {
+				s.mockRatelimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockShardController.EXPECT().GetEngine(gomock.Any()).Return(s.mockEngine, nil).Times(2)
+				s.mockEngine.EXPECT().DescribeWorkflowExecution(gomock.Any(), describeRequest(execution1)).Return(&types.DescribeWorkflowExecutionResponse{}, nil).Times(1)
+				s.mockEngine.EXPECT().DescribeWorkflowExecution(gomock.Any(), describeRequest(execution2)).Return(&types.DescribeWorkflowExecutionResponse{}, nil).Times(1)
+			},
+		},
+		"partial results": {
+			input:        validInput,
+			maxBatchSize: 2,
+			expected: &types.BatchDescribeWorkflowExecutionsResponse{
+				Results: []*types.DescribeWorkflowExecutionResult{
+					{Execution: execution1, Response: &types.DescribeWorkflowExecutionResponse{}},
+					{Execution: execution2, Error: "workflow not found"},
+				},
+			},
+			mockFn: func() {
+				s.mockRatelimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockShardController.EXPECT().GetEngine(execution1.WorkflowID).Return(s.mockEngine, nil).Times(1)
+				s.mockShardController.EXPECT().GetEngine(execution2.WorkflowID).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().DescribeWorkflowExecution(gomock.Any(), describeRequest(execution1)).Return(&types.DescribeWorkflowExecutionResponse{}, nil).Times(1)
+				s.mockEngine.EXPECT().DescribeWorkflowExecution(gomock.Any(), describeRequest(execution2)).Return(nil, &types.EntityNotExistsError{Message: "workflow not found"}).Times(1)
+			},
+		},
+		"empty domainID": {
+			input: &types.HistoryBatchDescribeWorkflowExecutionsRequest{
+				DomainUUID: "",
+			},
+			maxBatchSize:  2,
+			expectedError: true,
+			mockFn:        func() {},
+		},
+		"too many executions": {
+			input:         validInput,
+			maxBatchSize:  1,
+			expectedError: true,
+			mockFn:        func() {},
+		},
+		"ratelimit exceeded": {
+			input:         validInput,
+			maxBatchSize:  2,
+			expectedError: true,
+			mockFn: func() {
+				s.mockRatelimiter.EXPECT().Allow().Return(false).Times(1)
+			},
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			s.handler.config.MaxBatchDescribeWorkflowExecutionsSize = dynamicconfig.GetIntPropertyFn(input.maxBatchSize)
+			input.mockFn()
+			resp, err := s.handler.BatchDescribeWorkflowExecutions(context.Background(), input.input)
+			if input.expectedError {
+				s.Nil(resp)
+				s.Error(err)
+			} else {
+				s.Equal(input.expected, resp)
+				s.NoError(err)
+			}
+		})
+	}
+}
+
 func (s *handlerSuite) TestRequestCancelWorkflowExecution() {
 	validInput := &types.HistoryRequestCancelWorkflowExecutionRequest{
 		DomainUUID: testDomainID,
@@ -3044,6 +3215,170 @@ func (s *handlerSuite) TestReapplyEvents() {
 	}
 }
 
+func (s *handlerSuite) TestGetActivityRetryStats() {
+	validInput := &types.HistoryGetActivityRetryStatsRequest{
+		DomainUUID: testDomainID,
+		Request: &types.GetActivityRetryStatsRequest{
+			Domain: testDomainID,
+		},
+	}
+	testInput := map[string]struct {
+		input            *types.HistoryGetActivityRetryStatsRequest
+		expectedResponse *types.GetActivityRetryStatsResponse
+		expectedError    bool
+		mockFn           func()
+	}{
+		"valid input": {
+			input:            validInput,
+			expectedResponse: &types.GetActivityRetryStatsResponse{TotalAttempts: 3, Successes: 2},
+			mockFn: func() {
+				s.mockRatelimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockShardController.EXPECT().ShardIDs().Return([]int32{1, 2}).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(1).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().GetActivityRetryStats(gomock.Any(), validInput).
+					Return(&types.GetActivityRetryStatsResponse{TotalAttempts: 3, Successes: 2}, nil).Times(1)
+			},
+		},
+		"empty domainID": {
+			input: &types.HistoryGetActivityRetryStatsRequest{
+				DomainUUID: "",
+			},
+			expectedError: true,
+			mockFn:        func() {},
+		},
+		"ratelimit exceeded": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.mockRatelimiter.EXPECT().Allow().Return(false).Times(1)
+			},
+		},
+		"no shard owned": {
+			input:            validInput,
+			expectedResponse: &types.GetActivityRetryStatsResponse{},
+			mockFn: func() {
+				s.mockRatelimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockShardController.EXPECT().ShardIDs().Return(nil).Times(1)
+			},
+		},
+		"cannot get engine": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.mockRatelimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockShardController.EXPECT().ShardIDs().Return([]int32{1}).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(1).Return(nil, errors.New("error")).Times(1)
+			},
+		},
+		"engine error": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.mockRatelimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockShardController.EXPECT().ShardIDs().Return([]int32{1}).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(1).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().GetActivityRetryStats(gomock.Any(), validInput).Return(nil, errors.New("error")).Times(1)
+			},
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			input.mockFn()
+			resp, err := s.handler.GetActivityRetryStats(context.Background(), input.input)
+			if input.expectedError {
+				s.Error(err)
+				s.Nil(resp)
+			} else {
+				s.NoError(err)
+				s.Equal(input.expectedResponse, resp)
+			}
+		})
+	}
+}
+
+func (s *handlerSuite) TestGetActivityTokenValidationFailures() {
+	validInput := &types.HistoryGetActivityTokenValidationFailuresRequest{
+		DomainUUID: testDomainID,
+		Request: &types.GetActivityTokenValidationFailuresRequest{
+			Domain: testDomainID,
+		},
+	}
+	older := &types.ActivityTokenValidationFailure{ActivityID: "older", Timestamp: common.Int64Ptr(1)}
+	newer := &types.ActivityTokenValidationFailure{ActivityID: "newer", Timestamp: common.Int64Ptr(2)}
+	testInput := map[string]struct {
+		input            *types.HistoryGetActivityTokenValidationFailuresRequest
+		expectedResponse *types.GetActivityTokenValidationFailuresResponse
+		expectedError    bool
+		mockFn           func()
+	}{
+		"valid input": {
+			input: validInput,
+			expectedResponse: &types.GetActivityTokenValidationFailuresResponse{
+				Failures: []*types.ActivityTokenValidationFailure{older, newer},
+			},
+			mockFn: func() {
+				mockEngine2 := engine.NewMockEngine(s.controller)
+				s.mockRatelimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockShardController.EXPECT().ShardIDs().Return([]int32{1, 2}).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(1).Return(s.mockEngine, nil).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(2).Return(mockEngine2, nil).Times(1)
+				s.mockEngine.EXPECT().GetActivityTokenValidationFailures(gomock.Any(), validInput).
+					Return(&types.GetActivityTokenValidationFailuresResponse{Failures: []*types.ActivityTokenValidationFailure{newer}}, nil).Times(1)
+				mockEngine2.EXPECT().GetActivityTokenValidationFailures(gomock.Any(), validInput).
+					Return(&types.GetActivityTokenValidationFailuresResponse{Failures: []*types.ActivityTokenValidationFailure{older}}, nil).Times(1)
+			},
+		},
+		"empty domainID": {
+			input: &types.HistoryGetActivityTokenValidationFailuresRequest{
+				DomainUUID: "",
+			},
+			expectedError: true,
+			mockFn:        func() {},
+		},
+		"ratelimit exceeded": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.mockRatelimiter.EXPECT().Allow().Return(false).Times(1)
+			},
+		},
+		"cannot get engine": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.mockRatelimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockShardController.EXPECT().ShardIDs().Return([]int32{1}).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(1).Return(nil, errors.New("error")).Times(1)
+			},
+		},
+		"engine error": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.mockRatelimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockShardController.EXPECT().ShardIDs().Return([]int32{1}).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(1).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().GetActivityTokenValidationFailures(gomock.Any(), validInput).Return(nil, errors.New("error")).Times(1)
+			},
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			input.mockFn()
+			resp, err := s.handler.GetActivityTokenValidationFailures(context.Background(), input.input)
+			if input.expectedError {
+				s.Error(err)
+				s.Nil(resp)
+			} else {
+				s.NoError(err)
+				s.Equal(input.expectedResponse, resp)
+			}
+		})
+	}
+}
+
 func (s *handlerSuite) TestCountDLQMessages() {
 	validInput := &types.CountDLQMessagesRequest{
 		ForceFetch: true,
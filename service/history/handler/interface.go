@@ -48,6 +48,16 @@ type Handler interface {
 	DescribeMutableState(context.Context, *types.DescribeMutableStateRequest) (*types.DescribeMutableStateResponse, error)
 	DescribeQueue(context.Context, *types.DescribeQueueRequest) (*types.DescribeQueueResponse, error)
 	DescribeWorkflowExecution(context.Context, *types.HistoryDescribeWorkflowExecutionRequest) (*types.DescribeWorkflowExecutionResponse, error)
+	BatchDescribeWorkflowExecutions(context.Context, *types.HistoryBatchDescribeWorkflowExecutionsRequest) (*types.BatchDescribeWorkflowExecutionsResponse, error)
+	CancelPendingActivities(context.Context, *types.HistoryCancelPendingActivitiesRequest) error
+	ExpireActivityHeartbeat(context.Context, *types.HistoryExpireActivityHeartbeatRequest) error
+	GetActivityAttemptTimings(context.Context, *types.HistoryGetActivityAttemptTimingsRequest) (*types.GetActivityAttemptTimingsResponse, error)
+	GetActivityEffectiveConfig(context.Context, *types.HistoryGetActivityEffectiveConfigRequest) (*types.GetActivityEffectiveConfigResponse, error)
+	GetActivityNextRetryTime(context.Context, *types.HistoryGetActivityNextRetryTimeRequest) (*types.GetActivityNextRetryTimeResponse, error)
+	GetActivityRescheduleReasons(context.Context, *types.HistoryGetActivityRescheduleReasonsRequest) (*types.GetActivityRescheduleReasonsResponse, error)
+	GetActivityRetryStats(context.Context, *types.HistoryGetActivityRetryStatsRequest) (*types.GetActivityRetryStatsResponse, error)
+	GetActivityTokenValidationFailures(context.Context, *types.HistoryGetActivityTokenValidationFailuresRequest) (*types.GetActivityTokenValidationFailuresResponse, error)
+	GetActivityWorkerHistory(context.Context, *types.HistoryGetActivityWorkerHistoryRequest) (*types.GetActivityWorkerHistoryResponse, error)
 	GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error)
 	CountDLQMessages(context.Context, *types.CountDLQMessagesRequest) (*types.HistoryCountDLQMessagesResponse, error)
 	GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest) (*types.GetDLQReplicationMessagesResponse, error)
@@ -64,21 +74,26 @@ type Handler interface {
 	RecordActivityTaskStarted(context.Context, *types.RecordActivityTaskStartedRequest) (*types.RecordActivityTaskStartedResponse, error)
 	RecordChildExecutionCompleted(context.Context, *types.RecordChildExecutionCompletedRequest) error
 	RecordDecisionTaskStarted(context.Context, *types.RecordDecisionTaskStartedRequest) (*types.RecordDecisionTaskStartedResponse, error)
+	RedriveTimedOutActivities(context.Context, *types.HistoryRedriveTimedOutActivitiesRequest) error
 	RefreshWorkflowTasks(context.Context, *types.HistoryRefreshWorkflowTasksRequest) error
 	RemoveSignalMutableState(context.Context, *types.RemoveSignalMutableStateRequest) error
 	RemoveTask(context.Context, *types.RemoveTaskRequest) error
 	ReplicateEventsV2(context.Context, *types.ReplicateEventsV2Request) error
+	ReplayActivityCompletions(context.Context, *types.HistoryReplayActivityCompletionsRequest) (*types.ReplayActivityCompletionsResponse, error)
 	RequestCancelWorkflowExecution(context.Context, *types.HistoryRequestCancelWorkflowExecutionRequest) error
+	ResetActivityAttempts(context.Context, *types.HistoryResetActivityAttemptsRequest) error
 	ResetQueue(context.Context, *types.ResetQueueRequest) error
 	ResetStickyTaskList(context.Context, *types.HistoryResetStickyTaskListRequest) (*types.HistoryResetStickyTaskListResponse, error)
 	ResetWorkflowExecution(context.Context, *types.HistoryResetWorkflowExecutionRequest) (*types.ResetWorkflowExecutionResponse, error)
 	RespondActivityTaskCanceled(context.Context, *types.HistoryRespondActivityTaskCanceledRequest) error
 	RespondActivityTaskCompleted(context.Context, *types.HistoryRespondActivityTaskCompletedRequest) error
 	RespondActivityTaskFailed(context.Context, *types.HistoryRespondActivityTaskFailedRequest) error
+	RespondActivityTaskNack(context.Context, *types.HistoryRespondActivityTaskNackRequest) error
 	RespondCrossClusterTasksCompleted(context.Context, *types.RespondCrossClusterTasksCompletedRequest) (*types.RespondCrossClusterTasksCompletedResponse, error)
 	RespondDecisionTaskCompleted(context.Context, *types.HistoryRespondDecisionTaskCompletedRequest) (*types.HistoryRespondDecisionTaskCompletedResponse, error)
 	RespondDecisionTaskFailed(context.Context, *types.HistoryRespondDecisionTaskFailedRequest) error
 	ScheduleDecisionTask(context.Context, *types.ScheduleDecisionTaskRequest) error
+	SignalActivity(context.Context, *types.HistorySignalActivityRequest) error
 	SignalWithStartWorkflowExecution(context.Context, *types.HistorySignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
 	SignalWorkflowExecution(context.Context, *types.HistorySignalWorkflowExecutionRequest) error
 	StartWorkflowExecution(context.Context, *types.HistoryStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
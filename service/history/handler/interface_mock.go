@@ -65,6 +65,35 @@ func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
 	return m.recorder
 }
 
+// BatchDescribeWorkflowExecutions mocks base method.
+func (m *MockHandler) BatchDescribeWorkflowExecutions(arg0 context.Context, arg1 *types.HistoryBatchDescribeWorkflowExecutionsRequest) (*types.BatchDescribeWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDescribeWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*types.BatchDescribeWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchDescribeWorkflowExecutions indicates an expected call of BatchDescribeWorkflowExecutions.
+func (mr *MockHandlerMockRecorder) BatchDescribeWorkflowExecutions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDescribeWorkflowExecutions", reflect.TypeOf((*MockHandler)(nil).BatchDescribeWorkflowExecutions), arg0, arg1)
+}
+
+// CancelPendingActivities mocks base method.
+func (m *MockHandler) CancelPendingActivities(arg0 context.Context, arg1 *types.HistoryCancelPendingActivitiesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPendingActivities", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelPendingActivities indicates an expected call of CancelPendingActivities.
+func (mr *MockHandlerMockRecorder) CancelPendingActivities(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPendingActivities", reflect.TypeOf((*MockHandler)(nil).CancelPendingActivities), arg0, arg1)
+}
+
 // CloseShard mocks base method.
 func (m *MockHandler) CloseShard(arg0 context.Context, arg1 *types.CloseShardRequest) error {
 	m.ctrl.T.Helper()
@@ -154,6 +183,125 @@ func (mr *MockHandlerMockRecorder) DescribeWorkflowExecution(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).DescribeWorkflowExecution), arg0, arg1)
 }
 
+// ExpireActivityHeartbeat mocks base method.
+func (m *MockHandler) ExpireActivityHeartbeat(arg0 context.Context, arg1 *types.HistoryExpireActivityHeartbeatRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireActivityHeartbeat", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireActivityHeartbeat indicates an expected call of ExpireActivityHeartbeat.
+func (mr *MockHandlerMockRecorder) ExpireActivityHeartbeat(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireActivityHeartbeat", reflect.TypeOf((*MockHandler)(nil).ExpireActivityHeartbeat), arg0, arg1)
+}
+
+// GetActivityAttemptTimings mocks base method.
+func (m *MockHandler) GetActivityAttemptTimings(arg0 context.Context, arg1 *types.HistoryGetActivityAttemptTimingsRequest) (*types.GetActivityAttemptTimingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityAttemptTimings", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetActivityAttemptTimingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityAttemptTimings indicates an expected call of GetActivityAttemptTimings.
+func (mr *MockHandlerMockRecorder) GetActivityAttemptTimings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityAttemptTimings", reflect.TypeOf((*MockHandler)(nil).GetActivityAttemptTimings), arg0, arg1)
+}
+
+// GetActivityEffectiveConfig mocks base method.
+func (m *MockHandler) GetActivityEffectiveConfig(arg0 context.Context, arg1 *types.HistoryGetActivityEffectiveConfigRequest) (*types.GetActivityEffectiveConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityEffectiveConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetActivityEffectiveConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityEffectiveConfig indicates an expected call of GetActivityEffectiveConfig.
+func (mr *MockHandlerMockRecorder) GetActivityEffectiveConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityEffectiveConfig", reflect.TypeOf((*MockHandler)(nil).GetActivityEffectiveConfig), arg0, arg1)
+}
+
+// GetActivityNextRetryTime mocks base method.
+func (m *MockHandler) GetActivityNextRetryTime(arg0 context.Context, arg1 *types.HistoryGetActivityNextRetryTimeRequest) (*types.GetActivityNextRetryTimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityNextRetryTime", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetActivityNextRetryTimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityNextRetryTime indicates an expected call of GetActivityNextRetryTime.
+func (mr *MockHandlerMockRecorder) GetActivityNextRetryTime(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityNextRetryTime", reflect.TypeOf((*MockHandler)(nil).GetActivityNextRetryTime), arg0, arg1)
+}
+
+// GetActivityRescheduleReasons mocks base method.
+func (m *MockHandler) GetActivityRescheduleReasons(arg0 context.Context, arg1 *types.HistoryGetActivityRescheduleReasonsRequest) (*types.GetActivityRescheduleReasonsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityRescheduleReasons", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetActivityRescheduleReasonsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityRescheduleReasons indicates an expected call of GetActivityRescheduleReasons.
+func (mr *MockHandlerMockRecorder) GetActivityRescheduleReasons(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityRescheduleReasons", reflect.TypeOf((*MockHandler)(nil).GetActivityRescheduleReasons), arg0, arg1)
+}
+
+// GetActivityRetryStats mocks base method.
+func (m *MockHandler) GetActivityRetryStats(arg0 context.Context, arg1 *types.HistoryGetActivityRetryStatsRequest) (*types.GetActivityRetryStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityRetryStats", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetActivityRetryStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityRetryStats indicates an expected call of GetActivityRetryStats.
+func (mr *MockHandlerMockRecorder) GetActivityRetryStats(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityRetryStats", reflect.TypeOf((*MockHandler)(nil).GetActivityRetryStats), arg0, arg1)
+}
+
+// GetActivityTokenValidationFailures mocks base method.
+func (m *MockHandler) GetActivityTokenValidationFailures(arg0 context.Context, arg1 *types.HistoryGetActivityTokenValidationFailuresRequest) (*types.GetActivityTokenValidationFailuresResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityTokenValidationFailures", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetActivityTokenValidationFailuresResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityTokenValidationFailures indicates an expected call of GetActivityTokenValidationFailures.
+func (mr *MockHandlerMockRecorder) GetActivityTokenValidationFailures(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityTokenValidationFailures", reflect.TypeOf((*MockHandler)(nil).GetActivityTokenValidationFailures), arg0, arg1)
+}
+
+// GetActivityWorkerHistory mocks base method.
+func (m *MockHandler) GetActivityWorkerHistory(arg0 context.Context, arg1 *types.HistoryGetActivityWorkerHistoryRequest) (*types.GetActivityWorkerHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityWorkerHistory", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetActivityWorkerHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityWorkerHistory indicates an expected call of GetActivityWorkerHistory.
+func (mr *MockHandlerMockRecorder) GetActivityWorkerHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityWorkerHistory", reflect.TypeOf((*MockHandler)(nil).GetActivityWorkerHistory), arg0, arg1)
+}
+
 // GetCrossClusterTasks mocks base method.
 func (m *MockHandler) GetCrossClusterTasks(arg0 context.Context, arg1 *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -434,6 +582,20 @@ func (mr *MockHandlerMockRecorder) RecordDecisionTaskStarted(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDecisionTaskStarted", reflect.TypeOf((*MockHandler)(nil).RecordDecisionTaskStarted), arg0, arg1)
 }
 
+// RedriveTimedOutActivities mocks base method.
+func (m *MockHandler) RedriveTimedOutActivities(arg0 context.Context, arg1 *types.HistoryRedriveTimedOutActivitiesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedriveTimedOutActivities", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedriveTimedOutActivities indicates an expected call of RedriveTimedOutActivities.
+func (mr *MockHandlerMockRecorder) RedriveTimedOutActivities(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedriveTimedOutActivities", reflect.TypeOf((*MockHandler)(nil).RedriveTimedOutActivities), arg0, arg1)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockHandler) RefreshWorkflowTasks(arg0 context.Context, arg1 *types.HistoryRefreshWorkflowTasksRequest) error {
 	m.ctrl.T.Helper()
@@ -476,6 +638,21 @@ func (mr *MockHandlerMockRecorder) RemoveTask(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockHandler)(nil).RemoveTask), arg0, arg1)
 }
 
+// ReplayActivityCompletions mocks base method.
+func (m *MockHandler) ReplayActivityCompletions(arg0 context.Context, arg1 *types.HistoryReplayActivityCompletionsRequest) (*types.ReplayActivityCompletionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayActivityCompletions", arg0, arg1)
+	ret0, _ := ret[0].(*types.ReplayActivityCompletionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayActivityCompletions indicates an expected call of ReplayActivityCompletions.
+func (mr *MockHandlerMockRecorder) ReplayActivityCompletions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayActivityCompletions", reflect.TypeOf((*MockHandler)(nil).ReplayActivityCompletions), arg0, arg1)
+}
+
 // ReplicateEventsV2 mocks base method.
 func (m *MockHandler) ReplicateEventsV2(arg0 context.Context, arg1 *types.ReplicateEventsV2Request) error {
 	m.ctrl.T.Helper()
@@ -504,6 +681,20 @@ func (mr *MockHandlerMockRecorder) RequestCancelWorkflowExecution(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).RequestCancelWorkflowExecution), arg0, arg1)
 }
 
+// ResetActivityAttempts mocks base method.
+func (m *MockHandler) ResetActivityAttempts(arg0 context.Context, arg1 *types.HistoryResetActivityAttemptsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivityAttempts", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivityAttempts indicates an expected call of ResetActivityAttempts.
+func (mr *MockHandlerMockRecorder) ResetActivityAttempts(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityAttempts", reflect.TypeOf((*MockHandler)(nil).ResetActivityAttempts), arg0, arg1)
+}
+
 // ResetQueue mocks base method.
 func (m *MockHandler) ResetQueue(arg0 context.Context, arg1 *types.ResetQueueRequest) error {
 	m.ctrl.T.Helper()
@@ -590,6 +781,20 @@ func (mr *MockHandlerMockRecorder) RespondActivityTaskFailed(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondActivityTaskFailed", reflect.TypeOf((*MockHandler)(nil).RespondActivityTaskFailed), arg0, arg1)
 }
 
+// RespondActivityTaskNack mocks base method.
+func (m *MockHandler) RespondActivityTaskNack(arg0 context.Context, arg1 *types.HistoryRespondActivityTaskNackRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondActivityTaskNack", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RespondActivityTaskNack indicates an expected call of RespondActivityTaskNack.
+func (mr *MockHandlerMockRecorder) RespondActivityTaskNack(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondActivityTaskNack", reflect.TypeOf((*MockHandler)(nil).RespondActivityTaskNack), arg0, arg1)
+}
+
 // RespondCrossClusterTasksCompleted mocks base method.
 func (m *MockHandler) RespondCrossClusterTasksCompleted(arg0 context.Context, arg1 *types.RespondCrossClusterTasksCompletedRequest) (*types.RespondCrossClusterTasksCompletedResponse, error) {
 	m.ctrl.T.Helper()
@@ -648,6 +853,20 @@ func (mr *MockHandlerMockRecorder) ScheduleDecisionTask(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleDecisionTask", reflect.TypeOf((*MockHandler)(nil).ScheduleDecisionTask), arg0, arg1)
 }
 
+// SignalActivity mocks base method.
+func (m *MockHandler) SignalActivity(arg0 context.Context, arg1 *types.HistorySignalActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignalActivity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignalActivity indicates an expected call of SignalActivity.
+func (mr *MockHandlerMockRecorder) SignalActivity(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalActivity", reflect.TypeOf((*MockHandler)(nil).SignalActivity), arg0, arg1)
+}
+
 // SignalWithStartWorkflowExecution mocks base method.
 func (m *MockHandler) SignalWithStartWorkflowExecution(arg0 context.Context, arg1 *types.HistorySignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -51,7 +51,8 @@ type queueProcessorOptions struct {
 	EnableGracefulSyncShutdown           dynamicconfig.BoolPropertyFn
 	EnableValidator                      dynamicconfig.BoolPropertyFn
 	ValidationInterval                   dynamicconfig.DurationPropertyFn
-	// MaxPendingTaskSize is used in cross cluster queue to limit the pending task count
+	// MaxPendingTaskSize limits the number of outstanding tasks a queue processor will hold
+	// before backing off from loading more; 0 or less means no limit
 	MaxPendingTaskSize dynamicconfig.IntPropertyFn
 	MetricScope        int
 }
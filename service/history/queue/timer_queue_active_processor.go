@@ -40,7 +40,7 @@ func newTimerQueueActiveProcessor(
 	logger log.Logger,
 ) *timerQueueProcessorBase {
 	config := shard.GetConfig()
-	options := newTimerQueueProcessorOptions(config, true, false)
+	options := newTimerQueueProcessorOptions(config, shard.GetShardID(), true, false)
 
 	logger = logger.WithTags(tag.ClusterName(clusterName))
 
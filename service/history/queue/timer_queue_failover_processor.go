@@ -44,7 +44,7 @@ func newTimerQueueFailoverProcessor(
 	domainIDs map[string]struct{},
 ) (updateClusterAckLevelFn, *timerQueueProcessorBase) {
 	config := shardContext.GetConfig()
-	options := newTimerQueueProcessorOptions(config, true, true)
+	options := newTimerQueueProcessorOptions(config, shardContext.GetShardID(), true, true)
 
 	currentClusterName := shardContext.GetService().GetClusterMetadata().GetCurrentClusterName()
 	failoverStartTime := shardContext.GetTimeSource().Now()
@@ -38,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	ctask "github.com/uber/cadence/common/task"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
@@ -277,6 +278,12 @@ func (t *timerQueueProcessorBase) processQueueCollections(levels map[int]struct{
 			continue
 		}
 
+		if maxPendingTasks := t.options.MaxPendingTaskSize(); maxPendingTasks > 0 && t.pendingTaskCount() >= maxPendingTasks {
+			t.logger.Debug("Backing off timer queue loading because there are too many pending tasks", tag.QueueLevel(level))
+			t.setupBackoffTimer(level)
+			continue
+		}
+
 		ctx, cancel := context.WithTimeout(context.Background(), loadQueueTaskThrottleRetryDelay)
 		if err := t.rateLimiter.Wait(ctx); err != nil {
 			cancel()
@@ -363,6 +370,18 @@ func (t *timerQueueProcessorBase) processQueueCollections(levels map[int]struct{
 
 // splitQueue splits the processing queue collection based on some policy
 // and resets the timer with jitter for next run
+func (t *timerQueueProcessorBase) pendingTaskCount() int {
+	count := 0
+	for _, queueCollection := range t.processingQueueCollections {
+		for _, task := range queueCollection.GetTasks() {
+			if task.State() != ctask.TaskStateAcked {
+				count++
+			}
+		}
+	}
+	return count
+}
+
 func (t *timerQueueProcessorBase) splitQueue(splitQueueTimer *time.Timer) {
 	splitPolicy := t.initializeSplitPolicy(
 		func(key task.Key, domainID string) task.Key {
@@ -661,11 +680,17 @@ func (k timerTaskKey) String() string {
 
 func newTimerQueueProcessorOptions(
 	config *config.Config,
+	shardID int,
 	isActive bool,
 	isFailover bool,
 ) *queueProcessorOptions {
 	options := &queueProcessorOptions{
-		BatchSize:                            config.TimerTaskBatchSize,
+		BatchSize: func(opts ...dynamicconfig.FilterOption) int {
+			if batchSize := config.TimerProcessorBatchSize(shardID); batchSize > 0 {
+				return batchSize
+			}
+			return config.TimerTaskBatchSize(opts...)
+		},
 		DeleteBatchSize:                      config.TimerTaskDeleteBatchSize,
 		MaxPollRPS:                           config.TimerProcessorMaxPollRPS,
 		MaxPollInterval:                      config.TimerProcessorMaxPollInterval,
@@ -678,6 +703,9 @@ func newTimerQueueProcessorOptions(
 		PollBackoffInterval:                  config.QueueProcessorPollBackoffInterval,
 		PollBackoffIntervalJitterCoefficient: config.QueueProcessorPollBackoffIntervalJitterCoefficient,
 		EnableGracefulSyncShutdown:           config.QueueProcessorEnableGracefulSyncShutdown,
+		MaxPendingTaskSize: func(opts ...dynamicconfig.FilterOption) int {
+			return config.TimerProcessorMaxPendingTasks(shardID)
+		},
 	}
 
 	if isFailover {
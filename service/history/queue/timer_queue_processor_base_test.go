@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	ctask "github.com/uber/cadence/common/task"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/shard"
@@ -924,6 +925,57 @@ func (s *timerQueueProcessorBaseSuite) TestTimerProcessorPump_SplitQueue() {
 	}
 }
 
+func (s *timerQueueProcessorBaseSuite) TestTimerQueueProcessorOptions_ShardOverrides() {
+	config := s.mockShard.GetConfig()
+	config.TimerTaskBatchSize = dynamicconfig.GetIntPropertyFn(100)
+
+	options := newTimerQueueProcessorOptions(config, s.mockShard.GetShardID(), true, false)
+	s.Equal(100, options.BatchSize())
+	s.Equal(0, options.MaxPendingTaskSize())
+
+	config.TimerProcessorBatchSize = func(shardID int) int { return 20 }
+	config.TimerProcessorMaxPendingTasks = func(shardID int) int { return 500 }
+	s.Equal(20, options.BatchSize())
+	s.Equal(500, options.MaxPendingTaskSize())
+}
+
+func (s *timerQueueProcessorBaseSuite) TestProcessQueueCollections_TooManyPendingTasks() {
+	queueLevel := 0
+	ackLevel := newTimerTaskKey(time.Time{}, 0)
+	maxLevel := newTimerTaskKey(time.Now().Add(time.Hour), 0)
+	processingQueueStates := []ProcessingQueueState{
+		NewProcessingQueueState(
+			queueLevel,
+			ackLevel,
+			maxLevel,
+			NewDomainFilter(map[string]struct{}{"testDomain1": {}}, false),
+		),
+	}
+
+	updateMaxReadLevel := func() task.Key {
+		return maxLevel
+	}
+
+	timerQueueProcessBase, done := s.newTestTimerQueueProcessorBase(processingQueueStates, updateMaxReadLevel, nil, nil, nil)
+	defer done()
+	timerQueueProcessBase.options.MaxPendingTaskSize = dynamicconfig.GetIntPropertyFn(1)
+	mockTask := task.NewMockTask(s.controller)
+	mockTask.EXPECT().GetDomainID().Return("testDomain1").AnyTimes()
+	mockTask.EXPECT().State().Return(ctask.TaskStatePending).AnyTimes()
+	timerQueueProcessBase.processingQueueCollections[0].AddTasks(
+		map[task.Key]task.Task{newTimerTaskKey(time.Now(), 1): mockTask},
+		newTimerTaskKey(time.Now(), 1),
+	)
+
+	// no persistence read is expected since the pending task limit is reached
+	timerQueueProcessBase.processQueueCollections(map[int]struct{}{queueLevel: {}})
+
+	_, ok := timerQueueProcessBase.processingQueueReadProgress[queueLevel]
+	s.False(ok)
+	s.Len(timerQueueProcessBase.backoffTimer, 1)
+	timerQueueProcessBase.backoffTimer[queueLevel].Stop()
+}
+
 func (s *timerQueueProcessorBaseSuite) newTestTimerQueueProcessorBase(
 	processingQueueStates []ProcessingQueueState,
 	updateMaxReadLevel updateMaxReadLevelFn,
@@ -939,7 +991,7 @@ func (s *timerQueueProcessorBaseSuite) newTestTimerQueueProcessorBase(
 			processingQueueStates,
 			s.mockTaskProcessor,
 			timerGate,
-			newTimerQueueProcessorOptions(s.mockShard.GetConfig(), true, false),
+			newTimerQueueProcessorOptions(s.mockShard.GetConfig(), s.mockShard.GetShardID(), true, false),
 			updateMaxReadLevel,
 			updateClusterAckLevel,
 			updateProcessingQueueStates,
@@ -41,7 +41,7 @@ func newTimerQueueStandbyProcessor(
 	logger log.Logger,
 ) (*timerQueueProcessorBase, clock.EventTimerGate) {
 	config := shard.GetConfig()
-	options := newTimerQueueProcessorOptions(config, false, false)
+	options := newTimerQueueProcessorOptions(config, shard.GetShardID(), false, false)
 
 	logger = logger.WithTags(tag.ClusterName(clusterName))
 
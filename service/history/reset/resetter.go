@@ -395,7 +395,7 @@ func (r *workflowResetterImpl) failInflightActivity(
 				ai.StartedID,
 				&types.RespondActivityTaskFailedRequest{
 					Reason:   common.StringPtr(terminateReason),
-					Details:  ai.Details,
+					Details:  execution.GetActivityHeartbeatDetails(ai, false),
 					Identity: ai.StartedIdentity,
 				},
 			); err != nil {
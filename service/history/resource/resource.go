@@ -31,6 +31,9 @@ import (
 	"github.com/uber/cadence/common/quotas/global/algorithm"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/service/history/activitybreaker"
+	"github.com/uber/cadence/service/history/activitystats"
+	"github.com/uber/cadence/service/history/audit"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/events"
 )
@@ -40,6 +43,9 @@ type Resource interface {
 	resource.Resource
 	GetEventCache() events.Cache
 	GetRatelimiterAlgorithm() algorithm.RequestWeighted
+	GetActivityAuditSink() audit.Sink
+	GetActivityTypeBreaker() activitybreaker.Breaker
+	GetActivityRetryStats() activitystats.Aggregator
 }
 
 type resourceImpl struct {
@@ -48,6 +54,9 @@ type resourceImpl struct {
 	resource.Resource
 	eventCache         events.Cache
 	ratelimitAlgorithm algorithm.RequestWeighted
+	activityAuditSink  audit.Sink
+	activityBreaker    activitybreaker.Breaker
+	activityRetryStats activitystats.Aggregator
 }
 
 // Start starts all resources
@@ -77,6 +86,9 @@ func (h *resourceImpl) Stop() {
 	}
 
 	h.Resource.Stop()
+	if err := h.activityAuditSink.Close(); err != nil {
+		h.GetLogger().Error("failed to close activity audit log sink", tag.Error(err))
+	}
 	h.GetLogger().Info("history resource stopped", tag.LifeCycleStopped)
 }
 
@@ -88,6 +100,21 @@ func (h *resourceImpl) GetRatelimiterAlgorithm() algorithm.RequestWeighted {
 	return h.ratelimitAlgorithm
 }
 
+// GetActivityAuditSink return the sink of the activity audit log
+func (h *resourceImpl) GetActivityAuditSink() audit.Sink {
+	return h.activityAuditSink
+}
+
+// GetActivityTypeBreaker return the circuit breakers of the activity types
+func (h *resourceImpl) GetActivityTypeBreaker() activitybreaker.Breaker {
+	return h.activityBreaker
+}
+
+// GetActivityRetryStats return the aggregated outcome of the activity attempts of the domains
+func (h *resourceImpl) GetActivityRetryStats() activitystats.Aggregator {
+	return h.activityRetryStats
+}
+
 // New create a new resource containing common history dependencies
 func New(
 	params *resource.Params,
@@ -150,6 +177,20 @@ func New(
 		Resource:           serviceResource,
 		eventCache:         eventCache,
 		ratelimitAlgorithm: ratelimitAlgorithm,
+		activityAuditSink: audit.NewSink(
+			config.ActivityAuditLogSink,
+			config.ActivityAuditLogFilePath,
+			params.MessagingClient,
+		),
+		activityBreaker: activitybreaker.New(
+			config,
+			serviceResource.GetTimeSource(),
+			params.MetricsClient,
+		),
+		activityRetryStats: activitystats.New(
+			config,
+			serviceResource.GetTimeSource(),
+		),
 	}
 	return
 }
@@ -62,6 +62,9 @@ import (
 	client0 "github.com/uber/cadence/common/persistence/client"
 	algorithm "github.com/uber/cadence/common/quotas/global/algorithm"
 	rpc "github.com/uber/cadence/common/quotas/global/rpc"
+	activitybreaker "github.com/uber/cadence/service/history/activitybreaker"
+	activitystats "github.com/uber/cadence/service/history/activitystats"
+	audit "github.com/uber/cadence/service/history/audit"
 	events "github.com/uber/cadence/service/history/events"
 )
 
@@ -89,6 +92,48 @@ func (m *MockResource) EXPECT() *MockResourceMockRecorder {
 	return m.recorder
 }
 
+// GetActivityAuditSink mocks base method.
+func (m *MockResource) GetActivityAuditSink() audit.Sink {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityAuditSink")
+	ret0, _ := ret[0].(audit.Sink)
+	return ret0
+}
+
+// GetActivityAuditSink indicates an expected call of GetActivityAuditSink.
+func (mr *MockResourceMockRecorder) GetActivityAuditSink() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityAuditSink", reflect.TypeOf((*MockResource)(nil).GetActivityAuditSink))
+}
+
+// GetActivityRetryStats mocks base method.
+func (m *MockResource) GetActivityRetryStats() activitystats.Aggregator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityRetryStats")
+	ret0, _ := ret[0].(activitystats.Aggregator)
+	return ret0
+}
+
+// GetActivityRetryStats indicates an expected call of GetActivityRetryStats.
+func (mr *MockResourceMockRecorder) GetActivityRetryStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityRetryStats", reflect.TypeOf((*MockResource)(nil).GetActivityRetryStats))
+}
+
+// GetActivityTypeBreaker mocks base method.
+func (m *MockResource) GetActivityTypeBreaker() activitybreaker.Breaker {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityTypeBreaker")
+	ret0, _ := ret[0].(activitybreaker.Breaker)
+	return ret0
+}
+
+// GetActivityTypeBreaker indicates an expected call of GetActivityTypeBreaker.
+func (mr *MockResourceMockRecorder) GetActivityTypeBreaker() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityTypeBreaker", reflect.TypeOf((*MockResource)(nil).GetActivityTypeBreaker))
+}
+
 // GetArchivalMetadata mocks base method.
 func (m *MockResource) GetArchivalMetadata() archiver.ArchivalMetadata {
 	m.ctrl.T.Helper()
@@ -28,6 +28,9 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/quotas/global/algorithm"
 	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/service/history/activitybreaker"
+	"github.com/uber/cadence/service/history/activitystats"
+	"github.com/uber/cadence/service/history/audit"
 	"github.com/uber/cadence/service/history/events"
 )
 
@@ -36,6 +39,9 @@ type (
 	Test struct {
 		*resource.Test
 		EventCache           *events.MockCache
+		ActivityAuditSink    *audit.MockSink
+		ActivityTypeBreaker  *activitybreaker.MockBreaker
+		ActivityRetryStats   *activitystats.MockAggregator
 		ratelimiterAlgorithm algorithm.RequestWeighted
 	}
 )
@@ -49,8 +55,11 @@ func NewTest(
 	serviceMetricsIndex metrics.ServiceIdx,
 ) *Test {
 	return &Test{
-		Test:       resource.NewTest(t, controller, serviceMetricsIndex),
-		EventCache: events.NewMockCache(controller),
+		Test:                resource.NewTest(t, controller, serviceMetricsIndex),
+		EventCache:          events.NewMockCache(controller),
+		ActivityAuditSink:   audit.NewMockSink(controller),
+		ActivityTypeBreaker: activitybreaker.NewMockBreaker(controller),
+		ActivityRetryStats:  activitystats.NewMockAggregator(controller),
 	}
 }
 
@@ -62,3 +71,18 @@ func (s *Test) GetEventCache() events.Cache {
 func (s *Test) GetRatelimiterAlgorithm() algorithm.RequestWeighted {
 	return s.ratelimiterAlgorithm
 }
+
+// GetActivityAuditSink for testing
+func (s *Test) GetActivityAuditSink() audit.Sink {
+	return s.ActivityAuditSink
+}
+
+// GetActivityTypeBreaker for testing
+func (s *Test) GetActivityTypeBreaker() activitybreaker.Breaker {
+	return s.ActivityTypeBreaker
+}
+
+// GetActivityRetryStats for testing
+func (s *Test) GetActivityRetryStats() activitystats.Aggregator {
+	return s.ActivityRetryStats
+}
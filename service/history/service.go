@@ -25,6 +25,8 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activityencryption"
+	"github.com/uber/cadence/common/activityvalidator"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/quotas"
@@ -108,7 +110,16 @@ func (s *Service) Start() {
 		MetricsClient:          s.Resource.GetMetricsClient(),
 	})
 
-	rawHandler := handler.NewHandler(s.Resource, s.config, wfIDCache)
+	activityResultValidator := s.params.ActivityResultValidator
+	if activityResultValidator == nil {
+		activityResultValidator = activityvalidator.NewNopValidator()
+	}
+	activityPayloadEncryptor := s.params.ActivityPayloadEncryptor
+	if activityPayloadEncryptor == nil {
+		activityPayloadEncryptor = activityencryption.NewNopEncryptor()
+	}
+
+	rawHandler := handler.NewHandler(s.Resource, s.config, wfIDCache, activityResultValidator, activityPayloadEncryptor)
 	s.handler = ratelimited.NewHistoryHandler(
 		rawHandler,
 		wfIDCache,
@@ -0,0 +1,121 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package task
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/history/execution"
+)
+
+const (
+	activityCancellationEscalationIdentity = "cadence-history"
+	activityCancellationMsgNotAcknowledged = "CANCELLATION_NOT_ACKNOWLEDGED"
+)
+
+type (
+	// activityCancellationEscalation is recorded as the details of the ActivityTaskCanceled event
+	// of a forced cancellation, so that the escalation steps can be read from the history
+	activityCancellationEscalation struct {
+		Reason                 string     `json:"reason"`
+		CancelRequestedTime    time.Time  `json:"cancelRequestedTime"`
+		AckTimeoutExceededTime *time.Time `json:"ackTimeoutExceededTime,omitempty"`
+		ForcedTime             time.Time  `json:"forcedTime"`
+	}
+)
+
+// escalateActivityCancellation runs the next escalation step of the cancellation of a started activity which
+// the worker did not acknowledge by closing the activity: the first step reports the cancellation with a metric
+// and a log, the second one records ActivityTaskCanceled on behalf of the worker. Returns true if the activity
+// was canceled.
+//
+// An activity scheduled with CancellationCheckpointGraceSeconds whose final checkpoint heartbeat did not arrive
+// within the grace is canceled on behalf of the worker as well, with the last heartbeat details history has.
+func (t *timerActiveTaskExecutor) escalateActivityCancellation(
+	mutableState execution.MutableState,
+	activityInfo *persistence.ActivityInfo,
+	domainName string,
+	now time.Time,
+) (bool, error) {
+	logTags := []tag.Tag{
+		tag.WorkflowDomainName(domainName),
+		tag.WorkflowID(mutableState.GetExecutionInfo().WorkflowID),
+		tag.WorkflowRunID(mutableState.GetExecutionInfo().RunID),
+		tag.WorkflowActivityID(activityInfo.ActivityID),
+		tag.WorkflowScheduleID(activityInfo.ScheduleID),
+		tag.Dynamic("worker-identity", activityInfo.StartedIdentity),
+	}
+	scope := t.metricsClient.Scope(metrics.TimerActiveTaskActivityTimeoutScope, metrics.DomainTag(domainName))
+
+	if deadline, ok := execution.GetActivityCancellationCheckpointDeadline(activityInfo); ok && !deadline.After(now) {
+		scope.IncCounter(metrics.ActivityCancellationCheckpointGraceExceededCounter)
+		t.logger.Warn("Final checkpoint of canceled activity not received within the grace", logTags...)
+		if _, err := mutableState.AddActivityTaskCanceledEvent(
+			activityInfo.ScheduleID,
+			activityInfo.StartedID,
+			activityInfo.CancelRequestID,
+			execution.GetActivityHeartbeatDetails(activityInfo, false),
+			activityCancellationEscalationIdentity,
+		); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	if activityInfo.CancelAckTimeout > 0 && activityInfo.CancelAckTimeoutExceededTime.IsZero() {
+		activityInfo.CancelAckTimeoutExceededTime = now
+		scope.IncCounter(metrics.ActivityCancellationAckTimeoutCounter)
+		t.logger.Warn("Activity cancellation not acknowledged by worker", logTags...)
+		return false, mutableState.UpdateActivity(activityInfo)
+	}
+
+	escalation := activityCancellationEscalation{
+		Reason:              activityCancellationMsgNotAcknowledged,
+		CancelRequestedTime: activityInfo.CancelRequestedTime,
+		ForcedTime:          now,
+	}
+	if !activityInfo.CancelAckTimeoutExceededTime.IsZero() {
+		escalation.AckTimeoutExceededTime = &activityInfo.CancelAckTimeoutExceededTime
+	}
+	details, err := json.Marshal(escalation)
+	if err != nil {
+		return false, err
+	}
+
+	scope.IncCounter(metrics.ActivityCancellationForcedCounter)
+	t.logger.Warn("Forcing cancellation of activity not acknowledged by worker", logTags...)
+	if _, err := mutableState.AddActivityTaskCanceledEvent(
+		activityInfo.ScheduleID,
+		activityInfo.StartedID,
+		activityInfo.CancelRequestID,
+		details,
+		activityCancellationEscalationIdentity,
+	); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,80 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/history/execution"
+)
+
+// getActivityDispatchPredecessor returns the schedule ID of the activity the given activity is dispatched after,
+// or common.EmptyEventID if it can be dispatched now.
+//
+// Activities scheduled with OrderedDispatch are dispatched in the order they were scheduled, on each task list of
+// a workflow run: an ordered activity is held back until every ordered activity scheduled before it on the same
+// task list has started. Only the first dispatch is ordered, an activity waiting for its retry does not hold the
+// activities after it back. The ordering is enforced by history rather than matching because the transfer tasks
+// of a run are pushed concurrently and matching spreads them across task list partitions, so matching cannot tell
+// which of them was scheduled first.
+//
+// As a result, at most one ordered activity of a run is waiting in matching on a task list, and each of them is
+// only pushed once the previous one was picked up by a worker, followed by the redispatch backoff of its transfer
+// task. Priorities don't reorder ordered activities: a higher priority activity still waits for the ordered
+// activities scheduled before it.
+func getActivityDispatchPredecessor(
+	ctx context.Context,
+	mutableState execution.MutableState,
+	activityInfo *persistence.ActivityInfo,
+) (int64, error) {
+
+	scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, activityInfo.ScheduleID)
+	if err != nil {
+		return common.EmptyEventID, err
+	}
+	if !scheduledEvent.ActivityTaskScheduledEventAttributes.GetOrderedDispatch() {
+		return common.EmptyEventID, nil
+	}
+
+	for _, ai := range mutableState.GetPendingActivityInfos() {
+		if ai.ScheduleID >= activityInfo.ScheduleID || ai.TaskList != activityInfo.TaskList ||
+			ai.StartedID != common.EmptyEventID || ai.Attempt != 0 {
+			continue
+		}
+		predecessorEvent, err := mutableState.GetActivityScheduledEvent(ctx, ai.ScheduleID)
+		if err != nil {
+			return common.EmptyEventID, err
+		}
+		if predecessorEvent.ActivityTaskScheduledEventAttributes.GetOrderedDispatch() {
+			return ai.ScheduleID, nil
+		}
+	}
+	return common.EmptyEventID, nil
+}
+
+func newActivityDispatchOrderedError(predecessorID int64) error {
+	return &redispatchError{Reason: fmt.Sprintf("activity is dispatched after the activity scheduled by event %v", predecessorID)}
+}
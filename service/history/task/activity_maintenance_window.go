@@ -0,0 +1,194 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/robfig/cron"
+
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/history/execution"
+)
+
+const (
+	activityMaintenanceWindowCronScheduleKey = "cronSchedule"
+	activityMaintenanceWindowDurationKey     = "duration"
+	activityMaintenanceWindowAnyActivityType = "*"
+
+	// maxActivityMaintenanceWindowScan bounds the number of windows accounted for a single attempt
+	maxActivityMaintenanceWindowScan = 1000
+)
+
+type (
+	// activityMaintenanceWindow is the recurring maintenance window configured for an activity type through
+	// the history.activityMaintenanceWindows dynamic config, e.g.
+	//
+	//	history.activityMaintenanceWindows:
+	//	- value:
+	//	    dbMigrationActivity: {cronSchedule: "0 2 * * *", duration: "1h"}
+	//	  constraints: {domain: "billing"}
+	//
+	// The heartbeat and StartToClose timeouts of the activity are suspended while a window is in progress:
+	// a heartbeat timeout firing during a window is deferred until a heartbeat timeout after the end of the
+	// window, and the time an attempt spent in windows is added to its StartToClose timeout. ScheduleToClose
+	// timeouts are not suspended, so an activity paused for too long still eventually times out.
+	activityMaintenanceWindow struct {
+		schedule cron.Schedule
+		duration time.Duration
+	}
+)
+
+// getActivityMaintenanceWindow returns the maintenance window which applies to the given activity type,
+// the window configured for the activity type takes precedence over the one configured for every type.
+func getActivityMaintenanceWindow(windows map[string]interface{}, activityType string) (*activityMaintenanceWindow, bool) {
+	if window, ok := newActivityMaintenanceWindow(windows[activityType]); ok {
+		return window, true
+	}
+	return newActivityMaintenanceWindow(windows[activityMaintenanceWindowAnyActivityType])
+}
+
+// newActivityMaintenanceWindow parses the dynamic config value for a single activity type, returning false
+// if the value is not a valid window.
+func newActivityMaintenanceWindow(value interface{}) (*activityMaintenanceWindow, bool) {
+	fields, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	cronSchedule, _ := fields[activityMaintenanceWindowCronScheduleKey].(string)
+	schedule, err := backoff.ValidateSchedule(cronSchedule)
+	if err != nil {
+		return nil, false
+	}
+	durationValue, _ := fields[activityMaintenanceWindowDurationKey].(string)
+	duration, err := time.ParseDuration(durationValue)
+	if err != nil || duration <= 0 {
+		return nil, false
+	}
+	return &activityMaintenanceWindow{
+		schedule: schedule,
+		duration: duration,
+	}, true
+}
+
+// pausedTime returns the time spent in windows from the given time, a window which started before now
+// is counted until its end even if it is still in progress.
+func (w *activityMaintenanceWindow) pausedTime(from time.Time, now time.Time) time.Duration {
+	var paused time.Duration
+	accountedUntil := from.UTC()
+	start := w.schedule.Next(accountedUntil.Add(-w.duration))
+	for i := 0; i < maxActivityMaintenanceWindowScan && !start.IsZero() && !start.After(now); i++ {
+		// windows of a schedule shorter than their duration overlap, the overlap is only counted once
+		if end := start.Add(w.duration); end.After(accountedUntil) {
+			if start.After(accountedUntil) {
+				paused += end.Sub(start)
+			} else {
+				paused += end.Sub(accountedUntil)
+			}
+			accountedUntil = end
+		}
+		start = w.schedule.Next(start)
+	}
+	return paused
+}
+
+// lastEnd returns the end of the latest window which started before now and ends after the given time,
+// the second return value is false if there is no such window.
+func (w *activityMaintenanceWindow) lastEnd(from time.Time, now time.Time) (time.Time, bool) {
+	var lastEnd time.Time
+	start := w.schedule.Next(from.UTC().Add(-w.duration))
+	for i := 0; i < maxActivityMaintenanceWindowScan && !start.IsZero() && !start.After(now); i++ {
+		if end := start.Add(w.duration); end.After(lastEnd) {
+			lastEnd = end
+		}
+		start = w.schedule.Next(start)
+	}
+	return lastEnd, !lastEnd.IsZero()
+}
+
+// deferActivityTimeoutForMaintenance defers an expired heartbeat or StartToClose timeout of an activity whose
+// timeouts were suspended by a maintenance window, returns true if the timeout is not due anymore.
+func (t *timerActiveTaskExecutor) deferActivityTimeoutForMaintenance(
+	ctx context.Context,
+	mutableState execution.MutableState,
+	activityInfo *persistence.ActivityInfo,
+	timerType execution.TimerType,
+	domainName string,
+	now time.Time,
+) (bool, error) {
+	if timerType != execution.TimerTypeStartToClose && timerType != execution.TimerTypeHeartbeat {
+		return false, nil
+	}
+	windows := t.config.ActivityMaintenanceWindows(dynamicconfig.DomainFilter(domainName))
+	if len(windows) == 0 {
+		return false, nil
+	}
+	scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, activityInfo.ScheduleID)
+	if err != nil {
+		return false, err
+	}
+	window, ok := getActivityMaintenanceWindow(windows, scheduledEvent.ActivityTaskScheduledEventAttributes.GetActivityType().GetName())
+	if !ok {
+		return false, nil
+	}
+
+	var deadline time.Time
+	switch timerType {
+	case execution.TimerTypeStartToClose:
+		// the paused time is derived from the windows instead of being accumulated, so that it survives mutable state reloads
+		paused := window.pausedTime(activityInfo.StartedTime, now)
+		if paused <= activityInfo.MaintenancePausedTime {
+			return false, nil
+		}
+		activityInfo.MaintenancePausedTime = paused
+		activityInfo.TimerTaskStatus &^= execution.TimerTaskStatusCreatedStartToClose
+		deadline = activityInfo.StartedTime.Add(
+			time.Duration(execution.GetActivityStartToCloseTimeout(activityInfo))*time.Second + paused,
+		)
+	case execution.TimerTypeHeartbeat:
+		lastHeartbeat := activityInfo.StartedTime
+		if activityInfo.LastHeartBeatUpdatedTime.After(lastHeartbeat) {
+			lastHeartbeat = activityInfo.LastHeartBeatUpdatedTime
+		}
+		end, ok := window.lastEnd(lastHeartbeat, now)
+		if !ok {
+			return false, nil
+		}
+		// the heartbeat timeout starts over once the window is over
+		activityInfo.LastHeartBeatUpdatedTime = end
+		activityInfo.TimerTaskStatus &^= execution.TimerTaskStatusCreatedHeartbeat
+		deadline = end.Add(time.Duration(activityInfo.HeartbeatTimeout) * time.Second)
+	}
+
+	deferred := deadline.After(now)
+	if deferred {
+		activityInfo.MaintenanceTimeoutsDeferred++
+		t.metricsClient.Scope(metrics.TimerActiveTaskActivityTimeoutScope, metrics.DomainTag(domainName)).
+			IncCounter(metrics.ActivityTimeoutMaintenanceDeferredCounter)
+	}
+	return deferred, mutableState.UpdateActivity(activityInfo)
+}
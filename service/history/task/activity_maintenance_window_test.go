@@ -0,0 +1,92 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetActivityMaintenanceWindow(t *testing.T) {
+	windows := map[string]interface{}{
+		"nightly": map[string]interface{}{"cronSchedule": "0 2 * * *", "duration": "1h"},
+		"*":       map[string]interface{}{"cronSchedule": "0 3 * * *", "duration": "30m"},
+		"broken":  map[string]interface{}{"cronSchedule": "not a schedule", "duration": "1h"},
+	}
+
+	window, ok := getActivityMaintenanceWindow(windows, "nightly")
+	require.True(t, ok)
+	assert.Equal(t, time.Hour, window.duration)
+
+	window, ok = getActivityMaintenanceWindow(windows, "other")
+	require.True(t, ok)
+	assert.Equal(t, 30*time.Minute, window.duration)
+
+	window, ok = getActivityMaintenanceWindow(windows, "broken")
+	require.True(t, ok)
+	assert.Equal(t, 30*time.Minute, window.duration)
+
+	_, ok = getActivityMaintenanceWindow(map[string]interface{}{"nightly": map[string]interface{}{"cronSchedule": "0 2 * * *"}}, "nightly")
+	assert.False(t, ok)
+}
+
+func TestActivityMaintenanceWindow_PausedTime(t *testing.T) {
+	window, ok := newActivityMaintenanceWindow(map[string]interface{}{"cronSchedule": "0 2 * * *", "duration": "1h"})
+	require.True(t, ok)
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 1, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	// before the window
+	assert.Zero(t, window.pausedTime(at(1, 1, 0), at(1, 1, 30)))
+	// the window in progress is counted until its end
+	assert.Equal(t, time.Hour, window.pausedTime(at(1, 1, 50), at(1, 2, 10)))
+	// started during the window
+	assert.Equal(t, 30*time.Minute, window.pausedTime(at(1, 2, 30), at(1, 2, 40)))
+	// spanning two windows
+	assert.Equal(t, 2*time.Hour, window.pausedTime(at(1, 1, 0), at(2, 4, 0)))
+}
+
+func TestActivityMaintenanceWindow_LastEnd(t *testing.T) {
+	window, ok := newActivityMaintenanceWindow(map[string]interface{}{"cronSchedule": "0 2 * * *", "duration": "1h"})
+	require.True(t, ok)
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 1, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	_, ok = window.lastEnd(at(1, 1, 0), at(1, 1, 30))
+	assert.False(t, ok)
+	_, ok = window.lastEnd(at(1, 3, 10), at(1, 3, 30))
+	assert.False(t, ok)
+
+	end, ok := window.lastEnd(at(1, 1, 59), at(1, 2, 1))
+	require.True(t, ok)
+	assert.Equal(t, at(1, 3, 0), end)
+
+	end, ok = window.lastEnd(at(1, 1, 59), at(2, 2, 30))
+	require.True(t, ok)
+	assert.Equal(t, at(2, 3, 0), end)
+}
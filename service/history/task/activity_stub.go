@@ -0,0 +1,85 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package task
+
+const (
+	activityStubIdentity = "cadence-activity-stub"
+
+	activityStubResultKey         = "result"
+	activityStubFailureReasonKey  = "failureReason"
+	activityStubFailureDetailsKey = "failureDetails"
+	activityStubFailAttemptsKey   = "failAttempts"
+)
+
+type (
+	// activityStubOutcome is the canned outcome configured for an activity type through the
+	// history.activityStubOutcomes dynamic config, e.g.
+	//
+	//	history.activityStubOutcomes:
+	//	- value:
+	//	    flakyActivity: {failureReason: "timeout", failAttempts: 2, result: "done"}
+	//	    brokenActivity: {failureReason: "bad-input", failureDetails: "details"}
+	//	  constraints: {domain: "integration-test-domain"}
+	//
+	// An outcome without failureReason always completes with result. An outcome with failureReason
+	// fails every attempt, or only the first failAttempts attempts when failAttempts is positive.
+	activityStubOutcome struct {
+		result         []byte
+		failureReason  string
+		failureDetails []byte
+		failAttempts   int32
+	}
+)
+
+// newActivityStubOutcome parses the dynamic config value for a single activity type, returning false
+// if the activity type is not stubbed.
+func newActivityStubOutcome(value interface{}) (*activityStubOutcome, bool) {
+	fields, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	outcome := &activityStubOutcome{}
+	if result, ok := fields[activityStubResultKey].(string); ok {
+		outcome.result = []byte(result)
+	}
+	if reason, ok := fields[activityStubFailureReasonKey].(string); ok {
+		outcome.failureReason = reason
+	}
+	if details, ok := fields[activityStubFailureDetailsKey].(string); ok {
+		outcome.failureDetails = []byte(details)
+	}
+	switch attempts := fields[activityStubFailAttemptsKey].(type) {
+	case int:
+		outcome.failAttempts = int32(attempts)
+	case float64:
+		outcome.failAttempts = int32(attempts)
+	}
+	return outcome, true
+}
+
+func (o *activityStubOutcome) shouldFail(attempt int32) bool {
+	if o.failureReason == "" {
+		return false
+	}
+	return o.failAttempts <= 0 || attempt < o.failAttempts
+}
@@ -0,0 +1,76 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package task
+
+import (
+	"context"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+)
+
+const (
+	activityTimeoutResultIdentity = "cadence-history"
+)
+
+// completeActivityWithTimeoutResult closes an activity which timed out, once its retry policy gave up, with the
+// OnTimeoutDefaultResult of the decision which scheduled it: ActivityTaskCompleted, flagged as Synthesized, is
+// recorded instead of ActivityTaskTimedOut. It applies to all the timeout types, an activity which never started
+// gets an ActivityTaskStarted event first as the completion of an activity refers to its started event.
+// Returns false if the activity has no OnTimeoutDefaultResult.
+func completeActivityWithTimeoutResult(
+	ctx context.Context,
+	mutableState execution.MutableState,
+	activityInfo *persistence.ActivityInfo,
+	timeoutType types.TimeoutType,
+) (bool, error) {
+
+	scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, activityInfo.ScheduleID)
+	if err != nil {
+		return false, err
+	}
+	result := scheduledEvent.ActivityTaskScheduledEventAttributes.GetOnTimeoutDefaultResult()
+	if result == nil {
+		return false, nil
+	}
+
+	if activityInfo.StartedID == common.EmptyEventID {
+		if _, err := mutableState.AddActivityTaskStartedEvent(activityInfo, activityInfo.ScheduleID, uuid.New(), activityTimeoutResultIdentity); err != nil {
+			return false, err
+		}
+	}
+	if _, err := mutableState.AddActivityTaskCompletedEvent(activityInfo.ScheduleID, activityInfo.StartedID, &types.RespondActivityTaskCompletedRequest{
+		Result:   result,
+		Identity: activityTimeoutResultIdentity,
+	}, &execution.ActivityTaskCompletedOptions{
+		Synthesized: true,
+		TimeoutType: timeoutType.Ptr(),
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
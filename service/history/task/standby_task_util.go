@@ -114,6 +114,8 @@ type (
 	pushActivityToMatchingInfo struct {
 		activityScheduleToStartTimeout int32
 		partitionConfig                map[string]string
+		routingKey                     string
+		priority                       *int32
 	}
 
 	pushDecisionToMatchingInfo struct {
@@ -126,11 +128,15 @@ type (
 func newPushActivityToMatchingInfo(
 	activityScheduleToStartTimeout int32,
 	partitionConfig map[string]string,
+	routingKey string,
+	priority *int32,
 ) *pushActivityToMatchingInfo {
 
 	return &pushActivityToMatchingInfo{
 		activityScheduleToStartTimeout: activityScheduleToStartTimeout,
 		partitionConfig:                partitionConfig,
+		routingKey:                     routingKey,
+		priority:                       priority,
 	}
 }
 
@@ -355,7 +355,7 @@ func (t *taskImpl) Ack() {
 		t.scope.RecordTimer(metrics.TaskAttemptTimerPerDomain, time.Duration(t.attempt))
 		t.scope.RecordTimer(metrics.TaskLatencyPerDomain, time.Since(t.submitTime))
 		t.scope.RecordTimer(metrics.TaskQueueLatencyPerDomain, time.Since(t.GetVisibilityTimestamp()))
-
+		t.emitActivityTimerLatency()
 	}
 
 	if t.eventLogger != nil && t.shouldProcessTask && t.attempt != 0 {
@@ -364,6 +364,24 @@ func (t *taskImpl) Ack() {
 	}
 }
 
+// emitActivityTimerLatency records, per shard, how long after its fire time
+// an activity timer task was acked, so slow shards can be spotted
+func (t *taskImpl) emitActivityTimerLatency() {
+	timerTask, ok := t.Info.(*persistence.TimerTaskInfo)
+	if !ok {
+		return
+	}
+	if timerTask.TaskType != persistence.TaskTypeActivityTimeout &&
+		timerTask.TaskType != persistence.TaskTypeActivityRetryTimer {
+		return
+	}
+
+	t.shard.GetMetricsClient().Scope(
+		t.scopeIdx,
+		metrics.ShardIDTag(t.shard.GetShardID()),
+	).RecordTimer(metrics.ActivityTimerTaskLatencyPerShard, time.Since(t.GetVisibilityTimestamp()))
+}
+
 func (t *taskImpl) Nack() {
 	logEvent(t.eventLogger, "Nacked task")
 
@@ -268,6 +268,39 @@ func (s *taskSuite) TestTaskState() {
 	s.Equal(t.TaskStateNacked, taskBase.State())
 }
 
+func (s *taskSuite) TestTaskAck_ActivityTimerLatencyPerShard() {
+	taskBase := newTask(
+		s.mockShard,
+		&persistence.TimerTaskInfo{
+			DomainID:            constants.TestDomainID,
+			TaskType:            persistence.TaskTypeActivityTimeout,
+			VisibilityTimestamp: time.Now().Add(-time.Second),
+		},
+		QueueTypeActiveTimer,
+		0,
+		s.logger,
+		func(task Info) (bool, error) { return true, nil },
+		s.mockTaskExecutor,
+		s.mockTaskProcessor,
+		s.maxRetryCount,
+		func(_ Task) {},
+	)
+	taskBase.scope = s.mockShard.GetMetricsClient().Scope(0)
+	taskBase.shouldProcessTask = true
+
+	taskBase.Ack()
+
+	found := false
+	for _, timer := range s.mockShard.Resource.MetricsScope.Snapshot().Timers() {
+		if timer.Name() == "test.activity_timer_task_latency_per_shard" {
+			found = true
+			s.Equal("10", timer.Tags()["shard_id"])
+			s.NotEmpty(timer.Values())
+		}
+	}
+	s.True(found)
+}
+
 func (s *taskSuite) TestTaskPriority() {
 	taskBase := s.newTestTask(func(task Info) (bool, error) {
 		return true, nil
@@ -406,17 +406,77 @@ Loop:
 			}
 		}
 
-		if ok, err := mutableState.RetryActivity(
+		// the worker did not close the activity in time after its cancellation was requested
+		if timerSequenceID.TimerType == execution.TimerTypeHeartbeat {
+			if escalationTime, ok := execution.GetActivityCancellationEscalationTime(activityInfo); ok && !escalationTime.After(referenceTime) {
+				canceled, err := t.escalateActivityCancellation(mutableState, activityInfo, domainName, referenceTime)
+				if err != nil {
+					return err
+				}
+				updateMutableState = true
+				scheduleDecision = scheduleDecision || canceled
+				continue Loop
+			}
+		}
+
+		// a started attempt of an AtMostOnce activity may have had side effects already, it times out
+		// rather than being handed to another poller or retried
+		redeliverable := execution.IsActivityRedeliverable(activityInfo)
+
+		// the worker of an idempotent activity went without a heartbeat for the steal threshold of its task list,
+		// let a healthier poller take it over, the original worker is rejected once it reports back
+		if timerSequenceID.TimerType == execution.TimerTypeHeartbeat && execution.IsActivityStealTimeoutEffective(activityInfo) {
+			if err := mutableState.StealActivity(activityInfo); err != nil {
+				return err
+			}
+			t.metricsClient.Scope(
+				metrics.TimerActiveTaskActivityTimeoutScope,
+				metrics.DomainTag(domainName),
+				metrics.TaskListTag(activityInfo.TaskList),
+			).IncCounter(metrics.ActivityStolenCounter)
+			updateMutableState = true
+			continue Loop
+		}
+
+		// the worker did not heartbeat or complete within the visibility timeout,
+		// hand the activity to another poller without recording a timeout
+		if timerSequenceID.TimerType == execution.TimerTypeHeartbeat && execution.IsActivityVisibilityTimeoutEffective(activityInfo) && redeliverable {
+			if err := mutableState.RedispatchActivity(activityInfo); err != nil {
+				return err
+			}
+			t.metricsClient.Scope(metrics.TimerActiveTaskActivityTimeoutScope, metrics.DomainTag(domainName)).IncCounter(metrics.ActivityRedispatchCounter)
+			updateMutableState = true
+			continue Loop
+		}
+
+		if deferred, err := t.deferActivityTimeoutForMaintenance(
+			ctx,
+			mutableState,
 			activityInfo,
-			execution.TimerTypeToReason(timerSequenceID.TimerType),
-			nil,
+			timerSequenceID.TimerType,
+			domainName,
+			referenceTime,
 		); err != nil {
 			return err
-		} else if ok {
+		} else if deferred {
 			updateMutableState = true
 			continue Loop
 		}
 
+		if redeliverable {
+			if ok, err := mutableState.RetryActivity(
+				activityInfo,
+				execution.TimerTypeToReason(timerSequenceID.TimerType),
+				nil,
+				nil,
+			); err != nil {
+				return err
+			} else if ok {
+				updateMutableState = true
+				continue Loop
+			}
+		}
+
 		t.emitTimeoutMetricScopeWithDomainTag(
 			mutableState.GetExecutionInfo().DomainID,
 			metrics.TimerActiveTaskActivityTimeoutScope,
@@ -434,11 +494,24 @@ Loop:
 			tag.ActivityTimeoutType(shared.TimeoutType(timerSequenceID.TimerType)),
 		)
 
+		if completed, err := completeActivityWithTimeoutResult(
+			ctx,
+			mutableState,
+			activityInfo,
+			execution.TimerTypeToInternal(timerSequenceID.TimerType),
+		); err != nil {
+			return err
+		} else if completed {
+			updateMutableState = true
+			scheduleDecision = true
+			continue Loop
+		}
+
 		if _, err := mutableState.AddActivityTaskTimedOutEvent(
 			activityInfo.ScheduleID,
 			activityInfo.StartedID,
 			execution.TimerTypeToInternal(timerSequenceID.TimerType),
-			activityInfo.Details,
+			execution.GetActivityHeartbeatDetails(activityInfo, false),
 		); err != nil {
 			return err
 		}
@@ -678,6 +751,8 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		Name: activityInfo.TaskList,
 	}
 	scheduleToStartTimeout := activityInfo.ScheduleToStartTimeout
+	routingKey := activityInfo.RoutingKey
+	priority := activityInfo.Priority
 
 	release(nil) // release earlier as we don't need the lock anymore
 
@@ -689,6 +764,8 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		ScheduleID:                    scheduledID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
 		PartitionConfig:               mutableState.GetExecutionInfo().PartitionConfig,
+		RoutingKey:                    routingKey,
+		Priority:                      priority,
 	})
 	return err
 }
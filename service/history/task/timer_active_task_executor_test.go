@@ -364,6 +364,68 @@ func (s *timerActiveTaskExecutorSuite) TestProcessActivityTimeout_NoRetryPolicy_
 	s.False(ok)
 }
 
+func (s *timerActiveTaskExecutorSuite) TestProcessActivityTimeout_NoRetryPolicy_DefaultResult() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+
+	timerTimeout := 2 * time.Second
+	defaultResult := []byte("default result")
+	scheduledEvent, _, _, _, _, err := mutableState.AddActivityTaskScheduledEvent(nil, decisionCompletionID, &types.ScheduleActivityTaskDecisionAttributes{
+		ActivityID:                    "activity",
+		ActivityType:                  &types.ActivityType{Name: "activity type"},
+		TaskList:                      &types.TaskList{Name: mutableState.GetExecutionInfo().TaskList},
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(int32(timerTimeout.Seconds())),
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(int32(timerTimeout.Seconds())),
+		StartToCloseTimeoutSeconds:    common.Int32Ptr(int32(timerTimeout.Seconds())),
+		HeartbeatTimeoutSeconds:       common.Int32Ptr(int32(timerTimeout.Seconds())),
+		OnTimeoutDefaultResult:        defaultResult,
+	}, false)
+	s.NoError(err)
+
+	timerSequence := execution.NewTimerSequence(mutableState)
+	mutableState.DeleteTimerTasks()
+	modified, err := timerSequence.CreateNextActivityTimer()
+	s.NoError(err)
+	s.True(modified)
+	task := mutableState.GetTimerTasks()[0]
+	timerTask := s.newTimerTaskFromInfo(&persistence.TimerTaskInfo{
+		Version:             s.version,
+		DomainID:            s.domainID,
+		WorkflowID:          workflowExecution.GetWorkflowID(),
+		RunID:               workflowExecution.GetRunID(),
+		TaskID:              int64(100),
+		TaskType:            persistence.TaskTypeActivityTimeout,
+		TimeoutType:         int(types.TimeoutTypeScheduleToClose),
+		VisibilityTimestamp: task.(*persistence.ActivityTimeoutTask).GetVisibilityTimestamp(),
+		EventID:             scheduledEvent.ID,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, scheduledEvent.ID, scheduledEvent.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	var completedEvent *types.HistoryEvent
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		for _, event := range args.Get(1).(*persistence.AppendHistoryNodesRequest).Events {
+			if event.GetEventType() == types.EventTypeActivityTaskCompleted {
+				completedEvent = event
+			}
+		}
+	}).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.timeSource.Advance(2 * timerTimeout)
+	err = s.timerActiveTaskExecutor.Execute(timerTask, true)
+	s.NoError(err)
+
+	_, ok := s.getMutableStateFromCache(s.domainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID()).GetActivityInfo(scheduledEvent.ID)
+	s.False(ok)
+	s.NotNil(completedEvent)
+	s.Equal(defaultResult, completedEvent.ActivityTaskCompletedEventAttributes.Result)
+	s.True(completedEvent.ActivityTaskCompletedEventAttributes.Synthesized)
+	s.Equal(types.TimeoutTypeScheduleToClose.Ptr(), completedEvent.ActivityTaskCompletedEventAttributes.TimeoutType)
+}
+
 func (s *timerActiveTaskExecutorSuite) TestProcessActivityTimeout_NoRetryPolicy_Noop() {
 
 	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
@@ -659,6 +721,67 @@ func (s *timerActiveTaskExecutorSuite) TestProcessActivityTimeout_Heartbeat_Noop
 	s.NoError(err)
 }
 
+func (s *timerActiveTaskExecutorSuite) TestProcessActivityTimeout_CancellationForced() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+
+	identity := "identity"
+	timerTimeout := 10 * time.Second
+	scheduledEvent, _ := test.AddActivityTaskScheduledEvent(
+		mutableState,
+		decisionCompletionID,
+		"activity",
+		"activity type",
+		mutableState.GetExecutionInfo().TaskList,
+		[]byte(nil),
+		int32(timerTimeout.Seconds()),
+		int32(timerTimeout.Seconds()),
+		int32(timerTimeout.Seconds()),
+		0,
+	)
+	startedEvent := test.AddActivityTaskStartedEvent(mutableState, scheduledEvent.ID, identity)
+
+	activityInfo, ok := mutableState.GetActivityInfo(scheduledEvent.ID)
+	s.True(ok)
+	activityInfo.CancelRequested = true
+	activityInfo.CancelRequestID = startedEvent.ID + 1
+	activityInfo.CancelRequestedTime = s.timeSource.Now()
+	activityInfo.CancelForceTimeout = 1
+
+	timerSequence := execution.NewTimerSequence(mutableState)
+	mutableState.DeleteTimerTasks()
+	modified, err := timerSequence.CreateNextActivityTimer()
+	s.NoError(err)
+	s.True(modified)
+	task := mutableState.GetTimerTasks()[0]
+	s.Equal(int(execution.TimerTypeHeartbeat), task.(*persistence.ActivityTimeoutTask).TimeoutType)
+	timerTask := s.newTimerTaskFromInfo(&persistence.TimerTaskInfo{
+		Version:             s.version,
+		DomainID:            s.domainID,
+		WorkflowID:          workflowExecution.GetWorkflowID(),
+		RunID:               workflowExecution.GetRunID(),
+		TaskID:              int64(100),
+		TaskType:            persistence.TaskTypeActivityTimeout,
+		TimeoutType:         int(types.TimeoutTypeHeartbeat),
+		VisibilityTimestamp: task.(*persistence.ActivityTimeoutTask).GetVisibilityTimestamp(),
+		EventID:             scheduledEvent.ID,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, startedEvent.ID, startedEvent.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.timeSource.Advance(2 * time.Second)
+	err = s.timerActiveTaskExecutor.Execute(timerTask, true)
+	s.NoError(err)
+
+	_, ok = s.getMutableStateFromCache(s.domainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID()).GetActivityInfo(scheduledEvent.ID)
+	s.False(ok)
+}
+
 func (s *timerActiveTaskExecutorSuite) TestProcessActivityTimeout_Resurrected() {
 
 	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
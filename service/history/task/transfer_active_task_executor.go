@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -196,7 +197,57 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 		return err
 	}
 
+	if stubs := t.config.ActivityStubOutcomes(dynamicconfig.DomainFilter(domainName)); len(stubs) > 0 {
+		scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, task.ScheduleID)
+		if err != nil {
+			return err
+		}
+		activityType := scheduledEvent.ActivityTaskScheduledEventAttributes.ActivityType.GetName()
+		if outcome, ok := newActivityStubOutcome(stubs[activityType]); ok {
+			return t.recordStubbedActivityOutcome(ctx, wfContext, mutableState, ai, outcome)
+		}
+	}
+
+	predecessorID, err := getActivityDispatchPredecessor(ctx, mutableState, ai)
+	if err != nil {
+		return err
+	}
+	if predecessorID != common.EmptyEventID {
+		return newActivityDispatchOrderedError(predecessorID)
+	}
+	scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, task.ScheduleID)
+	if err != nil {
+		return err
+	}
+	activityType := scheduledEvent.ActivityTaskScheduledEventAttributes.GetActivityType().GetName()
+	if !t.shard.GetService().GetActivityTypeBreaker().Allow(domainName, task.DomainID, activityType) {
+		return &redispatchError{Reason: fmt.Sprintf("circuit breaker of activity type %v is open", activityType)}
+	}
+	// the timeout timers of the activity keep running while it is held back, see types.ActivityDispatchWindow
+	dispatchWindow := scheduledEvent.ActivityTaskScheduledEventAttributes.DispatchWindow
+	opensIn, err := common.ActivityDispatchWindowOpensIn(dispatchWindow, t.shard.GetTimeSource().Now())
+	if err != nil {
+		// the window was validated when the activity was scheduled, so dispatch rather than holding the activity forever
+		t.logger.Warn("Ignoring invalid activity dispatch window", tag.WorkflowScheduleID(task.ScheduleID), tag.Error(err))
+	} else if opensIn > 0 {
+		return &redispatchError{Reason: fmt.Sprintf("dispatch window of the activity opens in %v", opensIn)}
+	}
+	killSwitchTag := scheduledEvent.ActivityTaskScheduledEventAttributes.GetKillSwitchTag()
+	if execution.IsActivityKillSwitchOn(t.config, domainName, killSwitchTag) {
+		t.metricsClient.Scope(metrics.TransferActiveTaskActivityScope, metrics.DomainTag(domainName)).IncCounter(metrics.ActivityKillSwitchHeldCounter)
+		return &redispatchError{Reason: fmt.Sprintf("kill switch %v of the activity is on", killSwitchTag)}
+	}
+	// a dependency which failed, timed out or was canceled resolves the activity in mutable state instead
+	if dependsOn := scheduledEvent.ActivityTaskScheduledEventAttributes.GetDependsOnActivityID(); dependsOn != "" {
+		if _, ok := mutableState.GetActivityByActivityID(dependsOn); ok {
+			return &redispatchError{Reason: fmt.Sprintf("activity is dispatched once activity %v completes", dependsOn)}
+		}
+	}
+
 	timeout := common.MinInt32(ai.ScheduleToStartTimeout, common.MaxTaskTimeout)
+	routingKey := ai.RoutingKey
+	priority := ai.Priority
+	regionAffinity := scheduledEvent.ActivityTaskScheduledEventAttributes.GetRegionAffinity()
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
@@ -206,7 +257,7 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 		return errWorkflowRateLimited
 	}
 
-	err = t.pushActivity(ctx, task, timeout, mutableState.GetExecutionInfo().PartitionConfig)
+	err = t.pushActivity(ctx, task, timeout, mutableState.GetExecutionInfo().PartitionConfig, routingKey, priority, regionAffinity)
 	if err == nil {
 		scope := common.NewPerTaskListScope(domainName, task.TaskList, types.TaskListKindNormal, t.metricsClient, metrics.TransferActiveTaskActivityScope)
 		scope.RecordTimer(metrics.ScheduleToStartHistoryQueueLatencyPerTaskList, time.Since(task.GetVisibilityTimestamp()))
@@ -214,6 +265,52 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	return err
 }
 
+// recordStubbedActivityOutcome starts and closes the activity in place using a canned outcome from
+// dynamic config, so the activity is never dispatched to matching. A stubbed failure goes through the
+// activity retry policy exactly like a failure reported by a worker.
+func (t *transferActiveTaskExecutor) recordStubbedActivityOutcome(
+	ctx context.Context,
+	wfContext execution.Context,
+	mutableState execution.MutableState,
+	ai *persistence.ActivityInfo,
+	outcome *activityStubOutcome,
+) error {
+
+	scheduleID := ai.ScheduleID
+	if _, err := mutableState.AddActivityTaskStartedEvent(ai, scheduleID, uuid.New(), activityStubIdentity); err != nil {
+		return err
+	}
+
+	if outcome.shouldFail(ai.Attempt) {
+		retried, err := mutableState.RetryActivity(ai, outcome.failureReason, outcome.failureDetails, nil)
+		if err != nil {
+			return err
+		}
+		if retried {
+			return wfContext.UpdateWorkflowExecutionAsActive(ctx, t.shard.GetTimeSource().Now())
+		}
+		if _, err := mutableState.AddActivityTaskFailedEvent(scheduleID, ai.StartedID, &types.RespondActivityTaskFailedRequest{
+			Reason:   common.StringPtr(outcome.failureReason),
+			Details:  outcome.failureDetails,
+			Identity: activityStubIdentity,
+		}); err != nil {
+			return err
+		}
+	} else {
+		if _, err := mutableState.AddActivityTaskCompletedEvent(scheduleID, ai.StartedID, &types.RespondActivityTaskCompletedRequest{
+			Result:   outcome.result,
+			Identity: activityStubIdentity,
+		}, nil); err != nil {
+			return err
+		}
+	}
+
+	if err := execution.ScheduleDecision(mutableState); err != nil {
+		return err
+	}
+	return wfContext.UpdateWorkflowExecutionAsActive(ctx, t.shard.GetTimeSource().Now())
+}
+
 func (t *transferActiveTaskExecutor) processDecisionTask(
 	ctx context.Context,
 	task *persistence.TransferTaskInfo,
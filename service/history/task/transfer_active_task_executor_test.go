@@ -52,6 +52,7 @@ import (
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/activitybreaker"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/engine"
@@ -77,6 +78,7 @@ type (
 		mockWFCache        *workflowcache.MockWFCache
 		mockHistoryClient  *hclient.MockClient
 		mockMatchingClient *matching.MockClient
+		mockBreaker        *activitybreaker.MockBreaker
 
 		mockVisibilityMgr           *mocks.VisibilityManager
 		mockExecutionMgr            *mocks.ExecutionManager
@@ -159,6 +161,7 @@ func (s *transferActiveTaskExecutorSuite) SetupTest() {
 	s.mockArchiverProvider = s.mockShard.Resource.ArchiverProvider
 	s.mockDomainCache = s.mockShard.Resource.DomainCache
 	s.mockWFCache = workflowcache.NewMockWFCache(s.controller)
+	s.mockBreaker = s.mockShard.Resource.ActivityTypeBreaker
 
 	s.mockDomainCache.EXPECT().GetDomain(constants.TestRateLimitedDomainName).Return(constants.TestRateLimitedDomainEntry, nil).AnyTimes()
 	s.mockDomainCache.EXPECT().GetDomain(s.domainName).Return(s.domainEntry, nil).AnyTimes()
@@ -230,12 +233,207 @@ func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_Success() {
 	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
 	s.NoError(err)
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockBreaker.EXPECT().Allow(s.domainName, s.domainID, "some random activity type").Return(true).Times(1)
 	s.mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), createAddActivityTaskRequest(transferTask, ai, mutableState.GetExecutionInfo().PartitionConfig)).Return(&types.AddActivityTaskResponse{}, nil).Times(1)
 	s.mockWFCache.EXPECT().AllowInternal(constants.TestDomainID, constants.TestWorkflowID).Return(true).Times(1)
 	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
 	s.Nil(err)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_CircuitOpen() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+
+	event, _ := test.AddActivityTaskScheduledEvent(
+		mutableState,
+		decisionCompletionID,
+		"activity-1",
+		"some random activity type",
+		mutableState.GetExecutionInfo().TaskList,
+		[]byte{}, 1, 1, 1, 1,
+	)
+	mutableState.FlushBufferedEvents()
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:        s.version,
+		DomainID:       s.domainID,
+		TargetDomainID: constants.TestDomainID,
+		WorkflowID:     workflowExecution.GetWorkflowID(),
+		RunID:          workflowExecution.GetRunID(),
+		TaskID:         int64(59),
+		TaskList:       mutableState.GetExecutionInfo().TaskList,
+		TaskType:       persistence.TransferTaskTypeActivityTask,
+		ScheduleID:     event.ID,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	// the activity is held back without being dispatched to matching
+	s.mockBreaker.EXPECT().Allow(s.domainName, s.domainID, "some random activity type").Return(false).Times(1)
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.True(isRedispatchErr(err))
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_Stubbed() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+
+	event, _ := test.AddActivityTaskScheduledEvent(
+		mutableState,
+		decisionCompletionID,
+		"activity-1",
+		"stubbed activity type",
+		mutableState.GetExecutionInfo().TaskList,
+		[]byte{}, 1, 1, 1, 1,
+	)
+	mutableState.FlushBufferedEvents()
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:        s.version,
+		DomainID:       s.domainID,
+		TargetDomainID: constants.TestDomainID,
+		WorkflowID:     workflowExecution.GetWorkflowID(),
+		RunID:          workflowExecution.GetRunID(),
+		TaskID:         int64(59),
+		TaskList:       mutableState.GetExecutionInfo().TaskList,
+		TaskType:       persistence.TransferTaskTypeActivityTask,
+		ScheduleID:     event.ID,
+	})
+
+	s.transferActiveTaskExecutor.config.ActivityStubOutcomes = func(opts ...dc.FilterOption) map[string]interface{} {
+		return map[string]interface{}{
+			"stubbed activity type": map[string]interface{}{"result": "canned result"},
+		}
+	}
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.MatchedBy(func(req *persistence.AppendHistoryNodesRequest) bool {
+		if len(req.Events) != 3 {
+			return false
+		}
+		completed := req.Events[1].ActivityTaskCompletedEventAttributes
+		return req.Events[0].GetEventType() == types.EventTypeActivityTaskStarted &&
+			req.Events[0].ActivityTaskStartedEventAttributes.Identity == activityStubIdentity &&
+			completed != nil && string(completed.Result) == "canned result" &&
+			req.Events[2].GetEventType() == types.EventTypeDecisionTaskScheduled
+	})).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Nil(err)
+	s.mockHistoryV2Mgr.AssertExpectations(s.T())
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_OrderedDispatch() {
+	for name, predecessorStarted := range map[string]bool{
+		"predecessor not started": false,
+		"predecessor started":     true,
+	} {
+		s.Run(name, func() {
+			// Need setup the suite manually, since we are in a subtest
+			s.SetupTest()
+
+			workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+			s.NoError(err)
+
+			taskList := mutableState.GetExecutionInfo().TaskList
+			var events []*types.HistoryEvent
+			var activityInfos []*persistence.ActivityInfo
+			for _, activityID := range []string{"activity-1", "activity-2"} {
+				event, ai, _, _, _, err := mutableState.AddActivityTaskScheduledEvent(nil, decisionCompletionID, &types.ScheduleActivityTaskDecisionAttributes{
+					ActivityID:                    activityID,
+					ActivityType:                  &types.ActivityType{Name: "some random activity type"},
+					TaskList:                      &types.TaskList{Name: taskList},
+					ScheduleToCloseTimeoutSeconds: common.Int32Ptr(1),
+					ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
+					StartToCloseTimeoutSeconds:    common.Int32Ptr(1),
+					OrderedDispatch:               true,
+				}, false)
+				s.NoError(err)
+				events = append(events, event)
+				activityInfos = append(activityInfos, ai)
+			}
+			if predecessorStarted {
+				test.AddActivityTaskStartedEvent(mutableState, events[0].ID, "some random identity")
+			}
+			mutableState.FlushBufferedEvents()
+
+			transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+				Version:        s.version,
+				DomainID:       s.domainID,
+				TargetDomainID: constants.TestDomainID,
+				WorkflowID:     workflowExecution.GetWorkflowID(),
+				RunID:          workflowExecution.GetRunID(),
+				TaskID:         int64(59),
+				TaskList:       taskList,
+				TaskType:       persistence.TransferTaskTypeActivityTask,
+				ScheduleID:     events[1].ID,
+			})
+
+			persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, events[1].ID, events[1].Version)
+			s.NoError(err)
+			s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+			if predecessorStarted {
+				s.mockBreaker.EXPECT().Allow(s.domainName, s.domainID, "some random activity type").Return(true).Times(1)
+				s.mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), createAddActivityTaskRequest(transferTask, activityInfos[1], mutableState.GetExecutionInfo().PartitionConfig)).Return(&types.AddActivityTaskResponse{}, nil).Times(1)
+				s.mockWFCache.EXPECT().AllowInternal(constants.TestDomainID, constants.TestWorkflowID).Return(true).Times(1)
+			}
+			err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+			if predecessorStarted {
+				s.NoError(err)
+			} else {
+				s.True(isRedispatchErr(err))
+			}
+		})
+	}
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_RegionAffinity() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+
+	taskList := mutableState.GetExecutionInfo().TaskList
+	event, ai, _, _, _, err := mutableState.AddActivityTaskScheduledEvent(nil, decisionCompletionID, &types.ScheduleActivityTaskDecisionAttributes{
+		ActivityID:                    "activity-1",
+		ActivityType:                  &types.ActivityType{Name: "some random activity type"},
+		TaskList:                      &types.TaskList{Name: taskList},
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(1),
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
+		StartToCloseTimeoutSeconds:    common.Int32Ptr(1),
+		RegionAffinity:                true,
+	}, false)
+	s.NoError(err)
+	mutableState.FlushBufferedEvents()
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:        s.version,
+		DomainID:       s.domainID,
+		TargetDomainID: constants.TestDomainID,
+		WorkflowID:     workflowExecution.GetWorkflowID(),
+		RunID:          workflowExecution.GetRunID(),
+		TaskID:         int64(59),
+		TaskList:       taskList,
+		TaskType:       persistence.TransferTaskTypeActivityTask,
+		ScheduleID:     event.ID,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	request := createAddActivityTaskRequest(transferTask, ai, mutableState.GetExecutionInfo().PartitionConfig)
+	request.RegionAffinity = true
+	s.mockBreaker.EXPECT().Allow(s.domainName, s.domainID, "some random activity type").Return(true).Times(1)
+	s.mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), request).Return(&types.AddActivityTaskResponse{}, nil).Times(1)
+	s.mockWFCache.EXPECT().AllowInternal(constants.TestDomainID, constants.TestWorkflowID).Return(true).Times(1)
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Nil(err)
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_Ratelimits() {
 	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, constants.TestDomainID)
 	s.NoError(err)
@@ -278,6 +476,7 @@ func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_Ratelimits() {
 	s.NoError(err)
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
 
+	s.mockBreaker.EXPECT().Allow(gomock.Any(), gomock.Any(), "some random activity type").Return(true).Times(4)
 	// expected calls to matching if task processing is allowed
 	s.mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), createAddActivityTaskRequest(transferTaskInRatelimitedDomain, ai, mutableState.GetExecutionInfo().PartitionConfig)).Return(&types.AddActivityTaskResponse{}, nil).Times(1)
 	s.mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), createAddActivityTaskRequest(transferTask, ai, mutableState.GetExecutionInfo().PartitionConfig)).Return(&types.AddActivityTaskResponse{}, nil).Times(1)
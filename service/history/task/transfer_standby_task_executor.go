@@ -143,6 +143,8 @@ func (t *transferStandbyTaskExecutor) processActivityTask(
 			return newPushActivityToMatchingInfo(
 				activityInfo.ScheduleToStartTimeout,
 				mutableState.GetExecutionInfo().PartitionConfig,
+				activityInfo.RoutingKey,
+				activityInfo.Priority,
 			), nil
 		}
 
@@ -604,6 +606,10 @@ func (t *transferStandbyTaskExecutor) pushActivity(
 		task.(*persistence.TransferTaskInfo),
 		timeout,
 		pushActivityInfo.partitionConfig,
+		pushActivityInfo.routingKey,
+		pushActivityInfo.priority,
+		// region affinity is only honored by the cluster the domain is active in
+		false,
 	)
 }
 
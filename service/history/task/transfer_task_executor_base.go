@@ -95,6 +95,9 @@ func (t *transferTaskExecutorBase) pushActivity(
 	task *persistence.TransferTaskInfo,
 	activityScheduleToStartTimeout int32,
 	partitionConfig map[string]string,
+	routingKey string,
+	priority *int32,
+	regionAffinity bool,
 ) error {
 
 	ctx, cancel := context.WithTimeout(ctx, taskRPCCallTimeout)
@@ -115,6 +118,9 @@ func (t *transferTaskExecutorBase) pushActivity(
 		ScheduleID:                    task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(activityScheduleToStartTimeout),
 		PartitionConfig:               partitionConfig,
+		RoutingKey:                    routingKey,
+		Priority:                      priority,
+		RegionAffinity:                regionAffinity,
 	})
 	return err
 }
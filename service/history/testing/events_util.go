@@ -213,7 +213,7 @@ func AddActivityTaskCompletedEvent(
 	event, _ := builder.AddActivityTaskCompletedEvent(scheduleID, startedID, &types.RespondActivityTaskCompletedRequest{
 		Result:   result,
 		Identity: identity,
-	})
+	}, nil)
 
 	return event
 }
@@ -23,6 +23,7 @@ package workflow
 import (
 	"errors"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -49,6 +50,14 @@ var (
 	ErrCancellationAlreadyRequested = &types.CancellationAlreadyRequestedError{Message: "cancellation already requested for this workflow execution"}
 	// ErrSignalsLimitExceeded is the error indicating limit reached for maximum number of signal events
 	ErrSignalsLimitExceeded = &types.LimitExceededError{Message: "exceeded workflow execution limit for signal events"}
+	// ErrActivitySignalsLimitExceeded is the error indicating limit reached for maximum number of undelivered activity signals
+	ErrActivitySignalsLimitExceeded = &types.LimitExceededError{Message: "exceeded activity limit for pending signals"}
+	// ErrActivityStartToCloseLimitExceeded is the error indicating a heartbeat requested a StartToClose timeout above the allowed limit
+	ErrActivityStartToCloseLimitExceeded = &types.LimitExceededError{Message: "requested activity StartToClose timeout exceeds the allowed limit"}
+	// ErrActivityCapabilityMismatch is the error indicating the poller does not advertise the capabilities required by the activity
+	ErrActivityCapabilityMismatch = &types.ServiceBusyError{Message: "poller does not advertise the capabilities required by the activity", Reason: common.ActivityCapabilityMismatchReason}
+	// ErrActivityNotWaitingForRetry is the error indicating the activity has no pending retry timer
+	ErrActivityNotWaitingForRetry = &types.BadRequestError{Message: "activity is not waiting for a retry"}
 	// ErrQueryEnteredInvalidState is error indicating query entered invalid state
 	ErrQueryEnteredInvalidState = &types.BadRequestError{Message: "query entered invalid state, this should be impossible"}
 	// ErrQueryWorkflowBeforeFirstDecision is error indicating that query was attempted before first decision task completed
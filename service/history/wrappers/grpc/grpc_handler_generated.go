@@ -42,6 +42,16 @@ func NewGRPCHandler(h handler.Handler) GRPCHandler {
 	return GRPCHandler{h}
 }
 
+func (g GRPCHandler) BatchDescribeWorkflowExecutions(ctx context.Context, request *historyv1.BatchDescribeWorkflowExecutionsRequest) (*historyv1.BatchDescribeWorkflowExecutionsResponse, error) {
+	response, err := g.h.BatchDescribeWorkflowExecutions(ctx, proto.ToHistoryBatchDescribeWorkflowExecutionsRequest(request))
+	return proto.FromHistoryBatchDescribeWorkflowExecutionsResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) CancelPendingActivities(ctx context.Context, request *historyv1.CancelPendingActivitiesRequest) (*historyv1.CancelPendingActivitiesResponse, error) {
+	err := g.h.CancelPendingActivities(ctx, proto.ToHistoryCancelPendingActivitiesRequest(request))
+	return &historyv1.CancelPendingActivitiesResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) CloseShard(ctx context.Context, request *historyv1.CloseShardRequest) (*historyv1.CloseShardResponse, error) {
 	err := g.h.CloseShard(ctx, proto.ToHistoryCloseShardRequest(request))
 	return &historyv1.CloseShardResponse{}, proto.FromError(err)
@@ -72,6 +82,46 @@ func (g GRPCHandler) DescribeWorkflowExecution(ctx context.Context, request *his
 	return proto.FromHistoryDescribeWorkflowExecutionResponse(response), proto.FromError(err)
 }
 
+func (g GRPCHandler) ExpireActivityHeartbeat(ctx context.Context, request *historyv1.ExpireActivityHeartbeatRequest) (*historyv1.ExpireActivityHeartbeatResponse, error) {
+	err := g.h.ExpireActivityHeartbeat(ctx, proto.ToHistoryExpireActivityHeartbeatRequest(request))
+	return &historyv1.ExpireActivityHeartbeatResponse{}, proto.FromError(err)
+}
+
+func (g GRPCHandler) GetActivityAttemptTimings(ctx context.Context, request *historyv1.GetActivityAttemptTimingsRequest) (*historyv1.GetActivityAttemptTimingsResponse, error) {
+	response, err := g.h.GetActivityAttemptTimings(ctx, proto.ToHistoryGetActivityAttemptTimingsRequest(request))
+	return proto.FromHistoryGetActivityAttemptTimingsResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) GetActivityEffectiveConfig(ctx context.Context, request *historyv1.GetActivityEffectiveConfigRequest) (*historyv1.GetActivityEffectiveConfigResponse, error) {
+	response, err := g.h.GetActivityEffectiveConfig(ctx, proto.ToHistoryGetActivityEffectiveConfigRequest(request))
+	return proto.FromHistoryGetActivityEffectiveConfigResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) GetActivityNextRetryTime(ctx context.Context, request *historyv1.GetActivityNextRetryTimeRequest) (*historyv1.GetActivityNextRetryTimeResponse, error) {
+	response, err := g.h.GetActivityNextRetryTime(ctx, proto.ToHistoryGetActivityNextRetryTimeRequest(request))
+	return proto.FromHistoryGetActivityNextRetryTimeResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) GetActivityRescheduleReasons(ctx context.Context, request *historyv1.GetActivityRescheduleReasonsRequest) (*historyv1.GetActivityRescheduleReasonsResponse, error) {
+	response, err := g.h.GetActivityRescheduleReasons(ctx, proto.ToHistoryGetActivityRescheduleReasonsRequest(request))
+	return proto.FromHistoryGetActivityRescheduleReasonsResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) GetActivityRetryStats(ctx context.Context, request *historyv1.GetActivityRetryStatsRequest) (*historyv1.GetActivityRetryStatsResponse, error) {
+	response, err := g.h.GetActivityRetryStats(ctx, proto.ToHistoryGetActivityRetryStatsRequest(request))
+	return proto.FromHistoryGetActivityRetryStatsResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) GetActivityTokenValidationFailures(ctx context.Context, request *historyv1.GetActivityTokenValidationFailuresRequest) (*historyv1.GetActivityTokenValidationFailuresResponse, error) {
+	response, err := g.h.GetActivityTokenValidationFailures(ctx, proto.ToHistoryGetActivityTokenValidationFailuresRequest(request))
+	return proto.FromHistoryGetActivityTokenValidationFailuresResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) GetActivityWorkerHistory(ctx context.Context, request *historyv1.GetActivityWorkerHistoryRequest) (*historyv1.GetActivityWorkerHistoryResponse, error) {
+	response, err := g.h.GetActivityWorkerHistory(ctx, proto.ToHistoryGetActivityWorkerHistoryRequest(request))
+	return proto.FromHistoryGetActivityWorkerHistoryResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) GetCrossClusterTasks(ctx context.Context, request *historyv1.GetCrossClusterTasksRequest) (*historyv1.GetCrossClusterTasksResponse, error) {
 	response, err := g.h.GetCrossClusterTasks(ctx, proto.ToHistoryGetCrossClusterTasksRequest(request))
 	return proto.FromHistoryGetCrossClusterTasksResponse(response), proto.FromError(err)
@@ -157,6 +207,11 @@ func (g GRPCHandler) RecordDecisionTaskStarted(ctx context.Context, request *his
 	return proto.FromHistoryRecordDecisionTaskStartedResponse(response), proto.FromError(err)
 }
 
+func (g GRPCHandler) RedriveTimedOutActivities(ctx context.Context, request *historyv1.RedriveTimedOutActivitiesRequest) (*historyv1.RedriveTimedOutActivitiesResponse, error) {
+	err := g.h.RedriveTimedOutActivities(ctx, proto.ToHistoryRedriveTimedOutActivitiesRequest(request))
+	return &historyv1.RedriveTimedOutActivitiesResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) RefreshWorkflowTasks(ctx context.Context, request *historyv1.RefreshWorkflowTasksRequest) (*historyv1.RefreshWorkflowTasksResponse, error) {
 	err := g.h.RefreshWorkflowTasks(ctx, proto.ToHistoryRefreshWorkflowTasksRequest(request))
 	return &historyv1.RefreshWorkflowTasksResponse{}, proto.FromError(err)
@@ -172,6 +227,11 @@ func (g GRPCHandler) RemoveTask(ctx context.Context, request *historyv1.RemoveTa
 	return &historyv1.RemoveTaskResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) ReplayActivityCompletions(ctx context.Context, request *historyv1.ReplayActivityCompletionsRequest) (*historyv1.ReplayActivityCompletionsResponse, error) {
+	response, err := g.h.ReplayActivityCompletions(ctx, proto.ToHistoryReplayActivityCompletionsRequest(request))
+	return proto.FromHistoryReplayActivityCompletionsResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) ReplicateEventsV2(ctx context.Context, request *historyv1.ReplicateEventsV2Request) (*historyv1.ReplicateEventsV2Response, error) {
 	err := g.h.ReplicateEventsV2(ctx, proto.ToHistoryReplicateEventsV2Request(request))
 	return &historyv1.ReplicateEventsV2Response{}, proto.FromError(err)
@@ -182,6 +242,11 @@ func (g GRPCHandler) RequestCancelWorkflowExecution(ctx context.Context, request
 	return &historyv1.RequestCancelWorkflowExecutionResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) ResetActivityAttempts(ctx context.Context, request *historyv1.ResetActivityAttemptsRequest) (*historyv1.ResetActivityAttemptsResponse, error) {
+	err := g.h.ResetActivityAttempts(ctx, proto.ToHistoryResetActivityAttemptsRequest(request))
+	return &historyv1.ResetActivityAttemptsResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) ResetQueue(ctx context.Context, request *historyv1.ResetQueueRequest) (*historyv1.ResetQueueResponse, error) {
 	err := g.h.ResetQueue(ctx, proto.ToHistoryResetQueueRequest(request))
 	return &historyv1.ResetQueueResponse{}, proto.FromError(err)
@@ -212,6 +277,11 @@ func (g GRPCHandler) RespondActivityTaskFailed(ctx context.Context, request *his
 	return &historyv1.RespondActivityTaskFailedResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) RespondActivityTaskNack(ctx context.Context, request *historyv1.RespondActivityTaskNackRequest) (*historyv1.RespondActivityTaskNackResponse, error) {
+	err := g.h.RespondActivityTaskNack(ctx, proto.ToHistoryRespondActivityTaskNackRequest(request))
+	return &historyv1.RespondActivityTaskNackResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) RespondCrossClusterTasksCompleted(ctx context.Context, request *historyv1.RespondCrossClusterTasksCompletedRequest) (*historyv1.RespondCrossClusterTasksCompletedResponse, error) {
 	response, err := g.h.RespondCrossClusterTasksCompleted(ctx, proto.ToHistoryRespondCrossClusterTasksCompletedRequest(request))
 	return proto.FromHistoryRespondCrossClusterTasksCompletedResponse(response), proto.FromError(err)
@@ -232,6 +302,11 @@ func (g GRPCHandler) ScheduleDecisionTask(ctx context.Context, request *historyv
 	return &historyv1.ScheduleDecisionTaskResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) SignalActivity(ctx context.Context, request *historyv1.SignalActivityRequest) (*historyv1.SignalActivityResponse, error) {
+	err := g.h.SignalActivity(ctx, proto.ToHistorySignalActivityRequest(request))
+	return &historyv1.SignalActivityResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) SignalWithStartWorkflowExecution(ctx context.Context, request *historyv1.SignalWithStartWorkflowExecutionRequest) (*historyv1.SignalWithStartWorkflowExecutionResponse, error) {
 	response, err := g.h.SignalWithStartWorkflowExecution(ctx, proto.ToHistorySignalWithStartWorkflowExecutionRequest(request))
 	return proto.FromHistorySignalWithStartWorkflowExecutionResponse(response), proto.FromError(err)
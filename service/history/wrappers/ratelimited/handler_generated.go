@@ -64,6 +64,14 @@ func NewHistoryHandler(
 	return wrapper
 }
 
+func (h *historyHandler) BatchDescribeWorkflowExecutions(ctx context.Context, hp1 *types.HistoryBatchDescribeWorkflowExecutionsRequest) (bp1 *types.BatchDescribeWorkflowExecutionsResponse, err error) {
+	return h.wrapped.BatchDescribeWorkflowExecutions(ctx, hp1)
+}
+
+func (h *historyHandler) CancelPendingActivities(ctx context.Context, hp1 *types.HistoryCancelPendingActivitiesRequest) (err error) {
+	return h.wrapped.CancelPendingActivities(ctx, hp1)
+}
+
 func (h *historyHandler) CloseShard(ctx context.Context, cp1 *types.CloseShardRequest) (err error) {
 	return h.wrapped.CloseShard(ctx, cp1)
 }
@@ -111,6 +119,38 @@ func (h *historyHandler) DescribeWorkflowExecution(ctx context.Context, hp1 *typ
 	return h.wrapped.DescribeWorkflowExecution(ctx, hp1)
 }
 
+func (h *historyHandler) ExpireActivityHeartbeat(ctx context.Context, hp1 *types.HistoryExpireActivityHeartbeatRequest) (err error) {
+	return h.wrapped.ExpireActivityHeartbeat(ctx, hp1)
+}
+
+func (h *historyHandler) GetActivityAttemptTimings(ctx context.Context, hp1 *types.HistoryGetActivityAttemptTimingsRequest) (gp1 *types.GetActivityAttemptTimingsResponse, err error) {
+	return h.wrapped.GetActivityAttemptTimings(ctx, hp1)
+}
+
+func (h *historyHandler) GetActivityEffectiveConfig(ctx context.Context, hp1 *types.HistoryGetActivityEffectiveConfigRequest) (gp1 *types.GetActivityEffectiveConfigResponse, err error) {
+	return h.wrapped.GetActivityEffectiveConfig(ctx, hp1)
+}
+
+func (h *historyHandler) GetActivityNextRetryTime(ctx context.Context, hp1 *types.HistoryGetActivityNextRetryTimeRequest) (gp1 *types.GetActivityNextRetryTimeResponse, err error) {
+	return h.wrapped.GetActivityNextRetryTime(ctx, hp1)
+}
+
+func (h *historyHandler) GetActivityRescheduleReasons(ctx context.Context, hp1 *types.HistoryGetActivityRescheduleReasonsRequest) (gp1 *types.GetActivityRescheduleReasonsResponse, err error) {
+	return h.wrapped.GetActivityRescheduleReasons(ctx, hp1)
+}
+
+func (h *historyHandler) GetActivityRetryStats(ctx context.Context, hp1 *types.HistoryGetActivityRetryStatsRequest) (gp1 *types.GetActivityRetryStatsResponse, err error) {
+	return h.wrapped.GetActivityRetryStats(ctx, hp1)
+}
+
+func (h *historyHandler) GetActivityTokenValidationFailures(ctx context.Context, hp1 *types.HistoryGetActivityTokenValidationFailuresRequest) (gp1 *types.GetActivityTokenValidationFailuresResponse, err error) {
+	return h.wrapped.GetActivityTokenValidationFailures(ctx, hp1)
+}
+
+func (h *historyHandler) GetActivityWorkerHistory(ctx context.Context, hp1 *types.HistoryGetActivityWorkerHistoryRequest) (gp1 *types.GetActivityWorkerHistoryResponse, err error) {
+	return h.wrapped.GetActivityWorkerHistory(ctx, hp1)
+}
+
 func (h *historyHandler) GetCrossClusterTasks(ctx context.Context, gp1 *types.GetCrossClusterTasksRequest) (gp2 *types.GetCrossClusterTasksResponse, err error) {
 	return h.wrapped.GetCrossClusterTasks(ctx, gp1)
 }
@@ -187,6 +227,10 @@ func (h *historyHandler) RecordDecisionTaskStarted(ctx context.Context, rp1 *typ
 	return h.wrapped.RecordDecisionTaskStarted(ctx, rp1)
 }
 
+func (h *historyHandler) RedriveTimedOutActivities(ctx context.Context, hp1 *types.HistoryRedriveTimedOutActivitiesRequest) (err error) {
+	return h.wrapped.RedriveTimedOutActivities(ctx, hp1)
+}
+
 func (h *historyHandler) RefreshWorkflowTasks(ctx context.Context, hp1 *types.HistoryRefreshWorkflowTasksRequest) (err error) {
 	return h.wrapped.RefreshWorkflowTasks(ctx, hp1)
 }
@@ -199,6 +243,10 @@ func (h *historyHandler) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskRe
 	return h.wrapped.RemoveTask(ctx, rp1)
 }
 
+func (h *historyHandler) ReplayActivityCompletions(ctx context.Context, hp1 *types.HistoryReplayActivityCompletionsRequest) (rp1 *types.ReplayActivityCompletionsResponse, err error) {
+	return h.wrapped.ReplayActivityCompletions(ctx, hp1)
+}
+
 func (h *historyHandler) ReplicateEventsV2(ctx context.Context, rp1 *types.ReplicateEventsV2Request) (err error) {
 	return h.wrapped.ReplicateEventsV2(ctx, rp1)
 }
@@ -207,6 +255,10 @@ func (h *historyHandler) RequestCancelWorkflowExecution(ctx context.Context, hp1
 	return h.wrapped.RequestCancelWorkflowExecution(ctx, hp1)
 }
 
+func (h *historyHandler) ResetActivityAttempts(ctx context.Context, hp1 *types.HistoryResetActivityAttemptsRequest) (err error) {
+	return h.wrapped.ResetActivityAttempts(ctx, hp1)
+}
+
 func (h *historyHandler) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest) (err error) {
 	return h.wrapped.ResetQueue(ctx, rp1)
 }
@@ -231,6 +283,10 @@ func (h *historyHandler) RespondActivityTaskFailed(ctx context.Context, hp1 *typ
 	return h.wrapped.RespondActivityTaskFailed(ctx, hp1)
 }
 
+func (h *historyHandler) RespondActivityTaskNack(ctx context.Context, hp1 *types.HistoryRespondActivityTaskNackRequest) (err error) {
+	return h.wrapped.RespondActivityTaskNack(ctx, hp1)
+}
+
 func (h *historyHandler) RespondCrossClusterTasksCompleted(ctx context.Context, rp1 *types.RespondCrossClusterTasksCompletedRequest) (rp2 *types.RespondCrossClusterTasksCompletedResponse, err error) {
 	return h.wrapped.RespondCrossClusterTasksCompleted(ctx, rp1)
 }
@@ -247,6 +303,10 @@ func (h *historyHandler) ScheduleDecisionTask(ctx context.Context, sp1 *types.Sc
 	return h.wrapped.ScheduleDecisionTask(ctx, sp1)
 }
 
+func (h *historyHandler) SignalActivity(ctx context.Context, hp1 *types.HistorySignalActivityRequest) (err error) {
+	return h.wrapped.SignalActivity(ctx, hp1)
+}
+
 func (h *historyHandler) SignalWithStartWorkflowExecution(ctx context.Context, hp1 *types.HistorySignalWithStartWorkflowExecutionRequest) (sp1 *types.StartWorkflowExecutionResponse, err error) {
 
 	if hp1 == nil {
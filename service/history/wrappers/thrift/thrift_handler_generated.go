@@ -35,6 +35,16 @@ import (
 	"github.com/uber/cadence/common/types/mapper/thrift"
 )
 
+func (g ThriftHandler) BatchDescribeWorkflowExecutions(ctx context.Context, DescribeRequest *history.BatchDescribeWorkflowExecutionsRequest) (bp1 *shared.BatchDescribeWorkflowExecutionsResponse, err error) {
+	response, err := g.h.BatchDescribeWorkflowExecutions(ctx, thrift.ToHistoryBatchDescribeWorkflowExecutionsRequest(DescribeRequest))
+	return thrift.FromHistoryBatchDescribeWorkflowExecutionsResponse(response), thrift.FromError(err)
+}
+
+func (g ThriftHandler) CancelPendingActivities(ctx context.Context, Request *history.CancelPendingActivitiesRequest) (err error) {
+	err = g.h.CancelPendingActivities(ctx, thrift.ToHistoryCancelPendingActivitiesRequest(Request))
+	return thrift.FromError(err)
+}
+
 func (g ThriftHandler) CloseShard(ctx context.Context, Request *shared.CloseShardRequest) (err error) {
 	err = g.h.CloseShard(ctx, thrift.ToHistoryCloseShardRequest(Request))
 	return thrift.FromError(err)
@@ -60,6 +70,46 @@ func (g ThriftHandler) DescribeWorkflowExecution(ctx context.Context, DescribeRe
 	return thrift.FromHistoryDescribeWorkflowExecutionResponse(response), thrift.FromError(err)
 }
 
+func (g ThriftHandler) ExpireActivityHeartbeat(ctx context.Context, Request *history.ExpireActivityHeartbeatRequest) (err error) {
+	err = g.h.ExpireActivityHeartbeat(ctx, thrift.ToHistoryExpireActivityHeartbeatRequest(Request))
+	return thrift.FromError(err)
+}
+
+func (g ThriftHandler) GetActivityAttemptTimings(ctx context.Context, Request *history.GetActivityAttemptTimingsRequest) (gp1 *shared.GetActivityAttemptTimingsResponse, err error) {
+	response, err := g.h.GetActivityAttemptTimings(ctx, thrift.ToHistoryGetActivityAttemptTimingsRequest(Request))
+	return thrift.FromHistoryGetActivityAttemptTimingsResponse(response), thrift.FromError(err)
+}
+
+func (g ThriftHandler) GetActivityEffectiveConfig(ctx context.Context, Request *history.GetActivityEffectiveConfigRequest) (gp1 *shared.GetActivityEffectiveConfigResponse, err error) {
+	response, err := g.h.GetActivityEffectiveConfig(ctx, thrift.ToHistoryGetActivityEffectiveConfigRequest(Request))
+	return thrift.FromHistoryGetActivityEffectiveConfigResponse(response), thrift.FromError(err)
+}
+
+func (g ThriftHandler) GetActivityNextRetryTime(ctx context.Context, Request *history.GetActivityNextRetryTimeRequest) (gp1 *shared.GetActivityNextRetryTimeResponse, err error) {
+	response, err := g.h.GetActivityNextRetryTime(ctx, thrift.ToHistoryGetActivityNextRetryTimeRequest(Request))
+	return thrift.FromHistoryGetActivityNextRetryTimeResponse(response), thrift.FromError(err)
+}
+
+func (g ThriftHandler) GetActivityRescheduleReasons(ctx context.Context, Request *history.GetActivityRescheduleReasonsRequest) (gp1 *shared.GetActivityRescheduleReasonsResponse, err error) {
+	response, err := g.h.GetActivityRescheduleReasons(ctx, thrift.ToHistoryGetActivityRescheduleReasonsRequest(Request))
+	return thrift.FromHistoryGetActivityRescheduleReasonsResponse(response), thrift.FromError(err)
+}
+
+func (g ThriftHandler) GetActivityRetryStats(ctx context.Context, Request *history.GetActivityRetryStatsRequest) (gp1 *shared.GetActivityRetryStatsResponse, err error) {
+	response, err := g.h.GetActivityRetryStats(ctx, thrift.ToHistoryGetActivityRetryStatsRequest(Request))
+	return thrift.FromHistoryGetActivityRetryStatsResponse(response), thrift.FromError(err)
+}
+
+func (g ThriftHandler) GetActivityTokenValidationFailures(ctx context.Context, Request *history.GetActivityTokenValidationFailuresRequest) (gp1 *shared.GetActivityTokenValidationFailuresResponse, err error) {
+	response, err := g.h.GetActivityTokenValidationFailures(ctx, thrift.ToHistoryGetActivityTokenValidationFailuresRequest(Request))
+	return thrift.FromHistoryGetActivityTokenValidationFailuresResponse(response), thrift.FromError(err)
+}
+
+func (g ThriftHandler) GetActivityWorkerHistory(ctx context.Context, Request *history.GetActivityWorkerHistoryRequest) (gp1 *shared.GetActivityWorkerHistoryResponse, err error) {
+	response, err := g.h.GetActivityWorkerHistory(ctx, thrift.ToHistoryGetActivityWorkerHistoryRequest(Request))
+	return thrift.FromHistoryGetActivityWorkerHistoryResponse(response), thrift.FromError(err)
+}
+
 func (g ThriftHandler) GetCrossClusterTasks(ctx context.Context, Request *shared.GetCrossClusterTasksRequest) (gp1 *shared.GetCrossClusterTasksResponse, err error) {
 	response, err := g.h.GetCrossClusterTasks(ctx, thrift.ToHistoryGetCrossClusterTasksRequest(Request))
 	return thrift.FromHistoryGetCrossClusterTasksResponse(response), thrift.FromError(err)
@@ -145,6 +195,11 @@ func (g ThriftHandler) RecordDecisionTaskStarted(ctx context.Context, AddRequest
 	return thrift.FromHistoryRecordDecisionTaskStartedResponse(response), thrift.FromError(err)
 }
 
+func (g ThriftHandler) RedriveTimedOutActivities(ctx context.Context, Request *history.RedriveTimedOutActivitiesRequest) (err error) {
+	err = g.h.RedriveTimedOutActivities(ctx, thrift.ToHistoryRedriveTimedOutActivitiesRequest(Request))
+	return thrift.FromError(err)
+}
+
 func (g ThriftHandler) RefreshWorkflowTasks(ctx context.Context, Request *history.RefreshWorkflowTasksRequest) (err error) {
 	err = g.h.RefreshWorkflowTasks(ctx, thrift.ToHistoryRefreshWorkflowTasksRequest(Request))
 	return thrift.FromError(err)
@@ -160,6 +215,11 @@ func (g ThriftHandler) RemoveTask(ctx context.Context, Request *shared.RemoveTas
 	return thrift.FromError(err)
 }
 
+func (g ThriftHandler) ReplayActivityCompletions(ctx context.Context, Request *history.ReplayActivityCompletionsRequest) (rp1 *shared.ReplayActivityCompletionsResponse, err error) {
+	response, err := g.h.ReplayActivityCompletions(ctx, thrift.ToHistoryReplayActivityCompletionsRequest(Request))
+	return thrift.FromHistoryReplayActivityCompletionsResponse(response), thrift.FromError(err)
+}
+
 func (g ThriftHandler) ReplicateEventsV2(ctx context.Context, ReplicateV2Request *history.ReplicateEventsV2Request) (err error) {
 	err = g.h.ReplicateEventsV2(ctx, thrift.ToHistoryReplicateEventsV2Request(ReplicateV2Request))
 	return thrift.FromError(err)
@@ -170,6 +230,11 @@ func (g ThriftHandler) RequestCancelWorkflowExecution(ctx context.Context, Cance
 	return thrift.FromError(err)
 }
 
+func (g ThriftHandler) ResetActivityAttempts(ctx context.Context, Request *history.ResetActivityAttemptsRequest) (err error) {
+	err = g.h.ResetActivityAttempts(ctx, thrift.ToHistoryResetActivityAttemptsRequest(Request))
+	return thrift.FromError(err)
+}
+
 func (g ThriftHandler) ResetQueue(ctx context.Context, Request *shared.ResetQueueRequest) (err error) {
 	err = g.h.ResetQueue(ctx, thrift.ToHistoryResetQueueRequest(Request))
 	return thrift.FromError(err)
@@ -200,6 +265,11 @@ func (g ThriftHandler) RespondActivityTaskFailed(ctx context.Context, FailReques
 	return thrift.FromError(err)
 }
 
+func (g ThriftHandler) RespondActivityTaskNack(ctx context.Context, Request *history.RespondActivityTaskNackRequest) (err error) {
+	err = g.h.RespondActivityTaskNack(ctx, thrift.ToHistoryRespondActivityTaskNackRequest(Request))
+	return thrift.FromError(err)
+}
+
 func (g ThriftHandler) RespondCrossClusterTasksCompleted(ctx context.Context, Request *shared.RespondCrossClusterTasksCompletedRequest) (rp1 *shared.RespondCrossClusterTasksCompletedResponse, err error) {
 	response, err := g.h.RespondCrossClusterTasksCompleted(ctx, thrift.ToHistoryRespondCrossClusterTasksCompletedRequest(Request))
 	return thrift.FromHistoryRespondCrossClusterTasksCompletedResponse(response), thrift.FromError(err)
@@ -220,6 +290,11 @@ func (g ThriftHandler) ScheduleDecisionTask(ctx context.Context, ScheduleRequest
 	return thrift.FromError(err)
 }
 
+func (g ThriftHandler) SignalActivity(ctx context.Context, SignalRequest *history.SignalActivityRequest) (err error) {
+	err = g.h.SignalActivity(ctx, thrift.ToHistorySignalActivityRequest(SignalRequest))
+	return thrift.FromError(err)
+}
+
 func (g ThriftHandler) SignalWithStartWorkflowExecution(ctx context.Context, SignalWithStartRequest *history.SignalWithStartWorkflowExecutionRequest) (sp1 *shared.StartWorkflowExecutionResponse, err error) {
 	response, err := g.h.SignalWithStartWorkflowExecution(ctx, thrift.ToHistorySignalWithStartWorkflowExecutionRequest(SignalWithStartRequest))
 	return thrift.FromHistorySignalWithStartWorkflowExecutionResponse(response), thrift.FromError(err)
@@ -54,6 +54,13 @@ type (
 		AsyncTaskDispatchTimeout             dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 		LocalPollWaitTime                    dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 		LocalTaskWaitTime                    dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		ActivityDispatchJitter               dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		RegionAffinityFallbackTimeout        dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		EnableActivityBackpressure           dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		ActivityBackpressureMinDispatchRatio dynamicconfig.FloatPropertyFnWithTaskListInfoFilters
+		ActivityBackpressureHalfLife         dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		EnableLatencyAwarePollerSelection    dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		LatencyAwarePollerHeadStart          dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 		TaskIsolationDuration                dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 		TaskIsolationPollerWindow            dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 		EnableGetNumberOfPartitionsFromCache dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
@@ -66,6 +73,9 @@ type (
 		EnableStandbyTaskCompletion          dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
 		EnableClientAutoConfig               dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
 		QPSTrackerInterval                   dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		ActivityTaskVisibilityTimeout        dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		ActivityTaskStealThreshold           dynamicconfig.FloatPropertyFnWithTaskListInfoFilters
+		MaxActivityPrefetchCount             dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
@@ -81,6 +91,8 @@ type (
 		// debugging configuration
 		EnableDebugMode             bool // note that this value is initialized once on service start
 		EnableTaskInfoLogByDomainID dynamicconfig.BoolPropertyFnWithDomainIDFilter
+		// RemoveTaskListTask is a recovery tool and is disabled by default
+		EnableRemoveTaskListTask dynamicconfig.BoolPropertyFnWithDomainFilter
 
 		ActivityTaskSyncMatchWaitTime dynamicconfig.DurationPropertyFnWithDomainFilter
 
@@ -109,25 +121,32 @@ type (
 		ForwarderConfig
 		EnableSyncMatch func() bool
 		// Time to hold a poll request before returning an empty response if there are no tasks
-		LongPollExpirationInterval          func() time.Duration
-		RangeSize                           int64
-		ReadRangeSize                       dynamicconfig.IntPropertyFn
-		ActivityTaskSyncMatchWaitTime       dynamicconfig.DurationPropertyFnWithDomainFilter
-		GetTasksBatchSize                   func() int
-		UpdateAckInterval                   func() time.Duration
-		IdleTasklistCheckInterval           func() time.Duration
-		MaxTasklistIdleTime                 func() time.Duration
-		MinTaskThrottlingBurstSize          func() int
-		MaxTaskDeleteBatchSize              func() int
-		AsyncTaskDispatchTimeout            func() time.Duration
-		LocalPollWaitTime                   func() time.Duration
-		LocalTaskWaitTime                   func() time.Duration
-		PartitionUpscaleRPS                 func() int
-		PartitionDownscaleFactor            func() float64
-		PartitionUpscaleSustainedDuration   func() time.Duration
-		PartitionDownscaleSustainedDuration func() time.Duration
-		AdaptiveScalerUpdateInterval        func() time.Duration
-		QPSTrackerInterval                  func() time.Duration
+		LongPollExpirationInterval           func() time.Duration
+		RangeSize                            int64
+		ReadRangeSize                        dynamicconfig.IntPropertyFn
+		ActivityTaskSyncMatchWaitTime        dynamicconfig.DurationPropertyFnWithDomainFilter
+		GetTasksBatchSize                    func() int
+		UpdateAckInterval                    func() time.Duration
+		IdleTasklistCheckInterval            func() time.Duration
+		MaxTasklistIdleTime                  func() time.Duration
+		MinTaskThrottlingBurstSize           func() int
+		MaxTaskDeleteBatchSize               func() int
+		AsyncTaskDispatchTimeout             func() time.Duration
+		LocalPollWaitTime                    func() time.Duration
+		LocalTaskWaitTime                    func() time.Duration
+		ActivityDispatchJitter               func() time.Duration
+		RegionAffinityFallbackTimeout        func() time.Duration
+		EnableActivityBackpressure           func() bool
+		ActivityBackpressureMinDispatchRatio func() float64
+		ActivityBackpressureHalfLife         func() time.Duration
+		EnableLatencyAwarePollerSelection    func() bool
+		LatencyAwarePollerHeadStart          func() time.Duration
+		PartitionUpscaleRPS                  func() int
+		PartitionDownscaleFactor             func() float64
+		PartitionUpscaleSustainedDuration    func() time.Duration
+		PartitionDownscaleSustainedDuration  func() time.Duration
+		AdaptiveScalerUpdateInterval         func() time.Duration
+		QPSTrackerInterval                   func() time.Duration
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold      func() int
 		MaxTaskBatchSize                     func() int
@@ -186,12 +205,20 @@ func NewConfig(dc *dynamicconfig.Collection, hostName string, getIsolationGroups
 		ShutdownDrainDuration:                dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration),
 		EnableDebugMode:                      dc.GetBoolProperty(dynamicconfig.EnableDebugMode)(),
 		EnableTaskInfoLogByDomainID:          dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.MatchingEnableTaskInfoLogByDomainID),
+		EnableRemoveTaskListTask:             dc.GetBoolPropertyFilteredByDomain(dynamicconfig.MatchingEnableRemoveTaskListTask),
 		ActivityTaskSyncMatchWaitTime:        dc.GetDurationPropertyFilteredByDomain(dynamicconfig.MatchingActivityTaskSyncMatchWaitTime),
 		EnableTasklistIsolation:              dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableTasklistIsolation),
 		AsyncTaskDispatchTimeout:             dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.AsyncTaskDispatchTimeout),
 		EnableTasklistOwnershipGuard:         dc.GetBoolProperty(dynamicconfig.MatchingEnableTasklistGuardAgainstOwnershipShardLoss),
 		LocalPollWaitTime:                    dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.LocalPollWaitTime),
 		LocalTaskWaitTime:                    dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.LocalTaskWaitTime),
+		ActivityDispatchJitter:               dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingActivityDispatchJitter),
+		RegionAffinityFallbackTimeout:        dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingRegionAffinityFallbackTimeout),
+		EnableActivityBackpressure:           dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableActivityBackpressure),
+		ActivityBackpressureMinDispatchRatio: dc.GetFloat64PropertyFilteredByTaskListInfo(dynamicconfig.MatchingActivityBackpressureMinDispatchRatio),
+		ActivityBackpressureHalfLife:         dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingActivityBackpressureHalfLife),
+		EnableLatencyAwarePollerSelection:    dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableLatencyAwarePollerSelection),
+		LatencyAwarePollerHeadStart:          dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingLatencyAwarePollerHeadStart),
 		PartitionUpscaleRPS:                  dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionUpscaleRPS),
 		PartitionDownscaleFactor:             dc.GetFloat64PropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionDownscaleFactor),
 		PartitionUpscaleSustainedDuration:    dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionUpscaleSustainedDuration),
@@ -208,5 +235,8 @@ func NewConfig(dc *dynamicconfig.Collection, hostName string, getIsolationGroups
 		AllIsolationGroups:                   getIsolationGroups,
 		EnableStandbyTaskCompletion:          dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableStandbyTaskCompletion),
 		EnableClientAutoConfig:               dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableClientAutoConfig),
+		ActivityTaskVisibilityTimeout:        dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingActivityTaskVisibilityTimeout),
+		ActivityTaskStealThreshold:           dc.GetFloat64PropertyFilteredByTaskListInfo(dynamicconfig.MatchingActivityTaskStealThreshold),
+		MaxActivityPrefetchCount:             dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxActivityPrefetchCount),
 	}
 }
@@ -70,11 +70,19 @@ func TestNewConfig(t *testing.T) {
 		"ShutdownDrainDuration":                {dynamicconfig.MatchingShutdownDrainDuration, time.Duration(23)},
 		"EnableDebugMode":                      {dynamicconfig.EnableDebugMode, false},
 		"EnableTaskInfoLogByDomainID":          {dynamicconfig.MatchingEnableTaskInfoLogByDomainID, true},
+		"EnableRemoveTaskListTask":             {dynamicconfig.MatchingEnableRemoveTaskListTask, true},
 		"ActivityTaskSyncMatchWaitTime":        {dynamicconfig.MatchingActivityTaskSyncMatchWaitTime, time.Duration(24)},
 		"EnableTasklistIsolation":              {dynamicconfig.EnableTasklistIsolation, false},
 		"AsyncTaskDispatchTimeout":             {dynamicconfig.AsyncTaskDispatchTimeout, time.Duration(25)},
 		"LocalPollWaitTime":                    {dynamicconfig.LocalPollWaitTime, time.Duration(10)},
 		"LocalTaskWaitTime":                    {dynamicconfig.LocalTaskWaitTime, time.Duration(10)},
+		"ActivityDispatchJitter":               {dynamicconfig.MatchingActivityDispatchJitter, time.Duration(39)},
+		"RegionAffinityFallbackTimeout":        {dynamicconfig.MatchingRegionAffinityFallbackTimeout, time.Duration(40)},
+		"EnableActivityBackpressure":           {dynamicconfig.MatchingEnableActivityBackpressure, true},
+		"ActivityBackpressureMinDispatchRatio": {dynamicconfig.MatchingActivityBackpressureMinDispatchRatio, 41.0},
+		"ActivityBackpressureHalfLife":         {dynamicconfig.MatchingActivityBackpressureHalfLife, time.Duration(42)},
+		"EnableLatencyAwarePollerSelection":    {dynamicconfig.MatchingEnableLatencyAwarePollerSelection, true},
+		"LatencyAwarePollerHeadStart":          {dynamicconfig.MatchingLatencyAwarePollerHeadStart, time.Duration(43)},
 		"HostName":                             {nil, hostname},
 		"TaskDispatchRPS":                      {nil, 100000.0},
 		"TaskDispatchRPSTTL":                   {nil, time.Minute},
@@ -93,6 +101,9 @@ func TestNewConfig(t *testing.T) {
 		"EnableClientAutoConfig":               {dynamicconfig.MatchingEnableClientAutoConfig, false},
 		"TaskIsolationDuration":                {dynamicconfig.TaskIsolationDuration, time.Duration(35)},
 		"TaskIsolationPollerWindow":            {dynamicconfig.TaskIsolationPollerWindow, time.Duration(36)},
+		"ActivityTaskVisibilityTimeout":        {dynamicconfig.MatchingActivityTaskVisibilityTimeout, time.Duration(37)},
+		"MaxActivityPrefetchCount":             {dynamicconfig.MatchingMaxActivityPrefetchCount, 38},
+		"ActivityTaskStealThreshold":           {dynamicconfig.MatchingActivityTaskStealThreshold, 0.5},
 	}
 	client := dynamicconfig.NewInMemoryClient()
 	for fieldName, expected := range fields {
@@ -28,6 +28,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 	"sync"
 	"time"
 
@@ -464,6 +465,19 @@ func (e *matchingEngineImpl) AddActivityTask(
 		return nil, err
 	}
 
+	partitionConfig := request.GetPartitionConfig()
+	if request.GetRegionAffinity() || request.GetPriority() > 0 {
+		partitionConfig = make(map[string]string, len(request.GetPartitionConfig())+2)
+		for k, v := range request.GetPartitionConfig() {
+			partitionConfig[k] = v
+		}
+		if request.GetRegionAffinity() {
+			partitionConfig[partition.RegionAffinityKey] = "true"
+		}
+		if request.GetPriority() > 0 {
+			partitionConfig[partition.PriorityKey] = strconv.Itoa(int(request.GetPriority()))
+		}
+	}
 	taskInfo := &persistence.TaskInfo{
 		DomainID:                      request.GetSourceDomainUUID(),
 		RunID:                         request.Execution.GetRunID(),
@@ -471,7 +485,7 @@ func (e *matchingEngineImpl) AddActivityTask(
 		ScheduleID:                    request.GetScheduleID(),
 		ScheduleToStartTimeoutSeconds: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:                   e.timeSource.Now(),
-		PartitionConfig:               request.GetPartitionConfig(),
+		PartitionConfig:               partitionConfig,
 	}
 
 	syncMatched, err := tlMgr.AddTask(hCtx.Context, tasklist.AddTaskParams{
@@ -487,7 +501,9 @@ func (e *matchingEngineImpl) AddActivityTask(
 		hCtx.scope.RecordTimer(metrics.SyncMatchLatencyPerTaskList, time.Since(startT))
 	}
 	return &types.AddActivityTaskResponse{
-		PartitionConfig: tlMgr.TaskListPartitionConfig(),
+		PartitionConfig:          tlMgr.TaskListPartitionConfig(),
+		VisibilityTimeoutSeconds: e.activityTaskVisibilityTimeoutSeconds(domainName, taskListName),
+		StealThreshold:           e.activityTaskStealThreshold(domainName, taskListName),
 	}, nil
 }
 
@@ -723,6 +739,7 @@ pollLoop:
 		pollerCtx := tasklist.ContextWithPollerID(hCtx.Context, pollerID)
 		pollerCtx = tasklist.ContextWithIdentity(pollerCtx, request.GetIdentity())
 		pollerCtx = tasklist.ContextWithIsolationGroup(pollerCtx, req.GetIsolationGroup())
+		pollerCtx = tasklist.ContextWithSourceCluster(pollerCtx, req.GetSourceCluster())
 		taskListKind := request.TaskList.Kind
 		tlMgr, err := e.getTaskListManager(taskListID, taskListKind)
 		if err != nil {
@@ -762,11 +779,23 @@ pollLoop:
 		e.emitForwardedFromStats(hCtx.scope, task.IsForwarded(), req.GetForwardedFrom())
 		e.emitTaskIsolationMetrics(hCtx.scope, task.Event.PartitionConfig, req.GetIsolationGroup())
 		if task.ActivityTaskDispatchInfo != nil {
+			requiredCapabilities := task.ActivityTaskDispatchInfo.ScheduledEvent.ActivityTaskScheduledEventAttributes.GetRequiredCapabilities()
+			if !request.HasCapabilities(requiredCapabilities) {
+				// history falls back to a regular transfer task, so the activity is retried with other pollers
+				e.emitActivityCapabilityMismatch(hCtx.scope, domainID, taskListName)
+				task.Finish(&types.ServiceBusyError{
+					Message: "poller does not advertise the capabilities required by the activity",
+					Reason:  common.ActivityCapabilityMismatchReason,
+				})
+				continue pollLoop
+			}
 			task.Finish(nil)
-			return e.createSyncMatchPollForActivityTaskResponse(task, task.ActivityTaskDispatchInfo, tlMgr.TaskListPartitionConfig(), tlMgr.LoadBalancerHints()), nil
+			resp := e.createSyncMatchPollForActivityTaskResponse(task, task.ActivityTaskDispatchInfo, tlMgr.TaskListPartitionConfig(), tlMgr.LoadBalancerHints())
+			resp.PrefetchedTasks = e.prefetchActivityTasks(hCtx, req, tlMgr)
+			return resp, nil
 		}
 
-		resp, err := e.recordActivityTaskStarted(hCtx.Context, request, task)
+		resp, err := e.recordActivityTaskStarted(hCtx.Context, request, task, false)
 		if err != nil {
 			switch err.(type) {
 			case *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyCompletedError, *types.EventAlreadyStartedError:
@@ -789,14 +818,83 @@ pollLoop:
 				)
 				task.Finish(nil)
 			default:
+				if common.IsActivityCapabilityMismatchError(err) {
+					e.emitActivityCapabilityMismatch(hCtx.scope, domainID, taskListName)
+				}
 				task.Finish(err)
 			}
 
 			continue pollLoop
 		}
 		task.Finish(nil)
-		return e.createPollForActivityTaskResponse(task, resp, hCtx.scope, tlMgr.TaskListPartitionConfig(), tlMgr.LoadBalancerHints()), nil
+		pollResp := e.createPollForActivityTaskResponse(task, resp, hCtx.scope, tlMgr.TaskListPartitionConfig(), tlMgr.LoadBalancerHints())
+		pollResp.PrefetchedTasks = e.prefetchActivityTasks(hCtx, req, tlMgr)
+		return pollResp, nil
+	}
+}
+
+// prefetchActivityTasks leases up to PrefetchCount additional activity tasks to the poller. Only tasks which are
+// immediately available to the task list are taken, so the poll is never delayed, and the count is capped per task
+// list so that a pipelined worker does not drain the backlog ahead of the other pollers. Tasks dispatched by history
+// directly or forwarded from a parent partition are started already and are handed over without a lease.
+func (e *matchingEngineImpl) prefetchActivityTasks(
+	hCtx *handlerContext,
+	req *types.MatchingPollForActivityTaskRequest,
+	tlMgr tasklist.Manager,
+) []*types.MatchingPollForActivityTaskResponse {
+	request := req.PollRequest
+	taskListName := request.GetTaskList().GetName()
+	count := common.MinInt(
+		int(request.GetPrefetchCount()),
+		e.config.MaxActivityPrefetchCount(request.GetDomain(), taskListName, persistence.TaskListTypeActivity),
+	)
+
+	var prefetched []*types.MatchingPollForActivityTaskResponse
+	for i := 0; i < count; i++ {
+		// an expired context only matches tasks which are already waiting in the task list
+		pollCtx, cancel := context.WithTimeout(tasklist.ContextWithIsolationGroup(hCtx.Context, req.GetIsolationGroup()), 0)
+		task, err := tlMgr.GetTask(pollCtx, nil)
+		cancel()
+		if err != nil {
+			break
+		}
+
+		if task.IsStarted() {
+			prefetched = append(prefetched, task.PollForActivityResponse())
+			continue
+		}
+		if task.ActivityTaskDispatchInfo != nil {
+			requiredCapabilities := task.ActivityTaskDispatchInfo.ScheduledEvent.ActivityTaskScheduledEventAttributes.GetRequiredCapabilities()
+			if !request.HasCapabilities(requiredCapabilities) {
+				e.emitActivityCapabilityMismatch(hCtx.scope, req.GetDomainUUID(), taskListName)
+				task.Finish(&types.ServiceBusyError{
+					Message: "poller does not advertise the capabilities required by the activity",
+					Reason:  common.ActivityCapabilityMismatchReason,
+				})
+				continue
+			}
+			task.Finish(nil)
+			prefetched = append(prefetched, e.createSyncMatchPollForActivityTaskResponse(task, task.ActivityTaskDispatchInfo, tlMgr.TaskListPartitionConfig(), tlMgr.LoadBalancerHints()))
+			continue
+		}
+
+		resp, err := e.recordActivityTaskStarted(hCtx.Context, request, task, true)
+		if err != nil {
+			switch err.(type) {
+			case *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyCompletedError, *types.EventAlreadyStartedError:
+				task.Finish(nil)
+			default:
+				if common.IsActivityCapabilityMismatchError(err) {
+					e.emitActivityCapabilityMismatch(hCtx.scope, req.GetDomainUUID(), taskListName)
+				}
+				task.Finish(err)
+			}
+			continue
+		}
+		task.Finish(nil)
+		prefetched = append(prefetched, e.createPollForActivityTaskResponse(task, resp, hCtx.scope, tlMgr.TaskListPartitionConfig(), tlMgr.LoadBalancerHints()))
 	}
+	return prefetched
 }
 
 func (e *matchingEngineImpl) createSyncMatchPollForActivityTaskResponse(
@@ -818,8 +916,10 @@ func (e *matchingEngineImpl) createSyncMatchPollForActivityTaskResponse(
 	response.ScheduledTimestamp = scheduledEvent.Timestamp
 	response.ScheduleToCloseTimeoutSeconds = attributes.ScheduleToCloseTimeoutSeconds
 	response.StartedTimestamp = activityTaskDispatchInfo.StartedTimestamp
-	response.StartToCloseTimeoutSeconds = attributes.StartToCloseTimeoutSeconds
+	response.WasCancelBeforeStart = activityTaskDispatchInfo.WasCancelBeforeStart
+	response.StartToCloseTimeoutSeconds = common.Int32Ptr(attributes.GetStartToCloseTimeoutSecondsForAttempt(common.Int64Default(activityTaskDispatchInfo.Attempt)))
 	response.HeartbeatTimeoutSeconds = attributes.HeartbeatTimeoutSeconds
+	response.ExpectedResultSizeBytes = attributes.ExpectedResultSizeBytes
 
 	token := &common.TaskToken{
 		DomainID:        task.Event.DomainID,
@@ -830,6 +930,7 @@ func (e *matchingEngineImpl) createSyncMatchPollForActivityTaskResponse(
 		ScheduleAttempt: common.Int64Default(activityTaskDispatchInfo.Attempt),
 		ActivityID:      attributes.GetActivityID(),
 		ActivityType:    attributes.GetActivityType().GetName(),
+		TaskList:        attributes.GetTaskList().GetName(),
 	}
 
 	response.TaskToken, _ = e.tokenSerializer.Serialize(token)
@@ -955,6 +1056,24 @@ func (e *matchingEngineImpl) CancelOutstandingPoll(
 	return nil
 }
 
+func (e *matchingEngineImpl) RecordBackpressureHint(
+	hCtx *handlerContext,
+	request *types.MatchingRecordBackpressureHintRequest,
+) error {
+	taskListID, err := tasklist.NewIdentifier(request.GetDomainUUID(), request.GetTaskList().GetName(), persistence.TaskListTypeActivity)
+	if err != nil {
+		return err
+	}
+
+	tlMgr, err := e.getTaskListManager(taskListID, request.GetTaskList().Kind)
+	if err != nil {
+		return err
+	}
+
+	tlMgr.RecordBackpressureHint(request.GetHint())
+	return nil
+}
+
 func (e *matchingEngineImpl) DescribeTaskList(
 	hCtx *handlerContext,
 	request *types.MatchingDescribeTaskListRequest,
@@ -1112,6 +1231,50 @@ func (e *matchingEngineImpl) RefreshTaskListPartitionConfig(
 	return &types.MatchingRefreshTaskListPartitionConfigResponse{}, nil
 }
 
+// RemoveTaskListTask drops an activity task from the backlog of a task list, without updating the workflow.
+// It is a recovery tool for tasks wedging a task list: it has to be enabled for the domain, the caller has
+// to identify itself and give a reason, and each removal is logged.
+func (e *matchingEngineImpl) RemoveTaskListTask(
+	hCtx *handlerContext,
+	request *types.MatchingRemoveTaskListTaskRequest,
+) (*types.MatchingRemoveTaskListTaskResponse, error) {
+	domainID := request.GetDomainUUID()
+	domainName, err := e.domainCache.GetDomainName(domainID)
+	if err != nil {
+		return nil, err
+	}
+	if !e.config.EnableRemoveTaskListTask(domainName) {
+		return nil, &types.BadRequestError{Message: "RemoveTaskListTask is not enabled for the domain."}
+	}
+	if request.Operator == "" || request.Reason == "" {
+		return nil, &types.BadRequestError{Message: "Operator and Reason must be set."}
+	}
+	if request.GetTaskID() <= 0 {
+		execution := request.GetWorkflowExecution()
+		if execution.GetWorkflowID() == "" || execution.GetRunID() == "" || request.GetScheduleID() <= 0 {
+			return nil, &types.BadRequestError{Message: "Either TaskID or WorkflowExecution and ScheduleID must be set."}
+		}
+	}
+	taskListKind := request.GetTaskList().GetKind()
+	if taskListKind != types.TaskListKindNormal {
+		return nil, &types.BadRequestError{Message: "Tasks can only be removed from normal tasklists."}
+	}
+
+	taskListID, err := tasklist.NewIdentifier(domainID, request.GetTaskList().GetName(), persistence.TaskListTypeActivity)
+	if err != nil {
+		return nil, err
+	}
+	tlMgr, err := e.getTaskListManager(taskListID, &taskListKind)
+	if err != nil {
+		return nil, err
+	}
+	task, err := tlMgr.RemoveTask(hCtx.Context, request)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MatchingRemoveTaskListTaskResponse{TaskID: task.TaskID}, nil
+}
+
 func (e *matchingEngineImpl) getHostInfo(partitionKey string) (string, error) {
 	host, err := e.membershipResolver.Lookup(service.Matching, partitionKey)
 	if err != nil {
@@ -1234,8 +1397,10 @@ func (e *matchingEngineImpl) createPollForActivityTaskResponse(
 	response.ScheduledTimestamp = scheduledEvent.Timestamp
 	response.ScheduleToCloseTimeoutSeconds = attributes.ScheduleToCloseTimeoutSeconds
 	response.StartedTimestamp = historyResponse.StartedTimestamp
-	response.StartToCloseTimeoutSeconds = attributes.StartToCloseTimeoutSeconds
+	response.WasCancelBeforeStart = historyResponse.WasCancelBeforeStart
+	response.StartToCloseTimeoutSeconds = common.Int32Ptr(attributes.GetStartToCloseTimeoutSecondsForAttempt(historyResponse.Attempt))
 	response.HeartbeatTimeoutSeconds = attributes.HeartbeatTimeoutSeconds
+	response.ExpectedResultSizeBytes = attributes.ExpectedResultSizeBytes
 
 	token := &common.TaskToken{
 		DomainID:        task.Event.DomainID,
@@ -1246,6 +1411,7 @@ func (e *matchingEngineImpl) createPollForActivityTaskResponse(
 		ScheduleAttempt: historyResponse.GetAttempt(),
 		ActivityID:      attributes.GetActivityID(),
 		ActivityType:    attributes.GetActivityType().GetName(),
+		TaskList:        attributes.GetTaskList().GetName(),
 	}
 
 	response.TaskToken, _ = e.tokenSerializer.Serialize(token)
@@ -1290,14 +1456,18 @@ func (e *matchingEngineImpl) recordActivityTaskStarted(
 	ctx context.Context,
 	pollReq *types.PollForActivityTaskRequest,
 	task *tasklist.InternalTask,
+	prefetched bool,
 ) (*types.RecordActivityTaskStartedResponse, error) {
 	request := &types.RecordActivityTaskStartedRequest{
-		DomainUUID:        task.Event.DomainID,
-		WorkflowExecution: task.WorkflowExecution(),
-		ScheduleID:        task.Event.ScheduleID,
-		TaskID:            task.Event.TaskID,
-		RequestID:         uuid.New(),
-		PollRequest:       pollReq,
+		DomainUUID:               task.Event.DomainID,
+		WorkflowExecution:        task.WorkflowExecution(),
+		ScheduleID:               task.Event.ScheduleID,
+		TaskID:                   task.Event.TaskID,
+		RequestID:                uuid.New(),
+		PollRequest:              pollReq,
+		VisibilityTimeoutSeconds: e.activityTaskVisibilityTimeoutSeconds(pollReq.GetDomain(), pollReq.GetTaskList().GetName()),
+		StealThreshold:           e.activityTaskStealThreshold(pollReq.GetDomain(), pollReq.GetTaskList().GetName()),
+		Prefetched:               prefetched,
 	}
 	var resp *types.RecordActivityTaskStartedResponse
 	op := func() error {
@@ -1313,6 +1483,44 @@ func (e *matchingEngineImpl) recordActivityTaskStarted(
 	return resp, err
 }
 
+// activityTaskVisibilityTimeoutSeconds returns the time a started activity of the task list may go
+// without a heartbeat or completion before history hands it to another poller. Heartbeats extend the
+// window, and when the activity also has a shorter heartbeat timeout, the heartbeat timeout wins.
+func (e *matchingEngineImpl) activityTaskVisibilityTimeoutSeconds(
+	domainName string,
+	taskListName string,
+) int32 {
+	return int32(e.config.ActivityTaskVisibilityTimeout(domainName, taskListName, persistence.TaskListTypeActivity).Seconds())
+}
+
+// activityTaskStealThreshold returns the fraction of its heartbeat timeout a started activity of the task list
+// may go without a heartbeat before history offers it to another poller. History only steals activities declared
+// idempotent, as the original worker may still be running the attempt. Values outside of (0, 1) disable stealing.
+func (e *matchingEngineImpl) activityTaskStealThreshold(
+	domainName string,
+	taskListName string,
+) float64 {
+	threshold := e.config.ActivityTaskStealThreshold(domainName, taskListName, persistence.TaskListTypeActivity)
+	if threshold <= 0 || threshold >= 1 {
+		return 0
+	}
+	return threshold
+}
+
+// emitActivityCapabilityMismatch counts activity tasks which could not be handed to a poller because it
+// does not advertise the capabilities they require. The task goes back to the task list (or to history
+// for tasks dispatched by history directly) and waits for a capable poller or its ScheduleToStart timeout.
+func (e *matchingEngineImpl) emitActivityCapabilityMismatch(
+	scope metrics.Scope,
+	domainID string,
+	taskListName string,
+) {
+	domainName, _ := e.domainCache.GetDomainName(domainID)
+	scope.Tagged(metrics.DomainTag(domainName)).
+		Tagged(metrics.TaskListTag(taskListName)).
+		IncCounter(metrics.PollActivityTaskCapabilityMismatchCounterPerTaskList)
+}
+
 func (e *matchingEngineImpl) emitForwardedFromStats(
 	scope metrics.Scope,
 	isTaskForwarded bool,
@@ -1439,5 +1647,5 @@ func isMatchingRetryableError(err error) bool {
 	case *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyCompletedError, *types.EventAlreadyStartedError:
 		return false
 	}
-	return true
+	return !common.IsActivityCapabilityMismatchError(err)
 }
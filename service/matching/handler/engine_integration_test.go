@@ -1399,6 +1399,7 @@ func (s *matchingEngineSuite) assertPollTaskResponse(taskType int, param *testPa
 			ScheduleID:   scheduleID,
 			ActivityID:   param.ActivityID,
 			ActivityType: param.ActivityType.Name,
+			TaskList:     param.TaskList.Name,
 		}
 		s.EqualValues(token, actual.TaskToken)
 		s.EqualValues(param.ActivityID, actual.ActivityID)
@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/matching/config"
@@ -324,6 +325,64 @@ func TestCancelOutstandingPoll(t *testing.T) {
 	}
 }
 
+func TestRecordBackpressureHint(t *testing.T) {
+	testCases := []struct {
+		name      string
+		req       *types.MatchingRecordBackpressureHintRequest
+		mockSetup func(*tasklist.MockManager)
+		wantErr   bool
+	}{
+		{
+			name: "invalid tasklist name",
+			req: &types.MatchingRecordBackpressureHintRequest{
+				DomainUUID: "test-domain-id",
+				TaskList: &types.TaskList{
+					Name: "/__cadence_sys/invalid-tasklist-name",
+				},
+				Hint: 0.5,
+			},
+			mockSetup: func(mockManager *tasklist.MockManager) {
+			},
+			wantErr: true,
+		},
+		{
+			name: "success",
+			req: &types.MatchingRecordBackpressureHintRequest{
+				DomainUUID: "test-domain-id",
+				TaskList: &types.TaskList{
+					Name: "test-tasklist",
+				},
+				Hint: 0.5,
+			},
+			mockSetup: func(mockManager *tasklist.MockManager) {
+				mockManager.EXPECT().RecordBackpressureHint(0.5)
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockManager := tasklist.NewMockManager(mockCtrl)
+			tc.mockSetup(mockManager)
+			tasklistID, err := tasklist.NewIdentifier("test-domain-id", "test-tasklist", persistence.TaskListTypeActivity)
+			require.NoError(t, err)
+			engine := &matchingEngineImpl{
+				taskLists: map[tasklist.Identifier]tasklist.Manager{
+					*tasklistID: mockManager,
+				},
+			}
+			err = engine.RecordBackpressureHint(nil, tc.req)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestRespondQueryTaskCompleted(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -1117,3 +1176,97 @@ func TestRefreshTaskListPartitionConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoveTaskListTask(t *testing.T) {
+	validRequest := &types.MatchingRemoveTaskListTaskRequest{
+		DomainUUID: "test-domain-id",
+		TaskList:   &types.TaskList{Name: "test-tasklist"},
+		TaskID:     10,
+		Operator:   "operator",
+		Reason:     "poison task",
+	}
+	testCases := []struct {
+		name          string
+		enabled       bool
+		req           *types.MatchingRemoveTaskListTaskRequest
+		mockSetup     func(*tasklist.MockManager)
+		expectedError string
+	}{
+		{
+			name:    "success",
+			enabled: true,
+			req:     validRequest,
+			mockSetup: func(mockManager *tasklist.MockManager) {
+				mockManager.EXPECT().RemoveTask(gomock.Any(), validRequest).Return(&persistence.TaskInfo{TaskID: 10}, nil)
+			},
+		},
+		{
+			name:          "disabled for the domain",
+			req:           validRequest,
+			expectedError: "RemoveTaskListTask is not enabled for the domain.",
+		},
+		{
+			name:    "operator not set",
+			enabled: true,
+			req: &types.MatchingRemoveTaskListTaskRequest{
+				DomainUUID: "test-domain-id",
+				TaskList:   &types.TaskList{Name: "test-tasklist"},
+				TaskID:     10,
+				Reason:     "poison task",
+			},
+			expectedError: "Operator and Reason must be set.",
+		},
+		{
+			name:    "task not identified",
+			enabled: true,
+			req: &types.MatchingRemoveTaskListTaskRequest{
+				DomainUUID:        "test-domain-id",
+				TaskList:          &types.TaskList{Name: "test-tasklist"},
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid"},
+				Operator:          "operator",
+				Reason:            "poison task",
+			},
+			expectedError: "Either TaskID or WorkflowExecution and ScheduleID must be set.",
+		},
+		{
+			name:    "task not found",
+			enabled: true,
+			req:     validRequest,
+			mockSetup: func(mockManager *tasklist.MockManager) {
+				mockManager.EXPECT().RemoveTask(gomock.Any(), validRequest).Return(nil, &types.EntityNotExistsError{Message: "Task not found in the task list backlog."})
+			},
+			expectedError: "Task not found in the task list backlog.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockManager := tasklist.NewMockManager(mockCtrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockManager)
+			}
+			mockDomainCache := cache.NewMockDomainCache(mockCtrl)
+			mockDomainCache.EXPECT().GetDomainName("test-domain-id").Return("test-domain", nil)
+			tasklistID, err := tasklist.NewIdentifier("test-domain-id", "test-tasklist", persistence.TaskListTypeActivity)
+			require.NoError(t, err)
+			engine := &matchingEngineImpl{
+				taskLists: map[tasklist.Identifier]tasklist.Manager{
+					*tasklistID: mockManager,
+				},
+				domainCache: mockDomainCache,
+				config: &config.Config{
+					EnableRemoveTaskListTask: dynamicconfig.GetBoolPropertyFnFilteredByDomain(tc.enabled),
+				},
+				timeSource: clock.NewRealTimeSource(),
+			}
+			resp, err := engine.RemoveTaskListTask(&handlerContext{Context: context.Background()}, tc.req)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, int64(10), resp.TaskID)
+			}
+		})
+	}
+}
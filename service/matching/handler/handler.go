@@ -333,6 +333,29 @@ func (h *handlerImpl) CancelOutstandingPoll(ctx context.Context,
 	return hCtx.handleErr(err)
 }
 
+// RecordBackpressureHint records a backpressure hint reported by a worker of an activity task list
+func (h *handlerImpl) RecordBackpressureHint(ctx context.Context,
+	request *types.MatchingRecordBackpressureHintRequest) (retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetTaskList(),
+		metrics.MatchingRecordBackpressureHintScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	// Count the request in the RPS, but we still accept it even if RPS is exceeded
+	h.workerRateLimiter.Allow(quotas.Info{Domain: domainName})
+
+	err := h.engine.RecordBackpressureHint(hCtx, request)
+	return hCtx.handleErr(err)
+}
+
 // DescribeTaskList returns information about the target tasklist, right now this API returns the
 // pollers which polled this tasklist in last few minutes. If includeTaskListStatus field is true,
 // it will also return status of tasklist's ackManager (readLevel, ackLevel, backlogCountHint and taskIDBlock).
@@ -464,6 +487,28 @@ func (h *handlerImpl) RefreshTaskListPartitionConfig(
 	return response, hCtx.handleErr(err)
 }
 
+// RemoveTaskListTask drops an activity task from the backlog of a task list
+func (h *handlerImpl) RemoveTaskListTask(
+	ctx context.Context,
+	request *types.MatchingRemoveTaskListTaskRequest,
+) (resp *types.MatchingRemoveTaskListTaskResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetTaskList(),
+		metrics.MatchingRemoveTaskListTaskScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	response, err := h.engine.RemoveTaskListTask(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
 func (h *handlerImpl) domainName(id string) string {
 	domainName, err := h.domainCache.GetDomainName(id)
 	if err != nil {
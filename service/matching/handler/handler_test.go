@@ -582,6 +582,51 @@ func (s *handlerSuite) TestCancelOutstandingPoll() {
 	}
 }
 
+func (s *handlerSuite) TestRecordBackpressureHint() {
+	request := types.MatchingRecordBackpressureHintRequest{
+		DomainUUID: "test-domain-id",
+		Hint:       0.5,
+	}
+
+	testCases := []struct {
+		name       string
+		setupMocks func()
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func() {
+				s.mockEngine.EXPECT().RecordBackpressureHint(gomock.Any(), &request).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "Error case - RecordBackpressureHint failed",
+			setupMocks: func() {
+				s.mockEngine.EXPECT().RecordBackpressureHint(gomock.Any(), &request).Return(errors.New("record-hint-error")).Times(1)
+			},
+			err: &types.InternalServiceError{Message: "record-hint-error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+
+			s.mockDomainCache.EXPECT().GetDomainName(request.DomainUUID).Return(s.testDomain, nil).Times(1)
+			s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+
+			err := s.handler.RecordBackpressureHint(context.Background(), &request)
+
+			if tc.err != nil {
+				s.Error(err)
+				s.Equal(tc.err, err)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
 func (s *handlerSuite) TestDescribeTaskList() {
 	request := types.MatchingDescribeTaskListRequest{
 		DomainUUID: "test-domain-id",
@@ -25,7 +25,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -package handler -source interfaces.go -destination interfaces_mock.go -package handler github.com/uber/cadence/service/matching/handler Handler
+//	mockgen -package handler -source interfaces.go -destination interfaces_mock.go github.com/uber/cadence/service/matching/handler Handler
 //
 
 // Package handler is a generated GoMock package.
@@ -198,6 +198,20 @@ func (mr *MockEngineMockRecorder) QueryWorkflow(hCtx, request any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockEngine)(nil).QueryWorkflow), hCtx, request)
 }
 
+// RecordBackpressureHint mocks base method.
+func (m *MockEngine) RecordBackpressureHint(hCtx *handlerContext, request *types.MatchingRecordBackpressureHintRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordBackpressureHint", hCtx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordBackpressureHint indicates an expected call of RecordBackpressureHint.
+func (mr *MockEngineMockRecorder) RecordBackpressureHint(hCtx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordBackpressureHint", reflect.TypeOf((*MockEngine)(nil).RecordBackpressureHint), hCtx, request)
+}
+
 // RefreshTaskListPartitionConfig mocks base method.
 func (m *MockEngine) RefreshTaskListPartitionConfig(hCtx *handlerContext, request *types.MatchingRefreshTaskListPartitionConfigRequest) (*types.MatchingRefreshTaskListPartitionConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -213,6 +227,21 @@ func (mr *MockEngineMockRecorder) RefreshTaskListPartitionConfig(hCtx, request a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTaskListPartitionConfig", reflect.TypeOf((*MockEngine)(nil).RefreshTaskListPartitionConfig), hCtx, request)
 }
 
+// RemoveTaskListTask mocks base method.
+func (m *MockEngine) RemoveTaskListTask(hCtx *handlerContext, request *types.MatchingRemoveTaskListTaskRequest) (*types.MatchingRemoveTaskListTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTaskListTask", hCtx, request)
+	ret0, _ := ret[0].(*types.MatchingRemoveTaskListTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTaskListTask indicates an expected call of RemoveTaskListTask.
+func (mr *MockEngineMockRecorder) RemoveTaskListTask(hCtx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTaskListTask", reflect.TypeOf((*MockEngine)(nil).RemoveTaskListTask), hCtx, request)
+}
+
 // RespondQueryTaskCompleted mocks base method.
 func (m *MockEngine) RespondQueryTaskCompleted(hCtx *handlerContext, request *types.MatchingRespondQueryTaskCompletedRequest) error {
 	m.ctrl.T.Helper()
@@ -439,6 +468,20 @@ func (mr *MockHandlerMockRecorder) QueryWorkflow(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockHandler)(nil).QueryWorkflow), arg0, arg1)
 }
 
+// RecordBackpressureHint mocks base method.
+func (m *MockHandler) RecordBackpressureHint(arg0 context.Context, arg1 *types.MatchingRecordBackpressureHintRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordBackpressureHint", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordBackpressureHint indicates an expected call of RecordBackpressureHint.
+func (mr *MockHandlerMockRecorder) RecordBackpressureHint(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordBackpressureHint", reflect.TypeOf((*MockHandler)(nil).RecordBackpressureHint), arg0, arg1)
+}
+
 // RefreshTaskListPartitionConfig mocks base method.
 func (m *MockHandler) RefreshTaskListPartitionConfig(arg0 context.Context, arg1 *types.MatchingRefreshTaskListPartitionConfigRequest) (*types.MatchingRefreshTaskListPartitionConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -454,6 +497,21 @@ func (mr *MockHandlerMockRecorder) RefreshTaskListPartitionConfig(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTaskListPartitionConfig", reflect.TypeOf((*MockHandler)(nil).RefreshTaskListPartitionConfig), arg0, arg1)
 }
 
+// RemoveTaskListTask mocks base method.
+func (m *MockHandler) RemoveTaskListTask(arg0 context.Context, arg1 *types.MatchingRemoveTaskListTaskRequest) (*types.MatchingRemoveTaskListTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTaskListTask", arg0, arg1)
+	ret0, _ := ret[0].(*types.MatchingRemoveTaskListTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTaskListTask indicates an expected call of RemoveTaskListTask.
+func (mr *MockHandlerMockRecorder) RemoveTaskListTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTaskListTask", reflect.TypeOf((*MockHandler)(nil).RemoveTaskListTask), arg0, arg1)
+}
+
 // RespondQueryTaskCompleted mocks base method.
 func (m *MockHandler) RespondQueryTaskCompleted(arg0 context.Context, arg1 *types.MatchingRespondQueryTaskCompletedRequest) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,119 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+)
+
+const (
+	// minBackpressureLevel is the saturation level below which backpressure no longer applies
+	minBackpressureLevel = 0.01
+)
+
+type (
+	// backpressure aggregates the backpressure hints reported by the workers of an activity task list
+	// into a saturation level between 0 and 1, from which the dispatch rate of the task list is derived.
+	// A hint above the current level raises it right away, a lower hint moves it halfway down, and
+	// without new hints the level halves every half-life so that the dispatch rate recovers.
+	backpressure struct {
+		sync.Mutex
+		timeSource clock.TimeSource
+		halfLife   func() time.Duration
+		level      float64
+		updatedAt  time.Time
+		// pollerRPS is the last dispatch rate requested by pollers, the base rate backpressure applies to
+		pollerRPS *float64
+	}
+)
+
+func newBackpressure(timeSource clock.TimeSource, halfLife func() time.Duration) *backpressure {
+	return &backpressure{
+		timeSource: timeSource,
+		halfLife:   halfLife,
+	}
+}
+
+// record aggregates a hint reported by a worker into the saturation level
+func (b *backpressure) record(hint float64) {
+	if math.IsNaN(hint) {
+		return
+	}
+	hint = math.Max(0, math.Min(1, hint))
+	b.Lock()
+	defer b.Unlock()
+	now := b.timeSource.Now()
+	level := b.levelLocked(now)
+	if hint < level {
+		hint = (level + hint) / 2
+	}
+	b.level = hint
+	b.updatedAt = now
+}
+
+// adjust records the dispatch rate requested by a poller and returns the rate to dispatch tasks at
+func (b *backpressure) adjust(pollerRPS *float64, defaultRPS float64, minDispatchRatio float64) *float64 {
+	b.Lock()
+	defer b.Unlock()
+	if pollerRPS != nil {
+		rps := *pollerRPS
+		b.pollerRPS = &rps
+	}
+	rate := b.rateLocked(defaultRPS, minDispatchRatio)
+	return &rate
+}
+
+// rate returns the backpressure-derived dispatch rate, or zero when workers do not report any saturation
+func (b *backpressure) rate(defaultRPS float64, minDispatchRatio float64) float64 {
+	b.Lock()
+	defer b.Unlock()
+	if b.levelLocked(b.timeSource.Now()) == 0 {
+		return 0
+	}
+	return b.rateLocked(defaultRPS, minDispatchRatio)
+}
+
+func (b *backpressure) rateLocked(defaultRPS float64, minDispatchRatio float64) float64 {
+	rps := defaultRPS
+	if b.pollerRPS != nil {
+		rps = *b.pollerRPS
+	}
+	minDispatchRatio = math.Max(0, math.Min(1, minDispatchRatio))
+	return rps * (1 - b.levelLocked(b.timeSource.Now())*(1-minDispatchRatio))
+}
+
+func (b *backpressure) levelLocked(now time.Time) float64 {
+	halfLife := b.halfLife()
+	if b.level == 0 || halfLife <= 0 {
+		return b.level
+	}
+	level := b.level * math.Pow(0.5, float64(now.Sub(b.updatedAt))/float64(halfLife))
+	if level < minBackpressureLevel {
+		return 0
+	}
+	return level
+}
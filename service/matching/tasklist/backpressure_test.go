@@ -0,0 +1,74 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+)
+
+func TestBackpressure(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	b := newBackpressure(timeSource, func() time.Duration { return time.Minute })
+
+	// no backpressure before workers report saturation
+	assert.Equal(t, 0.0, b.rate(100, 0.1))
+	assert.Equal(t, common.Float64Ptr(100), b.adjust(nil, 100, 0.1))
+
+	// full saturation keeps the minimum dispatch ratio of the poller requested rate
+	b.record(1)
+	assert.InDelta(t, 5, *b.adjust(common.Float64Ptr(50), 100, 0.1), 0.001)
+	assert.InDelta(t, 5, b.rate(100, 0.1), 0.001)
+
+	// a lower hint moves the level halfway down
+	b.record(0)
+	assert.InDelta(t, 27.5, *b.adjust(nil, 100, 0.1), 0.001)
+
+	// the level halves every half-life without new hints
+	timeSource.Advance(time.Minute)
+	assert.InDelta(t, 38.75, *b.adjust(nil, 100, 0.1), 0.001)
+
+	// the dispatch rate recovers once the level decays below the threshold
+	timeSource.Advance(time.Hour)
+	assert.Equal(t, 0.0, b.rate(100, 0.1))
+	assert.Equal(t, common.Float64Ptr(50), b.adjust(nil, 100, 0.1))
+}
+
+func TestBackpressure_InvalidHints(t *testing.T) {
+	b := newBackpressure(clock.NewMockedTimeSource(), func() time.Duration { return time.Minute })
+
+	b.record(-1)
+	assert.Equal(t, 0.0, b.rate(100, 0.1))
+
+	b.record(2)
+	assert.InDelta(t, 10, b.rate(100, 0.1), 0.001)
+
+	// the minimum dispatch ratio is capped between 0 and 1
+	assert.InDelta(t, 0, b.rate(100, -1), 0.001)
+	assert.InDelta(t, 100, b.rate(100, 2), 0.001)
+}
@@ -252,6 +252,7 @@ func (fwdr *forwarderImpl) ForwardPoll(ctx context.Context) (*InternalTask, erro
 			},
 			ForwardedFrom:  fwdr.taskListID.GetName(),
 			IsolationGroup: isolationGroup,
+			SourceCluster:  SourceClusterFromContext(ctx),
 		})
 		if err != nil {
 			return nil, fwdr.handleErr(err)
@@ -31,6 +31,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -64,6 +65,10 @@ type (
 		UpdateTaskListPartitionConfig(context.Context, *types.TaskListPartitionConfig) error
 		RefreshTaskListPartitionConfig(context.Context, *types.TaskListPartitionConfig) error
 		LoadBalancerHints() *types.LoadBalancerHints
+		// RemoveTask drops a task from the backlog of the task list, see types.MatchingRemoveTaskListTaskRequest
+		RemoveTask(ctx context.Context, request *types.MatchingRemoveTaskListTaskRequest) (*persistence.TaskInfo, error)
+		// RecordBackpressureHint records a backpressure hint reported by a worker of the task list
+		RecordBackpressureHint(hint float64)
 	}
 
 	TaskMatcher interface {
@@ -38,6 +38,7 @@ import (
 
 	gomock "go.uber.org/mock/gomock"
 
+	persistence "github.com/uber/cadence/common/persistence"
 	types "github.com/uber/cadence/common/types"
 )
 
@@ -206,6 +207,18 @@ func (mr *MockManagerMockRecorder) LoadBalancerHints() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadBalancerHints", reflect.TypeOf((*MockManager)(nil).LoadBalancerHints))
 }
 
+// RecordBackpressureHint mocks base method.
+func (m *MockManager) RecordBackpressureHint(hint float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordBackpressureHint", hint)
+}
+
+// RecordBackpressureHint indicates an expected call of RecordBackpressureHint.
+func (mr *MockManagerMockRecorder) RecordBackpressureHint(hint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordBackpressureHint", reflect.TypeOf((*MockManager)(nil).RecordBackpressureHint), hint)
+}
+
 // RefreshTaskListPartitionConfig mocks base method.
 func (m *MockManager) RefreshTaskListPartitionConfig(arg0 context.Context, arg1 *types.TaskListPartitionConfig) error {
 	m.ctrl.T.Helper()
@@ -220,6 +233,21 @@ func (mr *MockManagerMockRecorder) RefreshTaskListPartitionConfig(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTaskListPartitionConfig", reflect.TypeOf((*MockManager)(nil).RefreshTaskListPartitionConfig), arg0, arg1)
 }
 
+// RemoveTask mocks base method.
+func (m *MockManager) RemoveTask(ctx context.Context, request *types.MatchingRemoveTaskListTaskRequest) (*persistence.TaskInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTask", ctx, request)
+	ret0, _ := ret[0].(*persistence.TaskInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTask indicates an expected call of RemoveTask.
+func (mr *MockManagerMockRecorder) RemoveTask(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockManager)(nil).RemoveTask), ctx, request)
+}
+
 // Start mocks base method.
 func (m *MockManager) Start() error {
 	m.ctrl.T.Helper()
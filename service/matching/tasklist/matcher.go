@@ -49,6 +49,9 @@ type taskMatcherImpl struct {
 	// synchronos task channels to match producer/consumer for a certain isolation group
 	// the key is the name of the isolation group
 	isolatedTaskC map[string]chan *InternalTask
+	// synchronous task channel to match producer/consumer for tasks scheduled with RegionAffinity
+	// only pollers of the current cluster read from this channel, pollers forwarded from other clusters don't
+	localTaskC chan *InternalTask
 	// synchronous task channel to match query task - the reason to have
 	// separate channel for this is because there are cases when consumers
 	// are interested in queryTasks but not others. Example is when domain is
@@ -102,6 +105,7 @@ func newTaskMatcher(
 		fwdr:                fwdr,
 		taskC:               make(chan *InternalTask),
 		isolatedTaskC:       isolatedTaskC,
+		localTaskC:          make(chan *InternalTask),
 		queryTaskC:          make(chan *InternalTask),
 		config:              config,
 		tasklist:            tasklist,
@@ -404,6 +408,12 @@ func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string) (*In
 		isolatedTaskC = tm.taskC
 		tm.scope.IncCounter(metrics.PollerInvalidIsolationGroupCounter)
 	}
+	// pollers forwarded from other clusters don't get the tasks kept for the pollers of the current cluster
+	localTaskC := tm.localTaskC
+	sourceCluster := SourceClusterFromContext(ctx)
+	if sourceCluster != "" {
+		localTaskC = nil
+	}
 
 	// we want cancellation of taskMatcher to be treated as cancellation of client context
 	// original context (ctx) won't be affected
@@ -418,11 +428,14 @@ func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string) (*In
 				EnableAutoConfig:   tm.config.EnableClientAutoConfig(),
 				PollerWaitTimeInMs: time.Since(startT).Milliseconds(),
 			}
+			if sourceCluster != "" && !task.IsQuery() && !task.IsStarted() {
+				tm.scope.IncCounter(metrics.CrossRegionDispatchPerTaskListCounter)
+			}
 		}
 	}()
 
 	// try local match first without blocking until context timeout
-	if task, err = tm.pollNonBlocking(ctxWithCancelPropagation, isolatedTaskC, tm.taskC, tm.queryTaskC); err == nil {
+	if task, err = tm.pollNonBlocking(ctxWithCancelPropagation, isolatedTaskC, localTaskC, tm.taskC, tm.queryTaskC); err == nil {
 		tm.scope.RecordTimer(metrics.PollLocalMatchLatencyPerTaskList, time.Since(startT))
 		return task, nil
 	}
@@ -440,7 +453,7 @@ func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string) (*In
 		TaskListKind: tm.tasklistKind.Ptr(),
 		EventName:    "Matcher Falling Back to Non-Local Polling",
 	})
-	task, err = tm.pollOrForward(ctxWithCancelPropagation, startT, isolationGroup, isolatedTaskC, tm.taskC, tm.queryTaskC)
+	task, err = tm.pollOrForward(ctxWithCancelPropagation, startT, isolationGroup, isolatedTaskC, localTaskC, tm.taskC, tm.queryTaskC)
 	return task, err
 }
 
@@ -450,14 +463,14 @@ func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string) (*In
 func (tm *taskMatcherImpl) PollForQuery(ctx context.Context) (*InternalTask, error) {
 	startT := time.Now()
 	// try local match first without blocking until context timeout
-	if task, err := tm.pollNonBlocking(ctx, nil, nil, tm.queryTaskC); err == nil {
+	if task, err := tm.pollNonBlocking(ctx, nil, nil, nil, tm.queryTaskC); err == nil {
 		tm.scope.RecordTimer(metrics.PollLocalMatchLatencyPerTaskList, time.Since(startT))
 		return task, nil
 	}
 	// there is no local poller available to pickup this task. Now block waiting
 	// either for a local poller or a forwarding token to be available. When a
 	// forwarding token becomes available, send this poll to a parent partition
-	return tm.pollOrForward(ctx, startT, "", nil, nil, tm.queryTaskC)
+	return tm.pollOrForward(ctx, startT, "", nil, nil, nil, tm.queryTaskC)
 }
 
 // UpdateRatelimit updates the task dispatch rate
@@ -484,6 +497,7 @@ func (tm *taskMatcherImpl) pollOrForward(
 	startT time.Time,
 	isolationGroup string,
 	isolatedTaskC <-chan *InternalTask,
+	localTaskC <-chan *InternalTask,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
@@ -508,6 +522,27 @@ func (tm *taskMatcherImpl) pollOrForward(
 			},
 		})
 		return task, nil
+	case task := <-localTaskC:
+		if task.ResponseC != nil {
+			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope.RecordTimer(metrics.PollLocalMatchLatencyPerTaskList, time.Since(startT))
+		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
+		event.Log(event.E{
+			TaskListName: tm.tasklist.GetName(),
+			TaskListType: tm.tasklist.GetType(),
+			TaskListKind: tm.tasklistKind.Ptr(),
+			TaskInfo:     task.Info(),
+			EventName:    "Matched Task (pollOrForward)",
+			Payload: map[string]any{
+				"TaskIsForwarded":   task.IsForwarded(),
+				"SyncMatched":       task.ResponseC != nil,
+				"FromIsolatedTaskC": false,
+				"FromLocalTaskC":    true,
+				"IsolationGroup":    task.isolationGroup,
+			},
+		})
+		return task, nil
 	case task := <-taskC:
 		if task.ResponseC != nil {
 			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
@@ -563,7 +598,7 @@ func (tm *taskMatcherImpl) pollOrForward(
 			return task, nil
 		}
 		token.release(isolationGroup)
-		return tm.poll(ctx, startT, isolatedTaskC, taskC, queryTaskC)
+		return tm.poll(ctx, startT, isolatedTaskC, localTaskC, taskC, queryTaskC)
 	}
 }
 
@@ -571,6 +606,7 @@ func (tm *taskMatcherImpl) poll(
 	ctx context.Context,
 	startT time.Time,
 	isolatedTaskC <-chan *InternalTask,
+	localTaskC <-chan *InternalTask,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
@@ -595,6 +631,27 @@ func (tm *taskMatcherImpl) poll(
 			},
 		})
 		return task, nil
+	case task := <-localTaskC:
+		if task.ResponseC != nil {
+			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope.RecordTimer(metrics.PollLocalMatchAfterForwardFailedLatencyPerTaskList, time.Since(startT))
+		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
+		event.Log(event.E{
+			TaskListName: tm.tasklist.GetName(),
+			TaskListType: tm.tasklist.GetType(),
+			TaskListKind: tm.tasklistKind.Ptr(),
+			TaskInfo:     task.Info(),
+			EventName:    "Matched Task (poll)",
+			Payload: map[string]any{
+				"TaskIsForwarded":   task.IsForwarded(),
+				"SyncMatched":       task.ResponseC != nil,
+				"FromIsolatedTaskC": false,
+				"FromLocalTaskC":    true,
+				"IsolationGroup":    task.isolationGroup,
+			},
+		})
+		return task, nil
 	case task := <-taskC:
 		if task.ResponseC != nil {
 			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
@@ -634,6 +691,7 @@ func (tm *taskMatcherImpl) poll(
 func (tm *taskMatcherImpl) pollLocalWait(
 	ctx context.Context,
 	isolatedTaskC <-chan *InternalTask,
+	localTaskC <-chan *InternalTask,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
@@ -657,6 +715,26 @@ func (tm *taskMatcherImpl) pollLocalWait(
 			},
 		})
 		return task, nil
+	case task := <-localTaskC:
+		if task.ResponseC != nil {
+			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
+		event.Log(event.E{
+			TaskListName: tm.tasklist.GetName(),
+			TaskListType: tm.tasklist.GetType(),
+			TaskListKind: tm.tasklistKind.Ptr(),
+			TaskInfo:     task.Info(),
+			EventName:    "Matched Task Nonblocking",
+			Payload: map[string]any{
+				"TaskIsForwarded":   task.IsForwarded(),
+				"SyncMatched":       task.ResponseC != nil,
+				"FromIsolatedTaskC": false,
+				"FromLocalTaskC":    true,
+				"IsolationGroup":    task.isolationGroup,
+			},
+		})
+		return task, nil
 	case task := <-taskC:
 		if task.ResponseC != nil {
 			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
@@ -694,6 +772,7 @@ func (tm *taskMatcherImpl) pollLocalWait(
 func (tm *taskMatcherImpl) pollNonBlocking(
 	ctx context.Context,
 	isolatedTaskC <-chan *InternalTask,
+	localTaskC <-chan *InternalTask,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
@@ -701,7 +780,7 @@ func (tm *taskMatcherImpl) pollNonBlocking(
 	if waitTime > 0 {
 		childCtx, cancel := context.WithTimeout(ctx, waitTime)
 		defer cancel()
-		return tm.pollLocalWait(childCtx, isolatedTaskC, taskC, queryTaskC)
+		return tm.pollLocalWait(childCtx, isolatedTaskC, localTaskC, taskC, queryTaskC)
 	}
 	select {
 	case task := <-isolatedTaskC:
@@ -723,6 +802,26 @@ func (tm *taskMatcherImpl) pollNonBlocking(
 			},
 		})
 		return task, nil
+	case task := <-localTaskC:
+		if task.ResponseC != nil {
+			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
+		event.Log(event.E{
+			TaskListName: tm.tasklist.GetName(),
+			TaskListType: tm.tasklist.GetType(),
+			TaskListKind: tm.tasklistKind.Ptr(),
+			TaskInfo:     task.Info(),
+			EventName:    "Matched Task Nonblocking",
+			Payload: map[string]any{
+				"TaskIsForwarded":   task.IsForwarded(),
+				"SyncMatched":       task.ResponseC != nil,
+				"FromIsolatedTaskC": false,
+				"FromLocalTaskC":    true,
+				"IsolationGroup":    task.isolationGroup,
+			},
+		})
+		return task, nil
 	case task := <-taskC:
 		if task.ResponseC != nil {
 			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
@@ -792,6 +891,8 @@ func (tm *taskMatcherImpl) getTaskC(task *InternalTask) chan<- *InternalTask {
 	taskC := tm.taskC
 	if isolatedTaskC, ok := tm.isolatedTaskC[task.isolationGroup]; ok && task.isolationGroup != "" {
 		taskC = isolatedTaskC
+	} else if task.regionLocal {
+		taskC = tm.localTaskC
 	}
 	return taskC
 }
@@ -637,6 +637,36 @@ func (t *MatcherTestSuite) TestIsolationPollFailure() {
 	t.Nil(task)
 }
 
+func (t *MatcherTestSuite) TestMustOffer_RegionLocalTask() {
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceDbBacklog, "", false, nil, "")
+	task.regionLocal = true
+
+	// a poller forwarded from another cluster doesn't get the task
+	remoteErrC := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(ContextWithSourceCluster(context.Background(), "cluster1"), 200*time.Millisecond)
+		defer cancel()
+		_, err := t.rootMatcher.Poll(ctx, "")
+		remoteErrC <- err
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	err := t.rootMatcher.MustOffer(ctx, task)
+	cancel()
+	t.ErrorIs(err, context.DeadlineExceeded)
+
+	// a poller of the current cluster does
+	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
+		polledTask, err := t.rootMatcher.Poll(ctx, "")
+		t.NoError(err)
+		t.Equal(task, polledTask)
+	})
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	t.NoError(t.rootMatcher.MustOffer(ctx, task))
+	wait()
+	t.ErrorIs(<-remoteErrC, ErrNoTasks)
+}
+
 func (t *MatcherTestSuite) TestOffer_RateLimited() {
 	t.matcher.UpdateRatelimit(common.Float64Ptr(0))
 
@@ -1052,7 +1082,7 @@ func (t *MatcherTestSuite) Test_pollOrForward_PollIsolatedTask() {
 	// Test pollOrForward for isolated task - poll
 	isolatedTask := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, isolationGroup)
 	isolatedTaskC <- isolatedTask
-	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, isolatedTaskC, nil, nil)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, isolatedTaskC, nil, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(isolatedTask, retTask)
@@ -1075,7 +1105,7 @@ func (t *MatcherTestSuite) Test_pollOrForward_PollTask() {
 	// Test pollOrForward for regular task - poll
 	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
 	taskC <- task
-	retTask, err := t.matcher.pollOrForward(ctx, startT, "", nil, taskC, nil)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, "", nil, nil, taskC, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1095,7 +1125,7 @@ func (t *MatcherTestSuite) Test_pollOrForward_PollQueryTask() {
 	// Test pollOrForward for query task - poll
 	queryTask := newInternalQueryTask(uuid.New(), &types.MatchingQueryWorkflowRequest{})
 	queryTaskC <- queryTask
-	retTask, err := t.matcher.pollOrForward(ctx, startT, "", nil, nil, queryTaskC)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, "", nil, nil, nil, queryTaskC)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(queryTask, retTask)
@@ -1120,7 +1150,7 @@ func (t *MatcherTestSuite) Test_pollOrForward_ForwardTask() {
 	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
 	mockForwarder.EXPECT().ForwardPoll(ctx).Return(task, nil).Times(1)
 
-	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, nil, nil, nil)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, nil, nil, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1155,7 +1185,7 @@ func (t *MatcherTestSuite) Test_pollOrForward_ForwardTaskThenPoll() {
 		}
 	}()
 
-	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, nil, taskC, nil)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, nil, nil, taskC, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1169,7 +1199,7 @@ func (t *MatcherTestSuite) Test_poll_IsolatedTask() {
 
 	isolatedTask := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, isolationGroup)
 	isolatedTaskC <- isolatedTask
-	retTask, err := t.matcher.poll(ctx, startT, isolatedTaskC, nil, nil)
+	retTask, err := t.matcher.poll(ctx, startT, isolatedTaskC, nil, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(isolatedTask, retTask)
@@ -1182,7 +1212,7 @@ func (t *MatcherTestSuite) Test_poll_Task() {
 
 	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
 	taskC <- task
-	retTask, err := t.matcher.poll(ctx, startT, nil, taskC, nil)
+	retTask, err := t.matcher.poll(ctx, startT, nil, nil, taskC, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1195,7 +1225,7 @@ func (t *MatcherTestSuite) Test_poll_QueryTask() {
 
 	queryTask := newInternalQueryTask(uuid.New(), &types.MatchingQueryWorkflowRequest{})
 	queryTaskC <- queryTask
-	retTask, err := t.matcher.poll(ctx, startT, nil, nil, queryTaskC)
+	retTask, err := t.matcher.poll(ctx, startT, nil, nil, nil, queryTaskC)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(queryTask, retTask)
@@ -1207,7 +1237,7 @@ func (t *MatcherTestSuite) Test_poll_ContextExpired() {
 
 	startT := time.Now()
 
-	retTask, err := t.matcher.poll(ctx, startT, nil, nil, nil)
+	retTask, err := t.matcher.poll(ctx, startT, nil, nil, nil, nil)
 
 	t.ErrorIs(err, ErrNoTasks)
 	t.Nil(retTask)
@@ -1222,7 +1252,7 @@ func (t *MatcherTestSuite) Test_pollNonBlocking_IsolatedTask() {
 
 	isolatedTask := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, isolationGroup)
 	isolatedTaskC <- isolatedTask
-	retTask, err := t.matcher.pollNonBlocking(ctx, isolatedTaskC, nil, nil)
+	retTask, err := t.matcher.pollNonBlocking(ctx, isolatedTaskC, nil, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(isolatedTask, retTask)
@@ -1236,7 +1266,7 @@ func (t *MatcherTestSuite) Test_pollNonBlocking_Task() {
 
 	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
 	taskC <- task
-	retTask, err := t.matcher.pollNonBlocking(ctx, nil, taskC, nil)
+	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, taskC, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1250,7 +1280,7 @@ func (t *MatcherTestSuite) Test_pollNonBlocking_QueryTask() {
 
 	queryTask := newInternalQueryTask(uuid.New(), &types.MatchingQueryWorkflowRequest{})
 	queryTaskC <- queryTask
-	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, queryTaskC)
+	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, nil, queryTaskC)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(queryTask, retTask)
@@ -1261,7 +1291,7 @@ func (t *MatcherTestSuite) Test_pollNonBlocking_NoTasks() {
 
 	ctx := context.Background()
 
-	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, nil)
+	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, nil, nil)
 
 	t.ErrorIs(err, ErrNoTasks)
 	t.Nil(retTask)
@@ -0,0 +1,157 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+)
+
+const (
+	// pollerLatencyWeight is the weight of a new sample in the rolling latency estimate of a poller
+	pollerLatencyWeight = 0.2
+	// maxPendingPollerDispatches bounds the dispatches to a poller identity waiting for its next poll
+	maxPendingPollerDispatches = 16
+	// pollerLatencyTTL is how long the estimate of a poller identity which stopped polling is kept
+	pollerLatencyTTL = 5 * time.Minute
+)
+
+type (
+	// pollerLatency keeps a rolling latency estimate per poller identity of an activity task list, so that
+	// the pollers which complete their activities faster can be preferred. Matching does not see activities
+	// complete, the latency of an identity is estimated as the time between the dispatch of a task to one of
+	// its polls and its next poll: workers poll again once they have capacity for another task, which on a
+	// busy worker is when one of its activities completes.
+	pollerLatency struct {
+		sync.Mutex
+		timeSource clock.TimeSource
+		pollers    map[string]*pollerLatencyInfo
+		// waitingFastPolls is the number of polls of identities not slower than the median currently waiting
+		waitingFastPolls int
+	}
+
+	pollerLatencyInfo struct {
+		estimate    time.Duration
+		hasEstimate bool
+		// dispatchedAt are the times of the dispatches to the identity not yet followed by a poll, oldest first
+		dispatchedAt []time.Time
+		lastPollAt   time.Time
+	}
+)
+
+func newPollerLatency(timeSource clock.TimeSource) *pollerLatency {
+	return &pollerLatency{
+		timeSource: timeSource,
+		pollers:    make(map[string]*pollerLatencyInfo),
+	}
+}
+
+// startPoll records a poll of the identity and returns whether the poll should give the faster polls
+// currently waiting a head start, along with the func to call once the poll ends
+func (p *pollerLatency) startPoll(identity string) (bool, func()) {
+	p.Lock()
+	defer p.Unlock()
+	now := p.timeSource.Now()
+	info, ok := p.pollers[identity]
+	if !ok {
+		info = &pollerLatencyInfo{}
+		p.pollers[identity] = info
+	}
+	info.lastPollAt = now
+	if len(info.dispatchedAt) > 0 {
+		sample := now.Sub(info.dispatchedAt[0])
+		info.dispatchedAt = info.dispatchedAt[1:]
+		if info.hasEstimate {
+			info.estimate += time.Duration(pollerLatencyWeight * float64(sample-info.estimate))
+		} else {
+			info.estimate = sample
+			info.hasEstimate = true
+		}
+	}
+
+	// identities without an estimate yet are neither preferred nor held back, so that they get samples
+	if !info.hasEstimate {
+		return false, func() {}
+	}
+	if info.estimate > p.medianLocked(now) {
+		return p.waitingFastPolls > 0, func() {}
+	}
+	p.waitingFastPolls++
+	return false, func() {
+		p.Lock()
+		defer p.Unlock()
+		p.waitingFastPolls--
+	}
+}
+
+// recordDispatch records that a task was dispatched to a poll of the identity
+func (p *pollerLatency) recordDispatch(identity string) {
+	p.Lock()
+	defer p.Unlock()
+	info, ok := p.pollers[identity]
+	if !ok {
+		return
+	}
+	if len(info.dispatchedAt) == maxPendingPollerDispatches {
+		info.dispatchedAt = info.dispatchedAt[1:]
+	}
+	info.dispatchedAt = append(info.dispatchedAt, p.timeSource.Now())
+}
+
+// estimates returns the latency estimate of the identities which have one
+func (p *pollerLatency) estimates() map[string]time.Duration {
+	p.Lock()
+	defer p.Unlock()
+	p.pruneLocked(p.timeSource.Now())
+	estimates := make(map[string]time.Duration, len(p.pollers))
+	for identity, info := range p.pollers {
+		if info.hasEstimate {
+			estimates[identity] = info.estimate
+		}
+	}
+	return estimates
+}
+
+// medianLocked returns the lower median of the estimates of the identities which have one
+func (p *pollerLatency) medianLocked(now time.Time) time.Duration {
+	p.pruneLocked(now)
+	var estimates []time.Duration
+	for _, info := range p.pollers {
+		if info.hasEstimate {
+			estimates = append(estimates, info.estimate)
+		}
+	}
+	sort.Slice(estimates, func(i, j int) bool { return estimates[i] < estimates[j] })
+	return estimates[(len(estimates)-1)/2]
+}
+
+func (p *pollerLatency) pruneLocked(now time.Time) {
+	for identity, info := range p.pollers {
+		if now.Sub(info.lastPollAt) > pollerLatencyTTL {
+			delete(p.pollers, identity)
+		}
+	}
+}
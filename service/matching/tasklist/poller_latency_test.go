@@ -0,0 +1,97 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/clock"
+)
+
+func TestPollerLatency(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	p := newPollerLatency(timeSource)
+
+	// pollers without an estimate are neither preferred nor held back
+	holdBack, fastDone := p.startPoll("fast")
+	assert.False(t, holdBack)
+	fastDone()
+	holdBack, slowDone := p.startPoll("slow")
+	assert.False(t, holdBack)
+	slowDone()
+	assert.Empty(t, p.estimates())
+
+	// the estimate is the time from a dispatch to the next poll of the identity
+	p.recordDispatch("fast")
+	p.recordDispatch("slow")
+	timeSource.Advance(100 * time.Millisecond)
+	_, fastDone = p.startPoll("fast")
+	timeSource.Advance(900 * time.Millisecond)
+	holdBack, slowDone = p.startPoll("slow")
+	assert.Equal(t, map[string]time.Duration{
+		"fast": 100 * time.Millisecond,
+		"slow": time.Second,
+	}, p.estimates())
+
+	// the slower poller is held back while the faster one is waiting, and no longer once it is done
+	assert.True(t, holdBack)
+	slowDone()
+	fastDone()
+	holdBack, slowDone = p.startPoll("slow")
+	assert.False(t, holdBack)
+	slowDone()
+
+	// new samples move the estimate by their weight
+	p.recordDispatch("fast")
+	timeSource.Advance(1100 * time.Millisecond)
+	_, fastDone = p.startPoll("fast")
+	fastDone()
+	assert.Equal(t, 300*time.Millisecond, p.estimates()["fast"])
+
+	// identities which stopped polling are dropped
+	timeSource.Advance(pollerLatencyTTL + time.Second)
+	assert.Empty(t, p.estimates())
+}
+
+func TestPollerLatency_PendingDispatches(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	p := newPollerLatency(timeSource)
+
+	// dispatches to identities which never polled are ignored
+	p.recordDispatch("poller")
+	_, done := p.startPoll("poller")
+	done()
+	assert.Empty(t, p.estimates())
+
+	// the dispatches are paired with the next polls oldest first, and only the latest ones are kept
+	for i := 0; i < maxPendingPollerDispatches+1; i++ {
+		p.recordDispatch("poller")
+		timeSource.Advance(time.Second)
+	}
+	_, done = p.startPoll("poller")
+	done()
+	assert.Equal(t, time.Duration(maxPendingPollerDispatches)*time.Second, p.estimates()["poller"])
+}
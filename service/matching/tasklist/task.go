@@ -55,6 +55,7 @@ type (
 		source                   types.TaskSource
 		forwardedFrom            string     // name of the child partition this task is forwarded from (empty if not forwarded)
 		isolationGroup           string     // isolation group of this task (empty if it can be polled by workers from any isolation group)
+		regionLocal              bool       // true if only pollers of the current cluster can poll this task (see RegionAffinity)
 		ResponseC                chan error // non-nil only where there is a caller waiting for response (sync-match)
 		BacklogCountHint         int64
 		ActivityTaskDispatchInfo *types.ActivityTaskDispatchInfo
@@ -89,7 +90,7 @@ func newInternalTask(
 	// OriginalIsolationGroup is populated here and isn't written to the DB. If it's already
 	// present then it's a forwarded task and we should respect it.
 	if configIsolationGroup, ok := task.Event.PartitionConfig[partition.IsolationGroupKey]; ok {
-		partitionConfig := make(map[string]string, 3)
+		partitionConfig := make(map[string]string, 5)
 		if originalIsolationGroup, ok := task.Event.PartitionConfig[partition.OriginalIsolationGroupKey]; ok {
 			partitionConfig[partition.OriginalIsolationGroupKey] = originalIsolationGroup
 		} else {
@@ -97,6 +98,12 @@ func newInternalTask(
 		}
 		partitionConfig[partition.IsolationGroupKey] = isolationGroup
 		partitionConfig[partition.WorkflowIDKey] = task.Event.PartitionConfig[partition.WorkflowIDKey]
+		if regionAffinity, ok := task.Event.PartitionConfig[partition.RegionAffinityKey]; ok {
+			partitionConfig[partition.RegionAffinityKey] = regionAffinity
+		}
+		if priority, ok := task.Event.PartitionConfig[partition.PriorityKey]; ok {
+			partitionConfig[partition.PriorityKey] = priority
+		}
 		task.Event.PartitionConfig = partitionConfig
 	}
 	return task
@@ -78,6 +78,7 @@ type (
 	pollerIDCtxKey       struct{}
 	identityCtxKey       struct{}
 	isolationGroupCtxKey struct{}
+	sourceClusterCtxKey  struct{}
 
 	AddTaskParams struct {
 		TaskInfo                 *persistence.TaskInfo
@@ -131,6 +132,8 @@ type (
 
 		qpsTracker     stats.QPSTrackerGroup
 		adaptiveScaler AdaptiveScaler
+		backpressure   *backpressure
+		pollerLatency  *pollerLatency
 
 		partitionConfigLock sync.RWMutex
 		partitionConfig     *types.TaskListPartitionConfig
@@ -202,6 +205,8 @@ func NewManager(
 			backoff.WithRetryableError(persistence.IsTransientError),
 		),
 		historyService: historyService,
+		backpressure:   newBackpressure(timeSource, taskListConfig.ActivityBackpressureHalfLife),
+		pollerLatency:  newPollerLatency(timeSource),
 	}
 
 	tlMgr.pollerHistory = poller.NewPollerHistory(func() {
@@ -522,6 +527,9 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params AddTaskParams)
 			c.qpsTracker.ReportCounter(1)
 		}
 		c.scope.UpdateGauge(metrics.EstimatedAddTaskQPSGauge, c.qpsTracker.QPS())
+		if err := c.waitActivityDispatchJitter(ctx); err != nil {
+			return false, err
+		}
 	}
 	var syncMatch bool
 	e := event.E{
@@ -588,6 +596,28 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params AddTaskParams)
 	return syncMatch, err
 }
 
+// waitActivityDispatchJitter delays the dispatch of an activity task by a random duration below ActivityDispatchJitter,
+// so that a burst of activities scheduled together reaches the pollers spread out instead of all at once. The delay
+// is part of the time the task waits for a poller, it doesn't otherwise change the ScheduleToStart timeout.
+func (c *taskListManagerImpl) waitActivityDispatchJitter(ctx context.Context) error {
+	if c.taskListID.GetType() != persistence.TaskListTypeActivity {
+		return nil
+	}
+	jitter := c.config.ActivityDispatchJitter()
+	if jitter <= 0 {
+		return nil
+	}
+
+	timer := c.timeSource.NewTimer(backoff.JitDuration(jitter/2, 1))
+	defer timer.Stop()
+	select {
+	case <-timer.Chan():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // DispatchTask dispatches a task to a poller on the active side. When there are no pollers to pick
 // up the task or if the rate limit is exceeded, this method will return error. Task
 // *will not* be persisted to db. On the passive side, dispatches the task to the taskCompleter; it will attempt
@@ -695,16 +725,66 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	// one rateLimiter for this entire task list and as we get polls,
 	// we update the ratelimiter rps if it has changed from the last
 	// value. Last poller wins if different pollers provide different values
-	c.matcher.UpdateRatelimit(maxDispatchPerSecond)
+	c.matcher.UpdateRatelimit(c.applyBackpressure(maxDispatchPerSecond))
 
 	if _, err := domainEntry.IsActiveIn(c.clusterMetadata.GetCurrentClusterName()); err != nil {
 		return c.matcher.PollForQuery(childCtx)
 	}
 
+	latencyAware := identity != "" && c.isLatencyAwarePollerSelectionEnabled()
+	if latencyAware {
+		holdBack, done := c.pollerLatency.startPoll(identity)
+		defer done()
+		if holdBack {
+			// let the faster pollers waiting for tasks match them first
+			select {
+			case <-c.timeSource.After(c.config.LatencyAwarePollerHeadStart()):
+			case <-childCtx.Done():
+				return nil, ErrNoTasks
+			}
+		}
+	}
+
+	pollIsolationGroup := ""
 	if c.isIsolationMatcherEnabled() {
-		return c.matcher.Poll(childCtx, isolationGroup)
+		pollIsolationGroup = isolationGroup
+	}
+	task, err := c.matcher.Poll(childCtx, pollIsolationGroup)
+	if err == nil && latencyAware {
+		c.pollerLatency.recordDispatch(identity)
+	}
+	return task, err
+}
+
+// RecordBackpressureHint aggregates a backpressure hint reported by a worker of an activity task list
+func (c *taskListManagerImpl) RecordBackpressureHint(hint float64) {
+	if !c.isBackpressureEnabled() {
+		return
 	}
-	return c.matcher.Poll(childCtx, "")
+	c.backpressure.record(hint)
+}
+
+// applyBackpressure scales the dispatch rate requested by the poller down by the saturation workers report
+func (c *taskListManagerImpl) applyBackpressure(maxDispatchPerSecond *float64) *float64 {
+	if !c.isBackpressureEnabled() {
+		return maxDispatchPerSecond
+	}
+	return c.backpressure.adjust(maxDispatchPerSecond, c.config.TaskDispatchRPS, c.config.ActivityBackpressureMinDispatchRatio())
+}
+
+func (c *taskListManagerImpl) backpressureRate() float64 {
+	if !c.isBackpressureEnabled() {
+		return 0
+	}
+	return c.backpressure.rate(c.config.TaskDispatchRPS, c.config.ActivityBackpressureMinDispatchRatio())
+}
+
+func (c *taskListManagerImpl) isLatencyAwarePollerSelectionEnabled() bool {
+	return c.pollerLatency != nil && c.taskListID.GetType() == persistence.TaskListTypeActivity && c.config.EnableLatencyAwarePollerSelection()
+}
+
+func (c *taskListManagerImpl) isBackpressureEnabled() bool {
+	return c.backpressure != nil && c.taskListID.GetType() == persistence.TaskListTypeActivity && c.config.EnableActivityBackpressure()
 }
 
 // GetAllPollerInfo returns all pollers that polled from this tasklist in last few minutes
@@ -733,6 +813,12 @@ func (c *taskListManagerImpl) CancelPoller(pollerID string) {
 // (readLevel, ackLevel, backlogCountHint and taskIDBlock).
 func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *types.DescribeTaskListResponse {
 	response := &types.DescribeTaskListResponse{Pollers: c.GetAllPollerInfo()}
+	if c.isLatencyAwarePollerSelectionEnabled() {
+		estimates := c.pollerLatency.estimates()
+		for _, pollerInfo := range response.Pollers {
+			pollerInfo.ActivityLatencyEstimateInMs = estimates[pollerInfo.GetIdentity()].Milliseconds()
+		}
+	}
 	response.PartitionConfig = c.TaskListPartitionConfig()
 	if !includeTaskListStatus {
 		return response
@@ -757,13 +843,81 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 			StartID: idBlock.start,
 			EndID:   idBlock.end,
 		},
-		IsolationGroupMetrics: isolationGroupMetrics,
-		NewTasksPerSecond:     c.qpsTracker.QPS(),
+		IsolationGroupMetrics:     isolationGroupMetrics,
+		NewTasksPerSecond:         c.qpsTracker.QPS(),
+		BackpressureRatePerSecond: c.backpressureRate(),
 	}
 
 	return response
 }
 
+// RemoveTask drops a task from the backlog without dispatching it. The task is deleted from persistence,
+// and dropped by the task reader in case it was already read.
+func (c *taskListManagerImpl) RemoveTask(ctx context.Context, request *types.MatchingRemoveTaskListTaskRequest) (*persistence.TaskInfo, error) {
+	task, err := c.findBacklogTask(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if task == nil {
+		return nil, &types.EntityNotExistsError{Message: "Task not found in the task list backlog."}
+	}
+	if err := c.db.CompleteTask(task.TaskID); err != nil {
+		return nil, err
+	}
+	c.taskReader.markRemovedTask(task.TaskID)
+
+	c.logger.Warn("Removed task from the task list backlog",
+		tag.ActorID(request.Operator),
+		tag.Value(request.Reason),
+		tag.TaskID(task.TaskID),
+		tag.WorkflowID(task.WorkflowID),
+		tag.WorkflowRunID(task.RunID),
+		tag.WorkflowScheduleID(task.ScheduleID),
+	)
+	return task, nil
+}
+
+// findBacklogTask scans the backlog which is not acked yet for the task of the request
+func (c *taskListManagerImpl) findBacklogTask(ctx context.Context, request *types.MatchingRemoveTaskListTaskRequest) (*persistence.TaskInfo, error) {
+	readLevel := c.taskAckManager.GetAckLevel()
+	maxReadLevel := c.taskWriter.GetMaxReadLevel()
+	if taskID := request.GetTaskID(); taskID > 0 {
+		if taskID <= readLevel || taskID > maxReadLevel {
+			return nil, nil
+		}
+		readLevel, maxReadLevel = taskID-1, taskID
+	}
+
+	for readLevel < maxReadLevel {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		resp, err := c.db.GetTasks(readLevel, maxReadLevel, c.config.GetTasksBatchSize())
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.Tasks) == 0 {
+			return nil, nil
+		}
+		for _, task := range resp.Tasks {
+			if isRemovedTask(task, request) {
+				return task, nil
+			}
+		}
+		readLevel = resp.Tasks[len(resp.Tasks)-1].TaskID
+	}
+	return nil, nil
+}
+
+func isRemovedTask(task *persistence.TaskInfo, request *types.MatchingRemoveTaskListTaskRequest) bool {
+	if request.GetTaskID() > 0 {
+		return task.TaskID == request.GetTaskID()
+	}
+	return task.WorkflowID == request.GetWorkflowExecution().GetWorkflowID() &&
+		task.RunID == request.GetWorkflowExecution().GetRunID() &&
+		task.ScheduleID == request.GetScheduleID()
+}
+
 func (c *taskListManagerImpl) String() string {
 	buf := new(bytes.Buffer)
 	if c.taskListID.GetType() == persistence.TaskListTypeActivity {
@@ -829,6 +983,8 @@ func (c *taskListManagerImpl) executeWithRetry(
 
 func (c *taskListManagerImpl) trySyncMatch(ctx context.Context, params AddTaskParams, isolationGroup string) (bool, error) {
 	task := newInternalTask(params.TaskInfo, nil, params.Source, params.ForwardedFrom, true, params.ActivityTaskDispatchInfo, isolationGroup)
+	// a task isolated to a group is already kept in the cluster of that group
+	task.regionLocal = isolationGroup == "" && c.getRegionAffinityDuration(params.TaskInfo) != noIsolationTimeout
 	childCtx := ctx
 	cancel := func() {}
 	waitTime := maxSyncMatchWaitTime
@@ -932,6 +1088,19 @@ func (c *taskListManagerImpl) getIsolationGroupForTask(ctx context.Context, task
 	return defaultTaskBufferIsolationGroup, noIsolationTimeout, nil
 }
 
+// getRegionAffinityDuration returns for how much longer a task scheduled with RegionAffinity is only dispatched to
+// pollers of the current cluster, or noIsolationTimeout once pollers forwarded from other clusters can pick it up
+func (c *taskListManagerImpl) getRegionAffinityDuration(taskInfo *persistence.TaskInfo) time.Duration {
+	if _, ok := taskInfo.PartitionConfig[partition.RegionAffinityKey]; !ok || c.taskListKind == types.TaskListKindSticky {
+		return noIsolationTimeout
+	}
+	taskLatency := c.timeSource.Now().Sub(taskInfo.CreatedTime)
+	if remaining := c.config.RegionAffinityFallbackTimeout() - taskLatency; remaining >= minimumIsolationDuration {
+		return remaining
+	}
+	return noIsolationTimeout
+}
+
 func (c *taskListManagerImpl) getPollerIsolationGroups() []string {
 	groupSet := c.getRecentPollersByIsolationGroup()
 	result := maps.Keys(groupSet)
@@ -1084,6 +1253,27 @@ func newTaskListConfig(id *Identifier, cfg *config.Config, domainName string) *c
 		LocalTaskWaitTime: func() time.Duration {
 			return cfg.LocalTaskWaitTime(domainName, taskListName, taskType)
 		},
+		ActivityDispatchJitter: func() time.Duration {
+			return cfg.ActivityDispatchJitter(domainName, taskListName, taskType)
+		},
+		RegionAffinityFallbackTimeout: func() time.Duration {
+			return cfg.RegionAffinityFallbackTimeout(domainName, taskListName, taskType)
+		},
+		EnableActivityBackpressure: func() bool {
+			return cfg.EnableActivityBackpressure(domainName, taskListName, taskType)
+		},
+		ActivityBackpressureMinDispatchRatio: func() float64 {
+			return cfg.ActivityBackpressureMinDispatchRatio(domainName, taskListName, taskType)
+		},
+		ActivityBackpressureHalfLife: func() time.Duration {
+			return cfg.ActivityBackpressureHalfLife(domainName, taskListName, taskType)
+		},
+		EnableLatencyAwarePollerSelection: func() bool {
+			return cfg.EnableLatencyAwarePollerSelection(domainName, taskListName, taskType)
+		},
+		LatencyAwarePollerHeadStart: func() time.Duration {
+			return cfg.LatencyAwarePollerHeadStart(domainName, taskListName, taskType)
+		},
 		PartitionUpscaleRPS: func() int {
 			return cfg.PartitionUpscaleRPS(domainName, taskListName, taskType)
 		},
@@ -1173,3 +1363,15 @@ func IsolationGroupFromContext(ctx context.Context) string {
 func ContextWithIsolationGroup(ctx context.Context, isolationGroup string) context.Context {
 	return context.WithValue(ctx, isolationGroupCtxKey{}, isolationGroup)
 }
+
+func SourceClusterFromContext(ctx context.Context) string {
+	val, ok := ctx.Value(sourceClusterCtxKey{}).(string)
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+func ContextWithSourceCluster(ctx context.Context, sourceCluster string) context.Context {
+	return context.WithValue(ctx, sourceClusterCtxKey{}, sourceCluster)
+}
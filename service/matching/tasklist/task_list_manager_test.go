@@ -514,6 +514,90 @@ func TestAddTaskStandby(t *testing.T) {
 	require.False(t, syncMatch)
 }
 
+func TestWaitActivityDispatchJitter(t *testing.T) {
+	controller := gomock.NewController(t)
+	logger := testlogger.New(t)
+	timeSource := clock.NewMockedTimeSource()
+
+	cfg := defaultTestConfig()
+	tlm := createTestTaskListManagerWithConfig(t, logger, controller, cfg, timeSource)
+	require.NoError(t, tlm.waitActivityDispatchJitter(context.Background()))
+
+	cfg.ActivityDispatchJitter = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(100 * time.Millisecond)
+	tlm = createTestTaskListManagerWithConfig(t, logger, controller, cfg, timeSource)
+	errC := make(chan error, 1)
+	go func() {
+		errC <- tlm.waitActivityDispatchJitter(context.Background())
+	}()
+	timeSource.BlockUntil(1)
+	timeSource.Advance(100 * time.Millisecond)
+	require.NoError(t, <-errC)
+}
+
+func TestGetRegionAffinityDuration(t *testing.T) {
+	controller := gomock.NewController(t)
+	logger := testlogger.New(t)
+	timeSource := clock.NewMockedTimeSource()
+
+	cfg := defaultTestConfig()
+	cfg.RegionAffinityFallbackTimeout = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(time.Second)
+	tlm := createTestTaskListManagerWithConfig(t, logger, controller, cfg, timeSource)
+
+	taskInfo := &persistence.TaskInfo{CreatedTime: timeSource.Now()}
+	require.Equal(t, noIsolationTimeout, tlm.getRegionAffinityDuration(taskInfo))
+
+	taskInfo.PartitionConfig = map[string]string{partition.RegionAffinityKey: "true"}
+	timeSource.Advance(400 * time.Millisecond)
+	require.Equal(t, 600*time.Millisecond, tlm.getRegionAffinityDuration(taskInfo))
+
+	timeSource.Advance(time.Second)
+	require.Equal(t, noIsolationTimeout, tlm.getRegionAffinityDuration(taskInfo))
+}
+
+func TestRemoveTask(t *testing.T) {
+	controller := gomock.NewController(t)
+	logger := testlogger.New(t)
+	tlm := createTestTaskListManager(t, logger, controller)
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+
+	for scheduleID := int64(1); scheduleID <= 3; scheduleID++ {
+		_, err := tlm.AddTask(context.Background(), AddTaskParams{
+			TaskInfo: &persistence.TaskInfo{
+				DomainID:                      "domain",
+				WorkflowID:                    "workflow1",
+				RunID:                         "run1",
+				ScheduleID:                    scheduleID,
+				ScheduleToStartTimeoutSeconds: 100,
+			},
+		})
+		require.NoError(t, err)
+	}
+	tm := tlm.db.store.(*TestTaskManager)
+	require.Equal(t, 3, tm.GetTaskCount(tlm.taskListID))
+
+	_, err := tlm.RemoveTask(context.Background(), &types.MatchingRemoveTaskListTaskRequest{
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "workflow1", RunID: "run1"},
+		ScheduleID:        4,
+	})
+	var notExistsErr *types.EntityNotExistsError
+	assert.ErrorAs(t, err, &notExistsErr)
+
+	task, err := tlm.RemoveTask(context.Background(), &types.MatchingRemoveTaskListTaskRequest{
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "workflow1", RunID: "run1"},
+		ScheduleID:        3,
+		Operator:          "operator",
+		Reason:            "poison task",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), task.ScheduleID)
+	assert.Equal(t, 2, tm.GetTaskCount(tlm.taskListID))
+	assert.True(t, tlm.taskReader.takeRemovedTask(task.TaskID))
+
+	_, err = tlm.RemoveTask(context.Background(), &types.MatchingRemoveTaskListTaskRequest{TaskID: task.TaskID})
+	assert.ErrorAs(t, err, &notExistsErr)
+}
+
 func TestGetPollerIsolationGroup(t *testing.T) {
 	controller := gomock.NewController(t)
 	logger := testlogger.New(t)
@@ -26,6 +26,7 @@ import (
 	"context"
 	"errors"
 	"runtime"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -38,6 +39,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/partition"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/matching/config"
@@ -86,17 +88,30 @@ type (
 		getIsolationGroupForTask func(context.Context, *persistence.TaskInfo) (string, time.Duration, error)
 		ratePerSecond            func() float64
 
+		// priorityTaskBuffers are the same per isolation group queues for the tasks which have a priority,
+		// they are drained ahead of taskBuffers. As a task can only jump the tasks which were read into
+		// the buffers with it, priorities only reorder the tasks within the read-ahead of the backlog,
+		// and tasks of different priorities keep the order they were read in
+		priorityTaskBuffers map[string]chan *persistence.TaskInfo
+
 		// stopWg is used to wait for all dispatchers to stop.
 		stopWg sync.WaitGroup
+
+		// removedTasks are tasks removed from the backlog which are dropped instead of dispatched,
+		// in case they were read before they were removed. Entries of tasks which were never read
+		// are pruned once the ack level moves past them
+		removedTasksLock sync.Mutex
+		removedTasks     map[int64]struct{}
 	}
 )
 
 func newTaskReader(tlMgr *taskListManagerImpl, isolationGroups []string) *taskReader {
 	ctx, cancel := context.WithCancel(context.Background())
 	taskBuffers := make(map[string]chan *persistence.TaskInfo)
-	taskBuffers[defaultTaskBufferIsolationGroup] = make(chan *persistence.TaskInfo, tlMgr.config.GetTasksBatchSize()-1)
-	for _, g := range isolationGroups {
+	priorityTaskBuffers := make(map[string]chan *persistence.TaskInfo)
+	for _, g := range append([]string{defaultTaskBufferIsolationGroup}, isolationGroups...) {
 		taskBuffers[g] = make(chan *persistence.TaskInfo, tlMgr.config.GetTasksBatchSize()-1)
+		priorityTaskBuffers[g] = make(chan *persistence.TaskInfo, tlMgr.config.GetTasksBatchSize()-1)
 	}
 	return &taskReader{
 		tlMgr:          tlMgr,
@@ -112,6 +127,7 @@ func newTaskReader(tlMgr *taskListManagerImpl, isolationGroups []string) *taskRe
 		// we always dequeue the head of the buffer and try to dispatch it to a poller
 		// so allocate one less than desired target buffer size
 		taskBuffers:              taskBuffers,
+		priorityTaskBuffers:      priorityTaskBuffers,
 		domainCache:              tlMgr.domainCache,
 		clusterMetadata:          tlMgr.clusterMetadata,
 		timeSource:               tlMgr.timeSource,
@@ -170,29 +186,75 @@ func (tr *taskReader) Signal() {
 func (tr *taskReader) dispatchBufferedTasks(isolationGroup string) {
 dispatchLoop:
 	for {
+		var taskInfo *persistence.TaskInfo
+		var ok bool
+		// a task with a priority is taken first if one is buffered, otherwise whichever task comes first
 		select {
-		case taskInfo, ok := <-tr.taskBuffers[isolationGroup]:
-			if !ok { // Task list getTasks pump is shutdown
-				break dispatchLoop
-			}
-			event.Log(event.E{
-				TaskListName: tr.taskListID.GetName(),
-				TaskListType: tr.taskListID.GetType(),
-				TaskListKind: &tr.tlMgr.taskListKind,
-				TaskInfo:     *taskInfo,
-				EventName:    "Attempting to Dispatch Buffered Task",
-			})
-			breakDispatchLoop := tr.dispatchSingleTaskFromBufferWithRetries(taskInfo)
-			if breakDispatchLoop {
-				// shutting down
+		case taskInfo, ok = <-tr.priorityTaskBuffers[isolationGroup]:
+		default:
+			select {
+			case taskInfo, ok = <-tr.priorityTaskBuffers[isolationGroup]:
+			case taskInfo, ok = <-tr.taskBuffers[isolationGroup]:
+			case <-tr.cancelCtx.Done():
 				break dispatchLoop
 			}
-		case <-tr.cancelCtx.Done():
+		}
+		if !ok { // Task list getTasks pump is shutdown
+			break dispatchLoop
+		}
+		event.Log(event.E{
+			TaskListName: tr.taskListID.GetName(),
+			TaskListType: tr.taskListID.GetType(),
+			TaskListKind: &tr.tlMgr.taskListKind,
+			TaskInfo:     *taskInfo,
+			EventName:    "Attempting to Dispatch Buffered Task",
+		})
+		if tr.takeRemovedTask(taskInfo.TaskID) {
+			tr.completeTask(taskInfo, nil)
+			continue
+		}
+		if err := tr.tlMgr.waitActivityDispatchJitter(tr.cancelCtx); err != nil {
+			// shutting down
+			break dispatchLoop
+		}
+		breakDispatchLoop := tr.dispatchSingleTaskFromBufferWithRetries(taskInfo)
+		if breakDispatchLoop {
+			// shutting down
 			break dispatchLoop
 		}
 	}
 }
 
+// markRemovedTask makes the reader drop the task if it comes across it
+func (tr *taskReader) markRemovedTask(taskID int64) {
+	tr.removedTasksLock.Lock()
+	defer tr.removedTasksLock.Unlock()
+	if tr.removedTasks == nil {
+		tr.removedTasks = make(map[int64]struct{})
+	}
+	tr.removedTasks[taskID] = struct{}{}
+}
+
+func (tr *taskReader) takeRemovedTask(taskID int64) bool {
+	tr.removedTasksLock.Lock()
+	defer tr.removedTasksLock.Unlock()
+	_, ok := tr.removedTasks[taskID]
+	delete(tr.removedTasks, taskID)
+	return ok
+}
+
+// pruneRemovedTasks forgets the removed tasks up to the ack level, they were either dropped already
+// or deleted before the reader got to them
+func (tr *taskReader) pruneRemovedTasks(ackLevel int64) {
+	tr.removedTasksLock.Lock()
+	defer tr.removedTasksLock.Unlock()
+	for taskID := range tr.removedTasks {
+		if taskID <= ackLevel {
+			delete(tr.removedTasks, taskID)
+		}
+	}
+}
+
 func (tr *taskReader) getTasksPump() {
 	updateAckTimer := tr.timeSource.NewTimer(tr.config.UpdateAckInterval())
 	defer updateAckTimer.Stop()
@@ -241,6 +303,7 @@ getTasksPumpLoop:
 				if size, err := tr.db.GetTaskListSize(ackLevel); err == nil {
 					tr.scope.UpdateGauge(metrics.TaskCountPerTaskListGauge, float64(size))
 				}
+				tr.pruneRemovedTasks(ackLevel)
 				if err := tr.handleErr(tr.persistAckLevel()); err != nil {
 					tr.logger.Error("Persistent store operation failure",
 						tag.StoreOperationUpdateTaskList,
@@ -302,6 +365,15 @@ func (tr *taskReader) isTaskExpired(t *persistence.TaskInfo) bool {
 	return !t.Expiry.IsZero() && t.Expiry.After(epochStartTime) && tr.timeSource.Now().After(t.Expiry)
 }
 
+// getTaskPriority returns the priority of an activity task, 0 if it has none
+func getTaskPriority(t *persistence.TaskInfo) int {
+	priority, err := strconv.Atoi(t.PartitionConfig[partition.PriorityKey])
+	if err != nil {
+		return 0
+	}
+	return priority
+}
+
 func (tr *taskReader) addTasksToBuffer(tasks []*persistence.TaskInfo) bool {
 	for _, t := range tasks {
 		if !tr.addSingleTaskToBuffer(t) {
@@ -338,8 +410,12 @@ func (tr *taskReader) addSingleTaskToBuffer(task *persistence.TaskInfo) bool {
 		}
 		return true
 	}
+	taskBuffers := tr.taskBuffers
+	if getTaskPriority(task) > 0 {
+		taskBuffers = tr.priorityTaskBuffers
+	}
 	select {
-	case tr.taskBuffers[isolationGroup] <- task:
+	case taskBuffers[isolationGroup] <- task:
 		return true
 	case <-tr.cancelCtx.Done():
 		return false
@@ -393,7 +469,7 @@ func (tr *taskReader) completeTask(task *persistence.TaskInfo, err error) {
 
 func (tr *taskReader) newDispatchContext(isolationGroup string, isolationDuration time.Duration) (context.Context, context.CancelFunc) {
 	rps := tr.ratePerSecond()
-	if isolationGroup != "" || rps > 1e-7 { // 1e-7 is a random number chosen to avoid overflow, normally user don't set such a low rps
+	if isolationGroup != "" || isolationDuration != noIsolationTimeout || rps > 1e-7 { // 1e-7 is a random number chosen to avoid overflow, normally user don't set such a low rps
 		timeout := tr.getDispatchTimeout(rps, isolationDuration)
 		domainEntry, err := tr.domainCache.GetDomainByID(tr.taskListID.GetDomainID())
 		if err != nil {
@@ -449,6 +525,14 @@ func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInf
 		isolationDuration = noIsolationTimeout
 	}
 	task := newInternalTask(taskInfo, tr.completeTask, types.TaskSourceDbBacklog, "", false, nil, isolationGroup)
+	if isolationGroup == defaultTaskBufferIsolationGroup {
+		// the task is kept for pollers of the current cluster the same way it is kept for an isolation group,
+		// once the dispatch times out it is retried without the region affinity if the fallback timeout passed
+		if regionAffinityDuration := tr.tlMgr.getRegionAffinityDuration(taskInfo); regionAffinityDuration != noIsolationTimeout {
+			task.regionLocal = true
+			isolationDuration = regionAffinityDuration
+		}
+	}
 	dispatchCtx, cancel := tr.newDispatchContext(isolationGroup, isolationDuration)
 	timerScope := tr.scope.StartTimer(metrics.AsyncMatchLatencyPerTaskList)
 	err = tr.dispatchTask(dispatchCtx, task)
@@ -491,6 +575,9 @@ func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInf
 		e.EventName = "Dispatch Timed Out"
 		event.Log(e)
 		tr.scope.IncCounter(metrics.AsyncMatchDispatchTimeoutCounterPerTaskList)
+		if task.regionLocal {
+			tr.scope.IncCounter(metrics.RegionAffinityDispatchTimeoutPerTaskListCounter)
+		}
 		return false, false
 	}
 
@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/partition"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/service/matching/config"
 )
@@ -334,3 +335,70 @@ func requireCallbackInvocation(t *testing.T, msg string) func() {
 		called = true
 	}
 }
+
+func TestPruneRemovedTasks(t *testing.T) {
+	tr := &taskReader{}
+	tr.markRemovedTask(1)
+	tr.markRemovedTask(3)
+	tr.markRemovedTask(5)
+
+	tr.pruneRemovedTasks(3)
+
+	assert.False(t, tr.takeRemovedTask(1))
+	assert.False(t, tr.takeRemovedTask(3))
+	assert.True(t, tr.takeRemovedTask(5))
+	assert.Empty(t, tr.removedTasks)
+}
+
+func TestAddSingleTaskToBufferByPriority(t *testing.T) {
+	controller := gomock.NewController(t)
+	timeSource := clock.NewMockedTimeSource()
+	tlm := createTestTaskListManagerWithConfig(t, testlogger.New(t), controller, defaultConfig(), timeSource)
+	reader := tlm.taskReader
+	reader.getIsolationGroupForTask = func(ctx context.Context, info *persistence.TaskInfo) (string, time.Duration, error) {
+		return defaultTaskBufferIsolationGroup, -1, nil
+	}
+
+	assert.True(t, reader.addSingleTaskToBuffer(&persistence.TaskInfo{TaskID: 10}))
+	assert.True(t, reader.addSingleTaskToBuffer(&persistence.TaskInfo{
+		TaskID:          11,
+		PartitionConfig: map[string]string{partition.PriorityKey: "5"},
+	}))
+
+	assert.Equal(t, int64(10), (<-reader.taskBuffers[defaultTaskBufferIsolationGroup]).TaskID)
+	assert.Equal(t, int64(11), (<-reader.priorityTaskBuffers[defaultTaskBufferIsolationGroup]).TaskID)
+}
+
+func TestDispatchBufferedTasksByPriority(t *testing.T) {
+	controller := gomock.NewController(t)
+	timeSource := clock.NewMockedTimeSource()
+	tlm := createTestTaskListManagerWithConfig(t, testlogger.New(t), controller, defaultConfig(), timeSource)
+	reader := tlm.taskReader
+	reader.getIsolationGroupForTask = func(ctx context.Context, info *persistence.TaskInfo) (string, time.Duration, error) {
+		return defaultTaskBufferIsolationGroup, -1, nil
+	}
+	var dispatched []int64
+	reader.dispatchTask = func(ctx context.Context, task *InternalTask) error {
+		dispatched = append(dispatched, task.Event.TaskID)
+		if len(dispatched) == 3 {
+			reader.cancelFunc()
+		}
+		return nil
+	}
+
+	reader.taskBuffers[defaultTaskBufferIsolationGroup] <- &persistence.TaskInfo{TaskID: 1}
+	reader.taskBuffers[defaultTaskBufferIsolationGroup] <- &persistence.TaskInfo{TaskID: 2}
+	reader.priorityTaskBuffers[defaultTaskBufferIsolationGroup] <- &persistence.TaskInfo{
+		TaskID:          3,
+		PartitionConfig: map[string]string{partition.PriorityKey: "5"},
+	}
+	reader.dispatchBufferedTasks(defaultTaskBufferIsolationGroup)
+
+	assert.Equal(t, []int64{3, 1, 2}, dispatched)
+}
+
+func TestGetTaskPriority(t *testing.T) {
+	assert.Equal(t, 0, getTaskPriority(&persistence.TaskInfo{}))
+	assert.Equal(t, 0, getTaskPriority(&persistence.TaskInfo{PartitionConfig: map[string]string{partition.PriorityKey: "x"}}))
+	assert.Equal(t, 5, getTaskPriority(&persistence.TaskInfo{PartitionConfig: map[string]string{partition.PriorityKey: "5"}}))
+}
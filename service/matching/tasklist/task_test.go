@@ -128,6 +128,22 @@ func TestNewInternalTask(t *testing.T) {
 				partition.WorkflowIDKey:             "workflowID",
 			},
 		},
+		{
+			name:           "tasklist isolation - priority",
+			source:         types.TaskSourceDbBacklog,
+			isolationGroup: "b",
+			partitionConfig: map[string]string{
+				partition.IsolationGroupKey: "a",
+				partition.WorkflowIDKey:     "workflowID",
+				partition.PriorityKey:       "5",
+			},
+			expectedPartitionConfig: map[string]string{
+				partition.OriginalIsolationGroupKey: "a",
+				partition.IsolationGroupKey:         "b",
+				partition.WorkflowIDKey:             "workflowID",
+				partition.PriorityKey:               "5",
+			},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -87,11 +87,21 @@ func (g GRPCHandler) QueryWorkflow(ctx context.Context, request *matchingv1.Quer
 	return proto.FromMatchingQueryWorkflowResponse(response), proto.FromError(err)
 }
 
+func (g GRPCHandler) RecordBackpressureHint(ctx context.Context, request *matchingv1.RecordBackpressureHintRequest) (*matchingv1.RecordBackpressureHintResponse, error) {
+	err := g.h.RecordBackpressureHint(ctx, proto.ToMatchingRecordBackpressureHintRequest(request))
+	return &matchingv1.RecordBackpressureHintResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) RefreshTaskListPartitionConfig(ctx context.Context, request *matchingv1.RefreshTaskListPartitionConfigRequest) (*matchingv1.RefreshTaskListPartitionConfigResponse, error) {
 	response, err := g.h.RefreshTaskListPartitionConfig(ctx, proto.ToMatchingRefreshTaskListPartitionConfigRequest(request))
 	return proto.FromMatchingRefreshTaskListPartitionConfigResponse(response), proto.FromError(err)
 }
 
+func (g GRPCHandler) RemoveTaskListTask(ctx context.Context, request *matchingv1.RemoveTaskListTaskRequest) (*matchingv1.RemoveTaskListTaskResponse, error) {
+	response, err := g.h.RemoveTaskListTask(ctx, proto.ToMatchingRemoveTaskListTaskRequest(request))
+	return proto.FromMatchingRemoveTaskListTaskResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) RespondQueryTaskCompleted(ctx context.Context, request *matchingv1.RespondQueryTaskCompletedRequest) (*matchingv1.RespondQueryTaskCompletedResponse, error) {
 	err := g.h.RespondQueryTaskCompleted(ctx, proto.ToMatchingRespondQueryTaskCompletedRequest(request))
 	return &matchingv1.RespondQueryTaskCompletedResponse{}, proto.FromError(err)
@@ -79,6 +79,11 @@ func (g ThriftHandler) QueryWorkflow(ctx context.Context, QueryRequest *matching
 	return thrift.FromMatchingQueryWorkflowResponse(response), thrift.FromError(err)
 }
 
+func (g ThriftHandler) RecordBackpressureHint(ctx context.Context, Request *matching.RecordBackpressureHintRequest) (err error) {
+	err = g.h.RecordBackpressureHint(ctx, thrift.ToMatchingRecordBackpressureHintRequest(Request))
+	return thrift.FromError(err)
+}
+
 func (g ThriftHandler) RespondQueryTaskCompleted(ctx context.Context, Request *matching.RespondQueryTaskCompletedRequest) (err error) {
 	err = g.h.RespondQueryTaskCompleted(ctx, thrift.ToMatchingRespondQueryTaskCompletedRequest(Request))
 	return thrift.FromError(err)
@@ -55,6 +55,7 @@ func setuptest(t *testing.T) (*Batcher, *resource.Test) {
 	mockResource.SDKClient.EXPECT().PollForActivityTask(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&shared.PollForActivityTaskResponse{}, nil).AnyTimes()
 	sdkClient := mockResource.GetSDKClient()
 	mockClientBean.EXPECT().GetFrontendClient().Return(mockResource.FrontendClient).AnyTimes()
+	mockClientBean.EXPECT().GetHistoryClient().Return(mockResource.HistoryClient).AnyTimes()
 	mockClientBean.EXPECT().GetRemoteAdminClient(gomock.Any()).Return(mockResource.RemoteAdminClient).AnyTimes()
 
 	return New(&BootstrapParams{
@@ -33,7 +33,9 @@ import (
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/batch"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -46,81 +48,40 @@ type (
 
 const (
 	batcherContextKey contextKey = "batcherContext"
-	// BatcherTaskListName is the tasklist name
-	BatcherTaskListName = "cadence-sys-batcher-tasklist"
-	// BatchWFTypeName is the workflow type
-	BatchWFTypeName   = "cadence-sys-batch-workflow"
-	batchActivityName = "cadence-sys-batch-activity"
-	// InfiniteDuration is a long duration(20 yrs) we used for infinite workflow running
-	InfiniteDuration = 20 * 365 * 24 * time.Hour
-
-	_nonRetriableReason = "non-retriable-error"
-
-	// DefaultRPS is the default RPS
-	DefaultRPS = 50
-	// DefaultConcurrency is the default concurrency
-	DefaultConcurrency = 5
-	// DefaultPageSize is the default page size
-	DefaultPageSize = 1000
-	// DefaultAttemptsOnRetryableError is the default value for AttemptsOnRetryableError
-	DefaultAttemptsOnRetryableError = 50
-	// DefaultActivityHeartBeatTimeout is the default value for ActivityHeartBeatTimeout
-	DefaultActivityHeartBeatTimeout = time.Second * 10
-	// DefaultMaxActivityRetries is the default value for MaxActivityRetries
-	DefaultMaxActivityRetries = 4
+	batchActivityName            = "cadence-sys-batch-activity"
+	// drainPollInterval is the interval between two checks of the pending activities of a draining workflow
+	drainPollInterval = 5 * time.Second
 )
 
-const (
-	// BatchTypeTerminate is batch type for terminating workflows
-	BatchTypeTerminate = "terminate"
-	// BatchTypeCancel is the batch type for canceling workflows
-	BatchTypeCancel = "cancel"
-	// BatchTypeSignal is batch type for signaling workflows
-	BatchTypeSignal = "signal"
-	// BatchTypeReplicate is batch type for replicating workflows
-	BatchTypeReplicate = "replicate"
-)
-
-// AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate}
-
 var (
-	BatchActivityRetryPolicy = cadence.RetryPolicy{
-		InitialInterval:          10 * time.Second,
-		BackoffCoefficient:       1.7,
-		MaximumInterval:          5 * time.Minute,
-		ExpirationInterval:       InfiniteDuration,
-		NonRetriableErrorReasons: []string{_nonRetriableReason},
-	}
-
 	batchActivityOptions = workflow.ActivityOptions{
 		ScheduleToStartTimeout: 5 * time.Minute,
-		StartToCloseTimeout:    InfiniteDuration,
-		RetryPolicy:            &BatchActivityRetryPolicy,
+		StartToCloseTimeout:    batch.InfiniteDuration,
+		RetryPolicy:            &batch.BatchActivityRetryPolicy,
 	}
 )
 
 func init() {
-	workflow.RegisterWithOptions(BatchWorkflow, workflow.RegisterOptions{Name: BatchWFTypeName})
+	workflow.RegisterWithOptions(BatchWorkflow, workflow.RegisterOptions{Name: batch.BatchWFTypeName})
 	activity.RegisterWithOptions(BatchActivity, activity.RegisterOptions{Name: batchActivityName})
 }
 
 // BatchWorkflow is the workflow that runs a batch job of resetting workflows
-func BatchWorkflow(ctx workflow.Context, batchParams BatchParams) (HeartBeatDetails, error) {
+func BatchWorkflow(ctx workflow.Context, batchParams batch.BatchParams) (batch.HeartBeatDetails, error) {
 	batchParams = setDefaultParams(batchParams)
 	err := validateParams(batchParams)
 	if err != nil {
-		return HeartBeatDetails{}, err
+		return batch.HeartBeatDetails{}, err
 	}
 	batchActivityOptions.HeartbeatTimeout = batchParams.ActivityHeartBeatTimeout
 	batchActivityOptions.RetryPolicy.MaximumAttempts = int32(batchParams.MaxActivityRetries)
 	opt := workflow.WithActivityOptions(ctx, batchActivityOptions)
-	var result HeartBeatDetails
+	var result batch.HeartBeatDetails
 	err = workflow.ExecuteActivity(opt, batchActivityName, batchParams).Get(ctx, &result)
 	return result, err
 }
 
-func validateParams(params BatchParams) error {
+func validateParams(params batch.BatchParams) error {
 	if params.BatchType == "" ||
 		params.Reason == "" ||
 		params.DomainName == "" ||
@@ -128,12 +89,12 @@ func validateParams(params BatchParams) error {
 		return fmt.Errorf("must provide required parameters: BatchType/Reason/DomainName/Query")
 	}
 	switch params.BatchType {
-	case BatchTypeSignal:
+	case batch.BatchTypeSignal:
 		if params.SignalParams.SignalName == "" {
 			return fmt.Errorf("must provide signal name")
 		}
 		return nil
-	case BatchTypeReplicate:
+	case batch.BatchTypeReplicate:
 		if params.ReplicateParams.SourceCluster == "" {
 			return fmt.Errorf("must provide source cluster")
 		}
@@ -141,55 +102,57 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide target cluster")
 		}
 		return nil
-	case BatchTypeCancel:
+	case batch.BatchTypeCancel:
+		fallthrough
+	case batch.BatchTypeTerminate:
 		fallthrough
-	case BatchTypeTerminate:
+	case batch.BatchTypeRedriveActivities:
+		fallthrough
+	case batch.BatchTypeTerminateWithDrain:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
 	}
 }
 
-func setDefaultParams(params BatchParams) BatchParams {
+func setDefaultParams(params batch.BatchParams) batch.BatchParams {
 	if params.RPS <= 0 {
-		params.RPS = DefaultRPS
+		params.RPS = batch.DefaultRPS
 	}
 	if params.Concurrency <= 0 {
-		params.Concurrency = DefaultConcurrency
+		params.Concurrency = batch.DefaultConcurrency
 	}
 	if params.PageSize <= 0 {
-		params.PageSize = DefaultPageSize
+		params.PageSize = batch.DefaultPageSize
 	}
 	if params.AttemptsOnRetryableError <= 0 {
-		params.AttemptsOnRetryableError = DefaultAttemptsOnRetryableError
+		params.AttemptsOnRetryableError = batch.DefaultAttemptsOnRetryableError
 	}
 	if params.ActivityHeartBeatTimeout <= 0 {
-		params.ActivityHeartBeatTimeout = DefaultActivityHeartBeatTimeout
-	}
-	if len(params.NonRetryableErrors) > 0 {
-		params._nonRetryableErrors = make(map[string]struct{}, len(params.NonRetryableErrors))
-		for _, estr := range params.NonRetryableErrors {
-			params._nonRetryableErrors[estr] = struct{}{}
-		}
+		params.ActivityHeartBeatTimeout = batch.DefaultActivityHeartBeatTimeout
 	}
 	if params.TerminateParams.TerminateChildren == nil {
 		params.TerminateParams.TerminateChildren = common.BoolPtr(true)
 	}
 	if params.MaxActivityRetries < 0 {
-		params.MaxActivityRetries = DefaultMaxActivityRetries
+		params.MaxActivityRetries = batch.DefaultMaxActivityRetries
+	}
+	if params.DrainParams.DrainTimeout <= 0 {
+		params.DrainParams.DrainTimeout = batch.DefaultDrainTimeout
 	}
 	return params
 }
 
 // BatchActivity is activity for processing batch operation
-func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetails, error) {
+func BatchActivity(ctx context.Context, batchParams batch.BatchParams) (batch.HeartBeatDetails, error) {
 	batcher := ctx.Value(batcherContextKey).(*Batcher)
 	client := batcher.clientBean.GetFrontendClient()
+	historyClient := batcher.clientBean.GetHistoryClient()
 	var adminClient admin.Client
-	if batchParams.BatchType == BatchTypeReplicate {
+	if batchParams.BatchType == batch.BatchTypeReplicate {
 		currentCluster := batcher.cfg.ClusterMetadata.GetCurrentClusterName()
 		if currentCluster != batchParams.ReplicateParams.SourceCluster {
-			return HeartBeatDetails{}, cadence.NewCustomError(_nonRetriableReason, fmt.Sprintf("the activity must run in the source cluster, current cluster is %s", currentCluster))
+			return batch.HeartBeatDetails{}, cadence.NewCustomError(batch.NonRetriableReason, fmt.Sprintf("the activity must run in the source cluster, current cluster is %s", currentCluster))
 		}
 		adminClient = batcher.clientBean.GetRemoteAdminClient(batchParams.ReplicateParams.TargetCluster)
 	}
@@ -198,7 +161,7 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 		Name: &batchParams.DomainName,
 	})
 	if err != nil {
-		return HeartBeatDetails{}, err
+		return batch.HeartBeatDetails{}, err
 	}
 	domainID := domainResp.GetDomainInfo().GetUUID()
 	hbd, ok := getHeartBeatDetails(ctx)
@@ -209,7 +172,7 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 			Query:  batchParams.Query,
 		})
 		if err != nil {
-			return HeartBeatDetails{}, err
+			return batch.HeartBeatDetails{}, err
 		}
 		hbd.TotalEstimate = resp.GetCount()
 	}
@@ -217,7 +180,7 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	taskCh := make(chan taskDetail, batchParams.PageSize)
 	respCh := make(chan error, batchParams.PageSize)
 	for i := 0; i < batchParams.Concurrency; i++ {
-		go startTaskProcessor(ctx, batchParams, domainID, taskCh, respCh, rateLimiter, client, adminClient)
+		go startTaskProcessor(ctx, batchParams, domainID, taskCh, respCh, rateLimiter, client, historyClient, adminClient)
 	}
 
 	for {
@@ -231,7 +194,7 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 			Query:         batchParams.Query,
 		})
 		if err != nil {
-			return HeartBeatDetails{}, err
+			return batch.HeartBeatDetails{}, err
 		}
 		batchCount := len(resp.Executions)
 		if batchCount <= 0 {
@@ -263,7 +226,7 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 					break Loop
 				}
 			case <-ctx.Done():
-				return HeartBeatDetails{}, ctx.Err()
+				return batch.HeartBeatDetails{}, ctx.Err()
 			}
 		}
 
@@ -281,13 +244,13 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	return hbd, nil
 }
 
-func getHeartBeatDetails(ctx context.Context) (hbd HeartBeatDetails, ok bool) {
+func getHeartBeatDetails(ctx context.Context) (hbd batch.HeartBeatDetails, ok bool) {
 	if activity.HasHeartbeatDetails(ctx) {
 		if err := activity.GetHeartbeatDetails(ctx, &hbd); err != nil {
 			batcher := ctx.Value(batcherContextKey).(*Batcher)
 			batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
 			getActivityLogger(ctx).Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
-			return HeartBeatDetails{}, false
+			return batch.HeartBeatDetails{}, false
 		}
 		return hbd, true
 	}
@@ -297,15 +260,20 @@ func getHeartBeatDetails(ctx context.Context) (hbd HeartBeatDetails, ok bool) {
 
 func startTaskProcessor(
 	ctx context.Context,
-	batchParams BatchParams,
+	batchParams batch.BatchParams,
 	domainID string,
 	taskCh chan taskDetail,
 	respCh chan error,
 	limiter *rate.Limiter,
 	client frontend.Client,
+	historyClient history.Client,
 	adminClient admin.Client,
 ) {
 	batcher := ctx.Value(batcherContextKey).(*Batcher)
+	nonRetryableErrors := make(map[string]struct{}, len(batchParams.NonRetryableErrors))
+	for _, estr := range batchParams.NonRetryableErrors {
+		nonRetryableErrors[estr] = struct{}{}
+	}
 	for {
 		select {
 		case <-ctx.Done():
@@ -318,7 +286,7 @@ func startTaskProcessor(
 			requestID := uuid.New().String()
 
 			switch batchParams.BatchType {
-			case BatchTypeTerminate:
+			case batch.BatchTypeTerminate:
 				err = processTask(ctx, limiter, task, batchParams, client,
 					batchParams.TerminateParams.TerminateChildren,
 					func(workflowID, runID string) error {
@@ -329,10 +297,10 @@ func startTaskProcessor(
 								RunID:      runID,
 							},
 							Reason:   batchParams.Reason,
-							Identity: BatchWFTypeName,
+							Identity: batch.BatchWFTypeName,
 						})
 					})
-			case BatchTypeCancel:
+			case batch.BatchTypeCancel:
 				err = processTask(ctx, limiter, task, batchParams, client,
 					batchParams.CancelParams.CancelChildren,
 					func(workflowID, runID string) error {
@@ -342,11 +310,11 @@ func startTaskProcessor(
 								WorkflowID: workflowID,
 								RunID:      runID,
 							},
-							Identity:  BatchWFTypeName,
+							Identity:  batch.BatchWFTypeName,
 							RequestID: requestID,
 						})
 					})
-			case BatchTypeSignal:
+			case batch.BatchTypeSignal:
 				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
 					func(workflowID, runID string) error {
 						return client.SignalWorkflowExecution(ctx, &types.SignalWorkflowExecutionRequest{
@@ -355,13 +323,13 @@ func startTaskProcessor(
 								WorkflowID: workflowID,
 								RunID:      runID,
 							},
-							Identity:   BatchWFTypeName,
+							Identity:   batch.BatchWFTypeName,
 							RequestID:  requestID,
 							SignalName: batchParams.SignalParams.SignalName,
 							Input:      []byte(batchParams.SignalParams.Input),
 						})
 					})
-			case BatchTypeReplicate:
+			case batch.BatchTypeReplicate:
 				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
 					func(workflowID, runID string) error {
 						return adminClient.ResendReplicationTasks(ctx, &types.ResendReplicationTasksRequest{
@@ -371,12 +339,23 @@ func startTaskProcessor(
 							RemoteCluster: batchParams.ReplicateParams.SourceCluster,
 						})
 					})
+			case batch.BatchTypeRedriveActivities:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return redriveTimedOutActivities(ctx, historyClient, domainID, workflowID, runID)
+					})
+			case batch.BatchTypeTerminateWithDrain:
+				err = processTask(ctx, limiter, task, batchParams, client,
+					batchParams.TerminateParams.TerminateChildren,
+					func(workflowID, runID string) error {
+						return drainAndTerminate(ctx, client, historyClient, batchParams, domainID, task.hbd, workflowID, runID)
+					})
 			}
 			if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
 				getActivityLogger(ctx).Error("Failed to process batch operation task", tag.Error(err))
 
-				_, ok := batchParams._nonRetryableErrors[err.Error()]
+				_, ok := nonRetryableErrors[err.Error()]
 				if ok || task.attempts >= batchParams.AttemptsOnRetryableError {
 					respCh <- err
 				} else {
@@ -396,7 +375,7 @@ func processTask(
 	ctx context.Context,
 	limiter *rate.Limiter,
 	task taskDetail,
-	batchParams BatchParams,
+	batchParams batch.BatchParams,
 	client frontend.Client,
 	applyOnChild *bool,
 	procFn func(string, string) error,
@@ -451,6 +430,124 @@ func processTask(
 	return nil
 }
 
+// redriveTimedOutActivities makes history reschedule right away the pending activities of a workflow which are
+// waiting to be retried after a timeout, workflows without such activities are left untouched
+func redriveTimedOutActivities(
+	ctx context.Context,
+	historyClient history.Client,
+	domainID string,
+	workflowID string,
+	runID string,
+) error {
+	return historyClient.RedriveTimedOutActivities(ctx, &types.HistoryRedriveTimedOutActivitiesRequest{
+		DomainUUID: domainID,
+		Request: &types.RedriveTimedOutActivitiesRequest{
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: workflowID,
+				RunID:      runID,
+			},
+			Identity: batch.BatchWFTypeName,
+		},
+	})
+}
+
+// drainAndTerminate terminates a workflow once its pending activities had a chance to shut down gracefully.
+// The cancellation of its pending activities is requested first, so that workers learn about it from their
+// next heartbeat. The workflow is then terminated as soon as it has no pending activity left, or when the drain
+// timeout expires.
+func drainAndTerminate(
+	ctx context.Context,
+	client frontend.Client,
+	historyClient history.Client,
+	batchParams batch.BatchParams,
+	domainID string,
+	hbd batch.HeartBeatDetails,
+	workflowID string,
+	runID string,
+) error {
+	execution := &types.WorkflowExecution{
+		WorkflowID: workflowID,
+		RunID:      runID,
+	}
+	resp, err := client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    batchParams.DomainName,
+		Execution: execution,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !isDrained(resp) {
+		err := historyClient.CancelPendingActivities(ctx, &types.HistoryCancelPendingActivitiesRequest{
+			DomainUUID: domainID,
+			Request: &types.CancelPendingActivitiesRequest{
+				Domain:            batchParams.DomainName,
+				WorkflowExecution: execution,
+				Details:           []byte(batchParams.Reason),
+				Identity:          batch.BatchWFTypeName,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if err := waitForDrain(ctx, client, batchParams, hbd, execution); err != nil {
+			return err
+		}
+	}
+
+	return client.TerminateWorkflowExecution(ctx, &types.TerminateWorkflowExecutionRequest{
+		Domain:            batchParams.DomainName,
+		WorkflowExecution: execution,
+		Reason:            batchParams.Reason,
+		Identity:          batch.BatchWFTypeName,
+	})
+}
+
+// waitForDrain polls the workflow until it is drained or the drain timeout expires, the activity keeps
+// heartbeating meanwhile since the drain timeout usually exceeds the heartbeat timeout.
+func waitForDrain(
+	ctx context.Context,
+	client frontend.Client,
+	batchParams batch.BatchParams,
+	hbd batch.HeartBeatDetails,
+	execution *types.WorkflowExecution,
+) error {
+	pollInterval := drainPollInterval
+	if pollInterval > batchParams.DrainParams.DrainTimeout {
+		pollInterval = batchParams.DrainParams.DrainTimeout
+	}
+	deadline := time.Now().Add(batchParams.DrainParams.DrainTimeout)
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+		activity.RecordHeartbeat(ctx, hbd)
+
+		resp, err := client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+			Domain:    batchParams.DomainName,
+			Execution: execution,
+		})
+		if err != nil {
+			return err
+		}
+		if isDrained(resp) {
+			return nil
+		}
+	}
+	getActivityLogger(ctx).Warn("Drain timeout expired before pending activities were gone",
+		tag.WorkflowID(execution.GetWorkflowID()), tag.WorkflowRunID(execution.GetRunID()))
+	return nil
+}
+
+func isDrained(resp *types.DescribeWorkflowExecutionResponse) bool {
+	if info := resp.GetWorkflowExecutionInfo(); info != nil && info.CloseStatus != nil {
+		return true
+	}
+	return len(resp.GetPendingActivities()) == 0
+}
+
 func isDone(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
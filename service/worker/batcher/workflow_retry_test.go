@@ -34,6 +34,7 @@ import (
 	"go.uber.org/cadence/worker"
 	"go.uber.org/mock/gomock"
 
+	"github.com/uber/cadence/common/batch"
 	"github.com/uber/cadence/common/metrics"
 	mmocks "github.com/uber/cadence/common/metrics/mocks"
 	"github.com/uber/cadence/common/resource"
@@ -84,7 +85,7 @@ func (s *workflowRetrySuite) SetupTest() {
 }
 
 func (s *workflowRetrySuite) TestActivityRetries() {
-	params := createParams(BatchTypeCancel)
+	params := createParams(batch.BatchTypeCancel)
 	params.MaxActivityRetries = 4
 
 	s.metricsMock.On("IncCounter", metrics.BatcherScope, metrics.BatcherProcessorSuccess).Times(2)
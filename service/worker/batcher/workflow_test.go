@@ -35,6 +35,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/batch"
 	"github.com/uber/cadence/common/metrics"
 	mmocks "github.com/uber/cadence/common/metrics/mocks"
 	"github.com/uber/cadence/common/types"
@@ -75,6 +76,8 @@ func (s *workflowSuite) SetupTest() {
 	mockResource.FrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	mockResource.FrontendClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
+	mockResource.HistoryClient.EXPECT().RedriveTimedOutActivities(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockResource.HistoryClient.EXPECT().CancelPendingActivities(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	mockResource.RemoteAdminClient.EXPECT().ResendReplicationTasks(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	ctx := context.WithValue(context.Background(), batcherContextKey, batcher)
@@ -88,8 +91,8 @@ func (s *workflowSuite) SetupTest() {
 }
 
 func (s *workflowSuite) TestWorkflow() {
-	params := createParams(BatchTypeCancel)
-	activityHeartBeatDeatils := HeartBeatDetails{}
+	params := createParams(batch.BatchTypeCancel)
+	activityHeartBeatDeatils := batch.HeartBeatDetails{}
 	s.workflowEnv.OnActivity(batchActivityName, mock.Anything, mock.Anything).Return(activityHeartBeatDeatils, nil)
 	s.workflowEnv.ExecuteWorkflow(BatchWorkflow, params)
 	s.True(s.workflowEnv.IsWorkflowCompleted())
@@ -97,31 +100,55 @@ func (s *workflowSuite) TestWorkflow() {
 }
 
 func (s *workflowSuite) TestActivity_BatchCancel() {
-	params := createParams(BatchTypeCancel)
+	params := createParams(batch.BatchTypeCancel)
 	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
 	s.NoError(err)
 }
 
 func (s *workflowSuite) TestActivity_BatchTerminate() {
-	params := createParams(BatchTypeTerminate)
+	params := createParams(batch.BatchTypeTerminate)
 	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
 	s.NoError(err)
 }
 
 func (s *workflowSuite) TestActivity_BatchSignal() {
-	params := createParams(BatchTypeSignal)
+	params := createParams(batch.BatchTypeSignal)
 	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
 	s.NoError(err)
 }
 
 func (s *workflowSuite) TestActivity_BatchReplicate() {
-	params := createParams(BatchTypeReplicate)
+	params := createParams(batch.BatchTypeReplicate)
 	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
 	s.NoError(err)
 }
 
+func (s *workflowSuite) TestActivity_BatchRedriveActivities() {
+	params := createParams(batch.BatchTypeRedriveActivities)
+	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+}
+
+func (s *workflowSuite) TestActivity_BatchTerminateWithDrain() {
+	params := createParams(batch.BatchTypeTerminateWithDrain)
+	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+}
+
+func (s *workflowSuite) TestIsDrained() {
+	s.True(isDrained(&types.DescribeWorkflowExecutionResponse{}))
+	s.False(isDrained(&types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{},
+		PendingActivities:     []*types.PendingActivityInfo{{ActivityID: "1"}},
+	}))
+	s.True(isDrained(&types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{CloseStatus: types.WorkflowExecutionCloseStatusCanceled.Ptr()},
+		PendingActivities:     []*types.PendingActivityInfo{{ActivityID: "1"}},
+	}))
+}
+
 func (s *workflowSuite) TestWorkflow_BatchTypeCancelValidationError() {
-	params := createParams(BatchTypeCancel)
+	params := createParams(batch.BatchTypeCancel)
 	params.Query = ""
 	s.workflowEnv.ExecuteWorkflow(BatchWorkflow, params)
 	s.True(s.workflowEnv.IsWorkflowCompleted())
@@ -136,7 +163,7 @@ func (s *workflowSuite) TestWorkflow_UnsupportedBatchType() {
 }
 
 func (s *workflowSuite) TestWorkflow_BatchTypeSignalValidation() {
-	params := createParams(BatchTypeSignal)
+	params := createParams(batch.BatchTypeSignal)
 	params.SignalParams.SignalName = ""
 	s.workflowEnv.ExecuteWorkflow(BatchWorkflow, params)
 	s.True(s.workflowEnv.IsWorkflowCompleted())
@@ -144,7 +171,7 @@ func (s *workflowSuite) TestWorkflow_BatchTypeSignalValidation() {
 }
 
 func (s *workflowSuite) TestWorkflow_BatchTypeReplicateSourceCLusterValidation() {
-	params := createParams(BatchTypeReplicate)
+	params := createParams(batch.BatchTypeReplicate)
 	params.ReplicateParams.SourceCluster = ""
 	s.workflowEnv.ExecuteWorkflow(BatchWorkflow, params)
 	s.True(s.workflowEnv.IsWorkflowCompleted())
@@ -152,7 +179,7 @@ func (s *workflowSuite) TestWorkflow_BatchTypeReplicateSourceCLusterValidation()
 }
 
 func (s *workflowSuite) TestWorkflow_BatchTypeReplicateTargetCLusterValidation() {
-	params := createParams(BatchTypeReplicate)
+	params := createParams(batch.BatchTypeReplicate)
 	params.ReplicateParams.TargetCluster = ""
 	s.workflowEnv.ExecuteWorkflow(BatchWorkflow, params)
 	s.True(s.workflowEnv.IsWorkflowCompleted())
@@ -163,22 +190,22 @@ func (s *workflowSuite) TearDownTest() {
 	s.workflowEnv.AssertExpectations(s.T())
 }
 
-func createParams(batchType string) BatchParams {
-	return BatchParams{
+func createParams(batchType string) batch.BatchParams {
+	return batch.BatchParams{
 		DomainName: "test-domain",
 		Query:      "Closetime=missing",
 		Reason:     "unit-test",
 		BatchType:  batchType,
-		TerminateParams: TerminateParams{
+		TerminateParams: batch.TerminateParams{
 			TerminateChildren: common.BoolPtr(true),
 		},
-		CancelParams: CancelParams{
+		CancelParams: batch.CancelParams{
 			CancelChildren: common.BoolPtr(true),
 		},
-		SignalParams: SignalParams{
+		SignalParams: batch.SignalParams{
 			SignalName: "test-signal-name",
 		},
-		ReplicateParams: ReplicateParams{
+		ReplicateParams: batch.ReplicateParams{
 			SourceCluster: "test-primary-cluster",
 			TargetCluster: "test-secondary-cluster",
 		},
@@ -189,6 +216,5 @@ func createParams(batchType string) BatchParams {
 		ActivityHeartBeatTimeout: 0,
 		MaxActivityRetries:       0,
 		NonRetryableErrors:       []string{"HeartbeatTimeoutError"},
-		_nonRetryableErrors:      nil,
 	}
 }
@@ -26,7 +26,7 @@ import (
 
 	"github.com/urfave/cli/v2"
 
-	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/uber/cadence/common/batch"
 )
 
 func newWorkflowCommands() []*cli.Command {
@@ -482,7 +482,7 @@ func newBatchCommands() []*cli.Command {
 				&cli.StringFlag{
 					Name:    FlagBatchType,
 					Aliases: []string{"bt"},
-					Usage:   "Types supported: " + strings.Join(batcher.AllBatchTypes, ","),
+					Usage:   "Types supported: " + strings.Join(batch.AllBatchTypes, ","),
 				},
 				// below are optional
 				&cli.StringFlag{
@@ -507,7 +507,7 @@ func newBatchCommands() []*cli.Command {
 				},
 				&cli.IntFlag{
 					Name:  FlagRPS,
-					Value: batcher.DefaultRPS,
+					Value: batch.DefaultRPS,
 					Usage: "RPS of processing",
 				},
 				&cli.BoolFlag{
@@ -516,29 +516,29 @@ func newBatchCommands() []*cli.Command {
 				},
 				&cli.IntFlag{
 					Name:  FlagPageSize,
-					Value: batcher.DefaultPageSize,
+					Value: batch.DefaultPageSize,
 					Usage: "PageSize of processiing",
 				},
 				&cli.IntFlag{
 					Name:  FlagRetryAttempts,
-					Value: batcher.DefaultAttemptsOnRetryableError,
+					Value: batch.DefaultAttemptsOnRetryableError,
 					Usage: "Retry attempts for retriable errors",
 				},
 				// TODO duration should use DurationFlag instead of IntFlag
 				&cli.IntFlag{
 					Name:    FlagActivityHeartBeatTimeout,
 					Aliases: []string{"hbt"},
-					Value:   int(batcher.DefaultActivityHeartBeatTimeout / time.Second),
+					Value:   int(batch.DefaultActivityHeartBeatTimeout / time.Second),
 					Usage:   "Heartbeat timeout for batcher activity in seconds",
 				},
 				&cli.IntFlag{
 					Name:  FlagConcurrency,
-					Value: batcher.DefaultConcurrency,
+					Value: batch.DefaultConcurrency,
 					Usage: "Concurrency of batch activity",
 				},
 				&cli.IntFlag{
 					Name:  FlagMaxActivityRetries,
-					Value: batcher.DefaultMaxActivityRetries,
+					Value: batch.DefaultMaxActivityRetries,
 					Usage: "Max retries of batch activity, before retrying the whole workflow (0 means unlimited)",
 				},
 			},
@@ -32,8 +32,8 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/batch"
 	"github.com/uber/cadence/common/types"
-	"github.com/uber/cadence/service/worker/batcher"
 	"github.com/uber/cadence/tools/common/commoncli"
 )
 
@@ -120,7 +120,7 @@ func DescribeBatchJob(c *cli.Context) error {
 		output["msg"] = "batch job is running"
 		if len(wf.PendingActivities) > 0 {
 			hbdBinary := wf.PendingActivities[0].HeartbeatDetails
-			hbd := batcher.HeartBeatDetails{}
+			hbd := batch.HeartBeatDetails{}
 			err := json.Unmarshal(hbdBinary, &hbd)
 			if err != nil {
 				return commoncli.Problem("Failed to describe batch job", err)
@@ -202,11 +202,11 @@ func StartBatchJob(c *cli.Context) error {
 		return commoncli.Problem("Required flag not found: ", err)
 	}
 	if !validateBatchType(batchType) {
-		return commoncli.Problem("batchType is not valid, supported:"+strings.Join(batcher.AllBatchTypes, ","), nil)
+		return commoncli.Problem("batchType is not valid, supported:"+strings.Join(batch.AllBatchTypes, ","), nil)
 	}
 	operator := getCurrentUserFromEnv()
 	var sigName, sigVal string
-	if batchType == batcher.BatchTypeSignal {
+	if batchType == batch.BatchTypeSignal {
 		sigName, err = getRequiredOption(c, FlagSignalName)
 		if err != nil {
 			return commoncli.Problem("Required flag not found: ", err)
@@ -217,7 +217,7 @@ func StartBatchJob(c *cli.Context) error {
 		}
 	}
 	var sourceCluster, targetCluster string
-	if batchType == batcher.BatchTypeReplicate {
+	if batchType == batch.BatchTypeReplicate {
 		sourceCluster, err = getRequiredOption(c, FlagSourceCluster)
 		if err != nil {
 			return commoncli.Problem("Required flag not found: ", err)
@@ -278,16 +278,16 @@ func StartBatchJob(c *cli.Context) error {
 		return commoncli.Problem("Error in creating context:", err)
 	}
 
-	params := batcher.BatchParams{
+	params := batch.BatchParams{
 		DomainName: domain,
 		Query:      query,
 		Reason:     reason,
 		BatchType:  batchType,
-		SignalParams: batcher.SignalParams{
+		SignalParams: batch.SignalParams{
 			SignalName: sigName,
 			Input:      sigVal,
 		},
-		ReplicateParams: batcher.ReplicateParams{
+		ReplicateParams: batch.ReplicateParams{
 			SourceCluster: sourceCluster,
 			TargetCluster: targetCluster,
 		},
@@ -320,13 +320,13 @@ func StartBatchJob(c *cli.Context) error {
 		Domain:                              common.BatcherLocalDomainName,
 		RequestID:                           uuid.New(),
 		WorkflowID:                          workflowID,
-		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(batcher.InfiniteDuration.Seconds())),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(batch.InfiniteDuration.Seconds())),
 		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(defaultDecisionTimeoutInSeconds)),
-		TaskList:                            &types.TaskList{Name: batcher.BatcherTaskListName},
+		TaskList:                            &types.TaskList{Name: batch.BatcherTaskListName},
 		Memo:                                memo,
 		SearchAttributes:                    searchAttributes,
 		RetryPolicy:                         copyRetryPolicyFromWorkflow(),
-		WorkflowType:                        &types.WorkflowType{Name: batcher.BatchWFTypeName},
+		WorkflowType:                        &types.WorkflowType{Name: batch.BatchWFTypeName},
 		Input:                               input,
 	}
 	_, err = svcClient.StartWorkflowExecution(tcCtx, request)
@@ -343,16 +343,16 @@ func StartBatchJob(c *cli.Context) error {
 
 func copyRetryPolicyFromWorkflow() *types.RetryPolicy {
 	return &types.RetryPolicy{
-		InitialIntervalInSeconds:    int32(batcher.BatchActivityRetryPolicy.InitialInterval.Seconds()),
-		BackoffCoefficient:          batcher.BatchActivityRetryPolicy.BackoffCoefficient,
-		MaximumIntervalInSeconds:    int32(batcher.BatchActivityRetryPolicy.MaximumInterval.Seconds()),
-		NonRetriableErrorReasons:    batcher.BatchActivityRetryPolicy.NonRetriableErrorReasons,
-		ExpirationIntervalInSeconds: int32(batcher.BatchActivityRetryPolicy.ExpirationInterval.Seconds()),
+		InitialIntervalInSeconds:    int32(batch.BatchActivityRetryPolicy.InitialInterval.Seconds()),
+		BackoffCoefficient:          batch.BatchActivityRetryPolicy.BackoffCoefficient,
+		MaximumIntervalInSeconds:    int32(batch.BatchActivityRetryPolicy.MaximumInterval.Seconds()),
+		NonRetriableErrorReasons:    batch.BatchActivityRetryPolicy.NonRetriableErrorReasons,
+		ExpirationIntervalInSeconds: int32(batch.BatchActivityRetryPolicy.ExpirationInterval.Seconds()),
 	}
 }
 
 func validateBatchType(bt string) bool {
-	for _, b := range batcher.AllBatchTypes {
+	for _, b := range batch.AllBatchTypes {
 		if b == bt {
 			return true
 		}
@@ -32,8 +32,8 @@ import (
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/batch"
 	"github.com/uber/cadence/common/types"
-	"github.com/uber/cadence/service/worker/batcher"
 )
 
 func TestStartBatchJob(t *testing.T) {
@@ -58,7 +58,7 @@ func TestStartBatchJob(t *testing.T) {
 				FlagDomain:     "test-domain",
 				FlagListQuery:  "workflowType='batch'",
 				FlagReason:     "Testing batch job",
-				FlagBatchType:  batcher.BatchTypeSignal,
+				FlagBatchType:  batch.BatchTypeSignal,
 				FlagSignalName: "test-signal",
 				FlagInput:      "test-input",
 				FlagYes:        true,
@@ -72,7 +72,7 @@ func TestStartBatchJob(t *testing.T) {
 			flags: map[string]interface{}{
 				FlagListQuery: "workflowType='batch'",
 				FlagReason:    "Testing batch job",
-				FlagBatchType: batcher.BatchTypeSignal,
+				FlagBatchType: batch.BatchTypeSignal,
 			},
 			expectedError: "Required flag not found: : option domain is required",
 		},
@@ -82,7 +82,7 @@ func TestStartBatchJob(t *testing.T) {
 			flags: map[string]interface{}{
 				FlagDomain:    "test-domain",
 				FlagReason:    "Testing batch job",
-				FlagBatchType: batcher.BatchTypeSignal,
+				FlagBatchType: batch.BatchTypeSignal,
 			},
 			expectedError: "Required flag not found: : option query is required",
 		},
@@ -93,7 +93,7 @@ func TestStartBatchJob(t *testing.T) {
 				FlagDomain:    "test-domain",
 				FlagListQuery: "workflowType='batch'",
 				FlagReason:    "Testing batch job",
-				FlagBatchType: batcher.BatchTypeSignal,
+				FlagBatchType: batch.BatchTypeSignal,
 			},
 			expectedError: "Required flag not found: : option signal_name is required",
 		},
@@ -104,7 +104,7 @@ func TestStartBatchJob(t *testing.T) {
 				FlagDomain:     "test-domain",
 				FlagListQuery:  "workflowType='batch'",
 				FlagReason:     "Testing batch job",
-				FlagBatchType:  batcher.BatchTypeSignal,
+				FlagBatchType:  batch.BatchTypeSignal,
 				FlagSignalName: "test-signal",
 			},
 			expectedError: "Required flag not found: : option input is required",
@@ -115,7 +115,7 @@ func TestStartBatchJob(t *testing.T) {
 			flags: map[string]interface{}{
 				FlagDomain:    "test-domain",
 				FlagListQuery: "workflowType='batch'",
-				FlagBatchType: batcher.BatchTypeSignal,
+				FlagBatchType: batch.BatchTypeSignal,
 			},
 			expectedError: "Required flag not found: : option reason is required",
 		},
@@ -139,7 +139,7 @@ func TestStartBatchJob(t *testing.T) {
 				FlagDomain:     "test-domain",
 				FlagListQuery:  "workflowType='batch'",
 				FlagReason:     "Testing batch job",
-				FlagBatchType:  batcher.BatchTypeSignal,
+				FlagBatchType:  batch.BatchTypeSignal,
 				FlagSignalName: "test-signal",
 				FlagInput:      "test-input",
 				FlagYes:        true,
@@ -158,7 +158,7 @@ func TestStartBatchJob(t *testing.T) {
 				FlagDomain:     "test-domain",
 				FlagListQuery:  "workflowType='batch'",
 				FlagReason:     "Testing batch job",
-				FlagBatchType:  batcher.BatchTypeSignal,
+				FlagBatchType:  batch.BatchTypeSignal,
 				FlagSignalName: "test-signal",
 				FlagInput:      "test-input",
 				FlagYes:        true,
@@ -352,7 +352,7 @@ func TestDescribeBatchJob(t *testing.T) {
 			expectedError: "",
 			expectedOutput: map[string]interface{}{
 				"msg": "batch job is running",
-				"progress": batcher.HeartBeatDetails{
+				"progress": batch.HeartBeatDetails{
 					PageToken:     nil,
 					CurrentPage:   30,
 					TotalEstimate: 100,
@@ -412,7 +412,7 @@ func TestDescribeBatchJob(t *testing.T) {
 				err = json.Unmarshal(ioHandler.outputBytes.Bytes(), &actualOutput)
 				assert.NoError(t, err)
 				if progressRaw, exists := actualOutput["progress"]; exists {
-					var progress batcher.HeartBeatDetails
+					var progress batch.HeartBeatDetails
 					progressBytes, err := json.Marshal(progressRaw)
 					if err == nil {
 						json.Unmarshal(progressBytes, &progress)
@@ -521,7 +521,7 @@ func TestListBatchJobs(t *testing.T) {
 
 func TestValidateBatchType(t *testing.T) {
 	// Mock batch types for testing
-	batcher.AllBatchTypes = []string{"signal", "replicate", "terminate"}
+	batch.AllBatchTypes = []string{"signal", "replicate", "terminate"}
 
 	tests := []struct {
 		name      string